@@ -23,17 +23,45 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
+// configTypes are the types of the config file, in the order they are documented
+var configTypes = []string{
+	"Config",
+	"ManagerConfig",
+	"EdgeConfig",
+	"UIConfig",
+	"LoggingConfig",
+	"ProfileConfig",
+	"MetricsConfig",
+	"MetricsAuth",
+	"MetricsQueryTemplates",
+	"TracesConfig",
+	"AccessLogsConfig",
+	"ExecConfig",
+	"EnvVar",
+}
+
 func main() {
-	if err := generateConfigDocs(); err != nil {
+	docPkg, err := parseConfigPackage()
+	if err != nil {
+		log.Fatalf("Failed to parse config package: %v", err)
+	}
+
+	if err := generateConfigDocs(docPkg); err != nil {
 		log.Fatalf("Failed to generate config documentation: %v", err)
 	}
 	fmt.Println("Configuration documentation generated: docs/reference/config/navctl.md")
+
+	if err := generateConfigSchema(docPkg); err != nil {
+		log.Fatalf("Failed to generate config schema: %v", err)
+	}
+	fmt.Println("Configuration schema generated: " + schemaPath)
 }
 
-func generateConfigDocs() error {
+func parseConfigPackage() (*doc.Package, error) {
 	// Parse the config package
 	fset := token.NewFileSet()
 	pkgPath := "./navctl/pkg/config"
@@ -42,48 +70,45 @@ func generateConfigDocs() error {
 		return strings.HasSuffix(info.Name(), ".go") && !strings.HasSuffix(info.Name(), "_test.go")
 	}, parser.ParseComments)
 	if err != nil {
-		return fmt.Errorf("failed to parse package: %w", err)
+		return nil, fmt.Errorf("failed to parse package: %w", err)
 	}
 
 	configPkg := pkgs["config"]
 	if configPkg == nil {
-		return fmt.Errorf("config package not found")
+		return nil, fmt.Errorf("config package not found")
 	}
 
-	// Create documentation
-	docPkg := doc.New(configPkg, "github.com/liamawhite/navigator/navctl/pkg/config", 0)
-
-	// Define the order we want types to appear
-	typeOrder := []string{
-		"Config",
-		"ManagerConfig",
-		"EdgeConfig",
-		"UIConfig",
-		"MetricsConfig",
-		"MetricsAuth",
-		"ExecConfig",
-		"EnvVar",
+	return doc.New(configPkg, "github.com/liamawhite/navigator/navctl/pkg/config", 0), nil
+}
+
+// findType returns the documentation of a type of the config package, nil if there is none
+func findType(docPkg *doc.Package, typeName string) *doc.Type {
+	for _, t := range docPkg.Types {
+		if t.Name == typeName {
+			return t
+		}
 	}
+	return nil
+}
 
+func generateConfigDocs(docPkg *doc.Package) error {
 	// Generate markdown
 	var content strings.Builder
 	content.WriteString("# navctl Configuration Reference\n\n")
 	content.WriteString("This document describes the configuration file format for navctl.\n\n")
+	fmt.Fprintf(&content, "Configuration files are validated against the [JSON Schema](%s) of this format when they are loaded. Unknown fields are ignored with a warning.\n\n", schemaID)
 
 	// Add table of contents
 	content.WriteString("## Table of Contents\n\n")
-	for _, typeName := range typeOrder {
+	for _, typeName := range configTypes {
 		fmt.Fprintf(&content, "- [%s](#%s)\n", typeName, strings.ToLower(typeName))
 	}
 	content.WriteString("\n")
 
 	// Generate documentation for each type in order
-	for _, typeName := range typeOrder {
-		for _, t := range docPkg.Types {
-			if t.Name == typeName {
-				generateTypeDoc(&content, t, fset)
-				break
-			}
+	for _, typeName := range configTypes {
+		if t := findType(docPkg, typeName); t != nil {
+			generateTypeDoc(&content, t)
 		}
 	}
 
@@ -100,7 +125,7 @@ func generateConfigDocs() error {
 	return nil
 }
 
-func generateTypeDoc(content *strings.Builder, t *doc.Type, fset *token.FileSet) {
+func generateTypeDoc(content *strings.Builder, t *doc.Type) {
 	fmt.Fprintf(content, "## %s\n\n", t.Name)
 
 	// Add type description
@@ -216,6 +241,8 @@ func formatType(expr ast.Expr) string {
 		return formatType(t.X) // Remove pointer indicator for doc purposes
 	case *ast.ArrayType:
 		return formatType(t.Elt) // Show element type for arrays
+	case *ast.MapType:
+		return formatType(t.Value) // Show value type for maps
 	case *ast.SelectorExpr:
 		return formatType(t.X) + "." + t.Sel.Name
 	default:
@@ -224,17 +251,7 @@ func formatType(expr ast.Expr) string {
 }
 
 func isComplexType(typeName string) bool {
-	complexTypes := []string{
-		"ManagerConfig", "EdgeConfig", "UIConfig",
-		"MetricsConfig", "MetricsAuth", "ExecConfig", "EnvVar",
-	}
-
-	for _, complexType := range complexTypes {
-		if typeName == complexType {
-			return true
-		}
-	}
-	return false
+	return typeName != "Config" && slices.Contains(configTypes, typeName)
 }
 
 func addCrossReferences(docText, fieldType string) string {
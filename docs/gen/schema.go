@@ -0,0 +1,197 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// schemaPath is where the JSON Schema of the config file is written, next to the config package
+	// that embeds it
+	schemaPath = "navctl/pkg/config/navctl-config.schema.json"
+
+	// schemaID is the URL of the schema, which editors can load it from
+	schemaID = "https://raw.githubusercontent.com/liamawhite/navigator/main/" + schemaPath
+)
+
+// quotedValue matches the quoted values listed in field documentation
+var quotedValue = regexp.MustCompile(`"([^"]*)"`)
+
+// generateConfigSchema writes a JSON Schema of the config file generated from the config types and
+// their documentation. Field documentation lines starting with "Valid values:" or "Must be:" become
+// enums, of the items of list fields, "Default:" lines become defaults and "Required." lines mark required
+// fields.
+func generateConfigSchema(docPkg *doc.Package) error {
+	defs := make(map[string]any)
+	for _, typeName := range configTypes[1:] {
+		t := findType(docPkg, typeName)
+		if t == nil {
+			return fmt.Errorf("type %s not found", typeName)
+		}
+		defs[typeName] = structSchema(t)
+	}
+
+	root := findType(docPkg, configTypes[0])
+	if root == nil {
+		return fmt.Errorf("type %s not found", configTypes[0])
+	}
+	schema := structSchema(root)
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["$id"] = schemaID
+	schema["title"] = "navctl configuration"
+	schema["$defs"] = defs
+
+	// JSON config files may reference the schema themselves
+	schema["properties"].(map[string]any)["$schema"] = map[string]any{
+		"type":        "string",
+		"description": "URL of the JSON Schema of the config file.",
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	if err := os.WriteFile(schemaPath, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	return nil
+}
+
+// structSchema returns the schema of a struct type, which allows no properties but its fields
+func structSchema(t *doc.Type) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+
+	if structType, ok := t.Decl.Specs[0].(*ast.TypeSpec).Type.(*ast.StructType); ok {
+		for _, field := range structType.Fields.List {
+			if len(field.Names) == 0 {
+				continue
+			}
+			name := extractYamlFieldName(field)
+			property := typeSchema(field.Type)
+			if field.Doc != nil {
+				if applyFieldDoc(property, field.Doc.Text()) {
+					required = append(required, name)
+				}
+			}
+			properties[name] = property
+		}
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"description":          typeDescription(t.Doc),
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// typeSchema returns the schema of the values of a field type
+func typeSchema(expr ast.Expr) map[string]any {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return map[string]any{"type": "string"}
+		case "int", "int32", "int64":
+			return map[string]any{"type": "integer"}
+		case "float32", "float64":
+			return map[string]any{"type": "number"}
+		case "bool":
+			return map[string]any{"type": "boolean"}
+		default:
+			return map[string]any{"$ref": "#/$defs/" + t.Name}
+		}
+	case *ast.StarExpr:
+		return typeSchema(t.X)
+	case *ast.ArrayType:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elt)}
+	case *ast.MapType:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Value)}
+	default:
+		return map[string]any{}
+	}
+}
+
+// applyFieldDoc adds the description, enum and default of a field's documentation to its schema,
+// and returns whether the documentation marks the field required
+func applyFieldDoc(property map[string]any, docText string) bool {
+	var lines []string
+	required := false
+	for _, line := range strings.Split(strings.TrimSpace(docText), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+
+		switch {
+		case strings.HasPrefix(line, "Valid values:"), strings.HasPrefix(line, "Must be:"):
+			var enum []any
+			for _, match := range quotedValue.FindAllStringSubmatch(line, -1) {
+				enum = append(enum, match[1])
+			}
+			if items, isArray := property["items"].(map[string]any); isArray && len(enum) > 0 {
+				items["enum"] = enum
+			} else if len(enum) > 0 {
+				property["enum"] = enum
+			}
+		case strings.HasPrefix(line, "Default:"):
+			if value, ok := parseDefault(property["type"], strings.TrimSpace(strings.TrimPrefix(line, "Default:"))); ok {
+				property["default"] = value
+			}
+		case line == "Required." || strings.HasPrefix(line, "Required. "):
+			required = true
+		}
+	}
+	property["description"] = strings.Join(lines, " ")
+	return required
+}
+
+// parseDefault parses the documented default of a field as a value of its schema type
+func parseDefault(schemaType any, value string) (any, bool) {
+	switch schemaType {
+	case "string":
+		return strings.Trim(value, `"`), true
+	case "integer":
+		n, err := strconv.Atoi(value)
+		return n, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		return b, err == nil
+	default:
+		return nil, false
+	}
+}
+
+// typeDescription returns the first paragraph of a type's documentation
+func typeDescription(docText string) string {
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(docText), "\n\n")
+	return strings.Join(strings.Fields(paragraph), " ")
+}
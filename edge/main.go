@@ -17,10 +17,13 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs/loki"
+	"github.com/liamawhite/navigator/edge/pkg/admin"
 	"github.com/liamawhite/navigator/edge/pkg/config"
 	"github.com/liamawhite/navigator/edge/pkg/interfaces"
 	"github.com/liamawhite/navigator/edge/pkg/kubernetes"
@@ -28,8 +31,10 @@ import (
 	"github.com/liamawhite/navigator/edge/pkg/metrics/prometheus"
 	"github.com/liamawhite/navigator/edge/pkg/proxy"
 	"github.com/liamawhite/navigator/edge/pkg/service"
+	"github.com/liamawhite/navigator/edge/pkg/traces/factory"
 	"github.com/liamawhite/navigator/pkg/istio/proxy/client"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/version"
 )
 
 func main() {
@@ -41,6 +46,23 @@ func main() {
 	}
 
 	// Setup logging
+	slog.SetDefault(logging.NewLogger(&logging.Config{
+		Level:  logging.ParseLevel(cfg.LogLevel),
+		Format: cfg.LogFormat,
+	}))
+	var logExporter *logging.OTLPExporter
+	if cfg.OTLPLogsEndpoint != "" {
+		logExporter, err = logging.NewOTLPExporter(context.Background(), logging.OTLPConfig{
+			Endpoint:       cfg.OTLPLogsEndpoint,
+			ServiceName:    "navigator-edge",
+			ServiceVersion: version.Get(),
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create OTLP log exporter: %v\n", err)
+			os.Exit(1)
+		}
+		slog.SetDefault(logging.WithOTLP(slog.Default(), logExporter))
+	}
 	logger := logging.For("edge")
 
 	// Create Kubernetes client
@@ -50,6 +72,27 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Only collect this edge's namespaces when the cluster is split between several edges
+	k8sClient.SetNamespaceShard(cfg.GetNamespaceShard())
+
+	// Collect resource groups that change less often than workloads on their own schedule
+	k8sClient.SetSyncIntervals(cfg.GetSyncIntervals())
+
+	// Leave out the parts of the cluster state that disabled collection modules cover
+	k8sClient.SetCollectionExclusions(cfg.GetCollectionExclusions())
+	for _, module := range config.Modules {
+		if !cfg.ModuleEnabled(module) {
+			logger.Info("collection module disabled", "module", module)
+		}
+	}
+
+	// Serve workload resources from informer caches instead of listing them on every collection
+	if cfg.UsesInformerDatastore() {
+		datastore := kubernetes.NewInformerDatastore(k8sClient.GetClientset(), cfg.GetInformerOptions())
+		defer datastore.Stop()
+		k8sClient.SetDatastore(datastore)
+	}
+
 	// Create admin client for Envoy proxy access
 	adminClient := client.NewAdminClient(k8sClient.GetClientset(), k8sClient.GetRestConfig())
 
@@ -70,9 +113,82 @@ func main() {
 			logger.Info("retrieved cluster name for metrics filtering", "cluster_name", clusterName)
 		}
 
-		metricsProvider, err = prometheus.Create(metricsConfig, logger, clusterName)
+		// Use a well-known Prometheus installation of the cluster when no endpoint is configured
+		if metricsConfig.Endpoint == "" {
+			endpoint, installation, err := k8sClient.DiscoverPrometheus(context.Background())
+			if err != nil {
+				logger.Warn("failed to discover prometheus", "error", err)
+			} else if endpoint == "" {
+				logger.Warn("no metrics endpoint configured and no prometheus found in the cluster, metrics are disabled")
+			} else {
+				logger.Info("discovered prometheus in the cluster", "installation", installation, "endpoint", endpoint)
+			}
+			metricsConfig.Endpoint = endpoint
+		}
+
+		// Out of the cluster, reach in-cluster metrics endpoints such as prometheus.istio-system:9090 through a port-forward
+		if cfg.KubeconfigPath != "" && metricsConfig.Endpoint != "" {
+			metricsConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(context.Background(), metricsConfig.Endpoint)
+			if err != nil {
+				logger.Error("failed to forward metrics endpoint", "error", err)
+				os.Exit(1)
+			}
+		}
+		if cfg.KubeconfigPath != "" && metricsConfig.SecondaryEndpoint != "" {
+			metricsConfig.SecondaryEndpoint, err = k8sClient.ForwardServiceEndpoint(context.Background(), metricsConfig.SecondaryEndpoint)
+			if err != nil {
+				logger.Error("failed to forward secondary metrics endpoint", "error", err)
+				os.Exit(1)
+			}
+		}
+
+		if metricsConfig.Endpoint != "" {
+			metricsProvider, err = prometheus.Create(metricsConfig, logger, clusterName)
+			if err != nil {
+				logger.Error("failed to create metrics provider", "error", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	// Create traces provider for the configured tracing backend
+	var tracesProvider interfaces.TracesProvider
+	tracesConfig := cfg.GetTracesConfig()
+
+	if tracesConfig.Enabled() {
+		// Out of the cluster, reach in-cluster tracing backends such as tracing.istio-system:16686 through a port-forward
+		if cfg.KubeconfigPath != "" {
+			tracesConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(context.Background(), tracesConfig.Endpoint)
+			if err != nil {
+				logger.Error("failed to forward traces endpoint", "error", err)
+				os.Exit(1)
+			}
+		}
+
+		tracesProvider, err = factory.Create(tracesConfig, logger)
+		if err != nil {
+			logger.Error("failed to create traces provider", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Create access logs provider for the configured logs backend
+	var accessLogsProvider interfaces.AccessLogsProvider
+	accessLogsConfig := cfg.GetAccessLogsConfig()
+
+	if accessLogsConfig.Enabled() {
+		// Out of the cluster, reach in-cluster logs backends such as loki.monitoring:3100 through a port-forward
+		if cfg.KubeconfigPath != "" {
+			accessLogsConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(context.Background(), accessLogsConfig.Endpoint)
+			if err != nil {
+				logger.Error("failed to forward access logs endpoint", "error", err)
+				os.Exit(1)
+			}
+		}
+
+		accessLogsProvider, err = loki.NewProvider(accessLogsConfig, logger)
 		if err != nil {
-			logger.Error("failed to create metrics provider", "error", err)
+			logger.Error("failed to create access logs provider", "error", err)
 			os.Exit(1)
 		}
 	}
@@ -84,12 +200,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	if tracesProvider != nil {
+		edgeService.SetTracesProvider(tracesProvider)
+	}
+	if accessLogsProvider != nil {
+		edgeService.SetAccessLogsProvider(accessLogsProvider)
+	}
+
+	// Only sync from the elected leader when running redundant replicas
+	if cfg.LeaderElect {
+		edgeService.SetLeaderElector(kubernetes.NewLeaderElector(
+			k8sClient.GetClientset(),
+			cfg.LeaderElectionNamespace,
+			cfg.LeaderElectionLeaseName,
+			cfg.LeaderElectionIdentity,
+			logger,
+		))
+	}
+
 	// Start edge service
 	if err := edgeService.Start(); err != nil {
 		logger.Error("failed to start edge service", "error", err)
 		os.Exit(1)
 	}
 
+	// Start admin server if enabled
+	var adminServer *admin.Server
+	if cfg.GetAdminPort() > 0 {
+		adminServer = admin.NewServer(cfg.GetAdminAddress(), cfg.GetAdminPort(), logger)
+		if err := adminServer.Start(); err != nil {
+			logger.Error("failed to start admin server", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Setup signal handling for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -104,14 +248,32 @@ func main() {
 	case sig := <-sigChan:
 		logger.Info("received signal", "signal", sig)
 		cancel()
+	case err := <-edgeService.Err():
+		logger.Error("edge service stopped unexpectedly", "error", err)
+		if err := edgeService.Stop(); err != nil {
+			logger.Error("error during shutdown", "error", err)
+		}
+		os.Exit(1)
 	}
 
 	// Graceful shutdown
 	logger.Info("shutting down edge service")
+	if adminServer != nil {
+		if err := adminServer.Stop(); err != nil {
+			logger.Error("error shutting down admin server", "error", err)
+		}
+	}
 	if err := edgeService.Stop(); err != nil {
 		logger.Error("error during shutdown", "error", err)
 		os.Exit(1)
 	}
 
 	logger.Info("edge service stopped")
+
+	// Flush logs still queued for the OTLP endpoint
+	if logExporter != nil {
+		if err := logExporter.Shutdown(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to flush OTLP logs: %v\n", err)
+		}
+	}
 }
@@ -0,0 +1,28 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslogs
+
+import "errors"
+
+var (
+	// ErrProviderNotSupported indicates that a logs backend type is not supported
+	ErrProviderNotSupported = errors.New("access logs provider type must be one of: none, loki")
+
+	// ErrMissingEndpoint indicates that the logs backend endpoint is missing
+	ErrMissingEndpoint = errors.New("access logs provider endpoint is required when enabled")
+
+	// ErrFormatNotSupported indicates that an access log format is not supported
+	ErrFormatNotSupported = errors.New("access log format must be one of: text, json")
+)
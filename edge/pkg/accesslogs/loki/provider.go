@@ -0,0 +1,206 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// textPattern extracts the fields of Istio's default text access log format
+const textPattern = `[<_>] "<method> <path> <_>" <response_code> <response_flags> <_> <_> "<_>" <_> <_> <duration> <_> "<_>" "<_>" "<_>" "<_>" "<_>" <upstream_cluster> <_>`
+
+// responseCodeRegexp matches a response code such as "404" or a class such as "5xx"
+var responseCodeRegexp = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+// Provider searches the access logs of the proxies stored in Grafana Loki through its HTTP API
+type Provider struct {
+	endpoint     string
+	client       *http.Client
+	format       accesslogs.Format
+	serviceLabel string
+	logger       *slog.Logger
+}
+
+// NewProvider creates a new Loki access logs provider
+func NewProvider(config accesslogs.Config, logger *slog.Logger) (*Provider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := url.Parse(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid loki endpoint: %w", err)
+	}
+
+	return &Provider{
+		endpoint:     strings.TrimSuffix(config.Endpoint, "/"),
+		client:       config.HTTPClient(),
+		format:       config.Format,
+		serviceLabel: config.ServiceLabel,
+		logger:       logger,
+	}, nil
+}
+
+// GetProviderInfo returns information about this access logs provider
+func (p *Provider) GetProviderInfo() accesslogs.ProviderInfo {
+	return accesslogs.ProviderInfo{
+		Type:     accesslogs.ProviderTypeLoki,
+		Endpoint: p.endpoint,
+	}
+}
+
+// queryRangeResponse is the response of the Loki query_range API for a log query
+type queryRangeResponse struct {
+	Data struct {
+		Result []stream `json:"result"`
+	} `json:"data"`
+}
+
+type stream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// ListAccessLogs searches the access logs of a service's proxies with LogQL, most recent first
+func (p *Provider) ListAccessLogs(ctx context.Context, query accesslogs.Query) ([]*typesv1alpha1.AccessLogEntry, error) {
+	logQL, err := p.buildQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("query", logQL)
+	params.Set("start", strconv.FormatInt(query.Start.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(query.End.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(query.Limit))
+	params.Set("direction", "backward")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/loki/api/v1/query_range?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loki request: %w", err)
+	}
+
+	p.logger.Debug("searching loki access logs", "query", logQL)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("loki query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("loki query failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result queryRangeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode loki response: %w", err)
+	}
+
+	var entries []*typesv1alpha1.AccessLogEntry
+	for _, s := range result.Data.Result {
+		for _, value := range s.Values {
+			entries = append(entries, toEntry(s.Stream, value))
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.AsTime().After(entries[j].Timestamp.AsTime())
+	})
+	if query.Limit > 0 && len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+
+	return entries, nil
+}
+
+// buildQuery builds the LogQL query selecting the access logs of the service's proxies, parsing them and
+// filtering them by response code and path
+func (p *Provider) buildQuery(query accesslogs.Query) (string, error) {
+	selectors := []string{
+		fmt.Sprintf("namespace=%s", strconv.Quote(query.Namespace)),
+		`container="istio-proxy"`,
+		fmt.Sprintf("%s=%s", p.serviceLabel, strconv.Quote(query.ServiceName)),
+	}
+	if query.PodName != "" {
+		selectors = append(selectors, fmt.Sprintf("pod=%s", strconv.Quote(query.PodName)))
+	}
+
+	var b strings.Builder
+	b.WriteString("{" + strings.Join(selectors, ", ") + "}")
+	if p.format == accesslogs.FormatJSON {
+		b.WriteString(` | json | __error__=""`)
+	} else {
+		b.WriteString(" | pattern `" + textPattern + "`")
+	}
+	// Skip the proxy's own logs, which are not access logs
+	b.WriteString(` | method != ""`)
+
+	if len(query.ResponseCodes) > 0 {
+		codes := make([]string, 0, len(query.ResponseCodes))
+		for _, code := range query.ResponseCodes {
+			if !responseCodeRegexp.MatchString(code) {
+				return "", fmt.Errorf("invalid response code %q, must be a code such as 404 or a class such as 5xx", code)
+			}
+			codes = append(codes, strings.ReplaceAll(code, "x", "."))
+		}
+		b.WriteString(fmt.Sprintf(" | response_code =~ %s", strconv.Quote(strings.Join(codes, "|"))))
+	}
+	if query.PathPrefix != "" {
+		b.WriteString(fmt.Sprintf(" | path =~ %s", strconv.Quote(regexp.QuoteMeta(query.PathPrefix)+".*")))
+	}
+
+	return b.String(), nil
+}
+
+// toEntry converts a log line and the labels extracted from it to an access log entry. Durations are
+// logged in milliseconds in both formats.
+func toEntry(labels map[string]string, value [2]string) *typesv1alpha1.AccessLogEntry {
+	nanos, _ := strconv.ParseInt(value[0], 10, 64)
+	responseCode, _ := strconv.Atoi(labels["response_code"])
+	durationMs, _ := strconv.ParseInt(labels["duration"], 10, 64)
+
+	return &typesv1alpha1.AccessLogEntry{
+		Timestamp:       timestamppb.New(time.Unix(0, nanos)),
+		Namespace:       labels["namespace"],
+		PodName:         labels["pod"],
+		Method:          labels["method"],
+		Path:            labels["path"],
+		ResponseCode:    int32(responseCode),
+		ResponseFlags:   labels["response_flags"],
+		Duration:        durationpb.New(time.Duration(durationMs) * time.Millisecond),
+		UpstreamCluster: labels["upstream_cluster"],
+		Line:            value[1],
+	}
+}
+
+// Close closes the provider and cleans up resources
+func (p *Provider) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_ListAccessLogs(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		_, _ = w.Write([]byte(`{
+  "status": "success",
+  "data": {
+    "resultType": "streams",
+    "result": [
+      {
+        "stream": {"namespace": "bookinfo", "pod": "reviews-v1-abc", "method": "GET", "path": "/reviews/1", "response_code": "503", "response_flags": "UF", "duration": "12", "upstream_cluster": "inbound|9080||"},
+        "values": [["1700000000000000000", "older line"]]
+      },
+      {
+        "stream": {"namespace": "bookinfo", "pod": "reviews-v2-def", "method": "GET", "path": "/reviews/2", "response_code": "500", "response_flags": "-", "duration": "250", "upstream_cluster": "inbound|9080||"},
+        "values": [["1700000060000000000", "newer line"]]
+      }
+    ]
+  }
+}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(accesslogs.Config{Type: accesslogs.ProviderTypeLoki, Endpoint: server.URL, TenantID: "mesh"}, logging.For("test"))
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	entries, err := provider.ListAccessLogs(context.Background(), accesslogs.Query{
+		ServiceName:   "reviews",
+		Namespace:     "bookinfo",
+		Start:         start,
+		End:           start.Add(time.Hour),
+		ResponseCodes: []string{"5xx"},
+		PathPrefix:    "/reviews",
+		Limit:         100,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, request)
+	assert.Equal(t, "/loki/api/v1/query_range", request.URL.Path)
+	assert.Equal(t, "mesh", request.Header.Get("X-Scope-OrgID"))
+	assert.Equal(t, "1700000000000000000", request.URL.Query().Get("start"))
+	assert.Equal(t, "1700003600000000000", request.URL.Query().Get("end"))
+	assert.Equal(t, "100", request.URL.Query().Get("limit"))
+	assert.Equal(t, "backward", request.URL.Query().Get("direction"))
+	assert.Equal(t, `{namespace="bookinfo", container="istio-proxy", app="reviews"} | pattern `+"`"+textPattern+"`"+
+		` | method != "" | response_code =~ "5.." | path =~ "/reviews.*"`, request.URL.Query().Get("query"))
+
+	require.Len(t, entries, 2)
+
+	newer := entries[0]
+	assert.Equal(t, time.Unix(1700000060, 0).UTC(), newer.Timestamp.AsTime())
+	assert.Equal(t, "reviews-v2-def", newer.PodName)
+	assert.Equal(t, "GET", newer.Method)
+	assert.Equal(t, "/reviews/2", newer.Path)
+	assert.Equal(t, int32(500), newer.ResponseCode)
+	assert.Equal(t, 250*time.Millisecond, newer.Duration.AsDuration())
+	assert.Equal(t, "newer line", newer.Line)
+
+	older := entries[1]
+	assert.Equal(t, "reviews-v1-abc", older.PodName)
+	assert.Equal(t, "UF", older.ResponseFlags)
+}
+
+func TestProvider_BuildQuery(t *testing.T) {
+	provider, err := NewProvider(accesslogs.Config{
+		Type:         accesslogs.ProviderTypeLoki,
+		Endpoint:     "http://loki:3100",
+		Format:       accesslogs.FormatJSON,
+		ServiceLabel: "service_name",
+	}, logging.For("test"))
+	require.NoError(t, err)
+
+	query, err := provider.buildQuery(accesslogs.Query{
+		ServiceName:   "reviews",
+		Namespace:     "bookinfo",
+		PodName:       "reviews-v1-abc",
+		ResponseCodes: []string{"404", "5xx"},
+		PathPrefix:    "/api/v1.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{namespace="bookinfo", container="istio-proxy", service_name="reviews", pod="reviews-v1-abc"} | json | __error__="" | method != "" | response_code =~ "404|5.." | path =~ "/api/v1\\.0.*"`, query)
+
+	_, err = provider.buildQuery(accesslogs.Query{ServiceName: "reviews", Namespace: "bookinfo", ResponseCodes: []string{"5XX"}})
+	require.Error(t, err)
+	assert.Equal(t, `invalid response code "5XX", must be a code such as 404 or a class such as 5xx`, err.Error())
+}
+
+func TestProvider_ListAccessLogsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "parse error", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(accesslogs.Config{Type: accesslogs.ProviderTypeLoki, Endpoint: server.URL}, logging.For("test"))
+	require.NoError(t, err)
+
+	_, err = provider.ListAccessLogs(context.Background(), accesslogs.Query{ServiceName: "reviews", Namespace: "bookinfo", Limit: 100})
+	require.Error(t, err)
+	assert.Equal(t, "loki query failed with status 400: parse error", err.Error())
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslogs
+
+import (
+	"net/http"
+	"time"
+)
+
+// Config represents the configuration for a logs backend storing the proxies' access logs
+type Config struct {
+	// Type is the type of logs backend
+	Type ProviderType `json:"type" yaml:"type"`
+	// Endpoint is the endpoint URL of the logs backend's query API
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Timeout is the timeout for access log searches (in seconds)
+	Timeout int `json:"timeout" yaml:"timeout"`
+	// BearerToken for bearer token authentication
+	BearerToken string `json:"bearer_token,omitempty" yaml:"bearer_token,omitempty"`
+	// TenantID is sent as the X-Scope-OrgID header to multi-tenant backends
+	TenantID string `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty"`
+	// Format is the encoding of the proxies' access logs
+	Format Format `json:"format" yaml:"format"`
+	// ServiceLabel is the stream label holding the name of a pod's service
+	ServiceLabel string `json:"service_label" yaml:"service_label"`
+}
+
+// Enabled returns whether a logs backend is configured
+func (c *Config) Enabled() bool {
+	return c.Type != "" && c.Type != ProviderTypeNone
+}
+
+// Validate validates the access logs configuration
+func (c *Config) Validate() error {
+	if c.Type == "" {
+		c.Type = ProviderTypeNone
+	}
+
+	if c.Type != ProviderTypeNone && c.Type != ProviderTypeLoki {
+		return ErrProviderNotSupported
+	}
+
+	if c.Type != ProviderTypeNone && c.Endpoint == "" {
+		return ErrMissingEndpoint
+	}
+
+	if c.Format == "" {
+		c.Format = FormatText
+	}
+
+	if c.Format != FormatText && c.Format != FormatJSON {
+		return ErrFormatNotSupported
+	}
+
+	if c.ServiceLabel == "" {
+		c.ServiceLabel = "app"
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 10 // Default to 10 seconds
+	}
+
+	return nil
+}
+
+// HTTPClient returns an HTTP client for the logs backend's query API, authenticating with the configured
+// bearer token and tenant
+func (c *Config) HTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   time.Duration(c.Timeout) * time.Second,
+		Transport: &authRoundTripper{token: c.BearerToken, tenantID: c.TenantID, next: http.DefaultTransport},
+	}
+}
+
+// authRoundTripper adds bearer token authentication and the tenant header to HTTP requests
+type authRoundTripper struct {
+	token    string
+	tenantID string
+	next     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.token != "" || rt.tenantID != "" {
+		req = req.Clone(req.Context())
+	}
+	if rt.token != "" {
+		req.Header.Set("Authorization", "Bearer "+rt.token)
+	}
+	if rt.tenantID != "" {
+		req.Header.Set("X-Scope-OrgID", rt.tenantID)
+	}
+	return rt.next.RoundTrip(req)
+}
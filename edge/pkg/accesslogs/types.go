@@ -0,0 +1,67 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslogs
+
+import (
+	"time"
+)
+
+// ProviderType represents the type of logs backend
+type ProviderType string
+
+const (
+	// ProviderTypeLoki indicates a Grafana Loki query frontend
+	ProviderTypeLoki ProviderType = "loki"
+	// ProviderTypeNone indicates no logs backend
+	ProviderTypeNone ProviderType = "none"
+)
+
+// Format represents the encoding of the proxies' access logs
+type Format string
+
+const (
+	// FormatText indicates Istio's default text access log format
+	FormatText Format = "text"
+	// FormatJSON indicates Istio's default JSON access log format
+	FormatJSON Format = "json"
+)
+
+// ProviderInfo contains information about a logs backend
+type ProviderInfo struct {
+	// Type is the type of logs backend
+	Type ProviderType `json:"type"`
+	// Endpoint is the endpoint URL of the logs backend
+	Endpoint string `json:"endpoint"`
+}
+
+// Query represents a search for the access logs of a service
+type Query struct {
+	// ServiceName is the name of the service to search access logs for
+	ServiceName string
+	// Namespace is the namespace of the service
+	Namespace string
+	// PodName limits the search to a single pod of the service, if set
+	PodName string
+	// Start is the start of the time window to search
+	Start time.Time
+	// End is the end of the time window to search
+	End time.Time
+	// ResponseCodes limits the search to these response codes or classes, e.g. "404" or "5xx"
+	ResponseCodes []string
+	// PathPrefix limits the search to requests whose path starts with this prefix
+	PathPrefix string
+	// Limit is the most entries to return
+	Limit int
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+)
+
+// Server serves edge admin endpoints over HTTP
+type Server struct {
+	address  string
+	port     int
+	logger   *slog.Logger
+	mux      *http.ServeMux
+	server   *http.Server
+	listener net.Listener
+}
+
+// NewServer creates a new admin server listening on the given address and port. The endpoints are not
+// authenticated, so the address should be localhost unless the network is trusted.
+func NewServer(address string, port int, logger *slog.Logger) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(logging.LevelPath, logging.LevelHandler())
+	mux.Handle(telemetry.MetricsPath, telemetry.Handler())
+
+	return &Server{
+		address: address,
+		port:    port,
+		logger:  logger,
+		mux:     mux,
+	}
+}
+
+// Start starts serving admin endpoints in the background
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.address, strconv.Itoa(s.port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on admin port %d: %w", s.port, err)
+	}
+	s.listener = listener
+
+	s.server = &http.Server{
+		Handler:           logging.HTTPMiddleware()(s.mux),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+
+	go func() {
+		s.logger.Info("starting admin server", "address", listener.Addr().String())
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("admin server error", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the admin server
+func (s *Server) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
@@ -17,19 +17,93 @@ package config
 import (
 	"flag"
 	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	"github.com/liamawhite/navigator/edge/pkg/kubernetes"
 	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// Workload datastores
+const (
+	// DatastoreList lists workload resources from the API server on every workload collection
+	DatastoreList = "list"
+	// DatastoreInformer serves workload resources from informer caches
+	DatastoreInformer = "informer"
+)
+
+// Optional collection modules, which can be disabled to reduce the edge's memory footprint
+const (
+	// ModuleEnvoyFilters collects EnvoyFilters
+	ModuleEnvoyFilters = "envoy-filters"
+	// ModuleWasmPlugins collects WasmPlugins
+	ModuleWasmPlugins = "wasm-plugins"
+	// ModuleRawConfig keeps the raw config of Istio resources and sends it to the manager
+	ModuleRawConfig = "raw-config"
+	// ModuleMetrics queries the metrics provider
+	ModuleMetrics = "metrics"
+)
+
+// Modules are the optional collection modules, all enabled by default
+var Modules = []string{ModuleEnvoyFilters, ModuleWasmPlugins, ModuleRawConfig, ModuleMetrics}
+
 // Config holds the configuration for the edge service
 type Config struct {
-	ManagerEndpoint string
-	SyncInterval    int
-	KubeconfigPath  string
-	LogLevel        string
-	LogFormat       string
-	MaxMessageSize  int // Maximum gRPC message size in MB
-	MetricsConfig   metrics.Config
+	ManagerEndpoint   string
+	SyncInterval      int
+	SyncJitter        float64 // Largest random change to each sync's timing, as a fraction of the sync interval
+	KubeconfigPath    string
+	LogLevel          string
+	LogFormat         string
+	MaxMessageSize    int    // Maximum gRPC message size in MB
+	SyncMemoryBudget  int    // Most converted cluster state buffered before sending during a streamed sync, in MB (0 for no limit beyond the message size)
+	AdminPort         int    // Port for the admin HTTP server, 0 disables it
+	AdminAddress      string // Address the admin HTTP server listens on, localhost by default as it is not authenticated
+	CompressRawConfig bool   // Compress Istio resource raw config when the manager supports it
+	MetricsConfig     metrics.Config
+	TracesConfig      traces.Config
+	AccessLogsConfig  accesslogs.Config
+	ClusterLabels     map[string]string // Metadata labels of the cluster, such as its region or environment
+
+	// OTLP/HTTP endpoint logs are exported to as well as stdout, empty to only write them to stdout
+	OTLPLogsEndpoint string
+
+	// Optional collection modules that are disabled, to run on small or resource-constrained clusters. Low
+	// footprint mode disables all of them.
+	LowFootprint    bool
+	DisabledModules []string
+
+	// Least time between collections of each group of resources, in seconds (0 for every sync-interval)
+	WorkloadSyncInterval     int
+	IstioConfigSyncInterval  int
+	ControlPlaneSyncInterval int
+
+	// Where workload resources are read from: listed from the API server on every workload collection, or
+	// served from informer caches kept up to date by watches
+	Datastore              string
+	InformerResync         int               // Seconds between informer cache resyncs, 0 disables them
+	InformerFieldSelectors map[string]string // Field selectors restricting the watched objects of each workload resource
+
+	// Leader election between redundant edge replicas of the same cluster
+	LeaderElect             bool
+	LeaderElectionNamespace string
+	LeaderElectionLeaseName string
+	LeaderElectionIdentity  string
+
+	// Namespace sharding between several edges of the same cluster, by hash or explicit namespaces
+	ShardIndex      int
+	ShardCount      int
+	ShardNamespaces []string
 }
 
 // ParseFlags parses command line flags and returns a Config
@@ -38,18 +112,131 @@ func ParseFlags() (*Config, error) {
 
 	flag.StringVar(&config.ManagerEndpoint, "manager-endpoint", "", "gRPC endpoint of the manager service (required)")
 	flag.IntVar(&config.SyncInterval, "sync-interval", 30, "Interval between cluster state sync operations (in seconds)")
-	flag.StringVar(&config.KubeconfigPath, "kubeconfig", "", "Path to kubeconfig file (uses in-cluster config if empty)")
+	flag.Float64Var(&config.SyncJitter, "sync-jitter", 0.1, "Largest random change to the timing of each sync, as a fraction of sync-interval (0 to 0.5)")
+	flag.StringVar(&config.KubeconfigPath, "kubeconfig", "", "Path to kubeconfig file, or a list of paths merged like KUBECONFIG (uses in-cluster config if empty)")
 	flag.StringVar(&config.LogLevel, "log-level", "info", "Log level (debug, info, warn, error)")
 	flag.StringVar(&config.LogFormat, "log-format", "text", "Log format (text, json)")
+	flag.StringVar(&config.OTLPLogsEndpoint, "otlp-logs-endpoint", "", "OTLP/HTTP endpoint URL logs are exported to as well as stdout, e.g. http://otel-collector:4318/v1/logs")
 	flag.IntVar(&config.MaxMessageSize, "max-message-size", 10, "Maximum gRPC message size in MB")
+	flag.IntVar(&config.SyncMemoryBudget, "sync-memory-budget", 8, "Most converted cluster state buffered before sending it to the manager during a sync, in MB (0 limits it by max-message-size only)")
+	flag.IntVar(&config.AdminPort, "admin-port", 0, "Port for the admin HTTP server serving the log level and metrics endpoints (0 disables it)")
+	flag.StringVar(&config.AdminAddress, "admin-address", "localhost", "Address the admin HTTP server listens on. The endpoints are not authenticated, so only listen beyond localhost (e.g. 0.0.0.0 to scrape metrics) on trusted networks")
+	flag.BoolVar(&config.CompressRawConfig, "compress-raw-config", true, "Compress Istio resource raw config sent to the manager when it supports it")
+
+	// Per resource group sync intervals
+	flag.IntVar(&config.WorkloadSyncInterval, "workload-sync-interval", 0, "Interval between collections of services, endpoints and pods, in seconds (0 uses sync-interval)")
+	flag.IntVar(&config.IstioConfigSyncInterval, "istio-config-sync-interval", 0, "Interval between collections of Istio config resources, in seconds (0 uses sync-interval)")
+	flag.IntVar(&config.ControlPlaneSyncInterval, "control-plane-sync-interval", 0, "Interval between collections of the Istio control plane config, CNI DaemonSet and injection webhooks, in seconds (0 uses sync-interval)")
+
+	// Collection module configuration
+	flag.BoolVar(&config.LowFootprint, "low-footprint", false, "Disable all optional collection modules ("+strings.Join(Modules, ", ")+") for a minimal memory footprint")
+	flag.Func("disable-modules", "Comma-separated optional collection modules to disable ("+strings.Join(Modules, ", ")+")", func(value string) error {
+		for _, module := range strings.Split(value, ",") {
+			if module = strings.TrimSpace(module); module != "" {
+				config.DisabledModules = append(config.DisabledModules, module)
+			}
+		}
+		return nil
+	})
+
+	// Workload datastore configuration
+	flag.StringVar(&config.Datastore, "datastore", DatastoreList, "Where workload resources are read from (list, informer)")
+	flag.IntVar(&config.InformerResync, "informer-resync", 0, "Interval between resyncs of the informer datastore's caches, in seconds (0 disables resyncs)")
+	flag.Func("informer-field-selector", "resource=selector field selector restricting the objects the informer datastore watches, e.g. pods=status.phase!=Succeeded (repeatable)", func(value string) error {
+		resource, selector, ok := strings.Cut(value, "=")
+		if !ok {
+			return fmt.Errorf("invalid informer field selector %q, expected resource=selector", value)
+		}
+		if config.InformerFieldSelectors == nil {
+			config.InformerFieldSelectors = make(map[string]string)
+		}
+		config.InformerFieldSelectors[strings.TrimSpace(resource)] = strings.TrimSpace(selector)
+		return nil
+	})
+
+	// Leader election configuration
+	hostname, _ := os.Hostname()
+	flag.BoolVar(&config.LeaderElect, "leader-elect", false, "Elect a leader among edge replicas for the cluster so only the leader syncs with the manager")
+	flag.StringVar(&config.LeaderElectionNamespace, "leader-election-namespace", os.Getenv("POD_NAMESPACE"), "Namespace of the leader election Lease (defaults to $POD_NAMESPACE)")
+	flag.StringVar(&config.LeaderElectionLeaseName, "leader-election-lease-name", "navigator-edge", "Name of the leader election Lease")
+	flag.StringVar(&config.LeaderElectionIdentity, "leader-election-identity", hostname, "Identity of this replica in leader election (defaults to the hostname)")
+
+	// Namespace shard configuration
+	flag.IntVar(&config.ShardIndex, "shard-index", 0, "Index of the namespace hash shard this edge collects, from 0 to shard-count - 1")
+	flag.IntVar(&config.ShardCount, "shard-count", 0, "Number of namespace hash shards the cluster is split into between edges (0 disables hash sharding)")
+	flag.Func("cluster-labels", "Comma-separated key=value metadata labels of the cluster, e.g. region=eu-west-1,env=prod", func(value string) error {
+		for _, pair := range strings.Split(value, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			key, labelValue, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid cluster label %q, expected key=value", pair)
+			}
+			if config.ClusterLabels == nil {
+				config.ClusterLabels = make(map[string]string)
+			}
+			config.ClusterLabels[strings.TrimSpace(key)] = strings.TrimSpace(labelValue)
+		}
+		return nil
+	})
+	flag.Func("shard-namespaces", "Comma-separated namespaces this edge collects when the cluster is split between edges", func(value string) error {
+		for _, namespace := range strings.Split(value, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				config.ShardNamespaces = append(config.ShardNamespaces, namespace)
+			}
+		}
+		return nil
+	})
 
 	// Metrics configuration
 	flag.BoolVar(&config.MetricsConfig.Enabled, "metrics-enabled", false, "Enable metrics collection")
 	flag.StringVar(&config.MetricsConfig.Endpoint, "metrics-endpoint", "", "Metrics provider endpoint URL")
+	flag.StringVar(&config.MetricsConfig.SecondaryEndpoint, "metrics-secondary-endpoint", "", "Endpoint URL of a second source of the same metrics, e.g. Thanos")
+	flag.StringVar((*string)(&config.MetricsConfig.SecondaryMode), "metrics-secondary-mode", "failover", "How the secondary metrics endpoint is used (failover, merge)")
 	flag.StringVar((*string)(&config.MetricsConfig.Type), "metrics-type", "none", "Metrics provider type (none, prometheus)")
 	flag.IntVar(&config.MetricsConfig.QueryInterval, "metrics-query-interval", 30, "Metrics query interval in seconds")
 	flag.IntVar(&config.MetricsConfig.Timeout, "metrics-timeout", 10, "Metrics query timeout in seconds")
 	flag.StringVar(&config.MetricsConfig.BearerToken, "metrics-auth-bearer", "", "Bearer token for metrics provider authentication")
+	flag.Func("metrics-label-mapping", "Comma-separated label=custom_label pairs mapping standard Istio metric labels to customized label names", func(value string) error {
+		for _, pair := range strings.Split(value, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			label, mapped, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid label mapping %q, expected label=custom_label", pair)
+			}
+			if config.MetricsConfig.LabelMapping == nil {
+				config.MetricsConfig.LabelMapping = make(metrics.LabelMapping)
+			}
+			config.MetricsConfig.LabelMapping[strings.TrimSpace(label)] = strings.TrimSpace(mapped)
+		}
+		return nil
+	})
+	flag.Func("metrics-query-templates", "Path to a YAML file overriding the metrics provider query templates", func(path string) error {
+		templates, err := metrics.LoadQueryTemplates(path)
+		if err != nil {
+			return err
+		}
+		config.MetricsConfig.QueryTemplates = templates
+		return nil
+	})
+
+	// Traces configuration
+	flag.StringVar((*string)(&config.TracesConfig.Type), "traces-type", "none", "Tracing backend type (none, jaeger, tempo)")
+	flag.StringVar(&config.TracesConfig.Endpoint, "traces-endpoint", "", "Tracing backend query API endpoint URL")
+	flag.IntVar(&config.TracesConfig.Timeout, "traces-timeout", 10, "Trace search timeout in seconds")
+	flag.StringVar(&config.TracesConfig.BearerToken, "traces-auth-bearer", "", "Bearer token for tracing backend authentication")
+
+	// Access logs configuration
+	flag.StringVar((*string)(&config.AccessLogsConfig.Type), "access-logs-type", "none", "Logs backend type for proxy access logs (none, loki)")
+	flag.StringVar(&config.AccessLogsConfig.Endpoint, "access-logs-endpoint", "", "Logs backend query API endpoint URL")
+	flag.IntVar(&config.AccessLogsConfig.Timeout, "access-logs-timeout", 10, "Access log search timeout in seconds")
+	flag.StringVar(&config.AccessLogsConfig.BearerToken, "access-logs-auth-bearer", "", "Bearer token for logs backend authentication")
+	flag.StringVar(&config.AccessLogsConfig.TenantID, "access-logs-tenant", "", "Tenant ID sent as X-Scope-OrgID to multi-tenant logs backends")
+	flag.StringVar((*string)(&config.AccessLogsConfig.Format), "access-logs-format", "text", "Encoding of the proxies' access logs (text, json)")
+	flag.StringVar(&config.AccessLogsConfig.ServiceLabel, "access-logs-service-label", "app", "Log stream label holding the name of a pod's service")
 
 	flag.Parse()
 
@@ -66,6 +253,45 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("sync-interval must be positive")
 	}
 
+	if c.SyncJitter < 0 || c.SyncJitter >= 0.5 {
+		return fmt.Errorf("sync-jitter must be at least 0 and less than 0.5")
+	}
+
+	if c.WorkloadSyncInterval < 0 {
+		return fmt.Errorf("workload-sync-interval must not be negative")
+	}
+
+	if c.IstioConfigSyncInterval < 0 {
+		return fmt.Errorf("istio-config-sync-interval must not be negative")
+	}
+
+	if c.ControlPlaneSyncInterval < 0 {
+		return fmt.Errorf("control-plane-sync-interval must not be negative")
+	}
+
+	for _, module := range c.DisabledModules {
+		if !slices.Contains(Modules, module) {
+			return fmt.Errorf("disable-modules must be one of: %s", strings.Join(Modules, ", "))
+		}
+	}
+
+	if c.Datastore != "" && c.Datastore != DatastoreList && c.Datastore != DatastoreInformer {
+		return fmt.Errorf("datastore must be one of: %s, %s", DatastoreList, DatastoreInformer)
+	}
+
+	if c.InformerResync < 0 {
+		return fmt.Errorf("informer-resync must not be negative")
+	}
+
+	for _, resource := range slices.Sorted(maps.Keys(c.InformerFieldSelectors)) {
+		if !slices.Contains(kubernetes.WorkloadResources, resource) {
+			return fmt.Errorf("informer-field-selector resource must be one of: %s", strings.Join(kubernetes.WorkloadResources, ", "))
+		}
+		if _, err := fields.ParseSelector(c.InformerFieldSelectors[resource]); err != nil {
+			return fmt.Errorf("invalid informer-field-selector for %s: %w", resource, err)
+		}
+	}
+
 	if c.LogLevel != "debug" && c.LogLevel != "info" && c.LogLevel != "warn" && c.LogLevel != "error" {
 		return fmt.Errorf("log-level must be one of: debug, info, warn, error")
 	}
@@ -74,15 +300,72 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("log-format must be one of: text, json")
 	}
 
+	if c.OTLPLogsEndpoint != "" {
+		endpoint, err := url.Parse(c.OTLPLogsEndpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("otlp-logs-endpoint must be an http or https URL")
+		}
+	}
+
 	if c.MaxMessageSize <= 0 {
 		return fmt.Errorf("max-message-size must be greater than 0")
 	}
 
+	if c.SyncMemoryBudget < 0 {
+		return fmt.Errorf("sync-memory-budget must not be negative")
+	}
+
+	if c.AdminPort < 0 || c.AdminPort > 65535 {
+		return fmt.Errorf("admin-port must be between 0 and 65535")
+	}
+
+	if c.LeaderElect {
+		if c.LeaderElectionNamespace == "" {
+			return fmt.Errorf("leader-election-namespace is required when leader-elect is enabled")
+		}
+		if c.LeaderElectionLeaseName == "" {
+			return fmt.Errorf("leader-election-lease-name is required when leader-elect is enabled")
+		}
+		if c.LeaderElectionIdentity == "" {
+			return fmt.Errorf("leader-election-identity is required when leader-elect is enabled")
+		}
+	}
+
+	if c.ShardCount < 0 {
+		return fmt.Errorf("shard-count must not be negative")
+	}
+
+	if c.ShardCount > 0 && len(c.ShardNamespaces) > 0 {
+		return fmt.Errorf("shard-count and shard-namespaces are mutually exclusive")
+	}
+
+	if c.ShardCount > 0 && (c.ShardIndex < 0 || c.ShardIndex >= c.ShardCount) {
+		return fmt.Errorf("shard-index must be between 0 and shard-count - 1")
+	}
+
+	if c.ShardCount == 0 && c.ShardIndex != 0 {
+		return fmt.Errorf("shard-index requires shard-count")
+	}
+
+	if err := ValidateClusterLabels(c.ClusterLabels); err != nil {
+		return err
+	}
+
 	// Validate metrics configuration
 	if err := c.MetricsConfig.Validate(); err != nil {
 		return fmt.Errorf("metrics configuration error: %w", err)
 	}
 
+	// Validate traces configuration
+	if err := c.TracesConfig.Validate(); err != nil {
+		return fmt.Errorf("traces configuration error: %w", err)
+	}
+
+	// Validate access logs configuration
+	if err := c.AccessLogsConfig.Validate(); err != nil {
+		return fmt.Errorf("access logs configuration error: %w", err)
+	}
+
 	return nil
 }
 
@@ -91,9 +374,76 @@ func (c *Config) GetManagerEndpoint() string {
 	return c.ManagerEndpoint
 }
 
-// GetSyncInterval returns the sync interval in seconds
+// GetSyncInterval returns the sync interval in seconds, the shortest of sync-interval and the resource
+// group sync intervals
 func (c *Config) GetSyncInterval() int {
-	return c.SyncInterval
+	interval := c.SyncInterval
+	for _, groupInterval := range []int{c.WorkloadSyncInterval, c.IstioConfigSyncInterval, c.ControlPlaneSyncInterval} {
+		if groupInterval > 0 && groupInterval < interval {
+			interval = groupInterval
+		}
+	}
+	return interval
+}
+
+// GetSyncJitter returns the largest random change to the timing of each sync, as a fraction of the sync interval
+func (c *Config) GetSyncJitter() float64 {
+	return c.SyncJitter
+}
+
+// GetSyncIntervals returns the least time between collections of each group of resources
+func (c *Config) GetSyncIntervals() kubernetes.SyncIntervals {
+	interval := func(seconds int) time.Duration {
+		if seconds == 0 {
+			seconds = c.SyncInterval
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	return kubernetes.SyncIntervals{
+		Workloads:    interval(c.WorkloadSyncInterval),
+		IstioConfig:  interval(c.IstioConfigSyncInterval),
+		ControlPlane: interval(c.ControlPlaneSyncInterval),
+	}
+}
+
+// ModuleEnabled returns whether an optional collection module is enabled
+func (c *Config) ModuleEnabled(module string) bool {
+	return !c.LowFootprint && !slices.Contains(c.DisabledModules, module)
+}
+
+// GetCollectionExclusions returns the parts of the cluster state left out of collections by disabled modules
+func (c *Config) GetCollectionExclusions() kubernetes.CollectionExclusions {
+	return kubernetes.CollectionExclusions{
+		EnvoyFilters: !c.ModuleEnabled(ModuleEnvoyFilters),
+		WasmPlugins:  !c.ModuleEnabled(ModuleWasmPlugins),
+		RawConfig:    !c.ModuleEnabled(ModuleRawConfig),
+	}
+}
+
+// GetExcludedResourceTypes returns the cluster state resource types disabled modules leave out of
+// collections, so the manager does not take their absence to mean the cluster has none
+func (c *Config) GetExcludedResourceTypes() []string {
+	var resourceTypes []string
+	if !c.ModuleEnabled(ModuleEnvoyFilters) {
+		resourceTypes = append(resourceTypes, "envoy_filters")
+	}
+	if !c.ModuleEnabled(ModuleWasmPlugins) {
+		resourceTypes = append(resourceTypes, "wasm_plugins")
+	}
+	return resourceTypes
+}
+
+// UsesInformerDatastore returns whether workload resources are served from informer caches
+func (c *Config) UsesInformerDatastore() bool {
+	return c.Datastore == DatastoreInformer
+}
+
+// GetInformerOptions returns the configuration of the informer datastore
+func (c *Config) GetInformerOptions() kubernetes.InformerOptions {
+	return kubernetes.InformerOptions{
+		Resync:         time.Duration(c.InformerResync) * time.Second,
+		FieldSelectors: c.InformerFieldSelectors,
+	}
 }
 
 // GetMaxMessageSize returns the maximum gRPC message size in bytes
@@ -101,7 +451,85 @@ func (c *Config) GetMaxMessageSize() int {
 	return c.MaxMessageSize * 1024 * 1024 // Convert MB to bytes
 }
 
-// GetMetricsConfig returns the metrics configuration
+// GetSyncMemoryBudget returns the most converted cluster state buffered during a streamed sync, in bytes,
+// or 0 if only the message size limits it
+func (c *Config) GetSyncMemoryBudget() int {
+	return c.SyncMemoryBudget * 1024 * 1024 // Convert MB to bytes
+}
+
+// GetAdminPort returns the admin HTTP server port
+func (c *Config) GetAdminPort() int {
+	return c.AdminPort
+}
+
+// GetAdminAddress returns the address the admin HTTP server listens on, localhost if unset
+func (c *Config) GetAdminAddress() string {
+	if c.AdminAddress == "" {
+		return "localhost"
+	}
+	return c.AdminAddress
+}
+
+// GetRawConfigCompression returns whether Istio resource raw config should be compressed
+func (c *Config) GetRawConfigCompression() bool {
+	return c.CompressRawConfig
+}
+
+// GetNamespaceShard returns the namespace shard this edge collects, or nil if it collects the whole cluster
+func (c *Config) GetNamespaceShard() *v1alpha1.NamespaceShard {
+	switch {
+	case c.ShardCount > 0:
+		return &v1alpha1.NamespaceShard{
+			Index: uint32(c.ShardIndex), // #nosec G115 - validated to be within shard-count
+			Count: uint32(c.ShardCount), // #nosec G115 - validated to be positive
+		}
+	case len(c.ShardNamespaces) > 0:
+		return &v1alpha1.NamespaceShard{Namespaces: c.ShardNamespaces}
+	default:
+		return nil
+	}
+}
+
+// GetClusterLabels returns the metadata labels of the cluster
+func (c *Config) GetClusterLabels() map[string]string {
+	return c.ClusterLabels
+}
+
+// ValidateClusterLabels checks that cluster labels are valid Kubernetes label keys and values, so clusters can be
+// selected by them with label selectors
+func ValidateClusterLabels(labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid cluster label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q of cluster label %q: %s", labels[key], key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// GetMetricsConfig returns the metrics configuration, disabled when the metrics module is
 func (c *Config) GetMetricsConfig() metrics.Config {
-	return c.MetricsConfig
+	config := c.MetricsConfig
+	if !c.ModuleEnabled(ModuleMetrics) {
+		config.Enabled = false
+	}
+	return config
+}
+
+// GetTracesConfig returns the traces configuration
+func (c *Config) GetTracesConfig() traces.Config {
+	return c.TracesConfig
+}
+
+// GetAccessLogsConfig returns the access logs configuration
+func (c *Config) GetAccessLogsConfig() accesslogs.Config {
+	return c.AccessLogsConfig
 }
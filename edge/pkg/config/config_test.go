@@ -16,7 +16,12 @@ package config
 
 import (
 	"testing"
+	"time"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	"github.com/liamawhite/navigator/edge/pkg/kubernetes"
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -97,6 +102,19 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "log-format must be one of: text, json",
 		},
+		{
+			name: "invalid otlp logs endpoint",
+			config: Config{
+				ManagerEndpoint:  "localhost:8080",
+				SyncInterval:     30,
+				LogLevel:         "info",
+				LogFormat:        "text",
+				OTLPLogsEndpoint: "otel-collector:4318",
+				MaxMessageSize:   10,
+			},
+			wantErr: true,
+			errMsg:  "otlp-logs-endpoint must be an http or https URL",
+		},
 		{
 			name: "invalid max message size",
 			config: Config{
@@ -109,6 +127,19 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "max-message-size must be greater than 0",
 		},
+		{
+			name: "invalid admin port",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				AdminPort:       70000,
+			},
+			wantErr: true,
+			errMsg:  "admin-port must be between 0 and 65535",
+		},
 		{
 			name: "valid debug log level",
 			config: Config{
@@ -143,6 +174,406 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid leader election",
+			config: Config{
+				ManagerEndpoint:         "localhost:8080",
+				SyncInterval:            30,
+				LogLevel:                "info",
+				LogFormat:               "text",
+				MaxMessageSize:          10,
+				LeaderElect:             true,
+				LeaderElectionNamespace: "navigator",
+				LeaderElectionLeaseName: "navigator-edge",
+				LeaderElectionIdentity:  "navigator-edge-0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "leader election without namespace",
+			config: Config{
+				ManagerEndpoint:         "localhost:8080",
+				SyncInterval:            30,
+				LogLevel:                "info",
+				LogFormat:               "text",
+				MaxMessageSize:          10,
+				LeaderElect:             true,
+				LeaderElectionLeaseName: "navigator-edge",
+				LeaderElectionIdentity:  "navigator-edge-0",
+			},
+			wantErr: true,
+			errMsg:  "leader-election-namespace is required when leader-elect is enabled",
+		},
+		{
+			name: "valid hash namespace shard",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				ShardIndex:      3,
+				ShardCount:      4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "shard index out of range",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				ShardIndex:      4,
+				ShardCount:      4,
+			},
+			wantErr: true,
+			errMsg:  "shard-index must be between 0 and shard-count - 1",
+		},
+		{
+			name: "hash and explicit namespace shard",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				ShardCount:      4,
+				ShardNamespaces: []string{"bookinfo"},
+			},
+			wantErr: true,
+			errMsg:  "shard-count and shard-namespaces are mutually exclusive",
+		},
+		{
+			name: "negative sync memory budget",
+			config: Config{
+				ManagerEndpoint:  "localhost:8080",
+				SyncInterval:     30,
+				LogLevel:         "info",
+				LogFormat:        "text",
+				MaxMessageSize:   10,
+				SyncMemoryBudget: -1,
+			},
+			wantErr: true,
+			errMsg:  "sync-memory-budget must not be negative",
+		},
+		{
+			name: "sync jitter too large",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				SyncJitter:      0.5,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+			},
+			wantErr: true,
+			errMsg:  "sync-jitter must be at least 0 and less than 0.5",
+		},
+		{
+			name: "negative istio config sync interval",
+			config: Config{
+				ManagerEndpoint:         "localhost:8080",
+				SyncInterval:            30,
+				LogLevel:                "info",
+				LogFormat:               "text",
+				MaxMessageSize:          10,
+				IstioConfigSyncInterval: -1,
+			},
+			wantErr: true,
+			errMsg:  "istio-config-sync-interval must not be negative",
+		},
+		{
+			name: "invalid metrics secondary mode",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled:           true,
+					Type:              metrics.ProviderTypePrometheus,
+					SecondaryEndpoint: "http://thanos:9090",
+					SecondaryMode:     "round-robin",
+				},
+			},
+			wantErr: true,
+			errMsg:  "metrics configuration error: metrics secondary mode must be one of: failover, merge",
+		},
+		{
+			name: "metrics label mapping of unknown label",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled:      true,
+					Type:         metrics.ProviderTypePrometheus,
+					LabelMapping: metrics.LabelMapping{"source_app": "app"},
+				},
+			},
+			wantErr: true,
+			errMsg:  `metrics configuration error: label mapping of unknown label "source_app"`,
+		},
+		{
+			name: "metrics label mapped to invalid label name",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled:      true,
+					Type:         metrics.ProviderTypePrometheus,
+					LabelMapping: metrics.LabelMapping{"destination_canonical_service": "dst-service"},
+				},
+			},
+			wantErr: true,
+			errMsg:  `metrics configuration error: label "destination_canonical_service" is mapped to invalid label name "dst-service"`,
+		},
+		{
+			name: "valid metrics query template",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled: true,
+					Type:    metrics.ProviderTypePrometheus,
+					QueryTemplates: metrics.QueryTemplates{
+						InboundRequestRate: `sum(rate(requests_total{dst_svc="{{.ServiceName}}", dst_ns="{{.ServiceNamespace}}"{{.FilterClause}}}[{{.TimeRange}}]))`,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unparseable metrics query template",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled: true,
+					Type:    metrics.ProviderTypePrometheus,
+					QueryTemplates: metrics.QueryTemplates{
+						OutboundErrorRate: `rate(requests_total{svc="{{.ServiceName}"}[5m])`,
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  `metrics configuration error: invalid outbound_error_rate query template: template: outbound_error_rate:1: bad character U+007D '}'`,
+		},
+		{
+			name: "metrics query template with unknown field",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				MetricsConfig: metrics.Config{
+					Enabled: true,
+					Type:    metrics.ProviderTypePrometheus,
+					QueryTemplates: metrics.QueryTemplates{
+						InboundLatencyDistribution: `rate(duration_bucket{svc="{{.Service}}"}[5m])`,
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  `metrics configuration error: invalid inbound_latency_distribution query template: template: inbound_latency_distribution:1:28: executing "inbound_latency_distribution" at <.Service>: can't evaluate field Service in type metrics.queryTemplateSample`,
+		},
+		{
+			name: "valid traces config",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				TracesConfig: traces.Config{
+					Type:     traces.ProviderTypeJaeger,
+					Endpoint: "http://tracing.istio-system:16686",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported traces type",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				TracesConfig: traces.Config{
+					Type:     "zipkin",
+					Endpoint: "http://zipkin.istio-system:9411",
+				},
+			},
+			wantErr: true,
+			errMsg:  "traces configuration error: traces provider type must be one of: none, jaeger, tempo",
+		},
+		{
+			name: "traces without endpoint",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				TracesConfig: traces.Config{
+					Type: traces.ProviderTypeTempo,
+				},
+			},
+			wantErr: true,
+			errMsg:  "traces configuration error: traces provider endpoint is required when enabled",
+		},
+		{
+			name: "valid access logs config",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				AccessLogsConfig: accesslogs.Config{
+					Type:     accesslogs.ProviderTypeLoki,
+					Endpoint: "http://loki.monitoring:3100",
+					Format:   accesslogs.FormatJSON,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported access log format",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				AccessLogsConfig: accesslogs.Config{
+					Type:     accesslogs.ProviderTypeLoki,
+					Endpoint: "http://loki.monitoring:3100",
+					Format:   "logfmt",
+				},
+			},
+			wantErr: true,
+			errMsg:  "access logs configuration error: access log format must be one of: text, json",
+		},
+		{
+			name: "valid cluster labels",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				ClusterLabels:   map[string]string{"region": "eu-west-1", "topology.example.com/tier": "gold", "canary": ""},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid cluster label value",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				ClusterLabels:   map[string]string{"region": "eu west 1"},
+			},
+			wantErr: true,
+			errMsg:  "invalid value \"eu west 1\" of cluster label \"region\": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')",
+		},
+		{
+			name: "informer datastore",
+			config: Config{
+				ManagerEndpoint:        "localhost:8080",
+				SyncInterval:           30,
+				LogLevel:               "info",
+				LogFormat:              "text",
+				MaxMessageSize:         10,
+				Datastore:              DatastoreInformer,
+				InformerResync:         600,
+				InformerFieldSelectors: map[string]string{"pods": "status.phase!=Succeeded,status.phase!=Failed"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown datastore",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				Datastore:       "etcd",
+			},
+			wantErr: true,
+			errMsg:  "datastore must be one of: list, informer",
+		},
+		{
+			name: "valid disabled modules",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				DisabledModules: []string{ModuleEnvoyFilters, ModuleRawConfig},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown disabled module",
+			config: Config{
+				ManagerEndpoint: "localhost:8080",
+				SyncInterval:    30,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				DisabledModules: []string{"gateways"},
+			},
+			wantErr: true,
+			errMsg:  "disable-modules must be one of: envoy-filters, wasm-plugins, raw-config, metrics",
+		},
+		{
+			name: "informer field selector for unknown resource",
+			config: Config{
+				ManagerEndpoint:        "localhost:8080",
+				SyncInterval:           30,
+				LogLevel:               "info",
+				LogFormat:              "text",
+				MaxMessageSize:         10,
+				InformerFieldSelectors: map[string]string{"deployments": "metadata.name=reviews"},
+			},
+			wantErr: true,
+			errMsg:  "informer-field-selector resource must be one of: services, endpointslices, pods, nodes",
+		},
+		{
+			name: "invalid informer field selector",
+			config: Config{
+				ManagerEndpoint:        "localhost:8080",
+				SyncInterval:           30,
+				LogLevel:               "info",
+				LogFormat:              "text",
+				MaxMessageSize:         10,
+				InformerFieldSelectors: map[string]string{"pods": "status.phase"},
+			},
+			wantErr: true,
+			errMsg:  "invalid informer-field-selector for pods: invalid selector: 'status.phase'; can't understand 'status.phase'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -158,3 +589,45 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_GetSyncIntervals(t *testing.T) {
+	config := Config{SyncInterval: 30, WorkloadSyncInterval: 10, IstioConfigSyncInterval: 300}
+
+	assert.Equal(t, 10, config.GetSyncInterval())
+	assert.Equal(t, kubernetes.SyncIntervals{
+		Workloads:    10 * time.Second,
+		IstioConfig:  300 * time.Second,
+		ControlPlane: 30 * time.Second,
+	}, config.GetSyncIntervals())
+}
+
+func TestConfig_GetInformerOptions(t *testing.T) {
+	config := Config{Datastore: DatastoreInformer, InformerResync: 300, InformerFieldSelectors: map[string]string{"pods": "spec.nodeName!="}}
+
+	assert.True(t, config.UsesInformerDatastore())
+	assert.Equal(t, kubernetes.InformerOptions{
+		Resync:         300 * time.Second,
+		FieldSelectors: map[string]string{"pods": "spec.nodeName!="},
+	}, config.GetInformerOptions())
+	assert.False(t, (&Config{Datastore: DatastoreList}).UsesInformerDatastore())
+}
+
+func TestConfig_Modules(t *testing.T) {
+	metricsConfig := metrics.Config{Enabled: true, Type: metrics.ProviderTypePrometheus}
+
+	all := Config{MetricsConfig: metricsConfig}
+	assert.Equal(t, kubernetes.CollectionExclusions{}, all.GetCollectionExclusions())
+	assert.Empty(t, all.GetExcludedResourceTypes())
+	assert.True(t, all.GetMetricsConfig().Enabled)
+
+	some := Config{MetricsConfig: metricsConfig, DisabledModules: []string{ModuleWasmPlugins, ModuleRawConfig}}
+	assert.Equal(t, kubernetes.CollectionExclusions{WasmPlugins: true, RawConfig: true}, some.GetCollectionExclusions())
+	assert.Equal(t, []string{"wasm_plugins"}, some.GetExcludedResourceTypes())
+	assert.True(t, some.GetMetricsConfig().Enabled)
+
+	lowFootprint := Config{MetricsConfig: metricsConfig, LowFootprint: true}
+	assert.Equal(t, kubernetes.CollectionExclusions{EnvoyFilters: true, WasmPlugins: true, RawConfig: true}, lowFootprint.GetCollectionExclusions())
+	assert.Equal(t, []string{"envoy_filters", "wasm_plugins"}, lowFootprint.GetExcludedResourceTypes())
+	assert.False(t, lowFootprint.GetMetricsConfig().Enabled)
+	assert.True(t, lowFootprint.MetricsConfig.Enabled)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// AccessLogsProvider interface for dependency injection
+type AccessLogsProvider interface {
+	GetProviderInfo() accesslogs.ProviderInfo
+	ListAccessLogs(ctx context.Context, query accesslogs.Query) ([]*typesv1alpha1.AccessLogEntry, error)
+	Close() error
+}
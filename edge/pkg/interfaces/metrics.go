@@ -25,6 +25,8 @@ import (
 // MetricsProvider interface for dependency injection
 type MetricsProvider interface {
 	GetProviderInfo() metrics.ProviderInfo
-	GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode typesv1alpha1.ProxyMode, startTime, endTime *timestamppb.Timestamp) (*typesv1alpha1.ServiceGraphMetrics, error)
+	GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode typesv1alpha1.ProxyMode, perspective typesv1alpha1.MetricsPerspective, startTime, endTime *timestamppb.Timestamp) (*typesv1alpha1.ServiceGraphMetrics, error)
+	GetPairInstanceMetrics(ctx context.Context, sourceService, sourceNamespace, destinationService, destinationNamespace string) (*typesv1alpha1.PairInstanceMetrics, error)
+	GetMeshMetricsTimeSeries(ctx context.Context, pairs []*typesv1alpha1.ServicePair, startTime, endTime *timestamppb.Timestamp, buckets int32) (*typesv1alpha1.MeshMetricsTimeSeries, error)
 	Close() error
 }
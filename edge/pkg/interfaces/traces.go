@@ -0,0 +1,29 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// TracesProvider interface for dependency injection
+type TracesProvider interface {
+	GetProviderInfo() traces.ProviderInfo
+	ListTraces(ctx context.Context, query traces.Query) ([]*typesv1alpha1.TraceSummary, error)
+	Close() error
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels Istio reads a pod's canonical service and revision from, in order of precedence
+var (
+	canonicalNameLabels     = []string{"service.istio.io/canonical-name", "app.kubernetes.io/name", "app"}
+	canonicalRevisionLabels = []string{"service.istio.io/canonical-revision", "app.kubernetes.io/version", "version"}
+)
+
+// defaultCanonicalRevision is the revision of pods without a revision label
+const defaultCanonicalRevision = "latest"
+
+// canonicalService returns the canonical service and revision Istio's telemetry reports a pod under. Like
+// Istio, the name falls back to the name of the pod's workload when the pod has no name label.
+func canonicalService(pod *corev1.Pod) (string, string) {
+	name := firstLabel(pod.Labels, canonicalNameLabels)
+	if name == "" {
+		name = workloadName(pod)
+	}
+	revision := firstLabel(pod.Labels, canonicalRevisionLabels)
+	if revision == "" {
+		revision = defaultCanonicalRevision
+	}
+	return name, revision
+}
+
+// workloadName returns the name of the workload controlling a pod, as Istio derives it: the Deployment of a
+// ReplicaSet's pods, the CronJob of a Job's pods, the controller for other controlled pods and the pod itself
+// otherwise.
+func workloadName(pod *corev1.Pod) string {
+	controller := metav1.GetControllerOf(pod)
+	if controller == nil || pod.GenerateName == "" {
+		return pod.Name
+	}
+
+	switch controller.Kind {
+	case "ReplicaSet":
+		if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; hash != "" {
+			return strings.TrimSuffix(controller.Name, "-"+hash)
+		}
+	case "Job":
+		// Jobs created by a CronJob are named after it with a numeric scheduled time suffix
+		if i := strings.LastIndex(controller.Name, "-"); i > 0 && isDigits(controller.Name[i+1:]) {
+			return controller.Name[:i]
+		}
+	}
+	return controller.Name
+}
+
+// isDigits reports whether s is a non-empty string of decimal digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCanonicalService(t *testing.T) {
+	controlled := func(kind, name string) []metav1.OwnerReference {
+		controller := true
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &controller}}
+	}
+
+	tests := []struct {
+		name         string
+		pod          *corev1.Pod
+		wantName     string
+		wantRevision string
+	}{
+		{
+			name: "canonical labels take precedence",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1-abc", Labels: map[string]string{
+				"service.istio.io/canonical-name":     "reviews-canonical",
+				"service.istio.io/canonical-revision": "v1-canonical",
+				"app":                                 "reviews",
+				"version":                             "v1",
+			}}},
+			wantName:     "reviews-canonical",
+			wantRevision: "v1-canonical",
+		},
+		{
+			name: "kubernetes recommended labels",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1-abc", Labels: map[string]string{
+				"app.kubernetes.io/name":    "reviews",
+				"app.kubernetes.io/version": "1.2.0",
+				"app":                       "legacy",
+			}}},
+			wantName:     "reviews",
+			wantRevision: "1.2.0",
+		},
+		{
+			name:         "app and version labels",
+			pod:          &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1-abc", Labels: map[string]string{"app": "reviews", "version": "v2"}}},
+			wantName:     "reviews",
+			wantRevision: "v2",
+		},
+		{
+			name: "deployment name without labels",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "ratings-v1-5f7c8d9b4-x2x9z",
+				GenerateName:    "ratings-v1-5f7c8d9b4-",
+				Labels:          map[string]string{"pod-template-hash": "5f7c8d9b4"},
+				OwnerReferences: controlled("ReplicaSet", "ratings-v1-5f7c8d9b4"),
+			}},
+			wantName:     "ratings-v1",
+			wantRevision: "latest",
+		},
+		{
+			name: "cron job name without labels",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "cleanup-28473920-q8k2p",
+				GenerateName:    "cleanup-28473920-",
+				OwnerReferences: controlled("Job", "cleanup-28473920"),
+			}},
+			wantName:     "cleanup",
+			wantRevision: "latest",
+		},
+		{
+			name: "stateful set name without labels",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "mysql-0",
+				GenerateName:    "mysql-",
+				OwnerReferences: controlled("StatefulSet", "mysql"),
+			}},
+			wantName:     "mysql",
+			wantRevision: "latest",
+		},
+		{
+			name:         "bare pod",
+			pod:          &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "debug"}},
+			wantName:     "debug",
+			wantRevision: "latest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, revision := canonicalService(tt.pod)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantRevision, revision)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxPendingResourceChanges bounds the resource changes held for the next sync, dropping the oldest, so
+// an edge that cannot reach the manager does not grow without bound
+const maxPendingResourceChanges = 1000
+
+// istioResourceKey identifies a collected Istio resource
+type istioResourceKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// istioResourceVersion is the version of a collected Istio resource
+type istioResourceVersion struct {
+	resourceVersion string
+	generation      int64
+	hash            string // SHA-256 of the resource's raw config
+	actor           string // Field manager of the most recent write, empty if unknown
+}
+
+// resourceVersions are the versions of the Istio resources of a collection, observed concurrently by the
+// fetchers
+type resourceVersions struct {
+	mu       sync.Mutex
+	versions map[istioResourceKey]istioResourceVersion
+}
+
+func newResourceVersions() *resourceVersions {
+	return &resourceVersions{versions: make(map[istioResourceKey]istioResourceVersion)}
+}
+
+// observe records the version of a collected resource
+func (v *resourceVersions) observe(kind string, obj metav1.Object, rawConfig string) {
+	hash := sha256.Sum256([]byte(rawConfig))
+	version := istioResourceVersion{
+		resourceVersion: obj.GetResourceVersion(),
+		generation:      obj.GetGeneration(),
+		hash:            hex.EncodeToString(hash[:]),
+	}
+	if latest := latestManagedFieldsEntry(obj); latest != nil {
+		version.actor = latest.Manager
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.versions[istioResourceKey{kind: kind, namespace: obj.GetNamespace(), name: obj.GetName()}] = version
+}
+
+// diffResourceVersions returns the resources created, updated and deleted between two collections, in
+// kind, namespace and name order. A resource is updated when the hash of its raw config changed, so
+// writes that leave it unchanged are not reported.
+func diffResourceVersions(previous, current *resourceVersions, observedAt time.Time) []*v1alpha1.ResourceChange {
+	var changes []*v1alpha1.ResourceChange
+	change := func(key istioResourceKey, changeType v1alpha1.ResourceChangeType, version istioResourceVersion) *v1alpha1.ResourceChange {
+		return &v1alpha1.ResourceChange{
+			Kind:            key.kind,
+			Namespace:       key.namespace,
+			Name:            key.name,
+			Type:            changeType,
+			ResourceVersion: version.resourceVersion,
+			Generation:      version.generation,
+			ObservedAt:      timestamppb.New(observedAt),
+		}
+	}
+
+	for key, version := range current.versions {
+		old, existed := previous.versions[key]
+		switch {
+		case !existed:
+			created := change(key, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_CREATED, version)
+			created.NewHash = version.hash
+			created.Actor = version.actor
+			changes = append(changes, created)
+		case old.hash != version.hash:
+			updated := change(key, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_UPDATED, version)
+			updated.OldHash = old.hash
+			updated.NewHash = version.hash
+			updated.Actor = version.actor
+			changes = append(changes, updated)
+		}
+	}
+	for key, version := range previous.versions {
+		if _, exists := current.versions[key]; !exists {
+			deleted := change(key, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_DELETED, version)
+			deleted.OldHash = version.hash
+			changes = append(changes, deleted)
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b *v1alpha1.ResourceChange) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return changes
+}
+
+// queueResourceChanges holds resource changes for the next sync. The caller must hold collectMu.
+func (k *Client) queueResourceChanges(changes []*v1alpha1.ResourceChange) {
+	k.resourceChanges = append(k.resourceChanges, changes...)
+	if excess := len(k.resourceChanges) - maxPendingResourceChanges; excess > 0 {
+		k.logger.Warn("dropping resource changes not yet sent to the manager", "count", excess)
+		k.resourceChanges = slices.Delete(k.resourceChanges, 0, excess)
+	}
+}
+
+// takeResourceChanges returns the resource changes held for the next sync and forgets them, so each change
+// is sent once
+func (k *Client) takeResourceChanges() []*v1alpha1.ResourceChange {
+	k.collectMu.Lock()
+	defer k.collectMu.Unlock()
+	changes := k.resourceChanges
+	k.resourceChanges = nil
+	return changes
+}
@@ -18,15 +18,21 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
 	istioclient "istio.io/client-go/pkg/clientset/versioned"
-	corev1 "k8s.io/api/core/v1"
-	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	// Registers the oidc auth provider, which refreshes expired ID tokens with the kubeconfig's refresh token
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -35,8 +41,24 @@ import (
 type Client struct {
 	clientset   kubernetes.Interface
 	istioClient istioclient.Interface
-	restConfig  *rest.Config
-	logger      *slog.Logger
+	// Reads resources without typed clients, such as Multi-Cluster Services API resources
+	dynamicClient dynamic.Interface
+	credentials   *credentialRefresher // Authenticates the clients, refreshing rejected credentials
+	logger        *slog.Logger
+
+	mu           sync.RWMutex
+	unavailable  map[string]bool           // Optional resource types preflight found unavailable, keyed by group/resource
+	discoveredAt time.Time                 // When the unavailable resource types were last checked
+	preflight    *v1alpha1.PreflightReport // Latest preflight report, replaced when unavailable resource types become available
+	legacyOnly   map[string]bool           // Istio resource types only served at v1beta1, keyed by group/resource
+	shard        *v1alpha1.NamespaceShard  // Namespaces collected when the cluster is split between edges, nil for all
+	intervals    SyncIntervals             // Least time between collections of each group of resources
+	exclusions   CollectionExclusions      // Optional parts of the cluster state left out of collections
+	datastore    Datastore                 // Where workload resources are read from, nil to list them from the API server
+
+	collectMu       sync.Mutex                 // Serializes collections
+	collected       collections                // Last collection of each group, reused until it is due again
+	resourceChanges []*v1alpha1.ResourceChange // Istio resource changes observed since they were last sent
 }
 
 // NewClient creates a new Kubernetes client
@@ -44,43 +66,16 @@ func NewClient(kubeconfigPath string, logger *slog.Logger) (*Client, error) {
 	return NewClientWithContext(kubeconfigPath, "", logger)
 }
 
-// NewClientWithContext creates a new Kubernetes client with a specific context
+// NewClientWithContext creates a new Kubernetes client with a specific context. The kubeconfig is loaded
+// again whenever the API server rejects the client's credentials, so expiring credentials are refreshed.
 func NewClientWithContext(kubeconfigPath string, contextName string, logger *slog.Logger) (*Client, error) {
-	var config *rest.Config
-	var err error
-
-	if kubeconfigPath != "" {
-		// Build config with specific context if provided
-		if contextName != "" {
-			// Load kubeconfig and override context
-			kubeconfig, err := clientcmd.LoadFromFile(kubeconfigPath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
-			}
-
-			// Override the current context
-			overrides := &clientcmd.ConfigOverrides{
-				CurrentContext: contextName,
-			}
-
-			config, err = clientcmd.NewDefaultClientConfig(*kubeconfig, overrides).ClientConfig()
-			if err != nil {
-				return nil, fmt.Errorf("failed to build kubeconfig for context '%s': %w", contextName, err)
-			}
-		} else {
-			// Use kubeconfig file with current context
-			config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
-			}
-		}
-	} else {
-		// Use in-cluster config
-		config, err = rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
-		}
+	credentials, err := newCredentialRefresher(func() (*rest.Config, error) {
+		return loadRestConfig(kubeconfigPath, contextName)
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
+	config := credentials.clientConfig()
 
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -92,22 +87,70 @@ func NewClientWithContext(kubeconfigPath string, contextName string, logger *slo
 		return nil, fmt.Errorf("failed to create istio client: %w", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
 	return &Client{
-		clientset:   clientset,
-		istioClient: istioClient,
-		restConfig:  config,
-		logger:      logger,
+		clientset:     clientset,
+		istioClient:   istioClient,
+		dynamicClient: dynamicClient,
+		credentials:   credentials,
+		logger:        logger,
 	}, nil
 }
 
+// loadRestConfig loads the REST config for a kubeconfig context, or the in-cluster config if no kubeconfig is
+// given. The kubeconfig path may be a list of paths, see KubeconfigLoadingRules.
+func loadRestConfig(kubeconfigPath string, contextName string) (*rest.Config, error) {
+	if kubeconfigPath == "" {
+		// Use in-cluster config
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+		}
+		return config, nil
+	}
+
+	// Load the kubeconfig through loading rules rather than parsing it directly, so that auth providers
+	// such as oidc can persist refreshed tokens back to the file
+	rules := KubeconfigLoadingRules(kubeconfigPath)
+
+	// Override the current context if one is provided
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: contextName,
+	}
+
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		if contextName != "" {
+			return nil, fmt.Errorf("failed to build kubeconfig for context '%s': %w", contextName, err)
+		}
+		return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
+	}
+	return config, nil
+}
+
+// KubeconfigLoadingRules returns the rules to load a kubeconfig path, which may be a list of paths separated
+// like KUBECONFIG. The files of a list are merged with client-go's precedence: the first file to set a
+// value wins, and files that do not exist are skipped. A single path must exist.
+func KubeconfigLoadingRules(kubeconfigPath string) *clientcmd.ClientConfigLoadingRules {
+	paths := filepath.SplitList(kubeconfigPath)
+	if len(paths) == 1 {
+		return &clientcmd.ClientConfigLoadingRules{ExplicitPath: paths[0]}
+	}
+	return &clientcmd.ClientConfigLoadingRules{Precedence: paths}
+}
+
 // GetClientset returns the underlying Kubernetes clientset
 func (k *Client) GetClientset() kubernetes.Interface {
 	return k.clientset
 }
 
-// GetRestConfig returns the underlying Kubernetes REST config
+// GetRestConfig returns the most recently loaded Kubernetes REST config
 func (k *Client) GetRestConfig() *rest.Config {
-	return k.restConfig
+	return k.credentials.restConfig()
 }
 
 // GetClusterName retrieves the cluster name from Istio's CLUSTER_ID environment variable in istiod deployment
@@ -140,83 +183,196 @@ func (k *Client) GetClusterName(ctx context.Context) (string, error) {
 
 // GetClusterState discovers all services in the cluster and returns the cluster state
 func (k *Client) GetClusterState(ctx context.Context) (*v1alpha1.ClusterState, error) {
-	// Parallelize API calls and map building in single goroutines
-	var wg sync.WaitGroup
-	var servicesResult *corev1.ServiceList
-	var endpointSlicesByService map[string][]discoveryv1.EndpointSlice
-	var podsByName map[string]*corev1.Pod
-	var protoDestinationRules []*typesv1alpha1.DestinationRule
-	var protoEnvoyFilters []*typesv1alpha1.EnvoyFilter
-	var protoRequestAuthentications []*typesv1alpha1.RequestAuthentication
-	var protoPeerAuthentications []*typesv1alpha1.PeerAuthentication
-	var protoAuthorizationPolicies []*typesv1alpha1.AuthorizationPolicy
-	var protoWasmPlugins []*typesv1alpha1.WasmPlugin
-	var protoGateways []*typesv1alpha1.Gateway
-	var protoSidecars []*typesv1alpha1.Sidecar
-	var protoVirtualServices []*typesv1alpha1.VirtualService
-	var protoServiceEntries []*typesv1alpha1.ServiceEntry
-	var protoIstioControlPlaneConfig *typesv1alpha1.IstioControlPlaneConfig
-
-	// Create error channel to collect errors from all goroutines
-	errChan := make(chan error, 14)
-	wg.Add(14)
-
-	// Fetch Kubernetes resources concurrently
-	go k.fetchServices(ctx, &wg, &servicesResult, errChan)
-	go k.fetchEndpointSlices(ctx, &wg, &endpointSlicesByService, errChan)
-	go k.fetchPods(ctx, &wg, &podsByName, errChan)
-
-	// Fetch and convert Istio resources concurrently
-	go k.fetchDestinationRules(ctx, &wg, &protoDestinationRules, errChan)
-	go k.fetchEnvoyFilters(ctx, &wg, &protoEnvoyFilters, errChan)
-	go k.fetchRequestAuthentications(ctx, &wg, &protoRequestAuthentications, errChan)
-	go k.fetchPeerAuthentications(ctx, &wg, &protoPeerAuthentications, errChan)
-	go k.fetchAuthorizationPolicies(ctx, &wg, &protoAuthorizationPolicies, errChan)
-	go k.fetchWasmPlugins(ctx, &wg, &protoWasmPlugins, errChan)
-	go k.fetchGateways(ctx, &wg, &protoGateways, errChan)
-	go k.fetchSidecars(ctx, &wg, &protoSidecars, errChan)
-	go k.fetchVirtualServices(ctx, &wg, &protoVirtualServices, errChan)
-	go k.fetchServiceEntries(ctx, &wg, &protoServiceEntries, errChan)
-	go k.fetchIstioControlPlaneConfig(ctx, &wg, &protoIstioControlPlaneConfig, errChan)
-
-	// Wait for all goroutines to complete
-	wg.Wait()
-	close(errChan)
-
-	// Collect all errors from the channel
-	var errors []error
-	for err := range errChan {
-		if err != nil {
-			errors = append(errors, err)
-		}
+	state := &v1alpha1.ClusterState{}
+	if err := k.StreamClusterState(ctx, func(segment *v1alpha1.ClusterState) error {
+		appendSegment(state, segment)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// StreamClusterState discovers all services in the cluster and emits the cluster state one namespace at a
+// time, in namespace order, so the converted state of the whole cluster is never held at once. The first
+// segment carries the Istio control plane config, CNI status and sidecar injection, and the Istio resource
+// changes observed since the last sync. Appending the segments in order yields the cluster state.
+func (k *Client) StreamClusterState(ctx context.Context, emit func(segment *v1alpha1.ClusterState) error) error {
+	// Collect the groups of resources that are due, reusing the last collection of the others
+	resources, err := k.collect(ctx)
+	if err != nil {
+		return err
 	}
+	servicesByNamespace := resources.workloads.servicesByNamespace
+	endpointSlicesByService := resources.workloads.endpointSlicesByService
+	podsByName := resources.workloads.podsByName
+	nodesByName := resources.workloads.nodesByName
+	istioConfig := resources.istioConfig
+	protoIstioControlPlaneConfig := resources.controlPlane.config
 
-	// If we have any errors, merge them and return
-	if len(errors) > 0 {
-		return nil, k.mergeErrors(errors)
+	// Only collect from the mesh member namespaces when the control plane is scoped, and from this edge's
+	// namespace shard when the cluster is split between several edges
+	members := memberSet(protoIstioControlPlaneConfig)
+	shard := k.namespaceShard()
+	collected := func(namespace string) bool {
+		return (members == nil || members[namespace]) && ownsNamespace(shard, namespace)
 	}
 
-	// Convert services using the fetched data
-	var protoServices []*v1alpha1.Service
-	for _, svc := range servicesResult.Items {
-		protoService := k.convertServiceWithMaps(&svc, endpointSlicesByService, podsByName)
-		protoServices = append(protoServices, protoService)
+	// Group the Istio resources into per-namespace segments
+	segments := make(map[string]*v1alpha1.ClusterState)
+	segment := func(namespace string) *v1alpha1.ClusterState {
+		if segments[namespace] == nil {
+			segments[namespace] = &v1alpha1.ClusterState{}
+		}
+		return segments[namespace]
+	}
+	addToSegments(istioConfig.destinationRules, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.DestinationRule) {
+		s.DestinationRules = append(s.DestinationRules, r)
+	})
+	addToSegments(istioConfig.envoyFilters, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.EnvoyFilter) {
+		s.EnvoyFilters = append(s.EnvoyFilters, r)
+	})
+	addToSegments(istioConfig.requestAuthentications, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.RequestAuthentication) {
+		s.RequestAuthentications = append(s.RequestAuthentications, r)
+	})
+	addToSegments(istioConfig.gateways, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.Gateway) {
+		s.Gateways = append(s.Gateways, r)
+	})
+	addToSegments(istioConfig.sidecars, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.Sidecar) {
+		s.Sidecars = append(s.Sidecars, r)
+	})
+	addToSegments(istioConfig.virtualServices, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.VirtualService) {
+		s.VirtualServices = append(s.VirtualServices, r)
+	})
+	addToSegments(istioConfig.peerAuthentications, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.PeerAuthentication) {
+		s.PeerAuthentications = append(s.PeerAuthentications, r)
+	})
+	addToSegments(istioConfig.authorizationPolicies, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.AuthorizationPolicy) {
+		s.AuthorizationPolicies = append(s.AuthorizationPolicies, r)
+	})
+	addToSegments(istioConfig.wasmPlugins, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.WasmPlugin) {
+		s.WasmPlugins = append(s.WasmPlugins, r)
+	})
+	addToSegments(istioConfig.serviceEntries, collected, segment, func(s *v1alpha1.ClusterState, r *typesv1alpha1.ServiceEntry) {
+		s.ServiceEntries = append(s.ServiceEntries, r)
+	})
+	addToSegments(istioConfig.serviceExports, collected, segment, func(s *v1alpha1.ClusterState, r *v1alpha1.ServiceExport) {
+		s.ServiceExports = append(s.ServiceExports, r)
+	})
+	addToSegments(istioConfig.serviceImports, collected, segment, func(s *v1alpha1.ClusterState, r *v1alpha1.ServiceImport) {
+		s.ServiceImports = append(s.ServiceImports, r)
+	})
+
+	namespaces := slices.Collect(maps.Keys(segments))
+	for namespace := range servicesByNamespace {
+		if collected(namespace) && segments[namespace] == nil {
+			namespaces = append(namespaces, namespace)
+		}
 	}
+	slices.Sort(namespaces)
 
-	return &v1alpha1.ClusterState{
-		Services:                protoServices,
-		DestinationRules:        protoDestinationRules,
-		EnvoyFilters:            protoEnvoyFilters,
-		RequestAuthentications:  protoRequestAuthentications,
-		Gateways:                protoGateways,
-		Sidecars:                protoSidecars,
-		VirtualServices:         protoVirtualServices,
+	// The control plane config, CNI status, sidecar injection and resource changes travel in the first
+	// segment, even when there are no namespaces to collect
+	first := &v1alpha1.ClusterState{
 		IstioControlPlaneConfig: protoIstioControlPlaneConfig,
-		PeerAuthentications:     protoPeerAuthentications,
-		AuthorizationPolicies:   protoAuthorizationPolicies,
-		WasmPlugins:             protoWasmPlugins,
-		ServiceEntries:          protoServiceEntries,
-	}, nil
+		IstioCni:                istioCNIStatus(resources.controlPlane.cni, podsByName, collected),
+		SidecarInjection:        sidecarInjectionStatus(resources.controlPlane.injection, collected),
+	}
+	for _, change := range k.takeResourceChanges() {
+		if collected(change.Namespace) {
+			first.ResourceChanges = append(first.ResourceChanges, change)
+		}
+	}
+	if len(namespaces) == 0 {
+		return emit(first)
+	}
+
+	// Match the namespace-scoped policies of every workload against all policies in the cluster, since
+	// policies in the root namespace, or in another edge's shard, apply to this edge's workloads too
+	rootNamespace := protoIstioControlPlaneConfig.GetRootNamespace()
+	policies := filters.NewSelectorIndex(&v1alpha1.ClusterState{
+		Sidecars:               istioConfig.sidecars,
+		EnvoyFilters:           istioConfig.envoyFilters,
+		RequestAuthentications: istioConfig.requestAuthentications,
+		PeerAuthentications:    istioConfig.peerAuthentications,
+		AuthorizationPolicies:  istioConfig.authorizationPolicies,
+		WasmPlugins:            istioConfig.wasmPlugins,
+	})
+
+	// Convert services one namespace at a time, releasing each segment once emitted
+	for i, namespace := range namespaces {
+		current := segments[namespace]
+		if current == nil {
+			current = &v1alpha1.ClusterState{}
+		}
+		if i == 0 {
+			current.IstioControlPlaneConfig = first.IstioControlPlaneConfig
+			current.IstioCni = first.IstioCni
+			current.SidecarInjection = first.SidecarInjection
+			current.ResourceChanges = first.ResourceChanges
+		}
+		for _, svc := range servicesByNamespace[namespace] {
+			service := k.convertServiceWithMaps(svc, endpointSlicesByService, podsByName)
+			for _, instance := range service.Instances {
+				instance.Policies = policies.MatchWorkload(instance, namespace, rootNamespace)
+				applyNodeTopology(instance, nodesByName)
+			}
+			current.Services = append(current.Services, service)
+		}
+		delete(segments, namespace)
+
+		if err := emit(current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addToSegments adds the collected resources to the segment of their namespace
+func addToSegments[T interface{ GetNamespace() string }](resources []T, collected func(namespace string) bool, segment func(namespace string) *v1alpha1.ClusterState, add func(segment *v1alpha1.ClusterState, resource T)) {
+	for _, resource := range resources {
+		if collected(resource.GetNamespace()) {
+			add(segment(resource.GetNamespace()), resource)
+		}
+	}
+}
+
+// appendSegment appends the resources of a cluster state segment to a cluster state
+func appendSegment(state, segment *v1alpha1.ClusterState) {
+	state.Services = append(state.Services, segment.Services...)
+	state.DestinationRules = append(state.DestinationRules, segment.DestinationRules...)
+	state.EnvoyFilters = append(state.EnvoyFilters, segment.EnvoyFilters...)
+	state.RequestAuthentications = append(state.RequestAuthentications, segment.RequestAuthentications...)
+	state.Gateways = append(state.Gateways, segment.Gateways...)
+	state.Sidecars = append(state.Sidecars, segment.Sidecars...)
+	state.VirtualServices = append(state.VirtualServices, segment.VirtualServices...)
+	state.PeerAuthentications = append(state.PeerAuthentications, segment.PeerAuthentications...)
+	state.AuthorizationPolicies = append(state.AuthorizationPolicies, segment.AuthorizationPolicies...)
+	state.WasmPlugins = append(state.WasmPlugins, segment.WasmPlugins...)
+	state.ServiceEntries = append(state.ServiceEntries, segment.ServiceEntries...)
+	state.ServiceExports = append(state.ServiceExports, segment.ServiceExports...)
+	state.ServiceImports = append(state.ServiceImports, segment.ServiceImports...)
+	state.ResourceChanges = append(state.ResourceChanges, segment.ResourceChanges...)
+	if segment.IstioControlPlaneConfig != nil {
+		state.IstioControlPlaneConfig = segment.IstioControlPlaneConfig
+	}
+	if segment.IstioCni != nil {
+		state.IstioCni = segment.IstioCni
+	}
+	if segment.SidecarInjection != nil {
+		state.SidecarInjection = segment.SidecarInjection
+	}
+}
+
+// fetchIfCollectable runs a fetch concurrently if its resource type is collectable and not excluded, and
+// otherwise marks it done
+func (k *Client) fetchIfCollectable(group, resource string, wg *sync.WaitGroup, fetch func()) {
+	if !k.collectable(group, resource) || k.collectionExclusions().excludes(group, resource) {
+		wg.Done()
+		return
+	}
+	go fetch()
 }
 
 // mergeErrors combines multiple errors into a single error with detailed information
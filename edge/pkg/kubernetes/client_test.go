@@ -17,8 +17,12 @@ package kubernetes
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,8 +31,8 @@ import (
 	securityapi "istio.io/api/security/v1beta1"
 	istiotype "istio.io/api/type/v1beta1"
 	istioextensionsv1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
-	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
-	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istiosecurityv1 "istio.io/client-go/pkg/apis/security/v1"
 	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
@@ -147,9 +151,10 @@ func TestClient_GetClusterState(t *testing.T) {
 			}
 
 			k8sClient := &Client{
-				clientset:   clientset,
-				istioClient: istiofake.NewSimpleClientset(),
-				logger:      logging.For("test"),
+				clientset:     clientset,
+				istioClient:   istiofake.NewSimpleClientset(),
+				dynamicClient: newFakeDynamicClient(),
+				logger:        logging.For("test"),
 			}
 
 			got, err := k8sClient.GetClusterState(context.TODO())
@@ -217,7 +222,7 @@ func TestClient_GetClusterStateWithIstio(t *testing.T) {
 	}
 
 	// Create test Istio resources
-	dr := &istionetworkingv1beta1.DestinationRule{
+	dr := &istionetworkingv1.DestinationRule{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-dr",
 			Namespace: "default",
@@ -232,9 +237,10 @@ func TestClient_GetClusterStateWithIstio(t *testing.T) {
 	istioClient := istiofake.NewSimpleClientset(dr)
 
 	client := &Client{
-		clientset:   k8sClient,
-		istioClient: istioClient,
-		logger:      logging.For("test"),
+		clientset:     k8sClient,
+		istioClient:   istioClient,
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
 	}
 
 	result, err := client.GetClusterState(context.Background())
@@ -278,7 +284,7 @@ func TestClient_GetClusterStateWithWasmPlugins(t *testing.T) {
 	}
 
 	// Create test RequestAuthentication for comparison
-	requestAuth := &istiosecurityv1beta1.RequestAuthentication{
+	requestAuth := &istiosecurityv1.RequestAuthentication{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-request-auth",
 			Namespace: "default",
@@ -297,9 +303,10 @@ func TestClient_GetClusterStateWithWasmPlugins(t *testing.T) {
 	istioClient := istiofake.NewSimpleClientset(wasmPlugin, requestAuth)
 
 	client := &Client{
-		clientset:   k8sClient,
-		istioClient: istioClient,
-		logger:      logging.For("test"),
+		clientset:     k8sClient,
+		istioClient:   istioClient,
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
 	}
 
 	result, err := client.GetClusterState(context.Background())
@@ -323,3 +330,148 @@ func TestClient_GetClusterStateWithWasmPlugins(t *testing.T) {
 	assert.Len(t, result.RequestAuthentications, 1)
 	assert.Equal(t, "test-request-auth", result.RequestAuthentications[0].Name)
 }
+
+func TestClient_StreamClusterState(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "ledger", Namespace: "payments"}},
+	)
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "istio-system"},
+			Spec:       istioapi.DestinationRule{Host: "*.local"},
+		},
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "ledger", Namespace: "payments"},
+			Spec:       istioapi.DestinationRule{Host: "ledger"},
+		},
+	)
+	client := &Client{clientset: k8sClient, istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+
+	var segments []*v1alpha1.ClusterState
+	err := client.StreamClusterState(context.Background(), func(segment *v1alpha1.ClusterState) error {
+		segments = append(segments, segment)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// One segment per namespace, in namespace order, with the control plane config in the first
+	require.Len(t, segments, 3)
+	assert.Len(t, segments[0].Services, 2)
+	assert.Equal(t, "bookinfo", segments[0].Services[0].Namespace)
+	assert.NotNil(t, segments[0].IstioControlPlaneConfig)
+	assert.Len(t, segments[1].DestinationRules, 1)
+	assert.Empty(t, segments[1].Services)
+	assert.Nil(t, segments[1].IstioControlPlaneConfig)
+	assert.Len(t, segments[2].Services, 1)
+	assert.Len(t, segments[2].DestinationRules, 1)
+
+	// An emit error stops the stream
+	emitErr := errors.New("stream closed")
+	emitted := 0
+	err = client.StreamClusterState(context.Background(), func(segment *v1alpha1.ClusterState) error {
+		emitted++
+		return emitErr
+	})
+	assert.ErrorIs(t, err, emitErr)
+	assert.Equal(t, 1, emitted)
+}
+
+func TestClient_GetClusterStateWithWorkloadPolicies(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reviews-abc123",
+				Namespace: "bookinfo",
+				Labels:    map[string]string{"kubernetes.io/service-name": "reviews"},
+			},
+			Endpoints: []discoveryv1.Endpoint{{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-1"},
+			}},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-1", Namespace: "bookinfo", Labels: map[string]string{"app": "reviews"}}},
+	)
+	istioClient := istiofake.NewSimpleClientset(
+		&istiosecurityv1.RequestAuthentication{
+			ObjectMeta: metav1.ObjectMeta{Name: "mesh-jwt", Namespace: "istio-system"},
+		},
+		&istiosecurityv1.RequestAuthentication{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews-jwt", Namespace: "bookinfo"},
+			Spec: securityapi.RequestAuthentication{
+				Selector: &istiotype.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+			},
+		},
+		&istiosecurityv1.RequestAuthentication{
+			ObjectMeta: metav1.ObjectMeta{Name: "ratings-jwt", Namespace: "bookinfo"},
+			Spec: securityapi.RequestAuthentication{
+				Selector: &istiotype.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}},
+			},
+		},
+	)
+	client := &Client{clientset: k8sClient, istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+
+	result, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result.Services, 1)
+	require.Len(t, result.Services[0].Instances, 1)
+
+	// The edge matches the policies of each workload, including those of the root namespace
+	policies := result.Services[0].Instances[0].Policies
+	require.NotNil(t, policies)
+	assert.ElementsMatch(t, []string{"istio-system/mesh-jwt", "bookinfo/reviews-jwt"}, policies.RequestAuthentications)
+	assert.Empty(t, policies.AuthorizationPolicies)
+}
+
+func TestLoadRestConfig_pathList(t *testing.T) {
+	dir := t.TempDir()
+	writeKubeconfig := func(name, config string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+		return path
+	}
+	prod := writeKubeconfig("prod", `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: prod
+  context:
+    cluster: prod
+current-context: prod
+`)
+	staging := writeKubeconfig("staging", `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://shadowed.example.com
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+current-context: staging
+`)
+	paths := strings.Join([]string{prod, filepath.Join(dir, "missing"), staging}, string(filepath.ListSeparator))
+
+	// The first file to set a value wins, including the current context
+	config, err := loadRestConfig(paths, "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", config.Host)
+
+	// Contexts of every file in the list are available
+	config, err = loadRestConfig(paths, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com", config.Host)
+
+	// A single path must exist
+	_, err = loadRestConfig(filepath.Join(dir, "missing"), "")
+	assert.Error(t, err)
+}
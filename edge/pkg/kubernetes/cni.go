@@ -0,0 +1,169 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// istioCNILabelSelector selects the istio-cni node agent DaemonSet of every Istio distribution
+	istioCNILabelSelector = "k8s-app=istio-cni-node"
+	// istioCNIContainer is the container of the CNI agent, whose image is the CNI version
+	istioCNIContainer = "install-cni"
+	// istioInitContainer sets up traffic redirection in pods that do not rely on the CNI agent
+	istioInitContainer = "istio-init"
+	// istioValidationContainer validates the redirection set up by the CNI agent in place of istio-init
+	istioValidationContainer = "istio-validation"
+)
+
+// fetchIstioCNI finds the istio-cni DaemonSet and reports its version and rollout. Detection is best
+// effort: if DaemonSets cannot be listed the result is nil and CNI status is not reported. When several
+// DaemonSets match, the one ready on the most nodes is reported.
+func (k *Client) fetchIstioCNI(ctx context.Context, wg *sync.WaitGroup, result **typesv1alpha1.IstioCNIStatus) {
+	defer wg.Done()
+
+	if !k.collectable("apps", "daemonsets") {
+		return
+	}
+	daemonSets, err := listAll[*appsv1.DaemonSet](ctx, metav1.ListOptions{LabelSelector: istioCNILabelSelector}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.clientset.AppsV1().DaemonSets("").List(ctx, opts)
+	})
+	if err != nil {
+		k.logger.Warn("failed to list istio-cni daemonsets, not reporting CNI status", "error", err)
+		return
+	}
+
+	var selected *appsv1.DaemonSet
+	for _, ds := range daemonSets {
+		if selected == nil || ds.Status.NumberReady > selected.Status.NumberReady {
+			selected = ds
+		}
+	}
+	if selected == nil {
+		*result = &typesv1alpha1.IstioCNIStatus{}
+		return
+	}
+
+	*result = &typesv1alpha1.IstioCNIStatus{
+		Installed:    true,
+		Namespace:    selected.Namespace,
+		Name:         selected.Name,
+		Version:      imageTag(cniContainer(selected.Spec.Template.Spec.Containers).Image),
+		DesiredNodes: selected.Status.DesiredNumberScheduled,
+		UpdatedNodes: selected.Status.UpdatedNumberScheduled,
+	}
+}
+
+// istioCNIStatus completes the CNI status collected with the control plane from the current pods: the
+// readiness of the CNI agent on each node, and how the pods of collected namespaces set up traffic
+// redirection. The collected status is not modified.
+func istioCNIStatus(collectedStatus *typesv1alpha1.IstioCNIStatus, podsByName map[string]*corev1.Pod, collected func(namespace string) bool) *typesv1alpha1.IstioCNIStatus {
+	if collectedStatus == nil {
+		return nil
+	}
+	status := proto.Clone(collectedStatus).(*typesv1alpha1.IstioCNIStatus)
+
+	for _, pod := range podsByName {
+		if status.Installed && pod.Namespace == status.Namespace && ownedByDaemonSet(pod, status.Name) {
+			node := convertCNINode(pod)
+			if node.Ready {
+				status.ReadyNodes++
+			}
+			status.Nodes = append(status.Nodes, node)
+		}
+
+		if !collected(pod.Namespace) {
+			continue
+		}
+		for _, container := range pod.Spec.InitContainers {
+			switch container.Name {
+			case istioInitContainer:
+				status.IstioInitPods++
+			case istioValidationContainer:
+				status.CniPods++
+			}
+		}
+	}
+	status.ReliesOnIstioInit = status.IstioInitPods > 0
+
+	sort.Slice(status.Nodes, func(i, j int) bool {
+		return status.Nodes[i].NodeName < status.Nodes[j].NodeName
+	})
+	return status
+}
+
+// convertCNINode converts a CNI agent pod to the status of the agent on its node
+func convertCNINode(pod *corev1.Pod) *typesv1alpha1.IstioCNINode {
+	node := &typesv1alpha1.IstioCNINode{
+		NodeName: pod.Spec.NodeName,
+		PodName:  pod.Name,
+		Version:  imageTag(cniContainer(pod.Spec.Containers).Image),
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			node.Ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == istioCNIContainer {
+			node.RestartCount = cs.RestartCount
+		}
+	}
+	return node
+}
+
+// ownedByDaemonSet reports whether a pod belongs to the named DaemonSet
+func ownedByDaemonSet(pod *corev1.Pod, name string) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" && owner.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cniContainer returns the CNI agent container, or the first container if none is named after it
+func cniContainer(containers []corev1.Container) corev1.Container {
+	for _, container := range containers {
+		if container.Name == istioCNIContainer {
+			return container
+		}
+	}
+	if len(containers) == 0 {
+		return corev1.Container{}
+	}
+	return containers[0]
+}
+
+// imageTag returns the tag of a container image reference, ignoring any digest, or "" if it has none
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
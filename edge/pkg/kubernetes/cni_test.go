@@ -0,0 +1,152 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// cniAgentPod builds an istio-cni agent pod of the istio-cni-node DaemonSet
+func cniAgentPod(name, node, image string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "istio-system",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "istio-cni-node"}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   node,
+			Containers: []corev1.Container{{Name: "install-cni", Image: image}},
+		},
+		Status: corev1.PodStatus{
+			Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "install-cni", RestartCount: 2}},
+		},
+	}
+}
+
+// sidecarPod builds an application pod that sets up traffic redirection with the given init container
+func sidecarPod(name, namespace, initContainer string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: initContainer}},
+			Containers:     []corev1.Container{{Name: "app"}, {Name: "istio-proxy"}},
+		},
+	}
+}
+
+func TestClient_GetClusterState_istioCNI(t *testing.T) {
+	objects := []runtime.Object{
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio-cni-node", Namespace: "istio-system", Labels: map[string]string{"k8s-app": "istio-cni-node"}},
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "install-cni", Image: "docker.io/istio/install-cni:1.26.2"}},
+			}}},
+			Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 1, UpdatedNumberScheduled: 1},
+		},
+		cniAgentPod("istio-cni-node-b", "node-b", "docker.io/istio/install-cni:1.25.4", false),
+		cniAgentPod("istio-cni-node-a", "node-a", "docker.io/istio/install-cni:1.26.2", true),
+		sidecarPod("reviews-1", "bookinfo", "istio-validation"),
+		sidecarPod("ratings-1", "bookinfo", "istio-init"),
+	}
+	client := &Client{
+		clientset:     fake.NewSimpleClientset(objects...),
+		istioClient:   istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
+	}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+
+	cni := state.IstioCni
+	require.NotNil(t, cni)
+	assert.True(t, cni.Installed)
+	assert.Equal(t, "istio-system", cni.Namespace)
+	assert.Equal(t, "istio-cni-node", cni.Name)
+	assert.Equal(t, "1.26.2", cni.Version)
+	assert.Equal(t, int32(2), cni.DesiredNodes)
+	assert.Equal(t, int32(1), cni.UpdatedNodes)
+	assert.Equal(t, int32(1), cni.ReadyNodes)
+	require.Len(t, cni.Nodes, 2)
+	assert.Equal(t, "node-a", cni.Nodes[0].NodeName)
+	assert.True(t, cni.Nodes[0].Ready)
+	assert.Equal(t, int32(2), cni.Nodes[0].RestartCount)
+	assert.Equal(t, "node-b", cni.Nodes[1].NodeName)
+	assert.False(t, cni.Nodes[1].Ready)
+	assert.Equal(t, "1.25.4", cni.Nodes[1].Version)
+	assert.Equal(t, int32(1), cni.CniPods)
+	assert.Equal(t, int32(1), cni.IstioInitPods)
+	assert.True(t, cni.ReliesOnIstioInit)
+}
+
+func TestClient_GetClusterState_istioCNINotInstalled(t *testing.T) {
+	client := &Client{
+		clientset:     fake.NewSimpleClientset(sidecarPod("ratings-1", "bookinfo", "istio-init")),
+		istioClient:   istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
+	}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, state.IstioCni)
+	assert.False(t, state.IstioCni.Installed)
+	assert.Empty(t, state.IstioCni.Nodes)
+	assert.Equal(t, int32(1), state.IstioCni.IstioInitPods)
+	assert.True(t, state.IstioCni.ReliesOnIstioInit)
+
+	// Without access to DaemonSets CNI status is not reported
+	client.unavailable = map[string]bool{resourceKey("apps", "daemonsets"): true}
+	client.SetSyncIntervals(SyncIntervals{})
+	state, err = client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, state.IstioCni)
+}
+
+func TestImageTag(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "docker.io/istio/install-cni:1.26.2", want: "1.26.2"},
+		{image: "gcr.io/istio-release/install-cni:1.26.2-distroless@sha256:abc", want: "1.26.2-distroless"},
+		{image: "registry:5000/istio/install-cni", want: ""},
+		{image: "install-cni@sha256:abc", want: ""},
+		{image: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			assert.Equal(t, tt.want, imageTag(tt.image))
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// collectionSlack collects a group that is due within it now rather than a whole sync later, since sync
+// ticks drift against the time the group was last collected
+const collectionSlack = time.Second
+
+// SyncIntervals are the least time between collections of each group of resources, so that groups which
+// rarely change are not listed on every sync. Syncs in between reuse the last collection of the group.
+// A zero interval collects the group on every sync.
+type SyncIntervals struct {
+	Workloads    time.Duration // Services, endpoint slices and pods
+	IstioConfig  time.Duration // Istio networking, security and extensions resources, and MCS service exports and imports
+	ControlPlane time.Duration // Istio control plane config and CNI DaemonSet
+}
+
+// CollectionExclusions are optional parts of the cluster state left out of collections, so the edge uses
+// less memory on small or resource-constrained clusters. By default everything is collected.
+type CollectionExclusions struct {
+	EnvoyFilters bool // EnvoyFilters are not listed
+	WasmPlugins  bool // WasmPlugins are not listed
+	RawConfig    bool // Istio resources are kept and sent without their raw config
+}
+
+// excludes returns whether a resource type is left out of collections
+func (e CollectionExclusions) excludes(group, resource string) bool {
+	switch resourceKey(group, resource) {
+	case resourceKey("networking.istio.io", "envoyfilters"):
+		return e.EnvoyFilters
+	case resourceKey("extensions.istio.io", "wasmplugins"):
+		return e.WasmPlugins
+	default:
+		return false
+	}
+}
+
+// collections are the last collected resources of each group
+type collections struct {
+	workloads    *workloadCollection
+	istioConfig  *istioConfigCollection
+	controlPlane *controlPlaneCollection
+}
+
+// workloadCollection are the Kubernetes resources services are converted from
+type workloadCollection struct {
+	collectedAt             time.Time
+	servicesByNamespace     map[string][]*corev1.Service
+	endpointSlicesByService map[string][]discoveryv1.EndpointSlice
+	podsByName              map[string]*corev1.Pod
+	nodesByName             map[string]nodeTopology // nil if nodes cannot be listed
+}
+
+// istioConfigCollection are the converted Istio config resources and Multi-Cluster Services API resources
+type istioConfigCollection struct {
+	collectedAt            time.Time
+	versions               *resourceVersions // Versions of the Istio config resources, to diff against the next collection
+	destinationRules       []*typesv1alpha1.DestinationRule
+	envoyFilters           []*typesv1alpha1.EnvoyFilter
+	requestAuthentications []*typesv1alpha1.RequestAuthentication
+	peerAuthentications    []*typesv1alpha1.PeerAuthentication
+	authorizationPolicies  []*typesv1alpha1.AuthorizationPolicy
+	wasmPlugins            []*typesv1alpha1.WasmPlugin
+	gateways               []*typesv1alpha1.Gateway
+	sidecars               []*typesv1alpha1.Sidecar
+	virtualServices        []*typesv1alpha1.VirtualService
+	serviceEntries         []*typesv1alpha1.ServiceEntry
+	serviceExports         []*v1alpha1.ServiceExport
+	serviceImports         []*v1alpha1.ServiceImport
+}
+
+// controlPlaneCollection is the converted Istio control plane config, CNI DaemonSet and sidecar
+// injection webhooks
+type controlPlaneCollection struct {
+	collectedAt time.Time
+	config      *typesv1alpha1.IstioControlPlaneConfig
+	cni         *typesv1alpha1.IstioCNIStatus // Without per-node readiness and pod counts, nil if unknown
+	injection   *sidecarInjection             // nil if unknown
+}
+
+// SetSyncIntervals sets how often each group of resources is collected. By default every group is
+// collected on every sync.
+func (k *Client) SetSyncIntervals(intervals SyncIntervals) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.intervals = intervals
+}
+
+// syncIntervals returns how often each group of resources is collected
+func (k *Client) syncIntervals() SyncIntervals {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.intervals
+}
+
+// SetCollectionExclusions sets the optional parts of the cluster state left out of collections. They are
+// left out from the next collection of their group.
+func (k *Client) SetCollectionExclusions(exclusions CollectionExclusions) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.exclusions = exclusions
+}
+
+// collectionExclusions returns the optional parts of the cluster state left out of collections
+func (k *Client) collectionExclusions() CollectionExclusions {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.exclusions
+}
+
+// collect fetches the groups of resources that are due for collection concurrently and returns them with
+// the last collection of the other groups. Nothing is kept from a collection that fails.
+func (k *Client) collect(ctx context.Context) (collections, error) {
+	k.collectMu.Lock()
+	defer k.collectMu.Unlock()
+
+	// Pick up resource types that became available since preflight, such as Istio CRDs installed later
+	k.rediscover(ctx)
+
+	intervals := k.syncIntervals()
+	now := time.Now()
+	due := func(collectedAt time.Time, interval time.Duration) bool {
+		return now.Sub(collectedAt)+collectionSlack > interval
+	}
+
+	current := k.collected
+	var wg sync.WaitGroup
+
+	// Create error channel to collect errors from all goroutines
+	errChan := make(chan error, 16)
+
+	// Fetch Kubernetes resources concurrently
+	if current.workloads == nil || due(current.workloads.collectedAt, intervals.Workloads) {
+		workloads := &workloadCollection{collectedAt: now}
+		current.workloads = workloads
+		wg.Add(4)
+		go k.fetchServices(ctx, &wg, &workloads.servicesByNamespace, errChan)
+		go k.fetchEndpointSlices(ctx, &wg, &workloads.endpointSlicesByService, errChan)
+		go k.fetchPods(ctx, &wg, &workloads.podsByName, errChan)
+		k.fetchIfCollectable("", "nodes", &wg, func() { k.fetchNodes(ctx, &wg, &workloads.nodesByName, errChan) })
+	}
+
+	// Fetch and convert Istio resources concurrently, skipping any preflight found unavailable
+	if current.istioConfig == nil || due(current.istioConfig.collectedAt, intervals.IstioConfig) {
+		config := &istioConfigCollection{collectedAt: now, versions: newResourceVersions()}
+		current.istioConfig = config
+		wg.Add(12)
+		k.fetchIfCollectable("networking.istio.io", "destinationrules", &wg, func() { k.fetchDestinationRules(ctx, &wg, &config.destinationRules, config.versions, errChan) })
+		k.fetchIfCollectable("networking.istio.io", "envoyfilters", &wg, func() { k.fetchEnvoyFilters(ctx, &wg, &config.envoyFilters, config.versions, errChan) })
+		k.fetchIfCollectable("security.istio.io", "requestauthentications", &wg, func() {
+			k.fetchRequestAuthentications(ctx, &wg, &config.requestAuthentications, config.versions, errChan)
+		})
+		k.fetchIfCollectable("security.istio.io", "peerauthentications", &wg, func() { k.fetchPeerAuthentications(ctx, &wg, &config.peerAuthentications, config.versions, errChan) })
+		k.fetchIfCollectable("security.istio.io", "authorizationpolicies", &wg, func() {
+			k.fetchAuthorizationPolicies(ctx, &wg, &config.authorizationPolicies, config.versions, errChan)
+		})
+		k.fetchIfCollectable("extensions.istio.io", "wasmplugins", &wg, func() { k.fetchWasmPlugins(ctx, &wg, &config.wasmPlugins, config.versions, errChan) })
+		k.fetchIfCollectable("networking.istio.io", "gateways", &wg, func() { k.fetchGateways(ctx, &wg, &config.gateways, config.versions, errChan) })
+		k.fetchIfCollectable("networking.istio.io", "sidecars", &wg, func() { k.fetchSidecars(ctx, &wg, &config.sidecars, config.versions, errChan) })
+		k.fetchIfCollectable("networking.istio.io", "virtualservices", &wg, func() { k.fetchVirtualServices(ctx, &wg, &config.virtualServices, config.versions, errChan) })
+		k.fetchIfCollectable("networking.istio.io", "serviceentries", &wg, func() { k.fetchServiceEntries(ctx, &wg, &config.serviceEntries, config.versions, errChan) })
+		k.fetchIfCollectable(multiClusterGroup, "serviceexports", &wg, func() { k.fetchServiceExports(ctx, &wg, &config.serviceExports, errChan) })
+		k.fetchIfCollectable(multiClusterGroup, "serviceimports", &wg, func() { k.fetchServiceImports(ctx, &wg, &config.serviceImports, errChan) })
+	}
+
+	if current.controlPlane == nil || due(current.controlPlane.collectedAt, intervals.ControlPlane) {
+		controlPlane := &controlPlaneCollection{collectedAt: now}
+		current.controlPlane = controlPlane
+		wg.Add(3)
+		go k.fetchIstioControlPlaneConfig(ctx, &wg, &controlPlane.config, errChan)
+		go k.fetchIstioCNI(ctx, &wg, &controlPlane.cni)
+		go k.fetchSidecarInjection(ctx, &wg, &controlPlane.injection)
+	}
+
+	// Wait for all goroutines to complete
+	wg.Wait()
+	close(errChan)
+
+	// Collect all errors from the channel
+	var errors []error
+	for err := range errChan {
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	// If we have any errors, merge them and return
+	if len(errors) > 0 {
+		return collections{}, k.mergeErrors(errors)
+	}
+
+	// Raw config was only needed to observe the versions of the Istio resources, drop it so collections
+	// kept between syncs stay small
+	if current.istioConfig != k.collected.istioConfig && k.collectionExclusions().RawConfig {
+		current.istioConfig.clearRawConfig()
+	}
+
+	// Queue the Istio resources that changed since the last collection for the next sync. The first
+	// collection has nothing to compare against.
+	if previous := k.collected.istioConfig; previous != nil && current.istioConfig != previous {
+		k.queueResourceChanges(diffResourceVersions(previous.versions, current.istioConfig.versions, now))
+	}
+
+	k.collected = current
+	return current, nil
+}
+
+// clearRawConfig removes the raw config of the collected Istio resources
+func (c *istioConfigCollection) clearRawConfig() {
+	clearRawConfig(c.destinationRules)
+	clearRawConfig(c.envoyFilters)
+	clearRawConfig(c.requestAuthentications)
+	clearRawConfig(c.peerAuthentications)
+	clearRawConfig(c.authorizationPolicies)
+	clearRawConfig(c.wasmPlugins)
+	clearRawConfig(c.gateways)
+	clearRawConfig(c.sidecars)
+	clearRawConfig(c.virtualServices)
+	clearRawConfig(c.serviceEntries)
+}
+
+// clearRawConfig removes the raw_config field of each resource
+func clearRawConfig[T proto.Message](resources []T) {
+	for _, resource := range resources {
+		message := resource.ProtoReflect()
+		message.Clear(message.Descriptor().Fields().ByName("raw_config"))
+	}
+}
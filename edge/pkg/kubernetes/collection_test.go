@@ -0,0 +1,184 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioapi "istio.io/api/networking/v1alpha3"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClient_GetClusterState_syncIntervals(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+	)
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "reviews"},
+		},
+	)
+	client := &Client{clientset: k8sClient, istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+	client.SetSyncIntervals(SyncIntervals{IstioConfig: time.Hour})
+
+	state, err := client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, state.Services, 1)
+	assert.Len(t, state.DestinationRules, 1)
+
+	_, err = k8sClient.CoreV1().Services("bookinfo").Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = istioClient.NetworkingV1().DestinationRules("bookinfo").Create(ctx, &istionetworkingv1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"},
+		Spec:       istioapi.DestinationRule{Host: "ratings"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Workloads are collected on every sync, the Istio config is reused until it is due
+	state, err = client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, state.Services, 2)
+	assert.Len(t, state.DestinationRules, 1)
+
+	client.SetSyncIntervals(SyncIntervals{})
+	state, err = client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, state.DestinationRules, 2)
+}
+
+func TestClient_GetClusterState_collectionExclusions(t *testing.T) {
+	ctx := context.Background()
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "reviews"},
+		},
+		&istionetworkingv1alpha3.EnvoyFilter{
+			ObjectMeta: metav1.ObjectMeta{Name: "lua", Namespace: "bookinfo"},
+		},
+	)
+	client := &Client{clientset: fake.NewSimpleClientset(), istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+
+	state, err := client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, state.EnvoyFilters, 1)
+	require.Len(t, state.DestinationRules, 1)
+	assert.NotEmpty(t, state.DestinationRules[0].RawConfig)
+
+	client.SetCollectionExclusions(CollectionExclusions{EnvoyFilters: true, RawConfig: true})
+	_, err = istioClient.NetworkingV1().DestinationRules("bookinfo").Update(ctx, &istionetworkingv1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+		Spec:       istioapi.DestinationRule{Host: "reviews.bookinfo.svc.cluster.local"},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Excluded resources are not collected and raw config is dropped, but changes are still tracked
+	state, err = client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, state.EnvoyFilters)
+	require.Len(t, state.DestinationRules, 1)
+	assert.Equal(t, "reviews.bookinfo.svc.cluster.local", state.DestinationRules[0].Host)
+	assert.Empty(t, state.DestinationRules[0].RawConfig)
+	var updated []string
+	for _, change := range state.ResourceChanges {
+		if change.Type == v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_UPDATED {
+			updated = append(updated, change.Name)
+		}
+	}
+	assert.Equal(t, []string{"reviews"}, updated)
+}
+
+func TestClient_GetClusterState_resourceChanges(t *testing.T) {
+	ctx := context.Background()
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", Generation: 1},
+			Spec:       istioapi.DestinationRule{Host: "reviews"},
+		},
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "details", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "details"},
+		},
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "productpage", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "productpage"},
+		},
+	)
+	client := &Client{clientset: fake.NewSimpleClientset(), istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+
+	// The first collection has nothing to compare against
+	state, err := client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, state.ResourceChanges)
+
+	destinationRules := istioClient.NetworkingV1().DestinationRules("bookinfo")
+	_, err = destinationRules.Update(ctx, &istionetworkingv1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "reviews", Namespace: "bookinfo", Generation: 2,
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl-client-side-apply", APIVersion: "networking.istio.io/v1"}},
+		},
+		Spec: istioapi.DestinationRule{Host: "reviews.bookinfo.svc.cluster.local"},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = destinationRules.Create(ctx, &istionetworkingv1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"},
+		Spec:       istioapi.DestinationRule{Host: "ratings"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, destinationRules.Delete(ctx, "details", metav1.DeleteOptions{}))
+
+	state, err = client.GetClusterState(ctx)
+	require.NoError(t, err)
+	require.Len(t, state.ResourceChanges, 3)
+
+	deleted, created, updated := state.ResourceChanges[0], state.ResourceChanges[1], state.ResourceChanges[2]
+	assert.Equal(t, "details", deleted.Name)
+	assert.Equal(t, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_DELETED, deleted.Type)
+	assert.NotEmpty(t, deleted.OldHash)
+	assert.Empty(t, deleted.NewHash)
+
+	assert.Equal(t, "ratings", created.Name)
+	assert.Equal(t, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_CREATED, created.Type)
+	assert.Empty(t, created.OldHash)
+	assert.NotEmpty(t, created.NewHash)
+
+	assert.Equal(t, references.KindDestinationRule, updated.Kind)
+	assert.Equal(t, "bookinfo", updated.Namespace)
+	assert.Equal(t, "reviews", updated.Name)
+	assert.Equal(t, v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_UPDATED, updated.Type)
+	assert.NotEqual(t, updated.OldHash, updated.NewHash)
+	assert.Equal(t, int64(2), updated.Generation)
+	assert.Equal(t, "kubectl-client-side-apply", updated.Actor)
+	assert.NotNil(t, updated.ObservedAt)
+
+	// Changes are sent once, and an unchanged collection has none
+	state, err = client.GetClusterState(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, state.ResourceChanges)
+}
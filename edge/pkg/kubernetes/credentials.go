@@ -0,0 +1,170 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// minCredentialRefreshBackoff is the least time between credential refreshes
+	minCredentialRefreshBackoff = 5 * time.Second
+
+	// maxCredentialRefreshBackoff is the most time between credential refreshes while they keep failing
+	maxCredentialRefreshBackoff = 5 * time.Minute
+)
+
+// credentialRefresher is the transport of the Kubernetes clients. When the API server rejects a request as
+// unauthorized, e.g. because a token from an exec credential plugin such as `aws eks get-token` or `gcloud`
+// expired, it loads the kubeconfig again and rebuilds the authenticated transport, so that long running
+// edges recover without a restart. Refreshes back off exponentially while credentials keep being rejected.
+type credentialRefresher struct {
+	load    func() (*rest.Config, error)
+	logger  *slog.Logger
+	current atomic.Pointer[credentials]
+
+	mu          sync.Mutex
+	backoff     time.Duration
+	nextRefresh time.Time
+	rejected    atomic.Bool // Whether the last refresh has not been followed by an authorized response yet
+}
+
+// credentials are a loaded kubeconfig and the authenticated transport built from it
+type credentials struct {
+	config    *rest.Config
+	transport http.RoundTripper
+}
+
+// newCredentialRefresher loads the initial credentials
+func newCredentialRefresher(load func() (*rest.Config, error), logger *slog.Logger) (*credentialRefresher, error) {
+	r := &credentialRefresher{
+		load:    load,
+		logger:  logger,
+		backoff: minCredentialRefreshBackoff,
+	}
+	creds, err := r.build()
+	if err != nil {
+		return nil, err
+	}
+	r.current.Store(creds)
+	return r, nil
+}
+
+// build loads the kubeconfig and builds an authenticated transport from it
+func (r *credentialRefresher) build() (*credentials, error) {
+	config, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transport: %w", err)
+	}
+	return &credentials{config: config, transport: transport}, nil
+}
+
+// restConfig returns the most recently loaded kubeconfig
+func (r *credentialRefresher) restConfig() *rest.Config {
+	return r.current.Load().config
+}
+
+// clientConfig returns a config for clients that authenticate through the refresher
+func (r *credentialRefresher) clientConfig() *rest.Config {
+	config := r.restConfig()
+	return &rest.Config{
+		Host:      config.Host,
+		APIPath:   config.APIPath,
+		QPS:       config.QPS,
+		Burst:     config.Burst,
+		Timeout:   config.Timeout,
+		UserAgent: config.UserAgent,
+		Transport: r,
+	}
+}
+
+// RoundTrip sends a request with the current credentials, refreshing them if the API server rejects them.
+// Requests without a body are retried once with the refreshed credentials.
+func (r *credentialRefresher) RoundTrip(req *http.Request) (*http.Response, error) {
+	creds := r.current.Load()
+	resp, err := r.send(creds, req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if !r.refresh(creds) || (req.Body != nil && req.Body != http.NoBody) {
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	return r.send(r.current.Load(), req)
+}
+
+// send sends a request with the given credentials, resetting the backoff if refreshed credentials are accepted
+func (r *credentialRefresher) send(creds *credentials, req *http.Request) (*http.Response, error) {
+	resp, err := creds.transport.RoundTrip(req)
+	if err == nil && resp.StatusCode != http.StatusUnauthorized && r.rejected.Load() {
+		r.authorized()
+	}
+	return resp, err
+}
+
+// refresh rebuilds the credentials if those a request was rejected with are still current and the backoff
+// allows it. It reports whether the current credentials differ from the rejected ones.
+func (r *credentialRefresher) refresh(rejected *credentials) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.current.Load() != rejected {
+		// Refreshed by a concurrent request
+		return true
+	}
+	now := time.Now()
+	if now.Before(r.nextRefresh) {
+		return false
+	}
+
+	// Back off in case the refreshed credentials are rejected too
+	backoff := r.backoff
+	r.nextRefresh = now.Add(backoff)
+	r.backoff = min(r.backoff*2, maxCredentialRefreshBackoff)
+	r.rejected.Store(true)
+
+	r.logger.Warn("kubernetes API server rejected credentials, reloading kubeconfig", "host", rejected.config.Host)
+	creds, err := r.build()
+	if err != nil {
+		r.logger.Error("failed to refresh kubernetes credentials", "error", err, "retry_after", backoff)
+		return false
+	}
+	r.current.Store(creds)
+	utilnet.CloseIdleConnectionsFor(rejected.transport)
+	r.logger.Info("refreshed kubernetes credentials", "host", creds.config.Host)
+	return true
+}
+
+// authorized resets the backoff once refreshed credentials are accepted. The next refresh still waits for
+// the least backoff, so that credentials which are only accepted intermittently are not reloaded constantly.
+func (r *credentialRefresher) authorized() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.backoff = minCredentialRefreshBackoff
+	r.rejected.Store(false)
+}
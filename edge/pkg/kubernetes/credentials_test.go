@@ -0,0 +1,177 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClient_refreshesRejectedCredentials(t *testing.T) {
+	// An API server that only accepts the rotated token
+	var requests int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[{"metadata":{"name":"bookinfo"}}]}`))
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	writeKubeconfig := func(token string) {
+		config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+users:
+- name: test
+  user:
+    token: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+`, server.URL, token)
+		require.NoError(t, os.WriteFile(kubeconfig, []byte(config), 0o600))
+	}
+
+	writeKubeconfig("expired")
+	client, err := NewClientWithContext(kubeconfig, "test", logging.For("test"))
+	require.NoError(t, err)
+	list := func() error {
+		_, err := client.GetClientset().CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+		return err
+	}
+
+	// The rejected list is retried once with the reloaded kubeconfig, which is still rejected
+	require.Error(t, list())
+	assert.Equal(t, 2, requests)
+
+	// Credentials that are still rejected are not reloaded again until the backoff passes
+	require.Error(t, list())
+	assert.Equal(t, 3, requests)
+
+	// Once the backoff passed, the rejected list is retried with the credentials of the rotated kubeconfig
+	writeKubeconfig("rotated")
+	client.credentials.mu.Lock()
+	client.credentials.nextRefresh = time.Time{}
+	client.credentials.mu.Unlock()
+	require.NoError(t, list())
+	assert.Equal(t, 5, requests)
+	assert.Equal(t, "rotated", client.GetRestConfig().BearerToken)
+	assert.Equal(t, minCredentialRefreshBackoff, client.credentials.backoff)
+}
+
+func TestClient_refreshesOIDCTokens(t *testing.T) {
+	refreshed := testIDToken(time.Now().Add(time.Hour))
+
+	// An OIDC issuer that exchanges the refresh token for a new ID token
+	var issuer *httptest.Server
+	issuer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_, _ = fmt.Fprintf(w, `{"issuer":%q,"token_endpoint":%q}`, issuer.URL, issuer.URL+"/token")
+		case "/token":
+			if r.FormValue("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"access_token":"access","token_type":"Bearer","refresh_token":"rotated-refresh","id_token":%q,"expires_in":3600}`, refreshed)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer issuer.Close()
+
+	// An API server that only accepts the refreshed ID token
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+refreshed {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[]}`))
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+users:
+- name: test
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        idp-issuer-url: %s
+        client-id: navigator
+        id-token: %s
+        refresh-token: refresh
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+`, server.URL, issuer.URL, testIDToken(time.Now().Add(-time.Hour)))
+	require.NoError(t, os.WriteFile(kubeconfig, []byte(config), 0o600))
+
+	client, err := NewClientWithContext(kubeconfig, "test", logging.For("test"))
+	require.NoError(t, err)
+
+	// The expired ID token is refreshed silently and the new tokens are persisted to the kubeconfig
+	_, err = client.GetClientset().CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	persisted, err := os.ReadFile(kubeconfig)
+	require.NoError(t, err)
+	assert.Contains(t, string(persisted), refreshed)
+	assert.Contains(t, string(persisted), "rotated-refresh")
+}
+
+// testIDToken returns an unsigned JWT expiring at the given time
+func testIDToken(expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	header := encode([]byte(`{"alg":"none"}`))
+	claims := encode([]byte(fmt.Sprintf(`{"iss":"navigator","exp":%d}`, expiry.Unix())))
+	return header + "." + claims + ".signature"
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Workload resources a datastore supplies, as named in informer field selectors
+const (
+	servicesResource       = "services"
+	endpointSlicesResource = "endpointslices"
+	podsResource           = "pods"
+	nodesResource          = "nodes"
+)
+
+// WorkloadResources are the resources a Datastore supplies
+var WorkloadResources = []string{servicesResource, endpointSlicesResource, podsResource, nodesResource}
+
+// ErrDatastoreStopped is returned by reads of an informer datastore after it has been stopped
+var ErrDatastoreStopped = errors.New("informer datastore is stopped")
+
+// Datastore supplies the workload resources cluster state is converted from. The returned objects may be
+// shared with the datastore and must not be modified.
+type Datastore interface {
+	ListServices(ctx context.Context) ([]*corev1.Service, error)
+	ListEndpointSlices(ctx context.Context) ([]*discoveryv1.EndpointSlice, error)
+	ListPods(ctx context.Context) ([]*corev1.Pod, error)
+	ListNodes(ctx context.Context) ([]*corev1.Node, error)
+}
+
+// SetDatastore sets where workload resources are read from. By default they are listed from the API server
+// on every workload collection.
+func (k *Client) SetDatastore(datastore Datastore) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.datastore = datastore
+}
+
+// workloadDatastore returns where workload resources are read from
+func (k *Client) workloadDatastore() Datastore {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.datastore == nil {
+		return NewListDatastore(k.clientset)
+	}
+	return k.datastore
+}
+
+// ListDatastore lists workload resources from the API server a page at a time on every call
+type ListDatastore struct {
+	clientset kubernetes.Interface
+}
+
+// NewListDatastore creates a datastore listing workload resources from the API server
+func NewListDatastore(clientset kubernetes.Interface) *ListDatastore {
+	return &ListDatastore{clientset: clientset}
+}
+
+// ListServices lists the services of every namespace
+func (d *ListDatastore) ListServices(ctx context.Context) ([]*corev1.Service, error) {
+	return listAll[*corev1.Service](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return d.clientset.CoreV1().Services("").List(ctx, opts)
+	})
+}
+
+// ListEndpointSlices lists the endpoint slices of every namespace
+func (d *ListDatastore) ListEndpointSlices(ctx context.Context) ([]*discoveryv1.EndpointSlice, error) {
+	return listAll[*discoveryv1.EndpointSlice](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return d.clientset.DiscoveryV1().EndpointSlices("").List(ctx, opts)
+	})
+}
+
+// ListPods lists the pods of every namespace
+func (d *ListDatastore) ListPods(ctx context.Context) ([]*corev1.Pod, error) {
+	return listAll[*corev1.Pod](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return d.clientset.CoreV1().Pods("").List(ctx, opts)
+	})
+}
+
+// ListNodes lists the nodes
+func (d *ListDatastore) ListNodes(ctx context.Context) ([]*corev1.Node, error) {
+	return listAll[*corev1.Node](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return d.clientset.CoreV1().Nodes().List(ctx, opts)
+	})
+}
+
+// InformerOptions configure an informer backed datastore
+type InformerOptions struct {
+	// Resync is how often the informers replay their caches to their handlers, 0 disables resyncs
+	Resync time.Duration
+	// FieldSelectors restrict the objects watched of each workload resource, e.g. "status.phase!=Succeeded"
+	// for pods. Resources without a selector are watched in full.
+	FieldSelectors map[string]string
+}
+
+// InformerDatastore serves workload resources from informer caches kept up to date by watches, so workload
+// collections no longer list every resource from the API server. Each resource's informer is started the
+// first time it is read, and reads wait for its cache to sync.
+type InformerDatastore struct {
+	clientset kubernetes.Interface
+	options   InformerOptions
+	stop      chan struct{}
+	stopOnce  sync.Once
+
+	mu        sync.Mutex
+	factories map[string]informers.SharedInformerFactory // Keyed by resource, as field selectors differ
+}
+
+// NewInformerDatastore creates a datastore serving workload resources from informer caches
+func NewInformerDatastore(clientset kubernetes.Interface, options InformerOptions) *InformerDatastore {
+	return &InformerDatastore{
+		clientset: clientset,
+		options:   options,
+		stop:      make(chan struct{}),
+		factories: make(map[string]informers.SharedInformerFactory),
+	}
+}
+
+// Stop stops the datastore's informers. Reads after the datastore is stopped fail with ErrDatastoreStopped,
+// and stopping it again does nothing.
+func (d *InformerDatastore) Stop() {
+	d.stopOnce.Do(func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		close(d.stop)
+		for _, factory := range d.factories {
+			factory.Shutdown()
+		}
+	})
+}
+
+// ListServices lists the cached services of every namespace
+func (d *InformerDatastore) ListServices(ctx context.Context) ([]*corev1.Service, error) {
+	informer := d.factory(servicesResource).Core().V1().Services()
+	if err := d.sync(ctx, servicesResource, informer.Informer()); err != nil {
+		return nil, err
+	}
+	return informer.Lister().List(labels.Everything())
+}
+
+// ListEndpointSlices lists the cached endpoint slices of every namespace
+func (d *InformerDatastore) ListEndpointSlices(ctx context.Context) ([]*discoveryv1.EndpointSlice, error) {
+	informer := d.factory(endpointSlicesResource).Discovery().V1().EndpointSlices()
+	if err := d.sync(ctx, endpointSlicesResource, informer.Informer()); err != nil {
+		return nil, err
+	}
+	return informer.Lister().List(labels.Everything())
+}
+
+// ListPods lists the cached pods of every namespace
+func (d *InformerDatastore) ListPods(ctx context.Context) ([]*corev1.Pod, error) {
+	informer := d.factory(podsResource).Core().V1().Pods()
+	if err := d.sync(ctx, podsResource, informer.Informer()); err != nil {
+		return nil, err
+	}
+	return informer.Lister().List(labels.Everything())
+}
+
+// ListNodes lists the cached nodes
+func (d *InformerDatastore) ListNodes(ctx context.Context) ([]*corev1.Node, error) {
+	informer := d.factory(nodesResource).Core().V1().Nodes()
+	if err := d.sync(ctx, nodesResource, informer.Informer()); err != nil {
+		return nil, err
+	}
+	return informer.Lister().List(labels.Everything())
+}
+
+// factory returns the informer factory of a resource, watching it with the resource's field selector and
+// dropping managed fields from cached objects
+func (d *InformerDatastore) factory(resource string) informers.SharedInformerFactory {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if factory, exists := d.factories[resource]; exists {
+		return factory
+	}
+	options := []informers.SharedInformerOption{informers.WithTransform(stripManagedFields)}
+	if selector := d.options.FieldSelectors[resource]; selector != "" {
+		options = append(options, informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = selector
+		}))
+	}
+	factory := informers.NewSharedInformerFactoryWithOptions(d.clientset, d.options.Resync, options...)
+	d.factories[resource] = factory
+	return factory
+}
+
+// stripManagedFields drops the managed fields of objects before they are cached, as the edge never reads them
+func stripManagedFields(obj interface{}) (interface{}, error) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return obj, nil
+}
+
+// sync starts a resource's informer if it is not running yet and waits for its cache to sync, giving up
+// when the context ends or the datastore is stopped
+func (d *InformerDatastore) sync(ctx context.Context, resource string, informer cache.SharedIndexInformer) error {
+	d.mu.Lock()
+	select {
+	case <-d.stop:
+		d.mu.Unlock()
+		return ErrDatastoreStopped
+	default:
+	}
+	d.factories[resource].Start(d.stop)
+	d.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-d.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		select {
+		case <-d.stop:
+			return ErrDatastoreStopped
+		default:
+		}
+		return fmt.Errorf("failed to sync %s cache: %w", resource, ctx.Err())
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestInformerDatastore(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:          "reviews-v1",
+			Namespace:     "bookinfo",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		}},
+	)
+	datastore := NewInformerDatastore(clientset, InformerOptions{})
+	defer datastore.Stop()
+	ctx := context.Background()
+
+	services, err := datastore.ListServices(ctx)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, "reviews", services[0].Name)
+
+	pods, err := datastore.ListPods(ctx)
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	assert.Nil(t, pods[0].ManagedFields, "managed fields are not cached")
+
+	slices, err := datastore.ListEndpointSlices(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, slices)
+
+	// Changes are picked up from the watch without listing again
+	_, err = clientset.CoreV1().Pods("bookinfo").Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v2", Namespace: "bookinfo"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		pods, err := datastore.ListPods(ctx)
+		return err == nil && len(pods) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestInformerDatastore_SyncCanceled(t *testing.T) {
+	datastore := NewInformerDatastore(fake.NewSimpleClientset(), InformerOptions{})
+	defer datastore.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := datastore.ListNodes(ctx)
+	assert.ErrorContains(t, err, "failed to sync nodes cache")
+}
+
+func TestInformerDatastore_Stopped(t *testing.T) {
+	datastore := NewInformerDatastore(fake.NewSimpleClientset(), InformerOptions{})
+	_, err := datastore.ListNodes(context.Background())
+	require.NoError(t, err)
+
+	datastore.Stop()
+	assert.NotPanics(t, datastore.Stop, "stopping again does nothing")
+
+	_, err = datastore.ListNodes(context.Background())
+	assert.ErrorIs(t, err, ErrDatastoreStopped)
+	_, err = datastore.ListPods(context.Background())
+	assert.ErrorIs(t, err, ErrDatastoreStopped, "informers are not started after stop")
+}
+
+func TestClient_GetClusterState_InformerDatastore(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1", Namespace: "bookinfo"}},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews-abc", Namespace: "bookinfo", Labels: map[string]string{"kubernetes.io/service-name": "reviews"}},
+			Endpoints: []discoveryv1.Endpoint{{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-v1"},
+			}},
+		},
+	)
+	client := &Client{
+		clientset:     clientset,
+		istioClient:   istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
+	}
+	datastore := NewInformerDatastore(clientset, InformerOptions{Resync: time.Minute})
+	defer datastore.Stop()
+	client.SetDatastore(datastore)
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	require.Len(t, state.Services, 1)
+	require.Len(t, state.Services[0].Instances, 1)
+	assert.Equal(t, "reviews-v1", state.Services[0].Instances[0].PodName)
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// sidecarInjectorConfigMap is the name of the sidecar injector ConfigMap of the default revision
+	sidecarInjectorConfigMap = "istio-sidecar-injector"
+
+	// injectorConfigKey is the key of the injection config in the sidecar injector ConfigMap
+	injectorConfigKey = "config"
+
+	// injectorValuesKey is the key of the Helm values the templates are rendered with
+	injectorValuesKey = "values"
+)
+
+// builtinInjectionTemplates are the injection templates Istio ships
+var builtinInjectionTemplates = map[string]bool{
+	"sidecar":      true,
+	"gateway":      true,
+	"grpc-simple":  true,
+	"grpc-agent":   true,
+	"waypoint":     true,
+	"kube-gateway": true,
+	"spire":        true,
+}
+
+// injectorConfig is the subset of the sidecar injector config Navigator reports
+type injectorConfig struct {
+	Policy           string            `json:"policy,omitempty"`
+	DefaultTemplates []string          `json:"defaultTemplates,omitempty"`
+	Templates        map[string]string `json:"templates,omitempty"`
+}
+
+// injectorValues is the subset of the sidecar injector values that determine the injected proxy
+type injectorValues struct {
+	Global struct {
+		Hub     string `json:"hub,omitempty"`
+		Tag     any    `json:"tag,omitempty"`
+		Variant string `json:"variant,omitempty"`
+		Proxy   struct {
+			Image     string `json:"image,omitempty"`
+			Resources struct {
+				Requests map[string]string `json:"requests,omitempty"`
+				Limits   map[string]string `json:"limits,omitempty"`
+			} `json:"resources,omitempty"`
+		} `json:"proxy,omitempty"`
+	} `json:"global,omitempty"`
+}
+
+// sidecarInjectorConfig reads the sidecar injection configuration of a control plane revision. It returns
+// nil if the revision has no sidecar injector ConfigMap.
+func (k *Client) sidecarInjectorConfig(ctx context.Context, namespace, revision string) (*typesv1alpha1.SidecarInjectorConfig, error) {
+	name := sidecarInjectorConfigMap
+	if revision != "" {
+		name = sidecarInjectorConfigMap + "-" + revision
+	}
+
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sidecar injector config %s/%s: %w", namespace, name, err)
+	}
+
+	var config injectorConfig
+	if err := yaml.Unmarshal([]byte(configMap.Data[injectorConfigKey]), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse sidecar injector config %s/%s: %w", namespace, name, err)
+	}
+	var values injectorValues
+	if err := yaml.Unmarshal([]byte(configMap.Data[injectorValuesKey]), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse sidecar injector values %s/%s: %w", namespace, name, err)
+	}
+
+	result := &typesv1alpha1.SidecarInjectorConfig{
+		ConfigMap:        name,
+		Policy:           config.Policy,
+		DefaultTemplates: config.DefaultTemplates,
+		ProxyImage:       values.proxyImage(),
+		Version:          values.tag(),
+	}
+
+	for templateName, template := range config.Templates {
+		checksum := sha256.Sum256([]byte(template))
+		result.Templates = append(result.Templates, &typesv1alpha1.InjectionTemplate{
+			Name:     templateName,
+			Custom:   !builtinInjectionTemplates[templateName],
+			Checksum: hex.EncodeToString(checksum[:]),
+		})
+	}
+	sort.Slice(result.Templates, func(i, j int) bool {
+		return result.Templates[i].Name < result.Templates[j].Name
+	})
+
+	resources := values.Global.Proxy.Resources
+	if len(resources.Requests) > 0 || len(resources.Limits) > 0 {
+		result.ProxyResources = &typesv1alpha1.ProxyResources{
+			CpuRequest:    resources.Requests["cpu"],
+			MemoryRequest: resources.Requests["memory"],
+			CpuLimit:      resources.Limits["cpu"],
+			MemoryLimit:   resources.Limits["memory"],
+		}
+	}
+
+	return result, nil
+}
+
+// tag returns the image tag of the values, which Helm allows to be written as a number
+func (v injectorValues) tag() string {
+	if v.Global.Tag == nil {
+		return ""
+	}
+	return fmt.Sprint(v.Global.Tag)
+}
+
+// proxyImage returns the proxy image the templates inject. Like the templates, a proxy image naming a
+// repository is used as is, and otherwise completed with the hub, tag and variant.
+func (v injectorValues) proxyImage() string {
+	image := v.Global.Proxy.Image
+	if image == "" || strings.Contains(image, "/") {
+		return image
+	}
+	tag := v.tag()
+	if v.Global.Variant != "" {
+		tag += "-" + v.Global.Variant
+	}
+	return v.Global.Hub + "/" + image + ":" + tag
+}
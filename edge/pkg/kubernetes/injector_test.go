@@ -0,0 +1,116 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testInjectorConfig = `
+policy: enabled
+defaultTemplates: [sidecar]
+templates:
+  sidecar: |
+    spec:
+      containers:
+      - name: istio-proxy
+  gateway: |
+    spec: {}
+  custom-logging: |
+    spec:
+      containers:
+      - name: istio-proxy
+        args: ["--log_output_level=debug"]
+`
+
+const testInjectorValues = `{
+  "global": {
+    "hub": "docker.io/istio",
+    "tag": "1.26.2",
+    "variant": "distroless",
+    "proxy": {
+      "image": "proxyv2",
+      "resources": {
+        "requests": {"cpu": "100m", "memory": "128Mi"},
+        "limits": {"cpu": "2000m", "memory": "1024Mi"}
+      }
+    }
+  },
+  "revision": "canary"
+}`
+
+func injectorConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system"}, Data: data}
+}
+
+func TestClient_sidecarInjectorConfig(t *testing.T) {
+	client := &Client{
+		clientset: fake.NewSimpleClientset(
+			injectorConfigMap("istio-sidecar-injector-canary", map[string]string{"config": testInjectorConfig, "values": testInjectorValues}),
+			injectorConfigMap("istio-sidecar-injector", map[string]string{"config": "{{ invalid", "values": "{}"}),
+		),
+		logger: logging.For("test"),
+	}
+
+	injector, err := client.sidecarInjectorConfig(context.Background(), "istio-system", "canary")
+	require.NoError(t, err)
+	require.NotNil(t, injector)
+	assert.Equal(t, "istio-sidecar-injector-canary", injector.ConfigMap)
+	assert.Equal(t, "enabled", injector.Policy)
+	assert.Equal(t, []string{"sidecar"}, injector.DefaultTemplates)
+	assert.Equal(t, "docker.io/istio/proxyv2:1.26.2-distroless", injector.ProxyImage)
+	assert.Equal(t, "1.26.2", injector.Version)
+	assert.Equal(t, "100m", injector.ProxyResources.CpuRequest)
+	assert.Equal(t, "128Mi", injector.ProxyResources.MemoryRequest)
+	assert.Equal(t, "2000m", injector.ProxyResources.CpuLimit)
+	assert.Equal(t, "1024Mi", injector.ProxyResources.MemoryLimit)
+
+	require.Len(t, injector.Templates, 3)
+	assert.Equal(t, "custom-logging", injector.Templates[0].Name)
+	assert.True(t, injector.Templates[0].Custom)
+	assert.Equal(t, "gateway", injector.Templates[1].Name)
+	assert.False(t, injector.Templates[1].Custom)
+	assert.Equal(t, "sidecar", injector.Templates[2].Name)
+	assert.Len(t, injector.Templates[2].Checksum, 64)
+	assert.NotEqual(t, injector.Templates[0].Checksum, injector.Templates[2].Checksum)
+
+	// A revision without a ConfigMap has no injection config
+	injector, err = client.sidecarInjectorConfig(context.Background(), "istio-system", "stable")
+	require.NoError(t, err)
+	assert.Nil(t, injector)
+
+	_, err = client.sidecarInjectorConfig(context.Background(), "istio-system", "")
+	assert.ErrorContains(t, err, "failed to parse sidecar injector config istio-system/istio-sidecar-injector")
+}
+
+func TestInjectorValues_proxyImage(t *testing.T) {
+	var values injectorValues
+	values.Global.Hub = "gcr.io/istio-release"
+	values.Global.Tag = 1.26
+	values.Global.Proxy.Image = "proxyv2"
+	assert.Equal(t, "gcr.io/istio-release/proxyv2:1.26", values.proxyImage())
+
+	// A proxy image naming a repository is used as is
+	values.Global.Proxy.Image = "registry.example.com/mesh/proxyv2:1.26.2"
+	assert.Equal(t, "registry.example.com/mesh/proxyv2:1.26.2", values.proxyImage())
+}
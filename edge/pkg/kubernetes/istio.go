@@ -21,237 +21,264 @@ import (
 	"sync"
 
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"github.com/liamawhite/navigator/pkg/telemetry"
 	istioextensionsv1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
-	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
-	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	istiosecurityv1 "istio.io/client-go/pkg/apis/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // fetchDestinationRules fetches and converts all destination rules from the cluster
-func (k *Client) fetchDestinationRules(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.DestinationRule, errChan chan<- error) {
+func (k *Client) fetchDestinationRules(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.DestinationRule, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	drList, err := k.istioClient.NetworkingV1beta1().DestinationRules("").List(ctx, metav1.ListOptions{})
+	destinationRules, err := k.listDestinationRules(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list destination rules: %w", err)
 		return
 	}
 
 	var protoDestinationRules []*typesv1alpha1.DestinationRule
-	for i := range drList.Items {
-		dr := drList.Items[i]
+	for i := range destinationRules {
+		dr := destinationRules[i]
 		protoDR, convertErr := k.convertDestinationRule(dr)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert destination rule", "name", dr.Name, "namespace", dr.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("destination_rules")
 			continue
 		}
 		protoDestinationRules = append(protoDestinationRules, protoDR)
+		versions.observe(references.KindDestinationRule, dr, protoDR.RawConfig)
 	}
 	*result = protoDestinationRules
 }
 
 // fetchEnvoyFilters fetches and converts all envoy filters from the cluster
-func (k *Client) fetchEnvoyFilters(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.EnvoyFilter, errChan chan<- error) {
+func (k *Client) fetchEnvoyFilters(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.EnvoyFilter, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	efList, err := k.istioClient.NetworkingV1alpha3().EnvoyFilters("").List(ctx, metav1.ListOptions{})
+	envoyFilters, err := listAll[*istionetworkingv1alpha3.EnvoyFilter](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.istioClient.NetworkingV1alpha3().EnvoyFilters("").List(ctx, opts)
+	})
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list envoy filters: %w", err)
 		return
 	}
 
 	var protoEnvoyFilters []*typesv1alpha1.EnvoyFilter
-	for i := range efList.Items {
-		ef := efList.Items[i]
+	for _, ef := range envoyFilters {
 		protoEF, convertErr := k.convertEnvoyFilter(ef)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert envoy filter", "name", ef.Name, "namespace", ef.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("envoy_filters")
 			continue
 		}
 		protoEnvoyFilters = append(protoEnvoyFilters, protoEF)
+		versions.observe(references.KindEnvoyFilter, ef, protoEF.RawConfig)
 	}
 	*result = protoEnvoyFilters
 }
 
 // fetchRequestAuthentications fetches and converts all request authentications from the cluster
-func (k *Client) fetchRequestAuthentications(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.RequestAuthentication, errChan chan<- error) {
+func (k *Client) fetchRequestAuthentications(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.RequestAuthentication, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	raList, err := k.istioClient.SecurityV1beta1().RequestAuthentications("").List(ctx, metav1.ListOptions{})
+	requestAuthentications, err := k.listRequestAuthentications(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list request authentications: %w", err)
 		return
 	}
 
 	var protoRequestAuthentications []*typesv1alpha1.RequestAuthentication
-	for i := range raList.Items {
-		ra := raList.Items[i]
+	for i := range requestAuthentications {
+		ra := requestAuthentications[i]
 		protoRA, convertErr := k.convertRequestAuthentication(ra)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert request authentication", "name", ra.Name, "namespace", ra.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("request_authentications")
 			continue
 		}
 		protoRequestAuthentications = append(protoRequestAuthentications, protoRA)
+		versions.observe(references.KindRequestAuthentication, ra, protoRA.RawConfig)
 	}
 	*result = protoRequestAuthentications
 }
 
 // fetchPeerAuthentications fetches and converts all peer authentications from the cluster
-func (k *Client) fetchPeerAuthentications(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.PeerAuthentication, errChan chan<- error) {
+func (k *Client) fetchPeerAuthentications(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.PeerAuthentication, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	paList, err := k.istioClient.SecurityV1beta1().PeerAuthentications("").List(ctx, metav1.ListOptions{})
+	peerAuthentications, err := k.listPeerAuthentications(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list peer authentications: %w", err)
 		return
 	}
 
 	var protoPeerAuthentications []*typesv1alpha1.PeerAuthentication
-	for i := range paList.Items {
-		pa := paList.Items[i]
+	for i := range peerAuthentications {
+		pa := peerAuthentications[i]
 		protoPA, convertErr := k.convertPeerAuthentication(pa)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert peer authentication", "name", pa.Name, "namespace", pa.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("peer_authentications")
 			continue
 		}
 		protoPeerAuthentications = append(protoPeerAuthentications, protoPA)
+		versions.observe(references.KindPeerAuthentication, pa, protoPA.RawConfig)
 	}
 	*result = protoPeerAuthentications
 }
 
 // fetchAuthorizationPolicies fetches and converts all authorization policies from the cluster
-func (k *Client) fetchAuthorizationPolicies(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.AuthorizationPolicy, errChan chan<- error) {
+func (k *Client) fetchAuthorizationPolicies(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.AuthorizationPolicy, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	apList, err := k.istioClient.SecurityV1beta1().AuthorizationPolicies("").List(ctx, metav1.ListOptions{})
+	authorizationPolicies, err := k.listAuthorizationPolicies(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list authorization policies: %w", err)
 		return
 	}
 
 	var protoAuthorizationPolicies []*typesv1alpha1.AuthorizationPolicy
-	for i := range apList.Items {
-		ap := apList.Items[i]
+	for i := range authorizationPolicies {
+		ap := authorizationPolicies[i]
 		protoAP, convertErr := k.convertAuthorizationPolicy(ap)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert authorization policy", "name", ap.Name, "namespace", ap.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("authorization_policies")
 			continue
 		}
 		protoAuthorizationPolicies = append(protoAuthorizationPolicies, protoAP)
+		versions.observe(references.KindAuthorizationPolicy, ap, protoAP.RawConfig)
 	}
 	*result = protoAuthorizationPolicies
 }
 
 // fetchWasmPlugins fetches and converts all wasm plugins from the cluster
-func (k *Client) fetchWasmPlugins(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.WasmPlugin, errChan chan<- error) {
+func (k *Client) fetchWasmPlugins(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.WasmPlugin, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	wpList, err := k.istioClient.ExtensionsV1alpha1().WasmPlugins("").List(ctx, metav1.ListOptions{})
+	wasmPlugins, err := listAll[*istioextensionsv1alpha1.WasmPlugin](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.istioClient.ExtensionsV1alpha1().WasmPlugins("").List(ctx, opts)
+	})
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list wasm plugins: %w", err)
 		return
 	}
 
 	var protoWasmPlugins []*typesv1alpha1.WasmPlugin
-	for i := range wpList.Items {
-		wp := wpList.Items[i]
+	for _, wp := range wasmPlugins {
 		protoWP, convertErr := k.convertWasmPlugin(wp)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert wasm plugin", "name", wp.Name, "namespace", wp.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("wasm_plugins")
 			continue
 		}
 		protoWasmPlugins = append(protoWasmPlugins, protoWP)
+		versions.observe(references.KindWasmPlugin, wp, protoWP.RawConfig)
 	}
 	*result = protoWasmPlugins
 }
 
 // fetchGateways fetches and converts all gateways from the cluster
-func (k *Client) fetchGateways(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.Gateway, errChan chan<- error) {
+func (k *Client) fetchGateways(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.Gateway, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	gwList, err := k.istioClient.NetworkingV1beta1().Gateways("").List(ctx, metav1.ListOptions{})
+	gateways, err := k.listGateways(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list gateways: %w", err)
 		return
 	}
 
 	var protoGateways []*typesv1alpha1.Gateway
-	for i := range gwList.Items {
-		gw := gwList.Items[i]
+	for i := range gateways {
+		gw := gateways[i]
 		protoGW, convertErr := k.convertGateway(gw)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert gateway", "name", gw.Name, "namespace", gw.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("gateways")
 			continue
 		}
 		protoGateways = append(protoGateways, protoGW)
+		versions.observe(references.KindGateway, gw, protoGW.RawConfig)
 	}
 	*result = protoGateways
 }
 
 // fetchSidecars fetches and converts all sidecars from the cluster
-func (k *Client) fetchSidecars(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.Sidecar, errChan chan<- error) {
+func (k *Client) fetchSidecars(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.Sidecar, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	scList, err := k.istioClient.NetworkingV1beta1().Sidecars("").List(ctx, metav1.ListOptions{})
+	sidecars, err := k.listSidecars(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list sidecars: %w", err)
 		return
 	}
 
 	var protoSidecars []*typesv1alpha1.Sidecar
-	for i := range scList.Items {
-		sc := scList.Items[i]
+	for i := range sidecars {
+		sc := sidecars[i]
 		protoSC, convertErr := k.convertSidecar(sc)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert sidecar", "name", sc.Name, "namespace", sc.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("sidecars")
 			continue
 		}
 		protoSidecars = append(protoSidecars, protoSC)
+		versions.observe(references.KindSidecar, sc, protoSC.RawConfig)
 	}
 	*result = protoSidecars
 }
 
 // fetchVirtualServices fetches and converts all virtual services from the cluster
-func (k *Client) fetchVirtualServices(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.VirtualService, errChan chan<- error) {
+func (k *Client) fetchVirtualServices(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.VirtualService, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	vsList, err := k.istioClient.NetworkingV1beta1().VirtualServices("").List(ctx, metav1.ListOptions{})
+	virtualServices, err := k.listVirtualServices(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list virtual services: %w", err)
 		return
 	}
 
 	var protoVirtualServices []*typesv1alpha1.VirtualService
-	for i := range vsList.Items {
-		vs := vsList.Items[i]
+	for i := range virtualServices {
+		vs := virtualServices[i]
 		protoVS, convertErr := k.convertVirtualService(vs)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert virtual service", "name", vs.Name, "namespace", vs.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("virtual_services")
 			continue
 		}
 		protoVirtualServices = append(protoVirtualServices, protoVS)
+		versions.observe(references.KindVirtualService, vs, protoVS.RawConfig)
 	}
 	*result = protoVirtualServices
 }
 
 // fetchServiceEntries fetches and converts all service entries from the cluster
-func (k *Client) fetchServiceEntries(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.ServiceEntry, errChan chan<- error) {
+func (k *Client) fetchServiceEntries(ctx context.Context, wg *sync.WaitGroup, result *[]*typesv1alpha1.ServiceEntry, versions *resourceVersions, errChan chan<- error) {
 	defer wg.Done()
-	seList, err := k.istioClient.NetworkingV1beta1().ServiceEntries("").List(ctx, metav1.ListOptions{})
+	serviceEntries, err := k.listServiceEntries(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list service entries: %w", err)
 		return
 	}
 
 	var protoServiceEntries []*typesv1alpha1.ServiceEntry
-	for i := range seList.Items {
-		se := seList.Items[i]
+	for i := range serviceEntries {
+		se := serviceEntries[i]
 		protoSE, convertErr := k.convertServiceEntry(se)
 		if convertErr != nil {
 			k.logger.Warn("failed to convert service entry", "name", se.Name, "namespace", se.Namespace, "error", convertErr)
+			telemetry.RecordConversionError("service_entries")
 			continue
 		}
 		protoServiceEntries = append(protoServiceEntries, protoSE)
+		versions.observe(references.KindServiceEntry, se, protoSE.RawConfig)
 	}
 	*result = protoServiceEntries
 }
 
 // convertDestinationRule converts an Istio DestinationRule to a protobuf DestinationRule
-func (k *Client) convertDestinationRule(dr *istionetworkingv1beta1.DestinationRule) (*typesv1alpha1.DestinationRule, error) {
-	resourceBytes, err := json.Marshal(dr)
+func (k *Client) convertDestinationRule(dr *istionetworkingv1.DestinationRule) (*typesv1alpha1.DestinationRule, error) {
+	resourceBytes, err := marshalRawConfig(dr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal destination rule resource: %w", err)
 	}
@@ -305,12 +332,13 @@ func (k *Client) convertDestinationRule(dr *istionetworkingv1beta1.DestinationRu
 		Subsets:          subsets,
 		ExportTo:         exportTo,
 		WorkloadSelector: workloadSelector,
+		ApiVersion:       authoredAPIVersion(dr),
 	}, nil
 }
 
 // convertEnvoyFilter converts an Istio EnvoyFilter to a protobuf EnvoyFilter
 func (k *Client) convertEnvoyFilter(ef *istionetworkingv1alpha3.EnvoyFilter) (*typesv1alpha1.EnvoyFilter, error) {
-	resourceBytes, err := json.Marshal(ef)
+	resourceBytes, err := marshalRawConfig(ef)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal envoy filter resource: %w", err)
 	}
@@ -347,12 +375,13 @@ func (k *Client) convertEnvoyFilter(ef *istionetworkingv1alpha3.EnvoyFilter) (*t
 		RawConfig:        string(resourceBytes),
 		WorkloadSelector: workloadSelector,
 		TargetRefs:       targetRefs,
+		ApiVersion:       authoredAPIVersion(ef),
 	}, nil
 }
 
 // convertRequestAuthentication converts an Istio RequestAuthentication to a protobuf RequestAuthentication
-func (k *Client) convertRequestAuthentication(ra *istiosecurityv1beta1.RequestAuthentication) (*typesv1alpha1.RequestAuthentication, error) {
-	resourceBytes, err := json.Marshal(ra)
+func (k *Client) convertRequestAuthentication(ra *istiosecurityv1.RequestAuthentication) (*typesv1alpha1.RequestAuthentication, error) {
+	resourceBytes, err := marshalRawConfig(ra)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request authentication resource: %w", err)
 	}
@@ -389,12 +418,13 @@ func (k *Client) convertRequestAuthentication(ra *istiosecurityv1beta1.RequestAu
 		RawConfig:  string(resourceBytes),
 		Selector:   selector,
 		TargetRefs: targetRefs,
+		ApiVersion: authoredAPIVersion(ra),
 	}, nil
 }
 
 // convertPeerAuthentication converts an Istio PeerAuthentication to a protobuf PeerAuthentication
-func (k *Client) convertPeerAuthentication(pa *istiosecurityv1beta1.PeerAuthentication) (*typesv1alpha1.PeerAuthentication, error) {
-	resourceBytes, err := json.Marshal(pa)
+func (k *Client) convertPeerAuthentication(pa *istiosecurityv1.PeerAuthentication) (*typesv1alpha1.PeerAuthentication, error) {
+	resourceBytes, err := marshalRawConfig(pa)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal peer authentication resource: %w", err)
 	}
@@ -412,16 +442,17 @@ func (k *Client) convertPeerAuthentication(pa *istiosecurityv1beta1.PeerAuthenti
 	}
 
 	return &typesv1alpha1.PeerAuthentication{
-		Name:      pa.Name,
-		Namespace: pa.Namespace,
-		RawConfig: string(resourceBytes),
-		Selector:  selector,
+		Name:       pa.Name,
+		Namespace:  pa.Namespace,
+		RawConfig:  string(resourceBytes),
+		Selector:   selector,
+		ApiVersion: authoredAPIVersion(pa),
 	}, nil
 }
 
 // convertAuthorizationPolicy converts an Istio AuthorizationPolicy to a protobuf AuthorizationPolicy
-func (k *Client) convertAuthorizationPolicy(ap *istiosecurityv1beta1.AuthorizationPolicy) (*typesv1alpha1.AuthorizationPolicy, error) {
-	resourceBytes, err := json.Marshal(ap)
+func (k *Client) convertAuthorizationPolicy(ap *istiosecurityv1.AuthorizationPolicy) (*typesv1alpha1.AuthorizationPolicy, error) {
+	resourceBytes, err := marshalRawConfig(ap)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal authorization policy resource: %w", err)
 	}
@@ -471,12 +502,13 @@ func (k *Client) convertAuthorizationPolicy(ap *istiosecurityv1beta1.Authorizati
 		RawConfig:  string(resourceBytes),
 		Selector:   selector,
 		TargetRefs: targetRefs,
+		ApiVersion: authoredAPIVersion(ap),
 	}, nil
 }
 
 // convertWasmPlugin converts an Istio WasmPlugin to a protobuf WasmPlugin
 func (k *Client) convertWasmPlugin(wp *istioextensionsv1alpha1.WasmPlugin) (*typesv1alpha1.WasmPlugin, error) {
-	resourceBytes, err := json.Marshal(wp)
+	resourceBytes, err := marshalRawConfig(wp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal wasm plugin resource: %w", err)
 	}
@@ -513,12 +545,13 @@ func (k *Client) convertWasmPlugin(wp *istioextensionsv1alpha1.WasmPlugin) (*typ
 		RawConfig:  string(resourceBytes),
 		Selector:   selector,
 		TargetRefs: targetRefs,
+		ApiVersion: authoredAPIVersion(wp),
 	}, nil
 }
 
 // convertGateway converts an Istio Gateway to a protobuf Gateway
-func (k *Client) convertGateway(gw *istionetworkingv1beta1.Gateway) (*typesv1alpha1.Gateway, error) {
-	resourceBytes, err := json.Marshal(gw)
+func (k *Client) convertGateway(gw *istionetworkingv1.Gateway) (*typesv1alpha1.Gateway, error) {
+	resourceBytes, err := marshalRawConfig(gw)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal gateway resource: %w", err)
 	}
@@ -532,16 +565,17 @@ func (k *Client) convertGateway(gw *istionetworkingv1beta1.Gateway) (*typesv1alp
 	}
 
 	return &typesv1alpha1.Gateway{
-		Name:      gw.Name,
-		Namespace: gw.Namespace,
-		RawConfig: string(resourceBytes),
-		Selector:  selector,
+		Name:       gw.Name,
+		Namespace:  gw.Namespace,
+		RawConfig:  string(resourceBytes),
+		Selector:   selector,
+		ApiVersion: authoredAPIVersion(gw),
 	}, nil
 }
 
 // convertSidecar converts an Istio Sidecar to a protobuf Sidecar
-func (k *Client) convertSidecar(sc *istionetworkingv1beta1.Sidecar) (*typesv1alpha1.Sidecar, error) {
-	resourceBytes, err := json.Marshal(sc)
+func (k *Client) convertSidecar(sc *istionetworkingv1.Sidecar) (*typesv1alpha1.Sidecar, error) {
+	resourceBytes, err := marshalRawConfig(sc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal sidecar resource: %w", err)
 	}
@@ -563,12 +597,13 @@ func (k *Client) convertSidecar(sc *istionetworkingv1beta1.Sidecar) (*typesv1alp
 		Namespace:        sc.Namespace,
 		RawConfig:        string(resourceBytes),
 		WorkloadSelector: workloadSelector,
+		ApiVersion:       authoredAPIVersion(sc),
 	}, nil
 }
 
 // convertVirtualService converts an Istio VirtualService to a protobuf VirtualService
-func (k *Client) convertVirtualService(vs *istionetworkingv1beta1.VirtualService) (*typesv1alpha1.VirtualService, error) {
-	resourceBytes, err := json.Marshal(vs)
+func (k *Client) convertVirtualService(vs *istionetworkingv1.VirtualService) (*typesv1alpha1.VirtualService, error) {
+	resourceBytes, err := marshalRawConfig(vs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal virtual service resource: %w", err)
 	}
@@ -596,12 +631,13 @@ func (k *Client) convertVirtualService(vs *istionetworkingv1beta1.VirtualService
 	}
 
 	return &typesv1alpha1.VirtualService{
-		Name:      vs.Name,
-		Namespace: vs.Namespace,
-		RawConfig: string(resourceBytes),
-		Hosts:     hosts,
-		Gateways:  gateways,
-		ExportTo:  exportTo,
+		Name:       vs.Name,
+		Namespace:  vs.Namespace,
+		RawConfig:  string(resourceBytes),
+		Hosts:      hosts,
+		Gateways:   gateways,
+		ExportTo:   exportTo,
+		ApiVersion: authoredAPIVersion(vs),
 	}, nil
 }
 
@@ -633,6 +669,14 @@ func (k *Client) fetchIstioControlPlaneConfig(ctx context.Context, wg *sync.Wait
 
 	// Extract configuration from the active deployment
 	k.extractPilotConfiguration(activeDeployment, config)
+	k.describeControlPlane(ctx, activeDeployment, config)
+
+	// The injection config is reported for visibility only, so failing to read it does not fail the sync
+	injector, err := k.sidecarInjectorConfig(ctx, activeDeployment.Namespace, config.Revision)
+	if err != nil {
+		k.logger.Warn("failed to read sidecar injector config", "namespace", activeDeployment.Namespace, "revision", config.Revision, "error", err)
+	}
+	config.SidecarInjector = injector
 
 	*result = config
 }
@@ -649,9 +693,11 @@ func (k *Client) discoverIstioControlPlane(ctx context.Context) (string, *appsv1
 	}
 
 	// Also check all namespaces for istiod deployments (for custom installations)
-	allNamespaces, err := k.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	allNamespaces, err := listAll[*corev1.Namespace](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.clientset.CoreV1().Namespaces().List(ctx, opts)
+	})
 	if err == nil {
-		for _, ns := range allNamespaces.Items {
+		for _, ns := range allNamespaces {
 			// Add any namespace that looks like it could contain Istio control plane
 			if ns.Name != "istio-system" &&
 				ns.Name != "istio-control-plane" &&
@@ -668,19 +714,23 @@ func (k *Client) discoverIstioControlPlane(ctx context.Context) (string, *appsv1
 
 	// Search each namespace for istiod deployments
 	for _, namespace := range candidateNamespaces {
-		deployments, err := k.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
-			LabelSelector: "app=istiod",
+		var deployments []appsv1.Deployment
+		err := listInPages(ctx, metav1.ListOptions{LabelSelector: "app=istiod"}, func(opts metav1.ListOptions) (runtime.Object, error) {
+			return k.clientset.AppsV1().Deployments(namespace).List(ctx, opts)
+		}, func(obj runtime.Object) error {
+			deployments = append(deployments, *obj.(*appsv1.Deployment))
+			return nil
 		})
 		if err != nil {
 			continue
 		}
 
-		if len(deployments.Items) == 0 {
+		if len(deployments) == 0 {
 			continue
 		}
 
 		// Select the best deployment from this namespace
-		activeDeployment := k.selectActiveControlPlane(deployments.Items)
+		activeDeployment := k.selectActiveControlPlane(deployments)
 		if activeDeployment == nil {
 			continue
 		}
@@ -775,8 +825,8 @@ func (k *Client) extractPilotConfiguration(deployment *appsv1.Deployment, config
 }
 
 // convertServiceEntry converts an Istio ServiceEntry to a protobuf ServiceEntry
-func (k *Client) convertServiceEntry(se *istionetworkingv1beta1.ServiceEntry) (*typesv1alpha1.ServiceEntry, error) {
-	resourceBytes, err := json.Marshal(se)
+func (k *Client) convertServiceEntry(se *istionetworkingv1.ServiceEntry) (*typesv1alpha1.ServiceEntry, error) {
+	resourceBytes, err := marshalRawConfig(se)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal service entry resource: %w", err)
 	}
@@ -790,9 +840,73 @@ func (k *Client) convertServiceEntry(se *istionetworkingv1beta1.ServiceEntry) (*
 	}
 
 	return &typesv1alpha1.ServiceEntry{
-		Name:      se.Name,
-		Namespace: se.Namespace,
-		RawConfig: string(resourceBytes),
-		ExportTo:  exportTo,
+		Name:       se.Name,
+		Namespace:  se.Namespace,
+		RawConfig:  string(resourceBytes),
+		ExportTo:   exportTo,
+		ApiVersion: authoredAPIVersion(se),
+		Hosts:      se.Spec.Hosts,
 	}, nil
 }
+
+// authoredAPIVersion returns the apiVersion a resource was last written with. Resources are read at a
+// fixed version, so the version clients use is taken from the most recent managed fields entry for the
+// main resource, falling back to the last-applied-configuration annotation. It is empty if unknown.
+func authoredAPIVersion(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+
+	if latest := latestManagedFieldsEntry(accessor); latest != nil {
+		return latest.APIVersion
+	}
+
+	var lastApplied struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal([]byte(accessor.GetAnnotations()[corev1.LastAppliedConfigAnnotation]), &lastApplied); err == nil {
+		return lastApplied.APIVersion
+	}
+	return ""
+}
+
+// latestManagedFieldsEntry returns the most recent managed fields entry for the main resource, or nil if
+// the resource has none
+func latestManagedFieldsEntry(accessor metav1.Object) *metav1.ManagedFieldsEntry {
+	var latest *metav1.ManagedFieldsEntry
+	managedFields := accessor.GetManagedFields()
+	for i := range managedFields {
+		entry := &managedFields[i]
+		if entry.Subresource != "" || entry.APIVersion == "" {
+			continue
+		}
+		// Entries without a timestamp rank below any with one
+		if latest == nil || (entry.Time != nil && (latest.Time == nil || !entry.Time.Before(latest.Time))) {
+			latest = entry
+		}
+	}
+	return latest
+}
+
+// marshalRawConfig marshals an Istio resource for RawConfig without server-side bookkeeping that
+// bloats payloads and changes on every write: managed fields, the last-applied-configuration
+// annotation and the resource version. The resource itself is not modified.
+func marshalRawConfig(obj runtime.Object) ([]byte, error) {
+	obj = obj.DeepCopyObject()
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	accessor.SetManagedFields(nil)
+	accessor.SetResourceVersion("")
+	if annotations := accessor.GetAnnotations(); annotations != nil {
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			accessor.SetAnnotations(nil)
+		}
+	}
+
+	return json.Marshal(obj)
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"sync"
 	"testing"
+	"time"
 
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
@@ -29,8 +30,8 @@ import (
 	securityapi "istio.io/api/security/v1beta1"
 	istiotype "istio.io/api/type/v1beta1"
 	istioextensionsv1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
-	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
-	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istiosecurityv1 "istio.io/client-go/pkg/apis/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,7 +43,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 
 	tests := []struct {
 		name                 string
-		destinationRule      *istionetworkingv1beta1.DestinationRule
+		destinationRule      *istionetworkingv1.DestinationRule
 		wantHost             string
 		wantSubsets          []*typesv1alpha1.DestinationRuleSubset
 		wantExportTo         []string
@@ -50,7 +51,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 	}{
 		{
 			name: "all fields specified",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-full",
 					Namespace: "default",
@@ -85,7 +86,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 		},
 		{
 			name: "host only - defaults for exportTo",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-host-only",
 					Namespace: "default",
@@ -101,7 +102,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 		},
 		{
 			name: "empty exportTo should get default",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-empty-export",
 					Namespace: "istio-system",
@@ -118,7 +119,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 		},
 		{
 			name: "subsets without labels",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-empty-labels",
 					Namespace: "default",
@@ -148,7 +149,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 		},
 		{
 			name: "workload selector without labels",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-empty-selector",
 					Namespace: "default",
@@ -167,7 +168,7 @@ func TestClient_convertDestinationRule(t *testing.T) {
 		},
 		{
 			name: "empty host should be preserved",
-			destinationRule: &istionetworkingv1beta1.DestinationRule{
+			destinationRule: &istionetworkingv1.DestinationRule{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-dr-no-host",
 					Namespace: "default",
@@ -219,13 +220,13 @@ func TestClient_convertGateway(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		gateway      *istionetworkingv1beta1.Gateway
+		gateway      *istionetworkingv1.Gateway
 		wantName     string
 		wantSelector map[string]string
 	}{
 		{
 			name: "gateway with selector",
-			gateway: &istionetworkingv1beta1.Gateway{
+			gateway: &istionetworkingv1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-gateway",
 					Namespace: "default",
@@ -255,7 +256,7 @@ func TestClient_convertGateway(t *testing.T) {
 		},
 		{
 			name: "gateway without selector",
-			gateway: &istionetworkingv1beta1.Gateway{
+			gateway: &istionetworkingv1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-gateway-no-selector",
 					Namespace: "default",
@@ -278,7 +279,7 @@ func TestClient_convertGateway(t *testing.T) {
 		},
 		{
 			name: "gateway with empty selector",
-			gateway: &istionetworkingv1beta1.Gateway{
+			gateway: &istionetworkingv1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-gateway-empty-selector",
 					Namespace: "default",
@@ -477,14 +478,14 @@ func TestClient_convertVirtualService(t *testing.T) {
 
 	tests := []struct {
 		name           string
-		virtualService *istionetworkingv1beta1.VirtualService
+		virtualService *istionetworkingv1.VirtualService
 		wantHosts      []string
 		wantGateways   []string
 		wantExportTo   []string
 	}{
 		{
 			name: "all fields specified",
-			virtualService: &istionetworkingv1beta1.VirtualService{
+			virtualService: &istionetworkingv1.VirtualService{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-vs",
 					Namespace: "default",
@@ -501,7 +502,7 @@ func TestClient_convertVirtualService(t *testing.T) {
 		},
 		{
 			name: "hosts only - defaults for gateways and exportTo",
-			virtualService: &istionetworkingv1beta1.VirtualService{
+			virtualService: &istionetworkingv1.VirtualService{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-vs-hosts-only",
 					Namespace: "default",
@@ -516,7 +517,7 @@ func TestClient_convertVirtualService(t *testing.T) {
 		},
 		{
 			name: "empty slices should get defaults",
-			virtualService: &istionetworkingv1beta1.VirtualService{
+			virtualService: &istionetworkingv1.VirtualService{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-vs-empty",
 					Namespace: "default",
@@ -533,7 +534,7 @@ func TestClient_convertVirtualService(t *testing.T) {
 		},
 		{
 			name: "nil slices should get defaults",
-			virtualService: &istionetworkingv1beta1.VirtualService{
+			virtualService: &istionetworkingv1.VirtualService{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-vs-nil",
 					Namespace: "default",
@@ -549,7 +550,7 @@ func TestClient_convertVirtualService(t *testing.T) {
 		},
 		{
 			name: "custom gateways with default exportTo",
-			virtualService: &istionetworkingv1beta1.VirtualService{
+			virtualService: &istionetworkingv1.VirtualService{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-vs-custom-gw",
 					Namespace: "istio-system",
@@ -586,14 +587,14 @@ func TestClient_convertSidecar(t *testing.T) {
 
 	tests := []struct {
 		name                 string
-		sidecar              *istionetworkingv1beta1.Sidecar
+		sidecar              *istionetworkingv1.Sidecar
 		wantName             string
 		wantNamespace        string
 		wantWorkloadSelector *typesv1alpha1.WorkloadSelector
 	}{
 		{
 			name: "sidecar with workload selector",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar",
 					Namespace: "production",
@@ -630,7 +631,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "sidecar without workload selector",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar-no-selector",
 					Namespace: "default",
@@ -649,7 +650,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "sidecar with nil workload selector",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar-nil-selector",
 					Namespace: "istio-system",
@@ -669,7 +670,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "sidecar with empty workload selector labels",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar-empty-labels",
 					Namespace: "test",
@@ -697,7 +698,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "sidecar with nil workload selector labels",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar-nil-labels",
 					Namespace: "default",
@@ -719,7 +720,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "sidecar with single label",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-sidecar-single-label",
 					Namespace: "bookinfo",
@@ -742,7 +743,7 @@ func TestClient_convertSidecar(t *testing.T) {
 		},
 		{
 			name: "minimal sidecar configuration",
-			sidecar: &istionetworkingv1beta1.Sidecar{
+			sidecar: &istionetworkingv1.Sidecar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "minimal-sidecar",
 					Namespace: "minimal",
@@ -780,14 +781,14 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 
 	tests := []struct {
 		name                 string
-		peerAuthentication   *istiosecurityv1beta1.PeerAuthentication
+		peerAuthentication   *istiosecurityv1.PeerAuthentication
 		wantName             string
 		wantNamespace        string
 		wantWorkloadSelector *typesv1alpha1.WorkloadSelector
 	}{
 		{
 			name: "peer authentication with workload selector",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-peer-auth",
 					Namespace: "production",
@@ -817,7 +818,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication without workload selector",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "default-peer-auth",
 					Namespace: "default",
@@ -834,7 +835,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with nil workload selector",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "nil-selector-peer-auth",
 					Namespace: "istio-system",
@@ -852,7 +853,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with empty workload selector labels",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "empty-labels-peer-auth",
 					Namespace: "test",
@@ -874,7 +875,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with nil workload selector labels",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "nil-labels-peer-auth",
 					Namespace: "default",
@@ -894,7 +895,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with single label",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "single-label-peer-auth",
 					Namespace: "bookinfo",
@@ -920,7 +921,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "minimal peer authentication configuration",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "minimal-peer-auth",
 					Namespace: "minimal",
@@ -935,7 +936,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with port-specific mTLS",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "port-specific-peer-auth",
 					Namespace: "secure",
@@ -967,7 +968,7 @@ func TestClient_convertPeerAuthentication(t *testing.T) {
 		},
 		{
 			name: "peer authentication with complex selector",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "complex-selector-peer-auth",
 					Namespace: "enterprise",
@@ -1024,12 +1025,12 @@ func TestClient_convertPeerAuthentication_ErrorCases(t *testing.T) {
 
 	tests := []struct {
 		name               string
-		peerAuthentication *istiosecurityv1beta1.PeerAuthentication
+		peerAuthentication *istiosecurityv1.PeerAuthentication
 		expectError        bool
 	}{
 		{
 			name: "valid peer authentication should not error",
-			peerAuthentication: &istiosecurityv1beta1.PeerAuthentication{
+			peerAuthentication: &istiosecurityv1.PeerAuthentication{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "valid-peer-auth",
 					Namespace: "default",
@@ -1342,13 +1343,13 @@ func TestClient_convertServiceEntry(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		serviceEntry *istionetworkingv1beta1.ServiceEntry
+		serviceEntry *istionetworkingv1.ServiceEntry
 		wantName     string
 		wantExportTo []string
 	}{
 		{
 			name: "service entry with all fields",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "httpbin",
 					Namespace: "default",
@@ -1372,7 +1373,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 		},
 		{
 			name: "service entry with empty exportTo defaults to global",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "database",
 					Namespace: "production",
@@ -1389,7 +1390,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 		},
 		{
 			name: "service entry with nil exportTo defaults to global",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "api-gateway",
 					Namespace: "istio-system",
@@ -1406,7 +1407,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 		},
 		{
 			name: "service entry with wildcard export",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "external-service",
 					Namespace: "services",
@@ -1423,7 +1424,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 		},
 		{
 			name: "service entry with dot export (same namespace only)",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "local-service",
 					Namespace: "team-a",
@@ -1440,7 +1441,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 		},
 		{
 			name: "service entry with multiple specific namespaces",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "shared-cache",
 					Namespace: "infrastructure",
@@ -1465,6 +1466,7 @@ func TestClient_convertServiceEntry(t *testing.T) {
 			assert.Equal(t, tt.wantName, result.Name)
 			assert.Equal(t, tt.serviceEntry.Namespace, result.Namespace)
 			assert.Equal(t, tt.wantExportTo, result.ExportTo)
+			assert.Equal(t, tt.serviceEntry.Spec.Hosts, result.Hosts)
 			assert.NotEmpty(t, result.RawConfig)
 
 			// Verify RawConfig contains valid JSON
@@ -1480,12 +1482,12 @@ func TestClient_convertServiceEntry_ErrorCases(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		serviceEntry *istionetworkingv1beta1.ServiceEntry
+		serviceEntry *istionetworkingv1.ServiceEntry
 		expectError  bool
 	}{
 		{
 			name: "valid service entry should not error",
-			serviceEntry: &istionetworkingv1beta1.ServiceEntry{
+			serviceEntry: &istionetworkingv1.ServiceEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "valid-service-entry",
 					Namespace: "default",
@@ -1523,7 +1525,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 
 	tests := []struct {
 		name                 string
-		authorizationPolicy  *istiosecurityv1beta1.AuthorizationPolicy
+		authorizationPolicy  *istiosecurityv1.AuthorizationPolicy
 		wantName             string
 		wantNamespace        string
 		wantWorkloadSelector *typesv1alpha1.WorkloadSelector
@@ -1531,7 +1533,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 	}{
 		{
 			name: "authorization policy with no selector",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-authz-policy",
 					Namespace: "default",
@@ -1557,7 +1559,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 		},
 		{
 			name: "authorization policy with workload selector",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "selector-authz-policy",
 					Namespace: "production",
@@ -1595,7 +1597,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 		},
 		{
 			name: "authorization policy with target references",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "targetref-authz-policy",
 					Namespace: "test-ns",
@@ -1633,7 +1635,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 		},
 		{
 			name: "authorization policy with multiple target references",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "multi-targetref-authz-policy",
 					Namespace: "multi-ns",
@@ -1683,7 +1685,7 @@ func TestClient_convertAuthorizationPolicy(t *testing.T) {
 		},
 		{
 			name: "authorization policy with both selector and target refs (single targetRef)",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "both-selector-targetref",
 					Namespace: "combined-ns",
@@ -1753,12 +1755,12 @@ func TestClient_convertAuthorizationPolicy_ErrorCases(t *testing.T) {
 
 	tests := []struct {
 		name                string
-		authorizationPolicy *istiosecurityv1beta1.AuthorizationPolicy
+		authorizationPolicy *istiosecurityv1.AuthorizationPolicy
 		expectError         bool
 	}{
 		{
 			name: "valid authorization policy should not error",
-			authorizationPolicy: &istiosecurityv1beta1.AuthorizationPolicy{
+			authorizationPolicy: &istiosecurityv1.AuthorizationPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "valid-authz-policy",
 					Namespace: "default",
@@ -1787,3 +1789,111 @@ func TestClient_convertAuthorizationPolicy_ErrorCases(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalRawConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		wantAnnotations map[string]interface{}
+	}{
+		{
+			name: "keeps user annotations",
+			annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: `{"kind":"Sidecar"}`,
+				"owner":                            "team-a",
+			},
+			wantAnnotations: map[string]interface{}{"owner": "team-a"},
+		},
+		{
+			name:        "drops annotations left empty",
+			annotations: map[string]string{corev1.LastAppliedConfigAnnotation: `{"kind":"Sidecar"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecar := &istionetworkingv1.Sidecar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "default",
+					Namespace:       "test-namespace",
+					ResourceVersion: "12345",
+					Annotations:     tt.annotations,
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+					},
+				},
+			}
+
+			data, err := marshalRawConfig(sidecar)
+			require.NoError(t, err)
+
+			var resource map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &resource))
+			metadata := resource["metadata"].(map[string]interface{})
+			assert.Equal(t, "default", metadata["name"])
+			assert.NotContains(t, metadata, "managedFields")
+			assert.NotContains(t, metadata, "resourceVersion")
+			if tt.wantAnnotations == nil {
+				assert.NotContains(t, metadata, "annotations")
+			} else {
+				assert.Equal(t, tt.wantAnnotations, metadata["annotations"])
+			}
+
+			// The original resource is left untouched
+			assert.Equal(t, "12345", sidecar.ResourceVersion)
+			assert.Len(t, sidecar.ManagedFields, 1)
+			assert.Contains(t, sidecar.Annotations, corev1.LastAppliedConfigAnnotation)
+		})
+	}
+}
+
+func TestAuthoredAPIVersion(t *testing.T) {
+	older := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name          string
+		managedFields []metav1.ManagedFieldsEntry
+		annotations   map[string]string
+		want          string
+	}{
+		{
+			name: "most recent managed fields entry",
+			managedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "helm", APIVersion: "networking.istio.io/v1", Time: &newer},
+				{Manager: "kubectl", APIVersion: "networking.istio.io/v1alpha3", Time: &older},
+			},
+			want: "networking.istio.io/v1",
+		},
+		{
+			name: "ignores status subresource",
+			managedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl", APIVersion: "networking.istio.io/v1alpha3", Time: &older},
+				{Manager: "pilot", APIVersion: "networking.istio.io/v1", Time: &newer, Subresource: "status"},
+			},
+			want: "networking.istio.io/v1alpha3",
+		},
+		{
+			name:        "last applied configuration",
+			annotations: map[string]string{corev1.LastAppliedConfigAnnotation: `{"apiVersion":"networking.istio.io/v1beta1","kind":"Sidecar"}`},
+			want:        "networking.istio.io/v1beta1",
+		},
+		{
+			name: "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecar := &istionetworkingv1.Sidecar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:          "default",
+					Namespace:     "test-namespace",
+					Annotations:   tt.annotations,
+					ManagedFields: tt.managedFields,
+				},
+			}
+			assert.Equal(t, tt.want, authoredAPIVersion(sidecar))
+		})
+	}
+}
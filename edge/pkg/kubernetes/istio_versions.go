@@ -0,0 +1,338 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	istiosecurityv1 "istio.io/client-go/pkg/apis/security/v1"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Istio serves networking.istio.io/v1 and security.istio.io/v1 from 1.22 onwards. Older
+// control planes only serve v1beta1, so resources are listed via v1 and fall back to v1beta1
+// when the cluster does not serve it. The spec schemas are identical across these versions,
+// so legacy objects are adapted to the v1 types without losing any fields.
+
+// listPreferringV1 lists resources via the v1 API, falling back to the legacy API when v1 is not served.
+// Resource types found to be served only at the legacy version are listed via it directly on later calls,
+// until an upgraded control plane stops serving it.
+func listPreferringV1[T any](k *Client, group, resource string, listV1, listLegacy func() ([]*T, error)) ([]*T, error) {
+	key := resourceKey(group, resource)
+	if k.listedViaLegacy(key) {
+		items, err := listLegacy()
+		if err == nil || !isVersionNotServed(err) {
+			return items, err
+		}
+		k.setListedViaLegacy(key, false)
+	}
+
+	items, err := listV1()
+	if err != nil && isVersionNotServed(err) {
+		items, err = listLegacy()
+		if err == nil {
+			k.setListedViaLegacy(key, true)
+		}
+	}
+	return items, err
+}
+
+// listedViaLegacy reports whether a resource type was last listed via its legacy version
+func (k *Client) listedViaLegacy(key string) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.legacyOnly[key]
+}
+
+// setListedViaLegacy records whether a resource type is listed via its legacy version
+func (k *Client) setListedViaLegacy(key string, legacy bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if !legacy {
+		delete(k.legacyOnly, key)
+		return
+	}
+	if k.legacyOnly == nil {
+		k.legacyOnly = make(map[string]bool)
+	}
+	k.legacyOnly[key] = true
+}
+
+// isVersionNotServed reports whether an error means the API server does not serve the requested group version
+func isVersionNotServed(err error) bool {
+	return apierrors.IsNotFound(err) || meta.IsNoMatchError(err)
+}
+
+// listDestinationRules lists all destination rules in the cluster
+func (k *Client) listDestinationRules(ctx context.Context) ([]*istionetworkingv1.DestinationRule, error) {
+	return listPreferringV1(k, "networking.istio.io", "destinationrules",
+		func() ([]*istionetworkingv1.DestinationRule, error) {
+			return listAll[*istionetworkingv1.DestinationRule](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1().DestinationRules("").List(ctx, opts)
+			})
+		},
+		func() ([]*istionetworkingv1.DestinationRule, error) {
+			legacyItems, err := listAll[*istionetworkingv1beta1.DestinationRule](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1beta1().DestinationRules("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istionetworkingv1.DestinationRule, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = destinationRuleFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listGateways lists all gateways in the cluster
+func (k *Client) listGateways(ctx context.Context) ([]*istionetworkingv1.Gateway, error) {
+	return listPreferringV1(k, "networking.istio.io", "gateways",
+		func() ([]*istionetworkingv1.Gateway, error) {
+			return listAll[*istionetworkingv1.Gateway](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1().Gateways("").List(ctx, opts)
+			})
+		},
+		func() ([]*istionetworkingv1.Gateway, error) {
+			legacyItems, err := listAll[*istionetworkingv1beta1.Gateway](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1beta1().Gateways("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istionetworkingv1.Gateway, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = gatewayFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listSidecars lists all sidecars in the cluster
+func (k *Client) listSidecars(ctx context.Context) ([]*istionetworkingv1.Sidecar, error) {
+	return listPreferringV1(k, "networking.istio.io", "sidecars",
+		func() ([]*istionetworkingv1.Sidecar, error) {
+			return listAll[*istionetworkingv1.Sidecar](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1().Sidecars("").List(ctx, opts)
+			})
+		},
+		func() ([]*istionetworkingv1.Sidecar, error) {
+			legacyItems, err := listAll[*istionetworkingv1beta1.Sidecar](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1beta1().Sidecars("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istionetworkingv1.Sidecar, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = sidecarFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listVirtualServices lists all virtual services in the cluster
+func (k *Client) listVirtualServices(ctx context.Context) ([]*istionetworkingv1.VirtualService, error) {
+	return listPreferringV1(k, "networking.istio.io", "virtualservices",
+		func() ([]*istionetworkingv1.VirtualService, error) {
+			return listAll[*istionetworkingv1.VirtualService](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1().VirtualServices("").List(ctx, opts)
+			})
+		},
+		func() ([]*istionetworkingv1.VirtualService, error) {
+			legacyItems, err := listAll[*istionetworkingv1beta1.VirtualService](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1beta1().VirtualServices("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istionetworkingv1.VirtualService, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = virtualServiceFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listServiceEntries lists all service entries in the cluster
+func (k *Client) listServiceEntries(ctx context.Context) ([]*istionetworkingv1.ServiceEntry, error) {
+	return listPreferringV1(k, "networking.istio.io", "serviceentries",
+		func() ([]*istionetworkingv1.ServiceEntry, error) {
+			return listAll[*istionetworkingv1.ServiceEntry](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1().ServiceEntries("").List(ctx, opts)
+			})
+		},
+		func() ([]*istionetworkingv1.ServiceEntry, error) {
+			legacyItems, err := listAll[*istionetworkingv1beta1.ServiceEntry](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.NetworkingV1beta1().ServiceEntries("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istionetworkingv1.ServiceEntry, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = serviceEntryFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listRequestAuthentications lists all request authentications in the cluster
+func (k *Client) listRequestAuthentications(ctx context.Context) ([]*istiosecurityv1.RequestAuthentication, error) {
+	return listPreferringV1(k, "security.istio.io", "requestauthentications",
+		func() ([]*istiosecurityv1.RequestAuthentication, error) {
+			return listAll[*istiosecurityv1.RequestAuthentication](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1().RequestAuthentications("").List(ctx, opts)
+			})
+		},
+		func() ([]*istiosecurityv1.RequestAuthentication, error) {
+			legacyItems, err := listAll[*istiosecurityv1beta1.RequestAuthentication](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1beta1().RequestAuthentications("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istiosecurityv1.RequestAuthentication, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = requestAuthenticationFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listPeerAuthentications lists all peer authentications in the cluster
+func (k *Client) listPeerAuthentications(ctx context.Context) ([]*istiosecurityv1.PeerAuthentication, error) {
+	return listPreferringV1(k, "security.istio.io", "peerauthentications",
+		func() ([]*istiosecurityv1.PeerAuthentication, error) {
+			return listAll[*istiosecurityv1.PeerAuthentication](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1().PeerAuthentications("").List(ctx, opts)
+			})
+		},
+		func() ([]*istiosecurityv1.PeerAuthentication, error) {
+			legacyItems, err := listAll[*istiosecurityv1beta1.PeerAuthentication](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1beta1().PeerAuthentications("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istiosecurityv1.PeerAuthentication, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = peerAuthenticationFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// listAuthorizationPolicies lists all authorization policies in the cluster
+func (k *Client) listAuthorizationPolicies(ctx context.Context) ([]*istiosecurityv1.AuthorizationPolicy, error) {
+	return listPreferringV1(k, "security.istio.io", "authorizationpolicies",
+		func() ([]*istiosecurityv1.AuthorizationPolicy, error) {
+			return listAll[*istiosecurityv1.AuthorizationPolicy](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1().AuthorizationPolicies("").List(ctx, opts)
+			})
+		},
+		func() ([]*istiosecurityv1.AuthorizationPolicy, error) {
+			legacyItems, err := listAll[*istiosecurityv1beta1.AuthorizationPolicy](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+				return k.istioClient.SecurityV1beta1().AuthorizationPolicies("").List(ctx, opts)
+			})
+			if err != nil {
+				return nil, err
+			}
+			items := make([]*istiosecurityv1.AuthorizationPolicy, len(legacyItems))
+			for i, legacy := range legacyItems {
+				items[i] = authorizationPolicyFromV1beta1(legacy)
+			}
+			return items, nil
+		},
+	)
+}
+
+// destinationRuleFromV1beta1 adapts a v1beta1 DestinationRule to the v1 type
+func destinationRuleFromV1beta1(legacy *istionetworkingv1beta1.DestinationRule) *istionetworkingv1.DestinationRule {
+	dr := &istionetworkingv1.DestinationRule{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&dr.Spec)
+	legacy.Status.DeepCopyInto(&dr.Status)
+	return dr
+}
+
+// gatewayFromV1beta1 adapts a v1beta1 Gateway to the v1 type
+func gatewayFromV1beta1(legacy *istionetworkingv1beta1.Gateway) *istionetworkingv1.Gateway {
+	gw := &istionetworkingv1.Gateway{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&gw.Spec)
+	legacy.Status.DeepCopyInto(&gw.Status)
+	return gw
+}
+
+// sidecarFromV1beta1 adapts a v1beta1 Sidecar to the v1 type
+func sidecarFromV1beta1(legacy *istionetworkingv1beta1.Sidecar) *istionetworkingv1.Sidecar {
+	sc := &istionetworkingv1.Sidecar{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&sc.Spec)
+	legacy.Status.DeepCopyInto(&sc.Status)
+	return sc
+}
+
+// virtualServiceFromV1beta1 adapts a v1beta1 VirtualService to the v1 type
+func virtualServiceFromV1beta1(legacy *istionetworkingv1beta1.VirtualService) *istionetworkingv1.VirtualService {
+	vs := &istionetworkingv1.VirtualService{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&vs.Spec)
+	legacy.Status.DeepCopyInto(&vs.Status)
+	return vs
+}
+
+// serviceEntryFromV1beta1 adapts a v1beta1 ServiceEntry to the v1 type
+func serviceEntryFromV1beta1(legacy *istionetworkingv1beta1.ServiceEntry) *istionetworkingv1.ServiceEntry {
+	se := &istionetworkingv1.ServiceEntry{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&se.Spec)
+	legacy.Status.DeepCopyInto(&se.Status)
+	return se
+}
+
+// requestAuthenticationFromV1beta1 adapts a v1beta1 RequestAuthentication to the v1 type
+func requestAuthenticationFromV1beta1(legacy *istiosecurityv1beta1.RequestAuthentication) *istiosecurityv1.RequestAuthentication {
+	ra := &istiosecurityv1.RequestAuthentication{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&ra.Spec)
+	legacy.Status.DeepCopyInto(&ra.Status)
+	return ra
+}
+
+// peerAuthenticationFromV1beta1 adapts a v1beta1 PeerAuthentication to the v1 type
+func peerAuthenticationFromV1beta1(legacy *istiosecurityv1beta1.PeerAuthentication) *istiosecurityv1.PeerAuthentication {
+	pa := &istiosecurityv1.PeerAuthentication{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&pa.Spec)
+	legacy.Status.DeepCopyInto(&pa.Status)
+	return pa
+}
+
+// authorizationPolicyFromV1beta1 adapts a v1beta1 AuthorizationPolicy to the v1 type
+func authorizationPolicyFromV1beta1(legacy *istiosecurityv1beta1.AuthorizationPolicy) *istiosecurityv1.AuthorizationPolicy {
+	ap := &istiosecurityv1.AuthorizationPolicy{TypeMeta: legacy.TypeMeta, ObjectMeta: legacy.ObjectMeta}
+	legacy.Spec.DeepCopyInto(&ap.Spec)
+	legacy.Status.DeepCopyInto(&ap.Status)
+	return ap
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioapi "istio.io/api/networking/v1alpha3"
+	securityapi "istio.io/api/security/v1beta1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// withoutV1 makes the fake clientset behave like a control plane that only serves v1beta1
+func withoutV1(client *istiofake.Clientset) {
+	client.PrependReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		resource := action.GetResource()
+		if resource.Version != "v1" {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: resource.Group, Resource: resource.Resource}, "")
+	})
+}
+
+func TestClient_listDestinationRules(t *testing.T) {
+	v1DR := &istionetworkingv1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1", Namespace: "default"},
+		Spec:       istioapi.DestinationRule{Host: "reviews"},
+	}
+	legacyDR := &istionetworkingv1beta1.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1beta1", Namespace: "default", Labels: map[string]string{"app": "reviews"}},
+		Spec: istioapi.DestinationRule{
+			Host:    "reviews",
+			Subsets: []*istioapi.Subset{{Name: "v1", Labels: map[string]string{"version": "v1"}}},
+		},
+	}
+
+	t.Run("prefers v1", func(t *testing.T) {
+		istioClient := istiofake.NewSimpleClientset(v1DR, legacyDR)
+		client := &Client{istioClient: istioClient, logger: logging.For("test")}
+
+		result, err := client.listDestinationRules(context.Background())
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "reviews-v1", result[0].Name)
+	})
+
+	t.Run("falls back to v1beta1 when v1 is not served", func(t *testing.T) {
+		istioClient := istiofake.NewSimpleClientset(legacyDR)
+		withoutV1(istioClient)
+		client := &Client{istioClient: istioClient, logger: logging.For("test")}
+
+		result, err := client.listDestinationRules(context.Background())
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "reviews-v1beta1", result[0].Name)
+		assert.Equal(t, map[string]string{"app": "reviews"}, result[0].Labels)
+		assert.Equal(t, "reviews", result[0].Spec.Host)
+		require.Len(t, result[0].Spec.Subsets, 1)
+		assert.Equal(t, "v1", result[0].Spec.Subsets[0].Name)
+	})
+
+	t.Run("remembers that v1 is not served", func(t *testing.T) {
+		istioClient := istiofake.NewSimpleClientset(legacyDR)
+		withoutV1(istioClient)
+		client := &Client{istioClient: istioClient, logger: logging.For("test")}
+
+		listsAt := func(version string) int {
+			count := 0
+			for _, action := range istioClient.Actions() {
+				if action.GetVerb() == "list" && action.GetResource().Version == version {
+					count++
+				}
+			}
+			return count
+		}
+
+		for range 3 {
+			result, err := client.listDestinationRules(context.Background())
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+		}
+		assert.Equal(t, 1, listsAt("v1"), "v1 is only tried on the first list")
+		assert.Equal(t, 3, listsAt("v1beta1"))
+	})
+
+	t.Run("returns to v1 when v1beta1 is no longer served", func(t *testing.T) {
+		istioClient := istiofake.NewSimpleClientset(v1DR)
+		istioClient.PrependReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			resource := action.GetResource()
+			if resource.Version != "v1beta1" {
+				return false, nil, nil
+			}
+			return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: resource.Group, Resource: resource.Resource}, "")
+		})
+		client := &Client{istioClient: istioClient, logger: logging.For("test")}
+		client.setListedViaLegacy(resourceKey("networking.istio.io", "destinationrules"), true)
+
+		result, err := client.listDestinationRules(context.Background())
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "reviews-v1", result[0].Name)
+		assert.False(t, client.listedViaLegacy(resourceKey("networking.istio.io", "destinationrules")))
+	})
+
+	t.Run("does not fall back on other errors", func(t *testing.T) {
+		istioClient := istiofake.NewSimpleClientset(legacyDR)
+		istioClient.PrependReactor("list", "destinationrules", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("connection refused")
+		})
+		client := &Client{istioClient: istioClient, logger: logging.For("test")}
+
+		_, err := client.listDestinationRules(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+}
+
+func TestClient_listAuthorizationPoliciesFallback(t *testing.T) {
+	legacyAP := &istiosecurityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "default"},
+		Spec:       securityapi.AuthorizationPolicy{Action: securityapi.AuthorizationPolicy_DENY},
+	}
+	istioClient := istiofake.NewSimpleClientset(legacyAP)
+	withoutV1(istioClient)
+	client := &Client{istioClient: istioClient, logger: logging.For("test")}
+
+	result, err := client.listAuthorizationPolicies(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "deny-all", result[0].Name)
+	assert.Equal(t, securityapi.AuthorizationPolicy_DENY, result[0].Spec.Action)
+
+	// The converted resource keeps every field of the legacy object
+	converted, err := client.convertAuthorizationPolicy(result[0])
+	require.NoError(t, err)
+	assert.Contains(t, converted.RawConfig, `"action":"DENY"`)
+}
@@ -19,13 +19,17 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"istio.io/api/label"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/pager"
 )
 
 // buildEndpointSliceMap creates a map of service name to endpoint slices for efficient lookup
@@ -70,6 +74,9 @@ func (k *Client) convertServiceWithMaps(
 	protoService.ServiceType = k.convertServiceType(svc.Spec.Type)
 	protoService.ClusterIp = svc.Spec.ClusterIP
 	protoService.ExternalIp = k.extractExternalIP(svc)
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		protoService.ExternalName = svc.Spec.ExternalName
+	}
 
 	// Get endpoint slices for this service
 	serviceKey := svc.Namespace + "/" + svc.Name
@@ -95,8 +102,9 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 
 	for _, slice := range endpointSlices {
 		for _, endpoint := range slice.Endpoints {
-			// Only process ready endpoints
-			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+			// Only process ready endpoints, and terminating ones so pods being replaced remain visible
+			terminating := endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready && !terminating {
 				continue
 			}
 
@@ -108,13 +116,18 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 
 			// Check for Envoy sidecar and extract additional pod info if we have a pod name
 			envoyPresent := false
-			var containers []*backendv1alpha1.Container
+			var containers, initContainers []*backendv1alpha1.Container
+			var tolerations []*typesv1alpha1.Toleration
+			serviceAccount := ""
 			podStatus := ""
 			nodeName := ""
 			createdAt := ""
 			labels := make(map[string]string)
 			annotations := make(map[string]string)
 			proxyMode := typesv1alpha1.ProxyMode_NONE
+			lifecyclePhase := typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_UNSPECIFIED
+			deletionTimestamp := ""
+			canonicalName, canonicalRevision := "", ""
 
 			if podName != "" {
 				podKey := slice.Namespace + "/" + podName
@@ -123,7 +136,10 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 					proxyMode = k.determineProxyMode(pod)
 
 					// Extract container information
-					containers = k.extractContainerInfo(pod)
+					containers = k.extractContainerInfo(pod.Spec.Containers, pod.Status.ContainerStatuses)
+					initContainers = k.extractContainerInfo(pod.Spec.InitContainers, pod.Status.InitContainerStatuses)
+					serviceAccount = pod.Spec.ServiceAccountName
+					tolerations = convertTolerations(pod.Spec.Tolerations)
 
 					// Extract pod metadata
 					podStatus = string(pod.Status.Phase)
@@ -131,6 +147,11 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 					if !pod.CreationTimestamp.IsZero() {
 						createdAt = pod.CreationTimestamp.Format("2006-01-02T15:04:05Z")
 					}
+					lifecyclePhase = podLifecyclePhase(pod)
+					canonicalName, canonicalRevision = canonicalService(pod)
+					if pod.DeletionTimestamp != nil {
+						deletionTimestamp = pod.DeletionTimestamp.UTC().Format(time.RFC3339)
+					}
 
 					// Copy labels and annotations (avoid nil maps)
 					if pod.Labels != nil {
@@ -146,19 +167,33 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 				}
 			}
 
+			// The endpoint slice may report termination before the pod has been observed as deleted
+			if terminating {
+				lifecyclePhase = typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING
+			}
+
 			// Create service instance for each IP address
 			for _, address := range endpoint.Addresses {
 				instance := &backendv1alpha1.ServiceInstance{
-					Ip:           address,
-					PodName:      podName,
-					EnvoyPresent: envoyPresent,
-					Containers:   containers,
-					PodStatus:    podStatus,
-					NodeName:     nodeName,
-					CreatedAt:    createdAt,
-					Labels:       labels,
-					Annotations:  annotations,
-					ProxyMode:    proxyMode,
+					Ip:                address,
+					PodName:           podName,
+					EnvoyPresent:      envoyPresent,
+					Containers:        containers,
+					PodStatus:         podStatus,
+					NodeName:          nodeName,
+					CreatedAt:         createdAt,
+					Labels:            labels,
+					Annotations:       annotations,
+					ProxyMode:         proxyMode,
+					InitContainers:    initContainers,
+					ServiceAccount:    serviceAccount,
+					Tolerations:       tolerations,
+					Locality:          endpointLocality(endpoint),
+					ZoneHints:         endpointZoneHints(endpoint),
+					LifecyclePhase:    lifecyclePhase,
+					DeletionTimestamp: deletionTimestamp,
+					CanonicalService:  canonicalName,
+					CanonicalRevision: canonicalRevision,
 				}
 				instances = append(instances, instance)
 			}
@@ -168,6 +203,46 @@ func (k *Client) convertEndpointSlicesToInstancesWithMaps(
 	return instances
 }
 
+// podLifecyclePhase returns where a pod is in its lifecycle. Deleted pods are terminating whatever their phase.
+func podLifecyclePhase(pod *corev1.Pod) typesv1alpha1.PodLifecyclePhase {
+	if pod.DeletionTimestamp != nil {
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING
+	}
+	switch pod.Status.Phase {
+	case corev1.PodPending:
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_PENDING
+	case corev1.PodRunning:
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_RUNNING
+	case corev1.PodSucceeded:
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_SUCCEEDED
+	case corev1.PodFailed:
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_FAILED
+	default:
+		return typesv1alpha1.PodLifecyclePhase_POD_LIFECYCLE_PHASE_UNSPECIFIED
+	}
+}
+
+// endpointLocality returns the locality of an endpoint from the zone the EndpointSlice controller copies from
+// its node. Nil when the endpoint has no zone.
+func endpointLocality(endpoint discoveryv1.Endpoint) *typesv1alpha1.LocalityInfo {
+	if endpoint.Zone == nil || *endpoint.Zone == "" {
+		return nil
+	}
+	return &typesv1alpha1.LocalityInfo{Zone: *endpoint.Zone}
+}
+
+// endpointZoneHints returns the zones an endpoint is hinted for by topology aware routing
+func endpointZoneHints(endpoint discoveryv1.Endpoint) []string {
+	if endpoint.Hints == nil {
+		return nil
+	}
+	var zones []string
+	for _, zone := range endpoint.Hints.ForZones {
+		zones = append(zones, zone.Name)
+	}
+	return zones
+}
+
 // hasEnvoySidecarInPod checks if a pod has an Envoy sidecar container (no API call)
 func (k *Client) hasEnvoySidecarInPod(pod *corev1.Pod) bool {
 	// Check all containers for Envoy indicators
@@ -206,21 +281,23 @@ func (k *Client) isEnvoyContainer(container corev1.Container) bool {
 	return false
 }
 
-// extractContainerInfo extracts container information from a pod
-func (k *Client) extractContainerInfo(pod *corev1.Pod) []*backendv1alpha1.Container {
+// extractContainerInfo extracts information about a pod's containers, or its init containers, from their specs and statuses
+func (k *Client) extractContainerInfo(specs []corev1.Container, statuses []corev1.ContainerStatus) []*backendv1alpha1.Container {
 	var containers []*backendv1alpha1.Container
 
 	// Extract information from all containers
-	for _, container := range pod.Spec.Containers {
+	for _, container := range specs {
 		ready := false
 		status := "Unknown"
 		restartCount := int32(0)
+		imageDigest := ""
 
 		// Find matching container status
-		for _, cs := range pod.Status.ContainerStatuses {
+		for _, cs := range statuses {
 			if cs.Name == container.Name {
 				ready = cs.Ready
 				restartCount = cs.RestartCount
+				imageDigest = imageDigestFromID(cs.ImageID)
 
 				// Determine status
 				if cs.State.Running != nil {
@@ -246,42 +323,124 @@ func (k *Client) extractContainerInfo(pod *corev1.Pod) []*backendv1alpha1.Contai
 			Status:       status,
 			Ready:        ready,
 			RestartCount: restartCount,
+			ImageDigest:  imageDigest,
 		})
 	}
 
 	return containers
 }
 
-// fetchServices fetches all services from the cluster
-func (k *Client) fetchServices(ctx context.Context, wg *sync.WaitGroup, result **corev1.ServiceList, errChan chan<- error) {
+// imageDigestFromID extracts the digest from a container status image ID, which runtimes report either as a
+// digested reference (e.g. "docker-pullable://istio/proxyv2@sha256:...") or as the bare digest
+func imageDigestFromID(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID[i+1:]
+	}
+	if strings.HasPrefix(imageID, "sha256:") {
+		return imageID
+	}
+	return ""
+}
+
+// convertTolerations converts a pod's tolerations
+func convertTolerations(tolerations []corev1.Toleration) []*typesv1alpha1.Toleration {
+	if len(tolerations) == 0 {
+		return nil
+	}
+	converted := make([]*typesv1alpha1.Toleration, len(tolerations))
+	for i, toleration := range tolerations {
+		converted[i] = &typesv1alpha1.Toleration{
+			Key:               toleration.Key,
+			Operator:          string(toleration.Operator),
+			Value:             toleration.Value,
+			Effect:            string(toleration.Effect),
+			TolerationSeconds: toleration.TolerationSeconds,
+		}
+	}
+	return converted
+}
+
+// listPageSize is how many objects each paged list request returns, so large lists are never held as one response
+const listPageSize = 500
+
+// listInPages lists objects a page at a time with limit and continue tokens, calling each for every object, so
+// lists of large clusters are neither held as one response nor time out. A list whose continue token expires
+// is restarted as a full list. Managed fields can be a large share of an object's size, so only the most
+// recent write to the main resource is kept, without its field set, as the edge reads nothing else.
+func listInPages(ctx context.Context, opts metav1.ListOptions, list func(opts metav1.ListOptions) (runtime.Object, error), each func(obj runtime.Object) error) error {
+	p := pager.New(pager.SimplePageFunc(list))
+	p.PageSize = listPageSize
+	p.PageBufferSize = 1
+	return p.EachListItem(ctx, opts, func(obj runtime.Object) error {
+		if accessor, err := meta.Accessor(obj); err == nil {
+			var managedFields []metav1.ManagedFieldsEntry
+			if latest := latestManagedFieldsEntry(accessor); latest != nil {
+				entry := *latest
+				entry.FieldsV1 = nil
+				managedFields = []metav1.ManagedFieldsEntry{entry}
+			}
+			accessor.SetManagedFields(managedFields)
+		}
+		return each(obj)
+	})
+}
+
+// listAll lists all objects a page at a time and returns them as T, the item type of the list
+func listAll[T runtime.Object](ctx context.Context, opts metav1.ListOptions, list func(opts metav1.ListOptions) (runtime.Object, error)) ([]T, error) {
+	var items []T
+	err := listInPages(ctx, opts, list, func(obj runtime.Object) error {
+		items = append(items, obj.(T))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// fetchServices fetches all services from the datastore, grouped by namespace
+func (k *Client) fetchServices(ctx context.Context, wg *sync.WaitGroup, servicesByNamespace *map[string][]*corev1.Service, errChan chan<- error) {
 	defer wg.Done()
-	servicesList, err := k.clientset.CoreV1().Services("").List(ctx, metav1.ListOptions{})
-	*result = servicesList
+	services, err := k.workloadDatastore().ListServices(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list services: %w", err)
+		return
+	}
+	result := make(map[string][]*corev1.Service)
+	for _, svc := range services {
+		result[svc.Namespace] = append(result[svc.Namespace], svc)
 	}
+	*servicesByNamespace = result
 }
 
-// fetchEndpointSlices fetches all endpoint slices and builds a service map
+// fetchEndpointSlices fetches all endpoint slices from the datastore and builds a service map
 func (k *Client) fetchEndpointSlices(ctx context.Context, wg *sync.WaitGroup, endpointSlicesByService *map[string][]discoveryv1.EndpointSlice, errChan chan<- error) {
 	defer wg.Done()
-	endpointSlicesResult, err := k.clientset.DiscoveryV1().EndpointSlices("").List(ctx, metav1.ListOptions{})
+	slices, err := k.workloadDatastore().ListEndpointSlices(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list endpoint slices: %w", err)
 		return
 	}
-	*endpointSlicesByService = k.buildEndpointSliceMap(endpointSlicesResult.Items)
+	endpointSlices := make([]discoveryv1.EndpointSlice, len(slices))
+	for i, slice := range slices {
+		endpointSlices[i] = *slice
+	}
+	*endpointSlicesByService = k.buildEndpointSliceMap(endpointSlices)
 }
 
-// fetchPods fetches all pods and builds a name map
+// fetchPods fetches all pods from the datastore and builds a name map
 func (k *Client) fetchPods(ctx context.Context, wg *sync.WaitGroup, podsByName *map[string]*corev1.Pod, errChan chan<- error) {
 	defer wg.Done()
-	podsResult, err := k.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	pods, err := k.workloadDatastore().ListPods(ctx)
 	if err != nil {
 		errChan <- fmt.Errorf("failed to list pods: %w", err)
 		return
 	}
-	*podsByName = k.buildPodMap(podsResult.Items)
+	result := make(map[string]*corev1.Pod, len(pods))
+	for _, pod := range pods {
+		result[pod.Namespace+"/"+pod.Name] = pod
+	}
+	*podsByName = result
 }
 
 // convertServiceType converts Kubernetes service type to protobuf ServiceType enum
@@ -17,15 +17,19 @@ package kubernetes
 import (
 	"context"
 	"testing"
+	"time"
 
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestClient_isEnvoyContainer(t *testing.T) {
@@ -394,6 +398,135 @@ func TestClient_convertEndpointSlicesToInstancesWithMaps(t *testing.T) {
 	}
 }
 
+func TestClient_convertEndpointSlicesToInstancesWithMaps_WorkloadDetail(t *testing.T) {
+	tolerationSeconds := int64(300)
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1", Namespace: "bookinfo"},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "bookinfo-reviews",
+			InitContainers:     []corev1.Container{{Name: "istio-init", Image: "istio/proxyv2:1.22.0"}},
+			Containers:         []corev1.Container{{Name: "reviews", Image: "reviews:v1"}},
+			Tolerations: []corev1.Toleration{
+				{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &tolerationSeconds},
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "istio-init",
+				ImageID: "docker-pullable://istio/proxyv2@sha256:init",
+				State:   corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "reviews",
+				ImageID: "sha256:app",
+				Ready:   true,
+				State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews-abc", Namespace: "bookinfo"},
+		Endpoints: []discoveryv1.Endpoint{{
+			Addresses: []string{"10.0.0.1"},
+			TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-v1"},
+		}},
+	}}
+
+	k8sClient := &Client{logger: logging.For("test")}
+	got := k8sClient.convertEndpointSlicesToInstancesWithMaps(slices, k8sClient.buildPodMap([]corev1.Pod{pod}))
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, "bookinfo-reviews", got[0].ServiceAccount)
+	assert.Equal(t, []*v1alpha1.Container{{Name: "istio-init", Image: "istio/proxyv2:1.22.0", Status: "Completed", ImageDigest: "sha256:init"}}, got[0].InitContainers)
+	assert.Equal(t, "sha256:app", got[0].Containers[0].ImageDigest)
+	assert.Len(t, got[0].Tolerations, 1)
+	assert.Equal(t, "Exists", got[0].Tolerations[0].Operator)
+	assert.Equal(t, "NoExecute", got[0].Tolerations[0].Effect)
+	assert.Equal(t, int64(300), got[0].Tolerations[0].GetTolerationSeconds())
+}
+
+func TestClient_convertEndpointSlicesToInstancesWithMaps_LifecyclePhase(t *testing.T) {
+	deletedAt := metav1.NewTime(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC))
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "terminating", Namespace: "default", DeletionTimestamp: &deletedAt},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodFailed},
+		},
+	}
+	endpoint := func(ip, pod string, ready, terminating bool) discoveryv1.Endpoint {
+		return discoveryv1.Endpoint{
+			Addresses:  []string{ip},
+			Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(ready), Terminating: boolPtr(terminating)},
+			TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: pod},
+		}
+	}
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default"},
+		Endpoints: []discoveryv1.Endpoint{
+			endpoint("10.0.0.1", "running", true, false),
+			endpoint("10.0.0.2", "terminating", false, true),
+			endpoint("10.0.0.3", "failed", false, false),
+			endpoint("10.0.0.4", "unknown", false, true),
+		},
+	}}
+
+	k8sClient := &Client{logger: logging.For("test")}
+	got := k8sClient.convertEndpointSlicesToInstancesWithMaps(slices, k8sClient.buildPodMap(pods))
+
+	// The failed pod is not ready and not terminating so it is filtered out
+	assert.Len(t, got, 3)
+	assert.Equal(t, types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_RUNNING, got[0].LifecyclePhase)
+	assert.Empty(t, got[0].DeletionTimestamp)
+	assert.Equal(t, types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING, got[1].LifecyclePhase)
+	assert.Equal(t, "2024-05-01T12:30:00Z", got[1].DeletionTimestamp)
+	assert.Equal(t, types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING, got[2].LifecyclePhase)
+	assert.Empty(t, got[2].DeletionTimestamp)
+}
+
+func TestPodLifecyclePhase(t *testing.T) {
+	deletedAt := metav1.Now()
+	tests := []struct {
+		name string
+		pod  corev1.Pod
+		want types.PodLifecyclePhase
+	}{
+		{name: "pending", pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}, want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_PENDING},
+		{name: "running", pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}, want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_RUNNING},
+		{name: "succeeded", pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}}, want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_SUCCEEDED},
+		{name: "failed", pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}}, want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_FAILED},
+		{name: "unknown", pod: corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodUnknown}}, want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_UNSPECIFIED},
+		{
+			name: "deleted pod is terminating",
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deletedAt},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			want: types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, podLifecyclePhase(&tt.pod))
+		})
+	}
+}
+
+func TestImageDigestFromID(t *testing.T) {
+	assert.Equal(t, "sha256:abc", imageDigestFromID("docker-pullable://istio/proxyv2@sha256:abc"))
+	assert.Equal(t, "sha256:abc", imageDigestFromID("docker.io/istio/proxyv2@sha256:abc"))
+	assert.Equal(t, "sha256:abc", imageDigestFromID("sha256:abc"))
+	assert.Empty(t, imageDigestFromID(""))
+}
+
 func TestClient_convertServiceType(t *testing.T) {
 	client := &Client{}
 
@@ -954,3 +1087,59 @@ func TestClient_convertServiceWithMaps_WithIPs(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_convertServiceWithMaps_ExternalName(t *testing.T) {
+	client := &Client{}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: "api.payments.example.com",
+		},
+	}
+
+	result := client.convertServiceWithMaps(service, map[string][]discoveryv1.EndpointSlice{}, map[string]*corev1.Pod{})
+
+	assert.Equal(t, types.ServiceType_EXTERNAL_NAME, result.ServiceType)
+	assert.Equal(t, "api.payments.example.com", result.ExternalName)
+	assert.Empty(t, result.ClusterIp)
+	assert.Empty(t, result.Instances)
+}
+
+func TestListAll_pages(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	var requests []metav1.ListOptions
+	clientset.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		opts := action.(k8stesting.ListActionImpl).ListOptions
+		requests = append(requests, opts)
+
+		// Serve one namespace per page, continuing after the namespace in the continue token
+		names := []string{"bookinfo", "default", "istio-system"}
+		page := &corev1.NamespaceList{}
+		for i, name := range names {
+			if name > opts.Continue {
+				page.Items = []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": "mesh"}, ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}}}}}
+				if i < len(names)-1 {
+					page.Continue = name
+				}
+				break
+			}
+		}
+		return true, page, nil
+	})
+
+	namespaces, err := listAll[*corev1.Namespace](context.Background(), metav1.ListOptions{LabelSelector: "team=mesh"}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return clientset.CoreV1().Namespaces().List(context.Background(), opts)
+	})
+	require.NoError(t, err)
+
+	require.Len(t, namespaces, 3)
+	assert.Equal(t, "istio-system", namespaces[2].Name)
+	assert.Nil(t, namespaces[0].ManagedFields)
+	require.Len(t, requests, 3)
+	for _, opts := range requests {
+		assert.Equal(t, int64(listPageSize), opts.Limit)
+		assert.Equal(t, "team=mesh", opts.LabelSelector)
+	}
+	assert.Equal(t, "default", requests[2].Continue)
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Lease timings, matching the Kubernetes controller manager defaults
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// LeaderElector elects a single leader among the edge replicas of a cluster using a Lease
+type LeaderElector struct {
+	lock   *resourcelock.LeaseLock
+	logger *slog.Logger
+}
+
+// NewLeaderElector creates a leader elector for the Lease namespace/name, campaigning as identity
+func NewLeaderElector(clientset kubernetes.Interface, namespace, name, identity string, logger *slog.Logger) *LeaderElector {
+	return &LeaderElector{
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Client:     clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		logger: logger,
+	}
+}
+
+// Run campaigns for leadership until ctx is done or leadership is lost. onStartedLeading is called once this
+// replica leads, with a context canceled when leadership ends. The Lease is released when ctx is canceled so
+// a standby can take over without waiting for it to expire.
+func (l *LeaderElector) Run(ctx context.Context, onStartedLeading func(ctx context.Context, leader *v1alpha1.LeaderElection)) {
+	identity := l.lock.Identity()
+	l.logger.Info("campaigning for leadership", "lease", l.lock.Describe(), "identity", identity)
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            l.lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            l.lock.Describe(),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				onStartedLeading(ctx, l.leadership(ctx))
+			},
+			OnStoppedLeading: func() {
+				l.logger.Info("stopped leading", "lease", l.lock.Describe(), "identity", identity)
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					l.logger.Info("standing by for leader", "lease", l.lock.Describe(), "leader", leader)
+				}
+			},
+		},
+	})
+}
+
+// leadership describes this replica's leadership from the Lease it holds
+func (l *LeaderElector) leadership(ctx context.Context) *v1alpha1.LeaderElection {
+	leader := &v1alpha1.LeaderElection{Identity: l.lock.Identity(), AcquiredAt: timestamppb.Now()}
+
+	record, _, err := l.lock.Get(ctx)
+	if err != nil {
+		l.logger.Warn("failed to read leader election record", "lease", l.lock.Describe(), "error", err)
+		return leader
+	}
+	leader.Term = int64(record.LeaderTransitions)
+	if !record.AcquireTime.IsZero() {
+		leader.AcquiredAt = timestamppb.New(record.AcquireTime.Time)
+	}
+	return leader
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// lead runs an elector until it leads, returning its leadership and a function that steps it down
+func lead(t *testing.T, elector *LeaderElector) (*v1alpha1.LeaderElection, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	elected := make(chan *v1alpha1.LeaderElection, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(ctx, func(ctx context.Context, leader *v1alpha1.LeaderElection) {
+			elected <- leader
+			<-ctx.Done()
+		})
+	}()
+
+	select {
+	case leader := <-elected:
+		return leader, func() {
+			cancel()
+			<-done
+		}
+	case <-time.After(5 * time.Second):
+		cancel()
+		t.Fatal("elector did not become leader")
+		return nil, nil
+	}
+}
+
+func TestLeaderElector_Run(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+
+	first := NewLeaderElector(clientset, "navigator", "navigator-edge", "edge-0", logging.For("test"))
+	leader, stepDown := lead(t, first)
+	assert.Equal(t, "edge-0", leader.Identity)
+	assert.Equal(t, int64(0), leader.Term)
+	assert.NotNil(t, leader.AcquiredAt)
+
+	// Stepping down releases the Lease so a standby takes over immediately, in a later term
+	stepDown()
+	second := NewLeaderElector(clientset, "navigator", "navigator-edge", "edge-1", logging.For("test"))
+	leader, stepDown = lead(t, second)
+	defer stepDown()
+	assert.Equal(t, "edge-1", leader.Identity)
+	assert.Equal(t, int64(1), leader.Term)
+
+	lease, err := clientset.CoordinationV1().Leases("navigator").Get(context.Background(), "navigator-edge", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "edge-1", *lease.Spec.HolderIdentity)
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultLogTailLines bounds the number of lines returned when the request does not specify one
+	defaultLogTailLines int64 = 500
+	// maxLogBytes bounds the size of a single log response so it fits comfortably in a stream message
+	maxLogBytes int64 = 1 << 20
+)
+
+// GetPodLogs retrieves logs for a container in a pod using the Kubernetes pod log API.
+// When no container is requested the first application (non-proxy) container is used.
+func (k *Client) GetPodLogs(ctx context.Context, req *v1alpha1.PodLogsRequest) (*typesv1alpha1.ContainerLogs, error) {
+	container := req.Container
+	if container == "" {
+		pod, err := k.clientset.CoreV1().Pods(req.PodNamespace).Get(ctx, req.PodName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s/%s: %w", req.PodNamespace, req.PodName, err)
+		}
+		container = k.defaultLogContainer(pod)
+		if container == "" {
+			return nil, fmt.Errorf("pod %s/%s has no containers", req.PodNamespace, req.PodName)
+		}
+	}
+
+	tailLines := req.TailLines
+	if tailLines <= 0 {
+		tailLines = defaultLogTailLines
+	}
+	limitBytes := maxLogBytes
+
+	options := &corev1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+		Previous:   req.Previous,
+	}
+	if req.SinceSeconds > 0 {
+		sinceSeconds := req.SinceSeconds
+		options.SinceSeconds = &sinceSeconds
+	}
+
+	k.logger.Debug("fetching pod logs",
+		"namespace", req.PodNamespace,
+		"pod", req.PodName,
+		"container", container,
+		"tail_lines", tailLines,
+		"previous", req.Previous)
+
+	stream, err := k.clientset.CoreV1().Pods(req.PodNamespace).GetLogs(req.PodName, options).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for %s/%s container %s: %w", req.PodNamespace, req.PodName, container, err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(stream, maxLogBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logs for %s/%s container %s: %w", req.PodNamespace, req.PodName, container, err)
+	}
+
+	return &typesv1alpha1.ContainerLogs{
+		Container: container,
+		Logs:      string(data),
+		Truncated: int64(len(data)) >= maxLogBytes,
+	}, nil
+}
+
+// defaultLogContainer picks the container whose logs are shown when none is requested
+func (k *Client) defaultLogContainer(pod *corev1.Pod) string {
+	for _, c := range pod.Spec.Containers {
+		if !k.isEnvoyContainer(c) {
+			return c.Name
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
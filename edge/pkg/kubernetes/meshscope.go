@@ -0,0 +1,206 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// revisionLabel holds the revision of an istiod deployment
+	revisionLabel = "istio.io/rev"
+
+	// defaultRevision is the revision label value of an unrevisioned control plane
+	defaultRevision = "default"
+
+	// maistraVersionLabel is set on control plane resources installed by OpenShift Service Mesh 2
+	maistraVersionLabel = "maistra-version"
+
+	// maistraMemberOfLabel is set by the ServiceMeshMemberRoll controller on each member namespace,
+	// with the control plane namespace as its value
+	maistraMemberOfLabel = "maistra.io/member-of"
+
+	// sailOperatorGroup is the API group of the Sail operator's resources
+	sailOperatorGroup = "sailoperator.io"
+
+	// meshConfigKey is the key of the mesh config in the istiod ConfigMap
+	meshConfigKey = "mesh"
+)
+
+// meshConfig is the subset of Istio's MeshConfig that determines which namespaces istiod watches and how
+// proxies handle traffic to destinations outside the service registry
+type meshConfig struct {
+	DiscoverySelectors    []metav1.LabelSelector `json:"discoverySelectors,omitempty"`
+	OutboundTrafficPolicy struct {
+		Mode string `json:"mode,omitempty"`
+	} `json:"outboundTrafficPolicy,omitempty"`
+}
+
+// describeControlPlane records how the active control plane was installed, which namespaces it watches and
+// its outbound traffic policy. Failures to read the mesh config or determine the member namespaces leave the
+// mesh unscoped and the outbound traffic policy unspecified rather than failing the sync.
+func (k *Client) describeControlPlane(ctx context.Context, deployment *appsv1.Deployment, config *typesv1alpha1.IstioControlPlaneConfig) {
+	config.Distribution = controlPlaneDistribution(deployment)
+	config.Revision = controlPlaneRevision(deployment)
+
+	mesh, err := k.meshConfig(ctx, deployment.Namespace, config.Revision)
+	if err != nil {
+		k.logger.Warn("failed to read mesh config",
+			"deployment", deployment.Name, "namespace", deployment.Namespace, "error", err)
+	}
+	if mesh != nil {
+		config.OutboundTrafficPolicyMode = outboundTrafficPolicyMode(mesh.OutboundTrafficPolicy.Mode)
+	}
+
+	members, err := k.meshMemberNamespaces(ctx, deployment.Namespace, config.Distribution, mesh.discoverySelectors())
+	if err != nil {
+		k.logger.Warn("failed to determine mesh member namespaces, collecting from all namespaces",
+			"deployment", deployment.Name, "namespace", deployment.Namespace, "error", err)
+		return
+	}
+	config.MemberNamespaces = members
+	if len(members) > 0 {
+		k.logger.Debug("control plane is scoped to member namespaces", "distribution", config.Distribution, "members", len(members))
+	}
+}
+
+// outboundTrafficPolicyMode converts a mesh config outboundTrafficPolicy mode, which defaults to ALLOW_ANY
+func outboundTrafficPolicyMode(mode string) typesv1alpha1.OutboundTrafficPolicyMode {
+	if mode == "REGISTRY_ONLY" {
+		return typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY
+	}
+	return typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY
+}
+
+// controlPlaneDistribution determines how an istiod deployment was installed
+func controlPlaneDistribution(deployment *appsv1.Deployment) typesv1alpha1.ControlPlaneDistribution {
+	if _, ok := deployment.Labels[maistraVersionLabel]; ok {
+		return typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_MAISTRA
+	}
+	// The Sail operator owns everything it renders through an IstioRevision
+	for _, owner := range deployment.OwnerReferences {
+		if strings.HasPrefix(owner.APIVersion, sailOperatorGroup+"/") {
+			return typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_SAIL
+		}
+	}
+	return typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_UPSTREAM
+}
+
+// controlPlaneRevision returns the revision of an istiod deployment, empty for the default revision
+func controlPlaneRevision(deployment *appsv1.Deployment) string {
+	revision := deployment.Labels[revisionLabel]
+	if revision == defaultRevision {
+		return ""
+	}
+	return revision
+}
+
+// meshMemberNamespaces returns the sorted namespaces a control plane watches, or nil if it watches every
+// namespace. OpenShift Service Mesh 2 scopes the mesh with a ServiceMeshMemberRoll, whose members are
+// labelled with the control plane namespace; other distributions scope it with discovery selectors.
+// The control plane namespace is always a member since it holds the mesh-wide configuration.
+func (k *Client) meshMemberNamespaces(ctx context.Context, controlPlaneNamespace string, distribution typesv1alpha1.ControlPlaneDistribution, discoverySelectors []metav1.LabelSelector) ([]string, error) {
+	var selectors []labels.Selector
+	if distribution == typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_MAISTRA {
+		selectors = []labels.Selector{labels.SelectorFromSet(labels.Set{maistraMemberOfLabel: controlPlaneNamespace})}
+	} else {
+		if len(discoverySelectors) == 0 {
+			return nil, nil
+		}
+		for i := range discoverySelectors {
+			selector, err := metav1.LabelSelectorAsSelector(&discoverySelectors[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid discovery selector: %w", err)
+			}
+			selectors = append(selectors, selector)
+		}
+	}
+
+	namespaces, err := listAll[*corev1.Namespace](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.clientset.CoreV1().Namespaces().List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	members := []string{controlPlaneNamespace}
+	for _, ns := range namespaces {
+		if ns.Name == controlPlaneNamespace {
+			continue
+		}
+		for _, selector := range selectors {
+			if selector.Matches(labels.Set(ns.Labels)) {
+				members = append(members, ns.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+// meshConfig reads the mesh config of a control plane revision, or returns nil if it has none
+func (k *Client) meshConfig(ctx context.Context, namespace, revision string) (*meshConfig, error) {
+	name := "istio"
+	if revision != "" {
+		name = "istio-" + revision
+	}
+
+	configMap, err := k.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mesh config %s/%s: %w", namespace, name, err)
+	}
+
+	var mesh meshConfig
+	if err := yaml.Unmarshal([]byte(configMap.Data[meshConfigKey]), &mesh); err != nil {
+		return nil, fmt.Errorf("failed to parse mesh config %s/%s: %w", namespace, name, err)
+	}
+	return &mesh, nil
+}
+
+// discoverySelectors returns the discovery selectors of a mesh config, which may be nil
+func (m *meshConfig) discoverySelectors() []metav1.LabelSelector {
+	if m == nil {
+		return nil
+	}
+	return m.DiscoverySelectors
+}
+
+// memberSet returns the member namespaces of a control plane as a set, or nil if the mesh is unscoped
+func memberSet(config *typesv1alpha1.IstioControlPlaneConfig) map[string]bool {
+	if config == nil || len(config.MemberNamespaces) == 0 {
+		return nil
+	}
+	members := make(map[string]bool, len(config.MemberNamespaces))
+	for _, namespace := range config.MemberNamespaces {
+		members[namespace] = true
+	}
+	return members
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioapi "istio.io/api/networking/v1alpha3"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func istiodDeployment(name, ns string, labels map[string]string, owners ...metav1.OwnerReference) *appsv1.Deployment {
+	deploymentLabels := map[string]string{"app": "istiod"}
+	for key, value := range labels {
+		deploymentLabels[key] = value
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: deploymentLabels, OwnerReferences: owners},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "discovery"}}}},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+	}
+}
+
+func meshConfigMap(name, ns, mesh string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Data:       map[string]string{"mesh": mesh},
+	}
+}
+
+func TestControlPlaneDistribution(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		want       typesv1alpha1.ControlPlaneDistribution
+	}{
+		{
+			name:       "upstream",
+			deployment: istiodDeployment("istiod", "istio-system", nil),
+			want:       typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_UPSTREAM,
+		},
+		{
+			name: "sail operator",
+			deployment: istiodDeployment("istiod", "istio-system", nil, metav1.OwnerReference{
+				APIVersion: "sailoperator.io/v1", Kind: "IstioRevision", Name: "default",
+			}),
+			want: typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_SAIL,
+		},
+		{
+			name:       "openshift service mesh 2",
+			deployment: istiodDeployment("istiod-basic", "istio-system", map[string]string{"maistra-version": "2.6.4"}),
+			want:       typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_MAISTRA,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, controlPlaneDistribution(tt.deployment))
+		})
+	}
+}
+
+func TestClient_fetchIstioControlPlaneConfig_meshScope(t *testing.T) {
+	tests := []struct {
+		name             string
+		objects          []runtime.Object
+		wantNamespace    string
+		wantDistribution typesv1alpha1.ControlPlaneDistribution
+		wantRevision     string
+		wantMembers      []string
+		wantOutbound     typesv1alpha1.OutboundTrafficPolicyMode
+	}{
+		{
+			name: "upstream without discovery selectors watches every namespace",
+			objects: []runtime.Object{
+				namespace("istio-system", nil),
+				namespace("bookinfo", nil),
+				istiodDeployment("istiod", "istio-system", nil),
+				meshConfigMap("istio", "istio-system", "accessLogFile: /dev/stdout\n"),
+			},
+			wantNamespace:    "istio-system",
+			wantDistribution: typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_UPSTREAM,
+			wantOutbound:     typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY,
+		},
+		{
+			name: "sail revision scoped by discovery selectors",
+			objects: []runtime.Object{
+				namespace("istio-system", nil),
+				namespace("bookinfo", map[string]string{"istio-discovery": "enabled"}),
+				namespace("payments", map[string]string{"team": "payments"}),
+				namespace("unmeshed", nil),
+				istiodDeployment("istiod-default-v1-24-0", "istio-system",
+					map[string]string{"istio.io/rev": "default-v1-24-0"},
+					metav1.OwnerReference{APIVersion: "sailoperator.io/v1", Kind: "IstioRevision", Name: "default-v1-24-0"}),
+				meshConfigMap("istio-default-v1-24-0", "istio-system", `discoverySelectors:
+- matchLabels:
+    istio-discovery: enabled
+- matchExpressions:
+  - key: team
+    operator: In
+    values: [payments]
+outboundTrafficPolicy:
+  mode: REGISTRY_ONLY
+`),
+			},
+			wantNamespace:    "istio-system",
+			wantDistribution: typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_SAIL,
+			wantRevision:     "default-v1-24-0",
+			wantMembers:      []string{"bookinfo", "istio-system", "payments"},
+			wantOutbound:     typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY,
+		},
+		{
+			name: "openshift service mesh 2 scoped by member roll",
+			objects: []runtime.Object{
+				namespace("mesh-control-plane", nil),
+				namespace("bookinfo", map[string]string{"maistra.io/member-of": "mesh-control-plane"}),
+				namespace("other-mesh-app", map[string]string{"maistra.io/member-of": "other-control-plane"}),
+				namespace("unmeshed", nil),
+				istiodDeployment("istiod-basic", "mesh-control-plane",
+					map[string]string{"maistra-version": "2.6.4", "istio.io/rev": "basic"}),
+			},
+			wantNamespace:    "mesh-control-plane",
+			wantDistribution: typesv1alpha1.ControlPlaneDistribution_CONTROL_PLANE_DISTRIBUTION_MAISTRA,
+			wantRevision:     "basic",
+			wantMembers:      []string{"bookinfo", "mesh-control-plane"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{clientset: fake.NewSimpleClientset(tt.objects...), logger: logging.For("test")}
+
+			config := fetchControlPlaneConfig(t, client)
+			assert.Equal(t, tt.wantNamespace, config.RootNamespace)
+			assert.Equal(t, tt.wantDistribution, config.Distribution)
+			assert.Equal(t, tt.wantRevision, config.Revision)
+			assert.Equal(t, tt.wantMembers, config.MemberNamespaces)
+			assert.Equal(t, tt.wantOutbound, config.OutboundTrafficPolicyMode)
+		})
+	}
+}
+
+func TestClient_GetClusterState_meshScope(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		namespace("istio-system", nil),
+		namespace("bookinfo", map[string]string{"istio-discovery": "enabled"}),
+		namespace("unmeshed", nil),
+		istiodDeployment("istiod", "istio-system", nil),
+		meshConfigMap("istio", "istio-system", "discoverySelectors:\n- matchLabels:\n    istio-discovery: enabled\n"),
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "unmeshed"}},
+	)
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "reviews"},
+		},
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "unmeshed"},
+			Spec:       istioapi.DestinationRule{Host: "legacy"},
+		},
+	)
+	client := &Client{clientset: k8sClient, istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, state.Services, 1)
+	assert.Equal(t, "reviews", state.Services[0].Name)
+	require.Len(t, state.DestinationRules, 1)
+	assert.Equal(t, "bookinfo", state.DestinationRules[0].Namespace)
+	assert.Equal(t, []string{"bookinfo", "istio-system"}, state.IstioControlPlaneConfig.MemberNamespaces)
+}
+
+func fetchControlPlaneConfig(t *testing.T, client *Client) *typesv1alpha1.IstioControlPlaneConfig {
+	t.Helper()
+	var result *typesv1alpha1.IstioControlPlaneConfig
+	errChan := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	client.fetchIstioControlPlaneConfig(context.Background(), &wg, &result, errChan)
+	wg.Wait()
+	close(errChan)
+	require.NoError(t, <-errChan)
+	require.NotNil(t, result)
+	return result
+}
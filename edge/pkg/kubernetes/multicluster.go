@@ -0,0 +1,140 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// multiClusterGroup is the API group of the Multi-Cluster Services API
+	multiClusterGroup = "multicluster.x-k8s.io"
+	// multiClusterVersion is the version of the Multi-Cluster Services API the edge reads
+	multiClusterVersion = "v1alpha1"
+)
+
+var (
+	serviceExportsResource = schema.GroupVersionResource{Group: multiClusterGroup, Version: multiClusterVersion, Resource: "serviceexports"}
+	serviceImportsResource = schema.GroupVersionResource{Group: multiClusterGroup, Version: multiClusterVersion, Resource: "serviceimports"}
+)
+
+// fetchServiceExports fetches and converts all MCS service exports from the cluster
+func (k *Client) fetchServiceExports(ctx context.Context, wg *sync.WaitGroup, result *[]*v1alpha1.ServiceExport, errChan chan<- error) {
+	defer wg.Done()
+	serviceExports, err := listAll[*unstructured.Unstructured](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.dynamicClient.Resource(serviceExportsResource).Namespace("").List(ctx, opts)
+	})
+	if err != nil {
+		errChan <- fmt.Errorf("failed to list service exports: %w", err)
+		return
+	}
+
+	protoServiceExports := make([]*v1alpha1.ServiceExport, 0, len(serviceExports))
+	for _, se := range serviceExports {
+		protoServiceExports = append(protoServiceExports, convertServiceExport(se))
+	}
+	*result = protoServiceExports
+}
+
+// fetchServiceImports fetches and converts all MCS service imports from the cluster
+func (k *Client) fetchServiceImports(ctx context.Context, wg *sync.WaitGroup, result *[]*v1alpha1.ServiceImport, errChan chan<- error) {
+	defer wg.Done()
+	serviceImports, err := listAll[*unstructured.Unstructured](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.dynamicClient.Resource(serviceImportsResource).Namespace("").List(ctx, opts)
+	})
+	if err != nil {
+		errChan <- fmt.Errorf("failed to list service imports: %w", err)
+		return
+	}
+
+	var protoServiceImports []*v1alpha1.ServiceImport
+	for _, si := range serviceImports {
+		protoSI, convertErr := convertServiceImport(si)
+		if convertErr != nil {
+			k.logger.Warn("failed to convert service import", "name", si.GetName(), "namespace", si.GetNamespace(), "error", convertErr)
+			telemetry.RecordConversionError("service_imports")
+			continue
+		}
+		protoServiceImports = append(protoServiceImports, protoSI)
+	}
+	*result = protoServiceImports
+}
+
+// convertServiceExport converts an MCS ServiceExport. An export is valid unless the MCS controller set its
+// Valid condition to False.
+func convertServiceExport(se *unstructured.Unstructured) *v1alpha1.ServiceExport {
+	export := &v1alpha1.ServiceExport{
+		Name:      se.GetName(),
+		Namespace: se.GetNamespace(),
+		Valid:     true,
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(se.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch condition["type"] {
+		case "Valid":
+			export.Valid = condition["status"] != string(metav1.ConditionFalse)
+		case "Conflict":
+			export.Conflict = condition["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return export
+}
+
+// convertServiceImport converts an MCS ServiceImport
+func convertServiceImport(si *unstructured.Unstructured) (*v1alpha1.ServiceImport, error) {
+	importType, _, err := unstructured.NestedString(si.Object, "spec", "type")
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec.type: %w", err)
+	}
+	ips, _, err := unstructured.NestedStringSlice(si.Object, "spec", "ips")
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec.ips: %w", err)
+	}
+	statusClusters, _, err := unstructured.NestedSlice(si.Object, "status", "clusters")
+	if err != nil {
+		return nil, fmt.Errorf("invalid status.clusters: %w", err)
+	}
+
+	var clusters []string
+	for _, c := range statusClusters {
+		if cluster, ok := c.(map[string]interface{}); ok {
+			if name, ok := cluster["cluster"].(string); ok && name != "" {
+				clusters = append(clusters, name)
+			}
+		}
+	}
+
+	return &v1alpha1.ServiceImport{
+		Name:      si.GetName(),
+		Namespace: si.GetNamespace(),
+		Type:      importType,
+		Ips:       ips,
+		Clusters:  clusters,
+	}, nil
+}
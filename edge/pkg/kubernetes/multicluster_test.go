@@ -0,0 +1,155 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newFakeDynamicClient returns a dynamic client serving the given Multi-Cluster Services API objects
+func newFakeDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		serviceExportsResource: "ServiceExportList",
+		serviceImportsResource: "ServiceImportList",
+	}, objects...)
+}
+
+// mcsObject builds an unstructured Multi-Cluster Services API object
+func mcsObject(kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: fields}
+	if obj.Object == nil {
+		obj.Object = map[string]interface{}{}
+	}
+	obj.SetAPIVersion(multiClusterGroup + "/" + multiClusterVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestConvertServiceExport(t *testing.T) {
+	tests := []struct {
+		name         string
+		conditions   []interface{}
+		wantValid    bool
+		wantConflict bool
+	}{
+		{
+			name:      "no conditions",
+			wantValid: true,
+		},
+		{
+			name: "valid",
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Valid", "status": "True"},
+				map[string]interface{}{"type": "Conflict", "status": "False"},
+			},
+			wantValid: true,
+		},
+		{
+			name: "invalid",
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Valid", "status": "False", "reason": "NoService"},
+			},
+		},
+		{
+			name: "conflict",
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Valid", "status": "True"},
+				map[string]interface{}{"type": "Conflict", "status": "True"},
+			},
+			wantValid:    true,
+			wantConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := map[string]interface{}{}
+			if tt.conditions != nil {
+				fields["status"] = map[string]interface{}{"conditions": tt.conditions}
+			}
+			export := convertServiceExport(mcsObject("ServiceExport", "bookinfo", "reviews", fields))
+			assert.Equal(t, "reviews", export.Name)
+			assert.Equal(t, "bookinfo", export.Namespace)
+			assert.Equal(t, tt.wantValid, export.Valid)
+			assert.Equal(t, tt.wantConflict, export.Conflict)
+		})
+	}
+}
+
+func TestConvertServiceImport(t *testing.T) {
+	serviceImport, err := convertServiceImport(mcsObject("ServiceImport", "bookinfo", "reviews", map[string]interface{}{
+		"spec": map[string]interface{}{
+			"type": "ClusterSetIP",
+			"ips":  []interface{}{"10.42.0.10"},
+		},
+		"status": map[string]interface{}{
+			"clusters": []interface{}{
+				map[string]interface{}{"cluster": "east"},
+				map[string]interface{}{"cluster": "west"},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "reviews", serviceImport.Name)
+	assert.Equal(t, "bookinfo", serviceImport.Namespace)
+	assert.Equal(t, "ClusterSetIP", serviceImport.Type)
+	assert.Equal(t, []string{"10.42.0.10"}, serviceImport.Ips)
+	assert.Equal(t, []string{"east", "west"}, serviceImport.Clusters)
+
+	_, err = convertServiceImport(mcsObject("ServiceImport", "bookinfo", "reviews", map[string]interface{}{
+		"spec": map[string]interface{}{"ips": "10.42.0.10"},
+	}))
+	assert.Error(t, err)
+}
+
+func TestClient_GetClusterState_multiClusterServices(t *testing.T) {
+	client := &Client{
+		clientset: fake.NewSimpleClientset(
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		),
+		istioClient: istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(
+			mcsObject("ServiceExport", "bookinfo", "reviews", nil),
+			mcsObject("ServiceImport", "bookinfo", "ratings", map[string]interface{}{
+				"spec": map[string]interface{}{"type": "ClusterSetIP", "ips": []interface{}{"10.42.0.11"}},
+			}),
+		),
+		logger: logging.For("test"),
+	}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	require.Len(t, state.ServiceExports, 1)
+	assert.Equal(t, "reviews", state.ServiceExports[0].Name)
+	assert.True(t, state.ServiceExports[0].Valid)
+	require.Len(t, state.ServiceImports, 1)
+	assert.Equal(t, "ratings", state.ServiceImports[0].Name)
+	assert.Equal(t, []string{"10.42.0.11"}, state.ServiceImports[0].Ips)
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	// minPortForwardBackoff is the least time between attempts to reconnect a dropped port-forward
+	minPortForwardBackoff = time.Second
+
+	// maxPortForwardBackoff is the most time between attempts to reconnect a dropped port-forward
+	maxPortForwardBackoff = 30 * time.Second
+)
+
+// serviceAddress is a port of a Service, addressed by its cluster DNS name
+type serviceAddress struct {
+	namespace string
+	name      string
+	port      int
+}
+
+// ForwardServiceEndpoint returns an endpoint that reaches a Service of the cluster from outside of it. If
+// the endpoint addresses an existing Service by its cluster DNS name, e.g. prometheus.istio-system:9090 or
+// http://prometheus.istio-system.svc.cluster.local:9090, a local port is forwarded to one of the Service's
+// ready pods and the endpoint is rewritten to that port. The port-forward reconnects, to another pod if
+// needed, whenever it drops until ctx is done. Any other endpoint is returned unchanged.
+func (k *Client) ForwardServiceEndpoint(ctx context.Context, endpoint string) (string, error) {
+	endpointURL, service, ok := parseServiceEndpoint(endpoint)
+	if !ok {
+		return endpoint, nil
+	}
+	if _, err := k.clientset.CoreV1().Services(service.namespace).Get(ctx, service.name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not a Service of this cluster after all, e.g. an external host with two labels
+			return endpoint, nil
+		}
+		return "", fmt.Errorf("failed to get service %s/%s: %w", service.namespace, service.name, err)
+	}
+
+	localPort, err := k.forwardService(ctx, service)
+	if err != nil {
+		return "", err
+	}
+	endpointURL.Host = net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort))
+	forwarded := endpointURL.String()
+	k.logger.Info("forwarding local port to in-cluster service", "endpoint", endpoint, "forwarded_endpoint", forwarded)
+	return forwarded, nil
+}
+
+// parseServiceEndpoint parses an endpoint with a cluster DNS name: <service>.<namespace>, optionally
+// followed by .svc and the cluster domain. Endpoints without a scheme are taken to be HTTP.
+func parseServiceEndpoint(endpoint string) (*url.URL, serviceAddress, bool) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, serviceAddress{}, false
+	}
+
+	labels := strings.Split(endpointURL.Hostname(), ".")
+	if len(labels) < 2 || (len(labels) > 2 && labels[2] != "svc") || net.ParseIP(endpointURL.Hostname()) != nil {
+		return nil, serviceAddress{}, false
+	}
+
+	port := 80
+	if endpointURL.Scheme == "https" {
+		port = 443
+	}
+	if endpointURL.Port() != "" {
+		if port, err = strconv.Atoi(endpointURL.Port()); err != nil {
+			return nil, serviceAddress{}, false
+		}
+	}
+	return endpointURL, serviceAddress{namespace: labels[1], name: labels[0], port: port}, true
+}
+
+// forwardService forwards a local port to a ready pod of a Service and keeps reconnecting it in the
+// background. It returns once the first port-forward is ready.
+func (k *Client) forwardService(ctx context.Context, service serviceAddress) (int, error) {
+	forwarder, err := k.newServiceForwarder(ctx, service, 0)
+	if err != nil {
+		return 0, err
+	}
+	done := make(chan error, 1)
+	go func() { done <- forwarder.ForwardPorts() }()
+
+	select {
+	case <-forwarder.Ready:
+	case err := <-done:
+		return 0, fmt.Errorf("failed to forward port to service %s/%s: %w", service.namespace, service.name, err)
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get forwarded port: %w", err)
+	}
+	localPort := int(ports[0].Local)
+
+	go k.reconnectServiceForward(ctx, service, localPort, done)
+	return localPort, nil
+}
+
+// reconnectServiceForward forwards the same local port again whenever the port-forward drops, backing
+// off while reconnecting fails
+func (k *Client) reconnectServiceForward(ctx context.Context, service serviceAddress, localPort int, done <-chan error) {
+	logger := k.logger.With("service", service.namespace+"/"+service.name, "local_port", localPort)
+	backoff := minPortForwardBackoff
+
+	for {
+		select {
+		case err := <-done:
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warn("port-forward to in-cluster service dropped, reconnecting", "error", err, "retry_after", backoff)
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		forwarder, err := k.newServiceForwarder(ctx, service, localPort)
+		if err != nil {
+			backoff = min(backoff*2, maxPortForwardBackoff)
+			done = failed(err)
+			continue
+		}
+		forwarding := make(chan error, 1)
+		go func() { forwarding <- forwarder.ForwardPorts() }()
+		select {
+		case <-forwarder.Ready:
+			logger.Info("reconnected port-forward to in-cluster service")
+			backoff = minPortForwardBackoff
+		case err := <-forwarding:
+			forwarding <- err
+			backoff = min(backoff*2, maxPortForwardBackoff)
+		case <-ctx.Done():
+			return
+		}
+		done = forwarding
+	}
+}
+
+// failed returns a channel that yields err, like a port-forward that failed right away
+func failed(err error) <-chan error {
+	done := make(chan error, 1)
+	done <- err
+	return done
+}
+
+// newServiceForwarder creates a port-forward from a local port, 0 for any, to a ready pod of a Service.
+// It stops when ctx is done.
+func (k *Client) newServiceForwarder(ctx context.Context, service serviceAddress, localPort int) (*portforward.PortForwarder, error) {
+	pod, podPort, err := k.resolveServicePod(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	// Port-forwarding upgrades the connection, so it uses the loaded credentials rather than the clients' transport
+	config := k.GetRestConfig()
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port-forward transport: %w", err)
+	}
+	request := k.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(service.namespace).
+		Name(pod).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, request.URL())
+
+	ports := []string{fmt.Sprintf("%d:%d", localPort, podPort)}
+	return portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, ports, ctx.Done(), make(chan struct{}), io.Discard, io.Discard)
+}
+
+// resolveServicePod returns a ready pod backing a Service port and the pod's port
+func (k *Client) resolveServicePod(ctx context.Context, service serviceAddress) (string, int, error) {
+	svc, err := k.clientset.CoreV1().Services(service.namespace).Get(ctx, service.name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get service %s/%s: %w", service.namespace, service.name, err)
+	}
+	var servicePort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if int(svc.Spec.Ports[i].Port) == service.port {
+			servicePort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return "", 0, fmt.Errorf("service %s/%s has no port %d", service.namespace, service.name, service.port)
+	}
+
+	slices, err := k.clientset.DiscoveryV1().EndpointSlices(service.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + service.name,
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to list endpoint slices of service %s/%s: %w", service.namespace, service.name, err)
+	}
+	for _, slice := range slices.Items {
+		podPort := 0
+		for _, port := range slice.Ports {
+			if port.Port != nil && (port.Name == nil || *port.Name == servicePort.Name) {
+				podPort = int(*port.Port)
+				break
+			}
+		}
+		if podPort == 0 {
+			continue
+		}
+		for _, endpoint := range slice.Endpoints {
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			if ready && endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+				return endpoint.TargetRef.Name, podPort, nil
+			}
+		}
+	}
+	return "", 0, fmt.Errorf("service %s/%s has no ready pods", service.namespace, service.name)
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseServiceEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     serviceAddress
+		ok       bool
+	}{
+		{endpoint: "prometheus.istio-system:9090", want: serviceAddress{namespace: "istio-system", name: "prometheus", port: 9090}, ok: true},
+		{endpoint: "http://prometheus.istio-system.svc:9090", want: serviceAddress{namespace: "istio-system", name: "prometheus", port: 9090}, ok: true},
+		{endpoint: "https://prometheus.monitoring.svc.cluster.local", want: serviceAddress{namespace: "monitoring", name: "prometheus", port: 443}, ok: true},
+		{endpoint: "http://prometheus.monitoring", want: serviceAddress{namespace: "monitoring", name: "prometheus", port: 80}, ok: true},
+		{endpoint: "http://localhost:9090"},
+		{endpoint: "http://127.0.0.1:9090"},
+		{endpoint: "https://prometheus.prod.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			_, service, ok := parseServiceEndpoint(tt.endpoint)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, service)
+		})
+	}
+}
+
+func TestClient_resolveServicePod(t *testing.T) {
+	ready, notReady := true, false
+	portName, podPort := "http-web", int32(9091)
+	clientset := fake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: "istio-system"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+				{Name: "http-web", Port: 9090, TargetPort: intstr.FromString("web")},
+			}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-abc",
+				Namespace: "istio-system",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "prometheus"},
+			},
+			Ports: []discoveryv1.EndpointPort{{Name: &portName, Port: &podPort}},
+			Endpoints: []discoveryv1.Endpoint{
+				{Conditions: discoveryv1.EndpointConditions{Ready: &notReady}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "prometheus-0"}},
+				{Conditions: discoveryv1.EndpointConditions{Ready: &ready}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "prometheus-1"}},
+			},
+		},
+	)
+	client := &Client{clientset: clientset, logger: logging.For("test")}
+
+	// The ready pod is used, with the pod port the service port maps to
+	pod, port, err := client.resolveServicePod(context.Background(), serviceAddress{namespace: "istio-system", name: "prometheus", port: 9090})
+	require.NoError(t, err)
+	assert.Equal(t, "prometheus-1", pod)
+	assert.Equal(t, 9091, port)
+
+	_, _, err = client.resolveServicePod(context.Background(), serviceAddress{namespace: "istio-system", name: "prometheus", port: 80})
+	assert.ErrorContains(t, err, "has no port 80")
+}
+
+func TestClient_ForwardServiceEndpoint_notAService(t *testing.T) {
+	client := &Client{clientset: fake.NewSimpleClientset(), logger: logging.For("test")}
+
+	// Endpoints that are not Services of the cluster are used as they are
+	for _, endpoint := range []string{"http://localhost:9090", "https://prometheus.example.com"} {
+		forwarded, err := client.ForwardServiceEndpoint(context.Background(), endpoint)
+		require.NoError(t, err)
+		assert.Equal(t, endpoint, forwarded)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// rediscoveryInterval is how often the resource types preflight found unavailable are checked again, so Istio
+// CRDs installed or access granted after the edge started are collected without restarting it
+const rediscoveryInterval = 5 * time.Minute
+
+// minKubernetesVersion is the oldest API server the edge supports, the first to serve discovery.k8s.io/v1 EndpointSlices
+var minKubernetesVersion = version.MustParseGeneric("v1.21.0")
+
+// collectedResource is a resource type the edge reads from the cluster
+type collectedResource struct {
+	group string
+	// versions the edge can read the resource at, most preferred first
+	versions []string
+	resource string
+	verb     string
+	// optional resources are skipped when unavailable instead of failing the sync
+	optional bool
+}
+
+// collectedResources are the resource types the edge reads from the cluster
+var collectedResources = []collectedResource{
+	{group: "", versions: []string{"v1"}, resource: "services", verb: "list"},
+	{group: "", versions: []string{"v1"}, resource: "pods", verb: "list"},
+	{group: "", versions: []string{"v1"}, resource: "namespaces", verb: "list"},
+	{group: "", versions: []string{"v1"}, resource: "configmaps", verb: "get"},
+	{group: "", versions: []string{"v1"}, resource: "nodes", verb: "list", optional: true},
+	{group: "apps", versions: []string{"v1"}, resource: "deployments", verb: "list"},
+	{group: "apps", versions: []string{"v1"}, resource: "daemonsets", verb: "list", optional: true},
+	{group: "admissionregistration.k8s.io", versions: []string{"v1"}, resource: "mutatingwebhookconfigurations", verb: "list", optional: true},
+	{group: "discovery.k8s.io", versions: []string{"v1"}, resource: "endpointslices", verb: "list"},
+	{group: "networking.istio.io", versions: []string{"v1", "v1beta1"}, resource: "destinationrules", verb: "list", optional: true},
+	{group: "networking.istio.io", versions: []string{"v1alpha3"}, resource: "envoyfilters", verb: "list", optional: true},
+	{group: "networking.istio.io", versions: []string{"v1", "v1beta1"}, resource: "gateways", verb: "list", optional: true},
+	{group: "networking.istio.io", versions: []string{"v1", "v1beta1"}, resource: "serviceentries", verb: "list", optional: true},
+	{group: "networking.istio.io", versions: []string{"v1", "v1beta1"}, resource: "sidecars", verb: "list", optional: true},
+	{group: "networking.istio.io", versions: []string{"v1", "v1beta1"}, resource: "virtualservices", verb: "list", optional: true},
+	{group: "security.istio.io", versions: []string{"v1", "v1beta1"}, resource: "authorizationpolicies", verb: "list", optional: true},
+	{group: "security.istio.io", versions: []string{"v1", "v1beta1"}, resource: "peerauthentications", verb: "list", optional: true},
+	{group: "security.istio.io", versions: []string{"v1", "v1beta1"}, resource: "requestauthentications", verb: "list", optional: true},
+	{group: "extensions.istio.io", versions: []string{"v1alpha1"}, resource: "wasmplugins", verb: "list", optional: true},
+	{group: multiClusterGroup, versions: []string{multiClusterVersion}, resource: "serviceexports", verb: "list", optional: true},
+	{group: multiClusterGroup, versions: []string{multiClusterVersion}, resource: "serviceimports", verb: "list", optional: true},
+}
+
+// Preflight checks the API server version and, for every resource type the edge collects, whether it is
+// served and whether the edge may read it. Optional resources found unavailable are skipped by later
+// cluster state collection.
+func (k *Client) Preflight(ctx context.Context) *v1alpha1.PreflightReport {
+	report := &v1alpha1.PreflightReport{CheckedAt: timestamppb.Now()}
+
+	if info, err := k.clientset.Discovery().ServerVersion(); err != nil {
+		k.logger.Warn("preflight: failed to get Kubernetes version", "error", err)
+	} else {
+		report.KubernetesVersion = info.GitVersion
+		if serverVersion, err := version.ParseGeneric(info.GitVersion); err == nil {
+			report.KubernetesVersionSupported = serverVersion.AtLeast(minKubernetesVersion)
+		}
+		if !report.KubernetesVersionSupported {
+			k.logger.Warn("preflight: unsupported Kubernetes version", "version", info.GitVersion, "minimum", minKubernetesVersion.String())
+		}
+	}
+
+	served := make(map[string]map[string]bool)
+	unavailable := make(map[string]bool)
+	for _, collected := range collectedResources {
+		capability := k.checkResource(ctx, collected, served)
+		report.Resources = append(report.Resources, capability)
+
+		if capability.Status == v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE {
+			continue
+		}
+		k.logger.Warn("preflight: resource cannot be collected",
+			"group", collected.group, "resource", collected.resource, "status", capability.Status, "message", capability.Message)
+		if collected.optional && capability.Status != v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNKNOWN {
+			unavailable[resourceKey(collected.group, collected.resource)] = true
+		}
+	}
+
+	k.mu.Lock()
+	k.unavailable = unavailable
+	k.discoveredAt = time.Now()
+	k.preflight = report
+	k.mu.Unlock()
+
+	return report
+}
+
+// PreflightReport returns the latest preflight report, nil before preflight has run. The report is replaced
+// rather than modified when resource types it found unavailable become available, so a different report
+// means the edge's capabilities changed.
+func (k *Client) PreflightReport() *v1alpha1.PreflightReport {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.preflight
+}
+
+// rediscover checks the resource types preflight found unavailable again once rediscoveryInterval has passed
+// since they were last checked. Those that have become available are collected from then on, and reported
+// available in a new preflight report.
+func (k *Client) rediscover(ctx context.Context) {
+	k.mu.RLock()
+	due := len(k.unavailable) > 0 && time.Since(k.discoveredAt) >= rediscoveryInterval
+	k.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	served := make(map[string]map[string]bool)
+	available := make(map[string]*v1alpha1.ResourceCapability)
+	for _, collected := range collectedResources {
+		if k.collectable(collected.group, collected.resource) {
+			continue
+		}
+		capability := k.checkResource(ctx, collected, served)
+		if capability.Status == v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE {
+			k.logger.Info("resource became available, collecting it from now on",
+				"group", collected.group, "resource", collected.resource, "version", capability.Version)
+			available[resourceKey(collected.group, collected.resource)] = capability
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.discoveredAt = time.Now()
+	if len(available) == 0 {
+		return
+	}
+	for key := range available {
+		delete(k.unavailable, key)
+	}
+	report := proto.Clone(k.preflight).(*v1alpha1.PreflightReport)
+	report.CheckedAt = timestamppb.Now()
+	for i, resource := range report.Resources {
+		if capability, ok := available[resourceKey(resource.Group, resource.Resource)]; ok {
+			report.Resources[i] = capability
+		}
+	}
+	k.preflight = report
+}
+
+// checkResource checks whether a resource type is served and whether the edge may read it.
+// served caches the resources of each group version across checks.
+func (k *Client) checkResource(ctx context.Context, collected collectedResource, served map[string]map[string]bool) *v1alpha1.ResourceCapability {
+	capability := &v1alpha1.ResourceCapability{
+		Group:    collected.group,
+		Version:  collected.versions[0],
+		Resource: collected.resource,
+	}
+
+	found := false
+	for _, v := range collected.versions {
+		groupVersion := v
+		if collected.group != "" {
+			groupVersion = collected.group + "/" + v
+		}
+		resources, err := k.servedResources(groupVersion, served)
+		if err != nil {
+			capability.Status = v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNKNOWN
+			capability.Message = fmt.Sprintf("failed to discover %s: %v", groupVersion, err)
+			return capability
+		}
+		if resources[collected.resource] {
+			capability.Version = v
+			found = true
+			break
+		}
+	}
+	if !found {
+		capability.Status = v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED
+		capability.Message = "the API server does not serve this resource"
+		return capability
+	}
+
+	review, err := k.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    collected.group,
+				Resource: collected.resource,
+				Verb:     collected.verb,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		capability.Status = v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNKNOWN
+		capability.Message = fmt.Sprintf("failed to review access: %v", err)
+		return capability
+	}
+	if !review.Status.Allowed {
+		capability.Status = v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_FORBIDDEN
+		capability.Message = fmt.Sprintf("the edge may not %s this resource across all namespaces", collected.verb)
+		if review.Status.Reason != "" {
+			capability.Message += ": " + review.Status.Reason
+		}
+		return capability
+	}
+
+	capability.Status = v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE
+	return capability
+}
+
+// servedResources returns the resources served at a group version. A group version the API server does
+// not serve has no resources.
+func (k *Client) servedResources(groupVersion string, served map[string]map[string]bool) (map[string]bool, error) {
+	if resources, ok := served[groupVersion]; ok {
+		return resources, nil
+	}
+
+	resources := make(map[string]bool)
+	list, err := k.clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if list != nil {
+		for _, resource := range list.APIResources {
+			resources[resource.Name] = true
+		}
+	}
+	served[groupVersion] = resources
+	return resources, nil
+}
+
+// collectable reports whether a resource type should be collected. Resources are collected unless
+// preflight found them unavailable.
+func (k *Client) collectable(group, resource string) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return !k.unavailable[resourceKey(group, resource)]
+}
+
+// resourceKey identifies a resource type
+func resourceKey(group, resource string) string {
+	return group + "/" + resource
+}
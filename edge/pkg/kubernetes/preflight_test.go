@@ -0,0 +1,199 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// apiResources builds a discovery resource list for a group version
+func apiResources(groupVersion string, resources ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, resource := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: resource})
+	}
+	return list
+}
+
+// preflightClient returns a client for a cluster serving the given resources, where the edge may read
+// everything except the forbidden resources
+func preflightClient(gitVersion string, resources []*metav1.APIResourceList, forbidden ...string) (*Client, *fake.Clientset) {
+	k8sClient := fake.NewSimpleClientset()
+	discovery := k8sClient.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.FakedServerVersion = &version.Info{GitVersion: gitVersion}
+	discovery.Resources = resources
+
+	k8sClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = true
+		for _, resource := range forbidden {
+			if review.Spec.ResourceAttributes.Resource == resource {
+				review.Status.Allowed = false
+				review.Status.Reason = "RBAC: access denied"
+			}
+		}
+		return true, review, nil
+	})
+
+	return &Client{clientset: k8sClient, istioClient: istiofake.NewSimpleClientset(), dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}, k8sClient
+}
+
+// coreResources are the Kubernetes resources served by every supported cluster
+var coreResources = []*metav1.APIResourceList{
+	apiResources("v1", "services", "pods", "namespaces", "configmaps"),
+	apiResources("apps/v1", "deployments", "daemonsets"),
+	apiResources("discovery.k8s.io/v1", "endpointslices"),
+}
+
+func capabilityFor(t *testing.T, report *v1alpha1.PreflightReport, resource string) *v1alpha1.ResourceCapability {
+	t.Helper()
+	for _, capability := range report.Resources {
+		if capability.Resource == resource {
+			return capability
+		}
+	}
+	t.Fatalf("no capability reported for %s", resource)
+	return nil
+}
+
+func TestClient_Preflight(t *testing.T) {
+	resources := append([]*metav1.APIResourceList{
+		apiResources("networking.istio.io/v1", "destinationrules", "gateways", "serviceentries", "sidecars", "virtualservices"),
+		apiResources("networking.istio.io/v1alpha3", "envoyfilters"),
+		apiResources("security.istio.io/v1beta1", "authorizationpolicies", "peerauthentications", "requestauthentications"),
+	}, coreResources...)
+	client, _ := preflightClient("v1.30.2", resources, "peerauthentications")
+
+	report := client.Preflight(context.Background())
+
+	assert.Equal(t, "v1.30.2", report.KubernetesVersion)
+	assert.True(t, report.KubernetesVersionSupported)
+	assert.NotNil(t, report.CheckedAt)
+	assert.Len(t, report.Resources, len(collectedResources))
+
+	services := capabilityFor(t, report, "services")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE, services.Status)
+
+	destinationRules := capabilityFor(t, report, "destinationrules")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE, destinationRules.Status)
+	assert.Equal(t, "v1", destinationRules.Version)
+
+	// Security resources are only served at v1beta1 on this cluster
+	authorizationPolicies := capabilityFor(t, report, "authorizationpolicies")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE, authorizationPolicies.Status)
+	assert.Equal(t, "v1beta1", authorizationPolicies.Version)
+
+	peerAuthentications := capabilityFor(t, report, "peerauthentications")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_FORBIDDEN, peerAuthentications.Status)
+	assert.Contains(t, peerAuthentications.Message, "RBAC: access denied")
+
+	wasmPlugins := capabilityFor(t, report, "wasmplugins")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED, wasmPlugins.Status)
+
+	assert.True(t, client.collectable("networking.istio.io", "destinationrules"))
+	assert.False(t, client.collectable("security.istio.io", "peerauthentications"))
+	assert.False(t, client.collectable("extensions.istio.io", "wasmplugins"))
+}
+
+func TestClient_rediscover(t *testing.T) {
+	client, k8sClient := preflightClient("v1.30.2", coreResources)
+	report := client.Preflight(context.Background())
+	require.False(t, client.collectable("networking.istio.io", "virtualservices"))
+	require.Same(t, report, client.PreflightReport())
+
+	// Istio is installed after the edge started
+	discovery := k8sClient.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.Resources = append(discovery.Resources, apiResources("networking.istio.io/v1", "virtualservices"))
+
+	client.rediscover(context.Background())
+	assert.False(t, client.collectable("networking.istio.io", "virtualservices"), "not checked again before the interval")
+	assert.Same(t, report, client.PreflightReport())
+
+	client.discoveredAt = time.Now().Add(-rediscoveryInterval)
+	client.rediscover(context.Background())
+	assert.True(t, client.collectable("networking.istio.io", "virtualservices"))
+	assert.False(t, client.collectable("extensions.istio.io", "wasmplugins"))
+
+	// The newly available type is reported in a new report, leaving the one already sent untouched
+	rediscovered := client.PreflightReport()
+	require.NotSame(t, report, rediscovered)
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE, capabilityFor(t, rediscovered, "virtualservices").Status)
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED, capabilityFor(t, report, "virtualservices").Status)
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED, capabilityFor(t, rediscovered, "wasmplugins").Status)
+
+	// Nothing else becoming available keeps the report
+	client.discoveredAt = time.Now().Add(-rediscoveryInterval)
+	client.rediscover(context.Background())
+	assert.Same(t, rediscovered, client.PreflightReport())
+}
+
+func TestClient_Preflight_unsupportedKubernetesVersion(t *testing.T) {
+	client, _ := preflightClient("v1.20.15", coreResources)
+
+	report := client.Preflight(context.Background())
+
+	assert.Equal(t, "v1.20.15", report.KubernetesVersion)
+	assert.False(t, report.KubernetesVersionSupported)
+}
+
+func TestClient_Preflight_accessReviewFailure(t *testing.T) {
+	client, k8sClient := preflightClient("v1.30.2", append([]*metav1.APIResourceList{
+		apiResources("extensions.istio.io/v1alpha1", "wasmplugins"),
+	}, coreResources...))
+	k8sClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+
+	report := client.Preflight(context.Background())
+
+	wasmPlugins := capabilityFor(t, report, "wasmplugins")
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNKNOWN, wasmPlugins.Status)
+	// Resources that could not be checked are still collected
+	assert.True(t, client.collectable("extensions.istio.io", "wasmplugins"))
+}
+
+func TestClient_GetClusterState_skipsUnavailableResources(t *testing.T) {
+	client, _ := preflightClient("v1.30.2", coreResources)
+	// Without the CRDs installed listing Istio resources fails
+	client.istioClient.(*istiofake.Clientset).PrependReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("the server could not find the requested resource")
+	})
+
+	_, err := client.GetClusterState(context.Background())
+	require.Error(t, err)
+
+	report := client.Preflight(context.Background())
+	assert.Equal(t, v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED, capabilityFor(t, report, "virtualservices").Status)
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, state.VirtualServices)
+}
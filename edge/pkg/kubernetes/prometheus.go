@@ -0,0 +1,116 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// prometheusInstallation is a well-known way of installing Prometheus, and how to find its Service
+type prometheusInstallation struct {
+	name      string
+	namespace string // Namespace and name of the Service, if it has a fixed one
+	service   string
+	selector  string // Labels of the Service otherwise, looked up in every namespace
+}
+
+// prometheusInstallations are the installations DiscoverPrometheus looks for, in order of preference
+var prometheusInstallations = []prometheusInstallation{
+	{name: "istio-addon", namespace: "istio-system", service: "prometheus"},
+	{name: "kube-prometheus-stack", selector: "app=kube-prometheus-stack-prometheus"},
+	{name: "prometheus-operator", selector: "operated-prometheus=true"},
+	{name: "prometheus-chart", selector: "app.kubernetes.io/name=prometheus,app.kubernetes.io/component=server"},
+}
+
+// prometheusPortNames are the names Prometheus charts give the port of the web API
+var prometheusPortNames = map[string]bool{"web": true, "http-web": true, "http": true}
+
+// DiscoverPrometheus looks for a well-known Prometheus installation in the cluster: the Istio addon,
+// kube-prometheus-stack, the Prometheus Operator or the prometheus-community chart. It returns the
+// endpoint of its Service by cluster DNS name, e.g. http://prometheus.istio-system:9090, and the name of
+// the installation, or an empty endpoint if none is found. Lookups the client is not allowed to make
+// are skipped.
+func (k *Client) DiscoverPrometheus(ctx context.Context) (string, string, error) {
+	for _, installation := range prometheusInstallations {
+		var services []corev1.Service
+		if installation.selector == "" {
+			service, err := k.clientset.CoreV1().Services(installation.namespace).Get(ctx, installation.service, metav1.GetOptions{})
+			if err != nil {
+				if skipDiscoveryError(err) {
+					continue
+				}
+				return "", "", fmt.Errorf("failed to get service %s/%s: %w", installation.namespace, installation.service, err)
+			}
+			services = append(services, *service)
+		} else {
+			list, err := k.clientset.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: installation.selector})
+			if err != nil {
+				if skipDiscoveryError(err) {
+					continue
+				}
+				return "", "", fmt.Errorf("failed to list services %s: %w", installation.selector, err)
+			}
+			services = list.Items
+		}
+
+		// Prefer the first of several installations by namespace and name, so discovery is deterministic
+		sort.Slice(services, func(i, j int) bool {
+			if services[i].Namespace != services[j].Namespace {
+				return services[i].Namespace < services[j].Namespace
+			}
+			return services[i].Name < services[j].Name
+		})
+		for _, service := range services {
+			if port, ok := prometheusPort(&service); ok {
+				endpoint := "http://" + service.Name + "." + service.Namespace + ":" + strconv.Itoa(int(port))
+				return endpoint, installation.name, nil
+			}
+		}
+	}
+	return "", "", nil
+}
+
+// prometheusPort returns the port of the web API of a Prometheus Service: 9090, a port with a well-known
+// name, or its only port
+func prometheusPort(service *corev1.Service) (int32, bool) {
+	ports := service.Spec.Ports
+	for _, port := range ports {
+		if port.Port == 9090 {
+			return port.Port, true
+		}
+	}
+	for _, port := range ports {
+		if prometheusPortNames[port.Name] {
+			return port.Port, true
+		}
+	}
+	if len(ports) == 1 {
+		return ports[0].Port, true
+	}
+	return 0, false
+}
+
+// skipDiscoveryError reports whether a lookup failed only because there is nothing to find, or nothing
+// the client is allowed to see
+func skipDiscoveryError(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsForbidden(err)
+}
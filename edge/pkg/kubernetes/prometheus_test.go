@@ -0,0 +1,117 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestClient_DiscoverPrometheus(t *testing.T) {
+	kubePrometheusStack := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "monitoring-kube-prometheus-prometheus",
+			Namespace: "monitoring",
+			Labels:    map[string]string{"app": "kube-prometheus-stack-prometheus"},
+		},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+			{Name: "reloader-web", Port: 8080},
+			{Name: "http-web", Port: 9090},
+		}},
+	}
+	istioAddon := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: "istio-system"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 9090}}},
+	}
+	chart := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-server",
+			Namespace: "prometheus",
+			Labels:    map[string]string{"app.kubernetes.io/name": "prometheus", "app.kubernetes.io/component": "server"},
+		},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+
+	tests := []struct {
+		name             string
+		services         []runtime.Object
+		wantEndpoint     string
+		wantInstallation string
+	}{
+		{name: "none"},
+		{
+			name:             "istio addon preferred",
+			services:         []runtime.Object{kubePrometheusStack, istioAddon},
+			wantEndpoint:     "http://prometheus.istio-system:9090",
+			wantInstallation: "istio-addon",
+		},
+		{
+			name:             "kube-prometheus-stack",
+			services:         []runtime.Object{chart, kubePrometheusStack},
+			wantEndpoint:     "http://monitoring-kube-prometheus-prometheus.monitoring:9090",
+			wantInstallation: "kube-prometheus-stack",
+		},
+		{
+			name:             "prometheus chart",
+			services:         []runtime.Object{chart},
+			wantEndpoint:     "http://prometheus-server.prometheus:80",
+			wantInstallation: "prometheus-chart",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{clientset: fake.NewSimpleClientset(tt.services...), logger: logging.For("test")}
+
+			endpoint, installation, err := client.DiscoverPrometheus(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEndpoint, endpoint)
+			assert.Equal(t, tt.wantInstallation, installation)
+		})
+	}
+}
+
+func TestClient_DiscoverPrometheus_forbidden(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: "istio-system"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 9090}}},
+	})
+	// Only namespaced lookups are allowed
+	clientset.PrependReactor("list", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(corev1.Resource("services"), "", nil)
+	})
+	client := &Client{clientset: clientset, logger: logging.For("test")}
+
+	endpoint, installation, err := client.DiscoverPrometheus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://prometheus.istio-system:9090", endpoint)
+	assert.Equal(t, "istio-addon", installation)
+
+	// Listing is skipped rather than failing discovery
+	require.NoError(t, clientset.CoreV1().Services("istio-system").Delete(context.Background(), "prometheus", metav1.DeleteOptions{}))
+	endpoint, _, err = client.DiscoverPrometheus(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, endpoint)
+}
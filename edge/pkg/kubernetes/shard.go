@@ -0,0 +1,51 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"hash/fnv"
+	"slices"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+)
+
+// SetNamespaceShard limits cluster state collection to the namespaces of a shard, for clusters too large
+// for a single edge. A nil shard collects every namespace.
+func (k *Client) SetNamespaceShard(shard *v1alpha1.NamespaceShard) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.shard = shard
+}
+
+// namespaceShard returns the namespace shard collected by this edge, or nil if it collects every namespace
+func (k *Client) namespaceShard() *v1alpha1.NamespaceShard {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.shard
+}
+
+// ownsNamespace reports whether a namespace belongs to a shard. Every namespace belongs to a nil shard.
+func ownsNamespace(shard *v1alpha1.NamespaceShard, namespace string) bool {
+	switch {
+	case shard == nil:
+		return true
+	case shard.Count > 0:
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(namespace))
+		return hash.Sum32()%shard.Count == shard.Index
+	default:
+		return slices.Contains(shard.Namespaces, namespace)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioapi "istio.io/api/networking/v1alpha3"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestOwnsNamespace_hash(t *testing.T) {
+	const count = 4
+	owners := make([]int, count)
+	for i := 0; i < 100; i++ {
+		namespace := fmt.Sprintf("team-%d", i)
+
+		owned := 0
+		for index := uint32(0); index < count; index++ {
+			if ownsNamespace(&v1alpha1.NamespaceShard{Index: index, Count: count}, namespace) {
+				owners[index]++
+				owned++
+			}
+		}
+		assert.Equal(t, 1, owned, "Expected %s to belong to exactly one shard", namespace)
+	}
+	for index, owned := range owners {
+		assert.NotZero(t, owned, "Expected shard %d to own some namespaces", index)
+	}
+}
+
+func TestOwnsNamespace_explicit(t *testing.T) {
+	shard := &v1alpha1.NamespaceShard{Namespaces: []string{"bookinfo", "payments"}}
+
+	assert.True(t, ownsNamespace(shard, "bookinfo"))
+	assert.False(t, ownsNamespace(shard, "istio-system"))
+	assert.True(t, ownsNamespace(nil, "istio-system"))
+}
+
+func TestClient_GetClusterState_namespaceShard(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "ledger", Namespace: "payments"}},
+	)
+	istioClient := istiofake.NewSimpleClientset(
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+			Spec:       istioapi.DestinationRule{Host: "reviews"},
+		},
+		&istionetworkingv1.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "ledger", Namespace: "payments"},
+			Spec:       istioapi.DestinationRule{Host: "ledger"},
+		},
+	)
+	client := &Client{clientset: k8sClient, istioClient: istioClient, dynamicClient: newFakeDynamicClient(), logger: logging.For("test")}
+	client.SetNamespaceShard(&v1alpha1.NamespaceShard{Namespaces: []string{"payments"}})
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, state.Services, 1)
+	assert.Equal(t, "ledger", state.Services[0].Name)
+	require.Len(t, state.DestinationRules, 1)
+	assert.Equal(t, "payments", state.DestinationRules[0].Namespace)
+}
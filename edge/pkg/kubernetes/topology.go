@@ -0,0 +1,84 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Node topology labels, preferring the well-known labels over the deprecated failure-domain ones
+var (
+	regionLabels = []string{corev1.LabelTopologyRegion, corev1.LabelFailureDomainBetaRegion}
+	zoneLabels   = []string{corev1.LabelTopologyZone, corev1.LabelFailureDomainBetaZone}
+)
+
+// nodeTopology is the region and zone of a node from its topology labels
+type nodeTopology struct {
+	region string
+	zone   string
+}
+
+// fetchNodes fetches the topology of every node from the datastore, keyed by node name
+func (k *Client) fetchNodes(ctx context.Context, wg *sync.WaitGroup, nodesByName *map[string]nodeTopology, errChan chan<- error) {
+	defer wg.Done()
+	nodes, err := k.workloadDatastore().ListNodes(ctx)
+	if err != nil {
+		errChan <- fmt.Errorf("failed to list nodes: %w", err)
+		return
+	}
+	result := make(map[string]nodeTopology, len(nodes))
+	for _, node := range nodes {
+		result[node.Name] = nodeTopology{
+			region: firstLabel(node.Labels, regionLabels),
+			zone:   firstLabel(node.Labels, zoneLabels),
+		}
+	}
+	*nodesByName = result
+}
+
+// firstLabel returns the value of the first of keys set in labels
+func firstLabel(labels map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value := labels[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// applyNodeTopology completes an instance's locality from the topology labels of its node: the region, and the
+// zone when the EndpointSlice did not report one. The locality is left unset when neither is known.
+func applyNodeTopology(instance *backendv1alpha1.ServiceInstance, nodesByName map[string]nodeTopology) {
+	topology, exists := nodesByName[instance.NodeName]
+	if !exists {
+		return
+	}
+	if instance.Locality == nil {
+		if topology.region == "" && topology.zone == "" {
+			return
+		}
+		instance.Locality = &typesv1alpha1.LocalityInfo{}
+	}
+	instance.Locality.Region = topology.region
+	if instance.Locality.Zone == "" {
+		instance.Locality.Zone = topology.zone
+	}
+}
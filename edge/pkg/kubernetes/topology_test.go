@@ -0,0 +1,86 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClient_GetClusterState_InstanceLocality(t *testing.T) {
+	zoneA := "us-east-1a"
+	pod := func(name, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bookinfo"},
+			Spec:       corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "reviews"}}},
+		}
+	}
+	objects := []runtime.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a", Labels: map[string]string{
+			corev1.LabelTopologyRegion: "us-east-1",
+			corev1.LabelTopologyZone:   zoneA,
+		}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b", Labels: map[string]string{
+			corev1.LabelFailureDomainBetaRegion: "us-east-1",
+			corev1.LabelFailureDomainBetaZone:   "us-east-1b",
+		}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		pod("reviews-a", "node-a"),
+		pod("reviews-b", "node-b"),
+		pod("reviews-c", "node-c"),
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews-abc", Namespace: "bookinfo", Labels: map[string]string{"kubernetes.io/service-name": "reviews"}},
+			Endpoints: []discoveryv1.Endpoint{
+				{
+					Addresses: []string{"10.0.0.1"},
+					TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-a"},
+					Zone:      &zoneA,
+					Hints:     &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: zoneA}}},
+				},
+				{Addresses: []string{"10.0.0.2"}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-b"}},
+				{Addresses: []string{"10.0.0.3"}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "reviews-c"}},
+			},
+		},
+	}
+	client := &Client{
+		clientset:     fake.NewSimpleClientset(objects...),
+		istioClient:   istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
+	}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	require.Len(t, state.Services, 1)
+	instances := state.Services[0].Instances
+	require.Len(t, instances, 3)
+
+	assert.Equal(t, &typesv1alpha1.LocalityInfo{Region: "us-east-1", Zone: zoneA}, instances[0].Locality)
+	assert.Equal(t, []string{zoneA}, instances[0].ZoneHints)
+	assert.Equal(t, &typesv1alpha1.LocalityInfo{Region: "us-east-1", Zone: "us-east-1b"}, instances[1].Locality)
+	assert.Empty(t, instances[1].ZoneHints)
+	assert.Nil(t, instances[2].Locality, "node-c is unknown")
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// injectionWebhookSuffix ends the name of every Istio sidecar injection webhook, such as
+	// namespace.sidecar-injector.istio.io and rev.object.sidecar-injector.istio.io
+	injectionWebhookSuffix = "sidecar-injector.istio.io"
+
+	// revisionTagLabel holds the revision tag a MutatingWebhookConfiguration implements
+	revisionTagLabel = "istio.io/tag"
+
+	// injectionLabel enables injection of a namespace by the default revision
+	injectionLabel = "istio-injection"
+)
+
+// sidecarInjection are the collected sidecar injection webhooks and the namespaces they select from
+type sidecarInjection struct {
+	webhooks   []injectionWebhook
+	namespaces []*corev1.Namespace
+}
+
+// injectionWebhook is a converted injection webhook with its parsed namespace selector
+type injectionWebhook struct {
+	webhook           *typesv1alpha1.InjectionWebhook
+	namespaceSelector labels.Selector
+}
+
+// fetchSidecarInjection collects the sidecar injection webhooks and the namespaces of the cluster.
+// Collection is best effort: if either cannot be listed the result is nil and injection is not reported.
+func (k *Client) fetchSidecarInjection(ctx context.Context, wg *sync.WaitGroup, result **sidecarInjection) {
+	defer wg.Done()
+
+	if !k.collectable("admissionregistration.k8s.io", "mutatingwebhookconfigurations") {
+		return
+	}
+	configurations, err := listAll[*admissionregistrationv1.MutatingWebhookConfiguration](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, opts)
+	})
+	if err != nil {
+		k.logger.Warn("failed to list mutating webhook configurations, not reporting sidecar injection", "error", err)
+		return
+	}
+	namespaces, err := listAll[*corev1.Namespace](ctx, metav1.ListOptions{}, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return k.clientset.CoreV1().Namespaces().List(ctx, opts)
+	})
+	if err != nil {
+		k.logger.Warn("failed to list namespaces, not reporting sidecar injection", "error", err)
+		return
+	}
+
+	injection := &sidecarInjection{namespaces: namespaces}
+	for _, configuration := range configurations {
+		for _, webhook := range configuration.Webhooks {
+			if !strings.HasSuffix(webhook.Name, injectionWebhookSuffix) {
+				continue
+			}
+			converted, err := convertInjectionWebhook(configuration, webhook)
+			if err != nil {
+				k.logger.Warn("skipping injection webhook with invalid selector",
+					"configuration", configuration.Name, "webhook", webhook.Name, "error", err)
+				continue
+			}
+			injection.webhooks = append(injection.webhooks, converted)
+		}
+	}
+	sort.Slice(injection.webhooks, func(i, j int) bool {
+		a, b := injection.webhooks[i].webhook, injection.webhooks[j].webhook
+		if a.Configuration != b.Configuration {
+			return a.Configuration < b.Configuration
+		}
+		return a.Name < b.Name
+	})
+	*result = injection
+}
+
+// convertInjectionWebhook converts a sidecar injection webhook of a MutatingWebhookConfiguration
+func convertInjectionWebhook(configuration *admissionregistrationv1.MutatingWebhookConfiguration, webhook admissionregistrationv1.MutatingWebhook) (injectionWebhook, error) {
+	namespaceSelector, err := webhookSelector(webhook.NamespaceSelector)
+	if err != nil {
+		return injectionWebhook{}, fmt.Errorf("namespace selector: %w", err)
+	}
+	objectSelector, err := webhookSelector(webhook.ObjectSelector)
+	if err != nil {
+		return injectionWebhook{}, fmt.Errorf("object selector: %w", err)
+	}
+
+	converted := &typesv1alpha1.InjectionWebhook{
+		Configuration:     configuration.Name,
+		Name:              webhook.Name,
+		Revision:          configuration.Labels[revisionLabel],
+		Tag:               configuration.Labels[revisionTagLabel],
+		NamespaceSelector: namespaceSelector.String(),
+		ObjectSelector:    objectSelector.String(),
+		OptIn:             requiresLabels(webhook.ObjectSelector),
+	}
+	if converted.Revision == "" {
+		converted.Revision = defaultRevision
+	}
+	switch {
+	case webhook.ClientConfig.Service != nil:
+		converted.Service = webhook.ClientConfig.Service.Name + "." + webhook.ClientConfig.Service.Namespace
+	case webhook.ClientConfig.URL != nil:
+		converted.Service = *webhook.ClientConfig.URL
+	}
+	return injectionWebhook{webhook: converted, namespaceSelector: namespaceSelector}, nil
+}
+
+// webhookSelector parses a webhook selector. Unlike other label selectors, an unset webhook selector
+// selects everything.
+func webhookSelector(selector *metav1.LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// requiresLabels reports whether a selector only selects objects carrying certain labels, rather than
+// every object without certain labels
+func requiresLabels(selector *metav1.LabelSelector) bool {
+	if selector == nil {
+		return false
+	}
+	if len(selector.MatchLabels) > 0 {
+		return true
+	}
+	for _, expression := range selector.MatchExpressions {
+		if expression.Operator == metav1.LabelSelectorOpIn || expression.Operator == metav1.LabelSelectorOpExists {
+			return true
+		}
+	}
+	return false
+}
+
+// sidecarInjectionStatus explains which revision injects each collected namespace by matching the
+// namespace selectors of the injection webhooks against the namespace labels
+func sidecarInjectionStatus(injection *sidecarInjection, collected func(namespace string) bool) *typesv1alpha1.SidecarInjectionStatus {
+	if injection == nil {
+		return nil
+	}
+
+	status := &typesv1alpha1.SidecarInjectionStatus{}
+	for _, webhook := range injection.webhooks {
+		status.Webhooks = append(status.Webhooks, webhook.webhook)
+	}
+	for _, namespace := range injection.namespaces {
+		if collected(namespace.Name) {
+			status.Namespaces = append(status.Namespaces, namespaceInjection(namespace, injection.webhooks))
+		}
+	}
+	sort.Slice(status.Namespaces, func(i, j int) bool {
+		return status.Namespaces[i].Namespace < status.Namespaces[j].Namespace
+	})
+	return status
+}
+
+// namespaceInjection matches the injection webhooks against the labels of a namespace
+func namespaceInjection(namespace *corev1.Namespace, webhooks []injectionWebhook) *typesv1alpha1.NamespaceInjection {
+	injection := &typesv1alpha1.NamespaceInjection{Namespace: namespace.Name}
+	for _, label := range []string{injectionLabel, revisionLabel} {
+		if value, ok := namespace.Labels[label]; ok {
+			if injection.Labels == nil {
+				injection.Labels = make(map[string]string)
+			}
+			injection.Labels[label] = value
+		}
+	}
+
+	// Revisions injecting every pod, with the first webhook of each
+	injecting := make(map[string]*typesv1alpha1.InjectionWebhook)
+	optIn := make(map[string]bool)
+	for _, webhook := range webhooks {
+		if !webhook.namespaceSelector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		injection.Webhooks = append(injection.Webhooks, webhook.webhook.Configuration+"/"+webhook.webhook.Name)
+		if webhook.webhook.OptIn {
+			optIn[webhook.webhook.Revision] = true
+		} else if injecting[webhook.webhook.Revision] == nil {
+			injecting[webhook.webhook.Revision] = webhook.webhook
+		}
+	}
+	for revision := range injecting {
+		delete(optIn, revision)
+	}
+	injection.OptInRevisions = slices.Sorted(maps.Keys(optIn))
+
+	revisions := slices.Sorted(maps.Keys(injecting))
+	switch {
+	case len(revisions) > 1:
+		injection.Revision = revisions[0]
+		injection.Conflict = true
+		injection.Explanation = fmt.Sprintf("Webhooks of revisions %s all select the namespace, so its pods are injected more than once", strings.Join(revisions, ", "))
+	case len(revisions) == 1:
+		webhook := injecting[revisions[0]]
+		injection.Revision = revisions[0]
+		injection.Explanation = fmt.Sprintf("Webhook %s of %s selects the namespace, so revision %s injects its pods", webhook.Name, webhook.Configuration, injection.Revision)
+		if webhook.Tag != "" {
+			injection.Explanation = fmt.Sprintf("Webhook %s of %s selects the namespace, so revision %s injects its pods through tag %s", webhook.Name, webhook.Configuration, injection.Revision, webhook.Tag)
+		}
+	case len(injection.Labels) > 0:
+		injection.Explanation = fmt.Sprintf("The namespace is labelled %s but no injection webhook selects it, so its pods are not injected", labels.Set(injection.Labels))
+	default:
+		injection.Explanation = "No injection webhook selects the namespace, so its pods are not injected"
+	}
+	if injection.Revision == "" && len(injection.OptInRevisions) > 0 {
+		injection.Explanation += fmt.Sprintf(" unless they opt in to revision %s", strings.Join(injection.OptInRevisions, " or "))
+	}
+	return injection
+}
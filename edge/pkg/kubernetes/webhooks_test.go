@@ -0,0 +1,184 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// injectorConfiguration builds a MutatingWebhookConfiguration with the namespace and object injection
+// webhooks Istio installs for a revision, or for a revision tag when tag is set
+func injectorConfiguration(name, revision, tag string) *admissionregistrationv1.MutatingWebhookConfiguration {
+	configuration := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"app": "sidecar-injector", "istio.io/rev": revision}},
+	}
+	service := &admissionregistrationv1.ServiceReference{Name: "istiod", Namespace: "istio-system"}
+	if revision != "default" {
+		service.Name = "istiod-" + revision
+	}
+	notInjectionLabelled := metav1.LabelSelectorRequirement{Key: "istio-injection", Operator: metav1.LabelSelectorOpDoesNotExist}
+	notRevisionLabelled := metav1.LabelSelectorRequirement{Key: "istio.io/rev", Operator: metav1.LabelSelectorOpDoesNotExist}
+
+	if revision == "default" && tag == "" {
+		configuration.Webhooks = []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:              "namespace.sidecar-injector.istio.io",
+				ClientConfig:      admissionregistrationv1.WebhookClientConfig{Service: service},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"istio-injection": "enabled"}},
+				ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "sidecar.istio.io/inject", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"false"}},
+				}},
+			},
+			{
+				Name:              "object.sidecar-injector.istio.io",
+				ClientConfig:      admissionregistrationv1.WebhookClientConfig{Service: service},
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{notInjectionLabelled, notRevisionLabelled}},
+				ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "sidecar.istio.io/inject", Operator: metav1.LabelSelectorOpIn, Values: []string{"true"}},
+					notRevisionLabelled,
+				}},
+			},
+		}
+		return configuration
+	}
+
+	selected := revision
+	if tag != "" {
+		configuration.Labels["istio.io/tag"] = tag
+		selected = tag
+	}
+	configuration.Webhooks = []admissionregistrationv1.MutatingWebhook{
+		{
+			Name:         "rev.namespace.sidecar-injector.istio.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: service},
+			NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "istio.io/rev", Operator: metav1.LabelSelectorOpIn, Values: []string{selected}},
+				notInjectionLabelled,
+			}},
+		},
+		{
+			Name:              "rev.object.sidecar-injector.istio.io",
+			ClientConfig:      admissionregistrationv1.WebhookClientConfig{Service: service},
+			NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{notInjectionLabelled, notRevisionLabelled}},
+			ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "istio.io/rev", Operator: metav1.LabelSelectorOpIn, Values: []string{selected}},
+			}},
+		},
+	}
+	return configuration
+}
+
+// labelledNamespace builds a namespace with the given labels
+func labelledNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestClient_GetClusterState_sidecarInjection(t *testing.T) {
+	objects := []runtime.Object{
+		injectorConfiguration("istio-sidecar-injector", "default", ""),
+		injectorConfiguration("istio-sidecar-injector-canary", "canary", ""),
+		injectorConfiguration("istio-revision-tag-prod", "canary", "prod"),
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "cert-manager-webhook"},
+			Webhooks:   []admissionregistrationv1.MutatingWebhook{{Name: "webhook.cert-manager.io"}},
+		},
+		labelledNamespace("bookinfo", map[string]string{"istio-injection": "enabled"}),
+		labelledNamespace("reviews", map[string]string{"istio.io/rev": "prod"}),
+		labelledNamespace("legacy", map[string]string{"istio.io/rev": "1-24"}),
+		labelledNamespace("plain", nil),
+	}
+	client := &Client{
+		clientset:     fake.NewSimpleClientset(objects...),
+		istioClient:   istiofake.NewSimpleClientset(),
+		dynamicClient: newFakeDynamicClient(),
+		logger:        logging.For("test"),
+	}
+
+	state, err := client.GetClusterState(context.Background())
+	require.NoError(t, err)
+
+	injection := state.SidecarInjection
+	require.NotNil(t, injection)
+	require.Len(t, injection.Webhooks, 6, "Expected only the sidecar injection webhooks")
+	tagWebhook := injection.Webhooks[0]
+	assert.Equal(t, "istio-revision-tag-prod", tagWebhook.Configuration)
+	assert.Equal(t, "rev.namespace.sidecar-injector.istio.io", tagWebhook.Name)
+	assert.Equal(t, "canary", tagWebhook.Revision)
+	assert.Equal(t, "prod", tagWebhook.Tag)
+	assert.Equal(t, "!istio-injection,istio.io/rev in (prod)", tagWebhook.NamespaceSelector)
+	assert.Equal(t, "istiod-canary.istio-system", tagWebhook.Service)
+	assert.False(t, tagWebhook.OptIn)
+	assert.True(t, injection.Webhooks[1].OptIn, "Expected the object webhook to require pods to opt in")
+
+	require.Len(t, injection.Namespaces, 4)
+	namespaces := make(map[string]string)
+	for _, namespace := range injection.Namespaces {
+		namespaces[namespace.Namespace] = namespace.Revision
+	}
+	assert.Equal(t, map[string]string{"bookinfo": "default", "legacy": "", "plain": "", "reviews": "canary"}, namespaces)
+
+	bookinfo := injection.Namespaces[0]
+	assert.Equal(t, map[string]string{"istio-injection": "enabled"}, bookinfo.Labels)
+	assert.Equal(t, []string{"istio-sidecar-injector/namespace.sidecar-injector.istio.io"}, bookinfo.Webhooks)
+	assert.Equal(t, "Webhook namespace.sidecar-injector.istio.io of istio-sidecar-injector selects the namespace, so revision default injects its pods", bookinfo.Explanation)
+
+	legacy := injection.Namespaces[1]
+	assert.Empty(t, legacy.Webhooks)
+	assert.Equal(t, "The namespace is labelled istio.io/rev=1-24 but no injection webhook selects it, so its pods are not injected", legacy.Explanation)
+
+	plain := injection.Namespaces[2]
+	assert.Equal(t, []string{"canary", "default"}, plain.OptInRevisions)
+	assert.Equal(t, "No injection webhook selects the namespace, so its pods are not injected unless they opt in to revision canary or default", plain.Explanation)
+
+	reviews := injection.Namespaces[3]
+	assert.Equal(t, "Webhook rev.namespace.sidecar-injector.istio.io of istio-revision-tag-prod selects the namespace, so revision canary injects its pods through tag prod", reviews.Explanation)
+
+	// Without access to webhook configurations sidecar injection is not reported
+	client.unavailable = map[string]bool{resourceKey("admissionregistration.k8s.io", "mutatingwebhookconfigurations"): true}
+	client.SetSyncIntervals(SyncIntervals{})
+	state, err = client.GetClusterState(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, state.SidecarInjection)
+}
+
+func TestNamespaceInjection_conflict(t *testing.T) {
+	stale := injectorConfiguration("istio-sidecar-injector-1-24", "default", "")
+	stale.Labels["istio.io/rev"] = "1-24"
+	webhooks := make([]injectionWebhook, 0, 4)
+	for _, configuration := range []*admissionregistrationv1.MutatingWebhookConfiguration{injectorConfiguration("istio-sidecar-injector", "default", ""), stale} {
+		for _, webhook := range configuration.Webhooks {
+			converted, err := convertInjectionWebhook(configuration, webhook)
+			require.NoError(t, err)
+			webhooks = append(webhooks, converted)
+		}
+	}
+
+	injection := namespaceInjection(labelledNamespace("bookinfo", map[string]string{"istio-injection": "enabled"}), webhooks)
+	assert.True(t, injection.Conflict)
+	assert.Equal(t, "1-24", injection.Revision)
+	assert.Len(t, injection.Webhooks, 2)
+	assert.Equal(t, "Webhooks of revisions 1-24, default all select the namespace, so its pods are injected more than once", injection.Explanation)
+}
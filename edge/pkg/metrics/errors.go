@@ -26,6 +26,9 @@ var (
 	// ErrMissingEndpoint indicates that the provider endpoint is missing
 	ErrMissingEndpoint = errors.New("metrics provider endpoint is required when enabled")
 
+	// ErrInvalidSecondaryMode indicates that the secondary endpoint mode is not supported
+	ErrInvalidSecondaryMode = errors.New("metrics secondary mode must be one of: failover, merge")
+
 	// ErrProviderUnavailable indicates that the metrics provider is unavailable
 	ErrProviderUnavailable = errors.New("metrics provider is unavailable")
 
@@ -0,0 +1,70 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/prometheus/common/model"
+)
+
+// MappableLabels are the labels read from query results that can be mapped to custom label names
+var MappableLabels = []string{
+	"source_cluster",
+	"source_workload_namespace",
+	"source_canonical_service",
+	"source_workload",
+	"destination_cluster",
+	"destination_service_namespace",
+	"destination_canonical_service",
+	"destination_workload",
+	"reporter",
+	"pod",
+	"namespace",
+}
+
+// LabelMapping maps the standard Istio labels read from query results to the label names a mesh uses
+// instead, for meshes whose Telemetry or EnvoyFilters rename metric dimensions. Labels that are not
+// mapped are read by their standard name.
+type LabelMapping map[string]string
+
+// Validate checks that only known labels are mapped, and to valid label names
+func (m LabelMapping) Validate() error {
+	labels := make([]string, 0, len(m))
+	for label := range m {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		if !slices.Contains(MappableLabels, label) {
+			return fmt.Errorf("label mapping of unknown label %q", label)
+		}
+		if !model.LegacyValidation.IsValidLabelName(m[label]) {
+			return fmt.Errorf("label %q is mapped to invalid label name %q", label, m[label])
+		}
+	}
+	return nil
+}
+
+// Label returns the name of a standard label in the metrics
+func (m LabelMapping) Label(label string) string {
+	if mapped, exists := m[label]; exists {
+		return mapped
+	}
+	return label
+}
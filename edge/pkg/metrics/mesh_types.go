@@ -40,10 +40,13 @@ type ServicePairMetrics struct {
 	DestinationCluster   string                             `json:"destination_cluster"`
 	DestinationNamespace string                             `json:"destination_namespace"`
 	DestinationService   string                             `json:"destination_service"`
-	ErrorRate            float64                            `json:"error_rate"`           // requests per second
-	RequestRate          float64                            `json:"request_rate"`         // requests per second
-	LatencyP99           float64                            `json:"latency_p99"`          // 99th percentile latency in milliseconds (deprecated - calculated by manager)
-	LatencyDistribution  *typesv1alpha1.LatencyDistribution `json:"latency_distribution"` // Raw histogram distribution for manager-side calculation
+	ErrorRate            float64                            `json:"error_rate"`             // requests per second
+	RequestRate          float64                            `json:"request_rate"`           // requests per second
+	LatencyP99           float64                            `json:"latency_p99"`            // 99th percentile latency in milliseconds (deprecated - calculated by manager)
+	LatencyDistribution  *typesv1alpha1.LatencyDistribution `json:"latency_distribution"`   // Raw histogram distribution for manager-side calculation
+	PlaintextRequestRate float64                            `json:"plaintext_request_rate"` // inbound requests per second received without mTLS
+	Reporter             string                             `json:"reporter"`               // side of the connection whose proxies reported the metrics
+	Exemplars            []*typesv1alpha1.LatencyExemplar   `json:"exemplars"`              // sample traces of the slowest requests, slowest first
 	Timestamp            time.Time                          `json:"timestamp"`
 }
 
@@ -129,6 +129,29 @@ func (c *Client) query(ctx context.Context, query string) (model.Value, error) {
 	return result, nil
 }
 
+// queryRange executes a Prometheus range query and returns native Prometheus types
+func (c *Client) queryRange(ctx context.Context, query string, r v1.Range) (model.Value, error) {
+	result, warnings, err := c.api.QueryRange(ctx, query, r)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus range query failed: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		c.logger.Warn("Prometheus range query returned warnings", "warnings", warnings)
+	}
+
+	return result, nil
+}
+
+// queryExemplars returns the exemplars of the series selected by a query over a time range
+func (c *Client) queryExemplars(ctx context.Context, query string, startTime, endTime time.Time) ([]v1.ExemplarQueryResult, error) {
+	result, err := c.api.QueryExemplars(ctx, query, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus exemplars query failed: %w", err)
+	}
+	return result, nil
+}
+
 // GetServiceConnections retrieves service connection metrics for a specific service
 func (c *Client) GetServiceConnections(ctx context.Context, serviceName, namespace string, startTime, endTime time.Time) (*typesv1alpha1.ServiceGraphMetrics, error) {
 	c.logger.Info("querying service connections from Prometheus",
@@ -0,0 +1,155 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxPairExemplars is the number of exemplars returned per service pair
+	maxPairExemplars = 5
+	// exemplarsWindow is how far back exemplars are looked up, matching the connection queries
+	exemplarsWindow = 5 * time.Minute
+)
+
+var (
+	// traceIDLabels are the exemplar labels tracers record the trace ID under
+	traceIDLabels = []model.LabelName{"trace_id", "traceID", "traceId"}
+	// spanIDLabels are the exemplar labels tracers record the span ID under
+	spanIDLabels = []model.LabelName{"span_id", "spanID", "spanId"}
+)
+
+// addLatencyExemplars adds the slowest exemplars recorded on a service's latency histograms to its connection
+// pairs, so slow connections can be followed into the tracing backend. Exemplars are only available when
+// Prometheus stores them, so failed lookups are logged and the pairs are left without exemplars.
+func (p *Provider) addLatencyExemplars(ctx context.Context, serviceName, serviceNamespace string, pairs []metrics.ServicePairMetrics) {
+	if p.client == nil || len(pairs) == 0 {
+		return
+	}
+
+	endTime := time.Now()
+	startTime := endTime.Add(-exemplarsWindow)
+	selectors := []string{
+		p.latencyHistogramSelector("destination_canonical_service", "destination_service_namespace", serviceName, serviceNamespace),
+		p.latencyHistogramSelector("source_canonical_service", "source_workload_namespace", serviceName, serviceNamespace),
+	}
+
+	results := make([][]v1.ExemplarQueryResult, len(selectors))
+	var wg sync.WaitGroup
+	for i, selector := range selectors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := p.client.queryExemplars(ctx, selector, startTime, endTime)
+			if err != nil {
+				p.logger.Warn("exemplars query failed", "error", err, "service", serviceName, "namespace", serviceNamespace)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	// Both selectors match the series of a service calling itself
+	seen := make(map[model.Fingerprint]bool)
+	exemplars := make(map[string][]*typesv1alpha1.LatencyExemplar)
+	for _, result := range results {
+		for _, series := range result {
+			fingerprint := series.SeriesLabels.Fingerprint()
+			if seen[fingerprint] {
+				continue
+			}
+			seen[fingerprint] = true
+
+			metric := model.Metric(series.SeriesLabels)
+			key := p.createPairKey(metric)
+			if key == "" {
+				continue
+			}
+			reporter := p.getStringValue(metric, "reporter")
+			cluster := p.getStringValue(metric, "source_cluster")
+			if reporter == reporterDestination {
+				cluster = p.getStringValue(metric, "destination_cluster")
+			}
+			for _, exemplar := range series.Exemplars {
+				if latencyExemplar := newLatencyExemplar(exemplar, cluster); latencyExemplar != nil {
+					exemplars[key+"|"+reporter] = append(exemplars[key+"|"+reporter], latencyExemplar)
+				}
+			}
+		}
+	}
+
+	for i := range pairs {
+		pairExemplars := exemplars[pairExemplarsKey(&pairs[i])]
+		sort.SliceStable(pairExemplars, func(a, b int) bool {
+			return pairExemplars[a].Latency.AsDuration() > pairExemplars[b].Latency.AsDuration()
+		})
+		if len(pairExemplars) > maxPairExemplars {
+			pairExemplars = pairExemplars[:maxPairExemplars]
+		}
+		pairs[i].Exemplars = pairExemplars
+	}
+}
+
+// latencyHistogramSelector selects the latency histogram series of a service on one side of its connections
+func (p *Provider) latencyHistogramSelector(serviceLabel, namespaceLabel, serviceName, serviceNamespace string) string {
+	return fmt.Sprintf(`istio_request_duration_milliseconds_bucket{%s="%s", %s="%s"}`,
+		p.labelMapping.Label(serviceLabel), serviceName, p.labelMapping.Label(namespaceLabel), serviceNamespace)
+}
+
+// pairExemplarsKey returns the key of a pair's exemplars, matching the keys of the histogram series
+// reported for it
+func pairExemplarsKey(pair *metrics.ServicePairMetrics) string {
+	return fmt.Sprintf("%s:%s:%s->%s:%s:%s|%s",
+		pair.SourceCluster, pair.SourceNamespace, pair.SourceService,
+		pair.DestinationCluster, pair.DestinationNamespace, pair.DestinationService, pair.Reporter)
+}
+
+// newLatencyExemplar converts a histogram exemplar to the API format, returning nil for exemplars without a trace ID
+func newLatencyExemplar(exemplar v1.Exemplar, cluster string) *typesv1alpha1.LatencyExemplar {
+	traceID := firstLabelValue(exemplar.Labels, traceIDLabels)
+	if traceID == "" {
+		return nil
+	}
+	return &typesv1alpha1.LatencyExemplar{
+		TraceId:   traceID,
+		SpanId:    firstLabelValue(exemplar.Labels, spanIDLabels),
+		Latency:   durationpb.New(time.Duration(float64(exemplar.Value) * float64(time.Millisecond))),
+		Timestamp: timestamppb.New(exemplar.Timestamp.Time()),
+		Cluster:   cluster,
+	}
+}
+
+// firstLabelValue returns the value of the first of the labels that is set
+func firstLabelValue(labels model.LabelSet, names []model.LabelName) string {
+	for _, name := range names {
+		if value, exists := labels[name]; exists && value != "" {
+			return string(value)
+		}
+	}
+	return ""
+}
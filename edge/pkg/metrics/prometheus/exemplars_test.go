@@ -0,0 +1,122 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceConnections_Exemplars(t *testing.T) {
+	labels := map[string]interface{}{
+		"source_cluster":                "Kubernetes",
+		"source_workload_namespace":     "microservices",
+		"source_canonical_service":      "frontend",
+		"destination_cluster":           "Kubernetes",
+		"destination_service_namespace": "microservices",
+		"destination_canonical_service": "backend",
+	}
+	seriesLabels := func(reporter string) model.LabelSet {
+		set := model.LabelSet{"__name__": "istio_request_duration_milliseconds_bucket", "reporter": model.LabelValue(reporter), "le": "100"}
+		for name, value := range labels {
+			set[model.LabelName(name)] = model.LabelValue(value.(string))
+		}
+		return set
+	}
+
+	// Seven traced requests of 10ms to 70ms, and one request recorded without a trace
+	var destinationExemplars []v1.Exemplar
+	for i := 1; i <= 7; i++ {
+		destinationExemplars = append(destinationExemplars, v1.Exemplar{
+			Labels:    model.LabelSet{"trace_id": model.LabelValue(fmt.Sprintf("trace-%d", i)), "span_id": "span"},
+			Value:     model.SampleValue(i * 10),
+			Timestamp: model.TimeFromUnix(1700000000),
+		})
+	}
+	destinationExemplars = append(destinationExemplars, v1.Exemplar{Labels: model.LabelSet{}, Value: 1000})
+
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 10.0)},
+		},
+		exemplars: map[string][]v1.ExemplarQueryResult{
+			`istio_request_duration_milliseconds_bucket{destination_canonical_service="backend", destination_service_namespace="microservices"}`: {
+				{SeriesLabels: seriesLabels("destination"), Exemplars: destinationExemplars},
+				// Exemplars reported by the frontend's proxies belong to the other perspective
+				{SeriesLabels: seriesLabels("source"), Exemplars: []v1.Exemplar{{Labels: model.LabelSet{"traceID": "source-trace"}, Value: 500}}},
+			},
+		},
+	}
+
+	provider := &Provider{
+		logger:      logging.For("test"),
+		client:      mockClient,
+		clusterName: "Kubernetes",
+	}
+
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_UNSPECIFIED, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 1)
+
+	exemplars := result.Pairs[0].Exemplars
+	require.Len(t, exemplars, maxPairExemplars)
+	assert.Equal(t, "trace-7", exemplars[0].TraceId)
+	assert.Equal(t, "span", exemplars[0].SpanId)
+	assert.Equal(t, 70*time.Millisecond, exemplars[0].Latency.AsDuration())
+	assert.Equal(t, int64(1700000000), exemplars[0].Timestamp.Seconds)
+	assert.Equal(t, "Kubernetes", exemplars[0].Cluster)
+	assert.Equal(t, "trace-3", exemplars[4].TraceId)
+}
+
+func TestGetServiceConnections_WithoutExemplars(t *testing.T) {
+	labels := map[string]interface{}{
+		"source_canonical_service":      "frontend",
+		"destination_canonical_service": "backend",
+	}
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 10.0)},
+		},
+	}
+
+	provider := &Provider{
+		logger: logging.For("test"),
+		client: mockClient,
+	}
+
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_UNSPECIFIED, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 1)
+	assert.Empty(t, result.Pairs[0].Exemplars)
+}
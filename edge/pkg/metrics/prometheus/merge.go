@@ -627,9 +627,10 @@ func (p *Provider) mergePairMapsWithDistributions(requestPairs, errorPairs, dist
 	return merged
 }
 
-// getStringValue safely extracts string values from Prometheus metric labels
+// getStringValue safely extracts string values from Prometheus metric labels, reading standard labels
+// by the name they are mapped to in the configuration
 func (p *Provider) getStringValue(metric model.Metric, key string) string {
-	if value, ok := metric[model.LabelName(key)]; ok {
+	if value, ok := metric[model.LabelName(p.labelMapping.Label(key))]; ok {
 		return string(value)
 	}
 	return ""
@@ -107,6 +107,39 @@ func TestProcessErrorRateResponse(t *testing.T) {
 	}
 }
 
+func TestProcessErrorRateResponse_LabelMapping(t *testing.T) {
+	provider := &Provider{
+		logger: logging.For("test"),
+		labelMapping: metrics.LabelMapping{
+			"source_canonical_service":      "src_service",
+			"destination_canonical_service": "dst_service",
+		},
+	}
+
+	response := model.Vector{
+		&model.Sample{
+			Metric: model.Metric{
+				"source_cluster":                "cluster1",
+				"source_workload_namespace":     "default",
+				"src_service":                   "frontend",
+				"destination_cluster":           "cluster1",
+				"destination_service_namespace": "default",
+				"dst_service":                   "backend",
+			},
+			Value: model.SampleValue(0.05),
+		},
+	}
+
+	result := provider.processErrorRateResponse(response, time.Now())
+	require.NoError(t, result.Error)
+	require.Len(t, result.PairData, 1)
+	pair := result.PairData["cluster1:default:frontend->cluster1:default:backend"]
+	require.NotNil(t, pair)
+	assert.Equal(t, "frontend", pair.SourceService)
+	assert.Equal(t, "backend", pair.DestinationService)
+	assert.Equal(t, 0.05, pair.ErrorRate)
+}
+
 func TestProcessRequestRateResponse(t *testing.T) {
 	logger := logging.For("test")
 	provider := &Provider{logger: logger}
@@ -0,0 +1,267 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"text/template"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	sharedmetrics "github.com/liamawhite/navigator/pkg/metrics"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Pair instance query templates. Both the source and destination proxies report the requests between the pair, each
+// labelled with its own pod, so grouping by reporter yields a per-pod breakdown for each side.
+var (
+	pairInstanceRequestRateQueryTemplate = template.Must(template.New("pairInstanceRequestRate").Parse(`
+sum by (
+  reporter, pod, source_cluster, source_workload, destination_cluster, destination_workload
+)(
+  rate(istio_requests_total{source_canonical_service="{{.SourceService}}", source_workload_namespace="{{.SourceNamespace}}", destination_canonical_service="{{.DestinationService}}", destination_service_namespace="{{.DestinationNamespace}}"}[{{.TimeRange}}])
+)`))
+
+	pairInstanceErrorRateQueryTemplate = template.Must(template.New("pairInstanceErrorRate").Parse(`
+sum by (
+  reporter, pod, source_cluster, source_workload, destination_cluster, destination_workload
+)(
+  rate(istio_requests_total{source_canonical_service="{{.SourceService}}", source_workload_namespace="{{.SourceNamespace}}", destination_canonical_service="{{.DestinationService}}", destination_service_namespace="{{.DestinationNamespace}}", response_code=~"0|4..|5.."}[{{.TimeRange}}])
+)`))
+
+	pairInstanceLatencyDistributionQueryTemplate = template.Must(template.New("pairInstanceLatencyDistribution").Parse(`
+sum by (
+  reporter, pod, source_cluster, source_workload, destination_cluster, destination_workload, le
+)(
+  rate(istio_request_duration_milliseconds_bucket{source_canonical_service="{{.SourceService}}", source_workload_namespace="{{.SourceNamespace}}", destination_canonical_service="{{.DestinationService}}", destination_service_namespace="{{.DestinationNamespace}}"}[{{.TimeRange}}])
+)`))
+)
+
+// pairInstanceQueryTemplateData holds the data for pair instance query templates
+type pairInstanceQueryTemplateData struct {
+	TimeRange            string
+	SourceService        string
+	SourceNamespace      string
+	DestinationService   string
+	DestinationNamespace string
+}
+
+// instanceKey identifies the pod reporting metrics for a pair of workloads
+type instanceKey struct {
+	reporter            string
+	pod                 string
+	sourceCluster       string
+	sourceWorkload      string
+	destinationCluster  string
+	destinationWorkload string
+}
+
+// GetPairInstanceMetrics retrieves the metrics from a source service to a destination service broken down by workload
+// and pod - implements interfaces.MetricsProvider
+func (p *Provider) GetPairInstanceMetrics(ctx context.Context, sourceService, sourceNamespace, destinationService, destinationNamespace string) (*typesv1alpha1.PairInstanceMetrics, error) {
+	if p.client == nil {
+		return nil, fmt.Errorf("prometheus client not available")
+	}
+
+	p.logger.Info("retrieving pair instance metrics from Prometheus",
+		"source_service", sourceService,
+		"source_namespace", sourceNamespace,
+		"destination_service", destinationService,
+		"destination_namespace", destinationNamespace,
+		"cluster", p.clusterName)
+
+	data := pairInstanceQueryTemplateData{
+		TimeRange:            "5m",
+		SourceService:        sourceService,
+		SourceNamespace:      sourceNamespace,
+		DestinationService:   destinationService,
+		DestinationNamespace: destinationNamespace,
+	}
+
+	// Run the queries in parallel, a failing query fails the breakdown as its pods would be incomplete
+	templates := []*template.Template{
+		pairInstanceRequestRateQueryTemplate,
+		pairInstanceErrorRateQueryTemplate,
+		pairInstanceLatencyDistributionQueryTemplate,
+	}
+	responses := make([]model.Value, len(templates))
+	errs := make([]error, len(templates))
+	var wg sync.WaitGroup
+	for i, tmpl := range templates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			query, err := p.executeTemplate(tmpl, data)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to build %s query: %w", tmpl.Name(), err)
+				return
+			}
+			p.logger.Debug("executing pair instance query", "query", query)
+			if responses[i], err = p.client.query(ctx, query); err != nil {
+				errs[i] = fmt.Errorf("%s query failed: %w", tmpl.Name(), err)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instances := make(map[instanceKey]*typesv1alpha1.InstancePairMetrics)
+	instance := func(metric model.Metric) *typesv1alpha1.InstancePairMetrics {
+		key := instanceKey{
+			reporter:            p.getStringValue(metric, "reporter"),
+			pod:                 p.getStringValue(metric, "pod"),
+			sourceCluster:       p.getStringValue(metric, "source_cluster"),
+			sourceWorkload:      p.getStringValue(metric, "source_workload"),
+			destinationCluster:  p.getStringValue(metric, "destination_cluster"),
+			destinationWorkload: p.getStringValue(metric, "destination_workload"),
+		}
+		// Only sidecars report the pod of one side of the pair, waypoints and gateways are not broken down
+		if key.pod == "" || (key.reporter != "source" && key.reporter != "destination") {
+			return nil
+		}
+		if existing, exists := instances[key]; exists {
+			return existing
+		}
+		created := &typesv1alpha1.InstancePairMetrics{
+			SourceCluster:       key.sourceCluster,
+			SourceWorkload:      key.sourceWorkload,
+			DestinationCluster:  key.destinationCluster,
+			DestinationWorkload: key.destinationWorkload,
+		}
+		if key.reporter == "source" {
+			created.SourcePod = key.pod
+		} else {
+			created.DestinationPod = key.pod
+		}
+		instances[key] = created
+		return created
+	}
+
+	requestVector, err := pairInstanceVector(responses[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, sample := range requestVector {
+		if metrics := instance(sample.Metric); metrics != nil {
+			metrics.RequestRate = float64(sample.Value)
+		}
+	}
+
+	errorVector, err := pairInstanceVector(responses[1])
+	if err != nil {
+		return nil, err
+	}
+	for _, sample := range errorVector {
+		if metrics := instance(sample.Metric); metrics != nil {
+			metrics.ErrorRate = float64(sample.Value)
+		}
+	}
+
+	latencyVector, err := pairInstanceVector(responses[2])
+	if err != nil {
+		return nil, err
+	}
+	buckets := make(map[*typesv1alpha1.InstancePairMetrics]map[float64]float64)
+	for _, sample := range latencyVector {
+		// Skip the +Inf bucket, the highest finite bucket approximates the total count
+		leStr := p.getStringValue(sample.Metric, "le")
+		if leStr == "" || leStr == "+Inf" {
+			continue
+		}
+		le, err := strconv.ParseFloat(leStr, 64)
+		if err != nil {
+			p.logger.Warn("failed to parse le value", "le", leStr, "error", err)
+			continue
+		}
+		metrics := instance(sample.Metric)
+		if metrics == nil {
+			continue
+		}
+		if buckets[metrics] == nil {
+			buckets[metrics] = make(map[float64]float64)
+		}
+		buckets[metrics][le] = float64(sample.Value)
+	}
+	for metrics, instanceBuckets := range buckets {
+		metrics.LatencyDistribution = newLatencyDistribution(instanceBuckets)
+		if p99, err := sharedmetrics.CalculateP99(metrics.LatencyDistribution); err == nil {
+			metrics.LatencyP99 = durationpb.New(time.Duration(p99 * float64(time.Millisecond)))
+		}
+	}
+
+	result := &typesv1alpha1.PairInstanceMetrics{
+		ClusterId: p.clusterName,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	for key, metrics := range instances {
+		if key.reporter == "source" {
+			result.SourcePods = append(result.SourcePods, metrics)
+		} else {
+			result.DestinationPods = append(result.DestinationPods, metrics)
+		}
+	}
+	sharedmetrics.SortInstancePairMetrics(result.SourcePods)
+	sharedmetrics.SortInstancePairMetrics(result.DestinationPods)
+
+	p.logger.Debug("completed pair instance metrics query",
+		"source_service", sourceService,
+		"destination_service", destinationService,
+		"source_pods", len(result.SourcePods),
+		"destination_pods", len(result.DestinationPods))
+
+	return result, nil
+}
+
+// pairInstanceVector returns the samples of a pair instance query response
+func pairInstanceVector(response model.Value) (model.Vector, error) {
+	if response == nil {
+		return nil, nil
+	}
+	vector, ok := response.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("expected Vector result for pair instance metrics, got %T", response)
+	}
+	return vector, nil
+}
+
+// newLatencyDistribution builds a latency distribution from cumulative bucket counts keyed by upper bound. The sum is
+// approximated from the bucket midpoints, as rate queries do not return it.
+func newLatencyDistribution(buckets map[float64]float64) *typesv1alpha1.LatencyDistribution {
+	les := make([]float64, 0, len(buckets))
+	for le := range buckets {
+		les = append(les, le)
+	}
+	sort.Float64s(les)
+
+	distribution := &typesv1alpha1.LatencyDistribution{}
+	var lowerBound, previousCount float64
+	for _, le := range les {
+		count := buckets[le]
+		distribution.Buckets = append(distribution.Buckets, &typesv1alpha1.HistogramBucket{Le: le, Count: count})
+		distribution.Sum += (lowerBound + le) / 2 * (count - previousCount)
+		distribution.TotalCount = max(distribution.TotalCount, count)
+		lowerBound, previousCount = le, count
+	}
+	return distribution
+}
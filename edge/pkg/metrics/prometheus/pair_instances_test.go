@@ -0,0 +1,113 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPairInstanceMetrics(t *testing.T) {
+	sample := func(reporter, pod, sourceWorkload, destinationWorkload string, labels map[string]string, value float64) *model.Sample {
+		metric := model.Metric{
+			"reporter":             model.LabelValue(reporter),
+			"pod":                  model.LabelValue(pod),
+			"source_cluster":       "Kubernetes",
+			"source_workload":      model.LabelValue(sourceWorkload),
+			"destination_cluster":  "Kubernetes",
+			"destination_workload": model.LabelValue(destinationWorkload),
+		}
+		for k, v := range labels {
+			metric[model.LabelName(k)] = model.LabelValue(v)
+		}
+		return &model.Sample{Metric: metric, Value: model.SampleValue(value)}
+	}
+
+	provider := &Provider{
+		logger:      logging.For("test"),
+		clusterName: "Kubernetes",
+	}
+	data := pairInstanceQueryTemplateData{
+		TimeRange:            "5m",
+		SourceService:        "frontend",
+		SourceNamespace:      "microservices",
+		DestinationService:   "backend",
+		DestinationNamespace: "microservices",
+	}
+	query := func(tmpl *template.Template) string {
+		q, err := provider.executeTemplate(tmpl, data)
+		require.NoError(t, err)
+		return q
+	}
+
+	provider.client = &mockClient{
+		responses: map[string]mockResponse{
+			query(pairInstanceRequestRateQueryTemplate): {result: model.Vector{
+				sample("source", "frontend-v1-a", "frontend-v1", "backend-v1", nil, 6),
+				sample("destination", "backend-v1-b", "frontend-v1", "backend-v1", nil, 4),
+				sample("destination", "backend-v1-a", "frontend-v1", "backend-v1", nil, 2),
+				// Waypoints are not broken down by pod
+				sample("waypoint", "waypoint-a", "frontend-v1", "backend-v1", nil, 6),
+			}},
+			query(pairInstanceErrorRateQueryTemplate): {result: model.Vector{
+				sample("destination", "backend-v1-b", "frontend-v1", "backend-v1", nil, 3),
+			}},
+			query(pairInstanceLatencyDistributionQueryTemplate): {result: model.Vector{
+				sample("destination", "backend-v1-b", "frontend-v1", "backend-v1", map[string]string{"le": "100"}, 2),
+				sample("destination", "backend-v1-b", "frontend-v1", "backend-v1", map[string]string{"le": "1000"}, 4),
+				sample("destination", "backend-v1-b", "frontend-v1", "backend-v1", map[string]string{"le": "+Inf"}, 4),
+			}},
+		},
+	}
+
+	result, err := provider.GetPairInstanceMetrics(context.Background(), "frontend", "microservices", "backend", "microservices")
+	require.NoError(t, err)
+	assert.Equal(t, "Kubernetes", result.ClusterId)
+
+	require.Len(t, result.SourcePods, 1)
+	assert.Equal(t, "frontend-v1-a", result.SourcePods[0].SourcePod)
+	assert.Empty(t, result.SourcePods[0].DestinationPod)
+	assert.Equal(t, "backend-v1", result.SourcePods[0].DestinationWorkload)
+	assert.Equal(t, 6.0, result.SourcePods[0].RequestRate)
+
+	require.Len(t, result.DestinationPods, 2)
+	assert.Equal(t, "backend-v1-a", result.DestinationPods[0].DestinationPod)
+	assert.Equal(t, 2.0, result.DestinationPods[0].RequestRate)
+	assert.Nil(t, result.DestinationPods[0].LatencyDistribution)
+
+	hot := result.DestinationPods[1]
+	assert.Equal(t, "backend-v1-b", hot.DestinationPod)
+	assert.Equal(t, 4.0, hot.RequestRate)
+	assert.Equal(t, 3.0, hot.ErrorRate)
+	require.NotNil(t, hot.LatencyDistribution)
+	assert.Len(t, hot.LatencyDistribution.Buckets, 2)
+	assert.Equal(t, 4.0, hot.LatencyDistribution.TotalCount)
+	assert.Greater(t, hot.LatencyP99.AsDuration(), 100*time.Millisecond)
+}
+
+func TestGetPairInstanceMetrics_NoClient(t *testing.T) {
+	provider := &Provider{logger: logging.For("test")}
+
+	result, err := provider.GetPairInstanceMetrics(context.Background(), "frontend", "microservices", "backend", "microservices")
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/prometheus/common/model"
+)
+
+// Counterpart query templates. A service's connections are normally reported by its own proxies: inbound
+// connections by the destination and outbound connections by the source. These templates query the proxies on the
+// other side of each connection, so both perspectives can be compared.
+var (
+	counterpartRequestRateQueryTemplate = template.Must(template.New("counterpartRequestRate").Parse(`
+sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="{{.Reporter}}", {{.Selector}}{{.FilterClause}}}[{{.TimeRange}}])
+)`))
+
+	counterpartErrorRateQueryTemplate = template.Must(template.New("counterpartErrorRate").Parse(`
+sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="{{.Reporter}}", {{.Selector}}, response_code=~"0|4..|5.."{{.FilterClause}}}[{{.TimeRange}}])
+)`))
+
+	counterpartLatencyDistributionQueryTemplate = template.Must(template.New("counterpartLatencyDistribution").Parse(`
+sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service, le
+)(
+  rate(istio_request_duration_milliseconds_bucket{reporter="{{.Reporter}}", {{.Selector}}{{.FilterClause}}}[{{.TimeRange}}])
+)`))
+)
+
+const (
+	reporterSource      = "source"
+	reporterDestination = "destination"
+)
+
+// counterpartQueryTemplateData holds the data for counterpart query templates
+type counterpartQueryTemplateData struct {
+	Reporter     string
+	Selector     string
+	FilterClause string
+	TimeRange    string
+}
+
+// getCounterpartConnections returns a service's connections as reported by the proxies on their other side: its
+// inbound connections as reported by their sources and its outbound connections as reported by their destinations.
+// Failed queries are logged and skipped, like the service's own connection queries.
+func (p *Provider) getCounterpartConnections(ctx context.Context, serviceName, serviceNamespace string, filters metrics.MeshMetricsFilters) ([]metrics.ServicePairMetrics, error) {
+	if p.client == nil {
+		return nil, fmt.Errorf("prometheus client not available")
+	}
+
+	timestamp := time.Now()
+	directions := []counterpartQueryTemplateData{
+		{
+			Reporter: reporterSource,
+			Selector: fmt.Sprintf(`destination_canonical_service="%s", destination_service_namespace="%s"`, serviceName, serviceNamespace),
+		},
+		{
+			Reporter: reporterDestination,
+			Selector: fmt.Sprintf(`source_canonical_service="%s", source_workload_namespace="%s"`, serviceName, serviceNamespace),
+		},
+	}
+	templates := []*template.Template{
+		counterpartRequestRateQueryTemplate,
+		counterpartErrorRateQueryTemplate,
+		counterpartLatencyDistributionQueryTemplate,
+	}
+
+	// Run every query of both directions in parallel
+	responses := make([][]model.Value, len(directions))
+	var wg sync.WaitGroup
+	for d, data := range directions {
+		data.FilterClause = p.buildFilterClause(filters)
+		data.TimeRange = "5m"
+		responses[d] = make([]model.Value, len(templates))
+		for t, tmpl := range templates {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				query, err := p.executeTemplate(tmpl, data)
+				if err != nil {
+					p.logger.Error("failed to build counterpart query", "query_type", tmpl.Name(), "reporter", data.Reporter, "error", err)
+					return
+				}
+				p.logger.Debug("executing counterpart query", "query", query, "service", serviceName, "namespace", serviceNamespace)
+				resp, err := p.client.query(ctx, query)
+				if err != nil {
+					p.logger.Error("query failed", "query_type", tmpl.Name(), "reporter", data.Reporter, "error", err, "service", serviceName, "namespace", serviceNamespace)
+					return
+				}
+				responses[d][t] = resp
+			}()
+		}
+	}
+	wg.Wait()
+
+	var pairs []metrics.ServicePairMetrics
+	for d, data := range directions {
+		requestPairs := p.processRequestRateResponse(responses[d][0], timestamp)
+		errorPairs := p.processErrorRateResponse(responses[d][1], timestamp)
+		distributionPairs := p.processLatencyDistributionResponse(responses[d][2], timestamp)
+
+		merged := p.mergePairMapsWithDistributions(requestPairs.PairData, errorPairs.PairData, distributionPairs.PairData)
+		for _, pair := range merged {
+			pair.Reporter = data.Reporter
+			pairs = append(pairs, *pair)
+		}
+	}
+
+	return pairs, nil
+}
+
+// primaryReporter returns the reporter of a connection as seen by a service's own proxies: the destination for
+// connections to the service and the source for connections from it
+func primaryReporter(pair *metrics.ServicePairMetrics, serviceName, serviceNamespace string) string {
+	if pair.DestinationService == serviceName && pair.DestinationNamespace == serviceNamespace {
+		return reporterDestination
+	}
+	return reporterSource
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceConnections_BothPerspectives(t *testing.T) {
+	labels := map[string]interface{}{
+		"source_cluster":                "Kubernetes",
+		"source_workload_namespace":     "microservices",
+		"source_canonical_service":      "frontend",
+		"destination_cluster":           "Kubernetes",
+		"destination_service_namespace": "microservices",
+		"destination_canonical_service": "backend",
+	}
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			// The backend's proxies see 10 RPS without errors
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 10.0)},
+			// The frontend's proxies see 12 RPS, 2 of which failed before reaching the backend
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="source", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 12.0)},
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="source", destination_canonical_service="backend", destination_service_namespace="microservices", response_code=~"0|4..|5.."}[5m])
+)`: {result: createMockVector(labels, 2.0)},
+		},
+	}
+
+	provider := &Provider{
+		logger:      logging.For("test"),
+		client:      mockClient,
+		clusterName: "Kubernetes",
+	}
+
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_BOTH, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 2)
+
+	byReporter := make(map[string]*typesv1alpha1.ServicePairMetrics)
+	for _, pair := range result.Pairs {
+		assert.Equal(t, "frontend", pair.SourceService)
+		assert.Equal(t, "backend", pair.DestinationService)
+		byReporter[pair.Reporter] = pair
+	}
+	require.Contains(t, byReporter, "destination")
+	require.Contains(t, byReporter, "source")
+	assert.Equal(t, 10.0, byReporter["destination"].RequestRate)
+	assert.Equal(t, 0.0, byReporter["destination"].ErrorRate)
+	assert.Equal(t, 12.0, byReporter["source"].RequestRate)
+	assert.Equal(t, 2.0, byReporter["source"].ErrorRate)
+}
+
+func TestCounterpartQueryTemplates(t *testing.T) {
+	provider := &Provider{logger: logging.For("test")}
+
+	query, err := provider.executeTemplate(counterpartErrorRateQueryTemplate, counterpartQueryTemplateData{
+		Reporter:     "destination",
+		Selector:     `source_canonical_service="backend", source_workload_namespace="microservices"`,
+		FilterClause: provider.buildFilterClause(metrics.MeshMetricsFilters{}),
+		TimeRange:    "5m",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, query, `rate(istio_requests_total{reporter="destination", source_canonical_service="backend", source_workload_namespace="microservices", response_code=~"0|4..|5.."}[5m])`)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/liamawhite/navigator/edge/pkg/metrics"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -30,16 +31,20 @@ import (
 // ClientInterface defines the interface for Prometheus client operations
 type ClientInterface interface {
 	query(ctx context.Context, query string) (model.Value, error)
+	queryRange(ctx context.Context, query string, r v1.Range) (model.Value, error)
+	queryExemplars(ctx context.Context, query string, startTime, endTime time.Time) ([]v1.ExemplarQueryResult, error)
 	GetServiceConnections(ctx context.Context, serviceName, namespace string, startTime, endTime time.Time) (*typesv1alpha1.ServiceGraphMetrics, error)
 }
 
 // Provider implements the metrics.Provider interface for Prometheus
 type Provider struct {
-	client      ClientInterface
-	config      metrics.Config
-	info        metrics.ProviderInfo
-	logger      *slog.Logger
-	clusterName string
+	client         ClientInterface
+	config         metrics.Config
+	info           metrics.ProviderInfo
+	logger         *slog.Logger
+	clusterName    string
+	queryTemplates *serviceConnectionsQueryTemplates // nil uses the default templates
+	labelMapping   metrics.LabelMapping
 }
 
 // NewProvider creates a new Prometheus metrics provider with cluster name for filtering
@@ -57,10 +62,26 @@ func NewProvider(config metrics.Config, logger *slog.Logger, clusterName string)
 		clientOpts = append(clientOpts, WithTimeout(time.Duration(config.Timeout)*time.Second))
 	}
 
-	client, err := NewClient(config.Endpoint, logger, clientOpts...)
+	primary, err := NewClient(config.Endpoint, logger, clientOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Prometheus client: %w", err)
 	}
+	var client ClientInterface = primary
+
+	// Query a second source of the same metrics, e.g. Thanos, for failover or merging
+	if config.SecondaryEndpoint != "" {
+		secondary, err := NewClient(config.SecondaryEndpoint, logger, clientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secondary Prometheus client: %w", err)
+		}
+		client = newSecondaryClient(client, secondary, config.SecondaryMode, logger)
+		logger.Debug("created Prometheus provider with secondary endpoint", "secondary_endpoint", config.SecondaryEndpoint, "mode", config.SecondaryMode)
+	}
+
+	queryTemplates, err := newServiceConnectionsQueryTemplates(config.QueryTemplates)
+	if err != nil {
+		return nil, err
+	}
 
 	provider := &Provider{
 		client:      client,
@@ -70,7 +91,9 @@ func NewProvider(config metrics.Config, logger *slog.Logger, clusterName string)
 			Type:     metrics.ProviderTypePrometheus,
 			Endpoint: config.Endpoint,
 		},
-		logger: logger,
+		logger:         logger,
+		queryTemplates: queryTemplates,
+		labelMapping:   config.LabelMapping,
 	}
 
 	if clusterName != "" {
@@ -91,11 +114,12 @@ func (p *Provider) GetClusterName() string {
 }
 
 // GetServiceConnections (new interface) retrieves service connection metrics for a specific service - implements interfaces.MetricsProvider
-func (p *Provider) GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode typesv1alpha1.ProxyMode, startTime, endTime *timestamppb.Timestamp) (*typesv1alpha1.ServiceGraphMetrics, error) {
+func (p *Provider) GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode typesv1alpha1.ProxyMode, perspective typesv1alpha1.MetricsPerspective, startTime, endTime *timestamppb.Timestamp) (*typesv1alpha1.ServiceGraphMetrics, error) {
 	p.logger.Info("retrieving service connections from Prometheus",
 		"service_name", serviceName,
 		"namespace", namespace,
 		"proxy_mode", proxyMode.String(),
+		"perspective", perspective.String(),
 		"cluster", p.clusterName)
 
 	// Health check will be performed by the actual query - no need to precheck
@@ -107,6 +131,17 @@ func (p *Provider) GetServiceConnections(ctx context.Context, serviceName, names
 		return nil, err
 	}
 
+	// Add the connections as reported by the proxies on their other side
+	if perspective == typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_BOTH {
+		counterparts, err := p.getCounterpartConnections(ctx, serviceName, namespace, metrics.MeshMetricsFilters{})
+		if err != nil {
+			return nil, err
+		}
+		result.Pairs = append(result.Pairs, counterparts...)
+	}
+
+	p.addLatencyExemplars(ctx, serviceName, namespace, result.Pairs)
+
 	// Convert from internal metrics format to API format
 	var apiPairs []*typesv1alpha1.ServicePairMetrics
 	for _, pair := range result.Pairs {
@@ -121,6 +156,9 @@ func (p *Provider) GetServiceConnections(ctx context.Context, serviceName, names
 			ErrorRate:            pair.ErrorRate,
 			LatencyP99:           durationpb.New(time.Duration(pair.LatencyP99 * float64(time.Millisecond))),
 			LatencyDistribution:  pair.LatencyDistribution,
+			PlaintextRequestRate: pair.PlaintextRequestRate,
+			Reporter:             pair.Reporter,
+			Exemplars:            pair.Exemplars,
 		})
 	}
 
@@ -0,0 +1,218 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// secondaryClient queries a primary and a secondary source of the same metrics, such as a local
+// Prometheus and Thanos. In failover mode the secondary is only queried when the primary fails. In merge
+// mode both are queried in parallel and their series are merged, so metrics federated across the two
+// sources are complete; a source that fails is skipped as long as the other one answers.
+type secondaryClient struct {
+	primary   ClientInterface
+	secondary ClientInterface
+	mode      metrics.SecondaryMode
+	logger    *slog.Logger
+}
+
+// newSecondaryClient creates a client querying primary and secondary in the given mode
+func newSecondaryClient(primary, secondary ClientInterface, mode metrics.SecondaryMode, logger *slog.Logger) *secondaryClient {
+	return &secondaryClient{
+		primary:   primary,
+		secondary: secondary,
+		mode:      mode,
+		logger:    logger,
+	}
+}
+
+// query executes an instant query against the primary and secondary sources
+func (c *secondaryClient) query(ctx context.Context, query string) (model.Value, error) {
+	return c.execute(ctx, func(client ClientInterface) (model.Value, error) {
+		return client.query(ctx, query)
+	})
+}
+
+// queryRange executes a range query against the primary and secondary sources
+func (c *secondaryClient) queryRange(ctx context.Context, query string, r v1.Range) (model.Value, error) {
+	return c.execute(ctx, func(client ClientInterface) (model.Value, error) {
+		return client.queryRange(ctx, query, r)
+	})
+}
+
+// queryExemplars returns the exemplars of the primary source, failing over to the secondary source. In merge
+// mode the exemplars of both sources are returned.
+func (c *secondaryClient) queryExemplars(ctx context.Context, query string, startTime, endTime time.Time) ([]v1.ExemplarQueryResult, error) {
+	result, err := c.primary.queryExemplars(ctx, query, startTime, endTime)
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
+	if err == nil && c.mode != metrics.SecondaryModeMerge {
+		return result, nil
+	}
+
+	secondaryResult, secondaryErr := c.secondary.queryExemplars(ctx, query, startTime, endTime)
+	switch {
+	case err != nil && secondaryErr != nil:
+		return nil, fmt.Errorf("primary and secondary metrics endpoints failed: %w; %w", err, secondaryErr)
+	case secondaryErr != nil:
+		c.logger.Warn("secondary metrics endpoint failed, using primary only", "error", secondaryErr)
+		return result, nil
+	}
+	return append(result, secondaryResult...), nil
+}
+
+// GetServiceConnections retrieves service connections from the primary source, failing over to the
+// secondary source in either mode
+func (c *secondaryClient) GetServiceConnections(ctx context.Context, serviceName, namespace string, startTime, endTime time.Time) (*typesv1alpha1.ServiceGraphMetrics, error) {
+	result, err := c.primary.GetServiceConnections(ctx, serviceName, namespace, startTime, endTime)
+	if err == nil || ctx.Err() != nil {
+		return result, err
+	}
+	c.logger.Warn("primary metrics endpoint failed, querying secondary", "error", err)
+	return c.secondary.GetServiceConnections(ctx, serviceName, namespace, startTime, endTime)
+}
+
+// execute runs a query against the sources according to the mode
+func (c *secondaryClient) execute(ctx context.Context, run func(ClientInterface) (model.Value, error)) (model.Value, error) {
+	if c.mode != metrics.SecondaryModeMerge {
+		result, err := run(c.primary)
+		if err == nil || ctx.Err() != nil {
+			return result, err
+		}
+		c.logger.Warn("primary metrics endpoint failed, querying secondary", "error", err)
+		result, secondaryErr := run(c.secondary)
+		if secondaryErr != nil {
+			return nil, fmt.Errorf("primary and secondary metrics endpoints failed: %w; %w", err, secondaryErr)
+		}
+		return result, nil
+	}
+
+	var wg sync.WaitGroup
+	var primaryResult, secondaryResult model.Value
+	var primaryErr, secondaryErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		primaryResult, primaryErr = run(c.primary)
+	}()
+	go func() {
+		defer wg.Done()
+		secondaryResult, secondaryErr = run(c.secondary)
+	}()
+	wg.Wait()
+
+	switch {
+	case primaryErr != nil && secondaryErr != nil:
+		return nil, fmt.Errorf("primary and secondary metrics endpoints failed: %w; %w", primaryErr, secondaryErr)
+	case primaryErr != nil:
+		c.logger.Warn("primary metrics endpoint failed, using secondary only", "error", primaryErr)
+		return secondaryResult, nil
+	case secondaryErr != nil:
+		c.logger.Warn("secondary metrics endpoint failed, using primary only", "error", secondaryErr)
+		return primaryResult, nil
+	}
+	return mergeValues(primaryResult, secondaryResult)
+}
+
+// mergeValues merges the results of the same query from two sources. Series reported by both keep the
+// primary's samples, and a range series is completed with the secondary's samples at timestamps the
+// primary has no sample for. Scalars and strings are taken from the primary.
+func mergeValues(primary, secondary model.Value) (model.Value, error) {
+	if primary == nil {
+		return secondary, nil
+	}
+	if secondary == nil {
+		return primary, nil
+	}
+
+	switch primaryValue := primary.(type) {
+	case model.Vector:
+		secondaryValue, ok := secondary.(model.Vector)
+		if !ok {
+			return nil, fmt.Errorf("cannot merge %T with %T", primary, secondary)
+		}
+
+		merged := make(model.Vector, 0, len(primaryValue)+len(secondaryValue))
+		seen := make(map[model.Fingerprint]bool, len(primaryValue))
+		for _, sample := range primaryValue {
+			seen[sample.Metric.Fingerprint()] = true
+			merged = append(merged, sample)
+		}
+		for _, sample := range secondaryValue {
+			if !seen[sample.Metric.Fingerprint()] {
+				merged = append(merged, sample)
+			}
+		}
+		return merged, nil
+
+	case model.Matrix:
+		secondaryValue, ok := secondary.(model.Matrix)
+		if !ok {
+			return nil, fmt.Errorf("cannot merge %T with %T", primary, secondary)
+		}
+
+		merged := make(model.Matrix, 0, len(primaryValue)+len(secondaryValue))
+		positions := make(map[model.Fingerprint]int, len(primaryValue))
+		for _, stream := range primaryValue {
+			positions[stream.Metric.Fingerprint()] = len(merged)
+			merged = append(merged, stream)
+		}
+		for _, stream := range secondaryValue {
+			position, exists := positions[stream.Metric.Fingerprint()]
+			if !exists {
+				merged = append(merged, stream)
+				continue
+			}
+			completed := *merged[position]
+			completed.Values = mergeSamplePairs(completed.Values, stream.Values)
+			merged[position] = &completed
+		}
+		return merged, nil
+
+	default:
+		return primary, nil
+	}
+}
+
+// mergeSamplePairs adds the secondary samples at timestamps without a primary sample, in time order
+func mergeSamplePairs(primary, secondary []model.SamplePair) []model.SamplePair {
+	merged := make([]model.SamplePair, 0, len(primary)+len(secondary))
+	timestamps := make(map[model.Time]bool, len(primary))
+	for _, sample := range primary {
+		timestamps[sample.Timestamp] = true
+		merged = append(merged, sample)
+	}
+	for _, sample := range secondary {
+		if !timestamps[sample.Timestamp] {
+			merged = append(merged, sample)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Timestamp < merged[j].Timestamp
+	})
+	return merged
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryClient_Failover(t *testing.T) {
+	primaryVector := createMockVector(map[string]interface{}{"source_canonical_service": "frontend"}, 10.0)
+	secondaryVector := createMockVector(map[string]interface{}{"source_canonical_service": "frontend"}, 20.0)
+
+	tests := []struct {
+		name      string
+		primary   mockResponse
+		secondary mockResponse
+		want      model.Value
+		wantErr   string
+	}{
+		{
+			name:      "primary answers",
+			primary:   mockResponse{result: primaryVector},
+			secondary: mockResponse{err: errors.New("not queried")},
+			want:      primaryVector,
+		},
+		{
+			name:      "primary fails",
+			primary:   mockResponse{err: errors.New("connection refused")},
+			secondary: mockResponse{result: secondaryVector},
+			want:      secondaryVector,
+		},
+		{
+			name:      "both fail",
+			primary:   mockResponse{err: errors.New("connection refused")},
+			secondary: mockResponse{err: errors.New("timeout")},
+			wantErr:   "primary and secondary metrics endpoints failed: connection refused; timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newSecondaryClient(
+				&mockClient{responses: map[string]mockResponse{"up": tt.primary}},
+				&mockClient{responses: map[string]mockResponse{"up": tt.secondary}},
+				metrics.SecondaryModeFailover,
+				logging.For("test"),
+			)
+
+			result, err := client.query(context.Background(), "up")
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestSecondaryClient_Merge(t *testing.T) {
+	local := model.Metric{"source_canonical_service": "frontend"}
+	federated := model.Metric{"source_canonical_service": "reviews"}
+
+	client := newSecondaryClient(
+		&mockClient{responses: map[string]mockResponse{"up": {result: model.Vector{
+			{Metric: local, Value: 10},
+		}}}},
+		&mockClient{responses: map[string]mockResponse{"up": {result: model.Vector{
+			{Metric: local, Value: 12},
+			{Metric: federated, Value: 5},
+		}}}},
+		metrics.SecondaryModeMerge,
+		logging.For("test"),
+	)
+
+	result, err := client.query(context.Background(), "up")
+	require.NoError(t, err)
+	assert.Equal(t, model.Vector{
+		{Metric: local, Value: 10},
+		{Metric: federated, Value: 5},
+	}, result)
+
+	// A failing source is skipped
+	client.secondary = &mockClient{responses: map[string]mockResponse{"up": {err: errors.New("timeout")}}}
+	result, err = client.query(context.Background(), "up")
+	require.NoError(t, err)
+	assert.Equal(t, model.Vector{{Metric: local, Value: 10}}, result)
+}
+
+func TestMergeValues_Matrix(t *testing.T) {
+	local := model.Metric{"source_canonical_service": "frontend"}
+	federated := model.Metric{"source_canonical_service": "reviews"}
+
+	primary := model.Matrix{
+		{Metric: local, Values: []model.SamplePair{{Timestamp: 2000, Value: 2}, {Timestamp: 3000, Value: 3}}},
+	}
+	secondary := model.Matrix{
+		{Metric: local, Values: []model.SamplePair{{Timestamp: 1000, Value: 10}, {Timestamp: 2000, Value: 20}}},
+		{Metric: federated, Values: []model.SamplePair{{Timestamp: 1000, Value: 5}}},
+	}
+
+	merged, err := mergeValues(primary, secondary)
+	require.NoError(t, err)
+	assert.Equal(t, model.Matrix{
+		{Metric: local, Values: []model.SamplePair{{Timestamp: 1000, Value: 10}, {Timestamp: 2000, Value: 2}, {Timestamp: 3000, Value: 3}}},
+		{Metric: federated, Values: []model.SamplePair{{Timestamp: 1000, Value: 5}}},
+	}, merged)
+
+	// The primary result is not modified
+	assert.Len(t, primary[0].Values, 2)
+
+	_, err = mergeValues(primary, model.Vector{})
+	assert.Error(t, err)
+}
@@ -61,6 +61,15 @@ sum by (
   rate(istio_requests_total{reporter="source", source_canonical_service="{{.ServiceName}}", source_workload_namespace="{{.ServiceNamespace}}", response_code=~"0|4..|5.."{{.FilterClause}}}[{{.TimeRange}}])
 )`))
 
+	// Requests received without mTLS, as recorded by the destination proxy
+	inboundPlaintextRequestRateQueryTemplate = template.Must(template.New("inboundPlaintextRequestRate").Parse(`
+sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", connection_security_policy="none", destination_canonical_service="{{.ServiceName}}", destination_service_namespace="{{.ServiceNamespace}}"{{.FilterClause}}}[{{.TimeRange}}])
+)`))
+
 	// Gateway-specific downstream metrics templates
 	gatewayDownstreamRequestRateQueryTemplate = template.Must(template.New("gatewayDownstreamRequestRate").Parse(`
 sum by (pod, namespace)(
@@ -98,6 +107,64 @@ sum by (pod, namespace, le)(
 )`))
 )
 
+// serviceConnectionsQueryTemplates are the templates of the service connection queries that can be
+// overridden in the metrics configuration
+type serviceConnectionsQueryTemplates struct {
+	inboundRequestRate          *template.Template
+	outboundRequestRate         *template.Template
+	inboundErrorRate            *template.Template
+	outboundErrorRate           *template.Template
+	inboundLatencyDistribution  *template.Template
+	outboundLatencyDistribution *template.Template
+}
+
+var defaultServiceConnectionsQueryTemplates = serviceConnectionsQueryTemplates{
+	inboundRequestRate:          inboundRequestRateQueryTemplate,
+	outboundRequestRate:         outboundRequestRateQueryTemplate,
+	inboundErrorRate:            inboundErrorRateQueryTemplate,
+	outboundErrorRate:           outboundErrorRateQueryTemplate,
+	inboundLatencyDistribution:  inboundLatencyDistributionQueryTemplate,
+	outboundLatencyDistribution: outboundLatencyDistributionQueryTemplate,
+}
+
+// newServiceConnectionsQueryTemplates parses the configured query templates, using the default
+// template for those that are not overridden
+func newServiceConnectionsQueryTemplates(overrides metrics.QueryTemplates) (*serviceConnectionsQueryTemplates, error) {
+	templates := defaultServiceConnectionsQueryTemplates
+
+	for _, override := range []struct {
+		name   string
+		text   string
+		target **template.Template
+	}{
+		{"inboundRequestRate", overrides.InboundRequestRate, &templates.inboundRequestRate},
+		{"outboundRequestRate", overrides.OutboundRequestRate, &templates.outboundRequestRate},
+		{"inboundErrorRate", overrides.InboundErrorRate, &templates.inboundErrorRate},
+		{"outboundErrorRate", overrides.OutboundErrorRate, &templates.outboundErrorRate},
+		{"inboundLatencyDistribution", overrides.InboundLatencyDistribution, &templates.inboundLatencyDistribution},
+		{"outboundLatencyDistribution", overrides.OutboundLatencyDistribution, &templates.outboundLatencyDistribution},
+	} {
+		if override.text == "" {
+			continue
+		}
+		tmpl, err := template.New(override.name).Parse(override.text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s query template: %w", override.name, err)
+		}
+		*override.target = tmpl
+	}
+
+	return &templates, nil
+}
+
+// serviceConnectionsQueryTemplates returns the service connection query templates of the provider
+func (p *Provider) serviceConnectionsQueryTemplates() *serviceConnectionsQueryTemplates {
+	if p.queryTemplates == nil {
+		return &defaultServiceConnectionsQueryTemplates
+	}
+	return p.queryTemplates
+}
+
 // serviceConnectionsQueryTemplateData holds the data for service-specific query templates
 type serviceConnectionsQueryTemplateData struct {
 	FilterClause     string
@@ -115,6 +182,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 
 	// Default to 5-minute time range if not specified
 	timeRange := "5m"
+	templates := p.serviceConnectionsQueryTemplates()
 	timestamp := time.Now()
 
 	// Check if this is a gateway service (ROUTER proxy mode) to determine if we need downstream metrics
@@ -131,8 +199,8 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 	}
 
 	// Adjust channel size based on whether we have gateway metrics
-	// Base queries: 4 (request/error rates) + 2 (latency distributions) = 6
-	channelSize := 6
+	// Base queries: 4 (request/error rates) + 1 (plaintext request rate) + 2 (latency distributions) = 7
+	channelSize := 7
 	if isGateway {
 		channelSize = 8 // Add 2 for downstream metrics (request rate, latency distribution)
 	}
@@ -157,7 +225,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 			default:
 			}
 
-			query, err := p.buildServiceConnectionQuery(inboundRequestRateQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+			query, err := p.buildServiceConnectionQuery(templates.inboundRequestRate, serviceName, serviceNamespace, filters, timeRange)
 			if err != nil {
 				results <- connectionQueryResult{Error: fmt.Errorf("failed to build inbound request rate query: %w", err), QueryType: "inbound_request_rate"}
 				return
@@ -175,6 +243,38 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 		}()
 	}
 
+	// Inbound plaintext request rate query (skip for gateways - they use downstream metrics)
+	if !isGateway {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Check for cancellation before starting work
+			select {
+			case <-queryCtx.Done():
+				results <- connectionQueryResult{Error: queryCtx.Err(), QueryType: "inbound_plaintext_request_rate"}
+				return
+			default:
+			}
+
+			query, err := p.buildServiceConnectionQuery(inboundPlaintextRequestRateQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+			if err != nil {
+				results <- connectionQueryResult{Error: fmt.Errorf("failed to build inbound plaintext request rate query: %w", err), QueryType: "inbound_plaintext_request_rate"}
+				return
+			}
+
+			p.logger.Debug("executing inbound plaintext request rate query", "query", query, "service", serviceName, "namespace", serviceNamespace)
+			resp, err := p.client.query(queryCtx, query)
+			if err != nil {
+				results <- connectionQueryResult{Error: err, QueryType: "inbound_plaintext_request_rate"}
+				return
+			}
+
+			processedMetrics := p.processRequestRateResponse(resp, timestamp)
+			results <- connectionQueryResult{ProcessedMetrics: processedMetrics, QueryType: "inbound_plaintext_request_rate"}
+		}()
+	}
+
 	// Outbound request rate query
 	wg.Add(1)
 	go func() {
@@ -188,7 +288,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 		default:
 		}
 
-		query, err := p.buildServiceConnectionQuery(outboundRequestRateQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+		query, err := p.buildServiceConnectionQuery(templates.outboundRequestRate, serviceName, serviceNamespace, filters, timeRange)
 		if err != nil {
 			results <- connectionQueryResult{Error: fmt.Errorf("failed to build outbound request rate query: %w", err), QueryType: "outbound_request_rate"}
 			return
@@ -218,7 +318,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 		default:
 		}
 
-		query, err := p.buildServiceConnectionQuery(inboundErrorRateQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+		query, err := p.buildServiceConnectionQuery(templates.inboundErrorRate, serviceName, serviceNamespace, filters, timeRange)
 		if err != nil {
 			results <- connectionQueryResult{Error: fmt.Errorf("failed to build inbound error rate query: %w", err), QueryType: "inbound_error_rate"}
 			return
@@ -248,7 +348,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 		default:
 		}
 
-		query, err := p.buildServiceConnectionQuery(outboundErrorRateQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+		query, err := p.buildServiceConnectionQuery(templates.outboundErrorRate, serviceName, serviceNamespace, filters, timeRange)
 		if err != nil {
 			results <- connectionQueryResult{Error: fmt.Errorf("failed to build outbound error rate query: %w", err), QueryType: "outbound_error_rate"}
 			return
@@ -279,7 +379,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 			default:
 			}
 
-			query, err := p.buildServiceConnectionQuery(inboundLatencyDistributionQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+			query, err := p.buildServiceConnectionQuery(templates.inboundLatencyDistribution, serviceName, serviceNamespace, filters, timeRange)
 			if err != nil {
 				results <- connectionQueryResult{Error: fmt.Errorf("failed to build inbound latency distribution query: %w", err), QueryType: "inbound_latency_distribution"}
 				return
@@ -310,7 +410,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 		default:
 		}
 
-		query, err := p.buildServiceConnectionQuery(outboundLatencyDistributionQueryTemplate, serviceName, serviceNamespace, filters, timeRange)
+		query, err := p.buildServiceConnectionQuery(templates.outboundLatencyDistribution, serviceName, serviceNamespace, filters, timeRange)
 		if err != nil {
 			results <- connectionQueryResult{Error: fmt.Errorf("failed to build outbound latency distribution query: %w", err), QueryType: "outbound_latency_distribution"}
 			return
@@ -398,6 +498,7 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 	allRequestPairs := make(map[string]*metrics.ServicePairMetrics)
 	allErrorPairs := make(map[string]*metrics.ServicePairMetrics)
 	allDistributionPairs := make(map[string]*metrics.ServicePairMetrics)
+	allPlaintextPairs := make(map[string]*metrics.ServicePairMetrics)
 
 	for result := range results {
 		if result.Error != nil {
@@ -420,6 +521,10 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 			for key, pair := range result.ProcessedMetrics.PairData {
 				allErrorPairs[key] = pair
 			}
+		case "inbound_plaintext_request_rate":
+			for key, pair := range result.ProcessedMetrics.PairData {
+				allPlaintextPairs[key] = pair
+			}
 		case "inbound_latency_distribution", "outbound_latency_distribution":
 			for key, pair := range result.ProcessedMetrics.PairData {
 				allDistributionPairs[key] = pair
@@ -440,9 +545,17 @@ func (p *Provider) getServiceConnectionsInternal(ctx context.Context, serviceNam
 	// Merge request, error, and distribution data
 	mergedPairs := p.mergePairMapsWithDistributions(allRequestPairs, allErrorPairs, allDistributionPairs)
 
+	// Plaintext requests are a subset of the pair's requests
+	for key, plaintextPair := range allPlaintextPairs {
+		if existing, exists := mergedPairs[key]; exists {
+			existing.PlaintextRequestRate = plaintextPair.RequestRate
+		}
+	}
+
 	// Convert to slice
 	var pairs []metrics.ServicePairMetrics
 	for _, pair := range mergedPairs {
+		pair.Reporter = primaryReporter(pair, serviceName, serviceNamespace)
 		pairs = append(pairs, *pair)
 	}
 
@@ -25,6 +25,7 @@ import (
 	"github.com/liamawhite/navigator/edge/pkg/metrics"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -115,7 +116,7 @@ func TestGetServiceConnections_DoubleCountingFix(t *testing.T) {
 	// Execute the service connections query using the Provider's public method (which now calls the FIXED method)
 	now := timestamppb.Now()
 	fiveMinutesAgo := timestamppb.New(time.Now().Add(-5 * time.Minute))
-	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, fiveMinutesAgo, now)
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_UNSPECIFIED, fiveMinutesAgo, now)
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -140,6 +141,91 @@ func TestGetServiceConnections_DoubleCountingFix(t *testing.T) {
 	// FIXED: Each connection should have its correct rate with no double-counting
 	assert.Equal(t, 15.0, frontendToBackend.RequestRate, "Frontend -> backend should have 15 RPS")
 	assert.Equal(t, 15.0, backendToDatabase.RequestRate, "Backend -> database should have 15 RPS")
+
+	// Each connection is reported by the service's own proxies
+	assert.Equal(t, "destination", frontendToBackend.Reporter)
+	assert.Equal(t, "source", backendToDatabase.Reporter)
+}
+
+func TestGetServiceConnections_PlaintextRequestRate(t *testing.T) {
+	labels := map[string]interface{}{
+		"source_cluster":                "Kubernetes",
+		"source_workload_namespace":     "microservices",
+		"source_canonical_service":      "frontend",
+		"destination_cluster":           "Kubernetes",
+		"destination_service_namespace": "microservices",
+		"destination_canonical_service": "backend",
+	}
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 15.0)},
+			`sum by (
+  source_cluster, source_workload_namespace, source_canonical_service,
+  destination_cluster, destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", connection_security_policy="none", destination_canonical_service="backend", destination_service_namespace="microservices"}[5m])
+)`: {result: createMockVector(labels, 5.0)},
+		},
+	}
+
+	provider := &Provider{
+		logger:      logging.For("test"),
+		client:      mockClient,
+		clusterName: "Kubernetes",
+	}
+
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_UNSPECIFIED, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 1)
+	assert.Equal(t, 15.0, result.Pairs[0].RequestRate)
+	assert.Equal(t, 5.0, result.Pairs[0].PlaintextRequestRate)
+}
+
+func TestGetServiceConnections_CustomQueryTemplates(t *testing.T) {
+	queryTemplates, err := newServiceConnectionsQueryTemplates(metrics.QueryTemplates{
+		InboundRequestRate: `label_replace(sum by (source_cluster, source_workload_namespace, source_canonical_service, destination_cluster, destination_service_namespace, svc)(rate(requests_total{svc="{{.ServiceName}}", ns="{{.ServiceNamespace}}"}[{{.TimeRange}}])), "destination_canonical_service", "$1", "svc", "(.*)")`,
+	})
+	require.NoError(t, err)
+
+	labels := map[string]interface{}{
+		"source_cluster":                "Kubernetes",
+		"source_workload_namespace":     "microservices",
+		"source_canonical_service":      "frontend",
+		"destination_cluster":           "Kubernetes",
+		"destination_service_namespace": "microservices",
+		"destination_canonical_service": "backend",
+	}
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			`label_replace(sum by (source_cluster, source_workload_namespace, source_canonical_service, destination_cluster, destination_service_namespace, svc)(rate(requests_total{svc="backend", ns="microservices"}[5m])), "destination_canonical_service", "$1", "svc", "(.*)")`: {result: createMockVector(labels, 15.0)},
+		},
+	}
+
+	provider := &Provider{
+		logger:         logging.For("test"),
+		client:         mockClient,
+		clusterName:    "Kubernetes",
+		queryTemplates: queryTemplates,
+	}
+
+	result, err := provider.GetServiceConnections(context.Background(), "backend", "microservices", typesv1alpha1.ProxyMode_SIDECAR, typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_UNSPECIFIED, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 1)
+	assert.Equal(t, 15.0, result.Pairs[0].RequestRate)
+
+	// Templates that are not overridden keep the default query
+	assert.Equal(t, outboundRequestRateQueryTemplate, queryTemplates.outboundRequestRate)
+}
+
+func TestNewServiceConnectionsQueryTemplates_Invalid(t *testing.T) {
+	_, err := newServiceConnectionsQueryTemplates(metrics.QueryTemplates{OutboundErrorRate: `rate(requests_total{svc="{{.ServiceName"}[5m])`})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse outboundErrorRate query template")
 }
 
 func TestBuildFilterClause(t *testing.T) {
@@ -230,6 +316,7 @@ func TestServiceConnectionsQueryTemplates(t *testing.T) {
 		outboundRequestRateQueryTemplate,
 		inboundErrorRateQueryTemplate,
 		outboundErrorRateQueryTemplate,
+		inboundPlaintextRequestRateQueryTemplate,
 	}
 
 	data := serviceConnectionsQueryTemplateData{
@@ -257,6 +344,7 @@ func TestServiceConnectionsQueryTemplates(t *testing.T) {
 // Mock client for testing - implements ClientInterface
 type mockClient struct {
 	responses map[string]mockResponse
+	exemplars map[string][]v1.ExemplarQueryResult
 }
 
 type mockResponse struct {
@@ -271,7 +359,15 @@ func (m *mockClient) query(ctx context.Context, query string) (model.Value, erro
 	return nil, fmt.Errorf("unexpected query: %s", query)
 }
 
+func (m *mockClient) queryRange(ctx context.Context, query string, r v1.Range) (model.Value, error) {
+	return m.query(ctx, query)
+}
+
 // GetServiceConnections is needed to satisfy ClientInterface but not used since we fixed the Provider
+func (m *mockClient) queryExemplars(ctx context.Context, query string, startTime, endTime time.Time) ([]v1.ExemplarQueryResult, error) {
+	return m.exemplars[query], nil
+}
+
 func (m *mockClient) GetServiceConnections(ctx context.Context, serviceName, namespace string, startTime, endTime time.Time) (*typesv1alpha1.ServiceGraphMetrics, error) {
 	return nil, fmt.Errorf("GetServiceConnections not implemented in mock - Provider now uses getServiceConnectionsInternal")
 }
@@ -0,0 +1,300 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	sharedmetrics "github.com/liamawhite/navigator/pkg/metrics"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Time series range query templates. All requested pairs are selected with one query per metric by matching any of
+// their services, and series of unrequested combinations are dropped. Like inbound connections, the metrics are
+// reported by the destination proxies.
+var (
+	timeSeriesRequestRateQueryTemplate = template.Must(template.New("timeSeriesRequestRate").Parse(`
+sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", {{.Selector}}}[{{.TimeRange}}])
+)`))
+
+	timeSeriesErrorRateQueryTemplate = template.Must(template.New("timeSeriesErrorRate").Parse(`
+sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", {{.Selector}}, response_code=~"0|4..|5.."}[{{.TimeRange}}])
+)`))
+
+	timeSeriesLatencyDistributionQueryTemplate = template.Must(template.New("timeSeriesLatencyDistribution").Parse(`
+sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service, le
+)(
+  rate(istio_request_duration_milliseconds_bucket{reporter="destination", {{.Selector}}}[{{.TimeRange}}])
+)`))
+)
+
+// minTimeSeriesRateWindow is the shortest window rates are computed over. Rates need at least two scrapes, so
+// intervals shorter than this are smoothed over the preceding minute.
+const minTimeSeriesRateWindow = time.Minute
+
+// timeSeriesQueryTemplateData holds the data for time series query templates
+type timeSeriesQueryTemplateData struct {
+	Selector  string
+	TimeRange string
+}
+
+// servicePairKey identifies a service pair in query results
+type servicePairKey struct {
+	sourceService        string
+	sourceNamespace      string
+	destinationService   string
+	destinationNamespace string
+}
+
+// GetMeshMetricsTimeSeries retrieves the metrics of service pairs over equal intervals of a time window - implements
+// interfaces.MetricsProvider
+func (p *Provider) GetMeshMetricsTimeSeries(ctx context.Context, pairs []*typesv1alpha1.ServicePair, startTime, endTime *timestamppb.Timestamp, buckets int32) (*typesv1alpha1.MeshMetricsTimeSeries, error) {
+	if p.client == nil {
+		return nil, fmt.Errorf("prometheus client not available")
+	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("at least one service pair is required")
+	}
+	if buckets <= 0 {
+		return nil, fmt.Errorf("buckets must be positive")
+	}
+
+	start, end := startTime.AsTime(), endTime.AsTime()
+	step := end.Sub(start) / time.Duration(buckets)
+	if step < time.Second {
+		return nil, fmt.Errorf("time window from %s to %s is too short for %d buckets", start.Format(time.RFC3339), end.Format(time.RFC3339), buckets)
+	}
+
+	p.logger.Info("retrieving mesh metrics time series from Prometheus",
+		"pairs", len(pairs),
+		"start_time", start,
+		"end_time", end,
+		"buckets", buckets,
+		"cluster", p.clusterName)
+
+	data := timeSeriesQueryTemplateData{
+		Selector:  timeSeriesSelector(pairs),
+		TimeRange: promDuration(max(step, minTimeSeriesRateWindow)),
+	}
+	// Each bucket is evaluated at its end, over a window of its length
+	r := v1.Range{Start: start.Add(step), End: end, Step: step}
+
+	// Run the queries in parallel, a failing query fails the time series as its buckets would be incomplete
+	templates := []*template.Template{
+		timeSeriesRequestRateQueryTemplate,
+		timeSeriesErrorRateQueryTemplate,
+		timeSeriesLatencyDistributionQueryTemplate,
+	}
+	responses := make([]model.Value, len(templates))
+	errs := make([]error, len(templates))
+	var wg sync.WaitGroup
+	for i, tmpl := range templates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			query, err := p.executeTemplate(tmpl, data)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to build %s query: %w", tmpl.Name(), err)
+				return
+			}
+			p.logger.Debug("executing time series query", "query", query, "step", step)
+			if responses[i], err = p.client.queryRange(ctx, query, r); err != nil {
+				errs[i] = fmt.Errorf("%s query failed: %w", tmpl.Name(), err)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create empty buckets for every requested pair, so pairs without traffic still have a series
+	result := &typesv1alpha1.MeshMetricsTimeSeries{
+		ClusterId: p.clusterName,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	series := make(map[servicePairKey]*typesv1alpha1.PairTimeSeries, len(pairs))
+	for _, pair := range pairs {
+		key := servicePairKey{pair.SourceService, pair.SourceNamespace, pair.DestinationService, pair.DestinationNamespace}
+		if _, exists := series[key]; exists {
+			continue
+		}
+		pairSeries := &typesv1alpha1.PairTimeSeries{Pair: pair, Buckets: make([]*typesv1alpha1.MetricsBucket, buckets)}
+		for i := range pairSeries.Buckets {
+			pairSeries.Buckets[i] = &typesv1alpha1.MetricsBucket{
+				StartTime: timestamppb.New(start.Add(time.Duration(i) * step)),
+				EndTime:   timestamppb.New(start.Add(time.Duration(i+1) * step)),
+			}
+		}
+		series[key] = pairSeries
+		result.Series = append(result.Series, pairSeries)
+	}
+
+	// bucket returns the bucket of a requested pair evaluated at a sample's timestamp
+	bucket := func(metric model.Metric, timestamp model.Time) *typesv1alpha1.MetricsBucket {
+		pairSeries, exists := series[servicePairKey{
+			sourceService:        p.getStringValue(metric, "source_canonical_service"),
+			sourceNamespace:      p.getStringValue(metric, "source_workload_namespace"),
+			destinationService:   p.getStringValue(metric, "destination_canonical_service"),
+			destinationNamespace: p.getStringValue(metric, "destination_service_namespace"),
+		}]
+		if !exists {
+			return nil
+		}
+		i := int(math.Round(float64(timestamp.Time().Sub(r.Start)) / float64(step)))
+		if i < 0 || i >= len(pairSeries.Buckets) {
+			return nil
+		}
+		return pairSeries.Buckets[i]
+	}
+
+	requestMatrix, err := timeSeriesMatrix(responses[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, stream := range requestMatrix {
+		for _, sample := range stream.Values {
+			if b := bucket(stream.Metric, sample.Timestamp); b != nil {
+				b.RequestRate = float64(sample.Value)
+			}
+		}
+	}
+
+	errorMatrix, err := timeSeriesMatrix(responses[1])
+	if err != nil {
+		return nil, err
+	}
+	for _, stream := range errorMatrix {
+		for _, sample := range stream.Values {
+			if b := bucket(stream.Metric, sample.Timestamp); b != nil {
+				b.ErrorRate = float64(sample.Value)
+			}
+		}
+	}
+
+	latencyMatrix, err := timeSeriesMatrix(responses[2])
+	if err != nil {
+		return nil, err
+	}
+	latencyBuckets := make(map[*typesv1alpha1.MetricsBucket]map[float64]float64)
+	for _, stream := range latencyMatrix {
+		// Skip the +Inf bucket, the highest finite bucket approximates the total count
+		leStr := p.getStringValue(stream.Metric, "le")
+		if leStr == "" || leStr == "+Inf" {
+			continue
+		}
+		le, err := strconv.ParseFloat(leStr, 64)
+		if err != nil {
+			p.logger.Warn("failed to parse le value", "le", leStr, "error", err)
+			continue
+		}
+		for _, sample := range stream.Values {
+			b := bucket(stream.Metric, sample.Timestamp)
+			if b == nil {
+				continue
+			}
+			if latencyBuckets[b] == nil {
+				latencyBuckets[b] = make(map[float64]float64)
+			}
+			latencyBuckets[b][le] = float64(sample.Value)
+		}
+	}
+	for b, counts := range latencyBuckets {
+		b.LatencyDistribution = newLatencyDistribution(counts)
+		if p99, err := sharedmetrics.CalculateP99(b.LatencyDistribution); err == nil {
+			b.LatencyP99 = durationpb.New(time.Duration(p99 * float64(time.Millisecond)))
+		}
+	}
+
+	p.logger.Debug("completed mesh metrics time series query",
+		"pairs", len(result.Series),
+		"buckets", buckets,
+		"request_series", len(requestMatrix),
+		"latency_series", len(latencyMatrix))
+
+	return result, nil
+}
+
+// timeSeriesSelector returns label matchers selecting the series of any of the service pairs
+func timeSeriesSelector(pairs []*typesv1alpha1.ServicePair) string {
+	var sourceServices, sourceNamespaces, destinationServices, destinationNamespaces []string
+	for _, pair := range pairs {
+		sourceServices = append(sourceServices, pair.SourceService)
+		sourceNamespaces = append(sourceNamespaces, pair.SourceNamespace)
+		destinationServices = append(destinationServices, pair.DestinationService)
+		destinationNamespaces = append(destinationNamespaces, pair.DestinationNamespace)
+	}
+	return fmt.Sprintf(`source_canonical_service=~"%s", source_workload_namespace=~"%s", destination_canonical_service=~"%s", destination_service_namespace=~"%s"`,
+		regexAlternation(sourceServices), regexAlternation(sourceNamespaces),
+		regexAlternation(destinationServices), regexAlternation(destinationNamespaces))
+}
+
+// regexAlternation returns a PromQL regex string matching any of the values exactly
+func regexAlternation(values []string) string {
+	unique := make(map[string]bool, len(values))
+	var quoted []string
+	for _, value := range values {
+		if unique[value] {
+			continue
+		}
+		unique[value] = true
+		// Backslashes are escaped again as PromQL strings interpret escape sequences
+		quoted = append(quoted, strings.ReplaceAll(regexp.QuoteMeta(value), `\`, `\\`))
+	}
+	sort.Strings(quoted)
+	return strings.Join(quoted, "|")
+}
+
+// promDuration formats a duration as a PromQL duration in whole seconds
+func promDuration(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+}
+
+// timeSeriesMatrix returns the series of a time series range query response
+func timeSeriesMatrix(response model.Value) (model.Matrix, error) {
+	if response == nil {
+		return nil, nil
+	}
+	matrix, ok := response.(model.Matrix)
+	if !ok {
+		return nil, fmt.Errorf("expected Matrix result for mesh metrics time series, got %T", response)
+	}
+	return matrix, nil
+}
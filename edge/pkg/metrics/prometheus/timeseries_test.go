@@ -0,0 +1,144 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetMeshMetricsTimeSeries(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Minute)
+	step := 2 * time.Minute
+
+	productpageToReviews := model.Metric{
+		"source_canonical_service":      "productpage",
+		"source_workload_namespace":     "bookinfo",
+		"destination_canonical_service": "reviews",
+		"destination_service_namespace": "bookinfo",
+	}
+	// Selected by the regex matchers but not requested
+	productpageToRatings := model.Metric{
+		"source_canonical_service":      "productpage",
+		"source_workload_namespace":     "bookinfo",
+		"destination_canonical_service": "ratings",
+		"destination_service_namespace": "bookinfo",
+	}
+	at := func(bucket int) model.Time {
+		return model.TimeFromUnixNano(start.Add(time.Duration(bucket+1) * step).UnixNano())
+	}
+	withLe := func(metric model.Metric, le string) model.Metric {
+		labeled := metric.Clone()
+		labeled["le"] = model.LabelValue(le)
+		return labeled
+	}
+
+	mockClient := &mockClient{
+		responses: map[string]mockResponse{
+			`sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", source_canonical_service=~"productpage|reviews", source_workload_namespace=~"bookinfo", destination_canonical_service=~"ratings|reviews", destination_service_namespace=~"bookinfo"}[120s])
+)`: {result: model.Matrix{
+				{Metric: productpageToReviews, Values: []model.SamplePair{{Timestamp: at(0), Value: 10}, {Timestamp: at(2), Value: 20}}},
+				{Metric: productpageToRatings, Values: []model.SamplePair{{Timestamp: at(0), Value: 99}}},
+			}},
+			`sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service
+)(
+  rate(istio_requests_total{reporter="destination", source_canonical_service=~"productpage|reviews", source_workload_namespace=~"bookinfo", destination_canonical_service=~"ratings|reviews", destination_service_namespace=~"bookinfo", response_code=~"0|4..|5.."}[120s])
+)`: {result: model.Matrix{
+				{Metric: productpageToReviews, Values: []model.SamplePair{{Timestamp: at(2), Value: 5}}},
+			}},
+			`sum by (
+  source_workload_namespace, source_canonical_service,
+  destination_service_namespace, destination_canonical_service, le
+)(
+  rate(istio_request_duration_milliseconds_bucket{reporter="destination", source_canonical_service=~"productpage|reviews", source_workload_namespace=~"bookinfo", destination_canonical_service=~"ratings|reviews", destination_service_namespace=~"bookinfo"}[120s])
+)`: {result: model.Matrix{
+				{Metric: withLe(productpageToReviews, "50"), Values: []model.SamplePair{{Timestamp: at(0), Value: 5}}},
+				{Metric: withLe(productpageToReviews, "100"), Values: []model.SamplePair{{Timestamp: at(0), Value: 10}}},
+				{Metric: withLe(productpageToReviews, "+Inf"), Values: []model.SamplePair{{Timestamp: at(0), Value: 10}}},
+			}},
+		},
+	}
+
+	provider := &Provider{
+		logger:      logging.For("test"),
+		client:      mockClient,
+		clusterName: "east",
+	}
+
+	result, err := provider.GetMeshMetricsTimeSeries(context.Background(), []*typesv1alpha1.ServicePair{
+		{SourceService: "productpage", SourceNamespace: "bookinfo", DestinationService: "reviews", DestinationNamespace: "bookinfo"},
+		{SourceService: "reviews", SourceNamespace: "bookinfo", DestinationService: "ratings", DestinationNamespace: "bookinfo"},
+	}, timestamppb.New(start), timestamppb.New(end), 5)
+	require.NoError(t, err)
+	assert.Equal(t, "east", result.ClusterId)
+	require.Len(t, result.Series, 2)
+
+	reviews := result.Series[0]
+	assert.Equal(t, "reviews", reviews.Pair.DestinationService)
+	require.Len(t, reviews.Buckets, 5)
+	assert.Equal(t, start, reviews.Buckets[0].StartTime.AsTime())
+	assert.Equal(t, start.Add(step), reviews.Buckets[0].EndTime.AsTime())
+	assert.Equal(t, end, reviews.Buckets[4].EndTime.AsTime())
+	assert.Equal(t, 10.0, reviews.Buckets[0].RequestRate)
+	assert.Equal(t, 0.0, reviews.Buckets[1].RequestRate)
+	assert.Equal(t, 20.0, reviews.Buckets[2].RequestRate)
+	assert.Equal(t, 5.0, reviews.Buckets[2].ErrorRate)
+	require.NotNil(t, reviews.Buckets[0].LatencyDistribution)
+	assert.Len(t, reviews.Buckets[0].LatencyDistribution.Buckets, 2)
+	assert.NotNil(t, reviews.Buckets[0].LatencyP99)
+	assert.Nil(t, reviews.Buckets[1].LatencyDistribution)
+
+	// Requested pairs without traffic have empty buckets
+	ratings := result.Series[1]
+	assert.Equal(t, "ratings", ratings.Pair.DestinationService)
+	require.Len(t, ratings.Buckets, 5)
+	for _, bucket := range ratings.Buckets {
+		assert.Zero(t, bucket.RequestRate)
+	}
+}
+
+func TestGetMeshMetricsTimeSeries_InvalidRequest(t *testing.T) {
+	provider := &Provider{logger: logging.For("test"), client: &mockClient{}}
+	pairs := []*typesv1alpha1.ServicePair{{SourceService: "productpage", SourceNamespace: "bookinfo", DestinationService: "reviews", DestinationNamespace: "bookinfo"}}
+	start := time.Now().Add(-time.Minute)
+
+	_, err := provider.GetMeshMetricsTimeSeries(context.Background(), nil, timestamppb.New(start), timestamppb.Now(), 5)
+	assert.Error(t, err)
+
+	_, err = provider.GetMeshMetricsTimeSeries(context.Background(), pairs, timestamppb.New(start), timestamppb.New(start.Add(10*time.Second)), 30)
+	assert.ErrorContains(t, err, "too short")
+
+	_, err = (&Provider{logger: logging.For("test")}).GetMeshMetricsTimeSeries(context.Background(), pairs, timestamppb.New(start), timestamppb.Now(), 5)
+	assert.Error(t, err)
+}
+
+func TestRegexAlternation(t *testing.T) {
+	assert.Equal(t, `details|reviews\\.v1`, regexAlternation([]string{"reviews.v1", "details", "details"}))
+}
@@ -36,6 +36,10 @@ type Config struct {
 	Type ProviderType `json:"type" yaml:"type"`
 	// Endpoint is the endpoint URL for the metrics provider
 	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// SecondaryEndpoint is the endpoint URL of a second source of the same metrics, e.g. Thanos
+	SecondaryEndpoint string `json:"secondary_endpoint,omitempty" yaml:"secondary_endpoint,omitempty"`
+	// SecondaryMode is how the secondary endpoint is used, defaults to failover
+	SecondaryMode SecondaryMode `json:"secondary_mode,omitempty" yaml:"secondary_mode,omitempty"`
 	// Enabled indicates whether metrics collection is enabled
 	Enabled bool `json:"enabled" yaml:"enabled"`
 	// QueryInterval is how often to query for metrics (in seconds)
@@ -44,6 +48,10 @@ type Config struct {
 	Timeout int `json:"timeout" yaml:"timeout"`
 	// BearerToken for bearer token authentication
 	BearerToken string `json:"bearer_token,omitempty" yaml:"bearer_token,omitempty"`
+	// LabelMapping maps standard labels to the custom label names of the metrics
+	LabelMapping LabelMapping `json:"label_mapping,omitempty" yaml:"label_mapping,omitempty"`
+	// QueryTemplates overrides the default queries of the provider
+	QueryTemplates QueryTemplates `json:"query_templates,omitempty" yaml:"query_templates,omitempty"`
 }
 
 // Validate validates the metrics configuration
@@ -56,10 +64,18 @@ func (c *Config) Validate() error {
 		c.Type = ProviderTypeNone
 	}
 
-	if c.Type != ProviderTypeNone && c.Endpoint == "" {
+	// The endpoint of Prometheus is discovered in the cluster when it is not set
+	if c.Type != ProviderTypeNone && c.Type != ProviderTypePrometheus && c.Endpoint == "" {
 		return ErrMissingEndpoint
 	}
 
+	if c.SecondaryMode == "" {
+		c.SecondaryMode = SecondaryModeFailover
+	}
+	if c.SecondaryMode != SecondaryModeFailover && c.SecondaryMode != SecondaryModeMerge {
+		return ErrInvalidSecondaryMode
+	}
+
 	if c.QueryInterval <= 0 {
 		c.QueryInterval = 30 // Default to 30 seconds
 	}
@@ -68,5 +84,13 @@ func (c *Config) Validate() error {
 		c.Timeout = 10 // Default to 10 seconds
 	}
 
+	if err := c.LabelMapping.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.QueryTemplates.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,104 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// QueryTemplates overrides the queries used to retrieve the connections of a service, for meshes whose
+// telemetry uses different metric or label names than Istio's defaults. Empty templates use the default
+// query.
+//
+// Templates are Go text/templates given the ServiceName, ServiceNamespace, FilterClause and TimeRange of
+// the query. Their results must keep the source_cluster, source_workload_namespace,
+// source_canonical_service, destination_cluster, destination_service_namespace and
+// destination_canonical_service labels, or the labels they are mapped to in the LabelMapping, and
+// latency distributions must also keep le.
+type QueryTemplates struct {
+	InboundRequestRate          string `json:"inbound_request_rate,omitempty" yaml:"inbound_request_rate,omitempty"`
+	OutboundRequestRate         string `json:"outbound_request_rate,omitempty" yaml:"outbound_request_rate,omitempty"`
+	InboundErrorRate            string `json:"inbound_error_rate,omitempty" yaml:"inbound_error_rate,omitempty"`
+	OutboundErrorRate           string `json:"outbound_error_rate,omitempty" yaml:"outbound_error_rate,omitempty"`
+	InboundLatencyDistribution  string `json:"inbound_latency_distribution,omitempty" yaml:"inbound_latency_distribution,omitempty"`
+	OutboundLatencyDistribution string `json:"outbound_latency_distribution,omitempty" yaml:"outbound_latency_distribution,omitempty"`
+}
+
+// queryTemplateSample is the data templates are executed with during validation
+type queryTemplateSample struct {
+	ServiceName      string
+	ServiceNamespace string
+	FilterClause     string
+	TimeRange        string
+}
+
+// LoadQueryTemplates reads query templates from a YAML file
+func LoadQueryTemplates(path string) (QueryTemplates, error) {
+	var templates QueryTemplates
+
+	file, err := os.Open(path) // #nosec G304 - path is provided by the operator
+	if err != nil {
+		return templates, fmt.Errorf("failed to open query templates file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&templates); err != nil && err != io.EOF {
+		return templates, fmt.Errorf("failed to parse query templates file: %w", err)
+	}
+	return templates, nil
+}
+
+// named returns the templates with their names, in order, including empty ones
+func (t QueryTemplates) named() [][2]string {
+	return [][2]string{
+		{"inbound_request_rate", t.InboundRequestRate},
+		{"outbound_request_rate", t.OutboundRequestRate},
+		{"inbound_error_rate", t.InboundErrorRate},
+		{"outbound_error_rate", t.OutboundErrorRate},
+		{"inbound_latency_distribution", t.InboundLatencyDistribution},
+		{"outbound_latency_distribution", t.OutboundLatencyDistribution},
+	}
+}
+
+// Validate checks that each template parses and only refers to the data it is given
+func (t QueryTemplates) Validate() error {
+	sample := queryTemplateSample{
+		ServiceName:      "service",
+		ServiceNamespace: "namespace",
+		TimeRange:        "5m",
+	}
+
+	for _, named := range t.named() {
+		name, text := named[0], named[1]
+		if text == "" {
+			continue
+		}
+		tmpl, err := template.New(name).Parse(text)
+		if err != nil {
+			return fmt.Errorf("invalid %s query template: %w", name, err)
+		}
+		if err := tmpl.Execute(io.Discard, sample); err != nil {
+			return fmt.Errorf("invalid %s query template: %w", name, err)
+		}
+	}
+	return nil
+}
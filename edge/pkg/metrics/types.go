@@ -28,6 +28,16 @@ const (
 	ProviderTypeNone ProviderType = "none"
 )
 
+// SecondaryMode is how a secondary metrics endpoint is used alongside the primary endpoint
+type SecondaryMode string
+
+const (
+	// SecondaryModeFailover queries the secondary endpoint only when the primary endpoint fails
+	SecondaryModeFailover SecondaryMode = "failover"
+	// SecondaryModeMerge queries both endpoints and merges their series, for metrics federated across them
+	SecondaryModeMerge SecondaryMode = "merge"
+)
+
 // ProviderInfo contains information about a metrics provider
 type ProviderInfo struct {
 	// Type is the type of metrics provider
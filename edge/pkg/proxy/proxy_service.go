@@ -22,6 +22,7 @@ import (
 	"log/slog"
 
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/envoy/admin"
 	"github.com/liamawhite/navigator/pkg/envoy/clusters"
 	"github.com/liamawhite/navigator/pkg/envoy/configdump"
 	"github.com/liamawhite/navigator/pkg/istio/proxy/client"
@@ -117,6 +118,8 @@ func (s *ProxyService) GetProxyConfig(ctx context.Context, namespace, podName st
 		Endpoints:     endpoints,
 		Routes:        summary.Routes,
 		RawClusters:   rawClusters,
+
+		OutboundTrafficPolicyMode: enrich.OutboundTrafficPolicyMode(summary.Listeners),
 	}
 
 	s.logger.Debug("successfully retrieved proxy config",
@@ -131,6 +134,24 @@ func (s *ProxyService) GetProxyConfig(ctx context.Context, namespace, podName st
 	return proxyConfig, nil
 }
 
+// GetAdminOutput retrieves the raw output of an allowlisted Envoy admin endpoint for a pod
+func (s *ProxyService) GetAdminOutput(ctx context.Context, namespace, podName, path, query string) (string, error) {
+	requestPath, err := admin.BuildRequestPath(path, query)
+	if err != nil {
+		return "", err
+	}
+
+	s.logger.Debug("retrieving envoy admin output", "namespace", namespace, "pod", podName, "path", requestPath)
+
+	output, err := s.adminClient.GetAdminPath(ctx, namespace, podName, requestPath)
+	if err != nil {
+		s.logger.Error("failed to get envoy admin output", "namespace", namespace, "pod", podName, "path", requestPath, "error", err)
+		return "", fmt.Errorf("failed to get envoy admin %s for pod %s/%s: %w", requestPath, namespace, podName, err)
+	}
+
+	return output, nil
+}
+
 // IsProxyReady checks if the Envoy proxy in the specified pod is ready for configuration requests
 func (s *ProxyService) IsProxyReady(ctx context.Context, namespace, podName string) (bool, error) {
 	s.logger.Debug("checking proxy readiness", "namespace", namespace, "pod", podName)
@@ -181,6 +202,7 @@ func (s *ProxyService) ValidateProxyAccess(ctx context.Context, namespace, podNa
 // MockProxyService provides a mock implementation for testing
 type MockProxyService struct {
 	GetProxyConfigFunc      func(ctx context.Context, namespace, podName string) (*types.ProxyConfig, error)
+	GetAdminOutputFunc      func(ctx context.Context, namespace, podName, path, query string) (string, error)
 	IsProxyReadyFunc        func(ctx context.Context, namespace, podName string) (bool, error)
 	GetProxyVersionFunc     func(ctx context.Context, namespace, podName string) (string, error)
 	ValidateProxyAccessFunc func(ctx context.Context, namespace, podName string) error
@@ -194,6 +216,14 @@ func (m *MockProxyService) GetProxyConfig(ctx context.Context, namespace, podNam
 	return &types.ProxyConfig{Version: "mock"}, nil
 }
 
+// GetAdminOutput mock implementation
+func (m *MockProxyService) GetAdminOutput(ctx context.Context, namespace, podName, path, query string) (string, error) {
+	if m.GetAdminOutputFunc != nil {
+		return m.GetAdminOutputFunc(ctx, namespace, podName, path, query)
+	}
+	return "", nil
+}
+
 // IsProxyReady mock implementation
 func (m *MockProxyService) IsProxyReady(ctx context.Context, namespace, podName string) (bool, error) {
 	if m.IsProxyReadyFunc != nil {
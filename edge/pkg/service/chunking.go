@@ -0,0 +1,117 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// chunkFieldOverhead approximates the per-element tag and length prefix added when a resource is serialized into a chunk
+const chunkFieldOverhead = 16
+
+// splitClusterState splits a cluster state into partial states that each serialize to
+// roughly maxChunkBytes or less. Merging the partial states in order yields the original.
+// A single resource larger than maxChunkBytes is placed in a chunk of its own.
+func splitClusterState(state *v1alpha1.ClusterState, maxChunkBytes int) []*v1alpha1.ClusterState {
+	var chunks []*v1alpha1.ClusterState
+	current := &v1alpha1.ClusterState{}
+	currentSize := 0
+
+	src := state.ProtoReflect()
+
+	// Singular fields (control plane config, sync metadata) and cluster labels travel in the first chunk
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() {
+			return true
+		}
+		current.ProtoReflect().Set(fd, v)
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsMap() {
+			currentSize += proto.Size(v.Message().Interface()) + chunkFieldOverhead
+		}
+		return true
+	})
+
+	// Repeated resource fields are packed greedily, preserving element order
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsList() || fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			elem := list.Get(i)
+			size := proto.Size(elem.Message().Interface()) + chunkFieldOverhead
+			if currentSize > 0 && currentSize+size > maxChunkBytes {
+				chunks = append(chunks, current)
+				current = &v1alpha1.ClusterState{}
+				currentSize = 0
+			}
+			current.ProtoReflect().Mutable(fd).List().Append(elem)
+			currentSize += size
+		}
+		return true
+	})
+
+	return append(chunks, current)
+}
+
+// appendClusterState appends the resources of a partial state to a cluster state, preserving element order.
+// Singular fields of the partial state replace those of the cluster state.
+func appendClusterState(state, partial *v1alpha1.ClusterState) {
+	dst := state.ProtoReflect()
+	partial.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsList() {
+			dst.Set(fd, v)
+			return true
+		}
+		list := v.List()
+		dstList := dst.Mutable(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			dstList.Append(list.Get(i))
+		}
+		return true
+	})
+}
+
+// resetClusterState empties a cluster state while keeping the capacity of its resource lists for reuse.
+// Elements are cleared so the resources of a sent chunk are not retained.
+func resetClusterState(state *v1alpha1.ClusterState) {
+	state.Services = truncate(state.Services)
+	state.DestinationRules = truncate(state.DestinationRules)
+	state.EnvoyFilters = truncate(state.EnvoyFilters)
+	state.RequestAuthentications = truncate(state.RequestAuthentications)
+	state.Gateways = truncate(state.Gateways)
+	state.Sidecars = truncate(state.Sidecars)
+	state.VirtualServices = truncate(state.VirtualServices)
+	state.PeerAuthentications = truncate(state.PeerAuthentications)
+	state.AuthorizationPolicies = truncate(state.AuthorizationPolicies)
+	state.WasmPlugins = truncate(state.WasmPlugins)
+	state.ServiceEntries = truncate(state.ServiceEntries)
+	state.ServiceExports = truncate(state.ServiceExports)
+	state.ServiceImports = truncate(state.ServiceImports)
+	state.ResourceChanges = truncate(state.ResourceChanges)
+	state.IstioControlPlaneConfig = nil
+	state.IstioCni = nil
+	state.SidecarInjection = nil
+	state.SyncMetadata = nil
+	state.ClusterLabels = nil
+}
+
+// truncate empties a slice, keeping its capacity
+func truncate[T any](s []T) []T {
+	clear(s)
+	return s[:0]
+}
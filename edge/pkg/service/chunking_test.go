@@ -0,0 +1,84 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func largeClusterState(services, virtualServices int) *v1alpha1.ClusterState {
+	state := &v1alpha1.ClusterState{
+		IstioControlPlaneConfig: &types.IstioControlPlaneConfig{RootNamespace: "istio-system"},
+		SyncMetadata:            &v1alpha1.SyncMetadata{CollectedAt: timestamppb.Now()},
+		ClusterLabels:           map[string]string{"region": "eu-west-1"},
+	}
+	for i := 0; i < services; i++ {
+		state.Services = append(state.Services, &v1alpha1.Service{
+			Name:      fmt.Sprintf("service-%d", i),
+			Namespace: "default",
+		})
+	}
+	for i := 0; i < virtualServices; i++ {
+		state.VirtualServices = append(state.VirtualServices, &types.VirtualService{
+			Name:      fmt.Sprintf("vs-%d", i),
+			Namespace: "default",
+		})
+	}
+	return state
+}
+
+func TestSplitClusterState_RoundTrip(t *testing.T) {
+	state := largeClusterState(500, 200)
+	maxChunkBytes := 2048
+
+	chunks := splitClusterState(state, maxChunkBytes)
+	assert.Greater(t, len(chunks), 1, "Expected state to be split into multiple chunks")
+
+	for i, chunk := range chunks {
+		assert.LessOrEqual(t, proto.Size(chunk), maxChunkBytes, "Expected chunk %d to fit the budget", i)
+	}
+
+	// Singular fields travel in the first chunk only
+	assert.NotNil(t, chunks[0].IstioControlPlaneConfig)
+	assert.NotNil(t, chunks[0].SyncMetadata)
+	assert.Equal(t, state.ClusterLabels, chunks[0].ClusterLabels)
+	assert.Nil(t, chunks[len(chunks)-1].IstioControlPlaneConfig)
+
+	merged := &v1alpha1.ClusterState{}
+	for _, chunk := range chunks {
+		proto.Merge(merged, chunk)
+	}
+	assert.True(t, proto.Equal(state, merged), "Expected merged chunks to equal the original state")
+}
+
+func TestSplitClusterState_SmallState(t *testing.T) {
+	state := largeClusterState(3, 1)
+
+	chunks := splitClusterState(state, 1024*1024)
+	assert.Len(t, chunks, 1)
+	assert.True(t, proto.Equal(state, chunks[0]))
+}
+
+func TestSplitClusterState_Empty(t *testing.T) {
+	chunks := splitClusterState(&v1alpha1.ClusterState{}, 1024)
+	assert.Len(t, chunks, 1)
+}
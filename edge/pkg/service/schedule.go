@@ -0,0 +1,56 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// syncSchedule times periodic syncs on a grid of sync intervals, shifted by the offset the manager
+// assigned so that edges started together sync at different times. Each sync is moved by a random
+// jitter around its grid point, spreading out edges that share one, without drifting off the grid.
+type syncSchedule struct {
+	start    time.Time
+	interval time.Duration
+	jitter   float64 // Largest move of a sync from its grid point, as a fraction of the interval
+	next     int64   // Grid point of the next sync
+	random   func() float64
+}
+
+// newSyncSchedule creates a schedule whose first sync is due an interval after the offset, a fraction of
+// the interval, has passed
+func newSyncSchedule(now time.Time, interval time.Duration, offset, jitter float64) *syncSchedule {
+	return &syncSchedule{
+		start:    now.Add(time.Duration(offset * float64(interval))),
+		interval: interval,
+		jitter:   jitter,
+		next:     1,
+		random:   rand.Float64,
+	}
+}
+
+// delay returns how long to wait for the next sync. Grid points that passed while syncing are skipped,
+// as with a ticker.
+func (s *syncSchedule) delay(now time.Time) time.Duration {
+	if elapsed := now.Sub(s.start); elapsed >= 0 {
+		s.next = max(s.next, int64(elapsed/s.interval)+1)
+	}
+	due := s.start.Add(time.Duration(s.next) * s.interval)
+	s.next++
+
+	jitter := time.Duration((s.random()*2 - 1) * s.jitter * float64(s.interval))
+	return max(due.Add(jitter).Sub(now), 0)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncSchedule_delay(t *testing.T) {
+	now := time.Now()
+	schedule := newSyncSchedule(now, 30*time.Second, 0.5, 0.1)
+	schedule.random = func() float64 { return 0.5 } // No jitter
+
+	// The first sync is due an interval after the offset
+	assert.Equal(t, 45*time.Second, schedule.delay(now))
+
+	// Later syncs stay on the grid however long syncing took
+	now = now.Add(50 * time.Second)
+	assert.Equal(t, 25*time.Second, schedule.delay(now))
+
+	// Grid points that passed while syncing are skipped
+	now = now.Add(70 * time.Second)
+	assert.Equal(t, 15*time.Second, schedule.delay(now))
+
+	// Jitter moves a sync around its grid point by at most the jitter
+	schedule.random = func() float64 { return 0 }
+	assert.Equal(t, 42*time.Second, schedule.delay(now))
+	schedule.random = func() float64 { return 1 }
+	assert.Equal(t, 78*time.Second, schedule.delay(now))
+}
@@ -19,57 +19,97 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
 	"github.com/liamawhite/navigator/edge/pkg/interfaces"
 	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/protocol"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+	"github.com/liamawhite/navigator/pkg/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // KubernetesClient interface for dependency injection
 type KubernetesClient interface {
 	GetClusterState(ctx context.Context) (*v1alpha1.ClusterState, error)
 	GetClusterStateWithMetrics(ctx context.Context, metricsProvider interfaces.MetricsProvider) (*v1alpha1.ClusterState, error)
+	StreamClusterState(ctx context.Context, emit func(segment *v1alpha1.ClusterState) error) error
 	GetClusterName(ctx context.Context) (string, error)
+	GetPodLogs(ctx context.Context, req *v1alpha1.PodLogsRequest) (*types.ContainerLogs, error)
+	Preflight(ctx context.Context) *v1alpha1.PreflightReport
+	PreflightReport() *v1alpha1.PreflightReport
 }
 
 // ProxyService interface for dependency injection
 type ProxyService interface {
 	GetProxyConfig(ctx context.Context, namespace, podName string) (*types.ProxyConfig, error)
+	GetAdminOutput(ctx context.Context, namespace, podName, path, query string) (string, error)
 	ValidateProxyAccess(ctx context.Context, namespace, podName string) error
 }
 
+// LeaderElector interface for dependency injection
+type LeaderElector interface {
+	Run(ctx context.Context, onStartedLeading func(ctx context.Context, leader *v1alpha1.LeaderElection))
+}
+
 // Config interface for dependency injection
 type Config interface {
 	GetManagerEndpoint() string
 	GetSyncInterval() int
+	GetSyncJitter() float64
 	GetMaxMessageSize() int
+	GetSyncMemoryBudget() int
+	GetRawConfigCompression() bool
 	GetMetricsConfig() metrics.Config
+	GetNamespaceShard() *v1alpha1.NamespaceShard
+	GetClusterLabels() map[string]string
+	GetExcludedResourceTypes() []string
 	Validate() error
 }
 
 // EdgeService manages the connection to the manager and handles cluster state synchronization
 type EdgeService struct {
-	config          Config
-	k8sClient       KubernetesClient
-	proxyService    ProxyService
-	metricsProvider interfaces.MetricsProvider
-	logger          *slog.Logger
-	clusterName     string // Auto-discovered from Istio
-	client          v1alpha1.ManagerServiceClient
-	conn            *grpc.ClientConn
-	stream          v1alpha1.ManagerService_ConnectClient
-	connected       bool
-	mu              sync.RWMutex
-	ctx             context.Context
-	cancel          context.CancelFunc
-	wg              sync.WaitGroup
+	config             Config
+	k8sClient          KubernetesClient
+	proxyService       ProxyService
+	metricsProvider    interfaces.MetricsProvider
+	tracesProvider     interfaces.TracesProvider     // Searches the cluster's tracing backend, nil when none is configured
+	accessLogsProvider interfaces.AccessLogsProvider // Searches the cluster's logs backend, nil when none is configured
+	logger             *slog.Logger
+	clusterName        string                    // Auto-discovered from Istio
+	preflight          *v1alpha1.PreflightReport // Compatibility checks last reported to the manager
+	elector            LeaderElector             // Elects the replica that syncs, nil when running as the only replica
+	leader             *v1alpha1.LeaderElection  // This replica's leadership, reported to the manager
+	errCh              chan error                // Reports errors that stop the service after Start returns
+	client             v1alpha1.ManagerServiceClient
+	conn               *grpc.ClientConn
+	stream             v1alpha1.ManagerService_ConnectClient
+	connected          bool
+	chunkedState       bool          // Whether the manager accepts chunked cluster state
+	streamedState      bool          // Whether the manager accepts cluster state streamed in chunks of unknown total
+	managerMaxSize     int           // Largest message the manager will receive, in bytes (0 if unknown)
+	compressConfig     bool          // Whether to send Istio resource raw config compressed
+	capabilitiesUpdate bool          // Whether the manager accepts capabilities updates on an established connection
+	syncOffset         float64       // Fraction of the sync interval periodic syncs are delayed by, assigned by the manager
+	resyncCh           chan struct{} // Signals an immediate cluster state sync
+	mu                 sync.RWMutex
+	ctx                context.Context
+	cancel             context.CancelFunc
+	wg                 sync.WaitGroup
 }
 
 // NewEdgeService creates a new edge service
@@ -86,6 +126,8 @@ func NewEdgeService(config Config, k8sClient KubernetesClient, proxyService Prox
 		proxyService:    proxyService,
 		metricsProvider: metricsProvider,
 		logger:          logger,
+		resyncCh:        make(chan struct{}, 1),
+		errCh:           make(chan error, 1),
 		ctx:             ctx,
 		cancel:          cancel,
 	}, nil
@@ -93,6 +135,9 @@ func NewEdgeService(config Config, k8sClient KubernetesClient, proxyService Prox
 
 // Start starts the edge service and begins cluster state synchronization
 func (e *EdgeService) Start() error {
+	// Check what the edge can collect before anything depends on it
+	e.preflight = e.k8sClient.Preflight(e.ctx)
+
 	// Auto-discover cluster name from Istio
 	clusterName, err := e.k8sClient.GetClusterName(e.ctx)
 	if err != nil {
@@ -102,6 +147,42 @@ func (e *EdgeService) Start() error {
 
 	e.logger.Info("starting edge service", "cluster_name", e.clusterName, "manager_endpoint", e.config.GetManagerEndpoint())
 
+	if e.elector != nil {
+		// Stay warm as a standby until elected, then sync for as long as this replica leads
+		e.wg.Add(1)
+		go e.campaign()
+		return nil
+	}
+
+	return e.startSyncing()
+}
+
+// SetLeaderElector makes the service sync only while elected leader among the edge replicas of its cluster.
+// It must be called before Start.
+func (e *EdgeService) SetLeaderElector(elector LeaderElector) {
+	e.elector = elector
+}
+
+// SetTracesProvider makes the service answer trace searches from the manager with a tracing backend.
+// It must be called before Start.
+func (e *EdgeService) SetTracesProvider(provider interfaces.TracesProvider) {
+	e.tracesProvider = provider
+}
+
+// SetAccessLogsProvider makes the service answer access log searches from the manager with a logs backend.
+// It must be called before Start.
+func (e *EdgeService) SetAccessLogsProvider(provider interfaces.AccessLogsProvider) {
+	e.accessLogsProvider = provider
+}
+
+// Err returns a channel reporting an error that stopped the service after Start returned,
+// such as losing leadership
+func (e *EdgeService) Err() <-chan error {
+	return e.errCh
+}
+
+// startSyncing connects to the manager and starts syncing cluster state
+func (e *EdgeService) startSyncing() error {
 	// Connect to manager
 	if err := e.connect(); err != nil {
 		return fmt.Errorf("failed to connect to manager: %w", err)
@@ -118,6 +199,37 @@ func (e *EdgeService) Start() error {
 	return nil
 }
 
+// campaign runs leader election, syncing while this replica leads. Leadership is only given up by
+// stopping: losing it is reported through Err so the replica restarts as a standby.
+func (e *EdgeService) campaign() {
+	defer e.wg.Done()
+
+	e.elector.Run(e.ctx, func(ctx context.Context, leader *v1alpha1.LeaderElection) {
+		e.mu.Lock()
+		e.leader = leader
+		e.mu.Unlock()
+
+		e.logger.Info("elected leader, starting sync", "identity", leader.Identity, "term", leader.Term)
+		if err := e.startSyncing(); err != nil {
+			e.fail(err)
+			return
+		}
+		<-ctx.Done()
+	})
+
+	if e.ctx.Err() == nil {
+		e.fail(fmt.Errorf("lost leadership"))
+	}
+}
+
+// fail reports an error that stops the service
+func (e *EdgeService) fail(err error) {
+	select {
+	case e.errCh <- err:
+	default:
+	}
+}
+
 // Stop gracefully stops the edge service
 func (e *EdgeService) Stop() error {
 	e.logger.Info("stopping edge service")
@@ -135,6 +247,20 @@ func (e *EdgeService) Stop() error {
 		}
 	}
 
+	// Close traces provider
+	if e.tracesProvider != nil {
+		if err := e.tracesProvider.Close(); err != nil {
+			e.logger.Error("failed to close traces provider", "error", err)
+		}
+	}
+
+	// Close access logs provider
+	if e.accessLogsProvider != nil {
+		if err := e.accessLogsProvider.Close(); err != nil {
+			e.logger.Error("failed to close access logs provider", "error", err)
+		}
+	}
+
 	// Close connection
 	if e.conn != nil {
 		return e.conn.Close()
@@ -169,7 +295,7 @@ func (e *EdgeService) connect() error {
 		return fmt.Errorf("failed to create stream: %w", err)
 	}
 
-	e.stream = stream
+	e.stream = newManagerStream(stream)
 
 	// Send cluster identification
 	if err := e.sendClusterIdentification(); err != nil {
@@ -192,13 +318,19 @@ func (e *EdgeService) connect() error {
 
 // sendClusterIdentification sends the cluster identification to the manager
 func (e *EdgeService) sendClusterIdentification() error {
+	e.mu.RLock()
+	leader := e.leader
+	e.mu.RUnlock()
+
 	req := &v1alpha1.ConnectRequest{
 		Message: &v1alpha1.ConnectRequest_ClusterIdentification{
 			ClusterIdentification: &v1alpha1.ClusterIdentification{
-				ClusterId: e.clusterName,
-				Capabilities: &v1alpha1.EdgeCapabilities{
-					MetricsEnabled: e.metricsProvider != nil && e.metricsProvider.GetProviderInfo().Type != metrics.ProviderTypeNone,
-				},
+				ClusterId:      e.clusterName,
+				Capabilities:   e.capabilities(),
+				EdgeVersion:    version.Get(),
+				LeaderElection: leader,
+				Shard:          e.config.GetNamespaceShard(),
+				Protocol:       e.protocolCapabilities(),
 			},
 		},
 	}
@@ -206,6 +338,60 @@ func (e *EdgeService) sendClusterIdentification() error {
 	return e.stream.Send(req)
 }
 
+// capabilities returns the features of this edge and its latest reported preflight report
+func (e *EdgeService) capabilities() *v1alpha1.EdgeCapabilities {
+	e.mu.RLock()
+	preflight := e.preflight
+	e.mu.RUnlock()
+
+	return &v1alpha1.EdgeCapabilities{
+		MetricsEnabled:    e.metricsProvider != nil && e.metricsProvider.GetProviderInfo().Type != metrics.ProviderTypeNone,
+		Preflight:         preflight,
+		TracesEnabled:     e.tracesProvider != nil,
+		AccessLogsEnabled: e.accessLogsProvider != nil,
+	}
+}
+
+// updateCapabilities sends the manager the edge's capabilities again when rediscovery replaced the preflight
+// report since it was last reported, so the manager stops reporting resource types that became available.
+// Managers that do not accept capabilities updates see the new report when the edge next reconnects.
+func (e *EdgeService) updateCapabilities() error {
+	report := e.k8sClient.PreflightReport()
+	e.mu.Lock()
+	changed := report != nil && report != e.preflight
+	if changed {
+		e.preflight = report
+	}
+	supported := e.capabilitiesUpdate
+	e.mu.Unlock()
+	if !changed || !supported {
+		return nil
+	}
+
+	req := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_CapabilitiesUpdate{
+			CapabilitiesUpdate: e.capabilities(),
+		},
+	}
+	if err := e.stream.Send(req); err != nil {
+		return fmt.Errorf("failed to send capabilities update: %w", err)
+	}
+
+	e.logger.Info("sent updated capabilities to manager", "preflight_checked_at", report.CheckedAt.AsTime())
+	return nil
+}
+
+// protocolCapabilities returns the protocol capabilities of this build without the resource types that
+// disabled collection modules leave out, so the manager reports them as gaps
+func (e *EdgeService) protocolCapabilities() *v1alpha1.ProtocolCapabilities {
+	capabilities := protocol.Capabilities()
+	excluded := e.config.GetExcludedResourceTypes()
+	capabilities.ResourceTypes = slices.DeleteFunc(capabilities.ResourceTypes, func(resourceType string) bool {
+		return slices.Contains(excluded, resourceType)
+	})
+	return capabilities
+}
+
 // waitForConnectionAck waits for the connection acknowledgment from the manager
 func (e *EdgeService) waitForConnectionAck() error {
 	resp, err := e.stream.Recv()
@@ -218,7 +404,30 @@ func (e *EdgeService) waitForConnectionAck() error {
 		if !msg.ConnectionAck.Accepted {
 			return fmt.Errorf("connection rejected by manager")
 		}
-		e.logger.Info("connection accepted by manager")
+		// Managers that predate capability negotiation only acknowledge the features they support
+		chunkedState := msg.ConnectionAck.ChunkedClusterState
+		streamedState := msg.ConnectionAck.StreamedClusterState
+		compressedConfig := msg.ConnectionAck.CompressedRawConfig
+		capabilitiesUpdate := msg.ConnectionAck.GetProtocol().GetCapabilitiesUpdate()
+		if managerProtocol := msg.ConnectionAck.GetProtocol(); managerProtocol != nil {
+			chunkedState = managerProtocol.ChunkedClusterState
+			streamedState = managerProtocol.StreamedClusterState
+			compressedConfig = protocol.SupportsCompression(managerProtocol, protocol.CompressionZstd)
+		}
+		e.mu.Lock()
+		e.chunkedState = chunkedState
+		e.streamedState = streamedState
+		e.managerMaxSize = int(msg.ConnectionAck.MaxMessageSize)
+		e.compressConfig = compressedConfig && e.config.GetRawConfigCompression()
+		e.syncOffset = msg.ConnectionAck.SyncOffset
+		e.capabilitiesUpdate = capabilitiesUpdate
+		compressConfig := e.compressConfig
+		e.mu.Unlock()
+		e.logger.Info("connection accepted by manager",
+			"api_version", msg.ConnectionAck.GetProtocol().GetApiVersion(),
+			"chunked_cluster_state", chunkedState,
+			"compressed_raw_config", compressConfig,
+			"sync_offset", msg.ConnectionAck.SyncOffset)
 		return nil
 	case *v1alpha1.ConnectResponse_Error:
 		return fmt.Errorf("connection error: %s", msg.Error.ErrorMessage)
@@ -231,20 +440,31 @@ func (e *EdgeService) waitForConnectionAck() error {
 func (e *EdgeService) syncLoop() {
 	defer e.wg.Done()
 
-	ticker := time.NewTicker(time.Duration(e.config.GetSyncInterval()) * time.Second)
-	defer ticker.Stop()
-
 	// Perform initial sync
 	if err := e.syncClusterState(); err != nil {
 		e.logger.Error("failed to sync cluster state", "error", err)
 	}
 
+	// Offset periodic syncs as assigned by the manager, and jitter them, to smooth the load of many edges
+	e.mu.RLock()
+	syncOffset := e.syncOffset
+	e.mu.RUnlock()
+	interval := time.Duration(e.config.GetSyncInterval()) * time.Second
+	schedule := newSyncSchedule(time.Now(), interval, syncOffset, e.config.GetSyncJitter())
+	timer := time.NewTimer(schedule.delay(time.Now()))
+	defer timer.Stop()
+
 	for {
 		select {
 		case <-e.ctx.Done():
 			e.logger.Info("sync loop stopped")
 			return
-		case <-ticker.C:
+		case <-e.resyncCh:
+			e.logger.Info("performing requested cluster state resync")
+			if err := e.syncClusterState(); err != nil {
+				e.logger.Error("failed to resync cluster state", "error", err)
+			}
+		case <-timer.C:
 			if err := e.syncClusterState(); err != nil {
 				e.logger.Error("failed to sync cluster state", "error", err)
 
@@ -256,6 +476,7 @@ func (e *EdgeService) syncLoop() {
 					}
 				}
 			}
+			timer.Reset(schedule.delay(time.Now()))
 		}
 	}
 }
@@ -264,17 +485,48 @@ func (e *EdgeService) syncLoop() {
 func (e *EdgeService) syncClusterState() error {
 	e.mu.RLock()
 	connected := e.connected
+	chunkedState := e.chunkedState
+	streamedState := e.streamedState
+	managerMaxSize := e.managerMaxSize
+	compressConfig := e.compressConfig
 	e.mu.RUnlock()
 
 	if !connected {
 		return fmt.Errorf("not connected to manager")
 	}
 
+	// Report resource types the previous collection found available before sending the state with them
+	if err := e.updateCapabilities(); err != nil {
+		return err
+	}
+
+	maxMessageSize := e.config.GetMaxMessageSize()
+	if managerMaxSize > 0 && managerMaxSize < maxMessageSize {
+		maxMessageSize = managerMaxSize
+	}
+
+	// Leave headroom below the limit for message framing
+	maxChunkBytes := maxMessageSize * 3 / 4
+
+	// Stream the state a namespace at a time when the manager supports it, bounding edge memory
+	if streamedState {
+		return e.streamClusterState(maxChunkBytes, compressConfig)
+	}
+
 	// Get cluster state from Kubernetes with metrics
+	start := time.Now()
 	clusterState, err := e.k8sClient.GetClusterStateWithMetrics(e.ctx, e.metricsProvider)
 	if err != nil {
 		return fmt.Errorf("failed to get cluster state: %w", err)
 	}
+	clusterState.SyncMetadata = &v1alpha1.SyncMetadata{
+		CollectedAt:          timestamppb.Now(),
+		CollectionDurationMs: time.Since(start).Milliseconds(),
+	}
+	clusterState.ClusterLabels = e.config.GetClusterLabels()
+	if err := encodeRawConfig(clusterState, compressConfig); err != nil {
+		return err
+	}
 
 	// Send cluster state to manager
 	req := &v1alpha1.ConnectRequest{
@@ -283,11 +535,58 @@ func (e *EdgeService) syncClusterState() error {
 		},
 	}
 
-	if err := e.stream.Send(req); err != nil {
+	sizeBytes := proto.Size(req)
+	if chunkedState && sizeBytes > maxChunkBytes {
+		if err := e.sendClusterStateChunks(clusterState, maxChunkBytes); err != nil {
+			return err
+		}
+	} else if err := e.stream.Send(req); err != nil {
 		return fmt.Errorf("failed to send cluster state: %w", err)
 	}
 
-	e.logger.Debug("sent cluster state", "services", len(clusterState.Services))
+	telemetry.RecordClusterStatePush(e.clusterName, sizeBytes)
+
+	e.logger.Debug("sent cluster state",
+		"services", len(clusterState.Services),
+		"size_bytes", sizeBytes,
+		"resource_counts", telemetry.CountResources(clusterState))
+
+	return nil
+}
+
+// encodeRawConfig compresses the raw config of Istio resources when the manager supports it, and restores
+// it otherwise, since resources reused from an earlier collection may have been compressed for a previous
+// connection
+func encodeRawConfig(state *v1alpha1.ClusterState, compress bool) error {
+	if compress {
+		rawconfig.Compress(state)
+		return nil
+	}
+	return rawconfig.Decompress(state)
+}
+
+// sendClusterStateChunks sends a cluster state to the manager as a sequence of chunks
+func (e *EdgeService) sendClusterStateChunks(clusterState *v1alpha1.ClusterState, maxChunkBytes int) error {
+	chunks := splitClusterState(clusterState, maxChunkBytes)
+	syncID := uuid.New().String()
+
+	for i, partial := range chunks {
+		req := &v1alpha1.ConnectRequest{
+			Message: &v1alpha1.ConnectRequest_ClusterStateChunk{
+				ClusterStateChunk: &v1alpha1.ClusterStateChunk{
+					SyncId:       syncID,
+					Index:        int32(i),           // #nosec G115 - chunk count is bounded by resource count
+					Total:        int32(len(chunks)), // #nosec G115 - chunk count is bounded by resource count
+					PartialState: partial,
+				},
+			},
+		}
+		if err := e.stream.Send(req); err != nil {
+			return fmt.Errorf("failed to send cluster state chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+	}
+
+	e.logger.Debug("sent chunked cluster state", "sync_id", syncID, "chunks", len(chunks))
 
 	return nil
 }
@@ -425,6 +724,21 @@ func (e *EdgeService) processIncomingMessage(resp *v1alpha1.ConnectResponse) err
 		return e.processProxyConfigRequest(msg.ProxyConfigRequest)
 	case *v1alpha1.ConnectResponse_ServiceConnectionsRequest:
 		return e.processServiceConnectionsRequest(msg.ServiceConnectionsRequest)
+	case *v1alpha1.ConnectResponse_PairInstanceMetricsRequest:
+		return e.processPairInstanceMetricsRequest(msg.PairInstanceMetricsRequest)
+	case *v1alpha1.ConnectResponse_MeshMetricsTimeSeriesRequest:
+		return e.processMeshMetricsTimeSeriesRequest(msg.MeshMetricsTimeSeriesRequest)
+	case *v1alpha1.ConnectResponse_TracesRequest:
+		return e.processTracesRequest(msg.TracesRequest)
+	case *v1alpha1.ConnectResponse_AccessLogsRequest:
+		return e.processAccessLogsRequest(msg.AccessLogsRequest)
+	case *v1alpha1.ConnectResponse_PodLogsRequest:
+		return e.processPodLogsRequest(msg.PodLogsRequest)
+	case *v1alpha1.ConnectResponse_EnvoyAdminRequest:
+		return e.processEnvoyAdminRequest(msg.EnvoyAdminRequest)
+	case *v1alpha1.ConnectResponse_ResyncRequest:
+		e.requestResync(msg.ResyncRequest.Reason)
+		return nil
 	case *v1alpha1.ConnectResponse_Error:
 		e.logger.Error("received error from manager", "error_code", msg.Error.ErrorCode, "error_message", msg.Error.ErrorMessage)
 		return fmt.Errorf("manager error: %s", msg.Error.ErrorMessage)
@@ -434,9 +748,23 @@ func (e *EdgeService) processIncomingMessage(resp *v1alpha1.ConnectResponse) err
 	}
 }
 
+// requestResync schedules an immediate cluster state sync, coalescing with any already pending
+func (e *EdgeService) requestResync(reason string) {
+	e.logger.Info("resync requested by manager", "reason", reason)
+
+	select {
+	case e.resyncCh <- struct{}{}:
+	default:
+		e.logger.Debug("resync already pending")
+	}
+}
+
 // processProxyConfigRequest handles proxy configuration requests from the manager
 func (e *EdgeService) processProxyConfigRequest(req *v1alpha1.ProxyConfigRequest) error {
-	e.logger.Info("processing proxy config request",
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing proxy config request",
 		"request_id", req.RequestId,
 		"namespace", req.PodNamespace,
 		"pod", req.PodName)
@@ -451,9 +779,9 @@ func (e *EdgeService) processProxyConfigRequest(req *v1alpha1.ProxyConfigRequest
 	}
 
 	// Get proxy configuration
-	proxyConfig, err := e.proxyService.GetProxyConfig(e.ctx, req.PodNamespace, req.PodName)
+	proxyConfig, err := e.proxyService.GetProxyConfig(ctx, req.PodNamespace, req.PodName)
 	if err != nil {
-		e.logger.Error("failed to get proxy config",
+		logger.Error("failed to get proxy config",
 			"request_id", req.RequestId,
 			"namespace", req.PodNamespace,
 			"pod", req.PodName,
@@ -464,7 +792,7 @@ func (e *EdgeService) processProxyConfigRequest(req *v1alpha1.ProxyConfigRequest
 			ErrorMessage: err.Error(),
 		}
 	} else {
-		e.logger.Info("successfully retrieved proxy config",
+		logger.Info("successfully retrieved proxy config",
 			"request_id", req.RequestId,
 			"namespace", req.PodNamespace,
 			"pod", req.PodName,
@@ -486,17 +814,146 @@ func (e *EdgeService) processProxyConfigRequest(req *v1alpha1.ProxyConfigRequest
 	}
 
 	if err := stream.Send(resp); err != nil {
-		e.logger.Error("failed to send proxy config response", "request_id", req.RequestId, "error", err)
+		logger.Error("failed to send proxy config response", "request_id", req.RequestId, "error", err)
 		return fmt.Errorf("failed to send proxy config response: %w", err)
 	}
 
-	e.logger.Debug("proxy config response sent", "request_id", req.RequestId)
+	logger.Debug("proxy config response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processPodLogsRequest handles container log requests from the manager
+func (e *EdgeService) processPodLogsRequest(req *v1alpha1.PodLogsRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing pod logs request",
+		"request_id", req.RequestId,
+		"namespace", req.PodNamespace,
+		"pod", req.PodName,
+		"container", req.Container)
+
+	response := &v1alpha1.PodLogsResponse{
+		RequestId: req.RequestId,
+	}
+
+	logs, err := e.k8sClient.GetPodLogs(ctx, req)
+	if err != nil {
+		logger.Error("failed to get pod logs",
+			"request_id", req.RequestId,
+			"namespace", req.PodNamespace,
+			"pod", req.PodName,
+			"container", req.Container,
+			"error", err)
+
+		response.Result = &v1alpha1.PodLogsResponse_ErrorMessage{
+			ErrorMessage: err.Error(),
+		}
+	} else {
+		logger.Info("successfully retrieved pod logs",
+			"request_id", req.RequestId,
+			"namespace", req.PodNamespace,
+			"pod", req.PodName,
+			"container", logs.Container,
+			"bytes", len(logs.Logs),
+			"truncated", logs.Truncated)
+
+		response.Result = &v1alpha1.PodLogsResponse_Logs{
+			Logs: logs,
+		}
+	}
+
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send pod logs response")
+	}
+
+	resp := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_PodLogsResponse{
+			PodLogsResponse: response,
+		},
+	}
+	if err := stream.Send(resp); err != nil {
+		logger.Error("failed to send pod logs response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send pod logs response: %w", err)
+	}
+
+	logger.Debug("pod logs response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processEnvoyAdminRequest handles Envoy admin requests from the manager
+func (e *EdgeService) processEnvoyAdminRequest(req *v1alpha1.EnvoyAdminRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing envoy admin request",
+		"request_id", req.RequestId,
+		"namespace", req.PodNamespace,
+		"pod", req.PodName,
+		"path", req.Path)
+
+	response := &v1alpha1.EnvoyAdminResponse{
+		RequestId: req.RequestId,
+	}
+
+	// The proxy service enforces the admin path allowlist
+	output, err := e.proxyService.GetAdminOutput(ctx, req.PodNamespace, req.PodName, req.Path, req.Query)
+	if err != nil {
+		logger.Error("failed to get envoy admin output",
+			"request_id", req.RequestId,
+			"namespace", req.PodNamespace,
+			"pod", req.PodName,
+			"path", req.Path,
+			"error", err)
+
+		response.Result = &v1alpha1.EnvoyAdminResponse_ErrorMessage{
+			ErrorMessage: err.Error(),
+		}
+	} else {
+		logger.Info("successfully retrieved envoy admin output",
+			"request_id", req.RequestId,
+			"namespace", req.PodNamespace,
+			"pod", req.PodName,
+			"path", req.Path,
+			"bytes", len(output))
+
+		response.Result = &v1alpha1.EnvoyAdminResponse_Output{
+			Output: output,
+		}
+	}
+
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send envoy admin response")
+	}
+
+	resp := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_EnvoyAdminResponse{
+			EnvoyAdminResponse: response,
+		},
+	}
+	if err := stream.Send(resp); err != nil {
+		logger.Error("failed to send envoy admin response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send envoy admin response: %w", err)
+	}
+
+	logger.Debug("envoy admin response sent", "request_id", req.RequestId)
 	return nil
 }
 
 // processServiceConnectionsRequest handles service connections requests from the manager
 func (e *EdgeService) processServiceConnectionsRequest(req *v1alpha1.ServiceConnectionsRequest) error {
-	e.logger.Info("processing service connections request",
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing service connections request",
 		"request_id", req.RequestId,
 		"service_name", req.ServiceName,
 		"namespace", req.Namespace)
@@ -513,16 +970,16 @@ func (e *EdgeService) processServiceConnectionsRequest(req *v1alpha1.ServiceConn
 	// Check if metrics provider is available
 	if e.metricsProvider == nil {
 		errorMsg := "metrics provider not available"
-		e.logger.Error("failed to get service connections", "request_id", req.RequestId, "error", errorMsg)
+		logger.Error("failed to get service connections", "request_id", req.RequestId, "error", errorMsg)
 
 		resp.Message.(*v1alpha1.ConnectRequest_ServiceConnectionsResponse).ServiceConnectionsResponse.Result = &v1alpha1.ServiceConnectionsResponse_ErrorMessage{
 			ErrorMessage: errorMsg,
 		}
 	} else {
 		// Get service connections using metrics provider
-		serviceConnections, err := e.metricsProvider.GetServiceConnections(e.ctx, req.ServiceName, req.Namespace, req.ProxyMode, req.StartTime, req.EndTime)
+		serviceConnections, err := e.metricsProvider.GetServiceConnections(ctx, req.ServiceName, req.Namespace, req.ProxyMode, req.Perspective, req.StartTime, req.EndTime)
 		if err != nil {
-			e.logger.Error("failed to get service connections from metrics provider",
+			logger.Error("failed to get service connections from metrics provider",
 				"request_id", req.RequestId,
 				"service_name", req.ServiceName,
 				"namespace", req.Namespace,
@@ -532,7 +989,7 @@ func (e *EdgeService) processServiceConnectionsRequest(req *v1alpha1.ServiceConn
 				ErrorMessage: err.Error(),
 			}
 		} else {
-			e.logger.Info("successfully retrieved service connections",
+			logger.Info("successfully retrieved service connections",
 				"request_id", req.RequestId,
 				"service_name", req.ServiceName,
 				"namespace", req.Namespace,
@@ -554,10 +1011,255 @@ func (e *EdgeService) processServiceConnectionsRequest(req *v1alpha1.ServiceConn
 	}
 
 	if err := stream.Send(resp); err != nil {
-		e.logger.Error("failed to send service connections response", "request_id", req.RequestId, "error", err)
+		logger.Error("failed to send service connections response", "request_id", req.RequestId, "error", err)
 		return fmt.Errorf("failed to send service connections response: %w", err)
 	}
 
-	e.logger.Debug("service connections response sent", "request_id", req.RequestId)
+	logger.Debug("service connections response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processPairInstanceMetricsRequest handles pair instance metrics requests from the manager
+func (e *EdgeService) processPairInstanceMetricsRequest(req *v1alpha1.PairInstanceMetricsRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing pair instance metrics request",
+		"request_id", req.RequestId,
+		"source_service", req.SourceService,
+		"source_namespace", req.SourceNamespace,
+		"destination_service", req.DestinationService,
+		"destination_namespace", req.DestinationNamespace)
+
+	response := &v1alpha1.PairInstanceMetricsResponse{
+		RequestId: req.RequestId,
+	}
+
+	if e.metricsProvider == nil {
+		errorMsg := "metrics provider not available"
+		logger.Error("failed to get pair instance metrics", "request_id", req.RequestId, "error", errorMsg)
+		response.Result = &v1alpha1.PairInstanceMetricsResponse_ErrorMessage{ErrorMessage: errorMsg}
+	} else {
+		pairInstanceMetrics, err := e.metricsProvider.GetPairInstanceMetrics(ctx, req.SourceService, req.SourceNamespace, req.DestinationService, req.DestinationNamespace)
+		if err != nil {
+			logger.Error("failed to get pair instance metrics from metrics provider", "request_id", req.RequestId, "error", err)
+			response.Result = &v1alpha1.PairInstanceMetricsResponse_ErrorMessage{ErrorMessage: err.Error()}
+		} else {
+			logger.Info("successfully retrieved pair instance metrics",
+				"request_id", req.RequestId,
+				"source_pods", len(pairInstanceMetrics.SourcePods),
+				"destination_pods", len(pairInstanceMetrics.DestinationPods))
+			response.Result = &v1alpha1.PairInstanceMetricsResponse_PairInstanceMetrics{PairInstanceMetrics: pairInstanceMetrics}
+		}
+	}
+
+	// Send response back to manager
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send pair instance metrics response")
+	}
+
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_PairInstanceMetricsResponse{
+			PairInstanceMetricsResponse: response,
+		},
+	}); err != nil {
+		logger.Error("failed to send pair instance metrics response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send pair instance metrics response: %w", err)
+	}
+
+	logger.Debug("pair instance metrics response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processMeshMetricsTimeSeriesRequest handles mesh metrics time series requests from the manager
+func (e *EdgeService) processMeshMetricsTimeSeriesRequest(req *v1alpha1.MeshMetricsTimeSeriesRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing mesh metrics time series request",
+		"request_id", req.RequestId,
+		"pairs", len(req.Pairs),
+		"buckets", req.Buckets)
+
+	response := &v1alpha1.MeshMetricsTimeSeriesResponse{
+		RequestId: req.RequestId,
+	}
+
+	if e.metricsProvider == nil {
+		errorMsg := "metrics provider not available"
+		logger.Error("failed to get mesh metrics time series", "request_id", req.RequestId, "error", errorMsg)
+		response.Result = &v1alpha1.MeshMetricsTimeSeriesResponse_ErrorMessage{ErrorMessage: errorMsg}
+	} else {
+		timeSeries, err := e.metricsProvider.GetMeshMetricsTimeSeries(ctx, req.Pairs, req.StartTime, req.EndTime, req.Buckets)
+		if err != nil {
+			logger.Error("failed to get mesh metrics time series from metrics provider", "request_id", req.RequestId, "error", err)
+			response.Result = &v1alpha1.MeshMetricsTimeSeriesResponse_ErrorMessage{ErrorMessage: err.Error()}
+		} else {
+			logger.Info("successfully retrieved mesh metrics time series",
+				"request_id", req.RequestId,
+				"series", len(timeSeries.Series))
+			response.Result = &v1alpha1.MeshMetricsTimeSeriesResponse_TimeSeries{TimeSeries: timeSeries}
+		}
+	}
+
+	// Send response back to manager
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send mesh metrics time series response")
+	}
+
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_MeshMetricsTimeSeriesResponse{
+			MeshMetricsTimeSeriesResponse: response,
+		},
+	}); err != nil {
+		logger.Error("failed to send mesh metrics time series response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send mesh metrics time series response: %w", err)
+	}
+
+	logger.Debug("mesh metrics time series response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processTracesRequest handles trace search requests from the manager
+func (e *EdgeService) processTracesRequest(req *v1alpha1.TracesRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing traces request",
+		"request_id", req.RequestId,
+		"service", req.ServiceName,
+		"namespace", req.Namespace,
+		"min_duration", req.MinDuration.AsDuration())
+
+	response := &v1alpha1.TracesResponse{
+		RequestId: req.RequestId,
+	}
+
+	if e.tracesProvider == nil {
+		errorMsg := "traces provider not available"
+		logger.Error("failed to get traces", "request_id", req.RequestId, "error", errorMsg)
+		response.Result = &v1alpha1.TracesResponse_ErrorMessage{ErrorMessage: errorMsg}
+	} else {
+		summaries, err := e.tracesProvider.ListTraces(ctx, traces.Query{
+			ServiceName: req.ServiceName,
+			Namespace:   req.Namespace,
+			Start:       req.StartTime.AsTime(),
+			End:         req.EndTime.AsTime(),
+			MinDuration: req.MinDuration.AsDuration(),
+			Limit:       int(req.Limit),
+		})
+		if err != nil {
+			logger.Error("failed to get traces from traces provider", "request_id", req.RequestId, "error", err)
+			response.Result = &v1alpha1.TracesResponse_ErrorMessage{ErrorMessage: err.Error()}
+		} else {
+			for _, summary := range summaries {
+				summary.Cluster = e.clusterName
+			}
+			logger.Info("successfully retrieved traces",
+				"request_id", req.RequestId,
+				"traces", len(summaries))
+			response.Result = &v1alpha1.TracesResponse_Traces{Traces: &types.ServiceTraces{
+				ClusterId: e.clusterName,
+				Traces:    summaries,
+			}}
+		}
+	}
+
+	// Send response back to manager
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send traces response")
+	}
+
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_TracesResponse{
+			TracesResponse: response,
+		},
+	}); err != nil {
+		logger.Error("failed to send traces response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send traces response: %w", err)
+	}
+
+	logger.Debug("traces response sent", "request_id", req.RequestId)
+	return nil
+}
+
+// processAccessLogsRequest handles access log search requests from the manager
+func (e *EdgeService) processAccessLogsRequest(req *v1alpha1.AccessLogsRequest) error {
+	logger := e.logger.With("correlation_id", req.CorrelationId)
+	ctx := logging.WithRequestID(e.ctx, req.CorrelationId)
+
+	logger.Info("processing access logs request",
+		"request_id", req.RequestId,
+		"service", req.ServiceName,
+		"namespace", req.Namespace,
+		"pod", req.PodName)
+
+	response := &v1alpha1.AccessLogsResponse{
+		RequestId: req.RequestId,
+	}
+
+	if e.accessLogsProvider == nil {
+		errorMsg := "access logs provider not available"
+		logger.Error("failed to get access logs", "request_id", req.RequestId, "error", errorMsg)
+		response.Result = &v1alpha1.AccessLogsResponse_ErrorMessage{ErrorMessage: errorMsg}
+	} else {
+		entries, err := e.accessLogsProvider.ListAccessLogs(ctx, accesslogs.Query{
+			ServiceName:   req.ServiceName,
+			Namespace:     req.Namespace,
+			PodName:       req.PodName,
+			Start:         req.StartTime.AsTime(),
+			End:           req.EndTime.AsTime(),
+			ResponseCodes: req.ResponseCodes,
+			PathPrefix:    req.PathPrefix,
+			Limit:         int(req.Limit),
+		})
+		if err != nil {
+			logger.Error("failed to get access logs from access logs provider", "request_id", req.RequestId, "error", err)
+			response.Result = &v1alpha1.AccessLogsResponse_ErrorMessage{ErrorMessage: err.Error()}
+		} else {
+			for _, entry := range entries {
+				entry.Cluster = e.clusterName
+			}
+			logger.Info("successfully retrieved access logs",
+				"request_id", req.RequestId,
+				"entries", len(entries))
+			response.Result = &v1alpha1.AccessLogsResponse_AccessLogs{AccessLogs: &types.ServiceAccessLogs{
+				ClusterId: e.clusterName,
+				Entries:   entries,
+			}}
+		}
+	}
+
+	// Send response back to manager
+	e.mu.RLock()
+	stream := e.stream
+	e.mu.RUnlock()
+
+	if stream == nil {
+		return fmt.Errorf("no active stream to send access logs response")
+	}
+
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_AccessLogsResponse{
+			AccessLogsResponse: response,
+		},
+	}); err != nil {
+		logger.Error("failed to send access logs response", "request_id", req.RequestId, "error", err)
+		return fmt.Errorf("failed to send access logs response: %w", err)
+	}
+
+	logger.Debug("access logs response sent", "request_id", req.RequestId)
 	return nil
 }
@@ -23,7 +23,9 @@ import (
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/protocol"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -32,9 +34,17 @@ import (
 // mockKubernetesClient implements the KubernetesClient interface for testing
 type mockKubernetesClient struct {
 	clusterState *v1alpha1.ClusterState
+	preflight    *v1alpha1.PreflightReport
 	err          error
 }
 
+func (m *mockKubernetesClient) StreamClusterState(ctx context.Context, emit func(segment *v1alpha1.ClusterState) error) error {
+	if m.err != nil {
+		return m.err
+	}
+	return emit(m.clusterState)
+}
+
 func (m *mockKubernetesClient) GetClusterState(ctx context.Context) (*v1alpha1.ClusterState, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -56,6 +66,32 @@ func (m *mockKubernetesClient) GetClusterName(ctx context.Context) (string, erro
 	return "test-cluster", nil
 }
 
+func (m *mockKubernetesClient) GetPodLogs(ctx context.Context, req *v1alpha1.PodLogsRequest) (*types.ContainerLogs, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &types.ContainerLogs{Container: req.Container}, nil
+}
+
+func (m *mockKubernetesClient) Preflight(ctx context.Context) *v1alpha1.PreflightReport {
+	return &v1alpha1.PreflightReport{KubernetesVersion: "v1.30.0", KubernetesVersionSupported: true}
+}
+
+func (m *mockKubernetesClient) PreflightReport() *v1alpha1.PreflightReport {
+	return m.preflight
+}
+
+// recordingStream records the requests sent on it
+type recordingStream struct {
+	v1alpha1.ManagerService_ConnectClient
+	sent []*v1alpha1.ConnectRequest
+}
+
+func (s *recordingStream) Send(req *v1alpha1.ConnectRequest) error {
+	s.sent = append(s.sent, req)
+	return nil
+}
+
 // mockProxyService implements the ProxyService interface for testing
 type mockProxyService struct {
 	proxyConfig *types.ProxyConfig
@@ -69,6 +105,13 @@ func (m *mockProxyService) GetProxyConfig(ctx context.Context, namespace, podNam
 	return m.proxyConfig, nil
 }
 
+func (m *mockProxyService) GetAdminOutput(ctx context.Context, namespace, podName, path, query string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return "", nil
+}
+
 func (m *mockProxyService) ValidateProxyAccess(ctx context.Context, namespace, podName string) error {
 	return m.err
 }
@@ -79,6 +122,13 @@ type mockConfig struct {
 	managerEndpoint string
 	syncInterval    int
 	maxMessageSize  int
+	shard           *v1alpha1.NamespaceShard
+	clusterLabels   map[string]string
+	excludedTypes   []string
+}
+
+func (m *mockConfig) GetSyncMemoryBudget() int {
+	return 0
 }
 
 // mockMetricsProvider implements the MetricsProvider interface for testing
@@ -93,13 +143,27 @@ func (m *mockMetricsProvider) GetProviderInfo() metrics.ProviderInfo {
 	}
 }
 
-func (m *mockMetricsProvider) GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode types.ProxyMode, startTime, endTime *timestamppb.Timestamp) (*types.ServiceGraphMetrics, error) {
+func (m *mockMetricsProvider) GetServiceConnections(ctx context.Context, serviceName, namespace string, proxyMode types.ProxyMode, perspective types.MetricsPerspective, startTime, endTime *timestamppb.Timestamp) (*types.ServiceGraphMetrics, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
 	return &types.ServiceGraphMetrics{}, nil
 }
 
+func (m *mockMetricsProvider) GetPairInstanceMetrics(ctx context.Context, sourceService, sourceNamespace, destinationService, destinationNamespace string) (*types.PairInstanceMetrics, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &types.PairInstanceMetrics{}, nil
+}
+
+func (m *mockMetricsProvider) GetMeshMetricsTimeSeries(ctx context.Context, pairs []*types.ServicePair, startTime, endTime *timestamppb.Timestamp, buckets int32) (*types.MeshMetricsTimeSeries, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &types.MeshMetricsTimeSeries{}, nil
+}
+
 func (m *mockMetricsProvider) Close() error {
 	return m.err
 }
@@ -116,10 +180,18 @@ func (m *mockConfig) GetSyncInterval() int {
 	return m.syncInterval
 }
 
+func (m *mockConfig) GetSyncJitter() float64 {
+	return 0
+}
+
 func (m *mockConfig) GetMaxMessageSize() int {
 	return m.maxMessageSize
 }
 
+func (m *mockConfig) GetRawConfigCompression() bool {
+	return false
+}
+
 func (m *mockConfig) GetMetricsConfig() metrics.Config {
 	return metrics.Config{
 		Enabled:  false,
@@ -129,6 +201,18 @@ func (m *mockConfig) GetMetricsConfig() metrics.Config {
 	}
 }
 
+func (m *mockConfig) GetNamespaceShard() *v1alpha1.NamespaceShard {
+	return m.shard
+}
+
+func (m *mockConfig) GetClusterLabels() map[string]string {
+	return m.clusterLabels
+}
+
+func (m *mockConfig) GetExcludedResourceTypes() []string {
+	return m.excludedTypes
+}
+
 func (m *mockConfig) Validate() error {
 	return nil
 }
@@ -244,6 +328,58 @@ func TestNewEdgeService(t *testing.T) {
 	}
 }
 
+func TestEdgeService_protocolCapabilities(t *testing.T) {
+	edgeService, err := NewEdgeService(&mockConfig{
+		managerEndpoint: "localhost:8080",
+		syncInterval:    30,
+		excludedTypes:   []string{"envoy_filters", "wasm_plugins"},
+	}, &mockKubernetesClient{}, &mockProxyService{}, nil, logging.For("test"))
+	assert.NoError(t, err)
+
+	capabilities := edgeService.protocolCapabilities()
+	assert.NotContains(t, capabilities.ResourceTypes, "envoy_filters")
+	assert.NotContains(t, capabilities.ResourceTypes, "wasm_plugins")
+	assert.Contains(t, capabilities.ResourceTypes, "services")
+	assert.Len(t, capabilities.ResourceTypes, len(protocol.ResourceTypes())-2)
+}
+
+func TestEdgeService_updateCapabilities(t *testing.T) {
+	startup := &v1alpha1.PreflightReport{KubernetesVersion: "v1.30.0", CheckedAt: timestamppb.Now()}
+	k8sClient := &mockKubernetesClient{preflight: startup}
+	edgeService, err := NewEdgeService(&mockConfig{
+		managerEndpoint: "localhost:8080",
+		syncInterval:    30,
+	}, k8sClient, &mockProxyService{}, nil, logging.For("test"))
+	require.NoError(t, err)
+	stream := &recordingStream{}
+	edgeService.stream = stream
+	edgeService.preflight = startup
+	edgeService.capabilitiesUpdate = true
+
+	// An unchanged report is not sent again
+	require.NoError(t, edgeService.updateCapabilities())
+	assert.Empty(t, stream.sent)
+
+	// A report replaced by rediscovery is sent and identified with from then on
+	rediscovered := &v1alpha1.PreflightReport{KubernetesVersion: "v1.30.0", CheckedAt: timestamppb.Now()}
+	k8sClient.preflight = rediscovered
+	require.NoError(t, edgeService.updateCapabilities())
+	require.Len(t, stream.sent, 1)
+	assert.Same(t, rediscovered, stream.sent[0].GetCapabilitiesUpdate().GetPreflight())
+	assert.Same(t, rediscovered, edgeService.capabilities().Preflight)
+
+	require.NoError(t, edgeService.updateCapabilities())
+	assert.Len(t, stream.sent, 1)
+
+	// Managers without capabilities updates only see the report when the edge reconnects
+	edgeService.capabilitiesUpdate = false
+	later := &v1alpha1.PreflightReport{KubernetesVersion: "v1.30.0", CheckedAt: timestamppb.Now()}
+	k8sClient.preflight = later
+	require.NoError(t, edgeService.updateCapabilities())
+	assert.Len(t, stream.sent, 1)
+	assert.Same(t, later, edgeService.capabilities().Preflight)
+}
+
 func TestEdgeService_syncClusterState(t *testing.T) {
 	tests := []struct {
 		name           string
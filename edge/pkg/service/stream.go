@@ -0,0 +1,41 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"sync"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+)
+
+// managerStream serializes the sends on the stream to the manager. A gRPC stream does not support concurrent
+// sends, and the sync loop sends cluster state while the message handling loop answers the manager's requests.
+type managerStream struct {
+	v1alpha1.ManagerService_ConnectClient
+
+	sendMu sync.Mutex
+}
+
+// newManagerStream wraps a stream so its sends are serialized
+func newManagerStream(stream v1alpha1.ManagerService_ConnectClient) *managerStream {
+	return &managerStream{ManagerService_ConnectClient: stream}
+}
+
+// Send sends a message to the manager, waiting for any send in progress to finish
+func (s *managerStream) Send(message *v1alpha1.ConnectRequest) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.ManagerService_ConnectClient.Send(message)
+}
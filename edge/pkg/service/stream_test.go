@@ -0,0 +1,65 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+// overlapDetectingStream records whether sends on it ever overlapped
+type overlapDetectingStream struct {
+	v1alpha1.ManagerService_ConnectClient
+
+	inFlight atomic.Int32
+	overlap  atomic.Bool
+	sent     atomic.Int32
+}
+
+func (s *overlapDetectingStream) Send(*v1alpha1.ConnectRequest) error {
+	if s.inFlight.Add(1) > 1 {
+		s.overlap.Store(true)
+	}
+	time.Sleep(100 * time.Microsecond)
+	s.inFlight.Add(-1)
+	s.sent.Add(1)
+	return nil
+}
+
+func TestManagerStream_SerializesSends(t *testing.T) {
+	underlying := &overlapDetectingStream{}
+	stream := newManagerStream(underlying)
+
+	// The sync loop and the message handling loop send concurrently
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 25 {
+				_ = stream.Send(&v1alpha1.ConnectRequest{})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.False(t, underlying.overlap.Load(), "sends overlapped")
+	assert.Equal(t, int32(100), underlying.sent.Load())
+}
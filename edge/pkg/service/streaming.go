@@ -0,0 +1,145 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// streamClusterState collects the cluster state a namespace at a time and sends it to the manager as a
+// streamed sequence of chunks, so the edge never holds the converted state of the whole cluster
+func (e *EdgeService) streamClusterState(maxChunkBytes int, compressConfig bool) error {
+	budget := maxChunkBytes
+	if memoryBudget := e.config.GetSyncMemoryBudget(); memoryBudget > 0 && memoryBudget < budget {
+		budget = memoryBudget
+	}
+
+	stream := &stateStream{
+		send:           e.stream.Send,
+		syncID:         uuid.New().String(),
+		budget:         budget,
+		batch:          &v1alpha1.ClusterState{},
+		resourceCounts: make(map[string]int),
+	}
+
+	start := time.Now()
+	err := e.k8sClient.StreamClusterState(e.ctx, func(segment *v1alpha1.ClusterState) error {
+		if err := encodeRawConfig(segment, compressConfig); err != nil {
+			return err
+		}
+		return stream.add(segment)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get cluster state: %w", err)
+	}
+
+	stream.batch.SyncMetadata = &v1alpha1.SyncMetadata{
+		CollectedAt:          timestamppb.Now(),
+		CollectionDurationMs: time.Since(start).Milliseconds(),
+	}
+	stream.batch.ClusterLabels = e.config.GetClusterLabels()
+	if err := stream.flush(true); err != nil {
+		return err
+	}
+
+	telemetry.RecordClusterStatePush(e.clusterName, stream.sizeBytes)
+
+	e.logger.Debug("sent streamed cluster state",
+		"sync_id", stream.syncID,
+		"chunks", stream.chunks,
+		"size_bytes", stream.sizeBytes,
+		"resource_counts", stream.resourceCounts)
+
+	return nil
+}
+
+// stateStream batches cluster state segments into streamed chunks of at most budget bytes
+type stateStream struct {
+	send           func(*v1alpha1.ConnectRequest) error
+	syncID         string
+	budget         int
+	batch          *v1alpha1.ClusterState // Reused for every chunk
+	batchSize      int
+	chunks         int32
+	sizeBytes      int
+	resourceCounts map[string]int
+}
+
+// add adds a segment to the batch, sending the batch first if the segment would take it over budget.
+// A segment larger than the budget is split and sent in chunks of its own.
+func (s *stateStream) add(segment *v1alpha1.ClusterState) error {
+	for resource, count := range telemetry.CountResources(segment) {
+		s.resourceCounts[resource] += count
+	}
+
+	size := proto.Size(segment)
+	if s.batchSize > 0 && s.batchSize+size > s.budget {
+		if err := s.flush(false); err != nil {
+			return err
+		}
+	}
+
+	if size <= s.budget {
+		appendClusterState(s.batch, segment)
+		s.batchSize += size
+		return nil
+	}
+
+	pieces := splitClusterState(segment, s.budget)
+	for _, piece := range pieces[:len(pieces)-1] {
+		appendClusterState(s.batch, piece)
+		s.batchSize = proto.Size(piece)
+		if err := s.flush(false); err != nil {
+			return err
+		}
+	}
+	last := pieces[len(pieces)-1]
+	appendClusterState(s.batch, last)
+	s.batchSize = proto.Size(last)
+	return nil
+}
+
+// flush sends the batch as the next chunk and empties it for reuse
+func (s *stateStream) flush(final bool) error {
+	req := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_ClusterStateChunk{
+			ClusterStateChunk: &v1alpha1.ClusterStateChunk{
+				SyncId:       s.syncID,
+				Index:        s.chunks,
+				PartialState: s.batch,
+				Final:        final,
+			},
+		},
+	}
+	if err := s.send(req); err != nil {
+		return fmt.Errorf("failed to send cluster state chunk %d: %w", s.chunks+1, err)
+	}
+
+	s.chunks++
+	s.sizeBytes += s.batchSize
+	s.batchSize = 0
+
+	// The chunk is encoded by the time Send returns, and no stats handler retains it, so the batch's
+	// slices can be reused for the next chunk
+	resetClusterState(s.batch)
+	return nil
+}
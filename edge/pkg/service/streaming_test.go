@@ -0,0 +1,89 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func namespaceSegment(namespace string, services int) *v1alpha1.ClusterState {
+	segment := &v1alpha1.ClusterState{}
+	for i := 0; i < services; i++ {
+		segment.Services = append(segment.Services, &v1alpha1.Service{
+			Name:      fmt.Sprintf("service-%d", i),
+			Namespace: namespace,
+		})
+	}
+	return segment
+}
+
+func TestStateStream(t *testing.T) {
+	var chunks []*v1alpha1.ClusterStateChunk
+	stream := &stateStream{
+		send: func(req *v1alpha1.ConnectRequest) error {
+			// Send encodes the chunk before returning, so record a copy as the batch is reused
+			chunks = append(chunks, proto.Clone(req.GetClusterStateChunk()).(*v1alpha1.ClusterStateChunk))
+			return nil
+		},
+		syncID:         "sync-1",
+		budget:         2048,
+		batch:          &v1alpha1.ClusterState{},
+		resourceCounts: make(map[string]int),
+	}
+
+	// Small namespaces are batched together, and a namespace larger than the budget is split
+	require.NoError(t, stream.add(namespaceSegment("a", 5)))
+	require.NoError(t, stream.add(namespaceSegment("b", 5)))
+	require.NoError(t, stream.add(namespaceSegment("large", 200)))
+	require.NoError(t, stream.add(namespaceSegment("z", 5)))
+	stream.batch.SyncMetadata = &v1alpha1.SyncMetadata{CollectionDurationMs: 10}
+	require.NoError(t, stream.flush(true))
+
+	require.Greater(t, len(chunks), 2, "Expected the state to be streamed in several chunks")
+	state := &v1alpha1.ClusterState{}
+	for i, chunk := range chunks {
+		assert.Equal(t, "sync-1", chunk.SyncId)
+		assert.Equal(t, int32(i), chunk.Index)
+		assert.Zero(t, chunk.Total, "Expected streamed chunks to have no total")
+		assert.Equal(t, i == len(chunks)-1, chunk.Final)
+		assert.LessOrEqual(t, proto.Size(chunk.PartialState), stream.budget, "Expected chunk %d to fit the budget", i)
+		proto.Merge(state, chunk.PartialState)
+	}
+
+	require.Len(t, state.Services, 215)
+	assert.Equal(t, "a", state.Services[0].Namespace)
+	assert.Equal(t, "large", state.Services[10].Namespace)
+	assert.Equal(t, "z", state.Services[214].Namespace)
+	assert.Equal(t, int64(10), state.SyncMetadata.CollectionDurationMs)
+	assert.Equal(t, int32(len(chunks)), stream.chunks)
+	assert.Equal(t, 215, stream.resourceCounts["services"])
+}
+
+func TestResetClusterState(t *testing.T) {
+	state := largeClusterState(10, 10)
+	services := state.Services
+
+	resetClusterState(state)
+
+	assert.True(t, proto.Equal(&v1alpha1.ClusterState{}, state), "Expected reset state to be empty")
+	assert.Equal(t, cap(services), cap(state.Services), "Expected the resource list capacity to be kept")
+	assert.Nil(t, services[0], "Expected sent resources to be released")
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import "errors"
+
+var (
+	// ErrProviderNotSupported indicates that a tracing backend type is not supported
+	ErrProviderNotSupported = errors.New("traces provider type must be one of: none, jaeger, tempo")
+
+	// ErrMissingEndpoint indicates that the tracing backend endpoint is missing
+	ErrMissingEndpoint = errors.New("traces provider endpoint is required when enabled")
+)
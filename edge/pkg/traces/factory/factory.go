@@ -0,0 +1,40 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factory
+
+import (
+	"log/slog"
+
+	"github.com/liamawhite/navigator/edge/pkg/interfaces"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	"github.com/liamawhite/navigator/edge/pkg/traces/jaeger"
+	"github.com/liamawhite/navigator/edge/pkg/traces/tempo"
+)
+
+// Create creates the traces provider of the configured tracing backend
+func Create(config traces.Config, logger *slog.Logger) (interfaces.TracesProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch config.Type {
+	case traces.ProviderTypeJaeger:
+		return jaeger.NewProvider(config, logger)
+	case traces.ProviderTypeTempo:
+		return tempo.NewProvider(config, logger)
+	default:
+		return nil, traces.ErrProviderNotSupported
+	}
+}
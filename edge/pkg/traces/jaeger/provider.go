@@ -0,0 +1,242 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Provider searches the traces of a Jaeger query service through its HTTP API
+type Provider struct {
+	endpoint string
+	client   *http.Client
+	logger   *slog.Logger
+}
+
+// NewProvider creates a new Jaeger traces provider
+func NewProvider(config traces.Config, logger *slog.Logger) (*Provider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := url.Parse(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid jaeger endpoint: %w", err)
+	}
+
+	return &Provider{
+		endpoint: strings.TrimSuffix(config.Endpoint, "/"),
+		client:   config.HTTPClient(),
+		logger:   logger,
+	}, nil
+}
+
+// GetProviderInfo returns information about this traces provider
+func (p *Provider) GetProviderInfo() traces.ProviderInfo {
+	return traces.ProviderInfo{
+		Type:     traces.ProviderTypeJaeger,
+		Endpoint: p.endpoint,
+	}
+}
+
+// tracesResponse is the response of the Jaeger query API's trace search
+type tracesResponse struct {
+	Data   []trace  `json:"data"`
+	Errors []apiErr `json:"errors"`
+}
+
+type apiErr struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+type trace struct {
+	TraceID   string             `json:"traceID"`
+	Spans     []span             `json:"spans"`
+	Processes map[string]process `json:"processes"`
+}
+
+type span struct {
+	SpanID        string      `json:"spanID"`
+	OperationName string      `json:"operationName"`
+	References    []reference `json:"references"`
+	StartTime     int64       `json:"startTime"` // microseconds since the epoch
+	Duration      int64       `json:"duration"`  // microseconds
+	Tags          []tag       `json:"tags"`
+	ProcessID     string      `json:"processID"`
+}
+
+type reference struct {
+	RefType string `json:"refType"`
+	SpanID  string `json:"spanID"`
+}
+
+type tag struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+type process struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// ListTraces searches the traces with spans of a service, most recent first
+func (p *Provider) ListTraces(ctx context.Context, query traces.Query) ([]*typesv1alpha1.TraceSummary, error) {
+	params := url.Values{}
+	params.Set("service", query.TracedServiceName())
+	params.Set("start", strconv.FormatInt(query.Start.UnixMicro(), 10))
+	params.Set("end", strconv.FormatInt(query.End.UnixMicro(), 10))
+	params.Set("limit", strconv.Itoa(query.Limit))
+	if query.MinDuration > 0 {
+		params.Set("minDuration", query.MinDuration.String())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/api/traces?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jaeger request: %w", err)
+	}
+
+	p.logger.Debug("searching jaeger traces", "service", query.TracedServiceName(), "min_duration", query.MinDuration)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jaeger query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("jaeger query failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result tracesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode jaeger response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("jaeger query failed: %s", result.Errors[0].Msg)
+	}
+
+	summaries := make([]*typesv1alpha1.TraceSummary, 0, len(result.Data))
+	for _, t := range result.Data {
+		if summary := summarize(t); summary != nil {
+			summaries = append(summaries, summary)
+		}
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].StartTime.AsTime().After(summaries[j].StartTime.AsTime())
+	})
+	if query.Limit > 0 && len(summaries) > query.Limit {
+		summaries = summaries[:query.Limit]
+	}
+
+	return summaries, nil
+}
+
+// summarize summarizes a trace, returning nil for traces without spans
+func summarize(t trace) *typesv1alpha1.TraceSummary {
+	if len(t.Spans) == 0 {
+		return nil
+	}
+
+	spanIDs := make(map[string]bool, len(t.Spans))
+	for _, s := range t.Spans {
+		spanIDs[s.SpanID] = true
+	}
+
+	// The root is the earliest span without a parent in the trace
+	var root *span
+	var start, end int64
+	services := make(map[string]bool)
+	errored := false
+	for i := range t.Spans {
+		s := &t.Spans[i]
+		if start == 0 || s.StartTime < start {
+			start = s.StartTime
+		}
+		end = max(end, s.StartTime+s.Duration)
+		if service := t.Processes[s.ProcessID].ServiceName; service != "" {
+			services[service] = true
+		}
+		errored = errored || hasErrorTag(s.Tags)
+		if !hasParent(s, spanIDs) && (root == nil || s.StartTime < root.StartTime) {
+			root = s
+		}
+	}
+	if root == nil {
+		root = &t.Spans[0]
+	}
+
+	serviceNames := make([]string, 0, len(services))
+	for service := range services {
+		serviceNames = append(serviceNames, service)
+	}
+	sort.Strings(serviceNames)
+
+	return &typesv1alpha1.TraceSummary{
+		TraceId:       t.TraceID,
+		RootService:   t.Processes[root.ProcessID].ServiceName,
+		RootOperation: root.OperationName,
+		StartTime:     timestamppb.New(time.UnixMicro(start)),
+		Duration:      durationpb.New(time.Duration(end-start) * time.Microsecond),
+		SpanCount:     int32(len(t.Spans)), // #nosec G115 - bounded by the size of the response
+		Error:         errored,
+		Services:      serviceNames,
+	}
+}
+
+// hasParent returns whether a span's parent is part of the trace
+func hasParent(s *span, spanIDs map[string]bool) bool {
+	for _, ref := range s.References {
+		if ref.RefType == "CHILD_OF" && spanIDs[ref.SpanID] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasErrorTag returns whether a span is tagged as failed
+func hasErrorTag(tags []tag) bool {
+	for _, t := range tags {
+		if t.Key != "error" {
+			continue
+		}
+		switch value := t.Value.(type) {
+		case bool:
+			return value
+		case string:
+			return value == "true"
+		}
+	}
+	return false
+}
+
+// Close closes the provider and cleans up resources
+func (p *Provider) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
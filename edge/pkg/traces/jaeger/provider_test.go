@@ -0,0 +1,139 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tracesResponseBody = `{
+  "data": [
+    {
+      "traceID": "older",
+      "spans": [
+        {"spanID": "a", "operationName": "GET /reviews", "references": [], "startTime": 1700000000000000, "duration": 20000, "tags": [], "processID": "p1"}
+      ],
+      "processes": {"p1": {"serviceName": "reviews.bookinfo"}}
+    },
+    {
+      "traceID": "newer",
+      "spans": [
+        {"spanID": "b", "operationName": "GET /ratings", "references": [{"refType": "CHILD_OF", "spanID": "a"}], "startTime": 1700000060010000, "duration": 30000, "tags": [{"key": "error", "type": "bool", "value": true}], "processID": "p2"},
+        {"spanID": "a", "operationName": "GET /productpage", "references": [], "startTime": 1700000060000000, "duration": 50000, "tags": [], "processID": "p1"},
+        {"spanID": "c", "operationName": "GET /details", "references": [{"refType": "CHILD_OF", "spanID": "a"}], "startTime": 1700000060045000, "duration": 15000, "tags": [], "processID": "p3"}
+      ],
+      "processes": {"p1": {"serviceName": "productpage.bookinfo"}, "p2": {"serviceName": "reviews.bookinfo"}, "p3": {"serviceName": "details.bookinfo"}}
+    }
+  ],
+  "errors": null
+}`
+
+func TestProvider_ListTraces(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		_, _ = w.Write([]byte(tracesResponseBody))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(traces.Config{Type: traces.ProviderTypeJaeger, Endpoint: server.URL + "/", BearerToken: "token"}, logging.For("test"))
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	summaries, err := provider.ListTraces(context.Background(), traces.Query{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		Start:       start,
+		End:         start.Add(time.Hour),
+		MinDuration: 10 * time.Millisecond,
+		Limit:       20,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, request)
+	assert.Equal(t, "/api/traces", request.URL.Path)
+	assert.Equal(t, "reviews.bookinfo", request.URL.Query().Get("service"))
+	assert.Equal(t, "1700000000000000", request.URL.Query().Get("start"))
+	assert.Equal(t, "1700003600000000", request.URL.Query().Get("end"))
+	assert.Equal(t, "10ms", request.URL.Query().Get("minDuration"))
+	assert.Equal(t, "20", request.URL.Query().Get("limit"))
+	assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+
+	require.Len(t, summaries, 2)
+
+	// Most recent first, summarized from the root span
+	newer := summaries[0]
+	assert.Equal(t, "newer", newer.TraceId)
+	assert.Equal(t, "productpage.bookinfo", newer.RootService)
+	assert.Equal(t, "GET /productpage", newer.RootOperation)
+	assert.Equal(t, time.UnixMicro(1700000060000000).UTC(), newer.StartTime.AsTime())
+	assert.Equal(t, 60*time.Millisecond, newer.Duration.AsDuration())
+	assert.Equal(t, int32(3), newer.SpanCount)
+	assert.True(t, newer.Error)
+	assert.Equal(t, []string{"details.bookinfo", "productpage.bookinfo", "reviews.bookinfo"}, newer.Services)
+
+	older := summaries[1]
+	assert.Equal(t, "older", older.TraceId)
+	assert.Equal(t, 20*time.Millisecond, older.Duration.AsDuration())
+	assert.False(t, older.Error)
+}
+
+func TestProvider_ListTracesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "error status",
+			status:  http.StatusServiceUnavailable,
+			body:    "unavailable\n",
+			wantErr: "jaeger query failed with status 503: unavailable",
+		},
+		{
+			name:    "query errors",
+			status:  http.StatusOK,
+			body:    `{"data": null, "errors": [{"code": 400, "msg": "unable to parse param 'minDuration'"}]}`,
+			wantErr: "jaeger query failed: unable to parse param 'minDuration'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			provider, err := NewProvider(traces.Config{Type: traces.ProviderTypeJaeger, Endpoint: server.URL}, logging.For("test"))
+			require.NoError(t, err)
+
+			_, err = provider.ListTraces(context.Background(), traces.Query{ServiceName: "reviews", Namespace: "bookinfo", Limit: 20})
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
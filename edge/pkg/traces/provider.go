@@ -0,0 +1,82 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"net/http"
+	"time"
+)
+
+// Config represents the configuration for a tracing backend
+type Config struct {
+	// Type is the type of tracing backend
+	Type ProviderType `json:"type" yaml:"type"`
+	// Endpoint is the endpoint URL of the tracing backend's query API
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Timeout is the timeout for trace searches (in seconds)
+	Timeout int `json:"timeout" yaml:"timeout"`
+	// BearerToken for bearer token authentication
+	BearerToken string `json:"bearer_token,omitempty" yaml:"bearer_token,omitempty"`
+}
+
+// Enabled returns whether a tracing backend is configured
+func (c *Config) Enabled() bool {
+	return c.Type != "" && c.Type != ProviderTypeNone
+}
+
+// Validate validates the traces configuration
+func (c *Config) Validate() error {
+	if c.Type == "" {
+		c.Type = ProviderTypeNone
+	}
+
+	if c.Type != ProviderTypeNone && c.Type != ProviderTypeJaeger && c.Type != ProviderTypeTempo {
+		return ErrProviderNotSupported
+	}
+
+	if c.Type != ProviderTypeNone && c.Endpoint == "" {
+		return ErrMissingEndpoint
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 10 // Default to 10 seconds
+	}
+
+	return nil
+}
+
+// HTTPClient returns an HTTP client for the tracing backend's query API, authenticating with the
+// configured bearer token
+func (c *Config) HTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   time.Duration(c.Timeout) * time.Second,
+		Transport: &bearerTokenRoundTripper{token: c.BearerToken, next: http.DefaultTransport},
+	}
+}
+
+// bearerTokenRoundTripper adds bearer token authentication to HTTP requests
+type bearerTokenRoundTripper struct {
+	token string
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *bearerTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+rt.token)
+	}
+	return rt.next.RoundTrip(req)
+}
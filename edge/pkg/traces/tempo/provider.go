@@ -0,0 +1,162 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Provider searches the traces of a Grafana Tempo query frontend through its HTTP API
+type Provider struct {
+	endpoint string
+	client   *http.Client
+	logger   *slog.Logger
+}
+
+// NewProvider creates a new Tempo traces provider
+func NewProvider(config traces.Config, logger *slog.Logger) (*Provider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := url.Parse(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid tempo endpoint: %w", err)
+	}
+
+	return &Provider{
+		endpoint: strings.TrimSuffix(config.Endpoint, "/"),
+		client:   config.HTTPClient(),
+		logger:   logger,
+	}, nil
+}
+
+// GetProviderInfo returns information about this traces provider
+func (p *Provider) GetProviderInfo() traces.ProviderInfo {
+	return traces.ProviderInfo{
+		Type:     traces.ProviderTypeTempo,
+		Endpoint: p.endpoint,
+	}
+}
+
+// searchResponse is the response of the Tempo search API
+type searchResponse struct {
+	Traces []traceMetadata `json:"traces"`
+}
+
+type traceMetadata struct {
+	TraceID           string                  `json:"traceID"`
+	RootServiceName   string                  `json:"rootServiceName"`
+	RootTraceName     string                  `json:"rootTraceName"`
+	StartTimeUnixNano string                  `json:"startTimeUnixNano"`
+	DurationMs        int64                   `json:"durationMs"`
+	ServiceStats      map[string]serviceStats `json:"serviceStats"`
+}
+
+type serviceStats struct {
+	SpanCount  int32 `json:"spanCount"`
+	ErrorCount int32 `json:"errorCount"`
+}
+
+// ListTraces searches the traces with spans of a service with TraceQL, most recent first
+func (p *Provider) ListTraces(ctx context.Context, query traces.Query) ([]*typesv1alpha1.TraceSummary, error) {
+	params := url.Values{}
+	params.Set("q", fmt.Sprintf(`{ resource.service.name = %s }`, strconv.Quote(query.TracedServiceName())))
+	params.Set("start", strconv.FormatInt(query.Start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(query.End.Unix(), 10))
+	params.Set("limit", strconv.Itoa(query.Limit))
+	if query.MinDuration > 0 {
+		params.Set("minDuration", query.MinDuration.String())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/api/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tempo request: %w", err)
+	}
+
+	p.logger.Debug("searching tempo traces", "service", query.TracedServiceName(), "min_duration", query.MinDuration)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tempo query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("tempo query failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode tempo response: %w", err)
+	}
+
+	summaries := make([]*typesv1alpha1.TraceSummary, 0, len(result.Traces))
+	for _, t := range result.Traces {
+		summaries = append(summaries, summarize(t))
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].StartTime.AsTime().After(summaries[j].StartTime.AsTime())
+	})
+	if query.Limit > 0 && len(summaries) > query.Limit {
+		summaries = summaries[:query.Limit]
+	}
+
+	return summaries, nil
+}
+
+// summarize summarizes a trace found by a search. Span counts, errors and services are only known when
+// Tempo reports service statistics.
+func summarize(t traceMetadata) *typesv1alpha1.TraceSummary {
+	startNanos, _ := strconv.ParseInt(t.StartTimeUnixNano, 10, 64)
+
+	summary := &typesv1alpha1.TraceSummary{
+		TraceId:       t.TraceID,
+		RootService:   t.RootServiceName,
+		RootOperation: t.RootTraceName,
+		StartTime:     timestamppb.New(time.Unix(0, startNanos)),
+		Duration:      durationpb.New(time.Duration(t.DurationMs) * time.Millisecond),
+	}
+	for service, stats := range t.ServiceStats {
+		summary.Services = append(summary.Services, service)
+		summary.SpanCount += stats.SpanCount
+		summary.Error = summary.Error || stats.ErrorCount > 0
+	}
+	if len(summary.Services) == 0 && t.RootServiceName != "" {
+		summary.Services = []string{t.RootServiceName}
+	}
+	sort.Strings(summary.Services)
+
+	return summary
+}
+
+// Close closes the provider and cleans up resources
+func (p *Provider) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tempo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_ListTraces(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		_, _ = w.Write([]byte(`{
+  "traces": [
+    {"traceID": "older", "rootServiceName": "reviews.bookinfo", "rootTraceName": "GET /reviews", "startTimeUnixNano": "1700000000000000000", "durationMs": 20},
+    {
+      "traceID": "newer",
+      "rootServiceName": "productpage.bookinfo",
+      "rootTraceName": "GET /productpage",
+      "startTimeUnixNano": "1700000060000000000",
+      "durationMs": 60,
+      "serviceStats": {"productpage.bookinfo": {"spanCount": 2}, "reviews.bookinfo": {"spanCount": 3, "errorCount": 1}}
+    }
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(traces.Config{Type: traces.ProviderTypeTempo, Endpoint: server.URL}, logging.For("test"))
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	summaries, err := provider.ListTraces(context.Background(), traces.Query{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		Start:       start,
+		End:         start.Add(time.Hour),
+		MinDuration: 500 * time.Millisecond,
+		Limit:       20,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, request)
+	assert.Equal(t, "/api/search", request.URL.Path)
+	assert.Equal(t, `{ resource.service.name = "reviews.bookinfo" }`, request.URL.Query().Get("q"))
+	assert.Equal(t, "1700000000", request.URL.Query().Get("start"))
+	assert.Equal(t, "1700003600", request.URL.Query().Get("end"))
+	assert.Equal(t, "500ms", request.URL.Query().Get("minDuration"))
+	assert.Equal(t, "20", request.URL.Query().Get("limit"))
+
+	require.Len(t, summaries, 2)
+
+	newer := summaries[0]
+	assert.Equal(t, "newer", newer.TraceId)
+	assert.Equal(t, "productpage.bookinfo", newer.RootService)
+	assert.Equal(t, "GET /productpage", newer.RootOperation)
+	assert.Equal(t, time.Unix(1700000060, 0).UTC(), newer.StartTime.AsTime())
+	assert.Equal(t, 60*time.Millisecond, newer.Duration.AsDuration())
+	assert.Equal(t, int32(5), newer.SpanCount)
+	assert.True(t, newer.Error)
+	assert.Equal(t, []string{"productpage.bookinfo", "reviews.bookinfo"}, newer.Services)
+
+	// Without service statistics only the root service is known
+	older := summaries[1]
+	assert.Equal(t, "older", older.TraceId)
+	assert.Equal(t, int32(0), older.SpanCount)
+	assert.Equal(t, []string{"reviews.bookinfo"}, older.Services)
+}
+
+func TestProvider_ListTracesErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid TraceQL query", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(traces.Config{Type: traces.ProviderTypeTempo, Endpoint: server.URL}, logging.For("test"))
+	require.NoError(t, err)
+
+	_, err = provider.ListTraces(context.Background(), traces.Query{ServiceName: "reviews", Namespace: "bookinfo", Limit: 20})
+	require.Error(t, err)
+	assert.Equal(t, "tempo query failed with status 400: invalid TraceQL query", err.Error())
+}
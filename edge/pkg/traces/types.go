@@ -0,0 +1,61 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"time"
+)
+
+// ProviderType represents the type of tracing backend
+type ProviderType string
+
+const (
+	// ProviderTypeJaeger indicates a Jaeger query service
+	ProviderTypeJaeger ProviderType = "jaeger"
+	// ProviderTypeTempo indicates a Grafana Tempo query frontend
+	ProviderTypeTempo ProviderType = "tempo"
+	// ProviderTypeNone indicates no tracing backend
+	ProviderTypeNone ProviderType = "none"
+)
+
+// ProviderInfo contains information about a tracing backend
+type ProviderInfo struct {
+	// Type is the type of tracing backend
+	Type ProviderType `json:"type"`
+	// Endpoint is the endpoint URL of the tracing backend
+	Endpoint string `json:"endpoint"`
+}
+
+// Query represents a search for the traces of a service
+type Query struct {
+	// ServiceName is the name of the service to search traces for
+	ServiceName string
+	// Namespace is the namespace of the service
+	Namespace string
+	// Start is the start of the time window to search
+	Start time.Time
+	// End is the end of the time window to search
+	End time.Time
+	// MinDuration limits the search to traces at least this long, 0 for no limit
+	MinDuration time.Duration
+	// Limit is the most traces to return
+	Limit int
+}
+
+// TracedServiceName returns the name Istio's proxies report a service's spans under, its canonical
+// service and namespace separated by a dot
+func (q Query) TracedServiceName() string {
+	return q.ServiceName + "." + q.Namespace
+}
@@ -17,6 +17,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
@@ -25,6 +26,7 @@ import (
 	"github.com/liamawhite/navigator/manager/pkg/connections"
 	"github.com/liamawhite/navigator/manager/pkg/server"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/version"
 )
 
 func main() {
@@ -36,10 +38,36 @@ func main() {
 	}
 
 	// Setup logging
+	slog.SetDefault(logging.NewLogger(&logging.Config{
+		Level:  logging.ParseLevel(cfg.LogLevel),
+		Format: cfg.LogFormat,
+	}))
+	var logExporter *logging.OTLPExporter
+	if cfg.OTLPLogsEndpoint != "" {
+		logExporter, err = logging.NewOTLPExporter(context.Background(), logging.OTLPConfig{
+			Endpoint:       cfg.OTLPLogsEndpoint,
+			ServiceName:    "navigator-manager",
+			ServiceVersion: version.Get(),
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create OTLP log exporter: %v\n", err)
+			os.Exit(1)
+		}
+		slog.SetDefault(logging.WithOTLP(slog.Default(), logExporter))
+	}
 	logger := logging.For("manager")
 
 	// Create connections manager
 	connectionManager := connections.NewManager(logger)
+	connectionManager.SetEvictionPolicy(connections.EvictionPolicy{
+		StaleRetention: cfg.GetStaleClusterRetention(),
+		MaxStaleness:   cfg.GetMaxClusterStaleness(),
+		Webhook:        cfg.EvictionWebhook,
+	})
+	if err := connectionManager.SetEventLogPolicy(cfg.GetEventLogPolicy()); err != nil {
+		logger.Error("failed to set up event log", "error", err)
+		os.Exit(1)
+	}
 
 	// Create manager server
 	managerServer, err := server.NewManagerServer(cfg, connectionManager, logger)
@@ -78,4 +106,11 @@ func main() {
 	}
 
 	logger.Info("manager server stopped")
+
+	// Flush logs still queued for the OTLP endpoint
+	if logExporter != nil {
+		if err := logExporter.Shutdown(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to flush OTLP logs: %v\n", err)
+		}
+	}
 }
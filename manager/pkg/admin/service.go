@@ -0,0 +1,111 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sort"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminService implements the backend AdminService for operators managing edge connections
+type AdminService struct {
+	v1alpha1.UnimplementedAdminServiceServer
+	connectionManager providers.ReadOptimizedConnectionManager
+	logger            *slog.Logger
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(connectionManager providers.ReadOptimizedConnectionManager, logger *slog.Logger) *AdminService {
+	return &AdminService{
+		connectionManager: connectionManager,
+		logger:            logger,
+	}
+}
+
+// ListEdgeConnections returns metadata about all connected edge processes
+func (a *AdminService) ListEdgeConnections(ctx context.Context, req *v1alpha1.ListEdgeConnectionsRequest) (*v1alpha1.ListEdgeConnectionsResponse, error) {
+	connectionInfo := a.connectionManager.GetConnectionInfo()
+
+	connections := make([]*v1alpha1.EdgeConnection, 0, len(connectionInfo))
+	for _, info := range connectionInfo {
+		serviceCount := int32(math.MaxInt32)
+		if info.ServiceCount < math.MaxInt32 {
+			serviceCount = int32(info.ServiceCount) // #nosec G115 - bounds checked above
+		}
+
+		connections = append(connections, &v1alpha1.EdgeConnection{
+			ClusterId:      info.ClusterID,
+			RemoteAddress:  info.RemoteAddr,
+			ConnectedAt:    timestamppb.New(info.ConnectedAt),
+			LastUpdate:     timestamppb.New(info.LastUpdate),
+			StateReceived:  info.StateReceived,
+			ServiceCount:   serviceCount,
+			Capabilities:   info.Capabilities,
+			LeaderElection: info.Leader,
+			Shards:         info.Shards,
+		})
+	}
+
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].ClusterId < connections[j].ClusterId
+	})
+
+	return &v1alpha1.ListEdgeConnectionsResponse{
+		Connections: connections,
+	}, nil
+}
+
+// DisconnectEdge forcibly closes the connection for a cluster
+func (a *AdminService) DisconnectEdge(ctx context.Context, req *v1alpha1.DisconnectEdgeRequest) (*v1alpha1.DisconnectEdgeResponse, error) {
+	if !a.connectionManager.IsClusterConnected(req.ClusterId) {
+		return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.ClusterId)
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "disconnected by administrator"
+	}
+
+	a.logger.Info("disconnecting edge", "cluster_id", req.ClusterId, "reason", reason)
+
+	if err := a.connectionManager.DisconnectCluster(req.ClusterId, reason); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to disconnect cluster %s: %v", req.ClusterId, err)
+	}
+
+	return &v1alpha1.DisconnectEdgeResponse{}, nil
+}
+
+// ResyncCluster asks the edge for a cluster to send its state immediately
+func (a *AdminService) ResyncCluster(ctx context.Context, req *v1alpha1.ResyncClusterRequest) (*v1alpha1.ResyncClusterResponse, error) {
+	if !a.connectionManager.IsClusterConnected(req.ClusterId) {
+		return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.ClusterId)
+	}
+
+	a.logger.Info("requesting cluster resync", "cluster_id", req.ClusterId)
+
+	if err := a.connectionManager.RequestResync(req.ClusterId, "requested via admin API"); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to send resync request to cluster %s: %v", req.ClusterId, err)
+	}
+
+	return &v1alpha1.ResyncClusterResponse{}, nil
+}
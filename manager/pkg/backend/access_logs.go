@@ -0,0 +1,181 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// accessLogsTimeout bounds how long the manager waits for an edge to search its logs backend
+const accessLogsTimeout = 30 * time.Second
+
+// AccessLogsService handles access log search requests to edge clusters
+type AccessLogsService struct {
+	connectionManager providers.ConnectionManager
+	logger            *slog.Logger
+
+	// Pending requests tracking
+	mu              sync.RWMutex
+	pendingRequests map[string]*PendingAccessLogsRequest
+}
+
+// PendingAccessLogsRequest tracks in-flight access log search requests
+type PendingAccessLogsRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *AccessLogsResult
+}
+
+// AccessLogsResult contains the result of an access log search request
+type AccessLogsResult struct {
+	AccessLogs *typesv1alpha1.ServiceAccessLogs
+	Error      error
+}
+
+// NewAccessLogsService creates a new access logs service
+func NewAccessLogsService(connectionManager providers.ConnectionManager, logger *slog.Logger) *AccessLogsService {
+	return &AccessLogsService{
+		connectionManager: connectionManager,
+		logger:            logger,
+		pendingRequests:   make(map[string]*PendingAccessLogsRequest),
+	}
+}
+
+// ListAccessLogs searches the logs backend of a specific edge cluster for the access logs of a service
+func (a *AccessLogsService) ListAccessLogs(ctx context.Context, clusterID, podName string, req *frontendv1alpha1.ListAccessLogsRequest) (*typesv1alpha1.ServiceAccessLogs, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	a.logger.Info("requesting access logs from edge cluster",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"service", req.ServiceName,
+		"namespace", req.Namespace,
+		"pod", podName)
+
+	requestID := uuid.New().String()
+	responseCh := make(chan *AccessLogsResult, 1)
+
+	a.mu.Lock()
+	a.pendingRequests[requestID] = &PendingAccessLogsRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    responseCh,
+	}
+	a.mu.Unlock()
+
+	// Clean up request when done
+	defer func() {
+		a.mu.Lock()
+		delete(a.pendingRequests, requestID)
+		a.mu.Unlock()
+	}()
+
+	if err := a.connectionManager.SendMessageToCluster(clusterID, &backendv1alpha1.ConnectResponse{
+		Message: &backendv1alpha1.ConnectResponse_AccessLogsRequest{
+			AccessLogsRequest: &backendv1alpha1.AccessLogsRequest{
+				RequestId:     requestID,
+				ServiceName:   req.ServiceName,
+				Namespace:     req.Namespace,
+				PodName:       podName,
+				StartTime:     req.StartTime,
+				EndTime:       req.EndTime,
+				ResponseCodes: req.ResponseCodes,
+				PathPrefix:    req.PathPrefix,
+				Limit:         req.Limit,
+				CorrelationId: correlationID,
+			},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send access logs request to cluster %s: %w", clusterID, err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case result := <-responseCh:
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		return result.AccessLogs, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(accessLogsTimeout):
+		return nil, fmt.Errorf("timeout waiting for access logs response from cluster %s", clusterID)
+	}
+}
+
+// HandleAccessLogsResponse processes an access log search response from an edge cluster
+func (a *AccessLogsService) HandleAccessLogsResponse(resp *backendv1alpha1.AccessLogsResponse) {
+	a.mu.Lock()
+	pendingRequest, exists := a.pendingRequests[resp.RequestId]
+	a.mu.Unlock()
+
+	if !exists {
+		a.logger.Warn("received access logs response for unknown request", "request_id", resp.RequestId)
+		return
+	}
+
+	result := &AccessLogsResult{}
+
+	switch r := resp.Result.(type) {
+	case *backendv1alpha1.AccessLogsResponse_AccessLogs:
+		result.AccessLogs = r.AccessLogs
+		a.logger.Info("received access logs from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"entries", len(r.AccessLogs.GetEntries()))
+	case *backendv1alpha1.AccessLogsResponse_ErrorMessage:
+		result.Error = fmt.Errorf("edge error: %s", r.ErrorMessage)
+		a.logger.Error("received access logs error from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"error", r.ErrorMessage)
+	default:
+		result.Error = fmt.Errorf("unknown access logs response type")
+		a.logger.Error("received unknown access logs response type",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	}
+
+	// Send result to waiting goroutine
+	select {
+	case pendingRequest.ResponseCh <- result:
+	default:
+		a.logger.Warn("failed to send access logs response - channel full or closed", "request_id", resp.RequestId)
+	}
+}
+
+// GetPendingRequestCount returns the number of pending access log search requests
+func (a *AccessLogsService) GetPendingRequestCount() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return len(a.pendingRequests)
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAccessLogsEdge answers access logs requests with an entry of the requested pod
+type fakeAccessLogsEdge struct {
+	providers.ConnectionManager
+	accessLogsService *AccessLogsService
+	requests          []*v1alpha1.AccessLogsRequest
+}
+
+func (f *fakeAccessLogsEdge) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
+	req := message.GetAccessLogsRequest()
+	f.requests = append(f.requests, req)
+	go f.accessLogsService.HandleAccessLogsResponse(&v1alpha1.AccessLogsResponse{
+		RequestId: req.RequestId,
+		Result: &v1alpha1.AccessLogsResponse_AccessLogs{AccessLogs: &types.ServiceAccessLogs{
+			ClusterId: clusterID,
+			Entries:   []*types.AccessLogEntry{{PodName: req.PodName, ResponseCode: 503}},
+		}},
+	})
+	return nil
+}
+
+func TestAccessLogsService_ListAccessLogs(t *testing.T) {
+	edge := &fakeAccessLogsEdge{}
+	service := NewAccessLogsService(edge, logging.For("test"))
+	edge.accessLogsService = service
+
+	logs, err := service.ListAccessLogs(context.Background(), "cluster-1", "reviews-v1-abc", &frontendv1alpha1.ListAccessLogsRequest{
+		ServiceName:   "reviews",
+		Namespace:     "bookinfo",
+		ResponseCodes: []string{"5xx"},
+		PathPrefix:    "/reviews",
+		Limit:         100,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs.Entries, 1)
+	assert.Equal(t, "reviews-v1-abc", logs.Entries[0].PodName)
+
+	require.Len(t, edge.requests, 1)
+	assert.Equal(t, "reviews-v1-abc", edge.requests[0].PodName)
+	assert.Equal(t, []string{"5xx"}, edge.requests[0].ResponseCodes)
+	assert.Equal(t, "/reviews", edge.requests[0].PathPrefix)
+	assert.Equal(t, 0, service.GetPendingRequestCount())
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/analysis"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// analysisMetricsWindow is how far back analysis looks for observed traffic
+	analysisMetricsWindow = 5 * time.Minute
+	// maxConcurrentAnalysisMetricsQueries bounds how many services of a cluster have their inbound metrics
+	// retrieved at once. Each is a round trip to the cluster's edge and its metrics backend.
+	maxConcurrentAnalysisMetricsQueries = 8
+	// analysisMetricsTimeout bounds how long analysis waits for the inbound metrics of a cluster. Services
+	// whose metrics are not retrieved in time are analyzed without them.
+	analysisMetricsTimeout = 15 * time.Second
+)
+
+// AnalysisService implements AnalysisProvider
+type AnalysisService struct {
+	connectionManager providers.ConnectionManager
+	metricsProvider   providers.MeshMetricsProvider
+	metricsTimeout    time.Duration
+	logger            *slog.Logger
+}
+
+// NewAnalysisService creates a new analysis service
+func NewAnalysisService(connectionManager providers.ConnectionManager, metricsProvider providers.MeshMetricsProvider, logger *slog.Logger) *AnalysisService {
+	return &AnalysisService{
+		connectionManager: connectionManager,
+		metricsProvider:   metricsProvider,
+		metricsTimeout:    analysisMetricsTimeout,
+		logger:            logger,
+	}
+}
+
+// AnalyzeClusters runs configuration analysis over the state of the matching clusters, ordered by cluster ID
+func (a *AnalysisService) AnalyzeClusters(ctx context.Context, clusterID string) ([]*frontendv1alpha1.ClusterAnalysis, error) {
+	a.logger.Debug("analyzing clusters", "cluster_id", clusterID)
+
+	var analyses []*frontendv1alpha1.ClusterAnalysis
+	scope := tenancy.FromContext(ctx)
+	for id, clusterState := range a.connectionManager.GetAllClusterStates() {
+		if (clusterID != "" && id != clusterID) || !scope.Allows(id) {
+			continue
+		}
+		inbound, warnings := a.inboundTraffic(ctx, id, analysis.StrictServices(clusterState))
+		analyses = append(analyses, &frontendv1alpha1.ClusterAnalysis{
+			ClusterId: id,
+			Findings:  analysis.Analyze(id, clusterState, inbound),
+			Warnings:  warnings,
+		})
+	}
+
+	sort.Slice(analyses, func(i, j int) bool {
+		return analyses[i].ClusterId < analyses[j].ClusterId
+	})
+
+	a.logger.Debug("analyzed clusters", "count", len(analyses))
+
+	return analyses, nil
+}
+
+// DryRunAuthorizationPolicy evaluates a drafted AuthorizationPolicy against the inbound traffic of the workloads it applies to
+func (a *AnalysisService) DryRunAuthorizationPolicy(ctx context.Context, clusterID string, policy *typesv1alpha1.AuthorizationPolicy) (*frontendv1alpha1.DryRunAuthorizationPolicyResponse, error) {
+	a.logger.Debug("dry-running authorization policy",
+		"cluster_id", clusterID,
+		"namespace", policy.Namespace,
+		"name", policy.Name)
+
+	clusterState, err := a.connectionManager.GetClusterState(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster state for cluster %s: %w", clusterID, err)
+	}
+
+	inbound, warnings := a.inboundTraffic(ctx, clusterID, analysis.AuthorizationPolicyServices(clusterState, policy))
+	result := analysis.DryRunAuthorizationPolicy(clusterID, clusterState, policy, inbound)
+
+	a.logger.Debug("dry-ran authorization policy",
+		"cluster_id", clusterID,
+		"workloads", len(result.Workloads),
+		"flows_evaluated", result.FlowsEvaluated,
+		"denied_flows", len(result.DeniedFlows))
+
+	return &frontendv1alpha1.DryRunAuthorizationPolicyResponse{
+		Policy: &typesv1alpha1.ResourceRef{
+			ClusterId: clusterID,
+			Kind:      references.KindAuthorizationPolicy,
+			Namespace: policy.Namespace,
+			Name:      policy.Name,
+		},
+		Workloads:      result.Workloads,
+		FlowsEvaluated: int32(result.FlowsEvaluated),
+		DeniedFlows:    result.DeniedFlows,
+		Warnings:       warnings,
+	}, nil
+}
+
+// inboundTraffic retrieves the recent inbound connections of services in a cluster, a bounded number of services
+// at a time. Metrics are best effort: services whose metrics cannot be retrieved, or are not retrieved within
+// the metrics timeout, are skipped and described in the returned warnings.
+func (a *AnalysisService) inboundTraffic(ctx context.Context, clusterID string, services []*backendv1alpha1.Service) ([]*typesv1alpha1.ServicePairMetrics, []string) {
+	ctx, cancel := context.WithTimeout(ctx, a.metricsTimeout)
+	defer cancel()
+	endTime := time.Now()
+
+	results := make([][]*typesv1alpha1.ServicePairMetrics, len(services))
+	errs := make([]error, len(services))
+	sem := make(chan struct{}, maxConcurrentAnalysisMetricsQueries)
+	var wg sync.WaitGroup
+
+	for i, service := range services {
+		wg.Add(1)
+		go func(i int, service *backendv1alpha1.Service) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// Services still waiting when the timeout expires are skipped without a query
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+
+			proxyMode := typesv1alpha1.ProxyMode_SIDECAR
+			if len(service.Instances) > 0 {
+				proxyMode = service.Instances[0].ProxyMode
+			}
+
+			req := &frontendv1alpha1.GetServiceConnectionsRequest{
+				ServiceName: service.Name,
+				Namespace:   service.Namespace,
+				StartTime:   timestamppb.New(endTime.Add(-analysisMetricsWindow)),
+				EndTime:     timestamppb.New(endTime),
+			}
+			metrics, err := a.metricsProvider.GetServiceConnections(ctx, clusterID, req, proxyMode)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = metrics.GetPairs()
+		}(i, service)
+	}
+	wg.Wait()
+
+	var inbound []*typesv1alpha1.ServicePairMetrics
+	var warnings []string
+	timedOut := 0
+	for i, service := range services {
+		if err := errs[i]; err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				timedOut++
+				continue
+			}
+			a.logger.Warn("failed to get service connections for analysis",
+				"cluster_id", clusterID,
+				"service", service.Name,
+				"namespace", service.Namespace,
+				"error", err)
+			warnings = append(warnings, fmt.Sprintf("failed to retrieve metrics for %s/%s: %v", service.Namespace, service.Name, err))
+			continue
+		}
+		inbound = append(inbound, results[i]...)
+	}
+	if timedOut > 0 {
+		a.logger.Warn("timed out getting service connections for analysis",
+			"cluster_id", clusterID,
+			"skipped_services", timedOut,
+			"timeout", a.metricsTimeout)
+		warnings = append(warnings, fmt.Sprintf("metrics for %d of %d services were not retrieved within %s, so their traffic was not checked",
+			timedOut, len(services), a.metricsTimeout))
+	}
+	return inbound, warnings
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMeshMetrics serves fixed service connections, keyed by cluster
+type fakeMeshMetrics struct {
+	metrics  map[string]*typesv1alpha1.ServiceGraphMetrics
+	hang     bool // Wait for the request to be canceled, like an unresponsive edge
+	mu       sync.Mutex
+	requests []*frontendv1alpha1.GetServiceConnectionsRequest
+}
+
+func (f *fakeMeshMetrics) GetServiceConnections(ctx context.Context, clusterID string, req *frontendv1alpha1.GetServiceConnectionsRequest, proxyMode typesv1alpha1.ProxyMode) (*typesv1alpha1.ServiceGraphMetrics, error) {
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.mu.Unlock()
+	if f.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	metrics, ok := f.metrics[clusterID]
+	if !ok {
+		return nil, errors.New("metrics unavailable")
+	}
+	return metrics, nil
+}
+
+func (f *fakeMeshMetrics) GetPairInstanceMetrics(ctx context.Context, clusterID string, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*typesv1alpha1.PairInstanceMetrics, error) {
+	return nil, errors.New("metrics unavailable")
+}
+
+func (f *fakeMeshMetrics) GetMeshMetricsTimeSeries(ctx context.Context, clusterID string, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*typesv1alpha1.MeshMetricsTimeSeries, error) {
+	return nil, errors.New("metrics unavailable")
+}
+
+func TestAnalysisService_AnalyzeClusters(t *testing.T) {
+	service := NewAnalysisService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-2": {
+			Gateways: []*typesv1alpha1.Gateway{{Name: "ingress", Namespace: "istio-system", Selector: map[string]string{"istio": "ingressgateway"}}},
+		},
+		"cluster-1": {},
+	}}, &fakeMeshMetrics{}, logging.For("test"))
+
+	analyses, err := service.AnalyzeClusters(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, analyses, 2)
+	assert.Equal(t, "cluster-1", analyses[0].ClusterId)
+	assert.Empty(t, analyses[0].Findings)
+	assert.Equal(t, "cluster-2", analyses[1].ClusterId)
+	require.Len(t, analyses[1].Findings, 1)
+	assert.Equal(t, "GatewayNoWorkloads", analyses[1].Findings[0].Code)
+
+	analyses, err = service.AnalyzeClusters(context.Background(), "cluster-1")
+	require.NoError(t, err)
+	require.Len(t, analyses, 1)
+	assert.Equal(t, "cluster-1", analyses[0].ClusterId)
+}
+
+func TestAnalysisService_AnalyzeClusters_PlaintextTraffic(t *testing.T) {
+	state := func() *backendv1alpha1.ClusterState {
+		return &backendv1alpha1.ClusterState{
+			Services: []*backendv1alpha1.Service{{
+				Name:      "reviews",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews"}}},
+			}},
+			PeerAuthentications: []*typesv1alpha1.PeerAuthentication{
+				{Name: "default", Namespace: "istio-system", RawConfig: `{"spec":{"mtls":{"mode":"STRICT"}}}`},
+			},
+		}
+	}
+	metrics := &fakeMeshMetrics{metrics: map[string]*typesv1alpha1.ServiceGraphMetrics{
+		"cluster-1": {Pairs: []*typesv1alpha1.ServicePairMetrics{{
+			SourceNamespace:      "legacy",
+			SourceService:        "batch",
+			DestinationNamespace: "default",
+			DestinationService:   "reviews",
+			RequestRate:          1,
+			PlaintextRequestRate: 1,
+		}}},
+	}}
+
+	service := NewAnalysisService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": state(),
+		"cluster-2": state(),
+	}}, metrics, logging.For("test"))
+
+	analyses, err := service.AnalyzeClusters(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, analyses, 2)
+
+	// Only services with STRICT mTLS are queried
+	require.Len(t, metrics.requests, 2)
+	assert.Equal(t, "reviews", metrics.requests[0].ServiceName)
+	assert.Equal(t, "default", metrics.requests[0].Namespace)
+
+	require.Len(t, analyses[0].Findings, 1)
+	assert.Equal(t, "StrictPlaintextTraffic", analyses[0].Findings[0].Code)
+
+	// Metrics failures do not fail the analysis
+	assert.Empty(t, analyses[1].Findings)
+	assert.Equal(t, []string{"failed to retrieve metrics for default/reviews: metrics unavailable"}, analyses[1].Warnings)
+}
+
+func TestAnalysisService_AnalyzeClusters_MetricsTimeout(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		PeerAuthentications: []*typesv1alpha1.PeerAuthentication{
+			{Name: "default", Namespace: "istio-system", RawConfig: `{"spec":{"mtls":{"mode":"STRICT"}}}`},
+		},
+	}
+	for i := range 20 {
+		state.Services = append(state.Services, &backendv1alpha1.Service{
+			Name:      fmt.Sprintf("service-%d", i),
+			Namespace: "default",
+			Instances: []*backendv1alpha1.ServiceInstance{{PodName: fmt.Sprintf("service-%d-pod", i)}},
+		})
+	}
+	metrics := &fakeMeshMetrics{hang: true}
+	service := NewAnalysisService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{"cluster-1": state}}, metrics, logging.For("test"))
+	service.metricsTimeout = 50 * time.Millisecond
+
+	analyses, err := service.AnalyzeClusters(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, analyses, 1)
+	assert.Equal(t, []string{"metrics for 20 of 20 services were not retrieved within 50ms, so their traffic was not checked"}, analyses[0].Warnings)
+
+	// Services still waiting for a query when the timeout expires are not queried
+	assert.Len(t, metrics.requests, maxConcurrentAnalysisMetricsQueries)
+}
+
+func TestAnalysisService_DryRunAuthorizationPolicy(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{{
+			Name:      "reviews",
+			Namespace: "default",
+			Instances: []*backendv1alpha1.ServiceInstance{{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews"}}},
+		}},
+	}
+	metrics := &fakeMeshMetrics{metrics: map[string]*typesv1alpha1.ServiceGraphMetrics{
+		"cluster-1": {Pairs: []*typesv1alpha1.ServicePairMetrics{
+			{SourceNamespace: "frontend", SourceService: "productpage", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 5},
+			{SourceNamespace: "monitoring", SourceService: "prober", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 1},
+		}},
+	}}
+	service := NewAnalysisService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": state,
+		"cluster-2": state,
+	}}, metrics, logging.For("test"))
+
+	policy := &typesv1alpha1.AuthorizationPolicy{
+		Name:      "reviews",
+		Namespace: "default",
+		RawConfig: `{"spec":{"rules":[{"from":[{"source":{"namespaces":["frontend"]}}]}]}}`,
+	}
+
+	resp, err := service.DryRunAuthorizationPolicy(context.Background(), "cluster-1", policy)
+	require.NoError(t, err)
+	assert.Equal(t, "AuthorizationPolicy", resp.Policy.Kind)
+	assert.Equal(t, "reviews", resp.Policy.Name)
+	require.Len(t, resp.Workloads, 1)
+	assert.Equal(t, int32(2), resp.FlowsEvaluated)
+	require.Len(t, resp.DeniedFlows, 1)
+	assert.Equal(t, "prober", resp.DeniedFlows[0].SourceService)
+	assert.Empty(t, resp.Warnings)
+	require.Len(t, metrics.requests, 1)
+	assert.NotNil(t, metrics.requests[0].StartTime)
+
+	// Clusters without metrics evaluate no flows and report a warning
+	resp, err = service.DryRunAuthorizationPolicy(context.Background(), "cluster-2", policy)
+	require.NoError(t, err)
+	assert.Zero(t, resp.FlowsEvaluated)
+	assert.Len(t, resp.Warnings, 1)
+
+	_, err = service.DryRunAuthorizationPolicy(context.Background(), "cluster-3", policy)
+	assert.Error(t, err)
+}
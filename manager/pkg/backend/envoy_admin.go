@@ -0,0 +1,186 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// envoyAdminTimeout bounds how long the manager waits for an edge to query an Envoy admin endpoint
+const envoyAdminTimeout = 30 * time.Second
+
+// EnvoyAdminService handles Envoy admin requests to edge clusters
+type EnvoyAdminService struct {
+	connectionManager providers.ConnectionManager
+	logger            *slog.Logger
+
+	// Pending requests tracking
+	mu              sync.RWMutex
+	pendingRequests map[string]*PendingEnvoyAdminRequest
+}
+
+// PendingEnvoyAdminRequest tracks in-flight Envoy admin requests
+type PendingEnvoyAdminRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *EnvoyAdminResult
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// EnvoyAdminResult contains the result of an Envoy admin request
+type EnvoyAdminResult struct {
+	Output string
+	Error  error
+}
+
+// NewEnvoyAdminService creates a new Envoy admin service
+func NewEnvoyAdminService(connectionManager providers.ConnectionManager, logger *slog.Logger) *EnvoyAdminService {
+	return &EnvoyAdminService{
+		connectionManager: connectionManager,
+		logger:            logger,
+		pendingRequests:   make(map[string]*PendingEnvoyAdminRequest),
+	}
+}
+
+// GetEnvoyAdmin queries an Envoy admin endpoint for a pod on a specific edge cluster
+func (e *EnvoyAdminService) GetEnvoyAdmin(ctx context.Context, clusterID, namespace, podName, path, query string) (string, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	e.logger.Info("requesting envoy admin output",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"namespace", namespace,
+		"pod", podName,
+		"path", path)
+
+	if !e.connectionManager.IsClusterConnected(clusterID) {
+		return "", fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+
+	requestID := uuid.New().String()
+
+	reqCtx, cancel := context.WithTimeout(ctx, envoyAdminTimeout)
+	defer cancel()
+
+	pendingReq := &PendingEnvoyAdminRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    make(chan *EnvoyAdminResult, 1),
+		ctx:           reqCtx,
+		cancel:        cancel,
+	}
+
+	e.mu.Lock()
+	e.pendingRequests[requestID] = pendingReq
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		delete(e.pendingRequests, requestID)
+		e.mu.Unlock()
+	}()
+
+	message := &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_EnvoyAdminRequest{
+			EnvoyAdminRequest: &v1alpha1.EnvoyAdminRequest{
+				RequestId:     requestID,
+				PodNamespace:  namespace,
+				PodName:       podName,
+				Path:          path,
+				Query:         query,
+				CorrelationId: correlationID,
+			},
+		},
+	}
+
+	if err := e.connectionManager.SendMessageToCluster(clusterID, message); err != nil {
+		return "", fmt.Errorf("failed to send envoy admin request: %w", err)
+	}
+
+	e.logger.Debug("envoy admin request sent", "request_id", requestID, "correlation_id", correlationID, "cluster_id", clusterID)
+
+	select {
+	case result := <-pendingReq.ResponseCh:
+		if result.Error != nil {
+			e.logger.Error("envoy admin request failed",
+				"request_id", requestID,
+				"correlation_id", correlationID,
+				"cluster_id", clusterID,
+				"error", result.Error)
+			return "", result.Error
+		}
+
+		e.logger.Info("envoy admin request completed",
+			"request_id", requestID,
+			"correlation_id", correlationID,
+			"cluster_id", clusterID,
+			"bytes", len(result.Output))
+		return result.Output, nil
+
+	case <-reqCtx.Done():
+		e.logger.Error("envoy admin request timed out",
+			"request_id", requestID,
+			"correlation_id", correlationID,
+			"cluster_id", clusterID)
+		return "", fmt.Errorf("envoy admin request timed out after %s", envoyAdminTimeout)
+	}
+}
+
+// HandleEnvoyAdminResponse processes Envoy admin responses from edges. Responses that arrive
+// after their request has completed or timed out are dropped.
+func (e *EnvoyAdminService) HandleEnvoyAdminResponse(response *v1alpha1.EnvoyAdminResponse) {
+	requestID := response.RequestId
+
+	e.logger.Debug("received envoy admin response", "request_id", requestID)
+
+	e.mu.RLock()
+	pendingReq, exists := e.pendingRequests[requestID]
+	e.mu.RUnlock()
+
+	if !exists {
+		e.logger.Warn("received response for unknown request", "request_id", requestID)
+		return
+	}
+
+	var result *EnvoyAdminResult
+
+	switch responseResult := response.Result.(type) {
+	case *v1alpha1.EnvoyAdminResponse_Output:
+		result = &EnvoyAdminResult{Output: responseResult.Output}
+	case *v1alpha1.EnvoyAdminResponse_ErrorMessage:
+		result = &EnvoyAdminResult{Error: fmt.Errorf("edge error: %s", responseResult.ErrorMessage)}
+	default:
+		result = &EnvoyAdminResult{Error: fmt.Errorf("unknown response type: %T", responseResult)}
+	}
+
+	select {
+	case pendingReq.ResponseCh <- result:
+	case <-pendingReq.ctx.Done():
+		e.logger.Warn("failed to deliver response - request expired", "request_id", requestID)
+	}
+}
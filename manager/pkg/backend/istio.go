@@ -18,15 +18,35 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 
 	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"google.golang.org/protobuf/proto"
 )
 
+// istioResource is implemented by every Istio resource message held in cluster state
+type istioResource interface {
+	proto.Message
+	GetName() string
+	GetNamespace() string
+	GetApiVersion() string
+}
+
+// collectedIstioResource is an Istio resource along with the cluster it was collected from
+type collectedIstioResource struct {
+	clusterID string
+	kind      typesv1alpha1.IstioResourceKind
+	resource  istioResource
+}
+
 // IstioService implements IstioResourcesProvider
 type IstioService struct {
 	connectionManager providers.ConnectionManager
@@ -48,11 +68,12 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 		"namespace", namespace,
 		"labels", instance.Labels)
 
-	// Get cluster state
-	clusterState, err := i.connectionManager.GetClusterState(clusterID)
+	// Get cluster state along with the index of its workload selectors
+	index, err := i.connectionManager.GetSelectorIndex(clusterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster state for cluster %s: %w", clusterID, err)
 	}
+	clusterState := index.ClusterState()
 
 	// Determine namespace scoping from control plane config
 	scopeToNamespace := false
@@ -66,6 +87,9 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 		rootNamespace = clusterState.IstioControlPlaneConfig.RootNamespace
 	}
 
+	// Only the resources the selector index returns can select the workload
+	candidates := index.Candidates(instance, namespace, rootNamespace)
+
 	// Parallelize filtering operations for better performance
 	var wg sync.WaitGroup
 	var matchingGateways []*typesv1alpha1.Gateway
@@ -79,48 +103,12 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 	var matchingServiceEntries []*typesv1alpha1.ServiceEntry
 	var matchingDestinationRules []*typesv1alpha1.DestinationRule
 
-	wg.Add(10)
+	wg.Add(4)
 
 	// Filter gateways concurrently
 	go func() {
 		defer wg.Done()
-		matchingGateways = filters.FilterGatewaysForWorkload(clusterState.Gateways, instance, namespace, scopeToNamespace)
-	}()
-
-	// Filter sidecars concurrently
-	go func() {
-		defer wg.Done()
-		matchingSidecars = filters.FilterSidecarsForWorkload(clusterState.Sidecars, instance, namespace)
-	}()
-
-	// Filter envoy filters concurrently
-	go func() {
-		defer wg.Done()
-		matchingEnvoyFilters = filters.FilterEnvoyFiltersForWorkload(clusterState.EnvoyFilters, instance, namespace, rootNamespace)
-	}()
-
-	// Filter request authentications concurrently
-	go func() {
-		defer wg.Done()
-		matchingRequestAuthentications = filters.FilterRequestAuthenticationsForWorkload(clusterState.RequestAuthentications, instance, namespace, rootNamespace)
-	}()
-
-	// Filter peer authentications concurrently
-	go func() {
-		defer wg.Done()
-		matchingPeerAuthentications = filters.FilterPeerAuthenticationsForWorkload(clusterState.PeerAuthentications, instance, namespace, rootNamespace)
-	}()
-
-	// Filter authorization policies concurrently
-	go func() {
-		defer wg.Done()
-		matchingAuthorizationPolicies = filters.FilterAuthorizationPoliciesForWorkload(clusterState.AuthorizationPolicies, instance, namespace, rootNamespace)
-	}()
-
-	// Filter wasm plugins concurrently
-	go func() {
-		defer wg.Done()
-		matchingWasmPlugins = filters.FilterWasmPluginsForWorkload(clusterState.WasmPlugins, instance, namespace, rootNamespace)
+		matchingGateways = filters.FilterGatewaysForWorkload(candidates.Gateways, instance, namespace, scopeToNamespace)
 	}()
 
 	// Filter virtual services concurrently
@@ -141,6 +129,56 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 		matchingDestinationRules = filters.FilterDestinationRulesForWorkload(clusterState.DestinationRules, instance, namespace)
 	}()
 
+	// Policies matched by the edge at sync time only need resolving, otherwise they are matched here
+	// against the candidates the selector index returns
+	if instance.Policies != nil {
+		matched := index.Resolve(instance.Policies)
+		matchingSidecars = matched.Sidecars
+		matchingEnvoyFilters = matched.EnvoyFilters
+		matchingRequestAuthentications = matched.RequestAuthentications
+		matchingPeerAuthentications = matched.PeerAuthentications
+		matchingAuthorizationPolicies = matched.AuthorizationPolicies
+		matchingWasmPlugins = matched.WasmPlugins
+	} else {
+		wg.Add(6)
+
+		// Filter sidecars concurrently
+		go func() {
+			defer wg.Done()
+			matchingSidecars = filters.FilterSidecarsForWorkload(candidates.Sidecars, instance, namespace)
+		}()
+
+		// Filter envoy filters concurrently
+		go func() {
+			defer wg.Done()
+			matchingEnvoyFilters = filters.FilterEnvoyFiltersForWorkload(candidates.EnvoyFilters, instance, namespace, rootNamespace)
+		}()
+
+		// Filter request authentications concurrently
+		go func() {
+			defer wg.Done()
+			matchingRequestAuthentications = filters.FilterRequestAuthenticationsForWorkload(candidates.RequestAuthentications, instance, namespace, rootNamespace)
+		}()
+
+		// Filter peer authentications concurrently
+		go func() {
+			defer wg.Done()
+			matchingPeerAuthentications = filters.FilterPeerAuthenticationsForWorkload(candidates.PeerAuthentications, instance, namespace, rootNamespace)
+		}()
+
+		// Filter authorization policies concurrently
+		go func() {
+			defer wg.Done()
+			matchingAuthorizationPolicies = filters.FilterAuthorizationPoliciesForWorkload(candidates.AuthorizationPolicies, instance, namespace, rootNamespace)
+		}()
+
+		// Filter wasm plugins concurrently
+		go func() {
+			defer wg.Done()
+			matchingWasmPlugins = filters.FilterWasmPluginsForWorkload(candidates.WasmPlugins, instance, namespace, rootNamespace)
+		}()
+	}
+
 	// Wait for all filtering operations to complete
 	wg.Wait()
 
@@ -182,7 +220,7 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 		"matching_destination_rules", len(matchingDestinationRules),
 		"scope_to_namespace", scopeToNamespace)
 
-	return &frontendv1alpha1.GetIstioResourcesResponse{
+	response := &frontendv1alpha1.GetIstioResourcesResponse{
 		VirtualServices:        matchingVirtualServices,
 		DestinationRules:       matchingDestinationRules,
 		Gateways:               matchingGateways,
@@ -193,9 +231,219 @@ func (i *IstioService) GetIstioResourcesForWorkload(ctx context.Context, cluster
 		AuthorizationPolicies:  matchingAuthorizationPolicies,
 		WasmPlugins:            matchingWasmPlugins,
 		ServiceEntries:         matchingServiceEntries,
+	}
+
+	// Edges may send raw config compressed; frontend consumers always receive it uncompressed
+	if err := rawconfig.Decompress(response); err != nil {
+		return nil, fmt.Errorf("failed to decompress istio resources for cluster %s: %w", clusterID, err)
+	}
+
+	return response, nil
+}
+
+// ListIstioResources lists the collected Istio resources matching the filter, ordered by cluster,
+// namespace, kind and name. Only the resources in [offset, offset+limit) are returned.
+func (i *IstioService) ListIstioResources(ctx context.Context, filter providers.IstioResourceFilter, offset, limit int) ([]*frontendv1alpha1.IstioResource, int, error) {
+	i.logger.Debug("listing istio resources",
+		"cluster_id", filter.ClusterID,
+		"namespace", filter.Namespace,
+		"kinds", filter.Kinds,
+		"names", filter.Names,
+		"offset", offset,
+		"limit", limit)
+
+	kinds := make(map[typesv1alpha1.IstioResourceKind]bool, len(filter.Kinds))
+	for _, kind := range filter.Kinds {
+		kinds[kind] = true
+	}
+	names := make(map[string]bool, len(filter.Names))
+	for _, name := range filter.Names {
+		names[name] = true
+	}
+
+	var matches []collectedIstioResource
+	scope := tenancy.FromContext(ctx)
+	for clusterID, clusterState := range i.connectionManager.GetAllClusterStates() {
+		if (filter.ClusterID != "" && clusterID != filter.ClusterID) || !scope.Allows(clusterID) {
+			continue
+		}
+		for kind, resources := range istioResourcesByKind(clusterState) {
+			if len(kinds) > 0 && !kinds[kind] {
+				continue
+			}
+			for _, resource := range resources {
+				if filter.Namespace != "" && resource.GetNamespace() != filter.Namespace {
+					continue
+				}
+				if len(names) > 0 && !names[resource.GetName()] {
+					continue
+				}
+				matches = append(matches, collectedIstioResource{clusterID: clusterID, kind: kind, resource: resource})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].clusterID != matches[b].clusterID {
+			return matches[a].clusterID < matches[b].clusterID
+		}
+		if matches[a].resource.GetNamespace() != matches[b].resource.GetNamespace() {
+			return matches[a].resource.GetNamespace() < matches[b].resource.GetNamespace()
+		}
+		if matches[a].kind != matches[b].kind {
+			return matches[a].kind < matches[b].kind
+		}
+		return matches[a].resource.GetName() < matches[b].resource.GetName()
+	})
+
+	total := len(matches)
+	if offset >= total {
+		return []*frontendv1alpha1.IstioResource{}, total, nil
+	}
+	end := min(offset+limit, total)
+
+	// Only the returned page is decompressed
+	page := make([]*frontendv1alpha1.IstioResource, 0, end-offset)
+	for _, match := range matches[offset:end] {
+		rawConfig, err := rawconfig.Get(match.resource)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read %s %s/%s from cluster %s: %w",
+				match.kind, match.resource.GetNamespace(), match.resource.GetName(), match.clusterID, err)
+		}
+		page = append(page, &frontendv1alpha1.IstioResource{
+			ClusterId:  match.clusterID,
+			Kind:       match.kind,
+			Name:       match.resource.GetName(),
+			Namespace:  match.resource.GetNamespace(),
+			RawConfig:  rawConfig,
+			ApiVersion: match.resource.GetApiVersion(),
+		})
+	}
+
+	i.logger.Debug("listed istio resources", "total", total, "returned", len(page))
+
+	return page, total, nil
+}
+
+// GetResourceInventory counts the collected Istio resources of each kind per namespace per cluster, along with
+// the totals of each cluster and across clusters. Resources are only counted, so their raw config is never read.
+func (i *IstioService) GetResourceInventory(ctx context.Context, clusterID string) (*frontendv1alpha1.GetResourceInventoryResponse, error) {
+	i.logger.Debug("getting resource inventory", "cluster_id", clusterID)
+
+	response := &frontendv1alpha1.GetResourceInventoryResponse{}
+	totals := make(kindCounts)
+
+	scope := tenancy.FromContext(ctx)
+	for id, clusterState := range i.connectionManager.GetAllClusterStates() {
+		if (clusterID != "" && id != clusterID) || !scope.Allows(id) {
+			continue
+		}
+
+		clusterTotals := make(kindCounts)
+		namespaces := make(map[string]kindCounts)
+		for kind, resources := range istioResourcesByKind(clusterState) {
+			for _, resource := range resources {
+				namespace := resource.GetNamespace()
+				if namespaces[namespace] == nil {
+					namespaces[namespace] = make(kindCounts)
+				}
+				namespaces[namespace][kind]++
+				clusterTotals[kind]++
+				totals[kind]++
+			}
+		}
+
+		cluster := &frontendv1alpha1.ClusterResourceInventory{ClusterId: id}
+		cluster.Counts, cluster.TotalCount = clusterTotals.toProto()
+		for namespace, counts := range namespaces {
+			inventory := &frontendv1alpha1.NamespaceResourceInventory{Namespace: namespace}
+			inventory.Counts, inventory.TotalCount = counts.toProto()
+			cluster.Namespaces = append(cluster.Namespaces, inventory)
+		}
+		sort.Slice(cluster.Namespaces, func(a, b int) bool {
+			return cluster.Namespaces[a].Namespace < cluster.Namespaces[b].Namespace
+		})
+		response.Clusters = append(response.Clusters, cluster)
+	}
+
+	sort.Slice(response.Clusters, func(a, b int) bool {
+		return response.Clusters[a].ClusterId < response.Clusters[b].ClusterId
+	})
+	response.Counts, response.TotalCount = totals.toProto()
+
+	i.logger.Debug("got resource inventory", "clusters", len(response.Clusters), "total", response.TotalCount)
+
+	return response, nil
+}
+
+// kindCounts is the number of resources of each kind
+type kindCounts map[typesv1alpha1.IstioResourceKind]int32
+
+// toProto returns the counts ordered by kind, and their sum
+func (c kindCounts) toProto() ([]*frontendv1alpha1.ResourceKindCount, int32) {
+	counts := make([]*frontendv1alpha1.ResourceKindCount, 0, len(c))
+	var total int32
+	for kind, count := range c {
+		counts = append(counts, &frontendv1alpha1.ResourceKindCount{Kind: kind, Count: count})
+		total += count
+	}
+	sort.Slice(counts, func(a, b int) bool {
+		return counts[a].Kind < counts[b].Kind
+	})
+	return counts, total
+}
+
+// GetResourceReferences builds the reference graph of a cluster and returns the references to and from a resource
+func (i *IstioService) GetResourceReferences(ctx context.Context, clusterID, kind, namespace, name string) (*frontendv1alpha1.GetResourceReferencesResponse, error) {
+	i.logger.Debug("getting resource references",
+		"cluster_id", clusterID,
+		"kind", kind,
+		"namespace", namespace,
+		"name", name)
+
+	clusterState, err := i.connectionManager.GetClusterState(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster state for cluster %s: %w", clusterID, err)
+	}
+
+	graph := references.Build(clusterID, clusterState)
+	resource, ok := graph.Resource(kind, namespace, name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s/%s in cluster %s", providers.ErrResourceNotFound, kind, namespace, name, clusterID)
+	}
+
+	return &frontendv1alpha1.GetResourceReferencesResponse{
+		Resource: resource,
+		Uses:     graph.Uses(kind, namespace, name),
+		UsedBy:   graph.UsedBy(kind, namespace, name),
 	}, nil
 }
 
+// istioResourcesByKind returns the Istio resources of each kind held in a cluster state
+func istioResourcesByKind(clusterState *backendv1alpha1.ClusterState) map[typesv1alpha1.IstioResourceKind][]istioResource {
+	return map[typesv1alpha1.IstioResourceKind][]istioResource{
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_AUTHORIZATION_POLICY:   asIstioResources(clusterState.AuthorizationPolicies),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_DESTINATION_RULE:       asIstioResources(clusterState.DestinationRules),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_ENVOY_FILTER:           asIstioResources(clusterState.EnvoyFilters),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_GATEWAY:                asIstioResources(clusterState.Gateways),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION:    asIstioResources(clusterState.PeerAuthentications),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_REQUEST_AUTHENTICATION: asIstioResources(clusterState.RequestAuthentications),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_SERVICE_ENTRY:          asIstioResources(clusterState.ServiceEntries),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_SIDECAR:                asIstioResources(clusterState.Sidecars),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE:        asIstioResources(clusterState.VirtualServices),
+		typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_WASM_PLUGIN:            asIstioResources(clusterState.WasmPlugins),
+	}
+}
+
+// asIstioResources converts a typed resource slice to a slice of istioResource
+func asIstioResources[T istioResource](resources []T) []istioResource {
+	converted := make([]istioResource, len(resources))
+	for i, resource := range resources {
+		converted[i] = resource
+	}
+	return converted
+}
+
 // mergeUniqueVirtualServices combines two slices of VirtualServices, removing duplicates based on name and namespace.
 // This is used to merge VirtualServices found by different filtering approaches (workload-based and gateway-based).
 func mergeUniqueVirtualServices(vs1, vs2 []*typesv1alpha1.VirtualService) []*typesv1alpha1.VirtualService {
@@ -0,0 +1,247 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClusterStates serves fixed cluster states
+type fakeClusterStates struct {
+	providers.ConnectionManager
+	states map[string]*backendv1alpha1.ClusterState
+}
+
+func (f *fakeClusterStates) GetAllClusterStates() map[string]*backendv1alpha1.ClusterState {
+	return f.states
+}
+
+func (f *fakeClusterStates) GetClusterState(clusterID string) (*backendv1alpha1.ClusterState, error) {
+	state, ok := f.states[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	return state, nil
+}
+
+func (f *fakeClusterStates) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	state, err := f.GetClusterState(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return filters.NewSelectorIndex(state), nil
+}
+
+func TestIstioService_ListIstioResources(t *testing.T) {
+	compressedState := &backendv1alpha1.ClusterState{
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "default", RawConfig: `{"kind":"VirtualService"}`},
+			{Name: "ratings", Namespace: "default", RawConfig: `{"kind":"VirtualService"}`},
+		},
+		Gateways: []*typesv1alpha1.Gateway{{Name: "ingress", Namespace: "istio-system", RawConfig: `{"kind":"Gateway"}`, ApiVersion: "networking.istio.io/v1"}},
+	}
+	rawconfig.Compress(compressedState)
+
+	service := NewIstioService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": compressedState,
+		"cluster-2": {
+			DestinationRules: []*typesv1alpha1.DestinationRule{{Name: "reviews", Namespace: "default"}},
+		},
+	}}, logging.For("test"))
+
+	tests := []struct {
+		name          string
+		filter        providers.IstioResourceFilter
+		offset, limit int
+		expected      []string
+		expectedTotal int
+	}{
+		{
+			name:          "all resources ordered",
+			limit:         10,
+			expected:      []string{"cluster-1/default/ratings", "cluster-1/default/reviews", "cluster-1/istio-system/ingress", "cluster-2/default/reviews"},
+			expectedTotal: 4,
+		},
+		{
+			name:          "paginated",
+			offset:        1,
+			limit:         2,
+			expected:      []string{"cluster-1/default/reviews", "cluster-1/istio-system/ingress"},
+			expectedTotal: 4,
+		},
+		{
+			name:          "offset past end",
+			offset:        10,
+			limit:         2,
+			expected:      []string{},
+			expectedTotal: 4,
+		},
+		{
+			name:          "namespace and cluster",
+			filter:        providers.IstioResourceFilter{ClusterID: "cluster-2", Namespace: "default"},
+			limit:         10,
+			expected:      []string{"cluster-2/default/reviews"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "kinds",
+			filter:        providers.IstioResourceFilter{Kinds: []typesv1alpha1.IstioResourceKind{typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_GATEWAY}},
+			limit:         10,
+			expected:      []string{"cluster-1/istio-system/ingress"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "names",
+			filter:        providers.IstioResourceFilter{Namespace: "default", Names: []string{"reviews"}},
+			limit:         10,
+			expected:      []string{"cluster-1/default/reviews", "cluster-2/default/reviews"},
+			expectedTotal: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, total, err := service.ListIstioResources(context.Background(), tt.filter, tt.offset, tt.limit)
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(resources))
+			for _, resource := range resources {
+				names = append(names, resource.ClusterId+"/"+resource.Namespace+"/"+resource.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+
+	// Raw config is decompressed in the response but stays compressed in cluster state
+	resources, _, err := service.ListIstioResources(context.Background(), providers.IstioResourceFilter{ClusterID: "cluster-1", Namespace: "istio-system"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_GATEWAY, resources[0].Kind)
+	assert.Equal(t, `{"kind":"Gateway"}`, resources[0].RawConfig)
+	assert.Equal(t, "networking.istio.io/v1", resources[0].ApiVersion)
+	assert.Empty(t, compressedState.Gateways[0].RawConfig)
+}
+
+func TestIstioService_GetResourceInventory(t *testing.T) {
+	service := NewIstioService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": {
+			VirtualServices: []*typesv1alpha1.VirtualService{
+				{Name: "reviews", Namespace: "default"},
+				{Name: "ratings", Namespace: "default"},
+			},
+			Gateways: []*typesv1alpha1.Gateway{{Name: "ingress", Namespace: "istio-system"}},
+		},
+		"cluster-2": {
+			DestinationRules: []*typesv1alpha1.DestinationRule{{Name: "reviews", Namespace: "default"}},
+			VirtualServices:  []*typesv1alpha1.VirtualService{{Name: "reviews", Namespace: "default"}},
+		},
+	}}, logging.For("test"))
+
+	gateway := typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_GATEWAY
+	destinationRule := typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_DESTINATION_RULE
+	virtualService := typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE
+
+	inventory, err := service.GetResourceInventory(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), inventory.TotalCount)
+	assert.Equal(t, []*frontendv1alpha1.ResourceKindCount{
+		{Kind: destinationRule, Count: 1},
+		{Kind: gateway, Count: 1},
+		{Kind: virtualService, Count: 3},
+	}, inventory.Counts)
+
+	require.Len(t, inventory.Clusters, 2)
+	cluster := inventory.Clusters[0]
+	assert.Equal(t, "cluster-1", cluster.ClusterId)
+	assert.Equal(t, int32(3), cluster.TotalCount)
+	require.Len(t, cluster.Namespaces, 2)
+	assert.Equal(t, "default", cluster.Namespaces[0].Namespace)
+	assert.Equal(t, []*frontendv1alpha1.ResourceKindCount{{Kind: virtualService, Count: 2}}, cluster.Namespaces[0].Counts)
+	assert.Equal(t, "istio-system", cluster.Namespaces[1].Namespace)
+	assert.Equal(t, int32(1), cluster.Namespaces[1].TotalCount)
+	assert.Equal(t, "cluster-2", inventory.Clusters[1].ClusterId)
+	assert.Equal(t, int32(2), inventory.Clusters[1].TotalCount)
+
+	inventory, err = service.GetResourceInventory(context.Background(), "cluster-2")
+	require.NoError(t, err)
+	require.Len(t, inventory.Clusters, 1)
+	assert.Equal(t, "cluster-2", inventory.Clusters[0].ClusterId)
+	assert.Equal(t, int32(2), inventory.TotalCount)
+}
+
+func TestIstioService_GetIstioResourcesForWorkload(t *testing.T) {
+	service := NewIstioService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": {
+			AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+				{Name: "reviews", Namespace: "default", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}}},
+				{Name: "ratings", Namespace: "default", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}}},
+			},
+		},
+	}}, logging.For("test"))
+
+	// Without policies matched by the edge, the manager matches them
+	resp, err := service.GetIstioResourcesForWorkload(context.Background(), "cluster-1", "default", &backendv1alpha1.ServiceInstance{
+		Labels: map[string]string{"app": "reviews"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.AuthorizationPolicies, 1)
+	assert.Equal(t, "reviews", resp.AuthorizationPolicies[0].Name)
+
+	// Policies matched by the edge are resolved as is
+	resp, err = service.GetIstioResourcesForWorkload(context.Background(), "cluster-1", "default", &backendv1alpha1.ServiceInstance{
+		Labels:   map[string]string{"app": "reviews"},
+		Policies: &backendv1alpha1.WorkloadPolicies{AuthorizationPolicies: []string{"default/ratings"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.AuthorizationPolicies, 1)
+	assert.Equal(t, "ratings", resp.AuthorizationPolicies[0].Name)
+}
+
+func TestIstioService_GetResourceReferences(t *testing.T) {
+	service := NewIstioService(&fakeClusterStates{states: map[string]*backendv1alpha1.ClusterState{
+		"cluster-1": {
+			Services:         []*backendv1alpha1.Service{{Name: "reviews", Namespace: "default"}},
+			DestinationRules: []*typesv1alpha1.DestinationRule{{Name: "reviews", Namespace: "default", Host: "reviews"}},
+		},
+	}}, logging.For("test"))
+
+	resp, err := service.GetResourceReferences(context.Background(), "cluster-1", "Service", "default", "reviews")
+	require.NoError(t, err)
+	assert.Equal(t, "reviews", resp.Resource.Name)
+	assert.Empty(t, resp.Uses)
+	require.Len(t, resp.UsedBy, 1)
+	assert.Equal(t, "DestinationRule", resp.UsedBy[0].From.Kind)
+
+	_, err = service.GetResourceReferences(context.Background(), "cluster-1", "Service", "default", "ratings")
+	assert.True(t, errors.Is(err, providers.ErrResourceNotFound))
+
+	_, err = service.GetResourceReferences(context.Background(), "cluster-2", "Service", "default", "reviews")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, providers.ErrResourceNotFound))
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// podLogsTimeout bounds how long the manager waits for an edge to return logs
+const podLogsTimeout = 30 * time.Second
+
+// LogsService handles container log requests to edge clusters
+type LogsService struct {
+	connectionManager providers.ConnectionManager
+	logger            *slog.Logger
+
+	// Pending requests tracking
+	mu              sync.RWMutex
+	pendingRequests map[string]*PendingPodLogsRequest
+}
+
+// PendingPodLogsRequest tracks in-flight container log requests
+type PendingPodLogsRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *PodLogsResult
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// PodLogsResult contains the result of a container log request
+type PodLogsResult struct {
+	Logs  *types.ContainerLogs
+	Error error
+}
+
+// NewLogsService creates a new logs service
+func NewLogsService(connectionManager providers.ConnectionManager, logger *slog.Logger) *LogsService {
+	return &LogsService{
+		connectionManager: connectionManager,
+		logger:            logger,
+		pendingRequests:   make(map[string]*PendingPodLogsRequest),
+	}
+}
+
+// GetPodLogs requests container logs for a pod from a specific edge cluster
+func (l *LogsService) GetPodLogs(ctx context.Context, clusterID, namespace, podName string, options providers.PodLogOptions) (*types.ContainerLogs, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	l.logger.Info("requesting pod logs",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"namespace", namespace,
+		"pod", podName,
+		"container", options.Container)
+
+	if !l.connectionManager.IsClusterConnected(clusterID) {
+		return nil, fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+
+	requestID := uuid.New().String()
+
+	reqCtx, cancel := context.WithTimeout(ctx, podLogsTimeout)
+	defer cancel()
+
+	pendingReq := &PendingPodLogsRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    make(chan *PodLogsResult, 1),
+		ctx:           reqCtx,
+		cancel:        cancel,
+	}
+
+	l.mu.Lock()
+	l.pendingRequests[requestID] = pendingReq
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		delete(l.pendingRequests, requestID)
+		l.mu.Unlock()
+	}()
+
+	message := &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_PodLogsRequest{
+			PodLogsRequest: &v1alpha1.PodLogsRequest{
+				RequestId:     requestID,
+				PodNamespace:  namespace,
+				PodName:       podName,
+				Container:     options.Container,
+				TailLines:     options.TailLines,
+				SinceSeconds:  options.SinceSeconds,
+				Previous:      options.Previous,
+				CorrelationId: correlationID,
+			},
+		},
+	}
+
+	if err := l.connectionManager.SendMessageToCluster(clusterID, message); err != nil {
+		return nil, fmt.Errorf("failed to send pod logs request: %w", err)
+	}
+
+	l.logger.Debug("pod logs request sent", "request_id", requestID, "correlation_id", correlationID, "cluster_id", clusterID)
+
+	select {
+	case result := <-pendingReq.ResponseCh:
+		if result.Error != nil {
+			l.logger.Error("pod logs request failed",
+				"request_id", requestID,
+				"correlation_id", correlationID,
+				"cluster_id", clusterID,
+				"error", result.Error)
+			return nil, result.Error
+		}
+
+		l.logger.Info("pod logs request completed",
+			"request_id", requestID,
+			"correlation_id", correlationID,
+			"cluster_id", clusterID,
+			"bytes", len(result.Logs.Logs),
+			"truncated", result.Logs.Truncated)
+		return result.Logs, nil
+
+	case <-reqCtx.Done():
+		l.logger.Error("pod logs request timed out",
+			"request_id", requestID,
+			"correlation_id", correlationID,
+			"cluster_id", clusterID)
+		return nil, fmt.Errorf("pod logs request timed out after %s", podLogsTimeout)
+	}
+}
+
+// HandlePodLogsResponse processes container log responses from edges. Responses that arrive
+// after their request has completed or timed out are dropped.
+func (l *LogsService) HandlePodLogsResponse(response *v1alpha1.PodLogsResponse) {
+	requestID := response.RequestId
+
+	l.logger.Debug("received pod logs response", "request_id", requestID)
+
+	l.mu.RLock()
+	pendingReq, exists := l.pendingRequests[requestID]
+	l.mu.RUnlock()
+
+	if !exists {
+		l.logger.Warn("received response for unknown request", "request_id", requestID)
+		return
+	}
+
+	var result *PodLogsResult
+
+	switch responseResult := response.Result.(type) {
+	case *v1alpha1.PodLogsResponse_Logs:
+		result = &PodLogsResult{Logs: responseResult.Logs}
+	case *v1alpha1.PodLogsResponse_ErrorMessage:
+		result = &PodLogsResult{Error: fmt.Errorf("edge error: %s", responseResult.ErrorMessage)}
+	default:
+		result = &PodLogsResult{Error: fmt.Errorf("unknown response type: %T", responseResult)}
+	}
+
+	select {
+	case pendingReq.ResponseCh <- result:
+	case <-pendingReq.ctx.Done():
+		l.logger.Warn("failed to deliver response - request expired", "request_id", requestID)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,24 +27,47 @@ import (
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// metricsCacheTTL is how long metrics fetched from an edge are served to identical requests. Request
+// time ranges are rounded to it, so requests for the same window issued a few seconds apart share a result.
+const metricsCacheTTL = 10 * time.Second
+
 // MeshMetricsService handles service mesh metrics requests to edge clusters
 type MeshMetricsService struct {
 	connectionManager providers.ConnectionManager
 	logger            *slog.Logger
 
 	// Pending requests tracking
-	mu                                sync.RWMutex
-	pendingServiceConnectionsRequests map[string]*PendingServiceConnectionsRequest
+	mu                                   sync.RWMutex
+	pendingServiceConnectionsRequests    map[string]*PendingServiceConnectionsRequest
+	pendingPairInstanceMetricsRequests   map[string]*PendingPairInstanceMetricsRequest
+	pendingMeshMetricsTimeSeriesRequests map[string]*PendingMeshMetricsTimeSeriesRequest
+
+	// Recently fetched metrics, keyed by cluster and request, and coalescing of concurrent identical requests
+	cacheMu  sync.Mutex
+	cache    map[string]*cachedMetrics
+	cacheTTL time.Duration
+	fetches  singleflight.Group
+}
+
+// cachedMetrics is a metrics result fetched from an edge
+type cachedMetrics struct {
+	result    proto.Message
+	fetchedAt time.Time
 }
 
 // PendingServiceConnectionsRequest tracks in-flight service connections requests
 type PendingServiceConnectionsRequest struct {
-	RequestID  string
-	ClusterID  string
-	CreatedAt  time.Time
-	ResponseCh chan *ServiceConnectionsResult
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *ServiceConnectionsResult
 }
 
 // ServiceConnectionsResult contains the result of a service connections request
@@ -52,18 +76,169 @@ type ServiceConnectionsResult struct {
 	Error              error
 }
 
+// PendingPairInstanceMetricsRequest tracks in-flight pair instance metrics requests
+type PendingPairInstanceMetricsRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *PairInstanceMetricsResult
+}
+
+// PairInstanceMetricsResult contains the result of a pair instance metrics request
+type PairInstanceMetricsResult struct {
+	PairInstanceMetrics *typesv1alpha1.PairInstanceMetrics
+	Error               error
+}
+
+// PendingMeshMetricsTimeSeriesRequest tracks in-flight mesh metrics time series requests
+type PendingMeshMetricsTimeSeriesRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *MeshMetricsTimeSeriesResult
+}
+
+// MeshMetricsTimeSeriesResult contains the result of a mesh metrics time series request
+type MeshMetricsTimeSeriesResult struct {
+	TimeSeries *typesv1alpha1.MeshMetricsTimeSeries
+	Error      error
+}
+
 // NewMeshMetricsService creates a new mesh metrics service
 func NewMeshMetricsService(connectionManager providers.ConnectionManager, logger *slog.Logger) *MeshMetricsService {
 	return &MeshMetricsService{
-		connectionManager:                 connectionManager,
-		logger:                            logger,
-		pendingServiceConnectionsRequests: make(map[string]*PendingServiceConnectionsRequest),
+		connectionManager:                    connectionManager,
+		logger:                               logger,
+		pendingServiceConnectionsRequests:    make(map[string]*PendingServiceConnectionsRequest),
+		pendingPairInstanceMetricsRequests:   make(map[string]*PendingPairInstanceMetricsRequest),
+		pendingMeshMetricsTimeSeriesRequests: make(map[string]*PendingMeshMetricsTimeSeriesRequest),
+		cache:                                make(map[string]*cachedMetrics),
+		cacheTTL:                             metricsCacheTTL,
 	}
 }
 
-// GetServiceConnections requests service connections metrics from a specific edge cluster
+// GetServiceConnections returns the service connections metrics of a service from a specific edge cluster.
+// Identical requests share one request to the edge and its result for a short time.
 func (m *MeshMetricsService) GetServiceConnections(ctx context.Context, clusterID string, req *frontendv1alpha1.GetServiceConnectionsRequest, proxyMode typesv1alpha1.ProxyMode) (*typesv1alpha1.ServiceGraphMetrics, error) {
+	key := metricsCacheKey("connections", clusterID, req.ServiceName, req.Namespace, proxyMode.String(), req.Perspective.String(),
+		m.roundTimestamp(req.StartTime), m.roundTimestamp(req.EndTime))
+	result, err := m.coalesce(ctx, key, func(ctx context.Context) (proto.Message, error) {
+		return m.fetchServiceConnections(ctx, clusterID, req, proxyMode)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*typesv1alpha1.ServiceGraphMetrics), nil
+}
+
+// GetPairInstanceMetrics returns the metrics between a source and a destination service broken down by pod from a
+// specific edge cluster. Identical requests share one request to the edge and its result for a short time.
+func (m *MeshMetricsService) GetPairInstanceMetrics(ctx context.Context, clusterID string, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*typesv1alpha1.PairInstanceMetrics, error) {
+	key := metricsCacheKey("pair-instances", clusterID, req.SourceService, req.SourceNamespace, req.DestinationService, req.DestinationNamespace)
+	result, err := m.coalesce(ctx, key, func(ctx context.Context) (proto.Message, error) {
+		return m.fetchPairInstanceMetrics(ctx, clusterID, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*typesv1alpha1.PairInstanceMetrics), nil
+}
+
+// GetMeshMetricsTimeSeries returns bucketed metrics of service pairs from a specific edge cluster. Identical
+// requests share one request to the edge and its result for a short time.
+func (m *MeshMetricsService) GetMeshMetricsTimeSeries(ctx context.Context, clusterID string, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*typesv1alpha1.MeshMetricsTimeSeries, error) {
+	parts := []string{"timeseries", clusterID, m.roundTimestamp(req.StartTime), m.roundTimestamp(req.EndTime), fmt.Sprint(req.Buckets)}
+	for _, pair := range req.Pairs {
+		parts = append(parts, pair.SourceService, pair.SourceNamespace, pair.DestinationService, pair.DestinationNamespace)
+	}
+	result, err := m.coalesce(ctx, metricsCacheKey(parts...), func(ctx context.Context) (proto.Message, error) {
+		return m.fetchMeshMetricsTimeSeries(ctx, clusterID, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*typesv1alpha1.MeshMetricsTimeSeries), nil
+}
+
+// coalesce returns a copy of the cached result for key if it has not expired. Otherwise it fetches the
+// result once for all concurrent callers with the same key and caches it.
+func (m *MeshMetricsService) coalesce(ctx context.Context, key string, fetch func(context.Context) (proto.Message, error)) (proto.Message, error) {
+	if result, ok := m.cachedMetrics(key); ok {
+		m.logger.Debug("serving cached metrics", "correlation_id", logging.RequestIDFromContext(ctx), "key", key)
+		return proto.Clone(result), nil
+	}
+
+	// The shared fetch must not be cancelled by whichever caller happened to start it
+	fetchCtx := context.WithoutCancel(ctx)
+	resultCh := m.fetches.DoChan(key, func() (interface{}, error) {
+		result, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		m.storeMetrics(key, result)
+		return result, nil
+	})
+
+	select {
+	case result := <-resultCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		// Callers may modify their result, so each gets its own copy
+		return proto.Clone(result.Val.(proto.Message)), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// cachedMetrics returns the cached result for key if it has not expired
+func (m *MeshMetricsService) cachedMetrics(key string) (proto.Message, bool) {
+	m.cacheMu.Lock()
+	defer m.cacheMu.Unlock()
+
+	cached, exists := m.cache[key]
+	if !exists || time.Since(cached.fetchedAt) > m.cacheTTL {
+		return nil, false
+	}
+	return cached.result, true
+}
+
+// storeMetrics caches a result and drops any expired entries
+func (m *MeshMetricsService) storeMetrics(key string, result proto.Message) {
+	m.cacheMu.Lock()
+	defer m.cacheMu.Unlock()
+
+	for k, existing := range m.cache {
+		if time.Since(existing.fetchedAt) > m.cacheTTL {
+			delete(m.cache, k)
+		}
+	}
+	m.cache[key] = &cachedMetrics{result: result, fetchedAt: time.Now()}
+}
+
+// roundTimestamp formats a request timestamp rounded down to the cache TTL for use in cache keys
+func (m *MeshMetricsService) roundTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	if m.cacheTTL <= 0 {
+		return timestamp.AsTime().Format(time.RFC3339Nano)
+	}
+	return timestamp.AsTime().Truncate(m.cacheTTL).Format(time.RFC3339)
+}
+
+// metricsCacheKey joins the parts identifying a metrics request
+func metricsCacheKey(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
+
+// fetchServiceConnections requests service connections metrics from a specific edge cluster
+func (m *MeshMetricsService) fetchServiceConnections(ctx context.Context, clusterID string, req *frontendv1alpha1.GetServiceConnectionsRequest, proxyMode typesv1alpha1.ProxyMode) (*typesv1alpha1.ServiceGraphMetrics, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
 	m.logger.Info("requesting service connections from edge cluster",
+		"correlation_id", correlationID,
 		"cluster_id", clusterID,
 		"service_name", req.ServiceName,
 		"namespace", req.Namespace)
@@ -76,10 +251,11 @@ func (m *MeshMetricsService) GetServiceConnections(ctx context.Context, clusterI
 
 	// Track pending request
 	pendingRequest := &PendingServiceConnectionsRequest{
-		RequestID:  requestID,
-		ClusterID:  clusterID,
-		CreatedAt:  time.Now(),
-		ResponseCh: responseCh,
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    responseCh,
 	}
 
 	m.mu.Lock()
@@ -99,12 +275,14 @@ func (m *MeshMetricsService) GetServiceConnections(ctx context.Context, clusterI
 
 	// Create service connections request for edge
 	serviceConnectionsReq := &backendv1alpha1.ServiceConnectionsRequest{
-		RequestId:   requestID,
-		ServiceName: req.ServiceName,
-		Namespace:   req.Namespace,
-		StartTime:   startTime,
-		EndTime:     endTime,
-		ProxyMode:   proxyMode,
+		RequestId:     requestID,
+		ServiceName:   req.ServiceName,
+		Namespace:     req.Namespace,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		ProxyMode:     proxyMode,
+		CorrelationId: correlationID,
+		Perspective:   req.Perspective,
 	}
 
 	// Send request to edge cluster
@@ -148,18 +326,21 @@ func (m *MeshMetricsService) HandleServiceConnectionsResponse(resp *backendv1alp
 		result.ServiceConnections = r.ServiceConnections
 		m.logger.Info("received service connections from edge cluster",
 			"cluster_id", pendingRequest.ClusterID,
-			"request_id", resp.RequestId)
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
 	case *backendv1alpha1.ServiceConnectionsResponse_ErrorMessage:
 		result.Error = fmt.Errorf("edge error: %s", r.ErrorMessage)
 		m.logger.Error("received service connections error from edge cluster",
 			"cluster_id", pendingRequest.ClusterID,
 			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
 			"error", r.ErrorMessage)
 	default:
 		result.Error = fmt.Errorf("unknown service connections response type")
 		m.logger.Error("received unknown service connections response type",
 			"cluster_id", pendingRequest.ClusterID,
-			"request_id", resp.RequestId)
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
 	}
 
 	// Send result to waiting goroutine
@@ -176,3 +357,223 @@ func (m *MeshMetricsService) GetPendingServiceConnectionsRequestCount() int {
 	defer m.mu.RUnlock()
 	return len(m.pendingServiceConnectionsRequests)
 }
+
+// fetchPairInstanceMetrics requests the metrics between a source and a destination service broken down by pod from a
+// specific edge cluster
+func (m *MeshMetricsService) fetchPairInstanceMetrics(ctx context.Context, clusterID string, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*typesv1alpha1.PairInstanceMetrics, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	m.logger.Info("requesting pair instance metrics from edge cluster",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"source_service", req.SourceService,
+		"source_namespace", req.SourceNamespace,
+		"destination_service", req.DestinationService,
+		"destination_namespace", req.DestinationNamespace)
+
+	requestID := uuid.New().String()
+	responseCh := make(chan *PairInstanceMetricsResult, 1)
+
+	m.mu.Lock()
+	m.pendingPairInstanceMetricsRequests[requestID] = &PendingPairInstanceMetricsRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    responseCh,
+	}
+	m.mu.Unlock()
+
+	// Clean up request when done
+	defer func() {
+		m.mu.Lock()
+		delete(m.pendingPairInstanceMetricsRequests, requestID)
+		m.mu.Unlock()
+	}()
+
+	if err := m.connectionManager.SendMessageToCluster(clusterID, &backendv1alpha1.ConnectResponse{
+		Message: &backendv1alpha1.ConnectResponse_PairInstanceMetricsRequest{
+			PairInstanceMetricsRequest: &backendv1alpha1.PairInstanceMetricsRequest{
+				RequestId:            requestID,
+				SourceService:        req.SourceService,
+				SourceNamespace:      req.SourceNamespace,
+				DestinationService:   req.DestinationService,
+				DestinationNamespace: req.DestinationNamespace,
+				CorrelationId:        correlationID,
+			},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send pair instance metrics request to cluster %s: %w", clusterID, err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case result := <-responseCh:
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		return result.PairInstanceMetrics, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for pair instance metrics response from cluster %s", clusterID)
+	}
+}
+
+// HandlePairInstanceMetricsResponse processes a pair instance metrics response from an edge cluster
+func (m *MeshMetricsService) HandlePairInstanceMetricsResponse(resp *backendv1alpha1.PairInstanceMetricsResponse) {
+	m.mu.Lock()
+	pendingRequest, exists := m.pendingPairInstanceMetricsRequests[resp.RequestId]
+	m.mu.Unlock()
+
+	if !exists {
+		m.logger.Warn("received pair instance metrics response for unknown request", "request_id", resp.RequestId)
+		return
+	}
+
+	result := &PairInstanceMetricsResult{}
+
+	switch r := resp.Result.(type) {
+	case *backendv1alpha1.PairInstanceMetricsResponse_PairInstanceMetrics:
+		result.PairInstanceMetrics = r.PairInstanceMetrics
+		m.logger.Info("received pair instance metrics from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	case *backendv1alpha1.PairInstanceMetricsResponse_ErrorMessage:
+		result.Error = fmt.Errorf("edge error: %s", r.ErrorMessage)
+		m.logger.Error("received pair instance metrics error from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"error", r.ErrorMessage)
+	default:
+		result.Error = fmt.Errorf("unknown pair instance metrics response type")
+		m.logger.Error("received unknown pair instance metrics response type",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	}
+
+	// Send result to waiting goroutine
+	select {
+	case pendingRequest.ResponseCh <- result:
+	default:
+		m.logger.Warn("failed to send pair instance metrics response - channel full or closed", "request_id", resp.RequestId)
+	}
+}
+
+// GetPendingPairInstanceMetricsRequestCount returns the number of pending pair instance metrics requests
+func (m *MeshMetricsService) GetPendingPairInstanceMetricsRequestCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.pendingPairInstanceMetricsRequests)
+}
+
+// fetchMeshMetricsTimeSeries requests the metrics of service pairs over equal intervals of a time window from a
+// specific edge cluster
+func (m *MeshMetricsService) fetchMeshMetricsTimeSeries(ctx context.Context, clusterID string, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*typesv1alpha1.MeshMetricsTimeSeries, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	m.logger.Info("requesting mesh metrics time series from edge cluster",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"pairs", len(req.Pairs),
+		"buckets", req.Buckets)
+
+	requestID := uuid.New().String()
+	responseCh := make(chan *MeshMetricsTimeSeriesResult, 1)
+
+	m.mu.Lock()
+	m.pendingMeshMetricsTimeSeriesRequests[requestID] = &PendingMeshMetricsTimeSeriesRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    responseCh,
+	}
+	m.mu.Unlock()
+
+	// Clean up request when done
+	defer func() {
+		m.mu.Lock()
+		delete(m.pendingMeshMetricsTimeSeriesRequests, requestID)
+		m.mu.Unlock()
+	}()
+
+	if err := m.connectionManager.SendMessageToCluster(clusterID, &backendv1alpha1.ConnectResponse{
+		Message: &backendv1alpha1.ConnectResponse_MeshMetricsTimeSeriesRequest{
+			MeshMetricsTimeSeriesRequest: &backendv1alpha1.MeshMetricsTimeSeriesRequest{
+				RequestId:     requestID,
+				Pairs:         req.Pairs,
+				StartTime:     req.StartTime,
+				EndTime:       req.EndTime,
+				Buckets:       req.Buckets,
+				CorrelationId: correlationID,
+			},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send mesh metrics time series request to cluster %s: %w", clusterID, err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case result := <-responseCh:
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		return result.TimeSeries, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(30 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for mesh metrics time series response from cluster %s", clusterID)
+	}
+}
+
+// HandleMeshMetricsTimeSeriesResponse processes a mesh metrics time series response from an edge cluster
+func (m *MeshMetricsService) HandleMeshMetricsTimeSeriesResponse(resp *backendv1alpha1.MeshMetricsTimeSeriesResponse) {
+	m.mu.Lock()
+	pendingRequest, exists := m.pendingMeshMetricsTimeSeriesRequests[resp.RequestId]
+	m.mu.Unlock()
+
+	if !exists {
+		m.logger.Warn("received mesh metrics time series response for unknown request", "request_id", resp.RequestId)
+		return
+	}
+
+	result := &MeshMetricsTimeSeriesResult{}
+
+	switch r := resp.Result.(type) {
+	case *backendv1alpha1.MeshMetricsTimeSeriesResponse_TimeSeries:
+		result.TimeSeries = r.TimeSeries
+		m.logger.Info("received mesh metrics time series from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	case *backendv1alpha1.MeshMetricsTimeSeriesResponse_ErrorMessage:
+		result.Error = fmt.Errorf("edge error: %s", r.ErrorMessage)
+		m.logger.Error("received mesh metrics time series error from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"error", r.ErrorMessage)
+	default:
+		result.Error = fmt.Errorf("unknown mesh metrics time series response type")
+		m.logger.Error("received unknown mesh metrics time series response type",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	}
+
+	// Send result to waiting goroutine
+	select {
+	case pendingRequest.ResponseCh <- result:
+	default:
+		m.logger.Warn("failed to send mesh metrics time series response - channel full or closed", "request_id", resp.RequestId)
+	}
+}
+
+// GetPendingMeshMetricsTimeSeriesRequestCount returns the number of pending mesh metrics time series requests
+func (m *MeshMetricsService) GetPendingMeshMetricsTimeSeriesRequestCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.pendingMeshMetricsTimeSeriesRequests)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeMetricsEdge answers service connections requests after a short delay, counting how many it receives
+type fakeMetricsEdge struct {
+	providers.ConnectionManager
+	metricsService *MeshMetricsService
+	requests       atomic.Int32
+}
+
+func (f *fakeMetricsEdge) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
+	f.requests.Add(1)
+	req := message.GetServiceConnectionsRequest()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		f.metricsService.HandleServiceConnectionsResponse(&v1alpha1.ServiceConnectionsResponse{
+			RequestId: req.RequestId,
+			Result: &v1alpha1.ServiceConnectionsResponse_ServiceConnections{
+				ServiceConnections: &types.ServiceGraphMetrics{
+					ClusterId: clusterID,
+					Pairs: []*types.ServicePairMetrics{
+						{SourceService: "frontend", DestinationService: req.ServiceName, RequestRate: 10},
+					},
+				},
+			},
+		})
+	}()
+	return nil
+}
+
+func newTestMeshMetricsService() (*MeshMetricsService, *fakeMetricsEdge) {
+	edge := &fakeMetricsEdge{}
+	service := NewMeshMetricsService(edge, logging.For("test"))
+	edge.metricsService = service
+	return service, edge
+}
+
+func serviceConnectionsRequest(service string, endTime time.Time) *frontendv1alpha1.GetServiceConnectionsRequest {
+	return &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: service,
+		Namespace:   "default",
+		StartTime:   timestamppb.New(endTime.Add(-5 * time.Minute)),
+		EndTime:     timestamppb.New(endTime),
+	}
+}
+
+func TestMeshMetricsService_GetServiceConnectionsCache(t *testing.T) {
+	service, edge := newTestMeshMetricsService()
+	ctx := context.Background()
+	now := time.Now().Truncate(metricsCacheTTL)
+
+	first, err := service.GetServiceConnections(ctx, "cluster-1", serviceConnectionsRequest("backend", now), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	require.Len(t, first.Pairs, 1)
+
+	// A request for the same window a moment later is served from cache
+	second, err := service.GetServiceConnections(ctx, "cluster-1", serviceConnectionsRequest("backend", now.Add(time.Second)), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	assert.Equal(t, first.Pairs[0].RequestRate, second.Pairs[0].RequestRate)
+	assert.Equal(t, int32(1), edge.requests.Load())
+
+	// Results are copies that callers can modify
+	second.Pairs[0].RequestRate = 0
+	third, err := service.GetServiceConnections(ctx, "cluster-1", serviceConnectionsRequest("backend", now), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, third.Pairs[0].RequestRate)
+
+	// Other services, clusters and windows are fetched
+	_, err = service.GetServiceConnections(ctx, "cluster-1", serviceConnectionsRequest("reviews", now), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	_, err = service.GetServiceConnections(ctx, "cluster-2", serviceConnectionsRequest("backend", now), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	_, err = service.GetServiceConnections(ctx, "cluster-1", serviceConnectionsRequest("backend", now.Add(-time.Minute)), types.ProxyMode_SIDECAR)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), edge.requests.Load())
+}
+
+func TestMeshMetricsService_GetServiceConnectionsCacheExpiry(t *testing.T) {
+	service, edge := newTestMeshMetricsService()
+	service.cacheTTL = 0
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		_, err := service.GetServiceConnections(context.Background(), "cluster-1", serviceConnectionsRequest("backend", now), types.ProxyMode_SIDECAR)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), edge.requests.Load())
+}
+
+func TestMeshMetricsService_GetServiceConnectionsCoalescesConcurrentRequests(t *testing.T) {
+	service, edge := newTestMeshMetricsService()
+	now := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			metrics, err := service.GetServiceConnections(context.Background(), "cluster-1", serviceConnectionsRequest("backend", now), types.ProxyMode_SIDECAR)
+			assert.NoError(t, err)
+			assert.Equal(t, "cluster-1", metrics.ClusterId)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), edge.requests.Load())
+	assert.Equal(t, 0, service.GetPendingServiceConnectionsRequestCount())
+}
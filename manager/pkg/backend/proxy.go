@@ -25,8 +25,15 @@ import (
 	"github.com/liamawhite/navigator/manager/pkg/providers"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/proxy/enrich"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"golang.org/x/sync/singleflight"
 )
 
+// proxyConfigCacheTTL is how long a fetched proxy config is served to subsequent requests
+const proxyConfigCacheTTL = 15 * time.Second
+
 // ProxyService handles proxy configuration requests to edge clusters
 type ProxyService struct {
 	connectionManager providers.ConnectionManager
@@ -35,18 +42,25 @@ type ProxyService struct {
 	// Pending requests tracking
 	mu              sync.RWMutex
 	pendingRequests map[string]*PendingProxyRequest
+
+	// Recently fetched configs, keyed by proxy, and coalescing of concurrent fetches
+	cacheMu  sync.Mutex
+	cache    map[string]*providers.ProxyConfigSnapshot
+	cacheTTL time.Duration
+	fetches  singleflight.Group
 }
 
 // PendingProxyRequest tracks in-flight proxy configuration requests
 type PendingProxyRequest struct {
-	RequestID  string
-	ClusterID  string
-	Namespace  string
-	PodName    string
-	CreatedAt  time.Time
-	ResponseCh chan *ProxyConfigResult
-	ctx        context.Context
-	cancel     context.CancelFunc
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	Namespace     string
+	PodName       string
+	CreatedAt     time.Time
+	ResponseCh    chan *ProxyConfigResult
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // ProxyConfigResult contains the result of a proxy configuration request
@@ -61,12 +75,83 @@ func NewProxyService(connectionManager providers.ConnectionManager, logger *slog
 		connectionManager: connectionManager,
 		logger:            logger,
 		pendingRequests:   make(map[string]*PendingProxyRequest),
+		cache:             make(map[string]*providers.ProxyConfigSnapshot),
+		cacheTTL:          proxyConfigCacheTTL,
+	}
+}
+
+// GetProxyConfig returns the proxy configuration for a pod. Recently fetched configs are served
+// from cache unless a refresh is forced, and concurrent requests for the same pod share one fetch.
+func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace, podName string, options providers.ProxyConfigOptions) (*providers.ProxyConfigSnapshot, error) {
+	key := clusterID + "/" + namespace + "/" + podName
+
+	if !options.ForceRefresh {
+		if snapshot, ok := p.cachedProxyConfig(key); ok {
+			p.logger.Debug("serving cached proxy config",
+				"correlation_id", logging.RequestIDFromContext(ctx),
+				"cluster_id", clusterID,
+				"namespace", namespace,
+				"pod", podName,
+				"fetched_at", snapshot.FetchedAt)
+			return snapshot, nil
+		}
 	}
+
+	// The shared fetch must not be cancelled by whichever caller happened to start it
+	fetchCtx := context.WithoutCancel(ctx)
+	resultCh := p.fetches.DoChan(key, func() (interface{}, error) {
+		snapshot, err := p.fetchProxyConfig(fetchCtx, clusterID, namespace, podName)
+		if err != nil {
+			return nil, err
+		}
+		p.storeProxyConfig(key, snapshot)
+		return snapshot, nil
+	})
+
+	select {
+	case result := <-resultCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*providers.ProxyConfigSnapshot), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// cachedProxyConfig returns the cached snapshot for a proxy if it has not expired
+func (p *ProxyService) cachedProxyConfig(key string) (*providers.ProxyConfigSnapshot, bool) {
+	p.cacheMu.Lock()
+	defer p.cacheMu.Unlock()
+
+	snapshot, exists := p.cache[key]
+	if !exists || time.Since(snapshot.FetchedAt) > p.cacheTTL {
+		return nil, false
+	}
+
+	cached := *snapshot
+	cached.Cached = true
+	return &cached, true
 }
 
-// GetProxyConfig requests proxy configuration from a specific edge cluster
-func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace, podName string) (*types.ProxyConfig, error) {
+// storeProxyConfig caches a snapshot and drops any expired entries
+func (p *ProxyService) storeProxyConfig(key string, snapshot *providers.ProxyConfigSnapshot) {
+	p.cacheMu.Lock()
+	defer p.cacheMu.Unlock()
+
+	for k, existing := range p.cache {
+		if time.Since(existing.FetchedAt) > p.cacheTTL {
+			delete(p.cache, k)
+		}
+	}
+	p.cache[key] = snapshot
+}
+
+// fetchProxyConfig requests proxy configuration from a specific edge cluster
+func (p *ProxyService) fetchProxyConfig(ctx context.Context, clusterID, namespace, podName string) (*providers.ProxyConfigSnapshot, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
 	p.logger.Info("requesting proxy config",
+		"correlation_id", correlationID,
 		"cluster_id", clusterID,
 		"namespace", namespace,
 		"pod", podName)
@@ -84,14 +169,15 @@ func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace,
 	defer cancel()
 
 	pendingReq := &PendingProxyRequest{
-		RequestID:  requestID,
-		ClusterID:  clusterID,
-		Namespace:  namespace,
-		PodName:    podName,
-		CreatedAt:  time.Now(),
-		ResponseCh: make(chan *ProxyConfigResult, 1),
-		ctx:        reqCtx,
-		cancel:     cancel,
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		Namespace:     namespace,
+		PodName:       podName,
+		CreatedAt:     time.Now(),
+		ResponseCh:    make(chan *ProxyConfigResult, 1),
+		ctx:           reqCtx,
+		cancel:        cancel,
 	}
 
 	// Register pending request
@@ -111,9 +197,10 @@ func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace,
 	message := &v1alpha1.ConnectResponse{
 		Message: &v1alpha1.ConnectResponse_ProxyConfigRequest{
 			ProxyConfigRequest: &v1alpha1.ProxyConfigRequest{
-				RequestId:    requestID,
-				PodNamespace: namespace,
-				PodName:      podName,
+				RequestId:     requestID,
+				PodNamespace:  namespace,
+				PodName:       podName,
+				CorrelationId: correlationID,
 			},
 		},
 	}
@@ -122,7 +209,7 @@ func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace,
 		return nil, fmt.Errorf("failed to send proxy config request: %w", err)
 	}
 
-	p.logger.Debug("proxy config request sent", "request_id", requestID, "cluster_id", clusterID)
+	p.logger.Debug("proxy config request sent", "request_id", requestID, "correlation_id", correlationID, "cluster_id", clusterID)
 
 	// Wait for response or timeout
 	select {
@@ -130,6 +217,7 @@ func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace,
 		if result.Error != nil {
 			p.logger.Error("proxy config request failed",
 				"request_id", requestID,
+				"correlation_id", correlationID,
 				"cluster_id", clusterID,
 				"error", result.Error)
 			return nil, result.Error
@@ -137,18 +225,63 @@ func (p *ProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace,
 
 		p.logger.Info("proxy config request completed",
 			"request_id", requestID,
+			"correlation_id", correlationID,
 			"cluster_id", clusterID,
 			"version", result.ProxyConfig.Version)
-		return result.ProxyConfig, nil
+
+		p.attributeProxyConfig(clusterID, namespace, podName, result.ProxyConfig)
+
+		return &providers.ProxyConfigSnapshot{
+			ProxyConfig: result.ProxyConfig,
+			FetchedAt:   time.Now(),
+		}, nil
 
 	case <-reqCtx.Done():
 		p.logger.Error("proxy config request timed out",
 			"request_id", requestID,
+			"correlation_id", correlationID,
 			"cluster_id", clusterID)
 		return nil, fmt.Errorf("proxy config request timed out after 30 seconds")
 	}
 }
 
+// attributeProxyConfig attributes a proxy's clusters and listeners to the Istio resources collected for its
+// cluster that generated them. Attribution is best effort: it is skipped when the cluster state is unavailable.
+func (p *ProxyService) attributeProxyConfig(clusterID, namespace, podName string, config *types.ProxyConfig) {
+	state, err := p.connectionManager.GetClusterState(clusterID)
+	if err != nil || state == nil || config == nil {
+		return
+	}
+
+	// Attribute clusters Istio's metadata leaves unattributed to the DestinationRules collected for the cluster
+	enrich.ClusterDestinationRules(config.Clusters, state.DestinationRules, namespace)
+
+	// Listeners are attributed to the Gateways and Sidecars selecting the proxy's workload
+	instance := findPodInstance(state, namespace, podName)
+	if instance == nil {
+		return
+	}
+	scopeToNamespace := state.GetIstioControlPlaneConfig().GetPilotScopeGatewayToNamespace()
+	enrich.ListenerSources(config.Listeners,
+		filters.FilterGatewaysForWorkload(state.Gateways, instance, namespace, scopeToNamespace),
+		filters.FilterSidecarsForWorkload(state.Sidecars, instance, namespace))
+}
+
+// findPodInstance returns the service instance of a pod, or nil if the pod backs no service
+func findPodInstance(state *v1alpha1.ClusterState, namespace, podName string) *v1alpha1.ServiceInstance {
+	for _, service := range state.Services {
+		if service.Namespace != namespace {
+			continue
+		}
+		for _, instance := range service.Instances {
+			if instance.PodName == podName {
+				return instance
+			}
+		}
+	}
+	return nil
+}
+
 // HandleProxyConfigResponse processes proxy configuration responses from edges
 func (p *ProxyService) HandleProxyConfigResponse(response *v1alpha1.ProxyConfigResponse) error {
 	requestID := response.RequestId
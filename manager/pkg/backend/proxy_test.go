@@ -0,0 +1,162 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEdgeConnection answers proxy config requests after a short delay, counting how many it receives
+type fakeEdgeConnection struct {
+	providers.ConnectionManager
+	proxyService *ProxyService
+	requests     atomic.Int32
+}
+
+func (f *fakeEdgeConnection) IsClusterConnected(clusterID string) bool {
+	return true
+}
+
+func (f *fakeEdgeConnection) GetClusterState(clusterID string) (*v1alpha1.ClusterState, error) {
+	return &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			{Name: "ingress", Namespace: "default", Instances: []*v1alpha1.ServiceInstance{
+				{PodName: "pod-1", Labels: map[string]string{"istio": "ingressgateway"}},
+			}},
+		},
+		DestinationRules: []*types.DestinationRule{
+			{Name: "backend", Namespace: "demo", Host: "backend", Subsets: []*types.DestinationRuleSubset{{Name: "v1"}}},
+		},
+		Gateways: []*types.Gateway{
+			{
+				Name:      "frontend",
+				Namespace: "default",
+				Selector:  map[string]string{"istio": "ingressgateway"},
+				RawConfig: `{"spec": {"servers": [{"port": {"number": 80, "name": "http", "protocol": "HTTP"}, "hosts": ["*"]}]}}`,
+			},
+		},
+	}, nil
+}
+
+func (f *fakeEdgeConnection) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
+	f.requests.Add(1)
+	req := message.GetProxyConfigRequest()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = f.proxyService.HandleProxyConfigResponse(&v1alpha1.ProxyConfigResponse{
+			RequestId: req.RequestId,
+			Result: &v1alpha1.ProxyConfigResponse_ProxyConfig{
+				ProxyConfig: &types.ProxyConfig{
+					Version: "1.26.0",
+					Listeners: []*types.ListenerSummary{
+						{Name: "0.0.0.0_8080", Port: 8080, Type: types.ListenerType_GATEWAY_INBOUND, RouteConfigs: []string{"http.80"}},
+					},
+					Clusters: []*types.ClusterSummary{
+						{Name: "outbound|8080|v1|backend.demo.svc.cluster.local", Direction: types.ClusterDirection_OUTBOUND, Subset: "v1", ServiceFqdn: "backend.demo.svc.cluster.local"},
+					},
+				},
+			},
+		})
+	}()
+	return nil
+}
+
+func newTestProxyService() (*ProxyService, *fakeEdgeConnection) {
+	edge := &fakeEdgeConnection{}
+	service := NewProxyService(edge, logging.For("test"))
+	edge.proxyService = service
+	return service, edge
+}
+
+func TestProxyService_GetProxyConfigCache(t *testing.T) {
+	service, edge := newTestProxyService()
+	ctx := context.Background()
+
+	first, err := service.GetProxyConfig(ctx, "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{})
+	require.NoError(t, err)
+	assert.False(t, first.Cached)
+
+	second, err := service.GetProxyConfig(ctx, "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{})
+	require.NoError(t, err)
+	assert.True(t, second.Cached)
+	assert.Equal(t, first.FetchedAt, second.FetchedAt)
+	assert.Equal(t, int32(1), edge.requests.Load())
+
+	refreshed, err := service.GetProxyConfig(ctx, "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{ForceRefresh: true})
+	require.NoError(t, err)
+	assert.False(t, refreshed.Cached)
+	assert.Equal(t, int32(2), edge.requests.Load())
+
+	_, err = service.GetProxyConfig(ctx, "cluster-1", "default", "pod-2", providers.ProxyConfigOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), edge.requests.Load())
+}
+
+func TestProxyService_GetProxyConfigAttributesIstioResources(t *testing.T) {
+	service, _ := newTestProxyService()
+
+	snapshot, err := service.GetProxyConfig(context.Background(), "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{})
+	require.NoError(t, err)
+	require.Len(t, snapshot.ProxyConfig.Clusters, 1)
+	assert.Equal(t, "demo", snapshot.ProxyConfig.Clusters[0].DestinationRuleNamespace)
+	assert.Equal(t, "backend", snapshot.ProxyConfig.Clusters[0].DestinationRuleName)
+
+	require.Len(t, snapshot.ProxyConfig.Listeners, 1)
+	require.Len(t, snapshot.ProxyConfig.Listeners[0].Sources, 1)
+	assert.Equal(t, "Gateway", snapshot.ProxyConfig.Listeners[0].Sources[0].Kind)
+	assert.Equal(t, "frontend", snapshot.ProxyConfig.Listeners[0].Sources[0].Name)
+}
+
+func TestProxyService_GetProxyConfigCacheExpiry(t *testing.T) {
+	service, edge := newTestProxyService()
+	service.cacheTTL = 0
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		snapshot, err := service.GetProxyConfig(ctx, "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{})
+		require.NoError(t, err)
+		assert.False(t, snapshot.Cached)
+	}
+	assert.Equal(t, int32(2), edge.requests.Load())
+}
+
+func TestProxyService_GetProxyConfigCoalescesConcurrentRequests(t *testing.T) {
+	service, edge := newTestProxyService()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			snapshot, err := service.GetProxyConfig(context.Background(), "cluster-1", "default", "pod-1", providers.ProxyConfigOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, "1.26.0", snapshot.ProxyConfig.Version)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), edge.requests.Load())
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// tracesTimeout bounds how long the manager waits for an edge to search its tracing backend
+const tracesTimeout = 30 * time.Second
+
+// TracesService handles trace search requests to edge clusters
+type TracesService struct {
+	connectionManager providers.ConnectionManager
+	logger            *slog.Logger
+
+	// Pending requests tracking
+	mu              sync.RWMutex
+	pendingRequests map[string]*PendingTracesRequest
+}
+
+// PendingTracesRequest tracks in-flight trace search requests
+type PendingTracesRequest struct {
+	RequestID     string
+	CorrelationID string
+	ClusterID     string
+	CreatedAt     time.Time
+	ResponseCh    chan *TracesResult
+}
+
+// TracesResult contains the result of a trace search request
+type TracesResult struct {
+	Traces *typesv1alpha1.ServiceTraces
+	Error  error
+}
+
+// NewTracesService creates a new traces service
+func NewTracesService(connectionManager providers.ConnectionManager, logger *slog.Logger) *TracesService {
+	return &TracesService{
+		connectionManager: connectionManager,
+		logger:            logger,
+		pendingRequests:   make(map[string]*PendingTracesRequest),
+	}
+}
+
+// ListTraces searches the tracing backend of a specific edge cluster for the traces of a service
+func (t *TracesService) ListTraces(ctx context.Context, clusterID string, req *frontendv1alpha1.ListTracesRequest) (*typesv1alpha1.ServiceTraces, error) {
+	correlationID := logging.RequestIDFromContext(ctx)
+	t.logger.Info("requesting traces from edge cluster",
+		"correlation_id", correlationID,
+		"cluster_id", clusterID,
+		"service", req.ServiceName,
+		"namespace", req.Namespace)
+
+	requestID := uuid.New().String()
+	responseCh := make(chan *TracesResult, 1)
+
+	t.mu.Lock()
+	t.pendingRequests[requestID] = &PendingTracesRequest{
+		RequestID:     requestID,
+		CorrelationID: correlationID,
+		ClusterID:     clusterID,
+		CreatedAt:     time.Now(),
+		ResponseCh:    responseCh,
+	}
+	t.mu.Unlock()
+
+	// Clean up request when done
+	defer func() {
+		t.mu.Lock()
+		delete(t.pendingRequests, requestID)
+		t.mu.Unlock()
+	}()
+
+	if err := t.connectionManager.SendMessageToCluster(clusterID, &backendv1alpha1.ConnectResponse{
+		Message: &backendv1alpha1.ConnectResponse_TracesRequest{
+			TracesRequest: &backendv1alpha1.TracesRequest{
+				RequestId:     requestID,
+				ServiceName:   req.ServiceName,
+				Namespace:     req.Namespace,
+				StartTime:     req.StartTime,
+				EndTime:       req.EndTime,
+				MinDuration:   req.MinDuration,
+				Limit:         req.Limit,
+				CorrelationId: correlationID,
+			},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send traces request to cluster %s: %w", clusterID, err)
+	}
+
+	// Wait for response with timeout
+	select {
+	case result := <-responseCh:
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		return result.Traces, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(tracesTimeout):
+		return nil, fmt.Errorf("timeout waiting for traces response from cluster %s", clusterID)
+	}
+}
+
+// HandleTracesResponse processes a trace search response from an edge cluster
+func (t *TracesService) HandleTracesResponse(resp *backendv1alpha1.TracesResponse) {
+	t.mu.Lock()
+	pendingRequest, exists := t.pendingRequests[resp.RequestId]
+	t.mu.Unlock()
+
+	if !exists {
+		t.logger.Warn("received traces response for unknown request", "request_id", resp.RequestId)
+		return
+	}
+
+	result := &TracesResult{}
+
+	switch r := resp.Result.(type) {
+	case *backendv1alpha1.TracesResponse_Traces:
+		result.Traces = r.Traces
+		t.logger.Info("received traces from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"traces", len(r.Traces.GetTraces()))
+	case *backendv1alpha1.TracesResponse_ErrorMessage:
+		result.Error = fmt.Errorf("edge error: %s", r.ErrorMessage)
+		t.logger.Error("received traces error from edge cluster",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID,
+			"error", r.ErrorMessage)
+	default:
+		result.Error = fmt.Errorf("unknown traces response type")
+		t.logger.Error("received unknown traces response type",
+			"cluster_id", pendingRequest.ClusterID,
+			"request_id", resp.RequestId,
+			"correlation_id", pendingRequest.CorrelationID)
+	}
+
+	// Send result to waiting goroutine
+	select {
+	case pendingRequest.ResponseCh <- result:
+	default:
+		t.logger.Warn("failed to send traces response - channel full or closed", "request_id", resp.RequestId)
+	}
+}
+
+// GetPendingRequestCount returns the number of pending trace search requests
+func (t *TracesService) GetPendingRequestCount() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.pendingRequests)
+}
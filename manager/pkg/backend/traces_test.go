@@ -0,0 +1,89 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeTracesEdge answers traces requests with the response built by respond
+type fakeTracesEdge struct {
+	providers.ConnectionManager
+	tracesService *TracesService
+	respond       func(req *v1alpha1.TracesRequest) *v1alpha1.TracesResponse
+	requests      []*v1alpha1.TracesRequest
+}
+
+func (f *fakeTracesEdge) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
+	req := message.GetTracesRequest()
+	f.requests = append(f.requests, req)
+	go f.tracesService.HandleTracesResponse(f.respond(req))
+	return nil
+}
+
+func TestTracesService_ListTraces(t *testing.T) {
+	edge := &fakeTracesEdge{respond: func(req *v1alpha1.TracesRequest) *v1alpha1.TracesResponse {
+		return &v1alpha1.TracesResponse{
+			RequestId: req.RequestId,
+			Result: &v1alpha1.TracesResponse_Traces{Traces: &types.ServiceTraces{
+				ClusterId: "cluster-1",
+				Traces:    []*types.TraceSummary{{TraceId: "abc", RootService: req.ServiceName + "." + req.Namespace}},
+			}},
+		}
+	}}
+	service := NewTracesService(edge, logging.For("test"))
+	edge.tracesService = service
+
+	traces, err := service.ListTraces(context.Background(), "cluster-1", &frontendv1alpha1.ListTracesRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		MinDuration: durationpb.New(time.Second),
+		Limit:       20,
+	})
+	require.NoError(t, err)
+	require.Len(t, traces.Traces, 1)
+	assert.Equal(t, "reviews.bookinfo", traces.Traces[0].RootService)
+
+	require.Len(t, edge.requests, 1)
+	assert.Equal(t, time.Second, edge.requests[0].MinDuration.AsDuration())
+	assert.Equal(t, int32(20), edge.requests[0].Limit)
+	assert.Equal(t, 0, service.GetPendingRequestCount())
+}
+
+func TestTracesService_ListTracesEdgeError(t *testing.T) {
+	edge := &fakeTracesEdge{respond: func(req *v1alpha1.TracesRequest) *v1alpha1.TracesResponse {
+		return &v1alpha1.TracesResponse{
+			RequestId: req.RequestId,
+			Result:    &v1alpha1.TracesResponse_ErrorMessage{ErrorMessage: "traces provider not available"},
+		}
+	}}
+	service := NewTracesService(edge, logging.For("test"))
+	edge.tracesService = service
+
+	_, err := service.ListTraces(context.Background(), "cluster-1", &frontendv1alpha1.ListTracesRequest{ServiceName: "reviews", Namespace: "bookinfo"})
+	require.Error(t, err)
+	assert.Equal(t, "edge error: traces provider not available", err.Error())
+}
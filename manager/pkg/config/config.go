@@ -17,14 +17,36 @@ package config
 import (
 	"flag"
 	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 )
 
+// DefaultStaleClusterRetention is how long, in seconds, the last state of a disconnected cluster is served by default
+const DefaultStaleClusterRetention = 15 * 60
+
+// DefaultMaxClusterStateSize is the size, in MB, a cluster state reassembled from chunks may reach by default
+const DefaultMaxClusterStateSize = 256
+
 // Config holds the configuration for the manager service
 type Config struct {
-	Port           int
-	LogLevel       string
-	LogFormat      string
-	MaxMessageSize int // Maximum gRPC message size in MB
+	Port                  int
+	LogLevel              string
+	LogFormat             string
+	MaxMessageSize        int             // Maximum gRPC message size in MB
+	MaxClusterStateSize   int             // Maximum size in MB of a cluster state reassembled from chunks, 0 for the default
+	HTTPSocket            string          // Unix socket for the HTTP gateway instead of the port after the gRPC port
+	AdminPort             int             // Port for the admin HTTP server on localhost, 0 disables it
+	StaleClusterRetention int             // Seconds to serve the last state of a disconnected cluster, 0 to forget it immediately
+	MaxClusterStaleness   int             // Seconds without a state update before a cluster is evicted, 0 to never evict for staleness
+	EvictionWebhook       string          // URL cluster evictions are posted to
+	EventLogSize          int             // Most recent events kept in the event log, 0 for the default
+	EventLogFile          string          // File the event log is persisted to, empty to keep it in memory only
+	Tenants               *tenancy.Config // Tenants and the clusters they may access, nil to serve every cluster to everyone
+	ReplaySnapshot        string          // Support bundle whose clusters are served as if connected, for offline investigation
+	OTLPLogsEndpoint      string          // OTLP/HTTP endpoint logs are exported to as well as stdout, empty to only write them to stdout
 }
 
 // ParseFlags parses command line flags and returns a Config
@@ -35,6 +57,25 @@ func ParseFlags() (*Config, error) {
 	flag.StringVar(&config.LogLevel, "log-level", "info", "Log level (debug, info, warn, error)")
 	flag.StringVar(&config.LogFormat, "log-format", "text", "Log format (text, json)")
 	flag.IntVar(&config.MaxMessageSize, "max-message-size", 10, "Maximum gRPC message size in MB")
+	flag.IntVar(&config.MaxClusterStateSize, "max-cluster-state-size", DefaultMaxClusterStateSize, "Maximum size in MB of a cluster state an edge sends in chunks, larger states are rejected")
+	flag.StringVar(&config.HTTPSocket, "http-socket", "", "Unix socket path for the HTTP gateway instead of the port after the gRPC port")
+	flag.IntVar(&config.AdminPort, "admin-port", 0, "Port for the admin HTTP server serving the log level and metrics endpoints, on localhost only (0 disables it)")
+	flag.IntVar(&config.StaleClusterRetention, "stale-cluster-retention", DefaultStaleClusterRetention, "How long to keep serving the last state of a disconnected cluster, marked stale, in seconds (0 forgets it immediately)")
+	flag.IntVar(&config.MaxClusterStaleness, "max-cluster-staleness", 0, "How long a cluster may go without a state update before it is evicted from aggregation, in seconds (0 never evicts for staleness)")
+	flag.StringVar(&config.EvictionWebhook, "eviction-webhook", "", "URL each cluster eviction is posted to as JSON")
+	flag.IntVar(&config.EventLogSize, "event-log-size", connections.DefaultEventLogCapacity, "How many of the most recent events the event log keeps")
+	flag.StringVar(&config.EventLogFile, "event-log-file", "", "Path to a file the event log is persisted to and loaded from on startup (default: in memory only)")
+	flag.Func("tenants-file", "Path to a YAML file mapping identities to tenants and the clusters they may access", func(path string) error {
+		tenants, err := tenancy.Load(path)
+		if err != nil {
+			return err
+		}
+		config.Tenants = tenants
+		return nil
+	})
+
+	flag.StringVar(&config.ReplaySnapshot, "replay-snapshot", "", "Path to a support bundle captured with navctl snapshot, whose clusters are served as if they were connected")
+	flag.StringVar(&config.OTLPLogsEndpoint, "otlp-logs-endpoint", "", "OTLP/HTTP endpoint URL logs are exported to as well as stdout, e.g. http://otel-collector:4318/v1/logs")
 
 	flag.Parse()
 
@@ -59,6 +100,46 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("max-message-size must be greater than 0")
 	}
 
+	if c.MaxClusterStateSize < 0 {
+		return fmt.Errorf("max-cluster-state-size must not be negative")
+	}
+
+	if c.AdminPort < 0 || c.AdminPort > 65535 {
+		return fmt.Errorf("admin-port must be between 0 and 65535")
+	}
+
+	if c.StaleClusterRetention < 0 {
+		return fmt.Errorf("stale-cluster-retention must not be negative")
+	}
+
+	if c.MaxClusterStaleness < 0 {
+		return fmt.Errorf("max-cluster-staleness must not be negative")
+	}
+
+	if c.EventLogSize < 0 {
+		return fmt.Errorf("event-log-size must not be negative")
+	}
+
+	if c.EvictionWebhook != "" {
+		webhook, err := url.Parse(c.EvictionWebhook)
+		if err != nil || (webhook.Scheme != "http" && webhook.Scheme != "https") || webhook.Host == "" {
+			return fmt.Errorf("eviction-webhook must be an http or https URL")
+		}
+	}
+
+	if c.OTLPLogsEndpoint != "" {
+		endpoint, err := url.Parse(c.OTLPLogsEndpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("otlp-logs-endpoint must be an http or https URL")
+		}
+	}
+
+	if c.Tenants != nil {
+		if err := c.Tenants.Validate(); err != nil {
+			return fmt.Errorf("invalid tenants: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -67,7 +148,50 @@ func (c *Config) GetPort() int {
 	return c.Port
 }
 
+// GetHTTPSocket returns the unix socket path for the HTTP gateway, empty to listen on a TCP port
+func (c *Config) GetHTTPSocket() string {
+	return c.HTTPSocket
+}
+
+// GetAdminPort returns the port of the admin HTTP server on localhost, 0 if it is disabled
+func (c *Config) GetAdminPort() int {
+	return c.AdminPort
+}
+
 // GetMaxMessageSize returns the maximum gRPC message size in bytes
 func (c *Config) GetMaxMessageSize() int {
 	return c.MaxMessageSize * 1024 * 1024 // Convert MB to bytes
 }
+
+// GetMaxClusterStateSize returns the maximum size in bytes of a cluster state reassembled from chunks
+func (c *Config) GetMaxClusterStateSize() int {
+	if c.MaxClusterStateSize == 0 {
+		return DefaultMaxClusterStateSize * 1024 * 1024
+	}
+	return c.MaxClusterStateSize * 1024 * 1024 // Convert MB to bytes
+}
+
+// GetStaleClusterRetention returns how long the last state of a disconnected cluster is served
+func (c *Config) GetStaleClusterRetention() time.Duration {
+	return time.Duration(c.StaleClusterRetention) * time.Second
+}
+
+// GetMaxClusterStaleness returns how long a cluster may go without a state update before it is evicted
+func (c *Config) GetMaxClusterStaleness() time.Duration {
+	return time.Duration(c.MaxClusterStaleness) * time.Second
+}
+
+// GetEventLogPolicy returns how many events the event log keeps and where it is persisted
+func (c *Config) GetEventLogPolicy() connections.EventLogPolicy {
+	return connections.EventLogPolicy{Capacity: c.EventLogSize, Path: c.EventLogFile}
+}
+
+// GetReplaySnapshot returns the path of the support bundle replayed as connected clusters, empty if none
+func (c *Config) GetReplaySnapshot() string {
+	return c.ReplaySnapshot
+}
+
+// GetTenants returns the tenants of the manager, nil if it is not multi-tenant
+func (c *Config) GetTenants() *tenancy.Config {
+	return c.Tenants
+}
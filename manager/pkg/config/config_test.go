@@ -16,6 +16,8 @@ package config
 
 import (
 	"testing"
+
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -54,6 +56,17 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "invalid admin port",
+			config: &Config{
+				Port:           8080,
+				AdminPort:      65536,
+				LogLevel:       "info",
+				LogFormat:      "text",
+				MaxMessageSize: 10,
+			},
+			wantError: true,
+		},
 		{
 			name: "invalid log level",
 			config: &Config{
@@ -84,6 +97,95 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "negative stale cluster retention",
+			config: &Config{
+				Port:                  8080,
+				LogLevel:              "info",
+				LogFormat:             "text",
+				MaxMessageSize:        10,
+				StaleClusterRetention: -1,
+			},
+			wantError: true,
+		},
+		{
+			name: "negative max cluster staleness",
+			config: &Config{
+				Port:                8080,
+				LogLevel:            "info",
+				LogFormat:           "text",
+				MaxMessageSize:      10,
+				MaxClusterStaleness: -1,
+			},
+			wantError: true,
+		},
+		{
+			name: "negative event log size",
+			config: &Config{
+				Port:           8080,
+				LogLevel:       "info",
+				LogFormat:      "text",
+				MaxMessageSize: 10,
+				EventLogSize:   -1,
+			},
+			wantError: true,
+		},
+		{
+			name: "eviction webhook without scheme",
+			config: &Config{
+				Port:            8080,
+				LogLevel:        "info",
+				LogFormat:       "text",
+				MaxMessageSize:  10,
+				EvictionWebhook: "hooks.example.com/evictions",
+			},
+			wantError: true,
+		},
+		{
+			name: "valid eviction policy",
+			config: &Config{
+				Port:                8080,
+				LogLevel:            "info",
+				LogFormat:           "text",
+				MaxMessageSize:      10,
+				MaxClusterStaleness: 3600,
+				EvictionWebhook:     "https://hooks.example.com/evictions",
+			},
+			wantError: false,
+		},
+		{
+			name: "otlp logs endpoint without scheme",
+			config: &Config{
+				Port:             8080,
+				LogLevel:         "info",
+				LogFormat:        "text",
+				MaxMessageSize:   10,
+				OTLPLogsEndpoint: "otel-collector:4318",
+			},
+			wantError: true,
+		},
+		{
+			name: "valid otlp logs endpoint",
+			config: &Config{
+				Port:             8080,
+				LogLevel:         "info",
+				LogFormat:        "text",
+				MaxMessageSize:   10,
+				OTLPLogsEndpoint: "http://otel-collector:4318/v1/logs",
+			},
+			wantError: false,
+		},
+		{
+			name: "tenant without identities",
+			config: &Config{
+				Port:           8080,
+				LogLevel:       "info",
+				LogFormat:      "text",
+				MaxMessageSize: 10,
+				Tenants:        &tenancy.Config{Tenants: []tenancy.Tenant{{Name: "payments"}}},
+			},
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
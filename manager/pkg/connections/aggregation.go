@@ -0,0 +1,305 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"hash/fnv"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// aggregationCache keeps the results of previous index rebuilds so that only services which changed
+// since the last sync are converted and aggregated again. Each cluster's services are diffed against
+// the previous sync by service ID and a hash of their content. Published indexes are never modified,
+// so cached objects can be shared between index generations. It is protected by the manager's indexMu.
+type aggregationCache struct {
+	clusters map[string]*clusterAggregation // cluster_id -> converted cluster state
+	services map[string]*cachedService      // service_id -> aggregated service
+	buf      []byte                         // reused to marshal services for hashing
+}
+
+// clusterAggregation is the converted state of one cluster
+type clusterAggregation struct {
+	state     *v1alpha1.ClusterState
+	services  map[string]*clusterService            // service_id -> service as reported by the cluster
+	instances map[string]*AggregatedServiceInstance // instance_id -> instance of any of its services
+	exports   map[string]bool                       // service_id -> exported by a valid MCS ServiceExport
+	imports   map[string]bool                       // service_id -> imported by an MCS ServiceImport
+}
+
+// clusterService is a service as reported by one cluster, and its converted instances
+type clusterService struct {
+	hash      uint64
+	service   *v1alpha1.Service
+	instances []*AggregatedServiceInstance
+}
+
+// cachedService is an aggregated service and the clusters it was built from, in order
+type cachedService struct {
+	service  *AggregatedService
+	clusters []string
+}
+
+func newAggregationCache() *aggregationCache {
+	return &aggregationCache{
+		clusters: make(map[string]*clusterAggregation),
+		services: make(map[string]*cachedService),
+	}
+}
+
+// diff converts the cluster states that changed since the previous rebuild and returns the IDs of the
+// services they added, changed or removed, and the IDs of the clusters whose state changed. Clusters
+// whose state is the same object as before are skipped without looking at their services.
+func (c *aggregationCache) diff(states map[string]*v1alpha1.ClusterState) (map[string]bool, []string) {
+	changedServices := make(map[string]bool)
+	var changedClusters []string
+
+	for clusterID, state := range states {
+		if state == nil {
+			continue
+		}
+		previous := c.clusters[clusterID]
+		if previous != nil && previous.state == state {
+			continue
+		}
+		c.clusters[clusterID] = c.convertCluster(clusterID, state, previous, changedServices)
+		changedClusters = append(changedClusters, clusterID)
+	}
+
+	// Drop clusters that disconnected or have not synced since
+	for clusterID, previous := range c.clusters {
+		if states[clusterID] != nil {
+			continue
+		}
+		for serviceID := range previous.services {
+			changedServices[serviceID] = true
+		}
+		delete(c.clusters, clusterID)
+		changedClusters = append(changedClusters, clusterID)
+	}
+
+	return changedServices, changedClusters
+}
+
+// convertCluster converts the services of a cluster state, reusing the instances of services whose hash
+// did not change since the previous state of the cluster. Services that were added, changed or removed
+// are recorded in changed.
+func (c *aggregationCache) convertCluster(clusterID string, state *v1alpha1.ClusterState, previous *clusterAggregation, changed map[string]bool) *clusterAggregation {
+	cluster := &clusterAggregation{
+		state:    state,
+		services: make(map[string]*clusterService, len(state.Services)),
+	}
+	instanceCount := 0
+
+	for _, service := range state.Services {
+		serviceID := service.Namespace + ":" + service.Name
+		hash := c.hash(service)
+
+		// A service that could not be hashed is always converted again
+		if previous != nil && hash != 0 {
+			if cached, exists := previous.services[serviceID]; exists && cached.hash == hash {
+				cluster.services[serviceID] = &clusterService{hash: hash, service: service, instances: cached.instances}
+				instanceCount += len(cached.instances)
+				continue
+			}
+		}
+
+		instances := make([]*AggregatedServiceInstance, len(service.Instances))
+		for i, instance := range service.Instances {
+			instances[i] = convertInstance(clusterID, service.Namespace, instance)
+		}
+		cluster.services[serviceID] = &clusterService{hash: hash, service: service, instances: instances}
+		instanceCount += len(instances)
+		changed[serviceID] = true
+	}
+
+	if previous != nil {
+		for serviceID := range previous.services {
+			if _, exists := cluster.services[serviceID]; !exists {
+				changed[serviceID] = true
+			}
+		}
+	}
+
+	cluster.instances = make(map[string]*AggregatedServiceInstance, instanceCount)
+	for _, service := range state.Services {
+		for _, instance := range cluster.services[service.Namespace+":"+service.Name].instances {
+			cluster.instances[instance.InstanceID] = instance
+		}
+	}
+
+	// Services exported or imported by the cluster are aggregated again when that changes, since the
+	// Multi-Cluster Services API resources are not part of the service's hash
+	cluster.exports = make(map[string]bool, len(state.ServiceExports))
+	for _, export := range state.ServiceExports {
+		if export.Valid {
+			cluster.exports[export.Namespace+":"+export.Name] = true
+		}
+	}
+	cluster.imports = make(map[string]bool, len(state.ServiceImports))
+	for _, serviceImport := range state.ServiceImports {
+		cluster.imports[serviceImport.Namespace+":"+serviceImport.Name] = true
+	}
+	var previousExports, previousImports map[string]bool
+	if previous != nil {
+		previousExports, previousImports = previous.exports, previous.imports
+	}
+	markSymmetricDifference(cluster.exports, previousExports, changed)
+	markSymmetricDifference(cluster.imports, previousImports, changed)
+
+	return cluster
+}
+
+// markSymmetricDifference marks the service IDs in exactly one of two sets as changed
+func markSymmetricDifference(current, previous, changed map[string]bool) {
+	for serviceID := range current {
+		if !previous[serviceID] {
+			changed[serviceID] = true
+		}
+	}
+	for serviceID := range previous {
+		if !current[serviceID] {
+			changed[serviceID] = true
+		}
+	}
+}
+
+// hash returns a hash of the content of a service. Marshaling is deterministic, so equal services hash
+// equally across syncs.
+func (c *aggregationCache) hash(service *v1alpha1.Service) uint64 {
+	buf, err := proto.MarshalOptions{Deterministic: true}.MarshalAppend(c.buf[:0], service)
+	if err != nil {
+		// Services that could not be hashed are treated as changed
+		return 0
+	}
+	c.buf = buf
+
+	h := fnv.New64a()
+	_, _ = h.Write(buf)
+	// Reserve 0 for services that could not be hashed
+	return h.Sum64() | 1
+}
+
+// aggregate builds the aggregated service for a service ID from the clusters reporting it, in the given
+// cluster order, and caches it. It returns nil if no cluster reports the service anymore.
+func (c *aggregationCache) aggregate(serviceID string, clusterIDs []string) *AggregatedService {
+	var aggService *AggregatedService
+	var clusters, exportedClusters, importedClusters []string
+
+	for _, clusterID := range clusterIDs {
+		// A cluster may import a service without a Service of its own
+		if c.clusters[clusterID].exports[serviceID] {
+			exportedClusters = append(exportedClusters, clusterID)
+		}
+		if c.clusters[clusterID].imports[serviceID] {
+			importedClusters = append(importedClusters, clusterID)
+		}
+
+		entry, exists := c.clusters[clusterID].services[serviceID]
+		if !exists {
+			continue
+		}
+		if aggService == nil {
+			aggService = &AggregatedService{
+				ID:            serviceID,
+				Name:          entry.service.Name,
+				Namespace:     entry.service.Namespace,
+				Instances:     make([]*AggregatedServiceInstance, 0),
+				ClusterMap:    make(map[string][]*AggregatedServiceInstance),
+				ClusterIPs:    make(map[string]string),
+				ExternalIPs:   make(map[string]string),
+				ExternalNames: make(map[string]string),
+			}
+		}
+		clusters = append(clusters, clusterID)
+
+		// Add cluster IP if present
+		if entry.service.ClusterIp != "" {
+			aggService.ClusterIPs[clusterID] = entry.service.ClusterIp
+		}
+
+		// Add external IP if present
+		if entry.service.ExternalIp != "" {
+			aggService.ExternalIPs[clusterID] = entry.service.ExternalIp
+		}
+
+		// ExternalName services have no instances, only the hostname they alias
+		if entry.service.ExternalName != "" {
+			aggService.ExternalNames[clusterID] = entry.service.ExternalName
+		}
+
+		// Add cluster instances to service cluster map
+		aggService.Instances = append(aggService.Instances, entry.instances...)
+		if len(entry.instances) > 0 {
+			aggService.ClusterMap[clusterID] = entry.instances
+		}
+	}
+
+	if aggService == nil {
+		delete(c.services, serviceID)
+		return nil
+	}
+	aggService.ExportedClusters = exportedClusters
+	aggService.ImportedClusters = importedClusters
+	c.services[serviceID] = &cachedService{service: aggService, clusters: clusters}
+	return aggService
+}
+
+// convertInstance converts a backend service instance to an aggregated instance
+func convertInstance(clusterID, namespace string, instance *v1alpha1.ServiceInstance) *AggregatedServiceInstance {
+	return &AggregatedServiceInstance{
+		InstanceID:        clusterID + ":" + namespace + ":" + instance.PodName,
+		IP:                instance.Ip,
+		PodName:           instance.PodName,
+		Namespace:         namespace,
+		ClusterName:       clusterID,
+		EnvoyPresent:      instance.EnvoyPresent,
+		Containers:        convertContainers(instance.Containers),
+		PodStatus:         instance.PodStatus,
+		NodeName:          instance.NodeName,
+		CreatedAt:         instance.CreatedAt,
+		Labels:            instance.Labels,
+		Annotations:       instance.Annotations,
+		IsEnvoyPresent:    instance.EnvoyPresent,
+		ProxyMode:         instance.ProxyMode,
+		Policies:          instance.Policies,
+		InitContainers:    convertContainers(instance.InitContainers),
+		ServiceAccount:    instance.ServiceAccount,
+		Tolerations:       instance.Tolerations,
+		Locality:          instance.Locality,
+		ZoneHints:         instance.ZoneHints,
+		LifecyclePhase:    instance.LifecyclePhase,
+		DeletionTimestamp: instance.DeletionTimestamp,
+		CanonicalService:  instance.CanonicalService,
+		CanonicalRevision: instance.CanonicalRevision,
+	}
+}
+
+// convertContainers converts backend containers to manager containers
+func convertContainers(backendContainers []*v1alpha1.Container) []Container {
+	containers := make([]Container, len(backendContainers))
+	for i, backendContainer := range backendContainers {
+		containers[i] = Container{
+			Name:         backendContainer.Name,
+			Image:        backendContainer.Image,
+			Status:       backendContainer.Status,
+			Ready:        backendContainer.Ready,
+			RestartCount: backendContainer.RestartCount,
+			ImageDigest:  backendContainer.ImageDigest,
+		}
+	}
+	return containers
+}
@@ -0,0 +1,308 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxChangesPerCluster bounds the changes retained for each cluster, the oldest are dropped first
+const maxChangesPerCluster = 1000
+
+// KindControlPlane is the kind of changes to a cluster's Istio control plane
+const KindControlPlane = "ControlPlane"
+
+// ChangeType is how a resource changed between two syncs of a cluster
+type ChangeType int
+
+const (
+	ChangeCreated ChangeType = iota + 1
+	ChangeUpdated
+	ChangeDeleted
+)
+
+// Change is a change to a cluster's configuration or topology, observed between two syncs of its state
+// or reported by the edge
+type Change struct {
+	ClusterID   string
+	ObservedAt  time.Time // When the edge collected the change, or the manager received the sync containing it
+	Type        ChangeType
+	Kind        string // Istio resource kind, references.KindService or KindControlPlane
+	Namespace   string
+	Name        string
+	Description string // Summary of control plane changes, empty for resources
+
+	// Versions of Istio resources reported by the edge, empty for changes observed by the manager
+	Actor           string // Field manager of the write, empty if unknown or deleted
+	ResourceVersion string
+	Generation      int64
+	OldHash         string // SHA-256 of the raw config before the change, empty if created
+	NewHash         string // SHA-256 of the raw config after the change, empty if deleted
+}
+
+// changeFeed retains the most recent changes of each cluster
+type changeFeed struct {
+	mu      sync.RWMutex
+	changes map[string][]Change // cluster_id -> changes, oldest first
+}
+
+func newChangeFeed() *changeFeed {
+	return &changeFeed{changes: make(map[string][]Change)}
+}
+
+// record appends the changes of a cluster, dropping its oldest changes beyond maxChangesPerCluster
+func (f *changeFeed) record(clusterID string, changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	retained := append(f.changes[clusterID], changes...)
+	if len(retained) > maxChangesPerCluster {
+		// Copy rather than reslice, so the dropped changes can be collected
+		retained = slices.Clone(retained[len(retained)-maxChangesPerCluster:])
+	}
+	f.changes[clusterID] = retained
+}
+
+// list returns up to limit changes observed after since, newest first. An empty cluster ID lists the
+// changes of every cluster.
+func (f *changeFeed) list(clusterID string, since time.Time, limit int) []Change {
+	f.mu.RLock()
+	var changes []Change
+	for id, clusterChanges := range f.changes {
+		if clusterID != "" && id != clusterID {
+			continue
+		}
+		for _, change := range clusterChanges {
+			if change.ObservedAt.After(since) {
+				changes = append(changes, change)
+			}
+		}
+	}
+	f.mu.RUnlock()
+
+	// Changes of one sync share their time, so keep their order within a cluster
+	slices.SortStableFunc(changes, func(a, b Change) int {
+		if c := b.ObservedAt.Compare(a.ObservedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ClusterID, b.ClusterID)
+	})
+	if limit > 0 && len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes
+}
+
+// ListChanges returns up to limit changes observed after since, newest first, in one cluster or in every
+// cluster if the cluster ID is empty. Changes are observed by comparing consecutive syncs of a cluster, or
+// reported by edges that track the versions of Istio resources.
+func (m *Manager) ListChanges(clusterID string, since time.Time, limit int) []Change {
+	return m.changes.list(clusterID, since, limit)
+}
+
+// clusterChanges returns the changes of a sync of a cluster. Edges that track the versions of Istio
+// resources report the changes to them, with the actor and versions of each, which replace those observed
+// between the previous and current states. The first sync of a cluster is not compared with anything.
+func clusterChanges(clusterID string, previous, current *v1alpha1.ClusterState, resourceChanges []*v1alpha1.ResourceChange, edgeTracksChanges bool, receivedAt time.Time) []Change {
+	var changes []Change
+	if previous != nil {
+		changes = diffClusterStates(clusterID, previous, current, receivedAt)
+	}
+	if !edgeTracksChanges {
+		return changes
+	}
+
+	changes = slices.DeleteFunc(changes, func(change Change) bool {
+		return change.Kind != references.KindService && change.Kind != KindControlPlane
+	})
+	for _, resourceChange := range resourceChanges {
+		changes = append(changes, convertResourceChange(clusterID, resourceChange, receivedAt))
+	}
+	return changes
+}
+
+// convertResourceChange converts a resource change reported by the edge, falling back to when the sync was
+// received if the edge did not report when it was observed
+func convertResourceChange(clusterID string, change *v1alpha1.ResourceChange, receivedAt time.Time) Change {
+	observedAt := receivedAt
+	if change.ObservedAt != nil {
+		observedAt = change.ObservedAt.AsTime()
+	}
+
+	var changeType ChangeType
+	switch change.Type {
+	case v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_CREATED:
+		changeType = ChangeCreated
+	case v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_DELETED:
+		changeType = ChangeDeleted
+	default:
+		changeType = ChangeUpdated
+	}
+
+	return Change{
+		ClusterID:       clusterID,
+		ObservedAt:      observedAt,
+		Type:            changeType,
+		Kind:            change.Kind,
+		Namespace:       change.Namespace,
+		Name:            change.Name,
+		Actor:           change.Actor,
+		ResourceVersion: change.ResourceVersion,
+		Generation:      change.Generation,
+		OldHash:         change.OldHash,
+		NewHash:         change.NewHash,
+	}
+}
+
+// resourceKey identifies a resource within a cluster state
+type resourceKey struct {
+	kind, namespace, name string
+}
+
+// diffClusterStates returns the changes between two consecutive states of a cluster, ordered by kind,
+// namespace and name. Services are only reported when they appear or disappear, as their instances change
+// with every rollout.
+func diffClusterStates(clusterID string, previous, current *v1alpha1.ClusterState, observedAt time.Time) []Change {
+	change := func(changeType ChangeType, key resourceKey) Change {
+		return Change{
+			ClusterID:  clusterID,
+			ObservedAt: observedAt,
+			Type:       changeType,
+			Kind:       key.kind,
+			Namespace:  key.namespace,
+			Name:       key.name,
+		}
+	}
+
+	var changes []Change
+	before, after := stateResources(previous), stateResources(current)
+	for key, resource := range after {
+		existing, existed := before[key]
+		switch {
+		case !existed:
+			changes = append(changes, change(ChangeCreated, key))
+		case key.kind != references.KindService && !proto.Equal(existing, resource):
+			changes = append(changes, change(ChangeUpdated, key))
+		}
+	}
+	for key := range before {
+		if _, exists := after[key]; !exists {
+			changes = append(changes, change(ChangeDeleted, key))
+		}
+	}
+	slices.SortFunc(changes, func(a, b Change) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	if description := describeControlPlaneChange(previous, current); description != "" {
+		changes = append(changes, Change{
+			ClusterID:   clusterID,
+			ObservedAt:  observedAt,
+			Type:        ChangeUpdated,
+			Kind:        KindControlPlane,
+			Name:        "istiod",
+			Description: description,
+		})
+	}
+	return changes
+}
+
+// stateResources indexes the services and Istio resources of a cluster state
+func stateResources(state *v1alpha1.ClusterState) map[resourceKey]proto.Message {
+	resources := make(map[resourceKey]proto.Message)
+	add := func(kind string, resource interface {
+		proto.Message
+		GetNamespace() string
+		GetName() string
+	}) {
+		resources[resourceKey{kind: kind, namespace: resource.GetNamespace(), name: resource.GetName()}] = resource
+	}
+
+	for _, service := range state.Services {
+		add(references.KindService, service)
+	}
+	for _, resource := range state.AuthorizationPolicies {
+		add(references.KindAuthorizationPolicy, resource)
+	}
+	for _, resource := range state.DestinationRules {
+		add(references.KindDestinationRule, resource)
+	}
+	for _, resource := range state.EnvoyFilters {
+		add(references.KindEnvoyFilter, resource)
+	}
+	for _, resource := range state.Gateways {
+		add(references.KindGateway, resource)
+	}
+	for _, resource := range state.PeerAuthentications {
+		add(references.KindPeerAuthentication, resource)
+	}
+	for _, resource := range state.RequestAuthentications {
+		add(references.KindRequestAuthentication, resource)
+	}
+	for _, resource := range state.ServiceEntries {
+		add(references.KindServiceEntry, resource)
+	}
+	for _, resource := range state.Sidecars {
+		add(references.KindSidecar, resource)
+	}
+	for _, resource := range state.VirtualServices {
+		add(references.KindVirtualService, resource)
+	}
+	for _, resource := range state.WasmPlugins {
+		add(references.KindWasmPlugin, resource)
+	}
+	return resources
+}
+
+// describeControlPlaneChange summarizes a change of the active control plane's revision or version between
+// two cluster states, or returns an empty string if neither changed or either state does not report them
+func describeControlPlaneChange(previous, current *v1alpha1.ClusterState) string {
+	before, after := previous.GetIstioControlPlaneConfig(), current.GetIstioControlPlaneConfig()
+	if before == nil || after == nil {
+		return ""
+	}
+
+	var description string
+	if before.Revision != after.Revision {
+		description = fmt.Sprintf("revision %s -> %s", revisionName(before.Revision), revisionName(after.Revision))
+	}
+	beforeVersion, afterVersion := before.GetSidecarInjector().GetVersion(), after.GetSidecarInjector().GetVersion()
+	if beforeVersion != "" && afterVersion != "" && beforeVersion != afterVersion {
+		if description != "" {
+			description += ", "
+		}
+		description += fmt.Sprintf("version %s -> %s", beforeVersion, afterVersion)
+	}
+	return description
+}
+
+// revisionName names a control plane revision, which is empty for the default revision
+func revisionName(revision string) string {
+	if revision == "" {
+		return "default"
+	}
+	return revision
+}
@@ -0,0 +1,319 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultEventLogCapacity is how many events the event log keeps by default
+const DefaultEventLogCapacity = 10000
+
+// EventType is what happened in an event
+type EventType string
+
+const (
+	EventConnected       EventType = "connected"
+	EventDisconnected    EventType = "disconnected"
+	EventSynced          EventType = "synced"
+	EventResyncRequested EventType = "resync_requested"
+	EventChanged         EventType = "changed"
+	EventEvicted         EventType = "evicted"
+)
+
+// Event is an entry of the manager's event log
+type Event struct {
+	Time         time.Time `json:"time"`
+	Type         EventType `json:"type"`
+	ClusterID    string    `json:"cluster_id"`
+	ConnectionID string    `json:"connection_id,omitempty"` // Set for connect, disconnect and sync events
+	Message      string    `json:"message"`
+	Change       *Change   `json:"change,omitempty"` // Set for change events
+}
+
+// EventLogPolicy determines how many events the event log keeps and where they are persisted
+type EventLogPolicy struct {
+	// Capacity is how many of the most recent events are kept, the oldest are dropped first. 0 keeps
+	// DefaultEventLogCapacity events.
+	Capacity int
+	// Path is the file events are appended to as JSON lines and loaded from on startup, so the event log
+	// survives restarts. Empty keeps events in memory only.
+	Path string
+}
+
+// eventLog is a ring buffer of the most recent events, optionally persisted to a file
+type eventLog struct {
+	logger *slog.Logger
+
+	mu     sync.RWMutex
+	events []Event // Ring buffer, the oldest event is at start once it is full
+	start  int
+	count  int
+
+	file     *os.File // nil unless persisted
+	path     string
+	appended int // Events appended to the file since it was last compacted
+}
+
+func newEventLog(logger *slog.Logger) *eventLog {
+	return &eventLog{logger: logger, events: make([]Event, DefaultEventLogCapacity)}
+}
+
+// SetEventLogPolicy sets how many events the event log keeps and where they are persisted. Events
+// persisted by a previous run are loaded, keeping the most recent. By default DefaultEventLogCapacity
+// events are kept in memory only.
+func (m *Manager) SetEventLogPolicy(policy EventLogPolicy) error {
+	return m.events.configure(policy)
+}
+
+// ListEvents returns up to limit events between start and end, inclusive, newest first, of one cluster or
+// of every cluster if the cluster ID is empty. A zero start or end leaves the range open on that side.
+func (m *Manager) ListEvents(clusterID string, start, end time.Time, limit int) []Event {
+	return m.events.list(clusterID, start, end, limit)
+}
+
+// recordEvent records an event in the event log at the current time
+func (m *Manager) recordEvent(event Event) {
+	event.Time = time.Now()
+	m.events.record(event)
+}
+
+// recordChangeEvents records an event for each change of a cluster
+func (m *Manager) recordChangeEvents(changes []Change) {
+	for i := range changes {
+		change := changes[i]
+		m.recordEvent(Event{
+			Type:      EventChanged,
+			ClusterID: change.ClusterID,
+			Message:   describeChange(change),
+			Change:    &change,
+		})
+	}
+}
+
+// describeChange summarizes a change for the event log
+func describeChange(change Change) string {
+	var verb string
+	switch change.Type {
+	case ChangeCreated:
+		verb = "created"
+	case ChangeDeleted:
+		verb = "deleted"
+	default:
+		verb = "updated"
+	}
+
+	name := change.Name
+	if change.Namespace != "" {
+		name = change.Namespace + "/" + name
+	}
+	description := fmt.Sprintf("%s %s %s", change.Kind, name, verb)
+	if change.Actor != "" {
+		description += " by " + change.Actor
+	}
+	if change.Description != "" {
+		description += ": " + change.Description
+	}
+	return description
+}
+
+// configure resizes the event log and persists it to a file, loading the events persisted there
+func (l *eventLog) configure(policy EventLogPolicy) error {
+	capacity := policy.Capacity
+	if capacity <= 0 {
+		capacity = DefaultEventLogCapacity
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Keep the most recent events that fit
+	events := l.ordered()
+	l.events = make([]Event, capacity)
+	l.start, l.count = 0, 0
+	if l.file != nil {
+		_ = l.file.Close()
+		l.file = nil
+	}
+	l.path = policy.Path
+
+	if l.path != "" {
+		persisted, err := loadEvents(l.path)
+		if err != nil {
+			return err
+		}
+		events = append(persisted, events...)
+	}
+	for _, event := range events {
+		l.push(event)
+	}
+
+	if l.path == "" {
+		return nil
+	}
+	return l.compact()
+}
+
+// record appends an event, dropping the oldest event once the log is full
+func (l *eventLog) record(event Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.push(event)
+	if l.file == nil {
+		return
+	}
+
+	if err := l.persist(event); err != nil {
+		l.logger.Warn("failed to persist event", "path", l.path, "error", err)
+	}
+}
+
+// list returns up to limit events between start and end, newest first
+func (l *eventLog) list(clusterID string, start, end time.Time, limit int) []Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var events []Event
+	for i := l.count - 1; i >= 0; i-- {
+		event := l.events[(l.start+i)%len(l.events)]
+		if clusterID != "" && event.ClusterID != clusterID {
+			continue
+		}
+		if (!start.IsZero() && event.Time.Before(start)) || (!end.IsZero() && event.Time.After(end)) {
+			continue
+		}
+		events = append(events, event)
+		if limit > 0 && len(events) == limit {
+			break
+		}
+	}
+	return events
+}
+
+// push appends an event to the ring buffer. The caller must hold mu.
+func (l *eventLog) push(event Event) {
+	if l.count < len(l.events) {
+		l.events[(l.start+l.count)%len(l.events)] = event
+		l.count++
+		return
+	}
+	l.events[l.start] = event
+	l.start = (l.start + 1) % len(l.events)
+}
+
+// ordered returns the events of the ring buffer, oldest first. The caller must hold mu.
+func (l *eventLog) ordered() []Event {
+	events := make([]Event, 0, l.count)
+	for i := range l.count {
+		events = append(events, l.events[(l.start+i)%len(l.events)])
+	}
+	return events
+}
+
+// persist appends an event to the file, compacting the file once it holds as many dropped events as the
+// log holds. The caller must hold mu.
+func (l *eventLog) persist(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+
+	l.appended++
+	if l.appended < len(l.events) {
+		return nil
+	}
+	return l.compact()
+}
+
+// compact rewrites the file with the events of the log, replacing it atomically, and reopens it for
+// appending. The caller must hold mu.
+func (l *eventLog) compact() error {
+	if l.file != nil {
+		_ = l.file.Close()
+		l.file = nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), ".events-*")
+	if err != nil {
+		return fmt.Errorf("failed to create event log: %w", err)
+	}
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, event := range l.ordered() {
+		if err := encoder.Encode(event); err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write event log: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write event log: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), l.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace event log: %w", err)
+	}
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+	l.file = file
+	l.appended = 0
+	return nil
+}
+
+// loadEvents reads the events persisted to a file, oldest first. A missing file has no events, and lines
+// that cannot be decoded, such as one cut short by a crash, are skipped.
+func loadEvents(path string) ([]Event, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err == nil {
+			events = append(events, event)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+)
+
+// evictionWebhookTimeout bounds how long posting an eviction event may take
+const evictionWebhookTimeout = 10 * time.Second
+
+// EvictionReason is why a cluster was evicted from aggregation
+type EvictionReason string
+
+const (
+	// EvictionRetentionExpired evicts a disconnected cluster once its stale retention expires
+	EvictionRetentionExpired EvictionReason = "retention_expired"
+	// EvictionMaxStaleness evicts a cluster whose state was not updated within the maximum staleness
+	EvictionMaxStaleness EvictionReason = "max_staleness"
+)
+
+// EvictionPolicy determines when clusters are evicted from aggregation, so clusters that are decommissioned
+// or whose edges stopped syncing eventually disappear
+type EvictionPolicy struct {
+	// StaleRetention is how long the last state of a disconnected cluster is served, marked stale. With 0 a
+	// cluster is forgotten as soon as its last edge disconnects.
+	StaleRetention time.Duration
+	// MaxStaleness is how long after its last state update a cluster is evicted, whether its edges are
+	// still connected or its state is retained. 0 never evicts clusters for staleness.
+	MaxStaleness time.Duration
+	// Webhook is the URL each eviction is posted to as a ClusterEviction, empty to only log evictions
+	Webhook string
+}
+
+// ClusterEviction is the event of a cluster being evicted from aggregation
+type ClusterEviction struct {
+	ClusterID  string         `json:"cluster_id"`
+	Reason     EvictionReason `json:"reason"`
+	EvictedAt  time.Time      `json:"evicted_at"`
+	LastUpdate time.Time      `json:"last_update"` // When the manager last received the cluster's state
+}
+
+// staleCluster is the last state of a cluster whose edges all disconnected, served until its retention
+// expires or the cluster reconnects
+type staleCluster struct {
+	info   ConnectionInfo // Connection info when the last edge disconnected, marked stale
+	state  *v1alpha1.ClusterState
+	expiry *time.Timer
+}
+
+// stalenessEviction evicts a cluster unless its state is updated before the maximum staleness
+type stalenessEviction struct {
+	lastUpdate time.Time
+	timer      *time.Timer
+}
+
+// SetEvictionPolicy sets when clusters are evicted from aggregation. By default the last state of a
+// disconnected cluster is forgotten as soon as its last edge disconnects, and clusters are never evicted
+// for staleness.
+func (m *Manager) SetEvictionPolicy(policy EvictionPolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.eviction = policy
+}
+
+// retainStaleCluster keeps serving the last state of a cluster whose last edge disconnected, and reports
+// whether it is retained. Clusters that never sent a state, or were already evicted for staleness, are not
+// retained. The caller must hold mu.
+func (m *Manager) retainStaleCluster(info ConnectionInfo, state *v1alpha1.ClusterState) bool {
+	if m.eviction.StaleRetention <= 0 || state == nil || m.evicted[info.ClusterID] {
+		return false
+	}
+
+	info.Stale = true
+	info.DisconnectedAt = time.Now()
+	stale := &staleCluster{info: info, state: state}
+	stale.expiry = time.AfterFunc(m.eviction.StaleRetention, func() {
+		m.expireStaleCluster(info.ClusterID, stale)
+	})
+	m.stale[info.ClusterID] = stale
+
+	m.logger.Info("retaining state of disconnected cluster",
+		"cluster_id", info.ClusterID,
+		"retention", m.eviction.StaleRetention)
+	return true
+}
+
+// reviveStaleCluster stops serving the retained state of a cluster that reconnects, which is replaced once
+// its edges sync. The caller must hold mu.
+func (m *Manager) reviveStaleCluster(clusterID string) {
+	if stale, exists := m.stale[clusterID]; exists {
+		stale.expiry.Stop()
+		delete(m.stale, clusterID)
+	}
+}
+
+// scheduleStalenessEviction restarts the maximum staleness of a cluster whose state was just updated, and
+// returns a cluster evicted for staleness to aggregation. The caller must hold mu.
+func (m *Manager) scheduleStalenessEviction(clusterID string) {
+	if eviction := m.staleness[clusterID]; eviction != nil {
+		eviction.timer.Stop()
+		delete(m.staleness, clusterID)
+	}
+	delete(m.evicted, clusterID)
+	if m.eviction.MaxStaleness <= 0 {
+		return
+	}
+
+	eviction := &stalenessEviction{lastUpdate: time.Now()}
+	eviction.timer = time.AfterFunc(m.eviction.MaxStaleness, func() {
+		m.evictStaleCluster(clusterID, eviction)
+	})
+	m.staleness[clusterID] = eviction
+}
+
+// forgetCluster drops everything retained about a cluster once it is no longer served. The caller must hold mu.
+func (m *Manager) forgetCluster(clusterID string) {
+	m.reviveStaleCluster(clusterID)
+	if eviction := m.staleness[clusterID]; eviction != nil {
+		eviction.timer.Stop()
+		delete(m.staleness, clusterID)
+	}
+	delete(m.evicted, clusterID)
+}
+
+// expireStaleCluster evicts the retained state of a cluster once its retention expires, unless the cluster
+// reconnected, or disconnected again, in the meantime
+func (m *Manager) expireStaleCluster(clusterID string, stale *staleCluster) {
+	m.mu.Lock()
+	if m.stale[clusterID] != stale {
+		m.mu.Unlock()
+		return
+	}
+	m.forgetCluster(clusterID)
+	m.stageStates()
+	m.mu.Unlock()
+
+	m.publishSnapshot()
+	m.notifyEviction(ClusterEviction{
+		ClusterID:  clusterID,
+		Reason:     EvictionRetentionExpired,
+		EvictedAt:  time.Now(),
+		LastUpdate: stale.info.LastUpdate,
+	})
+}
+
+// evictStaleCluster evicts a cluster whose state was not updated within the maximum staleness. A retained
+// state is forgotten, and a connected cluster is left out of aggregation until its edges sync again.
+func (m *Manager) evictStaleCluster(clusterID string, eviction *stalenessEviction) {
+	m.mu.Lock()
+	if m.staleness[clusterID] != eviction {
+		m.mu.Unlock()
+		return
+	}
+	delete(m.staleness, clusterID)
+	switch {
+	case m.stale[clusterID] != nil:
+		m.forgetCluster(clusterID)
+	case m.isClusterConnected(clusterID):
+		m.evicted[clusterID] = true
+	default:
+		m.mu.Unlock()
+		return
+	}
+	m.stageStates()
+	m.mu.Unlock()
+
+	m.publishSnapshot()
+	m.notifyEviction(ClusterEviction{
+		ClusterID:  clusterID,
+		Reason:     EvictionMaxStaleness,
+		EvictedAt:  time.Now(),
+		LastUpdate: eviction.lastUpdate,
+	})
+}
+
+// notifyEviction logs and records an eviction, and posts it to the eviction webhook in the background
+func (m *Manager) notifyEviction(eviction ClusterEviction) {
+	m.logger.Info("cluster evicted",
+		"cluster_id", eviction.ClusterID,
+		"reason", eviction.Reason,
+		"last_update", eviction.LastUpdate)
+	telemetry.RecordClusterEviction(string(eviction.Reason))
+	m.recordEvent(Event{
+		Type:      EventEvicted,
+		ClusterID: eviction.ClusterID,
+		Message:   fmt.Sprintf("cluster evicted: %s", eviction.Reason),
+	})
+
+	m.mu.RLock()
+	webhook := m.eviction.Webhook
+	m.mu.RUnlock()
+	if webhook == "" {
+		return
+	}
+	go func() {
+		if err := postEviction(webhook, eviction); err != nil {
+			m.logger.Warn("failed to post cluster eviction to webhook", "cluster_id", eviction.ClusterID, "error", err)
+		}
+	}()
+}
+
+// postEviction posts an eviction event to a webhook as JSON
+func postEviction(webhook string, eviction ClusterEviction) error {
+	body, err := json.Marshal(eviction)
+	if err != nil {
+		return fmt.Errorf("failed to encode eviction: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), evictionWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
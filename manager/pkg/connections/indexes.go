@@ -14,130 +14,124 @@
 
 package connections
 
-// rebuildIndexes rebuilds the read-optimized indexes from current cluster states
-// Must be called with m.mu.Lock() held
-func (m *Manager) rebuildIndexes() {
-	// Create new indexes
+import (
+	"maps"
+	"slices"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+)
+
+// rebuildIndexes builds the read-optimized indexes for the given cluster states from the previous ones.
+// Only services that were added, changed or removed since the previous rebuild are aggregated again;
+// everything else is carried over from copies of the previous indexes, which are never modified.
+// Must be called with m.indexMu held
+func (m *Manager) rebuildIndexes(previous *ReadOptimizedIndexes, states map[string]*v1alpha1.ClusterState) *ReadOptimizedIndexes {
+	cache := m.aggregation
+
+	changedServices, changedClusters := cache.diff(states)
+	if len(changedServices) == 0 && len(changedClusters) == 0 {
+		return previous
+	}
+
 	newIndexes := &ReadOptimizedIndexes{
-		Services:            make(map[string]*AggregatedService),
-		ServicesByNamespace: make(map[string][]*AggregatedService),
-		ServicesByCluster:   make(map[string][]*AggregatedService),
-		Instances:           make(map[string]*AggregatedServiceInstance),
-		InstancesByService:  make(map[string][]*AggregatedServiceInstance),
+		Services:            maps.Clone(previous.Services),
+		ServicesByNamespace: maps.Clone(previous.ServicesByNamespace),
+		ServicesByCluster:   maps.Clone(previous.ServicesByCluster),
+		Instances:           maps.Clone(previous.Instances),
+		InstancesByService:  maps.Clone(previous.InstancesByService),
 	}
 
-	// Process all cluster states
-	for clusterID, connection := range m.connections {
-		if connection.ClusterState == nil {
-			continue
-		}
+	// Aggregate changed services in cluster order so aggregation is deterministic
+	clusterIDs := slices.Sorted(maps.Keys(cache.clusters))
 
-		var clusterServices []*AggregatedService
-
-		// Process each service in the cluster
-		for _, service := range connection.ClusterState.Services {
-			serviceID := service.Namespace + ":" + service.Name
-
-			// Get or create aggregated service
-			aggService, exists := newIndexes.Services[serviceID]
-			if !exists {
-				aggService = &AggregatedService{
-					ID:          serviceID,
-					Name:        service.Name,
-					Namespace:   service.Namespace,
-					Instances:   make([]*AggregatedServiceInstance, 0),
-					ClusterMap:  make(map[string][]*AggregatedServiceInstance),
-					ClusterIPs:  make(map[string]string),
-					ExternalIPs: make(map[string]string),
-				}
-				newIndexes.Services[serviceID] = aggService
-			}
-
-			// Add cluster IP if present
-			if service.ClusterIp != "" {
-				aggService.ClusterIPs[clusterID] = service.ClusterIp
-			}
+	// Namespaces and clusters whose service lists contain a changed service, before or after the change
+	namespaces := make(map[string]bool)
+	clusters := make(map[string]bool, len(changedClusters))
+	for _, clusterID := range changedClusters {
+		clusters[clusterID] = true
+	}
 
-			// Add external IP if present
-			if service.ExternalIp != "" {
-				aggService.ExternalIPs[clusterID] = service.ExternalIp
+	for serviceID := range changedServices {
+		if cached, exists := cache.services[serviceID]; exists {
+			namespaces[cached.service.Namespace] = true
+			for _, clusterID := range cached.clusters {
+				clusters[clusterID] = true
 			}
+		}
 
-			var clusterInstances []*AggregatedServiceInstance
-
-			// Process each instance in the service
-			for _, instance := range service.Instances {
-				instanceID := clusterID + ":" + service.Namespace + ":" + instance.PodName
-
-				// Convert backend containers to manager containers
-				containers := make([]Container, len(instance.Containers))
-				for i, backendContainer := range instance.Containers {
-					containers[i] = Container{
-						Name:         backendContainer.Name,
-						Image:        backendContainer.Image,
-						Status:       backendContainer.Status,
-						Ready:        backendContainer.Ready,
-						RestartCount: backendContainer.RestartCount,
-					}
-				}
-
-				aggInstance := &AggregatedServiceInstance{
-					InstanceID:     instanceID,
-					IP:             instance.Ip,
-					PodName:        instance.PodName,
-					Namespace:      service.Namespace,
-					ClusterName:    clusterID,
-					EnvoyPresent:   instance.EnvoyPresent,
-					Containers:     containers,
-					PodStatus:      instance.PodStatus,
-					NodeName:       instance.NodeName,
-					CreatedAt:      instance.CreatedAt,
-					Labels:         instance.Labels,
-					Annotations:    instance.Annotations,
-					IsEnvoyPresent: instance.EnvoyPresent,
-					ProxyMode:      instance.ProxyMode,
-				}
-
-				// Add to global instances index
-				newIndexes.Instances[instanceID] = aggInstance
+		aggService := cache.aggregate(serviceID, clusterIDs)
+		if aggService == nil {
+			delete(newIndexes.Services, serviceID)
+			delete(newIndexes.InstancesByService, serviceID)
+			continue
+		}
 
-				// Add to service instances
-				aggService.Instances = append(aggService.Instances, aggInstance)
-				clusterInstances = append(clusterInstances, aggInstance)
+		newIndexes.Services[serviceID] = aggService
+		if len(aggService.Instances) > 0 {
+			newIndexes.InstancesByService[serviceID] = aggService.Instances
+		} else {
+			delete(newIndexes.InstancesByService, serviceID)
+		}
+		namespaces[aggService.Namespace] = true
+		for _, clusterID := range cache.services[serviceID].clusters {
+			clusters[clusterID] = true
+		}
+	}
 
-				// Add to instances by service index
-				newIndexes.InstancesByService[serviceID] = append(newIndexes.InstancesByService[serviceID], aggInstance)
+	// Replace the changed services in the lists of their namespaces
+	for namespace := range namespaces {
+		services := make([]*AggregatedService, 0, len(previous.ServicesByNamespace[namespace]))
+		for _, service := range previous.ServicesByNamespace[namespace] {
+			if !changedServices[service.ID] {
+				services = append(services, service)
 			}
-
-			// Add cluster instances to service cluster map
-			if len(clusterInstances) > 0 {
-				aggService.ClusterMap[clusterID] = clusterInstances
+		}
+		for serviceID := range changedServices {
+			if service, exists := newIndexes.Services[serviceID]; exists && service.Namespace == namespace {
+				services = append(services, service)
 			}
-
-			clusterServices = append(clusterServices, aggService)
 		}
+		if len(services) > 0 {
+			newIndexes.ServicesByNamespace[namespace] = services
+		} else {
+			delete(newIndexes.ServicesByNamespace, namespace)
+		}
+	}
 
-		// Add services to cluster index
-		if len(clusterServices) > 0 {
-			newIndexes.ServicesByCluster[clusterID] = clusterServices
+	// Rebuild the service lists of affected clusters, in the order of their cluster state
+	for clusterID := range clusters {
+		cluster, exists := cache.clusters[clusterID]
+		if !exists || len(cluster.state.Services) == 0 {
+			delete(newIndexes.ServicesByCluster, clusterID)
+			continue
 		}
+		services := make([]*AggregatedService, 0, len(cluster.state.Services))
+		for _, service := range cluster.state.Services {
+			services = append(services, newIndexes.Services[service.Namespace+":"+service.Name])
+		}
+		newIndexes.ServicesByCluster[clusterID] = services
 	}
 
-	// Build namespace index
-	for _, service := range newIndexes.Services {
-		newIndexes.ServicesByNamespace[service.Namespace] = append(newIndexes.ServicesByNamespace[service.Namespace], service)
+	// Instance IDs are prefixed by their cluster, so only the instances of changed clusters are replaced
+	for _, clusterID := range changedClusters {
+		if previousCluster, exists := previous.ServicesByCluster[clusterID]; exists {
+			for _, service := range previousCluster {
+				for _, instance := range service.ClusterMap[clusterID] {
+					delete(newIndexes.Instances, instance.InstanceID)
+				}
+			}
+		}
+		if cluster, exists := cache.clusters[clusterID]; exists {
+			maps.Copy(newIndexes.Instances, cluster.instances)
+		}
 	}
 
-	// Atomically update the indexes
-	m.indexes.Store(newIndexes)
+	return newIndexes
 }
 
 // ListAggregatedServices returns services filtered by namespace and/or cluster
 func (m *Manager) ListAggregatedServices(namespace, clusterID string) []*AggregatedService {
-	indexes := m.indexes.Load()
-	if indexes == nil {
-		return nil
-	}
+	indexes := m.snapshot.Load().indexes
 
 	var services []*AggregatedService
 
@@ -170,10 +164,7 @@ func (m *Manager) ListAggregatedServices(namespace, clusterID string) []*Aggrega
 
 // GetAggregatedService returns a specific service by ID
 func (m *Manager) GetAggregatedService(serviceID string) (*AggregatedService, bool) {
-	indexes := m.indexes.Load()
-	if indexes == nil {
-		return nil, false
-	}
+	indexes := m.snapshot.Load().indexes
 
 	service, exists := indexes.Services[serviceID]
 	return service, exists
@@ -181,10 +172,7 @@ func (m *Manager) GetAggregatedService(serviceID string) (*AggregatedService, bo
 
 // GetAggregatedServiceInstance returns a specific service instance by ID
 func (m *Manager) GetAggregatedServiceInstance(instanceID string) (*AggregatedServiceInstance, bool) {
-	indexes := m.indexes.Load()
-	if indexes == nil {
-		return nil, false
-	}
+	indexes := m.snapshot.Load().indexes
 
 	instance, exists := indexes.Instances[instanceID]
 	return instance, exists
@@ -192,10 +180,7 @@ func (m *Manager) GetAggregatedServiceInstance(instanceID string) (*AggregatedSe
 
 // GetServiceInstances returns all instances for a specific service
 func (m *Manager) GetServiceInstances(serviceID string) []*AggregatedServiceInstance {
-	indexes := m.indexes.Load()
-	if indexes == nil {
-		return nil
-	}
+	indexes := m.snapshot.Load().indexes
 
 	return indexes.InstancesByService[serviceID]
 }
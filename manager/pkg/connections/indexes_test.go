@@ -15,12 +15,15 @@
 package connections
 
 import (
+	"maps"
+	"slices"
 	"testing"
 
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/logging"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestManager_ReadOptimizedIndexes(t *testing.T) {
@@ -183,7 +186,7 @@ func TestManager_MultiClusterAggregation(t *testing.T) {
 	assert.Len(t, services, 1, "Expected 1 service in cluster1")
 
 	// Test that unregistering a cluster removes its data
-	manager.UnregisterConnection("cluster1")
+	manager.UnregisterConnection("cluster1", nil)
 
 	service, exists = manager.GetAggregatedService("default:web-service")
 	assert.True(t, exists, "Expected service to still exist after cluster1 removal")
@@ -363,6 +366,37 @@ func TestManager_ServiceIPAggregation_EmptyIPs(t *testing.T) {
 	assert.Len(t, service.Instances, 1, "Expected instance to be present")
 }
 
+func TestManager_ServiceIPAggregation_ExternalName(t *testing.T) {
+	logger := logging.For("test")
+	manager := NewManager(logger)
+
+	for _, clusterID := range []string{"cluster1", "cluster2"} {
+		err := manager.RegisterConnection(clusterID, nil)
+		assert.NoError(t, err)
+	}
+
+	// ExternalName services have neither IPs nor instances
+	err := manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			{Name: "payments", Namespace: "default", ServiceType: types.ServiceType_EXTERNAL_NAME, ExternalName: "api.payments.example.com"},
+		},
+	})
+	assert.NoError(t, err)
+	err = manager.UpdateClusterState("cluster2", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			{Name: "payments", Namespace: "default", ServiceType: types.ServiceType_CLUSTER_IP, ClusterIp: "10.96.0.5"},
+		},
+	})
+	assert.NoError(t, err)
+
+	service, exists := manager.GetAggregatedService("default:payments")
+	assert.True(t, exists)
+	assert.Equal(t, map[string]string{"cluster1": "api.payments.example.com"}, service.ExternalNames)
+	assert.Equal(t, map[string]string{"cluster2": "10.96.0.5"}, service.ClusterIPs)
+	assert.Empty(t, service.Instances)
+	assert.Len(t, manager.ListAggregatedServices("", "cluster1"), 1)
+}
+
 func TestManager_ServiceIPAggregation_MultipleServices(t *testing.T) {
 	logger := logging.For("test")
 	manager := NewManager(logger)
@@ -413,3 +447,174 @@ func TestManager_ServiceIPAggregation_MultipleServices(t *testing.T) {
 	assert.Equal(t, "10.96.0.3", serviceC.ClusterIPs["cluster1"])
 	assert.Empty(t, serviceC.ExternalIPs)
 }
+
+func TestManager_RebuildIndexesReusesUnchangedServices(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	assert.NoError(t, manager.RegisterConnection("cluster1", nil))
+	assert.NoError(t, manager.RegisterConnection("cluster2", nil))
+
+	state := func(ip string) *v1alpha1.ClusterState {
+		return &v1alpha1.ClusterState{
+			Services: []*v1alpha1.Service{
+				{Name: "reviews", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: ip, PodName: "reviews-1"}}},
+				{Name: "ratings", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: "10.0.0.9", PodName: "ratings-1"}}},
+			},
+		}
+	}
+	assert.NoError(t, manager.UpdateClusterState("cluster1", state("10.0.0.1")))
+	reviews, _ := manager.GetAggregatedService("bookinfo:reviews")
+	ratings, _ := manager.GetAggregatedService("bookinfo:ratings")
+
+	// An identical state from a new sync reuses the converted services
+	assert.NoError(t, manager.UpdateClusterState("cluster1", state("10.0.0.1")))
+	service, _ := manager.GetAggregatedService("bookinfo:reviews")
+	assert.Same(t, reviews, service)
+
+	// A changed service is converted again, its unchanged neighbour is not
+	assert.NoError(t, manager.UpdateClusterState("cluster1", state("10.0.0.2")))
+	service, _ = manager.GetAggregatedService("bookinfo:reviews")
+	assert.NotSame(t, reviews, service)
+	assert.Equal(t, "10.0.0.2", service.Instances[0].IP)
+	service, _ = manager.GetAggregatedService("bookinfo:ratings")
+	assert.Same(t, ratings, service)
+
+	// A service reported by another cluster is aggregated again but keeps the converted instances
+	assert.NoError(t, manager.UpdateClusterState("cluster2", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{{Name: "ratings", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: "10.1.0.9", PodName: "ratings-1"}}}},
+	}))
+	service, _ = manager.GetAggregatedService("bookinfo:ratings")
+	assert.NotSame(t, ratings, service)
+	assert.Len(t, service.Instances, 2)
+	assert.Same(t, ratings.Instances[0], service.ClusterMap["cluster1"][0])
+	assert.Equal(t, "10.1.0.9", service.ClusterMap["cluster2"][0].IP)
+
+	// Removing the cluster drops its instances
+	manager.UnregisterConnection("cluster2", nil)
+	service, _ = manager.GetAggregatedService("bookinfo:ratings")
+	assert.Len(t, service.Instances, 1)
+	_, exists := manager.GetAggregatedServiceInstance("cluster2:bookinfo:ratings-1")
+	assert.False(t, exists)
+}
+
+func TestManager_RebuildIndexesUnhashableServices(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	assert.NoError(t, manager.RegisterConnection("cluster1", nil))
+
+	// Invalid UTF-8 fails to marshal, so the service cannot be hashed
+	state := func(ip string) *v1alpha1.ClusterState {
+		return &v1alpha1.ClusterState{
+			Services: []*v1alpha1.Service{
+				{Name: "reviews", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: ip, PodName: "reviews-\xff"}}},
+			},
+		}
+	}
+	assert.NoError(t, manager.UpdateClusterState("cluster1", state("10.0.0.1")))
+	assert.NoError(t, manager.UpdateClusterState("cluster1", state("10.0.0.2")))
+
+	// Services that could not be hashed are never reused
+	service, _ := manager.GetAggregatedService("bookinfo:reviews")
+	require.Len(t, service.Instances, 1)
+	assert.Equal(t, "10.0.0.2", service.Instances[0].IP)
+}
+
+func TestManager_RebuildIndexesOnlyChangedServices(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	assert.NoError(t, manager.RegisterConnection("cluster1", nil))
+	assert.NoError(t, manager.RegisterConnection("cluster2", nil))
+
+	service := func(namespace, name, ip string) *v1alpha1.Service {
+		return &v1alpha1.Service{Name: name, Namespace: namespace, Instances: []*v1alpha1.ServiceInstance{{Ip: ip, PodName: name + "-1"}}}
+	}
+	assert.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{service("bookinfo", "reviews", "10.0.0.1"), service("bookinfo", "ratings", "10.0.0.2"), service("default", "httpbin", "10.0.0.3")},
+	}))
+	assert.NoError(t, manager.UpdateClusterState("cluster2", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{service("bookinfo", "reviews", "10.1.0.1")},
+	}))
+	indexes := manager.snapshot.Load().indexes
+	httpbin, _ := manager.GetAggregatedService("default:httpbin")
+
+	// Rebuilding from the same cluster state objects leaves the indexes untouched
+	manager.indexMu.Lock()
+	assert.Same(t, indexes, manager.rebuildIndexes(indexes, manager.snapshot.Load().states))
+	manager.indexMu.Unlock()
+
+	// Removing a service drops it from every index, services in other namespaces are carried over
+	assert.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{service("bookinfo", "reviews", "10.0.0.1"), service("default", "httpbin", "10.0.0.3")},
+	}))
+	_, exists := manager.GetAggregatedService("bookinfo:ratings")
+	assert.False(t, exists)
+	_, exists = manager.GetAggregatedServiceInstance("cluster1:bookinfo:ratings-1")
+	assert.False(t, exists)
+	assert.Empty(t, manager.GetServiceInstances("bookinfo:ratings"))
+	assert.ElementsMatch(t, []string{"bookinfo:reviews"}, serviceIDs(manager.ListAggregatedServices("bookinfo", "")))
+	assert.ElementsMatch(t, []string{"bookinfo:reviews", "default:httpbin"}, serviceIDs(manager.ListAggregatedServices("", "cluster1")))
+	service1, _ := manager.GetAggregatedService("default:httpbin")
+	assert.Same(t, httpbin, service1)
+	assert.Same(t, httpbin, manager.ListAggregatedServices("default", "")[0])
+
+	// A service changed by one cluster is replaced in the lists of the other clusters reporting it
+	assert.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{service("bookinfo", "reviews", "10.0.0.9"), service("default", "httpbin", "10.0.0.3")},
+	}))
+	reviews, _ := manager.GetAggregatedService("bookinfo:reviews")
+	assert.Same(t, reviews, manager.ListAggregatedServices("", "cluster2")[0])
+	instance, _ := manager.GetAggregatedServiceInstance("cluster1:bookinfo:reviews-1")
+	assert.Equal(t, "10.0.0.9", instance.IP)
+	instance, _ = manager.GetAggregatedServiceInstance("cluster2:bookinfo:reviews-1")
+	assert.Equal(t, "10.1.0.1", instance.IP)
+
+	// A disconnected cluster leaves no services or instances behind
+	manager.UnregisterConnection("cluster1", nil)
+	assert.Empty(t, manager.ListAggregatedServices("default", ""))
+	assert.Empty(t, manager.ListAggregatedServices("", "cluster1"))
+	_, exists = manager.GetAggregatedServiceInstance("cluster1:default:httpbin-1")
+	assert.False(t, exists)
+	reviews, _ = manager.GetAggregatedService("bookinfo:reviews")
+	assert.Equal(t, []string{"cluster2"}, slices.Collect(maps.Keys(reviews.ClusterMap)))
+}
+
+func TestManager_MultiClusterServicesAggregation(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	assert.NoError(t, manager.RegisterConnection("east", nil))
+	assert.NoError(t, manager.RegisterConnection("west", nil))
+
+	reviews := &v1alpha1.Service{Name: "reviews", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: "10.0.0.1", PodName: "reviews-1"}}}
+	assert.NoError(t, manager.UpdateClusterState("east", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{reviews},
+		ServiceExports: []*v1alpha1.ServiceExport{
+			{Name: "reviews", Namespace: "bookinfo", Valid: true},
+			// Invalid exports do not make a service available
+			{Name: "ratings", Namespace: "bookinfo", Valid: false},
+		},
+	}))
+	// The importing cluster has no Service of its own
+	assert.NoError(t, manager.UpdateClusterState("west", &v1alpha1.ClusterState{
+		ServiceImports: []*v1alpha1.ServiceImport{{Name: "reviews", Namespace: "bookinfo", Type: "ClusterSetIP"}},
+	}))
+
+	service, exists := manager.GetAggregatedService("bookinfo:reviews")
+	require.True(t, exists)
+	assert.Equal(t, []string{"east"}, service.ExportedClusters)
+	assert.Equal(t, []string{"west"}, service.ImportedClusters)
+	assert.Empty(t, manager.ListAggregatedServices("", "west"))
+
+	// Imports without an exported Service anywhere are not aggregated
+	_, exists = manager.GetAggregatedService("bookinfo:ratings")
+	assert.False(t, exists)
+
+	// Removing the import re-aggregates the service even though no Service changed
+	assert.NoError(t, manager.UpdateClusterState("west", &v1alpha1.ClusterState{}))
+	service, _ = manager.GetAggregatedService("bookinfo:reviews")
+	assert.Equal(t, []string{"east"}, service.ExportedClusters)
+	assert.Empty(t, service.ImportedClusters)
+}
+
+func serviceIDs(services []*AggregatedService) []string {
+	ids := make([]string, len(services))
+	for i, service := range services {
+		ids[i] = service.ID
+	}
+	return ids
+}
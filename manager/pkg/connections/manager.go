@@ -17,27 +17,56 @@ package connections
 import (
 	"fmt"
 	"log/slog"
+	"net"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/protocol"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
 )
 
 // Manager manages active connections and cluster state
 type Manager struct {
 	logger *slog.Logger
 
-	// Connection management (protected by mu)
+	// Connection management (protected by mu). mu is only held for bookkeeping, expensive work such as
+	// aggregating cluster states happens after it is released.
 	mu          sync.RWMutex
-	connections map[string]*Connection // cluster_id -> connection
+	connections map[string]*Connection            // connection ID -> connection, see ConnectionID
+	states      map[string]*v1alpha1.ClusterState // cluster_id -> cluster state, merged across shards
 
-	// Read-optimized indexes (atomic pointer for lock-free reads)
-	// This allows multiple goroutines to read service data simultaneously
+	// Eviction of disconnected and stale clusters (protected by mu)
+	eviction  EvictionPolicy
+	stale     map[string]*staleCluster      // cluster_id -> last state of the disconnected cluster
+	staleness map[string]*stalenessEviction // cluster_id -> eviction unless the cluster's state is updated
+	evicted   map[string]bool               // cluster_id -> connected cluster evicted for staleness until it syncs
+
+	// Read-optimized snapshot of cluster states and indexes (atomic pointer for lock-free reads)
+	// This allows multiple goroutines to read cluster states and service data simultaneously
 	// without blocking each other or blocking writers. Writers atomically
-	// replace the entire index structure, ensuring readers always see
+	// replace the entire snapshot, ensuring readers always see
 	// either the complete old or complete new version.
-	indexes atomic.Pointer[ReadOptimizedIndexes]
+	snapshot atomic.Pointer[stateSnapshot]
+
+	// Cluster states waiting to be indexed and published in a snapshot
+	pending atomic.Pointer[pendingStates]
+
+	// Index rebuilds (protected by indexMu)
+	indexMu     sync.Mutex
+	aggregation *aggregationCache // converted services from previous index rebuilds
+
+	// Changes observed between consecutive syncs of each cluster
+	changes *changeFeed
+
+	// Syncs, connections, resync requests, changes and evictions of every cluster
+	events *eventLog
 }
 
 // NewManager creates a new connection manager
@@ -45,15 +74,28 @@ func NewManager(logger *slog.Logger) *Manager {
 	m := &Manager{
 		logger:      logger,
 		connections: make(map[string]*Connection),
+		states:      make(map[string]*v1alpha1.ClusterState),
+		stale:       make(map[string]*staleCluster),
+		staleness:   make(map[string]*stalenessEviction),
+		evicted:     make(map[string]bool),
+		aggregation: newAggregationCache(),
+		changes:     newChangeFeed(),
+		events:      newEventLog(logger),
 	}
 
-	// Initialize empty indexes
-	m.indexes.Store(&ReadOptimizedIndexes{
-		Services:            make(map[string]*AggregatedService),
-		ServicesByNamespace: make(map[string][]*AggregatedService),
-		ServicesByCluster:   make(map[string][]*AggregatedService),
-		Instances:           make(map[string]*AggregatedServiceInstance),
-		InstancesByService:  make(map[string][]*AggregatedServiceInstance),
+	// Initialize empty snapshot
+	states := make(map[string]*v1alpha1.ClusterState)
+	m.pending.Store(&pendingStates{states: states})
+	m.snapshot.Store(&stateSnapshot{
+		states: states,
+		stale:  make(map[string]time.Time),
+		indexes: &ReadOptimizedIndexes{
+			Services:            make(map[string]*AggregatedService),
+			ServicesByNamespace: make(map[string][]*AggregatedService),
+			ServicesByCluster:   make(map[string][]*AggregatedService),
+			Instances:           make(map[string]*AggregatedServiceInstance),
+			InstancesByService:  make(map[string][]*AggregatedServiceInstance),
+		},
 	})
 
 	return m
@@ -61,183 +103,587 @@ func NewManager(logger *slog.Logger) *Manager {
 
 // RegisterConnection attempts to register a new connection for a cluster
 func (m *Manager) RegisterConnection(clusterID string, stream v1alpha1.ManagerService_ConnectServer) error {
+	_, err := m.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{ClusterId: clusterID}, stream)
+	return err
+}
+
+// leaderTakeoverGrace is how long the leader of an existing connection must have been silent before a leader
+// of a later term replaces it, twice the edges' default sync interval. A leader that loses its Lease closes
+// its connection, so a connection that still syncs belongs to the current leader whatever term is claimed.
+const leaderTakeoverGrace = time.Minute
+
+// RegisterEdgeConnection attempts to register a new connection for the cluster, or namespace shard of the
+// cluster, an edge identified itself with, and returns the ID of the connection. A cluster or shard only has
+// one connection, but a leader replaces an existing connection that stopped syncing from an earlier term, or
+// a previous connection of the same replica from the same host, since that leader can no longer be syncing.
+func (m *Manager) RegisterEdgeConnection(identification *v1alpha1.ClusterIdentification, stream v1alpha1.ManagerService_ConnectServer) (string, error) {
+	clusterID := identification.GetClusterId()
+	shard := identification.GetShard()
+	leader := identification.GetLeaderElection()
+	connectionID := ConnectionID(clusterID, shard)
+
+	var remoteAddr string
+	if stream != nil {
+		if p, ok := peer.FromContext(stream.Context()); ok {
+			remoteAddr = p.Addr.String()
+		}
+	}
+
 	m.mu.Lock()
-	defer m.mu.Unlock()
 
 	// Check if cluster already has an active connection
-	if existing, exists := m.connections[clusterID]; exists {
+	existing, exists := m.connections[connectionID]
+	if exists && !supersedes(leader, remoteAddr, existing) {
 		m.logger.Warn("connection rejected - cluster already has active connection",
 			"cluster_id", clusterID,
+			"connection_id", connectionID,
 			"existing_connected_at", existing.ConnectedAt)
-		return fmt.Errorf("cluster %s already has an active connection", clusterID)
+		m.mu.Unlock()
+		if shard != nil {
+			return "", fmt.Errorf("shard %s of cluster %s already has an active connection", ShardName(shard), clusterID)
+		}
+		return "", fmt.Errorf("cluster %s already has an active connection", clusterID)
+	}
+
+	// Shards of a cluster must not overlap
+	if err := m.shardConflict(clusterID, connectionID, shard); err != nil {
+		m.logger.Warn("connection rejected - conflicting namespace shard",
+			"cluster_id", clusterID,
+			"connection_id", connectionID,
+			"error", err)
+		m.mu.Unlock()
+		return "", err
 	}
 
 	// Register new connection
 	connection := &Connection{
+		ID:          connectionID,
 		ClusterID:   clusterID,
+		RemoteAddr:  remoteAddr,
 		ConnectedAt: time.Now(),
 		LastUpdate:  time.Now(),
 		Stream:      stream,
+		Leader:      leader,
+		Shard:       shard,
+		Protocol:    identification.GetProtocol(),
+		disconnect:  make(chan struct{}),
+	}
+	if stream != nil {
+		connection.sender = NewEdgeStream(stream)
+	}
+
+	var superseded *Connection
+	if exists {
+		// Keep serving the previous leader's state until the new leader syncs
+		connection.ClusterState = existing.ClusterState
+		if m.terminate(existing) {
+			superseded = existing
+		}
+		m.logger.Info("edge leader failover",
+			"cluster_id", clusterID,
+			"previous_leader", existing.Leader.GetIdentity(),
+			"previous_term", existing.Leader.GetTerm(),
+			"leader", leader.Identity,
+			"term", leader.Term)
 	}
 
-	m.connections[clusterID] = connection
+	m.connections[connectionID] = connection
+	m.reviveStaleCluster(clusterID)
+	m.stageStates()
+	m.mu.Unlock()
+
+	// Rebuild read-optimized indexes, then notify the superseded leader and record the event without holding mu
+	m.publishSnapshot()
+	if superseded != nil {
+		m.notifyDisconnect(superseded, fmt.Sprintf("superseded by leader %s (term %d)", leader.Identity, leader.Term))
+	}
 
 	m.logger.Info("connection registered",
 		"cluster_id", clusterID,
+		"connection_id", connectionID,
 		"connected_at", connection.ConnectedAt)
+	m.recordEvent(Event{
+		Type:         EventConnected,
+		ClusterID:    clusterID,
+		ConnectionID: connectionID,
+		Message:      connectedMessage(connection),
+	})
 
-	return nil
+	return connectionID, nil
 }
 
-// UnregisterConnection removes a connection for a cluster
-func (m *Manager) UnregisterConnection(clusterID string) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// connectedMessage describes the connection of an edge for the event log
+func connectedMessage(connection *Connection) string {
+	message := "edge connected"
+	if connection.RemoteAddr != "" {
+		message += " from " + connection.RemoteAddr
+	}
+	if connection.Shard != nil {
+		message += " for shard " + ShardName(connection.Shard)
+	}
+	if connection.Leader != nil {
+		message += fmt.Sprintf(" as leader %s (term %d)", connection.Leader.Identity, connection.Leader.Term)
+	}
+	return message
+}
 
-	if connection, exists := m.connections[clusterID]; exists {
-		delete(m.connections, clusterID)
+// supersedes reports whether a connecting leader replaces the leader of an existing connection. The term and
+// identity are claimed by the edge, so they are only trusted together with what the manager observes: the same
+// replica must reconnect from the same host, and a later term only replaces a leader that stopped syncing.
+func supersedes(leader *v1alpha1.LeaderElection, remoteAddr string, existing *Connection) bool {
+	if leader == nil || existing.Leader == nil {
+		return false
+	}
+	if leader.Identity == existing.Leader.Identity {
+		return peerHost(remoteAddr) == peerHost(existing.RemoteAddr)
+	}
+	return leader.Term > existing.Leader.Term && time.Since(existing.LastUpdate) > leaderTakeoverGrace
+}
 
-		// Rebuild read-optimized indexes after removing cluster
-		m.rebuildIndexes()
+// peerHost returns the host of a peer address, without its port
+func peerHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
 
-		duration := time.Since(connection.ConnectedAt)
-		m.logger.Info("connection unregistered",
-			"cluster_id", clusterID,
-			"connected_duration", duration)
+// UnregisterConnection removes a connection if it is served by the given stream, so a connection replaced
+// by a new leader does not remove its successor. A nil stream removes any connection. It reports whether a
+// connection was removed.
+func (m *Manager) UnregisterConnection(connectionID string, stream v1alpha1.ManagerService_ConnectServer) bool {
+	m.mu.Lock()
+	connection, exists := m.connections[connectionID]
+	if !exists || (stream != nil && connection.Stream != stream) {
+		m.mu.Unlock()
+		return false
+	}
+
+	// Keep the cluster's info and state in case this is its last connection
+	info := m.clusterConnectionInfo(connection.ClusterID, m.states[connection.ClusterID])
+	state := m.states[connection.ClusterID]
+
+	delete(m.connections, connectionID)
+	m.refreshClusterState(connection.ClusterID)
+	if !m.isClusterConnected(connection.ClusterID) {
+		if !m.retainStaleCluster(info, state) {
+			m.forgetCluster(connection.ClusterID)
+		}
+		telemetry.ForgetCluster(connection.ClusterID)
 	}
+	m.stageStates()
+	m.mu.Unlock()
+
+	// Rebuild read-optimized indexes after removing the cluster, or the shard of it
+	m.publishSnapshot()
+
+	duration := time.Since(connection.ConnectedAt)
+	m.logger.Info("connection unregistered",
+		"cluster_id", connection.ClusterID,
+		"connection_id", connectionID,
+		"connected_duration", duration)
+	m.recordEvent(Event{
+		Type:         EventDisconnected,
+		ClusterID:    connection.ClusterID,
+		ConnectionID: connectionID,
+		Message:      fmt.Sprintf("edge disconnected after %s", duration.Round(time.Second)),
+	})
+
+	return true
 }
 
 // UpdateClusterState updates the cluster state for a connection
-func (m *Manager) UpdateClusterState(clusterID string, clusterState *v1alpha1.ClusterState) error {
+func (m *Manager) UpdateClusterState(connectionID string, clusterState *v1alpha1.ClusterState) error {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	connection, exists := m.connections[clusterID]
+	connection, exists := m.connections[connectionID]
 	if !exists {
-		return fmt.Errorf("no active connection for cluster %s", clusterID)
+		m.mu.Unlock()
+		return fmt.Errorf("no active connection for cluster %s", connectionID)
 	}
 
+	// Resource changes are recorded once rather than kept with the state, which is merged on every sync
+	resourceChanges := clusterState.ResourceChanges
+	clusterState.ResourceChanges = nil
+	edgeTracksChanges := protocol.SupportsResourceType(connection.Protocol, protocol.ResourceTypeResourceChanges)
+
 	connection.ClusterState = clusterState
 	connection.LastUpdate = time.Now()
+	lastUpdate := connection.LastUpdate
+
+	clusterID := connection.ClusterID
+	previous := m.states[clusterID]
+	m.refreshClusterState(clusterID)
+	m.scheduleStalenessEviction(clusterID)
+	m.stageStates()
+	merged := m.states[clusterID]
+	m.mu.Unlock()
 
 	// Rebuild read-optimized indexes
-	m.rebuildIndexes()
+	m.publishSnapshot()
+
+	changes := clusterChanges(clusterID, previous, merged, resourceChanges, edgeTracksChanges, lastUpdate)
+	m.changes.record(clusterID, changes)
+
+	sizeBytes := proto.Size(merged)
+	resourceCounts := telemetry.CountResources(merged)
+	m.recordEvent(Event{
+		Type:         EventSynced,
+		ClusterID:    clusterID,
+		ConnectionID: connectionID,
+		Message:      fmt.Sprintf("synced %d services", len(clusterState.Services)),
+	})
+	m.recordChangeEvents(changes)
+
+	// Skip recording if the cluster disconnected meanwhile, so its metrics are not resurrected
+	m.mu.RLock()
+	if m.isClusterConnected(clusterID) {
+		telemetry.RecordClusterState(clusterID, sizeBytes, resourceCounts)
+	}
+	m.mu.RUnlock()
 
 	m.logger.Debug("cluster state updated",
 		"cluster_id", clusterID,
-		"services", len(clusterState.Services),
-		"last_update", connection.LastUpdate)
+		"connection_id", connectionID,
+		"services", len(merged.Services),
+		"size_bytes", sizeBytes,
+		"resource_counts", resourceCounts,
+		"last_update", lastUpdate)
 
 	return nil
 }
 
 // UpdateCapabilities updates the capabilities for a connection
-func (m *Manager) UpdateCapabilities(clusterID string, capabilities *v1alpha1.EdgeCapabilities) error {
+func (m *Manager) UpdateCapabilities(connectionID string, capabilities *v1alpha1.EdgeCapabilities) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	connection, exists := m.connections[clusterID]
+	connection, exists := m.connections[connectionID]
 	if !exists {
-		return fmt.Errorf("no active connection for cluster %s", clusterID)
+		return fmt.Errorf("no active connection for cluster %s", connectionID)
 	}
 
 	connection.Capabilities = capabilities
 	connection.LastUpdate = time.Now()
 
 	m.logger.Debug("connection capabilities updated",
-		"cluster_id", clusterID,
+		"cluster_id", connection.ClusterID,
+		"connection_id", connectionID,
 		"metrics_enabled", capabilities != nil && capabilities.MetricsEnabled)
 
 	return nil
 }
 
-// GetClusterState returns the current cluster state for a cluster
-func (m *Manager) GetClusterState(clusterID string) (*v1alpha1.ClusterState, error) {
+// UpdateEdgeVersion records the version reported by the edge process for a connection
+func (m *Manager) UpdateEdgeVersion(connectionID, edgeVersion string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	connection, exists := m.connections[connectionID]
+	if !exists {
+		return fmt.Errorf("no active connection for cluster %s", connectionID)
+	}
+
+	connection.EdgeVersion = edgeVersion
+
+	return nil
+}
+
+// RequestResync asks the edges for a cluster to send their cluster state immediately
+func (m *Manager) RequestResync(clusterID, reason string) error {
+	message := &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_ResyncRequest{
+			ResyncRequest: &v1alpha1.ResyncRequest{
+				Reason: reason,
+			},
+		},
+	}
+
+	m.mu.RLock()
+	clusterConnections := m.clusterConnections(clusterID)
+	var unsupported error
+	for _, connection := range clusterConnections {
+		if unsupported == nil {
+			unsupported = supportsRequest(connection, message)
+		}
+	}
+	m.mu.RUnlock()
+
+	if len(clusterConnections) == 0 {
+		return fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	if unsupported != nil {
+		return unsupported
+	}
+
+	// Every shard of the cluster resyncs its own namespaces
+	for _, connection := range clusterConnections {
+		if err := connection.Send(message); err != nil {
+			m.logger.Error("failed to send message to cluster", "cluster_id", clusterID, "connection_id", connection.ID, "error", err)
+			return fmt.Errorf("failed to send message to cluster %s: %w", clusterID, err)
+		}
+	}
+
+	m.logger.Info("cluster resync requested", "cluster_id", clusterID, "reason", reason)
+	m.recordEvent(Event{
+		Type:      EventResyncRequested,
+		ClusterID: clusterID,
+		Message:   "resync " + reason,
+	})
+	return nil
+}
+
+// DisconnectCluster signals the connections for a cluster to terminate and notifies their edges
+func (m *Manager) DisconnectCluster(clusterID, reason string) error {
+	m.mu.Lock()
+	clusterConnections := m.clusterConnections(clusterID)
+	var terminated []*Connection
+	for _, connection := range clusterConnections {
+		if m.terminate(connection) {
+			terminated = append(terminated, connection)
+		}
+	}
+	m.mu.Unlock()
+
+	if len(clusterConnections) == 0 {
+		return fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+
+	// A slow or partitioned edge must not block the manager while it is notified
+	for _, connection := range terminated {
+		m.notifyDisconnect(connection, reason)
+	}
+
+	m.logger.Info("connection disconnect requested",
+		"cluster_id", clusterID,
+		"reason", reason)
+
+	return nil
+}
+
+// terminate signals a connection to terminate and reports whether it was not already terminating. The caller
+// must hold mu, and notify the edge with notifyDisconnect after releasing it.
+func (m *Manager) terminate(connection *Connection) bool {
+	select {
+	case <-connection.disconnect:
+		// Already disconnecting
+		return false
+	default:
+	}
+
+	close(connection.disconnect)
+	return true
+}
+
+// notifyDisconnect tells the edge of a terminated connection why it was disconnected. The caller must not
+// hold mu, since the send blocks until the edge receives the notice or the stream fails.
+func (m *Manager) notifyDisconnect(connection *Connection, reason string) {
+	if connection.Stream == nil {
+		return
+	}
+
+	notice := &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_Error{
+			Error: &v1alpha1.ErrorMessage{
+				ErrorCode:    "DISCONNECTED",
+				ErrorMessage: reason,
+			},
+		},
+	}
+	if err := connection.Send(notice); err != nil {
+		m.logger.Warn("failed to notify cluster of disconnect", "cluster_id", connection.ClusterID, "error", err)
+	}
+}
+
+// Disconnected returns a channel that is closed when a connection is asked to terminate.
+// A nil channel is returned if the connection does not exist.
+func (m *Manager) Disconnected(connectionID string) <-chan struct{} {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	connection, exists := m.connections[clusterID]
+	connection, exists := m.connections[connectionID]
 	if !exists {
+		return nil
+	}
+
+	return connection.disconnect
+}
+
+// GetClusterState returns the current cluster state for a cluster
+func (m *Manager) GetClusterState(clusterID string) (*v1alpha1.ClusterState, error) {
+	clusterState, connected := m.snapshot.Load().states[clusterID]
+	if !connected {
 		return nil, fmt.Errorf("no active connection for cluster %s", clusterID)
 	}
 
-	if connection.ClusterState == nil {
+	if clusterState == nil {
 		return nil, fmt.Errorf("no cluster state available for cluster %s", clusterID)
 	}
 
-	return connection.ClusterState, nil
+	return clusterState, nil
 }
 
-// GetAllClusterStates returns cluster states for all connected clusters
-func (m *Manager) GetAllClusterStates() map[string]*v1alpha1.ClusterState {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+// GetSelectorIndex returns the selector index of the current cluster state for a cluster
+func (m *Manager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	snapshot := m.snapshot.Load()
+	if _, connected := snapshot.states[clusterID]; !connected {
+		return nil, fmt.Errorf("no active connection for cluster %s", clusterID)
+	}
+
+	index, exists := snapshot.selectors[clusterID]
+	if !exists {
+		return nil, fmt.Errorf("no cluster state available for cluster %s", clusterID)
+	}
 
-	result := make(map[string]*v1alpha1.ClusterState)
+	return index, nil
+}
 
-	for clusterID, connection := range m.connections {
-		if connection.ClusterState != nil {
-			result[clusterID] = connection.ClusterState
+// GetAllClusterStates returns cluster states for all connected clusters, and the last states of
+// disconnected clusters that are retained
+func (m *Manager) GetAllClusterStates() map[string]*v1alpha1.ClusterState {
+	states := m.snapshot.Load().states
+	result := make(map[string]*v1alpha1.ClusterState, len(states))
+
+	for clusterID, clusterState := range states {
+		if clusterState != nil {
+			result[clusterID] = clusterState
 		}
 	}
 
 	return result
 }
 
-// GetConnectionInfo returns information about active connections
+// GetConnectionInfo returns information about active connections, combining the shards of each cluster, and
+// about disconnected clusters whose last state is retained, marked stale
 func (m *Manager) GetConnectionInfo() map[string]ConnectionInfo {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	result := make(map[string]ConnectionInfo)
+	states := m.snapshot.Load().states
 
-	for clusterID, connection := range m.connections {
-		serviceCount := 0
-		if connection.ClusterState != nil {
-			serviceCount = len(connection.ClusterState.Services)
+	for _, clusterID := range m.clusterIDs() {
+		result[clusterID] = m.clusterConnectionInfo(clusterID, states[clusterID])
+	}
+	for clusterID, stale := range m.stale {
+		result[clusterID] = stale.info
+	}
+
+	return result
+}
+
+// clusterConnectionInfo describes the connections of a connected cluster and its merged cluster state.
+// The caller must hold mu.
+func (m *Manager) clusterConnectionInfo(clusterID string, state *v1alpha1.ClusterState) ConnectionInfo {
+	clusterConnections := m.clusterConnections(clusterID)
+	first := clusterConnections[0]
+
+	info := ConnectionInfo{
+		ClusterID:      clusterID,
+		RemoteAddr:     first.RemoteAddr,
+		ConnectedAt:    first.ConnectedAt,
+		LastUpdate:     first.LastUpdate,
+		StateReceived:  true,
+		MetricsEnabled: first.Capabilities != nil && first.Capabilities.MetricsEnabled,
+		Capabilities:   first.Capabilities,
+		EdgeVersion:    first.EdgeVersion,
+		Leader:         first.Leader,
+		Evicted:        m.evicted[clusterID],
+	}
+	for _, connection := range clusterConnections {
+		if connection.ConnectedAt.Before(info.ConnectedAt) {
+			info.ConnectedAt = connection.ConnectedAt
+		}
+		if connection.LastUpdate.After(info.LastUpdate) {
+			info.LastUpdate = connection.LastUpdate
 		}
+		// A sharded cluster is only complete once every shard has sent its state
+		info.StateReceived = info.StateReceived && connection.ClusterState != nil
+		if connection.Shard != nil {
+			info.Shards = append(info.Shards, connection.Shard)
+		}
+		info.CapabilityGaps = appendCapabilityGaps(info.CapabilityGaps, protocol.Gaps(protocol.Capabilities(), connection.Protocol))
+	}
 
-		result[clusterID] = ConnectionInfo{
-			ClusterID:      clusterID,
-			ConnectedAt:    connection.ConnectedAt,
-			LastUpdate:     connection.LastUpdate,
-			ServiceCount:   serviceCount,
-			StateReceived:  connection.ClusterState != nil,
-			MetricsEnabled: connection.Capabilities != nil && connection.Capabilities.MetricsEnabled,
+	info.LastSync = info.LastUpdate
+	if state != nil {
+		info.ServiceCount = len(state.Services)
+		info.ResourceCounts = telemetry.CountResources(state)
+		info.IstioCNI = state.IstioCni
+		info.SidecarInjector = state.GetIstioControlPlaneConfig().GetSidecarInjector()
+		info.SidecarInjection = state.SidecarInjection
+		info.OutboundPolicy = state.GetIstioControlPlaneConfig().GetOutboundTrafficPolicyMode()
+		info.Labels = state.ClusterLabels
+		if md := state.SyncMetadata; md != nil {
+			if md.CollectedAt != nil {
+				info.LastSync = md.CollectedAt.AsTime()
+			}
+			info.SyncDuration = time.Duration(md.CollectionDurationMs) * time.Millisecond
 		}
 	}
 
-	return result
+	return info
+}
+
+// appendCapabilityGaps adds the gaps of another edge of a cluster, skipping those already reported
+func appendCapabilityGaps(gaps, edgeGaps []*typesv1alpha1.CapabilityGap) []*typesv1alpha1.CapabilityGap {
+	for _, gap := range edgeGaps {
+		if !slices.ContainsFunc(gaps, func(existing *typesv1alpha1.CapabilityGap) bool {
+			return existing.Capability == gap.Capability && existing.Value == gap.Value
+		}) {
+			gaps = append(gaps, gap)
+		}
+	}
+	return gaps
 }
 
 // IsClusterConnected checks if a cluster has an active connection
 func (m *Manager) IsClusterConnected(clusterID string) bool {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	_, exists := m.connections[clusterID]
-	return exists
+	snapshot := m.snapshot.Load()
+	_, served := snapshot.states[clusterID]
+	_, stale := snapshot.stale[clusterID]
+	return served && !stale
 }
 
-// GetActiveClusterCount returns the number of active cluster connections
+// GetActiveClusterCount returns the number of clusters with active connections
 func (m *Manager) GetActiveClusterCount() int {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+	snapshot := m.snapshot.Load()
+	return len(snapshot.states) - len(snapshot.stale)
+}
 
-	return len(m.connections)
+// supportsRequest checks that the edge of a connection advertises support for the request a message
+// carries. The caller must hold mu.
+func supportsRequest(connection *Connection, message *v1alpha1.ConnectResponse) error {
+	request := protocol.RequestName(message)
+	if protocol.SupportsRequest(connection.Protocol, request) {
+		return nil
+	}
+	return &UnsupportedRequestError{
+		ClusterID:   connection.ClusterID,
+		Request:     request,
+		EdgeVersion: connection.EdgeVersion,
+	}
 }
 
-// SendMessageToCluster sends a message to a specific cluster
+// SendMessageToCluster sends a message to a specific cluster. Every edge of a sharded cluster can reach all
+// of its pods, so the message is sent to the first shard.
 func (m *Manager) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
 	m.mu.RLock()
-	connection, exists := m.connections[clusterID]
+	clusterConnections := m.clusterConnections(clusterID)
+	var unsupported error
+	if len(clusterConnections) > 0 {
+		unsupported = supportsRequest(clusterConnections[0], message)
+	}
 	m.mu.RUnlock()
 
-	if !exists {
+	if len(clusterConnections) == 0 {
 		return fmt.Errorf("cluster %s is not connected", clusterID)
 	}
+	if unsupported != nil {
+		return unsupported
+	}
 
-	if err := connection.Stream.Send(message); err != nil {
+	if err := clusterConnections[0].Send(message); err != nil {
 		m.logger.Error("failed to send message to cluster", "cluster_id", clusterID, "error", err)
 		return fmt.Errorf("failed to send message to cluster %s: %w", clusterID, err)
 	}
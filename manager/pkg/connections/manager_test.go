@@ -15,11 +15,27 @@
 package connections
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/protocol"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestManager_RegisterConnection(t *testing.T) {
@@ -51,17 +67,181 @@ func TestManager_UnregisterConnection(t *testing.T) {
 	assert.True(t, manager.IsClusterConnected("cluster1"), "Expected cluster to be connected")
 
 	// Unregister connection
-	manager.UnregisterConnection("cluster1")
+	manager.UnregisterConnection("cluster1", nil)
 
 	// Verify connection is removed
 	assert.False(t, manager.IsClusterConnected("cluster1"), "Expected cluster to be disconnected")
 
 	// Unregister non-existent connection (should not panic)
 	assert.NotPanics(t, func() {
-		manager.UnregisterConnection("non-existent")
+		manager.UnregisterConnection("non-existent", nil)
 	}, "Unregistering non-existent connection should not panic")
 }
 
+// fakeConnectStream is an edge stream that records the messages sent to it
+type fakeConnectStream struct {
+	v1alpha1.ManagerService_ConnectServer
+	addr string // Address of the edge, if known
+	sent []*v1alpha1.ConnectResponse
+}
+
+func (s *fakeConnectStream) Context() context.Context {
+	if s.addr == "" {
+		return context.Background()
+	}
+	addr, _ := net.ResolveTCPAddr("tcp", s.addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+}
+
+func (s *fakeConnectStream) Send(resp *v1alpha1.ConnectResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+// registerLeader registers a connection for cluster1 from an elected edge replica
+func registerLeader(manager *Manager, stream v1alpha1.ManagerService_ConnectServer, leader *v1alpha1.LeaderElection) error {
+	_, err := manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{ClusterId: "cluster1", LeaderElection: leader}, stream)
+	return err
+}
+
+func TestManager_RegisterEdgeConnection_leaderFailover(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+
+	first := &fakeConnectStream{addr: "10.0.0.1:40000"}
+	leader := &v1alpha1.LeaderElection{Identity: "edge-0", Term: 1}
+	assert.NoError(t, registerLeader(manager, first, leader))
+	assert.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{Services: []*v1alpha1.Service{{Name: "svc", Namespace: "default"}}}))
+	firstDisconnected := manager.Disconnected("cluster1")
+
+	// A connection without leadership cannot replace a leader
+	assert.Error(t, manager.RegisterConnection("cluster1", &fakeConnectStream{}))
+
+	// Another replica from the same term cannot replace the leader
+	assert.Error(t, registerLeader(manager, &fakeConnectStream{addr: "10.0.0.2:40000"}, &v1alpha1.LeaderElection{Identity: "edge-1", Term: 1}))
+
+	// A later term cannot replace a leader that is still syncing
+	assert.Error(t, registerLeader(manager, &fakeConnectStream{addr: "10.0.0.2:40000"}, &v1alpha1.LeaderElection{Identity: "edge-1", Term: 2}))
+
+	// The leader of a later term replaces a silent connection and keeps its state until it syncs
+	manager.mu.Lock()
+	manager.connections["cluster1"].LastUpdate = time.Now().Add(-2 * leaderTakeoverGrace)
+	manager.mu.Unlock()
+	second := &fakeConnectStream{addr: "10.0.0.2:40000"}
+	assert.NoError(t, registerLeader(manager, second, &v1alpha1.LeaderElection{Identity: "edge-1", Term: 2}))
+	select {
+	case <-firstDisconnected:
+	default:
+		t.Fatal("expected the previous leader's connection to be terminated")
+	}
+	if assert.Len(t, first.sent, 1) {
+		assert.Equal(t, "DISCONNECTED", first.sent[0].GetError().GetErrorCode())
+	}
+	state, err := manager.GetClusterState("cluster1")
+	assert.NoError(t, err)
+	assert.Len(t, state.Services, 1)
+	assert.Equal(t, "edge-1", manager.GetConnectionInfo()["cluster1"].Leader.GetIdentity())
+
+	// The replaced connection closing does not remove its successor
+	assert.False(t, manager.UnregisterConnection("cluster1", first))
+	assert.True(t, manager.IsClusterConnected("cluster1"))
+
+	// Another host claiming the leader's identity cannot replace it
+	assert.Error(t, registerLeader(manager, &fakeConnectStream{addr: "10.0.0.3:40000"}, &v1alpha1.LeaderElection{Identity: "edge-1", Term: 2}))
+
+	// The same replica reconnecting replaces its own stale connection
+	third := &fakeConnectStream{addr: "10.0.0.2:40001"}
+	assert.NoError(t, registerLeader(manager, third, &v1alpha1.LeaderElection{Identity: "edge-1", Term: 2}))
+
+	assert.True(t, manager.UnregisterConnection("cluster1", third))
+	assert.False(t, manager.IsClusterConnected("cluster1"))
+}
+
+func TestManager_RegisterEdgeConnection_shards(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+
+	register := func(shard *v1alpha1.NamespaceShard) (string, error) {
+		return manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{ClusterId: "cluster1", Shard: shard}, &fakeConnectStream{})
+	}
+
+	first, err := register(&v1alpha1.NamespaceShard{Namespaces: []string{"payments", "bookinfo"}})
+	require.NoError(t, err)
+	assert.Equal(t, "cluster1#bookinfo,payments", first)
+	second, err := register(&v1alpha1.NamespaceShard{Namespaces: []string{"istio-system"}})
+	require.NoError(t, err)
+
+	// Shards cannot overlap, duplicate a connected shard, mix kinds or be mixed with an unsharded edge
+	_, err = register(&v1alpha1.NamespaceShard{Namespaces: []string{"bookinfo"}})
+	assert.ErrorContains(t, err, "namespace bookinfo already belongs to shard bookinfo,payments")
+	_, err = register(&v1alpha1.NamespaceShard{Namespaces: []string{"istio-system"}})
+	assert.ErrorContains(t, err, "already has an active connection")
+	_, err = register(&v1alpha1.NamespaceShard{Index: 0, Count: 2})
+	assert.Error(t, err)
+	assert.Error(t, manager.RegisterConnection("cluster1", &fakeConnectStream{}))
+
+	// The shards are merged into one cluster
+	assert.Equal(t, 1, manager.GetActiveClusterCount())
+	require.NoError(t, manager.UpdateClusterState(first, &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+		IstioCni: &typesv1alpha1.IstioCNIStatus{Installed: true, ReadyNodes: 3, CniPods: 4},
+		SidecarInjection: &typesv1alpha1.SidecarInjectionStatus{
+			Webhooks:   []*typesv1alpha1.InjectionWebhook{{Configuration: "istio-sidecar-injector", Name: "namespace.sidecar-injector.istio.io"}},
+			Namespaces: []*typesv1alpha1.NamespaceInjection{{Namespace: "bookinfo", Revision: "default"}},
+		},
+		SyncMetadata: &v1alpha1.SyncMetadata{
+			CollectedAt:          timestamppb.New(time.Unix(200, 0)),
+			CollectionDurationMs: 50,
+		},
+	}))
+	assert.False(t, manager.GetConnectionInfo()["cluster1"].StateReceived, "Expected state to be incomplete until every shard syncs")
+
+	require.NoError(t, manager.UpdateClusterState(second, &v1alpha1.ClusterState{
+		Services:                []*v1alpha1.Service{{Name: "istiod", Namespace: "istio-system"}},
+		IstioControlPlaneConfig: &typesv1alpha1.IstioControlPlaneConfig{RootNamespace: "istio-system"},
+		IstioCni:                &typesv1alpha1.IstioCNIStatus{Installed: true, ReadyNodes: 3, CniPods: 1, IstioInitPods: 2, ReliesOnIstioInit: true},
+		SidecarInjection: &typesv1alpha1.SidecarInjectionStatus{
+			Webhooks:   []*typesv1alpha1.InjectionWebhook{{Configuration: "istio-sidecar-injector", Name: "namespace.sidecar-injector.istio.io"}},
+			Namespaces: []*typesv1alpha1.NamespaceInjection{{Namespace: "istio-system"}},
+		},
+		SyncMetadata: &v1alpha1.SyncMetadata{
+			CollectedAt:          timestamppb.New(time.Unix(100, 0)),
+			CollectionDurationMs: 80,
+		},
+	}))
+
+	state, err := manager.GetClusterState("cluster1")
+	require.NoError(t, err)
+	assert.Len(t, state.Services, 2)
+	assert.Equal(t, "istio-system", state.IstioControlPlaneConfig.RootNamespace)
+	assert.Equal(t, int64(100), state.SyncMetadata.CollectedAt.Seconds, "Expected the merged state to be as old as its oldest shard")
+	assert.Equal(t, int64(80), state.SyncMetadata.CollectionDurationMs)
+	// Pods relying on istio-init or CNI are counted across shards
+	assert.Equal(t, int32(3), state.IstioCni.ReadyNodes)
+	assert.Equal(t, int32(5), state.IstioCni.CniPods)
+	assert.Equal(t, int32(2), state.IstioCni.IstioInitPods)
+	assert.True(t, state.IstioCni.ReliesOnIstioInit)
+	// Every shard explains the injection of its own namespaces
+	assert.Len(t, state.SidecarInjection.Webhooks, 1)
+	require.Len(t, state.SidecarInjection.Namespaces, 2)
+	assert.Equal(t, "bookinfo", state.SidecarInjection.Namespaces[0].Namespace)
+	assert.Equal(t, "istio-system", state.SidecarInjection.Namespaces[1].Namespace)
+	assert.Len(t, manager.ListAggregatedServices("", "cluster1"), 2)
+
+	info := manager.GetConnectionInfo()["cluster1"]
+	assert.True(t, info.StateReceived)
+	assert.Equal(t, 2, info.ServiceCount)
+	assert.Len(t, info.Shards, 2)
+
+	// Losing a shard drops its namespaces from the cluster
+	assert.True(t, manager.UnregisterConnection(first, nil))
+	state, err = manager.GetClusterState("cluster1")
+	require.NoError(t, err)
+	assert.Len(t, state.Services, 1)
+	assert.True(t, manager.IsClusterConnected("cluster1"))
+
+	assert.True(t, manager.UnregisterConnection(second, nil))
+	assert.False(t, manager.IsClusterConnected("cluster1"))
+}
+
 func TestManager_UpdateClusterState(t *testing.T) {
 	logger := logging.For("test")
 	manager := NewManager(logger)
@@ -91,6 +271,286 @@ func TestManager_UpdateClusterState(t *testing.T) {
 	assert.Equal(t, "test-service", retrievedState.Services[0].Name, "Service name should match")
 }
 
+func TestManager_UpdateClusterState_snapshotIsolation(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+	require.NoError(t, manager.RegisterConnection("cluster2", nil))
+	require.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+	}))
+
+	// Hold the index rebuild to simulate aggregating a large update
+	manager.indexMu.Lock()
+	staged := manager.pending.Load().version
+	updated := make(chan error, 1)
+	go func() {
+		updated <- manager.UpdateClusterState("cluster2", &v1alpha1.ClusterState{
+			Services: []*v1alpha1.Service{{Name: "ratings", Namespace: "bookinfo"}},
+		})
+	}()
+	assert.Eventually(t, func() bool {
+		return manager.pending.Load().version > staged
+	}, time.Second, time.Millisecond)
+
+	// Readers are not blocked and consistently see the state before the update
+	_, err := manager.GetClusterState("cluster2")
+	assert.Error(t, err)
+	assert.Len(t, manager.GetAllClusterStates(), 1)
+	assert.Len(t, manager.ListAggregatedServices("", ""), 1)
+	assert.Zero(t, manager.GetConnectionInfo()["cluster2"].ServiceCount)
+
+	// Once published, every reader sees the state after the update
+	manager.indexMu.Unlock()
+	require.NoError(t, <-updated)
+	_, err = manager.GetClusterState("cluster2")
+	assert.NoError(t, err)
+	assert.Len(t, manager.GetAllClusterStates(), 2)
+	assert.Len(t, manager.ListAggregatedServices("", ""), 2)
+	assert.Equal(t, 1, manager.GetConnectionInfo()["cluster2"].ServiceCount)
+}
+
+func TestManager_ListChanges(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+
+	controlPlane := func(revision, version string) *typesv1alpha1.IstioControlPlaneConfig {
+		return &typesv1alpha1.IstioControlPlaneConfig{
+			Revision:        revision,
+			SidecarInjector: &typesv1alpha1.SidecarInjectorConfig{Version: version},
+		}
+	}
+	require.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			{Name: "reviews", Namespace: "bookinfo"},
+			{Name: "details", Namespace: "bookinfo"},
+		},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews"}},
+			{Name: "details", Namespace: "bookinfo", Hosts: []string{"details"}},
+		},
+		IstioControlPlaneConfig: controlPlane("", "1.25.2"),
+	}))
+
+	// The first sync of a cluster is not compared with anything
+	assert.Empty(t, manager.ListChanges("", time.Time{}, 0))
+
+	since := time.Now()
+	require.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			// Instance changes are not reported
+			{Name: "reviews", Namespace: "bookinfo", Instances: []*v1alpha1.ServiceInstance{{Ip: "10.0.0.1"}}},
+			{Name: "ratings", Namespace: "bookinfo"},
+		},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews", "reviews.bookinfo"}},
+			{Name: "details", Namespace: "bookinfo", Hosts: []string{"details"}},
+		},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{Name: "reviews", Namespace: "bookinfo", Host: "reviews"},
+		},
+		IstioControlPlaneConfig: controlPlane("canary", "1.26.0"),
+	}))
+
+	changes := manager.ListChanges("cluster1", time.Time{}, 0)
+	summaries := make([]string, len(changes))
+	for i, change := range changes {
+		assert.Equal(t, "cluster1", change.ClusterID)
+		assert.False(t, change.ObservedAt.Before(since))
+		summaries[i] = fmt.Sprintf("%d %s %s/%s", change.Type, change.Kind, change.Namespace, change.Name)
+	}
+	assert.Equal(t, []string{
+		fmt.Sprintf("%d DestinationRule bookinfo/reviews", ChangeCreated),
+		fmt.Sprintf("%d Service bookinfo/details", ChangeDeleted),
+		fmt.Sprintf("%d Service bookinfo/ratings", ChangeCreated),
+		fmt.Sprintf("%d VirtualService bookinfo/reviews", ChangeUpdated),
+		fmt.Sprintf("%d ControlPlane /istiod", ChangeUpdated),
+	}, summaries)
+	assert.Equal(t, "revision default -> canary, version 1.25.2 -> 1.26.0", changes[4].Description)
+
+	// Changes are filtered by cluster, time and limit
+	assert.Empty(t, manager.ListChanges("cluster2", time.Time{}, 0))
+	assert.Empty(t, manager.ListChanges("", time.Now(), 0))
+	assert.Len(t, manager.ListChanges("", since, 2), 2)
+}
+
+func TestManager_ListChanges_edgeResourceChanges(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	connectionID, err := manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{
+		ClusterId: "cluster1",
+		Protocol:  protocol.Capabilities(),
+	}, &fakeConnectStream{})
+	require.NoError(t, err)
+
+	observedAt := time.Now().Add(-time.Minute)
+	resourceChange := &v1alpha1.ResourceChange{
+		Kind:            "VirtualService",
+		Namespace:       "bookinfo",
+		Name:            "reviews",
+		Type:            v1alpha1.ResourceChangeType_RESOURCE_CHANGE_TYPE_UPDATED,
+		OldHash:         "old",
+		NewHash:         "new",
+		ResourceVersion: "42",
+		Generation:      3,
+		ObservedAt:      timestamppb.New(observedAt),
+		Actor:           "argocd-controller",
+	}
+
+	// Changes the edge reports are recorded on the first sync too
+	require.NoError(t, manager.UpdateClusterState(connectionID, &v1alpha1.ClusterState{
+		VirtualServices: []*typesv1alpha1.VirtualService{{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews"}}},
+		ResourceChanges: []*v1alpha1.ResourceChange{resourceChange},
+	}))
+	changes := manager.ListChanges("cluster1", time.Time{}, 0)
+	require.Len(t, changes, 1)
+	assert.Equal(t, Change{
+		ClusterID:       "cluster1",
+		ObservedAt:      observedAt.UTC(),
+		Type:            ChangeUpdated,
+		Kind:            "VirtualService",
+		Namespace:       "bookinfo",
+		Name:            "reviews",
+		Actor:           "argocd-controller",
+		ResourceVersion: "42",
+		Generation:      3,
+		OldHash:         "old",
+		NewHash:         "new",
+	}, changes[0])
+	state, err := manager.GetClusterState("cluster1")
+	require.NoError(t, err)
+	assert.Empty(t, state.ResourceChanges, "resource changes are not kept with the state")
+
+	// Istio resources are not diffed for edges that report their changes, services still are
+	require.NoError(t, manager.UpdateClusterState(connectionID, &v1alpha1.ClusterState{
+		Services:        []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+		VirtualServices: []*typesv1alpha1.VirtualService{{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews.bookinfo"}}},
+	}))
+	changes = manager.ListChanges("cluster1", time.Time{}, 0)
+	require.Len(t, changes, 2)
+	assert.Equal(t, references.KindService, changes[0].Kind)
+	assert.Equal(t, ChangeCreated, changes[0].Type)
+}
+
+func TestManager_ListEvents(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	start := time.Now()
+
+	stream := &fakeConnectStream{}
+	connectionID, err := manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{ClusterId: "cluster1"}, stream)
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateClusterState(connectionID, &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+	}))
+	require.NoError(t, manager.UpdateClusterState(connectionID, &v1alpha1.ClusterState{}))
+	require.NoError(t, manager.RequestResync("cluster1", "requested via admin API"))
+	require.NoError(t, manager.RegisterConnection("cluster2", nil))
+	manager.UnregisterConnection(connectionID, stream)
+
+	// Events are listed newest first
+	events := manager.ListEvents("cluster1", time.Time{}, time.Time{}, 0)
+	summaries := make([]string, len(events))
+	for i, event := range events {
+		assert.Equal(t, "cluster1", event.ClusterID)
+		assert.False(t, event.Time.Before(start))
+		summaries[i] = fmt.Sprintf("%s %s", event.Type, event.Message)
+	}
+	assert.Equal(t, []string{
+		"disconnected edge disconnected after 0s",
+		"resync_requested resync requested via admin API",
+		"changed Service bookinfo/reviews deleted",
+		"synced synced 0 services",
+		"synced synced 1 services",
+		"connected edge connected",
+	}, summaries)
+	require.NotNil(t, events[2].Change)
+	assert.Equal(t, ChangeDeleted, events[2].Change.Type)
+
+	// Events are filtered by cluster, time range and limit
+	assert.Len(t, manager.ListEvents("", time.Time{}, time.Time{}, 0), 7)
+	assert.Len(t, manager.ListEvents("", time.Time{}, time.Time{}, 2), 2)
+	assert.Empty(t, manager.ListEvents("", time.Now(), time.Time{}, 0))
+	assert.Empty(t, manager.ListEvents("", time.Time{}, start.Add(-time.Second), 0))
+}
+
+func TestEventLog_ringBuffer(t *testing.T) {
+	log := newEventLog(logging.For("test"))
+	require.NoError(t, log.configure(EventLogPolicy{Capacity: 3}))
+	start := time.Now()
+	for i := range 5 {
+		log.record(Event{Time: start.Add(time.Duration(i) * time.Second), ClusterID: "cluster1", Message: fmt.Sprint(i)})
+	}
+
+	// The oldest events are dropped once the log is full
+	events := log.list("", time.Time{}, time.Time{}, 0)
+	require.Len(t, events, 3)
+	assert.Equal(t, []string{"4", "3", "2"}, []string{events[0].Message, events[1].Message, events[2].Message})
+	events = log.list("", start.Add(2*time.Second), start.Add(3*time.Second), 0)
+	require.Len(t, events, 2)
+	assert.Equal(t, "3", events[0].Message)
+
+	// Shrinking the log keeps the most recent events
+	require.NoError(t, log.configure(EventLogPolicy{Capacity: 1}))
+	events = log.list("", time.Time{}, time.Time{}, 0)
+	require.Len(t, events, 1)
+	assert.Equal(t, "4", events[0].Message)
+}
+
+func TestEventLog_persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	start := time.Now().UTC()
+
+	log := newEventLog(logging.For("test"))
+	require.NoError(t, log.configure(EventLogPolicy{Capacity: 3, Path: path}))
+	for i := range 7 {
+		log.record(Event{
+			Time:      start.Add(time.Duration(i) * time.Second),
+			Type:      EventChanged,
+			ClusterID: "cluster1",
+			Message:   fmt.Sprint(i),
+			Change:    &Change{ClusterID: "cluster1", Type: ChangeCreated, Kind: "VirtualService", Name: fmt.Sprint(i)},
+		})
+	}
+
+	// The file is compacted once it holds as many dropped events as the log holds
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 4)
+
+	// A truncated line, such as one cut short by a crash, is skipped when the events are loaded
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"time":`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	restarted := newEventLog(logging.For("test"))
+	require.NoError(t, restarted.configure(EventLogPolicy{Capacity: 3, Path: path}))
+	events := restarted.list("", time.Time{}, time.Time{}, 0)
+	require.Len(t, events, 3)
+	assert.Equal(t, "6", events[0].Message)
+	assert.True(t, events[0].Time.Equal(start.Add(6*time.Second)))
+	assert.Equal(t, EventChanged, events[0].Type)
+	require.NotNil(t, events[0].Change)
+	assert.Equal(t, "6", events[0].Change.Name)
+	assert.Equal(t, "4", events[2].Message)
+}
+
+func TestChangeFeed_record(t *testing.T) {
+	feed := newChangeFeed()
+	start := time.Now()
+	for i := range maxChangesPerCluster + 10 {
+		feed.record("cluster1", []Change{{ClusterID: "cluster1", ObservedAt: start.Add(time.Duration(i) * time.Second), Name: fmt.Sprint(i)}})
+	}
+	feed.record("cluster2", []Change{{ClusterID: "cluster2", ObservedAt: start.Add(time.Hour), Name: "latest"}})
+
+	// The oldest changes of a cluster are dropped, and changes are listed newest first across clusters
+	assert.Len(t, feed.list("cluster1", time.Time{}, 0), maxChangesPerCluster)
+	changes := feed.list("", time.Time{}, 3)
+	require.Len(t, changes, 3)
+	assert.Equal(t, "latest", changes[0].Name)
+	assert.Equal(t, fmt.Sprint(maxChangesPerCluster+9), changes[1].Name)
+	assert.Equal(t, "10", feed.list("cluster1", time.Time{}, 0)[maxChangesPerCluster-1].Name)
+}
+
 func TestManager_GetClusterState(t *testing.T) {
 	logger := logging.For("test")
 	manager := NewManager(logger)
@@ -123,6 +583,32 @@ func TestManager_GetClusterState(t *testing.T) {
 	assert.Len(t, retrievedState.Services, 1, "Expected 1 service in retrieved state")
 }
 
+func TestManager_GetSelectorIndex(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+
+	_, err := manager.GetSelectorIndex("cluster1")
+	assert.Error(t, err, "Expected error for unconnected cluster")
+
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+	require.NoError(t, manager.RegisterConnection("cluster2", nil))
+	_, err = manager.GetSelectorIndex("cluster1")
+	assert.Error(t, err, "Expected error before the first sync")
+
+	state := &v1alpha1.ClusterState{
+		Sidecars: []*typesv1alpha1.Sidecar{{Name: "default", Namespace: "bookinfo"}},
+	}
+	require.NoError(t, manager.UpdateClusterState("cluster1", state))
+	index, err := manager.GetSelectorIndex("cluster1")
+	require.NoError(t, err)
+	assert.Same(t, state, index.ClusterState())
+
+	// Syncing another cluster keeps the index of an unchanged cluster
+	require.NoError(t, manager.UpdateClusterState("cluster2", &v1alpha1.ClusterState{}))
+	unchanged, err := manager.GetSelectorIndex("cluster1")
+	require.NoError(t, err)
+	assert.Same(t, index, unchanged)
+}
+
 func TestManager_GetAllClusterStates(t *testing.T) {
 	logger := logging.For("test")
 	manager := NewManager(logger)
@@ -195,6 +681,86 @@ func TestManager_GetConnectionInfo(t *testing.T) {
 	assert.False(t, clusterInfo.LastUpdate.IsZero(), "Expected LastUpdate to be set")
 }
 
+func TestManager_GetConnectionInfo_capabilityGaps(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+
+	register := func(namespace string, edge *v1alpha1.ProtocolCapabilities) string {
+		connectionID, err := manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{
+			ClusterId: "cluster1",
+			Shard:     &v1alpha1.NamespaceShard{Namespaces: []string{namespace}},
+			Protocol:  edge,
+		}, &fakeConnectStream{})
+		require.NoError(t, err)
+		require.NoError(t, manager.UpdateClusterState(connectionID, &v1alpha1.ClusterState{}))
+		return connectionID
+	}
+
+	// An edge of the manager's version has no gaps
+	current := register("bookinfo", protocol.Capabilities())
+	assert.Empty(t, manager.GetConnectionInfo()["cluster1"].CapabilityGaps)
+
+	// The gaps of older edges of a cluster are reported once
+	register("payments", nil)
+	register("istio-system", nil)
+	gaps := manager.GetConnectionInfo()["cluster1"].CapabilityGaps
+	require.Len(t, gaps, 1)
+	assert.Equal(t, protocol.CapabilityProtocol, gaps[0].Capability)
+
+	manager.UnregisterConnection(current, nil)
+	assert.Len(t, manager.GetConnectionInfo()["cluster1"].CapabilityGaps, 1)
+}
+
+func TestManager_GetConnectionInfo_SyncMetadata(t *testing.T) {
+	logger := logging.For("test")
+	manager := NewManager(logger)
+
+	err := manager.RegisterConnection("cluster1", nil)
+	assert.NoError(t, err, "Expected no error for registration")
+
+	err = manager.UpdateEdgeVersion("cluster1", "v1.2.3")
+	assert.NoError(t, err, "Expected no error for edge version update")
+
+	collectedAt := time.Now().Add(-10 * time.Second).Truncate(time.Second)
+	clusterState := &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{
+			{Name: "service1", Namespace: "default"},
+		},
+		Gateways: []*typesv1alpha1.Gateway{
+			{Name: "gateway1", Namespace: "default"},
+			{Name: "gateway2", Namespace: "default"},
+		},
+		IstioControlPlaneConfig: &typesv1alpha1.IstioControlPlaneConfig{
+			SidecarInjector:           &typesv1alpha1.SidecarInjectorConfig{ConfigMap: "istio-sidecar-injector", Version: "1.26.2"},
+			OutboundTrafficPolicyMode: typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY,
+		},
+		SidecarInjection: &typesv1alpha1.SidecarInjectionStatus{
+			Namespaces: []*typesv1alpha1.NamespaceInjection{{Namespace: "default", Revision: "canary"}},
+		},
+		SyncMetadata: &v1alpha1.SyncMetadata{
+			CollectedAt:          timestamppb.New(collectedAt),
+			CollectionDurationMs: 250,
+		},
+	}
+
+	err = manager.UpdateClusterState("cluster1", clusterState)
+	assert.NoError(t, err, "Expected no error for cluster state update")
+
+	clusterInfo := manager.GetConnectionInfo()["cluster1"]
+	assert.Equal(t, "v1.2.3", clusterInfo.EdgeVersion, "Expected edge version to match")
+	assert.True(t, collectedAt.Equal(clusterInfo.LastSync), "Expected LastSync to come from sync metadata")
+	assert.Equal(t, 250*time.Millisecond, clusterInfo.SyncDuration, "Expected sync duration to match")
+	assert.Equal(t, 1, clusterInfo.ResourceCounts["services"], "Expected 1 service")
+	assert.Equal(t, 2, clusterInfo.ResourceCounts["gateways"], "Expected 2 gateways")
+	assert.Equal(t, 0, clusterInfo.ResourceCounts["virtual_services"], "Expected 0 virtual services")
+	assert.Nil(t, clusterInfo.IstioCNI, "Expected no CNI status when the edge does not report it")
+	assert.Equal(t, "1.26.2", clusterInfo.SidecarInjector.GetVersion(), "Expected the sidecar injector config of the control plane")
+	assert.Equal(t, "canary", clusterInfo.SidecarInjection.GetNamespaces()[0].GetRevision(), "Expected the revision injecting each namespace")
+	assert.Equal(t, typesv1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY, clusterInfo.OutboundPolicy, "Expected the outbound traffic policy of the control plane")
+
+	err = manager.UpdateEdgeVersion("missing", "v1.2.3")
+	assert.Error(t, err, "Expected error for unknown cluster")
+}
+
 func TestManager_GetActiveClusterCount(t *testing.T) {
 	logger := logging.For("test")
 	manager := NewManager(logger)
@@ -215,9 +781,214 @@ func TestManager_GetActiveClusterCount(t *testing.T) {
 	assert.Equal(t, 2, count, "Expected 2 active clusters after registrations")
 
 	// Unregister one connection
-	manager.UnregisterConnection("cluster1")
+	manager.UnregisterConnection("cluster1", nil)
 
 	// Test count after unregistration
 	count = manager.GetActiveClusterCount()
 	assert.Equal(t, 1, count, "Expected 1 active cluster after unregistration")
 }
+
+func TestManager_SendMessageToCluster_unsupportedRequest(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	stream := &fakeConnectStream{}
+	connectionID, err := manager.RegisterEdgeConnection(&v1alpha1.ClusterIdentification{
+		ClusterId: "cluster1",
+		Protocol:  &v1alpha1.ProtocolCapabilities{Requests: []string{protocol.RequestProxyConfig}},
+	}, stream)
+	require.NoError(t, err)
+	require.NoError(t, manager.UpdateEdgeVersion(connectionID, "v0.9.0"))
+
+	// Requests the edge advertises are sent
+	require.NoError(t, manager.SendMessageToCluster("cluster1", &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_ProxyConfigRequest{ProxyConfigRequest: &v1alpha1.ProxyConfigRequest{}},
+	}))
+	assert.Len(t, stream.sent, 1)
+
+	// Others fail without reaching the edge
+	err = manager.SendMessageToCluster("cluster1", &v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_EnvoyAdminRequest{EnvoyAdminRequest: &v1alpha1.EnvoyAdminRequest{}},
+	})
+	var unsupported *UnsupportedRequestError
+	require.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, protocol.RequestEnvoyAdmin, unsupported.Request)
+	assert.EqualError(t, err, "envoy_admin requests are unsupported by the edge of cluster cluster1 (version v0.9.0)")
+	assert.ErrorAs(t, manager.RequestResync("cluster1", "test"), &unsupported)
+	assert.Len(t, stream.sent, 1)
+}
+
+func TestManager_DisconnectCluster(t *testing.T) {
+	logger := logging.For("test")
+	manager := NewManager(logger)
+
+	// Disconnecting an unknown cluster fails
+	err := manager.DisconnectCluster("cluster1", "test")
+	assert.Error(t, err, "Expected error for unknown cluster")
+	assert.Nil(t, manager.Disconnected("cluster1"), "Expected nil channel for unknown cluster")
+
+	err = manager.RegisterConnection("cluster1", nil)
+	assert.NoError(t, err, "Expected no error for registration")
+
+	disconnected := manager.Disconnected("cluster1")
+	assert.NotNil(t, disconnected, "Expected disconnect channel for registered cluster")
+
+	select {
+	case <-disconnected:
+		t.Fatal("Expected disconnect channel to be open before disconnect")
+	default:
+	}
+
+	// Disconnect closes the channel and is idempotent
+	err = manager.DisconnectCluster("cluster1", "test")
+	assert.NoError(t, err, "Expected no error for disconnect")
+	err = manager.DisconnectCluster("cluster1", "test")
+	assert.NoError(t, err, "Expected no error for repeated disconnect")
+
+	select {
+	case <-disconnected:
+	default:
+		t.Fatal("Expected disconnect channel to be closed after disconnect")
+	}
+}
+
+// blockingConnectStream is an edge stream whose sends block until it is released, like a partitioned edge
+type blockingConnectStream struct {
+	fakeConnectStream
+	sending chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingConnectStream) Send(resp *v1alpha1.ConnectResponse) error {
+	s.sending <- struct{}{}
+	<-s.release
+	return s.fakeConnectStream.Send(resp)
+}
+
+func TestManager_DisconnectCluster_slowEdge(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	stream := &blockingConnectStream{sending: make(chan struct{}), release: make(chan struct{})}
+	require.NoError(t, manager.RegisterConnection("cluster1", stream))
+
+	done := make(chan error, 1)
+	go func() { done <- manager.DisconnectCluster("cluster1", "test") }()
+	<-stream.sending
+
+	// The manager keeps serving while the disconnect notice is blocked
+	assert.NoError(t, manager.RegisterConnection("cluster2", nil))
+	assert.Len(t, manager.GetConnectionInfo(), 2)
+	select {
+	case <-manager.Disconnected("cluster1"):
+	default:
+		t.Fatal("Expected disconnect channel to be closed before the edge is notified")
+	}
+
+	// Other sends to the edge wait for the notice
+	resynced := make(chan error, 1)
+	go func() { resynced <- manager.RequestResync("cluster1", "test") }()
+	close(stream.release)
+	<-stream.sending
+	require.NoError(t, <-done)
+	require.NoError(t, <-resynced)
+	if assert.Len(t, stream.sent, 2) {
+		assert.Equal(t, "DISCONNECTED", stream.sent[0].GetError().GetErrorCode())
+		assert.NotNil(t, stream.sent[1].GetResyncRequest())
+	}
+}
+
+func TestManager_UnregisterConnection_staleRetention(t *testing.T) {
+	manager := NewManager(logging.For("test"))
+	manager.SetEvictionPolicy(EvictionPolicy{StaleRetention: time.Hour})
+
+	// A cluster that never synced is not retained
+	require.NoError(t, manager.RegisterConnection("cluster2", nil))
+	manager.UnregisterConnection("cluster2", nil)
+	assert.NotContains(t, manager.GetConnectionInfo(), "cluster2")
+
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+	require.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{
+		Services: []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+	}))
+	require.NoError(t, manager.UpdateEdgeVersion("cluster1", "v1.2.3"))
+	manager.UnregisterConnection("cluster1", nil)
+
+	// The last state keeps being served, marked stale
+	assert.False(t, manager.IsClusterConnected("cluster1"))
+	assert.Equal(t, 0, manager.GetActiveClusterCount())
+	state, err := manager.GetClusterState("cluster1")
+	require.NoError(t, err)
+	assert.Len(t, state.Services, 1)
+	assert.Len(t, manager.ListAggregatedServices("", "cluster1"), 1)
+	info := manager.GetConnectionInfo()["cluster1"]
+	assert.True(t, info.Stale)
+	assert.False(t, info.DisconnectedAt.IsZero())
+	assert.Equal(t, "v1.2.3", info.EdgeVersion)
+	assert.Equal(t, 1, info.ServiceCount)
+	assert.Error(t, manager.RequestResync("cluster1", "test"), "Expected a stale cluster to have no edge to resync")
+
+	// A reconnecting cluster is no longer stale, and is empty until it syncs
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+	assert.True(t, manager.IsClusterConnected("cluster1"))
+	assert.False(t, manager.GetConnectionInfo()["cluster1"].Stale)
+	assert.Empty(t, manager.ListAggregatedServices("", "cluster1"))
+
+	// The retained state is forgotten once the retention expires
+	require.NoError(t, manager.UpdateClusterState("cluster1", &v1alpha1.ClusterState{}))
+	manager.SetEvictionPolicy(EvictionPolicy{StaleRetention: 10 * time.Millisecond})
+	manager.UnregisterConnection("cluster1", nil)
+	assert.Contains(t, manager.GetConnectionInfo(), "cluster1")
+	assert.Eventually(t, func() bool {
+		_, err := manager.GetClusterState("cluster1")
+		return err != nil
+	}, time.Second, 5*time.Millisecond)
+	assert.NotContains(t, manager.GetConnectionInfo(), "cluster1")
+}
+
+func TestManager_EvictionPolicy_maxStaleness(t *testing.T) {
+	evictions := make(chan ClusterEviction, 2)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var eviction ClusterEviction
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&eviction))
+		evictions <- eviction
+	}))
+	defer webhook.Close()
+
+	manager := NewManager(logging.For("test"))
+	manager.SetEvictionPolicy(EvictionPolicy{StaleRetention: time.Hour, MaxStaleness: 50 * time.Millisecond, Webhook: webhook.URL})
+	update := func(clusterID string) {
+		require.NoError(t, manager.UpdateClusterState(clusterID, &v1alpha1.ClusterState{
+			Services: []*v1alpha1.Service{{Name: "reviews", Namespace: "bookinfo"}},
+		}))
+	}
+
+	// A connected cluster that stops syncing is left out of aggregation until it syncs again
+	require.NoError(t, manager.RegisterConnection("cluster1", nil))
+	update("cluster1")
+	eviction := <-evictions
+	assert.Equal(t, "cluster1", eviction.ClusterID)
+	assert.Equal(t, EvictionMaxStaleness, eviction.Reason)
+	assert.True(t, manager.IsClusterConnected("cluster1"))
+	assert.True(t, manager.GetConnectionInfo()["cluster1"].Evicted)
+	assert.Empty(t, manager.ListAggregatedServices("", "cluster1"))
+	_, err := manager.GetClusterState("cluster1")
+	assert.Error(t, err)
+
+	update("cluster1")
+	assert.False(t, manager.GetConnectionInfo()["cluster1"].Evicted)
+	assert.Len(t, manager.ListAggregatedServices("", "cluster1"), 1)
+	manager.UnregisterConnection("cluster1", nil)
+
+	// The retained state of a disconnected cluster is evicted once it exceeds the maximum staleness, even
+	// though its retention has not expired
+	manager.SetEvictionPolicy(EvictionPolicy{StaleRetention: time.Hour, MaxStaleness: time.Second, Webhook: webhook.URL})
+	require.NoError(t, manager.RegisterConnection("cluster2", nil))
+	update("cluster2")
+	manager.UnregisterConnection("cluster2", nil)
+	assert.True(t, manager.GetConnectionInfo()["cluster2"].Stale)
+	for eviction = range evictions {
+		if eviction.ClusterID == "cluster2" {
+			break
+		}
+	}
+	assert.Equal(t, EvictionMaxStaleness, eviction.Reason)
+	assert.NotContains(t, manager.GetConnectionInfo(), "cluster2")
+	assert.Empty(t, manager.ListAggregatedServices("", "cluster2"))
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConnectionID identifies the connection of an edge. It is the cluster ID, qualified by the namespace shard
+// when the cluster is split between several edges.
+func ConnectionID(clusterID string, shard *v1alpha1.NamespaceShard) string {
+	if shard == nil {
+		return clusterID
+	}
+	return clusterID + "#" + ShardName(shard)
+}
+
+// ShardName describes a namespace shard, e.g. "0-of-4" for a hash shard or its namespaces for an explicit shard
+func ShardName(shard *v1alpha1.NamespaceShard) string {
+	if shard.Count > 0 {
+		return fmt.Sprintf("%d-of-%d", shard.Index, shard.Count)
+	}
+	namespaces := slices.Clone(shard.Namespaces)
+	slices.Sort(namespaces)
+	return strings.Join(namespaces, ",")
+}
+
+// shardConflict checks that a connection for a shard of a cluster fits with the cluster's other connections:
+// a cluster is either synced by one edge or split into shards of the same kind that do not overlap.
+// The caller must hold mu.
+func (m *Manager) shardConflict(clusterID, connectionID string, shard *v1alpha1.NamespaceShard) error {
+	for _, other := range m.clusterConnections(clusterID) {
+		if other.ID == connectionID {
+			continue
+		}
+
+		switch {
+		case shard == nil:
+			return fmt.Errorf("cluster %s is already synced by sharded edges", clusterID)
+		case other.Shard == nil:
+			return fmt.Errorf("cluster %s is already synced by an unsharded edge", clusterID)
+		case (shard.Count > 0) != (other.Shard.Count > 0):
+			return fmt.Errorf("cluster %s cannot mix hash and explicit namespace shards", clusterID)
+		case shard.Count > 0 && shard.Count != other.Shard.Count:
+			return fmt.Errorf("cluster %s is already split into %d hash shards", clusterID, other.Shard.Count)
+		}
+
+		for _, namespace := range shard.Namespaces {
+			if slices.Contains(other.Shard.Namespaces, namespace) {
+				return fmt.Errorf("namespace %s already belongs to shard %s of cluster %s", namespace, ShardName(other.Shard), clusterID)
+			}
+		}
+	}
+	return nil
+}
+
+// clusterConnections returns the connections for a cluster, one per shard, ordered by connection ID.
+// The caller must hold mu.
+func (m *Manager) clusterConnections(clusterID string) []*Connection {
+	var result []*Connection
+	for _, connection := range m.connections {
+		if connection.ClusterID == clusterID {
+			result = append(result, connection)
+		}
+	}
+	slices.SortFunc(result, func(a, b *Connection) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+// clusterIDs returns the IDs of the connected clusters. The caller must hold mu.
+func (m *Manager) clusterIDs() []string {
+	var result []string
+	for _, connection := range m.connections {
+		if !slices.Contains(result, connection.ClusterID) {
+			result = append(result, connection.ClusterID)
+		}
+	}
+	return result
+}
+
+// isClusterConnected checks if any shard of a cluster is connected. The caller must hold mu.
+func (m *Manager) isClusterConnected(clusterID string) bool {
+	for _, connection := range m.connections {
+		if connection.ClusterID == clusterID {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshClusterState merges the cluster state of every shard of a cluster that has sent one.
+// The caller must hold mu.
+func (m *Manager) refreshClusterState(clusterID string) {
+	var shards []*v1alpha1.ClusterState
+	for _, connection := range m.clusterConnections(clusterID) {
+		if connection.ClusterState != nil {
+			shards = append(shards, connection.ClusterState)
+		}
+	}
+
+	if len(shards) == 0 {
+		delete(m.states, clusterID)
+		return
+	}
+	m.states[clusterID] = mergeClusterStates(shards)
+}
+
+// mergeClusterStates combines the cluster states of the shards of a cluster. Shards own disjoint
+// namespaces, so their resources are concatenated. Every shard reports the same control plane, and the
+// merged state is as old as its oldest shard.
+func mergeClusterStates(shards []*v1alpha1.ClusterState) *v1alpha1.ClusterState {
+	if len(shards) == 1 {
+		return shards[0]
+	}
+
+	merged := &v1alpha1.ClusterState{}
+	for _, shard := range shards {
+		merged.Services = append(merged.Services, shard.Services...)
+		merged.DestinationRules = append(merged.DestinationRules, shard.DestinationRules...)
+		merged.EnvoyFilters = append(merged.EnvoyFilters, shard.EnvoyFilters...)
+		merged.RequestAuthentications = append(merged.RequestAuthentications, shard.RequestAuthentications...)
+		merged.Gateways = append(merged.Gateways, shard.Gateways...)
+		merged.Sidecars = append(merged.Sidecars, shard.Sidecars...)
+		merged.VirtualServices = append(merged.VirtualServices, shard.VirtualServices...)
+		merged.PeerAuthentications = append(merged.PeerAuthentications, shard.PeerAuthentications...)
+		merged.AuthorizationPolicies = append(merged.AuthorizationPolicies, shard.AuthorizationPolicies...)
+		merged.WasmPlugins = append(merged.WasmPlugins, shard.WasmPlugins...)
+		merged.ServiceEntries = append(merged.ServiceEntries, shard.ServiceEntries...)
+		merged.ServiceExports = append(merged.ServiceExports, shard.ServiceExports...)
+		merged.ServiceImports = append(merged.ServiceImports, shard.ServiceImports...)
+
+		if merged.IstioControlPlaneConfig == nil {
+			merged.IstioControlPlaneConfig = shard.IstioControlPlaneConfig
+		}
+
+		// Every shard reports the same CNI agent, but counts the pods of its own namespaces
+		if cni := shard.IstioCni; cni != nil {
+			if merged.IstioCni == nil {
+				merged.IstioCni = proto.Clone(cni).(*typesv1alpha1.IstioCNIStatus)
+			} else {
+				merged.IstioCni.IstioInitPods += cni.IstioInitPods
+				merged.IstioCni.CniPods += cni.CniPods
+				merged.IstioCni.ReliesOnIstioInit = merged.IstioCni.ReliesOnIstioInit || cni.ReliesOnIstioInit
+			}
+		}
+
+		// Every shard reports the same webhooks, but explains the injection of its own namespaces
+		if injection := shard.SidecarInjection; injection != nil {
+			if merged.SidecarInjection == nil {
+				merged.SidecarInjection = &typesv1alpha1.SidecarInjectionStatus{Webhooks: injection.Webhooks}
+			}
+			merged.SidecarInjection.Namespaces = append(merged.SidecarInjection.Namespaces, injection.Namespaces...)
+		}
+
+		// Shards are usually labelled alike, but the labels of every shard are kept
+		for key, value := range shard.ClusterLabels {
+			if merged.ClusterLabels == nil {
+				merged.ClusterLabels = make(map[string]string)
+			}
+			merged.ClusterLabels[key] = value
+		}
+
+		if md := shard.SyncMetadata; md != nil {
+			if merged.SyncMetadata == nil {
+				merged.SyncMetadata = &v1alpha1.SyncMetadata{CollectedAt: md.CollectedAt, CollectionDurationMs: md.CollectionDurationMs}
+				continue
+			}
+			if md.CollectedAt != nil && (merged.SyncMetadata.CollectedAt == nil || md.CollectedAt.AsTime().Before(merged.SyncMetadata.CollectedAt.AsTime())) {
+				merged.SyncMetadata.CollectedAt = md.CollectedAt
+			}
+			merged.SyncMetadata.CollectionDurationMs = max(merged.SyncMetadata.CollectionDurationMs, md.CollectionDurationMs)
+		}
+	}
+	if merged.SidecarInjection != nil {
+		slices.SortFunc(merged.SidecarInjection.Namespaces, func(a, b *typesv1alpha1.NamespaceInjection) int {
+			return strings.Compare(a.Namespace, b.Namespace)
+		})
+	}
+	return merged
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+)
+
+// stateSnapshot is an immutable view of the connected clusters, their merged cluster states and the
+// indexes built from them, including the retained states of disconnected clusters. Readers load it
+// without locking and never observe an update that is only partially applied: the states and indexes
+// of a snapshot always belong to the same version.
+type stateSnapshot struct {
+	version   uint64
+	states    map[string]*v1alpha1.ClusterState // cluster_id -> merged cluster state, nil until the first sync
+	stale     map[string]time.Time              // cluster_id -> when the cluster disconnected, for retained states
+	indexes   *ReadOptimizedIndexes
+	selectors map[string]*filters.SelectorIndex // cluster_id -> selector index of the merged cluster state
+}
+
+// pendingStates are the latest cluster states, staged for the next snapshot
+type pendingStates struct {
+	version uint64
+	states  map[string]*v1alpha1.ClusterState
+	stale   map[string]time.Time
+}
+
+// stageStates stages a copy of the current cluster states, and the retained states of disconnected
+// clusters, for the next snapshot. The caller must hold mu.
+func (m *Manager) stageStates() {
+	clusterIDs := m.clusterIDs()
+	states := make(map[string]*v1alpha1.ClusterState, len(clusterIDs)+len(m.stale))
+	for _, clusterID := range clusterIDs {
+		// Connected clusters evicted for staleness have no state until their edges sync again
+		if m.evicted[clusterID] {
+			states[clusterID] = nil
+			continue
+		}
+		states[clusterID] = m.states[clusterID]
+	}
+	stale := make(map[string]time.Time, len(m.stale))
+	for clusterID, cluster := range m.stale {
+		states[clusterID] = cluster.state
+		stale[clusterID] = cluster.info.DisconnectedAt
+	}
+	m.pending.Store(&pendingStates{version: m.pending.Load().version + 1, states: states, stale: stale})
+}
+
+// publishSnapshot indexes the staged cluster states and atomically publishes them in a new snapshot.
+// It must be called without m.mu held, so that connection bookkeeping is not blocked while large
+// clusters are aggregated. Concurrent publishes are serialized and each one indexes the latest staged
+// states, so snapshots only move forward and converge on the most recent states.
+func (m *Manager) publishSnapshot() {
+	m.indexMu.Lock()
+	defer m.indexMu.Unlock()
+
+	pending := m.pending.Load()
+	current := m.snapshot.Load()
+	if pending.version <= current.version {
+		// Already published by a concurrent caller
+		return
+	}
+
+	m.snapshot.Store(&stateSnapshot{
+		version:   pending.version,
+		states:    pending.states,
+		stale:     pending.stale,
+		indexes:   m.rebuildIndexes(current.indexes, pending.states),
+		selectors: rebuildSelectorIndexes(current, pending.states),
+	})
+}
+
+// rebuildSelectorIndexes indexes the selectors of each cluster state, reusing the index of the current
+// snapshot for clusters that did not sync since
+func rebuildSelectorIndexes(current *stateSnapshot, states map[string]*v1alpha1.ClusterState) map[string]*filters.SelectorIndex {
+	selectors := make(map[string]*filters.SelectorIndex, len(states))
+	for clusterID, state := range states {
+		if state == nil {
+			continue
+		}
+		if index, exists := current.selectors[clusterID]; exists && index.ClusterState() == state {
+			selectors[clusterID] = index
+			continue
+		}
+		selectors[clusterID] = filters.NewSelectorIndex(state)
+	}
+	return selectors
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"sync"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+)
+
+// EdgeStream serializes the sends on the stream of an edge connection. A gRPC stream does not support
+// concurrent sends, and the connection handler, resync and disconnect requests, and proxied queries all send
+// to the edge.
+type EdgeStream struct {
+	v1alpha1.ManagerService_ConnectServer
+
+	sendMu sync.Mutex
+}
+
+// NewEdgeStream wraps a stream so its sends are serialized. A stream that is already wrapped is returned as is.
+func NewEdgeStream(stream v1alpha1.ManagerService_ConnectServer) *EdgeStream {
+	if edgeStream, ok := stream.(*EdgeStream); ok {
+		return edgeStream
+	}
+	return &EdgeStream{ManagerService_ConnectServer: stream}
+}
+
+// Send sends a message to the edge, waiting for any send in progress to finish
+func (s *EdgeStream) Send(message *v1alpha1.ConnectResponse) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.ManagerService_ConnectServer.Send(message)
+}
@@ -15,6 +15,7 @@
 package connections
 
 import (
+	"fmt"
 	"time"
 
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
@@ -23,23 +24,45 @@ import (
 
 // Connection represents an active connection from an edge process
 type Connection struct {
+	ID           string // Connection ID, see ConnectionID
 	ClusterID    string
+	RemoteAddr   string
 	ConnectedAt  time.Time
 	LastUpdate   time.Time
 	Stream       backendv1alpha1.ManagerService_ConnectServer
 	ClusterState *backendv1alpha1.ClusterState
 	Capabilities *backendv1alpha1.EdgeCapabilities
+	EdgeVersion  string
+	Leader       *backendv1alpha1.LeaderElection       // Set when the edge replica was elected leader
+	Shard        *backendv1alpha1.NamespaceShard       // Set when the edge syncs a shard of the cluster's namespaces
+	Protocol     *backendv1alpha1.ProtocolCapabilities // Set when the edge negotiates protocol capabilities
+
+	// disconnect is closed when the connection should be forcibly terminated
+	disconnect chan struct{}
+	// sender serializes the sends on Stream
+	sender *EdgeStream
+}
+
+// Send sends a message to the edge of the connection. Sends are serialized with every other send on the
+// connection's stream.
+func (c *Connection) Send(message *backendv1alpha1.ConnectResponse) error {
+	return c.sender.Send(message)
 }
 
 // AggregatedService represents a service consolidated across multiple clusters
 type AggregatedService struct {
-	ID          string // namespace:service-name
-	Name        string
-	Namespace   string
-	Instances   []*AggregatedServiceInstance            // All instances across clusters
-	ClusterMap  map[string][]*AggregatedServiceInstance // cluster_id -> instances
-	ClusterIPs  map[string]string                       // cluster_id -> cluster IP
-	ExternalIPs map[string]string                       // cluster_id -> external IP
+	ID            string // namespace:service-name
+	Name          string
+	Namespace     string
+	Instances     []*AggregatedServiceInstance            // All instances across clusters
+	ClusterMap    map[string][]*AggregatedServiceInstance // cluster_id -> instances
+	ClusterIPs    map[string]string                       // cluster_id -> cluster IP
+	ExternalIPs   map[string]string                       // cluster_id -> external IP
+	ExternalNames map[string]string                       // cluster_id -> external hostname of an ExternalName service
+
+	// Clusters exporting and importing the service with the Multi-Cluster Services API, in cluster order
+	ExportedClusters []string
+	ImportedClusters []string
 }
 
 // Container represents a container running in a pod
@@ -49,24 +72,35 @@ type Container struct {
 	Status       string
 	Ready        bool
 	RestartCount int32
+	ImageDigest  string
 }
 
 // AggregatedServiceInstance represents a service instance with cluster context
 type AggregatedServiceInstance struct {
-	InstanceID     string // cluster_id:namespace:pod_name
-	IP             string
-	PodName        string
-	Namespace      string
-	ClusterName    string
-	EnvoyPresent   bool
-	Containers     []Container
-	PodStatus      string
-	NodeName       string
-	CreatedAt      string
-	Labels         map[string]string
-	Annotations    map[string]string
-	IsEnvoyPresent bool
-	ProxyMode      typesv1alpha1.ProxyMode // Istio proxy mode for this instance
+	InstanceID        string // cluster_id:namespace:pod_name
+	IP                string
+	PodName           string
+	Namespace         string
+	ClusterName       string
+	EnvoyPresent      bool
+	Containers        []Container
+	PodStatus         string
+	NodeName          string
+	CreatedAt         string
+	Labels            map[string]string
+	Annotations       map[string]string
+	IsEnvoyPresent    bool
+	ProxyMode         typesv1alpha1.ProxyMode           // Istio proxy mode for this instance
+	Policies          *backendv1alpha1.WorkloadPolicies // Policies matched by the edge, nil if the edge does not match them
+	InitContainers    []Container
+	ServiceAccount    string
+	Tolerations       []*typesv1alpha1.Toleration
+	Locality          *typesv1alpha1.LocalityInfo // Region and zone of the instance's node, nil if unknown
+	ZoneHints         []string                    // Zones the EndpointSlice hints the instance for
+	LifecyclePhase    typesv1alpha1.PodLifecyclePhase
+	DeletionTimestamp string // RFC 3339 time the pod was deleted, empty unless terminating
+	CanonicalService  string // Istio canonical service the pod's telemetry is reported under
+	CanonicalRevision string
 }
 
 // ReadOptimizedIndexes contains read-optimized data structures
@@ -80,10 +114,43 @@ type ReadOptimizedIndexes struct {
 
 // ConnectionInfo provides information about an active connection
 type ConnectionInfo struct {
-	ClusterID      string
-	ConnectedAt    time.Time
-	LastUpdate     time.Time
-	ServiceCount   int
-	StateReceived  bool // Whether the connection has received a full cluster state
-	MetricsEnabled bool // Whether this edge supports metrics collection
+	ClusterID        string
+	RemoteAddr       string
+	ConnectedAt      time.Time
+	LastUpdate       time.Time
+	ServiceCount     int
+	StateReceived    bool // Whether the connection has received a full cluster state
+	MetricsEnabled   bool // Whether this edge supports metrics collection
+	Capabilities     *backendv1alpha1.EdgeCapabilities
+	EdgeVersion      string
+	Leader           *backendv1alpha1.LeaderElection         // Leadership of the connected edge replica, if elected
+	Shards           []*backendv1alpha1.NamespaceShard       // Namespace shards of the connected edges, if sharded
+	LastSync         time.Time                               // When the edge collected the most recent cluster state
+	SyncDuration     time.Duration                           // How long the edge took to collect the most recent cluster state
+	ResourceCounts   map[string]int                          // resource type -> count in the most recent cluster state
+	IstioCNI         *typesv1alpha1.IstioCNIStatus           // Istio CNI status of the most recent cluster state, nil if not reported
+	SidecarInjector  *typesv1alpha1.SidecarInjectorConfig    // Sidecar injection config of the active control plane, nil if not reported
+	SidecarInjection *typesv1alpha1.SidecarInjectionStatus   // Injection webhooks and the revision injecting each namespace, nil if not reported
+	OutboundPolicy   typesv1alpha1.OutboundTrafficPolicyMode // Mesh config outboundTrafficPolicy mode of the active control plane, unspecified if not reported
+	Stale            bool                                    // Whether the cluster's edges disconnected and its last state is served until its retention expires
+	DisconnectedAt   time.Time                               // When the last edge of a stale cluster disconnected
+	Evicted          bool                                    // Whether the connected cluster is left out of aggregation because its state exceeded the maximum staleness
+	CapabilityGaps   []*typesv1alpha1.CapabilityGap          // Features the manager supports that the cluster's edges do not
+	Labels           map[string]string                       // Metadata labels configured for the cluster on its edges
+}
+
+// UnsupportedRequestError is returned when a request is sent to a cluster whose edge version does not
+// advertise support for it, rather than waiting for a response that never arrives
+type UnsupportedRequestError struct {
+	ClusterID   string
+	Request     string // Name of the request, see protocol.Requests
+	EdgeVersion string
+}
+
+func (e *UnsupportedRequestError) Error() string {
+	edgeVersion := e.EdgeVersion
+	if edgeVersion == "" {
+		edgeVersion = "unknown"
+	}
+	return fmt.Sprintf("%s requests are unsupported by the edge of cluster %s (version %s)", e.Request, e.ClusterID, edgeVersion)
 }
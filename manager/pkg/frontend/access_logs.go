@@ -0,0 +1,165 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultAccessLogsLimit and maxAccessLogsLimit bound the number of access log entries listed
+	defaultAccessLogsLimit = 100
+	maxAccessLogsLimit     = 1000
+)
+
+// responseCodeFilterRegexp matches a response code filter such as "404" or a class such as "5xx"
+var responseCodeFilterRegexp = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+// AccessLogsService implements the frontend AccessLogsService
+type AccessLogsService struct {
+	frontendv1alpha1.UnimplementedAccessLogsServiceServer
+	connectionManager  providers.ReadOptimizedConnectionManager
+	accessLogsProvider providers.AccessLogsProvider
+	logger             *slog.Logger
+}
+
+// NewAccessLogsService creates a new access logs service
+func NewAccessLogsService(connectionManager providers.ReadOptimizedConnectionManager, accessLogsProvider providers.AccessLogsProvider, logger *slog.Logger) *AccessLogsService {
+	return &AccessLogsService{
+		connectionManager:  connectionManager,
+		accessLogsProvider: accessLogsProvider,
+		logger:             logger,
+	}
+}
+
+// ListAccessLogs returns the most recent access logs of a service across every connected cluster with a logs
+// backend, or of a single instance from its cluster's logs backend
+func (a *AccessLogsService) ListAccessLogs(ctx context.Context, req *frontendv1alpha1.ListAccessLogsRequest) (*frontendv1alpha1.ListAccessLogsResponse, error) {
+	a.logger.Debug("listing access logs", "service", req.ServiceName, "namespace", req.Namespace, "instance_id", req.InstanceId)
+
+	ctx, err := selectClusters(ctx, a.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ServiceName == "" || req.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "service_name and namespace are required")
+	}
+	if req.StartTime == nil || req.EndTime == nil || !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required and end_time must be after start_time")
+	}
+	for _, code := range req.ResponseCodes {
+		if !responseCodeFilterRegexp.MatchString(code) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid response code %q, must be a code such as 404 or a class such as 5xx", code)
+		}
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAccessLogsLimit
+	}
+	if limit < 0 || limit > maxAccessLogsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxAccessLogsLimit)
+	}
+
+	// An instance is searched for in its own cluster only
+	var podName string
+	var clusterIDs []string
+	if req.InstanceId != "" {
+		clusterID, namespace, pod, err := parseInstanceID(req.InstanceId)
+		if err != nil {
+			return nil, err
+		}
+		if namespace != req.Namespace {
+			return nil, status.Errorf(codes.InvalidArgument, "instance %s is not in namespace %s", req.InstanceId, req.Namespace)
+		}
+		info, exists := scopedConnections(ctx, a.connectionManager).GetConnectionInfo()[clusterID]
+		if !exists || !info.Capabilities.GetAccessLogsEnabled() {
+			return nil, status.Errorf(codes.FailedPrecondition, "cluster %s has no logs backend configured", clusterID)
+		}
+		podName = pod
+		clusterIDs = []string{clusterID}
+	} else {
+		for clusterID, info := range scopedConnections(ctx, a.connectionManager).GetConnectionInfo() {
+			if info.Capabilities.GetAccessLogsEnabled() {
+				clusterIDs = append(clusterIDs, clusterID)
+			}
+		}
+		if len(clusterIDs) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "no connected cluster has a logs backend configured")
+		}
+		sort.Strings(clusterIDs)
+	}
+
+	query := &frontendv1alpha1.ListAccessLogsRequest{
+		ServiceName:   req.ServiceName,
+		Namespace:     req.Namespace,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		ResponseCodes: req.ResponseCodes,
+		PathPrefix:    req.PathPrefix,
+		Limit:         limit,
+	}
+
+	// Search the logs backends of all clusters in parallel
+	results := make([]*typesv1alpha1.ServiceAccessLogs, len(clusterIDs))
+	errs := make([]error, len(clusterIDs))
+	var wg sync.WaitGroup
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = a.accessLogsProvider.ListAccessLogs(ctx, clusterID, podName, query)
+		}()
+	}
+	wg.Wait()
+
+	response := &frontendv1alpha1.ListAccessLogsResponse{}
+	for i, clusterID := range clusterIDs {
+		if errs[i] != nil {
+			a.logger.Warn("failed to list access logs from cluster", "cluster_id", clusterID, "error", errs[i])
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve access logs from cluster %s: %v", clusterID, errs[i]))
+			continue
+		}
+		response.ClustersQueried = append(response.ClustersQueried, clusterID)
+		response.Entries = append(response.Entries, results[i].GetEntries()...)
+	}
+
+	sort.SliceStable(response.Entries, func(i, j int) bool {
+		return response.Entries[i].Timestamp.AsTime().After(response.Entries[j].Timestamp.AsTime())
+	})
+	if len(response.Entries) > int(limit) {
+		response.Entries = response.Entries[:limit]
+	}
+
+	a.logger.Debug("listed access logs",
+		"service", req.ServiceName,
+		"namespace", req.Namespace,
+		"entries", len(response.Entries),
+		"clusters_queried", len(response.ClustersQueried),
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockAccessLogsProvider for testing
+type MockAccessLogsProvider struct {
+	mock.Mock
+}
+
+func (m *MockAccessLogsProvider) ListAccessLogs(ctx context.Context, clusterID, podName string, req *frontendv1alpha1.ListAccessLogsRequest) (*types.ServiceAccessLogs, error) {
+	args := m.Called(ctx, clusterID, podName, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.ServiceAccessLogs), args.Error(1)
+}
+
+func listAccessLogsRequest() *frontendv1alpha1.ListAccessLogsRequest {
+	now := time.Now()
+	return &frontendv1alpha1.ListAccessLogsRequest{
+		ServiceName:   "reviews",
+		Namespace:     "bookinfo",
+		StartTime:     timestamppb.New(now.Add(-time.Hour)),
+		EndTime:       timestamppb.New(now),
+		ResponseCodes: []string{"5xx"},
+		PathPrefix:    "/reviews",
+	}
+}
+
+func TestAccessLogsService_ListAccessLogs(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAccessLogsProvider := &MockAccessLogsProvider{}
+	service := NewAccessLogsService(mockConnManager, mockAccessLogsProvider, logging.For("test"))
+
+	logged := &backendv1alpha1.EdgeCapabilities{AccessLogsEnabled: true}
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"east":   {ClusterID: "east", Capabilities: logged},
+		"west":   {ClusterID: "west", Capabilities: logged},
+		"south":  {ClusterID: "south", Capabilities: logged},
+		"nologs": {ClusterID: "nologs"},
+	})
+
+	now := time.Now().Add(-time.Minute)
+	entry := func(pod string, age time.Duration) *types.AccessLogEntry {
+		return &types.AccessLogEntry{PodName: pod, Timestamp: timestamppb.New(now.Add(-age))}
+	}
+	mockAccessLogsProvider.On("ListAccessLogs", mock.Anything, "east", "", mock.Anything).Return(&types.ServiceAccessLogs{ClusterId: "east", Entries: []*types.AccessLogEntry{
+		entry("east-1", 0),
+		entry("east-2", 2*time.Second),
+	}}, nil)
+	mockAccessLogsProvider.On("ListAccessLogs", mock.Anything, "west", "", mock.Anything).Return(&types.ServiceAccessLogs{ClusterId: "west", Entries: []*types.AccessLogEntry{
+		entry("west-1", time.Second),
+	}}, nil)
+	mockAccessLogsProvider.On("ListAccessLogs", mock.Anything, "south", "", mock.Anything).Return(nil, errors.New("loki query failed"))
+
+	resp, err := service.ListAccessLogs(context.Background(), listAccessLogsRequest())
+	require.NoError(t, err)
+
+	// Entries of all clusters, most recent first
+	var pods []string
+	for _, entry := range resp.Entries {
+		pods = append(pods, entry.PodName)
+	}
+	assert.Equal(t, []string{"east-1", "west-1", "east-2"}, pods)
+	assert.Equal(t, []string{"east", "west"}, resp.ClustersQueried)
+	assert.Equal(t, []string{"failed to retrieve access logs from cluster south: loki query failed"}, resp.Warnings)
+
+	// The filters and default limit are passed on to the edges
+	query := mockAccessLogsProvider.Calls[0].Arguments.Get(3).(*frontendv1alpha1.ListAccessLogsRequest)
+	assert.Equal(t, int32(defaultAccessLogsLimit), query.Limit)
+	assert.Equal(t, []string{"5xx"}, query.ResponseCodes)
+	assert.Equal(t, "/reviews", query.PathPrefix)
+}
+
+func TestAccessLogsService_ListAccessLogsInstance(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAccessLogsProvider := &MockAccessLogsProvider{}
+	service := NewAccessLogsService(mockConnManager, mockAccessLogsProvider, logging.For("test"))
+
+	logged := &backendv1alpha1.EdgeCapabilities{AccessLogsEnabled: true}
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"east": {ClusterID: "east", Capabilities: logged},
+		"west": {ClusterID: "west", Capabilities: logged},
+	})
+	mockAccessLogsProvider.On("ListAccessLogs", mock.Anything, "west", "reviews-v1-abc", mock.Anything).Return(&types.ServiceAccessLogs{ClusterId: "west", Entries: []*types.AccessLogEntry{
+		{PodName: "reviews-v1-abc", Timestamp: timestamppb.Now()},
+	}}, nil)
+
+	req := listAccessLogsRequest()
+	req.InstanceId = "west:bookinfo:reviews-v1-abc"
+	resp, err := service.ListAccessLogs(context.Background(), req)
+	require.NoError(t, err)
+
+	// Only the instance's cluster is searched
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, []string{"west"}, resp.ClustersQueried)
+	mockAccessLogsProvider.AssertNumberOfCalls(t, "ListAccessLogs", 1)
+}
+
+func TestAccessLogsService_ListAccessLogsErrors(t *testing.T) {
+	logged := map[string]connections.ConnectionInfo{
+		"east": {ClusterID: "east", Capabilities: &backendv1alpha1.EdgeCapabilities{AccessLogsEnabled: true}},
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(req *frontendv1alpha1.ListAccessLogsRequest)
+		connections map[string]connections.ConnectionInfo
+		wantCode    codes.Code
+	}{
+		{
+			name:     "missing service name",
+			modify:   func(req *frontendv1alpha1.ListAccessLogsRequest) { req.ServiceName = "" },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "end before start",
+			modify: func(req *frontendv1alpha1.ListAccessLogsRequest) {
+				req.StartTime, req.EndTime = req.EndTime, req.StartTime
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid response code",
+			modify:   func(req *frontendv1alpha1.ListAccessLogsRequest) { req.ResponseCodes = []string{"server-error"} },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "limit too large",
+			modify:   func(req *frontendv1alpha1.ListAccessLogsRequest) { req.Limit = maxAccessLogsLimit + 1 },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "instance in another namespace",
+			modify:      func(req *frontendv1alpha1.ListAccessLogsRequest) { req.InstanceId = "east:default:reviews-v1-abc" },
+			connections: logged,
+			wantCode:    codes.InvalidArgument,
+		},
+		{
+			name:        "instance cluster without logs backend",
+			modify:      func(req *frontendv1alpha1.ListAccessLogsRequest) { req.InstanceId = "west:bookinfo:reviews-v1-abc" },
+			connections: logged,
+			wantCode:    codes.FailedPrecondition,
+		},
+		{
+			name:        "no logs backend",
+			modify:      func(req *frontendv1alpha1.ListAccessLogsRequest) {},
+			connections: map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}},
+			wantCode:    codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConnManager := &MockConnectionManager{}
+			mockConnManager.On("GetConnectionInfo").Return(tt.connections)
+			service := NewAccessLogsService(mockConnManager, &MockAccessLogsProvider{}, logging.For("test"))
+
+			req := listAccessLogsRequest()
+			tt.modify(req)
+			_, err := service.ListAccessLogs(context.Background(), req)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnalysisService implements the frontend AnalysisService
+type AnalysisService struct {
+	frontendv1alpha1.UnimplementedAnalysisServiceServer
+	connectionManager providers.ReadOptimizedConnectionManager
+	analysisProvider  providers.AnalysisProvider
+	logger            *slog.Logger
+}
+
+// NewAnalysisService creates a new analysis service
+func NewAnalysisService(connectionManager providers.ReadOptimizedConnectionManager, analysisProvider providers.AnalysisProvider, logger *slog.Logger) *AnalysisService {
+	return &AnalysisService{
+		connectionManager: connectionManager,
+		analysisProvider:  analysisProvider,
+		logger:            logger,
+	}
+}
+
+// AnalyzeClusters analyzes the configuration of connected clusters and reports findings per cluster
+func (a *AnalysisService) AnalyzeClusters(ctx context.Context, req *frontendv1alpha1.AnalyzeClustersRequest) (*frontendv1alpha1.AnalyzeClustersResponse, error) {
+	a.logger.Debug("analyzing clusters", "cluster_id", req.GetClusterId())
+
+	ctx, err := selectClusters(ctx, a.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ClusterId != nil {
+		if _, exists := scopedConnections(ctx, a.connectionManager).GetConnectionInfo()[req.GetClusterId()]; !exists {
+			return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.GetClusterId())
+		}
+	}
+
+	analyses, err := a.analysisProvider.AnalyzeClusters(ctx, req.GetClusterId())
+	if err != nil {
+		a.logger.Error("failed to analyze clusters", "cluster_id", req.GetClusterId(), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to analyze clusters: %v", err)
+	}
+
+	return &frontendv1alpha1.AnalyzeClustersResponse{
+		Clusters: analyses,
+	}, nil
+}
+
+// DryRunAuthorizationPolicy reports which observed traffic flows a drafted AuthorizationPolicy would deny
+func (a *AnalysisService) DryRunAuthorizationPolicy(ctx context.Context, req *frontendv1alpha1.DryRunAuthorizationPolicyRequest) (*frontendv1alpha1.DryRunAuthorizationPolicyResponse, error) {
+	a.logger.Debug("dry-running authorization policy", "cluster_id", req.ClusterId)
+
+	if req.ClusterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster_id is required")
+	}
+	policy, err := authz.Parse(req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+	}
+
+	if _, exists := scopedConnections(ctx, a.connectionManager).GetConnectionInfo()[req.ClusterId]; !exists {
+		return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.ClusterId)
+	}
+
+	response, err := a.analysisProvider.DryRunAuthorizationPolicy(ctx, req.ClusterId, policy)
+	if err != nil {
+		a.logger.Error("failed to dry-run authorization policy", "cluster_id", req.ClusterId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to dry-run authorization policy: %v", err)
+	}
+
+	return response, nil
+}
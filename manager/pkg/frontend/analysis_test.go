@@ -0,0 +1,138 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MockAnalysisProvider for testing
+type MockAnalysisProvider struct {
+	mock.Mock
+}
+
+func (m *MockAnalysisProvider) AnalyzeClusters(ctx context.Context, clusterID string) ([]*frontendv1alpha1.ClusterAnalysis, error) {
+	args := m.Called(ctx, clusterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*frontendv1alpha1.ClusterAnalysis), args.Error(1)
+}
+
+func (m *MockAnalysisProvider) DryRunAuthorizationPolicy(ctx context.Context, clusterID string, policy *types.AuthorizationPolicy) (*frontendv1alpha1.DryRunAuthorizationPolicyResponse, error) {
+	args := m.Called(ctx, clusterID, policy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*frontendv1alpha1.DryRunAuthorizationPolicyResponse), args.Error(1)
+}
+
+func TestAnalysisService_AnalyzeClusters(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAnalysisProvider := &MockAnalysisProvider{}
+
+	service := NewAnalysisService(mockConnManager, mockAnalysisProvider, logging.For("test"))
+
+	analyses := []*frontendv1alpha1.ClusterAnalysis{{
+		ClusterId: "cluster-1",
+		Findings: []*types.AnalysisFinding{{
+			Code:     "GatewayNoWorkloads",
+			Severity: types.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+			Resource: &types.ResourceRef{ClusterId: "cluster-1", Kind: "Gateway", Namespace: "istio-system", Name: "public"},
+		}},
+	}}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {}})
+	mockAnalysisProvider.On("AnalyzeClusters", mock.Anything, "").Return(analyses, nil)
+	mockAnalysisProvider.On("AnalyzeClusters", mock.Anything, "cluster-1").Return(analyses, nil)
+
+	resp, err := service.AnalyzeClusters(context.Background(), &frontendv1alpha1.AnalyzeClustersRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, analyses, resp.Clusters)
+
+	resp, err = service.AnalyzeClusters(context.Background(), &frontendv1alpha1.AnalyzeClustersRequest{ClusterId: proto.String("cluster-1")})
+	assert.NoError(t, err)
+	assert.Equal(t, analyses, resp.Clusters)
+
+	_, err = service.AnalyzeClusters(context.Background(), &frontendv1alpha1.AnalyzeClustersRequest{ClusterId: proto.String("cluster-2")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockAnalysisProvider.AssertExpectations(t)
+}
+
+func TestAnalysisService_AnalyzeClusters_ProviderError(t *testing.T) {
+	mockAnalysisProvider := &MockAnalysisProvider{}
+	mockAnalysisProvider.On("AnalyzeClusters", mock.Anything, "").Return(nil, errors.New("boom"))
+
+	service := NewAnalysisService(&MockConnectionManager{}, mockAnalysisProvider, logging.For("test"))
+
+	_, err := service.AnalyzeClusters(context.Background(), &frontendv1alpha1.AnalyzeClustersRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAnalysisService_DryRunAuthorizationPolicy(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAnalysisProvider := &MockAnalysisProvider{}
+
+	service := NewAnalysisService(mockConnManager, mockAnalysisProvider, logging.For("test"))
+
+	manifest := `
+apiVersion: security.istio.io/v1
+kind: AuthorizationPolicy
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: reviews
+`
+	expected := &frontendv1alpha1.DryRunAuthorizationPolicyResponse{
+		Policy:         &types.ResourceRef{ClusterId: "cluster-1", Kind: "AuthorizationPolicy", Namespace: "default", Name: "reviews"},
+		FlowsEvaluated: 1,
+	}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {}})
+	mockAnalysisProvider.On("DryRunAuthorizationPolicy", mock.Anything, "cluster-1", mock.MatchedBy(func(policy *types.AuthorizationPolicy) bool {
+		return policy.Name == "reviews" && policy.Namespace == "default" && policy.Selector.MatchLabels["app"] == "reviews"
+	})).Return(expected, nil)
+
+	resp, err := service.DryRunAuthorizationPolicy(context.Background(), &frontendv1alpha1.DryRunAuthorizationPolicyRequest{ClusterId: "cluster-1", Policy: manifest})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+
+	_, err = service.DryRunAuthorizationPolicy(context.Background(), &frontendv1alpha1.DryRunAuthorizationPolicyRequest{ClusterId: "cluster-2", Policy: manifest})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.DryRunAuthorizationPolicy(context.Background(), &frontendv1alpha1.DryRunAuthorizationPolicyRequest{Policy: manifest})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.DryRunAuthorizationPolicy(context.Background(), &frontendv1alpha1.DryRunAuthorizationPolicyRequest{ClusterId: "cluster-1", Policy: "kind: Gateway"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockAnalysisProvider.AssertExpectations(t)
+}
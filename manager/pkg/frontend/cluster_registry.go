@@ -21,7 +21,22 @@ import (
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
 	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultChangesLimit and maxChangesLimit bound the number of changes listed
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+
+	// defaultEventsLimit and maxEventsLimit bound the number of events listed
+	defaultEventsLimit = 100
+	maxEventsLimit     = 1000
 )
 
 // ClusterRegistryService implements the frontend ClusterRegistryService
@@ -43,7 +58,12 @@ func NewClusterRegistryService(connectionManager providers.ReadOptimizedConnecti
 func (c *ClusterRegistryService) ListClusters(ctx context.Context, req *frontendv1alpha1.ListClustersRequest) (*frontendv1alpha1.ListClustersResponse, error) {
 	c.logger.Debug("listing clusters")
 
-	connectionInfos := c.connectionManager.GetConnectionInfo()
+	ctx, err := selectClusters(ctx, c.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionInfos := scopedConnections(ctx, c.connectionManager).GetConnectionInfo()
 	clusters := make([]*frontendv1alpha1.ClusterSyncInfo, 0, len(connectionInfos))
 
 	for _, connInfo := range connectionInfos {
@@ -58,6 +78,201 @@ func (c *ClusterRegistryService) ListClusters(ctx context.Context, req *frontend
 	}, nil
 }
 
+// GetSyncStatus returns sync state information for a single connected cluster
+func (c *ClusterRegistryService) GetSyncStatus(ctx context.Context, req *frontendv1alpha1.GetSyncStatusRequest) (*frontendv1alpha1.GetSyncStatusResponse, error) {
+	c.logger.Debug("getting cluster sync status", "cluster_id", req.ClusterId)
+
+	connInfo, exists := scopedConnections(ctx, c.connectionManager).GetConnectionInfo()[req.ClusterId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.ClusterId)
+	}
+
+	return &frontendv1alpha1.GetSyncStatusResponse{
+		Cluster: convertConnectionInfoToClusterSyncInfo(connInfo),
+	}, nil
+}
+
+// ResyncCluster asks the edge managing a cluster to sync its state immediately
+func (c *ClusterRegistryService) ResyncCluster(ctx context.Context, req *frontendv1alpha1.ResyncClusterRequest) (*frontendv1alpha1.ResyncClusterResponse, error) {
+	c.logger.Debug("resyncing cluster", "cluster_id", req.ClusterId)
+
+	if !scopedConnections(ctx, c.connectionManager).IsClusterConnected(req.ClusterId) {
+		return nil, status.Errorf(codes.NotFound, "cluster %s is not connected", req.ClusterId)
+	}
+
+	if err := scopedConnections(ctx, c.connectionManager).RequestResync(req.ClusterId, "requested via frontend API"); err != nil {
+		c.logger.Error("failed to request cluster resync", "cluster_id", req.ClusterId, "error", err)
+		return nil, status.Errorf(edgeRequestCode(err, codes.Unavailable), "failed to send resync request to cluster %s: %v", req.ClusterId, err)
+	}
+
+	return &frontendv1alpha1.ResyncClusterResponse{}, nil
+}
+
+// ListChanges returns the recent configuration and topology changes of the connected clusters, newest first
+func (c *ClusterRegistryService) ListChanges(ctx context.Context, req *frontendv1alpha1.ListChangesRequest) (*frontendv1alpha1.ListChangesResponse, error) {
+	c.logger.Debug("listing changes", "cluster_id", req.GetClusterId(), "cluster_selector", req.ClusterSelector)
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultChangesLimit
+	}
+	if limit < 0 || limit > maxChangesLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxChangesLimit)
+	}
+
+	ctx, err := selectClusters(ctx, c.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+	changes := scopedConnections(ctx, c.connectionManager).ListChanges(req.GetClusterId(), since, int(limit))
+
+	response := &frontendv1alpha1.ListChangesResponse{
+		Changes: make([]*frontendv1alpha1.Change, 0, len(changes)),
+	}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, convertChange(change))
+	}
+	return response, nil
+}
+
+// convertChange converts a change observed by the connection manager to the frontend API format
+func convertChange(change connections.Change) *frontendv1alpha1.Change {
+	var changeType frontendv1alpha1.ChangeType
+	switch change.Type {
+	case connections.ChangeCreated:
+		changeType = frontendv1alpha1.ChangeType_CHANGE_TYPE_CREATED
+	case connections.ChangeUpdated:
+		changeType = frontendv1alpha1.ChangeType_CHANGE_TYPE_UPDATED
+	case connections.ChangeDeleted:
+		changeType = frontendv1alpha1.ChangeType_CHANGE_TYPE_DELETED
+	}
+
+	return &frontendv1alpha1.Change{
+		ClusterId:       change.ClusterID,
+		ObservedAt:      timestamppb.New(change.ObservedAt),
+		Type:            changeType,
+		Kind:            change.Kind,
+		Namespace:       change.Namespace,
+		Name:            change.Name,
+		Description:     change.Description,
+		Actor:           change.Actor,
+		ResourceVersion: change.ResourceVersion,
+		Generation:      change.Generation,
+		OldHash:         change.OldHash,
+		NewHash:         change.NewHash,
+	}
+}
+
+// ListEvents returns the event log of the connected clusters within a time range, newest first
+func (c *ClusterRegistryService) ListEvents(ctx context.Context, req *frontendv1alpha1.ListEventsRequest) (*frontendv1alpha1.ListEventsResponse, error) {
+	c.logger.Debug("listing events", "cluster_id", req.GetClusterId(), "cluster_selector", req.ClusterSelector)
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultEventsLimit
+	}
+	if limit < 0 || limit > maxEventsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxEventsLimit)
+	}
+
+	var start, end time.Time
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+
+	ctx, err := selectClusters(ctx, c.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	events := scopedConnections(ctx, c.connectionManager).ListEvents(req.GetClusterId(), start, end, int(limit))
+
+	response := &frontendv1alpha1.ListEventsResponse{
+		Events: make([]*frontendv1alpha1.Event, 0, len(events)),
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, convertEvent(event))
+	}
+	return response, nil
+}
+
+// convertEvent converts an event of the connection manager's event log to the frontend API format
+func convertEvent(event connections.Event) *frontendv1alpha1.Event {
+	var eventType frontendv1alpha1.EventType
+	switch event.Type {
+	case connections.EventConnected:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_CONNECTED
+	case connections.EventDisconnected:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_DISCONNECTED
+	case connections.EventSynced:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_SYNCED
+	case connections.EventResyncRequested:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_RESYNC_REQUESTED
+	case connections.EventChanged:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_CHANGED
+	case connections.EventEvicted:
+		eventType = frontendv1alpha1.EventType_EVENT_TYPE_EVICTED
+	}
+
+	converted := &frontendv1alpha1.Event{
+		Time:         timestamppb.New(event.Time),
+		Type:         eventType,
+		ClusterId:    event.ClusterID,
+		ConnectionId: event.ConnectionID,
+		Message:      event.Message,
+	}
+	if event.Change != nil {
+		converted.Change = convertChange(*event.Change)
+	}
+	return converted
+}
+
+// GetCapabilities reports the features available across the connected clusters the request may access
+func (c *ClusterRegistryService) GetCapabilities(ctx context.Context, req *frontendv1alpha1.GetCapabilitiesRequest) (*frontendv1alpha1.GetCapabilitiesResponse, error) {
+	c.logger.Debug("getting capabilities")
+
+	response := &frontendv1alpha1.GetCapabilitiesResponse{
+		AuthMode: frontendv1alpha1.AuthMode_AUTH_MODE_NONE,
+	}
+	if scope := tenancy.FromContext(ctx); scope != nil && len(scope.Tenants) > 0 {
+		response.AuthMode = frontendv1alpha1.AuthMode_AUTH_MODE_TENANT
+		response.Tenants = scope.Tenants
+	}
+
+	// Requests to stale clusters fail because their edges are disconnected, so they provide no features
+	for _, info := range scopedConnections(ctx, c.connectionManager).GetConnectionInfo() {
+		if info.Stale {
+			continue
+		}
+		response.Metrics = response.Metrics || info.MetricsEnabled
+		response.Traces = response.Traces || info.Capabilities.GetTracesEnabled()
+		response.AccessLogs = response.AccessLogs || info.Capabilities.GetAccessLogsEnabled()
+		response.PodLogs = response.PodLogs || !hasRequestGap(info, protocol.RequestPodLogs)
+	}
+	return response, nil
+}
+
+// hasRequestGap returns whether a cluster's edges do not handle a request
+func hasRequestGap(info connections.ConnectionInfo, request string) bool {
+	for _, gap := range info.CapabilityGaps {
+		if gap.Capability == protocol.CapabilityRequest && gap.Value == request {
+			return true
+		}
+	}
+	return false
+}
+
 // convertConnectionInfoToClusterSyncInfo converts a ConnectionInfo to the frontend API format
 func convertConnectionInfoToClusterSyncInfo(connInfo connections.ConnectionInfo) *frontendv1alpha1.ClusterSyncInfo {
 	// Safe conversion from int to int32 to avoid overflow
@@ -71,17 +286,29 @@ func convertConnectionInfoToClusterSyncInfo(connInfo connections.ConnectionInfo)
 	}
 
 	return &frontendv1alpha1.ClusterSyncInfo{
-		ClusterId:      connInfo.ClusterID,
-		ConnectedAt:    connInfo.ConnectedAt.Format(time.RFC3339),
-		LastUpdate:     connInfo.LastUpdate.Format(time.RFC3339),
-		ServiceCount:   serviceCount,
-		SyncStatus:     computeSyncStatus(connInfo),
-		MetricsEnabled: connInfo.MetricsEnabled,
+		ClusterId:                 connInfo.ClusterID,
+		ConnectedAt:               connInfo.ConnectedAt.Format(time.RFC3339),
+		LastUpdate:                connInfo.LastUpdate.Format(time.RFC3339),
+		ServiceCount:              serviceCount,
+		SyncStatus:                computeSyncStatus(connInfo),
+		MetricsEnabled:            connInfo.MetricsEnabled,
+		SyncMetadata:              convertConnectionInfoToSyncMetadata(connInfo),
+		IstioCni:                  connInfo.IstioCNI,
+		SidecarInjector:           connInfo.SidecarInjector,
+		SidecarInjection:          connInfo.SidecarInjection,
+		CapabilityGaps:            connInfo.CapabilityGaps,
+		OutboundTrafficPolicyMode: connInfo.OutboundPolicy,
 	}
 }
 
 // computeSyncStatus determines the sync health based on connection info
 func computeSyncStatus(connInfo connections.ConnectionInfo) frontendv1alpha1.SyncStatus {
+	// The last state of a disconnected cluster is served until its retention expires, and a cluster
+	// evicted for staleness has stopped syncing
+	if connInfo.Stale || connInfo.Evicted {
+		return frontendv1alpha1.SyncStatus_SYNC_STATUS_DISCONNECTED
+	}
+
 	// If no state has been received yet, connection is initializing
 	if !connInfo.StateReceived {
 		return frontendv1alpha1.SyncStatus_SYNC_STATUS_INITIALIZING
@@ -20,11 +20,19 @@ import (
 	"time"
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/protocol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockClusterRegistryConnectionManager for testing
@@ -37,8 +45,14 @@ func (m *MockClusterRegistryConnectionManager) RegisterConnection(clusterID stri
 	return args.Error(0)
 }
 
-func (m *MockClusterRegistryConnectionManager) UnregisterConnection(clusterID string) {
-	m.Called(clusterID)
+func (m *MockClusterRegistryConnectionManager) RegisterEdgeConnection(identification *backendv1alpha1.ClusterIdentification, stream backendv1alpha1.ManagerService_ConnectServer) (string, error) {
+	args := m.Called(identification, stream)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClusterRegistryConnectionManager) UnregisterConnection(clusterID string, stream backendv1alpha1.ManagerService_ConnectServer) bool {
+	m.Called(clusterID, stream)
+	return true
 }
 
 func (m *MockClusterRegistryConnectionManager) UpdateClusterState(clusterID string, clusterState *backendv1alpha1.ClusterState) error {
@@ -56,6 +70,11 @@ func (m *MockClusterRegistryConnectionManager) GetClusterState(clusterID string)
 	return args.Get(0).(*backendv1alpha1.ClusterState), args.Error(1)
 }
 
+func (m *MockClusterRegistryConnectionManager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	args := m.Called(clusterID)
+	return args.Get(0).(*filters.SelectorIndex), args.Error(1)
+}
+
 func (m *MockClusterRegistryConnectionManager) GetAllClusterStates() map[string]*backendv1alpha1.ClusterState {
 	args := m.Called()
 	return args.Get(0).(map[string]*backendv1alpha1.ClusterState)
@@ -76,6 +95,25 @@ func (m *MockClusterRegistryConnectionManager) SendMessageToCluster(clusterID st
 	return args.Error(0)
 }
 
+func (m *MockClusterRegistryConnectionManager) RequestResync(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockClusterRegistryConnectionManager) DisconnectCluster(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockClusterRegistryConnectionManager) Disconnected(clusterID string) <-chan struct{} {
+	return nil
+}
+
+func (m *MockClusterRegistryConnectionManager) UpdateEdgeVersion(clusterID, edgeVersion string) error {
+	args := m.Called(clusterID, edgeVersion)
+	return args.Error(0)
+}
+
 func (m *MockClusterRegistryConnectionManager) ListAggregatedServices(namespace, clusterID string) []*connections.AggregatedService {
 	args := m.Called(namespace, clusterID)
 	return args.Get(0).([]*connections.AggregatedService)
@@ -96,6 +134,16 @@ func (m *MockClusterRegistryConnectionManager) GetConnectionInfo() map[string]co
 	return args.Get(0).(map[string]connections.ConnectionInfo)
 }
 
+func (m *MockClusterRegistryConnectionManager) ListChanges(clusterID string, since time.Time, limit int) []connections.Change {
+	args := m.Called(clusterID, since, limit)
+	return args.Get(0).([]connections.Change)
+}
+
+func (m *MockClusterRegistryConnectionManager) ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event {
+	args := m.Called(clusterID, start, end, limit)
+	return args.Get(0).([]connections.Event)
+}
+
 func TestClusterRegistryService_ListClusters(t *testing.T) {
 	mockConnManager := &MockClusterRegistryConnectionManager{}
 	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
@@ -197,6 +245,216 @@ func TestClusterRegistryService_ListClusters_Empty(t *testing.T) {
 	mockConnManager.AssertExpectations(t)
 }
 
+func TestClusterRegistryService_ResyncCluster(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	mockConnManager.On("IsClusterConnected", "cluster-1").Return(true)
+	mockConnManager.On("RequestResync", "cluster-1", mock.Anything).Return(nil)
+
+	resp, err := service.ResyncCluster(context.Background(), &frontendv1alpha1.ResyncClusterRequest{ClusterId: "cluster-1"})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	mockConnManager.AssertExpectations(t)
+}
+
+func TestClusterRegistryService_ResyncCluster_NotConnected(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	mockConnManager.On("IsClusterConnected", "missing").Return(false)
+
+	resp, err := service.ResyncCluster(context.Background(), &frontendv1alpha1.ResyncClusterRequest{ClusterId: "missing"})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockConnManager.AssertNotCalled(t, "RequestResync", mock.Anything, mock.Anything)
+}
+
+func TestClusterRegistryService_ListChanges(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	since := time.Now().Add(-time.Hour)
+	observedAt := time.Now()
+	mockConnManager.On("ListChanges", "cluster-1", mock.MatchedBy(since.Equal), 50).Return([]connections.Change{
+		{ClusterID: "cluster-1", ObservedAt: observedAt, Type: connections.ChangeDeleted, Kind: "VirtualService", Namespace: "bookinfo", Name: "reviews"},
+		{ClusterID: "cluster-1", ObservedAt: observedAt, Type: connections.ChangeUpdated, Kind: connections.KindControlPlane, Name: "istiod", Description: "version 1.25.2 -> 1.26.0"},
+	})
+
+	clusterID := "cluster-1"
+	resp, err := service.ListChanges(context.Background(), &frontendv1alpha1.ListChangesRequest{
+		ClusterId: &clusterID,
+		Since:     timestamppb.New(since),
+		Limit:     50,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 2)
+	assert.Equal(t, frontendv1alpha1.ChangeType_CHANGE_TYPE_DELETED, resp.Changes[0].Type)
+	assert.Equal(t, "VirtualService", resp.Changes[0].Kind)
+	assert.Equal(t, "bookinfo", resp.Changes[0].Namespace)
+	assert.Equal(t, "reviews", resp.Changes[0].Name)
+	assert.True(t, resp.Changes[0].ObservedAt.AsTime().Equal(observedAt))
+	assert.Equal(t, frontendv1alpha1.ChangeType_CHANGE_TYPE_UPDATED, resp.Changes[1].Type)
+	assert.Equal(t, "version 1.25.2 -> 1.26.0", resp.Changes[1].Description)
+
+	// The limit defaults to 100 and is bounded
+	mockConnManager.On("ListChanges", "", time.Time{}, defaultChangesLimit).Return([]connections.Change{})
+	resp, err = service.ListChanges(context.Background(), &frontendv1alpha1.ListChangesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Changes)
+
+	_, err = service.ListChanges(context.Background(), &frontendv1alpha1.ListChangesRequest{Limit: maxChangesLimit + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClusterRegistryService_ListEvents(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	start := time.Now().Add(-time.Hour)
+	end := time.Now()
+	mockConnManager.On("ListEvents", "cluster-1", mock.MatchedBy(start.Equal), mock.MatchedBy(end.Equal), 50).Return([]connections.Event{
+		{
+			Time:      end,
+			Type:      connections.EventChanged,
+			ClusterID: "cluster-1",
+			Message:   "VirtualService bookinfo/reviews deleted",
+			Change:    &connections.Change{ClusterID: "cluster-1", Type: connections.ChangeDeleted, Kind: "VirtualService", Namespace: "bookinfo", Name: "reviews"},
+		},
+		{Time: start, Type: connections.EventConnected, ClusterID: "cluster-1", ConnectionID: "cluster-1", Message: "edge connected"},
+	})
+
+	clusterID := "cluster-1"
+	resp, err := service.ListEvents(context.Background(), &frontendv1alpha1.ListEventsRequest{
+		ClusterId: &clusterID,
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Limit:     50,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, frontendv1alpha1.EventType_EVENT_TYPE_CHANGED, resp.Events[0].Type)
+	assert.Equal(t, "VirtualService bookinfo/reviews deleted", resp.Events[0].Message)
+	assert.True(t, resp.Events[0].Time.AsTime().Equal(end))
+	require.NotNil(t, resp.Events[0].Change)
+	assert.Equal(t, frontendv1alpha1.ChangeType_CHANGE_TYPE_DELETED, resp.Events[0].Change.Type)
+	assert.Equal(t, frontendv1alpha1.EventType_EVENT_TYPE_CONNECTED, resp.Events[1].Type)
+	assert.Equal(t, "cluster-1", resp.Events[1].ConnectionId)
+	assert.Nil(t, resp.Events[1].Change)
+
+	// The limit defaults to 100 and is bounded, and the range must not be inverted
+	mockConnManager.On("ListEvents", "", time.Time{}, time.Time{}, defaultEventsLimit).Return([]connections.Event{})
+	resp, err = service.ListEvents(context.Background(), &frontendv1alpha1.ListEventsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Events)
+
+	_, err = service.ListEvents(context.Background(), &frontendv1alpha1.ListEventsRequest{Limit: maxEventsLimit + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.ListEvents(context.Background(), &frontendv1alpha1.ListEventsRequest{
+		StartTime: timestamppb.New(end),
+		EndTime:   timestamppb.New(start),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClusterRegistryService_GetCapabilities(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"cluster-1": {
+			ClusterID:      "cluster-1",
+			MetricsEnabled: true,
+			Capabilities:   &backendv1alpha1.EdgeCapabilities{MetricsEnabled: true},
+			CapabilityGaps: []*typesv1alpha1.CapabilityGap{{Capability: protocol.CapabilityRequest, Value: protocol.RequestPodLogs}},
+		},
+		"cluster-2": {
+			ClusterID:    "cluster-2",
+			Capabilities: &backendv1alpha1.EdgeCapabilities{TracesEnabled: true},
+		},
+		"cluster-3": {
+			ClusterID:    "cluster-3",
+			Capabilities: &backendv1alpha1.EdgeCapabilities{AccessLogsEnabled: true},
+			Stale:        true,
+		},
+	})
+
+	resp, err := service.GetCapabilities(context.Background(), &frontendv1alpha1.GetCapabilitiesRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Metrics)
+	assert.True(t, resp.Traces)
+	assert.False(t, resp.AccessLogs, "stale clusters provide no features")
+	assert.True(t, resp.PodLogs)
+	assert.False(t, resp.Ambient)
+	assert.Equal(t, frontendv1alpha1.AuthMode_AUTH_MODE_NONE, resp.AuthMode)
+	assert.Empty(t, resp.Tenants)
+
+	// Tenant requests only see the features of their tenants' clusters
+	tenants := &tenancy.Config{Tenants: []tenancy.Tenant{{Name: "payments", Identities: []string{"alice"}, Clusters: []string{"cluster-1"}}}}
+	ctx := tenancy.WithScope(context.Background(), tenants.ScopeFor("alice"))
+	resp, err = service.GetCapabilities(ctx, &frontendv1alpha1.GetCapabilitiesRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Metrics)
+	assert.False(t, resp.Traces)
+	assert.False(t, resp.PodLogs)
+	assert.Equal(t, frontendv1alpha1.AuthMode_AUTH_MODE_TENANT, resp.AuthMode)
+	assert.Equal(t, []string{"payments"}, resp.Tenants)
+}
+
+func TestClusterRegistryService_GetSyncStatus(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	lastSync := time.Now().Add(-5 * time.Second)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"cluster-1": {
+			ClusterID:      "cluster-1",
+			LastUpdate:     time.Now(),
+			StateReceived:  true,
+			EdgeVersion:    "v1.2.3",
+			LastSync:       lastSync,
+			SyncDuration:   1500 * time.Millisecond,
+			ResourceCounts: map[string]int{"services": 3},
+		},
+	})
+
+	resp, err := service.GetSyncStatus(context.Background(), &frontendv1alpha1.GetSyncStatusRequest{ClusterId: "cluster-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster-1", resp.Cluster.ClusterId)
+	assert.Equal(t, frontendv1alpha1.SyncStatus_SYNC_STATUS_HEALTHY, resp.Cluster.SyncStatus)
+
+	metadata := resp.Cluster.SyncMetadata
+	assert.NotNil(t, metadata)
+	assert.Equal(t, "v1.2.3", metadata.EdgeVersion)
+	assert.Equal(t, lastSync.Format(time.RFC3339), metadata.LastSyncTime)
+	assert.Equal(t, int64(1500), metadata.SyncDurationMs)
+	assert.Equal(t, int32(3), metadata.ResourceCounts["services"])
+
+	mockConnManager.AssertExpectations(t)
+}
+
+func TestClusterRegistryService_GetSyncStatus_NotConnected(t *testing.T) {
+	mockConnManager := &MockClusterRegistryConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{})
+
+	resp, err := service.GetSyncStatus(context.Background(), &frontendv1alpha1.GetSyncStatusRequest{ClusterId: "missing"})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestConvertConnectionInfoToClusterSyncInfo(t *testing.T) {
 	now := time.Now()
 
@@ -319,6 +577,7 @@ func TestComputeSyncStatus(t *testing.T) {
 	tests := []struct {
 		name           string
 		stateReceived  bool
+		stale          bool
 		lastUpdateAgo  time.Duration
 		expectedStatus frontendv1alpha1.SyncStatus
 	}{
@@ -358,12 +617,20 @@ func TestComputeSyncStatus(t *testing.T) {
 			lastUpdateAgo:  10 * time.Minute,
 			expectedStatus: frontendv1alpha1.SyncStatus_SYNC_STATUS_DISCONNECTED,
 		},
+		{
+			name:           "disconnected - last state retained",
+			stateReceived:  true,
+			stale:          true,
+			lastUpdateAgo:  10 * time.Second,
+			expectedStatus: frontendv1alpha1.SyncStatus_SYNC_STATUS_DISCONNECTED,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			connInfo := connections.ConnectionInfo{
 				StateReceived: tt.stateReceived,
+				Stale:         tt.stale,
 				LastUpdate:    now.Add(-tt.lastUpdateAgo),
 			}
 
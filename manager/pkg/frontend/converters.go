@@ -16,22 +16,78 @@ package frontend
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
 
+// convertConnectionInfoToSyncMetadata converts a ConnectionInfo to cluster sync metadata for API responses
+func convertConnectionInfoToSyncMetadata(connInfo connections.ConnectionInfo) *typesv1alpha1.ClusterSyncMetadata {
+	resourceCounts := make(map[string]int32, len(connInfo.ResourceCounts))
+	for resourceType, count := range connInfo.ResourceCounts {
+		if count > math.MaxInt32 {
+			count = math.MaxInt32
+		}
+		resourceCounts[resourceType] = int32(count) // #nosec G115 - bounds checked above
+	}
+
+	metadata := &typesv1alpha1.ClusterSyncMetadata{
+		ClusterId:      connInfo.ClusterID,
+		SyncDurationMs: connInfo.SyncDuration.Milliseconds(),
+		ResourceCounts: resourceCounts,
+		EdgeVersion:    connInfo.EdgeVersion,
+		Evicted:        connInfo.Evicted,
+		Labels:         connInfo.Labels,
+	}
+	if !connInfo.LastSync.IsZero() {
+		metadata.LastSyncTime = connInfo.LastSync.Format(time.RFC3339)
+	}
+	if connInfo.Stale {
+		metadata.Stale = true
+		metadata.DisconnectedAt = connInfo.DisconnectedAt.Format(time.RFC3339)
+	}
+
+	return metadata
+}
+
+// convertProxyConfigSnapshotToFreshness describes how fresh a proxy config snapshot is as of now
+func convertProxyConfigSnapshotToFreshness(snapshot *providers.ProxyConfigSnapshot, now time.Time) *frontendv1alpha1.ProxyConfigFreshness {
+	age := now.Sub(snapshot.FetchedAt)
+	if age < 0 {
+		age = 0
+	}
+
+	return &frontendv1alpha1.ProxyConfigFreshness{
+		FetchedAt:  snapshot.FetchedAt.Format(time.RFC3339),
+		AgeSeconds: int64(age.Seconds()),
+		Cached:     snapshot.Cached,
+	}
+}
+
 // convertAggregatedService converts an AggregatedService to the frontend API format
 func convertAggregatedService(aggService *connections.AggregatedService) *frontendv1alpha1.Service {
 	instances := make([]*frontendv1alpha1.ServiceInstance, 0, len(aggService.Instances))
 
 	// Determine the overall proxy mode for this service
 	serviceProxyMode := typesv1alpha1.ProxyMode_UNKNOWN_PROXY_MODE
+	var zoneSpread map[string]int32
 	for _, aggInstance := range aggService.Instances {
 		instance := convertAggregatedServiceInstance(aggInstance)
 		instances = append(instances, instance)
 
+		if zone := aggInstance.Locality.GetZone(); zone != "" {
+			if zoneSpread == nil {
+				zoneSpread = make(map[string]int32)
+			}
+			zoneSpread[zone]++
+		}
+
 		// Service proxy mode priority: ROUTER > SIDECAR > UNKNOWN_PROXY_MODE
 		if aggInstance.ProxyMode == typesv1alpha1.ProxyMode_ROUTER {
 			serviceProxyMode = typesv1alpha1.ProxyMode_ROUTER
@@ -41,58 +97,181 @@ func convertAggregatedService(aggService *connections.AggregatedService) *fronte
 	}
 
 	return &frontendv1alpha1.Service{
-		Id:          aggService.ID,
-		Name:        aggService.Name,
-		Namespace:   aggService.Namespace,
-		Instances:   instances,
-		ClusterIps:  aggService.ClusterIPs,
-		ExternalIps: aggService.ExternalIPs,
-		ProxyMode:   serviceProxyMode,
+		Id:                aggService.ID,
+		Name:              aggService.Name,
+		Namespace:         aggService.Namespace,
+		Instances:         instances,
+		ClusterIps:        aggService.ClusterIPs,
+		ExternalIps:       aggService.ExternalIPs,
+		ProxyMode:         serviceProxyMode,
+		ExportedClusters:  aggService.ExportedClusters,
+		ImportedClusters:  aggService.ImportedClusters,
+		ZoneSpread:        zoneSpread,
+		ExternalNames:     aggService.ExternalNames,
+		CanonicalServices: canonicalServiceNames(aggService),
+	}
+}
+
+// canonicalServiceNames returns the distinct canonical services of a service's instances, sorted
+func canonicalServiceNames(aggService *connections.AggregatedService) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, instance := range aggService.Instances {
+		if instance.CanonicalService != "" && !seen[instance.CanonicalService] {
+			seen[instance.CanonicalService] = true
+			names = append(names, instance.CanonicalService)
+		}
 	}
+	sort.Strings(names)
+	return names
+}
+
+// groupCanonicalServices groups the instances of services by their canonical service. An instance backing
+// several services is counted once, and instances without a canonical service are left out.
+func groupCanonicalServices(aggServices []*connections.AggregatedService) []*frontendv1alpha1.CanonicalService {
+	type group struct {
+		service   *frontendv1alpha1.CanonicalService
+		services  map[string]bool
+		revisions map[string]bool
+		clusters  map[string]bool
+		instances map[string]bool
+	}
+	groups := make(map[string]*group)
+
+	for _, aggService := range aggServices {
+		for _, instance := range aggService.Instances {
+			if instance.CanonicalService == "" {
+				continue
+			}
+			id := instance.Namespace + ":" + instance.CanonicalService
+			g, exists := groups[id]
+			if !exists {
+				g = &group{
+					service: &frontendv1alpha1.CanonicalService{
+						Id:        id,
+						Name:      instance.CanonicalService,
+						Namespace: instance.Namespace,
+					},
+					services:  make(map[string]bool),
+					revisions: make(map[string]bool),
+					clusters:  make(map[string]bool),
+					instances: make(map[string]bool),
+				}
+				groups[id] = g
+			}
+			g.services[aggService.ID] = true
+			if instance.CanonicalRevision != "" {
+				g.revisions[instance.CanonicalRevision] = true
+			}
+			g.clusters[instance.ClusterName] = true
+			g.instances[instance.InstanceID] = true
+		}
+	}
+
+	canonicalServices := make([]*frontendv1alpha1.CanonicalService, 0, len(groups))
+	for _, g := range groups {
+		g.service.Services = sortedKeys(g.services)
+		g.service.Revisions = sortedKeys(g.revisions)
+		g.service.Clusters = sortedKeys(g.clusters)
+		g.service.InstanceCount = int32(len(g.instances)) // #nosec G115 - instance counts fit in int32
+		canonicalServices = append(canonicalServices, g.service)
+	}
+	sort.Slice(canonicalServices, func(i, j int) bool {
+		if canonicalServices[i].Namespace != canonicalServices[j].Namespace {
+			return canonicalServices[i].Namespace < canonicalServices[j].Namespace
+		}
+		return canonicalServices[i].Name < canonicalServices[j].Name
+	})
+	return canonicalServices
+}
+
+// sortedKeys returns the keys of a set, sorted
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // convertAggregatedServiceInstance converts an AggregatedServiceInstance to the frontend API format
 func convertAggregatedServiceInstance(aggInstance *connections.AggregatedServiceInstance) *frontendv1alpha1.ServiceInstance {
 	return &frontendv1alpha1.ServiceInstance{
-		InstanceId:   aggInstance.InstanceID,
-		Ip:           aggInstance.IP,
-		PodName:      aggInstance.PodName,
-		Namespace:    aggInstance.Namespace,
-		ClusterName:  aggInstance.ClusterName,
-		EnvoyPresent: aggInstance.EnvoyPresent,
+		InstanceId:        aggInstance.InstanceID,
+		Ip:                aggInstance.IP,
+		PodName:           aggInstance.PodName,
+		Namespace:         aggInstance.Namespace,
+		ClusterName:       aggInstance.ClusterName,
+		EnvoyPresent:      aggInstance.EnvoyPresent,
+		Zone:              aggInstance.Locality.GetZone(),
+		LifecyclePhase:    aggInstance.LifecyclePhase,
+		CanonicalService:  aggInstance.CanonicalService,
+		CanonicalRevision: aggInstance.CanonicalRevision,
 	}
 }
 
 // convertAggregatedServiceInstanceToDetail converts an AggregatedServiceInstance to the detailed frontend API format
 func convertAggregatedServiceInstanceToDetail(aggInstance *connections.AggregatedServiceInstance) *frontendv1alpha1.ServiceInstanceDetail {
-	// Convert container information
-	containers := make([]*frontendv1alpha1.Container, len(aggInstance.Containers))
-	for i, container := range aggInstance.Containers {
-		containers[i] = &frontendv1alpha1.Container{
+	return &frontendv1alpha1.ServiceInstanceDetail{
+		InstanceId:        aggInstance.InstanceID,
+		Ip:                aggInstance.IP,
+		PodName:           aggInstance.PodName,
+		Namespace:         aggInstance.Namespace,
+		ClusterName:       aggInstance.ClusterName,
+		EnvoyPresent:      aggInstance.EnvoyPresent,
+		ServiceName:       fmt.Sprintf("%s:%s", aggInstance.Namespace, extractServiceNameFromInstanceID(aggInstance.InstanceID)),
+		Containers:        convertContainers(aggInstance.Containers),
+		PodStatus:         aggInstance.PodStatus,
+		NodeName:          aggInstance.NodeName,
+		CreatedAt:         aggInstance.CreatedAt,
+		Labels:            aggInstance.Labels,
+		Annotations:       aggInstance.Annotations,
+		IsEnvoyPresent:    aggInstance.IsEnvoyPresent,
+		InitContainers:    convertContainers(aggInstance.InitContainers),
+		ServiceAccount:    aggInstance.ServiceAccount,
+		IstioAnnotations:  istioAnnotations(aggInstance.Annotations),
+		Tolerations:       aggInstance.Tolerations,
+		Locality:          aggInstance.Locality,
+		ZoneHints:         aggInstance.ZoneHints,
+		LifecyclePhase:    aggInstance.LifecyclePhase,
+		DeletionTimestamp: aggInstance.DeletionTimestamp,
+		CanonicalService:  aggInstance.CanonicalService,
+		CanonicalRevision: aggInstance.CanonicalRevision,
+	}
+}
+
+// convertContainers converts manager containers to the frontend API format
+func convertContainers(containers []connections.Container) []*frontendv1alpha1.Container {
+	converted := make([]*frontendv1alpha1.Container, len(containers))
+	for i, container := range containers {
+		converted[i] = &frontendv1alpha1.Container{
 			Name:         container.Name,
 			Image:        container.Image,
 			Status:       container.Status,
 			Ready:        container.Ready,
 			RestartCount: container.RestartCount,
+			ImageDigest:  container.ImageDigest,
 		}
 	}
+	return converted
+}
 
-	return &frontendv1alpha1.ServiceInstanceDetail{
-		InstanceId:     aggInstance.InstanceID,
-		Ip:             aggInstance.IP,
-		PodName:        aggInstance.PodName,
-		Namespace:      aggInstance.Namespace,
-		ClusterName:    aggInstance.ClusterName,
-		EnvoyPresent:   aggInstance.EnvoyPresent,
-		ServiceName:    fmt.Sprintf("%s:%s", aggInstance.Namespace, extractServiceNameFromInstanceID(aggInstance.InstanceID)),
-		Containers:     containers,
-		PodStatus:      aggInstance.PodStatus,
-		NodeName:       aggInstance.NodeName,
-		CreatedAt:      aggInstance.CreatedAt,
-		Labels:         aggInstance.Labels,
-		Annotations:    aggInstance.Annotations,
-		IsEnvoyPresent: aggInstance.IsEnvoyPresent,
+// istioAnnotationPrefixes are the prefixes of the pod annotations that change how Istio injects and configures its proxy
+var istioAnnotationPrefixes = []string{"sidecar.istio.io/", "traffic.sidecar.istio.io/", "proxy.istio.io/"}
+
+// istioAnnotations returns the annotations that change how Istio injects and configures a pod's proxy
+func istioAnnotations(annotations map[string]string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range annotations {
+		for _, prefix := range istioAnnotationPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				result[key] = value
+				break
+			}
+		}
 	}
+	return result
 }
 
 // extractServiceNameFromInstanceID extracts the service name from an instance ID
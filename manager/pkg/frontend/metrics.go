@@ -19,15 +19,52 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/liamawhite/navigator/manager/pkg/connections"
 	"github.com/liamawhite/navigator/manager/pkg/providers"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	sharedmetrics "github.com/liamawhite/navigator/pkg/metrics"
 	"github.com/prometheus/prometheus/promql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// explainPathMetricsWindow is how far back ExplainPath looks for traffic between the pair
+	explainPathMetricsWindow = 5 * time.Minute
+
+	// compareServiceMetricsWindow is how far back CompareService looks for traffic to the service
+	compareServiceMetricsWindow = 5 * time.Minute
+
+	// defaultTimeSeriesBuckets and maxTimeSeriesBuckets bound the intervals of a time series
+	defaultTimeSeriesBuckets = 30
+	maxTimeSeriesBuckets     = 120
+
+	// maxTimeSeriesPairs is the most service pairs a single time series request may select
+	maxTimeSeriesPairs = 50
+
+	// reporterSource and reporterDestination identify the side of a connection whose proxies reported its metrics
+	reporterSource      = "source"
+	reporterDestination = "destination"
+
+	// perspectiveMinTolerance is the smallest difference in requests or errors per second between the source and
+	// destination perspectives of a connection that is reported as a discrepancy
+	perspectiveMinTolerance = 0.01
+
+	// maxPairExemplars is the number of exemplars returned for an aggregated connection
+	maxPairExemplars = 5
 )
 
 // MetricsService implements the frontend MetricsService
@@ -35,14 +72,16 @@ type MetricsService struct {
 	frontendv1alpha1.UnimplementedMetricsServiceServer
 	connectionManager   providers.ReadOptimizedConnectionManager
 	meshMetricsProvider providers.MeshMetricsProvider
+	istioProvider       providers.IstioResourcesProvider
 	logger              *slog.Logger
 }
 
 // NewMetricsService creates a new metrics service
-func NewMetricsService(connectionManager providers.ReadOptimizedConnectionManager, meshMetricsProvider providers.MeshMetricsProvider, logger *slog.Logger) *MetricsService {
+func NewMetricsService(connectionManager providers.ReadOptimizedConnectionManager, meshMetricsProvider providers.MeshMetricsProvider, istioProvider providers.IstioResourcesProvider, logger *slog.Logger) *MetricsService {
 	return &MetricsService{
 		connectionManager:   connectionManager,
 		meshMetricsProvider: meshMetricsProvider,
+		istioProvider:       istioProvider,
 		logger:              logger,
 	}
 }
@@ -51,6 +90,11 @@ func NewMetricsService(connectionManager providers.ReadOptimizedConnectionManage
 func (m *MetricsService) GetServiceConnections(ctx context.Context, req *frontendv1alpha1.GetServiceConnectionsRequest) (*frontendv1alpha1.GetServiceConnectionsResponse, error) {
 	m.logger.Debug("getting service connections", "service_name", req.ServiceName, "namespace", req.Namespace)
 
+	ctx, err := selectClusters(ctx, m.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate service name and namespace are provided
 	if req.ServiceName == "" {
 		return nil, fmt.Errorf("service name is required")
@@ -62,7 +106,7 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 	// Validate that the service exists before querying metrics
 	// Use the same service ID format as the rest of the system: namespace:serviceName
 	serviceID := fmt.Sprintf("%s:%s", req.Namespace, req.ServiceName)
-	aggregatedService, serviceExists := m.connectionManager.GetAggregatedService(serviceID)
+	aggregatedService, serviceExists := scopedConnections(ctx, m.connectionManager).GetAggregatedService(serviceID)
 	if !serviceExists {
 		m.logger.Debug("service not found", "service_name", req.ServiceName, "namespace", req.Namespace, "service_id", serviceID)
 		return &frontendv1alpha1.GetServiceConnectionsResponse{
@@ -73,6 +117,13 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 		}, nil
 	}
 
+	// Istio's telemetry reports the service under its canonical service, so the metrics are queried by that name
+	query := req
+	if telemetryName := telemetryServiceName(aggregatedService); telemetryName != req.ServiceName {
+		query = proto.Clone(req).(*frontendv1alpha1.GetServiceConnectionsRequest)
+		query.ServiceName = telemetryName
+	}
+
 	// Determine the ProxyMode from service instances
 	// All instances of a service should have the same ProxyMode
 	proxyMode := typesv1alpha1.ProxyMode_SIDECAR // Default to SIDECAR
@@ -84,7 +135,7 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 	var allPairs []*typesv1alpha1.ServicePairMetrics
 
 	// Get all connected clusters for metrics querying
-	connectionInfos := m.connectionManager.GetConnectionInfo()
+	connectionInfos := scopedConnections(ctx, m.connectionManager).GetConnectionInfo()
 
 	// Collect all connected cluster IDs
 	var healthyClusters []string
@@ -120,7 +171,7 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 			}
 
 			// Request targeted service connections from this cluster
-			serviceConnectionsMetrics, err := m.meshMetricsProvider.GetServiceConnections(clusterCtx, cID, req, proxyMode)
+			serviceConnectionsMetrics, err := m.meshMetricsProvider.GetServiceConnections(clusterCtx, cID, query, proxyMode)
 			if err != nil {
 				m.logger.Error("failed to get service connections from cluster", "cluster_id", cID, "error", err)
 				results <- clusterResult{clusterID: cID, err: err}
@@ -148,6 +199,9 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 						RequestRate:          pair.RequestRate,
 						LatencyP99:           pair.LatencyP99, // Calculated by edge
 						LatencyDistribution:  pair.LatencyDistribution,
+						PlaintextRequestRate: pair.PlaintextRequestRate,
+						Reporter:             pair.Reporter,
+						Exemplars:            pair.Exemplars,
 					})
 				}
 				results <- clusterResult{clusterID: cID, pairs: pairs}
@@ -172,18 +226,32 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 		}
 	}
 
-	// Separate inbound and outbound connections
-	var inbound []*typesv1alpha1.ServicePairMetrics
-	var outbound []*typesv1alpha1.ServicePairMetrics
+	// Separate inbound and outbound connections, and the connections reported by the proxies on their other side
+	bothPerspectives := req.Perspective == typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_BOTH
+	var inbound, inboundCounterparts []*typesv1alpha1.ServicePairMetrics
+	var outbound, outboundCounterparts []*typesv1alpha1.ServicePairMetrics
 
 	for _, pair := range allPairs {
+		toService := pair.DestinationService == query.ServiceName && pair.DestinationNamespace == req.Namespace
+		fromService := pair.SourceService == query.ServiceName && pair.SourceNamespace == req.Namespace
+		// Calls of the service to itself are reported by its own proxies on both sides
+		counterpart := bothPerspectives && !(toService && fromService)
+
 		// Inbound: services calling this service
-		if pair.DestinationService == req.ServiceName && pair.DestinationNamespace == req.Namespace {
-			inbound = append(inbound, pair)
+		if toService {
+			if counterpart && pair.Reporter == reporterSource {
+				inboundCounterparts = append(inboundCounterparts, pair)
+			} else {
+				inbound = append(inbound, pair)
+			}
 		}
 		// Outbound: services this service calls
-		if pair.SourceService == req.ServiceName && pair.SourceNamespace == req.Namespace {
-			outbound = append(outbound, pair)
+		if fromService {
+			if counterpart && pair.Reporter == reporterDestination {
+				outboundCounterparts = append(outboundCounterparts, pair)
+			} else {
+				outbound = append(outbound, pair)
+			}
 		}
 	}
 
@@ -198,6 +266,10 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 	// Aggregate the service pairs for the main overview
 	aggregatedInbound := m.aggregateServicePairs(inbound)
 	aggregatedOutbound := m.aggregateServicePairs(outbound)
+	if bothPerspectives {
+		aggregatedInbound = m.addPerspectives(aggregatedInbound, inboundCounterparts, reporterSource)
+		aggregatedOutbound = m.addPerspectives(aggregatedOutbound, outboundCounterparts, reporterDestination)
+	}
 
 	return &frontendv1alpha1.GetServiceConnectionsResponse{
 		Inbound:         aggregatedInbound,
@@ -207,6 +279,534 @@ func (m *MetricsService) GetServiceConnections(ctx context.Context, req *fronten
 	}, nil
 }
 
+// ExplainPath returns the Istio resources and current metrics for traffic from a source service to a destination service
+func (m *MetricsService) ExplainPath(ctx context.Context, req *frontendv1alpha1.ExplainPathRequest) (*frontendv1alpha1.ExplainPathResponse, error) {
+	m.logger.Debug("explaining path",
+		"source_service", req.SourceService, "source_namespace", req.SourceNamespace,
+		"destination_service", req.DestinationService, "destination_namespace", req.DestinationNamespace)
+
+	ctx, err := selectClusters(ctx, m.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SourceService == "" || req.SourceNamespace == "" || req.DestinationService == "" || req.DestinationNamespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "source and destination service names and namespaces are required")
+	}
+
+	sourceID := fmt.Sprintf("%s:%s", req.SourceNamespace, req.SourceService)
+	source, exists := scopedConnections(ctx, m.connectionManager).GetAggregatedService(sourceID)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "service not found: %s", sourceID)
+	}
+	destinationID := fmt.Sprintf("%s:%s", req.DestinationNamespace, req.DestinationService)
+	destination, exists := scopedConnections(ctx, m.connectionManager).GetAggregatedService(destinationID)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "service not found: %s", destinationID)
+	}
+
+	response := &frontendv1alpha1.ExplainPathResponse{}
+
+	// Outbound side: how the source's proxies scope, route and balance traffic to the destination host
+	for _, clusterID := range sortedClusterIDs(source) {
+		resources, err := m.workloadIstioResources(ctx, clusterID, source.Namespace, source.ClusterMap[clusterID])
+		if err != nil {
+			m.logger.Warn("failed to get source istio resources", "cluster_id", clusterID, "service_id", sourceID, "error", err)
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve resources for %s in cluster %s: %v", sourceID, clusterID, err))
+			continue
+		}
+		response.Source = append(response.Source, &frontendv1alpha1.PathResources{
+			ClusterId:        clusterID,
+			Sidecars:         resources.Sidecars,
+			Gateways:         resources.Gateways,
+			VirtualServices:  filters.FilterVirtualServicesForHost(resources.VirtualServices, destination.Name, destination.Namespace),
+			DestinationRules: filters.FilterDestinationRulesForHost(resources.DestinationRules, destination.Name, destination.Namespace),
+		})
+	}
+
+	// Inbound side: the mTLS and authorization policies the destination's proxies enforce
+	for _, clusterID := range sortedClusterIDs(destination) {
+		resources, err := m.workloadIstioResources(ctx, clusterID, destination.Namespace, destination.ClusterMap[clusterID])
+		if err != nil {
+			m.logger.Warn("failed to get destination istio resources", "cluster_id", clusterID, "service_id", destinationID, "error", err)
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve resources for %s in cluster %s: %v", destinationID, clusterID, err))
+			continue
+		}
+		response.Destination = append(response.Destination, &frontendv1alpha1.PathResources{
+			ClusterId:             clusterID,
+			PeerAuthentications:   resources.PeerAuthentications,
+			AuthorizationPolicies: resources.AuthorizationPolicies,
+		})
+	}
+
+	endTime := time.Now()
+	serviceConnections, err := m.GetServiceConnections(ctx, &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: destination.Name,
+		Namespace:   destination.Namespace,
+		StartTime:   timestamppb.New(endTime.Add(-explainPathMetricsWindow)),
+		EndTime:     timestamppb.New(endTime),
+	})
+	if err != nil {
+		m.logger.Warn("failed to get path metrics", "service_id", destinationID, "error", err)
+		response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve metrics: %v", err))
+	} else {
+		response.ClustersQueried = serviceConnections.ClustersQueried
+		for _, pair := range serviceConnections.Inbound {
+			if pair.SourceService == telemetryServiceName(source) && pair.SourceNamespace == source.Namespace {
+				response.Metrics = pair
+				break
+			}
+		}
+	}
+
+	m.logger.Debug("explained path",
+		"source_service_id", sourceID,
+		"destination_service_id", destinationID,
+		"source_clusters", len(response.Source),
+		"destination_clusters", len(response.Destination),
+		"has_metrics", response.Metrics != nil,
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
+
+// CompareService describes a service in two clusters side by side and reports how the clusters differ
+func (m *MetricsService) CompareService(ctx context.Context, req *frontendv1alpha1.CompareServiceRequest) (*frontendv1alpha1.CompareServiceResponse, error) {
+	m.logger.Debug("comparing service",
+		"service_name", req.ServiceName, "namespace", req.Namespace,
+		"cluster_a", req.ClusterA, "cluster_b", req.ClusterB)
+
+	if req.ServiceName == "" || req.Namespace == "" || req.ClusterA == "" || req.ClusterB == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "service name, namespace and both clusters are required")
+	}
+	if req.ClusterA == req.ClusterB {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot compare cluster %s with itself", req.ClusterA)
+	}
+
+	connectionInfos := scopedConnections(ctx, m.connectionManager).GetConnectionInfo()
+	for _, clusterID := range []string{req.ClusterA, req.ClusterB} {
+		if _, exists := connectionInfos[clusterID]; !exists {
+			return nil, status.Errorf(codes.NotFound, "cluster not found: %s", clusterID)
+		}
+	}
+
+	serviceID := fmt.Sprintf("%s:%s", req.Namespace, req.ServiceName)
+	service, exists := scopedConnections(ctx, m.connectionManager).GetAggregatedService(serviceID)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "service not found: %s", serviceID)
+	}
+
+	response := &frontendv1alpha1.CompareServiceResponse{}
+	var warnings []string
+	response.ClusterA, warnings = m.describeServiceInCluster(ctx, service, req.ClusterA)
+	response.Warnings = append(response.Warnings, warnings...)
+	response.ClusterB, warnings = m.describeServiceInCluster(ctx, service, req.ClusterB)
+	response.Warnings = append(response.Warnings, warnings...)
+	response.Differences = serviceClusterDifferences(response.ClusterA, response.ClusterB)
+
+	m.logger.Debug("compared service",
+		"service_id", serviceID,
+		"differences", len(response.Differences),
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
+
+// describeServiceInCluster collects the instances, Istio resources and inbound metrics of a service in one cluster.
+// Parts that cannot be retrieved are left empty and described by the returned warnings.
+func (m *MetricsService) describeServiceInCluster(ctx context.Context, service *connections.AggregatedService, clusterID string) (*frontendv1alpha1.ServiceClusterComparison, []string) {
+	instances := service.ClusterMap[clusterID]
+	comparison := &frontendv1alpha1.ServiceClusterComparison{
+		ClusterId:     clusterID,
+		InstanceCount: int32(min(len(instances), math.MaxInt32)), // #nosec G115 - bounds checked
+	}
+	if len(instances) == 0 {
+		return comparison, nil
+	}
+
+	versions := make(map[string]int32)
+	for _, instance := range instances {
+		if isInstanceHealthy(instance) {
+			comparison.HealthyInstanceCount++
+		}
+		version := instance.Labels["version"]
+		if version == "" {
+			version = instance.Labels["app.kubernetes.io/version"]
+		}
+		versions[version]++
+	}
+	for version, count := range versions {
+		comparison.Versions = append(comparison.Versions, &frontendv1alpha1.ServiceVersion{Version: version, InstanceCount: count})
+	}
+	sort.Slice(comparison.Versions, func(i, j int) bool {
+		return comparison.Versions[i].Version < comparison.Versions[j].Version
+	})
+
+	var warnings []string
+	resources, err := m.workloadIstioResources(ctx, clusterID, service.Namespace, instances)
+	if err != nil {
+		m.logger.Warn("failed to get service istio resources", "cluster_id", clusterID, "service_id", service.ID, "error", err)
+		warnings = append(warnings, fmt.Sprintf("failed to retrieve resources for %s in cluster %s: %v", service.ID, clusterID, err))
+	} else {
+		comparison.IstioResources = serviceResourceRefs(clusterID, service, resources)
+	}
+
+	endTime := time.Now()
+	metrics, err := m.meshMetricsProvider.GetServiceConnections(ctx, clusterID, &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: telemetryServiceName(service),
+		Namespace:   service.Namespace,
+		StartTime:   timestamppb.New(endTime.Add(-compareServiceMetricsWindow)),
+		EndTime:     timestamppb.New(endTime),
+	}, instances[0].ProxyMode)
+	if err != nil {
+		m.logger.Warn("failed to get service metrics", "cluster_id", clusterID, "service_id", service.ID, "error", err)
+		warnings = append(warnings, fmt.Sprintf("failed to retrieve metrics for %s in cluster %s: %v", service.ID, clusterID, err))
+	} else {
+		comparison.Metrics = m.inboundMetrics(service, metrics)
+	}
+
+	return comparison, warnings
+}
+
+// telemetryServiceName returns the name Istio's telemetry reports a service under: the canonical service of its
+// instances when they all share one, and the service name otherwise
+func telemetryServiceName(service *connections.AggregatedService) string {
+	if names := canonicalServiceNames(service); len(names) == 1 {
+		return names[0]
+	}
+	return service.Name
+}
+
+// inboundMetrics sums the requests received by a service from all of its callers, or returns nil if it received none
+func (m *MetricsService) inboundMetrics(service *connections.AggregatedService, metrics *typesv1alpha1.ServiceGraphMetrics) *frontendv1alpha1.ServiceInboundMetrics {
+	var inbound *frontendv1alpha1.ServiceInboundMetrics
+	var distributions []*typesv1alpha1.LatencyDistribution
+	for _, pair := range metrics.GetPairs() {
+		if pair.DestinationService != telemetryServiceName(service) || pair.DestinationNamespace != service.Namespace {
+			continue
+		}
+		if inbound == nil {
+			inbound = &frontendv1alpha1.ServiceInboundMetrics{}
+		}
+		inbound.RequestRate += pair.RequestRate
+		inbound.ErrorRate += pair.ErrorRate
+		if pair.LatencyDistribution != nil {
+			distributions = append(distributions, pair.LatencyDistribution)
+		}
+	}
+	if inbound != nil {
+		inbound.LatencyP99 = m.aggregateHistogramsAndCalculateP99(distributions)
+	}
+	return inbound
+}
+
+// serviceResourceRefs returns references to the VirtualServices and DestinationRules for a service's host and the
+// Sidecars, PeerAuthentications and AuthorizationPolicies applying to its instances, ordered by kind, namespace and name
+func serviceResourceRefs(clusterID string, service *connections.AggregatedService, resources *frontendv1alpha1.GetIstioResourcesResponse) []*typesv1alpha1.ResourceRef {
+	var refs []*typesv1alpha1.ResourceRef
+	add := func(kind string, resource references.NamedResource) {
+		refs = append(refs, &typesv1alpha1.ResourceRef{ClusterId: clusterID, Kind: kind, Namespace: resource.GetNamespace(), Name: resource.GetName()})
+	}
+	for _, resource := range filters.FilterVirtualServicesForHost(resources.VirtualServices, service.Name, service.Namespace) {
+		add(references.KindVirtualService, resource)
+	}
+	for _, resource := range filters.FilterDestinationRulesForHost(resources.DestinationRules, service.Name, service.Namespace) {
+		add(references.KindDestinationRule, resource)
+	}
+	for _, resource := range resources.Sidecars {
+		add(references.KindSidecar, resource)
+	}
+	for _, resource := range resources.PeerAuthentications {
+		add(references.KindPeerAuthentication, resource)
+	}
+	for _, resource := range resources.AuthorizationPolicies {
+		add(references.KindAuthorizationPolicy, resource)
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Kind != refs[j].Kind {
+			return refs[i].Kind < refs[j].Kind
+		}
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// serviceClusterDifferences describes how a service differs between two clusters
+func serviceClusterDifferences(a, b *frontendv1alpha1.ServiceClusterComparison) []string {
+	var differences []string
+
+	if a.InstanceCount != b.InstanceCount {
+		differences = append(differences, fmt.Sprintf("instance count differs: %d in %s, %d in %s", a.InstanceCount, a.ClusterId, b.InstanceCount, b.ClusterId))
+	}
+	if a.HealthyInstanceCount != b.HealthyInstanceCount {
+		differences = append(differences, fmt.Sprintf("healthy instance count differs: %d in %s, %d in %s", a.HealthyInstanceCount, a.ClusterId, b.HealthyInstanceCount, b.ClusterId))
+	}
+
+	versionsA, versionsB := serviceVersionNames(a), serviceVersionNames(b)
+	if versionsA != versionsB {
+		differences = append(differences, fmt.Sprintf("versions differ: [%s] in %s, [%s] in %s", versionsA, a.ClusterId, versionsB, b.ClusterId))
+	}
+
+	// Without instances in a cluster no resources apply there, which the instance count already reports
+	if a.InstanceCount > 0 && b.InstanceCount > 0 {
+		differences = append(differences, resourcesOnlyIn(a, b)...)
+		differences = append(differences, resourcesOnlyIn(b, a)...)
+	}
+
+	return differences
+}
+
+// serviceVersionNames returns the versions of a service in a cluster as a comma-separated list
+func serviceVersionNames(comparison *frontendv1alpha1.ServiceClusterComparison) string {
+	names := make([]string, len(comparison.Versions))
+	for i, version := range comparison.Versions {
+		names[i] = version.Version
+	}
+	return strings.Join(names, ",")
+}
+
+// resourcesOnlyIn describes the Istio resources applying to a service in one cluster but not the other
+func resourcesOnlyIn(in, other *frontendv1alpha1.ServiceClusterComparison) []string {
+	present := make(map[string]bool, len(other.IstioResources))
+	for _, ref := range other.IstioResources {
+		present[ref.Kind+"/"+ref.Namespace+"/"+ref.Name] = true
+	}
+
+	var differences []string
+	for _, ref := range in.IstioResources {
+		if !present[ref.Kind+"/"+ref.Namespace+"/"+ref.Name] {
+			differences = append(differences, fmt.Sprintf("%s %s/%s only applies in %s", ref.Kind, ref.Namespace, ref.Name, in.ClusterId))
+		}
+	}
+	return differences
+}
+
+// GetPairInstanceMetrics breaks the metrics from a source service to a destination service down by workload and pod,
+// merging the pods reported by every connected cluster
+func (m *MetricsService) GetPairInstanceMetrics(ctx context.Context, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*frontendv1alpha1.GetPairInstanceMetricsResponse, error) {
+	m.logger.Debug("getting pair instance metrics",
+		"source_service", req.SourceService, "source_namespace", req.SourceNamespace,
+		"destination_service", req.DestinationService, "destination_namespace", req.DestinationNamespace)
+
+	ctx, err := selectClusters(ctx, m.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SourceService == "" || req.SourceNamespace == "" || req.DestinationService == "" || req.DestinationNamespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "source and destination service names and namespaces are required")
+	}
+	for _, serviceID := range []string{
+		fmt.Sprintf("%s:%s", req.SourceNamespace, req.SourceService),
+		fmt.Sprintf("%s:%s", req.DestinationNamespace, req.DestinationService),
+	} {
+		if _, exists := scopedConnections(ctx, m.connectionManager).GetAggregatedService(serviceID); !exists {
+			return nil, status.Errorf(codes.NotFound, "service not found: %s", serviceID)
+		}
+	}
+
+	var clusterIDs []string
+	for clusterID := range scopedConnections(ctx, m.connectionManager).GetConnectionInfo() {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+
+	// Query clusters in parallel, each reports the pods of its own proxies
+	results := make([]*typesv1alpha1.PairInstanceMetrics, len(clusterIDs))
+	errs := make([]error, len(clusterIDs))
+	var wg sync.WaitGroup
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = m.meshMetricsProvider.GetPairInstanceMetrics(ctx, clusterID, req)
+		}()
+	}
+	wg.Wait()
+
+	response := &frontendv1alpha1.GetPairInstanceMetricsResponse{}
+	for i, clusterID := range clusterIDs {
+		if errs[i] != nil {
+			m.logger.Warn("failed to get pair instance metrics from cluster", "cluster_id", clusterID, "error", errs[i])
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve metrics from cluster %s: %v", clusterID, errs[i]))
+			continue
+		}
+		response.ClustersQueried = append(response.ClustersQueried, clusterID)
+		response.SourcePods = append(response.SourcePods, results[i].GetSourcePods()...)
+		response.DestinationPods = append(response.DestinationPods, results[i].GetDestinationPods()...)
+	}
+	sharedmetrics.SortInstancePairMetrics(response.SourcePods)
+	sharedmetrics.SortInstancePairMetrics(response.DestinationPods)
+
+	m.logger.Debug("retrieved pair instance metrics",
+		"clusters_queried", len(response.ClustersQueried),
+		"source_pods", len(response.SourcePods),
+		"destination_pods", len(response.DestinationPods),
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
+
+// GetMeshMetricsTimeSeries returns the metrics of service pairs over equal intervals of a time window, summing the
+// rates and merging the latency histograms of each interval across every connected cluster
+func (m *MetricsService) GetMeshMetricsTimeSeries(ctx context.Context, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*frontendv1alpha1.GetMeshMetricsTimeSeriesResponse, error) {
+	m.logger.Debug("getting mesh metrics time series", "pairs", len(req.Pairs), "buckets", req.Buckets)
+
+	ctx, err := selectClusters(ctx, m.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Pairs) == 0 || len(req.Pairs) > maxTimeSeriesPairs {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d service pairs are required", maxTimeSeriesPairs)
+	}
+	for _, pair := range req.Pairs {
+		if pair.SourceService == "" || pair.SourceNamespace == "" || pair.DestinationService == "" || pair.DestinationNamespace == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "source and destination service names and namespaces are required")
+		}
+	}
+	if req.StartTime == nil || req.EndTime == nil || !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required and end_time must be after start_time")
+	}
+	buckets := req.Buckets
+	if buckets == 0 {
+		buckets = defaultTimeSeriesBuckets
+	}
+	if buckets < 0 || buckets > maxTimeSeriesBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "buckets must be between 1 and %d", maxTimeSeriesBuckets)
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	step := end.Sub(start) / time.Duration(buckets)
+	if step < time.Second {
+		return nil, status.Errorf(codes.InvalidArgument, "time window is too short for %d buckets", buckets)
+	}
+
+	query := &frontendv1alpha1.GetMeshMetricsTimeSeriesRequest{
+		Pairs:     req.Pairs,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Buckets:   buckets,
+	}
+
+	var clusterIDs []string
+	for clusterID := range scopedConnections(ctx, m.connectionManager).GetConnectionInfo() {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+
+	// Query clusters in parallel, each reports the requests received by its own proxies
+	results := make([]*typesv1alpha1.MeshMetricsTimeSeries, len(clusterIDs))
+	errs := make([]error, len(clusterIDs))
+	var wg sync.WaitGroup
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = m.meshMetricsProvider.GetMeshMetricsTimeSeries(ctx, clusterID, query)
+		}()
+	}
+	wg.Wait()
+
+	// Collect the buckets reported for each pair by every cluster
+	response := &frontendv1alpha1.GetMeshMetricsTimeSeriesResponse{}
+	clusterSeries := make(map[string][]*typesv1alpha1.PairTimeSeries)
+	for i, clusterID := range clusterIDs {
+		if errs[i] != nil {
+			m.logger.Warn("failed to get mesh metrics time series from cluster", "cluster_id", clusterID, "error", errs[i])
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve metrics from cluster %s: %v", clusterID, errs[i]))
+			continue
+		}
+		response.ClustersQueried = append(response.ClustersQueried, clusterID)
+		for _, series := range results[i].GetSeries() {
+			pair := series.GetPair()
+			key := servicePairKey(pair.GetSourceService(), pair.GetSourceNamespace(), pair.GetDestinationService(), pair.GetDestinationNamespace())
+			clusterSeries[key] = append(clusterSeries[key], series)
+		}
+	}
+
+	for _, pair := range req.Pairs {
+		key := servicePairKey(pair.SourceService, pair.SourceNamespace, pair.DestinationService, pair.DestinationNamespace)
+		series := &typesv1alpha1.PairTimeSeries{Pair: pair, Buckets: make([]*typesv1alpha1.MetricsBucket, buckets)}
+		for i := range series.Buckets {
+			bucket := &typesv1alpha1.MetricsBucket{
+				StartTime: timestamppb.New(start.Add(time.Duration(i) * step)),
+				EndTime:   timestamppb.New(start.Add(time.Duration(i+1) * step)),
+			}
+			var distributions []*typesv1alpha1.LatencyDistribution
+			for _, reported := range clusterSeries[key] {
+				if i >= len(reported.Buckets) {
+					continue
+				}
+				bucket.RequestRate += reported.Buckets[i].RequestRate
+				bucket.ErrorRate += reported.Buckets[i].ErrorRate
+				if reported.Buckets[i].LatencyDistribution != nil {
+					distributions = append(distributions, reported.Buckets[i].LatencyDistribution)
+				}
+			}
+			// Raw histograms are only needed to merge clusters and are not returned
+			bucket.LatencyP99 = m.aggregateHistogramsAndCalculateP99(distributions)
+			series.Buckets[i] = bucket
+		}
+		response.Series = append(response.Series, series)
+	}
+
+	m.logger.Debug("retrieved mesh metrics time series",
+		"clusters_queried", len(response.ClustersQueried),
+		"series", len(response.Series),
+		"buckets", buckets,
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
+
+// workloadIstioResources collects the Istio resources applying to any of a service's instances in one cluster.
+// Instances sharing the same labels and proxy mode are only evaluated once.
+func (m *MetricsService) workloadIstioResources(ctx context.Context, clusterID, namespace string, instances []*connections.AggregatedServiceInstance) (*frontendv1alpha1.GetIstioResourcesResponse, error) {
+	merged := &frontendv1alpha1.GetIstioResourcesResponse{}
+	seen := make(map[string]bool)
+
+	for _, instance := range instances {
+		// fmt prints maps with sorted keys, so equal label sets produce equal keys
+		key := fmt.Sprintf("%v|%v", instance.Labels, instance.ProxyMode)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		resources, err := m.istioProvider.GetIstioResourcesForWorkload(ctx, clusterID, namespace, &backendv1alpha1.ServiceInstance{
+			Labels:    instance.Labels,
+			ProxyMode: instance.ProxyMode,
+			Policies:  instance.Policies,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		merged.Sidecars = references.AppendUnique(merged.Sidecars, resources.Sidecars)
+		merged.Gateways = references.AppendUnique(merged.Gateways, resources.Gateways)
+		merged.VirtualServices = references.AppendUnique(merged.VirtualServices, resources.VirtualServices)
+		merged.DestinationRules = references.AppendUnique(merged.DestinationRules, resources.DestinationRules)
+		merged.PeerAuthentications = references.AppendUnique(merged.PeerAuthentications, resources.PeerAuthentications)
+		merged.AuthorizationPolicies = references.AppendUnique(merged.AuthorizationPolicies, resources.AuthorizationPolicies)
+	}
+
+	return merged, nil
+}
+
+// sortedClusterIDs returns the clusters a service has instances in, in a stable order
+func sortedClusterIDs(service *connections.AggregatedService) []string {
+	clusterIDs := make([]string, 0, len(service.ClusterMap))
+	for clusterID := range service.ClusterMap {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+	return clusterIDs
+}
+
 // aggregateServicePairs groups service pairs by service name and properly aggregates their metrics
 func (m *MetricsService) aggregateServicePairs(pairs []*typesv1alpha1.ServicePairMetrics) []*typesv1alpha1.AggregatedServicePairMetrics {
 	if len(pairs) == 0 {
@@ -217,9 +817,7 @@ func (m *MetricsService) aggregateServicePairs(pairs []*typesv1alpha1.ServicePai
 	pairGroups := make(map[string][]*typesv1alpha1.ServicePairMetrics)
 
 	for _, pair := range pairs {
-		key := fmt.Sprintf("%s:%s->%s:%s",
-			pair.SourceService, pair.SourceNamespace,
-			pair.DestinationService, pair.DestinationNamespace)
+		key := servicePairKey(pair.SourceService, pair.SourceNamespace, pair.DestinationService, pair.DestinationNamespace)
 		pairGroups[key] = append(pairGroups[key], pair)
 	}
 
@@ -234,6 +832,96 @@ func (m *MetricsService) aggregateServicePairs(pairs []*typesv1alpha1.ServicePai
 	return aggregated
 }
 
+// servicePairKey identifies a connection between two services across clusters
+func servicePairKey(sourceService, sourceNamespace, destinationService, destinationNamespace string) string {
+	return fmt.Sprintf("%s:%s->%s:%s", sourceService, sourceNamespace, destinationService, destinationNamespace)
+}
+
+// addPerspectives sets the source- and destination-reported metrics of aggregated connections, given the
+// connections as reported by the proxies on their other side, and describes where the two disagree. Connections
+// only reported by the other side are added with its metrics.
+func (m *MetricsService) addPerspectives(aggregated []*typesv1alpha1.AggregatedServicePairMetrics, counterparts []*typesv1alpha1.ServicePairMetrics, counterpartReporter string) []*typesv1alpha1.AggregatedServicePairMetrics {
+	counterpartsByKey := make(map[string]*typesv1alpha1.AggregatedServicePairMetrics)
+	for _, counterpart := range m.aggregateServicePairs(counterparts) {
+		counterpartsByKey[servicePairKey(counterpart.SourceService, counterpart.SourceNamespace, counterpart.DestinationService, counterpart.DestinationNamespace)] = counterpart
+	}
+
+	for _, pair := range aggregated {
+		key := servicePairKey(pair.SourceService, pair.SourceNamespace, pair.DestinationService, pair.DestinationNamespace)
+		counterpart, exists := counterpartsByKey[key]
+		delete(counterpartsByKey, key)
+		if exists {
+			setPerspectives(pair, perspectiveMetrics(pair), perspectiveMetrics(counterpart), counterpartReporter)
+			pair.Exemplars = slowestExemplars(append(slices.Clone(pair.Exemplars), counterpart.Exemplars...))
+		} else {
+			setPerspectives(pair, perspectiveMetrics(pair), nil, counterpartReporter)
+		}
+	}
+
+	keys := make([]string, 0, len(counterpartsByKey))
+	for key := range counterpartsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		counterpart := counterpartsByKey[key]
+		setPerspectives(counterpart, nil, perspectiveMetrics(counterpart), counterpartReporter)
+		aggregated = append(aggregated, counterpart)
+	}
+
+	return aggregated
+}
+
+// perspectiveMetrics returns the metrics of an aggregated connection as reported by one side of it
+func perspectiveMetrics(pair *typesv1alpha1.AggregatedServicePairMetrics) *typesv1alpha1.PerspectiveMetrics {
+	return &typesv1alpha1.PerspectiveMetrics{
+		RequestRate: pair.RequestRate,
+		ErrorRate:   pair.ErrorRate,
+		LatencyP99:  pair.LatencyP99,
+	}
+}
+
+// setPerspectives sets the metrics reported by the service's own proxies and by the proxies on the other side of a
+// connection, and their discrepancies. Either may be nil if that side did not report the connection.
+func setPerspectives(pair *typesv1alpha1.AggregatedServicePairMetrics, own, counterpart *typesv1alpha1.PerspectiveMetrics, counterpartReporter string) {
+	if counterpartReporter == reporterSource {
+		pair.SourceReported, pair.DestinationReported = counterpart, own
+	} else {
+		pair.SourceReported, pair.DestinationReported = own, counterpart
+	}
+	pair.Discrepancies = perspectiveDiscrepancies(pair.SourceReported, pair.DestinationReported)
+}
+
+// perspectiveDiscrepancies describes where the metrics reported by the source and destination of a connection
+// disagree by more than 10% of its request rate. Errors only the source saw usually indicate connection-level
+// failures, such as connection resets, timeouts or failed mTLS handshakes, that never reached the destination.
+func perspectiveDiscrepancies(source, destination *typesv1alpha1.PerspectiveMetrics) []string {
+	if source == nil && destination == nil {
+		return nil
+	}
+	if source == nil {
+		return []string{"only reported by the destination, the source may not have a proxy"}
+	}
+	if destination == nil {
+		return []string{"only reported by the source, requests may not be reaching the destination"}
+	}
+
+	tolerance := math.Max(0.1*math.Max(source.RequestRate, destination.RequestRate), perspectiveMinTolerance)
+
+	var discrepancies []string
+	if diff := source.RequestRate - destination.RequestRate; diff > tolerance {
+		discrepancies = append(discrepancies, fmt.Sprintf("source sent %.2f more requests per second than the destination received", diff))
+	} else if -diff > tolerance {
+		discrepancies = append(discrepancies, fmt.Sprintf("destination received %.2f more requests per second than the source sent", -diff))
+	}
+	if diff := source.ErrorRate - destination.ErrorRate; diff > tolerance {
+		discrepancies = append(discrepancies, fmt.Sprintf("source saw %.2f more errors per second than the destination reported, which usually indicates connection-level failures", diff))
+	} else if -diff > tolerance {
+		discrepancies = append(discrepancies, fmt.Sprintf("destination reported %.2f more errors per second than the source saw, which may be hidden by retries", -diff))
+	}
+	return discrepancies
+}
+
 // aggregateGroup aggregates a group of service pairs representing the same service connection across different clusters
 func (m *MetricsService) aggregateGroup(pairs []*typesv1alpha1.ServicePairMetrics) *typesv1alpha1.AggregatedServicePairMetrics {
 	if len(pairs) == 0 {
@@ -248,6 +936,7 @@ func (m *MetricsService) aggregateGroup(pairs []*typesv1alpha1.ServicePairMetric
 
 	// Collect histogram distributions for proper aggregation
 	var distributions []*typesv1alpha1.LatencyDistribution
+	var exemplars []*typesv1alpha1.LatencyExemplar
 
 	for _, pair := range pairs {
 		totalRequestRate += pair.RequestRate
@@ -264,6 +953,7 @@ func (m *MetricsService) aggregateGroup(pairs []*typesv1alpha1.ServicePairMetric
 		if pair.LatencyDistribution != nil {
 			distributions = append(distributions, pair.LatencyDistribution)
 		}
+		exemplars = append(exemplars, pair.Exemplars...)
 	}
 
 	// Properly aggregate histograms and calculate P99
@@ -279,7 +969,23 @@ func (m *MetricsService) aggregateGroup(pairs []*typesv1alpha1.ServicePairMetric
 		LatencyP99:           aggregatedP99,
 		ClusterPairs:         clusterPairs,
 		DetailedBreakdown:    pairs,
+		Exemplars:            slowestExemplars(exemplars),
+	}
+}
+
+// slowestExemplars returns the slowest exemplars, slowest first
+func slowestExemplars(exemplars []*typesv1alpha1.LatencyExemplar) []*typesv1alpha1.LatencyExemplar {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	slowest := slices.Clone(exemplars)
+	sort.SliceStable(slowest, func(i, j int) bool {
+		return slowest[i].Latency.AsDuration() > slowest[j].Latency.AsDuration()
+	})
+	if len(slowest) > maxPairExemplars {
+		slowest = slowest[:maxPairExemplars]
 	}
+	return slowest
 }
 
 // aggregateHistogramsAndCalculateP99 performs proper histogram aggregation and P99 calculation using Prometheus histogram_quantile
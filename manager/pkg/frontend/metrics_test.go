@@ -16,12 +16,23 @@ package frontend
 
 import (
 	"context"
+	"errors"
+	"testing"
+	"time"
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockMetricsConnectionManager for testing
@@ -34,8 +45,14 @@ func (m *MockMetricsConnectionManager) RegisterConnection(clusterID string, stre
 	return args.Error(0)
 }
 
-func (m *MockMetricsConnectionManager) UnregisterConnection(clusterID string) {
-	m.Called(clusterID)
+func (m *MockMetricsConnectionManager) RegisterEdgeConnection(identification *backendv1alpha1.ClusterIdentification, stream backendv1alpha1.ManagerService_ConnectServer) (string, error) {
+	args := m.Called(identification, stream)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockMetricsConnectionManager) UnregisterConnection(clusterID string, stream backendv1alpha1.ManagerService_ConnectServer) bool {
+	m.Called(clusterID, stream)
+	return true
 }
 
 func (m *MockMetricsConnectionManager) UpdateClusterState(clusterID string, clusterState *backendv1alpha1.ClusterState) error {
@@ -53,6 +70,11 @@ func (m *MockMetricsConnectionManager) GetClusterState(clusterID string) (*backe
 	return args.Get(0).(*backendv1alpha1.ClusterState), args.Error(1)
 }
 
+func (m *MockMetricsConnectionManager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	args := m.Called(clusterID)
+	return args.Get(0).(*filters.SelectorIndex), args.Error(1)
+}
+
 func (m *MockMetricsConnectionManager) GetAllClusterStates() map[string]*backendv1alpha1.ClusterState {
 	args := m.Called()
 	return args.Get(0).(map[string]*backendv1alpha1.ClusterState)
@@ -73,6 +95,25 @@ func (m *MockMetricsConnectionManager) SendMessageToCluster(clusterID string, me
 	return args.Error(0)
 }
 
+func (m *MockMetricsConnectionManager) RequestResync(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockMetricsConnectionManager) DisconnectCluster(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockMetricsConnectionManager) Disconnected(clusterID string) <-chan struct{} {
+	return nil
+}
+
+func (m *MockMetricsConnectionManager) UpdateEdgeVersion(clusterID, edgeVersion string) error {
+	args := m.Called(clusterID, edgeVersion)
+	return args.Error(0)
+}
+
 func (m *MockMetricsConnectionManager) ListAggregatedServices(namespace, clusterID string) []*connections.AggregatedService {
 	args := m.Called(namespace, clusterID)
 	return args.Get(0).([]*connections.AggregatedService)
@@ -93,6 +134,16 @@ func (m *MockMetricsConnectionManager) GetConnectionInfo() map[string]connection
 	return args.Get(0).(map[string]connections.ConnectionInfo)
 }
 
+func (m *MockMetricsConnectionManager) ListChanges(clusterID string, since time.Time, limit int) []connections.Change {
+	args := m.Called(clusterID, since, limit)
+	return args.Get(0).([]connections.Change)
+}
+
+func (m *MockMetricsConnectionManager) ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event {
+	args := m.Called(clusterID, start, end, limit)
+	return args.Get(0).([]connections.Event)
+}
+
 // MockMeshMetricsProvider for testing
 type MockMeshMetricsProvider struct {
 	mock.Mock
@@ -105,3 +156,509 @@ func (m *MockMeshMetricsProvider) GetServiceConnections(ctx context.Context, clu
 	}
 	return args.Get(0).(*typesv1alpha1.ServiceGraphMetrics), args.Error(1)
 }
+
+func (m *MockMeshMetricsProvider) GetPairInstanceMetrics(ctx context.Context, clusterID string, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*typesv1alpha1.PairInstanceMetrics, error) {
+	args := m.Called(ctx, clusterID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*typesv1alpha1.PairInstanceMetrics), args.Error(1)
+}
+
+func (m *MockMeshMetricsProvider) GetMeshMetricsTimeSeries(ctx context.Context, clusterID string, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*typesv1alpha1.MeshMetricsTimeSeries, error) {
+	args := m.Called(ctx, clusterID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*typesv1alpha1.MeshMetricsTimeSeries), args.Error(1)
+}
+
+func TestMetricsService_ExplainPath(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	mockIstio := &MockIstioService{}
+
+	service := NewMetricsService(mockConnManager, mockMetrics, mockIstio, logging.For("test"))
+
+	sourceInstance := &connections.AggregatedServiceInstance{InstanceID: "cluster-1:bookinfo:productpage-1", Labels: map[string]string{"app": "productpage"}}
+	reviewsV1 := &connections.AggregatedServiceInstance{InstanceID: "cluster-1:bookinfo:reviews-v1", Labels: map[string]string{"app": "reviews", "version": "v1"}}
+	reviewsV1Replica := &connections.AggregatedServiceInstance{InstanceID: "cluster-1:bookinfo:reviews-v1-b", Labels: map[string]string{"app": "reviews", "version": "v1"}}
+	reviewsV2 := &connections.AggregatedServiceInstance{InstanceID: "cluster-1:bookinfo:reviews-v2", Labels: map[string]string{"app": "reviews", "version": "v2"}}
+	remoteReviews := &connections.AggregatedServiceInstance{InstanceID: "cluster-2:bookinfo:reviews-v3", Labels: map[string]string{"app": "reviews", "version": "v3"}}
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:productpage").Return(&connections.AggregatedService{
+		Name: "productpage", Namespace: "bookinfo",
+		Instances:  []*connections.AggregatedServiceInstance{sourceInstance},
+		ClusterMap: map[string][]*connections.AggregatedServiceInstance{"cluster-1": {sourceInstance}},
+	}, true)
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{
+		Name: "reviews", Namespace: "bookinfo",
+		Instances: []*connections.AggregatedServiceInstance{reviewsV1, reviewsV1Replica, reviewsV2, remoteReviews},
+		ClusterMap: map[string][]*connections.AggregatedServiceInstance{
+			"cluster-1": {reviewsV1, reviewsV1Replica, reviewsV2},
+			"cluster-2": {remoteReviews},
+		},
+	}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {ClusterID: "cluster-1"}})
+
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "cluster-1", "bookinfo", &backendv1alpha1.ServiceInstance{Labels: sourceInstance.Labels}).Return(&frontendv1alpha1.GetIstioResourcesResponse{
+		Sidecars: []*typesv1alpha1.Sidecar{{Name: "default", Namespace: "bookinfo"}},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews"}},
+			{Name: "ratings", Namespace: "bookinfo", Hosts: []string{"ratings"}},
+		},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{Name: "reviews", Namespace: "bookinfo", Host: "reviews.bookinfo.svc.cluster.local", Subsets: []*typesv1alpha1.DestinationRuleSubset{{Name: "v1"}}},
+		},
+		PeerAuthentications: []*typesv1alpha1.PeerAuthentication{{Name: "source-only", Namespace: "bookinfo"}},
+	}, nil)
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "cluster-1", "bookinfo", &backendv1alpha1.ServiceInstance{Labels: reviewsV1.Labels}).Return(&frontendv1alpha1.GetIstioResourcesResponse{
+		PeerAuthentications:   []*typesv1alpha1.PeerAuthentication{{Name: "strict", Namespace: "istio-system"}},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{{Name: "reviews-viewer", Namespace: "bookinfo"}},
+	}, nil).Once()
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "cluster-1", "bookinfo", &backendv1alpha1.ServiceInstance{Labels: reviewsV2.Labels}).Return(&frontendv1alpha1.GetIstioResourcesResponse{
+		PeerAuthentications:   []*typesv1alpha1.PeerAuthentication{{Name: "strict", Namespace: "istio-system"}},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{{Name: "reviews-v2-deny", Namespace: "bookinfo"}},
+	}, nil)
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "cluster-2", "bookinfo", mock.Anything).Return(nil, errors.New("cluster cluster-2 is not connected"))
+
+	mockMetrics.On("GetServiceConnections", mock.Anything, "cluster-1", mock.Anything, typesv1alpha1.ProxyMode_UNKNOWN_PROXY_MODE).Return(&typesv1alpha1.ServiceGraphMetrics{
+		Pairs: []*typesv1alpha1.ServicePairMetrics{
+			{SourceCluster: "cluster-1", SourceNamespace: "bookinfo", SourceService: "productpage", DestinationCluster: "cluster-1", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 12.5},
+			{SourceCluster: "cluster-1", SourceNamespace: "bookinfo", SourceService: "ratings", DestinationCluster: "cluster-1", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 1},
+		},
+	}, nil)
+
+	resp, err := service.ExplainPath(context.Background(), &frontendv1alpha1.ExplainPathRequest{
+		SourceService:        "productpage",
+		SourceNamespace:      "bookinfo",
+		DestinationService:   "reviews",
+		DestinationNamespace: "bookinfo",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Source, 1)
+	assert.Equal(t, "cluster-1", resp.Source[0].ClusterId)
+	assert.Len(t, resp.Source[0].Sidecars, 1)
+	require.Len(t, resp.Source[0].VirtualServices, 1)
+	assert.Equal(t, "reviews", resp.Source[0].VirtualServices[0].Name)
+	assert.Len(t, resp.Source[0].DestinationRules, 1)
+	assert.Empty(t, resp.Source[0].PeerAuthentications)
+
+	require.Len(t, resp.Destination, 1)
+	assert.Len(t, resp.Destination[0].PeerAuthentications, 1)
+	assert.Len(t, resp.Destination[0].AuthorizationPolicies, 2)
+	assert.Len(t, resp.Warnings, 1)
+
+	require.NotNil(t, resp.Metrics)
+	assert.Equal(t, "productpage", resp.Metrics.SourceService)
+	assert.Equal(t, 12.5, resp.Metrics.RequestRate)
+	assert.Equal(t, []string{"cluster-1"}, resp.ClustersQueried)
+
+	mockConnManager.AssertExpectations(t)
+	mockMetrics.AssertExpectations(t)
+	mockIstio.AssertExpectations(t)
+}
+
+func TestMetricsService_ExplainPath_InvalidRequest(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	service := NewMetricsService(mockConnManager, &MockMeshMetricsProvider{}, &MockIstioService{}, logging.For("test"))
+
+	_, err := service.ExplainPath(context.Background(), &frontendv1alpha1.ExplainPathRequest{SourceService: "productpage"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:productpage").Return((*connections.AggregatedService)(nil), false)
+	_, err = service.ExplainPath(context.Background(), &frontendv1alpha1.ExplainPathRequest{
+		SourceService:        "productpage",
+		SourceNamespace:      "bookinfo",
+		DestinationService:   "reviews",
+		DestinationNamespace: "bookinfo",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMetricsService_CompareService(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	mockIstio := &MockIstioService{}
+
+	service := NewMetricsService(mockConnManager, mockMetrics, mockIstio, logging.For("test"))
+
+	ready := []connections.Container{{Name: "reviews", Ready: true}}
+	eastV1 := &connections.AggregatedServiceInstance{InstanceID: "east:bookinfo:reviews-v1", PodStatus: "Running", Containers: ready, Labels: map[string]string{"app": "reviews", "version": "v1"}}
+	eastV2 := &connections.AggregatedServiceInstance{InstanceID: "east:bookinfo:reviews-v2", PodStatus: "Pending", Labels: map[string]string{"app": "reviews", "version": "v2"}}
+	westV1 := &connections.AggregatedServiceInstance{InstanceID: "west:bookinfo:reviews-v1", PodStatus: "Running", Containers: ready, Labels: map[string]string{"app": "reviews", "version": "v1"}}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}, "west": {ClusterID: "west"}})
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{
+		ID: "bookinfo:reviews", Name: "reviews", Namespace: "bookinfo",
+		Instances: []*connections.AggregatedServiceInstance{eastV1, eastV2, westV1},
+		ClusterMap: map[string][]*connections.AggregatedServiceInstance{
+			"east": {eastV1, eastV2},
+			"west": {westV1},
+		},
+	}, true)
+
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "east", "bookinfo", mock.Anything).Return(&frontendv1alpha1.GetIstioResourcesResponse{
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews"}},
+			{Name: "ratings", Namespace: "bookinfo", Hosts: []string{"ratings"}},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{{Name: "reviews-viewer", Namespace: "bookinfo"}},
+	}, nil)
+	mockIstio.On("GetIstioResourcesForWorkload", mock.Anything, "west", "bookinfo", mock.Anything).Return(&frontendv1alpha1.GetIstioResourcesResponse{
+		VirtualServices: []*typesv1alpha1.VirtualService{{Name: "reviews", Namespace: "bookinfo", Hosts: []string{"reviews"}}},
+	}, nil)
+
+	mockMetrics.On("GetServiceConnections", mock.Anything, "east", mock.Anything, typesv1alpha1.ProxyMode_UNKNOWN_PROXY_MODE).Return(&typesv1alpha1.ServiceGraphMetrics{
+		Pairs: []*typesv1alpha1.ServicePairMetrics{
+			{SourceNamespace: "bookinfo", SourceService: "productpage", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 10, ErrorRate: 1},
+			{SourceNamespace: "bookinfo", SourceService: "gateway", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 2},
+			{SourceNamespace: "bookinfo", SourceService: "reviews", DestinationNamespace: "bookinfo", DestinationService: "ratings", RequestRate: 5},
+		},
+	}, nil)
+	mockMetrics.On("GetServiceConnections", mock.Anything, "west", mock.Anything, typesv1alpha1.ProxyMode_UNKNOWN_PROXY_MODE).Return(nil, errors.New("prometheus unavailable"))
+
+	resp, err := service.CompareService(context.Background(), &frontendv1alpha1.CompareServiceRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		ClusterA:    "east",
+		ClusterB:    "west",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "east", resp.ClusterA.ClusterId)
+	assert.Equal(t, int32(2), resp.ClusterA.InstanceCount)
+	assert.Equal(t, int32(1), resp.ClusterA.HealthyInstanceCount)
+	assert.Equal(t, []*frontendv1alpha1.ServiceVersion{{Version: "v1", InstanceCount: 1}, {Version: "v2", InstanceCount: 1}}, resp.ClusterA.Versions)
+	assert.Equal(t, []*typesv1alpha1.ResourceRef{
+		{ClusterId: "east", Kind: "AuthorizationPolicy", Namespace: "bookinfo", Name: "reviews-viewer"},
+		{ClusterId: "east", Kind: "VirtualService", Namespace: "bookinfo", Name: "reviews"},
+	}, resp.ClusterA.IstioResources)
+	require.NotNil(t, resp.ClusterA.Metrics)
+	assert.Equal(t, 12.0, resp.ClusterA.Metrics.RequestRate)
+	assert.Equal(t, 1.0, resp.ClusterA.Metrics.ErrorRate)
+
+	assert.Equal(t, int32(1), resp.ClusterB.InstanceCount)
+	assert.Nil(t, resp.ClusterB.Metrics)
+	assert.Len(t, resp.Warnings, 1)
+
+	assert.Equal(t, []string{
+		"instance count differs: 2 in east, 1 in west",
+		"versions differ: [v1,v2] in east, [v1] in west",
+		"AuthorizationPolicy bookinfo/reviews-viewer only applies in east",
+	}, resp.Differences)
+}
+
+func TestMetricsService_CompareService_InvalidRequest(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	service := NewMetricsService(mockConnManager, &MockMeshMetricsProvider{}, &MockIstioService{}, logging.For("test"))
+
+	_, err := service.CompareService(context.Background(), &frontendv1alpha1.CompareServiceRequest{ServiceName: "reviews", Namespace: "bookinfo"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.CompareService(context.Background(), &frontendv1alpha1.CompareServiceRequest{ServiceName: "reviews", Namespace: "bookinfo", ClusterA: "east", ClusterB: "east"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}})
+	_, err = service.CompareService(context.Background(), &frontendv1alpha1.CompareServiceRequest{ServiceName: "reviews", Namespace: "bookinfo", ClusterA: "east", ClusterB: "west"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMetricsService_GetPairInstanceMetrics(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	service := NewMetricsService(mockConnManager, mockMetrics, &MockIstioService{}, logging.For("test"))
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:productpage").Return(&connections.AggregatedService{Name: "productpage", Namespace: "bookinfo"}, true)
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{Name: "reviews", Namespace: "bookinfo"}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"west":  {ClusterID: "west"},
+		"east":  {ClusterID: "east"},
+		"north": {ClusterID: "north"},
+	})
+
+	mockMetrics.On("GetPairInstanceMetrics", mock.Anything, "west", mock.Anything).Return(&typesv1alpha1.PairInstanceMetrics{
+		ClusterId: "west",
+		DestinationPods: []*typesv1alpha1.InstancePairMetrics{
+			{SourceCluster: "west", SourceWorkload: "productpage-v1", DestinationCluster: "west", DestinationWorkload: "reviews-v1", DestinationPod: "reviews-v1-b", RequestRate: 5, ErrorRate: 2},
+			{SourceCluster: "west", SourceWorkload: "productpage-v1", DestinationCluster: "west", DestinationWorkload: "reviews-v1", DestinationPod: "reviews-v1-a", RequestRate: 5},
+		},
+	}, nil)
+	mockMetrics.On("GetPairInstanceMetrics", mock.Anything, "east", mock.Anything).Return(&typesv1alpha1.PairInstanceMetrics{
+		ClusterId: "east",
+		SourcePods: []*typesv1alpha1.InstancePairMetrics{
+			{SourceCluster: "east", SourceWorkload: "productpage-v1", SourcePod: "productpage-v1-a", DestinationCluster: "west", DestinationWorkload: "reviews-v1", RequestRate: 10},
+		},
+	}, nil)
+	mockMetrics.On("GetPairInstanceMetrics", mock.Anything, "north", mock.Anything).Return(nil, errors.New("metrics provider not available"))
+
+	resp, err := service.GetPairInstanceMetrics(context.Background(), &frontendv1alpha1.GetPairInstanceMetricsRequest{
+		SourceService:        "productpage",
+		SourceNamespace:      "bookinfo",
+		DestinationService:   "reviews",
+		DestinationNamespace: "bookinfo",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"east", "west"}, resp.ClustersQueried)
+	assert.Equal(t, []string{"failed to retrieve metrics from cluster north: metrics provider not available"}, resp.Warnings)
+
+	require.Len(t, resp.SourcePods, 1)
+	assert.Equal(t, "productpage-v1-a", resp.SourcePods[0].SourcePod)
+	require.Len(t, resp.DestinationPods, 2)
+	assert.Equal(t, "reviews-v1-a", resp.DestinationPods[0].DestinationPod)
+	assert.Equal(t, "reviews-v1-b", resp.DestinationPods[1].DestinationPod)
+	assert.Equal(t, 2.0, resp.DestinationPods[1].ErrorRate)
+}
+
+func TestMetricsService_GetPairInstanceMetrics_InvalidRequest(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	service := NewMetricsService(mockConnManager, &MockMeshMetricsProvider{}, &MockIstioService{}, logging.For("test"))
+
+	_, err := service.GetPairInstanceMetrics(context.Background(), &frontendv1alpha1.GetPairInstanceMetricsRequest{SourceService: "productpage", SourceNamespace: "bookinfo"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:productpage").Return(&connections.AggregatedService{Name: "productpage", Namespace: "bookinfo"}, true)
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return((*connections.AggregatedService)(nil), false)
+	_, err = service.GetPairInstanceMetrics(context.Background(), &frontendv1alpha1.GetPairInstanceMetricsRequest{
+		SourceService:        "productpage",
+		SourceNamespace:      "bookinfo",
+		DestinationService:   "reviews",
+		DestinationNamespace: "bookinfo",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMetricsService_GetServiceConnections_BothPerspectives(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	service := NewMetricsService(mockConnManager, mockMetrics, &MockIstioService{}, logging.For("test"))
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{Name: "reviews", Namespace: "bookinfo"}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}})
+	mockMetrics.On("GetServiceConnections", mock.Anything, "east", mock.Anything, typesv1alpha1.ProxyMode_SIDECAR).Return(&typesv1alpha1.ServiceGraphMetrics{
+		Pairs: []*typesv1alpha1.ServicePairMetrics{
+			// productpage sees resets the reviews proxies never report
+			{SourceNamespace: "bookinfo", SourceService: "productpage", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 10, Reporter: "destination"},
+			{SourceNamespace: "bookinfo", SourceService: "productpage", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 12, ErrorRate: 2, Reporter: "source"},
+			// Both sides agree on the calls to ratings
+			{SourceNamespace: "bookinfo", SourceService: "reviews", DestinationNamespace: "bookinfo", DestinationService: "ratings", RequestRate: 5, Reporter: "source"},
+			{SourceNamespace: "bookinfo", SourceService: "reviews", DestinationNamespace: "bookinfo", DestinationService: "ratings", RequestRate: 5, Reporter: "destination"},
+			// Only the client proxy reports the calls from the gateway
+			{SourceNamespace: "istio-system", SourceService: "ingressgateway", DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 3, Reporter: "source"},
+		},
+	}, nil)
+
+	resp, err := service.GetServiceConnections(context.Background(), &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		Perspective: typesv1alpha1.MetricsPerspective_METRICS_PERSPECTIVE_BOTH,
+	})
+	require.NoError(t, err)
+
+	inbound := make(map[string]*typesv1alpha1.AggregatedServicePairMetrics)
+	for _, pair := range resp.Inbound {
+		inbound[pair.SourceService] = pair
+	}
+	require.Len(t, inbound, 2)
+
+	productpage := inbound["productpage"]
+	require.NotNil(t, productpage)
+	assert.Equal(t, 10.0, productpage.RequestRate)
+	assert.Equal(t, 12.0, productpage.SourceReported.RequestRate)
+	assert.Equal(t, 2.0, productpage.SourceReported.ErrorRate)
+	assert.Equal(t, 10.0, productpage.DestinationReported.RequestRate)
+	assert.Equal(t, []string{
+		"source sent 2.00 more requests per second than the destination received",
+		"source saw 2.00 more errors per second than the destination reported, which usually indicates connection-level failures",
+	}, productpage.Discrepancies)
+
+	gateway := inbound["ingressgateway"]
+	require.NotNil(t, gateway)
+	assert.Equal(t, 3.0, gateway.RequestRate)
+	assert.Nil(t, gateway.DestinationReported)
+	assert.Equal(t, []string{"only reported by the source, requests may not be reaching the destination"}, gateway.Discrepancies)
+
+	require.Len(t, resp.Outbound, 1)
+	assert.Equal(t, 5.0, resp.Outbound[0].SourceReported.RequestRate)
+	assert.Equal(t, 5.0, resp.Outbound[0].DestinationReported.RequestRate)
+	assert.Empty(t, resp.Outbound[0].Discrepancies)
+}
+
+func TestMetricsService_GetServiceConnections_CanonicalService(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	service := NewMetricsService(mockConnManager, mockMetrics, &MockIstioService{}, logging.For("test"))
+
+	// The reviews service is backed by pods whose telemetry is reported under the reviews-app canonical service
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{
+		Name:      "reviews",
+		Namespace: "bookinfo",
+		Instances: []*connections.AggregatedServiceInstance{
+			{CanonicalService: "reviews-app", ProxyMode: typesv1alpha1.ProxyMode_SIDECAR},
+			{CanonicalService: "reviews-app", ProxyMode: typesv1alpha1.ProxyMode_SIDECAR},
+		},
+	}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}})
+	mockMetrics.On("GetServiceConnections", mock.Anything, "east", mock.Anything, typesv1alpha1.ProxyMode_SIDECAR).Return(&typesv1alpha1.ServiceGraphMetrics{
+		Pairs: []*typesv1alpha1.ServicePairMetrics{
+			{SourceNamespace: "bookinfo", SourceService: "productpage", DestinationNamespace: "bookinfo", DestinationService: "reviews-app", RequestRate: 10, Reporter: "destination"},
+			{SourceNamespace: "bookinfo", SourceService: "reviews-app", DestinationNamespace: "bookinfo", DestinationService: "ratings", RequestRate: 5, Reporter: "source"},
+		},
+	}, nil)
+
+	resp, err := service.GetServiceConnections(context.Background(), &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+	})
+	require.NoError(t, err)
+
+	query := mockMetrics.Calls[0].Arguments.Get(2).(*frontendv1alpha1.GetServiceConnectionsRequest)
+	assert.Equal(t, "reviews-app", query.ServiceName)
+	require.Len(t, resp.Inbound, 1)
+	assert.Equal(t, "productpage", resp.Inbound[0].SourceService)
+	require.Len(t, resp.Outbound, 1)
+	assert.Equal(t, "ratings", resp.Outbound[0].DestinationService)
+}
+
+func TestMetricsService_GetServiceConnections_Exemplars(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	service := NewMetricsService(mockConnManager, mockMetrics, &MockIstioService{}, logging.For("test"))
+
+	exemplar := func(traceID string, latency time.Duration, cluster string) *typesv1alpha1.LatencyExemplar {
+		return &typesv1alpha1.LatencyExemplar{TraceId: traceID, Latency: durationpb.New(latency), Cluster: cluster}
+	}
+	pair := func(cluster string, exemplars ...*typesv1alpha1.LatencyExemplar) *typesv1alpha1.ServiceGraphMetrics {
+		return &typesv1alpha1.ServiceGraphMetrics{
+			Pairs: []*typesv1alpha1.ServicePairMetrics{
+				{SourceCluster: cluster, SourceNamespace: "bookinfo", SourceService: "productpage", DestinationCluster: cluster, DestinationNamespace: "bookinfo", DestinationService: "reviews", RequestRate: 10, Exemplars: exemplars},
+			},
+		}
+	}
+
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{Name: "reviews", Namespace: "bookinfo"}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}, "west": {ClusterID: "west"}})
+	mockMetrics.On("GetServiceConnections", mock.Anything, "east", mock.Anything, typesv1alpha1.ProxyMode_SIDECAR).Return(pair("east",
+		exemplar("e1", 300*time.Millisecond, "east"),
+		exemplar("e2", 100*time.Millisecond, "east"),
+		exemplar("e3", 50*time.Millisecond, "east"),
+	), nil)
+	mockMetrics.On("GetServiceConnections", mock.Anything, "west", mock.Anything, typesv1alpha1.ProxyMode_SIDECAR).Return(pair("west",
+		exemplar("w1", 500*time.Millisecond, "west"),
+		exemplar("w2", 200*time.Millisecond, "west"),
+		exemplar("w3", 10*time.Millisecond, "west"),
+	), nil)
+
+	resp, err := service.GetServiceConnections(context.Background(), &frontendv1alpha1.GetServiceConnectionsRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Inbound, 1)
+
+	// The slowest exemplars across both clusters are returned, slowest first
+	var traceIDs []string
+	for _, exemplar := range resp.Inbound[0].Exemplars {
+		traceIDs = append(traceIDs, exemplar.TraceId)
+	}
+	assert.Equal(t, []string{"w1", "e1", "w2", "e2", "e3"}, traceIDs)
+	assert.Equal(t, "west", resp.Inbound[0].Exemplars[0].Cluster)
+}
+
+func TestPerspectiveDiscrepancies(t *testing.T) {
+	assert.Empty(t, perspectiveDiscrepancies(
+		&typesv1alpha1.PerspectiveMetrics{RequestRate: 100, ErrorRate: 1},
+		&typesv1alpha1.PerspectiveMetrics{RequestRate: 95, ErrorRate: 0},
+	))
+	assert.Equal(t, []string{"destination reported 20.00 more errors per second than the source saw, which may be hidden by retries"}, perspectiveDiscrepancies(
+		&typesv1alpha1.PerspectiveMetrics{RequestRate: 100},
+		&typesv1alpha1.PerspectiveMetrics{RequestRate: 100, ErrorRate: 20},
+	))
+	assert.Equal(t, []string{"only reported by the destination, the source may not have a proxy"}, perspectiveDiscrepancies(nil, &typesv1alpha1.PerspectiveMetrics{RequestRate: 1}))
+}
+
+func TestMetricsService_GetMeshMetricsTimeSeries(t *testing.T) {
+	mockConnManager := &MockMetricsConnectionManager{}
+	mockMetrics := &MockMeshMetricsProvider{}
+	service := NewMetricsService(mockConnManager, mockMetrics, &MockIstioService{}, logging.For("test"))
+
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+	reviews := &typesv1alpha1.ServicePair{SourceService: "productpage", SourceNamespace: "bookinfo", DestinationService: "reviews", DestinationNamespace: "bookinfo"}
+	ratings := &typesv1alpha1.ServicePair{SourceService: "reviews", SourceNamespace: "bookinfo", DestinationService: "ratings", DestinationNamespace: "bookinfo"}
+	latency := func(count float64) *typesv1alpha1.LatencyDistribution {
+		return &typesv1alpha1.LatencyDistribution{Buckets: []*typesv1alpha1.HistogramBucket{{Le: 100, Count: count}}, TotalCount: count}
+	}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"west":  {ClusterID: "west"},
+		"east":  {ClusterID: "east"},
+		"north": {ClusterID: "north"},
+	})
+	mockMetrics.On("GetMeshMetricsTimeSeries", mock.Anything, "east", mock.MatchedBy(func(req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) bool {
+		return req.Buckets == 2
+	})).Return(&typesv1alpha1.MeshMetricsTimeSeries{
+		Series: []*typesv1alpha1.PairTimeSeries{{Pair: reviews, Buckets: []*typesv1alpha1.MetricsBucket{
+			{RequestRate: 10, ErrorRate: 1, LatencyDistribution: latency(10)},
+			{RequestRate: 20},
+		}}},
+	}, nil)
+	mockMetrics.On("GetMeshMetricsTimeSeries", mock.Anything, "west", mock.Anything).Return(&typesv1alpha1.MeshMetricsTimeSeries{
+		Series: []*typesv1alpha1.PairTimeSeries{{Pair: reviews, Buckets: []*typesv1alpha1.MetricsBucket{
+			{RequestRate: 5, LatencyDistribution: latency(5)},
+			{RequestRate: 5, ErrorRate: 2},
+		}}},
+	}, nil)
+	mockMetrics.On("GetMeshMetricsTimeSeries", mock.Anything, "north", mock.Anything).Return(nil, errors.New("metrics provider not available"))
+
+	resp, err := service.GetMeshMetricsTimeSeries(context.Background(), &frontendv1alpha1.GetMeshMetricsTimeSeriesRequest{
+		Pairs:     []*typesv1alpha1.ServicePair{ratings, reviews},
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Buckets:   2,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"east", "west"}, resp.ClustersQueried)
+	assert.Equal(t, []string{"failed to retrieve metrics from cluster north: metrics provider not available"}, resp.Warnings)
+
+	// Series are returned in the order requested, pairs without traffic have empty buckets
+	require.Len(t, resp.Series, 2)
+	assert.Equal(t, "ratings", resp.Series[0].Pair.DestinationService)
+	require.Len(t, resp.Series[0].Buckets, 2)
+	assert.Zero(t, resp.Series[0].Buckets[0].RequestRate)
+
+	merged := resp.Series[1]
+	require.Len(t, merged.Buckets, 2)
+	assert.Equal(t, start, merged.Buckets[0].StartTime.AsTime())
+	assert.Equal(t, start.Add(time.Minute), merged.Buckets[0].EndTime.AsTime())
+	assert.Equal(t, 15.0, merged.Buckets[0].RequestRate)
+	assert.Equal(t, 1.0, merged.Buckets[0].ErrorRate)
+	assert.Positive(t, merged.Buckets[0].LatencyP99.AsDuration())
+	assert.Nil(t, merged.Buckets[0].LatencyDistribution)
+	assert.Equal(t, 25.0, merged.Buckets[1].RequestRate)
+	assert.Equal(t, 2.0, merged.Buckets[1].ErrorRate)
+}
+
+func TestMetricsService_GetMeshMetricsTimeSeries_InvalidRequest(t *testing.T) {
+	service := NewMetricsService(&MockMetricsConnectionManager{}, &MockMeshMetricsProvider{}, &MockIstioService{}, logging.For("test"))
+	pair := &typesv1alpha1.ServicePair{SourceService: "productpage", SourceNamespace: "bookinfo", DestinationService: "reviews", DestinationNamespace: "bookinfo"}
+	end := time.Now().Add(-time.Minute)
+	start := end.Add(-time.Hour)
+
+	for name, req := range map[string]*frontendv1alpha1.GetMeshMetricsTimeSeriesRequest{
+		"no pairs":         {StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)},
+		"incomplete pair":  {Pairs: []*typesv1alpha1.ServicePair{{SourceService: "productpage"}}, StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)},
+		"no time window":   {Pairs: []*typesv1alpha1.ServicePair{pair}},
+		"reversed window":  {Pairs: []*typesv1alpha1.ServicePair{pair}, StartTime: timestamppb.New(end), EndTime: timestamppb.New(start)},
+		"too many buckets": {Pairs: []*typesv1alpha1.ServicePair{pair}, StartTime: timestamppb.New(start), EndTime: timestamppb.New(end), Buckets: 121},
+		"window too short": {Pairs: []*typesv1alpha1.ServicePair{pair}, StartTime: timestamppb.New(end.Add(-10 * time.Second)), EndTime: timestamppb.New(end)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := service.GetMeshMetricsTimeSeries(context.Background(), req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/base64"
+	"errors"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// encodePageToken encodes the offset of the next page along with a hash of the filters the page was listed with.
+// Tokens are opaque to clients, and a token is only accepted back with the same filters.
+func encodePageToken(offset int, filters ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + pageTokenHash(filters)))
+}
+
+// decodePageToken returns the offset a page token continues from, or 0 for an empty token. Tokens that were
+// not issued for the same filters are rejected.
+func decodePageToken(token string, filters ...string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errInvalidPageToken
+	}
+	offsetText, hash, found := strings.Cut(string(data), ":")
+	offset, err := strconv.Atoi(offsetText)
+	if !found || err != nil || offset < 0 {
+		return 0, errInvalidPageToken
+	}
+	if hash != pageTokenHash(filters) {
+		return 0, errors.New("page token was issued for different filters")
+	}
+	return offset, nil
+}
+
+// pageTokenHash hashes the filters a page was listed with
+func pageTokenHash(filters []string) string {
+	h := fnv.New64a()
+	for _, filter := range filters {
+		_, _ = h.Write([]byte(filter))
+		_, _ = h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	token := encodePageToken(42, "cluster-1", "default")
+	assert.NotContains(t, token, "42", "offset is not readable from the token")
+
+	offset, err := decodePageToken(token, "cluster-1", "default")
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+
+	offset, err = decodePageToken("")
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+
+	_, err = decodePageToken(token, "cluster-1", "istio-system")
+	assert.ErrorContains(t, err, "different filters")
+
+	_, err = decodePageToken(token, "cluster-1default")
+	assert.Error(t, err, "filters are delimited in the hash")
+
+	for _, invalid := range []string{"42", "not base64!", base64.RawURLEncoding.EncodeToString([]byte("-1:x"))} {
+		_, err = decodePageToken(invalid)
+		assert.ErrorIs(t, err, errInvalidPageToken, invalid)
+	}
+}
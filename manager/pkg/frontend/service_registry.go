@@ -15,15 +15,48 @@
 package frontend
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/liamawhite/navigator/manager/pkg/connections"
 	"github.com/liamawhite/navigator/manager/pkg/providers"
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/envoy/admin"
+	"github.com/liamawhite/navigator/pkg/istio/proxy/routesim"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// maxConcurrentProxyConfigFetches bounds how many proxy configs are fetched at once for a single service
+	maxConcurrentProxyConfigFetches = 8
+
+	// defaultIstioResourcePageSize and maxIstioResourcePageSize bound ListIstioResources pages
+	defaultIstioResourcePageSize = 100
+	maxIstioResourcePageSize     = 500
+
+	// maxIstioResourceDownloadBytes bounds the manifests DownloadIstioResources buffers for a single download
+	maxIstioResourceDownloadBytes = 64 << 20
+
+	// defaultServiceInstancePageSize and maxServiceInstancePageSize bound ListServiceInstances pages
+	defaultServiceInstancePageSize = 100
+	maxServiceInstancePageSize     = 500
 )
 
 // ServiceRegistryService implements the frontend ServiceRegistryService
@@ -32,16 +65,22 @@ type ServiceRegistryService struct {
 	connectionManager providers.ReadOptimizedConnectionManager
 	proxyProvider     providers.ProxyConfigProvider
 	istioProvider     providers.IstioResourcesProvider
+	logsProvider      providers.PodLogsProvider
+	adminProvider     providers.EnvoyAdminProvider
 	logger            *slog.Logger
+	downloadLimit     int // Bytes of manifests a single DownloadIstioResources call may buffer
 }
 
 // NewServiceRegistryService creates a new service registry service
-func NewServiceRegistryService(connectionManager providers.ReadOptimizedConnectionManager, proxyProvider providers.ProxyConfigProvider, istioProvider providers.IstioResourcesProvider, logger *slog.Logger) *ServiceRegistryService {
+func NewServiceRegistryService(connectionManager providers.ReadOptimizedConnectionManager, proxyProvider providers.ProxyConfigProvider, istioProvider providers.IstioResourcesProvider, logsProvider providers.PodLogsProvider, adminProvider providers.EnvoyAdminProvider, logger *slog.Logger) *ServiceRegistryService {
 	return &ServiceRegistryService{
 		connectionManager: connectionManager,
 		proxyProvider:     proxyProvider,
 		istioProvider:     istioProvider,
+		logsProvider:      logsProvider,
+		adminProvider:     adminProvider,
 		logger:            logger,
+		downloadLimit:     maxIstioResourceDownloadBytes,
 	}
 }
 
@@ -49,6 +88,11 @@ func NewServiceRegistryService(connectionManager providers.ReadOptimizedConnecti
 func (s *ServiceRegistryService) ListServices(ctx context.Context, req *frontendv1alpha1.ListServicesRequest) (*frontendv1alpha1.ListServicesResponse, error) {
 	s.logger.Debug("listing services", "namespace", req.Namespace, "cluster_id", req.ClusterId)
 
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	namespace := ""
 	clusterID := ""
 
@@ -59,18 +103,62 @@ func (s *ServiceRegistryService) ListServices(ctx context.Context, req *frontend
 		clusterID = *req.ClusterId
 	}
 
-	aggServices := s.connectionManager.ListAggregatedServices(namespace, clusterID)
+	aggServices := scopedConnections(ctx, s.connectionManager).ListAggregatedServices(namespace, clusterID)
 	services := make([]*frontendv1alpha1.Service, 0, len(aggServices))
+	clusterIDs := make(map[string]struct{})
 
 	for _, aggService := range aggServices {
 		service := convertAggregatedService(aggService)
 		services = append(services, service)
+		for id := range aggService.ClusterMap {
+			clusterIDs[id] = struct{}{}
+		}
+		for id := range aggService.ExternalNames {
+			clusterIDs[id] = struct{}{}
+		}
 	}
 
 	s.logger.Debug("listed services", "count", len(services))
 
 	return &frontendv1alpha1.ListServicesResponse{
-		Services: services,
+		Services:     services,
+		SyncMetadata: s.syncMetadataForClusters(ctx, clusterIDs),
+	}, nil
+}
+
+// ListCanonicalServices groups the instances of services by their Istio canonical service
+func (s *ServiceRegistryService) ListCanonicalServices(ctx context.Context, req *frontendv1alpha1.ListCanonicalServicesRequest) (*frontendv1alpha1.ListCanonicalServicesResponse, error) {
+	s.logger.Debug("listing canonical services", "namespace", req.Namespace, "cluster_id", req.ClusterId)
+
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	aggServices := scopedConnections(ctx, s.connectionManager).ListAggregatedServices(req.GetNamespace(), req.GetClusterId())
+
+	// Services are listed by cluster as a whole, so instances of other clusters are dropped when filtering by one
+	if clusterID := req.GetClusterId(); clusterID != "" {
+		filtered := make([]*connections.AggregatedService, 0, len(aggServices))
+		for _, aggService := range aggServices {
+			filtered = append(filtered, &connections.AggregatedService{ID: aggService.ID, Instances: aggService.ClusterMap[clusterID]})
+		}
+		aggServices = filtered
+	}
+
+	canonicalServices := groupCanonicalServices(aggServices)
+	clusterIDs := make(map[string]struct{})
+	for _, canonicalService := range canonicalServices {
+		for _, id := range canonicalService.Clusters {
+			clusterIDs[id] = struct{}{}
+		}
+	}
+
+	s.logger.Debug("listed canonical services", "count", len(canonicalServices))
+
+	return &frontendv1alpha1.ListCanonicalServicesResponse{
+		CanonicalServices: canonicalServices,
+		SyncMetadata:      s.syncMetadataForClusters(ctx, clusterIDs),
 	}, nil
 }
 
@@ -78,7 +166,7 @@ func (s *ServiceRegistryService) ListServices(ctx context.Context, req *frontend
 func (s *ServiceRegistryService) GetService(ctx context.Context, req *frontendv1alpha1.GetServiceRequest) (*frontendv1alpha1.GetServiceResponse, error) {
 	s.logger.Debug("getting service", "id", req.Id)
 
-	aggService, exists := s.connectionManager.GetAggregatedService(req.Id)
+	aggService, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedService(req.Id)
 	if !exists {
 		return nil, status.Errorf(codes.NotFound, "service not found: %s", req.Id)
 	}
@@ -87,8 +175,17 @@ func (s *ServiceRegistryService) GetService(ctx context.Context, req *frontendv1
 
 	s.logger.Debug("got service", "id", req.Id, "instances", len(service.Instances))
 
+	clusterIDs := make(map[string]struct{}, len(aggService.ClusterMap))
+	for id := range aggService.ClusterMap {
+		clusterIDs[id] = struct{}{}
+	}
+	for id := range aggService.ExternalNames {
+		clusterIDs[id] = struct{}{}
+	}
+
 	return &frontendv1alpha1.GetServiceResponse{
-		Service: service,
+		Service:      service,
+		SyncMetadata: s.syncMetadataForClusters(ctx, clusterIDs),
 	}, nil
 }
 
@@ -96,7 +193,7 @@ func (s *ServiceRegistryService) GetService(ctx context.Context, req *frontendv1
 func (s *ServiceRegistryService) GetServiceInstance(ctx context.Context, req *frontendv1alpha1.GetServiceInstanceRequest) (*frontendv1alpha1.GetServiceInstanceResponse, error) {
 	s.logger.Debug("getting service instance", "service_id", req.ServiceId, "instance_id", req.InstanceId)
 
-	aggInstance, exists := s.connectionManager.GetAggregatedServiceInstance(req.InstanceId)
+	aggInstance, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId)
 	if !exists {
 		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
 	}
@@ -106,13 +203,118 @@ func (s *ServiceRegistryService) GetServiceInstance(ctx context.Context, req *fr
 	s.logger.Debug("got service instance", "instance_id", req.InstanceId)
 
 	return &frontendv1alpha1.GetServiceInstanceResponse{
-		Instance: instance,
+		Instance:     instance,
+		SyncMetadata: s.syncMetadataForCluster(ctx, aggInstance.ClusterName),
 	}, nil
 }
 
+// ListServiceInstances returns a page of the service instances matching the filters, across all services
+func (s *ServiceRegistryService) ListServiceInstances(ctx context.Context, req *frontendv1alpha1.ListServiceInstancesRequest) (*frontendv1alpha1.ListServiceInstancesResponse, error) {
+	s.logger.Debug("listing service instances", "cluster_id", req.ClusterId, "namespace", req.Namespace, "node_name", req.NodeName,
+		"envoy_present", req.EnvoyPresent, "label_selector", req.LabelSelector, "health", req.Health, "page_token", req.PageToken)
+
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := labels.Parse(req.LabelSelector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label selector: %v", err)
+	}
+	if _, ok := frontendv1alpha1.InstanceHealth_name[int32(req.Health)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown instance health: %d", req.Health)
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultServiceInstancePageSize
+	}
+	pageSize = min(pageSize, maxServiceInstancePageSize)
+
+	pageFilters := serviceInstancePageFilters(req)
+	offset, err := decodePageToken(req.PageToken, pageFilters...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %s", err, req.PageToken)
+	}
+
+	type serviceInstance struct {
+		serviceID string
+		instance  *connections.AggregatedServiceInstance
+	}
+
+	var matches []serviceInstance
+	clusterIDs := make(map[string]struct{})
+	for _, aggService := range scopedConnections(ctx, s.connectionManager).ListAggregatedServices(req.GetNamespace(), req.GetClusterId()) {
+		instances := aggService.Instances
+		if req.ClusterId != nil {
+			instances = aggService.ClusterMap[req.GetClusterId()]
+		}
+		for _, instance := range instances {
+			if !matchesInstanceFilters(instance, req, selector) {
+				continue
+			}
+			matches = append(matches, serviceInstance{serviceID: aggService.ID, instance: instance})
+			clusterIDs[instance.ClusterName] = struct{}{}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i].instance, matches[j].instance
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.PodName != b.PodName {
+			return a.PodName < b.PodName
+		}
+		return matches[i].serviceID < matches[j].serviceID
+	})
+
+	total := len(matches)
+	page := matches[min(offset, total):min(offset+pageSize, total)]
+	instances := make([]*frontendv1alpha1.ServiceInstanceDetail, len(page))
+	for i, match := range page {
+		instances[i] = convertAggregatedServiceInstanceToDetail(match.instance)
+		instances[i].ServiceName = match.serviceID
+	}
+
+	nextPageToken := ""
+	if next := offset + len(instances); next < total {
+		nextPageToken = encodePageToken(next, pageFilters...)
+	}
+
+	s.logger.Debug("listed service instances", "total", total, "returned", len(instances))
+
+	return &frontendv1alpha1.ListServiceInstancesResponse{
+		Instances:     instances,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(min(total, math.MaxInt32)), // #nosec G115 - bounds checked
+		SyncMetadata:  s.syncMetadataForClusters(ctx, clusterIDs),
+	}, nil
+}
+
+// serviceInstancePageFilters returns the filters of a ListServiceInstances request its page tokens are issued for
+func serviceInstancePageFilters(req *frontendv1alpha1.ListServiceInstancesRequest) []string {
+	return []string{
+		req.ClusterSelector,
+		fmt.Sprint(req.ClusterId != nil, req.GetClusterId()),
+		fmt.Sprint(req.Namespace != nil, req.GetNamespace()),
+		fmt.Sprint(req.NodeName != nil, req.GetNodeName()),
+		fmt.Sprint(req.EnvoyPresent != nil, req.GetEnvoyPresent()),
+		req.LabelSelector,
+		req.Health.String(),
+	}
+}
+
 // GetProxyConfig retrieves the Envoy proxy configuration for a specific service instance
 func (s *ServiceRegistryService) GetProxyConfig(ctx context.Context, req *frontendv1alpha1.GetProxyConfigRequest) (*frontendv1alpha1.GetProxyConfigResponse, error) {
-	s.logger.Debug("getting proxy config", "service_id", req.ServiceId, "instance_id", req.InstanceId)
+	s.logger.Debug("getting proxy config", "service_id", req.ServiceId, "instance_id", req.InstanceId, "force_refresh", req.GetForceRefresh())
 
 	// Parse instance ID to extract cluster, namespace, and pod name
 	clusterID, namespace, podName, err := parseInstanceID(req.InstanceId)
@@ -122,14 +324,16 @@ func (s *ServiceRegistryService) GetProxyConfig(ctx context.Context, req *fronte
 	}
 
 	// Verify the instance exists
-	_, exists := s.connectionManager.GetAggregatedServiceInstance(req.InstanceId)
+	_, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId)
 	if !exists {
 		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
 		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
 	}
 
 	// Request proxy configuration from the appropriate edge cluster
-	proxyConfig, err := s.proxyProvider.GetProxyConfig(ctx, clusterID, namespace, podName)
+	snapshot, err := s.proxyProvider.GetProxyConfig(ctx, clusterID, namespace, podName, providers.ProxyConfigOptions{
+		ForceRefresh: req.GetForceRefresh(),
+	})
 	if err != nil {
 		s.logger.Error("failed to get proxy config",
 			"instance_id", req.InstanceId,
@@ -137,19 +341,139 @@ func (s *ServiceRegistryService) GetProxyConfig(ctx context.Context, req *fronte
 			"namespace", namespace,
 			"pod_name", podName,
 			"error", err)
-		return nil, status.Errorf(codes.Internal, "failed to retrieve proxy configuration: %v", err)
+		return nil, status.Errorf(edgeRequestCode(err, codes.Internal), "failed to retrieve proxy configuration: %v", err)
 	}
 
 	s.logger.Debug("got proxy config",
 		"instance_id", req.InstanceId,
 		"cluster_id", clusterID,
-		"version", proxyConfig.Version)
+		"version", snapshot.ProxyConfig.Version,
+		"cached", snapshot.Cached)
 
 	return &frontendv1alpha1.GetProxyConfigResponse{
-		ProxyConfig: proxyConfig,
+		ProxyConfig:  snapshot.ProxyConfig,
+		SyncMetadata: s.syncMetadataForCluster(ctx, clusterID),
+		Freshness:    convertProxyConfigSnapshotToFreshness(snapshot, time.Now()),
+	}, nil
+}
+
+// GetServiceProxyConfigs retrieves the proxy configuration of every instance of a service in parallel
+func (s *ServiceRegistryService) GetServiceProxyConfigs(ctx context.Context, req *frontendv1alpha1.GetServiceProxyConfigsRequest) (*frontendv1alpha1.GetServiceProxyConfigsResponse, error) {
+	s.logger.Debug("getting service proxy configs", "service_id", req.ServiceId, "force_refresh", req.GetForceRefresh())
+
+	aggService, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedService(req.ServiceId)
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "service not found: %s", req.ServiceId)
+	}
+
+	instances := make([]*connections.AggregatedServiceInstance, len(aggService.Instances))
+	copy(instances, aggService.Instances)
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].InstanceID < instances[j].InstanceID
+	})
+
+	options := providers.ProxyConfigOptions{ForceRefresh: req.GetForceRefresh()}
+	results := make([]*frontendv1alpha1.InstanceProxyConfig, len(instances))
+	sem := make(chan struct{}, maxConcurrentProxyConfigFetches)
+	var wg sync.WaitGroup
+
+	for i, instance := range instances {
+		result := &frontendv1alpha1.InstanceProxyConfig{
+			InstanceId:  instance.InstanceID,
+			ClusterName: instance.ClusterName,
+		}
+		results[i] = result
+
+		if !instance.EnvoyPresent {
+			result.Result = &frontendv1alpha1.InstanceProxyConfig_ErrorMessage{ErrorMessage: "instance has no Envoy proxy"}
+			continue
+		}
+
+		wg.Add(1)
+		go func(instance *connections.AggregatedServiceInstance) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			snapshot, err := s.proxyProvider.GetProxyConfig(ctx, instance.ClusterName, instance.Namespace, instance.PodName, options)
+			if err != nil {
+				s.logger.Warn("failed to get proxy config for service instance",
+					"service_id", req.ServiceId,
+					"instance_id", instance.InstanceID,
+					"error", err)
+				result.Result = &frontendv1alpha1.InstanceProxyConfig_ErrorMessage{ErrorMessage: err.Error()}
+				return
+			}
+			result.Result = &frontendv1alpha1.InstanceProxyConfig_ProxyConfig{ProxyConfig: snapshot.ProxyConfig}
+			result.Freshness = convertProxyConfigSnapshotToFreshness(snapshot, time.Now())
+		}(instance)
+	}
+	wg.Wait()
+
+	s.logger.Debug("got service proxy configs", "service_id", req.ServiceId, "instances", len(results))
+
+	return &frontendv1alpha1.GetServiceProxyConfigsResponse{
+		Instances: results,
 	}, nil
 }
 
+// GetProxyConfigDump returns the full raw Envoy config_dump for a specific service instance as a file download
+func (s *ServiceRegistryService) GetProxyConfigDump(ctx context.Context, req *frontendv1alpha1.GetProxyConfigDumpRequest) (*httpbody.HttpBody, error) {
+	s.logger.Debug("getting proxy config dump", "service_id", req.ServiceId, "instance_id", req.InstanceId, "gzip", req.GetGzip())
+
+	clusterID, namespace, podName, err := parseInstanceID(req.InstanceId)
+	if err != nil {
+		s.logger.Warn("invalid instance ID format", "instance_id", req.InstanceId, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance ID format: %v", err)
+	}
+
+	if _, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId); !exists {
+		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
+		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
+	}
+
+	query := ""
+	if req.GetIncludeEds() {
+		query = "include_eds"
+	}
+
+	configDump, err := s.adminProvider.GetEnvoyAdmin(ctx, clusterID, namespace, podName, "config_dump", query)
+	if err != nil {
+		s.logger.Error("failed to get proxy config dump",
+			"instance_id", req.InstanceId,
+			"cluster_id", clusterID,
+			"error", err)
+		return nil, status.Errorf(edgeRequestCode(err, codes.Internal), "failed to retrieve proxy config dump: %v", err)
+	}
+
+	body := &httpbody.HttpBody{
+		ContentType: "application/json",
+		Data:        []byte(configDump),
+	}
+	filename := fmt.Sprintf("%s_%s_config_dump.json", clusterID, podName)
+
+	if req.GetGzip() {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := gz.Write(body.Data); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compress proxy config dump: %v", err)
+		}
+		if err := gz.Close(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compress proxy config dump: %v", err)
+		}
+		body.ContentType = "application/gzip"
+		body.Data = buf.Bytes()
+		filename += ".gz"
+	}
+
+	// Ask the HTTP gateway to serve the dump as an attachment
+	if err := grpc.SetHeader(ctx, metadata.Pairs("content-disposition", fmt.Sprintf("attachment; filename=%q", filename))); err != nil {
+		s.logger.Debug("failed to set content-disposition header", "error", err)
+	}
+
+	return body, nil
+}
+
 // GetIstioResources retrieves the Istio configuration resources for a specific service instance
 func (s *ServiceRegistryService) GetIstioResources(ctx context.Context, req *frontendv1alpha1.GetIstioResourcesRequest) (*frontendv1alpha1.GetIstioResourcesResponse, error) {
 	s.logger.Debug("getting istio resources", "service_id", req.ServiceId, "instance_id", req.InstanceId)
@@ -162,7 +486,7 @@ func (s *ServiceRegistryService) GetIstioResources(ctx context.Context, req *fro
 	}
 
 	// Get the service instance to extract labels
-	aggInstance, exists := s.connectionManager.GetAggregatedServiceInstance(req.InstanceId)
+	aggInstance, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId)
 	if !exists {
 		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
 		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
@@ -170,7 +494,8 @@ func (s *ServiceRegistryService) GetIstioResources(ctx context.Context, req *fro
 
 	// Convert to ServiceInstance for the istio provider
 	serviceInstance := &backendv1alpha1.ServiceInstance{
-		Labels: aggInstance.Labels,
+		Labels:   aggInstance.Labels,
+		Policies: aggInstance.Policies,
 	}
 
 	// Request Istio resources from the appropriate cluster
@@ -191,9 +516,451 @@ func (s *ServiceRegistryService) GetIstioResources(ctx context.Context, req *fro
 		"virtual_services", len(istioResources.VirtualServices),
 		"destination_rules", len(istioResources.DestinationRules))
 
+	istioResources.SyncMetadata = s.syncMetadataForCluster(ctx, clusterID)
+
 	return istioResources, nil
 }
 
+// ListIstioResources returns a page of the Istio resources collected from connected clusters
+func (s *ServiceRegistryService) ListIstioResources(ctx context.Context, req *frontendv1alpha1.ListIstioResourcesRequest) (*frontendv1alpha1.ListIstioResourcesResponse, error) {
+	s.logger.Debug("listing istio resources", "namespace", req.Namespace, "cluster_id", req.ClusterId, "kinds", req.Kinds, "page_token", req.PageToken)
+
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kind := range req.Kinds {
+		if kind == typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "resource kind must be specified")
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	if _, ok := frontendv1alpha1.RawConfigFormat_name[int32(req.RawConfigFormat)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown raw config format: %d", req.RawConfigFormat)
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultIstioResourcePageSize
+	}
+	pageSize = min(pageSize, maxIstioResourcePageSize)
+
+	filter := providers.IstioResourceFilter{
+		ClusterID: req.GetClusterId(),
+		Namespace: req.GetNamespace(),
+		Kinds:     req.Kinds,
+	}
+	pageFilters := []string{req.ClusterSelector, filter.ClusterID, filter.Namespace, fmt.Sprint(filter.Kinds)}
+	offset, err := decodePageToken(req.PageToken, pageFilters...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %s", err, req.PageToken)
+	}
+
+	resources, total, err := s.istioProvider.ListIstioResources(ctx, filter, offset, pageSize)
+	if err != nil {
+		s.logger.Error("failed to list istio resources", "cluster_id", filter.ClusterID, "namespace", filter.Namespace, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list istio resources: %v", err)
+	}
+
+	if req.RawConfigFormat == frontendv1alpha1.RawConfigFormat_RAW_CONFIG_FORMAT_YAML {
+		for _, resource := range resources {
+			// Edges that do not collect raw config send resources without it
+			if resource.RawConfig == "" {
+				continue
+			}
+			yamlConfig, err := rawconfig.YAML(resource.RawConfig)
+			if err != nil {
+				s.logger.Error("failed to convert raw config to yaml", "cluster_id", resource.ClusterId, "namespace", resource.Namespace, "name", resource.Name, "error", err)
+				return nil, status.Errorf(codes.Internal, "failed to convert %s/%s to yaml: %v", resource.Namespace, resource.Name, err)
+			}
+			resource.RawConfig = yamlConfig
+		}
+	}
+
+	nextPageToken := ""
+	if next := offset + len(resources); next < total {
+		nextPageToken = encodePageToken(next, pageFilters...)
+	}
+
+	clusterIDs := make(map[string]struct{})
+	if filter.ClusterID != "" {
+		clusterIDs[filter.ClusterID] = struct{}{}
+	} else {
+		for id := range scopedConnections(ctx, s.connectionManager).GetConnectionInfo() {
+			clusterIDs[id] = struct{}{}
+		}
+	}
+
+	s.logger.Debug("listed istio resources", "total", total, "returned", len(resources))
+
+	return &frontendv1alpha1.ListIstioResourcesResponse{
+		Resources:     resources,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(min(total, math.MaxInt32)), // #nosec G115 - bounds checked
+		SyncMetadata:  s.syncMetadataForClusters(ctx, clusterIDs),
+	}, nil
+}
+
+// DownloadIstioResources returns the Istio resources matching the filters as a multi-document YAML file of
+// cleaned manifests, each preceded by a comment naming the cluster it was collected from
+func (s *ServiceRegistryService) DownloadIstioResources(ctx context.Context, req *frontendv1alpha1.DownloadIstioResourcesRequest) (*httpbody.HttpBody, error) {
+	s.logger.Debug("downloading istio resources", "namespace", req.Namespace, "cluster_id", req.ClusterId, "kinds", req.Kinds)
+
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kind := range req.Kinds {
+		if kind == typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "resource kind must be specified")
+		}
+	}
+
+	filter := providers.IstioResourceFilter{
+		ClusterID: req.GetClusterId(),
+		Namespace: req.GetNamespace(),
+		Kinds:     req.Kinds,
+	}
+
+	var buf bytes.Buffer
+	count := 0
+	for offset := 0; ; offset += maxIstioResourcePageSize {
+		resources, total, err := s.istioProvider.ListIstioResources(ctx, filter, offset, maxIstioResourcePageSize)
+		if err != nil {
+			s.logger.Error("failed to list istio resources", "cluster_id", filter.ClusterID, "namespace", filter.Namespace, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list istio resources: %v", err)
+		}
+
+		for _, resource := range resources {
+			// Edges that do not collect raw config send resources without it, so they have no manifest
+			if resource.RawConfig == "" {
+				fmt.Fprintf(&buf, "# %s/%s in cluster %s omitted: its edge does not collect raw config\n", resource.Namespace, resource.Name, resource.ClusterId)
+				continue
+			}
+			manifest, err := rawconfig.YAML(resource.RawConfig)
+			if err != nil {
+				s.logger.Error("failed to convert raw config to yaml", "cluster_id", resource.ClusterId, "namespace", resource.Namespace, "name", resource.Name, "error", err)
+				return nil, status.Errorf(codes.Internal, "failed to convert %s/%s to yaml: %v", resource.Namespace, resource.Name, err)
+			}
+			if count > 0 {
+				buf.WriteString("---\n")
+			}
+			fmt.Fprintf(&buf, "# cluster: %s\n", resource.ClusterId)
+			buf.WriteString(manifest)
+			count++
+		}
+		if buf.Len() > s.downloadLimit {
+			return nil, status.Errorf(codes.ResourceExhausted, "istio resources exceed the download limit of %d bytes, narrow the download by cluster, namespace or kind", s.downloadLimit)
+		}
+
+		if len(resources) == 0 || offset+len(resources) >= total {
+			break
+		}
+	}
+
+	s.logger.Debug("downloaded istio resources", "count", count)
+
+	filename := "istio-resources"
+	if filter.ClusterID != "" {
+		filename += "_" + filter.ClusterID
+	}
+	if filter.Namespace != "" {
+		filename += "_" + filter.Namespace
+	}
+	filename += ".yaml"
+
+	// Ask the HTTP gateway to serve the manifests as an attachment
+	if err := grpc.SetHeader(ctx, metadata.Pairs("content-disposition", fmt.Sprintf("attachment; filename=%q", filename))); err != nil {
+		s.logger.Debug("failed to set content-disposition header", "error", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/yaml",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// GetInstanceLogs retrieves container logs for a specific service instance
+func (s *ServiceRegistryService) GetInstanceLogs(ctx context.Context, req *frontendv1alpha1.GetInstanceLogsRequest) (*frontendv1alpha1.GetInstanceLogsResponse, error) {
+	s.logger.Debug("getting instance logs", "service_id", req.ServiceId, "instance_id", req.InstanceId, "container", req.GetContainer())
+
+	clusterID, namespace, podName, err := parseInstanceID(req.InstanceId)
+	if err != nil {
+		s.logger.Warn("invalid instance ID format", "instance_id", req.InstanceId, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance ID format: %v", err)
+	}
+
+	if req.GetTailLines() < 0 || req.GetSinceSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "tail_lines and since_seconds must not be negative")
+	}
+
+	if _, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId); !exists {
+		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
+		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
+	}
+
+	logs, err := s.logsProvider.GetPodLogs(ctx, clusterID, namespace, podName, providers.PodLogOptions{
+		Container:    req.GetContainer(),
+		TailLines:    req.GetTailLines(),
+		SinceSeconds: req.GetSinceSeconds(),
+		Previous:     req.GetPrevious(),
+	})
+	if err != nil {
+		s.logger.Error("failed to get instance logs",
+			"instance_id", req.InstanceId,
+			"cluster_id", clusterID,
+			"container", req.GetContainer(),
+			"error", err)
+		return nil, status.Errorf(edgeRequestCode(err, codes.Internal), "failed to retrieve instance logs: %v", err)
+	}
+
+	return &frontendv1alpha1.GetInstanceLogsResponse{
+		Logs: logs,
+	}, nil
+}
+
+// GetEnvoyAdmin queries an allowlisted Envoy admin endpoint on a specific service instance's proxy
+func (s *ServiceRegistryService) GetEnvoyAdmin(ctx context.Context, req *frontendv1alpha1.GetEnvoyAdminRequest) (*frontendv1alpha1.GetEnvoyAdminResponse, error) {
+	s.logger.Debug("getting envoy admin output", "service_id", req.ServiceId, "instance_id", req.InstanceId, "path", req.Path)
+
+	clusterID, namespace, podName, err := parseInstanceID(req.InstanceId)
+	if err != nil {
+		s.logger.Warn("invalid instance ID format", "instance_id", req.InstanceId, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance ID format: %v", err)
+	}
+
+	requestPath, err := admin.BuildRequestPath(req.Path, req.GetQuery())
+	if err != nil {
+		s.logger.Warn("rejected envoy admin path", "instance_id", req.InstanceId, "path", req.Path, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if _, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId); !exists {
+		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
+		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
+	}
+
+	output, err := s.adminProvider.GetEnvoyAdmin(ctx, clusterID, namespace, podName, req.Path, req.GetQuery())
+	if err != nil {
+		s.logger.Error("failed to get envoy admin output",
+			"instance_id", req.InstanceId,
+			"cluster_id", clusterID,
+			"path", requestPath,
+			"error", err)
+		return nil, status.Errorf(edgeRequestCode(err, codes.Internal), "failed to query envoy admin endpoint: %v", err)
+	}
+
+	return &frontendv1alpha1.GetEnvoyAdminResponse{
+		Path:   requestPath,
+		Output: output,
+	}, nil
+}
+
+// GetResourceInventory returns the number of Istio resources of each kind per namespace per cluster
+func (s *ServiceRegistryService) GetResourceInventory(ctx context.Context, req *frontendv1alpha1.GetResourceInventoryRequest) (*frontendv1alpha1.GetResourceInventoryResponse, error) {
+	s.logger.Debug("getting resource inventory", "cluster_id", req.ClusterId)
+
+	ctx, err := selectClusters(ctx, s.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.istioProvider.GetResourceInventory(ctx, req.GetClusterId())
+	if err != nil {
+		s.logger.Error("failed to get resource inventory", "cluster_id", req.GetClusterId(), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get resource inventory: %v", err)
+	}
+
+	clusterIDs := make(map[string]struct{}, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusterIDs[cluster.ClusterId] = struct{}{}
+	}
+	resp.SyncMetadata = s.syncMetadataForClusters(ctx, clusterIDs)
+
+	return resp, nil
+}
+
+// GetResourceReferences returns the references to and from a resource in its cluster's reference graph
+func (s *ServiceRegistryService) GetResourceReferences(ctx context.Context, req *frontendv1alpha1.GetResourceReferencesRequest) (*frontendv1alpha1.GetResourceReferencesResponse, error) {
+	s.logger.Debug("getting resource references", "cluster_id", req.ClusterId, "kind", req.Kind, "namespace", req.Namespace, "name", req.Name)
+
+	if req.ClusterId == "" || req.Kind == "" || req.Namespace == "" || req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster_id, kind, namespace and name are required")
+	}
+
+	if _, exists := scopedConnections(ctx, s.connectionManager).GetConnectionInfo()[req.ClusterId]; !exists {
+		s.logger.Warn("cluster not found", "cluster_id", req.ClusterId)
+		return nil, status.Errorf(codes.NotFound, "cluster not found: %s", req.ClusterId)
+	}
+
+	resp, err := s.istioProvider.GetResourceReferences(ctx, req.ClusterId, req.Kind, req.Namespace, req.Name)
+	if errors.Is(err, providers.ErrResourceNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	if err != nil {
+		s.logger.Error("failed to get resource references", "cluster_id", req.ClusterId, "kind", req.Kind, "namespace", req.Namespace, "name", req.Name, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get resource references: %v", err)
+	}
+
+	return resp, nil
+}
+
+// SimulateRoute reports which route, destination and VirtualService a service instance's proxy would use for an HTTP request
+func (s *ServiceRegistryService) SimulateRoute(ctx context.Context, req *frontendv1alpha1.SimulateRouteRequest) (*frontendv1alpha1.SimulateRouteResponse, error) {
+	s.logger.Debug("simulating route", "service_id", req.ServiceId, "instance_id", req.InstanceId, "host", req.Host, "path", req.Path, "method", req.Method)
+
+	clusterID, namespace, podName, err := parseInstanceID(req.InstanceId)
+	if err != nil {
+		s.logger.Warn("invalid instance ID format", "instance_id", req.InstanceId, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance ID format: %v", err)
+	}
+
+	if req.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "host is required")
+	}
+	if req.GetPort() > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid port: %d", req.GetPort())
+	}
+
+	if _, exists := scopedConnections(ctx, s.connectionManager).GetAggregatedServiceInstance(req.InstanceId); !exists {
+		s.logger.Warn("service instance not found", "instance_id", req.InstanceId)
+		return nil, status.Errorf(codes.NotFound, "service instance not found: %s", req.InstanceId)
+	}
+
+	snapshot, err := s.proxyProvider.GetProxyConfig(ctx, clusterID, namespace, podName, providers.ProxyConfigOptions{
+		ForceRefresh: req.GetForceRefresh(),
+	})
+	if err != nil {
+		s.logger.Error("failed to get proxy config for route simulation",
+			"instance_id", req.InstanceId,
+			"cluster_id", clusterID,
+			"error", err)
+		return nil, status.Errorf(codes.Internal, "failed to retrieve proxy configuration: %v", err)
+	}
+
+	matches := routesim.Simulate(snapshot.ProxyConfig, routesim.Request{
+		Host:    req.Host,
+		Port:    req.GetPort(),
+		Path:    req.Path,
+		Method:  req.Method,
+		Headers: req.Headers,
+	})
+
+	s.logger.Debug("simulated route", "instance_id", req.InstanceId, "matches", len(matches))
+
+	return &frontendv1alpha1.SimulateRouteResponse{
+		Matches:         matches,
+		VirtualServices: s.resolveVirtualServices(ctx, clusterID, matches),
+		Freshness:       convertProxyConfigSnapshotToFreshness(snapshot, time.Now()),
+	}, nil
+}
+
+// resolveVirtualServices looks up the VirtualServices referenced by simulated routes in the cluster's state.
+// Lookups are best effort: references that cannot be resolved are skipped.
+func (s *ServiceRegistryService) resolveVirtualServices(ctx context.Context, clusterID string, matches []*typesv1alpha1.RouteSimulationMatch) []*frontendv1alpha1.IstioResource {
+	namesByNamespace := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		namespace, name, found := strings.Cut(match.VirtualService, "/")
+		if !found || seen[match.VirtualService] {
+			continue
+		}
+		seen[match.VirtualService] = true
+		namesByNamespace[namespace] = append(namesByNamespace[namespace], name)
+	}
+
+	namespaces := make([]string, 0, len(namesByNamespace))
+	for namespace := range namesByNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	// Only the matched VirtualServices are listed, so the rest of the namespace's raw config is never decompressed
+	var virtualServices []*frontendv1alpha1.IstioResource
+	for _, namespace := range namespaces {
+		names := namesByNamespace[namespace]
+		sort.Strings(names)
+		resources, _, err := s.istioProvider.ListIstioResources(ctx, providers.IstioResourceFilter{
+			ClusterID: clusterID,
+			Namespace: namespace,
+			Kinds:     []typesv1alpha1.IstioResourceKind{typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE},
+			Names:     names,
+		}, 0, len(names))
+		if err != nil {
+			s.logger.Warn("failed to resolve virtual services", "cluster_id", clusterID, "namespace", namespace, "error", err)
+			continue
+		}
+		virtualServices = append(virtualServices, resources...)
+	}
+
+	return virtualServices
+}
+
+// syncMetadataForCluster returns sync metadata for a cluster, or nil if the cluster is not connected
+func (s *ServiceRegistryService) syncMetadataForCluster(ctx context.Context, clusterID string) *typesv1alpha1.ClusterSyncMetadata {
+	connInfo, exists := scopedConnections(ctx, s.connectionManager).GetConnectionInfo()[clusterID]
+	if !exists {
+		return nil
+	}
+	return convertConnectionInfoToSyncMetadata(connInfo)
+}
+
+// syncMetadataForClusters returns sync metadata for each connected cluster in the set, ordered by cluster ID
+func (s *ServiceRegistryService) syncMetadataForClusters(ctx context.Context, clusterIDs map[string]struct{}) []*typesv1alpha1.ClusterSyncMetadata {
+	connInfos := scopedConnections(ctx, s.connectionManager).GetConnectionInfo()
+
+	ids := make([]string, 0, len(clusterIDs))
+	for id := range clusterIDs {
+		if _, exists := connInfos[id]; exists {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	metadata := make([]*typesv1alpha1.ClusterSyncMetadata, 0, len(ids))
+	for _, id := range ids {
+		metadata = append(metadata, convertConnectionInfoToSyncMetadata(connInfos[id]))
+	}
+	return metadata
+}
+
+// matchesInstanceFilters reports whether an instance matches the node, sidecar, label and health filters of a request
+func matchesInstanceFilters(instance *connections.AggregatedServiceInstance, req *frontendv1alpha1.ListServiceInstancesRequest, selector labels.Selector) bool {
+	if req.NodeName != nil && instance.NodeName != req.GetNodeName() {
+		return false
+	}
+	if req.EnvoyPresent != nil && instance.EnvoyPresent != req.GetEnvoyPresent() {
+		return false
+	}
+	if !selector.Matches(labels.Set(instance.Labels)) {
+		return false
+	}
+	switch req.Health {
+	case frontendv1alpha1.InstanceHealth_INSTANCE_HEALTH_HEALTHY:
+		return isInstanceHealthy(instance)
+	case frontendv1alpha1.InstanceHealth_INSTANCE_HEALTH_UNHEALTHY:
+		return !isInstanceHealthy(instance)
+	}
+	return true
+}
+
+// isInstanceHealthy reports whether an instance's pod is running and all of its containers are ready
+func isInstanceHealthy(instance *connections.AggregatedServiceInstance) bool {
+	if instance.PodStatus != "Running" {
+		return false
+	}
+	for _, container := range instance.Containers {
+		if !container.Ready {
+			return false
+		}
+	}
+	return true
+}
+
 // parseInstanceID parses an instance ID in the format "cluster_id:namespace:pod_name"
 // Returns cluster ID, namespace, pod name, and any error
 func parseInstanceID(instanceID string) (clusterID, namespace, podName string, err error) {
@@ -212,3 +979,13 @@ func parseInstanceID(instanceID string) (clusterID, namespace, podName string, e
 
 	return clusterID, namespace, podName, nil
 }
+
+// edgeRequestCode returns the status code of a failed request to an edge. Requests the cluster's edge version
+// does not support are unimplemented, so clients can tell them from failures worth retrying.
+func edgeRequestCode(err error, fallback codes.Code) codes.Code {
+	var unsupported *connections.UnsupportedRequestError
+	if errors.As(err, &unsupported) {
+		return codes.Unimplemented
+	}
+	return fallback
+}
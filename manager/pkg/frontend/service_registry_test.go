@@ -15,13 +15,22 @@
 package frontend
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
 	"github.com/liamawhite/navigator/pkg/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -39,8 +48,14 @@ func (m *MockConnectionManager) RegisterConnection(clusterID string, stream back
 	return args.Error(0)
 }
 
-func (m *MockConnectionManager) UnregisterConnection(clusterID string) {
-	m.Called(clusterID)
+func (m *MockConnectionManager) RegisterEdgeConnection(identification *backendv1alpha1.ClusterIdentification, stream backendv1alpha1.ManagerService_ConnectServer) (string, error) {
+	args := m.Called(identification, stream)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockConnectionManager) UnregisterConnection(clusterID string, stream backendv1alpha1.ManagerService_ConnectServer) bool {
+	m.Called(clusterID, stream)
+	return true
 }
 
 func (m *MockConnectionManager) UpdateClusterState(clusterID string, clusterState *backendv1alpha1.ClusterState) error {
@@ -58,6 +73,11 @@ func (m *MockConnectionManager) GetClusterState(clusterID string) (*backendv1alp
 	return args.Get(0).(*backendv1alpha1.ClusterState), args.Error(1)
 }
 
+func (m *MockConnectionManager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	args := m.Called(clusterID)
+	return args.Get(0).(*filters.SelectorIndex), args.Error(1)
+}
+
 func (m *MockConnectionManager) GetAllClusterStates() map[string]*backendv1alpha1.ClusterState {
 	args := m.Called()
 	return args.Get(0).(map[string]*backendv1alpha1.ClusterState)
@@ -78,6 +98,25 @@ func (m *MockConnectionManager) SendMessageToCluster(clusterID string, message *
 	return args.Error(0)
 }
 
+func (m *MockConnectionManager) RequestResync(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockConnectionManager) DisconnectCluster(clusterID, reason string) error {
+	args := m.Called(clusterID, reason)
+	return args.Error(0)
+}
+
+func (m *MockConnectionManager) Disconnected(clusterID string) <-chan struct{} {
+	return nil
+}
+
+func (m *MockConnectionManager) UpdateEdgeVersion(clusterID, edgeVersion string) error {
+	args := m.Called(clusterID, edgeVersion)
+	return args.Error(0)
+}
+
 func (m *MockConnectionManager) ListAggregatedServices(namespace, clusterID string) []*connections.AggregatedService {
 	args := m.Called(namespace, clusterID)
 	return args.Get(0).([]*connections.AggregatedService)
@@ -98,17 +137,27 @@ func (m *MockConnectionManager) GetConnectionInfo() map[string]connections.Conne
 	return args.Get(0).(map[string]connections.ConnectionInfo)
 }
 
+func (m *MockConnectionManager) ListChanges(clusterID string, since time.Time, limit int) []connections.Change {
+	args := m.Called(clusterID, since, limit)
+	return args.Get(0).([]connections.Change)
+}
+
+func (m *MockConnectionManager) ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event {
+	args := m.Called(clusterID, start, end, limit)
+	return args.Get(0).([]connections.Event)
+}
+
 // MockProxyService for testing
 type MockProxyService struct {
 	mock.Mock
 }
 
-func (m *MockProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace, podName string) (*types.ProxyConfig, error) {
-	args := m.Called(ctx, clusterID, namespace, podName)
+func (m *MockProxyService) GetProxyConfig(ctx context.Context, clusterID, namespace, podName string, options providers.ProxyConfigOptions) (*providers.ProxyConfigSnapshot, error) {
+	args := m.Called(ctx, clusterID, namespace, podName, options)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*types.ProxyConfig), args.Error(1)
+	return args.Get(0).(*providers.ProxyConfigSnapshot), args.Error(1)
 }
 
 func (m *MockProxyService) HandleProxyConfigResponse(response *backendv1alpha1.ProxyConfigResponse) error {
@@ -138,12 +187,59 @@ func (m *MockIstioService) GetIstioResourcesForWorkload(ctx context.Context, clu
 	return args.Get(0).(*frontendv1alpha1.GetIstioResourcesResponse), args.Error(1)
 }
 
+func (m *MockIstioService) ListIstioResources(ctx context.Context, filter providers.IstioResourceFilter, offset, limit int) ([]*frontendv1alpha1.IstioResource, int, error) {
+	args := m.Called(ctx, filter, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*frontendv1alpha1.IstioResource), args.Int(1), args.Error(2)
+}
+
+func (m *MockIstioService) GetResourceReferences(ctx context.Context, clusterID, kind, namespace, name string) (*frontendv1alpha1.GetResourceReferencesResponse, error) {
+	args := m.Called(ctx, clusterID, kind, namespace, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*frontendv1alpha1.GetResourceReferencesResponse), args.Error(1)
+}
+
+func (m *MockIstioService) GetResourceInventory(ctx context.Context, clusterID string) (*frontendv1alpha1.GetResourceInventoryResponse, error) {
+	args := m.Called(ctx, clusterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*frontendv1alpha1.GetResourceInventoryResponse), args.Error(1)
+}
+
+// MockLogsService for testing
+type MockLogsService struct {
+	mock.Mock
+}
+
+func (m *MockLogsService) GetPodLogs(ctx context.Context, clusterID, namespace, podName string, options providers.PodLogOptions) (*types.ContainerLogs, error) {
+	args := m.Called(ctx, clusterID, namespace, podName, options)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.ContainerLogs), args.Error(1)
+}
+
+// MockEnvoyAdminService for testing
+type MockEnvoyAdminService struct {
+	mock.Mock
+}
+
+func (m *MockEnvoyAdminService) GetEnvoyAdmin(ctx context.Context, clusterID, namespace, podName, path, query string) (string, error) {
+	args := m.Called(ctx, clusterID, namespace, podName, path, query)
+	return args.String(0), args.Error(1)
+}
+
 func TestServiceRegistryService_ListServices(t *testing.T) {
 	mockConnManager := &MockConnectionManager{}
 	mockProxyService := &MockProxyService{}
 	mockIstioService := &MockIstioService{}
 
-	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, logging.For("test"))
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
 
 	// Mock data
 	aggregatedServices := []*connections.AggregatedService{
@@ -152,10 +248,17 @@ func TestServiceRegistryService_ListServices(t *testing.T) {
 			Name:      "test-service",
 			Namespace: "test-namespace",
 			Instances: []*connections.AggregatedServiceInstance{},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{
+				"cluster-1": {},
+			},
 		},
 	}
 
 	mockConnManager.On("ListAggregatedServices", "", "").Return(aggregatedServices)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"cluster-1": {ClusterID: "cluster-1", EdgeVersion: "v1.2.3", ResourceCounts: map[string]int{"services": 1}},
+		"cluster-2": {ClusterID: "cluster-2"},
+	})
 
 	req := &frontendv1alpha1.ListServicesRequest{}
 	resp, err := service.ListServices(context.Background(), req)
@@ -165,16 +268,90 @@ func TestServiceRegistryService_ListServices(t *testing.T) {
 	assert.Len(t, resp.Services, 1)
 	assert.Equal(t, "test-service", resp.Services[0].Name)
 	assert.Equal(t, "test-namespace", resp.Services[0].Namespace)
+	assert.Len(t, resp.SyncMetadata, 1)
+	assert.Equal(t, "cluster-1", resp.SyncMetadata[0].ClusterId)
+	assert.Equal(t, "v1.2.3", resp.SyncMetadata[0].EdgeVersion)
+	assert.Equal(t, int32(1), resp.SyncMetadata[0].ResourceCounts["services"])
 
 	mockConnManager.AssertExpectations(t)
 }
 
+func TestServiceRegistryService_ListCanonicalServices(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	newInstance := func(cluster, pod, canonicalService, revision string) *connections.AggregatedServiceInstance {
+		return &connections.AggregatedServiceInstance{
+			InstanceID:        cluster + ":bookinfo:" + pod,
+			PodName:           pod,
+			Namespace:         "bookinfo",
+			ClusterName:       cluster,
+			CanonicalService:  canonicalService,
+			CanonicalRevision: revision,
+		}
+	}
+	reviewsV1 := newInstance("east", "reviews-v1", "reviews", "v1")
+	reviewsV2 := newInstance("west", "reviews-v2", "reviews", "v2")
+	ratings := newInstance("east", "ratings-v1", "ratings", "v1")
+	services := []*connections.AggregatedService{
+		{
+			ID:         "bookinfo:reviews",
+			Instances:  []*connections.AggregatedServiceInstance{reviewsV1, reviewsV2},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"east": {reviewsV1}, "west": {reviewsV2}},
+		},
+		// A second service selecting the same pods, e.g. for a different port
+		{
+			ID:         "bookinfo:reviews-grpc",
+			Instances:  []*connections.AggregatedServiceInstance{reviewsV1},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"east": {reviewsV1}},
+		},
+		{
+			ID:         "bookinfo:ratings",
+			Instances:  []*connections.AggregatedServiceInstance{ratings},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"east": {ratings}},
+		},
+	}
+	mockConnManager.On("ListAggregatedServices", "", "").Return(services)
+	mockConnManager.On("ListAggregatedServices", "", "west").Return(services[:1])
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"east": {ClusterID: "east"},
+		"west": {ClusterID: "west"},
+	})
+
+	resp, err := service.ListCanonicalServices(context.Background(), &frontendv1alpha1.ListCanonicalServicesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.CanonicalServices, 2)
+	assert.Equal(t, &frontendv1alpha1.CanonicalService{
+		Id:            "bookinfo:ratings",
+		Name:          "ratings",
+		Namespace:     "bookinfo",
+		Services:      []string{"bookinfo:ratings"},
+		Revisions:     []string{"v1"},
+		Clusters:      []string{"east"},
+		InstanceCount: 1,
+	}, resp.CanonicalServices[0])
+	reviews := resp.CanonicalServices[1]
+	assert.Equal(t, []string{"bookinfo:reviews", "bookinfo:reviews-grpc"}, reviews.Services)
+	assert.Equal(t, []string{"v1", "v2"}, reviews.Revisions)
+	assert.Equal(t, []string{"east", "west"}, reviews.Clusters)
+	assert.Equal(t, int32(2), reviews.InstanceCount)
+	assert.Len(t, resp.SyncMetadata, 2)
+
+	// Filtering by cluster only counts the instances in that cluster
+	clusterID := "west"
+	resp, err = service.ListCanonicalServices(context.Background(), &frontendv1alpha1.ListCanonicalServicesRequest{ClusterId: &clusterID})
+	assert.NoError(t, err)
+	assert.Len(t, resp.CanonicalServices, 1)
+	assert.Equal(t, []string{"v2"}, resp.CanonicalServices[0].Revisions)
+	assert.Equal(t, int32(1), resp.CanonicalServices[0].InstanceCount)
+}
+
 func TestServiceRegistryService_GetService_Success(t *testing.T) {
 	mockConnManager := &MockConnectionManager{}
 	mockProxyService := &MockProxyService{}
 	mockIstioService := &MockIstioService{}
 
-	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, logging.For("test"))
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
 
 	// Mock data
 	aggregatedService := &connections.AggregatedService{
@@ -182,9 +359,15 @@ func TestServiceRegistryService_GetService_Success(t *testing.T) {
 		Name:      "test-service",
 		Namespace: "test-namespace",
 		Instances: []*connections.AggregatedServiceInstance{},
+		ClusterMap: map[string][]*connections.AggregatedServiceInstance{
+			"cluster-1": {},
+		},
 	}
 
 	mockConnManager.On("GetAggregatedService", "test-namespace:test-service").Return(aggregatedService, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"cluster-1": {ClusterID: "cluster-1"},
+	})
 
 	req := &frontendv1alpha1.GetServiceRequest{Id: "test-namespace:test-service"}
 	resp, err := service.GetService(context.Background(), req)
@@ -193,16 +376,46 @@ func TestServiceRegistryService_GetService_Success(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "test-service", resp.Service.Name)
 	assert.Equal(t, "test-namespace", resp.Service.Namespace)
+	assert.Len(t, resp.SyncMetadata, 1)
+	assert.Equal(t, "cluster-1", resp.SyncMetadata[0].ClusterId)
 
 	mockConnManager.AssertExpectations(t)
 }
 
+func TestServiceRegistryService_GetService_ZoneSpread(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	newInstance := func(podName string, locality *types.LocalityInfo) *connections.AggregatedServiceInstance {
+		return &connections.AggregatedServiceInstance{InstanceID: "cluster-1:bookinfo:" + podName, PodName: podName, Namespace: "bookinfo", ClusterName: "cluster-1", Locality: locality}
+	}
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{
+		ID:        "bookinfo:reviews",
+		Name:      "reviews",
+		Namespace: "bookinfo",
+		Instances: []*connections.AggregatedServiceInstance{
+			newInstance("reviews-v1", &types.LocalityInfo{Region: "us-east-1", Zone: "us-east-1a"}),
+			newInstance("reviews-v2", &types.LocalityInfo{Region: "us-east-1", Zone: "us-east-1a"}),
+			newInstance("reviews-v3", &types.LocalityInfo{Region: "us-east-1", Zone: "us-east-1b"}),
+			newInstance("reviews-v4", nil),
+		},
+	}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{})
+
+	resp, err := service.GetService(context.Background(), &frontendv1alpha1.GetServiceRequest{Id: "bookinfo:reviews"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]int32{"us-east-1a": 2, "us-east-1b": 1}, resp.Service.ZoneSpread)
+	assert.Equal(t, "us-east-1b", resp.Service.Instances[2].Zone)
+	assert.Empty(t, resp.Service.Instances[3].Zone)
+}
+
 func TestServiceRegistryService_GetService_NotFound(t *testing.T) {
 	mockConnManager := &MockConnectionManager{}
 	mockProxyService := &MockProxyService{}
 	mockIstioService := &MockIstioService{}
 
-	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, logging.For("test"))
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
 
 	// Mock returning not found
 	var nilService *connections.AggregatedService
@@ -221,3 +434,836 @@ func TestServiceRegistryService_GetService_NotFound(t *testing.T) {
 
 	mockConnManager.AssertExpectations(t)
 }
+
+func TestServiceRegistryService_GetServiceInstance(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	tolerationSeconds := int64(300)
+	mockConnManager.On("GetAggregatedServiceInstance", "cluster-1:bookinfo:reviews-v1").Return(&connections.AggregatedServiceInstance{
+		InstanceID:     "cluster-1:bookinfo:reviews-v1",
+		PodName:        "reviews-v1",
+		Namespace:      "bookinfo",
+		ClusterName:    "cluster-1",
+		Containers:     []connections.Container{{Name: "reviews", Image: "reviews:v1", ImageDigest: "sha256:app", Ready: true}},
+		InitContainers: []connections.Container{{Name: "istio-init", Image: "istio/proxyv2:1.22.0", Status: "Completed"}},
+		ServiceAccount: "bookinfo-reviews",
+		Annotations: map[string]string{
+			"sidecar.istio.io/inject":                          "true",
+			"traffic.sidecar.istio.io/excludeOutboundPorts":    "3306",
+			"proxy.istio.io/config":                            "holdApplicationUntilProxyStarts: true",
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		},
+		Tolerations:       []*types.Toleration{{Key: "node.kubernetes.io/not-ready", Operator: "Exists", Effect: "NoExecute", TolerationSeconds: &tolerationSeconds}},
+		Locality:          &types.LocalityInfo{Region: "us-east-1", Zone: "us-east-1a"},
+		ZoneHints:         []string{"us-east-1a"},
+		LifecyclePhase:    types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING,
+		DeletionTimestamp: "2024-05-01T12:30:00Z",
+	}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {ClusterID: "cluster-1"}})
+
+	resp, err := service.GetServiceInstance(context.Background(), &frontendv1alpha1.GetServiceInstanceRequest{
+		ServiceId:  "bookinfo:reviews",
+		InstanceId: "cluster-1:bookinfo:reviews-v1",
+	})
+	assert.NoError(t, err)
+
+	instance := resp.Instance
+	assert.Equal(t, "sha256:app", instance.Containers[0].ImageDigest)
+	assert.Len(t, instance.InitContainers, 1)
+	assert.Equal(t, "istio-init", instance.InitContainers[0].Name)
+	assert.Equal(t, "Completed", instance.InitContainers[0].Status)
+	assert.Equal(t, "bookinfo-reviews", instance.ServiceAccount)
+	assert.Equal(t, map[string]string{
+		"sidecar.istio.io/inject":                       "true",
+		"traffic.sidecar.istio.io/excludeOutboundPorts": "3306",
+		"proxy.istio.io/config":                         "holdApplicationUntilProxyStarts: true",
+	}, instance.IstioAnnotations)
+	assert.Len(t, instance.Tolerations, 1)
+	assert.Equal(t, int64(300), instance.Tolerations[0].GetTolerationSeconds())
+	assert.Equal(t, "us-east-1a", instance.Locality.GetZone())
+	assert.Equal(t, []string{"us-east-1a"}, instance.ZoneHints)
+	assert.Equal(t, types.PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING, instance.LifecyclePhase)
+	assert.Equal(t, "2024-05-01T12:30:00Z", instance.DeletionTimestamp)
+	assert.Equal(t, "cluster-1", resp.SyncMetadata.ClusterId)
+}
+
+func TestServiceRegistryService_ListServiceInstances(t *testing.T) {
+	newInstance := func(clusterID, namespace, podName, node, status string, envoy, ready bool, labels map[string]string) *connections.AggregatedServiceInstance {
+		return &connections.AggregatedServiceInstance{
+			InstanceID:   clusterID + ":" + namespace + ":" + podName,
+			PodName:      podName,
+			Namespace:    namespace,
+			ClusterName:  clusterID,
+			EnvoyPresent: envoy,
+			PodStatus:    status,
+			NodeName:     node,
+			Labels:       labels,
+			Containers:   []connections.Container{{Name: "app", Ready: ready}},
+		}
+	}
+	reviewsV1 := newInstance("cluster-1", "bookinfo", "reviews-v1", "node-a", "Running", true, true, map[string]string{"app": "reviews", "version": "v1"})
+	reviewsV2 := newInstance("cluster-2", "bookinfo", "reviews-v2", "node-b", "Running", true, false, map[string]string{"app": "reviews", "version": "v2"})
+	ratings := newInstance("cluster-1", "bookinfo", "ratings-v1", "node-b", "Pending", false, false, map[string]string{"app": "ratings"})
+
+	services := []*connections.AggregatedService{
+		{
+			ID:        "bookinfo:reviews",
+			Name:      "reviews",
+			Namespace: "bookinfo",
+			Instances: []*connections.AggregatedServiceInstance{reviewsV1, reviewsV2},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{
+				"cluster-1": {reviewsV1},
+				"cluster-2": {reviewsV2},
+			},
+		},
+		{
+			ID:         "bookinfo:ratings",
+			Name:       "ratings",
+			Namespace:  "bookinfo",
+			Instances:  []*connections.AggregatedServiceInstance{ratings},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"cluster-1": {ratings}},
+		},
+	}
+
+	ptr := func(s string) *string { return &s }
+	envoy := false
+	tests := []struct {
+		name      string
+		req       *frontendv1alpha1.ListServiceInstancesRequest
+		clusterID string
+		wantPods  []string
+		wantNext  string
+		wantTotal int32
+	}{
+		{
+			name:      "all instances ordered by cluster, namespace and pod",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{},
+			wantPods:  []string{"ratings-v1", "reviews-v1", "reviews-v2"},
+			wantTotal: 3,
+		},
+		{
+			name:      "cluster filter",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{ClusterId: ptr("cluster-2")},
+			clusterID: "cluster-2",
+			wantPods:  []string{"reviews-v2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "node filter",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{NodeName: ptr("node-b")},
+			wantPods:  []string{"ratings-v1", "reviews-v2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "without envoy",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{EnvoyPresent: &envoy},
+			wantPods:  []string{"ratings-v1"},
+			wantTotal: 1,
+		},
+		{
+			name:      "label selector",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{LabelSelector: "app=reviews,version in (v2,v3)"},
+			wantPods:  []string{"reviews-v2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "healthy",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{Health: frontendv1alpha1.InstanceHealth_INSTANCE_HEALTH_HEALTHY},
+			wantPods:  []string{"reviews-v1"},
+			wantTotal: 1,
+		},
+		{
+			name:      "unhealthy",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{Health: frontendv1alpha1.InstanceHealth_INSTANCE_HEALTH_UNHEALTHY},
+			wantPods:  []string{"ratings-v1", "reviews-v2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "first page",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{PageSize: 2},
+			wantPods:  []string{"ratings-v1", "reviews-v1"},
+			wantNext:  encodePageToken(2, serviceInstancePageFilters(&frontendv1alpha1.ListServiceInstancesRequest{})...),
+			wantTotal: 3,
+		},
+		{
+			name:      "last page",
+			req:       &frontendv1alpha1.ListServiceInstancesRequest{PageSize: 2, PageToken: encodePageToken(2, serviceInstancePageFilters(&frontendv1alpha1.ListServiceInstancesRequest{})...)},
+			wantPods:  []string{"reviews-v2"},
+			wantTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConnManager := &MockConnectionManager{}
+			service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+			mockConnManager.On("ListAggregatedServices", "", tt.clusterID).Return(services)
+			mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+				"cluster-1": {ClusterID: "cluster-1"},
+				"cluster-2": {ClusterID: "cluster-2"},
+			})
+
+			resp, err := service.ListServiceInstances(context.Background(), tt.req)
+			assert.NoError(t, err)
+
+			pods := make([]string, len(resp.Instances))
+			for i, instance := range resp.Instances {
+				pods[i] = instance.PodName
+			}
+			assert.Equal(t, tt.wantPods, pods)
+			assert.Equal(t, tt.wantNext, resp.NextPageToken)
+			assert.Equal(t, tt.wantTotal, resp.TotalCount)
+		})
+	}
+}
+
+func TestServiceRegistryService_ListServiceInstances_ServiceName(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	instance := &connections.AggregatedServiceInstance{InstanceID: "cluster-1:default:web-0", PodName: "web-0", Namespace: "default", ClusterName: "cluster-1"}
+	mockConnManager.On("ListAggregatedServices", "default", "").Return([]*connections.AggregatedService{
+		{ID: "default:web", Instances: []*connections.AggregatedServiceInstance{instance}},
+		{ID: "default:web-headless", Instances: []*connections.AggregatedServiceInstance{instance}},
+	})
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {ClusterID: "cluster-1"}})
+
+	namespace := "default"
+	resp, err := service.ListServiceInstances(context.Background(), &frontendv1alpha1.ListServiceInstancesRequest{Namespace: &namespace})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Instances, 2)
+	assert.Equal(t, "default:web", resp.Instances[0].ServiceName)
+	assert.Equal(t, "default:web-headless", resp.Instances[1].ServiceName)
+	assert.Len(t, resp.SyncMetadata, 1)
+}
+
+func TestServiceRegistryService_ListServiceInstances_InvalidArguments(t *testing.T) {
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+	node := "node-1"
+
+	for _, req := range []*frontendv1alpha1.ListServiceInstancesRequest{
+		{LabelSelector: "app in (reviews"},
+		{Health: frontendv1alpha1.InstanceHealth(42)},
+		{PageSize: -1},
+		{PageToken: "not-a-number"},
+		{NodeName: &node, PageToken: encodePageToken(2, serviceInstancePageFilters(&frontendv1alpha1.ListServiceInstancesRequest{})...)},
+	} {
+		_, err := service.ListServiceInstances(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "request %v", req)
+	}
+}
+
+func TestServiceRegistryService_GetInstanceLogs(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockLogsService := &MockLogsService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, mockLogsService, &MockEnvoyAdminService{}, logging.For("test"))
+
+	instanceID := "cluster-1:test-namespace:test-pod"
+	mockConnManager.On("GetAggregatedServiceInstance", instanceID).Return(&connections.AggregatedServiceInstance{ClusterName: "cluster-1"}, true)
+
+	container := "istio-proxy"
+	tailLines := int64(100)
+	expected := &types.ContainerLogs{Container: container, Logs: "line one\nline two\n"}
+	mockLogsService.On("GetPodLogs", mock.Anything, "cluster-1", "test-namespace", "test-pod", providers.PodLogOptions{
+		Container: container,
+		TailLines: tailLines,
+	}).Return(expected, nil)
+
+	resp, err := service.GetInstanceLogs(context.Background(), &frontendv1alpha1.GetInstanceLogsRequest{
+		ServiceId:  "test-namespace:test-service",
+		InstanceId: instanceID,
+		Container:  &container,
+		TailLines:  &tailLines,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp.Logs)
+
+	mockConnManager.AssertExpectations(t)
+	mockLogsService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_GetInstanceLogs_Errors(t *testing.T) {
+	negative := int64(-1)
+
+	tests := []struct {
+		name         string
+		req          *frontendv1alpha1.GetInstanceLogsRequest
+		setup        func(*MockConnectionManager, *MockLogsService)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "invalid instance ID",
+			req:          &frontendv1alpha1.GetInstanceLogsRequest{InstanceId: "not-an-instance"},
+			setup:        func(*MockConnectionManager, *MockLogsService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "negative tail lines",
+			req:          &frontendv1alpha1.GetInstanceLogsRequest{InstanceId: "cluster-1:ns:pod", TailLines: &negative},
+			setup:        func(*MockConnectionManager, *MockLogsService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "instance not found",
+			req:  &frontendv1alpha1.GetInstanceLogsRequest{InstanceId: "cluster-1:ns:pod"},
+			setup: func(cm *MockConnectionManager, _ *MockLogsService) {
+				var nilInstance *connections.AggregatedServiceInstance
+				cm.On("GetAggregatedServiceInstance", "cluster-1:ns:pod").Return(nilInstance, false)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "edge error",
+			req:  &frontendv1alpha1.GetInstanceLogsRequest{InstanceId: "cluster-1:ns:pod"},
+			setup: func(cm *MockConnectionManager, ls *MockLogsService) {
+				cm.On("GetAggregatedServiceInstance", "cluster-1:ns:pod").Return(&connections.AggregatedServiceInstance{}, true)
+				ls.On("GetPodLogs", mock.Anything, "cluster-1", "ns", "pod", providers.PodLogOptions{}).Return(nil, errors.New("container not found"))
+			},
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "unsupported by edge version",
+			req:  &frontendv1alpha1.GetInstanceLogsRequest{InstanceId: "cluster-1:ns:pod"},
+			setup: func(cm *MockConnectionManager, ls *MockLogsService) {
+				cm.On("GetAggregatedServiceInstance", "cluster-1:ns:pod").Return(&connections.AggregatedServiceInstance{}, true)
+				unsupported := &connections.UnsupportedRequestError{ClusterID: "cluster-1", Request: "pod_logs", EdgeVersion: "v0.1.0"}
+				ls.On("GetPodLogs", mock.Anything, "cluster-1", "ns", "pod", providers.PodLogOptions{}).Return(nil, fmt.Errorf("failed to send pod logs request: %w", unsupported))
+			},
+			expectedCode: codes.Unimplemented,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConnManager := &MockConnectionManager{}
+			mockLogsService := &MockLogsService{}
+			tt.setup(mockConnManager, mockLogsService)
+
+			service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, mockLogsService, &MockEnvoyAdminService{}, logging.For("test"))
+
+			resp, err := service.GetInstanceLogs(context.Background(), tt.req)
+
+			assert.Nil(t, resp)
+			statusErr, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, statusErr.Code())
+
+			mockConnManager.AssertExpectations(t)
+			mockLogsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceRegistryService_GetProxyConfig(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockProxyService := &MockProxyService{}
+
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	instanceID := "cluster-1:test-namespace:test-pod"
+	fetchedAt := time.Now().Add(-90 * time.Second)
+	mockConnManager.On("GetAggregatedServiceInstance", instanceID).Return(&connections.AggregatedServiceInstance{ClusterName: "cluster-1"}, true)
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{})
+	mockProxyService.On("GetProxyConfig", mock.Anything, "cluster-1", "test-namespace", "test-pod", providers.ProxyConfigOptions{ForceRefresh: true}).Return(&providers.ProxyConfigSnapshot{
+		ProxyConfig: &types.ProxyConfig{Version: "1.26.0"},
+		FetchedAt:   fetchedAt,
+		Cached:      true,
+	}, nil)
+
+	forceRefresh := true
+	resp, err := service.GetProxyConfig(context.Background(), &frontendv1alpha1.GetProxyConfigRequest{
+		ServiceId:    "test-namespace:test-service",
+		InstanceId:   instanceID,
+		ForceRefresh: &forceRefresh,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1.26.0", resp.ProxyConfig.Version)
+	assert.Equal(t, fetchedAt.Format(time.RFC3339), resp.Freshness.FetchedAt)
+	assert.GreaterOrEqual(t, resp.Freshness.AgeSeconds, int64(90))
+	assert.True(t, resp.Freshness.Cached)
+
+	mockConnManager.AssertExpectations(t)
+	mockProxyService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_GetServiceProxyConfigs(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockProxyService := &MockProxyService{}
+
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	mockConnManager.On("GetAggregatedService", "test-namespace:test-service").Return(&connections.AggregatedService{
+		ID: "test-namespace:test-service",
+		Instances: []*connections.AggregatedServiceInstance{
+			{InstanceID: "cluster-2:test-namespace:pod-c", ClusterName: "cluster-2", Namespace: "test-namespace", PodName: "pod-c", EnvoyPresent: true},
+			{InstanceID: "cluster-1:test-namespace:pod-b", ClusterName: "cluster-1", Namespace: "test-namespace", PodName: "pod-b"},
+			{InstanceID: "cluster-1:test-namespace:pod-a", ClusterName: "cluster-1", Namespace: "test-namespace", PodName: "pod-a", EnvoyPresent: true},
+		},
+	}, true)
+	mockProxyService.On("GetProxyConfig", mock.Anything, "cluster-1", "test-namespace", "pod-a", providers.ProxyConfigOptions{}).Return(&providers.ProxyConfigSnapshot{
+		ProxyConfig: &types.ProxyConfig{Version: "1.26.0"},
+		FetchedAt:   time.Now(),
+	}, nil)
+	mockProxyService.On("GetProxyConfig", mock.Anything, "cluster-2", "test-namespace", "pod-c", providers.ProxyConfigOptions{}).Return(nil, errors.New("cluster cluster-2 is not connected"))
+
+	resp, err := service.GetServiceProxyConfigs(context.Background(), &frontendv1alpha1.GetServiceProxyConfigsRequest{
+		ServiceId: "test-namespace:test-service",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Instances, 3)
+
+	assert.Equal(t, "cluster-1:test-namespace:pod-a", resp.Instances[0].InstanceId)
+	assert.Equal(t, "1.26.0", resp.Instances[0].GetProxyConfig().Version)
+	assert.NotNil(t, resp.Instances[0].Freshness)
+
+	assert.Equal(t, "cluster-1:test-namespace:pod-b", resp.Instances[1].InstanceId)
+	assert.Equal(t, "instance has no Envoy proxy", resp.Instances[1].GetErrorMessage())
+
+	assert.Equal(t, "cluster-2:test-namespace:pod-c", resp.Instances[2].InstanceId)
+	assert.Equal(t, "cluster cluster-2 is not connected", resp.Instances[2].GetErrorMessage())
+	assert.Nil(t, resp.Instances[2].Freshness)
+
+	mockConnManager.AssertExpectations(t)
+	mockProxyService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_ListIstioResources(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	namespace := "default"
+	clusterID := "cluster-1"
+	kinds := []types.IstioResourceKind{types.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE}
+	filter := providers.IstioResourceFilter{ClusterID: clusterID, Namespace: namespace, Kinds: kinds}
+	page := []*frontendv1alpha1.IstioResource{
+		{ClusterId: clusterID, Kind: kinds[0], Name: "reviews", Namespace: namespace},
+		{ClusterId: clusterID, Kind: kinds[0], Name: "ratings", Namespace: namespace},
+	}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{})
+	mockIstioService.On("ListIstioResources", mock.Anything, filter, 0, 2).Return(page, 5, nil)
+	mockIstioService.On("ListIstioResources", mock.Anything, filter, 4, 2).Return(page[:1], 5, nil)
+
+	resp, err := service.ListIstioResources(context.Background(), &frontendv1alpha1.ListIstioResourcesRequest{
+		Namespace: &namespace,
+		ClusterId: &clusterID,
+		Kinds:     kinds,
+		PageSize:  2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Resources, 2)
+	assert.Equal(t, int32(5), resp.TotalCount)
+	pageFilters := []string{"", clusterID, namespace, fmt.Sprint(kinds)}
+	assert.Equal(t, encodePageToken(2, pageFilters...), resp.NextPageToken)
+
+	resp, err = service.ListIstioResources(context.Background(), &frontendv1alpha1.ListIstioResourcesRequest{
+		Namespace: &namespace,
+		ClusterId: &clusterID,
+		Kinds:     kinds,
+		PageSize:  2,
+		PageToken: encodePageToken(4, pageFilters...),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Resources, 1)
+	assert.Empty(t, resp.NextPageToken)
+
+	// Tokens only continue the listing they were issued for
+	_, err = service.ListIstioResources(context.Background(), &frontendv1alpha1.ListIstioResourcesRequest{
+		ClusterId: &clusterID,
+		Kinds:     kinds,
+		PageSize:  2,
+		PageToken: encodePageToken(4, pageFilters...),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockConnManager.AssertExpectations(t)
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_ListIstioResources_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *frontendv1alpha1.ListIstioResourcesRequest
+	}{
+		{name: "invalid page token", req: &frontendv1alpha1.ListIstioResourcesRequest{PageToken: "abc"}},
+		{name: "negative page token", req: &frontendv1alpha1.ListIstioResourcesRequest{PageToken: "-1"}},
+		{name: "negative page size", req: &frontendv1alpha1.ListIstioResourcesRequest{PageSize: -1}},
+		{name: "unspecified kind", req: &frontendv1alpha1.ListIstioResourcesRequest{Kinds: []types.IstioResourceKind{types.IstioResourceKind_ISTIO_RESOURCE_KIND_UNSPECIFIED}}},
+		{name: "unknown raw config format", req: &frontendv1alpha1.ListIstioResourcesRequest{RawConfigFormat: frontendv1alpha1.RawConfigFormat(99)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIstioService := &MockIstioService{}
+			service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+			resp, err := service.ListIstioResources(context.Background(), tt.req)
+
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			mockIstioService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServiceRegistryService_ListIstioResources_YAML(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	page := []*frontendv1alpha1.IstioResource{{
+		ClusterId: "cluster-1",
+		Kind:      types.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE,
+		Name:      "reviews",
+		Namespace: "default",
+		RawConfig: `{"kind":"VirtualService","metadata":{"name":"reviews","managedFields":[{}]},"status":{}}`,
+	}}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{})
+	mockIstioService.On("ListIstioResources", mock.Anything, providers.IstioResourceFilter{}, 0, defaultIstioResourcePageSize).Return(page, 1, nil)
+
+	resp, err := service.ListIstioResources(context.Background(), &frontendv1alpha1.ListIstioResourcesRequest{
+		RawConfigFormat: frontendv1alpha1.RawConfigFormat_RAW_CONFIG_FORMAT_YAML,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Resources, 1)
+	assert.Equal(t, "kind: VirtualService\nmetadata:\n  name: reviews\n", resp.Resources[0].RawConfig)
+
+	mockConnManager.AssertExpectations(t)
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_DownloadIstioResources(t *testing.T) {
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	namespace := "default"
+	filter := providers.IstioResourceFilter{Namespace: namespace}
+	first := make([]*frontendv1alpha1.IstioResource, maxIstioResourcePageSize)
+	for i := range first {
+		first[i] = &frontendv1alpha1.IstioResource{
+			ClusterId: "cluster-1",
+			Name:      fmt.Sprintf("vs-%d", i),
+			Namespace: namespace,
+			RawConfig: fmt.Sprintf(`{"kind":"VirtualService","metadata":{"name":"vs-%d","resourceVersion":"1"}}`, i),
+		}
+	}
+	second := []*frontendv1alpha1.IstioResource{{
+		ClusterId: "cluster-2",
+		Name:      "reviews",
+		Namespace: namespace,
+		RawConfig: `{"kind":"DestinationRule","metadata":{"name":"reviews"},"status":{}}`,
+	}}
+
+	mockIstioService.On("ListIstioResources", mock.Anything, filter, 0, maxIstioResourcePageSize).Return(first, maxIstioResourcePageSize+1, nil)
+	mockIstioService.On("ListIstioResources", mock.Anything, filter, maxIstioResourcePageSize, maxIstioResourcePageSize).Return(second, maxIstioResourcePageSize+1, nil)
+
+	body, err := service.DownloadIstioResources(context.Background(), &frontendv1alpha1.DownloadIstioResourcesRequest{
+		Namespace: &namespace,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/yaml", body.ContentType)
+
+	data := string(body.Data)
+	assert.True(t, strings.HasPrefix(data, "# cluster: cluster-1\nkind: VirtualService\nmetadata:\n  name: vs-0\n---\n"))
+	assert.True(t, strings.HasSuffix(data, "---\n# cluster: cluster-2\nkind: DestinationRule\nmetadata:\n  name: reviews\n"))
+	assert.Equal(t, maxIstioResourcePageSize, strings.Count(data, "---\n"))
+	assert.NotContains(t, data, "resourceVersion")
+
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_DownloadIstioResources_WithoutRawConfig(t *testing.T) {
+	mockIstioService := &MockIstioService{}
+	mockIstioService.On("ListIstioResources", mock.Anything, providers.IstioResourceFilter{}, 0, maxIstioResourcePageSize).Return([]*frontendv1alpha1.IstioResource{
+		{ClusterId: "cluster-1", Name: "reviews", Namespace: "default"},
+		{ClusterId: "cluster-2", Name: "reviews", Namespace: "default", RawConfig: `{"kind":"DestinationRule","metadata":{"name":"reviews"}}`},
+	}, 2, nil)
+
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	body, err := service.DownloadIstioResources(context.Background(), &frontendv1alpha1.DownloadIstioResourcesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "# default/reviews in cluster cluster-1 omitted: its edge does not collect raw config\n# cluster: cluster-2\nkind: DestinationRule\nmetadata:\n  name: reviews\n", string(body.Data))
+}
+
+func TestServiceRegistryService_DownloadIstioResources_TooLarge(t *testing.T) {
+	mockIstioService := &MockIstioService{}
+	mockIstioService.On("ListIstioResources", mock.Anything, providers.IstioResourceFilter{}, 0, maxIstioResourcePageSize).Return([]*frontendv1alpha1.IstioResource{
+		{ClusterId: "cluster-1", Name: "reviews", Namespace: "default", RawConfig: `{"kind":"DestinationRule","metadata":{"name":"reviews"}}`},
+	}, maxIstioResourcePageSize+1, nil)
+
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+	service.downloadLimit = 16
+
+	body, err := service.DownloadIstioResources(context.Background(), &frontendv1alpha1.DownloadIstioResourcesRequest{})
+	assert.Nil(t, body)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_DownloadIstioResources_ProviderError(t *testing.T) {
+	mockIstioService := &MockIstioService{}
+	mockIstioService.On("ListIstioResources", mock.Anything, providers.IstioResourceFilter{}, 0, maxIstioResourcePageSize).Return(nil, 0, errors.New("boom"))
+
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	body, err := service.DownloadIstioResources(context.Background(), &frontendv1alpha1.DownloadIstioResourcesRequest{})
+	assert.Nil(t, body)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServiceRegistryService_GetResourceInventory(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	inventory := &frontendv1alpha1.GetResourceInventoryResponse{
+		Clusters:   []*frontendv1alpha1.ClusterResourceInventory{{ClusterId: "cluster-1", TotalCount: 1}},
+		TotalCount: 1,
+	}
+	mockIstioService.On("GetResourceInventory", mock.Anything, "cluster-1").Return(inventory, nil)
+	mockIstioService.On("GetResourceInventory", mock.Anything, "").Return(nil, errors.New("boom"))
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"cluster-1": {ClusterID: "cluster-1"},
+		"cluster-2": {ClusterID: "cluster-2"},
+	})
+
+	clusterID := "cluster-1"
+	resp, err := service.GetResourceInventory(context.Background(), &frontendv1alpha1.GetResourceInventoryRequest{ClusterId: &clusterID})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.TotalCount)
+	assert.Len(t, resp.SyncMetadata, 1)
+	assert.Equal(t, "cluster-1", resp.SyncMetadata[0].ClusterId)
+
+	_, err = service.GetResourceInventory(context.Background(), &frontendv1alpha1.GetResourceInventoryRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_GetResourceReferences(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	references := &frontendv1alpha1.GetResourceReferencesResponse{
+		Resource: &types.ResourceRef{ClusterId: "cluster-1", Kind: "Gateway", Namespace: "istio-system", Name: "public"},
+		UsedBy: []*types.ResourceReference{{
+			From: &types.ResourceRef{ClusterId: "cluster-1", Kind: "VirtualService", Namespace: "default", Name: "reviews"},
+			To:   &types.ResourceRef{ClusterId: "cluster-1", Kind: "Gateway", Namespace: "istio-system", Name: "public"},
+			Type: types.ReferenceType_REFERENCE_TYPE_GATEWAY,
+		}},
+	}
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{"cluster-1": {}})
+	mockIstioService.On("GetResourceReferences", mock.Anything, "cluster-1", "Gateway", "istio-system", "public").Return(references, nil)
+	mockIstioService.On("GetResourceReferences", mock.Anything, "cluster-1", "Gateway", "istio-system", "private").Return(nil, fmt.Errorf("%w: Gateway istio-system/private", providers.ErrResourceNotFound))
+
+	resp, err := service.GetResourceReferences(context.Background(), &frontendv1alpha1.GetResourceReferencesRequest{
+		ClusterId: "cluster-1",
+		Kind:      "Gateway",
+		Namespace: "istio-system",
+		Name:      "public",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, references, resp)
+
+	_, err = service.GetResourceReferences(context.Background(), &frontendv1alpha1.GetResourceReferencesRequest{
+		ClusterId: "cluster-1",
+		Kind:      "Gateway",
+		Namespace: "istio-system",
+		Name:      "private",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.GetResourceReferences(context.Background(), &frontendv1alpha1.GetResourceReferencesRequest{
+		ClusterId: "cluster-2",
+		Kind:      "Gateway",
+		Namespace: "istio-system",
+		Name:      "public",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.GetResourceReferences(context.Background(), &frontendv1alpha1.GetResourceReferencesRequest{ClusterId: "cluster-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockConnManager.AssertExpectations(t)
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_GetEnvoyAdmin(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAdminService := &MockEnvoyAdminService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, mockAdminService, logging.For("test"))
+
+	instanceID := "cluster-1:test-namespace:test-pod"
+	mockConnManager.On("GetAggregatedServiceInstance", instanceID).Return(&connections.AggregatedServiceInstance{ClusterName: "cluster-1"}, true)
+	mockAdminService.On("GetEnvoyAdmin", mock.Anything, "cluster-1", "test-namespace", "test-pod", "stats", "filter=^http").Return("http.inbound.rq_total: 3\n", nil)
+
+	query := "filter=^http"
+	resp, err := service.GetEnvoyAdmin(context.Background(), &frontendv1alpha1.GetEnvoyAdminRequest{
+		ServiceId:  "test-namespace:test-service",
+		InstanceId: instanceID,
+		Path:       "stats",
+		Query:      &query,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "stats?filter=^http", resp.Path)
+	assert.Equal(t, "http.inbound.rq_total: 3\n", resp.Output)
+
+	mockConnManager.AssertExpectations(t)
+	mockAdminService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_GetEnvoyAdmin_DisallowedPath(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockAdminService := &MockEnvoyAdminService{}
+
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, mockAdminService, logging.For("test"))
+
+	resp, err := service.GetEnvoyAdmin(context.Background(), &frontendv1alpha1.GetEnvoyAdminRequest{
+		ServiceId:  "test-namespace:test-service",
+		InstanceId: "cluster-1:test-namespace:test-pod",
+		Path:       "quitquitquit",
+	})
+
+	assert.Nil(t, resp)
+	statusErr, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+
+	mockConnManager.AssertExpectations(t)
+	mockAdminService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_SimulateRoute(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockProxyService := &MockProxyService{}
+	mockIstioService := &MockIstioService{}
+
+	service := NewServiceRegistryService(mockConnManager, mockProxyService, mockIstioService, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	instanceID := "cluster-1:test-namespace:test-pod"
+	proxyConfig := &types.ProxyConfig{
+		Routes: []*types.RouteConfigSummary{{
+			Name: "9080",
+			VirtualHosts: []*types.VirtualHostInfo{{
+				Name:    "reviews.default.svc.cluster.local:9080",
+				Domains: []string{"reviews.default.svc.cluster.local", "reviews"},
+				Routes: []*types.RouteInfo{{
+					Name:     "default",
+					Match:    &types.RouteMatchInfo{PathSpecifier: "prefix", Path: "/", CaseSensitive: true},
+					Action:   &types.RouteActionInfo{ActionType: "route", Cluster: "outbound|9080|v1|reviews.default.svc.cluster.local"},
+					Metadata: map[string]string{"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews"},
+				}},
+			}},
+		}},
+	}
+	kinds := []types.IstioResourceKind{types.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE}
+
+	mockConnManager.On("GetAggregatedServiceInstance", instanceID).Return(&connections.AggregatedServiceInstance{ClusterName: "cluster-1"}, true)
+	mockProxyService.On("GetProxyConfig", mock.Anything, "cluster-1", "test-namespace", "test-pod", providers.ProxyConfigOptions{}).Return(&providers.ProxyConfigSnapshot{
+		ProxyConfig: proxyConfig,
+		FetchedAt:   time.Now(),
+	}, nil)
+	mockIstioService.On("ListIstioResources", mock.Anything, providers.IstioResourceFilter{ClusterID: "cluster-1", Namespace: "default", Kinds: kinds, Names: []string{"reviews"}}, 0, 1).Return([]*frontendv1alpha1.IstioResource{
+		{ClusterId: "cluster-1", Kind: kinds[0], Name: "reviews", Namespace: "default"},
+	}, 1, nil)
+
+	resp, err := service.SimulateRoute(context.Background(), &frontendv1alpha1.SimulateRouteRequest{
+		ServiceId:  "test-namespace:test-service",
+		InstanceId: instanceID,
+		Host:       "reviews:9080",
+		Path:       "/reviews/1",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Matches, 1)
+	assert.Equal(t, "default/reviews", resp.Matches[0].VirtualService)
+	assert.Equal(t, "v1", resp.Matches[0].Destinations[0].Subset)
+	assert.Len(t, resp.VirtualServices, 1)
+	assert.Equal(t, "reviews", resp.VirtualServices[0].Name)
+	assert.False(t, resp.Freshness.Cached)
+
+	mockConnManager.AssertExpectations(t)
+	mockProxyService.AssertExpectations(t)
+	mockIstioService.AssertExpectations(t)
+}
+
+func TestServiceRegistryService_SimulateRoute_MissingHost(t *testing.T) {
+	service := NewServiceRegistryService(&MockConnectionManager{}, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	resp, err := service.SimulateRoute(context.Background(), &frontendv1alpha1.SimulateRouteRequest{
+		ServiceId:  "test-namespace:test-service",
+		InstanceId: "cluster-1:test-namespace:test-pod",
+	})
+
+	assert.Nil(t, resp)
+	statusErr, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestServiceRegistryService_GetProxyConfigDump(t *testing.T) {
+	configDump := `{"configs":[]}`
+	instanceID := "cluster-1:test-namespace:test-pod"
+
+	tests := []struct {
+		name                string
+		gzip                bool
+		includeEds          bool
+		expectedQuery       string
+		expectedContentType string
+	}{
+		{name: "plain json", expectedContentType: "application/json"},
+		{name: "gzip with eds", gzip: true, includeEds: true, expectedQuery: "include_eds", expectedContentType: "application/gzip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConnManager := &MockConnectionManager{}
+			mockAdminService := &MockEnvoyAdminService{}
+			mockConnManager.On("GetAggregatedServiceInstance", instanceID).Return(&connections.AggregatedServiceInstance{ClusterName: "cluster-1"}, true)
+			mockAdminService.On("GetEnvoyAdmin", mock.Anything, "cluster-1", "test-namespace", "test-pod", "config_dump", tt.expectedQuery).Return(configDump, nil)
+
+			service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, mockAdminService, logging.For("test"))
+
+			body, err := service.GetProxyConfigDump(context.Background(), &frontendv1alpha1.GetProxyConfigDumpRequest{
+				ServiceId:  "test-namespace:test-service",
+				InstanceId: instanceID,
+				Gzip:       &tt.gzip,
+				IncludeEds: &tt.includeEds,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedContentType, body.ContentType)
+
+			data := body.Data
+			if tt.gzip {
+				reader, err := gzip.NewReader(bytes.NewReader(body.Data))
+				assert.NoError(t, err)
+				data, err = io.ReadAll(reader)
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, configDump, string(data))
+
+			mockConnManager.AssertExpectations(t)
+			mockAdminService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// scopedConnections returns the view of the connection manager a request may access. Clusters outside the
+// scope of the request, by tenant or cluster selector, are treated as not connected, so they cannot be told
+// apart from clusters that do not exist.
+func scopedConnections(ctx context.Context, connectionManager providers.ReadOptimizedConnectionManager) providers.ReadOptimizedConnectionManager {
+	scope := tenancy.FromContext(ctx)
+	if scope == nil {
+		return connectionManager
+	}
+	return &scopedConnectionManager{ReadOptimizedConnectionManager: connectionManager, scope: scope}
+}
+
+// selectClusters narrows the scope of a request to the clusters whose labels match a cluster selector. The
+// context is returned unchanged if the selector is empty.
+func selectClusters(ctx context.Context, connectionManager providers.ReadOptimizedConnectionManager, clusterSelector string) (context.Context, error) {
+	if clusterSelector == "" {
+		return ctx, nil
+	}
+	selector, err := labels.Parse(clusterSelector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cluster selector: %v", err)
+	}
+
+	var clusterIDs []string
+	for clusterID, info := range scopedConnections(ctx, connectionManager).GetConnectionInfo() {
+		if selector.Matches(labels.Set(info.Labels)) {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	return tenancy.WithScope(ctx, tenancy.FromContext(ctx).Narrow(clusterIDs)), nil
+}
+
+// scopedConnectionManager restricts the reads of a connection manager, and the requests sent through it, to
+// the clusters of a tenant scope
+type scopedConnectionManager struct {
+	providers.ReadOptimizedConnectionManager
+	scope *tenancy.Scope
+}
+
+func (s *scopedConnectionManager) ListAggregatedServices(namespace, clusterID string) []*connections.AggregatedService {
+	if clusterID != "" && !s.scope.Allows(clusterID) {
+		return nil
+	}
+	var services []*connections.AggregatedService
+	for _, service := range s.ReadOptimizedConnectionManager.ListAggregatedServices(namespace, clusterID) {
+		if scoped := scopeService(service, s.scope); scoped != nil {
+			services = append(services, scoped)
+		}
+	}
+	return services
+}
+
+func (s *scopedConnectionManager) GetAggregatedService(serviceID string) (*connections.AggregatedService, bool) {
+	service, exists := s.ReadOptimizedConnectionManager.GetAggregatedService(serviceID)
+	if !exists {
+		return nil, false
+	}
+	scoped := scopeService(service, s.scope)
+	return scoped, scoped != nil
+}
+
+func (s *scopedConnectionManager) GetAggregatedServiceInstance(instanceID string) (*connections.AggregatedServiceInstance, bool) {
+	instance, exists := s.ReadOptimizedConnectionManager.GetAggregatedServiceInstance(instanceID)
+	if !exists || !s.scope.Allows(instance.ClusterName) {
+		return nil, false
+	}
+	return instance, true
+}
+
+func (s *scopedConnectionManager) GetConnectionInfo() map[string]connections.ConnectionInfo {
+	infos := make(map[string]connections.ConnectionInfo)
+	for clusterID, info := range s.ReadOptimizedConnectionManager.GetConnectionInfo() {
+		if s.scope.Allows(clusterID) {
+			infos[clusterID] = info
+		}
+	}
+	return infos
+}
+
+func (s *scopedConnectionManager) ListChanges(clusterID string, since time.Time, limit int) []connections.Change {
+	if clusterID != "" && !s.scope.Allows(clusterID) {
+		return nil
+	}
+	var changes []connections.Change
+	for _, change := range s.ReadOptimizedConnectionManager.ListChanges(clusterID, since, 0) {
+		if !s.scope.Allows(change.ClusterID) {
+			continue
+		}
+		changes = append(changes, change)
+		if len(changes) == limit {
+			break
+		}
+	}
+	return changes
+}
+
+func (s *scopedConnectionManager) ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event {
+	if clusterID != "" && !s.scope.Allows(clusterID) {
+		return nil
+	}
+	var events []connections.Event
+	for _, event := range s.ReadOptimizedConnectionManager.ListEvents(clusterID, start, end, 0) {
+		if !s.scope.Allows(event.ClusterID) {
+			continue
+		}
+		events = append(events, event)
+		if len(events) == limit {
+			break
+		}
+	}
+	return events
+}
+
+func (s *scopedConnectionManager) GetAllClusterStates() map[string]*v1alpha1.ClusterState {
+	states := make(map[string]*v1alpha1.ClusterState)
+	for clusterID, state := range s.ReadOptimizedConnectionManager.GetAllClusterStates() {
+		if s.scope.Allows(clusterID) {
+			states[clusterID] = state
+		}
+	}
+	return states
+}
+
+func (s *scopedConnectionManager) GetClusterState(clusterID string) (*v1alpha1.ClusterState, error) {
+	if !s.scope.Allows(clusterID) {
+		return nil, fmt.Errorf("no active connection for cluster %s", clusterID)
+	}
+	return s.ReadOptimizedConnectionManager.GetClusterState(clusterID)
+}
+
+func (s *scopedConnectionManager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	if !s.scope.Allows(clusterID) {
+		return nil, fmt.Errorf("no active connection for cluster %s", clusterID)
+	}
+	return s.ReadOptimizedConnectionManager.GetSelectorIndex(clusterID)
+}
+
+func (s *scopedConnectionManager) IsClusterConnected(clusterID string) bool {
+	return s.scope.Allows(clusterID) && s.ReadOptimizedConnectionManager.IsClusterConnected(clusterID)
+}
+
+func (s *scopedConnectionManager) GetActiveClusterCount() int {
+	count := 0
+	for clusterID := range s.ReadOptimizedConnectionManager.GetConnectionInfo() {
+		if s.scope.Allows(clusterID) && s.ReadOptimizedConnectionManager.IsClusterConnected(clusterID) {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *scopedConnectionManager) SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error {
+	if !s.scope.Allows(clusterID) {
+		return fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	return s.ReadOptimizedConnectionManager.SendMessageToCluster(clusterID, message)
+}
+
+func (s *scopedConnectionManager) RequestResync(clusterID, reason string) error {
+	if !s.scope.Allows(clusterID) {
+		return fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	return s.ReadOptimizedConnectionManager.RequestResync(clusterID, reason)
+}
+
+func (s *scopedConnectionManager) DisconnectCluster(clusterID, reason string) error {
+	if !s.scope.Allows(clusterID) {
+		return fmt.Errorf("cluster %s is not connected", clusterID)
+	}
+	return s.ReadOptimizedConnectionManager.DisconnectCluster(clusterID, reason)
+}
+
+// scopeService returns the part of an aggregated service in the clusters of a scope, or nil if it has no
+// instances, IPs or external names in any of them
+func scopeService(service *connections.AggregatedService, scope *tenancy.Scope) *connections.AggregatedService {
+	scoped := &connections.AggregatedService{
+		ID:            service.ID,
+		Name:          service.Name,
+		Namespace:     service.Namespace,
+		ClusterMap:    make(map[string][]*connections.AggregatedServiceInstance),
+		ClusterIPs:    make(map[string]string),
+		ExternalIPs:   make(map[string]string),
+		ExternalNames: make(map[string]string),
+	}
+	for _, instance := range service.Instances {
+		if scope.Allows(instance.ClusterName) {
+			scoped.Instances = append(scoped.Instances, instance)
+		}
+	}
+	for clusterID, instances := range service.ClusterMap {
+		if scope.Allows(clusterID) {
+			scoped.ClusterMap[clusterID] = instances
+		}
+	}
+	for clusterID, ip := range service.ClusterIPs {
+		if scope.Allows(clusterID) {
+			scoped.ClusterIPs[clusterID] = ip
+		}
+	}
+	for clusterID, ip := range service.ExternalIPs {
+		if scope.Allows(clusterID) {
+			scoped.ExternalIPs[clusterID] = ip
+		}
+	}
+	for clusterID, name := range service.ExternalNames {
+		if scope.Allows(clusterID) {
+			scoped.ExternalNames[clusterID] = name
+		}
+	}
+	for _, clusterID := range service.ExportedClusters {
+		if scope.Allows(clusterID) {
+			scoped.ExportedClusters = append(scoped.ExportedClusters, clusterID)
+		}
+	}
+	for _, clusterID := range service.ImportedClusters {
+		if scope.Allows(clusterID) {
+			scoped.ImportedClusters = append(scoped.ImportedClusters, clusterID)
+		}
+	}
+
+	if len(scoped.ClusterMap) == 0 && len(scoped.ClusterIPs) == 0 && len(scoped.ExternalIPs) == 0 && len(scoped.ExternalNames) == 0 {
+		return nil
+	}
+	return scoped
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func tenantContext(identity string) context.Context {
+	config := &tenancy.Config{Tenants: []tenancy.Tenant{
+		{Name: "payments", Identities: []string{"alice"}, Clusters: []string{"payments-*"}},
+	}}
+	return tenancy.WithScope(context.Background(), config.ScopeFor(identity))
+}
+
+func TestServiceRegistryService_ListServicesScopedToTenant(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	paymentsInstance := &connections.AggregatedServiceInstance{ClusterName: "payments-east"}
+	searchInstance := &connections.AggregatedServiceInstance{ClusterName: "search-east"}
+	mockConnManager.On("ListAggregatedServices", "", "").Return([]*connections.AggregatedService{
+		{
+			ID:         "shared:gateway",
+			Name:       "gateway",
+			Namespace:  "shared",
+			Instances:  []*connections.AggregatedServiceInstance{paymentsInstance, searchInstance},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"payments-east": {paymentsInstance}, "search-east": {searchInstance}},
+			ClusterIPs: map[string]string{"payments-east": "10.0.0.1", "search-east": "10.1.0.1"},
+		},
+		{
+			ID:         "search:indexer",
+			Name:       "indexer",
+			Namespace:  "search",
+			Instances:  []*connections.AggregatedServiceInstance{searchInstance},
+			ClusterMap: map[string][]*connections.AggregatedServiceInstance{"search-east": {searchInstance}},
+		},
+	})
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"payments-east": {ClusterID: "payments-east"},
+		"search-east":   {ClusterID: "search-east"},
+	})
+
+	resp, err := service.ListServices(tenantContext("alice"), &frontendv1alpha1.ListServicesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Services, 1)
+	assert.Equal(t, "gateway", resp.Services[0].Name)
+	require.Len(t, resp.Services[0].Instances, 1)
+	assert.Equal(t, "payments-east", resp.Services[0].Instances[0].ClusterName)
+	assert.Equal(t, map[string]string{"payments-east": "10.0.0.1"}, resp.Services[0].ClusterIps)
+	require.Len(t, resp.SyncMetadata, 1)
+	assert.Equal(t, "payments-east", resp.SyncMetadata[0].ClusterId)
+
+	// Requests without a tenant scope see every cluster
+	resp, err = service.ListServices(context.Background(), &frontendv1alpha1.ListServicesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Services, 2)
+}
+
+func TestServiceRegistryService_ListServicesScopedToTenant_ExternalName(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewServiceRegistryService(mockConnManager, &MockProxyService{}, &MockIstioService{}, &MockLogsService{}, &MockEnvoyAdminService{}, logging.For("test"))
+
+	mockConnManager.On("ListAggregatedServices", "", "").Return([]*connections.AggregatedService{
+		{
+			ID:            "payments:processor",
+			Name:          "processor",
+			Namespace:     "payments",
+			ExternalNames: map[string]string{"payments-east": "api.processor.example.com", "search-east": "api.other.example.com"},
+		},
+	})
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"payments-east": {ClusterID: "payments-east"},
+		"search-east":   {ClusterID: "search-east"},
+	})
+
+	// Services with only an external name in the tenant's clusters are kept
+	resp, err := service.ListServices(tenantContext("alice"), &frontendv1alpha1.ListServicesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Services, 1)
+	assert.Empty(t, resp.Services[0].Instances)
+	assert.Equal(t, map[string]string{"payments-east": "api.processor.example.com"}, resp.Services[0].ExternalNames)
+	require.Len(t, resp.SyncMetadata, 1)
+	assert.Equal(t, "payments-east", resp.SyncMetadata[0].ClusterId)
+}
+
+func TestSelectClusters(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	clusters := NewClusterRegistryService(mockConnManager, logging.For("test"))
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"payments-east": {ClusterID: "payments-east", Labels: map[string]string{"env": "prod", "region": "us-east-1"}},
+		"payments-west": {ClusterID: "payments-west", Labels: map[string]string{"env": "staging", "region": "us-west-2"}},
+		"search-east":   {ClusterID: "search-east", Labels: map[string]string{"env": "prod", "region": "us-east-1"}},
+	})
+	clusterIDs := func(resp *frontendv1alpha1.ListClustersResponse) []string {
+		var ids []string
+		for _, cluster := range resp.Clusters {
+			ids = append(ids, cluster.ClusterId)
+		}
+		return ids
+	}
+
+	resp, err := clusters.ListClusters(context.Background(), &frontendv1alpha1.ListClustersRequest{ClusterSelector: "env=prod"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"payments-east", "search-east"}, clusterIDs(resp))
+	assert.Equal(t, "us-east-1", resp.Clusters[0].SyncMetadata.Labels["region"])
+
+	// The selector narrows the tenant scope of the request
+	resp, err = clusters.ListClusters(tenantContext("alice"), &frontendv1alpha1.ListClustersRequest{ClusterSelector: "region in (us-east-1,us-west-2)"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"payments-east", "payments-west"}, clusterIDs(resp))
+
+	resp, err = clusters.ListClusters(context.Background(), &frontendv1alpha1.ListClustersRequest{ClusterSelector: "tier=gold"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Clusters)
+
+	_, err = clusters.ListClusters(context.Background(), &frontendv1alpha1.ListClustersRequest{ClusterSelector: "env in prod"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClusterRegistryService_ScopedToTenant(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	service := NewClusterRegistryService(mockConnManager, logging.For("test"))
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"payments-east": {ClusterID: "payments-east"},
+		"search-east":   {ClusterID: "search-east"},
+	})
+
+	ctx := tenantContext("alice")
+	clusters, err := service.ListClusters(ctx, &frontendv1alpha1.ListClustersRequest{})
+	require.NoError(t, err)
+	require.Len(t, clusters.Clusters, 1)
+	assert.Equal(t, "payments-east", clusters.Clusters[0].ClusterId)
+
+	// Clusters of other tenants cannot be told apart from clusters that are not connected
+	_, err = service.GetSyncStatus(ctx, &frontendv1alpha1.GetSyncStatusRequest{ClusterId: "search-east"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.ResyncCluster(ctx, &frontendv1alpha1.ResyncClusterRequest{ClusterId: "search-east"})
+	assert.Error(t, err)
+	mockConnManager.AssertNotCalled(t, "RequestResync", "search-east", "manual")
+
+	// Changes of other tenants' clusters are left out before the limit applies
+	mockConnManager.On("ListChanges", "", time.Time{}, 0).Return([]connections.Change{
+		{ClusterID: "search-east", Kind: "VirtualService", Name: "indexer"},
+		{ClusterID: "payments-east", Kind: "VirtualService", Name: "processor"},
+		{ClusterID: "payments-east", Kind: "Service", Name: "ledger"},
+	})
+	changes, err := service.ListChanges(ctx, &frontendv1alpha1.ListChangesRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	assert.Equal(t, "processor", changes.Changes[0].Name)
+
+	searchCluster := "search-east"
+	changes, err = service.ListChanges(ctx, &frontendv1alpha1.ListChangesRequest{ClusterId: &searchCluster})
+	require.NoError(t, err)
+	assert.Empty(t, changes.Changes)
+
+	// So are events
+	mockConnManager.On("ListEvents", "", time.Time{}, time.Time{}, 0).Return([]connections.Event{
+		{ClusterID: "search-east", Type: connections.EventSynced},
+		{ClusterID: "payments-east", Type: connections.EventConnected},
+	})
+	events, err := service.ListEvents(ctx, &frontendv1alpha1.ListEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, "payments-east", events.Events[0].ClusterId)
+
+	events, err = service.ListEvents(ctx, &frontendv1alpha1.ListEventsRequest{ClusterId: &searchCluster})
+	require.NoError(t, err)
+	assert.Empty(t, events.Events)
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTracesLimit and maxTracesLimit bound the number of traces listed
+	defaultTracesLimit = 20
+	maxTracesLimit     = 100
+)
+
+// TracesService implements the frontend TracesService
+type TracesService struct {
+	frontendv1alpha1.UnimplementedTracesServiceServer
+	connectionManager providers.ReadOptimizedConnectionManager
+	tracesProvider    providers.TracesProvider
+	logger            *slog.Logger
+}
+
+// NewTracesService creates a new traces service
+func NewTracesService(connectionManager providers.ReadOptimizedConnectionManager, tracesProvider providers.TracesProvider, logger *slog.Logger) *TracesService {
+	return &TracesService{
+		connectionManager: connectionManager,
+		tracesProvider:    tracesProvider,
+		logger:            logger,
+	}
+}
+
+// ListTraces returns the most recent traces of a service across every connected cluster with a tracing backend
+func (t *TracesService) ListTraces(ctx context.Context, req *frontendv1alpha1.ListTracesRequest) (*frontendv1alpha1.ListTracesResponse, error) {
+	t.logger.Debug("listing traces", "service", req.ServiceName, "namespace", req.Namespace, "min_duration", req.MinDuration.AsDuration())
+
+	ctx, err := selectClusters(ctx, t.connectionManager, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ServiceName == "" || req.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "service_name and namespace are required")
+	}
+	if req.StartTime == nil || req.EndTime == nil || !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required and end_time must be after start_time")
+	}
+	if req.MinDuration.AsDuration() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min_duration must not be negative")
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultTracesLimit
+	}
+	if limit < 0 || limit > maxTracesLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxTracesLimit)
+	}
+
+	var clusterIDs []string
+	for clusterID, info := range scopedConnections(ctx, t.connectionManager).GetConnectionInfo() {
+		if info.Capabilities.GetTracesEnabled() {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	if len(clusterIDs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no connected cluster has a tracing backend configured")
+	}
+	sort.Strings(clusterIDs)
+
+	// Spans are reported under the service's canonical service, which may differ from the service name
+	serviceName := req.ServiceName
+	if service, exists := scopedConnections(ctx, t.connectionManager).GetAggregatedService(req.Namespace + ":" + req.ServiceName); exists {
+		serviceName = telemetryServiceName(service)
+	}
+
+	query := &frontendv1alpha1.ListTracesRequest{
+		ServiceName: serviceName,
+		Namespace:   req.Namespace,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		MinDuration: req.MinDuration,
+		Limit:       limit,
+	}
+
+	// Search the tracing backends of all clusters in parallel
+	results := make([]*typesv1alpha1.ServiceTraces, len(clusterIDs))
+	errs := make([]error, len(clusterIDs))
+	var wg sync.WaitGroup
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = t.tracesProvider.ListTraces(ctx, clusterID, query)
+		}()
+	}
+	wg.Wait()
+
+	// A trace crossing clusters is found by the backend of each, keep the first
+	response := &frontendv1alpha1.ListTracesResponse{}
+	seen := make(map[string]bool)
+	for i, clusterID := range clusterIDs {
+		if errs[i] != nil {
+			t.logger.Warn("failed to list traces from cluster", "cluster_id", clusterID, "error", errs[i])
+			response.Warnings = append(response.Warnings, fmt.Sprintf("failed to retrieve traces from cluster %s: %v", clusterID, errs[i]))
+			continue
+		}
+		response.ClustersQueried = append(response.ClustersQueried, clusterID)
+		for _, trace := range results[i].GetTraces() {
+			if seen[trace.TraceId] {
+				continue
+			}
+			seen[trace.TraceId] = true
+			response.Traces = append(response.Traces, trace)
+		}
+	}
+
+	sort.SliceStable(response.Traces, func(i, j int) bool {
+		return response.Traces[i].StartTime.AsTime().After(response.Traces[j].StartTime.AsTime())
+	})
+	if len(response.Traces) > int(limit) {
+		response.Traces = response.Traces[:limit]
+	}
+
+	t.logger.Debug("listed traces",
+		"service", req.ServiceName,
+		"namespace", req.Namespace,
+		"traces", len(response.Traces),
+		"clusters_queried", len(response.ClustersQueried),
+		"warnings", len(response.Warnings))
+
+	return response, nil
+}
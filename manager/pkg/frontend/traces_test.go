@@ -0,0 +1,181 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	types "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockTracesProvider for testing
+type MockTracesProvider struct {
+	mock.Mock
+}
+
+func (m *MockTracesProvider) ListTraces(ctx context.Context, clusterID string, req *frontendv1alpha1.ListTracesRequest) (*types.ServiceTraces, error) {
+	args := m.Called(ctx, clusterID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.ServiceTraces), args.Error(1)
+}
+
+func listTracesRequest() *frontendv1alpha1.ListTracesRequest {
+	now := time.Now()
+	return &frontendv1alpha1.ListTracesRequest{
+		ServiceName: "reviews",
+		Namespace:   "bookinfo",
+		StartTime:   timestamppb.New(now.Add(-time.Hour)),
+		EndTime:     timestamppb.New(now),
+		MinDuration: durationpb.New(100 * time.Millisecond),
+	}
+}
+
+func TestTracesService_ListTraces(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockTracesProvider := &MockTracesProvider{}
+	service := NewTracesService(mockConnManager, mockTracesProvider, logging.For("test"))
+
+	traced := &backendv1alpha1.EdgeCapabilities{TracesEnabled: true}
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"east":     {ClusterID: "east", Capabilities: traced},
+		"west":     {ClusterID: "west", Capabilities: traced},
+		"south":    {ClusterID: "south", Capabilities: traced},
+		"untraced": {ClusterID: "untraced"},
+	})
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return(&connections.AggregatedService{
+		ID:        "bookinfo:reviews",
+		Name:      "reviews",
+		Namespace: "bookinfo",
+		Instances: []*connections.AggregatedServiceInstance{{CanonicalService: "reviews-app"}},
+	}, true)
+
+	start := time.Now().Add(-time.Minute)
+	trace := func(id string, age time.Duration, cluster string) *types.TraceSummary {
+		return &types.TraceSummary{TraceId: id, StartTime: timestamppb.New(start.Add(-age)), Cluster: cluster}
+	}
+	mockTracesProvider.On("ListTraces", mock.Anything, "east", mock.Anything).Return(&types.ServiceTraces{ClusterId: "east", Traces: []*types.TraceSummary{
+		trace("shared", 0, "east"),
+		trace("east-old", 2*time.Second, "east"),
+	}}, nil)
+	mockTracesProvider.On("ListTraces", mock.Anything, "west", mock.Anything).Return(&types.ServiceTraces{ClusterId: "west", Traces: []*types.TraceSummary{
+		trace("west-recent", -time.Second, "west"),
+		trace("shared", 0, "west"),
+	}}, nil)
+	mockTracesProvider.On("ListTraces", mock.Anything, "south", mock.Anything).Return(nil, errors.New("jaeger query failed"))
+
+	resp, err := service.ListTraces(context.Background(), listTracesRequest())
+	require.NoError(t, err)
+
+	// Traces of all clusters, most recent first, with traces crossing clusters listed once
+	var traceIDs []string
+	for _, trace := range resp.Traces {
+		traceIDs = append(traceIDs, trace.TraceId)
+	}
+	assert.Equal(t, []string{"west-recent", "shared", "east-old"}, traceIDs)
+	assert.Equal(t, "east", resp.Traces[1].Cluster)
+	assert.Equal(t, []string{"east", "west"}, resp.ClustersQueried)
+	assert.Equal(t, []string{"failed to retrieve traces from cluster south: jaeger query failed"}, resp.Warnings)
+
+	// The default limit and the service's canonical service are passed on to the edges
+	query := mockTracesProvider.Calls[0].Arguments.Get(2).(*frontendv1alpha1.ListTracesRequest)
+	assert.Equal(t, "reviews-app", query.ServiceName)
+	assert.Equal(t, int32(defaultTracesLimit), query.Limit)
+	assert.Equal(t, 100*time.Millisecond, query.MinDuration.AsDuration())
+}
+
+func TestTracesService_ListTracesLimit(t *testing.T) {
+	mockConnManager := &MockConnectionManager{}
+	mockTracesProvider := &MockTracesProvider{}
+	service := NewTracesService(mockConnManager, mockTracesProvider, logging.For("test"))
+
+	mockConnManager.On("GetConnectionInfo").Return(map[string]connections.ConnectionInfo{
+		"east": {ClusterID: "east", Capabilities: &backendv1alpha1.EdgeCapabilities{TracesEnabled: true}},
+	})
+	mockConnManager.On("GetAggregatedService", "bookinfo:reviews").Return((*connections.AggregatedService)(nil), false)
+	now := time.Now()
+	mockTracesProvider.On("ListTraces", mock.Anything, "east", mock.Anything).Return(&types.ServiceTraces{ClusterId: "east", Traces: []*types.TraceSummary{
+		{TraceId: "a", StartTime: timestamppb.New(now)},
+		{TraceId: "b", StartTime: timestamppb.New(now.Add(-time.Second))},
+		{TraceId: "c", StartTime: timestamppb.New(now.Add(-2 * time.Second))},
+	}}, nil)
+
+	req := listTracesRequest()
+	req.Limit = 2
+	resp, err := service.ListTraces(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Traces, 2)
+	assert.Equal(t, "b", resp.Traces[1].TraceId)
+}
+
+func TestTracesService_ListTracesErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(req *frontendv1alpha1.ListTracesRequest)
+		connections map[string]connections.ConnectionInfo
+		wantCode    codes.Code
+	}{
+		{
+			name:     "missing namespace",
+			modify:   func(req *frontendv1alpha1.ListTracesRequest) { req.Namespace = "" },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "end before start",
+			modify:   func(req *frontendv1alpha1.ListTracesRequest) { req.StartTime, req.EndTime = req.EndTime, req.StartTime },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "limit too large",
+			modify:   func(req *frontendv1alpha1.ListTracesRequest) { req.Limit = maxTracesLimit + 1 },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "no tracing backend",
+			modify:      func(req *frontendv1alpha1.ListTracesRequest) {},
+			connections: map[string]connections.ConnectionInfo{"east": {ClusterID: "east"}},
+			wantCode:    codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConnManager := &MockConnectionManager{}
+			mockConnManager.On("GetConnectionInfo").Return(tt.connections)
+			service := NewTracesService(mockConnManager, &MockTracesProvider{}, logging.For("test"))
+
+			req := listTracesRequest()
+			tt.modify(req)
+			_, err := service.ListTraces(context.Background(), req)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// AnalysisProvider defines the interface for analyzing the configuration of connected clusters
+type AnalysisProvider interface {
+	// AnalyzeClusters analyzes the configuration of a cluster, or of every connected cluster if clusterID is empty
+	AnalyzeClusters(ctx context.Context, clusterID string) ([]*frontendv1alpha1.ClusterAnalysis, error)
+	// DryRunAuthorizationPolicy reports the observed traffic in a cluster that a drafted AuthorizationPolicy would deny
+	DryRunAuthorizationPolicy(ctx context.Context, clusterID string, policy *typesv1alpha1.AuthorizationPolicy) (*frontendv1alpha1.DryRunAuthorizationPolicyResponse, error)
+}
@@ -14,9 +14,16 @@
 
 package providers
 
+import "github.com/liamawhite/navigator/manager/pkg/tenancy"
+
 // Config interface for server configuration
 type Config interface {
 	GetPort() int
+	GetHTTPSocket() string
+	GetAdminPort() int
 	GetMaxMessageSize() int
+	GetMaxClusterStateSize() int
+	GetTenants() *tenancy.Config
+	GetReplaySnapshot() string
 	Validate() error
 }
@@ -15,21 +15,30 @@
 package providers
 
 import (
+	"time"
+
 	"github.com/liamawhite/navigator/manager/pkg/connections"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
 )
 
 // ConnectionManager interface for basic connection management
 type ConnectionManager interface {
 	RegisterConnection(clusterID string, stream v1alpha1.ManagerService_ConnectServer) error
-	UnregisterConnection(clusterID string)
-	UpdateClusterState(clusterID string, clusterState *v1alpha1.ClusterState) error
-	UpdateCapabilities(clusterID string, capabilities *v1alpha1.EdgeCapabilities) error
+	RegisterEdgeConnection(identification *v1alpha1.ClusterIdentification, stream v1alpha1.ManagerService_ConnectServer) (string, error)
+	UnregisterConnection(connectionID string, stream v1alpha1.ManagerService_ConnectServer) bool
+	UpdateClusterState(connectionID string, clusterState *v1alpha1.ClusterState) error
+	UpdateCapabilities(connectionID string, capabilities *v1alpha1.EdgeCapabilities) error
+	UpdateEdgeVersion(connectionID, edgeVersion string) error
 	GetClusterState(clusterID string) (*v1alpha1.ClusterState, error)
+	GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error)
 	GetAllClusterStates() map[string]*v1alpha1.ClusterState
 	IsClusterConnected(clusterID string) bool
 	GetActiveClusterCount() int
 	SendMessageToCluster(clusterID string, message *v1alpha1.ConnectResponse) error
+	RequestResync(clusterID, reason string) error
+	DisconnectCluster(clusterID, reason string) error
+	Disconnected(connectionID string) <-chan struct{}
 }
 
 // ReadOptimizedConnectionManager extends ConnectionManager with read-optimized methods
@@ -39,4 +48,6 @@ type ReadOptimizedConnectionManager interface {
 	GetAggregatedService(serviceID string) (*connections.AggregatedService, bool)
 	GetAggregatedServiceInstance(instanceID string) (*connections.AggregatedServiceInstance, bool)
 	GetConnectionInfo() map[string]connections.ConnectionInfo
+	ListChanges(clusterID string, since time.Time, limit int) []connections.Change
+	ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event
 }
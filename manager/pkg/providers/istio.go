@@ -16,12 +16,31 @@ package providers
 
 import (
 	"context"
+	"errors"
 
 	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
 
-// IstioResourcesProvider defines the interface for retrieving Istio resources for a specific workload
+// IstioResourceFilter selects which collected Istio resources are listed
+type IstioResourceFilter struct {
+	ClusterID string                            // Empty selects all connected clusters
+	Namespace string                            // Empty selects all namespaces
+	Kinds     []typesv1alpha1.IstioResourceKind // Empty selects all kinds
+	Names     []string                          // Empty selects all names
+}
+
+// ErrResourceNotFound is returned when a requested resource is not present in the collected cluster state
+var ErrResourceNotFound = errors.New("resource not found")
+
+// IstioResourcesProvider defines the interface for retrieving Istio resources
 type IstioResourcesProvider interface {
 	GetIstioResourcesForWorkload(ctx context.Context, clusterID, namespace string, instance *backendv1alpha1.ServiceInstance) (*frontendv1alpha1.GetIstioResourcesResponse, error)
+	// ListIstioResources returns the matching resources in [offset, offset+limit) along with the total number of matches
+	ListIstioResources(ctx context.Context, filter IstioResourceFilter, offset, limit int) ([]*frontendv1alpha1.IstioResource, int, error)
+	// GetResourceInventory counts the resources of each kind per namespace per cluster, in the given cluster or all if empty
+	GetResourceInventory(ctx context.Context, clusterID string) (*frontendv1alpha1.GetResourceInventoryResponse, error)
+	// GetResourceReferences returns the references to and from a resource, or ErrResourceNotFound if it does not exist
+	GetResourceReferences(ctx context.Context, clusterID, kind, namespace, name string) (*frontendv1alpha1.GetResourceReferencesResponse, error)
 }
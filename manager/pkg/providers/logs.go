@@ -0,0 +1,34 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+
+	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// PodLogOptions controls which container logs are retrieved
+type PodLogOptions struct {
+	Container    string // Empty selects the pod's default container
+	TailLines    int64  // Zero uses the edge default
+	SinceSeconds int64  // Zero applies no time limit
+	Previous     bool   // Retrieve logs from the previous terminated container
+}
+
+// PodLogsProvider defines the interface for retrieving container logs
+type PodLogsProvider interface {
+	GetPodLogs(ctx context.Context, clusterID, namespace, podName string, options PodLogOptions) (*v1alpha1.ContainerLogs, error)
+}
@@ -24,4 +24,6 @@ import (
 // MeshMetricsProvider defines the interface for retrieving service mesh metrics
 type MeshMetricsProvider interface {
 	GetServiceConnections(ctx context.Context, clusterID string, req *frontendv1alpha1.GetServiceConnectionsRequest, proxyMode typesv1alpha1.ProxyMode) (*typesv1alpha1.ServiceGraphMetrics, error)
+	GetPairInstanceMetrics(ctx context.Context, clusterID string, req *frontendv1alpha1.GetPairInstanceMetricsRequest) (*typesv1alpha1.PairInstanceMetrics, error)
+	GetMeshMetricsTimeSeries(ctx context.Context, clusterID string, req *frontendv1alpha1.GetMeshMetricsTimeSeriesRequest) (*typesv1alpha1.MeshMetricsTimeSeries, error)
 }
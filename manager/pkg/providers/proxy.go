@@ -16,11 +16,24 @@ package providers
 
 import (
 	"context"
+	"time"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
 
+// ProxyConfigOptions controls how a proxy configuration is retrieved
+type ProxyConfigOptions struct {
+	ForceRefresh bool // Bypass any cached configuration and fetch a new snapshot
+}
+
+// ProxyConfigSnapshot is a proxy configuration along with when it was taken from the proxy
+type ProxyConfigSnapshot struct {
+	ProxyConfig *v1alpha1.ProxyConfig
+	FetchedAt   time.Time
+	Cached      bool // Served from a previous snapshot rather than fetched for this request
+}
+
 // ProxyConfigProvider defines the interface for retrieving proxy configurations
 type ProxyConfigProvider interface {
-	GetProxyConfig(ctx context.Context, clusterID, namespace, podName string) (*v1alpha1.ProxyConfig, error)
+	GetProxyConfig(ctx context.Context, clusterID, namespace, podName string, options ProxyConfigOptions) (*ProxyConfigSnapshot, error)
 }
@@ -0,0 +1,157 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay serves a support bundle captured with navctl snapshot as if its clusters were connected,
+// so that an issue can be investigated, or the UI demoed, without access to the clusters.
+package replay
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Bundle is the captured state of a service, as written by navctl snapshot
+type Bundle struct {
+	CreatedAt      time.Time
+	Service        *frontendv1alpha1.GetServiceResponse
+	Clusters       *frontendv1alpha1.ListClustersResponse                 // nil if the clusters were not captured
+	Instances      map[string]*frontendv1alpha1.ServiceInstanceDetail     // instance ID -> instance detail
+	IstioResources map[string]*frontendv1alpha1.GetIstioResourcesResponse // file name -> Istio resources applying to the instance
+	ProxyConfigs   map[string]*frontendv1alpha1.InstanceProxyConfig       // instance ID -> proxy config
+	Metrics        *frontendv1alpha1.GetServiceConnectionsResponse        // nil if metrics were not captured
+}
+
+// manifest is the part of a bundle's manifest.json the replay uses
+type manifest struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Load reads a bundle from a gzipped tar archive on disk
+func Load(path string) (*Bundle, error) {
+	file, err := os.Open(path) // #nosec G304 - the bundle path is chosen by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	bundle, err := Read(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// Read reads a bundle from a gzipped tar archive
+func Read(r io.Reader) (*Bundle, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Instances:      make(map[string]*frontendv1alpha1.ServiceInstanceDetail),
+		IstioResources: make(map[string]*frontendv1alpha1.GetIstioResourcesResponse),
+		ProxyConfigs:   make(map[string]*frontendv1alpha1.InstanceProxyConfig),
+	}
+	reader := tar.NewReader(gzipReader)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		// Files are written below a directory named after the service and the time of the snapshot
+		_, name, _ := strings.Cut(header.Name, "/")
+		if err := bundle.add(name, data); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+	}
+
+	if bundle.Service.GetService() == nil {
+		return nil, fmt.Errorf("bundle has no service.json")
+	}
+	return bundle, nil
+}
+
+// add parses a file of the bundle, ignoring files the replay does not use, such as logs
+func (b *Bundle) add(name string, data []byte) error {
+	dir, file := path.Split(name)
+	key := strings.TrimSuffix(file, ".json")
+	switch {
+	case name == "manifest.json":
+		var m manifest
+		if err := json.Unmarshal(data, &m); err != nil {
+			return err
+		}
+		b.CreatedAt = m.CreatedAt
+	case name == "service.json":
+		b.Service = &frontendv1alpha1.GetServiceResponse{}
+		return unmarshal(data, b.Service)
+	case name == "clusters.json":
+		b.Clusters = &frontendv1alpha1.ListClustersResponse{}
+		return unmarshal(data, b.Clusters)
+	case name == "metrics.json":
+		b.Metrics = &frontendv1alpha1.GetServiceConnectionsResponse{}
+		return unmarshal(data, b.Metrics)
+	case dir == "instances/":
+		instance := &frontendv1alpha1.GetServiceInstanceResponse{}
+		if err := unmarshal(data, instance); err != nil {
+			return err
+		}
+		b.Instances[instance.GetInstance().GetInstanceId()] = instance.Instance
+	case dir == "istio-resources/":
+		resources := &frontendv1alpha1.GetIstioResourcesResponse{}
+		if err := unmarshal(data, resources); err != nil {
+			return err
+		}
+		b.IstioResources[key] = resources
+	case dir == "proxy-configs/":
+		proxyConfig := &frontendv1alpha1.InstanceProxyConfig{}
+		if err := unmarshal(data, proxyConfig); err != nil {
+			return err
+		}
+		b.ProxyConfigs[proxyConfig.InstanceId] = proxyConfig
+	}
+	return nil
+}
+
+// unmarshal parses a JSON message, ignoring fields added to the API after the bundle's navctl was built
+func unmarshal(data []byte, message proto.Message) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, message)
+}
+
+// fileName is the name of an instance's files in the bundle, see navctl snapshot
+func fileName(instanceID string) string {
+	return strings.NewReplacer(":", "_", "/", "_").Replace(instanceID)
+}
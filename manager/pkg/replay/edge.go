@@ -0,0 +1,241 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/protocol"
+	"google.golang.org/grpc"
+)
+
+// EdgeVersion is the edge version replayed clusters report to the manager
+const EdgeVersion = "snapshot-replay"
+
+// reconnectInterval is how long a replayed cluster waits before reconnecting to the manager
+const reconnectInterval = 5 * time.Second
+
+// edge simulates the edge of a cluster in a bundle. It syncs the cluster's captured state and answers
+// proxy config and service connections requests from the bundle; other requests are not advertised, so
+// the manager fails them as unsupported.
+type edge struct {
+	clusterID string
+	state     *v1alpha1.ClusterState
+	states    map[string]*v1alpha1.ClusterState // cluster_id -> state of every replayed cluster
+	bundle    *Bundle
+	client    v1alpha1.ManagerServiceClient
+	logger    *slog.Logger
+}
+
+// Run connects a simulated edge for each cluster of a bundle to the manager, reconnecting them whenever
+// they are disconnected, until ctx is done
+func Run(ctx context.Context, conn grpc.ClientConnInterface, bundle *Bundle, logger *slog.Logger) {
+	client := v1alpha1.NewManagerServiceClient(conn)
+	states := bundle.ClusterStates()
+	var wg sync.WaitGroup
+	for clusterID, state := range states {
+		e := &edge{
+			clusterID: clusterID,
+			state:     state,
+			states:    states,
+			bundle:    bundle,
+			client:    client,
+			logger:    logger.With("cluster_id", clusterID),
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.run(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// run keeps the edge connected until ctx is done
+func (e *edge) run(ctx context.Context) {
+	for {
+		err := e.serve(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		e.logger.Warn("replayed cluster disconnected, reconnecting", "error", err, "interval", reconnectInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// serve connects to the manager, syncs the cluster's state and answers the manager's requests until the
+// connection fails
+func (e *edge) serve(ctx context.Context) error {
+	stream, err := e.client.Connect(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create stream: %w", err)
+	}
+
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_ClusterIdentification{
+			ClusterIdentification: &v1alpha1.ClusterIdentification{
+				ClusterId:    e.clusterID,
+				Capabilities: &v1alpha1.EdgeCapabilities{MetricsEnabled: e.bundle.Metrics != nil},
+				EdgeVersion:  EdgeVersion,
+				Protocol:     capabilities(),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send cluster identification: %w", err)
+	}
+
+	ack, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("failed to receive connection acknowledgment: %w", err)
+	}
+	if !ack.GetConnectionAck().GetAccepted() {
+		return fmt.Errorf("connection rejected by manager")
+	}
+
+	if err := e.sync(stream); err != nil {
+		return err
+	}
+	e.logger.Info("replaying cluster from snapshot", "services", len(e.state.Services))
+
+	for {
+		message, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		var response *v1alpha1.ConnectRequest
+		switch msg := message.Message.(type) {
+		case *v1alpha1.ConnectResponse_ResyncRequest:
+			if err := e.sync(stream); err != nil {
+				return err
+			}
+		case *v1alpha1.ConnectResponse_ProxyConfigRequest:
+			response = e.proxyConfig(msg.ProxyConfigRequest)
+		case *v1alpha1.ConnectResponse_ServiceConnectionsRequest:
+			response = e.serviceConnections(msg.ServiceConnectionsRequest)
+		case *v1alpha1.ConnectResponse_Error:
+			e.logger.Error("received error from manager", "error_code", msg.Error.ErrorCode, "error_message", msg.Error.ErrorMessage)
+		default:
+			e.logger.Debug("ignoring unsupported request", "type", fmt.Sprintf("%T", msg))
+		}
+		if response == nil {
+			continue
+		}
+		if err := stream.Send(response); err != nil {
+			return fmt.Errorf("failed to send response: %w", err)
+		}
+	}
+}
+
+// sync sends the cluster's captured state to the manager
+func (e *edge) sync(stream v1alpha1.ManagerService_ConnectClient) error {
+	if err := stream.Send(&v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_ClusterState{ClusterState: e.state},
+	}); err != nil {
+		return fmt.Errorf("failed to send cluster state: %w", err)
+	}
+	return nil
+}
+
+// proxyConfig answers a proxy config request with the proxy config captured for the pod
+func (e *edge) proxyConfig(req *v1alpha1.ProxyConfigRequest) *v1alpha1.ConnectRequest {
+	response := &v1alpha1.ProxyConfigResponse{RequestId: req.RequestId}
+	instanceID := fmt.Sprintf("%s:%s:%s", e.clusterID, req.PodNamespace, req.PodName)
+	captured, exists := e.bundle.ProxyConfigs[instanceID]
+	switch {
+	case !exists:
+		response.Result = &v1alpha1.ProxyConfigResponse_ErrorMessage{
+			ErrorMessage: fmt.Sprintf("the proxy config of pod %s/%s was not captured in the snapshot", req.PodNamespace, req.PodName),
+		}
+	case captured.GetProxyConfig() != nil:
+		response.Result = &v1alpha1.ProxyConfigResponse_ProxyConfig{ProxyConfig: captured.GetProxyConfig()}
+	default:
+		response.Result = &v1alpha1.ProxyConfigResponse_ErrorMessage{ErrorMessage: captured.GetErrorMessage()}
+	}
+	return &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_ProxyConfigResponse{ProxyConfigResponse: response},
+	}
+}
+
+// serviceConnections answers a service connections request with the captured connections of the bundle's
+// service that were reported in this cluster, regardless of the requested time range. Other services have
+// no connections.
+func (e *edge) serviceConnections(req *v1alpha1.ServiceConnectionsRequest) *v1alpha1.ConnectRequest {
+	metrics := &typesv1alpha1.ServiceGraphMetrics{
+		ClusterId: e.clusterID,
+		Timestamp: e.bundle.Metrics.GetTimestamp(),
+	}
+	service := e.bundle.Service.Service
+	if req.ServiceName == service.Name && req.Namespace == service.Namespace {
+		metrics.Pairs = e.bundle.clusterPairs(e.clusterID, e.states)
+	}
+	return &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_ServiceConnectionsResponse{
+			ServiceConnectionsResponse: &v1alpha1.ServiceConnectionsResponse{
+				RequestId: req.RequestId,
+				Result:    &v1alpha1.ServiceConnectionsResponse_ServiceConnections{ServiceConnections: metrics},
+			},
+		},
+	}
+}
+
+// clusterPairs returns the captured service pairs reported by the proxies of a cluster. A pair whose
+// reporting cluster is not in the bundle is attributed to the cluster on its other side.
+func (b *Bundle) clusterPairs(clusterID string, states map[string]*v1alpha1.ClusterState) []*typesv1alpha1.ServicePairMetrics {
+	var pairs []*typesv1alpha1.ServicePairMetrics
+	seen := make(map[string]bool)
+	for _, aggregated := range append(b.Metrics.GetInbound(), b.Metrics.GetOutbound()...) {
+		for _, pair := range aggregated.DetailedBreakdown {
+			reporting, other := pair.SourceCluster, pair.DestinationCluster
+			if pair.Reporter == "destination" {
+				reporting, other = other, reporting
+			}
+			if _, exists := states[reporting]; !exists {
+				reporting = other
+			}
+			if reporting != clusterID {
+				continue
+			}
+
+			// Calls of the service to itself are both inbound and outbound
+			key := fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s", pair.SourceCluster, pair.SourceNamespace, pair.SourceService,
+				pair.DestinationCluster, pair.DestinationNamespace, pair.DestinationService, pair.Reporter)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+// capabilities returns the protocol capabilities of a replayed cluster, which only handles the requests
+// the bundle has the answers to
+func capabilities() *v1alpha1.ProtocolCapabilities {
+	capabilities := protocol.Capabilities()
+	capabilities.Requests = []string{protocol.RequestProxyConfig, protocol.RequestServiceConnections, protocol.RequestResync}
+	return capabilities
+}
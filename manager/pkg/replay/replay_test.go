@@ -0,0 +1,173 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBundle is a snapshot of a service with instances in two clusters
+var testBundle = map[string]string{
+	"manifest.json": `{"navctlVersion": "dev", "createdAt": "2025-06-01T12:00:00Z", "service": "bookinfo:reviews"}`,
+	"service.json": `{
+		"service": {
+			"id": "bookinfo:reviews",
+			"name": "reviews",
+			"namespace": "bookinfo",
+			"clusterIps": {"east": "10.96.0.10", "west": "10.96.0.20"},
+			"proxyMode": "SIDECAR",
+			"instances": [
+				{"instanceId": "east:bookinfo:reviews-v1", "ip": "10.0.0.1", "podName": "reviews-v1", "namespace": "bookinfo", "clusterName": "east", "envoyPresent": true},
+				{"instanceId": "east:bookinfo:reviews-v2", "ip": "10.0.0.2", "podName": "reviews-v2", "namespace": "bookinfo", "clusterName": "east", "envoyPresent": true},
+				{"instanceId": "west:bookinfo:reviews-v1", "ip": "10.1.0.1", "podName": "reviews-v1", "namespace": "bookinfo", "clusterName": "west"}
+			]
+		}
+	}`,
+	"clusters.json": `{"clusters": [
+		{"clusterId": "east", "syncMetadata": {"clusterId": "east", "labels": {"region": "us-east"}}},
+		{"clusterId": "west"},
+		{"clusterId": "central"}
+	]}`,
+	"instances/east_bookinfo_reviews-v1.json": `{"instance": {
+		"instanceId": "east:bookinfo:reviews-v1",
+		"podName": "reviews-v1",
+		"podStatus": "Running",
+		"labels": {"version": "v1"},
+		"containers": [{"name": "reviews", "image": "reviews:v1", "ready": true}]
+	}}`,
+	"istio-resources/east_bookinfo_reviews-v1.json": `{"virtualServices": [{"name": "reviews", "namespace": "bookinfo"}]}`,
+	"istio-resources/east_bookinfo_reviews-v2.json": `{"virtualServices": [{"name": "reviews", "namespace": "bookinfo"}], "destinationRules": [{"name": "reviews", "namespace": "bookinfo"}]}`,
+	"proxy-configs/east_bookinfo_reviews-v1.json":   `{"instanceId": "east:bookinfo:reviews-v1", "clusterName": "east", "proxyConfig": {"version": "1.22.0"}}`,
+	"proxy-configs/west_bookinfo_reviews-v1.json":   `{"instanceId": "west:bookinfo:reviews-v1", "clusterName": "west", "errorMessage": "no proxy"}`,
+	"metrics.json": `{
+		"inbound": [{
+			"sourceNamespace": "bookinfo", "sourceService": "productpage", "destinationNamespace": "bookinfo", "destinationService": "reviews",
+			"detailedBreakdown": [
+				{"sourceCluster": "east", "sourceNamespace": "bookinfo", "sourceService": "productpage", "destinationCluster": "east", "destinationNamespace": "bookinfo", "destinationService": "reviews", "requestRate": 10, "reporter": "destination"},
+				{"sourceCluster": "east", "sourceNamespace": "bookinfo", "sourceService": "productpage", "destinationCluster": "west", "destinationNamespace": "bookinfo", "destinationService": "reviews", "requestRate": 2, "reporter": "destination"},
+				{"sourceCluster": "unknown", "sourceNamespace": "bookinfo", "sourceService": "productpage", "destinationCluster": "west", "destinationNamespace": "bookinfo", "destinationService": "reviews", "requestRate": 1, "reporter": "source"}
+			]
+		}],
+		"timestamp": "2025-06-01T12:00:00Z"
+	}`,
+	"logs/navctl.log": "level=INFO msg=started\n",
+}
+
+// writeBundle writes files to a gzipped tar archive below a directory, like navctl snapshot
+func writeBundle(t *testing.T, files map[string]string) []byte {
+	var out bytes.Buffer
+	gzipWriter := gzip.NewWriter(&out)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     "navigator-snapshot-bookinfo-reviews-20250601T120000Z/" + name,
+			Typeflag: tar.TypeReg,
+			Mode:     0600,
+			Size:     int64(len(content)),
+		}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return out.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	bundle, err := Read(bytes.NewReader(writeBundle(t, testBundle)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "bookinfo:reviews", bundle.Service.Service.Id)
+	assert.Equal(t, 2025, bundle.CreatedAt.Year())
+	assert.Len(t, bundle.Clusters.Clusters, 3)
+	assert.Len(t, bundle.Instances, 1)
+	assert.Len(t, bundle.IstioResources, 2)
+	assert.Len(t, bundle.ProxyConfigs, 2)
+	require.NotNil(t, bundle.Metrics)
+
+	_, err = Read(bytes.NewReader(writeBundle(t, map[string]string{"clusters.json": `{}`})))
+	assert.ErrorContains(t, err, "no service.json")
+
+	_, err = Read(bytes.NewReader(writeBundle(t, map[string]string{"service.json": `{"service": `})))
+	assert.ErrorContains(t, err, "failed to parse service.json")
+}
+
+func TestBundle_ClusterStates(t *testing.T) {
+	bundle, err := Read(bytes.NewReader(writeBundle(t, testBundle)))
+	require.NoError(t, err)
+
+	states := bundle.ClusterStates()
+	require.Len(t, states, 3)
+
+	east := states["east"]
+	assert.Equal(t, map[string]string{"region": "us-east"}, east.ClusterLabels)
+	assert.Equal(t, bundle.CreatedAt, east.SyncMetadata.CollectedAt.AsTime())
+	require.Len(t, east.Services, 1)
+	assert.Equal(t, "10.96.0.10", east.Services[0].ClusterIp)
+	require.Len(t, east.Services[0].Instances, 2)
+
+	// Instances are completed with their captured detail
+	instance := east.Services[0].Instances[0]
+	assert.Equal(t, "Running", instance.PodStatus)
+	assert.Equal(t, map[string]string{"version": "v1"}, instance.Labels)
+	assert.Equal(t, []*v1alpha1.Container{{Name: "reviews", Image: "reviews:v1", Ready: true}}, instance.Containers)
+	assert.Equal(t, typesv1alpha1.ProxyMode_SIDECAR, instance.ProxyMode)
+
+	// Resources applying to several instances are synced once
+	assert.Len(t, east.VirtualServices, 1)
+	assert.Len(t, east.DestinationRules, 1)
+
+	west := states["west"]
+	require.Len(t, west.Services, 1)
+	assert.Equal(t, typesv1alpha1.ProxyMode_NONE, west.Services[0].Instances[0].ProxyMode)
+
+	// Clusters without the service are still replayed
+	assert.Empty(t, states["central"].Services)
+}
+
+func TestEdge_Responses(t *testing.T) {
+	bundle, err := Read(bytes.NewReader(writeBundle(t, testBundle)))
+	require.NoError(t, err)
+	states := bundle.ClusterStates()
+	east := &edge{clusterID: "east", state: states["east"], states: states, bundle: bundle}
+	west := &edge{clusterID: "west", state: states["west"], states: states, bundle: bundle}
+
+	response := east.proxyConfig(&v1alpha1.ProxyConfigRequest{RequestId: "1", PodNamespace: "bookinfo", PodName: "reviews-v1"})
+	assert.Equal(t, "1.22.0", response.GetProxyConfigResponse().GetProxyConfig().GetVersion())
+	response = west.proxyConfig(&v1alpha1.ProxyConfigRequest{RequestId: "2", PodNamespace: "bookinfo", PodName: "reviews-v1"})
+	assert.Equal(t, "no proxy", response.GetProxyConfigResponse().GetErrorMessage())
+	response = east.proxyConfig(&v1alpha1.ProxyConfigRequest{RequestId: "3", PodNamespace: "bookinfo", PodName: "reviews-v3"})
+	assert.Contains(t, response.GetProxyConfigResponse().GetErrorMessage(), "was not captured")
+
+	// Pairs are served by the cluster whose proxies reported them
+	request := &v1alpha1.ServiceConnectionsRequest{RequestId: "4", ServiceName: "reviews", Namespace: "bookinfo"}
+	pairs := east.serviceConnections(request).GetServiceConnectionsResponse().GetServiceConnections().GetPairs()
+	require.Len(t, pairs, 1)
+	assert.Equal(t, 10.0, pairs[0].RequestRate)
+	pairs = west.serviceConnections(request).GetServiceConnectionsResponse().GetServiceConnections().GetPairs()
+	assert.Len(t, pairs, 2)
+
+	// Other services have no captured connections
+	request.ServiceName = "ratings"
+	assert.Empty(t, east.serviceConnections(request).GetServiceConnectionsResponse().GetServiceConnections().GetPairs())
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ClusterStates returns the state of each cluster in the bundle as its edge would have synced it. Only
+// the captured service, the Istio resources applying to its instances and the status of the cluster are
+// known, so the rest of each cluster is empty.
+func (b *Bundle) ClusterStates() map[string]*v1alpha1.ClusterState {
+	states := make(map[string]*v1alpha1.ClusterState)
+	state := func(clusterID string) *v1alpha1.ClusterState {
+		if _, exists := states[clusterID]; !exists {
+			states[clusterID] = &v1alpha1.ClusterState{
+				SyncMetadata: &v1alpha1.SyncMetadata{CollectedAt: timestamppb.New(b.CreatedAt)},
+			}
+		}
+		return states[clusterID]
+	}
+
+	for _, cluster := range b.Clusters.GetClusters() {
+		clusterState := state(cluster.ClusterId)
+		clusterState.IstioCni = cluster.IstioCni
+		clusterState.SidecarInjection = cluster.SidecarInjection
+		clusterState.ClusterLabels = cluster.SyncMetadata.GetLabels()
+	}
+	for _, metadata := range b.Service.SyncMetadata {
+		clusterState := state(metadata.ClusterId)
+		if clusterState.ClusterLabels == nil {
+			clusterState.ClusterLabels = metadata.Labels
+		}
+	}
+
+	service := b.Service.Service
+	services := make(map[string]*v1alpha1.Service)
+	serviceIn := func(clusterID string) *v1alpha1.Service {
+		if _, exists := services[clusterID]; !exists {
+			services[clusterID] = &v1alpha1.Service{
+				Name:       service.Name,
+				Namespace:  service.Namespace,
+				ClusterIp:  service.ClusterIps[clusterID],
+				ExternalIp: service.ExternalIps[clusterID],
+			}
+			if externalName := service.ExternalNames[clusterID]; externalName != "" {
+				services[clusterID].ServiceType = typesv1alpha1.ServiceType_EXTERNAL_NAME
+				services[clusterID].ExternalName = externalName
+			}
+		}
+		return services[clusterID]
+	}
+	for clusterID := range service.ClusterIps {
+		serviceIn(clusterID)
+	}
+	for clusterID := range service.ExternalNames {
+		serviceIn(clusterID)
+	}
+	for _, instance := range service.Instances {
+		serviceIn(instance.ClusterName).Instances = append(serviceIn(instance.ClusterName).Instances, b.convertInstance(instance, service.ProxyMode))
+		addIstioResources(state(instance.ClusterName), b.IstioResources[fileName(instance.InstanceId)])
+	}
+	for clusterID, clusterService := range services {
+		state(clusterID).Services = append(state(clusterID).Services, clusterService)
+	}
+
+	// Multi-Cluster Services API exports and imports of the service
+	for _, clusterID := range service.ExportedClusters {
+		state(clusterID).ServiceExports = append(state(clusterID).ServiceExports, &v1alpha1.ServiceExport{
+			Name:      service.Name,
+			Namespace: service.Namespace,
+			Valid:     true,
+		})
+	}
+	for _, clusterID := range service.ImportedClusters {
+		state(clusterID).ServiceImports = append(state(clusterID).ServiceImports, &v1alpha1.ServiceImport{
+			Name:      service.Name,
+			Namespace: service.Namespace,
+			Clusters:  service.ExportedClusters,
+		})
+	}
+
+	return states
+}
+
+// convertInstance converts a captured instance to the instance an edge syncs, using its captured detail
+// where available. Instances with a proxy are assumed to run it in the service's proxy mode.
+func (b *Bundle) convertInstance(instance *frontendv1alpha1.ServiceInstance, proxyMode typesv1alpha1.ProxyMode) *v1alpha1.ServiceInstance {
+	converted := &v1alpha1.ServiceInstance{
+		Ip:           instance.Ip,
+		PodName:      instance.PodName,
+		EnvoyPresent: instance.EnvoyPresent,
+		ProxyMode:    typesv1alpha1.ProxyMode_NONE,
+	}
+	if instance.EnvoyPresent {
+		converted.ProxyMode = proxyMode
+		if proxyMode == typesv1alpha1.ProxyMode_UNKNOWN_PROXY_MODE || proxyMode == typesv1alpha1.ProxyMode_NONE {
+			converted.ProxyMode = typesv1alpha1.ProxyMode_SIDECAR
+		}
+	}
+
+	detail, exists := b.Instances[instance.InstanceId]
+	if !exists {
+		return converted
+	}
+	converted.Containers = convertContainers(detail.Containers)
+	converted.InitContainers = convertContainers(detail.InitContainers)
+	converted.PodStatus = detail.PodStatus
+	converted.NodeName = detail.NodeName
+	converted.CreatedAt = detail.CreatedAt
+	converted.Labels = detail.Labels
+	converted.Annotations = detail.Annotations
+	converted.ServiceAccount = detail.ServiceAccount
+	converted.Tolerations = detail.Tolerations
+	converted.Locality = detail.Locality
+	converted.ZoneHints = detail.ZoneHints
+	converted.LifecyclePhase = detail.LifecyclePhase
+	converted.DeletionTimestamp = detail.DeletionTimestamp
+	converted.CanonicalService = detail.CanonicalService
+	converted.CanonicalRevision = detail.CanonicalRevision
+	return converted
+}
+
+// convertContainers converts captured containers to the containers an edge syncs
+func convertContainers(containers []*frontendv1alpha1.Container) []*v1alpha1.Container {
+	var converted []*v1alpha1.Container
+	for _, container := range containers {
+		converted = append(converted, &v1alpha1.Container{
+			Name:         container.Name,
+			Image:        container.Image,
+			Status:       container.Status,
+			Ready:        container.Ready,
+			RestartCount: container.RestartCount,
+			ImageDigest:  container.ImageDigest,
+		})
+	}
+	return converted
+}
+
+// addIstioResources adds the Istio resources applying to an instance to the state of its cluster. The
+// same resource usually applies to several instances, so resources already in the state are skipped.
+func addIstioResources(state *v1alpha1.ClusterState, resources *frontendv1alpha1.GetIstioResourcesResponse) {
+	if resources == nil {
+		return
+	}
+	state.VirtualServices = references.AppendUnique(state.VirtualServices, resources.VirtualServices)
+	state.DestinationRules = references.AppendUnique(state.DestinationRules, resources.DestinationRules)
+	state.Gateways = references.AppendUnique(state.Gateways, resources.Gateways)
+	state.Sidecars = references.AppendUnique(state.Sidecars, resources.Sidecars)
+	state.EnvoyFilters = references.AppendUnique(state.EnvoyFilters, resources.EnvoyFilters)
+	state.RequestAuthentications = references.AppendUnique(state.RequestAuthentications, resources.RequestAuthentications)
+	state.PeerAuthentications = references.AppendUnique(state.PeerAuthentications, resources.PeerAuthentications)
+	state.AuthorizationPolicies = references.AppendUnique(state.AuthorizationPolicies, resources.AuthorizationPolicies)
+	state.WasmPlugins = references.AppendUnique(state.WasmPlugins, resources.WasmPlugins)
+	state.ServiceEntries = references.AppendUnique(state.ServiceEntries, resources.ServiceEntries)
+}
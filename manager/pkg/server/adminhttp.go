@@ -0,0 +1,50 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/telemetry"
+)
+
+// setupAdminServer sets up the admin HTTP server serving the log level and metrics endpoints, if an admin
+// port is configured. It only listens on localhost, since the endpoints are not authenticated.
+func (s *ManagerServer) setupAdminServer() error {
+	port := s.config.GetAdminPort()
+	if port == 0 {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on admin port %d: %w", port, err)
+	}
+	s.adminListener = listener
+
+	mux := http.NewServeMux()
+	mux.Handle(logging.LevelPath, logging.LevelHandler())
+	mux.Handle(telemetry.MetricsPath, telemetry.Handler())
+
+	s.adminServer = &http.Server{
+		Handler:           logging.HTTPMiddleware()(mux),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	return nil
+}
@@ -16,8 +16,11 @@ package server
 
 import (
 	"fmt"
+	"math"
 
+	"github.com/liamawhite/navigator/manager/pkg/connections"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/protocol"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -26,6 +29,9 @@ import (
 func (s *ManagerServer) Connect(stream v1alpha1.ManagerService_ConnectServer) error {
 	s.logger.Info("new connection attempt")
 
+	// Every send to the edge, from this handler or the connection manager, goes through the same send mutex
+	stream = connections.NewEdgeStream(stream)
+
 	// Wait for cluster identification
 	req, err := stream.Recv()
 	if err != nil {
@@ -55,8 +61,11 @@ func (s *ManagerServer) Connect(stream v1alpha1.ManagerService_ConnectServer) er
 		return status.Errorf(codes.InvalidArgument, "invalid cluster identification: %v", err)
 	}
 
-	// Try to register connection
-	if err := s.connectionManager.RegisterConnection(clusterID, stream); err != nil {
+	// Try to register connection, for the cluster or the namespace shard of it the edge syncs
+	identification := req.GetClusterIdentification()
+	leader := identification.GetLeaderElection()
+	connectionID, err := s.connectionManager.RegisterEdgeConnection(identification, stream)
+	if err != nil {
 		s.logger.Error("failed to register connection", "cluster_id", clusterID, "error", err)
 
 		// Send rejection response
@@ -75,49 +84,105 @@ func (s *ManagerServer) Connect(stream v1alpha1.ManagerService_ConnectServer) er
 		return status.Errorf(codes.AlreadyExists, "connection rejected: %v", err)
 	}
 
+	// Stagger the periodic syncs of connected edges
+	staggerSlot, syncOffset := s.syncStagger.Acquire()
+	defer s.syncStagger.Release(staggerSlot)
+
 	// Send connection acceptance
+	maxMessageSize := s.config.GetMaxMessageSize()
+	if maxMessageSize > math.MaxInt32 {
+		maxMessageSize = math.MaxInt32
+	}
 	acceptanceResp := &v1alpha1.ConnectResponse{
 		Message: &v1alpha1.ConnectResponse_ConnectionAck{
 			ConnectionAck: &v1alpha1.ConnectionAck{
-				Accepted: true,
+				Accepted:             true,
+				ChunkedClusterState:  true,
+				StreamedClusterState: true,
+				CompressedRawConfig:  true,
+				MaxMessageSize:       int32(maxMessageSize), // #nosec G115 - bounds checked above
+				SyncOffset:           syncOffset,
+				Protocol:             protocol.Capabilities(),
 			},
 		},
 	}
 
 	if err := stream.Send(acceptanceResp); err != nil {
 		s.logger.Error("failed to send acceptance response", "error", err)
-		s.connectionManager.UnregisterConnection(clusterID)
+		s.connectionManager.UnregisterConnection(connectionID, stream)
 		return status.Errorf(codes.Internal, "failed to send acceptance response: %v", err)
 	}
 
 	// Update capabilities for the connection
 	if capabilities != nil {
-		if err := s.connectionManager.UpdateCapabilities(clusterID, capabilities); err != nil {
+		if err := s.connectionManager.UpdateCapabilities(connectionID, capabilities); err != nil {
 			s.logger.Error("failed to update capabilities", "cluster_id", clusterID, "error", err)
 		} else {
 			s.logger.Info("connection capabilities updated",
 				"cluster_id", clusterID,
 				"metrics_enabled", capabilities.MetricsEnabled)
 		}
+		s.logPreflight(clusterID, capabilities.GetPreflight())
 	}
+	s.logCapabilityGaps(clusterID, identification.GetProtocol())
 
-	s.logger.Info("connection accepted", "cluster_id", clusterID)
+	if edgeVersion := identification.GetEdgeVersion(); edgeVersion != "" {
+		if err := s.connectionManager.UpdateEdgeVersion(connectionID, edgeVersion); err != nil {
+			s.logger.Error("failed to update edge version", "cluster_id", clusterID, "error", err)
+		}
+	}
+
+	s.logger.Info("connection accepted",
+		"cluster_id", clusterID,
+		"connection_id", connectionID,
+		"edge_version", identification.GetEdgeVersion(),
+		"leader", leader.GetIdentity(),
+		"term", leader.GetTerm(),
+		"sync_offset", syncOffset)
 
 	// Handle incoming messages
 	defer func() {
-		s.connectionManager.UnregisterConnection(clusterID)
-		s.logger.Info("connection closed", "cluster_id", clusterID)
+		// A connection replaced by a new leader leaves its successor's partial state alone
+		if s.connectionManager.UnregisterConnection(connectionID, stream) {
+			s.stateAssembler.Forget(connectionID)
+		}
+		s.logger.Info("connection closed", "cluster_id", clusterID, "connection_id", connectionID)
+	}()
+
+	// Receive messages in the background so the connection can also be terminated on request
+	recvCh := make(chan *v1alpha1.ConnectRequest)
+	recvErrCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErrCh <- err
+				return
+			}
+			select {
+			case recvCh <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
 	}()
 
+	disconnected := s.connectionManager.Disconnected(connectionID)
+
 	for {
-		req, err := stream.Recv()
-		if err != nil {
+		var req *v1alpha1.ConnectRequest
+		select {
+		case <-disconnected:
+			s.logger.Info("connection terminated by manager", "cluster_id", clusterID)
+			return status.Errorf(codes.Unavailable, "connection closed by manager")
+		case err := <-recvErrCh:
 			s.logger.Info("connection terminated", "cluster_id", clusterID, "error", err)
 			return nil
+		case req = <-recvCh:
 		}
 
-		if err := s.processIncomingMessage(clusterID, req); err != nil {
-			s.logger.Error("failed to process message", "cluster_id", clusterID, "error", err)
+		if err := s.processIncomingMessage(connectionID, req); err != nil {
+			s.logger.Error("failed to process message", "cluster_id", clusterID, "connection_id", connectionID, "error", err)
 
 			// Send error response
 			errorResp := &v1alpha1.ConnectResponse{
@@ -139,20 +204,87 @@ func (s *ManagerServer) Connect(stream v1alpha1.ManagerService_ConnectServer) er
 }
 
 // processIncomingMessage processes different types of messages from edges
-func (s *ManagerServer) processIncomingMessage(clusterID string, req *v1alpha1.ConnectRequest) error {
+func (s *ManagerServer) processIncomingMessage(connectionID string, req *v1alpha1.ConnectRequest) error {
 	switch msg := req.Message.(type) {
 	case *v1alpha1.ConnectRequest_ClusterState:
-		return s.processClusterStateUpdate(clusterID, req)
+		return s.processClusterStateUpdate(connectionID, req)
+	case *v1alpha1.ConnectRequest_ClusterStateChunk:
+		return s.processClusterStateChunk(connectionID, msg.ClusterStateChunk)
+	case *v1alpha1.ConnectRequest_CapabilitiesUpdate:
+		return s.processCapabilitiesUpdate(connectionID, msg.CapabilitiesUpdate)
 	case *v1alpha1.ConnectRequest_ProxyConfigResponse:
 		return s.processProxyConfigResponse(msg.ProxyConfigResponse)
 	case *v1alpha1.ConnectRequest_ServiceConnectionsResponse:
 		return s.processServiceConnectionsResponse(msg.ServiceConnectionsResponse)
+	case *v1alpha1.ConnectRequest_PairInstanceMetricsResponse:
+		return s.processPairInstanceMetricsResponse(msg.PairInstanceMetricsResponse)
+	case *v1alpha1.ConnectRequest_MeshMetricsTimeSeriesResponse:
+		return s.processMeshMetricsTimeSeriesResponse(msg.MeshMetricsTimeSeriesResponse)
+	case *v1alpha1.ConnectRequest_TracesResponse:
+		return s.processTracesResponse(msg.TracesResponse)
+	case *v1alpha1.ConnectRequest_AccessLogsResponse:
+		return s.processAccessLogsResponse(msg.AccessLogsResponse)
+	case *v1alpha1.ConnectRequest_PodLogsResponse:
+		return s.processPodLogsResponse(msg.PodLogsResponse)
+	case *v1alpha1.ConnectRequest_EnvoyAdminResponse:
+		return s.processEnvoyAdminResponse(msg.EnvoyAdminResponse)
 	default:
-		s.logger.Warn("received unknown message type", "cluster_id", clusterID, "type", fmt.Sprintf("%T", msg))
+		s.logger.Warn("received unknown message type", "connection_id", connectionID, "type", fmt.Sprintf("%T", msg))
 		return fmt.Errorf("unknown message type: %T", msg)
 	}
 }
 
+// processCapabilitiesUpdate replaces the capabilities an edge identified with, such as when resource types
+// its preflight found unavailable have become available
+func (s *ManagerServer) processCapabilitiesUpdate(connectionID string, capabilities *v1alpha1.EdgeCapabilities) error {
+	if capabilities == nil {
+		return fmt.Errorf("nil capabilities update")
+	}
+
+	if err := s.connectionManager.UpdateCapabilities(connectionID, capabilities); err != nil {
+		return fmt.Errorf("failed to update capabilities: %w", err)
+	}
+
+	unavailable := 0
+	for _, resource := range capabilities.GetPreflight().GetResources() {
+		if resource.Status != v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE {
+			unavailable++
+		}
+	}
+	s.logger.Info("connection capabilities updated",
+		"connection_id", connectionID,
+		"unavailable_resources", unavailable)
+
+	return nil
+}
+
+// processClusterStateChunk reassembles chunked cluster state and applies it once complete
+func (s *ManagerServer) processClusterStateChunk(connectionID string, chunk *v1alpha1.ClusterStateChunk) error {
+	if chunk == nil {
+		return fmt.Errorf("nil cluster state chunk")
+	}
+
+	clusterState, err := s.stateAssembler.Add(connectionID, chunk)
+	if err != nil {
+		return fmt.Errorf("failed to reassemble cluster state: %w", err)
+	}
+	if clusterState == nil {
+		return nil
+	}
+
+	if err := s.connectionManager.UpdateClusterState(connectionID, clusterState); err != nil {
+		return fmt.Errorf("failed to update cluster state: %w", err)
+	}
+
+	s.logger.Debug("chunked cluster state updated",
+		"connection_id", connectionID,
+		"sync_id", chunk.SyncId,
+		"chunks", chunk.Total,
+		"services", len(clusterState.Services))
+
+	return nil
+}
+
 // processProxyConfigResponse processes proxy configuration responses from edges
 func (s *ManagerServer) processProxyConfigResponse(response *v1alpha1.ProxyConfigResponse) error {
 	s.logger.Debug("processing proxy config response", "request_id", response.RequestId)
@@ -166,6 +298,81 @@ func (s *ManagerServer) processServiceConnectionsResponse(response *v1alpha1.Ser
 	return nil
 }
 
+// processPairInstanceMetricsResponse processes pair instance metrics responses from edges
+func (s *ManagerServer) processPairInstanceMetricsResponse(response *v1alpha1.PairInstanceMetricsResponse) error {
+	s.logger.Debug("processing pair instance metrics response", "request_id", response.RequestId)
+	s.meshMetricsService.HandlePairInstanceMetricsResponse(response)
+	return nil
+}
+
+// processMeshMetricsTimeSeriesResponse processes mesh metrics time series responses from edges
+func (s *ManagerServer) processMeshMetricsTimeSeriesResponse(response *v1alpha1.MeshMetricsTimeSeriesResponse) error {
+	s.logger.Debug("processing mesh metrics time series response", "request_id", response.RequestId)
+	s.meshMetricsService.HandleMeshMetricsTimeSeriesResponse(response)
+	return nil
+}
+
+// processTracesResponse processes trace search responses from edges
+func (s *ManagerServer) processTracesResponse(response *v1alpha1.TracesResponse) error {
+	s.logger.Debug("processing traces response", "request_id", response.RequestId)
+	s.tracesProvider.HandleTracesResponse(response)
+	return nil
+}
+
+// processAccessLogsResponse processes access log search responses from edges
+func (s *ManagerServer) processAccessLogsResponse(response *v1alpha1.AccessLogsResponse) error {
+	s.logger.Debug("processing access logs response", "request_id", response.RequestId)
+	s.accessLogsProvider.HandleAccessLogsResponse(response)
+	return nil
+}
+
+// processPodLogsResponse processes container log responses from edges
+func (s *ManagerServer) processPodLogsResponse(response *v1alpha1.PodLogsResponse) error {
+	s.logger.Debug("processing pod logs response", "request_id", response.RequestId)
+	s.logsService.HandlePodLogsResponse(response)
+	return nil
+}
+
+// processEnvoyAdminResponse processes Envoy admin responses from edges
+func (s *ManagerServer) processEnvoyAdminResponse(response *v1alpha1.EnvoyAdminResponse) error {
+	s.logger.Debug("processing envoy admin response", "request_id", response.RequestId)
+	s.envoyAdminService.HandleEnvoyAdminResponse(response)
+	return nil
+}
+
+// logPreflight warns about anything an edge's preflight found would stop it collecting cluster state
+func (s *ManagerServer) logPreflight(clusterID string, preflight *v1alpha1.PreflightReport) {
+	if preflight == nil {
+		return
+	}
+	if !preflight.KubernetesVersionSupported {
+		s.logger.Warn("edge reported an unsupported Kubernetes version",
+			"cluster_id", clusterID, "kubernetes_version", preflight.KubernetesVersion)
+	}
+	for _, resource := range preflight.Resources {
+		if resource.Status == v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE {
+			continue
+		}
+		s.logger.Warn("edge cannot collect resource",
+			"cluster_id", clusterID,
+			"group", resource.Group,
+			"resource", resource.Resource,
+			"status", resource.Status,
+			"message", resource.Message)
+	}
+}
+
+// logCapabilityGaps warns about the features the manager supports that a connecting edge does not
+func (s *ManagerServer) logCapabilityGaps(clusterID string, edge *v1alpha1.ProtocolCapabilities) {
+	for _, gap := range protocol.Gaps(protocol.Capabilities(), edge) {
+		s.logger.Warn("edge lacks a capability of the manager",
+			"cluster_id", clusterID,
+			"capability", gap.Capability,
+			"value", gap.Value,
+			"message", gap.Message)
+	}
+}
+
 // processClusterIdentification processes cluster identification request and returns clusterID and capabilities
 func (s *ManagerServer) processClusterIdentification(req *v1alpha1.ConnectRequest) (string, *v1alpha1.EdgeCapabilities, error) {
 	if req.Message == nil {
@@ -186,6 +393,10 @@ func (s *ManagerServer) processClusterIdentification(req *v1alpha1.ConnectReques
 		return "", nil, fmt.Errorf("empty cluster ID")
 	}
 
+	if err := validateShard(clusterIdentification.ClusterIdentification.Shard); err != nil {
+		return "", nil, err
+	}
+
 	capabilities := clusterIdentification.ClusterIdentification.Capabilities
 	// Capabilities are optional, but if present should be valid
 
@@ -193,7 +404,7 @@ func (s *ManagerServer) processClusterIdentification(req *v1alpha1.ConnectReques
 }
 
 // processClusterStateUpdate processes cluster state update request
-func (s *ManagerServer) processClusterStateUpdate(clusterID string, req *v1alpha1.ConnectRequest) error {
+func (s *ManagerServer) processClusterStateUpdate(connectionID string, req *v1alpha1.ConnectRequest) error {
 	if req.Message == nil {
 		return fmt.Errorf("empty message")
 	}
@@ -208,11 +419,28 @@ func (s *ManagerServer) processClusterStateUpdate(clusterID string, req *v1alpha
 	}
 
 	// Update cluster state
-	if err := s.connectionManager.UpdateClusterState(clusterID, clusterStateMsg.ClusterState); err != nil {
+	if err := s.connectionManager.UpdateClusterState(connectionID, clusterStateMsg.ClusterState); err != nil {
 		return fmt.Errorf("failed to update cluster state: %w", err)
 	}
 
-	s.logger.Debug("cluster state updated", "cluster_id", clusterID, "services", len(clusterStateMsg.ClusterState.Services))
+	s.logger.Debug("cluster state updated", "connection_id", connectionID, "services", len(clusterStateMsg.ClusterState.Services))
 
 	return nil
 }
+
+// validateShard checks that a namespace shard is either a hash shard or lists its namespaces
+func validateShard(shard *v1alpha1.NamespaceShard) error {
+	switch {
+	case shard == nil:
+		return nil
+	case shard.Count > 0 && len(shard.Namespaces) > 0:
+		return fmt.Errorf("shard cannot set both a hash shard count and namespaces")
+	case shard.Count > 0 && shard.Index >= shard.Count:
+		return fmt.Errorf("shard index %d is out of range for %d shards", shard.Index, shard.Count)
+	case shard.Count == 0 && len(shard.Namespaces) == 0:
+		return fmt.Errorf("shard must set a hash shard count or namespaces")
+	case shard.Count == 0 && shard.Index > 0:
+		return fmt.Errorf("shard index requires a hash shard count")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxClusterStateChunks bounds how many chunks a single cluster state may be split into
+const maxClusterStateChunks = 10000
+
+// pendingClusterState is a cluster state that is still being reassembled from chunks
+type pendingClusterState struct {
+	syncID string
+	total  int32 // 0 for a streamed cluster state, completed by its final chunk
+	next   int32
+	size   int // Bytes of the partial states merged so far
+	state  *v1alpha1.ClusterState
+}
+
+// clusterStateAssembler reassembles cluster state sent as a sequence of chunks
+type clusterStateAssembler struct {
+	mu      sync.Mutex
+	maxSize int                             // Bytes a cluster state may reach before it is rejected
+	pending map[string]*pendingClusterState // cluster_id -> partially received state
+}
+
+// newClusterStateAssembler creates a new cluster state assembler rejecting cluster states larger than maxSize bytes
+func newClusterStateAssembler(maxSize int) *clusterStateAssembler {
+	return &clusterStateAssembler{
+		maxSize: maxSize,
+		pending: make(map[string]*pendingClusterState),
+	}
+}
+
+// Add merges a chunk into the pending cluster state for a cluster. It returns the
+// complete cluster state once the final chunk has been added, or nil otherwise.
+// Streamed cluster states have a total of 0 and end with a chunk marked final. A
+// cluster state growing larger than the assembler's maximum size is discarded.
+func (a *clusterStateAssembler) Add(clusterID string, chunk *v1alpha1.ClusterStateChunk) (*v1alpha1.ClusterState, error) {
+	if chunk.SyncId == "" {
+		return nil, fmt.Errorf("chunk has empty sync ID")
+	}
+	streamed := chunk.Total == 0
+	if chunk.Total < 0 || chunk.Total > maxClusterStateChunks {
+		return nil, fmt.Errorf("chunk total %d out of range", chunk.Total)
+	}
+	if streamed && (chunk.Index < 0 || chunk.Index >= maxClusterStateChunks) {
+		return nil, fmt.Errorf("streamed chunk index %d out of range", chunk.Index)
+	}
+	if !streamed && (chunk.Index < 0 || chunk.Index >= chunk.Total) {
+		return nil, fmt.Errorf("chunk index %d out of range for total %d", chunk.Index, chunk.Total)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	pending, exists := a.pending[clusterID]
+	if chunk.Index == 0 {
+		// A new cluster state supersedes any incomplete one
+		pending = &pendingClusterState{
+			syncID: chunk.SyncId,
+			total:  chunk.Total,
+			state:  &v1alpha1.ClusterState{},
+		}
+		a.pending[clusterID] = pending
+	} else if !exists || pending.syncID != chunk.SyncId || pending.total != chunk.Total || pending.next != chunk.Index {
+		delete(a.pending, clusterID)
+		return nil, fmt.Errorf("unexpected chunk %d/%d for sync %s", chunk.Index+1, chunk.Total, chunk.SyncId)
+	}
+
+	if chunk.PartialState != nil {
+		// Chunks each fit in a message, so without a cap on their sum an edge could make the manager buffer
+		// an unbounded state
+		pending.size += proto.Size(chunk.PartialState)
+		if pending.size > a.maxSize {
+			delete(a.pending, clusterID)
+			return nil, fmt.Errorf("cluster state for sync %s exceeds %d bytes", chunk.SyncId, a.maxSize)
+		}
+		proto.Merge(pending.state, chunk.PartialState)
+	}
+	pending.next++
+
+	if (streamed && !chunk.Final) || (!streamed && pending.next < pending.total) {
+		return nil, nil
+	}
+
+	delete(a.pending, clusterID)
+	return pending.state, nil
+}
+
+// Forget discards any partially received cluster state for a cluster
+func (a *clusterStateAssembler) Forget(clusterID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.pending, clusterID)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func chunk(syncID string, index, total int32, services ...string) *v1alpha1.ClusterStateChunk {
+	partial := &v1alpha1.ClusterState{}
+	for _, name := range services {
+		partial.Services = append(partial.Services, &v1alpha1.Service{Name: name, Namespace: "default"})
+	}
+	return &v1alpha1.ClusterStateChunk{SyncId: syncID, Index: index, Total: total, PartialState: partial}
+}
+
+func TestClusterStateAssembler_Complete(t *testing.T) {
+	assembler := newClusterStateAssembler(1024 * 1024)
+
+	state, err := assembler.Add("cluster1", chunk("sync-1", 0, 3, "a", "b"))
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	state, err = assembler.Add("cluster1", chunk("sync-1", 1, 3, "c"))
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	state, err = assembler.Add("cluster1", chunk("sync-1", 2, 3, "d"))
+	assert.NoError(t, err)
+	assert.NotNil(t, state)
+	assert.Len(t, state.Services, 4)
+	assert.Equal(t, "a", state.Services[0].Name)
+	assert.Equal(t, "d", state.Services[3].Name)
+	assert.Empty(t, assembler.pending)
+}
+
+func TestClusterStateAssembler_Streamed(t *testing.T) {
+	assembler := newClusterStateAssembler(1024 * 1024)
+
+	state, err := assembler.Add("cluster1", chunk("sync-1", 0, 0, "a", "b"))
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	state, err = assembler.Add("cluster1", chunk("sync-1", 1, 0, "c"))
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	final := chunk("sync-1", 2, 0)
+	final.Final = true
+	state, err = assembler.Add("cluster1", final)
+	assert.NoError(t, err)
+	assert.NotNil(t, state)
+	assert.Len(t, state.Services, 3)
+	assert.Empty(t, assembler.pending)
+}
+
+func TestClusterStateAssembler_NewSyncSupersedes(t *testing.T) {
+	assembler := newClusterStateAssembler(1024 * 1024)
+
+	_, err := assembler.Add("cluster1", chunk("sync-1", 0, 2, "stale"))
+	assert.NoError(t, err)
+
+	_, err = assembler.Add("cluster1", chunk("sync-2", 0, 2, "a"))
+	assert.NoError(t, err)
+
+	state, err := assembler.Add("cluster1", chunk("sync-2", 1, 2, "b"))
+	assert.NoError(t, err)
+	assert.Len(t, state.Services, 2)
+	assert.Equal(t, "a", state.Services[0].Name)
+}
+
+func TestClusterStateAssembler_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		first *v1alpha1.ClusterStateChunk
+		chunk *v1alpha1.ClusterStateChunk
+	}{
+		{name: "empty sync ID", chunk: chunk("", 0, 1)},
+		{name: "negative total", chunk: chunk("sync-1", 0, -1)},
+		{name: "streamed index out of range", chunk: chunk("sync-1", maxClusterStateChunks, 0)},
+		{name: "index out of range", chunk: chunk("sync-1", 2, 2)},
+		{name: "missing first chunk", chunk: chunk("sync-1", 1, 2)},
+		{name: "out of order chunk", first: chunk("sync-1", 0, 3), chunk: chunk("sync-1", 2, 3)},
+		{name: "mismatched sync ID", first: chunk("sync-1", 0, 2), chunk: chunk("sync-2", 1, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assembler := newClusterStateAssembler(1024 * 1024)
+			if tt.first != nil {
+				_, err := assembler.Add("cluster1", tt.first)
+				assert.NoError(t, err)
+			}
+
+			state, err := assembler.Add("cluster1", tt.chunk)
+			assert.Error(t, err)
+			assert.Nil(t, state)
+			assert.Empty(t, assembler.pending, "Expected pending state to be discarded")
+		})
+	}
+}
+
+func TestClusterStateAssembler_Forget(t *testing.T) {
+	assembler := newClusterStateAssembler(1024 * 1024)
+
+	_, err := assembler.Add("cluster1", chunk("sync-1", 0, 2, "a"))
+	assert.NoError(t, err)
+
+	assembler.Forget("cluster1")
+	assert.Empty(t, assembler.pending)
+}
+
+func TestClusterStateAssembler_MaxSize(t *testing.T) {
+	first := chunk("sync-1", 0, 0, "a", "b")
+	assembler := newClusterStateAssembler(proto.Size(first.PartialState) + 1)
+
+	state, err := assembler.Add("cluster1", first)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	// Each chunk fits, but the state they add up to does not
+	state, err = assembler.Add("cluster1", chunk("sync-1", 1, 0, "c"))
+	assert.Error(t, err)
+	assert.Nil(t, state)
+	assert.Empty(t, assembler.pending, "Expected pending state to be discarded")
+
+	// A new cluster state starts counting from zero
+	final := chunk("sync-2", 0, 0, "a", "b")
+	final.Final = true
+	state, err = assembler.Add("cluster1", final)
+	assert.NoError(t, err)
+	assert.Len(t, state.Services, 2)
+}
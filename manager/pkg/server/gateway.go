@@ -19,33 +19,31 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/socket"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 // setupHTTPGateway sets up the HTTP gateway for the frontend API
 func (s *ManagerServer) setupHTTPGateway() error {
-	// Create HTTP listener (actual gRPC port + 1, or 0 if configured port was 0)
-	var httpPort int
-	if s.config.GetPort() == 0 {
-		// If configured with port 0, use port 0 for HTTP listener too (system will assign)
-		httpPort = 0
-	} else {
-		// Otherwise use configured port + 1
-		httpPort = s.config.GetPort() + 1
-	}
-	httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", httpPort))
+	httpListener, err := s.listenHTTP()
 	if err != nil {
-		return fmt.Errorf("failed to listen on HTTP port %d: %w", httpPort, err)
+		return err
 	}
 	s.httpListener = httpListener
 
-	// Create gRPC gateway mux
-	mux := runtime.NewServeMux()
+	// Create gRPC gateway mux, forwarding correlation IDs and the identity of multi-tenant requests to the gRPC server
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher(s.config.GetTenants())),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
 	// Setup gRPC connection options
 	grpcEndpoint := fmt.Sprintf("localhost:%d", s.config.GetPort())
@@ -57,6 +55,9 @@ func (s *ManagerServer) setupHTTPGateway() error {
 			grpc.MaxCallSendMsgSize(maxMessageSize),
 		),
 	}
+	if s.config.GetTenants() != nil {
+		opts = append(opts, grpc.WithUnaryInterceptor(tenancy.UnaryClientInterceptor(s.gatewayToken)))
+	}
 
 	// Register service registry service handler
 	if err := frontendv1alpha1.RegisterServiceRegistryServiceHandlerFromEndpoint(
@@ -88,11 +89,96 @@ func (s *ManagerServer) setupHTTPGateway() error {
 		return fmt.Errorf("failed to register cluster registry service handler: %w", err)
 	}
 
+	// Register analysis service handler
+	if err := frontendv1alpha1.RegisterAnalysisServiceHandlerFromEndpoint(
+		context.Background(),
+		mux,
+		grpcEndpoint,
+		opts,
+	); err != nil {
+		return fmt.Errorf("failed to register analysis service handler: %w", err)
+	}
+
+	// Register traces service handler
+	if err := frontendv1alpha1.RegisterTracesServiceHandlerFromEndpoint(
+		context.Background(),
+		mux,
+		grpcEndpoint,
+		opts,
+	); err != nil {
+		return fmt.Errorf("failed to register traces service handler: %w", err)
+	}
+
+	// Register access logs service handler
+	if err := frontendv1alpha1.RegisterAccessLogsServiceHandlerFromEndpoint(
+		context.Background(),
+		mux,
+		grpcEndpoint,
+		opts,
+	); err != nil {
+		return fmt.Errorf("failed to register access logs service handler: %w", err)
+	}
+
 	// Create HTTP server
 	s.httpServer = &http.Server{
-		Handler:           mux,
+		Handler:           logging.HTTPMiddleware()(mux),
 		ReadHeaderTimeout: 30 * time.Second,
 	}
 
 	return nil
 }
+
+// listenHTTP listens on the HTTP gateway's unix socket if configured. Otherwise it listens on the actual
+// gRPC port + 1, or on port 0 if the configured port was 0.
+func (s *ManagerServer) listenHTTP() (net.Listener, error) {
+	if path := s.config.GetHTTPSocket(); path != "" {
+		return socket.Listen(path)
+	}
+
+	var httpPort int
+	if s.config.GetPort() == 0 {
+		// If configured with port 0, use port 0 for HTTP listener too (system will assign)
+		httpPort = 0
+	} else {
+		// Otherwise use configured port + 1
+		httpPort = s.config.GetPort() + 1
+	}
+	httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", httpPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on HTTP port %d: %w", httpPort, err)
+	}
+	return httpListener, nil
+}
+
+// incomingHeaderMatcher forwards the request ID and trace context headers, and the identity header of a
+// multi-tenant manager, in addition to the default headers
+func incomingHeaderMatcher(tenants *tenancy.Config) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if strings.EqualFold(key, logging.RequestIDHeader) {
+			return logging.RequestIDMetadataKey, true
+		}
+		if strings.EqualFold(key, logging.TraceParentHeader) {
+			return logging.TraceParentHeader, true
+		}
+		if tenants != nil && strings.EqualFold(key, tenants.GetIdentityHeader()) {
+			return strings.ToLower(key), true
+		}
+		// Clients must not set the identity or gateway token as Grpc-Metadata- headers, which the
+		// authenticating proxy does not strip
+		if metadataKey, ok := runtime.DefaultHeaderMatcher(key); ok {
+			if strings.EqualFold(metadataKey, tenancy.GatewayTokenKey) || (tenants != nil && strings.EqualFold(metadataKey, tenants.GetIdentityHeader())) {
+				return "", false
+			}
+			return metadataKey, true
+		}
+		return "", false
+	}
+}
+
+// outgoingHeaderMatcher passes Content-Disposition through unprefixed so file downloads are named correctly
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "content-disposition") {
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
 	"github.com/liamawhite/navigator/pkg/grpc/interceptors"
+	"github.com/liamawhite/navigator/pkg/logging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -34,22 +36,32 @@ func (s *ManagerServer) setupGRPCServer() error {
 	}
 	s.listener = grpcListener
 
-	// Create gRPC server with message size limits and validation interceptors
+	// Create gRPC server with message size limits, request logging and validation interceptors, serving the
+	// admin API on localhost only and scoping frontend requests to tenants when the manager is multi-tenant
 	maxMessageSize := s.config.GetMaxMessageSize()
+	unaryInterceptors := []grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor(), tenancy.AdminUnaryServerInterceptor()}
+	if tenants := s.config.GetTenants(); tenants != nil {
+		unaryInterceptors = append(unaryInterceptors, tenancy.UnaryServerInterceptor(tenants, s.gatewayToken, s.logger))
+	}
+	unaryInterceptors = append(unaryInterceptors, interceptors.ValidationInterceptor(s.logger))
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(maxMessageSize),
 		grpc.MaxSendMsgSize(maxMessageSize),
-		grpc.UnaryInterceptor(interceptors.ValidationInterceptor(s.logger)),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.StreamInterceptor(interceptors.StreamValidationInterceptor(s.logger)),
 	)
 
 	// Register backend services
 	v1alpha1.RegisterManagerServiceServer(s.grpcServer, s)
+	v1alpha1.RegisterAdminServiceServer(s.grpcServer, s.adminService)
 
 	// Register frontend services
 	frontendv1alpha1.RegisterServiceRegistryServiceServer(s.grpcServer, s.serviceRegistryService)
 	frontendv1alpha1.RegisterMetricsServiceServer(s.grpcServer, s.metricsService)
 	frontendv1alpha1.RegisterClusterRegistryServiceServer(s.grpcServer, s.clusterRegistryService)
+	frontendv1alpha1.RegisterAnalysisServiceServer(s.grpcServer, s.analysisService)
+	frontendv1alpha1.RegisterTracesServiceServer(s.grpcServer, s.tracesService)
+	frontendv1alpha1.RegisterAccessLogsServiceServer(s.grpcServer, s.accessLogsService)
 
 	// Enable reflection for debugging
 	reflection.Register(s.grpcServer)
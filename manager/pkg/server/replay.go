@@ -0,0 +1,66 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/liamawhite/navigator/manager/pkg/replay"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// loadReplay loads the support bundle to replay as connected clusters, nil if none is configured
+func (s *ManagerServer) loadReplay() (*replay.Bundle, error) {
+	path := s.config.GetReplaySnapshot()
+	if path == "" {
+		return nil, nil
+	}
+	return replay.Load(path)
+}
+
+// startReplay connects a simulated edge for each cluster of the bundle to the gRPC server, over the same
+// protocol as real edges, until the server is stopped
+func (s *ManagerServer) startReplay(bundle *replay.Bundle) error {
+	maxMessageSize := s.config.GetMaxMessageSize()
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.listener.Addr().(*net.TCPAddr).Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect replayed clusters: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		replay.Run(ctx, conn, bundle, logging.For("replay"))
+	}()
+	s.stopReplay = func() {
+		cancel()
+		<-done
+		_ = conn.Close()
+	}
+
+	s.logger.Info("replaying snapshot", "service", bundle.Service.Service.Id, "captured_at", bundle.CreatedAt)
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/replay"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSnapshot writes a support bundle of a service with one instance, like navctl snapshot
+func writeSnapshot(t *testing.T) string {
+	files := map[string]string{
+		"manifest.json": `{"createdAt": "2025-06-01T12:00:00Z"}`,
+		"service.json": `{"service": {"id": "bookinfo:reviews", "name": "reviews", "namespace": "bookinfo", "instances": [
+			{"instanceId": "east:bookinfo:reviews-v1", "ip": "10.0.0.1", "podName": "reviews-v1", "namespace": "bookinfo", "clusterName": "east", "envoyPresent": true}
+		]}}`,
+		"proxy-configs/east_bookinfo_reviews-v1.json": `{"instanceId": "east:bookinfo:reviews-v1", "clusterName": "east", "proxyConfig": {"version": "1.22.0"}}`,
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "navigator-snapshot/" + name, Mode: 0600, Size: int64(len(content))}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return path
+}
+
+func TestManagerServer_Replay(t *testing.T) {
+	config := &mockConfig{port: 0, maxMessageSize: 10485760, replaySnapshot: writeSnapshot(t)}
+	connectionManager := connections.NewManager(logging.For("test"))
+	server, err := NewManagerServer(config, connectionManager, logging.For("test"))
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() { assert.NoError(t, server.Stop()) }()
+
+	// The cluster of the snapshot connects and syncs the captured service
+	require.Eventually(t, func() bool {
+		_, exists := connectionManager.GetAggregatedService("bookinfo:reviews")
+		return exists
+	}, 5*time.Second, 10*time.Millisecond)
+	info := connectionManager.GetConnectionInfo()["east"]
+	assert.Equal(t, replay.EdgeVersion, info.EdgeVersion)
+
+	// Proxy configs are answered from the snapshot
+	snapshot, err := server.GetProxyService().GetProxyConfig(context.Background(), "east", "bookinfo", "reviews-v1", providers.ProxyConfigOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "1.22.0", snapshot.ProxyConfig.Version)
+}
+
+func TestManagerServer_ReplayInvalidSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("not a bundle"), 0600))
+	config := &mockConfig{port: 0, maxMessageSize: 10485760, replaySnapshot: path}
+
+	server, err := NewManagerServer(config, newMockConnectionManager(), logging.For("test"))
+	require.NoError(t, err)
+	assert.ErrorContains(t, server.Start(), "failed to load snapshot to replay")
+}
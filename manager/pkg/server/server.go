@@ -22,9 +22,11 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/liamawhite/navigator/manager/pkg/admin"
 	"github.com/liamawhite/navigator/manager/pkg/backend"
 	"github.com/liamawhite/navigator/manager/pkg/frontend"
 	"github.com/liamawhite/navigator/manager/pkg/providers"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
 	"google.golang.org/grpc"
 )
@@ -39,20 +41,45 @@ type ManagerServer struct {
 	httpServer        *http.Server
 	listener          net.Listener
 	httpListener      net.Listener
+	adminServer       *http.Server // Serves the log level and metrics endpoints on localhost, nil when disabled
+	adminListener     net.Listener
 	mu                sync.RWMutex
 	running           bool
 
+	// Proves to the gRPC server that a request was forwarded by the HTTP gateway, so the identity of
+	// multi-tenant requests is only trusted from the gateway
+	gatewayToken string
+
+	// Stops the simulated edges of a replayed snapshot, nil unless replaying
+	stopReplay func()
+
 	// Backend services
 	proxyService       *backend.ProxyService
 	meshMetricsService *backend.MeshMetricsService
+	logsService        *backend.LogsService
+	envoyAdminService  *backend.EnvoyAdminService
+	tracesProvider     *backend.TracesService
+	accessLogsProvider *backend.AccessLogsService
 
 	// Provider implementations
 	istioProvider providers.IstioResourcesProvider
 
+	// Reassembles cluster state sent in chunks
+	stateAssembler *clusterStateAssembler
+
+	// Spreads the periodic syncs of connected edges over the sync interval
+	syncStagger *syncStagger
+
+	// Admin services
+	adminService *admin.AdminService
+
 	// Frontend services
 	serviceRegistryService *frontend.ServiceRegistryService
 	metricsService         *frontend.MetricsService
 	clusterRegistryService *frontend.ClusterRegistryService
+	analysisService        *frontend.AnalysisService
+	tracesService          *frontend.TracesService
+	accessLogsService      *frontend.AccessLogsService
 }
 
 // NewManagerServer creates a new manager server
@@ -65,14 +92,25 @@ func NewManagerServer(config providers.Config, connectionManager providers.ReadO
 	// Create backend services
 	proxyService := backend.NewProxyService(connectionManager, logger)
 	meshMetricsService := backend.NewMeshMetricsService(connectionManager, logger)
+	logsService := backend.NewLogsService(connectionManager, logger)
+	envoyAdminService := backend.NewEnvoyAdminService(connectionManager, logger)
+	tracesProvider := backend.NewTracesService(connectionManager, logger)
+	accessLogsProvider := backend.NewAccessLogsService(connectionManager, logger)
 
 	// Create provider implementations
 	istioProvider := backend.NewIstioService(connectionManager, logger)
+	analysisProvider := backend.NewAnalysisService(connectionManager, meshMetricsService, logger)
+
+	// Create admin services
+	adminService := admin.NewAdminService(connectionManager, logger)
 
 	// Create frontend services
-	serviceRegistryService := frontend.NewServiceRegistryService(connectionManager, proxyService, istioProvider, logger)
-	metricsService := frontend.NewMetricsService(connectionManager, meshMetricsService, logger)
+	serviceRegistryService := frontend.NewServiceRegistryService(connectionManager, proxyService, istioProvider, logsService, envoyAdminService, logger)
+	metricsService := frontend.NewMetricsService(connectionManager, meshMetricsService, istioProvider, logger)
 	clusterRegistryService := frontend.NewClusterRegistryService(connectionManager, logger)
+	analysisService := frontend.NewAnalysisService(connectionManager, analysisProvider, logger)
+	tracesService := frontend.NewTracesService(connectionManager, tracesProvider, logger)
+	accessLogsService := frontend.NewAccessLogsService(connectionManager, accessLogsProvider, logger)
 
 	return &ManagerServer{
 		config:                 config,
@@ -80,10 +118,21 @@ func NewManagerServer(config providers.Config, connectionManager providers.ReadO
 		logger:                 logger,
 		proxyService:           proxyService,
 		meshMetricsService:     meshMetricsService,
+		logsService:            logsService,
+		envoyAdminService:      envoyAdminService,
+		tracesProvider:         tracesProvider,
+		accessLogsProvider:     accessLogsProvider,
 		istioProvider:          istioProvider,
+		gatewayToken:           tenancy.NewGatewayToken(),
+		stateAssembler:         newClusterStateAssembler(config.GetMaxClusterStateSize()),
+		syncStagger:            newSyncStagger(),
+		adminService:           adminService,
 		serviceRegistryService: serviceRegistryService,
 		metricsService:         metricsService,
 		clusterRegistryService: clusterRegistryService,
+		analysisService:        analysisService,
+		tracesService:          tracesService,
+		accessLogsService:      accessLogsService,
 	}, nil
 }
 
@@ -96,6 +145,12 @@ func (s *ManagerServer) Start() error {
 		return fmt.Errorf("manager server is already running")
 	}
 
+	// Load the snapshot to replay before listening, so a bad bundle fails the start
+	bundle, err := s.loadReplay()
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot to replay: %w", err)
+	}
+
 	// Setup gRPC server
 	if err := s.setupGRPCServer(); err != nil {
 		return fmt.Errorf("failed to setup gRPC server: %w", err)
@@ -106,11 +161,22 @@ func (s *ManagerServer) Start() error {
 		return fmt.Errorf("failed to setup HTTP gateway: %w", err)
 	}
 
+	// Setup admin server
+	if err := s.setupAdminServer(); err != nil {
+		return fmt.Errorf("failed to setup admin server: %w", err)
+	}
+
 	s.running = true
 
 	// Start both servers in goroutines
 	s.startServers()
 
+	if bundle != nil {
+		if err := s.startReplay(bundle); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -125,6 +191,12 @@ func (s *ManagerServer) Stop() error {
 
 	s.logger.Info("stopping gRPC server and HTTP gateway")
 
+	// Disconnect replayed clusters first, the graceful stop waits for their streams
+	if s.stopReplay != nil {
+		s.stopReplay()
+		s.stopReplay = nil
+	}
+
 	// Graceful shutdown of HTTP server
 	if s.httpServer != nil {
 		if err := s.httpServer.Shutdown(context.Background()); err != nil {
@@ -132,6 +204,13 @@ func (s *ManagerServer) Stop() error {
 		}
 	}
 
+	// Graceful shutdown of admin server
+	if s.adminServer != nil {
+		if err := s.adminServer.Shutdown(context.Background()); err != nil {
+			s.logger.Error("error shutting down admin server", "error", err)
+		}
+	}
+
 	// Graceful shutdown of gRPC server
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
@@ -144,13 +223,16 @@ func (s *ManagerServer) Stop() error {
 	if s.httpListener != nil {
 		_ = s.httpListener.Close()
 	}
+	if s.adminListener != nil {
+		_ = s.adminListener.Close()
+	}
 
 	s.running = false
 
 	return nil
 }
 
-// startServers starts the gRPC and HTTP servers in separate goroutines
+// startServers starts the gRPC, HTTP and admin servers in separate goroutines
 func (s *ManagerServer) startServers() {
 	// Start gRPC server
 	go func() {
@@ -162,13 +244,26 @@ func (s *ManagerServer) startServers() {
 
 	// Start HTTP server
 	go func() {
-		// Get the actual port from the listener
-		actualPort := s.httpListener.Addr().(*net.TCPAddr).Port
-		s.logger.Info("starting HTTP gateway", "port", actualPort)
+		// Get the actual port or socket from the listener
+		if addr, ok := s.httpListener.Addr().(*net.TCPAddr); ok {
+			s.logger.Info("starting HTTP gateway", "port", addr.Port)
+		} else {
+			s.logger.Info("starting HTTP gateway", "socket", s.httpListener.Addr().String())
+		}
 		if err := s.httpServer.Serve(s.httpListener); err != nil && err != http.ErrServerClosed {
 			s.logger.Error("HTTP server error", "error", err)
 		}
 	}()
+
+	// Start admin server
+	if s.adminServer != nil {
+		go func() {
+			s.logger.Info("starting admin server", "address", s.adminListener.Addr().String())
+			if err := s.adminServer.Serve(s.adminListener); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("admin server error", "error", err)
+			}
+		}()
+	}
 }
 
 // GetProxyService returns the proxy service for external access (backward compatibility)
@@ -15,12 +15,21 @@
 package server
 
 import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/tenancy"
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
 	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/socket"
+	"github.com/liamawhite/navigator/pkg/telemetry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,32 +37,58 @@ import (
 // Mock config for testing
 type mockConfig struct {
 	port           int
+	httpSocket     string
+	adminPort      int
 	maxMessageSize int
+	tenants        *tenancy.Config
+	replaySnapshot string
 }
 
 func (m *mockConfig) GetPort() int {
 	return m.port
 }
 
+func (m *mockConfig) GetHTTPSocket() string {
+	return m.httpSocket
+}
+
+func (m *mockConfig) GetAdminPort() int {
+	return m.adminPort
+}
+
 func (m *mockConfig) GetMaxMessageSize() int {
 	return m.maxMessageSize
 }
 
+func (m *mockConfig) GetMaxClusterStateSize() int {
+	return 256 * 1024 * 1024
+}
+
+func (m *mockConfig) GetTenants() *tenancy.Config {
+	return m.tenants
+}
+
+func (m *mockConfig) GetReplaySnapshot() string {
+	return m.replaySnapshot
+}
+
 func (m *mockConfig) Validate() error {
 	return nil
 }
 
 // Mock connection manager for testing
 type mockConnectionManager struct {
-	connections map[string]bool
-	states      map[string]*v1alpha1.ClusterState
-	shouldFail  bool
+	connections  map[string]bool
+	states       map[string]*v1alpha1.ClusterState
+	capabilities map[string]*v1alpha1.EdgeCapabilities
+	shouldFail   bool
 }
 
 func newMockConnectionManager() *mockConnectionManager {
 	return &mockConnectionManager{
-		connections: make(map[string]bool),
-		states:      make(map[string]*v1alpha1.ClusterState),
+		connections:  make(map[string]bool),
+		states:       make(map[string]*v1alpha1.ClusterState),
+		capabilities: make(map[string]*v1alpha1.EdgeCapabilities),
 	}
 }
 
@@ -70,9 +105,15 @@ func (m *mockConnectionManager) RegisterConnection(clusterID string, stream v1al
 	return nil
 }
 
-func (m *mockConnectionManager) UnregisterConnection(clusterID string) {
+func (m *mockConnectionManager) RegisterEdgeConnection(identification *v1alpha1.ClusterIdentification, stream v1alpha1.ManagerService_ConnectServer) (string, error) {
+	return identification.ClusterId, m.RegisterConnection(identification.ClusterId, stream)
+}
+
+func (m *mockConnectionManager) UnregisterConnection(clusterID string, stream v1alpha1.ManagerService_ConnectServer) bool {
+	_, exists := m.connections[clusterID]
 	delete(m.connections, clusterID)
 	delete(m.states, clusterID)
+	return exists
 }
 
 func (m *mockConnectionManager) UpdateClusterState(clusterID string, clusterState *v1alpha1.ClusterState) error {
@@ -88,7 +129,14 @@ func (m *mockConnectionManager) UpdateCapabilities(clusterID string, capabilitie
 	if !m.connections[clusterID] {
 		return status.Errorf(codes.NotFound, "connection not found")
 	}
-	// Mock implementation - just return success
+	m.capabilities[clusterID] = capabilities
+	return nil
+}
+
+func (m *mockConnectionManager) UpdateEdgeVersion(clusterID, edgeVersion string) error {
+	if !m.connections[clusterID] {
+		return status.Errorf(codes.NotFound, "connection not found")
+	}
 	return nil
 }
 
@@ -100,6 +148,14 @@ func (m *mockConnectionManager) GetClusterState(clusterID string) (*v1alpha1.Clu
 	return state, nil
 }
 
+func (m *mockConnectionManager) GetSelectorIndex(clusterID string) (*filters.SelectorIndex, error) {
+	state, err := m.GetClusterState(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return filters.NewSelectorIndex(state), nil
+}
+
 func (m *mockConnectionManager) GetAllClusterStates() map[string]*v1alpha1.ClusterState {
 	return m.states
 }
@@ -119,6 +175,24 @@ func (m *mockConnectionManager) SendMessageToCluster(clusterID string, message *
 	return nil
 }
 
+func (m *mockConnectionManager) RequestResync(clusterID, reason string) error {
+	if !m.connections[clusterID] {
+		return status.Errorf(codes.NotFound, "connection not found")
+	}
+	return nil
+}
+
+func (m *mockConnectionManager) DisconnectCluster(clusterID, reason string) error {
+	if !m.connections[clusterID] {
+		return status.Errorf(codes.NotFound, "connection not found")
+	}
+	return nil
+}
+
+func (m *mockConnectionManager) Disconnected(clusterID string) <-chan struct{} {
+	return nil
+}
+
 // Read-optimized methods for ReadOptimizedConnectionManager interface
 func (m *mockConnectionManager) ListAggregatedServices(namespace, clusterID string) []*connections.AggregatedService {
 	// Simple mock implementation - return empty slice
@@ -140,6 +214,16 @@ func (m *mockConnectionManager) GetConnectionInfo() map[string]connections.Conne
 	return make(map[string]connections.ConnectionInfo)
 }
 
+func (m *mockConnectionManager) ListChanges(clusterID string, since time.Time, limit int) []connections.Change {
+	// Simple mock implementation - return no changes
+	return nil
+}
+
+func (m *mockConnectionManager) ListEvents(clusterID string, start, end time.Time, limit int) []connections.Event {
+	// Simple mock implementation - return no events
+	return nil
+}
+
 func TestManagerServer_processClusterIdentification(t *testing.T) {
 	logger := logging.For("test")
 	config := &mockConfig{port: 8080, maxMessageSize: 10485760}
@@ -195,6 +279,58 @@ func TestManagerServer_processClusterIdentification(t *testing.T) {
 			expectedID:  "",
 			expectError: true,
 		},
+		{
+			name: "hash namespace shard",
+			req: &v1alpha1.ConnectRequest{
+				Message: &v1alpha1.ConnectRequest_ClusterIdentification{
+					ClusterIdentification: &v1alpha1.ClusterIdentification{
+						ClusterId: "test-cluster",
+						Shard:     &v1alpha1.NamespaceShard{Index: 1, Count: 4},
+					},
+				},
+			},
+			expectedID:  "test-cluster",
+			expectError: false,
+		},
+		{
+			name: "namespace shard index out of range",
+			req: &v1alpha1.ConnectRequest{
+				Message: &v1alpha1.ConnectRequest_ClusterIdentification{
+					ClusterIdentification: &v1alpha1.ClusterIdentification{
+						ClusterId: "test-cluster",
+						Shard:     &v1alpha1.NamespaceShard{Index: 4, Count: 4},
+					},
+				},
+			},
+			expectedID:  "",
+			expectError: true,
+		},
+		{
+			name: "namespace shard with both hash count and namespaces",
+			req: &v1alpha1.ConnectRequest{
+				Message: &v1alpha1.ConnectRequest_ClusterIdentification{
+					ClusterIdentification: &v1alpha1.ClusterIdentification{
+						ClusterId: "test-cluster",
+						Shard:     &v1alpha1.NamespaceShard{Count: 2, Namespaces: []string{"bookinfo"}},
+					},
+				},
+			},
+			expectedID:  "",
+			expectError: true,
+		},
+		{
+			name: "empty namespace shard",
+			req: &v1alpha1.ConnectRequest{
+				Message: &v1alpha1.ConnectRequest_ClusterIdentification{
+					ClusterIdentification: &v1alpha1.ClusterIdentification{
+						ClusterId: "test-cluster",
+						Shard:     &v1alpha1.NamespaceShard{},
+					},
+				},
+			},
+			expectedID:  "",
+			expectError: true,
+		},
 		{
 			name: "nil cluster identification",
 			req: &v1alpha1.ConnectRequest{
@@ -350,6 +486,47 @@ func TestManagerServer_processClusterStateUpdate(t *testing.T) {
 	}
 }
 
+func TestManagerServer_processCapabilitiesUpdate(t *testing.T) {
+	logger := logging.For("test")
+	config := &mockConfig{port: 8080, maxMessageSize: 10485760}
+	connectionManager := newMockConnectionManager()
+
+	server, err := NewManagerServer(config, connectionManager, logger)
+	if err != nil {
+		t.Fatalf("Failed to create manager server: %v", err)
+	}
+	_ = connectionManager.RegisterConnection("test-cluster", nil)
+
+	capabilities := &v1alpha1.EdgeCapabilities{
+		Preflight: &v1alpha1.PreflightReport{
+			Resources: []*v1alpha1.ResourceCapability{{
+				Group:    "networking.istio.io",
+				Resource: "virtualservices",
+				Status:   v1alpha1.ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE,
+			}},
+		},
+	}
+	req := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_CapabilitiesUpdate{CapabilitiesUpdate: capabilities},
+	}
+	if err := server.processIncomingMessage("test-cluster", req); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if connectionManager.capabilities["test-cluster"] != capabilities {
+		t.Error("Expected the connection's capabilities to be replaced")
+	}
+
+	nilUpdate := &v1alpha1.ConnectRequest{
+		Message: &v1alpha1.ConnectRequest_CapabilitiesUpdate{},
+	}
+	if err := server.processIncomingMessage("test-cluster", nilUpdate); err == nil {
+		t.Error("Expected error for a nil capabilities update")
+	}
+	if err := server.processIncomingMessage("unknown-cluster", req); err == nil {
+		t.Error("Expected error for an unknown connection")
+	}
+}
+
 func TestManagerServer_StartStop(t *testing.T) {
 	logger := logging.For("test")
 	config := &mockConfig{port: 0, maxMessageSize: 10485760} // Use port 0 to get a random available port
@@ -387,3 +564,106 @@ func TestManagerServer_StartStop(t *testing.T) {
 		t.Errorf("Expected no error stopping server twice, got: %v", err)
 	}
 }
+
+func TestManagerServer_HTTPSocket(t *testing.T) {
+	logger := logging.For("test")
+	path := filepath.Join(t.TempDir(), "gateway.sock")
+	config := &mockConfig{port: 0, httpSocket: path, maxMessageSize: 10485760}
+
+	server, err := NewManagerServer(config, newMockConnectionManager(), logger)
+	if err != nil {
+		t.Fatalf("Failed to create manager server: %v", err)
+	}
+	if err := server.Start(); err != nil {
+		t.Fatalf("Expected no error starting server, got: %v", err)
+	}
+
+	// The gateway serves on the socket, whatever the host. Metrics are only served by the admin server.
+	client := &http.Client{Transport: socket.Transport(path)}
+	resp, err := client.Get("http://navigator" + telemetry.MetricsPath)
+	if err != nil {
+		t.Fatalf("Expected no error requesting the gateway over its socket, got: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+
+	if err := server.Stop(); err != nil {
+		t.Errorf("Expected no error stopping server, got: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected socket to be removed on stop, got: %v", err)
+	}
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	matcher := incomingHeaderMatcher(nil)
+
+	// The gateway forwards a client-supplied request ID as gRPC metadata so the request keeps its ID
+	key, ok := matcher("X-Request-Id")
+	if !ok || key != logging.RequestIDMetadataKey {
+		t.Errorf("Expected X-Request-ID to be forwarded as %s, got %q, %v", logging.RequestIDMetadataKey, key, ok)
+	}
+	key, ok = matcher("traceparent")
+	if !ok || key != logging.TraceParentHeader {
+		t.Errorf("Expected traceparent to be forwarded as %s, got %q, %v", logging.TraceParentHeader, key, ok)
+	}
+	if _, ok := matcher("X-Unknown"); ok {
+		t.Error("Expected unknown headers not to be forwarded")
+	}
+
+	// Clients cannot forge the identity of a multi-tenant request or the gateway token with metadata headers
+	matcher = incomingHeaderMatcher(&tenancy.Config{})
+	key, ok = matcher("X-Forwarded-User")
+	if !ok || key != "x-forwarded-user" {
+		t.Errorf("Expected X-Forwarded-User to be forwarded as x-forwarded-user, got %q, %v", key, ok)
+	}
+	for _, header := range []string{"Grpc-Metadata-X-Forwarded-User", "Grpc-Metadata-" + tenancy.GatewayTokenKey} {
+		if key, ok := matcher(header); ok {
+			t.Errorf("Expected %s not to be forwarded, got %q", header, key)
+		}
+	}
+	key, ok = matcher("Grpc-Metadata-Custom")
+	if !ok || key != "Custom" {
+		t.Errorf("Expected other metadata headers to be forwarded, got %q, %v", key, ok)
+	}
+}
+
+func TestManagerServer_AdminServer(t *testing.T) {
+	logger := logging.For("test")
+
+	// Find a free port for the admin server
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	adminPort := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	config := &mockConfig{port: 0, httpSocket: filepath.Join(t.TempDir(), "gateway.sock"), adminPort: adminPort, maxMessageSize: 10485760}
+	server, err := NewManagerServer(config, newMockConnectionManager(), logger)
+	if err != nil {
+		t.Fatalf("Failed to create manager server: %v", err)
+	}
+	if err := server.Start(); err != nil {
+		t.Fatalf("Expected no error starting server, got: %v", err)
+	}
+	defer func() { _ = server.Stop() }()
+
+	// The admin server only listens on localhost
+	if host := server.adminListener.Addr().(*net.TCPAddr).IP; !host.IsLoopback() {
+		t.Errorf("Expected the admin server to listen on a loopback address, got %s", host)
+	}
+
+	for _, path := range []string{telemetry.MetricsPath, logging.LevelPath} {
+		resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", adminPort, path))
+		if err != nil {
+			t.Fatalf("Expected no error requesting %s, got: %v", path, err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected status 200 for %s, got %d", path, resp.StatusCode)
+		}
+	}
+}
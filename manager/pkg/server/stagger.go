@@ -0,0 +1,64 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import "sync"
+
+// syncStagger assigns connected edges slots whose offsets spread their periodic syncs over the sync
+// interval, so edges started together do not all push their cluster state at once. Slots are reused
+// once released, keeping the offsets of the connected edges evenly spread.
+type syncStagger struct {
+	mu    sync.Mutex
+	slots map[int]bool // slots taken by connected edges
+}
+
+// newSyncStagger creates a new sync stagger
+func newSyncStagger() *syncStagger {
+	return &syncStagger{
+		slots: make(map[int]bool),
+	}
+}
+
+// Acquire takes the lowest free slot and returns it with its sync offset
+func (s *syncStagger) Acquire() (int, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slot := 0
+	for s.slots[slot] {
+		slot++
+	}
+	s.slots[slot] = true
+	return slot, syncOffset(slot)
+}
+
+// Release frees a slot taken by Acquire
+func (s *syncStagger) Release(slot int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.slots, slot)
+}
+
+// syncOffset returns the sync offset of a slot as a fraction of the sync interval. Offsets follow the
+// base 2 van der Corput sequence (0, 1/2, 1/4, 3/4, 1/8, ...), so the first n slots are always spread
+// evenly over the interval, however many edges connect.
+func syncOffset(slot int) float64 {
+	offset := 0.0
+	for denominator := 2.0; slot > 0; denominator *= 2 {
+		offset += float64(slot%2) / denominator
+		slot /= 2
+	}
+	return offset
+}
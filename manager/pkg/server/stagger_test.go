@@ -0,0 +1,43 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncStagger_AcquireRelease(t *testing.T) {
+	stagger := newSyncStagger()
+
+	var offsets []float64
+	for i := 0; i < 4; i++ {
+		slot, offset := stagger.Acquire()
+		assert.Equal(t, i, slot)
+		offsets = append(offsets, offset)
+	}
+	assert.Equal(t, []float64{0, 0.5, 0.25, 0.75}, offsets)
+
+	// A released slot is handed to the next edge to connect
+	stagger.Release(1)
+	slot, offset := stagger.Acquire()
+	assert.Equal(t, 1, slot)
+	assert.Equal(t, 0.5, offset)
+
+	slot, offset = stagger.Acquire()
+	assert.Equal(t, 4, slot)
+	assert.Equal(t, 0.125, offset)
+}
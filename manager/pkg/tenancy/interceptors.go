@@ -0,0 +1,114 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"log/slog"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// frontendMethodPrefix prefixes the methods of the frontend API, which are scoped to tenants. The backend
+	// API used by edges is not.
+	frontendMethodPrefix = "/navigator.frontend."
+	// adminMethodPrefix prefixes the methods of the admin API, which act on the clusters of every tenant
+	adminMethodPrefix = "/navigator.backend.v1alpha1.AdminService/"
+)
+
+// GatewayTokenKey is the metadata key of the token the HTTP gateway forwards requests with. Edges reach the
+// gRPC port directly, so identities are only trusted from requests carrying the token, which only the manager
+// process knows.
+const GatewayTokenKey = "x-navigator-gateway-token"
+
+// NewGatewayToken returns a random token for the HTTP gateway to forward requests with
+func NewGatewayToken() string {
+	return rand.Text()
+}
+
+// UnaryClientInterceptor adds the gateway token to the requests the HTTP gateway forwards
+func UnaryClientInterceptor(gatewayToken string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, GatewayTokenKey, gatewayToken), method, req, reply, cc, opts...)
+	}
+}
+
+// AdminUnaryServerInterceptor rejects admin requests from clients not connecting to the manager on localhost.
+// Admin requests disconnect edges and resync the clusters of every tenant, and the gRPC port is reachable by
+// edges, so they are refused whether or not the manager is multi-tenant.
+func AdminUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, adminMethodPrefix) && !isLocal(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "the admin API is only served on localhost")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// UnaryServerInterceptor scopes frontend requests to the tenants of the identity the HTTP gateway forwarded
+// them for, and rejects requests without an identity or whose identity belongs to no tenant
+func UnaryServerInterceptor(config *Config, gatewayToken string, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, frontendMethodPrefix) {
+			return handler(ctx, req)
+		}
+		scope, err := resolveScope(ctx, config, gatewayToken, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(WithScope(ctx, scope), req)
+	}
+}
+
+// isLocal reports whether a request was made over a connection to a loopback address of the manager, such as
+// from the manager's own host or a port forward
+func isLocal(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	addr, ok := p.LocalAddr.(*net.TCPAddr)
+	return ok && addr.IP.IsLoopback()
+}
+
+// resolveScope returns the scope of the identity a request is made by. The identity header of requests that
+// did not come through the HTTP gateway is ignored, since their client could have set it.
+func resolveScope(ctx context.Context, config *Config, gatewayToken string, logger *slog.Logger, method string) (*Scope, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(GatewayTokenKey)
+	if len(tokens) != 1 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(gatewayToken)) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "request has no %s identity forwarded by the HTTP gateway", config.GetIdentityHeader())
+	}
+	identities := md.Get(strings.ToLower(config.GetIdentityHeader()))
+	if len(identities) == 0 || identities[0] == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "request has no %s identity", config.GetIdentityHeader())
+	}
+
+	scope := config.ScopeFor(identities[0])
+	if scope == nil {
+		logger.Warn("request from identity without tenant rejected", "identity", identities[0], "method", method)
+		return nil, status.Errorf(codes.PermissionDenied, "identity %s belongs to no tenant", identities[0])
+	}
+	return scope, nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenancy scopes the clusters served by the frontend API to the tenants of the authenticated identity,
+// so one manager can serve several teams without leaking each other's clusters.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultIdentityHeader is the header an authenticating proxy sets to the identity of the user by default
+const DefaultIdentityHeader = "X-Forwarded-User"
+
+// Config maps identities to tenants and tenants to the clusters they may access. Identities are read from
+// a header set by an authenticating proxy in front of the manager's HTTP gateway, which must not let clients
+// set it.
+type Config struct {
+	IdentityHeader string   `yaml:"identity_header,omitempty"` // Defaults to DefaultIdentityHeader
+	Tenants        []Tenant `yaml:"tenants"`
+}
+
+// Tenant is a team sharing the manager
+type Tenant struct {
+	Name       string   `yaml:"name"`
+	Identities []string `yaml:"identities"` // Identities belonging to the tenant
+	Clusters   []string `yaml:"clusters"`   // Cluster IDs, or path.Match patterns such as "payments-*"
+}
+
+// Load reads the tenant configuration from a YAML file
+func Load(filePath string) (*Config, error) {
+	config := &Config{}
+
+	file, err := os.Open(filePath) // #nosec G304 - path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tenants file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse tenants file: %w", err)
+	}
+	return config, config.Validate()
+}
+
+// Validate checks that every tenant is named once, has identities and valid cluster patterns
+func (c *Config) Validate() error {
+	if len(c.Tenants) == 0 {
+		return fmt.Errorf("at least one tenant is required")
+	}
+
+	names := make(map[string]bool, len(c.Tenants))
+	for _, tenant := range c.Tenants {
+		if tenant.Name == "" {
+			return fmt.Errorf("tenant name is required")
+		}
+		if names[tenant.Name] {
+			return fmt.Errorf("tenant %s is defined more than once", tenant.Name)
+		}
+		names[tenant.Name] = true
+
+		if len(tenant.Identities) == 0 {
+			return fmt.Errorf("tenant %s has no identities", tenant.Name)
+		}
+		for _, pattern := range tenant.Clusters {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("tenant %s has invalid cluster pattern %q: %w", tenant.Name, pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetIdentityHeader returns the header holding the identity of the user
+func (c *Config) GetIdentityHeader() string {
+	if c.IdentityHeader == "" {
+		return DefaultIdentityHeader
+	}
+	return c.IdentityHeader
+}
+
+// ScopeFor returns the scope of an identity across every tenant it belongs to, or nil if it belongs to none
+func (c *Config) ScopeFor(identity string) *Scope {
+	var scope *Scope
+	for _, tenant := range c.Tenants {
+		if !slices.Contains(tenant.Identities, identity) {
+			continue
+		}
+		if scope == nil {
+			scope = &Scope{}
+		}
+		scope.Tenants = append(scope.Tenants, tenant.Name)
+		scope.clusters = append(scope.clusters, tenant.Clusters...)
+	}
+	return scope
+}
+
+// Scope is the set of clusters a request may access. A nil scope is unrestricted, for managers without
+// tenants and for requests that are not made on behalf of a user.
+type Scope struct {
+	Tenants  []string        // Names of the tenants of the identity, empty if the request is not scoped by tenant
+	clusters []string        // Cluster IDs and patterns of the tenants
+	selected map[string]bool // Clusters the request selected, nil if it did not narrow its scope
+}
+
+// Narrow returns a scope that only includes the clusters of this scope that are also in clusterIDs, for
+// requests selecting the clusters they apply to
+func (s *Scope) Narrow(clusterIDs []string) *Scope {
+	narrowed := &Scope{selected: make(map[string]bool, len(clusterIDs))}
+	if s != nil {
+		narrowed.Tenants = s.Tenants
+		narrowed.clusters = s.clusters
+	}
+	for _, clusterID := range clusterIDs {
+		if s.Allows(clusterID) {
+			narrowed.selected[clusterID] = true
+		}
+	}
+	return narrowed
+}
+
+// Allows returns whether the scope includes a cluster
+func (s *Scope) Allows(clusterID string) bool {
+	if s == nil {
+		return true
+	}
+	if s.selected != nil {
+		return s.selected[clusterID]
+	}
+	for _, pattern := range s.clusters {
+		if matched, _ := path.Match(pattern, clusterID); matched {
+			return true
+		}
+	}
+	return false
+}
+
+type scopeKey struct{}
+
+// WithScope returns a context carrying the scope of a request
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// FromContext returns the scope of a request, nil if it is unrestricted
+func FromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+	return scope
+}
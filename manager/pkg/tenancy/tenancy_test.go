@@ -0,0 +1,191 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenancy
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func testConfig() *Config {
+	return &Config{Tenants: []Tenant{
+		{Name: "payments", Identities: []string{"alice", "ops"}, Clusters: []string{"payments-*"}},
+		{Name: "search", Identities: []string{"bob", "ops"}, Clusters: []string{"search-east"}},
+	}}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tenants.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+identity_header: X-Auth-Request-Email
+tenants:
+  - name: payments
+    identities: [alice@example.com]
+    clusters: [payments-*]
+`), 0o600))
+
+	config, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "X-Auth-Request-Email", config.GetIdentityHeader())
+	assert.Equal(t, []string{"payments-*"}, config.Tenants[0].Clusters)
+
+	require.NoError(t, os.WriteFile(path, []byte("tenants:\n  - name: payments\n    users: [alice]\n"), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "failed to parse tenants file")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, testConfig().Validate())
+	assert.Equal(t, DefaultIdentityHeader, testConfig().GetIdentityHeader())
+
+	tests := map[string]*Config{
+		"no tenants":        {},
+		"unnamed tenant":    {Tenants: []Tenant{{Identities: []string{"alice"}}}},
+		"duplicate tenant":  {Tenants: []Tenant{{Name: "a", Identities: []string{"alice"}}, {Name: "a", Identities: []string{"bob"}}}},
+		"no identities":     {Tenants: []Tenant{{Name: "a"}}},
+		"malformed pattern": {Tenants: []Tenant{{Name: "a", Identities: []string{"alice"}, Clusters: []string{"payments-["}}}},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, config.Validate())
+		})
+	}
+}
+
+func TestConfig_ScopeFor(t *testing.T) {
+	config := testConfig()
+
+	alice := config.ScopeFor("alice")
+	require.NotNil(t, alice)
+	assert.Equal(t, []string{"payments"}, alice.Tenants)
+	assert.True(t, alice.Allows("payments-east"))
+	assert.False(t, alice.Allows("search-east"))
+
+	// An identity of several tenants accesses the clusters of all of them
+	ops := config.ScopeFor("ops")
+	assert.Equal(t, []string{"payments", "search"}, ops.Tenants)
+	assert.True(t, ops.Allows("payments-west"))
+	assert.True(t, ops.Allows("search-east"))
+	assert.False(t, ops.Allows("search-west"))
+
+	assert.Nil(t, config.ScopeFor("mallory"))
+
+	// A nil scope is unrestricted
+	var unrestricted *Scope
+	assert.True(t, unrestricted.Allows("search-west"))
+	assert.Nil(t, FromContext(context.Background()))
+}
+
+func TestScope_Narrow(t *testing.T) {
+	alice := testConfig().ScopeFor("alice").Narrow([]string{"payments-east", "search-east"})
+	assert.Equal(t, []string{"payments"}, alice.Tenants)
+	assert.True(t, alice.Allows("payments-east"))
+	assert.False(t, alice.Allows("payments-west"))
+	assert.False(t, alice.Allows("search-east"))
+
+	// Narrowing an unrestricted scope only includes the given clusters
+	var unrestricted *Scope
+	selected := unrestricted.Narrow([]string{"search-east"})
+	assert.True(t, selected.Allows("search-east"))
+	assert.False(t, selected.Allows("payments-east"))
+	assert.False(t, unrestricted.Narrow(nil).Allows("search-east"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(testConfig(), "gateway-token", logging.For("test"))
+	var scope *Scope
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		scope = FromContext(ctx)
+		return nil, nil
+	}
+	call := func(ctx context.Context, method string, pairs ...string) error {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+		scope = nil
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	ctx := context.Background()
+
+	frontend := "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServices"
+	require.NoError(t, call(ctx, frontend, GatewayTokenKey, "gateway-token", "x-forwarded-user", "bob"))
+	assert.Equal(t, []string{"search"}, scope.Tenants)
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(ctx, frontend, GatewayTokenKey, "gateway-token")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(ctx, frontend, GatewayTokenKey, "gateway-token", "x-forwarded-user", "mallory")))
+
+	// An identity set by a client calling the gRPC port directly is not trusted
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(ctx, frontend, "x-forwarded-user", "bob")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(ctx, frontend, GatewayTokenKey, "guess", "x-forwarded-user", "bob")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(ctx, frontend, GatewayTokenKey, "gateway-token", GatewayTokenKey, "guess", "x-forwarded-user", "bob")))
+
+	// The backend API is not scoped
+	require.NoError(t, call(ctx, "/navigator.backend.v1alpha1.ManagerService/Connect"))
+	assert.Nil(t, scope)
+	require.NoError(t, call(ctx, "/navigator.backend.v1alpha1.AdminService/ListEdgeConnections"))
+	assert.Nil(t, scope)
+}
+
+func TestAdminUnaryServerInterceptor(t *testing.T) {
+	interceptor := AdminUnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	ctx := context.Background()
+
+	// The admin API is only served on localhost
+	admin := "/navigator.backend.v1alpha1.AdminService/DisconnectEdge"
+	remote := peer.NewContext(ctx, &peer.Peer{
+		Addr:      &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 40000},
+		LocalAddr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 8080},
+	})
+	local := peer.NewContext(ctx, &peer.Peer{
+		Addr:      &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 40000},
+		LocalAddr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8080},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(ctx, admin)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(remote, admin)))
+	require.NoError(t, call(local, admin))
+
+	// Other APIs are served to every client
+	require.NoError(t, call(remote, "/navigator.backend.v1alpha1.ManagerService/Connect"))
+	require.NoError(t, call(remote, "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServices"))
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor("gateway-token")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"gateway-token"}, md.Get(GatewayTokenKey))
+		return nil
+	}
+	require.NoError(t, interceptor(context.Background(), "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServices", nil, nil, nil, invoker))
+	assert.NotEqual(t, NewGatewayToken(), NewGatewayToken())
+}
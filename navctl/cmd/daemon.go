@@ -0,0 +1,154 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+
+	"github.com/liamawhite/navigator/navctl/pkg/daemon"
+	"github.com/liamawhite/navigator/navctl/pkg/output"
+)
+
+var (
+	// Background process flags
+	detach      bool
+	pidFile     string
+	logFile     string
+	stopTimeout time.Duration
+
+	// statusOutput prints the status of the background process
+	statusOutput output.Printer
+)
+
+// processStatus is the status of navctl local running in the background. Status is running, stopped, or
+// stale when the PID file is left over from a process that is no longer running.
+type processStatus struct {
+	Status  string `json:"status"`
+	PID     int    `json:"pid,omitempty"`
+	PIDFile string `json:"pidFile"`
+}
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:          "status",
+	Short:        "Show whether navctl local is running in the background",
+	Long:         "Show whether a navctl local started with --detach is running, using its PID file.",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, running, err := daemon.Status(pidFile)
+		if err != nil {
+			return err
+		}
+
+		status := processStatus{Status: "stopped", PID: pid, PIDFile: pidFile}
+		switch {
+		case running:
+			status.Status = "running"
+		case pid != 0:
+			status.Status = "stale"
+		}
+		pidColumn := "-"
+		if pid != 0 {
+			pidColumn = strconv.Itoa(pid)
+		}
+		if err := statusOutput.Print(os.Stdout, status, output.Table{
+			Headers: []string{"Status", "PID", "PID File"},
+			Rows:    [][]string{{status.Status, pidColumn, pidFile}},
+		}); err != nil {
+			return err
+		}
+
+		// A background process that is not running fails the command, so scripts can check its exit code
+		switch {
+		case running:
+			return nil
+		case pid != 0:
+			return fmt.Errorf("navigator is not running (stale PID file %s for pid %d)", pidFile, pid)
+		default:
+			return fmt.Errorf("navigator is not running")
+		}
+	},
+}
+
+// stopCmd represents the stop command
+var stopCmd = &cobra.Command{
+	Use:          "stop",
+	Short:        "Stop navctl local running in the background",
+	Long:         "Gracefully stop a navctl local started with --detach and wait for it to exit.",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, err := daemon.Stop(pidFile, stopTimeout)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Navigator stopped (pid %d)\n", pid)
+		return nil
+	},
+}
+
+// runDetached starts navctl local again in the background with the same arguments, without --detach
+func runDetached() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find navctl executable: %w", err)
+	}
+
+	var args []string
+	for _, arg := range os.Args[1:] {
+		if arg == "--detach" || strings.HasPrefix(arg, "--detach=") {
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	pid, err := daemon.Start(pidFile, logFile, executable, args...)
+	if err != nil {
+		return fmt.Errorf("failed to start navctl in the background: %w", err)
+	}
+	fmt.Printf("Navigator is running in the background (pid %d)\n", pid)
+	fmt.Printf("  Logs: %s\n", logFile)
+	fmt.Printf("  Check status with: navctl status\n")
+	fmt.Printf("  Stop with: navctl stop\n")
+	return nil
+}
+
+// defaultDaemonPath returns the default path of a background process file in ~/.navigator
+func defaultDaemonPath(name string) string {
+	if home := homedir.HomeDir(); home != "" {
+		return filepath.Join(home, ".navigator", name)
+	}
+	return name
+}
+
+func init() {
+	defaultPIDFile := defaultDaemonPath("navctl.pid")
+
+	localCmd.Flags().BoolVar(&detach, "detach", false, "Run in the background, writing logs to --log-file and the process ID to --pid-file")
+	localCmd.Flags().StringVar(&pidFile, "pid-file", defaultPIDFile, "Path to the PID file of the background process")
+	localCmd.Flags().StringVar(&logFile, "log-file", defaultDaemonPath("navctl.log"), "Path to the log file of the background process")
+
+	statusCmd.Flags().StringVar(&pidFile, "pid-file", defaultPIDFile, "Path to the PID file of the background process")
+	statusOutput.AddFlags(statusCmd.Flags())
+	stopCmd.Flags().StringVar(&pidFile, "pid-file", defaultPIDFile, "Path to the PID file of the background process")
+	stopCmd.Flags().DurationVar(&stopTimeout, "timeout", 30*time.Second, "How long to wait for the background process to exit")
+}
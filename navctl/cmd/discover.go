@@ -0,0 +1,140 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/liamawhite/navigator/navctl/pkg/discovery"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+var (
+	discoverProvider      string
+	discoverRegions       []string
+	discoverProjects      []string
+	discoverSubscriptions []string
+	discoverKubeconfig    string
+	discoverOutput        string
+	discoverForce         bool
+)
+
+// discoverCmd represents the discover command
+var discoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "Discover cloud clusters and generate kubeconfig contexts and a navctl config",
+	Long: `Discover the Kubernetes clusters of a cloud provider and generate a kubeconfig context
+for each of them, plus a navctl configuration file with one edge per cluster.
+
+Clusters are listed with the provider's CLI (aws, gcloud or az), using its current
+credentials and defaults. The generated contexts authenticate with the provider's exec
+credential plugin and are merged into the kubeconfig, replacing contexts of the same name.
+
+Examples:
+  # Discover EKS clusters in two regions
+  navctl discover --provider eks --region us-east-1 --region eu-west-1
+
+  # Discover GKE clusters of a project and run Navigator against all of them
+  navctl discover --provider gke --project my-project
+  navctl local --config navctl-config.yaml
+
+  # Discover AKS clusters into a dedicated kubeconfig
+  navctl discover --provider aks --kube-config ~/.kube/aks --output aks.yaml`,
+	RunE: runDiscover,
+}
+
+func runDiscover(cmd *cobra.Command, args []string) error {
+	logger := logging.For("discover")
+
+	provider, err := discovery.NewProvider(discoverProvider, discovery.Options{
+		Regions:       discoverRegions,
+		Projects:      discoverProjects,
+		Subscriptions: discoverSubscriptions,
+	}, logger)
+	if err != nil {
+		return err
+	}
+
+	// Don't discover clusters only to fail writing the config
+	if _, err := os.Stat(discoverOutput); err == nil && !discoverForce {
+		return fmt.Errorf("config file already exists: %s (use --force to overwrite)", discoverOutput)
+	}
+
+	logger.Info("discovering clusters", "provider", provider.Name())
+	discovered, err := provider.Discover(context.Background())
+	if err != nil {
+		return err
+	}
+	contexts := discovery.Contexts(discovered)
+	if len(contexts) == 0 {
+		return fmt.Errorf("no %s clusters found", provider.Name())
+	}
+	logger.Info("discovered clusters", "provider", provider.Name(), "count", len(contexts), "contexts", contexts)
+
+	// Merge the contexts into the kubeconfig, keeping its other contexts
+	kubeconfigPath, err := filepath.Abs(discoverKubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to resolve kubeconfig path: %w", err)
+	}
+	kubeconfig, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if os.IsNotExist(err) {
+		kubeconfig, err = clientcmd.Load(nil)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	discovery.Merge(kubeconfig, discovered)
+	if err := clientcmd.WriteToFile(*kubeconfig, kubeconfigPath); err != nil {
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+	logger.Info("wrote kubeconfig contexts", "path", kubeconfigPath, "count", len(contexts))
+
+	data, err := yaml.Marshal(discovery.NavctlConfig(contexts, kubeconfigPath))
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.WriteFile(discoverOutput, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	logger.Info("wrote navctl config", "path", discoverOutput, "edges", len(contexts))
+
+	fmt.Printf("Run Navigator against the discovered clusters with:\n  navctl local --config %s\n", discoverOutput)
+	return nil
+}
+
+func init() {
+	// Like kubectl, write to the first file of a KUBECONFIG path list
+	defaultKubeconfig := ""
+	if paths := filepath.SplitList(defaultKubeconfigPath()); len(paths) > 0 {
+		defaultKubeconfig = paths[0]
+	}
+
+	discoverCmd.Flags().StringVar(&discoverProvider, "provider", "", fmt.Sprintf("Cloud provider to discover clusters in (%s)", strings.Join(discovery.Providers, ", ")))
+	discoverCmd.Flags().StringSliceVar(&discoverRegions, "region", nil, "AWS regions to discover EKS clusters in (default: the aws CLI's region)")
+	discoverCmd.Flags().StringSliceVar(&discoverProjects, "project", nil, "GCP projects to discover GKE clusters in (default: the gcloud CLI's project)")
+	discoverCmd.Flags().StringSliceVar(&discoverSubscriptions, "subscription", nil, "Azure subscriptions to discover AKS clusters in (default: the az CLI's subscription)")
+	discoverCmd.Flags().StringVarP(&discoverKubeconfig, "kube-config", "k", defaultKubeconfig, "Kubeconfig file to merge the discovered contexts into")
+	discoverCmd.Flags().StringVarP(&discoverOutput, "output", "o", "navctl-config.yaml", "Path to write the navctl configuration file to")
+	discoverCmd.Flags().BoolVar(&discoverForce, "force", false, "Overwrite the navctl configuration file if it exists")
+	_ = discoverCmd.MarkFlagRequired("provider")
+}
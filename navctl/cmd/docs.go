@@ -81,6 +81,7 @@ func normalizeGeneratedDocs(outputDir string) error {
 		regexp.MustCompile(`/Users/[^/\s]+/\.kube/config`),
 		regexp.MustCompile(`/home/[^/\s]+/\.kube/config`),
 	}
+	navigatorDirPattern := regexp.MustCompile(`/(?:Users|home)/[^/\s]+/\.navigator/`)
 
 	// Pattern to normalize dynamic context listing - more precise to avoid removing too much
 	contextPattern := regexp.MustCompile(`(?s)Available contexts in [^:]+:\s*\n(?:\s*[-*]\s+[^\n]*\n)*\n`)
@@ -113,6 +114,7 @@ func normalizeGeneratedDocs(outputDir string) error {
 		for _, pattern := range patterns {
 			normalized = pattern.ReplaceAllString(normalized, "~/.kube/config")
 		}
+		normalized = navigatorDirPattern.ReplaceAllString(normalized, "~/.navigator/")
 
 		// Replace dynamic context listings with generic placeholder
 		normalized = contextPattern.ReplaceAllString(normalized, "Available contexts will be shown from your kubeconfig file.\n")
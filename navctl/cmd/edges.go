@@ -0,0 +1,187 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/liamawhite/navigator/edge/pkg/kubernetes"
+	edgeService "github.com/liamawhite/navigator/edge/pkg/service"
+	"github.com/liamawhite/navigator/navctl/pkg/kubeconfig"
+)
+
+// kubeconfigPollInterval is how often navctl local checks the kubeconfig for changes
+const kubeconfigPollInterval = 2 * time.Second
+
+// watchKubeconfig reconciles the edge services with the kubeconfig whenever it changes, until ctx is done
+func watchKubeconfig(ctx context.Context, runtime *LocalRuntime, edges *localEdges) {
+	var pathLists []string
+	for _, edgeConfig := range runtime.EdgeConfigs {
+		pathLists = append(pathLists, edgeConfig.KubeconfigPath)
+	}
+
+	kubeconfig.NewWatcher(kubeconfigPollInterval, pathLists...).Run(ctx, func() {
+		desired := runtime.EdgeConfigs
+		if runtime.ReloadEdges != nil {
+			var err error
+			if desired, err = runtime.ReloadEdges(); err != nil {
+				runtime.Logger.Warn("kubeconfig changed but edges could not be prepared, leaving them as they are", "error", err)
+				return
+			}
+		}
+		runtime.Logger.Info("kubeconfig changed, reconciling edge services")
+		edges.reconcile(ctx, desired)
+	})
+}
+
+// localEdges are the edge services of a navctl local session. They are reconciled with the kubeconfig,
+// so that only the edges whose context was added, removed or changed are started or stopped.
+type localEdges struct {
+	logs   *localLogs
+	logger *slog.Logger
+
+	mu    sync.Mutex
+	edges map[string]*localEdge // kubeconfig and context -> edge
+}
+
+// localEdge is an edge service and the kubeconfig context it was started with
+type localEdge struct {
+	config      EdgeRuntimeConfig
+	fingerprint string                   // Fingerprint of the context when the edge was started
+	service     *edgeService.EdgeService // nil if the edge failed to start
+	cancel      context.CancelFunc       // Stops the port-forwards of the edge
+}
+
+func newLocalEdges(logs *localLogs, logger *slog.Logger) *localEdges {
+	return &localEdges{logs: logs, logger: logger, edges: make(map[string]*localEdge)}
+}
+
+// reconcile starts the desired edges that are not running, restarts those whose context changed since
+// they were started and stops those that are no longer desired or whose context was removed. Edges that
+// failed to start are only started again once their context changes. Edges whose kubeconfig cannot be
+// loaded are left as they are, e.g. while the kubeconfig is being written.
+func (l *localEdges) reconcile(ctx context.Context, desired []EdgeRuntimeConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	kubeconfigs := make(map[string]*api.Config)
+	wanted := make(map[string]bool, len(desired))
+	for _, edgeConfig := range desired {
+		key := edgeConfig.KubeconfigPath + "#" + edgeConfig.ContextName
+		wanted[key] = true
+
+		config, loaded := kubeconfigs[edgeConfig.KubeconfigPath]
+		if !loaded {
+			var err error
+			config, err = kubernetes.KubeconfigLoadingRules(edgeConfig.KubeconfigPath).Load()
+			if err != nil {
+				l.logger.Warn("failed to load kubeconfig, leaving edge as it is", "context", edgeConfig.ContextName, "kubeconfig", edgeConfig.KubeconfigPath, "error", err)
+				continue
+			}
+			kubeconfigs[edgeConfig.KubeconfigPath] = config
+		}
+		fingerprint, err := kubeconfig.Fingerprint(config, edgeConfig.ContextName)
+		if err != nil {
+			l.logger.Warn("failed to fingerprint kubeconfig context, leaving edge as it is", "context", edgeConfig.ContextName, "error", err)
+			continue
+		}
+
+		current := l.edges[key]
+		if current != nil && current.fingerprint == fingerprint {
+			continue
+		}
+		switch {
+		case current != nil && fingerprint == "":
+			l.logger.Info("context removed from kubeconfig, stopping edge service", "context", edgeConfig.ContextName)
+			l.stop(key)
+		case current != nil:
+			l.logger.Info("context changed in kubeconfig, restarting edge service", "context", edgeConfig.ContextName)
+			l.stop(key)
+		case fingerprint == "":
+			l.logger.Warn("context not found in kubeconfig, not starting edge service", "context", edgeConfig.ContextName)
+		}
+		if fingerprint != "" {
+			l.start(ctx, key, edgeConfig, fingerprint)
+		}
+	}
+
+	for key, edge := range l.edges {
+		if !wanted[key] {
+			l.logger.Info("context no longer selected, stopping edge service", "context", edge.config.ContextName)
+			l.stop(key)
+		}
+	}
+}
+
+// start starts an edge service. The caller must hold mu.
+func (l *localEdges) start(ctx context.Context, key string, edgeConfig EdgeRuntimeConfig, fingerprint string) {
+	l.logger.Info("starting edge service", "context", edgeConfig.ContextName)
+	edgeCtx, cancel := context.WithCancel(ctx)
+	edgeSvc, err := startEdgeServiceFromRuntime(edgeCtx, edgeConfig, l.logs.edgeLogger(edgeConfig.ContextName), l.logger)
+	if err != nil {
+		l.logger.Error("failed to start edge service", "context", edgeConfig.ContextName, "error", err)
+		cancel()
+	}
+	l.edges[key] = &localEdge{config: edgeConfig, fingerprint: fingerprint, service: edgeSvc, cancel: cancel}
+}
+
+// stop stops an edge service. The caller must hold mu.
+func (l *localEdges) stop(key string) {
+	edge := l.edges[key]
+	delete(l.edges, key)
+	if edge.service != nil {
+		if err := edge.service.Stop(); err != nil {
+			l.logger.Error("error stopping edge service", "context", edge.config.ContextName, "error", err)
+		}
+	}
+	edge.cancel()
+}
+
+// running returns the number of edge services that started
+func (l *localEdges) running() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := 0
+	for _, edge := range l.edges {
+		if edge.service != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// stopAll stops every edge service
+func (l *localEdges) stopAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	keys := make([]string, 0, len(l.edges))
+	for key := range l.edges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	l.logger.Info("stopping edge services", "count", len(keys))
+	for _, key := range keys {
+		l.stop(key)
+	}
+}
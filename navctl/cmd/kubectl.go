@@ -0,0 +1,97 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/liamawhite/navigator/navctl/pkg/kubeconfig"
+)
+
+// kubectlFlagNames maps kubectl's flag names to the navctl flags of the local command
+var kubectlFlagNames = map[string]string{
+	"context":    "contexts",
+	"kubeconfig": "kube-config",
+}
+
+var (
+	// Kubeconfig context and file the kubectl plugin infers the default namespace of snapshots from
+	kubectlContext    string
+	kubectlKubeconfig string
+)
+
+// ExecuteKubectlPlugin runs navctl as the kubectl-navigator plugin, invoked as kubectl navigator. It
+// exposes the main commands with kubectl's conventions: local accepts --context and --kubeconfig, and the
+// namespace of a snapshot defaults to the namespace of the kubeconfig context.
+func ExecuteKubectlPlugin() error {
+	configureKubectlPlugin()
+	return rootCmd.Execute()
+}
+
+// configureKubectlPlugin turns the navctl command into the kubectl plugin
+func configureKubectlPlugin() {
+	rootCmd.Use = "kubectl-navigator"
+	rootCmd.Short = "Navigator kubectl plugin"
+	rootCmd.Long = `kubectl-navigator runs Navigator from kubectl, as kubectl navigator.
+It selects clusters and namespaces with kubectl's --context, --kubeconfig and -n flags.`
+	rootCmd.Annotations = map[string]string{cobra.CommandDisplayNameAnnotation: "kubectl navigator"}
+
+	pluginCommands := []*cobra.Command{localCmd, snapshotCmd, statusCmd, stopCmd, versionCmd, watchCmd}
+	for _, command := range rootCmd.Commands() {
+		if !slices.Contains(pluginCommands, command) {
+			rootCmd.RemoveCommand(command)
+		}
+	}
+
+	localCmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if navctlName, exists := kubectlFlagNames[name]; exists {
+			return pflag.NormalizedName(navctlName)
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	snapshotCmd.Flags().StringVar(&kubectlContext, "context", "", "Kubeconfig context whose namespace is the default --namespace (default: the current context)")
+	snapshotCmd.Flags().StringVar(&kubectlKubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default: KUBECONFIG or ~/.kube/config)")
+	namespaceFlag := snapshotCmd.Flags().Lookup("namespace")
+	namespaceFlag.Usage = "Namespace of the service (default: namespace of the kubeconfig context)"
+	namespaceFlag.DefValue = ""
+	snapshotCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("namespace") {
+			return nil
+		}
+		namespace, err := kubectlNamespace()
+		if err != nil {
+			return err
+		}
+		snapshotNamespace = namespace
+		return nil
+	}
+}
+
+// kubectlNamespace returns the namespace kubectl would use for the selected kubeconfig context
+func kubectlNamespace() (string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubectlKubeconfig
+	config, err := rules.Load()
+	if err != nil {
+		return "", fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	return kubeconfig.Namespace(config, kubectlContext)
+}
@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
@@ -23,6 +24,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"syscall"
@@ -32,6 +34,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs/loki"
 	edgeConfig "github.com/liamawhite/navigator/edge/pkg/config"
 	"github.com/liamawhite/navigator/edge/pkg/interfaces"
 	"github.com/liamawhite/navigator/edge/pkg/kubernetes"
@@ -39,10 +43,14 @@ import (
 	"github.com/liamawhite/navigator/edge/pkg/metrics/prometheus"
 	"github.com/liamawhite/navigator/edge/pkg/proxy"
 	edgeService "github.com/liamawhite/navigator/edge/pkg/service"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	"github.com/liamawhite/navigator/edge/pkg/traces/factory"
 	managerConfig "github.com/liamawhite/navigator/manager/pkg/config"
 	"github.com/liamawhite/navigator/manager/pkg/connections"
+	"github.com/liamawhite/navigator/manager/pkg/replay"
 	managerServer "github.com/liamawhite/navigator/manager/pkg/server"
 	navctlConfig "github.com/liamawhite/navigator/navctl/pkg/config"
+	"github.com/liamawhite/navigator/navctl/pkg/daemon"
 	"github.com/liamawhite/navigator/navctl/pkg/ui"
 	"github.com/liamawhite/navigator/pkg/istio/proxy/client"
 	"github.com/liamawhite/navigator/pkg/logging"
@@ -51,23 +59,36 @@ import (
 var (
 	// Config file flag
 	configFile string
+	// Profile of the config file to use, empty for its default profile
+	configProfile string
 	// Demo mode flag
 	demoMode bool
+	// Support bundle replayed instead of connecting to clusters
+	fromSnapshot string
 
 	// Traditional CLI flags (used when no config file is specified)
-	kubeconfig     string
+	kubeconfigs    []string // Each may be a list of paths, like KUBECONFIG
 	contexts       []string
 	managerPort    int
 	managerHost    string
 	maxMessageSize int
 	disableUI      bool
 	uiPort         int
+	uiSocket       string
+	gatewaySocket  string
+	adminPort      int
 	noBrowser      bool
 	// Metrics flags (enabled is inferred from presence of endpoint)
 	metricsType       string
 	metricsEndpoint   string
 	metricsTimeout    int
 	metricsAuthBearer string
+	// Traces flags (enabled is inferred from presence of endpoint)
+	tracesType     string
+	tracesEndpoint string
+	// Access logs flags (enabled is inferred from presence of endpoint)
+	accessLogsEndpoint string
+	accessLogsFormat   string
 )
 
 // localCmd represents the local command
@@ -84,6 +105,12 @@ type LocalRuntime struct {
 	ManagerConfig *managerConfig.Config
 	UIConfig      *UIConfig
 	EdgeConfigs   []EdgeRuntimeConfig
+	// ReloadEdges prepares the edge configurations again after the kubeconfig changed, for edges
+	// selected by patterns of context names. Nil if the edges are fixed.
+	ReloadEdges func() ([]EdgeRuntimeConfig, error)
+	// LogFiles configures the rotating files logs are written to. Nil if logs are only written to
+	// standard output.
+	LogFiles *navctlConfig.LoggingConfig
 }
 
 // EdgeRuntimeConfig holds configuration for a single edge service
@@ -96,6 +123,7 @@ type EdgeRuntimeConfig struct {
 // UIConfig holds UI server configuration
 type UIConfig struct {
 	Port      int
+	Socket    string // Unix socket to listen on instead of the port
 	Disabled  bool
 	NoBrowser bool
 }
@@ -107,12 +135,20 @@ func runLocal(cmd *cobra.Command, args []string) error {
 	if demoMode && configFile != "" {
 		return fmt.Errorf("cannot use --demo and --config flags together")
 	}
+	if fromSnapshot != "" && (demoMode || configFile != "") {
+		return fmt.Errorf("cannot use --from-snapshot with --demo or --config")
+	}
+	if configProfile != "" && configFile == "" {
+		return fmt.Errorf("--profile requires --config")
+	}
 
 	// Prepare runtime configuration based on mode
 	var runtime *LocalRuntime
 	var err error
 
-	if demoMode || configFile != "" {
+	if fromSnapshot != "" {
+		runtime, err = prepareSnapshotRuntime(logger, logLevel, logFormat)
+	} else if demoMode || configFile != "" {
 		runtime, err = prepareConfigFileRuntime(logger, logLevel, logFormat)
 	} else {
 		runtime, err = prepareCLIRuntime(logger, logLevel, logFormat)
@@ -122,6 +158,17 @@ func runLocal(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The configuration was validated above, so problems are reported before detaching from the terminal
+	if detach && !daemon.Detached() {
+		return runDetached()
+	}
+	if noBrowser || daemon.Detached() {
+		runtime.UIConfig.NoBrowser = true
+	}
+	if daemon.Detached() {
+		defer daemon.Cleanup(pidFile)
+	}
+
 	// Run Navigator services with the prepared configuration
 	return runNavigatorServices(runtime)
 }
@@ -141,7 +188,7 @@ func prepareConfigFileRuntime(logger *slog.Logger, globalLogLevel, globalLogForm
 		logger.Info("loaded embedded demo configuration")
 	} else {
 		// Load configuration from file
-		configManager, err = navctlConfig.NewManager(configFile, logger)
+		configManager, err = navctlConfig.NewManager(configFile, configProfile, logger)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load configuration: %w", err)
 		}
@@ -156,6 +203,7 @@ func prepareConfigFileRuntime(logger *slog.Logger, globalLogLevel, globalLogForm
 
 	logger.Info("loaded Navigator configuration",
 		"config_file", configFile,
+		"profile", cmp.Or(configProfile, config.DefaultProfile),
 		"edge_count", len(config.Edges),
 		"manager_host", config.Manager.Host,
 		"manager_port", config.Manager.Port)
@@ -191,9 +239,7 @@ func prepareConfigFileRuntime(logger *slog.Logger, globalLogLevel, globalLogForm
 
 		// Use default kubeconfig if not specified
 		if kubeconfigPath == "" {
-			if home := homedir.HomeDir(); home != "" {
-				kubeconfigPath = filepath.Join(home, ".kube", "config")
-			}
+			kubeconfigPath = defaultKubeconfigPath()
 		}
 
 		contextName, err := configManager.GetEdgeKubeContext(i)
@@ -218,10 +264,12 @@ func prepareConfigFileRuntime(logger *slog.Logger, globalLogLevel, globalLogForm
 		ManagerConfig: managerCfg,
 		UIConfig: &UIConfig{
 			Port:      uiConfig.Port,
+			Socket:    uiConfig.Socket,
 			Disabled:  uiConfig.Disabled,
 			NoBrowser: uiConfig.NoBrowser,
 		},
 		EdgeConfigs: edgeConfigs,
+		LogFiles:    configManager.GetLoggingConfig(),
 	}, nil
 }
 
@@ -237,24 +285,91 @@ func prepareCLIRuntime(logger *slog.Logger, globalLogLevel, globalLogFormat stri
 		return nil, fmt.Errorf("context validation failed: %w", err)
 	}
 
-	// Get contexts to use
-	contextsToUse, err := getContextsToUse(logger)
+	edgeConfigs, err := prepareCLIEdgeConfigs(logger, globalLogLevel, globalLogFormat)
 	if err != nil {
-		return nil, fmt.Errorf("failed to determine contexts: %w", err)
+		return nil, err
 	}
 
 	logger.Info("loaded Navigator CLI configuration",
-		"kubeconfig", kubeconfig,
-		"contexts", contextsToUse,
+		"kubeconfig", kubeconfigPaths(),
+		"edge_count", len(edgeConfigs),
 		"manager_port", managerPort,
 		"manager_host", managerHost)
 
 	// Prepare manager configuration
 	managerCfg := &managerConfig.Config{
-		Port:           managerPort,
-		MaxMessageSize: maxMessageSize,
-		LogLevel:       globalLogLevel,
-		LogFormat:      globalLogFormat,
+		Port:                  managerPort,
+		MaxMessageSize:        maxMessageSize,
+		HTTPSocket:            gatewaySocket,
+		AdminPort:             adminPort,
+		StaleClusterRetention: managerConfig.DefaultStaleClusterRetention,
+		LogLevel:              globalLogLevel,
+		LogFormat:             globalLogFormat,
+	}
+
+	runtime := &LocalRuntime{
+		Logger:        logger,
+		ManagerConfig: managerCfg,
+		UIConfig: &UIConfig{
+			Port:      uiPort,
+			Socket:    uiSocket,
+			Disabled:  disableUI,
+			NoBrowser: noBrowser,
+		},
+		EdgeConfigs: edgeConfigs,
+	}
+
+	// Contexts selected by --contexts are selected again when the kubeconfig changes. Without it, the
+	// edge keeps the context that was current at startup rather than following use-context.
+	if len(contexts) > 0 {
+		runtime.ReloadEdges = func() ([]EdgeRuntimeConfig, error) {
+			return prepareCLIEdgeConfigs(logger, globalLogLevel, globalLogFormat)
+		}
+	}
+	return runtime, nil
+}
+
+// prepareSnapshotRuntime prepares LocalRuntime to replay a support bundle without any edges, so that no
+// cluster access is needed
+func prepareSnapshotRuntime(logger *slog.Logger, globalLogLevel, globalLogFormat string) (*LocalRuntime, error) {
+	// The manager loads the bundle again when it starts, it is read here to report problems up front
+	bundle, err := replay.Load(fromSnapshot)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot validation failed: %w", err)
+	}
+
+	logger.Info("loaded Navigator snapshot",
+		"snapshot", fromSnapshot,
+		"service", bundle.Service.Service.Id,
+		"captured_at", bundle.CreatedAt,
+		"manager_port", managerPort)
+
+	return &LocalRuntime{
+		Logger: logger,
+		ManagerConfig: &managerConfig.Config{
+			Port:           managerPort,
+			MaxMessageSize: maxMessageSize,
+			HTTPSocket:     gatewaySocket,
+			AdminPort:      adminPort,
+			LogLevel:       globalLogLevel,
+			LogFormat:      globalLogFormat,
+			ReplaySnapshot: fromSnapshot,
+		},
+		UIConfig: &UIConfig{
+			Port:      uiPort,
+			Socket:    uiSocket,
+			Disabled:  disableUI,
+			NoBrowser: noBrowser,
+		},
+	}, nil
+}
+
+// prepareCLIEdgeConfigs prepares an edge configuration for each context selected by the CLI flags
+func prepareCLIEdgeConfigs(logger *slog.Logger, globalLogLevel, globalLogFormat string) ([]EdgeRuntimeConfig, error) {
+	// Get contexts to use
+	contextsToUse, err := getContextsToUse(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine contexts: %w", err)
 	}
 
 	// Prepare edge configurations for each context
@@ -263,6 +378,7 @@ func prepareCLIRuntime(logger *slog.Logger, globalLogLevel, globalLogFormat stri
 		edgeConfig := &edgeConfig.Config{
 			ManagerEndpoint: fmt.Sprintf("%s:%d", managerHost, managerPort),
 			SyncInterval:    30,
+			SyncJitter:      0.1,
 			LogLevel:        globalLogLevel,
 			LogFormat:       globalLogFormat,
 			MaxMessageSize:  maxMessageSize,
@@ -279,27 +395,40 @@ func prepareCLIRuntime(logger *slog.Logger, globalLogLevel, globalLogFormat stri
 			edgeConfig.MetricsConfig.Timeout = 10       // Default timeout
 		}
 
+		// Add traces configuration if endpoint provided
+		if tracesEndpoint != "" {
+			edgeConfig.TracesConfig = traces.Config{
+				Type:     traces.ProviderType(tracesType),
+				Endpoint: tracesEndpoint,
+				Timeout:  10, // Default timeout
+			}
+		}
+
+		// Add access logs configuration if endpoint provided
+		if accessLogsEndpoint != "" {
+			edgeConfig.AccessLogsConfig = accesslogs.Config{
+				Type:     accesslogs.ProviderTypeLoki,
+				Endpoint: accessLogsEndpoint,
+				Format:   accesslogs.Format(accessLogsFormat),
+			}
+		}
+
 		edgeConfigs = append(edgeConfigs, EdgeRuntimeConfig{
-			KubeconfigPath: kubeconfig,
+			KubeconfigPath: kubeconfigPaths(),
 			ContextName:    contextName,
 			EdgeConfig:     edgeConfig,
 		})
 	}
 
-	return &LocalRuntime{
-		Logger:        logger,
-		ManagerConfig: managerCfg,
-		UIConfig: &UIConfig{
-			Port:      uiPort,
-			Disabled:  disableUI,
-			NoBrowser: noBrowser,
-		},
-		EdgeConfigs: edgeConfigs,
-	}, nil
+	return edgeConfigs, nil
 }
 
 // runNavigatorServices runs all Navigator services using the provided runtime configuration
 func runNavigatorServices(runtime *LocalRuntime) error {
+	// Write logs to rotating files as well, if configured. The logger is created again to write to them.
+	logs := newLocalLogs(runtime.LogFiles)
+	defer logs.close()
+	runtime.Logger = logging.For("navctl-local")
 	logger := runtime.Logger
 
 	// Setup context for graceful shutdown
@@ -307,7 +436,7 @@ func runNavigatorServices(runtime *LocalRuntime) error {
 	defer cancel()
 
 	// Start manager service
-	managerSvc, err := startManagerServiceWithConfig(ctx, runtime.ManagerConfig, logger)
+	managerSvc, err := startManagerServiceWithConfig(ctx, runtime.ManagerConfig, logs.logger("manager"), logger)
 	if err != nil {
 		return fmt.Errorf("failed to start manager service: %w", err)
 	}
@@ -321,37 +450,31 @@ func runNavigatorServices(runtime *LocalRuntime) error {
 	// Wait a moment for manager to start
 	time.Sleep(2 * time.Second)
 
-	// Start edge services
-	var edgeServices []*edgeService.EdgeService
-	for _, edgeConfig := range runtime.EdgeConfigs {
-		logger.Info("starting edge service", "context", edgeConfig.ContextName)
-		edgeSvc, err := startEdgeServiceFromRuntime(ctx, edgeConfig, logger)
-		if err != nil {
-			logger.Error("failed to start edge service", "context", edgeConfig.ContextName, "error", err)
-			// Continue with other edges instead of failing completely
-			continue
-		}
-		edgeServices = append(edgeServices, edgeSvc)
-	}
+	// Start edge services, continuing with the other edges if some fail
+	edges := newLocalEdges(logs, logger)
+	edges.reconcile(ctx, runtime.EdgeConfigs)
+	defer edges.stopAll()
 
-	if len(edgeServices) == 0 {
+	// Replayed snapshots are served without edges
+	if edges.running() == 0 && runtime.ManagerConfig.ReplaySnapshot == "" {
 		return fmt.Errorf("no edge services could be started")
 	}
 
-	// Setup cleanup for all edge services
+	// Start, stop and restart only the edges affected by changes to the kubeconfig
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		watchKubeconfig(ctx, runtime, edges)
+	}()
 	defer func() {
-		logger.Info("stopping edge services", "count", len(edgeServices))
-		for i, edgeSvc := range edgeServices {
-			if err := edgeSvc.Stop(); err != nil {
-				logger.Error("error stopping edge service", "service_index", i, "error", err)
-			}
-		}
+		cancel()
+		<-watchDone
 	}()
 
 	// Start UI server unless disabled
 	var uiSvc *ui.Server
 	if !runtime.UIConfig.Disabled {
-		uiSvc, err = startUIServerFromRuntime(ctx, runtime.UIConfig, runtime.ManagerConfig.Port, logger)
+		uiSvc, err = startUIServerFromRuntime(ctx, runtime.UIConfig, runtime.ManagerConfig, logs.logger("ui"), logger)
 		if err != nil {
 			return fmt.Errorf("failed to start UI server: %w", err)
 		}
@@ -369,10 +492,17 @@ func runNavigatorServices(runtime *LocalRuntime) error {
 
 	logger.Info("Navigator services started successfully")
 	logger.Info("manager gRPC server listening", "port", runtime.ManagerConfig.Port)
-	logger.Info("manager HTTP gateway listening", "port", runtime.ManagerConfig.Port+1)
-	logger.Info("edge services running", "count", len(edgeServices))
+	if runtime.ManagerConfig.HTTPSocket != "" {
+		logger.Info("manager HTTP gateway listening", "socket", runtime.ManagerConfig.HTTPSocket)
+	} else {
+		logger.Info("manager HTTP gateway listening", "port", runtime.ManagerConfig.Port+1)
+	}
+	logger.Info("edge services running", "count", edges.running())
 
-	if !runtime.UIConfig.Disabled {
+	if !runtime.UIConfig.Disabled && runtime.UIConfig.Socket != "" {
+		// A browser can't open a socket, the reverse proxy in front of it serves the UI
+		logger.Info("UI server listening", "socket", runtime.UIConfig.Socket)
+	} else if !runtime.UIConfig.Disabled {
 		logger.Info("UI server listening", "port", runtime.UIConfig.Port)
 		if !runtime.UIConfig.NoBrowser {
 			// Open browser after a short delay
@@ -402,10 +532,13 @@ func runNavigatorServices(runtime *LocalRuntime) error {
 	return nil
 }
 
-// startEdgeServiceFromRuntime starts an edge service using EdgeRuntimeConfig
-func startEdgeServiceFromRuntime(ctx context.Context, edgeConfig EdgeRuntimeConfig, logger *slog.Logger) (*edgeService.EdgeService, error) {
+// startEdgeServiceFromRuntime starts an edge service using EdgeRuntimeConfig. The edge's components log
+// with edgeLogger as their base logger.
+func startEdgeServiceFromRuntime(ctx context.Context, edgeConfig EdgeRuntimeConfig, edgeLogger, logger *slog.Logger) (*edgeService.EdgeService, error) {
+	serverLogger := edgeLogger.With("component", string(logging.ComponentServer))
+
 	// Create Kubernetes client with specific context
-	k8sLogger := logging.For(logging.ComponentServer).With("context", edgeConfig.ContextName, "component", "k8s")
+	k8sLogger := serverLogger.With("context", edgeConfig.ContextName, "component", "k8s")
 	k8sClient, err := kubernetes.NewClientWithContext(edgeConfig.KubeconfigPath, edgeConfig.ContextName, k8sLogger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kubernetes client for context '%s': %w", edgeConfig.ContextName, err)
@@ -419,31 +552,99 @@ func startEdgeServiceFromRuntime(ctx context.Context, edgeConfig EdgeRuntimeConf
 
 	logger.Info("discovered cluster name from Istio", "cluster_name", clusterName, "context", edgeConfig.ContextName)
 
+	// Leave out the parts of the cluster state that disabled collection modules cover
+	k8sClient.SetCollectionExclusions(edgeConfig.EdgeConfig.GetCollectionExclusions())
+
 	// Create admin client for proxy configuration access
 	adminClient := client.NewAdminClient(k8sClient.GetClientset(), k8sClient.GetRestConfig())
 
 	// Create proxy service
-	proxyLogger := logging.For(logging.ComponentServer).With("cluster", clusterName, "component", "proxy")
+	proxyLogger := serverLogger.With("cluster", clusterName, "component", "proxy")
 	proxyService := proxy.NewProxyService(adminClient, proxyLogger)
 
 	// Create metrics provider
-	metricsLogger := logging.For(logging.ComponentServer).With("cluster", clusterName, "component", "metrics")
+	metricsLogger := serverLogger.With("cluster", clusterName, "component", "metrics")
 	var metricsProvider interfaces.MetricsProvider
 	metricsConfig := edgeConfig.EdgeConfig.GetMetricsConfig()
 
-	if metricsConfig.Enabled && metricsConfig.Type == metrics.ProviderTypePrometheus {
+	if metricsConfig.Enabled && metricsConfig.Type == metrics.ProviderTypePrometheus && metricsConfig.Endpoint == "" {
+		// Use a well-known Prometheus installation of the cluster when no endpoint is configured
+		endpoint, installation, err := k8sClient.DiscoverPrometheus(ctx)
+		if err != nil {
+			metricsLogger.Warn("failed to discover prometheus", "error", err)
+		} else if endpoint == "" {
+			metricsLogger.Warn("no metrics endpoint configured and no prometheus found in the cluster, metrics are disabled")
+		} else {
+			metricsLogger.Info("discovered prometheus in the cluster", "installation", installation, "endpoint", endpoint)
+		}
+		metricsConfig.Endpoint = endpoint
+	}
+
+	if metricsConfig.Enabled && metricsConfig.Type == metrics.ProviderTypePrometheus && metricsConfig.Endpoint != "" {
+		// Reach in-cluster metrics endpoints, e.g. prometheus.istio-system:9090, through a port-forward
+		metricsConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(ctx, metricsConfig.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to forward metrics endpoint for cluster '%s': %w", clusterName, err)
+		}
+		if metricsConfig.SecondaryEndpoint != "" {
+			metricsConfig.SecondaryEndpoint, err = k8sClient.ForwardServiceEndpoint(ctx, metricsConfig.SecondaryEndpoint)
+			if err != nil {
+				return nil, fmt.Errorf("failed to forward secondary metrics endpoint for cluster '%s': %w", clusterName, err)
+			}
+		}
+
 		metricsProvider, err = prometheus.Create(metricsConfig, metricsLogger, clusterName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create metrics provider for cluster '%s': %w", clusterName, err)
 		}
 	}
 
+	// Create traces provider
+	var tracesProvider interfaces.TracesProvider
+	tracesConfig := edgeConfig.EdgeConfig.GetTracesConfig()
+	if tracesConfig.Enabled() {
+		// Reach in-cluster tracing backends, e.g. tracing.istio-system:80, through a port-forward
+		tracesConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(ctx, tracesConfig.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to forward traces endpoint for cluster '%s': %w", clusterName, err)
+		}
+
+		tracesLogger := serverLogger.With("cluster", clusterName, "component", "traces")
+		tracesProvider, err = factory.Create(tracesConfig, tracesLogger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create traces provider for cluster '%s': %w", clusterName, err)
+		}
+	}
+
+	// Create access logs provider
+	var accessLogsProvider interfaces.AccessLogsProvider
+	accessLogsConfig := edgeConfig.EdgeConfig.GetAccessLogsConfig()
+	if accessLogsConfig.Enabled() {
+		// Reach in-cluster logs backends, e.g. loki.monitoring:3100, through a port-forward
+		accessLogsConfig.Endpoint, err = k8sClient.ForwardServiceEndpoint(ctx, accessLogsConfig.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to forward access logs endpoint for cluster '%s': %w", clusterName, err)
+		}
+
+		accessLogsLogger := serverLogger.With("cluster", clusterName, "component", "access-logs")
+		accessLogsProvider, err = loki.NewProvider(accessLogsConfig, accessLogsLogger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create access logs provider for cluster '%s': %w", clusterName, err)
+		}
+	}
+
 	// Create edge service
-	edgeLogger := logging.For(logging.ComponentServer).With("cluster", clusterName, "component", "edge")
-	edgeSvc, err := edgeService.NewEdgeService(edgeConfig.EdgeConfig, k8sClient, proxyService, metricsProvider, edgeLogger)
+	edgeSvcLogger := serverLogger.With("cluster", clusterName, "component", "edge")
+	edgeSvc, err := edgeService.NewEdgeService(edgeConfig.EdgeConfig, k8sClient, proxyService, metricsProvider, edgeSvcLogger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create edge service for cluster '%s': %w", clusterName, err)
 	}
+	if tracesProvider != nil {
+		edgeSvc.SetTracesProvider(tracesProvider)
+	}
+	if accessLogsProvider != nil {
+		edgeSvc.SetAccessLogsProvider(accessLogsProvider)
+	}
 
 	// Start edge service in goroutine
 	go func() {
@@ -455,10 +656,14 @@ func startEdgeServiceFromRuntime(ctx context.Context, edgeConfig EdgeRuntimeConf
 	return edgeSvc, nil
 }
 
-// startUIServerFromRuntime starts a UI server using UIConfig
-func startUIServerFromRuntime(ctx context.Context, uiConfig *UIConfig, managerPort int, logger *slog.Logger) (*ui.Server, error) {
+// startUIServerFromRuntime starts a UI server using UIConfig, logging with uiLogger as its base logger
+func startUIServerFromRuntime(ctx context.Context, uiConfig *UIConfig, managerCfg *managerConfig.Config, uiLogger, logger *slog.Logger) (*ui.Server, error) {
 	// Create UI server
-	uiSvc, err := ui.NewServer(uiConfig.Port, managerPort+1) // HTTP gateway port
+	uiSvc, err := ui.NewServer(
+		ui.Address{Port: uiConfig.Port, Socket: uiConfig.Socket},
+		ui.Address{Port: managerCfg.Port + 1, Socket: managerCfg.HTTPSocket}, // HTTP gateway
+		uiLogger.With("component", "navctl-ui"),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create UI server: %w", err)
 	}
@@ -491,17 +696,43 @@ func openBrowser(url string) error {
 	return cmd.Start()
 }
 
+// defaultKubeconfigPath returns the KUBECONFIG path list if set, or the default kubeconfig location
+func defaultKubeconfigPath() string {
+	if paths := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); paths != "" {
+		return paths
+	}
+	if home := homedir.HomeDir(); home != "" {
+		return filepath.Join(home, ".kube", "config")
+	}
+	return ""
+}
+
+// kubeconfigPaths joins the --kube-config flags into a single path list, merged like KUBECONFIG
+func kubeconfigPaths() string {
+	return strings.Join(kubeconfigs, string(filepath.ListSeparator))
+}
+
 func validateKubeconfig() error {
-	if kubeconfig == "" {
+	paths := slices.DeleteFunc(filepath.SplitList(kubeconfigPaths()), func(path string) bool { return path == "" })
+	if len(paths) == 0 {
 		return fmt.Errorf("kubeconfig path is required")
 	}
 
-	// Check if file exists
-	if _, err := os.Stat(kubeconfig); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("kubeconfig file does not exist: %s", kubeconfig)
+	// Check if the files exist. Like KUBECONFIG, a list only needs one of its files to exist.
+	var missing []string
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("cannot access kubeconfig file: %w", err)
+			}
+			missing = append(missing, path)
 		}
-		return fmt.Errorf("cannot access kubeconfig file: %w", err)
+	}
+	if len(missing) == len(paths) {
+		if len(paths) == 1 {
+			return fmt.Errorf("kubeconfig file does not exist: %s", paths[0])
+		}
+		return fmt.Errorf("none of the kubeconfig files exist: %s", strings.Join(paths, ", "))
 	}
 
 	return nil
@@ -525,7 +756,7 @@ func validateContexts(logger *slog.Logger) error {
 	}
 
 	// Load the kubeconfig
-	config, err := clientcmd.LoadFromFile(kubeconfig)
+	config, err := kubernetes.KubeconfigLoadingRules(kubeconfigPaths()).Load()
 	if err != nil {
 		return fmt.Errorf("failed to load kubeconfig: %w", err)
 	}
@@ -553,7 +784,7 @@ func getContextsToUse(logger *slog.Logger) ([]string, error) {
 	}
 
 	// Load the kubeconfig to get current context
-	config, err := clientcmd.LoadFromFile(kubeconfig)
+	config, err := kubernetes.KubeconfigLoadingRules(kubeconfigPaths()).Load()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
 	}
@@ -565,9 +796,9 @@ func getContextsToUse(logger *slog.Logger) ([]string, error) {
 	return []string{config.CurrentContext}, nil
 }
 
-// getAvailableContexts returns all available contexts from kubeconfig
+// getAvailableContexts returns all available contexts from kubeconfig, merging the files of a path list
 func getAvailableContexts(kubeconfigPath string) ([]string, string, error) {
-	config, err := clientcmd.LoadFromFile(kubeconfigPath)
+	config, err := kubernetes.KubeconfigLoadingRules(kubeconfigPath).Load()
 	if err != nil {
 		return nil, "", err
 	}
@@ -595,7 +826,7 @@ func expandContextPatterns(patterns []string, logger *slog.Logger) ([]string, er
 	}
 
 	// Load available contexts
-	availableContexts, _, err := getAvailableContexts(kubeconfig)
+	availableContexts, _, err := getAvailableContexts(kubeconfigPaths())
 	if err != nil {
 		return nil, fmt.Errorf("failed to load contexts from kubeconfig: %w", err)
 	}
@@ -676,6 +907,10 @@ The --contexts flag supports both exact context names and glob patterns:
   [abc] - matches any character in brackets
   [a-z] - matches any character in range
 
+The kubeconfig is watched while Navigator runs. Edges are started for contexts
+added that match --contexts and stopped for contexts removed, and an edge is
+restarted when the cluster or credentials of its context change.
+
 Examples:
   # Use current context
   navctl local
@@ -693,7 +928,13 @@ Examples:
   navctl local --contexts "production,*-staging"
 
   # Use custom kubeconfig with patterns
-  navctl local --kube-config ~/.kube/config --contexts "*-prod"`
+  navctl local --kube-config ~/.kube/config --contexts "*-prod"
+
+  # Merge split kubeconfig files, like a KUBECONFIG path list
+  navctl local --kube-config ~/.kube/prod --kube-config ~/.kube/staging --contexts "*"
+
+  # Replay a support bundle captured with navctl snapshot, without cluster access
+  navctl local --from-snapshot navigator-snapshot-bookinfo-reviews.tar.gz`
 
 	// Try to get available contexts
 	availableContexts, currentContext, err := getAvailableContexts(kubeconfigPath)
@@ -716,13 +957,24 @@ Examples:
 	return baseHelp
 }
 
-// startManagerServiceWithConfig starts the manager service using configuration
-func startManagerServiceWithConfig(ctx context.Context, cfg *managerConfig.Config, logger *slog.Logger) (*managerServer.ManagerServer, error) {
+// startManagerServiceWithConfig starts the manager service using configuration, logging with managerLogger as
+// its base logger
+func startManagerServiceWithConfig(ctx context.Context, cfg *managerConfig.Config, managerLogger, logger *slog.Logger) (*managerServer.ManagerServer, error) {
+	managerLogger = managerLogger.With("component", "manager")
+
 	// Create connections manager
-	connectionManager := connections.NewManager(logging.For("manager"))
+	connectionManager := connections.NewManager(managerLogger)
+	connectionManager.SetEvictionPolicy(connections.EvictionPolicy{
+		StaleRetention: cfg.GetStaleClusterRetention(),
+		MaxStaleness:   cfg.GetMaxClusterStaleness(),
+		Webhook:        cfg.EvictionWebhook,
+	})
+	if err := connectionManager.SetEventLogPolicy(cfg.GetEventLogPolicy()); err != nil {
+		return nil, fmt.Errorf("failed to set up event log: %w", err)
+	}
 
 	// Create manager server
-	managerSvc, err := managerServer.NewManagerServer(cfg, connectionManager, logging.For("manager"))
+	managerSvc, err := managerServer.NewManagerServer(cfg, connectionManager, managerLogger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create manager server: %w", err)
 	}
@@ -743,25 +995,27 @@ func startManagerServiceWithConfig(ctx context.Context, cfg *managerConfig.Confi
 
 func init() {
 	// Default kubeconfig path
-	defaultKubeconfig := ""
-	if home := homedir.HomeDir(); home != "" {
-		defaultKubeconfig = filepath.Join(home, ".kube", "config")
-	}
+	defaultKubeconfig := defaultKubeconfigPath()
 
 	// Set initial help text with available contexts
 	localCmd.Long = generateHelpText(defaultKubeconfig)
 
 	// Command flags
 	localCmd.Flags().StringVarP(&configFile, "config", "c", "", "Path to navctl configuration file (YAML or JSON)")
+	localCmd.Flags().StringVar(&configProfile, "profile", "", "Profile of the configuration file to use (default: its defaultProfile)")
 	localCmd.Flags().BoolVar(&demoMode, "demo", false, "Use embedded demo configuration for navigator-demo clusters")
-	localCmd.Flags().StringVarP(&kubeconfig, "kube-config", "k", defaultKubeconfig, "Path to kubeconfig file (CLI mode only)")
+	localCmd.Flags().StringVar(&fromSnapshot, "from-snapshot", "", "Replay a support bundle captured with navctl snapshot instead of connecting to clusters")
+	localCmd.Flags().StringArrayVarP(&kubeconfigs, "kube-config", "k", []string{defaultKubeconfig}, "Path to kubeconfig file or KUBECONFIG-style path list, repeat to merge files (CLI mode only)")
 	localCmd.Flags().StringSliceVar(&contexts, "contexts", nil, "Comma-separated list of kubeconfig contexts to use (CLI mode only)")
 	localCmd.Flags().IntVar(&managerPort, "manager-port", 8080, "Port for manager service (CLI mode only)")
 	localCmd.Flags().StringVar(&managerHost, "manager-host", "localhost", "Host for manager service (CLI mode only)")
 	localCmd.Flags().IntVar(&maxMessageSize, "max-message-size", 10, "Maximum gRPC message size in MB (CLI mode only)")
 	localCmd.Flags().BoolVar(&disableUI, "disable-ui", false, "Disable UI server (CLI mode only)")
 	localCmd.Flags().IntVar(&uiPort, "ui-port", 8082, "Port for UI server (CLI mode only)")
-	localCmd.Flags().BoolVar(&noBrowser, "no-browser", false, "Don't open browser automatically (CLI mode only)")
+	localCmd.Flags().StringVar(&uiSocket, "ui-socket", "", "Unix socket path for UI server instead of --ui-port (CLI mode only)")
+	localCmd.Flags().StringVar(&gatewaySocket, "gateway-socket", "", "Unix socket path for manager HTTP gateway instead of the port after --manager-port (CLI mode only)")
+	localCmd.Flags().IntVar(&adminPort, "admin-port", 0, "Port for the manager admin server serving the log level and metrics endpoints, on localhost only (0 disables it, CLI mode only)")
+	localCmd.Flags().BoolVar(&noBrowser, "no-browser", false, "Don't open browser automatically")
 
 	// Metrics flags (CLI mode only)
 	localCmd.Flags().StringVar(&metricsType, "metrics-type", "prometheus", "Metrics provider type (CLI mode only)")
@@ -769,5 +1023,13 @@ func init() {
 	localCmd.Flags().IntVar(&metricsTimeout, "metrics-timeout", 10, "Metrics query timeout in seconds (CLI mode only)")
 	localCmd.Flags().StringVar(&metricsAuthBearer, "metrics-auth-bearer", "", "Bearer token for metrics provider authentication (CLI mode only)")
 
+	// Traces flags (CLI mode only)
+	localCmd.Flags().StringVar(&tracesType, "traces-type", "jaeger", "Tracing backend type: jaeger or tempo (CLI mode only)")
+	localCmd.Flags().StringVar(&tracesEndpoint, "traces-endpoint", "", "Tracing backend query endpoint (CLI mode only)")
+
+	// Access logs flags (CLI mode only)
+	localCmd.Flags().StringVar(&accessLogsEndpoint, "access-logs-endpoint", "", "Loki endpoint for searching proxy access logs (CLI mode only)")
+	localCmd.Flags().StringVar(&accessLogsFormat, "access-logs-format", "text", "Encoding of the proxies' access logs: text or json (CLI mode only)")
+
 	// kube-config is optional with default value
 }
@@ -0,0 +1,88 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"log/slog"
+	"regexp"
+	"sync"
+
+	navctlConfig "github.com/liamawhite/navigator/navctl/pkg/config"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// unsafeFileNameChars matches the characters of context names that are replaced in log file names
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// localLogs writes the logs of a navctl local session to rotating files, as well as to standard output:
+// every record to local.log, and the records of each component to its own file too
+type localLogs struct {
+	config *navctlConfig.LoggingConfig // nil if logs are only written to standard output
+
+	mu      sync.Mutex
+	sinks   []*logging.FileSink
+	loggers map[string]*slog.Logger // file name -> logger writing to it
+}
+
+// newLocalLogs sets up the log files of a session and makes the default logger write to local.log
+func newLocalLogs(config *navctlConfig.LoggingConfig) *localLogs {
+	l := &localLogs{config: config, loggers: make(map[string]*slog.Logger)}
+	if config != nil {
+		slog.SetDefault(l.withFile(slog.Default(), "local"))
+	}
+	return l
+}
+
+// logger returns the base logger of a component, writing to the log file with the given name. Edges that
+// are restarted write to the same file again.
+func (l *localLogs) logger(name string) *slog.Logger {
+	if l.config == nil {
+		return slog.Default()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if logger, exists := l.loggers[name]; exists {
+		return logger
+	}
+	logger := l.withFile(slog.Default(), name)
+	l.loggers[name] = logger
+	return logger
+}
+
+// edgeLogger returns the base logger of the edge of a kubeconfig context
+func (l *localLogs) edgeLogger(contextName string) *slog.Logger {
+	if contextName == "" {
+		return l.logger("edge")
+	}
+	return l.logger("edge-" + unsafeFileNameChars.ReplaceAllString(contextName, "_"))
+}
+
+// close closes the log files
+func (l *localLogs) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, sink := range l.sinks {
+		_ = sink.Close()
+	}
+	l.sinks = nil
+}
+
+// withFile returns a logger writing to the log file with the given name as well as to logger
+func (l *localLogs) withFile(logger *slog.Logger, name string) *slog.Logger {
+	sink := logging.NewFileSink(l.config.FileConfig(name))
+	l.sinks = append(l.sinks, sink)
+	return logging.WithWriter(logger, sink, l.config.Format)
+}
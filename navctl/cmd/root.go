@@ -65,4 +65,10 @@ func init() {
 	rootCmd.AddCommand(localCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(demoCmd)
+	rootCmd.AddCommand(discoverCmd)
+	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(stopCmd)
+	rootCmd.AddCommand(serviceCmd)
+	rootCmd.AddCommand(snapshotCmd)
+	rootCmd.AddCommand(watchCmd)
 }
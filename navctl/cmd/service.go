@@ -0,0 +1,180 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+
+	navctlConfig "github.com/liamawhite/navigator/navctl/pkg/config"
+	"github.com/liamawhite/navigator/navctl/pkg/service"
+)
+
+var (
+	serviceName    string
+	serviceManager string
+	serviceSystem  bool
+	serviceOutput  string
+	serviceForce   bool
+)
+
+// serviceCmd represents the service command
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Manage navctl as a persistent system service",
+}
+
+// serviceInstallCmd represents the service install command
+var serviceInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install a systemd or launchd service that runs navctl local",
+	Long: `Write a service definition that runs navctl local --config with the given configuration
+file, so Navigator keeps running on a shared machine and is restarted when it fails.
+
+On Linux a systemd unit is written, for the current user by default or system-wide with
+--system. On macOS a launchd property list is written, as a LaunchAgent by default or a
+LaunchDaemon with --system. The service runs with the current PATH and KUBECONFIG, so
+that kubeconfig exec credential plugins such as aws or gke-gcloud-auth-plugin are found.
+
+Examples:
+  # Install a user service and start it
+  navctl service install --config navctl-config.yaml
+  systemctl --user enable --now navctl
+
+  # Install a system-wide service running as the current user
+  sudo navctl service install --config /etc/navigator/navctl-config.yaml --system
+
+  # Print the launchd property list without installing it
+  navctl service install --config navctl-config.yaml --manager launchd --output -`,
+	SilenceUsage: true,
+	RunE:         runServiceInstall,
+}
+
+func runServiceInstall(cmd *cobra.Command, args []string) error {
+	if configFile == "" {
+		return fmt.Errorf("--config is required")
+	}
+	configPath, err := filepath.Abs(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to resolve config path: %w", err)
+	}
+	// Fail now rather than in a restart loop of the service
+	if _, err := navctlConfig.LoadConfig(configPath, configProfile); err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	manager, err := service.ForOS(runtime.GOOS)
+	if serviceManager != "" {
+		manager, err = service.ParseManager(serviceManager)
+	}
+	if err != nil {
+		return err
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find navctl executable: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+
+	command := []string{executable, "local", "--config", configPath, "--no-browser"}
+	if configProfile != "" {
+		command = append(command, "--profile", configProfile)
+	}
+	for _, name := range []string{"log-level", "log-format"} {
+		if flag := cmd.Flag(name); flag.Changed {
+			command = append(command, "--"+name, flag.Value.String())
+		}
+	}
+
+	unit := service.Unit{
+		Name:        serviceName,
+		Description: "Navigator local control plane",
+		Command:     command,
+		Environment: map[string]string{},
+		System:      serviceSystem,
+		LogFile:     defaultDaemonPath(serviceName + ".log"),
+	}
+	for _, key := range []string{"PATH", "KUBECONFIG"} {
+		if value := os.Getenv(key); value != "" {
+			unit.Environment[key] = value
+		}
+	}
+	if serviceSystem {
+		unit.LogFile = filepath.Join("/var/log", serviceName+".log")
+
+		// Run as the user the kubeconfig and credentials belong to, even when installed with sudo
+		unit.User = os.Getenv("SUDO_USER")
+		if current, err := user.Current(); err == nil && unit.User == "" {
+			unit.User = current.Username
+		}
+	}
+
+	data, err := manager.Render(unit)
+	if err != nil {
+		return err
+	}
+	if serviceOutput == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	path := serviceOutput
+	if path == "" {
+		path = manager.Path(unit, homedir.HomeDir())
+	}
+	if _, err := os.Stat(path); err == nil && !serviceForce {
+		return fmt.Errorf("service file already exists: %s (use --force to overwrite)", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create service directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write service file: %w", err)
+	}
+	if manager == service.Launchd {
+		// launchd does not create the directory of the log file
+		if err := os.MkdirAll(filepath.Dir(unit.LogFile), 0o755); err != nil {
+			return fmt.Errorf("failed to create log directory: %w", err)
+		}
+	}
+
+	fmt.Printf("Wrote %s service to %s\n", manager, path)
+	fmt.Printf("Start it with:\n")
+	for _, instruction := range manager.Instructions(unit, path) {
+		fmt.Printf("  %s\n", instruction)
+	}
+	return nil
+}
+
+func init() {
+	serviceInstallCmd.Flags().StringVarP(&configFile, "config", "c", "", "Path to navctl configuration file (YAML or JSON) the service runs with")
+	serviceInstallCmd.Flags().StringVar(&configProfile, "profile", "", "Profile of the configuration file the service runs with (default: its defaultProfile)")
+	serviceInstallCmd.Flags().StringVar(&serviceName, "name", "navctl", "Name of the service")
+	serviceInstallCmd.Flags().StringVar(&serviceManager, "manager", "", "Service manager to generate for (systemd, launchd), defaults to the one of this OS")
+	serviceInstallCmd.Flags().BoolVar(&serviceSystem, "system", false, "Install a system-wide service instead of a service of the current user")
+	serviceInstallCmd.Flags().StringVarP(&serviceOutput, "output", "o", "", "Path to write the service file to instead of the default location, - for stdout")
+	serviceInstallCmd.Flags().BoolVar(&serviceForce, "force", false, "Overwrite an existing service file")
+
+	serviceCmd.AddCommand(serviceInstallCmd)
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/liamawhite/navigator/navctl/pkg/snapshot"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+var (
+	snapshotService         string
+	snapshotNamespace       string
+	snapshotManagerEndpoint string
+	snapshotOutput          string
+	snapshotMetricsWindow   time.Duration
+	snapshotLogFiles        []string
+	snapshotLogLines        int
+	snapshotRedactIPs       bool
+	snapshotRedactPatterns  []string
+	snapshotMaxMessageSize  int
+	snapshotTimeout         time.Duration
+)
+
+// snapshotCmd represents the snapshot command
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Capture a support bundle of a service",
+	Long: `Capture a support bundle of a service from a running Navigator, for sharing with vendors
+or attaching to incident reviews.
+
+The bundle is a gzipped tar archive holding the aggregated state of the service and of the
+connected clusters, the Istio resources applying to each instance, the proxy configuration
+of each instance, the service's recent metrics and the last lines of the navctl logs. Parts
+that cannot be captured, such as metrics of clusters without a metrics provider, are listed
+in the bundle's manifest.json.
+
+Redact IP addresses and any other values matching regular expressions before sharing the
+bundle. Each redacted value is replaced with the same placeholder in every file.
+
+Examples:
+  # Capture the reviews service of the bookinfo namespace
+  navctl snapshot --service reviews --namespace bookinfo
+
+  # Redact IP addresses and cluster names
+  navctl snapshot --service reviews --namespace bookinfo --redact-ips --redact 'prod-[a-z0-9-]+'`,
+	SilenceUsage: true,
+	RunE:         runSnapshot,
+}
+
+func runSnapshot(cmd *cobra.Command, args []string) error {
+	logger := logging.For("snapshot")
+
+	if snapshotLogLines <= 0 {
+		return fmt.Errorf("--log-lines must be positive")
+	}
+	redactor, err := snapshot.NewRedactor(snapshotRedactIPs, snapshotRedactPatterns)
+	if err != nil {
+		return err
+	}
+
+	output := snapshotOutput
+	if output == "" {
+		output = fmt.Sprintf("navigator-snapshot-%s-%s-%s.tar.gz", snapshotNamespace, snapshotService, time.Now().UTC().Format("20060102T150405Z"))
+	}
+
+	conn, err := grpc.NewClient(snapshotManagerEndpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(snapshotMaxMessageSize*1024*1024)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to manager: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600) // #nosec G304 - output path is chosen by the user
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
+	defer cancel()
+
+	logger.Info("capturing snapshot", "service", snapshotService, "namespace", snapshotNamespace, "manager", snapshotManagerEndpoint)
+	manifest, err := snapshot.Capture(ctx, conn, snapshot.Options{
+		Namespace:     snapshotNamespace,
+		Service:       snapshotService,
+		MetricsWindow: snapshotMetricsWindow,
+		LogFiles:      snapshotLogFiles,
+		LogLines:      snapshotLogLines,
+		Redactor:      redactor,
+	}, file, logger)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write bundle: %w", closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(output)
+		return err
+	}
+
+	for _, captureErr := range manifest.Errors {
+		logger.Warn("part of the snapshot could not be captured", "error", captureErr)
+	}
+	fmt.Printf("Wrote support bundle to %s (%d files", output, len(manifest.Files))
+	if redactor != nil {
+		fmt.Printf(", %d values redacted", manifest.Redacted)
+	}
+	fmt.Println(")")
+	return nil
+}
+
+func init() {
+	snapshotCmd.Flags().StringVar(&snapshotService, "service", "", "Name of the service to capture")
+	snapshotCmd.Flags().StringVarP(&snapshotNamespace, "namespace", "n", "default", "Namespace of the service")
+	snapshotCmd.Flags().StringVar(&snapshotManagerEndpoint, "manager-endpoint", "localhost:8080", "gRPC endpoint of the Navigator manager")
+	snapshotCmd.Flags().StringVarP(&snapshotOutput, "output", "o", "", "Path to write the bundle to (default: navigator-snapshot-<namespace>-<service>-<time>.tar.gz)")
+	snapshotCmd.Flags().DurationVar(&snapshotMetricsWindow, "metrics-window", 15*time.Minute, "How far back to capture the service's metrics (0 skips metrics)")
+	snapshotCmd.Flags().StringArrayVar(&snapshotLogFiles, "log-file", []string{defaultDaemonPath("navctl.log")}, "navctl log file to capture the last lines of, repeat to capture several")
+	snapshotCmd.Flags().IntVar(&snapshotLogLines, "log-lines", 1000, "Number of lines to capture from the end of each log file")
+	snapshotCmd.Flags().BoolVar(&snapshotRedactIPs, "redact-ips", false, "Redact IPv4 addresses")
+	snapshotCmd.Flags().StringArrayVar(&snapshotRedactPatterns, "redact", nil, "Regular expression of values to redact, repeat to redact several")
+	snapshotCmd.Flags().IntVar(&snapshotMaxMessageSize, "max-message-size", 10, "Maximum gRPC message size in MB")
+	snapshotCmd.Flags().DurationVar(&snapshotTimeout, "timeout", 2*time.Minute, "How long to wait for the snapshot to be captured")
+	_ = snapshotCmd.MarkFlagRequired("service")
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/liamawhite/navigator/navctl/pkg/output"
+	"github.com/liamawhite/navigator/navctl/pkg/watch"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+var (
+	watchCluster         string
+	watchNamespace       string
+	watchKinds           []string
+	watchManagerEndpoint string
+	watchInterval        time.Duration
+	watchOnly            bool
+	watchMaxMessageSize  int
+
+	// watchOutput prints the watch events
+	watchOutput output.Printer
+)
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream changes of services or Istio resources to the terminal",
+	Long: `Print services or Istio resources of a running Navigator as they are added, modified or
+removed, for example while rolling out configuration changes.
+
+The objects that exist when the watch starts are printed as added, unless --watch-only is
+set. Navigator is checked for changes every --interval; changes that are undone within an
+interval are not printed.`,
+}
+
+// watchServicesCmd represents the watch services command
+var watchServicesCmd = &cobra.Command{
+	Use:   "services",
+	Short: "Stream changes of services",
+	Long: `Print services as they are added, modified or removed. A service is modified when its
+instances, addresses or exports change.
+
+Examples:
+  # Watch the services of the bookinfo namespace in the prod-us-east cluster
+  navctl watch services --cluster prod-us-east --namespace bookinfo`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWatch(func(client frontendv1alpha1.ServiceRegistryServiceClient) watch.ListFunc {
+			return watch.Services(client, watchNamespace, watchCluster)
+		})
+	},
+}
+
+// watchResourcesCmd represents the watch resources command
+var watchResourcesCmd = &cobra.Command{
+	Use:   "resources",
+	Short: "Stream changes of Istio resources",
+	Long: `Print Istio resources as they are added, modified or removed.
+
+Examples:
+  # Watch all Istio resources in the prod-us-east cluster
+  navctl watch resources --cluster prod-us-east
+
+  # Watch VirtualServices and DestinationRules as JSON, one event per line
+  navctl watch resources --cluster prod-us-east --kind VirtualService --kind DestinationRule -o json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var kinds []typesv1alpha1.IstioResourceKind
+		for _, name := range watchKinds {
+			kind, err := watch.ParseKind(name)
+			if err != nil {
+				return err
+			}
+			kinds = append(kinds, kind)
+		}
+		return runWatch(func(client frontendv1alpha1.ServiceRegistryServiceClient) watch.ListFunc {
+			return watch.Resources(client, watchNamespace, watchCluster, kinds)
+		})
+	},
+}
+
+// runWatch prints the changes of the objects listed from the manager until interrupted
+func runWatch(list func(frontendv1alpha1.ServiceRegistryServiceClient) watch.ListFunc) error {
+	logger := logging.For("watch")
+
+	if watchInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	conn, err := grpc.NewClient(watchManagerEndpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(watchMaxMessageSize*1024*1024)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to manager: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	stream := watchOutput.Stream(os.Stdout, []string{"Time", "Event", "Kind", "Cluster", "Namespace", "Name", "Detail"})
+	watcher := watch.NewWatcher(list(frontendv1alpha1.NewServiceRegistryServiceClient(conn)), watchInterval, logger)
+	return watcher.Run(ctx, watchOnly, func(event watch.Event) error {
+		return stream.Print(event, []string{
+			event.Time.Format(time.TimeOnly),
+			string(event.Type),
+			event.Kind,
+			valueOrDash(event.Cluster),
+			event.Namespace,
+			event.Name,
+			event.Detail,
+		})
+	})
+}
+
+// valueOrDash returns value, or a dash if it is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func init() {
+	watchCmd.PersistentFlags().StringVar(&watchCluster, "cluster", "", "Cluster to watch (default: all connected clusters)")
+	watchCmd.PersistentFlags().StringVarP(&watchNamespace, "namespace", "n", "", "Namespace to watch (default: all namespaces)")
+	watchCmd.PersistentFlags().StringVar(&watchManagerEndpoint, "manager-endpoint", "localhost:8080", "gRPC endpoint of the Navigator manager")
+	watchCmd.PersistentFlags().DurationVar(&watchInterval, "interval", 2*time.Second, "How often to check for changes")
+	watchCmd.PersistentFlags().BoolVar(&watchOnly, "watch-only", false, "Only print changes, not the objects that exist when the watch starts")
+	watchCmd.PersistentFlags().IntVar(&watchMaxMessageSize, "max-message-size", 10, "Maximum gRPC message size in MB")
+	watchOutput.AddFlags(watchCmd.PersistentFlags())
+
+	watchResourcesCmd.Flags().StringArrayVar(&watchKinds, "kind", nil, "Kind of Istio resources to watch, e.g. VirtualService, repeat to watch several (default: all kinds)")
+
+	watchCmd.AddCommand(watchServicesCmd)
+	watchCmd.AddCommand(watchResourcesCmd)
+}
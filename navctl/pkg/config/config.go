@@ -17,10 +17,15 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"path/filepath"
+	"time"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
 	edgeConfig "github.com/liamawhite/navigator/edge/pkg/config"
 	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
 	managerConfig "github.com/liamawhite/navigator/manager/pkg/config"
+	"github.com/liamawhite/navigator/pkg/logging"
 )
 
 // Manager encapsulates configuration management for navctl
@@ -30,9 +35,10 @@ type Manager struct {
 	logger        *slog.Logger
 }
 
-// NewManager creates a new configuration manager
-func NewManager(configPath string, logger *slog.Logger) (*Manager, error) {
-	config, err := LoadConfig(configPath)
+// NewManager creates a new configuration manager for the config file with the sections of the named
+// profile, or of its default profile when profile is empty
+func NewManager(configPath, profile string, logger *slog.Logger) (*Manager, error) {
+	config, err := LoadConfig(configPath, profile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
@@ -55,10 +61,18 @@ func (m *Manager) GetConfig() *Config {
 // GetManagerConfig returns a manager configuration
 func (m *Manager) GetManagerConfig() *managerConfig.Config {
 	return &managerConfig.Config{
-		Port:           m.config.Manager.Port,
-		LogLevel:       "info", // Will be overridden by CLI flags
-		LogFormat:      "text", // Will be overridden by CLI flags
-		MaxMessageSize: m.config.Manager.MaxMessageSize,
+		Port:                  m.config.Manager.Port,
+		LogLevel:              "info", // Will be overridden by CLI flags
+		LogFormat:             "text", // Will be overridden by CLI flags
+		MaxMessageSize:        m.config.Manager.MaxMessageSize,
+		MaxClusterStateSize:   m.config.Manager.MaxClusterStateSize,
+		HTTPSocket:            m.config.Manager.HTTPSocket,
+		AdminPort:             m.config.Manager.AdminPort,
+		StaleClusterRetention: m.config.Manager.StaleClusterRetention,
+		MaxClusterStaleness:   m.config.Manager.MaxClusterStaleness,
+		EvictionWebhook:       m.config.Manager.EvictionWebhook,
+		EventLogSize:          m.config.Manager.EventLogSize,
+		EventLogFile:          m.config.Manager.EventLogFile,
 	}
 }
 
@@ -83,8 +97,12 @@ func (m *Manager) GetEdgeConfig(edgeIndex int, globalLogLevel, globalLogFormat s
 	if edge.Metrics != nil {
 		metricsConfig.Type = metrics.ProviderType(edge.Metrics.Type)
 		metricsConfig.Endpoint = edge.Metrics.Endpoint
+		metricsConfig.SecondaryEndpoint = edge.Metrics.SecondaryEndpoint
+		metricsConfig.SecondaryMode = metrics.SecondaryMode(edge.Metrics.SecondaryMode)
 		metricsConfig.QueryInterval = edge.Metrics.QueryInterval
 		metricsConfig.Timeout = edge.Metrics.Timeout
+		metricsConfig.LabelMapping = edge.Metrics.LabelMapping
+		metricsConfig.QueryTemplates = edge.Metrics.QueryTemplates.toEdge()
 
 		// Get bearer token
 		if edge.Metrics.Auth != nil {
@@ -108,13 +126,18 @@ func (m *Manager) GetEdgeConfig(edgeIndex int, globalLogLevel, globalLogFormat s
 	}
 
 	return &edgeConfig.Config{
-		ManagerEndpoint: fmt.Sprintf("%s:%d", m.config.Manager.Host, m.config.Manager.Port),
-		SyncInterval:    edge.SyncInterval,
-		KubeconfigPath:  edge.Kubeconfig,
-		LogLevel:        logLevel,
-		LogFormat:       logFormat,
-		MaxMessageSize:  m.config.Manager.MaxMessageSize,
-		MetricsConfig:   metricsConfig,
+		ManagerEndpoint:  fmt.Sprintf("%s:%d", m.config.Manager.Host, m.config.Manager.Port),
+		SyncInterval:     edge.SyncInterval,
+		KubeconfigPath:   edge.Kubeconfig,
+		LogLevel:         logLevel,
+		LogFormat:        logFormat,
+		MaxMessageSize:   m.config.Manager.MaxMessageSize,
+		ClusterLabels:    edge.Labels,
+		LowFootprint:     edge.LowFootprint,
+		DisabledModules:  edge.DisabledModules,
+		MetricsConfig:    metricsConfig,
+		TracesConfig:     edge.Traces.toEdge(),
+		AccessLogsConfig: edge.AccessLogs.toEdge(),
 	}, nil
 }
 
@@ -157,6 +180,11 @@ func (m *Manager) GetUIConfig() *UIConfig {
 	return m.config.UI
 }
 
+// GetLoggingConfig returns the log file configuration, nil if logs are only written to standard output
+func (m *Manager) GetLoggingConfig() *LoggingConfig {
+	return m.config.Logging
+}
+
 // ValidateEdges validates that all edge configurations are valid
 func (m *Manager) ValidateEdges() error {
 	if len(m.config.Edges) == 0 {
@@ -192,3 +220,59 @@ func (m *Manager) Close() {
 		m.tokenExecutor.Close()
 	}
 }
+
+// FileConfig returns the configuration of the log file with the given name, e.g. "manager"
+func (l *LoggingConfig) FileConfig(name string) logging.FileConfig {
+	return logging.FileConfig{
+		Path:             filepath.Join(l.Directory, name+".log"),
+		MaxSize:          l.MaxSize,
+		MaxBackups:       l.MaxBackups,
+		MaxAge:           l.MaxAge,
+		RotationInterval: time.Duration(l.RotationInterval) * time.Second,
+		Compress:         l.Compress,
+	}
+}
+
+// toEdge converts the traces configuration to the edge's, with trace search disabled when omitted
+func (t *TracesConfig) toEdge() traces.Config {
+	if t == nil {
+		return traces.Config{Type: traces.ProviderTypeNone}
+	}
+	return traces.Config{
+		Type:        traces.ProviderType(t.Type),
+		Endpoint:    t.Endpoint,
+		Timeout:     t.Timeout,
+		BearerToken: t.BearerToken,
+	}
+}
+
+// toEdge converts the access logs configuration to the edge's, with access log search disabled when omitted
+func (a *AccessLogsConfig) toEdge() accesslogs.Config {
+	if a == nil {
+		return accesslogs.Config{Type: accesslogs.ProviderTypeNone}
+	}
+	return accesslogs.Config{
+		Type:         accesslogs.ProviderType(a.Type),
+		Endpoint:     a.Endpoint,
+		Timeout:      a.Timeout,
+		BearerToken:  a.BearerToken,
+		TenantID:     a.TenantID,
+		Format:       accesslogs.Format(a.Format),
+		ServiceLabel: a.ServiceLabel,
+	}
+}
+
+// toEdge converts query templates to the edge metrics configuration, without overrides if they are not set
+func (t *MetricsQueryTemplates) toEdge() metrics.QueryTemplates {
+	if t == nil {
+		return metrics.QueryTemplates{}
+	}
+	return metrics.QueryTemplates{
+		InboundRequestRate:          t.InboundRequestRate,
+		OutboundRequestRate:         t.OutboundRequestRate,
+		InboundErrorRate:            t.InboundErrorRate,
+		OutboundErrorRate:           t.OutboundErrorRate,
+		InboundLatencyDistribution:  t.InboundLatencyDistribution,
+		OutboundLatencyDistribution: t.OutboundLatencyDistribution,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,7 +47,7 @@ edges:
 	require.NoError(t, err)
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
-	manager, err := NewManager(configFile, logger)
+	manager, err := NewManager(configFile, "", logger)
 	require.NoError(t, err)
 
 	assert.NotNil(t, manager)
@@ -90,10 +91,12 @@ func TestManager_GetEdgeConfig(t *testing.T) {
 				LogLevel:     "debug",
 				LogFormat:    "json",
 				Metrics: &MetricsConfig{
-					Type:          "prometheus",
-					Endpoint:      "http://prometheus:9090",
-					QueryInterval: 60,
-					Timeout:       15,
+					Type:              "prometheus",
+					Endpoint:          "http://prometheus:9090",
+					SecondaryEndpoint: "http://thanos:9090",
+					SecondaryMode:     "merge",
+					QueryInterval:     60,
+					Timeout:           15,
 					Auth: &MetricsAuth{
 						BearerToken: "test-token",
 					},
@@ -120,6 +123,8 @@ func TestManager_GetEdgeConfig(t *testing.T) {
 	assert.True(t, edgeCfg.MetricsConfig.Enabled)
 	assert.Equal(t, "prometheus", string(edgeCfg.MetricsConfig.Type))
 	assert.Equal(t, "http://prometheus:9090", edgeCfg.MetricsConfig.Endpoint)
+	assert.Equal(t, "http://thanos:9090", edgeCfg.MetricsConfig.SecondaryEndpoint)
+	assert.Equal(t, metrics.SecondaryModeMerge, edgeCfg.MetricsConfig.SecondaryMode)
 	assert.Equal(t, "test-token", edgeCfg.MetricsConfig.BearerToken)
 }
 
@@ -17,21 +17,32 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 
+	"github.com/liamawhite/navigator/edge/pkg/accesslogs"
+	edgeConfig "github.com/liamawhite/navigator/edge/pkg/config"
+	"github.com/liamawhite/navigator/edge/pkg/metrics"
+	"github.com/liamawhite/navigator/edge/pkg/traces"
+	managerConfig "github.com/liamawhite/navigator/manager/pkg/config"
+	"github.com/liamawhite/navigator/pkg/logging"
 	"gopkg.in/yaml.v3"
 	"k8s.io/client-go/util/homedir"
 )
 
 const (
 	defaultConfigFilename = "navctl-config.yaml"
+
+	// DefaultLogFileMaxBackups is how many rotated files of each log file are kept by default
+	DefaultLogFileMaxBackups = 5
 )
 
-// LoadConfig loads a configuration from a file path or discovers it automatically
-func LoadConfig(configPath string) (*Config, error) {
+// LoadConfig loads a configuration from a file path or discovers it automatically, with the sections
+// of the named profile, or of its default profile when profile is empty
+func LoadConfig(configPath, profile string) (*Config, error) {
 	var filePath string
 	var err error
 
@@ -46,6 +57,9 @@ func LoadConfig(configPath string) (*Config, error) {
 		}
 		if filePath == "" {
 			// No config file found, return default config
+			if profile != "" {
+				return nil, fmt.Errorf("profile %q not found, no config file was found", profile)
+			}
 			return DefaultConfig(), nil
 		}
 	}
@@ -71,6 +85,11 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config file %s: %w", filePath, err)
 	}
 
+	// Replace the sections set by the selected profile
+	if err := applyProfile(config, profile); err != nil {
+		return nil, err
+	}
+
 	// Apply defaults and validate
 	if err := applyDefaultsAndValidate(config); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
@@ -79,6 +98,39 @@ func LoadConfig(configPath string) (*Config, error) {
 	return config, nil
 }
 
+// applyProfile replaces the sections of the config set by the named profile, or by the default profile
+// when name is empty
+func applyProfile(config *Config, name string) error {
+	if name == "" {
+		name = config.DefaultProfile
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		if len(config.Profiles) == 0 {
+			return fmt.Errorf("profile %q not found, the config has no profiles", name)
+		}
+		return fmt.Errorf("profile %q not found, available profiles: %s", name, strings.Join(slices.Sorted(maps.Keys(config.Profiles)), ", "))
+	}
+
+	if profile.Manager != nil {
+		config.Manager = profile.Manager
+	}
+	if profile.Edges != nil {
+		config.Edges = profile.Edges
+	}
+	if profile.UI != nil {
+		config.UI = profile.UI
+	}
+	if profile.Logging != nil {
+		config.Logging = profile.Logging
+	}
+	return nil
+}
+
 // discoverConfigFile looks for config files in standard locations
 func discoverConfigFile() (string, error) {
 	// Search paths in order of preference
@@ -110,23 +162,44 @@ func discoverConfigFile() (string, error) {
 	return "", nil
 }
 
-// parseConfig parses config data based on file extension
+// parseConfig parses config data based on file extension, after validating it against the config schema
 func parseConfig(data []byte, filePath string) (*Config, error) {
 	config := &Config{}
+	var document any
 
 	// Determine format from file extension
 	ext := strings.ToLower(filepath.Ext(filePath))
 	switch ext {
 	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML: %w", err)
+		}
+		if err := checkSchema(document, filePath); err != nil {
+			return nil, err
+		}
 		if err := yaml.Unmarshal(data, config); err != nil {
 			return nil, fmt.Errorf("failed to parse YAML: %w", err)
 		}
 	case ".json":
+		if err := json.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		if err := checkSchema(document, filePath); err != nil {
+			return nil, err
+		}
 		if err := json.Unmarshal(data, config); err != nil {
 			return nil, fmt.Errorf("failed to parse JSON: %w", err)
 		}
 	default:
 		// Try YAML first, then JSON
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			if jsonErr := json.Unmarshal(data, &document); jsonErr != nil {
+				return nil, fmt.Errorf("failed to parse as YAML (%v) or JSON (%v)", err, jsonErr)
+			}
+		}
+		if err := checkSchema(document, filePath); err != nil {
+			return nil, err
+		}
 		if err := yaml.Unmarshal(data, config); err != nil {
 			if jsonErr := json.Unmarshal(data, config); jsonErr != nil {
 				return nil, fmt.Errorf("failed to parse as YAML (%v) or JSON (%v)", err, jsonErr)
@@ -137,6 +210,18 @@ func parseConfig(data []byte, filePath string) (*Config, error) {
 	return config, nil
 }
 
+// checkSchema validates a config document against the config schema, and warns about the unknown keys
+// in it, which are ignored
+func checkSchema(document any, filePath string) error {
+	unknownKeys, err := validateSchema(document)
+	if len(unknownKeys) > 0 {
+		logging.For("config").Warn("ignoring unknown keys in config file",
+			"path", filePath,
+			"keys", strings.Join(unknownKeys, ", "))
+	}
+	return err
+}
+
 // applyDefaultsAndValidate applies defaults to config and validates it
 func applyDefaultsAndValidate(config *Config) error {
 	// Set defaults for missing values
@@ -160,6 +245,9 @@ func applyDefaultsAndValidate(config *Config) error {
 	if config.Manager.MaxMessageSize == 0 {
 		config.Manager.MaxMessageSize = 10
 	}
+	if config.Manager.StaleClusterRetention == 0 {
+		config.Manager.StaleClusterRetention = managerConfig.DefaultStaleClusterRetention
+	}
 
 	// Apply UI defaults
 	if config.UI == nil {
@@ -169,6 +257,28 @@ func applyDefaultsAndValidate(config *Config) error {
 		config.UI.Port = 8082
 	}
 
+	// Apply logging defaults and validate logging configuration
+	if config.Logging != nil {
+		if config.Logging.Format == "" {
+			config.Logging.Format = "text"
+		}
+		if config.Logging.MaxSize == 0 {
+			config.Logging.MaxSize = logging.DefaultFileMaxSize
+		}
+		if config.Logging.MaxBackups == 0 {
+			config.Logging.MaxBackups = DefaultLogFileMaxBackups
+		}
+		if config.Logging.Directory == "" {
+			return fmt.Errorf("logging directory is required")
+		}
+		if config.Logging.Format != "text" && config.Logging.Format != "json" {
+			return fmt.Errorf("invalid logging format %s, must be one of: text, json", config.Logging.Format)
+		}
+		if config.Logging.MaxSize < 0 || config.Logging.MaxBackups < 0 || config.Logging.MaxAge < 0 || config.Logging.RotationInterval < 0 {
+			return fmt.Errorf("logging maxSize, maxBackups, maxAge and rotationInterval must not be negative")
+		}
+	}
+
 	// Apply edge defaults and validate
 	for i := range config.Edges {
 		edge := &config.Edges[i]
@@ -184,6 +294,18 @@ func applyDefaultsAndValidate(config *Config) error {
 			edge.LogFormat = "text"
 		}
 
+		// Validate cluster labels
+		if err := edgeConfig.ValidateClusterLabels(edge.Labels); err != nil {
+			return fmt.Errorf("edge %d: %w", i, err)
+		}
+
+		// Validate disabled modules
+		for _, module := range edge.DisabledModules {
+			if !slices.Contains(edgeConfig.Modules, module) {
+				return fmt.Errorf("edge %d: disabledModules must be one of: %s", i, strings.Join(edgeConfig.Modules, ", "))
+			}
+		}
+
 		// Apply metrics defaults
 		if edge.Metrics != nil {
 			if edge.Metrics.Type == "" {
@@ -195,14 +317,13 @@ func applyDefaultsAndValidate(config *Config) error {
 			if edge.Metrics.Timeout == 0 {
 				edge.Metrics.Timeout = 10
 			}
+			if edge.Metrics.SecondaryMode == "" {
+				edge.Metrics.SecondaryMode = string(metrics.SecondaryModeFailover)
+			}
 		}
 
 		// Validate metrics configuration
 		if edge.Metrics != nil {
-			if edge.Metrics.Endpoint == "" {
-				return fmt.Errorf("edge %d: metrics endpoint is required when metrics is configured", i)
-			}
-
 			// Validate auth configuration
 			if edge.Metrics.Auth != nil {
 				if edge.Metrics.Auth.BearerToken != "" && edge.Metrics.Auth.BearerTokenExec != nil {
@@ -215,6 +336,59 @@ func applyDefaultsAndValidate(config *Config) error {
 					}
 				}
 			}
+
+			// Validate secondary mode
+			if edge.Metrics.SecondaryMode != string(metrics.SecondaryModeFailover) && edge.Metrics.SecondaryMode != string(metrics.SecondaryModeMerge) {
+				return fmt.Errorf("edge %d: invalid secondaryMode %s, must be one of: failover, merge", i, edge.Metrics.SecondaryMode)
+			}
+
+			// Validate label mapping
+			if err := metrics.LabelMapping(edge.Metrics.LabelMapping).Validate(); err != nil {
+				return fmt.Errorf("edge %d: %w", i, err)
+			}
+
+			// Validate query templates
+			if err := edge.Metrics.QueryTemplates.toEdge().Validate(); err != nil {
+				return fmt.Errorf("edge %d: %w", i, err)
+			}
+		}
+
+		// Apply traces defaults and validate traces configuration
+		if edge.Traces != nil {
+			if edge.Traces.Timeout == 0 {
+				edge.Traces.Timeout = 10
+			}
+			if edge.Traces.Type != string(traces.ProviderTypeJaeger) && edge.Traces.Type != string(traces.ProviderTypeTempo) {
+				return fmt.Errorf("edge %d: invalid traces type %q, must be one of: jaeger, tempo", i, edge.Traces.Type)
+			}
+			if edge.Traces.Endpoint == "" {
+				return fmt.Errorf("edge %d: traces endpoint is required", i)
+			}
+		}
+
+		// Apply access logs defaults and validate access logs configuration
+		if edge.AccessLogs != nil {
+			if edge.AccessLogs.Type == "" {
+				edge.AccessLogs.Type = string(accesslogs.ProviderTypeLoki)
+			}
+			if edge.AccessLogs.Timeout == 0 {
+				edge.AccessLogs.Timeout = 10
+			}
+			if edge.AccessLogs.Format == "" {
+				edge.AccessLogs.Format = string(accesslogs.FormatText)
+			}
+			if edge.AccessLogs.ServiceLabel == "" {
+				edge.AccessLogs.ServiceLabel = "app"
+			}
+			if edge.AccessLogs.Type != string(accesslogs.ProviderTypeLoki) {
+				return fmt.Errorf("edge %d: invalid access logs type %q, must be: loki", i, edge.AccessLogs.Type)
+			}
+			if edge.AccessLogs.Endpoint == "" {
+				return fmt.Errorf("edge %d: access logs endpoint is required", i)
+			}
+			if edge.AccessLogs.Format != string(accesslogs.FormatText) && edge.AccessLogs.Format != string(accesslogs.FormatJSON) {
+				return fmt.Errorf("edge %d: invalid access logs format %q, must be one of: text, json", i, edge.AccessLogs.Format)
+			}
 		}
 
 		// Validate log level
@@ -235,9 +409,19 @@ func applyDefaultsAndValidate(config *Config) error {
 	return nil
 }
 
-// expandEnvVars expands environment variables in strings using ${VAR} or $VAR syntax
+// expandEnvVars expands environment variables in strings using ${VAR} or $VAR syntax. ${VAR:-default}
+// expands to the default when VAR is unset or empty, and $$ to a literal $.
 func expandEnvVars(s string) string {
-	return os.ExpandEnv(s)
+	return os.Expand(s, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+		name, fallback, hasDefault := strings.Cut(name, ":-")
+		if value := os.Getenv(name); value != "" || !hasDefault {
+			return value
+		}
+		return fallback
+	})
 }
 
 // expandConfigEnvVars recursively expands environment variables in config
@@ -245,6 +429,19 @@ func (c *Config) expandEnvVars() {
 	// Expand manager config
 	if c.Manager != nil {
 		c.Manager.Host = expandEnvVars(c.Manager.Host)
+		c.Manager.HTTPSocket = expandEnvVars(c.Manager.HTTPSocket)
+		c.Manager.EvictionWebhook = expandEnvVars(c.Manager.EvictionWebhook)
+		c.Manager.EventLogFile = expandEnvVars(c.Manager.EventLogFile)
+	}
+
+	// Expand UI config
+	if c.UI != nil {
+		c.UI.Socket = expandEnvVars(c.UI.Socket)
+	}
+
+	// Expand logging config
+	if c.Logging != nil {
+		c.Logging.Directory = expandEnvVars(c.Logging.Directory)
 	}
 
 	// Expand edge configs
@@ -255,6 +452,7 @@ func (c *Config) expandEnvVars() {
 
 		if edge.Metrics != nil {
 			edge.Metrics.Endpoint = expandEnvVars(edge.Metrics.Endpoint)
+			edge.Metrics.SecondaryEndpoint = expandEnvVars(edge.Metrics.SecondaryEndpoint)
 
 			if edge.Metrics.Auth != nil {
 				edge.Metrics.Auth.BearerToken = expandEnvVars(edge.Metrics.Auth.BearerToken)
@@ -271,6 +469,17 @@ func (c *Config) expandEnvVars() {
 				}
 			}
 		}
+
+		if edge.Traces != nil {
+			edge.Traces.Endpoint = expandEnvVars(edge.Traces.Endpoint)
+			edge.Traces.BearerToken = expandEnvVars(edge.Traces.BearerToken)
+		}
+
+		if edge.AccessLogs != nil {
+			edge.AccessLogs.Endpoint = expandEnvVars(edge.AccessLogs.Endpoint)
+			edge.AccessLogs.BearerToken = expandEnvVars(edge.AccessLogs.BearerToken)
+			edge.AccessLogs.TenantID = expandEnvVars(edge.AccessLogs.TenantID)
+		}
 	}
 }
 
@@ -156,8 +156,7 @@ func TestApplyDefaultsAndValidate(t *testing.T) {
 					},
 				},
 			},
-			wantErr:     true,
-			errContains: "metrics endpoint is required",
+			wantErr: false, // the endpoint is discovered in the cluster
 		},
 		{
 			name: "both bearer token and exec",
@@ -200,6 +199,157 @@ func TestApplyDefaultsAndValidate(t *testing.T) {
 			wantErr:     true,
 			errContains: "bearerTokenExec command is required",
 		},
+		{
+			name: "invalid secondary mode",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Metrics: &MetricsConfig{
+							Type:              "prometheus",
+							Endpoint:          "http://prometheus:9090",
+							SecondaryEndpoint: "http://thanos:9090",
+							SecondaryMode:     "round-robin",
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: "edge 0: invalid secondaryMode round-robin",
+		},
+		{
+			name: "invalid label mapping",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Metrics: &MetricsConfig{
+							Type:         "prometheus",
+							LabelMapping: map[string]string{"source_app": "app"},
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: `edge 0: label mapping of unknown label "source_app"`,
+		},
+		{
+			name: "invalid query template",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Metrics: &MetricsConfig{
+							Type: "prometheus",
+							QueryTemplates: &MetricsQueryTemplates{
+								InboundErrorRate: `rate(requests_total{svc="{{.Service}}"}[5m])`,
+							},
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: "edge 0: invalid inbound_error_rate query template",
+		},
+		{
+			name: "traces config",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Traces: &TracesConfig{
+							Type:     "tempo",
+							Endpoint: "http://tempo.observability:3200",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid traces type",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Traces: &TracesConfig{
+							Type:     "zipkin",
+							Endpoint: "http://zipkin:9411",
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: `edge 0: invalid traces type "zipkin"`,
+		},
+		{
+			name: "traces without endpoint",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						Traces: &TracesConfig{Type: "jaeger"},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: "edge 0: traces endpoint is required",
+		},
+		{
+			name: "access logs config",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						AccessLogs: &AccessLogsConfig{Endpoint: "http://loki.monitoring:3100"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid access logs format",
+			config: &Config{
+				Edges: []EdgeConfig{
+					{
+						AccessLogs: &AccessLogsConfig{
+							Endpoint: "http://loki.monitoring:3100",
+							Format:   "logfmt",
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: `edge 0: invalid access logs format "logfmt"`,
+		},
+		{
+			name: "logging config",
+			config: &Config{
+				Edges:   []EdgeConfig{{}},
+				Logging: &LoggingConfig{Directory: "/var/log/navigator"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "logging without directory",
+			config: &Config{
+				Edges:   []EdgeConfig{{}},
+				Logging: &LoggingConfig{},
+			},
+			wantErr:     true,
+			errContains: "logging directory is required",
+		},
+		{
+			name: "invalid logging format",
+			config: &Config{
+				Edges:   []EdgeConfig{{}},
+				Logging: &LoggingConfig{Directory: "/var/log/navigator", Format: "logfmt"},
+			},
+			wantErr:     true,
+			errContains: "invalid logging format logfmt",
+		},
+		{
+			name: "negative logging rotation interval",
+			config: &Config{
+				Edges:   []EdgeConfig{{}},
+				Logging: &LoggingConfig{Directory: "/var/log/navigator", RotationInterval: -1},
+			},
+			wantErr:     true,
+			errContains: "must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -219,6 +369,7 @@ func TestApplyDefaultsAndValidate(t *testing.T) {
 				assert.Equal(t, "localhost", tt.config.Manager.Host)
 				assert.Equal(t, 8080, tt.config.Manager.Port)
 				assert.Equal(t, 10, tt.config.Manager.MaxMessageSize)
+				assert.Equal(t, 900, tt.config.Manager.StaleClusterRetention)
 				assert.Equal(t, 8082, tt.config.UI.Port)
 
 				for _, edge := range tt.config.Edges {
@@ -231,6 +382,23 @@ func TestApplyDefaultsAndValidate(t *testing.T) {
 						assert.Equal(t, 30, edge.Metrics.QueryInterval)
 						assert.Equal(t, 10, edge.Metrics.Timeout)
 					}
+
+					if edge.Traces != nil {
+						assert.Equal(t, 10, edge.Traces.Timeout)
+					}
+
+					if edge.AccessLogs != nil {
+						assert.Equal(t, "loki", edge.AccessLogs.Type)
+						assert.Equal(t, 10, edge.AccessLogs.Timeout)
+						assert.Equal(t, "text", edge.AccessLogs.Format)
+						assert.Equal(t, "app", edge.AccessLogs.ServiceLabel)
+					}
+				}
+
+				if tt.config.Logging != nil {
+					assert.Equal(t, "text", tt.config.Logging.Format)
+					assert.Equal(t, 100, tt.config.Logging.MaxSize)
+					assert.Equal(t, 5, tt.config.Logging.MaxBackups)
 				}
 			}
 		})
@@ -238,7 +406,7 @@ func TestApplyDefaultsAndValidate(t *testing.T) {
 }
 
 func TestLoadConfig_NonExistentFile(t *testing.T) {
-	_, err := LoadConfig("/non/existent/file.yaml")
+	_, err := LoadConfig("/non/existent/file.yaml", "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "does not exist")
 }
@@ -262,7 +430,7 @@ edges:
 	err := os.WriteFile(configFile, []byte(yamlContent), 0600)
 	require.NoError(t, err)
 
-	config, err := LoadConfig(configFile)
+	config, err := LoadConfig(configFile, "")
 	require.NoError(t, err)
 
 	assert.Equal(t, "testhost", config.Manager.Host)
@@ -271,6 +439,87 @@ edges:
 	// Name field removed - auto-discovery from Istio
 }
 
+func TestLoadConfig_Profiles(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "navctl-config.yaml")
+	yamlContent := `
+manager:
+  port: 9090
+edges:
+  - context: local
+defaultProfile: dev
+profiles:
+  dev:
+    edges:
+      - context: dev-cluster
+  prod:
+    edges:
+      - context: prod-eu
+      - context: prod-us
+    ui:
+      port: 9082
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(yamlContent), 0600))
+
+	tests := []struct {
+		name         string
+		profile      string
+		wantContexts []string
+		wantUIPort   int
+		errMsg       string
+	}{
+		{
+			name:         "default profile",
+			wantContexts: []string{"dev-cluster"},
+			wantUIPort:   8082,
+		},
+		{
+			name:         "selected profile",
+			profile:      "prod",
+			wantContexts: []string{"prod-eu", "prod-us"},
+			wantUIPort:   9082,
+		},
+		{
+			name:    "unknown profile",
+			profile: "staging",
+			errMsg:  `profile "staging" not found, available profiles: dev, prod`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := LoadConfig(configFile, tt.profile)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			var contexts []string
+			for _, edge := range config.Edges {
+				contexts = append(contexts, edge.Context)
+			}
+			assert.Equal(t, tt.wantContexts, contexts)
+			assert.Equal(t, tt.wantUIPort, config.UI.Port)
+			// Sections the profile does not set are shared
+			assert.Equal(t, 9090, config.Manager.Port)
+		})
+	}
+}
+
+func TestLoadConfig_ProfileWithoutProfiles(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "navctl-config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("edges:\n  - context: local\n"), 0600))
+
+	_, err := LoadConfig(configFile, "prod")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `profile "prod" not found, the config has no profiles`)
+
+	config, err := LoadConfig(configFile, "")
+	require.NoError(t, err)
+	assert.Len(t, config.Edges, 1)
+}
+
 func TestLoadConfig_EmptyPath_ReturnsDefault(t *testing.T) {
 	// Save current directory
 	originalWd, err := os.Getwd()
@@ -286,7 +535,7 @@ func TestLoadConfig_EmptyPath_ReturnsDefault(t *testing.T) {
 	err = os.Chdir(tmpDir)
 	require.NoError(t, err)
 
-	config, err := LoadConfig("")
+	config, err := LoadConfig("", "")
 	require.NoError(t, err)
 
 	// Should return default config
@@ -331,3 +580,29 @@ func TestExpandEnvVars(t *testing.T) {
 	assert.Equal(t, "http://envhost:9090", config.Edges[0].Metrics.Endpoint)
 	assert.Equal(t, "envhost-token", config.Edges[0].Metrics.Auth.BearerToken)
 }
+
+func TestExpandEnvVars_Defaults(t *testing.T) {
+	t.Setenv("TEST_TOKEN", "secret")
+	t.Setenv("TEST_EMPTY", "")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "braced variable", value: "${TEST_TOKEN}", want: "secret"},
+		{name: "bare variable", value: "Bearer $TEST_TOKEN", want: "Bearer secret"},
+		{name: "set variable ignores default", value: "${TEST_TOKEN:-fallback}", want: "secret"},
+		{name: "unset variable with default", value: "${TEST_UNSET:-http://prometheus:9090}", want: "http://prometheus:9090"},
+		{name: "empty variable with default", value: "${TEST_EMPTY:-fallback}", want: "fallback"},
+		{name: "unset variable without default", value: "${TEST_UNSET}", want: ""},
+		{name: "empty default", value: "${TEST_UNSET:-}", want: ""},
+		{name: "escaped dollar", value: "pa$$word", want: "pa$word"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, expandEnvVars(tt.value))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// SchemaURL is the URL of the JSON Schema of the config file. Editors can load it for completion and
+// validation, e.g. with a "# yaml-language-server: $schema=<url>" comment at the top of a YAML file.
+const SchemaURL = "https://raw.githubusercontent.com/liamawhite/navigator/main/navctl/pkg/config/navctl-config.schema.json"
+
+// schemaJSON is the JSON Schema of the config file, generated from the config types by docs/gen
+//
+//go:embed navctl-config.schema.json
+var schemaJSON []byte
+
+// compileSchema compiles the embedded schema once, the first time a config file is validated
+var compileSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	schema, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(SchemaURL, schema); err != nil {
+		return nil, fmt.Errorf("failed to load config schema: %w", err)
+	}
+	return compiler.Compile(SchemaURL)
+})
+
+// validateSchema validates a config document decoded from YAML or JSON against the schema of the
+// config file, and returns an error listing each invalid value with its path, e.g. edges[0].logLevel.
+// Unknown keys were ignored before the config was validated, so they are returned rather than rejected,
+// e.g. edges[0].syncIntervall.
+func validateSchema(document any) ([]string, error) {
+	// An empty file is the default configuration
+	if document == nil {
+		return nil, nil
+	}
+
+	schema, err := compileSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	// Round trip the document through JSON so its values have the types the validator expects
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert config to JSON: %w", err)
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert config to JSON: %w", err)
+	}
+
+	err = schema.Validate(instance)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	printer := message.NewPrinter(language.English)
+	var unknownKeys, problems []string
+	for _, cause := range leafCauses(validationErr) {
+		path := instancePath(cause.InstanceLocation)
+		if additional, ok := cause.ErrorKind.(*kind.AdditionalProperties); ok {
+			for _, key := range additional.Properties {
+				unknownKeys = append(unknownKeys, instancePath(append(slices.Clone(cause.InstanceLocation), key)))
+			}
+			continue
+		}
+		problem := cause.ErrorKind.LocalizedString(printer)
+		if path != "" {
+			problem = path + ": " + problem
+		}
+		problems = append(problems, problem)
+	}
+	slices.Sort(unknownKeys)
+	if len(problems) == 0 {
+		return unknownKeys, nil
+	}
+	slices.Sort(problems)
+	return unknownKeys, fmt.Errorf("config does not match schema: %s", strings.Join(slices.Compact(problems), "; "))
+}
+
+// leafCauses returns the validation errors without causes of their own, which name the invalid values
+func leafCauses(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, leafCauses(cause)...)
+	}
+	return leaves
+}
+
+// instancePath formats the location of a value in the config, e.g. edges[0].metrics.type
+func instancePath(location []string) string {
+	var path strings.Builder
+	for _, token := range location {
+		if _, err := strconv.Atoi(token); err == nil {
+			fmt.Fprintf(&path, "[%s]", token)
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteByte('.')
+		}
+		path.WriteString(token)
+	}
+	return path.String()
+}
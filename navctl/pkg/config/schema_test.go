@@ -0,0 +1,188 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig_Schema(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		filePath string
+		errMsg   string
+	}{
+		{
+			name: "valid config",
+			content: `
+manager:
+  port: 9090
+edges:
+  - context: prod
+    logLevel: debug
+    labels:
+      region: eu-west-1
+    disabledModules: [envoy-filters, wasm-plugins]
+    metrics:
+      type: prometheus
+      secondaryMode: merge
+logging:
+  directory: /var/log/navctl
+`,
+			filePath: "test.yaml",
+		},
+		{
+			name:     "empty file",
+			content:  "",
+			filePath: "test.yaml",
+		},
+		{
+			name:     "json config referencing the schema",
+			content:  `{"$schema": "` + SchemaURL + `", "ui": {"port": 3000}}`,
+			filePath: "test.json",
+		},
+		{
+			name: "unknown fields are ignored",
+			content: `
+edges:
+  - context: prod
+    syncIntervall: 10
+`,
+			filePath: "test.yaml",
+		},
+		{
+			name: "wrong type",
+			content: `
+manager:
+  port: "8080"
+`,
+			filePath: "test.yaml",
+			errMsg:   "manager.port: got string, want integer",
+		},
+		{
+			name: "invalid enum value",
+			content: `
+edges:
+  - context: prod
+  - context: staging
+    traces:
+      type: zipkin
+      endpoint: http://zipkin:9411
+`,
+			filePath: "test.yaml",
+			errMsg:   "edges[1].traces.type: value must be one of 'jaeger', 'tempo'",
+		},
+		{
+			name: "invalid list item",
+			content: `
+edges:
+  - context: prod
+    disabledModules: [raw-config, gateways]
+`,
+			filePath: "test.yaml",
+			errMsg:   "edges[0].disabledModules[1]: value must be one of 'envoy-filters', 'wasm-plugins', 'raw-config', 'metrics'",
+		},
+		{
+			name:     "missing required field",
+			content:  `{"logging": {"maxSize": 10}}`,
+			filePath: "test.json",
+			errMsg:   "logging: missing property 'directory'",
+		},
+		{
+			name: "every invalid value is reported",
+			content: `
+ui:
+  port: 8082
+  disabled: "no"
+edges:
+  - logFormat: xml
+`,
+			filePath: "navctl-config",
+			errMsg:   "edges[0].logFormat: value must be one of 'text', 'json'; ui.disabled: got string, want boolean",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseConfig([]byte(tt.content), tt.filePath)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateSchema_UnknownKeys(t *testing.T) {
+	var document any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"version": 1,
+		"manager": {"port": 9090},
+		"edges": [
+			{"context": "prod", "name": "prod-edge", "clusterId": "prod"},
+			{"context": "staging", "syncIntervall": 10}
+		]
+	}`), &document))
+
+	// Unknown keys are returned with their paths instead of failing validation
+	unknownKeys, err := validateSchema(document)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"edges[0].clusterId", "edges[0].name", "edges[1].syncIntervall", "version"}, unknownKeys)
+
+	// Invalid values are still rejected alongside unknown keys
+	require.NoError(t, json.Unmarshal([]byte(`{"manager": {"port": "9090", "hostname": "localhost"}}`), &document))
+	unknownKeys, err = validateSchema(document)
+	assert.EqualError(t, err, "config does not match schema: manager.port: got string, want integer")
+	assert.Equal(t, []string{"manager.hostname"}, unknownKeys)
+}
+
+func TestSchema_CoversConfigTypes(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	defs := schema["$defs"].(map[string]any)
+
+	// Every field of the config types must be in the schema, or regenerate it with go run ./docs/gen
+	var check func(schema map[string]any, typ reflect.Type)
+	check = func(schema map[string]any, typ reflect.Type) {
+		properties, ok := schema["properties"].(map[string]any)
+		require.True(t, ok, "schema of %s has no properties", typ.Name())
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			assert.Contains(t, properties, name, "field %s.%s is not in the schema", typ.Name(), field.Name)
+
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				def, ok := defs[fieldType.Name()].(map[string]any)
+				require.True(t, ok, "type %s is not in the schema", fieldType.Name())
+				check(def, fieldType)
+			}
+		}
+	}
+	check(schema, reflect.TypeOf(Config{}))
+}
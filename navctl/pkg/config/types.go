@@ -25,6 +25,11 @@ import (
 // and Kubernetes contexts. The configuration file enables declarative
 // management of Navigator services across multiple clusters.
 //
+// Endpoints, bearer tokens, paths and other string values that may differ between
+// environments or hold secrets can reference environment variables as ${VAR}, or
+// ${VAR:-default} to fall back to a default when VAR is unset or empty, so secrets
+// do not have to be committed with the file. $$ is a literal $.
+//
 // Example YAML configuration:
 //
 //	apiVersion: navigator.io/v1alpha1
@@ -36,7 +41,7 @@ import (
 //	  - context: prod-context
 //	    metrics:
 //	      type: prometheus
-//	      endpoint: https://prometheus.prod.example.com
+//	      endpoint: ${PROMETHEUS_URL:-https://prometheus.prod.example.com}
 //	      auth:
 //	        bearerTokenExec:
 //	          command: kubectl
@@ -67,6 +72,58 @@ type Config struct {
 	// UI contains configuration for the web UI server.
 	// Optional - if omitted, default UI settings will be used.
 	UI *UIConfig `yaml:"ui,omitempty" json:"ui,omitempty"`
+
+	// Logging contains configuration for writing logs to rotating files.
+	// Optional - if omitted, logs are only written to standard output.
+	Logging *LoggingConfig `yaml:"logging,omitempty" json:"logging,omitempty"`
+
+	// Profiles contains named variants of this configuration, such as the edges of dev,
+	// staging and prod clusters, selected with navctl local --profile.
+	// Optional. The sections set by the selected profile replace the top-level ones.
+	Profiles map[string]ProfileConfig `yaml:"profiles,omitempty" json:"profiles,omitempty"`
+
+	// DefaultProfile specifies the profile used when --profile is not set.
+	// Optional. If omitted, only the top-level sections are used unless a profile is selected.
+	DefaultProfile string `yaml:"defaultProfile,omitempty" json:"defaultProfile,omitempty"`
+}
+
+// ProfileConfig holds a named variant of the configuration.
+//
+// Each section a profile sets replaces the same top-level section when the
+// profile is selected, and the sections it omits are shared with every other
+// profile. Edges are replaced as a whole.
+//
+// Example configuration:
+//
+//	manager:
+//	  port: 8080
+//	defaultProfile: dev
+//	profiles:
+//	  dev:
+//	    edges:
+//	      - context: dev-cluster
+//	  prod:
+//	    edges:
+//	      - context: prod-eu
+//	      - context: prod-us
+//	    ui:
+//	      port: 9082
+type ProfileConfig struct {
+	// Manager replaces the manager configuration when the profile is selected.
+	// Optional. If omitted, the top-level manager configuration is used.
+	Manager *ManagerConfig `yaml:"manager,omitempty" json:"manager,omitempty"`
+
+	// Edges replaces the edges when the profile is selected.
+	// Optional. If omitted, the top-level edges are used.
+	Edges []EdgeConfig `yaml:"edges,omitempty" json:"edges,omitempty"`
+
+	// UI replaces the web UI configuration when the profile is selected.
+	// Optional. If omitted, the top-level web UI configuration is used.
+	UI *UIConfig `yaml:"ui,omitempty" json:"ui,omitempty"`
+
+	// Logging replaces the log file configuration when the profile is selected.
+	// Optional. If omitted, the top-level log file configuration is used.
+	Logging *LoggingConfig `yaml:"logging,omitempty" json:"logging,omitempty"`
 }
 
 // ManagerConfig holds configuration for the Navigator manager service.
@@ -96,6 +153,46 @@ type ManagerConfig struct {
 	// Default: 10
 	// Increase this value if you have large service discovery payloads.
 	MaxMessageSize int `yaml:"maxMessageSize,omitempty" json:"maxMessageSize,omitempty"`
+
+	// MaxClusterStateSize specifies the maximum size in megabytes of a cluster state an edge sends
+	// in chunks. Larger cluster states are rejected.
+	// Default: 256
+	MaxClusterStateSize int `yaml:"maxClusterStateSize,omitempty" json:"maxClusterStateSize,omitempty"`
+
+	// HTTPSocket specifies a unix socket path for the HTTP gateway.
+	// Optional. If set, the HTTP gateway listens on the socket instead of port+1,
+	// so it can sit behind a local reverse proxy without exposing a port.
+	HTTPSocket string `yaml:"httpSocket,omitempty" json:"httpSocket,omitempty"`
+
+	// AdminPort specifies the port of the manager's admin server, which serves the runtime log
+	// level switch and Prometheus metrics without authentication.
+	// Optional. The admin server is disabled by default, and only listens on localhost when enabled.
+	AdminPort int `yaml:"adminPort,omitempty" json:"adminPort,omitempty"`
+
+	// StaleClusterRetention specifies how long, in seconds, the manager keeps serving the last
+	// state of a cluster whose edge disconnected, marked stale.
+	// Default: 900
+	StaleClusterRetention int `yaml:"staleClusterRetention,omitempty" json:"staleClusterRetention,omitempty"`
+
+	// MaxClusterStaleness specifies how long, in seconds, a cluster may go without a state
+	// update before the manager evicts it from aggregation, even while its last state is retained
+	// or its edge is still connected.
+	// Optional. Clusters are never evicted for staleness by default.
+	MaxClusterStaleness int `yaml:"maxClusterStaleness,omitempty" json:"maxClusterStaleness,omitempty"`
+
+	// EvictionWebhook specifies a URL each cluster eviction is posted to as JSON.
+	// Optional. Evictions are only logged by default.
+	EvictionWebhook string `yaml:"evictionWebhook,omitempty" json:"evictionWebhook,omitempty"`
+
+	// EventLogSize specifies how many of the most recent events the manager's event log keeps:
+	// syncs, edge connects and disconnects, resync requests, changes and evictions.
+	// Default: 10000
+	EventLogSize int `yaml:"eventLogSize,omitempty" json:"eventLogSize,omitempty"`
+
+	// EventLogFile specifies a file the manager's event log is persisted to as JSON lines, and
+	// loaded from on startup so events survive restarts.
+	// Optional. Events are kept in memory only by default.
+	EventLogFile string `yaml:"eventLogFile,omitempty" json:"eventLogFile,omitempty"`
 }
 
 // EdgeConfig holds configuration for a single edge service.
@@ -125,8 +222,9 @@ type EdgeConfig struct {
 	Context string `yaml:"context,omitempty" json:"context,omitempty"`
 
 	// Kubeconfig specifies the path to the kubeconfig file.
-	// Optional. If omitted, uses the default kubeconfig location (~/.kube/config).
+	// Optional. If omitted, uses KUBECONFIG or the default kubeconfig location (~/.kube/config).
 	// Can be an absolute path or relative to the working directory.
+	// Can be a list of paths separated like KUBECONFIG, merged with the first file taking precedence.
 	Kubeconfig string `yaml:"kubeconfig,omitempty" json:"kubeconfig,omitempty"`
 
 	// SyncInterval specifies how often to sync cluster state, in seconds.
@@ -144,9 +242,32 @@ type EdgeConfig struct {
 	// Valid values: "text", "json"
 	LogFormat string `yaml:"logFormat,omitempty" json:"logFormat,omitempty"`
 
+	// Labels are metadata labels of this cluster, such as its region, environment or tier.
+	// Optional. Clusters can be filtered by their labels in the UI and API with label selectors.
+	// Keys and values must be valid Kubernetes label keys and values.
+	Labels map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+
+	// LowFootprint disables all optional collection modules of this edge, for a minimal memory footprint
+	// on small or resource-constrained clusters.
+	// Default: false
+	LowFootprint bool `yaml:"lowFootprint,omitempty" json:"lowFootprint,omitempty"`
+
+	// DisabledModules lists optional collection modules this edge does not run.
+	// Optional. All modules run by default.
+	// Valid values: "envoy-filters", "wasm-plugins", "raw-config", "metrics"
+	DisabledModules []string `yaml:"disabledModules,omitempty" json:"disabledModules,omitempty"`
+
 	// Metrics contains configuration for metrics collection from this cluster.
 	// Optional. If omitted, metrics collection is disabled for this edge.
 	Metrics *MetricsConfig `yaml:"metrics,omitempty" json:"metrics,omitempty"`
+
+	// Traces contains configuration for searching traces of this cluster.
+	// Optional. If omitted, trace search is disabled for this edge.
+	Traces *TracesConfig `yaml:"traces,omitempty" json:"traces,omitempty"`
+
+	// AccessLogs contains configuration for searching the proxies' access logs of this cluster.
+	// Optional. If omitted, access log search is disabled for this edge.
+	AccessLogs *AccessLogsConfig `yaml:"accessLogs,omitempty" json:"accessLogs,omitempty"`
 }
 
 // UIConfig holds configuration for the Navigator web UI server.
@@ -167,6 +288,11 @@ type UIConfig struct {
 	// The UI will be accessible at http://localhost:<port>
 	Port int `yaml:"port,omitempty" json:"port,omitempty"`
 
+	// Socket specifies a unix socket path for the web UI server.
+	// Optional. If set, the UI listens on the socket instead of the port, for a
+	// local reverse proxy to serve it. The browser is not opened automatically.
+	Socket string `yaml:"socket,omitempty" json:"socket,omitempty"`
+
 	// Disabled determines whether to start the UI server.
 	// Default: false
 	// Set to true to run navctl without the web interface.
@@ -178,6 +304,51 @@ type UIConfig struct {
 	NoBrowser bool `yaml:"noBrowser,omitempty" json:"noBrowser,omitempty"`
 }
 
+// LoggingConfig holds configuration for writing logs to rotating files.
+//
+// Logs are still written to standard output. In addition, every record is written
+// to local.log in the directory, and the records of the manager, each edge and the
+// UI are also written to manager.log, edge-<context>.log and ui.log, so the logs
+// of a long-running navctl local can be followed per component.
+//
+// Example configuration:
+//
+//	logging:
+//	  directory: ${HOME}/.navigator/logs
+//	  maxSize: 50
+//	  maxBackups: 10
+//	  rotationInterval: 86400
+type LoggingConfig struct {
+	// Directory specifies the directory log files are written to.
+	// Required. Created if it does not exist. Environment variables are expanded.
+	Directory string `yaml:"directory" json:"directory"`
+
+	// Format specifies the format of the log files.
+	// Default: "text"
+	// Valid values: "text", "json"
+	Format string `yaml:"format,omitempty" json:"format,omitempty"`
+
+	// MaxSize specifies the size in megabytes a log file grows to before it is rotated.
+	// Default: 100
+	MaxSize int `yaml:"maxSize,omitempty" json:"maxSize,omitempty"`
+
+	// MaxBackups specifies how many rotated files of each log file are kept.
+	// Default: 5
+	MaxBackups int `yaml:"maxBackups,omitempty" json:"maxBackups,omitempty"`
+
+	// MaxAge specifies how many days rotated files are kept.
+	// Optional. Rotated files are kept regardless of their age by default.
+	MaxAge int `yaml:"maxAge,omitempty" json:"maxAge,omitempty"`
+
+	// RotationInterval specifies how often, in seconds, log files are rotated regardless of their size.
+	// Optional. Log files are only rotated by size by default.
+	RotationInterval int `yaml:"rotationInterval,omitempty" json:"rotationInterval,omitempty"`
+
+	// Compress determines whether rotated files are gzipped.
+	// Default: false
+	Compress bool `yaml:"compress,omitempty" json:"compress,omitempty"`
+}
+
 // MetricsConfig holds configuration for metrics collection from a cluster.
 //
 // Navigator supports pluggable metrics providers, with Prometheus being
@@ -190,6 +361,8 @@ type UIConfig struct {
 //	metrics:
 //	  type: prometheus
 //	  endpoint: https://prometheus.prod.example.com
+//	  secondaryEndpoint: https://thanos.prod.example.com
+//	  secondaryMode: failover
 //	  queryInterval: 30
 //	  timeout: 10
 //	  auth:
@@ -203,10 +376,26 @@ type MetricsConfig struct {
 	Type string `yaml:"type" json:"type"`
 
 	// Endpoint specifies the URL for the metrics provider.
-	// Required. For Prometheus, this should be the base URL (e.g., https://prometheus.example.com).
-	// The endpoint should be accessible from where navctl is running.
+	// Optional. For Prometheus, this should be the base URL (e.g., https://prometheus.example.com).
+	// The endpoint should be accessible from where navctl is running, or be an in-cluster Service
+	// such as http://prometheus.istio-system:9090, which is port-forwarded automatically.
+	// If omitted, a well-known Prometheus installation is discovered in the cluster: the Istio
+	// addon, kube-prometheus-stack, the Prometheus Operator or the prometheus-community chart.
+	// Environment variables are expanded, e.g. ${PROMETHEUS_URL:-http://prometheus.istio-system:9090}.
 	Endpoint string `yaml:"endpoint" json:"endpoint"`
 
+	// SecondaryEndpoint specifies the URL of a second source of the same metrics.
+	// Optional. For example, a local Prometheus as the endpoint and Thanos as the secondary endpoint.
+	// In-cluster Services are port-forwarded like the endpoint, and the same auth is used for both.
+	SecondaryEndpoint string `yaml:"secondaryEndpoint,omitempty" json:"secondaryEndpoint,omitempty"`
+
+	// SecondaryMode specifies how the secondary endpoint is used: "failover" or "merge".
+	// Default: failover
+	// Valid values: "failover", "merge"
+	// With failover, the secondary endpoint is only queried when the endpoint fails. With merge, both
+	// are queried and their series are merged, for metrics federated across the two sources.
+	SecondaryMode string `yaml:"secondaryMode,omitempty" json:"secondaryMode,omitempty"`
+
 	// QueryInterval specifies how often to query for metrics, in seconds.
 	// Default: 30
 	// Lower values provide more real-time metrics but increase load on the metrics provider.
@@ -221,6 +410,133 @@ type MetricsConfig struct {
 	// Optional. If omitted, no authentication is used.
 	// Supports static bearer tokens and dynamic token generation via exec commands.
 	Auth *MetricsAuth `yaml:"auth,omitempty" json:"auth,omitempty"`
+
+	// LabelMapping maps standard Istio metric labels to the label names used in the metrics.
+	// Optional. Use this when Telemetry or EnvoyFilter customization renames metric dimensions,
+	// e.g. {destination_canonical_service: dst_service}. Labels that are not mapped keep their name.
+	// Mappable labels: source_cluster, source_workload_namespace, source_canonical_service,
+	// source_workload, destination_cluster, destination_service_namespace,
+	// destination_canonical_service, destination_workload, reporter, pod and namespace.
+	LabelMapping map[string]string `yaml:"labelMapping,omitempty" json:"labelMapping,omitempty"`
+
+	// QueryTemplates overrides the queries used to retrieve service connection metrics.
+	// Optional. If omitted, the default Istio metric and label names are queried.
+	// Use this when Telemetry customization changes the labels of the Istio metrics.
+	QueryTemplates *MetricsQueryTemplates `yaml:"queryTemplates,omitempty" json:"queryTemplates,omitempty"`
+}
+
+// MetricsQueryTemplates holds Prometheus query templates overriding the defaults.
+//
+// Each template is a Go text/template given {{.ServiceName}}, {{.ServiceNamespace}},
+// {{.FilterClause}} and {{.TimeRange}}. Results must keep the source_cluster,
+// source_workload_namespace, source_canonical_service, destination_cluster,
+// destination_service_namespace and destination_canonical_service labels, or the
+// custom labels they are mapped to with labelMapping. Latency distributions must also keep le.
+// Templates are validated when navctl starts. Omitted templates use the default query.
+//
+// Example configuration:
+//
+//	queryTemplates:
+//	  inboundRequestRate: |
+//	    sum by (source_cluster, source_workload_namespace, source_canonical_service,
+//	      destination_cluster, destination_service_namespace, destination_canonical_service)(
+//	      rate(istio_requests_total{reporter="destination", destination_canonical_service="{{.ServiceName}}",
+//	        destination_service_namespace="{{.ServiceNamespace}}"{{.FilterClause}}}[{{.TimeRange}}]))
+type MetricsQueryTemplates struct {
+	// InboundRequestRate queries the rate of requests received by the service.
+	InboundRequestRate string `yaml:"inboundRequestRate,omitempty" json:"inboundRequestRate,omitempty"`
+
+	// OutboundRequestRate queries the rate of requests sent by the service.
+	OutboundRequestRate string `yaml:"outboundRequestRate,omitempty" json:"outboundRequestRate,omitempty"`
+
+	// InboundErrorRate queries the rate of failed requests received by the service.
+	InboundErrorRate string `yaml:"inboundErrorRate,omitempty" json:"inboundErrorRate,omitempty"`
+
+	// OutboundErrorRate queries the rate of failed requests sent by the service.
+	OutboundErrorRate string `yaml:"outboundErrorRate,omitempty" json:"outboundErrorRate,omitempty"`
+
+	// InboundLatencyDistribution queries the latency histogram buckets of requests received by the service.
+	InboundLatencyDistribution string `yaml:"inboundLatencyDistribution,omitempty" json:"inboundLatencyDistribution,omitempty"`
+
+	// OutboundLatencyDistribution queries the latency histogram buckets of requests sent by the service.
+	OutboundLatencyDistribution string `yaml:"outboundLatencyDistribution,omitempty" json:"outboundLatencyDistribution,omitempty"`
+}
+
+// TracesConfig holds configuration for the tracing backend of a cluster.
+//
+// Navigator searches the tracing backend for recent traces of a service, so slow
+// or failing requests can be followed into Jaeger or Grafana Tempo.
+//
+// Example configuration:
+//
+//	traces:
+//	  type: jaeger
+//	  endpoint: http://tracing.istio-system:80
+//	  timeout: 10
+type TracesConfig struct {
+	// Type specifies the tracing backend type.
+	// Valid values: "jaeger", "tempo"
+	// Required when trace search is enabled.
+	Type string `yaml:"type" json:"type"`
+
+	// Endpoint specifies the URL of the tracing backend's query API.
+	// Required. For Jaeger this is the query service, for Tempo the query frontend.
+	// In-cluster Services such as http://tracing.istio-system:80 are port-forwarded automatically.
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+
+	// Timeout specifies the timeout for trace searches, in seconds.
+	// Default: 10
+	Timeout int `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// BearerToken specifies a static bearer token for authentication.
+	// Optional. Environment variables are expanded, e.g. ${TEMPO_TOKEN}.
+	BearerToken string `yaml:"bearerToken,omitempty" json:"bearerToken,omitempty"`
+}
+
+// AccessLogsConfig holds configuration for the logs backend storing a cluster's access logs.
+//
+// Navigator searches the Envoy access logs of a service's proxies in the logs
+// backend, filtered by response code and path, complementing live pod logs
+// with indexed historical search.
+//
+// Example configuration:
+//
+//	accessLogs:
+//	  type: loki
+//	  endpoint: http://loki.monitoring:3100
+//	  format: json
+//	  serviceLabel: app
+type AccessLogsConfig struct {
+	// Type specifies the logs backend type.
+	// Currently supported: "loki"
+	// Default: loki
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+
+	// Endpoint specifies the URL of the logs backend's query API.
+	// Required. In-cluster Services such as http://loki.monitoring:3100 are port-forwarded automatically.
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+
+	// Timeout specifies the timeout for access log searches, in seconds.
+	// Default: 10
+	Timeout int `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// BearerToken specifies a static bearer token for authentication.
+	// Optional. Environment variables are expanded, e.g. ${LOKI_TOKEN}.
+	BearerToken string `yaml:"bearerToken,omitempty" json:"bearerToken,omitempty"`
+
+	// TenantID specifies the tenant sent as the X-Scope-OrgID header to multi-tenant backends.
+	// Optional.
+	TenantID string `yaml:"tenantID,omitempty" json:"tenantID,omitempty"`
+
+	// Format specifies the encoding of the proxies' access logs: "text" or "json".
+	// Default: text
+	// Valid values: "text", "json"
+	// Use json when the mesh sets accessLogEncoding: JSON.
+	Format string `yaml:"format,omitempty" json:"format,omitempty"`
+
+	// ServiceLabel specifies the log stream label holding the name of a pod's service.
+	// Default: app
+	ServiceLabel string `yaml:"serviceLabel,omitempty" json:"serviceLabel,omitempty"`
 }
 
 // MetricsAuth holds authentication configuration for metrics providers.
@@ -246,6 +562,7 @@ type MetricsAuth struct {
 	// BearerToken specifies a static bearer token for authentication.
 	// Optional. Mutually exclusive with BearerTokenExec.
 	// Use this for long-lived tokens or when you want to manage token rotation externally.
+	// Environment variables are expanded, e.g. ${PROMETHEUS_TOKEN}, to keep the token out of the file.
 	BearerToken string `yaml:"bearerToken,omitempty" json:"bearerToken,omitempty"`
 
 	// BearerTokenExec specifies a command to execute to obtain a bearer token.
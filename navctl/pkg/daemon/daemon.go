@@ -0,0 +1,135 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package daemon runs navctl in the background, tracking the background process with a PID file.
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// detachedEnvVar marks the environment of a background process started by Start
+const detachedEnvVar = "NAVCTL_DETACHED"
+
+// pollInterval is how often Stop checks whether the process exited
+const pollInterval = 100 * time.Millisecond
+
+// Detached reports whether the current process was started in the background by Start
+func Detached() bool {
+	return os.Getenv(detachedEnvVar) == "1"
+}
+
+// Start runs a command in the background, detached from the terminal, with its output appended to
+// logFile, and records its PID in pidFile. It fails if the PID file belongs to a process that is still
+// running.
+func Start(pidFile, logFile, name string, args ...string) (int, error) {
+	if pid, running, err := Status(pidFile); err != nil {
+		return 0, err
+	} else if running {
+		return 0, fmt.Errorf("already running with pid %d (PID file %s)", pid, pidFile)
+	}
+
+	for _, path := range []string{pidFile, logFile} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return 0, fmt.Errorf("failed to create directory for %s: %w", path, err)
+		}
+	}
+	logs, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() { _ = logs.Close() }()
+
+	cmd := exec.Command(name, args...)
+	cmd.Env = append(os.Environ(), detachedEnvVar+"=1")
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	cmd.SysProcAttr = detachedSysProcAttr()
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("failed to start background process: %w", err)
+	}
+	pid := cmd.Process.Pid
+
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(pid)+"\n"), 0o600); err != nil {
+		_ = cmd.Process.Kill()
+		return 0, fmt.Errorf("failed to write PID file: %w", err)
+	}
+
+	// Reap the process if it exits while this one is still running
+	go func() { _ = cmd.Wait() }()
+	return pid, nil
+}
+
+// Status returns the PID recorded in pidFile and whether that process is running. A missing PID file
+// means not running.
+func Status(pidFile string) (int, bool, error) {
+	data, err := os.ReadFile(pidFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read PID file: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, false, fmt.Errorf("invalid PID file %s", pidFile)
+	}
+	return pid, alive(pid), nil
+}
+
+// Stop asks the process recorded in pidFile to shut down gracefully and waits up to timeout for it to
+// exit, then removes the PID file. It returns the PID of the stopped process.
+func Stop(pidFile string, timeout time.Duration) (int, error) {
+	pid, running, err := Status(pidFile)
+	if err != nil {
+		return 0, err
+	}
+	if pid == 0 {
+		return 0, fmt.Errorf("not running (no PID file %s)", pidFile)
+	}
+	if !running {
+		_ = os.Remove(pidFile)
+		return pid, fmt.Errorf("not running (removed stale PID file for pid %d)", pid)
+	}
+
+	if err := terminate(pid); err != nil {
+		return pid, fmt.Errorf("failed to stop pid %d: %w", pid, err)
+	}
+	for deadline := time.Now().Add(timeout); alive(pid); {
+		if time.Now().After(deadline) {
+			return pid, fmt.Errorf("pid %d did not exit within %s", pid, timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+
+	// The process normally removes its own PID file on shutdown
+	if err := os.Remove(pidFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return pid, fmt.Errorf("failed to remove PID file: %w", err)
+	}
+	return pid, nil
+}
+
+// Cleanup removes pidFile if it records the current process
+func Cleanup(pidFile string) {
+	if pid, _, err := Status(pidFile); err == nil && pid == os.Getpid() {
+		_ = os.Remove(pidFile)
+	}
+}
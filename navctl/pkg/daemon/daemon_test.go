@@ -0,0 +1,86 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "navctl.pid")
+
+	// No PID file means not running
+	pid, running, err := Status(pidFile)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pid)
+	assert.False(t, running)
+
+	require.NoError(t, os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())+"\n"), 0o600))
+	pid, running, err = Status(pidFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.Getpid(), pid)
+	assert.True(t, running)
+
+	// Cleanup only removes the PID file of the current process
+	Cleanup(pidFile)
+	assert.NoFileExists(t, pidFile)
+
+	require.NoError(t, os.WriteFile(pidFile, []byte("not a pid"), 0o600))
+	_, _, err = Status(pidFile)
+	assert.Error(t, err)
+}
+
+func TestStartStop(t *testing.T) {
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "run", "navctl.pid")
+	logFile := filepath.Join(dir, "log", "navctl.log")
+
+	pid, err := Start(pidFile, logFile, "sh", "-c", "echo detached=$"+detachedEnvVar+"; exec sleep 60")
+	require.NoError(t, err)
+
+	status, running, err := Status(pidFile)
+	require.NoError(t, err)
+	assert.Equal(t, pid, status)
+	assert.True(t, running)
+
+	// A second background process is refused while the first runs
+	_, err = Start(pidFile, logFile, "sleep", "60")
+	assert.ErrorContains(t, err, "already running")
+
+	// The child writes its output before it is stopped
+	require.Eventually(t, func() bool {
+		logs, err := os.ReadFile(logFile)
+		return err == nil && string(logs) == "detached=1\n"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stopped, err := Stop(pidFile, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, pid, stopped)
+	assert.NoFileExists(t, pidFile)
+
+	// Stopping again reports that nothing runs
+	_, err = Stop(pidFile, time.Second)
+	assert.ErrorContains(t, err, "not running")
+}
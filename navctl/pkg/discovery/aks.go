@@ -0,0 +1,89 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// aksProvider discovers AKS clusters with the az CLI
+type aksProvider struct {
+	subscriptions []string
+	run           runFunc
+	logger        *slog.Logger
+}
+
+// aksCluster is an AKS cluster as listed by az, with the subscription it was listed in
+type aksCluster struct {
+	Name          string `json:"name"`
+	ResourceGroup string `json:"resourceGroup"`
+	subscription  string
+}
+
+func (p *aksProvider) Name() string {
+	return "aks"
+}
+
+func (p *aksProvider) Discover(ctx context.Context) (*clientcmdapi.Config, error) {
+	subscriptions := p.subscriptions
+	if len(subscriptions) == 0 {
+		subscriptions = []string{""}
+	}
+
+	var clusters []aksCluster
+	for _, subscription := range subscriptions {
+		out, err := p.run(ctx, "az", withSubscription([]string{"aks", "list", "--output", "json"}, subscription)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list AKS clusters: %w", err)
+		}
+		var list []aksCluster
+		if err := json.Unmarshal(out, &list); err != nil {
+			return nil, fmt.Errorf("failed to parse AKS clusters: %w", err)
+		}
+		p.logger.Debug("listed AKS clusters", "subscription", subscription, "count", len(list))
+		for _, cluster := range list {
+			cluster.subscription = subscription
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	// The az CLI prints the kubeconfig of a cluster instead of merging it when the file is "-"
+	return forEach(ctx, clusters, func(ctx context.Context, cluster aksCluster) (*clientcmdapi.Config, error) {
+		args := []string{"aks", "get-credentials", "--name", cluster.Name, "--resource-group", cluster.ResourceGroup, "--file", "-"}
+		out, err := p.run(ctx, "az", withSubscription(args, cluster.subscription)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credentials for AKS cluster %s: %w", cluster.Name, err)
+		}
+		config, err := clientcmd.Load(out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse kubeconfig of AKS cluster %s: %w", cluster.Name, err)
+		}
+		return config, nil
+	})
+}
+
+// withSubscription appends the --subscription flag if a subscription is given
+func withSubscription(args []string, subscription string) []string {
+	if subscription == "" {
+		return args
+	}
+	return append(args, "--subscription", subscription)
+}
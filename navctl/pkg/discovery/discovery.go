@@ -0,0 +1,166 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package discovery lists the Kubernetes clusters of a cloud provider and generates kubeconfig contexts
+// and a navctl configuration with one edge per cluster.
+//
+// Providers are queried through their CLIs (aws, gcloud, az), so discovery uses the same credentials,
+// profiles and defaults as the user's shell. The generated contexts authenticate with the provider's exec
+// credential plugin, so tokens are refreshed for as long as the CLI is logged in.
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"sort"
+	"strings"
+
+	navctlConfig "github.com/liamawhite/navigator/navctl/pkg/config"
+	"golang.org/x/sync/errgroup"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// maxConcurrentCalls bounds the CLI calls made at once while fetching the credentials of each cluster
+const maxConcurrentCalls = 8
+
+// Provider lists the clusters of a cloud provider
+type Provider interface {
+	// Name returns the provider name used by --provider
+	Name() string
+
+	// Discover lists the clusters and returns a kubeconfig with a context for each of them
+	Discover(ctx context.Context) (*clientcmdapi.Config, error)
+}
+
+// Options scope discovery. Each provider only uses its own scopes and falls back to the CLI's defaults
+// when they are empty.
+type Options struct {
+	Regions       []string // AWS regions to list EKS clusters in
+	Projects      []string // GCP projects to list GKE clusters in
+	Subscriptions []string // Azure subscriptions to list AKS clusters in
+}
+
+// runFunc runs a CLI command and returns its standard output
+type runFunc func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+// Providers are the names of the supported providers
+var Providers = []string{"eks", "gke", "aks"}
+
+// NewProvider creates the provider with the given name
+func NewProvider(name string, opts Options, logger *slog.Logger) (Provider, error) {
+	return newProvider(name, opts, logger, runCommand)
+}
+
+func newProvider(name string, opts Options, logger *slog.Logger, run runFunc) (Provider, error) {
+	switch name {
+	case "eks":
+		return &eksProvider{regions: opts.Regions, run: run, logger: logger}, nil
+	case "gke":
+		return &gkeProvider{projects: opts.Projects, run: run, logger: logger}, nil
+	case "aks":
+		return &aksProvider{subscriptions: opts.Subscriptions, run: run, logger: logger}, nil
+	default:
+		return nil, fmt.Errorf("unsupported provider %q, must be one of: %s", name, strings.Join(Providers, ", "))
+	}
+}
+
+// runCommand runs a CLI command, including its standard error in the returned error
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%s %s failed: %w: %s", name, strings.Join(args, " "), err, msg)
+		}
+		return nil, fmt.Errorf("%s %s failed: %w", name, strings.Join(args, " "), err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// forEach calls fn for every item with bounded concurrency and merges the kubeconfigs it returns
+func forEach[T any](ctx context.Context, items []T, fn func(ctx context.Context, item T) (*clientcmdapi.Config, error)) (*clientcmdapi.Config, error) {
+	configs := make([]*clientcmdapi.Config, len(items))
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(maxConcurrentCalls)
+	for i, item := range items {
+		group.Go(func() error {
+			config, err := fn(ctx, item)
+			configs[i] = config
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	merged := clientcmdapi.NewConfig()
+	for _, config := range configs {
+		Merge(merged, config)
+	}
+	return merged, nil
+}
+
+// Merge adds the clusters, users and contexts of src to dst, replacing entries with the same name. The
+// current context of dst is kept if set.
+func Merge(dst, src *clientcmdapi.Config) {
+	for name, cluster := range src.Clusters {
+		dst.Clusters[name] = cluster
+	}
+	for name, authInfo := range src.AuthInfos {
+		dst.AuthInfos[name] = authInfo
+	}
+	for name, kubeContext := range src.Contexts {
+		dst.Contexts[name] = kubeContext
+	}
+	if dst.CurrentContext == "" {
+		dst.CurrentContext = src.CurrentContext
+	}
+}
+
+// Contexts returns the context names of a kubeconfig, sorted
+func Contexts(config *clientcmdapi.Config) []string {
+	contexts := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+	return contexts
+}
+
+// NavctlConfig returns a navctl configuration with one edge for each context, reading the kubeconfig
+// at kubeconfigPath
+func NavctlConfig(contexts []string, kubeconfigPath string) *navctlConfig.Config {
+	edges := make([]navctlConfig.EdgeConfig, len(contexts))
+	for i, contextName := range contexts {
+		edges[i] = navctlConfig.EdgeConfig{
+			Context:    contextName,
+			Kubeconfig: kubeconfigPath,
+		}
+	}
+
+	return &navctlConfig.Config{
+		APIVersion: "navigator.io/v1alpha1",
+		Kind:       "NavctlConfig",
+		Manager: &navctlConfig.ManagerConfig{
+			Host: "localhost",
+			Port: 8080,
+		},
+		Edges: edges,
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	navctlConfig "github.com/liamawhite/navigator/navctl/pkg/config"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeCLI answers CLI commands with canned output, keyed by the command line
+type fakeCLI struct {
+	mu      sync.Mutex
+	outputs map[string]string
+	calls   []string
+}
+
+func (f *fakeCLI) run(_ context.Context, name string, args ...string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	command := name + " " + strings.Join(args, " ")
+	f.calls = append(f.calls, command)
+	out, exists := f.outputs[command]
+	if !exists {
+		return nil, fmt.Errorf("unexpected command: %s", command)
+	}
+	return []byte(out), nil
+}
+
+// kubeconfig returns a kubeconfig with a single context, like the aws and az CLIs print
+func kubeconfig(name, server string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: %[2]s
+users:
+- name: %[1]s
+  user:
+    token: token
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+current-context: %[1]s
+`, name, server)
+}
+
+func TestProvider_Discover_eks(t *testing.T) {
+	cli := &fakeCLI{outputs: map[string]string{
+		"aws eks list-clusters --output json --region us-east-1":                `{"clusters":["prod"]}`,
+		"aws eks list-clusters --output json --region eu-west-1":                `{"clusters":["staging"]}`,
+		"aws eks update-kubeconfig --name prod --dry-run --region us-east-1":    kubeconfig("arn:aws:eks:us-east-1:1:cluster/prod", "https://prod.eks"),
+		"aws eks update-kubeconfig --name staging --dry-run --region eu-west-1": kubeconfig("arn:aws:eks:eu-west-1:1:cluster/staging", "https://staging.eks"),
+	}}
+	provider, err := newProvider("eks", Options{Regions: []string{"us-east-1", "eu-west-1"}}, logging.For("test"), cli.run)
+	require.NoError(t, err)
+
+	config, err := provider.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:eks:eu-west-1:1:cluster/staging", "arn:aws:eks:us-east-1:1:cluster/prod"}, Contexts(config))
+	assert.Equal(t, "https://prod.eks", config.Clusters["arn:aws:eks:us-east-1:1:cluster/prod"].Server)
+}
+
+func TestProvider_Discover_gke(t *testing.T) {
+	cli := &fakeCLI{outputs: map[string]string{
+		"gcloud container clusters list --format json": `[{
+			"name": "prod",
+			"location": "us-central1",
+			"endpoint": "10.0.0.1",
+			"selfLink": "https://container.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/prod",
+			"masterAuth": {"clusterCaCertificate": "Y2E="}
+		}]`,
+	}}
+	provider, err := newProvider("gke", Options{}, logging.For("test"), cli.run)
+	require.NoError(t, err)
+
+	config, err := provider.Discover(context.Background())
+	require.NoError(t, err)
+
+	// Contexts are named like gcloud names them and authenticate with the GKE exec plugin
+	name := "gke_my-project_us-central1_prod"
+	assert.Equal(t, []string{name}, Contexts(config))
+	assert.Equal(t, "https://10.0.0.1", config.Clusters[name].Server)
+	assert.Equal(t, []byte("ca"), config.Clusters[name].CertificateAuthorityData)
+	assert.Equal(t, "gke-gcloud-auth-plugin", config.AuthInfos[name].Exec.Command)
+	assert.Len(t, cli.calls, 1)
+}
+
+func TestProvider_Discover_aks(t *testing.T) {
+	cli := &fakeCLI{outputs: map[string]string{
+		"az aks list --output json --subscription sub":                                       `[{"name":"prod","resourceGroup":"rg"}]`,
+		"az aks get-credentials --name prod --resource-group rg --file - --subscription sub": kubeconfig("prod", "https://prod.aks"),
+	}}
+	provider, err := newProvider("aks", Options{Subscriptions: []string{"sub"}}, logging.For("test"), cli.run)
+	require.NoError(t, err)
+
+	config, err := provider.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod"}, Contexts(config))
+	assert.Equal(t, "https://prod.aks", config.Clusters["prod"].Server)
+}
+
+func TestProvider_Discover_error(t *testing.T) {
+	cli := &fakeCLI{outputs: map[string]string{
+		"aws eks list-clusters --output json": `{"clusters":["prod"]}`,
+	}}
+	provider, err := newProvider("eks", Options{}, logging.For("test"), cli.run)
+	require.NoError(t, err)
+
+	_, err = provider.Discover(context.Background())
+	assert.ErrorContains(t, err, "failed to get credentials for EKS cluster prod")
+}
+
+func TestNewProvider_unsupported(t *testing.T) {
+	_, err := NewProvider("openshift", Options{}, logging.For("test"))
+	assert.EqualError(t, err, `unsupported provider "openshift", must be one of: eks, gke, aks`)
+}
+
+func TestNavctlConfig(t *testing.T) {
+	config := NavctlConfig([]string{"prod", "staging"}, "/home/user/.kube/config")
+
+	assert.Equal(t, "NavctlConfig", config.Kind)
+	require.Len(t, config.Edges, 2)
+	assert.Equal(t, "prod", config.Edges[0].Context)
+	assert.Equal(t, "staging", config.Edges[1].Context)
+	assert.Equal(t, "/home/user/.kube/config", config.Edges[1].Kubeconfig)
+
+	// The generated config is accepted by navctl local
+	data, err := yaml.Marshal(config)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "navctl-config.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	loaded, err := navctlConfig.LoadConfig(path, "")
+	require.NoError(t, err)
+	assert.Len(t, loaded.Edges, 2)
+}
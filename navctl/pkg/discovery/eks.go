@@ -0,0 +1,88 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// eksProvider discovers EKS clusters with the aws CLI
+type eksProvider struct {
+	regions []string
+	run     runFunc
+	logger  *slog.Logger
+}
+
+// eksCluster is an EKS cluster in a region, empty for the CLI's default region
+type eksCluster struct {
+	name   string
+	region string
+}
+
+func (p *eksProvider) Name() string {
+	return "eks"
+}
+
+func (p *eksProvider) Discover(ctx context.Context) (*clientcmdapi.Config, error) {
+	regions := p.regions
+	if len(regions) == 0 {
+		regions = []string{""}
+	}
+
+	var clusters []eksCluster
+	for _, region := range regions {
+		out, err := p.run(ctx, "aws", withRegion([]string{"eks", "list-clusters", "--output", "json"}, region)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list EKS clusters: %w", err)
+		}
+		var list struct {
+			Clusters []string `json:"clusters"`
+		}
+		if err := json.Unmarshal(out, &list); err != nil {
+			return nil, fmt.Errorf("failed to parse EKS clusters: %w", err)
+		}
+		p.logger.Debug("listed EKS clusters", "region", region, "count", len(list.Clusters))
+		for _, name := range list.Clusters {
+			clusters = append(clusters, eksCluster{name: name, region: region})
+		}
+	}
+
+	// The aws CLI generates the context with its exec credential plugin, without writing it
+	return forEach(ctx, clusters, func(ctx context.Context, cluster eksCluster) (*clientcmdapi.Config, error) {
+		out, err := p.run(ctx, "aws", withRegion([]string{"eks", "update-kubeconfig", "--name", cluster.name, "--dry-run"}, cluster.region)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credentials for EKS cluster %s: %w", cluster.name, err)
+		}
+		config, err := clientcmd.Load(out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse kubeconfig of EKS cluster %s: %w", cluster.name, err)
+		}
+		return config, nil
+	})
+}
+
+// withRegion appends the --region flag if a region is given
+func withRegion(args []string, region string) []string {
+	if region == "" {
+		return args
+	}
+	return append(args, "--region", region)
+}
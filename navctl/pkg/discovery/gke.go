@@ -0,0 +1,123 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// gkeProvider discovers GKE clusters with the gcloud CLI
+type gkeProvider struct {
+	projects []string
+	run      runFunc
+	logger   *slog.Logger
+}
+
+// gkeCluster is a GKE cluster as listed by gcloud
+type gkeCluster struct {
+	Name       string `json:"name"`
+	Location   string `json:"location"`
+	Endpoint   string `json:"endpoint"`
+	SelfLink   string `json:"selfLink"`
+	MasterAuth struct {
+		ClusterCACertificate string `json:"clusterCaCertificate"`
+	} `json:"masterAuth"`
+}
+
+func (p *gkeProvider) Name() string {
+	return "gke"
+}
+
+func (p *gkeProvider) Discover(ctx context.Context) (*clientcmdapi.Config, error) {
+	projects := p.projects
+	if len(projects) == 0 {
+		projects = []string{""}
+	}
+
+	config := clientcmdapi.NewConfig()
+	for _, project := range projects {
+		args := []string{"container", "clusters", "list", "--format", "json"}
+		if project != "" {
+			args = append(args, "--project", project)
+		}
+		out, err := p.run(ctx, "gcloud", args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list GKE clusters: %w", err)
+		}
+		var clusters []gkeCluster
+		if err := json.Unmarshal(out, &clusters); err != nil {
+			return nil, fmt.Errorf("failed to parse GKE clusters: %w", err)
+		}
+		p.logger.Debug("listed GKE clusters", "project", project, "count", len(clusters))
+
+		// The list has everything the kubeconfig needs, so no call per cluster is required
+		for _, cluster := range clusters {
+			if err := addGKECluster(config, cluster, project); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return config, nil
+}
+
+// addGKECluster adds a context for a GKE cluster, named like gcloud's get-credentials names it
+func addGKECluster(config *clientcmdapi.Config, cluster gkeCluster, project string) error {
+	if project == "" {
+		project = gkeProject(cluster.SelfLink)
+	}
+	ca, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCACertificate)
+	if err != nil {
+		return fmt.Errorf("failed to decode CA certificate of GKE cluster %s: %w", cluster.Name, err)
+	}
+
+	name := fmt.Sprintf("gke_%s_%s_%s", project, cluster.Location, cluster.Name)
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   "https://" + cluster.Endpoint,
+		CertificateAuthorityData: ca,
+	}
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:         "client.authentication.k8s.io/v1beta1",
+			Command:            "gke-gcloud-auth-plugin",
+			InstallHint:        "Install gke-gcloud-auth-plugin for use with kubectl by following https://cloud.google.com/kubernetes-engine/docs/how-to/cluster-access-for-kubectl#install_plugin",
+			ProvideClusterInfo: true,
+			InteractiveMode:    clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+	config.Contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: name,
+	}
+	return nil
+}
+
+// gkeProject returns the project of a cluster from its self link, e.g.
+// https://container.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/my-cluster
+func gkeProject(selfLink string) string {
+	parts := strings.Split(selfLink, "/")
+	for i, part := range parts {
+		if part == "projects" && i+1 < len(parts) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeconfig notices changes to kubeconfig files that affect the clients of their contexts, and
+// reads contexts the way kubectl does.
+package kubeconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// persistedAuthProviderKeys are auth provider settings that clients write back to the kubeconfig
+// themselves when they refresh tokens, and that clients pick up without restarting
+var persistedAuthProviderKeys = map[string]bool{"id-token": true, "refresh-token": true, "access-token": true, "expiry": true}
+
+// contextClient is what a client of a context connects to and authenticates with
+type contextClient struct {
+	Namespace string        `json:"namespace,omitempty"`
+	Cluster   *api.Cluster  `json:"cluster"`
+	AuthInfo  *api.AuthInfo `json:"user"`
+}
+
+// Fingerprint returns a digest of the cluster and credentials of a context, or of the current context if
+// the name is empty. It changes when a client of the context would connect or authenticate differently,
+// and is empty if the context does not exist.
+func Fingerprint(config *api.Config, contextName string) (string, error) {
+	if contextName == "" {
+		contextName = config.CurrentContext
+	}
+	kubeContext, exists := config.Contexts[contextName]
+	if !exists {
+		return "", nil
+	}
+
+	client := contextClient{Namespace: kubeContext.Namespace}
+	if cluster, exists := config.Clusters[kubeContext.Cluster]; exists {
+		client.Cluster = cluster.DeepCopy()
+		client.Cluster.Extensions = nil
+	}
+	if authInfo, exists := config.AuthInfos[kubeContext.AuthInfo]; exists {
+		client.AuthInfo = authInfo.DeepCopy()
+		client.AuthInfo.Extensions = nil
+		if provider := client.AuthInfo.AuthProvider; provider != nil {
+			for key := range provider.Config {
+				if persistedAuthProviderKeys[key] {
+					delete(provider.Config, key)
+				}
+			}
+		}
+	}
+
+	data, err := json.Marshal(client)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal context %s: %w", contextName, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Namespace returns the namespace of a context, or of the current context if the name is empty, like
+// kubectl's default for --namespace. It is "default" if the context sets no namespace or there is no
+// current context.
+func Namespace(config *api.Config, contextName string) (string, error) {
+	name := contextName
+	if name == "" {
+		name = config.CurrentContext
+	}
+	kubeContext, exists := config.Contexts[name]
+	if !exists && contextName != "" {
+		return "", fmt.Errorf("context %q does not exist in the kubeconfig", contextName)
+	}
+	if !exists || kubeContext.Namespace == "" {
+		return "default", nil
+	}
+	return kubeContext.Namespace, nil
+}
+
+// fileState is what a Watcher compares to notice that a file changed
+type fileState struct {
+	modTime int64 // Nanoseconds since the Unix epoch
+	size    int64
+	exists  bool
+}
+
+// Watcher polls kubeconfig files for changes. Polling rather than watching the files notices files
+// that are replaced rather than written, as editors and cloud CLIs often do, and files that are created
+// after the watcher started.
+type Watcher struct {
+	paths    []string
+	interval time.Duration
+	states   map[string]fileState
+}
+
+// NewWatcher creates a watcher of kubeconfig files, given as path lists like KUBECONFIG
+func NewWatcher(interval time.Duration, pathLists ...string) *Watcher {
+	w := &Watcher{interval: interval, states: make(map[string]fileState)}
+	for _, pathList := range pathLists {
+		for _, path := range filepath.SplitList(pathList) {
+			if _, seen := w.states[path]; seen || path == "" {
+				continue
+			}
+			w.paths = append(w.paths, path)
+			w.states[path] = stat(path)
+		}
+	}
+	return w
+}
+
+// Run calls onChange whenever any of the files changed since the previous poll, until ctx is done
+func (w *Watcher) Run(ctx context.Context, onChange func()) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if w.poll() {
+				onChange()
+			}
+		}
+	}
+}
+
+// poll records the current state of the files and reports whether any of them changed
+func (w *Watcher) poll() bool {
+	changed := false
+	for _, path := range w.paths {
+		state := stat(path)
+		if state != w.states[path] {
+			w.states[path] = state
+			changed = true
+		}
+	}
+	return changed
+}
+
+func stat(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{modTime: info.ModTime().UnixNano(), size: info.Size(), exists: true}
+}
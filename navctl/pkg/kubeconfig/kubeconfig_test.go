@@ -0,0 +1,123 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func testConfig() *api.Config {
+	config := api.NewConfig()
+	config.CurrentContext = "prod"
+	config.Contexts["prod"] = &api.Context{Cluster: "prod", AuthInfo: "prod"}
+	config.Contexts["staging"] = &api.Context{Cluster: "staging", AuthInfo: "staging"}
+	config.Clusters["prod"] = &api.Cluster{Server: "https://prod.example.com"}
+	config.Clusters["staging"] = &api.Cluster{Server: "https://staging.example.com"}
+	config.AuthInfos["prod"] = &api.AuthInfo{AuthProvider: &api.AuthProviderConfig{
+		Name:   "oidc",
+		Config: map[string]string{"client-id": "navigator", "id-token": "token-1"},
+	}}
+	config.AuthInfos["staging"] = &api.AuthInfo{Token: "token-1"}
+	return config
+}
+
+func TestFingerprint(t *testing.T) {
+	config := testConfig()
+	prod, err := Fingerprint(config, "prod")
+	require.NoError(t, err)
+	staging, err := Fingerprint(config, "staging")
+	require.NoError(t, err)
+	assert.NotEmpty(t, prod)
+	assert.NotEqual(t, prod, staging)
+
+	// The current context is used when none is named
+	current, err := Fingerprint(config, "")
+	require.NoError(t, err)
+	assert.Equal(t, prod, current)
+
+	missing, err := Fingerprint(config, "dev")
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	// Tokens that clients persist themselves don't change the fingerprint
+	config.AuthInfos["prod"].AuthProvider.Config["id-token"] = "token-2"
+	refreshed, err := Fingerprint(config, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, prod, refreshed)
+	assert.Equal(t, "token-2", config.AuthInfos["prod"].AuthProvider.Config["id-token"], "config must not be modified")
+
+	// Rotated credentials and moved clusters do
+	config.AuthInfos["staging"].Token = "token-2"
+	rotated, err := Fingerprint(config, "staging")
+	require.NoError(t, err)
+	assert.NotEqual(t, staging, rotated)
+
+	config.Clusters["prod"].Server = "https://prod-2.example.com"
+	moved, err := Fingerprint(config, "prod")
+	require.NoError(t, err)
+	assert.NotEqual(t, prod, moved)
+}
+
+func TestNamespace(t *testing.T) {
+	config := testConfig()
+	config.Contexts["staging"].Namespace = "bookinfo"
+
+	namespace, err := Namespace(config, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "bookinfo", namespace)
+
+	// The current context sets no namespace
+	namespace, err = Namespace(config, "")
+	require.NoError(t, err)
+	assert.Equal(t, "default", namespace)
+
+	config.CurrentContext = ""
+	namespace, err = Namespace(config, "")
+	require.NoError(t, err)
+	assert.Equal(t, "default", namespace)
+
+	_, err = Namespace(config, "dev")
+	assert.Error(t, err)
+}
+
+func TestWatcher_poll(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "config")
+	second := filepath.Join(dir, "eks")
+	require.NoError(t, os.WriteFile(first, []byte("apiVersion: v1\n"), 0o600))
+
+	w := NewWatcher(time.Second, first+string(filepath.ListSeparator)+second, first)
+	assert.Equal(t, []string{first, second}, w.paths)
+	assert.False(t, w.poll())
+
+	// Files that appear are changes
+	require.NoError(t, os.WriteFile(second, []byte("apiVersion: v1\n"), 0o600))
+	assert.True(t, w.poll())
+	assert.False(t, w.poll())
+
+	require.NoError(t, os.WriteFile(first, []byte("apiVersion: v1\nkind: Config\n"), 0o600))
+	assert.True(t, w.poll())
+
+	require.NoError(t, os.Remove(second))
+	assert.True(t, w.poll())
+	assert.False(t, w.poll())
+}
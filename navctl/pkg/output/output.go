@@ -0,0 +1,130 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package output writes the results of navctl query commands as a table, JSON or YAML, selected with the
+// -o flag, so that they can be read by people and piped into jq and scripts alike.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// Format is an output format of navctl query commands
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+)
+
+// Formats are the supported output formats
+var Formats = []Format{FormatTable, FormatJSON, FormatYAML}
+
+// String implements pflag.Value
+func (f *Format) String() string {
+	return string(*f)
+}
+
+// Set implements pflag.Value, rejecting unsupported formats when the flag is parsed
+func (f *Format) Set(value string) error {
+	for _, format := range Formats {
+		if Format(value) == format {
+			*f = format
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported output format %q (supported: %s)", value, formatNames())
+}
+
+// Type implements pflag.Value
+func (f *Format) Type() string {
+	return "format"
+}
+
+// formatNames returns the supported formats as a comma-separated list
+func formatNames() string {
+	names := make([]string, len(Formats))
+	for i, format := range Formats {
+		names[i] = string(format)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Table is the tabular form of a result. Headers are written in upper case above the rows.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// Printer writes results in the format selected with a command's flags
+type Printer struct {
+	Format    Format
+	NoHeaders bool
+}
+
+// AddFlags adds the -o and --no-headers flags configuring the printer to a command's flags
+func (p *Printer) AddFlags(flags *pflag.FlagSet) {
+	p.Format = FormatTable
+	flags.VarP(&p.Format, "output", "o", fmt.Sprintf("Output format (%s)", formatNames()))
+	flags.BoolVar(&p.NoHeaders, "no-headers", false, "Omit the headers of table output")
+}
+
+// Print writes data as JSON or YAML, or its table in table format. The JSON and YAML field names are
+// those of data's json tags.
+func (p *Printer) Print(w io.Writer, data any, table Table) error {
+	switch p.Format {
+	case FormatJSON:
+		encoded, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal output to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(encoded))
+		return err
+	case FormatYAML:
+		encoded, err := yaml.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output to YAML: %w", err)
+		}
+		_, err = w.Write(encoded)
+		return err
+	case FormatTable, "":
+		return p.printTable(w, table)
+	default:
+		return fmt.Errorf("unsupported output format %q (supported: %s)", p.Format, formatNames())
+	}
+}
+
+// printTable writes the table with its columns aligned
+func (p *Printer) printTable(w io.Writer, table Table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	if !p.NoHeaders {
+		headers := make([]string, len(table.Headers))
+		for i, header := range table.Headers {
+			headers[i] = strings.ToUpper(header)
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	}
+	for _, row := range table.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
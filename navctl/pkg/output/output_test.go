@@ -0,0 +1,129 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type result struct {
+	Name    string `json:"name"`
+	Running bool   `json:"running"`
+}
+
+var (
+	testResult = []result{{Name: "navctl", Running: true}, {Name: "edge-cluster-1", Running: false}}
+	testTable  = Table{
+		Headers: []string{"Name", "Running"},
+		Rows:    [][]string{{"navctl", "true"}, {"edge-cluster-1", "false"}},
+	}
+)
+
+func TestPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name    string
+		printer Printer
+		want    string
+	}{
+		{
+			name:    "table",
+			printer: Printer{Format: FormatTable},
+			want:    "NAME             RUNNING\nnavctl           true\nedge-cluster-1   false\n",
+		},
+		{
+			name:    "table without headers",
+			printer: Printer{Format: FormatTable, NoHeaders: true},
+			want:    "navctl           true\nedge-cluster-1   false\n",
+		},
+		{
+			name:    "json",
+			printer: Printer{Format: FormatJSON},
+			want:    "[\n  {\n    \"name\": \"navctl\",\n    \"running\": true\n  },\n  {\n    \"name\": \"edge-cluster-1\",\n    \"running\": false\n  }\n]\n",
+		},
+		{
+			name:    "yaml",
+			printer: Printer{Format: FormatYAML},
+			want:    "- name: navctl\n  running: true\n- name: edge-cluster-1\n  running: false\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, tt.printer.Print(&out, testResult, testTable))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestPrinter_AddFlags(t *testing.T) {
+	var printer Printer
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	printer.AddFlags(flags)
+	assert.Equal(t, FormatTable, printer.Format)
+
+	require.NoError(t, flags.Parse([]string{"-o", "yaml", "--no-headers"}))
+	assert.Equal(t, FormatYAML, printer.Format)
+	assert.True(t, printer.NoHeaders)
+
+	err := flags.Parse([]string{"-o", "xml"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported output format "xml" (supported: table, json, yaml)`)
+}
+
+func TestStream_Print(t *testing.T) {
+	tests := []struct {
+		name    string
+		printer Printer
+		want    string
+	}{
+		{
+			name:    "table",
+			printer: Printer{Format: FormatTable},
+			want:    "NAME   RUNNING\nnavctl   true\nedge-cluster-1   false\n",
+		},
+		{
+			name:    "table without headers",
+			printer: Printer{Format: FormatTable, NoHeaders: true},
+			want:    "navctl   true\nedge-cluster-1   false\n",
+		},
+		{
+			name:    "json",
+			printer: Printer{Format: FormatJSON},
+			want:    "{\"name\":\"navctl\",\"running\":true}\n{\"name\":\"edge-cluster-1\",\"running\":false}\n",
+		},
+		{
+			name:    "yaml",
+			printer: Printer{Format: FormatYAML},
+			want:    "---\nname: navctl\nrunning: true\n---\nname: edge-cluster-1\nrunning: false\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			stream := tt.printer.Stream(&out, testTable.Headers)
+			for i, result := range testResult {
+				require.NoError(t, stream.Print(result, testTable.Rows[i]))
+			}
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Stream writes results as they happen, such as watch events. Table rows are written under a single
+// header, JSON results one per line and YAML results as separate documents.
+type Stream struct {
+	printer *Printer
+	w       io.Writer
+	headers []string
+	widths  []int
+	started bool
+}
+
+// Stream returns a stream writing results in the printer's format, with tables under the given headers
+func (p *Printer) Stream(w io.Writer, headers []string) *Stream {
+	return &Stream{printer: p, w: w, headers: headers}
+}
+
+// Print writes data as JSON or YAML, or row in table format
+func (s *Stream) Print(data any, row []string) error {
+	switch s.printer.Format {
+	case FormatJSON:
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(s.w, string(encoded))
+		return err
+	case FormatYAML:
+		encoded, err := yaml.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output to YAML: %w", err)
+		}
+		_, err = fmt.Fprintf(s.w, "---\n%s", encoded)
+		return err
+	case FormatTable, "":
+		return s.printRow(row)
+	default:
+		return fmt.Errorf("unsupported output format %q (supported: %s)", s.printer.Format, formatNames())
+	}
+}
+
+// printRow writes a table row, preceded by the headers on the first row. Rows cannot be aligned with
+// rows that have not been written yet, so columns are as wide as the widest value written so far.
+func (s *Stream) printRow(row []string) error {
+	if !s.started {
+		s.started = true
+		s.widths = make([]int, len(s.headers))
+		for i, header := range s.headers {
+			s.widths[i] = utf8.RuneCountInString(header)
+		}
+		if !s.printer.NoHeaders {
+			headers := make([]string, len(s.headers))
+			for i, header := range s.headers {
+				headers[i] = strings.ToUpper(header)
+			}
+			if err := s.writeRow(headers); err != nil {
+				return err
+			}
+		}
+	}
+	return s.writeRow(row)
+}
+
+// writeRow writes the cells padded to the column widths, widening columns for longer cells
+func (s *Stream) writeRow(cells []string) error {
+	var line strings.Builder
+	for i, cell := range cells {
+		if i == len(cells)-1 {
+			line.WriteString(cell)
+			break
+		}
+		length := utf8.RuneCountInString(cell)
+		if i < len(s.widths) && length > s.widths[i] {
+			s.widths[i] = length
+		}
+		width := length
+		if i < len(s.widths) {
+			width = s.widths[i]
+		}
+		line.WriteString(cell)
+		line.WriteString(strings.Repeat(" ", width-length+3))
+	}
+	_, err := fmt.Fprintln(s.w, line.String())
+	return err
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service generates service definitions that run navctl persistently under the init system of
+// the host: a systemd unit on Linux or a launchd property list on macOS.
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Manager is an init system that service definitions are generated for
+type Manager string
+
+const (
+	Systemd Manager = "systemd"
+	Launchd Manager = "launchd"
+)
+
+// Managers lists the supported init systems
+var Managers = []Manager{Systemd, Launchd}
+
+// Unit describes a navctl service
+type Unit struct {
+	// Name of the service, used for the unit file name and the launchd label
+	Name        string
+	Description string
+	// Command line of the service, the executable first
+	Command []string
+	// Environment of the service, e.g. the PATH of credential plugins
+	Environment map[string]string
+	// User runs a system-wide service as this user, instead of root
+	User string
+	// LogFile receives the output of launchd services; systemd services log to the journal
+	LogFile string
+	// System installs the service system-wide rather than for the current user
+	System bool
+}
+
+// ForOS returns the init system of an operating system, as named by runtime.GOOS
+func ForOS(goos string) (Manager, error) {
+	switch goos {
+	case "linux":
+		return Systemd, nil
+	case "darwin":
+		return Launchd, nil
+	default:
+		return "", fmt.Errorf("services are not supported on %s, only systemd (linux) and launchd (darwin)", goos)
+	}
+}
+
+// ParseManager parses the name of an init system
+func ParseManager(name string) (Manager, error) {
+	for _, manager := range Managers {
+		if string(manager) == name {
+			return manager, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported service manager %q, must be one of: systemd, launchd", name)
+}
+
+// Path returns where the definition of a unit is installed, given the home directory of the user
+func (m Manager) Path(unit Unit, home string) string {
+	switch m {
+	case Launchd:
+		if unit.System {
+			return filepath.Join("/Library/LaunchDaemons", unit.Name+".plist")
+		}
+		return filepath.Join(home, "Library", "LaunchAgents", unit.Name+".plist")
+	default:
+		if unit.System {
+			return filepath.Join("/etc/systemd/system", unit.Name+".service")
+		}
+		return filepath.Join(home, ".config", "systemd", "user", unit.Name+".service")
+	}
+}
+
+// Render returns the definition of a unit
+func (m Manager) Render(unit Unit) ([]byte, error) {
+	if unit.Name == "" || len(unit.Command) == 0 {
+		return nil, fmt.Errorf("service name and command are required")
+	}
+
+	tmpl := systemdTemplate
+	if m == Launchd {
+		tmpl = launchdTemplate
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, unit); err != nil {
+		return nil, fmt.Errorf("failed to render %s service: %w", m, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Instructions returns the commands that load and start an installed unit
+func (m Manager) Instructions(unit Unit, path string) []string {
+	if m == Launchd {
+		domain := "gui/$(id -u)"
+		if unit.System {
+			domain = "system"
+		}
+		return []string{
+			fmt.Sprintf("launchctl bootstrap %s %s", domain, path),
+			fmt.Sprintf("launchctl print %s/%s", domain, unit.Name),
+		}
+	}
+
+	systemctl := "systemctl --user"
+	journalctl := "journalctl --user"
+	if unit.System {
+		systemctl = "sudo systemctl"
+		journalctl = "journalctl"
+	}
+	return []string{
+		systemctl + " daemon-reload",
+		fmt.Sprintf("%s enable --now %s", systemctl, unit.Name),
+		fmt.Sprintf("%s -u %s -f", journalctl, unit.Name),
+	}
+}
+
+var systemdTemplate = template.Must(template.New("systemd").Funcs(template.FuncMap{
+	"exec":  systemdCommand,
+	"quote": systemdQuote,
+}).Parse(`[Unit]
+Description={{ .Description }}
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=simple
+ExecStart={{ exec .Command }}
+{{- range $key, $value := .Environment }}
+Environment={{ quote (printf "%s=%s" $key $value) }}
+{{- end }}
+{{- if and .System .User }}
+User={{ .User }}
+{{- end }}
+Restart=on-failure
+RestartSec=5s
+KillSignal=SIGTERM
+TimeoutStopSec=30s
+
+[Install]
+WantedBy={{ if .System }}multi-user.target{{ else }}default.target{{ end }}
+`))
+
+var launchdTemplate = template.Must(template.New("launchd").Funcs(template.FuncMap{
+	"xml": xmlEscape,
+}).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{ xml .Name }}</string>
+	<key>ProgramArguments</key>
+	<array>
+{{- range .Command }}
+		<string>{{ xml . }}</string>
+{{- end }}
+	</array>
+{{- if .Environment }}
+	<key>EnvironmentVariables</key>
+	<dict>
+{{- range $key, $value := .Environment }}
+		<key>{{ xml $key }}</key>
+		<string>{{ xml $value }}</string>
+{{- end }}
+	</dict>
+{{- end }}
+{{- if and .System .User }}
+	<key>UserName</key>
+	<string>{{ xml .User }}</string>
+{{- end }}
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+	<key>ThrottleInterval</key>
+	<integer>5</integer>
+{{- if .LogFile }}
+	<key>StandardOutPath</key>
+	<string>{{ xml .LogFile }}</string>
+	<key>StandardErrorPath</key>
+	<string>{{ xml .LogFile }}</string>
+{{- end }}
+</dict>
+</plist>
+`))
+
+// systemdCommand joins a command line for ExecStart, quoting arguments that systemd would split and
+// escaping specifiers and variable substitutions
+func systemdCommand(command []string) string {
+	args := make([]string, len(command))
+	for i, arg := range command {
+		arg = strings.ReplaceAll(arg, "$", "$$")
+		if arg == "" || strings.ContainsAny(arg, " \t\"'\\") {
+			args[i] = systemdQuote(arg)
+			continue
+		}
+		args[i] = strings.ReplaceAll(arg, "%", "%%")
+	}
+	return strings.Join(args, " ")
+}
+
+// systemdQuote double-quotes a value, escaping the characters systemd interprets inside quotes.
+// Environment assignments are not subject to variable substitution, so $ is left alone.
+func systemdQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "%", "%%").Replace(value) + `"`
+}
+
+func xmlEscape(value string) (string, error) {
+	var buf bytes.Buffer
+	if err := xml.EscapeText(&buf, []byte(value)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
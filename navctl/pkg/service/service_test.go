@@ -0,0 +1,91 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUnit() Unit {
+	return Unit{
+		Name:        "navctl",
+		Description: "Navigator local control plane",
+		Command:     []string{"/usr/local/bin/navctl", "local", "--config", "/srv/my config.yaml", "--no-browser"},
+		Environment: map[string]string{"PATH": "/usr/bin:/opt/100%", "KUBECONFIG": "/srv/kube&config"},
+		LogFile:     "/home/user/.navigator/navctl.log",
+	}
+}
+
+func TestSystemd(t *testing.T) {
+	data, err := Systemd.Render(testUnit())
+	require.NoError(t, err)
+
+	unit := string(data)
+	assert.Contains(t, unit, `ExecStart=/usr/local/bin/navctl local --config "/srv/my config.yaml" --no-browser`+"\n")
+	assert.Contains(t, unit, "Environment=\"KUBECONFIG=/srv/kube&config\"\nEnvironment=\"PATH=/usr/bin:/opt/100%%\"\n")
+	assert.Contains(t, unit, "Restart=on-failure\n")
+	assert.Contains(t, unit, "WantedBy=default.target\n")
+	assert.NotContains(t, unit, "User=")
+	assert.Equal(t, "/home/user/.config/systemd/user/navctl.service", Systemd.Path(testUnit(), "/home/user"))
+
+	system := testUnit()
+	system.System = true
+	system.User = "navigator"
+	data, err = Systemd.Render(system)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "User=navigator\n")
+	assert.Contains(t, string(data), "WantedBy=multi-user.target\n")
+	assert.Equal(t, "/etc/systemd/system/navctl.service", Systemd.Path(system, "/home/user"))
+}
+
+func TestSystemdCommand(t *testing.T) {
+	assert.Equal(t, `navctl --name "" "it's" "a\\b" 50%% $$HOME`, systemdCommand([]string{"navctl", "--name", "", "it's", `a\b`, "50%", "$HOME"}))
+}
+
+func TestLaunchd(t *testing.T) {
+	data, err := Launchd.Render(testUnit())
+	require.NoError(t, err)
+
+	plist := string(data)
+	assert.Contains(t, plist, "<string>navctl</string>")
+	assert.Contains(t, plist, "\t\t<string>/srv/my config.yaml</string>\n")
+	assert.Contains(t, plist, "\t\t<key>KUBECONFIG</key>\n\t\t<string>/srv/kube&amp;config</string>\n")
+	assert.Contains(t, plist, "<key>KeepAlive</key>\n\t<dict>\n\t\t<key>SuccessfulExit</key>\n\t\t<false/>")
+	assert.Contains(t, plist, "<key>StandardErrorPath</key>\n\t<string>/home/user/.navigator/navctl.log</string>")
+	assert.NotContains(t, plist, "UserName")
+	assert.Equal(t, "/home/user/Library/LaunchAgents/navctl.plist", Launchd.Path(testUnit(), "/home/user"))
+
+	_, err = Launchd.Render(Unit{Name: "navctl"})
+	assert.Error(t, err)
+}
+
+func TestForOS(t *testing.T) {
+	manager, err := ForOS("linux")
+	require.NoError(t, err)
+	assert.Equal(t, Systemd, manager)
+
+	manager, err = ForOS("darwin")
+	require.NoError(t, err)
+	assert.Equal(t, Launchd, manager)
+
+	_, err = ForOS("windows")
+	assert.Error(t, err)
+
+	_, err = ParseManager("upstart")
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// ipv4Pattern matches IPv4 addresses
+var ipv4Pattern = regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\.){3}(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\b`)
+
+// Redactor replaces sensitive values in the files of a bundle with placeholders. Each distinct value is
+// replaced with the same placeholder in every file, so redacted values can still be correlated.
+type Redactor struct {
+	patterns []*regexp.Regexp
+
+	mu           sync.Mutex
+	replacements map[string]string
+}
+
+// NewRedactor creates a redactor replacing matches of the given regular expressions, and IPv4 addresses
+// if redactIPs is set. It returns nil if there is nothing to redact.
+func NewRedactor(redactIPs bool, patterns []string) (*Redactor, error) {
+	redactor := &Redactor{replacements: make(map[string]string)}
+	if redactIPs {
+		redactor.patterns = append(redactor.patterns, ipv4Pattern)
+	}
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		redactor.patterns = append(redactor.patterns, compiled)
+	}
+	if len(redactor.patterns) == 0 {
+		return nil, nil
+	}
+	return redactor, nil
+}
+
+// Redact returns data with every match of the redactor's patterns replaced. A nil redactor returns data
+// unchanged.
+func (r *Redactor) Redact(data []byte) []byte {
+	if r == nil {
+		return data
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, pattern := range r.patterns {
+		data = pattern.ReplaceAllFunc(data, func(match []byte) []byte {
+			replacement, exists := r.replacements[string(match)]
+			if !exists {
+				replacement = fmt.Sprintf("REDACTED-%d", len(r.replacements)+1)
+				r.replacements[string(match)] = replacement
+			}
+			return []byte(replacement)
+		})
+	}
+	return data
+}
+
+// Count returns the number of distinct values redacted so far
+func (r *Redactor) Count() int {
+	if r == nil {
+		return 0
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.replacements)
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/version"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxLogTailBytes bounds how much of the end of a log file is read to find its last lines
+const maxLogTailBytes = 8 * 1024 * 1024
+
+// Options configures what a snapshot captures
+type Options struct {
+	Namespace     string        // Namespace of the service
+	Service       string        // Name of the service
+	MetricsWindow time.Duration // How far back the service's metrics are captured, 0 to skip metrics
+	LogFiles      []string      // navctl log files whose last lines are captured, skipped if they do not exist
+	LogLines      int           // Number of lines captured from the end of each log file
+	Redactor      *Redactor     // Redacts the bundle's files, nil to leave them unchanged
+}
+
+// Manifest describes the contents of a bundle. It is written to the bundle as manifest.json.
+type Manifest struct {
+	NavctlVersion string    `json:"navctlVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Service       string    `json:"service"`
+	Files         []string  `json:"files"`
+	Errors        []string  `json:"errors,omitempty"` // Parts of the snapshot that could not be captured
+	Redacted      int       `json:"redacted"`         // Number of distinct values redacted
+}
+
+// bundle writes the files of a snapshot to a gzipped tar archive under a common directory
+type bundle struct {
+	tar      *tar.Writer
+	dir      string
+	modTime  time.Time
+	redactor *Redactor
+	manifest *Manifest
+}
+
+// Capture queries the frontend API of a manager for the state of a service and writes it, together with
+// the last lines of the navctl logs, to w as a gzipped tar archive. Parts of the state that cannot be
+// retrieved are recorded in the manifest rather than failing the snapshot, except for the service itself.
+func Capture(ctx context.Context, conn grpc.ClientConnInterface, opts Options, w io.Writer, logger *slog.Logger) (*Manifest, error) {
+	serviceID := fmt.Sprintf("%s:%s", opts.Namespace, opts.Service)
+	serviceRegistry := frontendv1alpha1.NewServiceRegistryServiceClient(conn)
+	service, err := serviceRegistry.GetService(ctx, &frontendv1alpha1.GetServiceRequest{Id: serviceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s: %w", serviceID, err)
+	}
+
+	now := time.Now().UTC()
+	gzipWriter := gzip.NewWriter(w)
+	b := &bundle{
+		tar:      tar.NewWriter(gzipWriter),
+		dir:      fmt.Sprintf("navigator-snapshot-%s-%s-%s", opts.Namespace, opts.Service, now.Format("20060102T150405Z")),
+		modTime:  now,
+		redactor: opts.Redactor,
+		manifest: &Manifest{
+			NavctlVersion: version.Get(),
+			CreatedAt:     now,
+			Service:       serviceID,
+		},
+	}
+
+	b.addMessage("service.json", service)
+
+	logger.Debug("capturing clusters")
+	clusters, err := frontendv1alpha1.NewClusterRegistryServiceClient(conn).ListClusters(ctx, &frontendv1alpha1.ListClustersRequest{})
+	b.addResult("clusters.json", clusters, err)
+
+	logger.Debug("capturing instances and their istio resources", "instances", len(service.Service.GetInstances()))
+	for _, instance := range service.Service.GetInstances() {
+		detail, err := serviceRegistry.GetServiceInstance(ctx, &frontendv1alpha1.GetServiceInstanceRequest{
+			ServiceId:  serviceID,
+			InstanceId: instance.InstanceId,
+		})
+		b.addResult(filepath.Join("instances", fileName(instance.InstanceId)+".json"), detail, err)
+
+		resources, err := serviceRegistry.GetIstioResources(ctx, &frontendv1alpha1.GetIstioResourcesRequest{
+			ServiceId:  serviceID,
+			InstanceId: instance.InstanceId,
+		})
+		b.addResult(filepath.Join("istio-resources", fileName(instance.InstanceId)+".json"), resources, err)
+	}
+
+	logger.Debug("capturing proxy configs")
+	proxyConfigs, err := serviceRegistry.GetServiceProxyConfigs(ctx, &frontendv1alpha1.GetServiceProxyConfigsRequest{ServiceId: serviceID})
+	if err != nil {
+		b.addError("proxy-configs", err)
+	}
+	for _, instance := range proxyConfigs.GetInstances() {
+		b.addMessage(filepath.Join("proxy-configs", fileName(instance.InstanceId)+".json"), instance)
+	}
+
+	if opts.MetricsWindow > 0 {
+		logger.Debug("capturing metrics", "window", opts.MetricsWindow)
+		metrics, err := frontendv1alpha1.NewMetricsServiceClient(conn).GetServiceConnections(ctx, &frontendv1alpha1.GetServiceConnectionsRequest{
+			ServiceName: opts.Service,
+			Namespace:   opts.Namespace,
+			StartTime:   timestamppb.New(now.Add(-opts.MetricsWindow)),
+			EndTime:     timestamppb.New(now.Add(-time.Second)),
+		})
+		b.addResult("metrics.json", metrics, err)
+	}
+
+	for _, logFile := range opts.LogFiles {
+		logger.Debug("capturing log file", "path", logFile)
+		data, err := tailLines(logFile, opts.LogLines)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			b.addError(filepath.Join("logs", filepath.Base(logFile)), err)
+			continue
+		}
+		b.add(filepath.Join("logs", filepath.Base(logFile)), data)
+	}
+
+	b.manifest.Redacted = b.redactor.Count()
+	manifest, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	// The manifest is not redacted so that it lists the files as written
+	if err := b.write("manifest.json", manifest); err != nil {
+		return nil, err
+	}
+
+	if err := b.tar.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return b.manifest, nil
+}
+
+// addResult adds the result of a request to the bundle, or records why it failed
+func (b *bundle) addResult(name string, message proto.Message, err error) {
+	if err != nil {
+		b.addError(name, err)
+		return
+	}
+	b.addMessage(name, message)
+}
+
+// addMessage adds a message to the bundle as JSON
+func (b *bundle) addMessage(name string, message proto.Message) {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(message)
+	if err != nil {
+		b.addError(name, err)
+		return
+	}
+	b.add(name, data)
+}
+
+// add adds a file to the bundle, redacting its name and contents
+func (b *bundle) add(name string, data []byte) {
+	name = string(b.redactor.Redact([]byte(name)))
+	if err := b.write(name, b.redactor.Redact(data)); err != nil {
+		b.addError(name, err)
+	}
+}
+
+// addError records that a part of the snapshot could not be captured
+func (b *bundle) addError(name string, err error) {
+	b.manifest.Errors = append(b.manifest.Errors, fmt.Sprintf("%s: %s", name, b.redactor.Redact([]byte(err.Error()))))
+}
+
+// write writes a file to the bundle's directory in the archive
+func (b *bundle) write(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    filepath.ToSlash(filepath.Join(b.dir, name)),
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: b.modTime,
+	}
+	if err := b.tar.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	if _, err := b.tar.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	if name != "manifest.json" {
+		b.manifest.Files = append(b.manifest.Files, filepath.ToSlash(name))
+	}
+	return nil
+}
+
+// fileName makes an instance ID, such as cluster:namespace:pod, safe to use as a file name
+func fileName(id string) string {
+	return strings.NewReplacer(":", "_", "/", "_").Replace(id)
+}
+
+// tailLines returns the last lines of a file, reading at most the last maxLogTailBytes of it. Every line
+// read is returned if lines is not positive.
+func tailLines(path string, lines int) ([]byte, error) {
+	file, err := os.Open(path) // #nosec G304 - log file paths are chosen by the user
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := max(info.Size()-maxLogTailBytes, 0)
+	data := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(data, offset); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	data = bytes.TrimRight(data, "\n")
+	if len(data) == 0 {
+		return data, nil
+	}
+	for i := len(data) - 1; i >= 0; i-- {
+		if data[i] != '\n' {
+			continue
+		}
+		if lines--; lines == 0 {
+			data = data[i+1:]
+			break
+		}
+	}
+	return append(data, '\n'), nil
+}
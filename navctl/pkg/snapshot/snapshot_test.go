@@ -0,0 +1,205 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeServiceRegistry struct {
+	frontendv1alpha1.UnimplementedServiceRegistryServiceServer
+}
+
+func (f *fakeServiceRegistry) GetService(ctx context.Context, req *frontendv1alpha1.GetServiceRequest) (*frontendv1alpha1.GetServiceResponse, error) {
+	if req.Id != "bookinfo:reviews" {
+		return nil, status.Errorf(codes.NotFound, "service not found: %s", req.Id)
+	}
+	return &frontendv1alpha1.GetServiceResponse{Service: &frontendv1alpha1.Service{
+		Id:        "bookinfo:reviews",
+		Name:      "reviews",
+		Namespace: "bookinfo",
+		Instances: []*frontendv1alpha1.ServiceInstance{
+			{InstanceId: "prod-east:bookinfo:reviews-v1", Ip: "10.0.0.12", ClusterName: "prod-east"},
+		},
+	}}, nil
+}
+
+func (f *fakeServiceRegistry) GetServiceInstance(ctx context.Context, req *frontendv1alpha1.GetServiceInstanceRequest) (*frontendv1alpha1.GetServiceInstanceResponse, error) {
+	return &frontendv1alpha1.GetServiceInstanceResponse{Instance: &frontendv1alpha1.ServiceInstanceDetail{
+		InstanceId:  req.InstanceId,
+		Ip:          "10.0.0.12",
+		PodName:     "reviews-v1",
+		Namespace:   "bookinfo",
+		ClusterName: "prod-east",
+		PodStatus:   "Running",
+	}}, nil
+}
+
+func (f *fakeServiceRegistry) GetIstioResources(ctx context.Context, req *frontendv1alpha1.GetIstioResourcesRequest) (*frontendv1alpha1.GetIstioResourcesResponse, error) {
+	return &frontendv1alpha1.GetIstioResourcesResponse{}, nil
+}
+
+func (f *fakeServiceRegistry) GetServiceProxyConfigs(ctx context.Context, req *frontendv1alpha1.GetServiceProxyConfigsRequest) (*frontendv1alpha1.GetServiceProxyConfigsResponse, error) {
+	return &frontendv1alpha1.GetServiceProxyConfigsResponse{Instances: []*frontendv1alpha1.InstanceProxyConfig{
+		{
+			InstanceId:  "prod-east:bookinfo:reviews-v1",
+			ClusterName: "prod-east",
+			Result:      &frontendv1alpha1.InstanceProxyConfig_ErrorMessage{ErrorMessage: "failed to reach 10.0.0.12:15000"},
+		},
+	}}, nil
+}
+
+type fakeClusterRegistry struct {
+	frontendv1alpha1.UnimplementedClusterRegistryServiceServer
+}
+
+func (f *fakeClusterRegistry) ListClusters(ctx context.Context, req *frontendv1alpha1.ListClustersRequest) (*frontendv1alpha1.ListClustersResponse, error) {
+	return &frontendv1alpha1.ListClustersResponse{Clusters: []*frontendv1alpha1.ClusterSyncInfo{{ClusterId: "prod-east"}}}, nil
+}
+
+// startFakeManager serves fake frontend services, without a metrics service
+func startFakeManager(t *testing.T) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	frontendv1alpha1.RegisterServiceRegistryServiceServer(server, &fakeServiceRegistry{})
+	frontendv1alpha1.RegisterClusterRegistryServiceServer(server, &fakeClusterRegistry{})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// readBundle returns the files of a bundle by their path below the bundle's directory
+func readBundle(t *testing.T, data []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	reader := tar.NewReader(gzipReader)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		_, name, _ := strings.Cut(header.Name, "/")
+		files[name] = string(content)
+	}
+}
+
+func TestCapture(t *testing.T) {
+	conn := startFakeManager(t)
+	logFile := filepath.Join(t.TempDir(), "navctl.log")
+	require.NoError(t, os.WriteFile(logFile, []byte("first\nsecond\nthird\n"), 0600))
+	redactor, err := NewRedactor(true, []string{`prod-[a-z]+`})
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	manifest, err := Capture(context.Background(), conn, Options{
+		Namespace:     "bookinfo",
+		Service:       "reviews",
+		MetricsWindow: 15 * time.Minute,
+		LogFiles:      []string{logFile, filepath.Join(t.TempDir(), "missing.log")},
+		LogLines:      2,
+		Redactor:      redactor,
+	}, &out, logging.For("test"))
+	require.NoError(t, err)
+
+	files := readBundle(t, out.Bytes())
+	assert.ElementsMatch(t, []string{
+		"manifest.json",
+		"service.json",
+		"clusters.json",
+		"instances/REDACTED-2_bookinfo_reviews-v1.json",
+		"istio-resources/REDACTED-2_bookinfo_reviews-v1.json",
+		"proxy-configs/REDACTED-2_bookinfo_reviews-v1.json",
+		"logs/navctl.log",
+	}, keys(files))
+	assert.Equal(t, "second\nthird\n", files["logs/navctl.log"])
+
+	// Redacted values are replaced consistently across files
+	assert.NotContains(t, files["service.json"], "10.0.0.12")
+	assert.NotContains(t, files["service.json"], "prod-east")
+	assert.Contains(t, files["service.json"], `"REDACTED-1"`)
+	assert.Contains(t, files["proxy-configs/REDACTED-2_bookinfo_reviews-v1.json"], "failed to reach REDACTED-1:15000")
+
+	// The metrics service is not served, so metrics are reported missing
+	require.Len(t, manifest.Errors, 1)
+	assert.Contains(t, manifest.Errors[0], "metrics.json")
+	assert.Equal(t, 2, manifest.Redacted)
+
+	var written Manifest
+	require.NoError(t, json.Unmarshal([]byte(files["manifest.json"]), &written))
+	assert.Equal(t, "bookinfo:reviews", written.Service)
+	assert.Len(t, written.Files, 6)
+}
+
+func TestCapture_ServiceNotFound(t *testing.T) {
+	conn := startFakeManager(t)
+
+	_, err := Capture(context.Background(), conn, Options{Namespace: "bookinfo", Service: "ratings"}, io.Discard, logging.For("test"))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRedactor(t *testing.T) {
+	redactor, err := NewRedactor(true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "REDACTED-1 -> REDACTED-2 -> REDACTED-1, version 1.22.3",
+		string(redactor.Redact([]byte("10.0.0.1 -> 192.168.1.20 -> 10.0.0.1, version 1.22.3"))))
+
+	redactor, err = NewRedactor(false, nil)
+	require.NoError(t, err)
+	assert.Nil(t, redactor)
+	assert.Equal(t, "10.0.0.1", string(redactor.Redact([]byte("10.0.0.1"))))
+
+	_, err = NewRedactor(false, []string{"("})
+	assert.Error(t, err)
+}
+
+func keys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -19,24 +19,39 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"log/slog"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/liamawhite/navigator/pkg/logging"
+	"github.com/liamawhite/navigator/pkg/socket"
 	"github.com/liamawhite/navigator/pkg/ui"
 )
 
 // Server represents a UI server that serves the Navigator web interface
 type Server struct {
-	server *http.Server
-	port   int
+	server  *http.Server
+	address Address
 }
 
-// NewServer creates a new UI server
-func NewServer(port int, apiPort int) (*Server, error) {
+// Address is where a server listens or is reached: a unix socket if Socket is set, otherwise a TCP port
+type Address struct {
+	Port   int
+	Socket string
+}
+
+// String returns the socket path, or the port as a listen address
+func (a Address) String() string {
+	if a.Socket != "" {
+		return a.Socket
+	}
+	return fmt.Sprintf(":%d", a.Port)
+}
+
+// NewServer creates a new UI server listening on an address, proxying API requests to the HTTP gateway
+func NewServer(address Address, api Address, logger *slog.Logger) (*Server, error) {
 	// Get UI filesystem
 	uiFS, err := ui.GetFileSystem()
 	if err != nil {
@@ -44,23 +59,31 @@ func NewServer(port int, apiPort int) (*Server, error) {
 	}
 
 	// Create UI handler
-	handler := createUIHandler(uiFS, apiPort)
+	handler := createUIHandler(uiFS, api, logger)
 
 	// Create HTTP server
 	server := &http.Server{
-		Addr:              fmt.Sprintf(":%d", port),
 		Handler:           handler,
 		ReadHeaderTimeout: 30 * time.Second,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
 	}
 
 	return &Server{
-		server: server,
-		port:   port,
+		server:  server,
+		address: address,
 	}, nil
 }
 
 // Start starts the UI server
 func (s *Server) Start() error {
+	if s.address.Socket != "" {
+		listener, err := socket.Listen(s.address.Socket)
+		if err != nil {
+			return err
+		}
+		return s.server.Serve(listener)
+	}
+	s.server.Addr = s.address.String()
 	return s.server.ListenAndServe()
 }
 
@@ -71,14 +94,22 @@ func (s *Server) Stop() error {
 
 // Address returns the address the UI server is listening on
 func (s *Server) Address() string {
-	return fmt.Sprintf(":%d", s.port)
+	return s.address.String()
 }
 
 // createUIHandler creates an HTTP handler for serving the embedded UI files and proxying API requests
-func createUIHandler(uiFS fs.FS, apiPort int) http.Handler {
+func createUIHandler(uiFS fs.FS, api Address, logger *slog.Logger) http.Handler {
 	// Create reverse proxy for API requests
-	apiURL, _ := url.Parse(fmt.Sprintf("http://localhost:%d", apiPort))
+	apiURL := &url.URL{Scheme: "http", Host: fmt.Sprintf("localhost:%d", api.Port)}
+	if api.Socket != "" {
+		// The host is only used for the Host header, requests go to the socket
+		apiURL.Host = "localhost"
+	}
 	proxy := httputil.NewSingleHostReverseProxy(apiURL)
+	proxy.ErrorLog = slog.NewLogLogger(logger.Handler(), slog.LevelWarn)
+	if api.Socket != "" {
+		proxy.Transport = socket.Transport(api.Socket)
+	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Proxy API requests to the HTTP gateway
@@ -106,7 +137,7 @@ func createUIHandler(uiFS fs.FS, apiPort int) http.Handler {
 		defer func() {
 			if err := file.Close(); err != nil {
 				// Log error but don't fail the request
-				logging.For("navctl-ui").Warn("failed to close file", "error", err)
+				logger.Warn("failed to close file", "error", err)
 			}
 		}()
 
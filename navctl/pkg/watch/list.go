@@ -0,0 +1,157 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+const (
+	// ServiceKind is the kind of watched services
+	ServiceKind = "Service"
+
+	// resourcePageSize is the number of Istio resources listed per request, the most the manager returns
+	resourcePageSize = 500
+
+	// kindPrefix is the prefix of the names of the Istio resource kind values
+	kindPrefix = "ISTIO_RESOURCE_KIND_"
+)
+
+// Services lists the services of a namespace and cluster, or of all namespaces and clusters if empty.
+// A service changes when its instances, addresses or exports change.
+func Services(client frontendv1alpha1.ServiceRegistryServiceClient, namespace, cluster string) ListFunc {
+	return func(ctx context.Context) ([]Object, error) {
+		req := &frontendv1alpha1.ListServicesRequest{}
+		if namespace != "" {
+			req.Namespace = &namespace
+		}
+		if cluster != "" {
+			req.ClusterId = &cluster
+		}
+		resp, err := client.ListServices(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		objects := make([]Object, 0, len(resp.Services))
+		for _, service := range resp.Services {
+			version, err := serviceVersion(service)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, Object{
+				Kind:      ServiceKind,
+				Cluster:   cluster,
+				Namespace: service.Namespace,
+				Name:      service.Name,
+				Detail:    fmt.Sprintf("%d instances", len(service.Instances)),
+				Version:   version,
+			})
+		}
+		return objects, nil
+	}
+}
+
+// serviceVersion hashes a service, with its instances sorted so that their order does not change it
+func serviceVersion(service *frontendv1alpha1.Service) (string, error) {
+	normalized := proto.Clone(service).(*frontendv1alpha1.Service)
+	sort.Slice(normalized.Instances, func(i, j int) bool {
+		return normalized.Instances[i].InstanceId < normalized.Instances[j].InstanceId
+	})
+	sort.Strings(normalized.ExportedClusters)
+	sort.Strings(normalized.ImportedClusters)
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal service %s: %w", service.Id, err)
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(data)
+	return strconv.FormatUint(hash.Sum64(), 16), nil
+}
+
+// Resources lists the Istio resources of the given kinds in a namespace and cluster, or of all kinds,
+// namespaces and clusters if empty. A resource changes when its configuration changes.
+func Resources(client frontendv1alpha1.ServiceRegistryServiceClient, namespace, cluster string, kinds []typesv1alpha1.IstioResourceKind) ListFunc {
+	return func(ctx context.Context) ([]Object, error) {
+		req := &frontendv1alpha1.ListIstioResourcesRequest{
+			Kinds:    kinds,
+			PageSize: resourcePageSize,
+		}
+		if namespace != "" {
+			req.Namespace = &namespace
+		}
+		if cluster != "" {
+			req.ClusterId = &cluster
+		}
+
+		var objects []Object
+		for {
+			resp, err := client.ListIstioResources(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			for _, resource := range resp.Resources {
+				objects = append(objects, Object{
+					Kind:      KindName(resource.Kind),
+					Cluster:   resource.ClusterId,
+					Namespace: resource.Namespace,
+					Name:      resource.Name,
+					Version:   resource.ApiVersion + "\n" + resource.RawConfig,
+				})
+			}
+			if resp.NextPageToken == "" {
+				return objects, nil
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+}
+
+// KindName returns the Kubernetes kind of an Istio resource kind, e.g. VirtualService
+func KindName(kind typesv1alpha1.IstioResourceKind) string {
+	words := strings.Split(strings.TrimPrefix(kind.String(), kindPrefix), "_")
+	for i, word := range words {
+		words[i] = word[:1] + strings.ToLower(word[1:])
+	}
+	return strings.Join(words, "")
+}
+
+// ParseKind returns the Istio resource kind of a Kubernetes kind, matched case-insensitively
+func ParseKind(name string) (typesv1alpha1.IstioResourceKind, error) {
+	var names []string
+	for value := range typesv1alpha1.IstioResourceKind_name {
+		kind := typesv1alpha1.IstioResourceKind(value)
+		if kind == typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_UNSPECIFIED {
+			continue
+		}
+		if strings.EqualFold(KindName(kind), name) {
+			return kind, nil
+		}
+		names = append(names, KindName(kind))
+	}
+	sort.Strings(names)
+	return 0, fmt.Errorf("unknown Istio resource kind %q (supported: %s)", name, strings.Join(names, ", "))
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch reports the changes of services and Istio resources known to a Navigator manager as
+// they happen. The frontend API has no change stream, so the watcher lists the objects periodically and
+// reports the differences between consecutive lists as events.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// EventType is the kind of change of an object
+type EventType string
+
+const (
+	Added    EventType = "ADDED"
+	Modified EventType = "MODIFIED"
+	Removed  EventType = "REMOVED"
+)
+
+// Object is a watched object. Its version changes whenever the object changes.
+type Object struct {
+	Kind      string
+	Cluster   string
+	Namespace string
+	Name      string
+	Detail    string
+	Version   string
+}
+
+// key identifies the object across lists
+func (o Object) key() string {
+	return o.Kind + "/" + o.Cluster + "/" + o.Namespace + "/" + o.Name
+}
+
+// Event is a change of an object. Removed objects are described as they were last seen.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Type      EventType `json:"type"`
+	Kind      string    `json:"kind"`
+	Cluster   string    `json:"cluster,omitempty"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Detail    string    `json:"detail,omitempty"`
+}
+
+// ListFunc lists the current objects
+type ListFunc func(ctx context.Context) ([]Object, error)
+
+// Diff returns the events turning the previous objects into the current ones, ordered by object
+func Diff(previous, current []Object) []Event {
+	previousByKey := make(map[string]Object, len(previous))
+	for _, object := range previous {
+		previousByKey[object.key()] = object
+	}
+
+	type change struct {
+		eventType EventType
+		object    Object
+	}
+	var changes []change
+	currentKeys := make(map[string]bool, len(current))
+	for _, object := range current {
+		currentKeys[object.key()] = true
+		before, existed := previousByKey[object.key()]
+		switch {
+		case !existed:
+			changes = append(changes, change{Added, object})
+		case before.Version != object.Version:
+			changes = append(changes, change{Modified, object})
+		}
+	}
+	for _, object := range previous {
+		if !currentKeys[object.key()] {
+			changes = append(changes, change{Removed, object})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].object.key() < changes[j].object.key()
+	})
+
+	events := make([]Event, len(changes))
+	for i, change := range changes {
+		events[i] = Event{
+			Type:      change.eventType,
+			Kind:      change.object.Kind,
+			Cluster:   change.object.Cluster,
+			Namespace: change.object.Namespace,
+			Name:      change.object.Name,
+			Detail:    change.object.Detail,
+		}
+	}
+	return events
+}
+
+// Watcher reports the changes of the objects listed by a ListFunc
+type Watcher struct {
+	list     ListFunc
+	interval time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewWatcher creates a watcher listing the objects every interval
+func NewWatcher(list ListFunc, interval time.Duration, logger *slog.Logger) *Watcher {
+	return &Watcher{
+		list:     list,
+		interval: interval,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Run calls handle with the changes of the objects until ctx is done or handle fails. The objects that
+// exist when the watch starts are reported as added unless watchOnly is set. Lists that fail after the
+// first one, for example while the manager restarts, are logged and retried at the next interval.
+func (w *Watcher) Run(ctx context.Context, watchOnly bool, handle func(Event) error) error {
+	previous, err := w.list(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list objects to watch: %w", err)
+	}
+	if !watchOnly {
+		if err := w.report(Diff(nil, previous), handle); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := w.list(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.logger.Warn("failed to list watched objects, retrying", "error", err)
+			continue
+		}
+		if err := w.report(Diff(previous, current), handle); err != nil {
+			return err
+		}
+		previous = current
+	}
+}
+
+// report calls handle with each event, timestamped with the current time
+func (w *Watcher) report(events []Event, handle func(Event) error) error {
+	now := w.now()
+	for _, event := range events {
+		event.Time = now
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
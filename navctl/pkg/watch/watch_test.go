@@ -0,0 +1,191 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/logging"
+)
+
+// fakeServiceRegistry lists the services and resources it holds
+type fakeServiceRegistry struct {
+	frontendv1alpha1.ServiceRegistryServiceClient
+	services  []*frontendv1alpha1.Service
+	resources []*frontendv1alpha1.IstioResource
+	requests  []*frontendv1alpha1.ListIstioResourcesRequest
+}
+
+func (f *fakeServiceRegistry) ListServices(ctx context.Context, req *frontendv1alpha1.ListServicesRequest, opts ...grpc.CallOption) (*frontendv1alpha1.ListServicesResponse, error) {
+	return &frontendv1alpha1.ListServicesResponse{Services: f.services}, nil
+}
+
+// ListIstioResources returns one resource per page
+func (f *fakeServiceRegistry) ListIstioResources(ctx context.Context, req *frontendv1alpha1.ListIstioResourcesRequest, opts ...grpc.CallOption) (*frontendv1alpha1.ListIstioResourcesResponse, error) {
+	f.requests = append(f.requests, req)
+	page := len(f.requests) - 1
+	resp := &frontendv1alpha1.ListIstioResourcesResponse{Resources: f.resources[page : page+1]}
+	if page+1 < len(f.resources) {
+		resp.NextPageToken = "next"
+	}
+	return resp, nil
+}
+
+func TestDiff(t *testing.T) {
+	previous := []Object{
+		{Kind: "VirtualService", Namespace: "default", Name: "reviews", Version: "1"},
+		{Kind: "VirtualService", Namespace: "default", Name: "ratings", Version: "1"},
+		{Kind: "DestinationRule", Namespace: "default", Name: "reviews", Version: "1"},
+	}
+	current := []Object{
+		{Kind: "VirtualService", Namespace: "default", Name: "reviews", Version: "2"},
+		{Kind: "DestinationRule", Namespace: "default", Name: "reviews", Version: "1"},
+		{Kind: "Gateway", Namespace: "istio-system", Name: "ingress", Version: "1"},
+	}
+
+	assert.Equal(t, []Event{
+		{Type: Added, Kind: "Gateway", Namespace: "istio-system", Name: "ingress"},
+		{Type: Removed, Kind: "VirtualService", Namespace: "default", Name: "ratings"},
+		{Type: Modified, Kind: "VirtualService", Namespace: "default", Name: "reviews"},
+	}, Diff(previous, current))
+	assert.Empty(t, Diff(current, current))
+}
+
+func TestServices(t *testing.T) {
+	registry := &fakeServiceRegistry{services: []*frontendv1alpha1.Service{{
+		Id: "default:reviews", Name: "reviews", Namespace: "default",
+		Instances: []*frontendv1alpha1.ServiceInstance{{InstanceId: "c1:default:reviews-1"}, {InstanceId: "c1:default:reviews-2"}},
+	}}}
+	list := Services(registry, "default", "c1")
+
+	objects, err := list(context.Background())
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "Service", objects[0].Kind)
+	assert.Equal(t, "c1", objects[0].Cluster)
+	assert.Equal(t, "2 instances", objects[0].Detail)
+
+	// Instances listed in another order leave the service unchanged
+	instances := registry.services[0].Instances
+	instances[0], instances[1] = instances[1], instances[0]
+	reordered, err := list(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, Diff(objects, reordered))
+
+	// A removed instance modifies it
+	registry.services[0].Instances = instances[:1]
+	scaled, err := list(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Event{{Type: Modified, Kind: "Service", Cluster: "c1", Namespace: "default", Name: "reviews", Detail: "1 instances"}}, Diff(objects, scaled))
+}
+
+func TestResources(t *testing.T) {
+	registry := &fakeServiceRegistry{resources: []*frontendv1alpha1.IstioResource{
+		{ClusterId: "c1", Kind: typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE, Namespace: "default", Name: "reviews", RawConfig: "{}"},
+		{ClusterId: "c1", Kind: typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION, Namespace: "istio-system", Name: "default", RawConfig: "{}"},
+	}}
+	kinds := []typesv1alpha1.IstioResourceKind{typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE}
+
+	objects, err := Resources(registry, "", "c1", kinds)(context.Background())
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "VirtualService", objects[0].Kind)
+	assert.Equal(t, "PeerAuthentication", objects[1].Kind)
+
+	// Every page is listed with the same filters
+	require.Len(t, registry.requests, 2)
+	assert.Equal(t, "next", registry.requests[1].PageToken)
+	assert.Equal(t, "c1", registry.requests[1].GetClusterId())
+	assert.Nil(t, registry.requests[1].Namespace)
+	assert.Equal(t, kinds, registry.requests[1].Kinds)
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("virtualservice")
+	require.NoError(t, err)
+	assert.Equal(t, typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE, kind)
+
+	kind, err = ParseKind("WasmPlugin")
+	require.NoError(t, err)
+	assert.Equal(t, typesv1alpha1.IstioResourceKind_ISTIO_RESOURCE_KIND_WASM_PLUGIN, kind)
+
+	_, err = ParseKind("Deployment")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "supported: AuthorizationPolicy, DestinationRule")
+}
+
+func TestWatcher_Run(t *testing.T) {
+	var mu sync.Mutex
+	lists := [][]Object{
+		{{Kind: "Service", Namespace: "default", Name: "reviews", Version: "1"}},
+		nil, // a failed list is retried
+		{{Kind: "Service", Namespace: "default", Name: "reviews", Version: "2"}},
+		{},
+	}
+	calls := 0
+	list := func(ctx context.Context) ([]Object, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		switch {
+		case calls > len(lists):
+			return lists[len(lists)-1], nil
+		case calls == 2:
+			return nil, errors.New("manager unavailable")
+		}
+		return lists[calls-1], nil
+	}
+
+	for _, watchOnly := range []bool{false, true} {
+		calls = 0
+		watcher := NewWatcher(list, time.Millisecond, logging.For("test"))
+		ctx, cancel := context.WithCancel(context.Background())
+		var types []EventType
+		err := watcher.Run(ctx, watchOnly, func(event Event) error {
+			types = append(types, event.Type)
+			if event.Type == Removed {
+				cancel()
+			}
+			return nil
+		})
+		cancel()
+		require.NoError(t, err)
+
+		want := []EventType{Added, Modified, Removed}
+		if watchOnly {
+			want = want[1:]
+		}
+		assert.Equal(t, want, types)
+	}
+}
+
+func TestWatcher_RunInitialListFails(t *testing.T) {
+	list := func(ctx context.Context) ([]Object, error) {
+		return nil, errors.New("manager unavailable")
+	}
+	err := NewWatcher(list, time.Millisecond, logging.For("test")).Run(context.Background(), false, func(Event) error { return nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list objects to watch")
+}
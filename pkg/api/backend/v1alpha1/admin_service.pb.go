@@ -0,0 +1,688 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: backend/v1alpha1/admin_service.proto
+
+package v1alpha1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListEdgeConnectionsRequest for retrieving connected edge processes.
+type ListEdgeConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListEdgeConnectionsRequest) Reset() {
+	*x = ListEdgeConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEdgeConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEdgeConnectionsRequest) ProtoMessage() {}
+
+func (x *ListEdgeConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEdgeConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEdgeConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ListEdgeConnectionsResponse contains all connected edge processes.
+type ListEdgeConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// connections contains metadata about each connected edge process.
+	Connections []*EdgeConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *ListEdgeConnectionsResponse) Reset() {
+	*x = ListEdgeConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEdgeConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEdgeConnectionsResponse) ProtoMessage() {}
+
+func (x *ListEdgeConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEdgeConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEdgeConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEdgeConnectionsResponse) GetConnections() []*EdgeConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// EdgeConnection describes an active connection from an edge process.
+type EdgeConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster managed by this edge process.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// remote_address is the network address the edge process connected from.
+	RemoteAddress string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	// connected_at is when the edge process connected to the manager.
+	ConnectedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	// last_update is when the manager last received an update from the edge process.
+	LastUpdate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	// state_received indicates whether the edge process has sent at least one cluster state.
+	StateReceived bool `protobuf:"varint,5,opt,name=state_received,json=stateReceived,proto3" json:"state_received,omitempty"`
+	// service_count is the number of services in the most recent cluster state.
+	ServiceCount int32 `protobuf:"varint,6,opt,name=service_count,json=serviceCount,proto3" json:"service_count,omitempty"`
+	// capabilities describe what features this edge process supports.
+	Capabilities *EdgeCapabilities `protobuf:"bytes,7,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// leader_election identifies the leading replica when the cluster runs several edge replicas.
+	LeaderElection *LeaderElection `protobuf:"bytes,8,opt,name=leader_election,json=leaderElection,proto3" json:"leader_election,omitempty"`
+	// shards are the namespace shards of the edges syncing the cluster, empty when a single edge syncs it.
+	Shards []*NamespaceShard `protobuf:"bytes,9,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (x *EdgeConnection) Reset() {
+	*x = EdgeConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeConnection) ProtoMessage() {}
+
+func (x *EdgeConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeConnection.ProtoReflect.Descriptor instead.
+func (*EdgeConnection) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EdgeConnection) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *EdgeConnection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *EdgeConnection) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *EdgeConnection) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+func (x *EdgeConnection) GetStateReceived() bool {
+	if x != nil {
+		return x.StateReceived
+	}
+	return false
+}
+
+func (x *EdgeConnection) GetServiceCount() int32 {
+	if x != nil {
+		return x.ServiceCount
+	}
+	return 0
+}
+
+func (x *EdgeConnection) GetCapabilities() *EdgeCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *EdgeConnection) GetLeaderElection() *LeaderElection {
+	if x != nil {
+		return x.LeaderElection
+	}
+	return nil
+}
+
+func (x *EdgeConnection) GetShards() []*NamespaceShard {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+// DisconnectEdgeRequest identifies the edge connection to close.
+type DisconnectEdgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster whose edge connection should be closed.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// reason is an optional human-readable explanation, sent to the edge process and logged.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DisconnectEdgeRequest) Reset() {
+	*x = DisconnectEdgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectEdgeRequest) ProtoMessage() {}
+
+func (x *DisconnectEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectEdgeRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DisconnectEdgeRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DisconnectEdgeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DisconnectEdgeResponse is returned once the disconnect has been initiated.
+type DisconnectEdgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectEdgeResponse) Reset() {
+	*x = DisconnectEdgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectEdgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectEdgeResponse) ProtoMessage() {}
+
+func (x *DisconnectEdgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectEdgeResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectEdgeResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{4}
+}
+
+// ResyncClusterRequest identifies the cluster to resync.
+type ResyncClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster whose state should be resynced.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (x *ResyncClusterRequest) Reset() {
+	*x = ResyncClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncClusterRequest) ProtoMessage() {}
+
+func (x *ResyncClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncClusterRequest.ProtoReflect.Descriptor instead.
+func (*ResyncClusterRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResyncClusterRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// ResyncClusterResponse is returned once the resync request has been sent to the edge process.
+type ResyncClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResyncClusterResponse) Reset() {
+	*x = ResyncClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncClusterResponse) ProtoMessage() {}
+
+func (x *ResyncClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_admin_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncClusterResponse.ProtoReflect.Descriptor instead.
+func (*ResyncClusterResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_admin_service_proto_rawDescGZIP(), []int{6}
+}
+
+var File_backend_v1alpha1_admin_service_proto protoreflect.FileDescriptor
+
+var file_backend_v1alpha1_admin_service_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x1a, 0x26, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x89, 0x04, 0x0a, 0x0e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22,
+	0x57, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x86, 0x03, 0x0a, 0x0c,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x12, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74,
+	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x79, 0x6e, 0x63, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_backend_v1alpha1_admin_service_proto_rawDescOnce sync.Once
+	file_backend_v1alpha1_admin_service_proto_rawDescData = file_backend_v1alpha1_admin_service_proto_rawDesc
+)
+
+func file_backend_v1alpha1_admin_service_proto_rawDescGZIP() []byte {
+	file_backend_v1alpha1_admin_service_proto_rawDescOnce.Do(func() {
+		file_backend_v1alpha1_admin_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_backend_v1alpha1_admin_service_proto_rawDescData)
+	})
+	return file_backend_v1alpha1_admin_service_proto_rawDescData
+}
+
+var file_backend_v1alpha1_admin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_backend_v1alpha1_admin_service_proto_goTypes = []any{
+	(*ListEdgeConnectionsRequest)(nil),  // 0: navigator.backend.v1alpha1.ListEdgeConnectionsRequest
+	(*ListEdgeConnectionsResponse)(nil), // 1: navigator.backend.v1alpha1.ListEdgeConnectionsResponse
+	(*EdgeConnection)(nil),              // 2: navigator.backend.v1alpha1.EdgeConnection
+	(*DisconnectEdgeRequest)(nil),       // 3: navigator.backend.v1alpha1.DisconnectEdgeRequest
+	(*DisconnectEdgeResponse)(nil),      // 4: navigator.backend.v1alpha1.DisconnectEdgeResponse
+	(*ResyncClusterRequest)(nil),        // 5: navigator.backend.v1alpha1.ResyncClusterRequest
+	(*ResyncClusterResponse)(nil),       // 6: navigator.backend.v1alpha1.ResyncClusterResponse
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+	(*EdgeCapabilities)(nil),            // 8: navigator.backend.v1alpha1.EdgeCapabilities
+	(*LeaderElection)(nil),              // 9: navigator.backend.v1alpha1.LeaderElection
+	(*NamespaceShard)(nil),              // 10: navigator.backend.v1alpha1.NamespaceShard
+}
+var file_backend_v1alpha1_admin_service_proto_depIdxs = []int32{
+	2,  // 0: navigator.backend.v1alpha1.ListEdgeConnectionsResponse.connections:type_name -> navigator.backend.v1alpha1.EdgeConnection
+	7,  // 1: navigator.backend.v1alpha1.EdgeConnection.connected_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: navigator.backend.v1alpha1.EdgeConnection.last_update:type_name -> google.protobuf.Timestamp
+	8,  // 3: navigator.backend.v1alpha1.EdgeConnection.capabilities:type_name -> navigator.backend.v1alpha1.EdgeCapabilities
+	9,  // 4: navigator.backend.v1alpha1.EdgeConnection.leader_election:type_name -> navigator.backend.v1alpha1.LeaderElection
+	10, // 5: navigator.backend.v1alpha1.EdgeConnection.shards:type_name -> navigator.backend.v1alpha1.NamespaceShard
+	0,  // 6: navigator.backend.v1alpha1.AdminService.ListEdgeConnections:input_type -> navigator.backend.v1alpha1.ListEdgeConnectionsRequest
+	3,  // 7: navigator.backend.v1alpha1.AdminService.DisconnectEdge:input_type -> navigator.backend.v1alpha1.DisconnectEdgeRequest
+	5,  // 8: navigator.backend.v1alpha1.AdminService.ResyncCluster:input_type -> navigator.backend.v1alpha1.ResyncClusterRequest
+	1,  // 9: navigator.backend.v1alpha1.AdminService.ListEdgeConnections:output_type -> navigator.backend.v1alpha1.ListEdgeConnectionsResponse
+	4,  // 10: navigator.backend.v1alpha1.AdminService.DisconnectEdge:output_type -> navigator.backend.v1alpha1.DisconnectEdgeResponse
+	6,  // 11: navigator.backend.v1alpha1.AdminService.ResyncCluster:output_type -> navigator.backend.v1alpha1.ResyncClusterResponse
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_backend_v1alpha1_admin_service_proto_init() }
+func file_backend_v1alpha1_admin_service_proto_init() {
+	if File_backend_v1alpha1_admin_service_proto != nil {
+		return
+	}
+	file_backend_v1alpha1_manager_service_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_backend_v1alpha1_admin_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEdgeConnectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEdgeConnectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EdgeConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DisconnectEdgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DisconnectEdgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ResyncClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_admin_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ResyncClusterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_backend_v1alpha1_admin_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backend_v1alpha1_admin_service_proto_goTypes,
+		DependencyIndexes: file_backend_v1alpha1_admin_service_proto_depIdxs,
+		MessageInfos:      file_backend_v1alpha1_admin_service_proto_msgTypes,
+	}.Build()
+	File_backend_v1alpha1_admin_service_proto = out.File
+	file_backend_v1alpha1_admin_service_proto_rawDesc = nil
+	file_backend_v1alpha1_admin_service_proto_goTypes = nil
+	file_backend_v1alpha1_admin_service_proto_depIdxs = nil
+}
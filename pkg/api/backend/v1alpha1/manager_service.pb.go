@@ -24,6 +24,7 @@ import (
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -36,6 +37,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ResourceCapabilityStatus is whether the edge can collect a resource type.
+type ResourceCapabilityStatus int32
+
+const (
+	ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNSPECIFIED ResourceCapabilityStatus = 0
+	// The resource is served and the edge may read it.
+	ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_AVAILABLE ResourceCapabilityStatus = 1
+	// The API server does not serve the resource, e.g. its CRD is not installed.
+	ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED ResourceCapabilityStatus = 2
+	// The edge's service account is not permitted to read the resource.
+	ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_FORBIDDEN ResourceCapabilityStatus = 3
+	// The check could not be completed.
+	ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNKNOWN ResourceCapabilityStatus = 4
+)
+
+// Enum value maps for ResourceCapabilityStatus.
+var (
+	ResourceCapabilityStatus_name = map[int32]string{
+		0: "RESOURCE_CAPABILITY_STATUS_UNSPECIFIED",
+		1: "RESOURCE_CAPABILITY_STATUS_AVAILABLE",
+		2: "RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED",
+		3: "RESOURCE_CAPABILITY_STATUS_FORBIDDEN",
+		4: "RESOURCE_CAPABILITY_STATUS_UNKNOWN",
+	}
+	ResourceCapabilityStatus_value = map[string]int32{
+		"RESOURCE_CAPABILITY_STATUS_UNSPECIFIED":   0,
+		"RESOURCE_CAPABILITY_STATUS_AVAILABLE":     1,
+		"RESOURCE_CAPABILITY_STATUS_NOT_INSTALLED": 2,
+		"RESOURCE_CAPABILITY_STATUS_FORBIDDEN":     3,
+		"RESOURCE_CAPABILITY_STATUS_UNKNOWN":       4,
+	}
+)
+
+func (x ResourceCapabilityStatus) Enum() *ResourceCapabilityStatus {
+	p := new(ResourceCapabilityStatus)
+	*p = x
+	return p
+}
+
+func (x ResourceCapabilityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceCapabilityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_v1alpha1_manager_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ResourceCapabilityStatus) Type() protoreflect.EnumType {
+	return &file_backend_v1alpha1_manager_service_proto_enumTypes[0]
+}
+
+func (x ResourceCapabilityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceCapabilityStatus.Descriptor instead.
+func (ResourceCapabilityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{0}
+}
+
 // ConnectRequest represents messages sent from the edge process to the manager.
 type ConnectRequest struct {
 	state         protoimpl.MessageState
@@ -48,6 +109,14 @@ type ConnectRequest struct {
 	//	*ConnectRequest_ClusterState
 	//	*ConnectRequest_ProxyConfigResponse
 	//	*ConnectRequest_ServiceConnectionsResponse
+	//	*ConnectRequest_ClusterStateChunk
+	//	*ConnectRequest_PodLogsResponse
+	//	*ConnectRequest_EnvoyAdminResponse
+	//	*ConnectRequest_PairInstanceMetricsResponse
+	//	*ConnectRequest_MeshMetricsTimeSeriesResponse
+	//	*ConnectRequest_TracesResponse
+	//	*ConnectRequest_AccessLogsResponse
+	//	*ConnectRequest_CapabilitiesUpdate
 	Message isConnectRequest_Message `protobuf_oneof:"message"`
 }
 
@@ -118,6 +187,62 @@ func (x *ConnectRequest) GetServiceConnectionsResponse() *ServiceConnectionsResp
 	return nil
 }
 
+func (x *ConnectRequest) GetClusterStateChunk() *ClusterStateChunk {
+	if x, ok := x.GetMessage().(*ConnectRequest_ClusterStateChunk); ok {
+		return x.ClusterStateChunk
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetPodLogsResponse() *PodLogsResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_PodLogsResponse); ok {
+		return x.PodLogsResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetEnvoyAdminResponse() *EnvoyAdminResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_EnvoyAdminResponse); ok {
+		return x.EnvoyAdminResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetPairInstanceMetricsResponse() *PairInstanceMetricsResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_PairInstanceMetricsResponse); ok {
+		return x.PairInstanceMetricsResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetMeshMetricsTimeSeriesResponse() *MeshMetricsTimeSeriesResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_MeshMetricsTimeSeriesResponse); ok {
+		return x.MeshMetricsTimeSeriesResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetTracesResponse() *TracesResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_TracesResponse); ok {
+		return x.TracesResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetAccessLogsResponse() *AccessLogsResponse {
+	if x, ok := x.GetMessage().(*ConnectRequest_AccessLogsResponse); ok {
+		return x.AccessLogsResponse
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetCapabilitiesUpdate() *EdgeCapabilities {
+	if x, ok := x.GetMessage().(*ConnectRequest_CapabilitiesUpdate); ok {
+		return x.CapabilitiesUpdate
+	}
+	return nil
+}
+
 type isConnectRequest_Message interface {
 	isConnectRequest_Message()
 }
@@ -142,6 +267,48 @@ type ConnectRequest_ServiceConnectionsResponse struct {
 	ServiceConnectionsResponse *ServiceConnectionsResponse `protobuf:"bytes,4,opt,name=service_connections_response,json=serviceConnectionsResponse,proto3,oneof"`
 }
 
+type ConnectRequest_ClusterStateChunk struct {
+	// cluster_state_chunk contains part of a cluster state too large to send as a single message.
+	ClusterStateChunk *ClusterStateChunk `protobuf:"bytes,5,opt,name=cluster_state_chunk,json=clusterStateChunk,proto3,oneof"`
+}
+
+type ConnectRequest_PodLogsResponse struct {
+	// pod_logs_response is sent in response to a pod logs request from the manager.
+	PodLogsResponse *PodLogsResponse `protobuf:"bytes,6,opt,name=pod_logs_response,json=podLogsResponse,proto3,oneof"`
+}
+
+type ConnectRequest_EnvoyAdminResponse struct {
+	// envoy_admin_response is sent in response to an Envoy admin request from the manager.
+	EnvoyAdminResponse *EnvoyAdminResponse `protobuf:"bytes,7,opt,name=envoy_admin_response,json=envoyAdminResponse,proto3,oneof"`
+}
+
+type ConnectRequest_PairInstanceMetricsResponse struct {
+	// pair_instance_metrics_response is sent in response to a pair instance metrics request from the manager.
+	PairInstanceMetricsResponse *PairInstanceMetricsResponse `protobuf:"bytes,8,opt,name=pair_instance_metrics_response,json=pairInstanceMetricsResponse,proto3,oneof"`
+}
+
+type ConnectRequest_MeshMetricsTimeSeriesResponse struct {
+	// mesh_metrics_time_series_response is sent in response to a mesh metrics time series request from the manager.
+	MeshMetricsTimeSeriesResponse *MeshMetricsTimeSeriesResponse `protobuf:"bytes,9,opt,name=mesh_metrics_time_series_response,json=meshMetricsTimeSeriesResponse,proto3,oneof"`
+}
+
+type ConnectRequest_TracesResponse struct {
+	// traces_response is sent in response to a traces request from the manager.
+	TracesResponse *TracesResponse `protobuf:"bytes,10,opt,name=traces_response,json=tracesResponse,proto3,oneof"`
+}
+
+type ConnectRequest_AccessLogsResponse struct {
+	// access_logs_response is sent in response to an access logs request from the manager.
+	AccessLogsResponse *AccessLogsResponse `protobuf:"bytes,11,opt,name=access_logs_response,json=accessLogsResponse,proto3,oneof"`
+}
+
+type ConnectRequest_CapabilitiesUpdate struct {
+	// capabilities_update replaces the capabilities the edge identified with when they change on an
+	// established connection, e.g. when resource types preflight found unavailable become available. Only
+	// sent to managers advertising capabilities_update.
+	CapabilitiesUpdate *EdgeCapabilities `protobuf:"bytes,12,opt,name=capabilities_update,json=capabilitiesUpdate,proto3,oneof"`
+}
+
 func (*ConnectRequest_ClusterIdentification) isConnectRequest_Message() {}
 
 func (*ConnectRequest_ClusterState) isConnectRequest_Message() {}
@@ -150,6 +317,22 @@ func (*ConnectRequest_ProxyConfigResponse) isConnectRequest_Message() {}
 
 func (*ConnectRequest_ServiceConnectionsResponse) isConnectRequest_Message() {}
 
+func (*ConnectRequest_ClusterStateChunk) isConnectRequest_Message() {}
+
+func (*ConnectRequest_PodLogsResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_EnvoyAdminResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_PairInstanceMetricsResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_MeshMetricsTimeSeriesResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_TracesResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_AccessLogsResponse) isConnectRequest_Message() {}
+
+func (*ConnectRequest_CapabilitiesUpdate) isConnectRequest_Message() {}
+
 // ConnectResponse represents messages sent from the manager to the edge process.
 type ConnectResponse struct {
 	state         protoimpl.MessageState
@@ -162,6 +345,13 @@ type ConnectResponse struct {
 	//	*ConnectResponse_Error
 	//	*ConnectResponse_ProxyConfigRequest
 	//	*ConnectResponse_ServiceConnectionsRequest
+	//	*ConnectResponse_ResyncRequest
+	//	*ConnectResponse_PodLogsRequest
+	//	*ConnectResponse_EnvoyAdminRequest
+	//	*ConnectResponse_PairInstanceMetricsRequest
+	//	*ConnectResponse_MeshMetricsTimeSeriesRequest
+	//	*ConnectResponse_TracesRequest
+	//	*ConnectResponse_AccessLogsRequest
 	Message isConnectResponse_Message `protobuf_oneof:"message"`
 }
 
@@ -232,6 +422,55 @@ func (x *ConnectResponse) GetServiceConnectionsRequest() *ServiceConnectionsRequ
 	return nil
 }
 
+func (x *ConnectResponse) GetResyncRequest() *ResyncRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_ResyncRequest); ok {
+		return x.ResyncRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetPodLogsRequest() *PodLogsRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_PodLogsRequest); ok {
+		return x.PodLogsRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetEnvoyAdminRequest() *EnvoyAdminRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_EnvoyAdminRequest); ok {
+		return x.EnvoyAdminRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetPairInstanceMetricsRequest() *PairInstanceMetricsRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_PairInstanceMetricsRequest); ok {
+		return x.PairInstanceMetricsRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetMeshMetricsTimeSeriesRequest() *MeshMetricsTimeSeriesRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_MeshMetricsTimeSeriesRequest); ok {
+		return x.MeshMetricsTimeSeriesRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetTracesRequest() *TracesRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_TracesRequest); ok {
+		return x.TracesRequest
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetAccessLogsRequest() *AccessLogsRequest {
+	if x, ok := x.GetMessage().(*ConnectResponse_AccessLogsRequest); ok {
+		return x.AccessLogsRequest
+	}
+	return nil
+}
+
 type isConnectResponse_Message interface {
 	isConnectResponse_Message()
 }
@@ -257,6 +496,41 @@ type ConnectResponse_ServiceConnectionsRequest struct {
 	ServiceConnectionsRequest *ServiceConnectionsRequest `protobuf:"bytes,4,opt,name=service_connections_request,json=serviceConnectionsRequest,proto3,oneof"`
 }
 
+type ConnectResponse_ResyncRequest struct {
+	// resync_request asks the edge process to send its cluster state immediately.
+	ResyncRequest *ResyncRequest `protobuf:"bytes,5,opt,name=resync_request,json=resyncRequest,proto3,oneof"`
+}
+
+type ConnectResponse_PodLogsRequest struct {
+	// pod_logs_request asks the edge process to provide container logs for a specific pod.
+	PodLogsRequest *PodLogsRequest `protobuf:"bytes,6,opt,name=pod_logs_request,json=podLogsRequest,proto3,oneof"`
+}
+
+type ConnectResponse_EnvoyAdminRequest struct {
+	// envoy_admin_request asks the edge process to query an Envoy admin endpoint for a specific pod.
+	EnvoyAdminRequest *EnvoyAdminRequest `protobuf:"bytes,7,opt,name=envoy_admin_request,json=envoyAdminRequest,proto3,oneof"`
+}
+
+type ConnectResponse_PairInstanceMetricsRequest struct {
+	// pair_instance_metrics_request asks the edge process to break down the metrics between two services by pod.
+	PairInstanceMetricsRequest *PairInstanceMetricsRequest `protobuf:"bytes,8,opt,name=pair_instance_metrics_request,json=pairInstanceMetricsRequest,proto3,oneof"`
+}
+
+type ConnectResponse_MeshMetricsTimeSeriesRequest struct {
+	// mesh_metrics_time_series_request asks the edge process to provide the metrics of service pairs over time.
+	MeshMetricsTimeSeriesRequest *MeshMetricsTimeSeriesRequest `protobuf:"bytes,9,opt,name=mesh_metrics_time_series_request,json=meshMetricsTimeSeriesRequest,proto3,oneof"`
+}
+
+type ConnectResponse_TracesRequest struct {
+	// traces_request asks the edge process to search its tracing backend for the traces of a service.
+	TracesRequest *TracesRequest `protobuf:"bytes,10,opt,name=traces_request,json=tracesRequest,proto3,oneof"`
+}
+
+type ConnectResponse_AccessLogsRequest struct {
+	// access_logs_request asks the edge process to search its logs backend for the access logs of a service.
+	AccessLogsRequest *AccessLogsRequest `protobuf:"bytes,11,opt,name=access_logs_request,json=accessLogsRequest,proto3,oneof"`
+}
+
 func (*ConnectResponse_ConnectionAck) isConnectResponse_Message() {}
 
 func (*ConnectResponse_Error) isConnectResponse_Message() {}
@@ -265,6 +539,20 @@ func (*ConnectResponse_ProxyConfigRequest) isConnectResponse_Message() {}
 
 func (*ConnectResponse_ServiceConnectionsRequest) isConnectResponse_Message() {}
 
+func (*ConnectResponse_ResyncRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_PodLogsRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_EnvoyAdminRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_PairInstanceMetricsRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_MeshMetricsTimeSeriesRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_TracesRequest) isConnectResponse_Message() {}
+
+func (*ConnectResponse_AccessLogsRequest) isConnectResponse_Message() {}
+
 // EdgeCapabilities describes what features an edge process supports.
 type EdgeCapabilities struct {
 	state         protoimpl.MessageState
@@ -273,6 +561,13 @@ type EdgeCapabilities struct {
 
 	// metrics_enabled indicates whether this edge process supports metrics collection.
 	MetricsEnabled bool `protobuf:"varint,1,opt,name=metrics_enabled,json=metricsEnabled,proto3" json:"metrics_enabled,omitempty"`
+	// preflight reports the Kubernetes version and which resource types the edge can collect, checked at startup
+	// and again when resource types found unavailable become available.
+	Preflight *PreflightReport `protobuf:"bytes,2,opt,name=preflight,proto3" json:"preflight,omitempty"`
+	// traces_enabled indicates whether this edge process can search a tracing backend.
+	TracesEnabled bool `protobuf:"varint,3,opt,name=traces_enabled,json=tracesEnabled,proto3" json:"traces_enabled,omitempty"`
+	// access_logs_enabled indicates whether this edge process can search a logs backend for access logs.
+	AccessLogsEnabled bool `protobuf:"varint,4,opt,name=access_logs_enabled,json=accessLogsEnabled,proto3" json:"access_logs_enabled,omitempty"`
 }
 
 func (x *EdgeCapabilities) Reset() {
@@ -314,20 +609,45 @@ func (x *EdgeCapabilities) GetMetricsEnabled() bool {
 	return false
 }
 
-// ClusterIdentification is sent by the edge process to identify which cluster it manages.
-type ClusterIdentification struct {
+func (x *EdgeCapabilities) GetPreflight() *PreflightReport {
+	if x != nil {
+		return x.Preflight
+	}
+	return nil
+}
+
+func (x *EdgeCapabilities) GetTracesEnabled() bool {
+	if x != nil {
+		return x.TracesEnabled
+	}
+	return false
+}
+
+func (x *EdgeCapabilities) GetAccessLogsEnabled() bool {
+	if x != nil {
+		return x.AccessLogsEnabled
+	}
+	return false
+}
+
+// PreflightReport records the edge's startup compatibility checks against its cluster.
+type PreflightReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cluster_id is a unique identifier for the cluster this edge manages.
-	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	// capabilities describe what features this edge process supports.
-	Capabilities *EdgeCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// kubernetes_version is the API server version, e.g. "v1.30.2".
+	KubernetesVersion string `protobuf:"bytes,1,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+	// kubernetes_version_supported indicates whether the API server is at least the oldest version the edge supports.
+	KubernetesVersionSupported bool `protobuf:"varint,2,opt,name=kubernetes_version_supported,json=kubernetesVersionSupported,proto3" json:"kubernetes_version_supported,omitempty"`
+	// resources reports, for each resource type the edge collects, whether it can be collected.
+	Resources []*ResourceCapability `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	// checked_at is when the checks ran.
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
 }
 
-func (x *ClusterIdentification) Reset() {
-	*x = ClusterIdentification{}
+func (x *PreflightReport) Reset() {
+	*x = PreflightReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +655,13 @@ func (x *ClusterIdentification) Reset() {
 	}
 }
 
-func (x *ClusterIdentification) String() string {
+func (x *PreflightReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterIdentification) ProtoMessage() {}
+func (*PreflightReport) ProtoMessage() {}
 
-func (x *ClusterIdentification) ProtoReflect() protoreflect.Message {
+func (x *PreflightReport) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,37 +673,60 @@ func (x *ClusterIdentification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterIdentification.ProtoReflect.Descriptor instead.
-func (*ClusterIdentification) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreflightReport.ProtoReflect.Descriptor instead.
+func (*PreflightReport) Descriptor() ([]byte, []int) {
 	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ClusterIdentification) GetClusterId() string {
+func (x *PreflightReport) GetKubernetesVersion() string {
 	if x != nil {
-		return x.ClusterId
+		return x.KubernetesVersion
 	}
 	return ""
 }
 
-func (x *ClusterIdentification) GetCapabilities() *EdgeCapabilities {
+func (x *PreflightReport) GetKubernetesVersionSupported() bool {
 	if x != nil {
-		return x.Capabilities
+		return x.KubernetesVersionSupported
+	}
+	return false
+}
+
+func (x *PreflightReport) GetResources() []*ResourceCapability {
+	if x != nil {
+		return x.Resources
 	}
 	return nil
 }
 
-// ConnectionAck acknowledges cluster identification and indicates connection status.
-type ConnectionAck struct {
+func (x *PreflightReport) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// ResourceCapability reports whether the edge can collect one resource type.
+type ResourceCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// accepted indicates whether the connection was accepted.
-	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// group is the API group of the resource, empty for the core group.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// version is the API version the edge reads the resource at. For Istio resources served at
+	// several versions this is the preferred version the cluster serves.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// resource is the plural resource name, e.g. "destinationrules".
+	Resource string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// status is whether the resource can be collected.
+	Status ResourceCapabilityStatus `protobuf:"varint,4,opt,name=status,proto3,enum=navigator.backend.v1alpha1.ResourceCapabilityStatus" json:"status,omitempty"`
+	// message explains why the resource cannot be collected.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ConnectionAck) Reset() {
-	*x = ConnectionAck{}
+func (x *ResourceCapability) Reset() {
+	*x = ResourceCapability{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,13 +734,13 @@ func (x *ConnectionAck) Reset() {
 	}
 }
 
-func (x *ConnectionAck) String() string {
+func (x *ResourceCapability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionAck) ProtoMessage() {}
+func (*ResourceCapability) ProtoMessage() {}
 
-func (x *ConnectionAck) ProtoReflect() protoreflect.Message {
+func (x *ResourceCapability) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,32 +752,72 @@ func (x *ConnectionAck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionAck.ProtoReflect.Descriptor instead.
-func (*ConnectionAck) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceCapability.ProtoReflect.Descriptor instead.
+func (*ResourceCapability) Descriptor() ([]byte, []int) {
 	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ConnectionAck) GetAccepted() bool {
+func (x *ResourceCapability) GetGroup() string {
 	if x != nil {
-		return x.Accepted
+		return x.Group
 	}
-	return false
+	return ""
 }
 
-// ErrorMessage indicates an error condition.
-type ErrorMessage struct {
+func (x *ResourceCapability) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResourceCapability) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourceCapability) GetStatus() ResourceCapabilityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResourceCapabilityStatus_RESOURCE_CAPABILITY_STATUS_UNSPECIFIED
+}
+
+func (x *ResourceCapability) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ClusterIdentification is sent by the edge process to identify which cluster it manages.
+type ClusterIdentification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// error_code provides a machine-readable error identifier.
-	ErrorCode string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
-	// error_message provides a human-readable error description.
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// cluster_id is a unique identifier for the cluster this edge manages.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// capabilities describe what features this edge process supports.
+	Capabilities *EdgeCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// edge_version is the version of the edge process.
+	EdgeVersion string `protobuf:"bytes,3,opt,name=edge_version,json=edgeVersion,proto3" json:"edge_version,omitempty"`
+	// leader_election is set when the edge runs as one of several replicas for the cluster and identifies
+	// the replica's leadership. A connection from a leader with a later term replaces the existing one once
+	// that leader stops syncing.
+	LeaderElection *LeaderElection `protobuf:"bytes,4,opt,name=leader_election,json=leaderElection,proto3" json:"leader_election,omitempty"`
+	// shard is set when the edge is one of several edges that each collect a shard of the cluster's namespaces.
+	// The manager merges the cluster state of every shard of a cluster into one.
+	Shard *NamespaceShard `protobuf:"bytes,5,opt,name=shard,proto3" json:"shard,omitempty"`
+	// protocol describes the parts of the backend protocol the edge supports. Unset for edges that predate
+	// capability negotiation.
+	Protocol *ProtocolCapabilities `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
-func (x *ErrorMessage) Reset() {
-	*x = ErrorMessage{}
+func (x *ClusterIdentification) Reset() {
+	*x = ClusterIdentification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +825,13 @@ func (x *ErrorMessage) Reset() {
 	}
 }
 
-func (x *ErrorMessage) String() string {
+func (x *ClusterIdentification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorMessage) ProtoMessage() {}
+func (*ClusterIdentification) ProtoMessage() {}
 
-func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+func (x *ClusterIdentification) ProtoReflect() protoreflect.Message {
 	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,56 +843,1599 @@ func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
-func (*ErrorMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterIdentification.ProtoReflect.Descriptor instead.
+func (*ClusterIdentification) Descriptor() ([]byte, []int) {
 	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ErrorMessage) GetErrorCode() string {
+func (x *ClusterIdentification) GetClusterId() string {
 	if x != nil {
-		return x.ErrorCode
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *ErrorMessage) GetErrorMessage() string {
+func (x *ClusterIdentification) GetCapabilities() *EdgeCapabilities {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Capabilities
 	}
-	return ""
+	return nil
 }
 
-// ProxyConfigRequest is sent by the manager to request proxy configuration for a specific pod.
-type ProxyConfigRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// request_id is a unique identifier for this request, used for correlating the response.
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// pod_namespace is the Kubernetes namespace of the pod.
-	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
-	// pod_name is the Kubernetes name of the pod.
-	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+func (x *ClusterIdentification) GetEdgeVersion() string {
+	if x != nil {
+		return x.EdgeVersion
+	}
+	return ""
 }
 
-func (x *ProxyConfigRequest) Reset() {
-	*x = ProxyConfigRequest{}
+func (x *ClusterIdentification) GetLeaderElection() *LeaderElection {
+	if x != nil {
+		return x.LeaderElection
+	}
+	return nil
+}
+
+func (x *ClusterIdentification) GetShard() *NamespaceShard {
+	if x != nil {
+		return x.Shard
+	}
+	return nil
+}
+
+func (x *ClusterIdentification) GetProtocol() *ProtocolCapabilities {
+	if x != nil {
+		return x.Protocol
+	}
+	return nil
+}
+
+// ProtocolCapabilities describes the parts of the backend protocol one side of a connection supports, so
+// edges and managers of different versions only use the features both support.
+type ProtocolCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// api_version is the version of the backend API, e.g. "v1alpha1".
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// resource_types lists the cluster state fields the side collects or aggregates, by field name,
+	// e.g. "destination_rules".
+	ResourceTypes []string `protobuf:"bytes,2,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	// delta_sync indicates support for cluster state updates that only carry what changed since the previous sync.
+	DeltaSync bool `protobuf:"varint,3,opt,name=delta_sync,json=deltaSync,proto3" json:"delta_sync,omitempty"`
+	// compression lists the compression algorithms supported for Istio resource raw config, e.g. "zstd".
+	Compression []string `protobuf:"bytes,4,rep,name=compression,proto3" json:"compression,omitempty"`
+	// chunked_cluster_state indicates support for cluster state split into ClusterStateChunk messages.
+	ChunkedClusterState bool `protobuf:"varint,5,opt,name=chunked_cluster_state,json=chunkedClusterState,proto3" json:"chunked_cluster_state,omitempty"`
+	// streamed_cluster_state indicates support for chunked cluster state whose total is not known in advance.
+	StreamedClusterState bool `protobuf:"varint,6,opt,name=streamed_cluster_state,json=streamedClusterState,proto3" json:"streamed_cluster_state,omitempty"`
+	// requests lists the requests from the manager to the edge the side supports, by ConnectResponse field
+	// name without the "_request" suffix, e.g. "proxy_config". The manager only sends an edge the requests it
+	// lists, and fails the others as unsupported by the edge's version instead of waiting for a response.
+	Requests []string `protobuf:"bytes,7,rep,name=requests,proto3" json:"requests,omitempty"`
+	// capabilities_update indicates support for ConnectRequest capabilities_update messages, which update the
+	// edge's capabilities without reconnecting.
+	CapabilitiesUpdate bool `protobuf:"varint,8,opt,name=capabilities_update,json=capabilitiesUpdate,proto3" json:"capabilities_update,omitempty"`
+}
+
+func (x *ProtocolCapabilities) Reset() {
+	*x = ProtocolCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolCapabilities) ProtoMessage() {}
+
+func (x *ProtocolCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolCapabilities.ProtoReflect.Descriptor instead.
+func (*ProtocolCapabilities) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProtocolCapabilities) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ProtocolCapabilities) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *ProtocolCapabilities) GetDeltaSync() bool {
+	if x != nil {
+		return x.DeltaSync
+	}
+	return false
+}
+
+func (x *ProtocolCapabilities) GetCompression() []string {
+	if x != nil {
+		return x.Compression
+	}
+	return nil
+}
+
+func (x *ProtocolCapabilities) GetChunkedClusterState() bool {
+	if x != nil {
+		return x.ChunkedClusterState
+	}
+	return false
+}
+
+func (x *ProtocolCapabilities) GetStreamedClusterState() bool {
+	if x != nil {
+		return x.StreamedClusterState
+	}
+	return false
+}
+
+func (x *ProtocolCapabilities) GetRequests() []string {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ProtocolCapabilities) GetCapabilitiesUpdate() bool {
+	if x != nil {
+		return x.CapabilitiesUpdate
+	}
+	return false
+}
+
+// NamespaceShard identifies the namespaces an edge collects when a cluster is split between several edges.
+// Namespaces are either assigned by hash, when count is set, or listed explicitly. Every namespace must
+// belong to exactly one shard of the cluster.
+type NamespaceShard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the shard of this edge among count hash shards, from 0 to count - 1.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// count is the number of hash shards. A namespace belongs to the shard whose index is the FNV-1a hash of
+	// its name modulo count.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// namespaces lists the namespaces of an explicit shard.
+	Namespaces []string `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *NamespaceShard) Reset() {
+	*x = NamespaceShard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceShard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceShard) ProtoMessage() {}
+
+func (x *NamespaceShard) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceShard.ProtoReflect.Descriptor instead.
+func (*NamespaceShard) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NamespaceShard) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NamespaceShard) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NamespaceShard) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+// LeaderElection describes the leadership of an edge replica elected through a Kubernetes Lease.
+type LeaderElection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identity is the identity of the leading replica, typically its pod name.
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// term counts the leadership changes of the Lease, increasing with every failover.
+	Term int64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// acquired_at is when the replica became leader.
+	AcquiredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+}
+
+func (x *LeaderElection) Reset() {
+	*x = LeaderElection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderElection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderElection) ProtoMessage() {}
+
+func (x *LeaderElection) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderElection.ProtoReflect.Descriptor instead.
+func (*LeaderElection) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LeaderElection) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *LeaderElection) GetTerm() int64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *LeaderElection) GetAcquiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return nil
+}
+
+// ConnectionAck acknowledges cluster identification and indicates connection status.
+type ConnectionAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// accepted indicates whether the connection was accepted.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// chunked_cluster_state indicates the manager can reassemble cluster state sent as ClusterStateChunk messages.
+	ChunkedClusterState bool `protobuf:"varint,2,opt,name=chunked_cluster_state,json=chunkedClusterState,proto3" json:"chunked_cluster_state,omitempty"`
+	// max_message_size is the largest message the manager will receive, in bytes.
+	MaxMessageSize int32 `protobuf:"varint,3,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	// compressed_raw_config indicates the manager accepts Istio resources with zstd-compressed raw_config_zstd instead of raw_config.
+	CompressedRawConfig bool `protobuf:"varint,4,opt,name=compressed_raw_config,json=compressedRawConfig,proto3" json:"compressed_raw_config,omitempty"`
+	// streamed_cluster_state indicates the manager can reassemble cluster state sent as ClusterStateChunk messages
+	// whose total is not known in advance, completed by a chunk marked final.
+	StreamedClusterState bool `protobuf:"varint,5,opt,name=streamed_cluster_state,json=streamedClusterState,proto3" json:"streamed_cluster_state,omitempty"`
+	// sync_offset is the fraction of its sync interval, from 0 up to 1, by which the edge delays its periodic
+	// syncs. The manager assigns connected edges offsets spread over the interval so they do not all sync at once.
+	SyncOffset float64 `protobuf:"fixed64,6,opt,name=sync_offset,json=syncOffset,proto3" json:"sync_offset,omitempty"`
+	// protocol describes the parts of the backend protocol the manager supports. Edges that predate capability
+	// negotiation rely on chunked_cluster_state, streamed_cluster_state and compressed_raw_config instead.
+	Protocol *ProtocolCapabilities `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *ConnectionAck) Reset() {
+	*x = ConnectionAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionAck) ProtoMessage() {}
+
+func (x *ConnectionAck) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionAck.ProtoReflect.Descriptor instead.
+func (*ConnectionAck) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConnectionAck) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ConnectionAck) GetChunkedClusterState() bool {
+	if x != nil {
+		return x.ChunkedClusterState
+	}
+	return false
+}
+
+func (x *ConnectionAck) GetMaxMessageSize() int32 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *ConnectionAck) GetCompressedRawConfig() bool {
+	if x != nil {
+		return x.CompressedRawConfig
+	}
+	return false
+}
+
+func (x *ConnectionAck) GetStreamedClusterState() bool {
+	if x != nil {
+		return x.StreamedClusterState
+	}
+	return false
+}
+
+func (x *ConnectionAck) GetSyncOffset() float64 {
+	if x != nil {
+		return x.SyncOffset
+	}
+	return 0
+}
+
+func (x *ConnectionAck) GetProtocol() *ProtocolCapabilities {
+	if x != nil {
+		return x.Protocol
+	}
+	return nil
+}
+
+// ClusterStateChunk carries part of a cluster state that is too large to send as a single message.
+// The manager merges chunks in order and applies the cluster state once all chunks have arrived.
+type ClusterStateChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sync_id identifies the cluster state this chunk belongs to; all chunks of one state share it.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// index is the zero-based position of this chunk within the cluster state.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// total is the number of chunks making up the cluster state, or 0 when the state is streamed and its last
+	// chunk is marked final.
+	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// partial_state contains a subset of the cluster state's resources.
+	PartialState *ClusterState `protobuf:"bytes,4,opt,name=partial_state,json=partialState,proto3" json:"partial_state,omitempty"`
+	// final marks the last chunk of a streamed cluster state.
+	Final bool `protobuf:"varint,5,opt,name=final,proto3" json:"final,omitempty"`
+}
+
+func (x *ClusterStateChunk) Reset() {
+	*x = ClusterStateChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterStateChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterStateChunk) ProtoMessage() {}
+
+func (x *ClusterStateChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterStateChunk.ProtoReflect.Descriptor instead.
+func (*ClusterStateChunk) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClusterStateChunk) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *ClusterStateChunk) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ClusterStateChunk) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ClusterStateChunk) GetPartialState() *ClusterState {
+	if x != nil {
+		return x.PartialState
+	}
+	return nil
+}
+
+func (x *ClusterStateChunk) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+// ErrorMessage indicates an error condition.
+type ErrorMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error_code provides a machine-readable error identifier.
+	ErrorCode string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// error_message provides a human-readable error description.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ErrorMessage) Reset() {
+	*x = ErrorMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorMessage) ProtoMessage() {}
+
+func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ErrorMessage) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ErrorMessage) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ErrorMessage) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ResyncRequest is sent by the manager to trigger an immediate cluster state sync.
+type ResyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reason is a human-readable explanation of why the resync was requested.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ResyncRequest) Reset() {
+	*x = ResyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncRequest) ProtoMessage() {}
+
+func (x *ResyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncRequest.ProtoReflect.Descriptor instead.
+func (*ResyncRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResyncRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ProxyConfigRequest is sent by the manager to request proxy configuration for a specific pod.
+type ProxyConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// pod_namespace is the Kubernetes namespace of the pod.
+	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	// pod_name is the Kubernetes name of the pod.
+	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *ProxyConfigRequest) Reset() {
+	*x = ProxyConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConfigRequest) ProtoMessage() {}
+
+func (x *ProxyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ProxyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProxyConfigRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ProxyConfigRequest) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *ProxyConfigRequest) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *ProxyConfigRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// ProxyConfigResponse is sent by the edge process in response to a proxy config request.
+type ProxyConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding ProxyConfigRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*ProxyConfigResponse_ProxyConfig
+	//	*ProxyConfigResponse_ErrorMessage
+	Result isProxyConfigResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *ProxyConfigResponse) Reset() {
+	*x = ProxyConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConfigResponse) ProtoMessage() {}
+
+func (x *ProxyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ProxyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProxyConfigResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *ProxyConfigResponse) GetResult() isProxyConfigResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ProxyConfigResponse) GetProxyConfig() *v1alpha1.ProxyConfig {
+	if x, ok := x.GetResult().(*ProxyConfigResponse_ProxyConfig); ok {
+		return x.ProxyConfig
+	}
+	return nil
+}
+
+func (x *ProxyConfigResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*ProxyConfigResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isProxyConfigResponse_Result interface {
+	isProxyConfigResponse_Result()
+}
+
+type ProxyConfigResponse_ProxyConfig struct {
+	// proxy_config contains the proxy configuration for the requested pod.
+	ProxyConfig *v1alpha1.ProxyConfig `protobuf:"bytes,2,opt,name=proxy_config,json=proxyConfig,proto3,oneof"`
+}
+
+type ProxyConfigResponse_ErrorMessage struct {
+	// error_message indicates that the proxy config could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*ProxyConfigResponse_ProxyConfig) isProxyConfigResponse_Result() {}
+
+func (*ProxyConfigResponse_ErrorMessage) isProxyConfigResponse_Result() {}
+
+// PodLogsRequest is sent by the manager to request container logs for a specific pod.
+type PodLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// pod_namespace is the Kubernetes namespace of the pod.
+	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	// pod_name is the Kubernetes name of the pod.
+	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// container is the container to retrieve logs from. If empty, the pod's default container is used.
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// tail_lines limits the number of lines returned from the end of the log. If zero, the edge default is used.
+	TailLines int64 `protobuf:"varint,5,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// since_seconds limits the logs to those written within this many seconds. If zero, no time limit is applied.
+	SinceSeconds int64 `protobuf:"varint,6,opt,name=since_seconds,json=sinceSeconds,proto3" json:"since_seconds,omitempty"`
+	// previous returns logs from the previous terminated instance of the container.
+	Previous bool `protobuf:"varint,7,opt,name=previous,proto3" json:"previous,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,8,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *PodLogsRequest) Reset() {
+	*x = PodLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodLogsRequest) ProtoMessage() {}
+
+func (x *PodLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodLogsRequest.ProtoReflect.Descriptor instead.
+func (*PodLogsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PodLogsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PodLogsRequest) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *PodLogsRequest) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *PodLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *PodLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *PodLogsRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
+	}
+	return 0
+}
+
+func (x *PodLogsRequest) GetPrevious() bool {
+	if x != nil {
+		return x.Previous
+	}
+	return false
+}
+
+func (x *PodLogsRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// PodLogsResponse is sent by the edge process in response to a pod logs request.
+type PodLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding PodLogsRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*PodLogsResponse_Logs
+	//	*PodLogsResponse_ErrorMessage
+	Result isPodLogsResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *PodLogsResponse) Reset() {
+	*x = PodLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodLogsResponse) ProtoMessage() {}
+
+func (x *PodLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodLogsResponse.ProtoReflect.Descriptor instead.
+func (*PodLogsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PodLogsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *PodLogsResponse) GetResult() isPodLogsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *PodLogsResponse) GetLogs() *v1alpha1.ContainerLogs {
+	if x, ok := x.GetResult().(*PodLogsResponse_Logs); ok {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *PodLogsResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*PodLogsResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isPodLogsResponse_Result interface {
+	isPodLogsResponse_Result()
+}
+
+type PodLogsResponse_Logs struct {
+	// logs contains the requested container logs.
+	Logs *v1alpha1.ContainerLogs `protobuf:"bytes,2,opt,name=logs,proto3,oneof"`
+}
+
+type PodLogsResponse_ErrorMessage struct {
+	// error_message indicates that the logs could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*PodLogsResponse_Logs) isPodLogsResponse_Result() {}
+
+func (*PodLogsResponse_ErrorMessage) isPodLogsResponse_Result() {}
+
+// EnvoyAdminRequest is sent by the manager to query an allowlisted Envoy admin endpoint for a specific pod.
+type EnvoyAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// pod_namespace is the Kubernetes namespace of the pod.
+	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	// pod_name is the Kubernetes name of the pod.
+	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// path is the Envoy admin endpoint to query (e.g., "stats", "config_dump").
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// query is the query string passed to the admin endpoint, without the leading "?".
+	Query string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,6,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *EnvoyAdminRequest) Reset() {
+	*x = EnvoyAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvoyAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvoyAdminRequest) ProtoMessage() {}
+
+func (x *EnvoyAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvoyAdminRequest.ProtoReflect.Descriptor instead.
+func (*EnvoyAdminRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EnvoyAdminRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EnvoyAdminRequest) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *EnvoyAdminRequest) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *EnvoyAdminRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EnvoyAdminRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *EnvoyAdminRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// EnvoyAdminResponse is sent by the edge process in response to an Envoy admin request.
+type EnvoyAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding EnvoyAdminRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*EnvoyAdminResponse_Output
+	//	*EnvoyAdminResponse_ErrorMessage
+	Result isEnvoyAdminResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *EnvoyAdminResponse) Reset() {
+	*x = EnvoyAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvoyAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvoyAdminResponse) ProtoMessage() {}
+
+func (x *EnvoyAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvoyAdminResponse.ProtoReflect.Descriptor instead.
+func (*EnvoyAdminResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EnvoyAdminResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *EnvoyAdminResponse) GetResult() isEnvoyAdminResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *EnvoyAdminResponse) GetOutput() string {
+	if x, ok := x.GetResult().(*EnvoyAdminResponse_Output); ok {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *EnvoyAdminResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*EnvoyAdminResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isEnvoyAdminResponse_Result interface {
+	isEnvoyAdminResponse_Result()
+}
+
+type EnvoyAdminResponse_Output struct {
+	// output is the raw response body returned by the admin endpoint.
+	Output string `protobuf:"bytes,2,opt,name=output,proto3,oneof"`
+}
+
+type EnvoyAdminResponse_ErrorMessage struct {
+	// error_message indicates that the admin endpoint could not be queried.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*EnvoyAdminResponse_Output) isEnvoyAdminResponse_Result() {}
+
+func (*EnvoyAdminResponse_ErrorMessage) isEnvoyAdminResponse_Result() {}
+
+// ServiceConnectionsRequest is sent by the manager to request service connections for a specific service.
+type ServiceConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// service_name is the name of the service to get connections for.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// namespace is the Kubernetes namespace of the service.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// start_time specifies the start time for the metrics query.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time specifies the end time for the metrics query.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// proxy_mode indicates whether this service is a gateway (ROUTER) or regular service (SIDECAR).
+	ProxyMode v1alpha1.ProxyMode `protobuf:"varint,6,opt,name=proxy_mode,json=proxyMode,proto3,enum=navigator.types.v1alpha1.ProxyMode" json:"proxy_mode,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,7,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// perspective selects which side of each connection reports its metrics.
+	Perspective v1alpha1.MetricsPerspective `protobuf:"varint,8,opt,name=perspective,proto3,enum=navigator.types.v1alpha1.MetricsPerspective" json:"perspective,omitempty"`
+}
+
+func (x *ServiceConnectionsRequest) Reset() {
+	*x = ServiceConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceConnectionsRequest) ProtoMessage() {}
+
+func (x *ServiceConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ServiceConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ServiceConnectionsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ServiceConnectionsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceConnectionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceConnectionsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ServiceConnectionsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ServiceConnectionsRequest) GetProxyMode() v1alpha1.ProxyMode {
+	if x != nil {
+		return x.ProxyMode
+	}
+	return v1alpha1.ProxyMode(0)
+}
+
+func (x *ServiceConnectionsRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *ServiceConnectionsRequest) GetPerspective() v1alpha1.MetricsPerspective {
+	if x != nil {
+		return x.Perspective
+	}
+	return v1alpha1.MetricsPerspective(0)
+}
+
+// ServiceConnectionsResponse is sent by the edge process in response to a service connections request.
+type ServiceConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding ServiceConnectionsRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*ServiceConnectionsResponse_ServiceConnections
+	//	*ServiceConnectionsResponse_ErrorMessage
+	Result isServiceConnectionsResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *ServiceConnectionsResponse) Reset() {
+	*x = ServiceConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceConnectionsResponse) ProtoMessage() {}
+
+func (x *ServiceConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ServiceConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ServiceConnectionsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *ServiceConnectionsResponse) GetResult() isServiceConnectionsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ServiceConnectionsResponse) GetServiceConnections() *v1alpha1.ServiceGraphMetrics {
+	if x, ok := x.GetResult().(*ServiceConnectionsResponse_ServiceConnections); ok {
+		return x.ServiceConnections
+	}
+	return nil
+}
+
+func (x *ServiceConnectionsResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*ServiceConnectionsResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isServiceConnectionsResponse_Result interface {
+	isServiceConnectionsResponse_Result()
+}
+
+type ServiceConnectionsResponse_ServiceConnections struct {
+	// service_connections contains the service connections for the requested service.
+	ServiceConnections *v1alpha1.ServiceGraphMetrics `protobuf:"bytes,2,opt,name=service_connections,json=serviceConnections,proto3,oneof"`
+}
+
+type ServiceConnectionsResponse_ErrorMessage struct {
+	// error_message indicates that the service connections could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*ServiceConnectionsResponse_ServiceConnections) isServiceConnectionsResponse_Result() {}
+
+func (*ServiceConnectionsResponse_ErrorMessage) isServiceConnectionsResponse_Result() {}
+
+// PairInstanceMetricsRequest is sent by the manager to request the metrics between a source and a destination service
+// broken down by workload and pod.
+type PairInstanceMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// source_service is the name of the calling service.
+	SourceService string `protobuf:"bytes,2,opt,name=source_service,json=sourceService,proto3" json:"source_service,omitempty"`
+	// source_namespace is the Kubernetes namespace of the calling service.
+	SourceNamespace string `protobuf:"bytes,3,opt,name=source_namespace,json=sourceNamespace,proto3" json:"source_namespace,omitempty"`
+	// destination_service is the name of the called service.
+	DestinationService string `protobuf:"bytes,4,opt,name=destination_service,json=destinationService,proto3" json:"destination_service,omitempty"`
+	// destination_namespace is the Kubernetes namespace of the called service.
+	DestinationNamespace string `protobuf:"bytes,5,opt,name=destination_namespace,json=destinationNamespace,proto3" json:"destination_namespace,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,6,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *PairInstanceMetricsRequest) Reset() {
+	*x = PairInstanceMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairInstanceMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairInstanceMetricsRequest) ProtoMessage() {}
+
+func (x *PairInstanceMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairInstanceMetricsRequest.ProtoReflect.Descriptor instead.
+func (*PairInstanceMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PairInstanceMetricsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PairInstanceMetricsRequest) GetSourceService() string {
+	if x != nil {
+		return x.SourceService
+	}
+	return ""
+}
+
+func (x *PairInstanceMetricsRequest) GetSourceNamespace() string {
+	if x != nil {
+		return x.SourceNamespace
+	}
+	return ""
+}
+
+func (x *PairInstanceMetricsRequest) GetDestinationService() string {
+	if x != nil {
+		return x.DestinationService
+	}
+	return ""
+}
+
+func (x *PairInstanceMetricsRequest) GetDestinationNamespace() string {
+	if x != nil {
+		return x.DestinationNamespace
+	}
+	return ""
+}
+
+func (x *PairInstanceMetricsRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// PairInstanceMetricsResponse is sent by the edge process in response to a pair instance metrics request.
+type PairInstanceMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding PairInstanceMetricsRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*PairInstanceMetricsResponse_PairInstanceMetrics
+	//	*PairInstanceMetricsResponse_ErrorMessage
+	Result isPairInstanceMetricsResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *PairInstanceMetricsResponse) Reset() {
+	*x = PairInstanceMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairInstanceMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairInstanceMetricsResponse) ProtoMessage() {}
+
+func (x *PairInstanceMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairInstanceMetricsResponse.ProtoReflect.Descriptor instead.
+func (*PairInstanceMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PairInstanceMetricsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *PairInstanceMetricsResponse) GetResult() isPairInstanceMetricsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *PairInstanceMetricsResponse) GetPairInstanceMetrics() *v1alpha1.PairInstanceMetrics {
+	if x, ok := x.GetResult().(*PairInstanceMetricsResponse_PairInstanceMetrics); ok {
+		return x.PairInstanceMetrics
+	}
+	return nil
+}
+
+func (x *PairInstanceMetricsResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*PairInstanceMetricsResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isPairInstanceMetricsResponse_Result interface {
+	isPairInstanceMetricsResponse_Result()
+}
+
+type PairInstanceMetricsResponse_PairInstanceMetrics struct {
+	// pair_instance_metrics contains the metrics between the requested services, broken down by pod.
+	PairInstanceMetrics *v1alpha1.PairInstanceMetrics `protobuf:"bytes,2,opt,name=pair_instance_metrics,json=pairInstanceMetrics,proto3,oneof"`
+}
+
+type PairInstanceMetricsResponse_ErrorMessage struct {
+	// error_message indicates that the metrics could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*PairInstanceMetricsResponse_PairInstanceMetrics) isPairInstanceMetricsResponse_Result() {}
+
+func (*PairInstanceMetricsResponse_ErrorMessage) isPairInstanceMetricsResponse_Result() {}
+
+// MeshMetricsTimeSeriesRequest is sent by the manager to request the metrics of service pairs over consecutive
+// intervals of a time window.
+type MeshMetricsTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// pairs are the service pairs to return time series for.
+	Pairs []*v1alpha1.ServicePair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// start_time is the start of the time window.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the end of the time window.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// buckets is the number of equal intervals the time window is divided into.
+	Buckets int32 `protobuf:"varint,5,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,6,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *MeshMetricsTimeSeriesRequest) Reset() {
+	*x = MeshMetricsTimeSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[6]
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProxyConfigRequest) String() string {
+func (x *MeshMetricsTimeSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyConfigRequest) ProtoMessage() {}
+func (*MeshMetricsTimeSeriesRequest) ProtoMessage() {}
 
-func (x *ProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[6]
+func (x *MeshMetricsTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,64 +2446,85 @@ func (x *ProxyConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyConfigRequest.ProtoReflect.Descriptor instead.
-func (*ProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MeshMetricsTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*MeshMetricsTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ProxyConfigRequest) GetRequestId() string {
+func (x *MeshMetricsTimeSeriesRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ProxyConfigRequest) GetPodNamespace() string {
+func (x *MeshMetricsTimeSeriesRequest) GetPairs() []*v1alpha1.ServicePair {
 	if x != nil {
-		return x.PodNamespace
+		return x.Pairs
 	}
-	return ""
+	return nil
 }
 
-func (x *ProxyConfigRequest) GetPodName() string {
+func (x *MeshMetricsTimeSeriesRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PodName
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MeshMetricsTimeSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MeshMetricsTimeSeriesRequest) GetBuckets() int32 {
+	if x != nil {
+		return x.Buckets
+	}
+	return 0
+}
+
+func (x *MeshMetricsTimeSeriesRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
 	}
 	return ""
 }
 
-// ProxyConfigResponse is sent by the edge process in response to a proxy config request.
-type ProxyConfigResponse struct {
+// MeshMetricsTimeSeriesResponse is sent by the edge process in response to a mesh metrics time series request.
+type MeshMetricsTimeSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request_id matches the request_id from the corresponding ProxyConfigRequest.
+	// request_id matches the request_id from the corresponding MeshMetricsTimeSeriesRequest.
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Types that are assignable to Result:
 	//
-	//	*ProxyConfigResponse_ProxyConfig
-	//	*ProxyConfigResponse_ErrorMessage
-	Result isProxyConfigResponse_Result `protobuf_oneof:"result"`
+	//	*MeshMetricsTimeSeriesResponse_TimeSeries
+	//	*MeshMetricsTimeSeriesResponse_ErrorMessage
+	Result isMeshMetricsTimeSeriesResponse_Result `protobuf_oneof:"result"`
 }
 
-func (x *ProxyConfigResponse) Reset() {
-	*x = ProxyConfigResponse{}
+func (x *MeshMetricsTimeSeriesResponse) Reset() {
+	*x = MeshMetricsTimeSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[7]
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProxyConfigResponse) String() string {
+func (x *MeshMetricsTimeSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyConfigResponse) ProtoMessage() {}
+func (*MeshMetricsTimeSeriesResponse) ProtoMessage() {}
 
-func (x *ProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[7]
+func (x *MeshMetricsTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,94 +2535,98 @@ func (x *ProxyConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyConfigResponse.ProtoReflect.Descriptor instead.
-func (*ProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MeshMetricsTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*MeshMetricsTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ProxyConfigResponse) GetRequestId() string {
+func (x *MeshMetricsTimeSeriesResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (m *ProxyConfigResponse) GetResult() isProxyConfigResponse_Result {
+func (m *MeshMetricsTimeSeriesResponse) GetResult() isMeshMetricsTimeSeriesResponse_Result {
 	if m != nil {
 		return m.Result
 	}
 	return nil
 }
 
-func (x *ProxyConfigResponse) GetProxyConfig() *v1alpha1.ProxyConfig {
-	if x, ok := x.GetResult().(*ProxyConfigResponse_ProxyConfig); ok {
-		return x.ProxyConfig
+func (x *MeshMetricsTimeSeriesResponse) GetTimeSeries() *v1alpha1.MeshMetricsTimeSeries {
+	if x, ok := x.GetResult().(*MeshMetricsTimeSeriesResponse_TimeSeries); ok {
+		return x.TimeSeries
 	}
 	return nil
 }
 
-func (x *ProxyConfigResponse) GetErrorMessage() string {
-	if x, ok := x.GetResult().(*ProxyConfigResponse_ErrorMessage); ok {
+func (x *MeshMetricsTimeSeriesResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*MeshMetricsTimeSeriesResponse_ErrorMessage); ok {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type isProxyConfigResponse_Result interface {
-	isProxyConfigResponse_Result()
+type isMeshMetricsTimeSeriesResponse_Result interface {
+	isMeshMetricsTimeSeriesResponse_Result()
 }
 
-type ProxyConfigResponse_ProxyConfig struct {
-	// proxy_config contains the proxy configuration for the requested pod.
-	ProxyConfig *v1alpha1.ProxyConfig `protobuf:"bytes,2,opt,name=proxy_config,json=proxyConfig,proto3,oneof"`
+type MeshMetricsTimeSeriesResponse_TimeSeries struct {
+	// time_series contains the metrics of the requested service pairs over time.
+	TimeSeries *v1alpha1.MeshMetricsTimeSeries `protobuf:"bytes,2,opt,name=time_series,json=timeSeries,proto3,oneof"`
 }
 
-type ProxyConfigResponse_ErrorMessage struct {
-	// error_message indicates that the proxy config could not be retrieved.
+type MeshMetricsTimeSeriesResponse_ErrorMessage struct {
+	// error_message indicates that the metrics could not be retrieved.
 	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
 }
 
-func (*ProxyConfigResponse_ProxyConfig) isProxyConfigResponse_Result() {}
+func (*MeshMetricsTimeSeriesResponse_TimeSeries) isMeshMetricsTimeSeriesResponse_Result() {}
 
-func (*ProxyConfigResponse_ErrorMessage) isProxyConfigResponse_Result() {}
+func (*MeshMetricsTimeSeriesResponse_ErrorMessage) isMeshMetricsTimeSeriesResponse_Result() {}
 
-// ServiceConnectionsRequest is sent by the manager to request service connections for a specific service.
-type ServiceConnectionsRequest struct {
+// TracesRequest is sent by the manager to search the edge's tracing backend for the traces of a service.
+type TracesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// request_id is a unique identifier for this request, used for correlating the response.
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// service_name is the name of the service to get connections for.
+	// service_name is the name of the service whose traces to return.
 	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	// namespace is the Kubernetes namespace of the service.
+	// namespace is the namespace of the service.
 	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// start_time specifies the start time for the metrics query.
+	// start_time is the start of the time window to search.
 	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// end_time specifies the end time for the metrics query.
+	// end_time is the end of the time window to search.
 	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// proxy_mode indicates whether this service is a gateway (ROUTER) or regular service (SIDECAR).
-	ProxyMode v1alpha1.ProxyMode `protobuf:"varint,6,opt,name=proxy_mode,json=proxyMode,proto3,enum=navigator.types.v1alpha1.ProxyMode" json:"proxy_mode,omitempty"`
+	// min_duration limits the search to traces at least this long.
+	MinDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	// limit is the most traces to return.
+	Limit int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,8,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
 }
 
-func (x *ServiceConnectionsRequest) Reset() {
-	*x = ServiceConnectionsRequest{}
+func (x *TracesRequest) Reset() {
+	*x = TracesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[8]
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceConnectionsRequest) String() string {
+func (x *TracesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceConnectionsRequest) ProtoMessage() {}
+func (*TracesRequest) ProtoMessage() {}
 
-func (x *ServiceConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[8]
+func (x *TracesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,85 +2637,322 @@ func (x *ServiceConnectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceConnectionsRequest.ProtoReflect.Descriptor instead.
-func (*ServiceConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TracesRequest.ProtoReflect.Descriptor instead.
+func (*TracesRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ServiceConnectionsRequest) GetRequestId() string {
+func (x *TracesRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ServiceConnectionsRequest) GetServiceName() string {
+func (x *TracesRequest) GetServiceName() string {
 	if x != nil {
 		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *ServiceConnectionsRequest) GetNamespace() string {
+func (x *TracesRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ServiceConnectionsRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *TracesRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartTime
 	}
 	return nil
 }
 
-func (x *ServiceConnectionsRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *TracesRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndTime
 	}
 	return nil
 }
 
-func (x *ServiceConnectionsRequest) GetProxyMode() v1alpha1.ProxyMode {
+func (x *TracesRequest) GetMinDuration() *durationpb.Duration {
 	if x != nil {
-		return x.ProxyMode
+		return x.MinDuration
 	}
-	return v1alpha1.ProxyMode(0)
+	return nil
 }
 
-// ServiceConnectionsResponse is sent by the edge process in response to a service connections request.
-type ServiceConnectionsResponse struct {
+func (x *TracesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TracesRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// TracesResponse is sent by the edge process in response to a traces request.
+type TracesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// request_id matches the request_id from the corresponding ServiceConnectionsRequest.
+	// request_id matches the request_id from the corresponding TracesRequest.
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Types that are assignable to Result:
 	//
-	//	*ServiceConnectionsResponse_ServiceConnections
-	//	*ServiceConnectionsResponse_ErrorMessage
-	Result isServiceConnectionsResponse_Result `protobuf_oneof:"result"`
+	//	*TracesResponse_Traces
+	//	*TracesResponse_ErrorMessage
+	Result isTracesResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *TracesResponse) Reset() {
+	*x = TracesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracesResponse) ProtoMessage() {}
+
+func (x *TracesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracesResponse.ProtoReflect.Descriptor instead.
+func (*TracesResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TracesResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *TracesResponse) GetResult() isTracesResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *TracesResponse) GetTraces() *v1alpha1.ServiceTraces {
+	if x, ok := x.GetResult().(*TracesResponse_Traces); ok {
+		return x.Traces
+	}
+	return nil
+}
+
+func (x *TracesResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*TracesResponse_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isTracesResponse_Result interface {
+	isTracesResponse_Result()
+}
+
+type TracesResponse_Traces struct {
+	// traces contains the traces found in the edge's tracing backend.
+	Traces *v1alpha1.ServiceTraces `protobuf:"bytes,2,opt,name=traces,proto3,oneof"`
+}
+
+type TracesResponse_ErrorMessage struct {
+	// error_message indicates that the traces could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*TracesResponse_Traces) isTracesResponse_Result() {}
+
+func (*TracesResponse_ErrorMessage) isTracesResponse_Result() {}
+
+// AccessLogsRequest is sent by the manager to search the edge's logs backend for the access logs of a service.
+type AccessLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is a unique identifier for this request, used for correlating the response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// service_name is the name of the service whose access logs to return.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// namespace is the namespace of the service.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// pod_name limits the search to the access logs of a single pod, if set.
+	PodName string `protobuf:"bytes,4,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// start_time is the start of the time window to search.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the end of the time window to search.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// response_codes limits the search to requests with these response codes or classes, e.g. "404" or "5xx".
+	ResponseCodes []string `protobuf:"bytes,7,rep,name=response_codes,json=responseCodes,proto3" json:"response_codes,omitempty"`
+	// path_prefix limits the search to requests whose path starts with this prefix.
+	PathPrefix string `protobuf:"bytes,8,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// limit is the most entries to return.
+	Limit int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	// correlation_id is the ID of the originating frontend request, used for cross-service log correlation.
+	CorrelationId string `protobuf:"bytes,10,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *AccessLogsRequest) Reset() {
+	*x = AccessLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessLogsRequest) ProtoMessage() {}
+
+func (x *AccessLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessLogsRequest.ProtoReflect.Descriptor instead.
+func (*AccessLogsRequest) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AccessLogsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AccessLogsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AccessLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AccessLogsRequest) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *AccessLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AccessLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AccessLogsRequest) GetResponseCodes() []string {
+	if x != nil {
+		return x.ResponseCodes
+	}
+	return nil
+}
+
+func (x *AccessLogsRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *AccessLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AccessLogsRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// AccessLogsResponse is sent by the edge process in response to an access logs request.
+type AccessLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id matches the request_id from the corresponding AccessLogsRequest.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*AccessLogsResponse_AccessLogs
+	//	*AccessLogsResponse_ErrorMessage
+	Result isAccessLogsResponse_Result `protobuf_oneof:"result"`
 }
 
-func (x *ServiceConnectionsResponse) Reset() {
-	*x = ServiceConnectionsResponse{}
+func (x *AccessLogsResponse) Reset() {
+	*x = AccessLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[9]
+		mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceConnectionsResponse) String() string {
+func (x *AccessLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceConnectionsResponse) ProtoMessage() {}
+func (*AccessLogsResponse) ProtoMessage() {}
 
-func (x *ServiceConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[9]
+func (x *AccessLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_v1alpha1_manager_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,56 +2963,56 @@ func (x *ServiceConnectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceConnectionsResponse.ProtoReflect.Descriptor instead.
-func (*ServiceConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AccessLogsResponse.ProtoReflect.Descriptor instead.
+func (*AccessLogsResponse) Descriptor() ([]byte, []int) {
+	return file_backend_v1alpha1_manager_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ServiceConnectionsResponse) GetRequestId() string {
+func (x *AccessLogsResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (m *ServiceConnectionsResponse) GetResult() isServiceConnectionsResponse_Result {
+func (m *AccessLogsResponse) GetResult() isAccessLogsResponse_Result {
 	if m != nil {
 		return m.Result
 	}
 	return nil
 }
 
-func (x *ServiceConnectionsResponse) GetServiceConnections() *v1alpha1.ServiceGraphMetrics {
-	if x, ok := x.GetResult().(*ServiceConnectionsResponse_ServiceConnections); ok {
-		return x.ServiceConnections
+func (x *AccessLogsResponse) GetAccessLogs() *v1alpha1.ServiceAccessLogs {
+	if x, ok := x.GetResult().(*AccessLogsResponse_AccessLogs); ok {
+		return x.AccessLogs
 	}
 	return nil
 }
 
-func (x *ServiceConnectionsResponse) GetErrorMessage() string {
-	if x, ok := x.GetResult().(*ServiceConnectionsResponse_ErrorMessage); ok {
+func (x *AccessLogsResponse) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*AccessLogsResponse_ErrorMessage); ok {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type isServiceConnectionsResponse_Result interface {
-	isServiceConnectionsResponse_Result()
+type isAccessLogsResponse_Result interface {
+	isAccessLogsResponse_Result()
 }
 
-type ServiceConnectionsResponse_ServiceConnections struct {
-	// service_connections contains the service connections for the requested service.
-	ServiceConnections *v1alpha1.ServiceGraphMetrics `protobuf:"bytes,2,opt,name=service_connections,json=serviceConnections,proto3,oneof"`
+type AccessLogsResponse_AccessLogs struct {
+	// access_logs contains the access logs found in the edge's logs backend.
+	AccessLogs *v1alpha1.ServiceAccessLogs `protobuf:"bytes,2,opt,name=access_logs,json=accessLogs,proto3,oneof"`
 }
 
-type ServiceConnectionsResponse_ErrorMessage struct {
-	// error_message indicates that the service connections could not be retrieved.
+type AccessLogsResponse_ErrorMessage struct {
+	// error_message indicates that the access logs could not be retrieved.
 	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof"`
 }
 
-func (*ServiceConnectionsResponse_ServiceConnections) isServiceConnectionsResponse_Result() {}
+func (*AccessLogsResponse_AccessLogs) isAccessLogsResponse_Result() {}
 
-func (*ServiceConnectionsResponse_ErrorMessage) isServiceConnectionsResponse_Result() {}
+func (*AccessLogsResponse_ErrorMessage) isAccessLogsResponse_Result() {}
 
 var File_backend_v1alpha1_manager_service_proto protoreflect.FileDescriptor
 
@@ -835,14 +3023,23 @@ var file_backend_v1alpha1_manager_service_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x1a, 0x23, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xbb, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x22, 0xff, 0x09, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x16, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
 	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
@@ -869,116 +3066,542 @@ var file_backend_v1alpha1_manager_service_proto_rawDesc = []byte{
 	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8f,
-	0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x11, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x59, 0x0a, 0x11, 0x70, 0x6f, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x64,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f,
+	0x70, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x14, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x12, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1e, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1b, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x6d, 0x65,
+	0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67,
+	0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x48, 0x00, 0x52, 0x12, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xd3, 0x08, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x14,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x77, 0x0a, 0x1b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
 	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x1b,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x3b, 0x0a, 0x10, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x88, 0x01,
-	0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x73, 0x0a, 0x12, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb1,
-	0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61,
-	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0xb1, 0x02, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a,
+	0x10, 0x70, 0x6f, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x13, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x11, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x1d, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x20, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1c, 0x6d, 0x65, 0x73, 0x68,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x13,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x67,
+	0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x65,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x12,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x1c, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x90, 0x03, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x64,
+	0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x53, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52,
+	0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xd6, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65,
+	0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a,
+	0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0e, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x3b, 0x0a,
+	0x0b, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22,
+	0xbd, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x22,
+	0x52, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a,
+	0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x4a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x94, 0x02,
+	0x0a, 0x0e, 0x50, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x48, 0x00,
+	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x76, 0x6f,
+	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7e, 0x0a,
+	0x12, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa8, 0x03,
+	0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x50, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x60, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x48, 0x00, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x1a, 0x50, 0x61,
+	0x69, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x1b, 0x50, 0x61, 0x69, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x15, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x1c,
+	0x4d, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x05, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x1d,
+	0x4d, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x85, 0x03, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb4,
+	0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x60, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x48, 0x00, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x78, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
-	0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2a, 0xf0, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43,
+	0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28,
+	0x0a, 0x24, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x03,
+	0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x41, 0x50,
+	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x04, 0x32, 0x78, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
+	0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -993,46 +3616,114 @@ func file_backend_v1alpha1_manager_service_proto_rawDescGZIP() []byte {
 	return file_backend_v1alpha1_manager_service_proto_rawDescData
 }
 
-var file_backend_v1alpha1_manager_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_backend_v1alpha1_manager_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_backend_v1alpha1_manager_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_backend_v1alpha1_manager_service_proto_goTypes = []any{
-	(*ConnectRequest)(nil),               // 0: navigator.backend.v1alpha1.ConnectRequest
-	(*ConnectResponse)(nil),              // 1: navigator.backend.v1alpha1.ConnectResponse
-	(*EdgeCapabilities)(nil),             // 2: navigator.backend.v1alpha1.EdgeCapabilities
-	(*ClusterIdentification)(nil),        // 3: navigator.backend.v1alpha1.ClusterIdentification
-	(*ConnectionAck)(nil),                // 4: navigator.backend.v1alpha1.ConnectionAck
-	(*ErrorMessage)(nil),                 // 5: navigator.backend.v1alpha1.ErrorMessage
-	(*ProxyConfigRequest)(nil),           // 6: navigator.backend.v1alpha1.ProxyConfigRequest
-	(*ProxyConfigResponse)(nil),          // 7: navigator.backend.v1alpha1.ProxyConfigResponse
-	(*ServiceConnectionsRequest)(nil),    // 8: navigator.backend.v1alpha1.ServiceConnectionsRequest
-	(*ServiceConnectionsResponse)(nil),   // 9: navigator.backend.v1alpha1.ServiceConnectionsResponse
-	(*ClusterState)(nil),                 // 10: navigator.backend.v1alpha1.ClusterState
-	(*v1alpha1.ProxyConfig)(nil),         // 11: navigator.types.v1alpha1.ProxyConfig
-	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
-	(v1alpha1.ProxyMode)(0),              // 13: navigator.types.v1alpha1.ProxyMode
-	(*v1alpha1.ServiceGraphMetrics)(nil), // 14: navigator.types.v1alpha1.ServiceGraphMetrics
+	(ResourceCapabilityStatus)(0),          // 0: navigator.backend.v1alpha1.ResourceCapabilityStatus
+	(*ConnectRequest)(nil),                 // 1: navigator.backend.v1alpha1.ConnectRequest
+	(*ConnectResponse)(nil),                // 2: navigator.backend.v1alpha1.ConnectResponse
+	(*EdgeCapabilities)(nil),               // 3: navigator.backend.v1alpha1.EdgeCapabilities
+	(*PreflightReport)(nil),                // 4: navigator.backend.v1alpha1.PreflightReport
+	(*ResourceCapability)(nil),             // 5: navigator.backend.v1alpha1.ResourceCapability
+	(*ClusterIdentification)(nil),          // 6: navigator.backend.v1alpha1.ClusterIdentification
+	(*ProtocolCapabilities)(nil),           // 7: navigator.backend.v1alpha1.ProtocolCapabilities
+	(*NamespaceShard)(nil),                 // 8: navigator.backend.v1alpha1.NamespaceShard
+	(*LeaderElection)(nil),                 // 9: navigator.backend.v1alpha1.LeaderElection
+	(*ConnectionAck)(nil),                  // 10: navigator.backend.v1alpha1.ConnectionAck
+	(*ClusterStateChunk)(nil),              // 11: navigator.backend.v1alpha1.ClusterStateChunk
+	(*ErrorMessage)(nil),                   // 12: navigator.backend.v1alpha1.ErrorMessage
+	(*ResyncRequest)(nil),                  // 13: navigator.backend.v1alpha1.ResyncRequest
+	(*ProxyConfigRequest)(nil),             // 14: navigator.backend.v1alpha1.ProxyConfigRequest
+	(*ProxyConfigResponse)(nil),            // 15: navigator.backend.v1alpha1.ProxyConfigResponse
+	(*PodLogsRequest)(nil),                 // 16: navigator.backend.v1alpha1.PodLogsRequest
+	(*PodLogsResponse)(nil),                // 17: navigator.backend.v1alpha1.PodLogsResponse
+	(*EnvoyAdminRequest)(nil),              // 18: navigator.backend.v1alpha1.EnvoyAdminRequest
+	(*EnvoyAdminResponse)(nil),             // 19: navigator.backend.v1alpha1.EnvoyAdminResponse
+	(*ServiceConnectionsRequest)(nil),      // 20: navigator.backend.v1alpha1.ServiceConnectionsRequest
+	(*ServiceConnectionsResponse)(nil),     // 21: navigator.backend.v1alpha1.ServiceConnectionsResponse
+	(*PairInstanceMetricsRequest)(nil),     // 22: navigator.backend.v1alpha1.PairInstanceMetricsRequest
+	(*PairInstanceMetricsResponse)(nil),    // 23: navigator.backend.v1alpha1.PairInstanceMetricsResponse
+	(*MeshMetricsTimeSeriesRequest)(nil),   // 24: navigator.backend.v1alpha1.MeshMetricsTimeSeriesRequest
+	(*MeshMetricsTimeSeriesResponse)(nil),  // 25: navigator.backend.v1alpha1.MeshMetricsTimeSeriesResponse
+	(*TracesRequest)(nil),                  // 26: navigator.backend.v1alpha1.TracesRequest
+	(*TracesResponse)(nil),                 // 27: navigator.backend.v1alpha1.TracesResponse
+	(*AccessLogsRequest)(nil),              // 28: navigator.backend.v1alpha1.AccessLogsRequest
+	(*AccessLogsResponse)(nil),             // 29: navigator.backend.v1alpha1.AccessLogsResponse
+	(*ClusterState)(nil),                   // 30: navigator.backend.v1alpha1.ClusterState
+	(*timestamppb.Timestamp)(nil),          // 31: google.protobuf.Timestamp
+	(*v1alpha1.ProxyConfig)(nil),           // 32: navigator.types.v1alpha1.ProxyConfig
+	(*v1alpha1.ContainerLogs)(nil),         // 33: navigator.types.v1alpha1.ContainerLogs
+	(v1alpha1.ProxyMode)(0),                // 34: navigator.types.v1alpha1.ProxyMode
+	(v1alpha1.MetricsPerspective)(0),       // 35: navigator.types.v1alpha1.MetricsPerspective
+	(*v1alpha1.ServiceGraphMetrics)(nil),   // 36: navigator.types.v1alpha1.ServiceGraphMetrics
+	(*v1alpha1.PairInstanceMetrics)(nil),   // 37: navigator.types.v1alpha1.PairInstanceMetrics
+	(*v1alpha1.ServicePair)(nil),           // 38: navigator.types.v1alpha1.ServicePair
+	(*v1alpha1.MeshMetricsTimeSeries)(nil), // 39: navigator.types.v1alpha1.MeshMetricsTimeSeries
+	(*durationpb.Duration)(nil),            // 40: google.protobuf.Duration
+	(*v1alpha1.ServiceTraces)(nil),         // 41: navigator.types.v1alpha1.ServiceTraces
+	(*v1alpha1.ServiceAccessLogs)(nil),     // 42: navigator.types.v1alpha1.ServiceAccessLogs
 }
 var file_backend_v1alpha1_manager_service_proto_depIdxs = []int32{
-	3,  // 0: navigator.backend.v1alpha1.ConnectRequest.cluster_identification:type_name -> navigator.backend.v1alpha1.ClusterIdentification
-	10, // 1: navigator.backend.v1alpha1.ConnectRequest.cluster_state:type_name -> navigator.backend.v1alpha1.ClusterState
-	7,  // 2: navigator.backend.v1alpha1.ConnectRequest.proxy_config_response:type_name -> navigator.backend.v1alpha1.ProxyConfigResponse
-	9,  // 3: navigator.backend.v1alpha1.ConnectRequest.service_connections_response:type_name -> navigator.backend.v1alpha1.ServiceConnectionsResponse
-	4,  // 4: navigator.backend.v1alpha1.ConnectResponse.connection_ack:type_name -> navigator.backend.v1alpha1.ConnectionAck
-	5,  // 5: navigator.backend.v1alpha1.ConnectResponse.error:type_name -> navigator.backend.v1alpha1.ErrorMessage
-	6,  // 6: navigator.backend.v1alpha1.ConnectResponse.proxy_config_request:type_name -> navigator.backend.v1alpha1.ProxyConfigRequest
-	8,  // 7: navigator.backend.v1alpha1.ConnectResponse.service_connections_request:type_name -> navigator.backend.v1alpha1.ServiceConnectionsRequest
-	2,  // 8: navigator.backend.v1alpha1.ClusterIdentification.capabilities:type_name -> navigator.backend.v1alpha1.EdgeCapabilities
-	11, // 9: navigator.backend.v1alpha1.ProxyConfigResponse.proxy_config:type_name -> navigator.types.v1alpha1.ProxyConfig
-	12, // 10: navigator.backend.v1alpha1.ServiceConnectionsRequest.start_time:type_name -> google.protobuf.Timestamp
-	12, // 11: navigator.backend.v1alpha1.ServiceConnectionsRequest.end_time:type_name -> google.protobuf.Timestamp
-	13, // 12: navigator.backend.v1alpha1.ServiceConnectionsRequest.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
-	14, // 13: navigator.backend.v1alpha1.ServiceConnectionsResponse.service_connections:type_name -> navigator.types.v1alpha1.ServiceGraphMetrics
-	0,  // 14: navigator.backend.v1alpha1.ManagerService.Connect:input_type -> navigator.backend.v1alpha1.ConnectRequest
-	1,  // 15: navigator.backend.v1alpha1.ManagerService.Connect:output_type -> navigator.backend.v1alpha1.ConnectResponse
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	6,  // 0: navigator.backend.v1alpha1.ConnectRequest.cluster_identification:type_name -> navigator.backend.v1alpha1.ClusterIdentification
+	30, // 1: navigator.backend.v1alpha1.ConnectRequest.cluster_state:type_name -> navigator.backend.v1alpha1.ClusterState
+	15, // 2: navigator.backend.v1alpha1.ConnectRequest.proxy_config_response:type_name -> navigator.backend.v1alpha1.ProxyConfigResponse
+	21, // 3: navigator.backend.v1alpha1.ConnectRequest.service_connections_response:type_name -> navigator.backend.v1alpha1.ServiceConnectionsResponse
+	11, // 4: navigator.backend.v1alpha1.ConnectRequest.cluster_state_chunk:type_name -> navigator.backend.v1alpha1.ClusterStateChunk
+	17, // 5: navigator.backend.v1alpha1.ConnectRequest.pod_logs_response:type_name -> navigator.backend.v1alpha1.PodLogsResponse
+	19, // 6: navigator.backend.v1alpha1.ConnectRequest.envoy_admin_response:type_name -> navigator.backend.v1alpha1.EnvoyAdminResponse
+	23, // 7: navigator.backend.v1alpha1.ConnectRequest.pair_instance_metrics_response:type_name -> navigator.backend.v1alpha1.PairInstanceMetricsResponse
+	25, // 8: navigator.backend.v1alpha1.ConnectRequest.mesh_metrics_time_series_response:type_name -> navigator.backend.v1alpha1.MeshMetricsTimeSeriesResponse
+	27, // 9: navigator.backend.v1alpha1.ConnectRequest.traces_response:type_name -> navigator.backend.v1alpha1.TracesResponse
+	29, // 10: navigator.backend.v1alpha1.ConnectRequest.access_logs_response:type_name -> navigator.backend.v1alpha1.AccessLogsResponse
+	3,  // 11: navigator.backend.v1alpha1.ConnectRequest.capabilities_update:type_name -> navigator.backend.v1alpha1.EdgeCapabilities
+	10, // 12: navigator.backend.v1alpha1.ConnectResponse.connection_ack:type_name -> navigator.backend.v1alpha1.ConnectionAck
+	12, // 13: navigator.backend.v1alpha1.ConnectResponse.error:type_name -> navigator.backend.v1alpha1.ErrorMessage
+	14, // 14: navigator.backend.v1alpha1.ConnectResponse.proxy_config_request:type_name -> navigator.backend.v1alpha1.ProxyConfigRequest
+	20, // 15: navigator.backend.v1alpha1.ConnectResponse.service_connections_request:type_name -> navigator.backend.v1alpha1.ServiceConnectionsRequest
+	13, // 16: navigator.backend.v1alpha1.ConnectResponse.resync_request:type_name -> navigator.backend.v1alpha1.ResyncRequest
+	16, // 17: navigator.backend.v1alpha1.ConnectResponse.pod_logs_request:type_name -> navigator.backend.v1alpha1.PodLogsRequest
+	18, // 18: navigator.backend.v1alpha1.ConnectResponse.envoy_admin_request:type_name -> navigator.backend.v1alpha1.EnvoyAdminRequest
+	22, // 19: navigator.backend.v1alpha1.ConnectResponse.pair_instance_metrics_request:type_name -> navigator.backend.v1alpha1.PairInstanceMetricsRequest
+	24, // 20: navigator.backend.v1alpha1.ConnectResponse.mesh_metrics_time_series_request:type_name -> navigator.backend.v1alpha1.MeshMetricsTimeSeriesRequest
+	26, // 21: navigator.backend.v1alpha1.ConnectResponse.traces_request:type_name -> navigator.backend.v1alpha1.TracesRequest
+	28, // 22: navigator.backend.v1alpha1.ConnectResponse.access_logs_request:type_name -> navigator.backend.v1alpha1.AccessLogsRequest
+	4,  // 23: navigator.backend.v1alpha1.EdgeCapabilities.preflight:type_name -> navigator.backend.v1alpha1.PreflightReport
+	5,  // 24: navigator.backend.v1alpha1.PreflightReport.resources:type_name -> navigator.backend.v1alpha1.ResourceCapability
+	31, // 25: navigator.backend.v1alpha1.PreflightReport.checked_at:type_name -> google.protobuf.Timestamp
+	0,  // 26: navigator.backend.v1alpha1.ResourceCapability.status:type_name -> navigator.backend.v1alpha1.ResourceCapabilityStatus
+	3,  // 27: navigator.backend.v1alpha1.ClusterIdentification.capabilities:type_name -> navigator.backend.v1alpha1.EdgeCapabilities
+	9,  // 28: navigator.backend.v1alpha1.ClusterIdentification.leader_election:type_name -> navigator.backend.v1alpha1.LeaderElection
+	8,  // 29: navigator.backend.v1alpha1.ClusterIdentification.shard:type_name -> navigator.backend.v1alpha1.NamespaceShard
+	7,  // 30: navigator.backend.v1alpha1.ClusterIdentification.protocol:type_name -> navigator.backend.v1alpha1.ProtocolCapabilities
+	31, // 31: navigator.backend.v1alpha1.LeaderElection.acquired_at:type_name -> google.protobuf.Timestamp
+	7,  // 32: navigator.backend.v1alpha1.ConnectionAck.protocol:type_name -> navigator.backend.v1alpha1.ProtocolCapabilities
+	30, // 33: navigator.backend.v1alpha1.ClusterStateChunk.partial_state:type_name -> navigator.backend.v1alpha1.ClusterState
+	32, // 34: navigator.backend.v1alpha1.ProxyConfigResponse.proxy_config:type_name -> navigator.types.v1alpha1.ProxyConfig
+	33, // 35: navigator.backend.v1alpha1.PodLogsResponse.logs:type_name -> navigator.types.v1alpha1.ContainerLogs
+	31, // 36: navigator.backend.v1alpha1.ServiceConnectionsRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 37: navigator.backend.v1alpha1.ServiceConnectionsRequest.end_time:type_name -> google.protobuf.Timestamp
+	34, // 38: navigator.backend.v1alpha1.ServiceConnectionsRequest.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
+	35, // 39: navigator.backend.v1alpha1.ServiceConnectionsRequest.perspective:type_name -> navigator.types.v1alpha1.MetricsPerspective
+	36, // 40: navigator.backend.v1alpha1.ServiceConnectionsResponse.service_connections:type_name -> navigator.types.v1alpha1.ServiceGraphMetrics
+	37, // 41: navigator.backend.v1alpha1.PairInstanceMetricsResponse.pair_instance_metrics:type_name -> navigator.types.v1alpha1.PairInstanceMetrics
+	38, // 42: navigator.backend.v1alpha1.MeshMetricsTimeSeriesRequest.pairs:type_name -> navigator.types.v1alpha1.ServicePair
+	31, // 43: navigator.backend.v1alpha1.MeshMetricsTimeSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 44: navigator.backend.v1alpha1.MeshMetricsTimeSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	39, // 45: navigator.backend.v1alpha1.MeshMetricsTimeSeriesResponse.time_series:type_name -> navigator.types.v1alpha1.MeshMetricsTimeSeries
+	31, // 46: navigator.backend.v1alpha1.TracesRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 47: navigator.backend.v1alpha1.TracesRequest.end_time:type_name -> google.protobuf.Timestamp
+	40, // 48: navigator.backend.v1alpha1.TracesRequest.min_duration:type_name -> google.protobuf.Duration
+	41, // 49: navigator.backend.v1alpha1.TracesResponse.traces:type_name -> navigator.types.v1alpha1.ServiceTraces
+	31, // 50: navigator.backend.v1alpha1.AccessLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 51: navigator.backend.v1alpha1.AccessLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	42, // 52: navigator.backend.v1alpha1.AccessLogsResponse.access_logs:type_name -> navigator.types.v1alpha1.ServiceAccessLogs
+	1,  // 53: navigator.backend.v1alpha1.ManagerService.Connect:input_type -> navigator.backend.v1alpha1.ConnectRequest
+	2,  // 54: navigator.backend.v1alpha1.ManagerService.Connect:output_type -> navigator.backend.v1alpha1.ConnectResponse
+	54, // [54:55] is the sub-list for method output_type
+	53, // [53:54] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_backend_v1alpha1_manager_service_proto_init() }
@@ -1079,7 +3770,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ClusterIdentification); i {
+			switch v := v.(*PreflightReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1091,7 +3782,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ConnectionAck); i {
+			switch v := v.(*ResourceCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1103,7 +3794,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ErrorMessage); i {
+			switch v := v.(*ClusterIdentification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1115,7 +3806,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ProxyConfigRequest); i {
+			switch v := v.(*ProtocolCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1127,7 +3818,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ProxyConfigResponse); i {
+			switch v := v.(*NamespaceShard); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1139,7 +3830,7 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ServiceConnectionsRequest); i {
+			switch v := v.(*LeaderElection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1151,6 +3842,138 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 			}
 		}
 		file_backend_v1alpha1_manager_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ConnectionAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterStateChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ResyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ProxyConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ProxyConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PodLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*PodLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*EnvoyAdminRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*EnvoyAdminResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceConnectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceConnectionsResponse); i {
 			case 0:
 				return &v.state
@@ -1162,39 +3985,175 @@ func file_backend_v1alpha1_manager_service_proto_init() {
 				return nil
 			}
 		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PairInstanceMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PairInstanceMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*MeshMetricsTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*MeshMetricsTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*TracesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*TracesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AccessLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backend_v1alpha1_manager_service_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*AccessLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_backend_v1alpha1_manager_service_proto_msgTypes[0].OneofWrappers = []any{
 		(*ConnectRequest_ClusterIdentification)(nil),
 		(*ConnectRequest_ClusterState)(nil),
 		(*ConnectRequest_ProxyConfigResponse)(nil),
 		(*ConnectRequest_ServiceConnectionsResponse)(nil),
+		(*ConnectRequest_ClusterStateChunk)(nil),
+		(*ConnectRequest_PodLogsResponse)(nil),
+		(*ConnectRequest_EnvoyAdminResponse)(nil),
+		(*ConnectRequest_PairInstanceMetricsResponse)(nil),
+		(*ConnectRequest_MeshMetricsTimeSeriesResponse)(nil),
+		(*ConnectRequest_TracesResponse)(nil),
+		(*ConnectRequest_AccessLogsResponse)(nil),
+		(*ConnectRequest_CapabilitiesUpdate)(nil),
 	}
 	file_backend_v1alpha1_manager_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*ConnectResponse_ConnectionAck)(nil),
 		(*ConnectResponse_Error)(nil),
 		(*ConnectResponse_ProxyConfigRequest)(nil),
 		(*ConnectResponse_ServiceConnectionsRequest)(nil),
+		(*ConnectResponse_ResyncRequest)(nil),
+		(*ConnectResponse_PodLogsRequest)(nil),
+		(*ConnectResponse_EnvoyAdminRequest)(nil),
+		(*ConnectResponse_PairInstanceMetricsRequest)(nil),
+		(*ConnectResponse_MeshMetricsTimeSeriesRequest)(nil),
+		(*ConnectResponse_TracesRequest)(nil),
+		(*ConnectResponse_AccessLogsRequest)(nil),
 	}
-	file_backend_v1alpha1_manager_service_proto_msgTypes[7].OneofWrappers = []any{
+	file_backend_v1alpha1_manager_service_proto_msgTypes[14].OneofWrappers = []any{
 		(*ProxyConfigResponse_ProxyConfig)(nil),
 		(*ProxyConfigResponse_ErrorMessage)(nil),
 	}
-	file_backend_v1alpha1_manager_service_proto_msgTypes[9].OneofWrappers = []any{
+	file_backend_v1alpha1_manager_service_proto_msgTypes[16].OneofWrappers = []any{
+		(*PodLogsResponse_Logs)(nil),
+		(*PodLogsResponse_ErrorMessage)(nil),
+	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[18].OneofWrappers = []any{
+		(*EnvoyAdminResponse_Output)(nil),
+		(*EnvoyAdminResponse_ErrorMessage)(nil),
+	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[20].OneofWrappers = []any{
 		(*ServiceConnectionsResponse_ServiceConnections)(nil),
 		(*ServiceConnectionsResponse_ErrorMessage)(nil),
 	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[22].OneofWrappers = []any{
+		(*PairInstanceMetricsResponse_PairInstanceMetrics)(nil),
+		(*PairInstanceMetricsResponse_ErrorMessage)(nil),
+	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[24].OneofWrappers = []any{
+		(*MeshMetricsTimeSeriesResponse_TimeSeries)(nil),
+		(*MeshMetricsTimeSeriesResponse_ErrorMessage)(nil),
+	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[26].OneofWrappers = []any{
+		(*TracesResponse_Traces)(nil),
+		(*TracesResponse_ErrorMessage)(nil),
+	}
+	file_backend_v1alpha1_manager_service_proto_msgTypes[28].OneofWrappers = []any{
+		(*AccessLogsResponse_AccessLogs)(nil),
+		(*AccessLogsResponse_ErrorMessage)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backend_v1alpha1_manager_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_backend_v1alpha1_manager_service_proto_goTypes,
 		DependencyIndexes: file_backend_v1alpha1_manager_service_proto_depIdxs,
+		EnumInfos:         file_backend_v1alpha1_manager_service_proto_enumTypes,
 		MessageInfos:      file_backend_v1alpha1_manager_service_proto_msgTypes,
 	}.Build()
 	File_backend_v1alpha1_manager_service_proto = out.File
@@ -0,0 +1,579 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: frontend/v1alpha1/analysis_service.proto
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnalyzeClustersRequest specifies which clusters to analyze.
+type AnalyzeClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id limits the analysis to a single cluster. All connected clusters are analyzed if omitted.
+	ClusterId *string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// cluster_selector limits the analysis to the clusters whose labels match it, using Kubernetes label
+	// selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,2,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *AnalyzeClustersRequest) Reset() {
+	*x = AnalyzeClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeClustersRequest) ProtoMessage() {}
+
+func (x *AnalyzeClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeClustersRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeClustersRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyzeClustersRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
+	}
+	return ""
+}
+
+func (x *AnalyzeClustersRequest) GetClusterSelector() string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return ""
+}
+
+// AnalyzeClustersResponse contains the findings for each analyzed cluster.
+type AnalyzeClustersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// clusters contains the analysis of each cluster, ordered by cluster ID.
+	Clusters []*ClusterAnalysis `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *AnalyzeClustersResponse) Reset() {
+	*x = AnalyzeClustersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeClustersResponse) ProtoMessage() {}
+
+func (x *AnalyzeClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeClustersResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeClustersResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnalyzeClustersResponse) GetClusters() []*ClusterAnalysis {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// ClusterAnalysis contains the findings for a single cluster.
+type ClusterAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the analyzed cluster.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// findings are the problems found in the cluster's configuration.
+	Findings []*v1alpha1.AnalysisFinding `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	// warnings describe services whose traffic could not be retrieved in time and was not checked.
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *ClusterAnalysis) Reset() {
+	*x = ClusterAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterAnalysis) ProtoMessage() {}
+
+func (x *ClusterAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterAnalysis.ProtoReflect.Descriptor instead.
+func (*ClusterAnalysis) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClusterAnalysis) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ClusterAnalysis) GetFindings() []*v1alpha1.AnalysisFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ClusterAnalysis) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// DryRunAuthorizationPolicyRequest specifies a drafted AuthorizationPolicy and the cluster to evaluate it in.
+type DryRunAuthorizationPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster the policy would be applied to.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// policy is the drafted AuthorizationPolicy manifest in YAML or JSON. It replaces any existing
+	// AuthorizationPolicy with the same name and namespace.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *DryRunAuthorizationPolicyRequest) Reset() {
+	*x = DryRunAuthorizationPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunAuthorizationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunAuthorizationPolicyRequest) ProtoMessage() {}
+
+func (x *DryRunAuthorizationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunAuthorizationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DryRunAuthorizationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DryRunAuthorizationPolicyRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DryRunAuthorizationPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// DryRunAuthorizationPolicyResponse contains the observed traffic flows the drafted policy would deny.
+type DryRunAuthorizationPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// policy is the drafted AuthorizationPolicy.
+	Policy *v1alpha1.ResourceRef `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// workloads are the pods the drafted policy applies to.
+	Workloads []*v1alpha1.ResourceRef `protobuf:"bytes,2,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	// flows_evaluated is the number of observed flows to those workloads' services.
+	FlowsEvaluated int32 `protobuf:"varint,3,opt,name=flows_evaluated,json=flowsEvaluated,proto3" json:"flows_evaluated,omitempty"`
+	// denied_flows are the flows that are allowed today but would be denied, ordered by source and destination.
+	DeniedFlows []*v1alpha1.DeniedFlow `protobuf:"bytes,4,rep,name=denied_flows,json=deniedFlows,proto3" json:"denied_flows,omitempty"`
+	// warnings describe services whose traffic could not be retrieved and was not evaluated.
+	Warnings []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *DryRunAuthorizationPolicyResponse) Reset() {
+	*x = DryRunAuthorizationPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DryRunAuthorizationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunAuthorizationPolicyResponse) ProtoMessage() {}
+
+func (x *DryRunAuthorizationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_analysis_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunAuthorizationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DryRunAuthorizationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DryRunAuthorizationPolicyResponse) GetPolicy() *v1alpha1.ResourceRef {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *DryRunAuthorizationPolicyResponse) GetWorkloads() []*v1alpha1.ResourceRef {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *DryRunAuthorizationPolicyResponse) GetFlowsEvaluated() int32 {
+	if x != nil {
+		return x.FlowsEvaluated
+	}
+	return 0
+}
+
+func (x *DryRunAuthorizationPolicyResponse) GetDeniedFlows() []*v1alpha1.DeniedFlow {
+	if x != nil {
+		return x.DeniedFlows
+	}
+	return nil
+}
+
+func (x *DryRunAuthorizationPolicyResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+var File_frontend_v1alpha1_analysis_service_proto protoreflect.FileDescriptor
+
+var file_frontend_v1alpha1_analysis_service_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x76, 0x0a, 0x16, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x17, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x93, 0x01,
+	0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x45, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x20, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xb5,
+	0x02, 0x0a, 0x21, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x43, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x0b, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x32, 0x9a, 0x03, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x33,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0xe7, 0x01, 0x0a, 0x19, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a, 0x01,
+	0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2d, 0x64, 0x72, 0x79, 0x2d,
+	0x72, 0x75, 0x6e, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_frontend_v1alpha1_analysis_service_proto_rawDescOnce sync.Once
+	file_frontend_v1alpha1_analysis_service_proto_rawDescData = file_frontend_v1alpha1_analysis_service_proto_rawDesc
+)
+
+func file_frontend_v1alpha1_analysis_service_proto_rawDescGZIP() []byte {
+	file_frontend_v1alpha1_analysis_service_proto_rawDescOnce.Do(func() {
+		file_frontend_v1alpha1_analysis_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_frontend_v1alpha1_analysis_service_proto_rawDescData)
+	})
+	return file_frontend_v1alpha1_analysis_service_proto_rawDescData
+}
+
+var file_frontend_v1alpha1_analysis_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_frontend_v1alpha1_analysis_service_proto_goTypes = []any{
+	(*AnalyzeClustersRequest)(nil),            // 0: navigator.frontend.v1alpha1.AnalyzeClustersRequest
+	(*AnalyzeClustersResponse)(nil),           // 1: navigator.frontend.v1alpha1.AnalyzeClustersResponse
+	(*ClusterAnalysis)(nil),                   // 2: navigator.frontend.v1alpha1.ClusterAnalysis
+	(*DryRunAuthorizationPolicyRequest)(nil),  // 3: navigator.frontend.v1alpha1.DryRunAuthorizationPolicyRequest
+	(*DryRunAuthorizationPolicyResponse)(nil), // 4: navigator.frontend.v1alpha1.DryRunAuthorizationPolicyResponse
+	(*v1alpha1.AnalysisFinding)(nil),          // 5: navigator.types.v1alpha1.AnalysisFinding
+	(*v1alpha1.ResourceRef)(nil),              // 6: navigator.types.v1alpha1.ResourceRef
+	(*v1alpha1.DeniedFlow)(nil),               // 7: navigator.types.v1alpha1.DeniedFlow
+}
+var file_frontend_v1alpha1_analysis_service_proto_depIdxs = []int32{
+	2, // 0: navigator.frontend.v1alpha1.AnalyzeClustersResponse.clusters:type_name -> navigator.frontend.v1alpha1.ClusterAnalysis
+	5, // 1: navigator.frontend.v1alpha1.ClusterAnalysis.findings:type_name -> navigator.types.v1alpha1.AnalysisFinding
+	6, // 2: navigator.frontend.v1alpha1.DryRunAuthorizationPolicyResponse.policy:type_name -> navigator.types.v1alpha1.ResourceRef
+	6, // 3: navigator.frontend.v1alpha1.DryRunAuthorizationPolicyResponse.workloads:type_name -> navigator.types.v1alpha1.ResourceRef
+	7, // 4: navigator.frontend.v1alpha1.DryRunAuthorizationPolicyResponse.denied_flows:type_name -> navigator.types.v1alpha1.DeniedFlow
+	0, // 5: navigator.frontend.v1alpha1.AnalysisService.AnalyzeClusters:input_type -> navigator.frontend.v1alpha1.AnalyzeClustersRequest
+	3, // 6: navigator.frontend.v1alpha1.AnalysisService.DryRunAuthorizationPolicy:input_type -> navigator.frontend.v1alpha1.DryRunAuthorizationPolicyRequest
+	1, // 7: navigator.frontend.v1alpha1.AnalysisService.AnalyzeClusters:output_type -> navigator.frontend.v1alpha1.AnalyzeClustersResponse
+	4, // 8: navigator.frontend.v1alpha1.AnalysisService.DryRunAuthorizationPolicy:output_type -> navigator.frontend.v1alpha1.DryRunAuthorizationPolicyResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_frontend_v1alpha1_analysis_service_proto_init() }
+func file_frontend_v1alpha1_analysis_service_proto_init() {
+	if File_frontend_v1alpha1_analysis_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_frontend_v1alpha1_analysis_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_analysis_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_analysis_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_analysis_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DryRunAuthorizationPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_analysis_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DryRunAuthorizationPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_frontend_v1alpha1_analysis_service_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_frontend_v1alpha1_analysis_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_frontend_v1alpha1_analysis_service_proto_goTypes,
+		DependencyIndexes: file_frontend_v1alpha1_analysis_service_proto_depIdxs,
+		MessageInfos:      file_frontend_v1alpha1_analysis_service_proto_msgTypes,
+	}.Build()
+	File_frontend_v1alpha1_analysis_service_proto = out.File
+	file_frontend_v1alpha1_analysis_service_proto_rawDesc = nil
+	file_frontend_v1alpha1_analysis_service_proto_goTypes = nil
+	file_frontend_v1alpha1_analysis_service_proto_depIdxs = nil
+}
@@ -33,7 +33,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ClusterRegistryService_ListClusters_FullMethodName = "/navigator.frontend.v1alpha1.ClusterRegistryService/ListClusters"
+	ClusterRegistryService_ListClusters_FullMethodName    = "/navigator.frontend.v1alpha1.ClusterRegistryService/ListClusters"
+	ClusterRegistryService_GetSyncStatus_FullMethodName   = "/navigator.frontend.v1alpha1.ClusterRegistryService/GetSyncStatus"
+	ClusterRegistryService_ResyncCluster_FullMethodName   = "/navigator.frontend.v1alpha1.ClusterRegistryService/ResyncCluster"
+	ClusterRegistryService_ListChanges_FullMethodName     = "/navigator.frontend.v1alpha1.ClusterRegistryService/ListChanges"
+	ClusterRegistryService_ListEvents_FullMethodName      = "/navigator.frontend.v1alpha1.ClusterRegistryService/ListEvents"
+	ClusterRegistryService_GetCapabilities_FullMethodName = "/navigator.frontend.v1alpha1.ClusterRegistryService/GetCapabilities"
 )
 
 // ClusterRegistryServiceClient is the client API for ClusterRegistryService service.
@@ -42,6 +47,20 @@ const (
 type ClusterRegistryServiceClient interface {
 	// ListClusters returns sync state information for all connected clusters.
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	// GetSyncStatus returns sync state information for a single connected cluster.
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
+	// ResyncCluster asks the edge managing a cluster to sync its state immediately
+	// rather than waiting for the next sync interval.
+	ResyncCluster(ctx context.Context, in *ResyncClusterRequest, opts ...grpc.CallOption) (*ResyncClusterResponse, error)
+	// ListChanges returns the recent configuration and topology changes observed in the connected clusters,
+	// newest first, so the first question of an incident ("what changed?") can be answered.
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	// ListEvents returns the manager's event log, newest first: syncs, edge connects and disconnects, resync
+	// requests, changes and evictions of the connected clusters.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// GetCapabilities reports the features available to the caller across its connected clusters, so clients
+	// can hide the views of features that are not configured rather than showing their errors.
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
 }
 
 type clusterRegistryServiceClient struct {
@@ -61,12 +80,71 @@ func (c *clusterRegistryServiceClient) ListClusters(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *clusterRegistryServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, ClusterRegistryService_GetSyncStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterRegistryServiceClient) ResyncCluster(ctx context.Context, in *ResyncClusterRequest, opts ...grpc.CallOption) (*ResyncClusterResponse, error) {
+	out := new(ResyncClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterRegistryService_ResyncCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterRegistryServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, ClusterRegistryService_ListChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterRegistryServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, ClusterRegistryService_ListEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterRegistryServiceClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, ClusterRegistryService_GetCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterRegistryServiceServer is the server API for ClusterRegistryService service.
 // All implementations must embed UnimplementedClusterRegistryServiceServer
 // for forward compatibility
 type ClusterRegistryServiceServer interface {
 	// ListClusters returns sync state information for all connected clusters.
 	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	// GetSyncStatus returns sync state information for a single connected cluster.
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
+	// ResyncCluster asks the edge managing a cluster to sync its state immediately
+	// rather than waiting for the next sync interval.
+	ResyncCluster(context.Context, *ResyncClusterRequest) (*ResyncClusterResponse, error)
+	// ListChanges returns the recent configuration and topology changes observed in the connected clusters,
+	// newest first, so the first question of an incident ("what changed?") can be answered.
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	// ListEvents returns the manager's event log, newest first: syncs, edge connects and disconnects, resync
+	// requests, changes and evictions of the connected clusters.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// GetCapabilities reports the features available to the caller across its connected clusters, so clients
+	// can hide the views of features that are not configured rather than showing their errors.
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
 	mustEmbedUnimplementedClusterRegistryServiceServer()
 }
 
@@ -77,6 +155,21 @@ type UnimplementedClusterRegistryServiceServer struct {
 func (UnimplementedClusterRegistryServiceServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
 }
+func (UnimplementedClusterRegistryServiceServer) GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
+func (UnimplementedClusterRegistryServiceServer) ResyncCluster(context.Context, *ResyncClusterRequest) (*ResyncClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncCluster not implemented")
+}
+func (UnimplementedClusterRegistryServiceServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedClusterRegistryServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedClusterRegistryServiceServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedClusterRegistryServiceServer) mustEmbedUnimplementedClusterRegistryServiceServer() {
 }
 
@@ -109,6 +202,96 @@ func _ClusterRegistryService_ListClusters_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterRegistryService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterRegistryServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterRegistryService_GetSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterRegistryServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterRegistryService_ResyncCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterRegistryServiceServer).ResyncCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterRegistryService_ResyncCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterRegistryServiceServer).ResyncCluster(ctx, req.(*ResyncClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterRegistryService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterRegistryServiceServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterRegistryService_ListChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterRegistryServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterRegistryService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterRegistryServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterRegistryService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterRegistryServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterRegistryService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterRegistryServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterRegistryService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterRegistryServiceServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterRegistryService_ServiceDesc is the grpc.ServiceDesc for ClusterRegistryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -120,6 +303,26 @@ var ClusterRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListClusters",
 			Handler:    _ClusterRegistryService_ListClusters_Handler,
 		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _ClusterRegistryService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "ResyncCluster",
+			Handler:    _ClusterRegistryService_ResyncCluster_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _ClusterRegistryService_ListChanges_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _ClusterRegistryService_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _ClusterRegistryService_GetCapabilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "frontend/v1alpha1/cluster_registry.proto",
@@ -33,7 +33,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MetricsService_GetServiceConnections_FullMethodName = "/navigator.frontend.v1alpha1.MetricsService/GetServiceConnections"
+	MetricsService_GetServiceConnections_FullMethodName    = "/navigator.frontend.v1alpha1.MetricsService/GetServiceConnections"
+	MetricsService_ExplainPath_FullMethodName              = "/navigator.frontend.v1alpha1.MetricsService/ExplainPath"
+	MetricsService_CompareService_FullMethodName           = "/navigator.frontend.v1alpha1.MetricsService/CompareService"
+	MetricsService_GetPairInstanceMetrics_FullMethodName   = "/navigator.frontend.v1alpha1.MetricsService/GetPairInstanceMetrics"
+	MetricsService_GetMeshMetricsTimeSeries_FullMethodName = "/navigator.frontend.v1alpha1.MetricsService/GetMeshMetricsTimeSeries"
 )
 
 // MetricsServiceClient is the client API for MetricsService service.
@@ -42,6 +46,18 @@ const (
 type MetricsServiceClient interface {
 	// GetServiceConnections returns inbound and outbound connections for a specific service.
 	GetServiceConnections(ctx context.Context, in *GetServiceConnectionsRequest, opts ...grpc.CallOption) (*GetServiceConnectionsResponse, error)
+	// ExplainPath explains the traffic path from a source service to a destination service for guided debugging.
+	// It returns the Istio resources each side's proxies apply to the path together with current metrics for the pair.
+	ExplainPath(ctx context.Context, in *ExplainPathRequest, opts ...grpc.CallOption) (*ExplainPathResponse, error)
+	// CompareService compares the same logical service in two clusters side by side: its instance counts and versions,
+	// the Istio resources applying to it and its current inbound metrics. It is meant as a failover sanity check.
+	CompareService(ctx context.Context, in *CompareServiceRequest, opts ...grpc.CallOption) (*CompareServiceResponse, error)
+	// GetPairInstanceMetrics breaks the current metrics from a source service to a destination service down by the
+	// workload and pod on each side, so that hot or failing replicas can be told apart from the service average.
+	GetPairInstanceMetrics(ctx context.Context, in *GetPairInstanceMetricsRequest, opts ...grpc.CallOption) (*GetPairInstanceMetricsResponse, error)
+	// GetMeshMetricsTimeSeries returns the request rate, error rate and P99 latency of selected service pairs over
+	// consecutive intervals of a time window, aggregated across clusters, so that trends can be shown with one request.
+	GetMeshMetricsTimeSeries(ctx context.Context, in *GetMeshMetricsTimeSeriesRequest, opts ...grpc.CallOption) (*GetMeshMetricsTimeSeriesResponse, error)
 }
 
 type metricsServiceClient struct {
@@ -61,12 +77,60 @@ func (c *metricsServiceClient) GetServiceConnections(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *metricsServiceClient) ExplainPath(ctx context.Context, in *ExplainPathRequest, opts ...grpc.CallOption) (*ExplainPathResponse, error) {
+	out := new(ExplainPathResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ExplainPath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) CompareService(ctx context.Context, in *CompareServiceRequest, opts ...grpc.CallOption) (*CompareServiceResponse, error) {
+	out := new(CompareServiceResponse)
+	err := c.cc.Invoke(ctx, MetricsService_CompareService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) GetPairInstanceMetrics(ctx context.Context, in *GetPairInstanceMetricsRequest, opts ...grpc.CallOption) (*GetPairInstanceMetricsResponse, error) {
+	out := new(GetPairInstanceMetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_GetPairInstanceMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) GetMeshMetricsTimeSeries(ctx context.Context, in *GetMeshMetricsTimeSeriesRequest, opts ...grpc.CallOption) (*GetMeshMetricsTimeSeriesResponse, error) {
+	out := new(GetMeshMetricsTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, MetricsService_GetMeshMetricsTimeSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetricsServiceServer is the server API for MetricsService service.
 // All implementations must embed UnimplementedMetricsServiceServer
 // for forward compatibility
 type MetricsServiceServer interface {
 	// GetServiceConnections returns inbound and outbound connections for a specific service.
 	GetServiceConnections(context.Context, *GetServiceConnectionsRequest) (*GetServiceConnectionsResponse, error)
+	// ExplainPath explains the traffic path from a source service to a destination service for guided debugging.
+	// It returns the Istio resources each side's proxies apply to the path together with current metrics for the pair.
+	ExplainPath(context.Context, *ExplainPathRequest) (*ExplainPathResponse, error)
+	// CompareService compares the same logical service in two clusters side by side: its instance counts and versions,
+	// the Istio resources applying to it and its current inbound metrics. It is meant as a failover sanity check.
+	CompareService(context.Context, *CompareServiceRequest) (*CompareServiceResponse, error)
+	// GetPairInstanceMetrics breaks the current metrics from a source service to a destination service down by the
+	// workload and pod on each side, so that hot or failing replicas can be told apart from the service average.
+	GetPairInstanceMetrics(context.Context, *GetPairInstanceMetricsRequest) (*GetPairInstanceMetricsResponse, error)
+	// GetMeshMetricsTimeSeries returns the request rate, error rate and P99 latency of selected service pairs over
+	// consecutive intervals of a time window, aggregated across clusters, so that trends can be shown with one request.
+	GetMeshMetricsTimeSeries(context.Context, *GetMeshMetricsTimeSeriesRequest) (*GetMeshMetricsTimeSeriesResponse, error)
 	mustEmbedUnimplementedMetricsServiceServer()
 }
 
@@ -77,6 +141,18 @@ type UnimplementedMetricsServiceServer struct {
 func (UnimplementedMetricsServiceServer) GetServiceConnections(context.Context, *GetServiceConnectionsRequest) (*GetServiceConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceConnections not implemented")
 }
+func (UnimplementedMetricsServiceServer) ExplainPath(context.Context, *ExplainPathRequest) (*ExplainPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPath not implemented")
+}
+func (UnimplementedMetricsServiceServer) CompareService(context.Context, *CompareServiceRequest) (*CompareServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareService not implemented")
+}
+func (UnimplementedMetricsServiceServer) GetPairInstanceMetrics(context.Context, *GetPairInstanceMetricsRequest) (*GetPairInstanceMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPairInstanceMetrics not implemented")
+}
+func (UnimplementedMetricsServiceServer) GetMeshMetricsTimeSeries(context.Context, *GetMeshMetricsTimeSeriesRequest) (*GetMeshMetricsTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeshMetricsTimeSeries not implemented")
+}
 func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
 
 // UnsafeMetricsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -108,6 +184,78 @@ func _MetricsService_GetServiceConnections_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricsService_ExplainPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ExplainPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ExplainPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ExplainPath(ctx, req.(*ExplainPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_CompareService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).CompareService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_CompareService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).CompareService(ctx, req.(*CompareServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_GetPairInstanceMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPairInstanceMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).GetPairInstanceMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_GetPairInstanceMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).GetPairInstanceMetrics(ctx, req.(*GetPairInstanceMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_GetMeshMetricsTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeshMetricsTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).GetMeshMetricsTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_GetMeshMetricsTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).GetMeshMetricsTimeSeries(ctx, req.(*GetMeshMetricsTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,6 +267,22 @@ var MetricsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServiceConnections",
 			Handler:    _MetricsService_GetServiceConnections_Handler,
 		},
+		{
+			MethodName: "ExplainPath",
+			Handler:    _MetricsService_ExplainPath_Handler,
+		},
+		{
+			MethodName: "CompareService",
+			Handler:    _MetricsService_CompareService_Handler,
+		},
+		{
+			MethodName: "GetPairInstanceMetrics",
+			Handler:    _MetricsService_GetPairInstanceMetrics_Handler,
+		},
+		{
+			MethodName: "GetMeshMetricsTimeSeries",
+			Handler:    _MetricsService_GetMeshMetricsTimeSeries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "frontend/v1alpha1/metrics_service.proto",
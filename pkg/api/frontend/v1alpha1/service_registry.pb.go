@@ -23,6 +23,7 @@ package v1alpha1
 import (
 	v1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -36,6 +37,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InstanceHealth is the health of a service instance.
+type InstanceHealth int32
+
+const (
+	InstanceHealth_INSTANCE_HEALTH_UNSPECIFIED InstanceHealth = 0
+	InstanceHealth_INSTANCE_HEALTH_HEALTHY     InstanceHealth = 1 // The pod is running and all of its containers are ready
+	InstanceHealth_INSTANCE_HEALTH_UNHEALTHY   InstanceHealth = 2 // The pod is not running or any of its containers is not ready
+)
+
+// Enum value maps for InstanceHealth.
+var (
+	InstanceHealth_name = map[int32]string{
+		0: "INSTANCE_HEALTH_UNSPECIFIED",
+		1: "INSTANCE_HEALTH_HEALTHY",
+		2: "INSTANCE_HEALTH_UNHEALTHY",
+	}
+	InstanceHealth_value = map[string]int32{
+		"INSTANCE_HEALTH_UNSPECIFIED": 0,
+		"INSTANCE_HEALTH_HEALTHY":     1,
+		"INSTANCE_HEALTH_UNHEALTHY":   2,
+	}
+)
+
+func (x InstanceHealth) Enum() *InstanceHealth {
+	p := new(InstanceHealth)
+	*p = x
+	return p
+}
+
+func (x InstanceHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontend_v1alpha1_service_registry_proto_enumTypes[0].Descriptor()
+}
+
+func (InstanceHealth) Type() protoreflect.EnumType {
+	return &file_frontend_v1alpha1_service_registry_proto_enumTypes[0]
+}
+
+func (x InstanceHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceHealth.Descriptor instead.
+func (InstanceHealth) EnumDescriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{0}
+}
+
+// RawConfigFormat is the format of a resource's raw_config.
+type RawConfigFormat int32
+
+const (
+	RawConfigFormat_RAW_CONFIG_FORMAT_UNSPECIFIED RawConfigFormat = 0
+	RawConfigFormat_RAW_CONFIG_FORMAT_JSON        RawConfigFormat = 1 // The JSON collected from the cluster, unchanged
+	RawConfigFormat_RAW_CONFIG_FORMAT_YAML        RawConfigFormat = 2 // YAML with status and server-populated metadata (managedFields, resourceVersion, ...) stripped
+)
+
+// Enum value maps for RawConfigFormat.
+var (
+	RawConfigFormat_name = map[int32]string{
+		0: "RAW_CONFIG_FORMAT_UNSPECIFIED",
+		1: "RAW_CONFIG_FORMAT_JSON",
+		2: "RAW_CONFIG_FORMAT_YAML",
+	}
+	RawConfigFormat_value = map[string]int32{
+		"RAW_CONFIG_FORMAT_UNSPECIFIED": 0,
+		"RAW_CONFIG_FORMAT_JSON":        1,
+		"RAW_CONFIG_FORMAT_YAML":        2,
+	}
+)
+
+func (x RawConfigFormat) Enum() *RawConfigFormat {
+	p := new(RawConfigFormat)
+	*p = x
+	return p
+}
+
+func (x RawConfigFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RawConfigFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontend_v1alpha1_service_registry_proto_enumTypes[1].Descriptor()
+}
+
+func (RawConfigFormat) Type() protoreflect.EnumType {
+	return &file_frontend_v1alpha1_service_registry_proto_enumTypes[1]
+}
+
+func (x RawConfigFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RawConfigFormat.Descriptor instead.
+func (RawConfigFormat) EnumDescriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{1}
+}
+
 // ListServicesRequest specifies which namespace to list services from.
 type ListServicesRequest struct {
 	state         protoimpl.MessageState
@@ -48,6 +149,9 @@ type ListServicesRequest struct {
 	// cluster_id filters services to only those from the specified cluster.
 	// If not specified, services from all connected clusters are returned.
 	ClusterId *string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// cluster_selector filters services to only those from clusters whose labels match it, using Kubernetes
+	// label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,3,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
 }
 
 func (x *ListServicesRequest) Reset() {
@@ -96,6 +200,13 @@ func (x *ListServicesRequest) GetClusterId() string {
 	return ""
 }
 
+func (x *ListServicesRequest) GetClusterSelector() string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return ""
+}
+
 // ListServicesResponse contains the list of services in the requested namespace(s).
 type ListServicesResponse struct {
 	state         protoimpl.MessageState
@@ -104,6 +215,8 @@ type ListServicesResponse struct {
 
 	// services is the list of services found in the namespace(s).
 	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,2,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
 }
 
 func (x *ListServicesResponse) Reset() {
@@ -145,6 +258,141 @@ func (x *ListServicesResponse) GetServices() []*Service {
 	return nil
 }
 
+func (x *ListServicesResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// ListCanonicalServicesRequest specifies which canonical services to list.
+type ListCanonicalServicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace is the Kubernetes namespace to list canonical services from.
+	// If not specified, canonical services from all namespaces are returned.
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// cluster_id filters canonical services to only those from the specified cluster.
+	// If not specified, canonical services from all connected clusters are returned.
+	ClusterId *string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// cluster_selector filters canonical services to only those from clusters whose labels match it, using
+	// Kubernetes label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,3,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *ListCanonicalServicesRequest) Reset() {
+	*x = ListCanonicalServicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCanonicalServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanonicalServicesRequest) ProtoMessage() {}
+
+func (x *ListCanonicalServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanonicalServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanonicalServicesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCanonicalServicesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *ListCanonicalServicesRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListCanonicalServicesRequest) GetClusterSelector() string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return ""
+}
+
+// ListCanonicalServicesResponse contains the canonical services in the requested namespace(s).
+type ListCanonicalServicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// canonical_services are the canonical services found, sorted by namespace and name.
+	CanonicalServices []*CanonicalService `protobuf:"bytes,1,rep,name=canonical_services,json=canonicalServices,proto3" json:"canonical_services,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,2,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
+}
+
+func (x *ListCanonicalServicesResponse) Reset() {
+	*x = ListCanonicalServicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCanonicalServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanonicalServicesResponse) ProtoMessage() {}
+
+func (x *ListCanonicalServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanonicalServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanonicalServicesResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCanonicalServicesResponse) GetCanonicalServices() []*CanonicalService {
+	if x != nil {
+		return x.CanonicalServices
+	}
+	return nil
+}
+
+func (x *ListCanonicalServicesResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
 // GetServiceRequest specifies which service to retrieve.
 type GetServiceRequest struct {
 	state         protoimpl.MessageState
@@ -159,7 +407,7 @@ type GetServiceRequest struct {
 func (x *GetServiceRequest) Reset() {
 	*x = GetServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[2]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -172,7 +420,7 @@ func (x *GetServiceRequest) String() string {
 func (*GetServiceRequest) ProtoMessage() {}
 
 func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[2]
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +433,7 @@ func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceRequest) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{2}
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetServiceRequest) GetId() string {
@@ -203,12 +451,14 @@ type GetServiceResponse struct {
 
 	// service contains the detailed service information.
 	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,2,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
 }
 
 func (x *GetServiceResponse) Reset() {
 	*x = GetServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[3]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -221,7 +471,7 @@ func (x *GetServiceResponse) String() string {
 func (*GetServiceResponse) ProtoMessage() {}
 
 func (x *GetServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[3]
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +484,7 @@ func (x *GetServiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceResponse) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{3}
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetServiceResponse) GetService() *Service {
@@ -244,6 +494,13 @@ func (x *GetServiceResponse) GetService() *Service {
 	return nil
 }
 
+func (x *GetServiceResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
 // GetServiceInstanceRequest specifies which service instance to retrieve.
 type GetServiceInstanceRequest struct {
 	state         protoimpl.MessageState
@@ -261,7 +518,7 @@ type GetServiceInstanceRequest struct {
 func (x *GetServiceInstanceRequest) Reset() {
 	*x = GetServiceInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[4]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -274,7 +531,7 @@ func (x *GetServiceInstanceRequest) String() string {
 func (*GetServiceInstanceRequest) ProtoMessage() {}
 
 func (x *GetServiceInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[4]
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +544,7 @@ func (x *GetServiceInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceInstanceRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{4}
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetServiceInstanceRequest) GetServiceId() string {
@@ -312,12 +569,14 @@ type GetServiceInstanceResponse struct {
 
 	// instance contains the detailed service instance information.
 	Instance *ServiceInstanceDetail `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// sync_metadata describes the most recent state sync from the instance's cluster.
+	SyncMetadata *v1alpha1.ClusterSyncMetadata `protobuf:"bytes,2,opt,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
 }
 
 func (x *GetServiceInstanceResponse) Reset() {
 	*x = GetServiceInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[5]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +589,7 @@ func (x *GetServiceInstanceResponse) String() string {
 func (*GetServiceInstanceResponse) ProtoMessage() {}
 
 func (x *GetServiceInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[5]
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +602,7 @@ func (x *GetServiceInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceInstanceResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{5}
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetServiceInstanceResponse) GetInstance() *ServiceInstanceDetail {
@@ -353,47 +612,62 @@ func (x *GetServiceInstanceResponse) GetInstance() *ServiceInstanceDetail {
 	return nil
 }
 
-// Service represents a Kubernetes service with its backing instances.
-// Services in different clusters that share the same name and namespace are considered the same service.
-type Service struct {
+func (x *GetServiceInstanceResponse) GetSyncMetadata() *v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// ListServiceInstancesRequest specifies which service instances to list.
+type ListServiceInstancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is a unique identifier for the service in format namespace:service-name (e.g., "default:nginx-service").
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the service name.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// namespace is the Kubernetes namespace containing the service.
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// instances are the backend instances (pods) that serve this service across all clusters.
-	Instances []*ServiceInstance `protobuf:"bytes,4,rep,name=instances,proto3" json:"instances,omitempty"`
-	// cluster_ips maps cluster names to their cluster IP addresses for this service.
-	ClusterIps map[string]string `protobuf:"bytes,5,rep,name=cluster_ips,json=clusterIps,proto3" json:"cluster_ips,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// external_ips maps cluster names to their external IP addresses for this service.
-	ExternalIps map[string]string `protobuf:"bytes,6,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// proxy_mode indicates the Istio proxy mode for this service (determined from instances).
-	// Services with instances that have ProxyMode_ROUTER are gateway services.
-	ProxyMode v1alpha1.ProxyMode `protobuf:"varint,7,opt,name=proxy_mode,json=proxyMode,proto3,enum=navigator.types.v1alpha1.ProxyMode" json:"proxy_mode,omitempty"`
-}
-
-func (x *Service) Reset() {
-	*x = Service{}
+	// cluster_id filters instances to only those in the specified cluster.
+	// If not specified, instances from all connected clusters are returned.
+	ClusterId *string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// namespace filters instances to only those in the specified namespace.
+	// If not specified, instances from all namespaces are returned.
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// node_name filters instances to only those scheduled on the specified node.
+	NodeName *string `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3,oneof" json:"node_name,omitempty"`
+	// envoy_present filters instances by whether they have an Envoy proxy sidecar.
+	// If not specified, instances are returned regardless of their sidecar.
+	EnvoyPresent *bool `protobuf:"varint,4,opt,name=envoy_present,json=envoyPresent,proto3,oneof" json:"envoy_present,omitempty"`
+	// label_selector filters instances by their pod labels, using Kubernetes label selector syntax
+	// (e.g., "app=reviews,version in (v1,v2)").
+	LabelSelector string `protobuf:"bytes,5,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// health filters instances by their health. Defaults to returning all instances.
+	Health InstanceHealth `protobuf:"varint,6,opt,name=health,proto3,enum=navigator.frontend.v1alpha1.InstanceHealth" json:"health,omitempty"`
+	// page_size is the maximum number of instances to return. Defaults to 100; values above 500 are capped.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token from a previous response with the same filters, used to retrieve the
+	// following page. Tokens are opaque.
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// cluster_selector filters instances to only those from clusters whose labels match it, using Kubernetes
+	// label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,9,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *ListServiceInstancesRequest) Reset() {
+	*x = ListServiceInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[6]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Service) String() string {
+func (x *ListServiceInstancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*ListServiceInstancesRequest) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[6]
+func (x *ListServiceInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,98 +678,108 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListServiceInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Service) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListServiceInstancesRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
 	}
 	return ""
 }
 
-func (x *Service) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *ListServiceInstancesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
-func (x *Service) GetNamespace() string {
+func (x *ListServiceInstancesRequest) GetNodeName() string {
+	if x != nil && x.NodeName != nil {
+		return *x.NodeName
+	}
+	return ""
+}
+
+func (x *ListServiceInstancesRequest) GetEnvoyPresent() bool {
+	if x != nil && x.EnvoyPresent != nil {
+		return *x.EnvoyPresent
+	}
+	return false
+}
+
+func (x *ListServiceInstancesRequest) GetLabelSelector() string {
 	if x != nil {
-		return x.Namespace
+		return x.LabelSelector
 	}
 	return ""
 }
 
-func (x *Service) GetInstances() []*ServiceInstance {
+func (x *ListServiceInstancesRequest) GetHealth() InstanceHealth {
 	if x != nil {
-		return x.Instances
+		return x.Health
 	}
-	return nil
+	return InstanceHealth_INSTANCE_HEALTH_UNSPECIFIED
 }
 
-func (x *Service) GetClusterIps() map[string]string {
+func (x *ListServiceInstancesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ClusterIps
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Service) GetExternalIps() map[string]string {
+func (x *ListServiceInstancesRequest) GetPageToken() string {
 	if x != nil {
-		return x.ExternalIps
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Service) GetProxyMode() v1alpha1.ProxyMode {
+func (x *ListServiceInstancesRequest) GetClusterSelector() string {
 	if x != nil {
-		return x.ProxyMode
+		return x.ClusterSelector
 	}
-	return v1alpha1.ProxyMode(0)
+	return ""
 }
 
-// ServiceInstance represents a single backend instance serving a service.
-type ServiceInstance struct {
+// ListServiceInstancesResponse contains a page of service instances.
+type ListServiceInstancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// instance_id is a unique identifier for this instance across all clusters.
-	// Format: cluster_name:namespace:pod_name (e.g., "prod-west:default:nginx-abc123")
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// ip is the IP address of the instance.
-	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	// pod_name is the name of the Kubernetes pod backing this instance.
-	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
-	// namespace is the Kubernetes namespace containing the pod.
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// cluster_name is the name of the Kubernetes cluster this instance belongs to.
-	ClusterName string `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	// envoy_present indicates whether this instance has an Envoy proxy sidecar.
-	EnvoyPresent bool `protobuf:"varint,6,opt,name=envoy_present,json=envoyPresent,proto3" json:"envoy_present,omitempty"`
+	// instances is the page of matching instances, ordered by cluster, namespace, pod name and service.
+	// An instance backing several services is listed once for each of them.
+	Instances []*ServiceInstanceDetail `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	// next_page_token retrieves the next page of results. Empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_count is the total number of instances matching the filters across all pages.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,4,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
 }
 
-func (x *ServiceInstance) Reset() {
-	*x = ServiceInstance{}
+func (x *ListServiceInstancesResponse) Reset() {
+	*x = ListServiceInstancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[7]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceInstance) String() string {
+func (x *ListServiceInstancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceInstance) ProtoMessage() {}
+func (*ListServiceInstancesResponse) ProtoMessage() {}
 
-func (x *ServiceInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[7]
+func (x *ListServiceInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,88 +790,235 @@ func (x *ServiceInstance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceInstance.ProtoReflect.Descriptor instead.
-func (*ServiceInstance) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListServiceInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ServiceInstance) GetInstanceId() string {
+func (x *ListServiceInstancesResponse) GetInstances() []*ServiceInstanceDetail {
 	if x != nil {
-		return x.InstanceId
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *ListServiceInstancesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ServiceInstance) GetIp() string {
+func (x *ListServiceInstancesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Ip
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListServiceInstancesResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// Service represents a Kubernetes service with its backing instances.
+// Services in different clusters that share the same name and namespace are considered the same service.
+type Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is a unique identifier for the service in format namespace:service-name (e.g., "default:nginx-service").
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the service name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// namespace is the Kubernetes namespace containing the service.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// instances are the backend instances (pods) that serve this service across all clusters.
+	Instances []*ServiceInstance `protobuf:"bytes,4,rep,name=instances,proto3" json:"instances,omitempty"`
+	// cluster_ips maps cluster names to their cluster IP addresses for this service.
+	ClusterIps map[string]string `protobuf:"bytes,5,rep,name=cluster_ips,json=clusterIps,proto3" json:"cluster_ips,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// external_ips maps cluster names to their external IP addresses for this service.
+	ExternalIps map[string]string `protobuf:"bytes,6,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// proxy_mode indicates the Istio proxy mode for this service (determined from instances).
+	// Services with instances that have ProxyMode_ROUTER are gateway services.
+	ProxyMode v1alpha1.ProxyMode `protobuf:"varint,7,opt,name=proxy_mode,json=proxyMode,proto3,enum=navigator.types.v1alpha1.ProxyMode" json:"proxy_mode,omitempty"`
+	// exported_clusters are the clusters exporting this service to their cluster set with a Multi-Cluster
+	// Services API ServiceExport.
+	ExportedClusters []string `protobuf:"bytes,8,rep,name=exported_clusters,json=exportedClusters,proto3" json:"exported_clusters,omitempty"`
+	// imported_clusters are the clusters importing this service from their cluster set with a Multi-Cluster
+	// Services API ServiceImport.
+	ImportedClusters []string `protobuf:"bytes,9,rep,name=imported_clusters,json=importedClusters,proto3" json:"imported_clusters,omitempty"`
+	// zone_spread maps zones to the number of instances of this service running in them, across all clusters.
+	// Instances in an unknown zone are not counted.
+	ZoneSpread map[string]int32 `protobuf:"bytes,10,rep,name=zone_spread,json=zoneSpread,proto3" json:"zone_spread,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// external_names maps cluster names to the hostname the service resolves to in clusters where it is an
+	// ExternalName service. ExternalName services have no instances.
+	ExternalNames map[string]string `protobuf:"bytes,11,rep,name=external_names,json=externalNames,proto3" json:"external_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// canonical_services are the Istio canonical services of this service's instances, sorted by name. Metrics
+	// and traces of the service are reported under these names rather than the service name.
+	CanonicalServices []string `protobuf:"bytes,12,rep,name=canonical_services,json=canonicalServices,proto3" json:"canonical_services,omitempty"`
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Service) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ServiceInstance) GetPodName() string {
+func (x *Service) GetName() string {
 	if x != nil {
-		return x.PodName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ServiceInstance) GetNamespace() string {
+func (x *Service) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ServiceInstance) GetClusterName() string {
+func (x *Service) GetInstances() []*ServiceInstance {
 	if x != nil {
-		return x.ClusterName
+		return x.Instances
 	}
-	return ""
+	return nil
 }
 
-func (x *ServiceInstance) GetEnvoyPresent() bool {
+func (x *Service) GetClusterIps() map[string]string {
 	if x != nil {
-		return x.EnvoyPresent
+		return x.ClusterIps
 	}
-	return false
+	return nil
 }
 
-// Container represents a container running in a pod.
-type Container struct {
+func (x *Service) GetExternalIps() map[string]string {
+	if x != nil {
+		return x.ExternalIps
+	}
+	return nil
+}
+
+func (x *Service) GetProxyMode() v1alpha1.ProxyMode {
+	if x != nil {
+		return x.ProxyMode
+	}
+	return v1alpha1.ProxyMode(0)
+}
+
+func (x *Service) GetExportedClusters() []string {
+	if x != nil {
+		return x.ExportedClusters
+	}
+	return nil
+}
+
+func (x *Service) GetImportedClusters() []string {
+	if x != nil {
+		return x.ImportedClusters
+	}
+	return nil
+}
+
+func (x *Service) GetZoneSpread() map[string]int32 {
+	if x != nil {
+		return x.ZoneSpread
+	}
+	return nil
+}
+
+func (x *Service) GetExternalNames() map[string]string {
+	if x != nil {
+		return x.ExternalNames
+	}
+	return nil
+}
+
+func (x *Service) GetCanonicalServices() []string {
+	if x != nil {
+		return x.CanonicalServices
+	}
+	return nil
+}
+
+// CanonicalService groups the instances Istio's telemetry reports under one canonical service, together with
+// the Kubernetes services they back. A canonical service may span several services, and a service may back
+// several canonical services.
+type CanonicalService struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the container.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// image is the container image.
-	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	// status is the current status of the container (e.g., "Running", "Waiting", "Terminated").
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	// ready indicates whether the container is ready to serve requests.
-	Ready bool `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
-	// restart_count is the number of times the container has been restarted.
-	RestartCount int32 `protobuf:"varint,5,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
-}
-
-func (x *Container) Reset() {
-	*x = Container{}
+	// id is a unique identifier for the canonical service in format namespace:canonical-name.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the canonical service name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// namespace is the Kubernetes namespace of the canonical service.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// services are the IDs of the Kubernetes services backed by instances of the canonical service, sorted.
+	Services []string `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	// revisions are the canonical revisions of the canonical service's instances, sorted.
+	Revisions []string `protobuf:"bytes,5,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// clusters are the clusters running instances of the canonical service, sorted.
+	Clusters []string `protobuf:"bytes,6,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// instance_count is the number of distinct instances of the canonical service across all clusters.
+	InstanceCount int32 `protobuf:"varint,7,opt,name=instance_count,json=instanceCount,proto3" json:"instance_count,omitempty"`
+}
+
+func (x *CanonicalService) Reset() {
+	*x = CanonicalService{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[8]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Container) String() string {
+func (x *CanonicalService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Container) ProtoMessage() {}
+func (*CanonicalService) ProtoMessage() {}
 
-func (x *Container) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[8]
+func (x *CanonicalService) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,48 +1029,62 @@ func (x *Container) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Container.ProtoReflect.Descriptor instead.
-func (*Container) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CanonicalService.ProtoReflect.Descriptor instead.
+func (*CanonicalService) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Container) GetName() string {
+func (x *CanonicalService) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Container) GetImage() string {
+func (x *CanonicalService) GetName() string {
 	if x != nil {
-		return x.Image
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Container) GetStatus() string {
+func (x *CanonicalService) GetNamespace() string {
 	if x != nil {
-		return x.Status
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *Container) GetReady() bool {
+func (x *CanonicalService) GetServices() []string {
 	if x != nil {
-		return x.Ready
+		return x.Services
 	}
-	return false
+	return nil
 }
 
-func (x *Container) GetRestartCount() int32 {
+func (x *CanonicalService) GetRevisions() []string {
 	if x != nil {
-		return x.RestartCount
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *CanonicalService) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *CanonicalService) GetInstanceCount() int32 {
+	if x != nil {
+		return x.InstanceCount
 	}
 	return 0
 }
 
-// ServiceInstanceDetail represents detailed information about a specific service instance.
-type ServiceInstanceDetail struct {
+// ServiceInstance represents a single backend instance serving a service.
+type ServiceInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -657,41 +1102,33 @@ type ServiceInstanceDetail struct {
 	ClusterName string `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	// envoy_present indicates whether this instance has an Envoy proxy sidecar.
 	EnvoyPresent bool `protobuf:"varint,6,opt,name=envoy_present,json=envoyPresent,proto3" json:"envoy_present,omitempty"`
-	// service_name is the name of the service this instance belongs to.
-	ServiceName string `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	// containers is the list of containers running in this pod.
-	Containers []*Container `protobuf:"bytes,8,rep,name=containers,proto3" json:"containers,omitempty"`
-	// pod_status is the current status of the pod (e.g., "Running", "Pending").
-	PodStatus string `protobuf:"bytes,9,opt,name=pod_status,json=podStatus,proto3" json:"pod_status,omitempty"`
-	// node_name is the name of the Kubernetes node hosting this pod.
-	NodeName string `protobuf:"bytes,10,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	// created_at is the timestamp when the pod was created.
-	CreatedAt string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// labels are the Kubernetes labels assigned to the pod.
-	Labels map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// annotations are the Kubernetes annotations assigned to the pod.
-	Annotations map[string]string `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// is_envoy_present indicates whether this instance has an Envoy proxy sidecar.
-	IsEnvoyPresent bool `protobuf:"varint,14,opt,name=is_envoy_present,json=isEnvoyPresent,proto3" json:"is_envoy_present,omitempty"`
+	// zone is the zone of the node hosting this instance. Empty when unknown.
+	Zone string `protobuf:"bytes,7,opt,name=zone,proto3" json:"zone,omitempty"`
+	// lifecycle_phase is where the instance's pod is in its lifecycle, terminating once it has been deleted.
+	LifecyclePhase v1alpha1.PodLifecyclePhase `protobuf:"varint,8,opt,name=lifecycle_phase,json=lifecyclePhase,proto3,enum=navigator.types.v1alpha1.PodLifecyclePhase" json:"lifecycle_phase,omitempty"`
+	// canonical_service is the Istio canonical service of the instance's pod, which Istio's telemetry is keyed by.
+	CanonicalService string `protobuf:"bytes,9,opt,name=canonical_service,json=canonicalService,proto3" json:"canonical_service,omitempty"`
+	// canonical_revision is the revision of the instance's canonical service.
+	CanonicalRevision string `protobuf:"bytes,10,opt,name=canonical_revision,json=canonicalRevision,proto3" json:"canonical_revision,omitempty"`
 }
 
-func (x *ServiceInstanceDetail) Reset() {
-	*x = ServiceInstanceDetail{}
+func (x *ServiceInstance) Reset() {
+	*x = ServiceInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[9]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServiceInstanceDetail) String() string {
+func (x *ServiceInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceInstanceDetail) ProtoMessage() {}
+func (*ServiceInstance) ProtoMessage() {}
 
-func (x *ServiceInstanceDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[9]
+func (x *ServiceInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,26 +1139,285 @@ func (x *ServiceInstanceDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceInstanceDetail.ProtoReflect.Descriptor instead.
-func (*ServiceInstanceDetail) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ServiceInstance.ProtoReflect.Descriptor instead.
+func (*ServiceInstance) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ServiceInstanceDetail) GetInstanceId() string {
+func (x *ServiceInstance) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetIp() string {
+func (x *ServiceInstance) GetIp() string {
 	if x != nil {
 		return x.Ip
 	}
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetPodName() string {
+func (x *ServiceInstance) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetEnvoyPresent() bool {
+	if x != nil {
+		return x.EnvoyPresent
+	}
+	return false
+}
+
+func (x *ServiceInstance) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetLifecyclePhase() v1alpha1.PodLifecyclePhase {
+	if x != nil {
+		return x.LifecyclePhase
+	}
+	return v1alpha1.PodLifecyclePhase(0)
+}
+
+func (x *ServiceInstance) GetCanonicalService() string {
+	if x != nil {
+		return x.CanonicalService
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetCanonicalRevision() string {
+	if x != nil {
+		return x.CanonicalRevision
+	}
+	return ""
+}
+
+// Container represents a container running in a pod.
+type Container struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the container.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// image is the container image.
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// status is the current status of the container (e.g., "Running", "Waiting", "Terminated").
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// ready indicates whether the container is ready to serve requests.
+	Ready bool `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	// restart_count is the number of times the container has been restarted.
+	RestartCount int32 `protobuf:"varint,5,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// image_digest is the digest of the image the container runs (e.g., "sha256:..."), from its status.
+	// Empty until the image has been pulled.
+	ImageDigest string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Container) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Container) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Container) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *Container) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+// ServiceInstanceDetail represents detailed information about a specific service instance.
+type ServiceInstanceDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// instance_id is a unique identifier for this instance across all clusters.
+	// Format: cluster_name:namespace:pod_name (e.g., "prod-west:default:nginx-abc123")
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// ip is the IP address of the instance.
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	// pod_name is the name of the Kubernetes pod backing this instance.
+	PodName string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// namespace is the Kubernetes namespace containing the pod.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// cluster_name is the name of the Kubernetes cluster this instance belongs to.
+	ClusterName string `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// envoy_present indicates whether this instance has an Envoy proxy sidecar.
+	EnvoyPresent bool `protobuf:"varint,6,opt,name=envoy_present,json=envoyPresent,proto3" json:"envoy_present,omitempty"`
+	// service_name is the name of the service this instance belongs to.
+	ServiceName string `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// containers is the list of containers running in this pod.
+	Containers []*Container `protobuf:"bytes,8,rep,name=containers,proto3" json:"containers,omitempty"`
+	// pod_status is the current status of the pod (e.g., "Running", "Pending").
+	PodStatus string `protobuf:"bytes,9,opt,name=pod_status,json=podStatus,proto3" json:"pod_status,omitempty"`
+	// node_name is the name of the Kubernetes node hosting this pod.
+	NodeName string `protobuf:"bytes,10,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// created_at is the timestamp when the pod was created.
+	CreatedAt string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// labels are the Kubernetes labels assigned to the pod.
+	Labels map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// annotations are the Kubernetes annotations assigned to the pod.
+	Annotations map[string]string `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// is_envoy_present indicates whether this instance has an Envoy proxy sidecar.
+	IsEnvoyPresent bool `protobuf:"varint,14,opt,name=is_envoy_present,json=isEnvoyPresent,proto3" json:"is_envoy_present,omitempty"`
+	// init_containers is the list of init containers of this pod, such as istio-init or istio-validation.
+	InitContainers []*Container `protobuf:"bytes,15,rep,name=init_containers,json=initContainers,proto3" json:"init_containers,omitempty"`
+	// service_account is the name of the Kubernetes service account the pod runs as.
+	ServiceAccount string `protobuf:"bytes,16,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// istio_annotations are the pod's annotations that change how Istio injects and configures its proxy:
+	// those prefixed with sidecar.istio.io/, traffic.sidecar.istio.io/ or proxy.istio.io/.
+	IstioAnnotations map[string]string `protobuf:"bytes,17,rep,name=istio_annotations,json=istioAnnotations,proto3" json:"istio_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tolerations are the pod's tolerations.
+	Tolerations []*v1alpha1.Toleration `protobuf:"bytes,18,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// locality is the region and zone of the node hosting this instance.
+	Locality *v1alpha1.LocalityInfo `protobuf:"bytes,19,opt,name=locality,proto3" json:"locality,omitempty"`
+	// zone_hints are the zones whose clients the EndpointSlice hints should be routed to this instance by
+	// topology aware routing.
+	ZoneHints []string `protobuf:"bytes,20,rep,name=zone_hints,json=zoneHints,proto3" json:"zone_hints,omitempty"`
+	// lifecycle_phase is where the pod is in its lifecycle, terminating once it has been deleted.
+	LifecyclePhase v1alpha1.PodLifecyclePhase `protobuf:"varint,21,opt,name=lifecycle_phase,json=lifecyclePhase,proto3,enum=navigator.types.v1alpha1.PodLifecyclePhase" json:"lifecycle_phase,omitempty"`
+	// deletion_timestamp is when the pod was deleted, in RFC 3339 format. Empty unless it is terminating.
+	DeletionTimestamp string `protobuf:"bytes,22,opt,name=deletion_timestamp,json=deletionTimestamp,proto3" json:"deletion_timestamp,omitempty"`
+	// canonical_service is the Istio canonical service of the pod, which Istio's telemetry is keyed by. It is
+	// taken from the service.istio.io/canonical-name, app.kubernetes.io/name or app label, falling back to the
+	// name of the pod's workload.
+	CanonicalService string `protobuf:"bytes,23,opt,name=canonical_service,json=canonicalService,proto3" json:"canonical_service,omitempty"`
+	// canonical_revision is the revision of the pod's canonical service, taken from the
+	// service.istio.io/canonical-revision, app.kubernetes.io/version or version label. Defaults to "latest".
+	CanonicalRevision string `protobuf:"bytes,24,opt,name=canonical_revision,json=canonicalRevision,proto3" json:"canonical_revision,omitempty"`
+}
+
+func (x *ServiceInstanceDetail) Reset() {
+	*x = ServiceInstanceDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceInstanceDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInstanceDetail) ProtoMessage() {}
+
+func (x *ServiceInstanceDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInstanceDetail.ProtoReflect.Descriptor instead.
+func (*ServiceInstanceDetail) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ServiceInstanceDetail) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetPodName() string {
 	if x != nil {
 		return x.PodName
 	}
@@ -735,107 +1431,1733 @@ func (x *ServiceInstanceDetail) GetNamespace() string {
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
+func (x *ServiceInstanceDetail) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetEnvoyPresent() bool {
+	if x != nil {
+		return x.EnvoyPresent
+	}
+	return false
+}
+
+func (x *ServiceInstanceDetail) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetContainers() []*Container {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetPodStatus() string {
+	if x != nil {
+		return x.PodStatus
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetIsEnvoyPresent() bool {
+	if x != nil {
+		return x.IsEnvoyPresent
+	}
+	return false
+}
+
+func (x *ServiceInstanceDetail) GetInitContainers() []*Container {
+	if x != nil {
+		return x.InitContainers
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetServiceAccount() string {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetIstioAnnotations() map[string]string {
+	if x != nil {
+		return x.IstioAnnotations
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetTolerations() []*v1alpha1.Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetLocality() *v1alpha1.LocalityInfo {
+	if x != nil {
+		return x.Locality
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetZoneHints() []string {
+	if x != nil {
+		return x.ZoneHints
+	}
+	return nil
+}
+
+func (x *ServiceInstanceDetail) GetLifecyclePhase() v1alpha1.PodLifecyclePhase {
+	if x != nil {
+		return x.LifecyclePhase
+	}
+	return v1alpha1.PodLifecyclePhase(0)
+}
+
+func (x *ServiceInstanceDetail) GetDeletionTimestamp() string {
+	if x != nil {
+		return x.DeletionTimestamp
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetCanonicalService() string {
+	if x != nil {
+		return x.CanonicalService
+	}
+	return ""
+}
+
+func (x *ServiceInstanceDetail) GetCanonicalRevision() string {
+	if x != nil {
+		return x.CanonicalRevision
+	}
+	return ""
+}
+
+// GetProxyConfigRequest specifies which service instance's proxy configuration to retrieve.
+type GetProxyConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// force_refresh bypasses any cached configuration and takes a new snapshot from the proxy.
+	ForceRefresh *bool `protobuf:"varint,3,opt,name=force_refresh,json=forceRefresh,proto3,oneof" json:"force_refresh,omitempty"`
+}
+
+func (x *GetProxyConfigRequest) Reset() {
+	*x = GetProxyConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProxyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProxyConfigRequest) ProtoMessage() {}
+
+func (x *GetProxyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetProxyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetProxyConfigRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetProxyConfigRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetProxyConfigRequest) GetForceRefresh() bool {
+	if x != nil && x.ForceRefresh != nil {
+		return *x.ForceRefresh
+	}
+	return false
+}
+
+// GetProxyConfigResponse contains the proxy configuration for the requested pod.
+type GetProxyConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proxy_config contains the complete Envoy proxy configuration.
+	ProxyConfig *v1alpha1.ProxyConfig `protobuf:"bytes,1,opt,name=proxy_config,json=proxyConfig,proto3" json:"proxy_config,omitempty"`
+	// sync_metadata describes the most recent state sync from the instance's cluster.
+	SyncMetadata *v1alpha1.ClusterSyncMetadata `protobuf:"bytes,2,opt,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
+	// freshness describes when the proxy configuration was captured.
+	Freshness *ProxyConfigFreshness `protobuf:"bytes,3,opt,name=freshness,proto3" json:"freshness,omitempty"`
+}
+
+func (x *GetProxyConfigResponse) Reset() {
+	*x = GetProxyConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProxyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProxyConfigResponse) ProtoMessage() {}
+
+func (x *GetProxyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetProxyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetProxyConfigResponse) GetProxyConfig() *v1alpha1.ProxyConfig {
+	if x != nil {
+		return x.ProxyConfig
+	}
+	return nil
+}
+
+func (x *GetProxyConfigResponse) GetSyncMetadata() *v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+func (x *GetProxyConfigResponse) GetFreshness() *ProxyConfigFreshness {
+	if x != nil {
+		return x.Freshness
+	}
+	return nil
+}
+
+// ProxyConfigFreshness describes when a proxy configuration snapshot was taken from the proxy.
+type ProxyConfigFreshness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fetched_at is when the configuration was retrieved from the proxy (RFC3339 format).
+	FetchedAt string `protobuf:"bytes,1,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	// age_seconds is how long before this response the configuration was retrieved.
+	AgeSeconds int64 `protobuf:"varint,2,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	// cached indicates the configuration was served from a previous snapshot rather than fetched for this request.
+	Cached bool `protobuf:"varint,3,opt,name=cached,proto3" json:"cached,omitempty"`
+}
+
+func (x *ProxyConfigFreshness) Reset() {
+	*x = ProxyConfigFreshness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyConfigFreshness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyConfigFreshness) ProtoMessage() {}
+
+func (x *ProxyConfigFreshness) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyConfigFreshness.ProtoReflect.Descriptor instead.
+func (*ProxyConfigFreshness) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProxyConfigFreshness) GetFetchedAt() string {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return ""
+}
+
+func (x *ProxyConfigFreshness) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *ProxyConfigFreshness) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+// GetServiceProxyConfigsRequest specifies which service's instance proxy configurations to retrieve.
+type GetServiceProxyConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// force_refresh bypasses any cached configuration and takes new snapshots from every proxy.
+	ForceRefresh *bool `protobuf:"varint,2,opt,name=force_refresh,json=forceRefresh,proto3,oneof" json:"force_refresh,omitempty"`
+}
+
+func (x *GetServiceProxyConfigsRequest) Reset() {
+	*x = GetServiceProxyConfigsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceProxyConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceProxyConfigsRequest) ProtoMessage() {}
+
+func (x *GetServiceProxyConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceProxyConfigsRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceProxyConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetServiceProxyConfigsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetServiceProxyConfigsRequest) GetForceRefresh() bool {
+	if x != nil && x.ForceRefresh != nil {
+		return *x.ForceRefresh
+	}
+	return false
+}
+
+// GetServiceProxyConfigsResponse contains the proxy configuration of each instance of the requested service.
+type GetServiceProxyConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// instances contains one result per service instance, ordered by instance ID.
+	Instances []*InstanceProxyConfig `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *GetServiceProxyConfigsResponse) Reset() {
+	*x = GetServiceProxyConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceProxyConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceProxyConfigsResponse) ProtoMessage() {}
+
+func (x *GetServiceProxyConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceProxyConfigsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceProxyConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetServiceProxyConfigsResponse) GetInstances() []*InstanceProxyConfig {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// InstanceProxyConfig is the proxy configuration, or the reason it could not be retrieved, for one service instance.
+type InstanceProxyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// cluster_name is the name of the cluster the instance runs in.
+	ClusterName string `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// result contains either the proxy configuration or an error message.
+	//
+	// Types that are assignable to Result:
+	//
+	//	*InstanceProxyConfig_ProxyConfig
+	//	*InstanceProxyConfig_ErrorMessage
+	Result isInstanceProxyConfig_Result `protobuf_oneof:"result"`
+	// freshness describes when the proxy configuration was captured. Unset when retrieval failed.
+	Freshness *ProxyConfigFreshness `protobuf:"bytes,5,opt,name=freshness,proto3" json:"freshness,omitempty"`
+}
+
+func (x *InstanceProxyConfig) Reset() {
+	*x = InstanceProxyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceProxyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceProxyConfig) ProtoMessage() {}
+
+func (x *InstanceProxyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceProxyConfig.ProtoReflect.Descriptor instead.
+func (*InstanceProxyConfig) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InstanceProxyConfig) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceProxyConfig) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (m *InstanceProxyConfig) GetResult() isInstanceProxyConfig_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *InstanceProxyConfig) GetProxyConfig() *v1alpha1.ProxyConfig {
+	if x, ok := x.GetResult().(*InstanceProxyConfig_ProxyConfig); ok {
+		return x.ProxyConfig
+	}
+	return nil
+}
+
+func (x *InstanceProxyConfig) GetErrorMessage() string {
+	if x, ok := x.GetResult().(*InstanceProxyConfig_ErrorMessage); ok {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *InstanceProxyConfig) GetFreshness() *ProxyConfigFreshness {
+	if x != nil {
+		return x.Freshness
+	}
+	return nil
+}
+
+type isInstanceProxyConfig_Result interface {
+	isInstanceProxyConfig_Result()
+}
+
+type InstanceProxyConfig_ProxyConfig struct {
+	// proxy_config contains the complete Envoy proxy configuration.
+	ProxyConfig *v1alpha1.ProxyConfig `protobuf:"bytes,3,opt,name=proxy_config,json=proxyConfig,proto3,oneof"`
+}
+
+type InstanceProxyConfig_ErrorMessage struct {
+	// error_message describes why the configuration could not be retrieved.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*InstanceProxyConfig_ProxyConfig) isInstanceProxyConfig_Result() {}
+
+func (*InstanceProxyConfig_ErrorMessage) isInstanceProxyConfig_Result() {}
+
+// GetProxyConfigDumpRequest specifies which service instance's raw config_dump to download.
+type GetProxyConfigDumpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// gzip compresses the download with gzip.
+	Gzip *bool `protobuf:"varint,3,opt,name=gzip,proto3,oneof" json:"gzip,omitempty"`
+	// include_eds includes endpoint (EDS) configuration in the dump.
+	IncludeEds *bool `protobuf:"varint,4,opt,name=include_eds,json=includeEds,proto3,oneof" json:"include_eds,omitempty"`
+}
+
+func (x *GetProxyConfigDumpRequest) Reset() {
+	*x = GetProxyConfigDumpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProxyConfigDumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProxyConfigDumpRequest) ProtoMessage() {}
+
+func (x *GetProxyConfigDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProxyConfigDumpRequest.ProtoReflect.Descriptor instead.
+func (*GetProxyConfigDumpRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetProxyConfigDumpRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetProxyConfigDumpRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetProxyConfigDumpRequest) GetGzip() bool {
+	if x != nil && x.Gzip != nil {
+		return *x.Gzip
+	}
+	return false
+}
+
+func (x *GetProxyConfigDumpRequest) GetIncludeEds() bool {
+	if x != nil && x.IncludeEds != nil {
+		return *x.IncludeEds
+	}
+	return false
+}
+
+// GetIstioResourcesRequest specifies which service instance's Istio resources to retrieve.
+type GetIstioResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *GetIstioResourcesRequest) Reset() {
+	*x = GetIstioResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIstioResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIstioResourcesRequest) ProtoMessage() {}
+
+func (x *GetIstioResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIstioResourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetIstioResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetIstioResourcesRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetIstioResourcesRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+// GetIstioResourcesResponse contains the Istio resources for the requested service instance.
+type GetIstioResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// virtual_services are VirtualService resources affecting this instance.
+	VirtualServices []*v1alpha1.VirtualService `protobuf:"bytes,1,rep,name=virtual_services,json=virtualServices,proto3" json:"virtual_services,omitempty"`
+	// destination_rules are DestinationRule resources affecting this instance.
+	DestinationRules []*v1alpha1.DestinationRule `protobuf:"bytes,2,rep,name=destination_rules,json=destinationRules,proto3" json:"destination_rules,omitempty"`
+	// gateways are Gateway resources affecting this instance.
+	Gateways []*v1alpha1.Gateway `protobuf:"bytes,3,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	// sidecars are Sidecar resources affecting this instance.
+	Sidecars []*v1alpha1.Sidecar `protobuf:"bytes,4,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	// envoy_filters are EnvoyFilter resources affecting this instance.
+	EnvoyFilters []*v1alpha1.EnvoyFilter `protobuf:"bytes,5,rep,name=envoy_filters,json=envoyFilters,proto3" json:"envoy_filters,omitempty"`
+	// request_authentications are RequestAuthentication resources affecting this instance.
+	RequestAuthentications []*v1alpha1.RequestAuthentication `protobuf:"bytes,6,rep,name=request_authentications,json=requestAuthentications,proto3" json:"request_authentications,omitempty"`
+	// peer_authentications are PeerAuthentication resources affecting this instance.
+	PeerAuthentications []*v1alpha1.PeerAuthentication `protobuf:"bytes,7,rep,name=peer_authentications,json=peerAuthentications,proto3" json:"peer_authentications,omitempty"`
+	// authorization_policies are AuthorizationPolicy resources affecting this instance.
+	AuthorizationPolicies []*v1alpha1.AuthorizationPolicy `protobuf:"bytes,8,rep,name=authorization_policies,json=authorizationPolicies,proto3" json:"authorization_policies,omitempty"`
+	// wasm_plugins are WasmPlugin resources affecting this instance.
+	WasmPlugins []*v1alpha1.WasmPlugin `protobuf:"bytes,9,rep,name=wasm_plugins,json=wasmPlugins,proto3" json:"wasm_plugins,omitempty"`
+	// service_entries are ServiceEntry resources affecting this instance.
+	ServiceEntries []*v1alpha1.ServiceEntry `protobuf:"bytes,10,rep,name=service_entries,json=serviceEntries,proto3" json:"service_entries,omitempty"`
+	// sync_metadata describes the most recent state sync from the instance's cluster.
+	SyncMetadata *v1alpha1.ClusterSyncMetadata `protobuf:"bytes,11,opt,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
+}
+
+func (x *GetIstioResourcesResponse) Reset() {
+	*x = GetIstioResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIstioResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIstioResourcesResponse) ProtoMessage() {}
+
+func (x *GetIstioResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIstioResourcesResponse.ProtoReflect.Descriptor instead.
+func (*GetIstioResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetIstioResourcesResponse) GetVirtualServices() []*v1alpha1.VirtualService {
+	if x != nil {
+		return x.VirtualServices
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetDestinationRules() []*v1alpha1.DestinationRule {
+	if x != nil {
+		return x.DestinationRules
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetGateways() []*v1alpha1.Gateway {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetSidecars() []*v1alpha1.Sidecar {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetEnvoyFilters() []*v1alpha1.EnvoyFilter {
+	if x != nil {
+		return x.EnvoyFilters
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetRequestAuthentications() []*v1alpha1.RequestAuthentication {
+	if x != nil {
+		return x.RequestAuthentications
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetPeerAuthentications() []*v1alpha1.PeerAuthentication {
+	if x != nil {
+		return x.PeerAuthentications
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetAuthorizationPolicies() []*v1alpha1.AuthorizationPolicy {
+	if x != nil {
+		return x.AuthorizationPolicies
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetWasmPlugins() []*v1alpha1.WasmPlugin {
+	if x != nil {
+		return x.WasmPlugins
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetServiceEntries() []*v1alpha1.ServiceEntry {
+	if x != nil {
+		return x.ServiceEntries
+	}
+	return nil
+}
+
+func (x *GetIstioResourcesResponse) GetSyncMetadata() *v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// GetInstanceLogsRequest specifies which service instance's container logs to retrieve.
+type GetInstanceLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// container is the container to retrieve logs from (e.g., "istio-proxy").
+	// If not specified, the pod's default container is used.
+	Container *string `protobuf:"bytes,3,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	// tail_lines limits the number of lines returned from the end of the log.
+	TailLines *int64 `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3,oneof" json:"tail_lines,omitempty"`
+	// since_seconds limits the logs to those written within this many seconds.
+	SinceSeconds *int64 `protobuf:"varint,5,opt,name=since_seconds,json=sinceSeconds,proto3,oneof" json:"since_seconds,omitempty"`
+	// previous returns logs from the previous terminated instance of the container.
+	Previous *bool `protobuf:"varint,6,opt,name=previous,proto3,oneof" json:"previous,omitempty"`
+}
+
+func (x *GetInstanceLogsRequest) Reset() {
+	*x = GetInstanceLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstanceLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceLogsRequest) ProtoMessage() {}
+
+func (x *GetInstanceLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceLogsRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetInstanceLogsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetInstanceLogsRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetInstanceLogsRequest) GetContainer() string {
+	if x != nil && x.Container != nil {
+		return *x.Container
+	}
+	return ""
+}
+
+func (x *GetInstanceLogsRequest) GetTailLines() int64 {
+	if x != nil && x.TailLines != nil {
+		return *x.TailLines
+	}
+	return 0
+}
+
+func (x *GetInstanceLogsRequest) GetSinceSeconds() int64 {
+	if x != nil && x.SinceSeconds != nil {
+		return *x.SinceSeconds
+	}
+	return 0
+}
+
+func (x *GetInstanceLogsRequest) GetPrevious() bool {
+	if x != nil && x.Previous != nil {
+		return *x.Previous
+	}
+	return false
+}
+
+// GetInstanceLogsResponse contains the container logs for the requested service instance.
+type GetInstanceLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// logs contains the retrieved container logs.
+	Logs *v1alpha1.ContainerLogs `protobuf:"bytes,1,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *GetInstanceLogsResponse) Reset() {
+	*x = GetInstanceLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstanceLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceLogsResponse) ProtoMessage() {}
+
+func (x *GetInstanceLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetInstanceLogsResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetInstanceLogsResponse) GetLogs() *v1alpha1.ContainerLogs {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// GetEnvoyAdminRequest specifies which Envoy admin endpoint to query on a service instance.
+type GetEnvoyAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// path is the Envoy admin endpoint to query. Only read-only endpoints are allowed:
+	// certs, clusters, config_dump, listeners, logging, memory, ready, runtime, server_info, stats, stats/prometheus.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// query is the query string passed to the admin endpoint, without the leading "?"
+	// (e.g., "filter=^cluster&format=json"). logging does not accept one, since it would change the log levels.
+	Query *string `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
+}
+
+func (x *GetEnvoyAdminRequest) Reset() {
+	*x = GetEnvoyAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnvoyAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvoyAdminRequest) ProtoMessage() {}
+
+func (x *GetEnvoyAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvoyAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvoyAdminRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetEnvoyAdminRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GetEnvoyAdminRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetEnvoyAdminRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetEnvoyAdminRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+// GetEnvoyAdminResponse contains the raw output of the Envoy admin endpoint.
+type GetEnvoyAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path is the admin endpoint that was queried, including any query string.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// output is the raw response body returned by the admin endpoint.
+	Output string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *GetEnvoyAdminResponse) Reset() {
+	*x = GetEnvoyAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnvoyAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvoyAdminResponse) ProtoMessage() {}
+
+func (x *GetEnvoyAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvoyAdminResponse.ProtoReflect.Descriptor instead.
+func (*GetEnvoyAdminResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetEnvoyAdminResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetEnvoyAdminResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// ListIstioResourcesRequest specifies which Istio resources to list.
+type ListIstioResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace filters resources to only those in the specified namespace.
+	// If not specified, resources from all namespaces are returned.
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// cluster_id filters resources to only those from the specified cluster.
+	// If not specified, resources from all connected clusters are returned.
+	ClusterId *string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// kinds filters resources to only those of the specified kinds.
+	// If not specified, resources of all kinds are returned.
+	Kinds []v1alpha1.IstioResourceKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=navigator.types.v1alpha1.IstioResourceKind" json:"kinds,omitempty"`
+	// page_size is the maximum number of resources to return. Defaults to 100; values above 500 are capped.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token from a previous response with the same filters, used to retrieve the
+	// following page. Tokens are opaque.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// raw_config_format selects how raw_config is returned. Defaults to the JSON collected from the cluster.
+	RawConfigFormat RawConfigFormat `protobuf:"varint,6,opt,name=raw_config_format,json=rawConfigFormat,proto3,enum=navigator.frontend.v1alpha1.RawConfigFormat" json:"raw_config_format,omitempty"`
+	// cluster_selector filters resources to only those from clusters whose labels match it, using Kubernetes
+	// label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,7,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *ListIstioResourcesRequest) Reset() {
+	*x = ListIstioResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIstioResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIstioResourcesRequest) ProtoMessage() {}
+
+func (x *ListIstioResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIstioResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListIstioResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListIstioResourcesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *ListIstioResourcesRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListIstioResourcesRequest) GetKinds() []v1alpha1.IstioResourceKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ListIstioResourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIstioResourcesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListIstioResourcesRequest) GetRawConfigFormat() RawConfigFormat {
+	if x != nil {
+		return x.RawConfigFormat
+	}
+	return RawConfigFormat_RAW_CONFIG_FORMAT_UNSPECIFIED
+}
+
+func (x *ListIstioResourcesRequest) GetClusterSelector() string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return ""
+}
+
+// ListIstioResourcesResponse contains a page of Istio resources.
+type ListIstioResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resources is the page of matching resources, ordered by cluster, namespace, kind and name.
+	Resources []*IstioResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	// next_page_token retrieves the next page of results. Empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_count is the total number of resources matching the filters across all pages.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,4,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
+}
+
+func (x *ListIstioResourcesResponse) Reset() {
+	*x = ListIstioResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIstioResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIstioResourcesResponse) ProtoMessage() {}
+
+func (x *ListIstioResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIstioResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListIstioResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListIstioResourcesResponse) GetResources() []*IstioResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ListIstioResourcesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListIstioResourcesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListIstioResourcesResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
+	if x != nil {
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// IstioResource is a single Istio configuration resource collected from a cluster.
+type IstioResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster the resource was collected from.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// kind is the kind of Istio resource.
+	Kind v1alpha1.IstioResourceKind `protobuf:"varint,2,opt,name=kind,proto3,enum=navigator.types.v1alpha1.IstioResourceKind" json:"kind,omitempty"`
+	// name is the name of the resource.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// namespace is the namespace of the resource.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// raw_config is the complete resource as JSON.
+	RawConfig string `protobuf:"bytes,5,opt,name=raw_config,json=rawConfig,proto3" json:"raw_config,omitempty"`
+	// api_version is the apiVersion the resource was last written with. It is empty if unknown.
+	ApiVersion string `protobuf:"bytes,6,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+}
+
+func (x *IstioResource) Reset() {
+	*x = IstioResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstioResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstioResource) ProtoMessage() {}
+
+func (x *IstioResource) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstioResource.ProtoReflect.Descriptor instead.
+func (*IstioResource) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *IstioResource) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *IstioResource) GetKind() v1alpha1.IstioResourceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return v1alpha1.IstioResourceKind(0)
+}
+
+func (x *IstioResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IstioResource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *IstioResource) GetRawConfig() string {
+	if x != nil {
+		return x.RawConfig
+	}
+	return ""
+}
+
+func (x *IstioResource) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+// DownloadIstioResourcesRequest specifies which Istio resources to download.
+type DownloadIstioResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace filters resources to only those in the specified namespace.
+	// If not specified, resources from all namespaces are downloaded.
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// cluster_id filters resources to only those from the specified cluster.
+	// If not specified, resources from all connected clusters are downloaded.
+	ClusterId *string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// kinds filters resources to only those of the specified kinds.
+	// If not specified, resources of all kinds are downloaded.
+	Kinds []v1alpha1.IstioResourceKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=navigator.types.v1alpha1.IstioResourceKind" json:"kinds,omitempty"`
+	// cluster_selector filters resources to only those from clusters whose labels match it, using Kubernetes
+	// label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,4,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *DownloadIstioResourcesRequest) Reset() {
+	*x = DownloadIstioResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadIstioResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadIstioResourcesRequest) ProtoMessage() {}
+
+func (x *DownloadIstioResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadIstioResourcesRequest.ProtoReflect.Descriptor instead.
+func (*DownloadIstioResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DownloadIstioResourcesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *DownloadIstioResourcesRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
 	}
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetEnvoyPresent() bool {
+func (x *DownloadIstioResourcesRequest) GetKinds() []v1alpha1.IstioResourceKind {
 	if x != nil {
-		return x.EnvoyPresent
+		return x.Kinds
 	}
-	return false
+	return nil
 }
 
-func (x *ServiceInstanceDetail) GetServiceName() string {
+func (x *DownloadIstioResourcesRequest) GetClusterSelector() string {
 	if x != nil {
-		return x.ServiceName
+		return x.ClusterSelector
 	}
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetContainers() []*Container {
+// GetResourceInventoryRequest specifies which clusters to count Istio resources in.
+type GetResourceInventoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id counts only the resources from the specified cluster.
+	// If not specified, resources from all connected clusters are counted.
+	ClusterId *string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3,oneof" json:"cluster_id,omitempty"`
+	// cluster_selector counts only the resources from clusters whose labels match it, using Kubernetes
+	// label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+	ClusterSelector string `protobuf:"bytes,2,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+}
+
+func (x *GetResourceInventoryRequest) Reset() {
+	*x = GetResourceInventoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceInventoryRequest) ProtoMessage() {}
+
+func (x *GetResourceInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetResourceInventoryRequest) GetClusterId() string {
+	if x != nil && x.ClusterId != nil {
+		return *x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetResourceInventoryRequest) GetClusterSelector() string {
 	if x != nil {
-		return x.Containers
+		return x.ClusterSelector
+	}
+	return ""
+}
+
+// GetResourceInventoryResponse contains the number of Istio resources of each kind.
+type GetResourceInventoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// clusters are the counts of each cluster, ordered by cluster ID.
+	Clusters []*ClusterResourceInventory `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// counts are the number of resources of each kind across all clusters, ordered by kind.
+	Counts []*ResourceKindCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	// total_count is the number of resources across all clusters and kinds.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// sync_metadata describes the most recent state sync from each cluster contributing to this response.
+	SyncMetadata []*v1alpha1.ClusterSyncMetadata `protobuf:"bytes,4,rep,name=sync_metadata,json=syncMetadata,proto3" json:"sync_metadata,omitempty"`
+}
+
+func (x *GetResourceInventoryResponse) Reset() {
+	*x = GetResourceInventoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceInventoryResponse) ProtoMessage() {}
+
+func (x *GetResourceInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetResourceInventoryResponse) GetClusters() []*ClusterResourceInventory {
+	if x != nil {
+		return x.Clusters
 	}
 	return nil
 }
 
-func (x *ServiceInstanceDetail) GetPodStatus() string {
+func (x *GetResourceInventoryResponse) GetCounts() []*ResourceKindCount {
 	if x != nil {
-		return x.PodStatus
+		return x.Counts
 	}
-	return ""
+	return nil
 }
 
-func (x *ServiceInstanceDetail) GetNodeName() string {
+func (x *GetResourceInventoryResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.NodeName
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ServiceInstanceDetail) GetCreatedAt() string {
+func (x *GetResourceInventoryResponse) GetSyncMetadata() []*v1alpha1.ClusterSyncMetadata {
 	if x != nil {
-		return x.CreatedAt
+		return x.SyncMetadata
+	}
+	return nil
+}
+
+// ClusterResourceInventory contains the number of Istio resources of each kind in a cluster.
+type ClusterResourceInventory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id is the cluster the resources were collected from.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// namespaces are the counts of each namespace holding resources, ordered by namespace.
+	Namespaces []*NamespaceResourceInventory `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// counts are the number of resources of each kind in the cluster, ordered by kind.
+	Counts []*ResourceKindCount `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`
+	// total_count is the number of resources in the cluster.
+	TotalCount int32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ClusterResourceInventory) Reset() {
+	*x = ClusterResourceInventory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterResourceInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterResourceInventory) ProtoMessage() {}
+
+func (x *ClusterResourceInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterResourceInventory.ProtoReflect.Descriptor instead.
+func (*ClusterResourceInventory) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClusterResourceInventory) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *ServiceInstanceDetail) GetLabels() map[string]string {
+func (x *ClusterResourceInventory) GetNamespaces() []*NamespaceResourceInventory {
 	if x != nil {
-		return x.Labels
+		return x.Namespaces
 	}
 	return nil
 }
 
-func (x *ServiceInstanceDetail) GetAnnotations() map[string]string {
+func (x *ClusterResourceInventory) GetCounts() []*ResourceKindCount {
 	if x != nil {
-		return x.Annotations
+		return x.Counts
 	}
 	return nil
 }
 
-func (x *ServiceInstanceDetail) GetIsEnvoyPresent() bool {
+func (x *ClusterResourceInventory) GetTotalCount() int32 {
 	if x != nil {
-		return x.IsEnvoyPresent
+		return x.TotalCount
 	}
-	return false
+	return 0
 }
 
-// GetProxyConfigRequest specifies which service instance's proxy configuration to retrieve.
-type GetProxyConfigRequest struct {
+// NamespaceResourceInventory contains the number of Istio resources of each kind in a namespace.
+type NamespaceResourceInventory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// service_id is the unique identifier of the service.
-	// Format: namespace:service-name (e.g., "default:nginx-service")
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// instance_id is the unique identifier of the service instance.
-	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
-	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// namespace is the namespace of the resources.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// counts are the number of resources of each kind in the namespace, ordered by kind.
+	Counts []*ResourceKindCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	// total_count is the number of resources in the namespace.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *GetProxyConfigRequest) Reset() {
-	*x = GetProxyConfigRequest{}
+func (x *NamespaceResourceInventory) Reset() {
+	*x = NamespaceResourceInventory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[10]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProxyConfigRequest) String() string {
+func (x *NamespaceResourceInventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProxyConfigRequest) ProtoMessage() {}
+func (*NamespaceResourceInventory) ProtoMessage() {}
 
-func (x *GetProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[10]
+func (x *NamespaceResourceInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,52 +3168,61 @@ func (x *GetProxyConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProxyConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use NamespaceResourceInventory.ProtoReflect.Descriptor instead.
+func (*NamespaceResourceInventory) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetProxyConfigRequest) GetServiceId() string {
+func (x *NamespaceResourceInventory) GetNamespace() string {
 	if x != nil {
-		return x.ServiceId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetProxyConfigRequest) GetInstanceId() string {
+func (x *NamespaceResourceInventory) GetCounts() []*ResourceKindCount {
 	if x != nil {
-		return x.InstanceId
+		return x.Counts
 	}
-	return ""
+	return nil
 }
 
-// GetProxyConfigResponse contains the proxy configuration for the requested pod.
-type GetProxyConfigResponse struct {
+func (x *NamespaceResourceInventory) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ResourceKindCount is the number of Istio resources of a kind. Kinds without resources are omitted.
+type ResourceKindCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proxy_config contains the complete Envoy proxy configuration.
-	ProxyConfig *v1alpha1.ProxyConfig `protobuf:"bytes,1,opt,name=proxy_config,json=proxyConfig,proto3" json:"proxy_config,omitempty"`
+	// kind is the kind of Istio resource.
+	Kind v1alpha1.IstioResourceKind `protobuf:"varint,1,opt,name=kind,proto3,enum=navigator.types.v1alpha1.IstioResourceKind" json:"kind,omitempty"`
+	// count is the number of resources of the kind.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetProxyConfigResponse) Reset() {
-	*x = GetProxyConfigResponse{}
+func (x *ResourceKindCount) Reset() {
+	*x = ResourceKindCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[11]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProxyConfigResponse) String() string {
+func (x *ResourceKindCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProxyConfigResponse) ProtoMessage() {}
+func (*ResourceKindCount) ProtoMessage() {}
 
-func (x *GetProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[11]
+func (x *ResourceKindCount) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,49 +3233,59 @@ func (x *GetProxyConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProxyConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ResourceKindCount.ProtoReflect.Descriptor instead.
+func (*ResourceKindCount) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetProxyConfigResponse) GetProxyConfig() *v1alpha1.ProxyConfig {
+func (x *ResourceKindCount) GetKind() v1alpha1.IstioResourceKind {
 	if x != nil {
-		return x.ProxyConfig
+		return x.Kind
 	}
-	return nil
+	return v1alpha1.IstioResourceKind(0)
 }
 
-// GetIstioResourcesRequest specifies which service instance's Istio resources to retrieve.
-type GetIstioResourcesRequest struct {
+func (x *ResourceKindCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetResourceReferencesRequest identifies the resource whose references to return.
+type GetResourceReferencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// service_id is the unique identifier of the service.
-	// Format: namespace:service-name (e.g., "default:nginx-service")
-	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// instance_id is the unique identifier of the service instance.
-	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
-	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// cluster_id is the cluster the resource was collected from.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// kind is the Kubernetes kind of the resource, e.g. "VirtualService", "Gateway", "DestinationRule",
+	// "Service" or "Pod".
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// namespace is the namespace of the resource.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetIstioResourcesRequest) Reset() {
-	*x = GetIstioResourcesRequest{}
+func (x *GetResourceReferencesRequest) Reset() {
+	*x = GetResourceReferencesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[12]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetIstioResourcesRequest) String() string {
+func (x *GetResourceReferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIstioResourcesRequest) ProtoMessage() {}
+func (*GetResourceReferencesRequest) ProtoMessage() {}
 
-func (x *GetIstioResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[12]
+func (x *GetResourceReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,70 +3296,150 @@ func (x *GetIstioResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIstioResourcesRequest.ProtoReflect.Descriptor instead.
-func (*GetIstioResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetResourceReferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetResourceReferencesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetResourceReferencesRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetResourceReferencesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetResourceReferencesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetResourceReferencesResponse contains the references to and from a resource.
+type GetResourceReferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resource is the resource the references were requested for.
+	Resource *v1alpha1.ResourceRef `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// uses are the references from the resource to other resources.
+	Uses []*v1alpha1.ResourceReference `protobuf:"bytes,2,rep,name=uses,proto3" json:"uses,omitempty"`
+	// used_by are the references from other resources to the resource.
+	UsedBy []*v1alpha1.ResourceReference `protobuf:"bytes,3,rep,name=used_by,json=usedBy,proto3" json:"used_by,omitempty"`
+}
+
+func (x *GetResourceReferencesResponse) Reset() {
+	*x = GetResourceReferencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceReferencesResponse) ProtoMessage() {}
+
+func (x *GetResourceReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceReferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetResourceReferencesResponse) GetResource() *v1alpha1.ResourceRef {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
 }
 
-func (x *GetIstioResourcesRequest) GetServiceId() string {
+func (x *GetResourceReferencesResponse) GetUses() []*v1alpha1.ResourceReference {
 	if x != nil {
-		return x.ServiceId
+		return x.Uses
 	}
-	return ""
+	return nil
 }
 
-func (x *GetIstioResourcesRequest) GetInstanceId() string {
+func (x *GetResourceReferencesResponse) GetUsedBy() []*v1alpha1.ResourceReference {
 	if x != nil {
-		return x.InstanceId
+		return x.UsedBy
 	}
-	return ""
+	return nil
 }
 
-// GetIstioResourcesResponse contains the Istio resources for the requested service instance.
-type GetIstioResourcesResponse struct {
+// SimulateRouteRequest describes the HTTP request to evaluate against a service instance's proxy routes.
+type SimulateRouteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// virtual_services are VirtualService resources affecting this instance.
-	VirtualServices []*v1alpha1.VirtualService `protobuf:"bytes,1,rep,name=virtual_services,json=virtualServices,proto3" json:"virtual_services,omitempty"`
-	// destination_rules are DestinationRule resources affecting this instance.
-	DestinationRules []*v1alpha1.DestinationRule `protobuf:"bytes,2,rep,name=destination_rules,json=destinationRules,proto3" json:"destination_rules,omitempty"`
-	// gateways are Gateway resources affecting this instance.
-	Gateways []*v1alpha1.Gateway `protobuf:"bytes,3,rep,name=gateways,proto3" json:"gateways,omitempty"`
-	// sidecars are Sidecar resources affecting this instance.
-	Sidecars []*v1alpha1.Sidecar `protobuf:"bytes,4,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
-	// envoy_filters are EnvoyFilter resources affecting this instance.
-	EnvoyFilters []*v1alpha1.EnvoyFilter `protobuf:"bytes,5,rep,name=envoy_filters,json=envoyFilters,proto3" json:"envoy_filters,omitempty"`
-	// request_authentications are RequestAuthentication resources affecting this instance.
-	RequestAuthentications []*v1alpha1.RequestAuthentication `protobuf:"bytes,6,rep,name=request_authentications,json=requestAuthentications,proto3" json:"request_authentications,omitempty"`
-	// peer_authentications are PeerAuthentication resources affecting this instance.
-	PeerAuthentications []*v1alpha1.PeerAuthentication `protobuf:"bytes,7,rep,name=peer_authentications,json=peerAuthentications,proto3" json:"peer_authentications,omitempty"`
-	// authorization_policies are AuthorizationPolicy resources affecting this instance.
-	AuthorizationPolicies []*v1alpha1.AuthorizationPolicy `protobuf:"bytes,8,rep,name=authorization_policies,json=authorizationPolicies,proto3" json:"authorization_policies,omitempty"`
-	// wasm_plugins are WasmPlugin resources affecting this instance.
-	WasmPlugins []*v1alpha1.WasmPlugin `protobuf:"bytes,9,rep,name=wasm_plugins,json=wasmPlugins,proto3" json:"wasm_plugins,omitempty"`
-	// service_entries are ServiceEntry resources affecting this instance.
-	ServiceEntries []*v1alpha1.ServiceEntry `protobuf:"bytes,10,rep,name=service_entries,json=serviceEntries,proto3" json:"service_entries,omitempty"`
-}
-
-func (x *GetIstioResourcesResponse) Reset() {
-	*x = GetIstioResourcesResponse{}
+	// service_id is the unique identifier of the service.
+	// Format: namespace:service-name (e.g., "default:nginx-service")
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// instance_id is the unique identifier of the service instance whose proxy routes the request.
+	// Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// host is the request's Host (:authority) header, optionally including a port (e.g., "reviews:9080").
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	// path is the request path, optionally including a query string. Defaults to "/".
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// method is the HTTP method. Defaults to GET.
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// headers are additional request headers to match against route header matchers.
+	Headers map[string]string `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// port restricts evaluation to the route configurations serving this port.
+	// If not specified, the port in host is used; without either, every route configuration is evaluated.
+	Port *uint32 `protobuf:"varint,7,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	// force_refresh bypasses any cached configuration and takes a new snapshot from the proxy.
+	ForceRefresh *bool `protobuf:"varint,8,opt,name=force_refresh,json=forceRefresh,proto3,oneof" json:"force_refresh,omitempty"`
+}
+
+func (x *SimulateRouteRequest) Reset() {
+	*x = SimulateRouteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[13]
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetIstioResourcesResponse) String() string {
+func (x *SimulateRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIstioResourcesResponse) ProtoMessage() {}
+func (*SimulateRouteRequest) ProtoMessage() {}
 
-func (x *GetIstioResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[13]
+func (x *SimulateRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,77 +3450,130 @@ func (x *GetIstioResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIstioResourcesResponse.ProtoReflect.Descriptor instead.
-func (*GetIstioResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SimulateRouteRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRouteRequest) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetIstioResourcesResponse) GetVirtualServices() []*v1alpha1.VirtualService {
+func (x *SimulateRouteRequest) GetServiceId() string {
 	if x != nil {
-		return x.VirtualServices
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetIstioResourcesResponse) GetDestinationRules() []*v1alpha1.DestinationRule {
+func (x *SimulateRouteRequest) GetInstanceId() string {
 	if x != nil {
-		return x.DestinationRules
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetIstioResourcesResponse) GetGateways() []*v1alpha1.Gateway {
+func (x *SimulateRouteRequest) GetHost() string {
 	if x != nil {
-		return x.Gateways
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *GetIstioResourcesResponse) GetSidecars() []*v1alpha1.Sidecar {
+func (x *SimulateRouteRequest) GetPath() string {
 	if x != nil {
-		return x.Sidecars
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *GetIstioResourcesResponse) GetEnvoyFilters() []*v1alpha1.EnvoyFilter {
+func (x *SimulateRouteRequest) GetMethod() string {
 	if x != nil {
-		return x.EnvoyFilters
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *GetIstioResourcesResponse) GetRequestAuthentications() []*v1alpha1.RequestAuthentication {
+func (x *SimulateRouteRequest) GetHeaders() map[string]string {
 	if x != nil {
-		return x.RequestAuthentications
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *GetIstioResourcesResponse) GetPeerAuthentications() []*v1alpha1.PeerAuthentication {
-	if x != nil {
-		return x.PeerAuthentications
+func (x *SimulateRouteRequest) GetPort() uint32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *GetIstioResourcesResponse) GetAuthorizationPolicies() []*v1alpha1.AuthorizationPolicy {
+func (x *SimulateRouteRequest) GetForceRefresh() bool {
+	if x != nil && x.ForceRefresh != nil {
+		return *x.ForceRefresh
+	}
+	return false
+}
+
+// SimulateRouteResponse reports how the instance's proxy would route the simulated request.
+type SimulateRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// matches contains the selected route for each evaluated route configuration that handles the request.
+	Matches []*v1alpha1.RouteSimulationMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// virtual_services contains the VirtualServices referenced by the matches, as collected from the instance's cluster.
+	VirtualServices []*IstioResource `protobuf:"bytes,2,rep,name=virtual_services,json=virtualServices,proto3" json:"virtual_services,omitempty"`
+	// freshness describes when the proxy configuration used for the simulation was captured.
+	Freshness *ProxyConfigFreshness `protobuf:"bytes,3,opt,name=freshness,proto3" json:"freshness,omitempty"`
+}
+
+func (x *SimulateRouteResponse) Reset() {
+	*x = SimulateRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRouteResponse) ProtoMessage() {}
+
+func (x *SimulateRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontend_v1alpha1_service_registry_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRouteResponse.ProtoReflect.Descriptor instead.
+func (*SimulateRouteResponse) Descriptor() ([]byte, []int) {
+	return file_frontend_v1alpha1_service_registry_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SimulateRouteResponse) GetMatches() []*v1alpha1.RouteSimulationMatch {
 	if x != nil {
-		return x.AuthorizationPolicies
+		return x.Matches
 	}
 	return nil
 }
 
-func (x *GetIstioResourcesResponse) GetWasmPlugins() []*v1alpha1.WasmPlugin {
+func (x *SimulateRouteResponse) GetVirtualServices() []*IstioResource {
 	if x != nil {
-		return x.WasmPlugins
+		return x.VirtualServices
 	}
 	return nil
 }
 
-func (x *GetIstioResourcesResponse) GetServiceEntries() []*v1alpha1.ServiceEntry {
+func (x *SimulateRouteResponse) GetFreshness() *ProxyConfigFreshness {
 	if x != nil {
-		return x.ServiceEntries
+		return x.Freshness
 	}
 	return nil
 }
@@ -1113,282 +3587,924 @@ var file_frontend_v1alpha1_service_registry_proto_rawDesc = []byte{
 	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x5b, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x79, 0x6e,
+	0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5b, 0x0a,
 	0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
 	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x72,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc0, 0x03,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x0c, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x22, 0x8d, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0d,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xcc, 0x07, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a,
+	0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70,
+	0x73, 0x12, 0x58, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x0b, 0x7a, 0x6f, 0x6e,
+	0x65, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x5e, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
+	0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a,
+	0x12, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xd1, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x12, 0x55, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x70, 0x73, 0x12, 0x58, 0x0a, 0x0c, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x89, 0x03, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x54, 0x0a,
+	0x0f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x6f, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xab, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xb5, 0x0b,
+	0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x56, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e,
 	0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79,
-	0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a,
-	0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf3, 0x05, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x65, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x45, 0x6e, 0x76, 0x6f,
+	0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x75, 0x0a, 0x11, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x50, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x56, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6e, 0x61, 0x76, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x12, 0x65, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x65,
-	0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
-	0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5a, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x6c, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x68, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x48,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x64, 0x4c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x43, 0x0a, 0x15, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x87, 0x02, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x6e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xdd, 0x06, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x73,
-	0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x3d, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73,
-	0x12, 0x3d, 0x0a, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x08, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12,
-	0x4a, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x65,
-	0x6e, 0x76, 0x6f, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x22, 0x70, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x4a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0d, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x4f, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
+	0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68, 0x6e,
+	0x65, 0x73, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb3, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x67,
+	0x7a, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x67, 0x7a, 0x69,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x65, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x45, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x67,
+	0x7a, 0x69, 0x70, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x65, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0xb1, 0x07, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e,
 	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x14, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x13, 0x70, 0x65, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x08,
+	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f,
+	0x0a, 0x14, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x70, 0x65, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x64, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x0b, 0x77, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x4f,
+	0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c,
-	0x77, 0x61, 0x73, 0x6d, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
-	0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x0b, 0x77, 0x61, 0x73, 0x6d, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x32, 0xb8, 0x07, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x93, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x12, 0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xa6, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0c,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x56, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x6f,
+	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x19, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x83, 0x03, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x41, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x58, 0x0a, 0x11, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0f, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x83, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e,
+	0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
+	0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x1d,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
+	0x7b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xae, 0x02, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfb, 0x01,
+	0x0a, 0x18, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
 	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xca, 0x01, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x36, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x1a,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x8a, 0x03, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x58, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x89, 0x02, 0x0a,
+	0x15, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x55, 0x0a, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x2a, 0x6d, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e,
+	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x48,
+	0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x48, 0x45,
+	0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x41,
+	0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x41, 0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x41, 0x57,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59,
+	0x41, 0x4d, 0x4c, 0x10, 0x02, 0x32, 0xe3, 0x17, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x93, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x30, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
 	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6e, 0x61,
-	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcb, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x2e, 0x6e,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0xca, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0xb4, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x6e,
 	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x33, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xd7, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49,
-	0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x35, 0x2e,
+	0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x2e,
 	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xcc, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x12, 0x3a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x36,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x4f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
 	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x64, 0x75, 0x6d, 0x70, 0x12, 0xd7, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x33, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0xd1, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x53, 0x12, 0x51, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x2d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68,
+	0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73,
+	0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3a,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0xbc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x38, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x2d, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0xb9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x0d,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x31, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4f, 0x3a, 0x01, 0x2a, 0x22,
+	0x4a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x3b, 0x5a, 0x39, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1403,76 +4519,185 @@ func file_frontend_v1alpha1_service_registry_proto_rawDescGZIP() []byte {
 	return file_frontend_v1alpha1_service_registry_proto_rawDescData
 }
 
-var file_frontend_v1alpha1_service_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_frontend_v1alpha1_service_registry_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_frontend_v1alpha1_service_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_frontend_v1alpha1_service_registry_proto_goTypes = []any{
-	(*ListServicesRequest)(nil),            // 0: navigator.frontend.v1alpha1.ListServicesRequest
-	(*ListServicesResponse)(nil),           // 1: navigator.frontend.v1alpha1.ListServicesResponse
-	(*GetServiceRequest)(nil),              // 2: navigator.frontend.v1alpha1.GetServiceRequest
-	(*GetServiceResponse)(nil),             // 3: navigator.frontend.v1alpha1.GetServiceResponse
-	(*GetServiceInstanceRequest)(nil),      // 4: navigator.frontend.v1alpha1.GetServiceInstanceRequest
-	(*GetServiceInstanceResponse)(nil),     // 5: navigator.frontend.v1alpha1.GetServiceInstanceResponse
-	(*Service)(nil),                        // 6: navigator.frontend.v1alpha1.Service
-	(*ServiceInstance)(nil),                // 7: navigator.frontend.v1alpha1.ServiceInstance
-	(*Container)(nil),                      // 8: navigator.frontend.v1alpha1.Container
-	(*ServiceInstanceDetail)(nil),          // 9: navigator.frontend.v1alpha1.ServiceInstanceDetail
-	(*GetProxyConfigRequest)(nil),          // 10: navigator.frontend.v1alpha1.GetProxyConfigRequest
-	(*GetProxyConfigResponse)(nil),         // 11: navigator.frontend.v1alpha1.GetProxyConfigResponse
-	(*GetIstioResourcesRequest)(nil),       // 12: navigator.frontend.v1alpha1.GetIstioResourcesRequest
-	(*GetIstioResourcesResponse)(nil),      // 13: navigator.frontend.v1alpha1.GetIstioResourcesResponse
-	nil,                                    // 14: navigator.frontend.v1alpha1.Service.ClusterIpsEntry
-	nil,                                    // 15: navigator.frontend.v1alpha1.Service.ExternalIpsEntry
-	nil,                                    // 16: navigator.frontend.v1alpha1.ServiceInstanceDetail.LabelsEntry
-	nil,                                    // 17: navigator.frontend.v1alpha1.ServiceInstanceDetail.AnnotationsEntry
-	(v1alpha1.ProxyMode)(0),                // 18: navigator.types.v1alpha1.ProxyMode
-	(*v1alpha1.ProxyConfig)(nil),           // 19: navigator.types.v1alpha1.ProxyConfig
-	(*v1alpha1.VirtualService)(nil),        // 20: navigator.types.v1alpha1.VirtualService
-	(*v1alpha1.DestinationRule)(nil),       // 21: navigator.types.v1alpha1.DestinationRule
-	(*v1alpha1.Gateway)(nil),               // 22: navigator.types.v1alpha1.Gateway
-	(*v1alpha1.Sidecar)(nil),               // 23: navigator.types.v1alpha1.Sidecar
-	(*v1alpha1.EnvoyFilter)(nil),           // 24: navigator.types.v1alpha1.EnvoyFilter
-	(*v1alpha1.RequestAuthentication)(nil), // 25: navigator.types.v1alpha1.RequestAuthentication
-	(*v1alpha1.PeerAuthentication)(nil),    // 26: navigator.types.v1alpha1.PeerAuthentication
-	(*v1alpha1.AuthorizationPolicy)(nil),   // 27: navigator.types.v1alpha1.AuthorizationPolicy
-	(*v1alpha1.WasmPlugin)(nil),            // 28: navigator.types.v1alpha1.WasmPlugin
-	(*v1alpha1.ServiceEntry)(nil),          // 29: navigator.types.v1alpha1.ServiceEntry
+	(InstanceHealth)(0),                    // 0: navigator.frontend.v1alpha1.InstanceHealth
+	(RawConfigFormat)(0),                   // 1: navigator.frontend.v1alpha1.RawConfigFormat
+	(*ListServicesRequest)(nil),            // 2: navigator.frontend.v1alpha1.ListServicesRequest
+	(*ListServicesResponse)(nil),           // 3: navigator.frontend.v1alpha1.ListServicesResponse
+	(*ListCanonicalServicesRequest)(nil),   // 4: navigator.frontend.v1alpha1.ListCanonicalServicesRequest
+	(*ListCanonicalServicesResponse)(nil),  // 5: navigator.frontend.v1alpha1.ListCanonicalServicesResponse
+	(*GetServiceRequest)(nil),              // 6: navigator.frontend.v1alpha1.GetServiceRequest
+	(*GetServiceResponse)(nil),             // 7: navigator.frontend.v1alpha1.GetServiceResponse
+	(*GetServiceInstanceRequest)(nil),      // 8: navigator.frontend.v1alpha1.GetServiceInstanceRequest
+	(*GetServiceInstanceResponse)(nil),     // 9: navigator.frontend.v1alpha1.GetServiceInstanceResponse
+	(*ListServiceInstancesRequest)(nil),    // 10: navigator.frontend.v1alpha1.ListServiceInstancesRequest
+	(*ListServiceInstancesResponse)(nil),   // 11: navigator.frontend.v1alpha1.ListServiceInstancesResponse
+	(*Service)(nil),                        // 12: navigator.frontend.v1alpha1.Service
+	(*CanonicalService)(nil),               // 13: navigator.frontend.v1alpha1.CanonicalService
+	(*ServiceInstance)(nil),                // 14: navigator.frontend.v1alpha1.ServiceInstance
+	(*Container)(nil),                      // 15: navigator.frontend.v1alpha1.Container
+	(*ServiceInstanceDetail)(nil),          // 16: navigator.frontend.v1alpha1.ServiceInstanceDetail
+	(*GetProxyConfigRequest)(nil),          // 17: navigator.frontend.v1alpha1.GetProxyConfigRequest
+	(*GetProxyConfigResponse)(nil),         // 18: navigator.frontend.v1alpha1.GetProxyConfigResponse
+	(*ProxyConfigFreshness)(nil),           // 19: navigator.frontend.v1alpha1.ProxyConfigFreshness
+	(*GetServiceProxyConfigsRequest)(nil),  // 20: navigator.frontend.v1alpha1.GetServiceProxyConfigsRequest
+	(*GetServiceProxyConfigsResponse)(nil), // 21: navigator.frontend.v1alpha1.GetServiceProxyConfigsResponse
+	(*InstanceProxyConfig)(nil),            // 22: navigator.frontend.v1alpha1.InstanceProxyConfig
+	(*GetProxyConfigDumpRequest)(nil),      // 23: navigator.frontend.v1alpha1.GetProxyConfigDumpRequest
+	(*GetIstioResourcesRequest)(nil),       // 24: navigator.frontend.v1alpha1.GetIstioResourcesRequest
+	(*GetIstioResourcesResponse)(nil),      // 25: navigator.frontend.v1alpha1.GetIstioResourcesResponse
+	(*GetInstanceLogsRequest)(nil),         // 26: navigator.frontend.v1alpha1.GetInstanceLogsRequest
+	(*GetInstanceLogsResponse)(nil),        // 27: navigator.frontend.v1alpha1.GetInstanceLogsResponse
+	(*GetEnvoyAdminRequest)(nil),           // 28: navigator.frontend.v1alpha1.GetEnvoyAdminRequest
+	(*GetEnvoyAdminResponse)(nil),          // 29: navigator.frontend.v1alpha1.GetEnvoyAdminResponse
+	(*ListIstioResourcesRequest)(nil),      // 30: navigator.frontend.v1alpha1.ListIstioResourcesRequest
+	(*ListIstioResourcesResponse)(nil),     // 31: navigator.frontend.v1alpha1.ListIstioResourcesResponse
+	(*IstioResource)(nil),                  // 32: navigator.frontend.v1alpha1.IstioResource
+	(*DownloadIstioResourcesRequest)(nil),  // 33: navigator.frontend.v1alpha1.DownloadIstioResourcesRequest
+	(*GetResourceInventoryRequest)(nil),    // 34: navigator.frontend.v1alpha1.GetResourceInventoryRequest
+	(*GetResourceInventoryResponse)(nil),   // 35: navigator.frontend.v1alpha1.GetResourceInventoryResponse
+	(*ClusterResourceInventory)(nil),       // 36: navigator.frontend.v1alpha1.ClusterResourceInventory
+	(*NamespaceResourceInventory)(nil),     // 37: navigator.frontend.v1alpha1.NamespaceResourceInventory
+	(*ResourceKindCount)(nil),              // 38: navigator.frontend.v1alpha1.ResourceKindCount
+	(*GetResourceReferencesRequest)(nil),   // 39: navigator.frontend.v1alpha1.GetResourceReferencesRequest
+	(*GetResourceReferencesResponse)(nil),  // 40: navigator.frontend.v1alpha1.GetResourceReferencesResponse
+	(*SimulateRouteRequest)(nil),           // 41: navigator.frontend.v1alpha1.SimulateRouteRequest
+	(*SimulateRouteResponse)(nil),          // 42: navigator.frontend.v1alpha1.SimulateRouteResponse
+	nil,                                    // 43: navigator.frontend.v1alpha1.Service.ClusterIpsEntry
+	nil,                                    // 44: navigator.frontend.v1alpha1.Service.ExternalIpsEntry
+	nil,                                    // 45: navigator.frontend.v1alpha1.Service.ZoneSpreadEntry
+	nil,                                    // 46: navigator.frontend.v1alpha1.Service.ExternalNamesEntry
+	nil,                                    // 47: navigator.frontend.v1alpha1.ServiceInstanceDetail.LabelsEntry
+	nil,                                    // 48: navigator.frontend.v1alpha1.ServiceInstanceDetail.AnnotationsEntry
+	nil,                                    // 49: navigator.frontend.v1alpha1.ServiceInstanceDetail.IstioAnnotationsEntry
+	nil,                                    // 50: navigator.frontend.v1alpha1.SimulateRouteRequest.HeadersEntry
+	(*v1alpha1.ClusterSyncMetadata)(nil),   // 51: navigator.types.v1alpha1.ClusterSyncMetadata
+	(v1alpha1.ProxyMode)(0),                // 52: navigator.types.v1alpha1.ProxyMode
+	(v1alpha1.PodLifecyclePhase)(0),        // 53: navigator.types.v1alpha1.PodLifecyclePhase
+	(*v1alpha1.Toleration)(nil),            // 54: navigator.types.v1alpha1.Toleration
+	(*v1alpha1.LocalityInfo)(nil),          // 55: navigator.types.v1alpha1.LocalityInfo
+	(*v1alpha1.ProxyConfig)(nil),           // 56: navigator.types.v1alpha1.ProxyConfig
+	(*v1alpha1.VirtualService)(nil),        // 57: navigator.types.v1alpha1.VirtualService
+	(*v1alpha1.DestinationRule)(nil),       // 58: navigator.types.v1alpha1.DestinationRule
+	(*v1alpha1.Gateway)(nil),               // 59: navigator.types.v1alpha1.Gateway
+	(*v1alpha1.Sidecar)(nil),               // 60: navigator.types.v1alpha1.Sidecar
+	(*v1alpha1.EnvoyFilter)(nil),           // 61: navigator.types.v1alpha1.EnvoyFilter
+	(*v1alpha1.RequestAuthentication)(nil), // 62: navigator.types.v1alpha1.RequestAuthentication
+	(*v1alpha1.PeerAuthentication)(nil),    // 63: navigator.types.v1alpha1.PeerAuthentication
+	(*v1alpha1.AuthorizationPolicy)(nil),   // 64: navigator.types.v1alpha1.AuthorizationPolicy
+	(*v1alpha1.WasmPlugin)(nil),            // 65: navigator.types.v1alpha1.WasmPlugin
+	(*v1alpha1.ServiceEntry)(nil),          // 66: navigator.types.v1alpha1.ServiceEntry
+	(*v1alpha1.ContainerLogs)(nil),         // 67: navigator.types.v1alpha1.ContainerLogs
+	(v1alpha1.IstioResourceKind)(0),        // 68: navigator.types.v1alpha1.IstioResourceKind
+	(*v1alpha1.ResourceRef)(nil),           // 69: navigator.types.v1alpha1.ResourceRef
+	(*v1alpha1.ResourceReference)(nil),     // 70: navigator.types.v1alpha1.ResourceReference
+	(*v1alpha1.RouteSimulationMatch)(nil),  // 71: navigator.types.v1alpha1.RouteSimulationMatch
+	(*httpbody.HttpBody)(nil),              // 72: google.api.HttpBody
 }
 var file_frontend_v1alpha1_service_registry_proto_depIdxs = []int32{
-	6,  // 0: navigator.frontend.v1alpha1.ListServicesResponse.services:type_name -> navigator.frontend.v1alpha1.Service
-	6,  // 1: navigator.frontend.v1alpha1.GetServiceResponse.service:type_name -> navigator.frontend.v1alpha1.Service
-	9,  // 2: navigator.frontend.v1alpha1.GetServiceInstanceResponse.instance:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail
-	7,  // 3: navigator.frontend.v1alpha1.Service.instances:type_name -> navigator.frontend.v1alpha1.ServiceInstance
-	14, // 4: navigator.frontend.v1alpha1.Service.cluster_ips:type_name -> navigator.frontend.v1alpha1.Service.ClusterIpsEntry
-	15, // 5: navigator.frontend.v1alpha1.Service.external_ips:type_name -> navigator.frontend.v1alpha1.Service.ExternalIpsEntry
-	18, // 6: navigator.frontend.v1alpha1.Service.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
-	8,  // 7: navigator.frontend.v1alpha1.ServiceInstanceDetail.containers:type_name -> navigator.frontend.v1alpha1.Container
-	16, // 8: navigator.frontend.v1alpha1.ServiceInstanceDetail.labels:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail.LabelsEntry
-	17, // 9: navigator.frontend.v1alpha1.ServiceInstanceDetail.annotations:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail.AnnotationsEntry
-	19, // 10: navigator.frontend.v1alpha1.GetProxyConfigResponse.proxy_config:type_name -> navigator.types.v1alpha1.ProxyConfig
-	20, // 11: navigator.frontend.v1alpha1.GetIstioResourcesResponse.virtual_services:type_name -> navigator.types.v1alpha1.VirtualService
-	21, // 12: navigator.frontend.v1alpha1.GetIstioResourcesResponse.destination_rules:type_name -> navigator.types.v1alpha1.DestinationRule
-	22, // 13: navigator.frontend.v1alpha1.GetIstioResourcesResponse.gateways:type_name -> navigator.types.v1alpha1.Gateway
-	23, // 14: navigator.frontend.v1alpha1.GetIstioResourcesResponse.sidecars:type_name -> navigator.types.v1alpha1.Sidecar
-	24, // 15: navigator.frontend.v1alpha1.GetIstioResourcesResponse.envoy_filters:type_name -> navigator.types.v1alpha1.EnvoyFilter
-	25, // 16: navigator.frontend.v1alpha1.GetIstioResourcesResponse.request_authentications:type_name -> navigator.types.v1alpha1.RequestAuthentication
-	26, // 17: navigator.frontend.v1alpha1.GetIstioResourcesResponse.peer_authentications:type_name -> navigator.types.v1alpha1.PeerAuthentication
-	27, // 18: navigator.frontend.v1alpha1.GetIstioResourcesResponse.authorization_policies:type_name -> navigator.types.v1alpha1.AuthorizationPolicy
-	28, // 19: navigator.frontend.v1alpha1.GetIstioResourcesResponse.wasm_plugins:type_name -> navigator.types.v1alpha1.WasmPlugin
-	29, // 20: navigator.frontend.v1alpha1.GetIstioResourcesResponse.service_entries:type_name -> navigator.types.v1alpha1.ServiceEntry
-	0,  // 21: navigator.frontend.v1alpha1.ServiceRegistryService.ListServices:input_type -> navigator.frontend.v1alpha1.ListServicesRequest
-	2,  // 22: navigator.frontend.v1alpha1.ServiceRegistryService.GetService:input_type -> navigator.frontend.v1alpha1.GetServiceRequest
-	4,  // 23: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceInstance:input_type -> navigator.frontend.v1alpha1.GetServiceInstanceRequest
-	10, // 24: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfig:input_type -> navigator.frontend.v1alpha1.GetProxyConfigRequest
-	12, // 25: navigator.frontend.v1alpha1.ServiceRegistryService.GetIstioResources:input_type -> navigator.frontend.v1alpha1.GetIstioResourcesRequest
-	1,  // 26: navigator.frontend.v1alpha1.ServiceRegistryService.ListServices:output_type -> navigator.frontend.v1alpha1.ListServicesResponse
-	3,  // 27: navigator.frontend.v1alpha1.ServiceRegistryService.GetService:output_type -> navigator.frontend.v1alpha1.GetServiceResponse
-	5,  // 28: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceInstance:output_type -> navigator.frontend.v1alpha1.GetServiceInstanceResponse
-	11, // 29: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfig:output_type -> navigator.frontend.v1alpha1.GetProxyConfigResponse
-	13, // 30: navigator.frontend.v1alpha1.ServiceRegistryService.GetIstioResources:output_type -> navigator.frontend.v1alpha1.GetIstioResourcesResponse
-	26, // [26:31] is the sub-list for method output_type
-	21, // [21:26] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	12, // 0: navigator.frontend.v1alpha1.ListServicesResponse.services:type_name -> navigator.frontend.v1alpha1.Service
+	51, // 1: navigator.frontend.v1alpha1.ListServicesResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	13, // 2: navigator.frontend.v1alpha1.ListCanonicalServicesResponse.canonical_services:type_name -> navigator.frontend.v1alpha1.CanonicalService
+	51, // 3: navigator.frontend.v1alpha1.ListCanonicalServicesResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	12, // 4: navigator.frontend.v1alpha1.GetServiceResponse.service:type_name -> navigator.frontend.v1alpha1.Service
+	51, // 5: navigator.frontend.v1alpha1.GetServiceResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	16, // 6: navigator.frontend.v1alpha1.GetServiceInstanceResponse.instance:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail
+	51, // 7: navigator.frontend.v1alpha1.GetServiceInstanceResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	0,  // 8: navigator.frontend.v1alpha1.ListServiceInstancesRequest.health:type_name -> navigator.frontend.v1alpha1.InstanceHealth
+	16, // 9: navigator.frontend.v1alpha1.ListServiceInstancesResponse.instances:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail
+	51, // 10: navigator.frontend.v1alpha1.ListServiceInstancesResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	14, // 11: navigator.frontend.v1alpha1.Service.instances:type_name -> navigator.frontend.v1alpha1.ServiceInstance
+	43, // 12: navigator.frontend.v1alpha1.Service.cluster_ips:type_name -> navigator.frontend.v1alpha1.Service.ClusterIpsEntry
+	44, // 13: navigator.frontend.v1alpha1.Service.external_ips:type_name -> navigator.frontend.v1alpha1.Service.ExternalIpsEntry
+	52, // 14: navigator.frontend.v1alpha1.Service.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
+	45, // 15: navigator.frontend.v1alpha1.Service.zone_spread:type_name -> navigator.frontend.v1alpha1.Service.ZoneSpreadEntry
+	46, // 16: navigator.frontend.v1alpha1.Service.external_names:type_name -> navigator.frontend.v1alpha1.Service.ExternalNamesEntry
+	53, // 17: navigator.frontend.v1alpha1.ServiceInstance.lifecycle_phase:type_name -> navigator.types.v1alpha1.PodLifecyclePhase
+	15, // 18: navigator.frontend.v1alpha1.ServiceInstanceDetail.containers:type_name -> navigator.frontend.v1alpha1.Container
+	47, // 19: navigator.frontend.v1alpha1.ServiceInstanceDetail.labels:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail.LabelsEntry
+	48, // 20: navigator.frontend.v1alpha1.ServiceInstanceDetail.annotations:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail.AnnotationsEntry
+	15, // 21: navigator.frontend.v1alpha1.ServiceInstanceDetail.init_containers:type_name -> navigator.frontend.v1alpha1.Container
+	49, // 22: navigator.frontend.v1alpha1.ServiceInstanceDetail.istio_annotations:type_name -> navigator.frontend.v1alpha1.ServiceInstanceDetail.IstioAnnotationsEntry
+	54, // 23: navigator.frontend.v1alpha1.ServiceInstanceDetail.tolerations:type_name -> navigator.types.v1alpha1.Toleration
+	55, // 24: navigator.frontend.v1alpha1.ServiceInstanceDetail.locality:type_name -> navigator.types.v1alpha1.LocalityInfo
+	53, // 25: navigator.frontend.v1alpha1.ServiceInstanceDetail.lifecycle_phase:type_name -> navigator.types.v1alpha1.PodLifecyclePhase
+	56, // 26: navigator.frontend.v1alpha1.GetProxyConfigResponse.proxy_config:type_name -> navigator.types.v1alpha1.ProxyConfig
+	51, // 27: navigator.frontend.v1alpha1.GetProxyConfigResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	19, // 28: navigator.frontend.v1alpha1.GetProxyConfigResponse.freshness:type_name -> navigator.frontend.v1alpha1.ProxyConfigFreshness
+	22, // 29: navigator.frontend.v1alpha1.GetServiceProxyConfigsResponse.instances:type_name -> navigator.frontend.v1alpha1.InstanceProxyConfig
+	56, // 30: navigator.frontend.v1alpha1.InstanceProxyConfig.proxy_config:type_name -> navigator.types.v1alpha1.ProxyConfig
+	19, // 31: navigator.frontend.v1alpha1.InstanceProxyConfig.freshness:type_name -> navigator.frontend.v1alpha1.ProxyConfigFreshness
+	57, // 32: navigator.frontend.v1alpha1.GetIstioResourcesResponse.virtual_services:type_name -> navigator.types.v1alpha1.VirtualService
+	58, // 33: navigator.frontend.v1alpha1.GetIstioResourcesResponse.destination_rules:type_name -> navigator.types.v1alpha1.DestinationRule
+	59, // 34: navigator.frontend.v1alpha1.GetIstioResourcesResponse.gateways:type_name -> navigator.types.v1alpha1.Gateway
+	60, // 35: navigator.frontend.v1alpha1.GetIstioResourcesResponse.sidecars:type_name -> navigator.types.v1alpha1.Sidecar
+	61, // 36: navigator.frontend.v1alpha1.GetIstioResourcesResponse.envoy_filters:type_name -> navigator.types.v1alpha1.EnvoyFilter
+	62, // 37: navigator.frontend.v1alpha1.GetIstioResourcesResponse.request_authentications:type_name -> navigator.types.v1alpha1.RequestAuthentication
+	63, // 38: navigator.frontend.v1alpha1.GetIstioResourcesResponse.peer_authentications:type_name -> navigator.types.v1alpha1.PeerAuthentication
+	64, // 39: navigator.frontend.v1alpha1.GetIstioResourcesResponse.authorization_policies:type_name -> navigator.types.v1alpha1.AuthorizationPolicy
+	65, // 40: navigator.frontend.v1alpha1.GetIstioResourcesResponse.wasm_plugins:type_name -> navigator.types.v1alpha1.WasmPlugin
+	66, // 41: navigator.frontend.v1alpha1.GetIstioResourcesResponse.service_entries:type_name -> navigator.types.v1alpha1.ServiceEntry
+	51, // 42: navigator.frontend.v1alpha1.GetIstioResourcesResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	67, // 43: navigator.frontend.v1alpha1.GetInstanceLogsResponse.logs:type_name -> navigator.types.v1alpha1.ContainerLogs
+	68, // 44: navigator.frontend.v1alpha1.ListIstioResourcesRequest.kinds:type_name -> navigator.types.v1alpha1.IstioResourceKind
+	1,  // 45: navigator.frontend.v1alpha1.ListIstioResourcesRequest.raw_config_format:type_name -> navigator.frontend.v1alpha1.RawConfigFormat
+	32, // 46: navigator.frontend.v1alpha1.ListIstioResourcesResponse.resources:type_name -> navigator.frontend.v1alpha1.IstioResource
+	51, // 47: navigator.frontend.v1alpha1.ListIstioResourcesResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	68, // 48: navigator.frontend.v1alpha1.IstioResource.kind:type_name -> navigator.types.v1alpha1.IstioResourceKind
+	68, // 49: navigator.frontend.v1alpha1.DownloadIstioResourcesRequest.kinds:type_name -> navigator.types.v1alpha1.IstioResourceKind
+	36, // 50: navigator.frontend.v1alpha1.GetResourceInventoryResponse.clusters:type_name -> navigator.frontend.v1alpha1.ClusterResourceInventory
+	38, // 51: navigator.frontend.v1alpha1.GetResourceInventoryResponse.counts:type_name -> navigator.frontend.v1alpha1.ResourceKindCount
+	51, // 52: navigator.frontend.v1alpha1.GetResourceInventoryResponse.sync_metadata:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata
+	37, // 53: navigator.frontend.v1alpha1.ClusterResourceInventory.namespaces:type_name -> navigator.frontend.v1alpha1.NamespaceResourceInventory
+	38, // 54: navigator.frontend.v1alpha1.ClusterResourceInventory.counts:type_name -> navigator.frontend.v1alpha1.ResourceKindCount
+	38, // 55: navigator.frontend.v1alpha1.NamespaceResourceInventory.counts:type_name -> navigator.frontend.v1alpha1.ResourceKindCount
+	68, // 56: navigator.frontend.v1alpha1.ResourceKindCount.kind:type_name -> navigator.types.v1alpha1.IstioResourceKind
+	69, // 57: navigator.frontend.v1alpha1.GetResourceReferencesResponse.resource:type_name -> navigator.types.v1alpha1.ResourceRef
+	70, // 58: navigator.frontend.v1alpha1.GetResourceReferencesResponse.uses:type_name -> navigator.types.v1alpha1.ResourceReference
+	70, // 59: navigator.frontend.v1alpha1.GetResourceReferencesResponse.used_by:type_name -> navigator.types.v1alpha1.ResourceReference
+	50, // 60: navigator.frontend.v1alpha1.SimulateRouteRequest.headers:type_name -> navigator.frontend.v1alpha1.SimulateRouteRequest.HeadersEntry
+	71, // 61: navigator.frontend.v1alpha1.SimulateRouteResponse.matches:type_name -> navigator.types.v1alpha1.RouteSimulationMatch
+	32, // 62: navigator.frontend.v1alpha1.SimulateRouteResponse.virtual_services:type_name -> navigator.frontend.v1alpha1.IstioResource
+	19, // 63: navigator.frontend.v1alpha1.SimulateRouteResponse.freshness:type_name -> navigator.frontend.v1alpha1.ProxyConfigFreshness
+	2,  // 64: navigator.frontend.v1alpha1.ServiceRegistryService.ListServices:input_type -> navigator.frontend.v1alpha1.ListServicesRequest
+	6,  // 65: navigator.frontend.v1alpha1.ServiceRegistryService.GetService:input_type -> navigator.frontend.v1alpha1.GetServiceRequest
+	4,  // 66: navigator.frontend.v1alpha1.ServiceRegistryService.ListCanonicalServices:input_type -> navigator.frontend.v1alpha1.ListCanonicalServicesRequest
+	8,  // 67: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceInstance:input_type -> navigator.frontend.v1alpha1.GetServiceInstanceRequest
+	10, // 68: navigator.frontend.v1alpha1.ServiceRegistryService.ListServiceInstances:input_type -> navigator.frontend.v1alpha1.ListServiceInstancesRequest
+	17, // 69: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfig:input_type -> navigator.frontend.v1alpha1.GetProxyConfigRequest
+	20, // 70: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceProxyConfigs:input_type -> navigator.frontend.v1alpha1.GetServiceProxyConfigsRequest
+	23, // 71: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfigDump:input_type -> navigator.frontend.v1alpha1.GetProxyConfigDumpRequest
+	24, // 72: navigator.frontend.v1alpha1.ServiceRegistryService.GetIstioResources:input_type -> navigator.frontend.v1alpha1.GetIstioResourcesRequest
+	26, // 73: navigator.frontend.v1alpha1.ServiceRegistryService.GetInstanceLogs:input_type -> navigator.frontend.v1alpha1.GetInstanceLogsRequest
+	28, // 74: navigator.frontend.v1alpha1.ServiceRegistryService.GetEnvoyAdmin:input_type -> navigator.frontend.v1alpha1.GetEnvoyAdminRequest
+	30, // 75: navigator.frontend.v1alpha1.ServiceRegistryService.ListIstioResources:input_type -> navigator.frontend.v1alpha1.ListIstioResourcesRequest
+	33, // 76: navigator.frontend.v1alpha1.ServiceRegistryService.DownloadIstioResources:input_type -> navigator.frontend.v1alpha1.DownloadIstioResourcesRequest
+	34, // 77: navigator.frontend.v1alpha1.ServiceRegistryService.GetResourceInventory:input_type -> navigator.frontend.v1alpha1.GetResourceInventoryRequest
+	39, // 78: navigator.frontend.v1alpha1.ServiceRegistryService.GetResourceReferences:input_type -> navigator.frontend.v1alpha1.GetResourceReferencesRequest
+	41, // 79: navigator.frontend.v1alpha1.ServiceRegistryService.SimulateRoute:input_type -> navigator.frontend.v1alpha1.SimulateRouteRequest
+	3,  // 80: navigator.frontend.v1alpha1.ServiceRegistryService.ListServices:output_type -> navigator.frontend.v1alpha1.ListServicesResponse
+	7,  // 81: navigator.frontend.v1alpha1.ServiceRegistryService.GetService:output_type -> navigator.frontend.v1alpha1.GetServiceResponse
+	5,  // 82: navigator.frontend.v1alpha1.ServiceRegistryService.ListCanonicalServices:output_type -> navigator.frontend.v1alpha1.ListCanonicalServicesResponse
+	9,  // 83: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceInstance:output_type -> navigator.frontend.v1alpha1.GetServiceInstanceResponse
+	11, // 84: navigator.frontend.v1alpha1.ServiceRegistryService.ListServiceInstances:output_type -> navigator.frontend.v1alpha1.ListServiceInstancesResponse
+	18, // 85: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfig:output_type -> navigator.frontend.v1alpha1.GetProxyConfigResponse
+	21, // 86: navigator.frontend.v1alpha1.ServiceRegistryService.GetServiceProxyConfigs:output_type -> navigator.frontend.v1alpha1.GetServiceProxyConfigsResponse
+	72, // 87: navigator.frontend.v1alpha1.ServiceRegistryService.GetProxyConfigDump:output_type -> google.api.HttpBody
+	25, // 88: navigator.frontend.v1alpha1.ServiceRegistryService.GetIstioResources:output_type -> navigator.frontend.v1alpha1.GetIstioResourcesResponse
+	27, // 89: navigator.frontend.v1alpha1.ServiceRegistryService.GetInstanceLogs:output_type -> navigator.frontend.v1alpha1.GetInstanceLogsResponse
+	29, // 90: navigator.frontend.v1alpha1.ServiceRegistryService.GetEnvoyAdmin:output_type -> navigator.frontend.v1alpha1.GetEnvoyAdminResponse
+	31, // 91: navigator.frontend.v1alpha1.ServiceRegistryService.ListIstioResources:output_type -> navigator.frontend.v1alpha1.ListIstioResourcesResponse
+	72, // 92: navigator.frontend.v1alpha1.ServiceRegistryService.DownloadIstioResources:output_type -> google.api.HttpBody
+	35, // 93: navigator.frontend.v1alpha1.ServiceRegistryService.GetResourceInventory:output_type -> navigator.frontend.v1alpha1.GetResourceInventoryResponse
+	40, // 94: navigator.frontend.v1alpha1.ServiceRegistryService.GetResourceReferences:output_type -> navigator.frontend.v1alpha1.GetResourceReferencesResponse
+	42, // 95: navigator.frontend.v1alpha1.ServiceRegistryService.SimulateRoute:output_type -> navigator.frontend.v1alpha1.SimulateRouteResponse
+	80, // [80:96] is the sub-list for method output_type
+	64, // [64:80] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_frontend_v1alpha1_service_registry_proto_init() }
@@ -1493,8 +4718,68 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ListServicesResponse); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ListServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCanonicalServicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCanonicalServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1505,8 +4790,8 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServiceRequest); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1517,8 +4802,8 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServiceResponse); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListServiceInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1529,8 +4814,8 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServiceInstanceRequest); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListServiceInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1541,8 +4826,8 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetServiceInstanceResponse); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Service); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1553,8 +4838,8 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*Service); i {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CanonicalService); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1565,7 +4850,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceInstance); i {
 			case 0:
 				return &v.state
@@ -1577,7 +4862,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*Container); i {
 			case 0:
 				return &v.state
@@ -1589,7 +4874,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceInstanceDetail); i {
 			case 0:
 				return &v.state
@@ -1601,7 +4886,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*GetProxyConfigRequest); i {
 			case 0:
 				return &v.state
@@ -1613,7 +4898,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*GetProxyConfigResponse); i {
 			case 0:
 				return &v.state
@@ -1625,7 +4910,67 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ProxyConfigFreshness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceProxyConfigsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceProxyConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceProxyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProxyConfigDumpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*GetIstioResourcesRequest); i {
 			case 0:
 				return &v.state
@@ -1637,7 +4982,7 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
-		file_frontend_v1alpha1_service_registry_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*GetIstioResourcesResponse); i {
 			case 0:
 				return &v.state
@@ -1649,20 +4994,240 @@ func file_frontend_v1alpha1_service_registry_proto_init() {
 				return nil
 			}
 		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInstanceLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInstanceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEnvoyAdminRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEnvoyAdminResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ListIstioResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListIstioResourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*IstioResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadIstioResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResourceInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResourceInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterResourceInventory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*NamespaceResourceInventory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceKindCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResourceReferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResourceReferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SimulateRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frontend_v1alpha1_service_registry_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*SimulateRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_frontend_v1alpha1_service_registry_proto_msgTypes[0].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[2].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[8].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[15].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[18].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[20].OneofWrappers = []any{
+		(*InstanceProxyConfig_ProxyConfig)(nil),
+		(*InstanceProxyConfig_ErrorMessage)(nil),
+	}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[21].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[24].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[26].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[28].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[31].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[32].OneofWrappers = []any{}
+	file_frontend_v1alpha1_service_registry_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_frontend_v1alpha1_service_registry_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      2,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_frontend_v1alpha1_service_registry_proto_goTypes,
 		DependencyIndexes: file_frontend_v1alpha1_service_registry_proto_depIdxs,
+		EnumInfos:         file_frontend_v1alpha1_service_registry_proto_enumTypes,
 		MessageInfos:      file_frontend_v1alpha1_service_registry_proto_msgTypes,
 	}.Build()
 	File_frontend_v1alpha1_service_registry_proto = out.File
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -33,11 +34,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ServiceRegistryService_ListServices_FullMethodName       = "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServices"
-	ServiceRegistryService_GetService_FullMethodName         = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetService"
-	ServiceRegistryService_GetServiceInstance_FullMethodName = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetServiceInstance"
-	ServiceRegistryService_GetProxyConfig_FullMethodName     = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetProxyConfig"
-	ServiceRegistryService_GetIstioResources_FullMethodName  = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetIstioResources"
+	ServiceRegistryService_ListServices_FullMethodName           = "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServices"
+	ServiceRegistryService_GetService_FullMethodName             = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetService"
+	ServiceRegistryService_ListCanonicalServices_FullMethodName  = "/navigator.frontend.v1alpha1.ServiceRegistryService/ListCanonicalServices"
+	ServiceRegistryService_GetServiceInstance_FullMethodName     = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetServiceInstance"
+	ServiceRegistryService_ListServiceInstances_FullMethodName   = "/navigator.frontend.v1alpha1.ServiceRegistryService/ListServiceInstances"
+	ServiceRegistryService_GetProxyConfig_FullMethodName         = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetProxyConfig"
+	ServiceRegistryService_GetServiceProxyConfigs_FullMethodName = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetServiceProxyConfigs"
+	ServiceRegistryService_GetProxyConfigDump_FullMethodName     = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetProxyConfigDump"
+	ServiceRegistryService_GetIstioResources_FullMethodName      = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetIstioResources"
+	ServiceRegistryService_GetInstanceLogs_FullMethodName        = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetInstanceLogs"
+	ServiceRegistryService_GetEnvoyAdmin_FullMethodName          = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetEnvoyAdmin"
+	ServiceRegistryService_ListIstioResources_FullMethodName     = "/navigator.frontend.v1alpha1.ServiceRegistryService/ListIstioResources"
+	ServiceRegistryService_DownloadIstioResources_FullMethodName = "/navigator.frontend.v1alpha1.ServiceRegistryService/DownloadIstioResources"
+	ServiceRegistryService_GetResourceInventory_FullMethodName   = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetResourceInventory"
+	ServiceRegistryService_GetResourceReferences_FullMethodName  = "/navigator.frontend.v1alpha1.ServiceRegistryService/GetResourceReferences"
+	ServiceRegistryService_SimulateRoute_FullMethodName          = "/navigator.frontend.v1alpha1.ServiceRegistryService/SimulateRoute"
 )
 
 // ServiceRegistryServiceClient is the client API for ServiceRegistryService service.
@@ -50,12 +62,47 @@ type ServiceRegistryServiceClient interface {
 	// GetService returns detailed information about a specific service.
 	// The service may have instances across multiple clusters.
 	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceResponse, error)
+	// ListCanonicalServices groups service instances by their Istio canonical service, which Istio's telemetry
+	// is keyed by, listing the Kubernetes services each canonical service backs.
+	ListCanonicalServices(ctx context.Context, in *ListCanonicalServicesRequest, opts ...grpc.CallOption) (*ListCanonicalServicesResponse, error)
 	// GetServiceInstance returns detailed information about a specific service instance.
 	GetServiceInstance(ctx context.Context, in *GetServiceInstanceRequest, opts ...grpc.CallOption) (*GetServiceInstanceResponse, error)
+	// ListServiceInstances lists service instances across all services and connected clusters.
+	// Results can be filtered by cluster, namespace, node, sidecar presence, labels and health, and are paginated.
+	ListServiceInstances(ctx context.Context, in *ListServiceInstancesRequest, opts ...grpc.CallOption) (*ListServiceInstancesResponse, error)
 	// GetProxyConfig retrieves the Envoy proxy configuration for a specific service instance.
 	GetProxyConfig(ctx context.Context, in *GetProxyConfigRequest, opts ...grpc.CallOption) (*GetProxyConfigResponse, error)
+	// GetServiceProxyConfigs retrieves the Envoy proxy configuration for every instance of a service in parallel,
+	// so configuration consistency across replicas can be checked in one call. Failures are reported per instance.
+	GetServiceProxyConfigs(ctx context.Context, in *GetServiceProxyConfigsRequest, opts ...grpc.CallOption) (*GetServiceProxyConfigsResponse, error)
+	// GetProxyConfigDump downloads the full, unsummarized Envoy config_dump JSON for a specific service instance,
+	// suitable for attaching to upstream bug reports. The response is served as a file attachment.
+	GetProxyConfigDump(ctx context.Context, in *GetProxyConfigDumpRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	// GetIstioResources retrieves the Istio configuration resources for a specific service instance.
 	GetIstioResources(ctx context.Context, in *GetIstioResourcesRequest, opts ...grpc.CallOption) (*GetIstioResourcesResponse, error)
+	// GetInstanceLogs retrieves container logs for a specific service instance through its cluster's edge.
+	GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (*GetInstanceLogsResponse, error)
+	// GetEnvoyAdmin queries an allowlisted, read-only Envoy admin endpoint (e.g. stats, config_dump)
+	// on a specific service instance's proxy and returns the raw output.
+	GetEnvoyAdmin(ctx context.Context, in *GetEnvoyAdminRequest, opts ...grpc.CallOption) (*GetEnvoyAdminResponse, error)
+	// ListIstioResources lists the Istio resources collected from connected clusters, independent of any workload.
+	// Results can be filtered by cluster, namespace and kind, and are paginated.
+	ListIstioResources(ctx context.Context, in *ListIstioResourcesRequest, opts ...grpc.CallOption) (*ListIstioResourcesResponse, error)
+	// DownloadIstioResources downloads the Istio resources matching the filters as a multi-document YAML file
+	// of cleaned, apply-able manifests. The response is served as a file attachment. Downloads larger than 64 MiB
+	// are rejected and must be narrowed by cluster, namespace or kind.
+	DownloadIstioResources(ctx context.Context, in *DownloadIstioResourcesRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// GetResourceInventory counts the Istio resources of each kind collected from connected clusters, per namespace
+	// per cluster and in total, without returning the resources themselves.
+	GetResourceInventory(ctx context.Context, in *GetResourceInventoryRequest, opts ...grpc.CallOption) (*GetResourceInventoryResponse, error)
+	// GetResourceReferences returns the references to and from a resource in its cluster's reference graph,
+	// linking VirtualServices to the Gateways they bind, VirtualServices and DestinationRules to the Services
+	// they target, VirtualServices and ServiceEntries to the ExternalName Services whose external hostname they
+	// declare, Services to their workloads, and Gateways and policies to the workloads they select.
+	GetResourceReferences(ctx context.Context, in *GetResourceReferencesRequest, opts ...grpc.CallOption) (*GetResourceReferencesResponse, error)
+	// SimulateRoute evaluates an HTTP request against a service instance's proxy routes and reports
+	// the route that would handle it, its destination clusters and the VirtualService that generated it.
+	SimulateRoute(ctx context.Context, in *SimulateRouteRequest, opts ...grpc.CallOption) (*SimulateRouteResponse, error)
 }
 
 type serviceRegistryServiceClient struct {
@@ -84,6 +131,15 @@ func (c *serviceRegistryServiceClient) GetService(ctx context.Context, in *GetSe
 	return out, nil
 }
 
+func (c *serviceRegistryServiceClient) ListCanonicalServices(ctx context.Context, in *ListCanonicalServicesRequest, opts ...grpc.CallOption) (*ListCanonicalServicesResponse, error) {
+	out := new(ListCanonicalServicesResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_ListCanonicalServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceRegistryServiceClient) GetServiceInstance(ctx context.Context, in *GetServiceInstanceRequest, opts ...grpc.CallOption) (*GetServiceInstanceResponse, error) {
 	out := new(GetServiceInstanceResponse)
 	err := c.cc.Invoke(ctx, ServiceRegistryService_GetServiceInstance_FullMethodName, in, out, opts...)
@@ -93,6 +149,15 @@ func (c *serviceRegistryServiceClient) GetServiceInstance(ctx context.Context, i
 	return out, nil
 }
 
+func (c *serviceRegistryServiceClient) ListServiceInstances(ctx context.Context, in *ListServiceInstancesRequest, opts ...grpc.CallOption) (*ListServiceInstancesResponse, error) {
+	out := new(ListServiceInstancesResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_ListServiceInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceRegistryServiceClient) GetProxyConfig(ctx context.Context, in *GetProxyConfigRequest, opts ...grpc.CallOption) (*GetProxyConfigResponse, error) {
 	out := new(GetProxyConfigResponse)
 	err := c.cc.Invoke(ctx, ServiceRegistryService_GetProxyConfig_FullMethodName, in, out, opts...)
@@ -102,6 +167,24 @@ func (c *serviceRegistryServiceClient) GetProxyConfig(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *serviceRegistryServiceClient) GetServiceProxyConfigs(ctx context.Context, in *GetServiceProxyConfigsRequest, opts ...grpc.CallOption) (*GetServiceProxyConfigsResponse, error) {
+	out := new(GetServiceProxyConfigsResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetServiceProxyConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) GetProxyConfigDump(ctx context.Context, in *GetProxyConfigDumpRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetProxyConfigDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceRegistryServiceClient) GetIstioResources(ctx context.Context, in *GetIstioResourcesRequest, opts ...grpc.CallOption) (*GetIstioResourcesResponse, error) {
 	out := new(GetIstioResourcesResponse)
 	err := c.cc.Invoke(ctx, ServiceRegistryService_GetIstioResources_FullMethodName, in, out, opts...)
@@ -111,6 +194,69 @@ func (c *serviceRegistryServiceClient) GetIstioResources(ctx context.Context, in
 	return out, nil
 }
 
+func (c *serviceRegistryServiceClient) GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (*GetInstanceLogsResponse, error) {
+	out := new(GetInstanceLogsResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetInstanceLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) GetEnvoyAdmin(ctx context.Context, in *GetEnvoyAdminRequest, opts ...grpc.CallOption) (*GetEnvoyAdminResponse, error) {
+	out := new(GetEnvoyAdminResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetEnvoyAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) ListIstioResources(ctx context.Context, in *ListIstioResourcesRequest, opts ...grpc.CallOption) (*ListIstioResourcesResponse, error) {
+	out := new(ListIstioResourcesResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_ListIstioResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) DownloadIstioResources(ctx context.Context, in *DownloadIstioResourcesRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_DownloadIstioResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) GetResourceInventory(ctx context.Context, in *GetResourceInventoryRequest, opts ...grpc.CallOption) (*GetResourceInventoryResponse, error) {
+	out := new(GetResourceInventoryResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetResourceInventory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) GetResourceReferences(ctx context.Context, in *GetResourceReferencesRequest, opts ...grpc.CallOption) (*GetResourceReferencesResponse, error) {
+	out := new(GetResourceReferencesResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_GetResourceReferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceRegistryServiceClient) SimulateRoute(ctx context.Context, in *SimulateRouteRequest, opts ...grpc.CallOption) (*SimulateRouteResponse, error) {
+	out := new(SimulateRouteResponse)
+	err := c.cc.Invoke(ctx, ServiceRegistryService_SimulateRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceRegistryServiceServer is the server API for ServiceRegistryService service.
 // All implementations must embed UnimplementedServiceRegistryServiceServer
 // for forward compatibility
@@ -121,12 +267,47 @@ type ServiceRegistryServiceServer interface {
 	// GetService returns detailed information about a specific service.
 	// The service may have instances across multiple clusters.
 	GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error)
+	// ListCanonicalServices groups service instances by their Istio canonical service, which Istio's telemetry
+	// is keyed by, listing the Kubernetes services each canonical service backs.
+	ListCanonicalServices(context.Context, *ListCanonicalServicesRequest) (*ListCanonicalServicesResponse, error)
 	// GetServiceInstance returns detailed information about a specific service instance.
 	GetServiceInstance(context.Context, *GetServiceInstanceRequest) (*GetServiceInstanceResponse, error)
+	// ListServiceInstances lists service instances across all services and connected clusters.
+	// Results can be filtered by cluster, namespace, node, sidecar presence, labels and health, and are paginated.
+	ListServiceInstances(context.Context, *ListServiceInstancesRequest) (*ListServiceInstancesResponse, error)
 	// GetProxyConfig retrieves the Envoy proxy configuration for a specific service instance.
 	GetProxyConfig(context.Context, *GetProxyConfigRequest) (*GetProxyConfigResponse, error)
+	// GetServiceProxyConfigs retrieves the Envoy proxy configuration for every instance of a service in parallel,
+	// so configuration consistency across replicas can be checked in one call. Failures are reported per instance.
+	GetServiceProxyConfigs(context.Context, *GetServiceProxyConfigsRequest) (*GetServiceProxyConfigsResponse, error)
+	// GetProxyConfigDump downloads the full, unsummarized Envoy config_dump JSON for a specific service instance,
+	// suitable for attaching to upstream bug reports. The response is served as a file attachment.
+	GetProxyConfigDump(context.Context, *GetProxyConfigDumpRequest) (*httpbody.HttpBody, error)
 	// GetIstioResources retrieves the Istio configuration resources for a specific service instance.
 	GetIstioResources(context.Context, *GetIstioResourcesRequest) (*GetIstioResourcesResponse, error)
+	// GetInstanceLogs retrieves container logs for a specific service instance through its cluster's edge.
+	GetInstanceLogs(context.Context, *GetInstanceLogsRequest) (*GetInstanceLogsResponse, error)
+	// GetEnvoyAdmin queries an allowlisted, read-only Envoy admin endpoint (e.g. stats, config_dump)
+	// on a specific service instance's proxy and returns the raw output.
+	GetEnvoyAdmin(context.Context, *GetEnvoyAdminRequest) (*GetEnvoyAdminResponse, error)
+	// ListIstioResources lists the Istio resources collected from connected clusters, independent of any workload.
+	// Results can be filtered by cluster, namespace and kind, and are paginated.
+	ListIstioResources(context.Context, *ListIstioResourcesRequest) (*ListIstioResourcesResponse, error)
+	// DownloadIstioResources downloads the Istio resources matching the filters as a multi-document YAML file
+	// of cleaned, apply-able manifests. The response is served as a file attachment. Downloads larger than 64 MiB
+	// are rejected and must be narrowed by cluster, namespace or kind.
+	DownloadIstioResources(context.Context, *DownloadIstioResourcesRequest) (*httpbody.HttpBody, error)
+	// GetResourceInventory counts the Istio resources of each kind collected from connected clusters, per namespace
+	// per cluster and in total, without returning the resources themselves.
+	GetResourceInventory(context.Context, *GetResourceInventoryRequest) (*GetResourceInventoryResponse, error)
+	// GetResourceReferences returns the references to and from a resource in its cluster's reference graph,
+	// linking VirtualServices to the Gateways they bind, VirtualServices and DestinationRules to the Services
+	// they target, VirtualServices and ServiceEntries to the ExternalName Services whose external hostname they
+	// declare, Services to their workloads, and Gateways and policies to the workloads they select.
+	GetResourceReferences(context.Context, *GetResourceReferencesRequest) (*GetResourceReferencesResponse, error)
+	// SimulateRoute evaluates an HTTP request against a service instance's proxy routes and reports
+	// the route that would handle it, its destination clusters and the VirtualService that generated it.
+	SimulateRoute(context.Context, *SimulateRouteRequest) (*SimulateRouteResponse, error)
 	mustEmbedUnimplementedServiceRegistryServiceServer()
 }
 
@@ -140,15 +321,48 @@ func (UnimplementedServiceRegistryServiceServer) ListServices(context.Context, *
 func (UnimplementedServiceRegistryServiceServer) GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
 }
+func (UnimplementedServiceRegistryServiceServer) ListCanonicalServices(context.Context, *ListCanonicalServicesRequest) (*ListCanonicalServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCanonicalServices not implemented")
+}
 func (UnimplementedServiceRegistryServiceServer) GetServiceInstance(context.Context, *GetServiceInstanceRequest) (*GetServiceInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceInstance not implemented")
 }
+func (UnimplementedServiceRegistryServiceServer) ListServiceInstances(context.Context, *ListServiceInstancesRequest) (*ListServiceInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceInstances not implemented")
+}
 func (UnimplementedServiceRegistryServiceServer) GetProxyConfig(context.Context, *GetProxyConfigRequest) (*GetProxyConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProxyConfig not implemented")
 }
+func (UnimplementedServiceRegistryServiceServer) GetServiceProxyConfigs(context.Context, *GetServiceProxyConfigsRequest) (*GetServiceProxyConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceProxyConfigs not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) GetProxyConfigDump(context.Context, *GetProxyConfigDumpRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProxyConfigDump not implemented")
+}
 func (UnimplementedServiceRegistryServiceServer) GetIstioResources(context.Context, *GetIstioResourcesRequest) (*GetIstioResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIstioResources not implemented")
 }
+func (UnimplementedServiceRegistryServiceServer) GetInstanceLogs(context.Context, *GetInstanceLogsRequest) (*GetInstanceLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceLogs not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) GetEnvoyAdmin(context.Context, *GetEnvoyAdminRequest) (*GetEnvoyAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvoyAdmin not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) ListIstioResources(context.Context, *ListIstioResourcesRequest) (*ListIstioResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIstioResources not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) DownloadIstioResources(context.Context, *DownloadIstioResourcesRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadIstioResources not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) GetResourceInventory(context.Context, *GetResourceInventoryRequest) (*GetResourceInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceInventory not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) GetResourceReferences(context.Context, *GetResourceReferencesRequest) (*GetResourceReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceReferences not implemented")
+}
+func (UnimplementedServiceRegistryServiceServer) SimulateRoute(context.Context, *SimulateRouteRequest) (*SimulateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRoute not implemented")
+}
 func (UnimplementedServiceRegistryServiceServer) mustEmbedUnimplementedServiceRegistryServiceServer() {
 }
 
@@ -199,6 +413,24 @@ func _ServiceRegistryService_GetService_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceRegistryService_ListCanonicalServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanonicalServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).ListCanonicalServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_ListCanonicalServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).ListCanonicalServices(ctx, req.(*ListCanonicalServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServiceRegistryService_GetServiceInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetServiceInstanceRequest)
 	if err := dec(in); err != nil {
@@ -217,6 +449,24 @@ func _ServiceRegistryService_GetServiceInstance_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceRegistryService_ListServiceInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).ListServiceInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_ListServiceInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).ListServiceInstances(ctx, req.(*ListServiceInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServiceRegistryService_GetProxyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProxyConfigRequest)
 	if err := dec(in); err != nil {
@@ -235,6 +485,42 @@ func _ServiceRegistryService_GetProxyConfig_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceRegistryService_GetServiceProxyConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceProxyConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetServiceProxyConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetServiceProxyConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetServiceProxyConfigs(ctx, req.(*GetServiceProxyConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_GetProxyConfigDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProxyConfigDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetProxyConfigDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetProxyConfigDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetProxyConfigDump(ctx, req.(*GetProxyConfigDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServiceRegistryService_GetIstioResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetIstioResourcesRequest)
 	if err := dec(in); err != nil {
@@ -253,6 +539,132 @@ func _ServiceRegistryService_GetIstioResources_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceRegistryService_GetInstanceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetInstanceLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetInstanceLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetInstanceLogs(ctx, req.(*GetInstanceLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_GetEnvoyAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvoyAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetEnvoyAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetEnvoyAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetEnvoyAdmin(ctx, req.(*GetEnvoyAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_ListIstioResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIstioResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).ListIstioResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_ListIstioResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).ListIstioResources(ctx, req.(*ListIstioResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_DownloadIstioResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadIstioResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).DownloadIstioResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_DownloadIstioResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).DownloadIstioResources(ctx, req.(*DownloadIstioResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_GetResourceInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetResourceInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetResourceInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetResourceInventory(ctx, req.(*GetResourceInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_GetResourceReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).GetResourceReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_GetResourceReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).GetResourceReferences(ctx, req.(*GetResourceReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceRegistryService_SimulateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceRegistryServiceServer).SimulateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceRegistryService_SimulateRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceRegistryServiceServer).SimulateRoute(ctx, req.(*SimulateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServiceRegistryService_ServiceDesc is the grpc.ServiceDesc for ServiceRegistryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,18 +680,62 @@ var ServiceRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetService",
 			Handler:    _ServiceRegistryService_GetService_Handler,
 		},
+		{
+			MethodName: "ListCanonicalServices",
+			Handler:    _ServiceRegistryService_ListCanonicalServices_Handler,
+		},
 		{
 			MethodName: "GetServiceInstance",
 			Handler:    _ServiceRegistryService_GetServiceInstance_Handler,
 		},
+		{
+			MethodName: "ListServiceInstances",
+			Handler:    _ServiceRegistryService_ListServiceInstances_Handler,
+		},
 		{
 			MethodName: "GetProxyConfig",
 			Handler:    _ServiceRegistryService_GetProxyConfig_Handler,
 		},
+		{
+			MethodName: "GetServiceProxyConfigs",
+			Handler:    _ServiceRegistryService_GetServiceProxyConfigs_Handler,
+		},
+		{
+			MethodName: "GetProxyConfigDump",
+			Handler:    _ServiceRegistryService_GetProxyConfigDump_Handler,
+		},
 		{
 			MethodName: "GetIstioResources",
 			Handler:    _ServiceRegistryService_GetIstioResources_Handler,
 		},
+		{
+			MethodName: "GetInstanceLogs",
+			Handler:    _ServiceRegistryService_GetInstanceLogs_Handler,
+		},
+		{
+			MethodName: "GetEnvoyAdmin",
+			Handler:    _ServiceRegistryService_GetEnvoyAdmin_Handler,
+		},
+		{
+			MethodName: "ListIstioResources",
+			Handler:    _ServiceRegistryService_ListIstioResources_Handler,
+		},
+		{
+			MethodName: "DownloadIstioResources",
+			Handler:    _ServiceRegistryService_DownloadIstioResources_Handler,
+		},
+		{
+			MethodName: "GetResourceInventory",
+			Handler:    _ServiceRegistryService_GetResourceInventory_Handler,
+		},
+		{
+			MethodName: "GetResourceReferences",
+			Handler:    _ServiceRegistryService_GetResourceReferences_Handler,
+		},
+		{
+			MethodName: "SimulateRoute",
+			Handler:    _ServiceRegistryService_SimulateRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "frontend/v1alpha1/service_registry.proto",
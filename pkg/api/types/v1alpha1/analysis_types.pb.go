@@ -0,0 +1,515 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: types/v1alpha1/analysis_types.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnalysisSeverity indicates how serious an analysis finding is.
+type AnalysisSeverity int32
+
+const (
+	AnalysisSeverity_ANALYSIS_SEVERITY_UNSPECIFIED AnalysisSeverity = 0
+	AnalysisSeverity_ANALYSIS_SEVERITY_INFO        AnalysisSeverity = 1 // The configuration is valid but may not behave as intended
+	AnalysisSeverity_ANALYSIS_SEVERITY_WARNING     AnalysisSeverity = 2 // The configuration has no effect
+	AnalysisSeverity_ANALYSIS_SEVERITY_ERROR       AnalysisSeverity = 3 // The configuration refers to something that does not exist
+)
+
+// Enum value maps for AnalysisSeverity.
+var (
+	AnalysisSeverity_name = map[int32]string{
+		0: "ANALYSIS_SEVERITY_UNSPECIFIED",
+		1: "ANALYSIS_SEVERITY_INFO",
+		2: "ANALYSIS_SEVERITY_WARNING",
+		3: "ANALYSIS_SEVERITY_ERROR",
+	}
+	AnalysisSeverity_value = map[string]int32{
+		"ANALYSIS_SEVERITY_UNSPECIFIED": 0,
+		"ANALYSIS_SEVERITY_INFO":        1,
+		"ANALYSIS_SEVERITY_WARNING":     2,
+		"ANALYSIS_SEVERITY_ERROR":       3,
+	}
+)
+
+func (x AnalysisSeverity) Enum() *AnalysisSeverity {
+	p := new(AnalysisSeverity)
+	*p = x
+	return p
+}
+
+func (x AnalysisSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalysisSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1alpha1_analysis_types_proto_enumTypes[0].Descriptor()
+}
+
+func (AnalysisSeverity) Type() protoreflect.EnumType {
+	return &file_types_v1alpha1_analysis_types_proto_enumTypes[0]
+}
+
+func (x AnalysisSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalysisSeverity.Descriptor instead.
+func (AnalysisSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1alpha1_analysis_types_proto_rawDescGZIP(), []int{0}
+}
+
+// AuthorizationVerdict is the outcome of evaluating AuthorizationPolicies against a traffic flow.
+type AuthorizationVerdict int32
+
+const (
+	AuthorizationVerdict_AUTHORIZATION_VERDICT_UNSPECIFIED   AuthorizationVerdict = 0
+	AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED       AuthorizationVerdict = 1 // Every request in the flow is allowed
+	AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE AuthorizationVerdict = 2 // Some requests may be denied, depending on attributes metrics do not record
+	AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED        AuthorizationVerdict = 3 // Every request in the flow is denied
+)
+
+// Enum value maps for AuthorizationVerdict.
+var (
+	AuthorizationVerdict_name = map[int32]string{
+		0: "AUTHORIZATION_VERDICT_UNSPECIFIED",
+		1: "AUTHORIZATION_VERDICT_ALLOWED",
+		2: "AUTHORIZATION_VERDICT_INDETERMINATE",
+		3: "AUTHORIZATION_VERDICT_DENIED",
+	}
+	AuthorizationVerdict_value = map[string]int32{
+		"AUTHORIZATION_VERDICT_UNSPECIFIED":   0,
+		"AUTHORIZATION_VERDICT_ALLOWED":       1,
+		"AUTHORIZATION_VERDICT_INDETERMINATE": 2,
+		"AUTHORIZATION_VERDICT_DENIED":        3,
+	}
+)
+
+func (x AuthorizationVerdict) Enum() *AuthorizationVerdict {
+	p := new(AuthorizationVerdict)
+	*p = x
+	return p
+}
+
+func (x AuthorizationVerdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthorizationVerdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1alpha1_analysis_types_proto_enumTypes[1].Descriptor()
+}
+
+func (AuthorizationVerdict) Type() protoreflect.EnumType {
+	return &file_types_v1alpha1_analysis_types_proto_enumTypes[1]
+}
+
+func (x AuthorizationVerdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthorizationVerdict.Descriptor instead.
+func (AuthorizationVerdict) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1alpha1_analysis_types_proto_rawDescGZIP(), []int{1}
+}
+
+// AnalysisFinding is a problem found while analyzing a cluster's configuration.
+type AnalysisFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code identifies the check that produced the finding, e.g. "GatewayNoWorkloads".
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// severity indicates how serious the finding is.
+	Severity AnalysisSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=navigator.types.v1alpha1.AnalysisSeverity" json:"severity,omitempty"`
+	// resource is the resource the finding is about.
+	Resource *ResourceRef `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// message describes the finding.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// related are other resources involved in the finding.
+	Related []*ResourceRef `protobuf:"bytes,5,rep,name=related,proto3" json:"related,omitempty"`
+}
+
+func (x *AnalysisFinding) Reset() {
+	*x = AnalysisFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_analysis_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisFinding) ProtoMessage() {}
+
+func (x *AnalysisFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_analysis_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisFinding.ProtoReflect.Descriptor instead.
+func (*AnalysisFinding) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_analysis_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalysisFinding) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AnalysisFinding) GetSeverity() AnalysisSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnalysisSeverity_ANALYSIS_SEVERITY_UNSPECIFIED
+}
+
+func (x *AnalysisFinding) GetResource() *ResourceRef {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *AnalysisFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AnalysisFinding) GetRelated() []*ResourceRef {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+// DeniedFlow is an observed traffic flow that a drafted AuthorizationPolicy would deny.
+type DeniedFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// source_cluster is the cluster name of the source service.
+	SourceCluster string `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	// source_namespace is the namespace of the source service.
+	SourceNamespace string `protobuf:"bytes,2,opt,name=source_namespace,json=sourceNamespace,proto3" json:"source_namespace,omitempty"`
+	// source_service is the service name of the source service.
+	SourceService string `protobuf:"bytes,3,opt,name=source_service,json=sourceService,proto3" json:"source_service,omitempty"`
+	// destination_namespace is the namespace of the destination service.
+	DestinationNamespace string `protobuf:"bytes,4,opt,name=destination_namespace,json=destinationNamespace,proto3" json:"destination_namespace,omitempty"`
+	// destination_service is the service name of the destination service.
+	DestinationService string `protobuf:"bytes,5,opt,name=destination_service,json=destinationService,proto3" json:"destination_service,omitempty"`
+	// request_rate is the observed request rate of the flow in requests per second.
+	RequestRate float64 `protobuf:"fixed64,6,opt,name=request_rate,json=requestRate,proto3" json:"request_rate,omitempty"`
+	// verdict is DENIED if every request would be denied, or INDETERMINATE if requests would be denied
+	// depending on attributes such as paths, methods or principals.
+	Verdict AuthorizationVerdict `protobuf:"varint,7,opt,name=verdict,proto3,enum=navigator.types.v1alpha1.AuthorizationVerdict" json:"verdict,omitempty"`
+	// reason explains which policy decides the verdict.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// workloads are the destination pods that would deny the flow.
+	Workloads []*ResourceRef `protobuf:"bytes,9,rep,name=workloads,proto3" json:"workloads,omitempty"`
+}
+
+func (x *DeniedFlow) Reset() {
+	*x = DeniedFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_analysis_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeniedFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeniedFlow) ProtoMessage() {}
+
+func (x *DeniedFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_analysis_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeniedFlow.ProtoReflect.Descriptor instead.
+func (*DeniedFlow) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_analysis_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeniedFlow) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetSourceNamespace() string {
+	if x != nil {
+		return x.SourceNamespace
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetSourceService() string {
+	if x != nil {
+		return x.SourceService
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetDestinationNamespace() string {
+	if x != nil {
+		return x.DestinationNamespace
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetDestinationService() string {
+	if x != nil {
+		return x.DestinationService
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetRequestRate() float64 {
+	if x != nil {
+		return x.RequestRate
+	}
+	return 0
+}
+
+func (x *DeniedFlow) GetVerdict() AuthorizationVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return AuthorizationVerdict_AUTHORIZATION_VERDICT_UNSPECIFIED
+}
+
+func (x *DeniedFlow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeniedFlow) GetWorkloads() []*ResourceRef {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+var File_types_v1alpha1_analysis_types_proto protoreflect.FileDescriptor
+
+var file_types_v1alpha1_analysis_types_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a,
+	0x24, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0xb5, 0x03, 0x0a, 0x0a, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x46, 0x6c,
+	0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66,
+	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2a, 0x8d, 0x01, 0x0a, 0x10,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x1d, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x53, 0x45, 0x56, 0x45,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x2a, 0xab, 0x01, 0x0a, 0x14,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41,
+	0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52,
+	0x44, 0x49, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27,
+	0x0a, 0x23, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x54, 0x45, 0x52, 0x4d,
+	0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54,
+	0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69,
+	0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_v1alpha1_analysis_types_proto_rawDescOnce sync.Once
+	file_types_v1alpha1_analysis_types_proto_rawDescData = file_types_v1alpha1_analysis_types_proto_rawDesc
+)
+
+func file_types_v1alpha1_analysis_types_proto_rawDescGZIP() []byte {
+	file_types_v1alpha1_analysis_types_proto_rawDescOnce.Do(func() {
+		file_types_v1alpha1_analysis_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_v1alpha1_analysis_types_proto_rawDescData)
+	})
+	return file_types_v1alpha1_analysis_types_proto_rawDescData
+}
+
+var file_types_v1alpha1_analysis_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_types_v1alpha1_analysis_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_types_v1alpha1_analysis_types_proto_goTypes = []any{
+	(AnalysisSeverity)(0),     // 0: navigator.types.v1alpha1.AnalysisSeverity
+	(AuthorizationVerdict)(0), // 1: navigator.types.v1alpha1.AuthorizationVerdict
+	(*AnalysisFinding)(nil),   // 2: navigator.types.v1alpha1.AnalysisFinding
+	(*DeniedFlow)(nil),        // 3: navigator.types.v1alpha1.DeniedFlow
+	(*ResourceRef)(nil),       // 4: navigator.types.v1alpha1.ResourceRef
+}
+var file_types_v1alpha1_analysis_types_proto_depIdxs = []int32{
+	0, // 0: navigator.types.v1alpha1.AnalysisFinding.severity:type_name -> navigator.types.v1alpha1.AnalysisSeverity
+	4, // 1: navigator.types.v1alpha1.AnalysisFinding.resource:type_name -> navigator.types.v1alpha1.ResourceRef
+	4, // 2: navigator.types.v1alpha1.AnalysisFinding.related:type_name -> navigator.types.v1alpha1.ResourceRef
+	1, // 3: navigator.types.v1alpha1.DeniedFlow.verdict:type_name -> navigator.types.v1alpha1.AuthorizationVerdict
+	4, // 4: navigator.types.v1alpha1.DeniedFlow.workloads:type_name -> navigator.types.v1alpha1.ResourceRef
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_types_v1alpha1_analysis_types_proto_init() }
+func file_types_v1alpha1_analysis_types_proto_init() {
+	if File_types_v1alpha1_analysis_types_proto != nil {
+		return
+	}
+	file_types_v1alpha1_istio_resources_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_types_v1alpha1_analysis_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalysisFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_analysis_types_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*DeniedFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_v1alpha1_analysis_types_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_v1alpha1_analysis_types_proto_goTypes,
+		DependencyIndexes: file_types_v1alpha1_analysis_types_proto_depIdxs,
+		EnumInfos:         file_types_v1alpha1_analysis_types_proto_enumTypes,
+		MessageInfos:      file_types_v1alpha1_analysis_types_proto_msgTypes,
+	}.Build()
+	File_types_v1alpha1_analysis_types_proto = out.File
+	file_types_v1alpha1_analysis_types_proto_rawDesc = nil
+	file_types_v1alpha1_analysis_types_proto_goTypes = nil
+	file_types_v1alpha1_analysis_types_proto_depIdxs = nil
+}
@@ -0,0 +1,1026 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: types/v1alpha1/cluster_types.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ClusterSyncMetadata describes the most recent state sync received from a cluster's edge.
+// It accompanies cluster-scoped API responses so consumers can tell how fresh the data is.
+type ClusterSyncMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_id identifies the cluster this metadata describes.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// last_sync_time is when the edge collected the most recent cluster state (RFC3339 format).
+	LastSyncTime string `protobuf:"bytes,2,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// sync_duration_ms is how long the edge took to collect the most recent cluster state, in milliseconds.
+	SyncDurationMs int64 `protobuf:"varint,3,opt,name=sync_duration_ms,json=syncDurationMs,proto3" json:"sync_duration_ms,omitempty"`
+	// resource_counts is the number of resources of each type in the most recent cluster state, keyed by resource type.
+	ResourceCounts map[string]int32 `protobuf:"bytes,4,rep,name=resource_counts,json=resourceCounts,proto3" json:"resource_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// edge_version is the version of the edge process syncing this cluster.
+	EdgeVersion string `protobuf:"bytes,5,opt,name=edge_version,json=edgeVersion,proto3" json:"edge_version,omitempty"`
+	// stale indicates the cluster's edges disconnected and its last state is still served until the
+	// manager's retention expires.
+	Stale bool `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`
+	// disconnected_at is when the last edge of a stale cluster disconnected (RFC3339 format).
+	DisconnectedAt string `protobuf:"bytes,7,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at,omitempty"`
+	// evicted indicates the cluster's edge is connected but its state exceeded the manager's maximum
+	// staleness, so its resources are left out until the edge syncs again.
+	Evicted bool `protobuf:"varint,8,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	// labels are the metadata labels configured for the cluster on its edge (e.g., region, env, tier).
+	Labels map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ClusterSyncMetadata) Reset() {
+	*x = ClusterSyncMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterSyncMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSyncMetadata) ProtoMessage() {}
+
+func (x *ClusterSyncMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSyncMetadata.ProtoReflect.Descriptor instead.
+func (*ClusterSyncMetadata) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClusterSyncMetadata) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ClusterSyncMetadata) GetLastSyncTime() string {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return ""
+}
+
+func (x *ClusterSyncMetadata) GetSyncDurationMs() int64 {
+	if x != nil {
+		return x.SyncDurationMs
+	}
+	return 0
+}
+
+func (x *ClusterSyncMetadata) GetResourceCounts() map[string]int32 {
+	if x != nil {
+		return x.ResourceCounts
+	}
+	return nil
+}
+
+func (x *ClusterSyncMetadata) GetEdgeVersion() string {
+	if x != nil {
+		return x.EdgeVersion
+	}
+	return ""
+}
+
+func (x *ClusterSyncMetadata) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *ClusterSyncMetadata) GetDisconnectedAt() string {
+	if x != nil {
+		return x.DisconnectedAt
+	}
+	return ""
+}
+
+func (x *ClusterSyncMetadata) GetEvicted() bool {
+	if x != nil {
+		return x.Evicted
+	}
+	return false
+}
+
+func (x *ClusterSyncMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// IstioCNIStatus describes the Istio CNI node agent of a cluster and how its sidecar pods set up traffic
+// redirection. A CNI agent that is not ready on a node leaves new pods on that node without redirection.
+type IstioCNIStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// installed indicates whether an istio-cni DaemonSet was found in the cluster.
+	Installed bool `protobuf:"varint,1,opt,name=installed,proto3" json:"installed,omitempty"`
+	// namespace is the namespace of the istio-cni DaemonSet.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the istio-cni DaemonSet.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the image tag of the CNI agent (e.g., "1.26.2").
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// desired_nodes is the number of nodes that should run the CNI agent.
+	DesiredNodes int32 `protobuf:"varint,5,opt,name=desired_nodes,json=desiredNodes,proto3" json:"desired_nodes,omitempty"`
+	// ready_nodes is the number of nodes whose CNI agent is ready.
+	ReadyNodes int32 `protobuf:"varint,6,opt,name=ready_nodes,json=readyNodes,proto3" json:"ready_nodes,omitempty"`
+	// updated_nodes is the number of nodes running the current DaemonSet template. It is lower than
+	// desired_nodes while a rollout is in progress.
+	UpdatedNodes int32 `protobuf:"varint,7,opt,name=updated_nodes,json=updatedNodes,proto3" json:"updated_nodes,omitempty"`
+	// nodes is the readiness of the CNI agent on each node it runs on, sorted by node name.
+	Nodes []*IstioCNINode `protobuf:"bytes,8,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// istio_init_pods is the number of pods that set up traffic redirection with the istio-init init container.
+	IstioInitPods int32 `protobuf:"varint,9,opt,name=istio_init_pods,json=istioInitPods,proto3" json:"istio_init_pods,omitempty"`
+	// cni_pods is the number of pods whose traffic redirection is set up by the CNI agent, which run the
+	// istio-validation init container instead of istio-init.
+	CniPods int32 `protobuf:"varint,10,opt,name=cni_pods,json=cniPods,proto3" json:"cni_pods,omitempty"`
+	// relies_on_istio_init indicates whether any pods still set up traffic redirection with istio-init.
+	ReliesOnIstioInit bool `protobuf:"varint,11,opt,name=relies_on_istio_init,json=reliesOnIstioInit,proto3" json:"relies_on_istio_init,omitempty"`
+}
+
+func (x *IstioCNIStatus) Reset() {
+	*x = IstioCNIStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstioCNIStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstioCNIStatus) ProtoMessage() {}
+
+func (x *IstioCNIStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstioCNIStatus.ProtoReflect.Descriptor instead.
+func (*IstioCNIStatus) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IstioCNIStatus) GetInstalled() bool {
+	if x != nil {
+		return x.Installed
+	}
+	return false
+}
+
+func (x *IstioCNIStatus) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *IstioCNIStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IstioCNIStatus) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *IstioCNIStatus) GetDesiredNodes() int32 {
+	if x != nil {
+		return x.DesiredNodes
+	}
+	return 0
+}
+
+func (x *IstioCNIStatus) GetReadyNodes() int32 {
+	if x != nil {
+		return x.ReadyNodes
+	}
+	return 0
+}
+
+func (x *IstioCNIStatus) GetUpdatedNodes() int32 {
+	if x != nil {
+		return x.UpdatedNodes
+	}
+	return 0
+}
+
+func (x *IstioCNIStatus) GetNodes() []*IstioCNINode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *IstioCNIStatus) GetIstioInitPods() int32 {
+	if x != nil {
+		return x.IstioInitPods
+	}
+	return 0
+}
+
+func (x *IstioCNIStatus) GetCniPods() int32 {
+	if x != nil {
+		return x.CniPods
+	}
+	return 0
+}
+
+func (x *IstioCNIStatus) GetReliesOnIstioInit() bool {
+	if x != nil {
+		return x.ReliesOnIstioInit
+	}
+	return false
+}
+
+// IstioCNINode is the CNI agent running on one node.
+type IstioCNINode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node_name is the name of the node.
+	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// pod_name is the name of the CNI agent pod on the node.
+	PodName string `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// ready indicates whether the CNI agent pod is ready.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	// restart_count is the number of times the CNI agent container has restarted.
+	RestartCount int32 `protobuf:"varint,4,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// version is the image tag the CNI agent pod runs, which differs from the DaemonSet's during a rollout.
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *IstioCNINode) Reset() {
+	*x = IstioCNINode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IstioCNINode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IstioCNINode) ProtoMessage() {}
+
+func (x *IstioCNINode) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IstioCNINode.ProtoReflect.Descriptor instead.
+func (*IstioCNINode) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IstioCNINode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *IstioCNINode) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *IstioCNINode) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *IstioCNINode) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *IstioCNINode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// SidecarInjectionStatus describes the sidecar injection webhooks of a cluster and which control plane
+// revision injects the pods of each namespace.
+type SidecarInjectionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// webhooks are the sidecar injection webhooks of the cluster's MutatingWebhookConfigurations, sorted by
+	// configuration and webhook name.
+	Webhooks []*InjectionWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// namespaces is the injection of each collected namespace, sorted by namespace.
+	Namespaces []*NamespaceInjection `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *SidecarInjectionStatus) Reset() {
+	*x = SidecarInjectionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidecarInjectionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarInjectionStatus) ProtoMessage() {}
+
+func (x *SidecarInjectionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarInjectionStatus.ProtoReflect.Descriptor instead.
+func (*SidecarInjectionStatus) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SidecarInjectionStatus) GetWebhooks() []*InjectionWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *SidecarInjectionStatus) GetNamespaces() []*NamespaceInjection {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+// InjectionWebhook is one sidecar injection webhook of a MutatingWebhookConfiguration.
+type InjectionWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// configuration is the name of the MutatingWebhookConfiguration.
+	Configuration string `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// name is the name of the webhook (e.g., "rev.namespace.sidecar-injector.istio.io").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// revision is the control plane revision the webhook calls, from the configuration's istio.io/rev label.
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// tag is the revision tag the configuration implements, from its istio.io/tag label. Empty for the
+	// webhooks installed with a revision.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// namespace_selector is the webhook's namespace selector in label selector syntax. Empty selects every
+	// namespace.
+	NamespaceSelector string `protobuf:"bytes,5,opt,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty"`
+	// object_selector is the webhook's object selector in label selector syntax. Empty selects every pod.
+	ObjectSelector string `protobuf:"bytes,6,opt,name=object_selector,json=objectSelector,proto3" json:"object_selector,omitempty"`
+	// service is the istiod service the webhook calls as "name.namespace", or its URL.
+	Service string `protobuf:"bytes,7,opt,name=service,proto3" json:"service,omitempty"`
+	// opt_in indicates whether the object selector requires pod labels, so the webhook only injects pods
+	// that opt in rather than every pod of the namespaces it selects.
+	OptIn bool `protobuf:"varint,8,opt,name=opt_in,json=optIn,proto3" json:"opt_in,omitempty"`
+}
+
+func (x *InjectionWebhook) Reset() {
+	*x = InjectionWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InjectionWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionWebhook) ProtoMessage() {}
+
+func (x *InjectionWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectionWebhook.ProtoReflect.Descriptor instead.
+func (*InjectionWebhook) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InjectionWebhook) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetNamespaceSelector() string {
+	if x != nil {
+		return x.NamespaceSelector
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetObjectSelector() string {
+	if x != nil {
+		return x.ObjectSelector
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *InjectionWebhook) GetOptIn() bool {
+	if x != nil {
+		return x.OptIn
+	}
+	return false
+}
+
+// NamespaceInjection explains which control plane revision injects the pods of a namespace.
+type NamespaceInjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace is the name of the namespace.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// labels are the namespace's injection labels (istio-injection and istio.io/rev).
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// revision is the revision injecting every pod of the namespace. Empty when no webhook injects the
+	// namespace's pods without them opting in.
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// webhooks are the webhooks whose namespace selector matches the namespace, as "configuration/name".
+	Webhooks []string `protobuf:"bytes,4,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// opt_in_revisions are the revisions that only inject the namespace's pods labelled to opt in.
+	OptInRevisions []string `protobuf:"bytes,5,rep,name=opt_in_revisions,json=optInRevisions,proto3" json:"opt_in_revisions,omitempty"`
+	// conflict indicates whether webhooks of several revisions inject every pod of the namespace, so pods
+	// are injected more than once.
+	Conflict bool `protobuf:"varint,6,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	// explanation describes in a sentence why the namespace is injected by its revision, or not at all.
+	Explanation string `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *NamespaceInjection) Reset() {
+	*x = NamespaceInjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceInjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceInjection) ProtoMessage() {}
+
+func (x *NamespaceInjection) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceInjection.ProtoReflect.Descriptor instead.
+func (*NamespaceInjection) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NamespaceInjection) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceInjection) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NamespaceInjection) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *NamespaceInjection) GetWebhooks() []string {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *NamespaceInjection) GetOptInRevisions() []string {
+	if x != nil {
+		return x.OptInRevisions
+	}
+	return nil
+}
+
+func (x *NamespaceInjection) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+func (x *NamespaceInjection) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// CapabilityGap is a feature the manager supports that an edge of a cluster does not, typically because the
+// edge runs an older version.
+type CapabilityGap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// capability is the missing feature: "protocol", "api_version", "resource_type", "request", "delta_sync",
+	// "compression", "chunked_cluster_state", "streamed_cluster_state" or "capabilities_update".
+	Capability string `protobuf:"bytes,1,opt,name=capability,proto3" json:"capability,omitempty"`
+	// value identifies what of the capability is missing, e.g. the resource type or compression algorithm.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// message explains the consequence of the gap.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CapabilityGap) Reset() {
+	*x = CapabilityGap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilityGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityGap) ProtoMessage() {}
+
+func (x *CapabilityGap) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_cluster_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityGap.ProtoReflect.Descriptor instead.
+func (*CapabilityGap) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_cluster_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CapabilityGap) GetCapability() string {
+	if x != nil {
+		return x.Capability
+	}
+	return ""
+}
+
+func (x *CapabilityGap) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CapabilityGap) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_types_v1alpha1_cluster_types_proto protoreflect.FileDescriptor
+
+var file_types_v1alpha1_cluster_types_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xbd,
+	0x04, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x6a, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x51, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97,
+	0x03, 0x0a, 0x0e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x4e, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x43, 0x4e, 0x49, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x69, 0x6e,
+	0x69, 0x74, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69,
+	0x73, 0x74, 0x69, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6e, 0x69, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x63, 0x6e, 0x69, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x69, 0x65,
+	0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x69, 0x65, 0x73, 0x4f, 0x6e, 0x49,
+	0x73, 0x74, 0x69, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x43, 0x4e, 0x49, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x46, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x5f, 0x69, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x22, 0xdf, 0x02,
+	0x0a, 0x12, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x6f, 0x70, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x5f, 0x0a, 0x0d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x61, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_types_v1alpha1_cluster_types_proto_rawDescOnce sync.Once
+	file_types_v1alpha1_cluster_types_proto_rawDescData = file_types_v1alpha1_cluster_types_proto_rawDesc
+)
+
+func file_types_v1alpha1_cluster_types_proto_rawDescGZIP() []byte {
+	file_types_v1alpha1_cluster_types_proto_rawDescOnce.Do(func() {
+		file_types_v1alpha1_cluster_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_v1alpha1_cluster_types_proto_rawDescData)
+	})
+	return file_types_v1alpha1_cluster_types_proto_rawDescData
+}
+
+var file_types_v1alpha1_cluster_types_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_types_v1alpha1_cluster_types_proto_goTypes = []any{
+	(*ClusterSyncMetadata)(nil),    // 0: navigator.types.v1alpha1.ClusterSyncMetadata
+	(*IstioCNIStatus)(nil),         // 1: navigator.types.v1alpha1.IstioCNIStatus
+	(*IstioCNINode)(nil),           // 2: navigator.types.v1alpha1.IstioCNINode
+	(*SidecarInjectionStatus)(nil), // 3: navigator.types.v1alpha1.SidecarInjectionStatus
+	(*InjectionWebhook)(nil),       // 4: navigator.types.v1alpha1.InjectionWebhook
+	(*NamespaceInjection)(nil),     // 5: navigator.types.v1alpha1.NamespaceInjection
+	(*CapabilityGap)(nil),          // 6: navigator.types.v1alpha1.CapabilityGap
+	nil,                            // 7: navigator.types.v1alpha1.ClusterSyncMetadata.ResourceCountsEntry
+	nil,                            // 8: navigator.types.v1alpha1.ClusterSyncMetadata.LabelsEntry
+	nil,                            // 9: navigator.types.v1alpha1.NamespaceInjection.LabelsEntry
+}
+var file_types_v1alpha1_cluster_types_proto_depIdxs = []int32{
+	7, // 0: navigator.types.v1alpha1.ClusterSyncMetadata.resource_counts:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata.ResourceCountsEntry
+	8, // 1: navigator.types.v1alpha1.ClusterSyncMetadata.labels:type_name -> navigator.types.v1alpha1.ClusterSyncMetadata.LabelsEntry
+	2, // 2: navigator.types.v1alpha1.IstioCNIStatus.nodes:type_name -> navigator.types.v1alpha1.IstioCNINode
+	4, // 3: navigator.types.v1alpha1.SidecarInjectionStatus.webhooks:type_name -> navigator.types.v1alpha1.InjectionWebhook
+	5, // 4: navigator.types.v1alpha1.SidecarInjectionStatus.namespaces:type_name -> navigator.types.v1alpha1.NamespaceInjection
+	9, // 5: navigator.types.v1alpha1.NamespaceInjection.labels:type_name -> navigator.types.v1alpha1.NamespaceInjection.LabelsEntry
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_types_v1alpha1_cluster_types_proto_init() }
+func file_types_v1alpha1_cluster_types_proto_init() {
+	if File_types_v1alpha1_cluster_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_v1alpha1_cluster_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterSyncMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*IstioCNIStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*IstioCNINode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SidecarInjectionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*InjectionWebhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*NamespaceInjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_cluster_types_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CapabilityGap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_v1alpha1_cluster_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_v1alpha1_cluster_types_proto_goTypes,
+		DependencyIndexes: file_types_v1alpha1_cluster_types_proto_depIdxs,
+		MessageInfos:      file_types_v1alpha1_cluster_types_proto_msgTypes,
+	}.Build()
+	File_types_v1alpha1_cluster_types_proto = out.File
+	file_types_v1alpha1_cluster_types_proto_rawDesc = nil
+	file_types_v1alpha1_cluster_types_proto_goTypes = nil
+	file_types_v1alpha1_cluster_types_proto_depIdxs = nil
+}
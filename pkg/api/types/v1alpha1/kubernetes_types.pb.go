@@ -95,6 +95,226 @@ func (ServiceType) EnumDescriptor() ([]byte, []int) {
 	return file_types_v1alpha1_kubernetes_types_proto_rawDescGZIP(), []int{0}
 }
 
+// PodLifecyclePhase is where a pod is in its lifecycle: its Kubernetes phase, or terminating once it has
+// been deleted.
+type PodLifecyclePhase int32
+
+const (
+	// POD_LIFECYCLE_PHASE_UNSPECIFIED indicates the phase is unknown.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_UNSPECIFIED PodLifecyclePhase = 0
+	// POD_LIFECYCLE_PHASE_PENDING indicates the pod is scheduled or starting but not all of its containers run.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_PENDING PodLifecyclePhase = 1
+	// POD_LIFECYCLE_PHASE_RUNNING indicates the pod's containers have started.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_RUNNING PodLifecyclePhase = 2
+	// POD_LIFECYCLE_PHASE_TERMINATING indicates the pod has been deleted and is shutting down, e.g. when it
+	// is replaced during a rolling update.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_TERMINATING PodLifecyclePhase = 3
+	// POD_LIFECYCLE_PHASE_SUCCEEDED indicates all of the pod's containers exited successfully.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_SUCCEEDED PodLifecyclePhase = 4
+	// POD_LIFECYCLE_PHASE_FAILED indicates the pod's containers exited and at least one failed.
+	PodLifecyclePhase_POD_LIFECYCLE_PHASE_FAILED PodLifecyclePhase = 5
+)
+
+// Enum value maps for PodLifecyclePhase.
+var (
+	PodLifecyclePhase_name = map[int32]string{
+		0: "POD_LIFECYCLE_PHASE_UNSPECIFIED",
+		1: "POD_LIFECYCLE_PHASE_PENDING",
+		2: "POD_LIFECYCLE_PHASE_RUNNING",
+		3: "POD_LIFECYCLE_PHASE_TERMINATING",
+		4: "POD_LIFECYCLE_PHASE_SUCCEEDED",
+		5: "POD_LIFECYCLE_PHASE_FAILED",
+	}
+	PodLifecyclePhase_value = map[string]int32{
+		"POD_LIFECYCLE_PHASE_UNSPECIFIED": 0,
+		"POD_LIFECYCLE_PHASE_PENDING":     1,
+		"POD_LIFECYCLE_PHASE_RUNNING":     2,
+		"POD_LIFECYCLE_PHASE_TERMINATING": 3,
+		"POD_LIFECYCLE_PHASE_SUCCEEDED":   4,
+		"POD_LIFECYCLE_PHASE_FAILED":      5,
+	}
+)
+
+func (x PodLifecyclePhase) Enum() *PodLifecyclePhase {
+	p := new(PodLifecyclePhase)
+	*p = x
+	return p
+}
+
+func (x PodLifecyclePhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PodLifecyclePhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1alpha1_kubernetes_types_proto_enumTypes[1].Descriptor()
+}
+
+func (PodLifecyclePhase) Type() protoreflect.EnumType {
+	return &file_types_v1alpha1_kubernetes_types_proto_enumTypes[1]
+}
+
+func (x PodLifecyclePhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PodLifecyclePhase.Descriptor instead.
+func (PodLifecyclePhase) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1alpha1_kubernetes_types_proto_rawDescGZIP(), []int{1}
+}
+
+// ContainerLogs contains log output retrieved from a single container.
+type ContainerLogs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// container is the name of the container the logs were retrieved from.
+	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	// logs is the raw log output, oldest line first.
+	Logs string `protobuf:"bytes,2,opt,name=logs,proto3" json:"logs,omitempty"`
+	// truncated indicates the output was cut short because it exceeded the size limit.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *ContainerLogs) Reset() {
+	*x = ContainerLogs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_kubernetes_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogs) ProtoMessage() {}
+
+func (x *ContainerLogs) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_kubernetes_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogs.ProtoReflect.Descriptor instead.
+func (*ContainerLogs) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_kubernetes_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContainerLogs) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *ContainerLogs) GetLogs() string {
+	if x != nil {
+		return x.Logs
+	}
+	return ""
+}
+
+func (x *ContainerLogs) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Toleration allows a pod to be scheduled onto nodes with a matching taint.
+type Toleration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the taint key the toleration applies to. Empty matches all keys.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// operator is the relationship between the key and the value: "Exists" or "Equal".
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// value is the taint value the toleration matches when the operator is "Equal".
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// effect is the taint effect to match: "NoSchedule", "PreferNoSchedule" or "NoExecute". Empty matches all effects.
+	Effect string `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+	// toleration_seconds is how long a NoExecute taint is tolerated before the pod is evicted.
+	// Unset tolerates the taint forever.
+	TolerationSeconds *int64 `protobuf:"varint,5,opt,name=toleration_seconds,json=tolerationSeconds,proto3,oneof" json:"toleration_seconds,omitempty"`
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_kubernetes_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_kubernetes_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_kubernetes_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Toleration) GetTolerationSeconds() int64 {
+	if x != nil && x.TolerationSeconds != nil {
+		return *x.TolerationSeconds
+	}
+	return 0
+}
+
 var File_types_v1alpha1_kubernetes_types_proto protoreflect.FileDescriptor
 
 var file_types_v1alpha1_kubernetes_types_proto_rawDesc = []byte{
@@ -102,18 +322,50 @@ var file_types_v1alpha1_kubernetes_types_proto_rawDesc = []byte{
 	0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2a, 0x70, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x50, 0x10, 0x01, 0x12, 0x0d,
-	0x0a, 0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a,
-	0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x03,
-	0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x10, 0x04, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x22, 0x5f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a,
+	0x12, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2a, 0x70, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x49, 0x50, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x2a, 0xe2, 0x01, 0x0a, 0x11, 0x50,
+	0x6f, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4f, 0x44, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c,
+	0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4f, 0x44, 0x5f, 0x4c, 0x49, 0x46,
+	0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4f, 0x44, 0x5f, 0x4c, 0x49,
+	0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4f, 0x44, 0x5f, 0x4c,
+	0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x54,
+	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d,
+	0x50, 0x4f, 0x44, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x44, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45,
+	0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42,
+	0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
+	0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -128,9 +380,13 @@ func file_types_v1alpha1_kubernetes_types_proto_rawDescGZIP() []byte {
 	return file_types_v1alpha1_kubernetes_types_proto_rawDescData
 }
 
-var file_types_v1alpha1_kubernetes_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_v1alpha1_kubernetes_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_types_v1alpha1_kubernetes_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_types_v1alpha1_kubernetes_types_proto_goTypes = []any{
-	(ServiceType)(0), // 0: navigator.types.v1alpha1.ServiceType
+	(ServiceType)(0),       // 0: navigator.types.v1alpha1.ServiceType
+	(PodLifecyclePhase)(0), // 1: navigator.types.v1alpha1.PodLifecyclePhase
+	(*ContainerLogs)(nil),  // 2: navigator.types.v1alpha1.ContainerLogs
+	(*Toleration)(nil),     // 3: navigator.types.v1alpha1.Toleration
 }
 var file_types_v1alpha1_kubernetes_types_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -145,19 +401,47 @@ func file_types_v1alpha1_kubernetes_types_proto_init() {
 	if File_types_v1alpha1_kubernetes_types_proto != nil {
 		return
 	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_v1alpha1_kubernetes_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ContainerLogs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_kubernetes_types_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Toleration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_v1alpha1_kubernetes_types_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v1alpha1_kubernetes_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   0,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_types_v1alpha1_kubernetes_types_proto_goTypes,
 		DependencyIndexes: file_types_v1alpha1_kubernetes_types_proto_depIdxs,
 		EnumInfos:         file_types_v1alpha1_kubernetes_types_proto_enumTypes,
+		MessageInfos:      file_types_v1alpha1_kubernetes_types_proto_msgTypes,
 	}.Build()
 	File_types_v1alpha1_kubernetes_types_proto = out.File
 	file_types_v1alpha1_kubernetes_types_proto_rawDesc = nil
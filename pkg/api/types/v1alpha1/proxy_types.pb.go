@@ -400,6 +400,61 @@ func (AddressType) EnumDescriptor() ([]byte, []int) {
 	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{5}
 }
 
+// ProtocolDetection identifies how a listener selects the application protocol of a port
+type ProtocolDetection int32
+
+const (
+	// UNKNOWN_PROTOCOL_DETECTION indicates the listener serves no recognizable filter chains for the port
+	ProtocolDetection_UNKNOWN_PROTOCOL_DETECTION ProtocolDetection = 0
+	// EXPLICIT indicates the protocol was declared by the service port's name or appProtocol, so every
+	// filter chain for the port serves the same protocol
+	ProtocolDetection_EXPLICIT ProtocolDetection = 1
+	// SNIFFED indicates the protocol is sniffed from the first bytes of each connection, with HTTP and TCP
+	// filter chains for the port. Connections that do not look like HTTP fall back to TCP.
+	ProtocolDetection_SNIFFED ProtocolDetection = 2
+)
+
+// Enum value maps for ProtocolDetection.
+var (
+	ProtocolDetection_name = map[int32]string{
+		0: "UNKNOWN_PROTOCOL_DETECTION",
+		1: "EXPLICIT",
+		2: "SNIFFED",
+	}
+	ProtocolDetection_value = map[string]int32{
+		"UNKNOWN_PROTOCOL_DETECTION": 0,
+		"EXPLICIT":                   1,
+		"SNIFFED":                    2,
+	}
+)
+
+func (x ProtocolDetection) Enum() *ProtocolDetection {
+	p := new(ProtocolDetection)
+	*p = x
+	return p
+}
+
+func (x ProtocolDetection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolDetection) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1alpha1_proxy_types_proto_enumTypes[6].Descriptor()
+}
+
+func (ProtocolDetection) Type() protoreflect.EnumType {
+	return &file_types_v1alpha1_proxy_types_proto_enumTypes[6]
+}
+
+func (x ProtocolDetection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtocolDetection.Descriptor instead.
+func (ProtocolDetection) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{6}
+}
+
 // ProxyConfig represents the configuration of a proxy sidecar (e.g., Envoy).
 type ProxyConfig struct {
 	state         protoimpl.MessageState
@@ -422,6 +477,10 @@ type ProxyConfig struct {
 	Routes []*RouteConfigSummary `protobuf:"bytes,7,rep,name=routes,proto3" json:"routes,omitempty"`
 	// raw_clusters is the original raw clusters output from /clusters?format=json endpoint.
 	RawClusters string `protobuf:"bytes,8,opt,name=raw_clusters,json=rawClusters,proto3" json:"raw_clusters,omitempty"`
+	// outbound_traffic_policy_mode is how the proxy handles traffic to destinations outside the service
+	// registry, read from the catch-all filter chain of its virtual outbound listener. Unspecified when
+	// the proxy has no virtual outbound listener, such as gateways.
+	OutboundTrafficPolicyMode OutboundTrafficPolicyMode `protobuf:"varint,9,opt,name=outbound_traffic_policy_mode,json=outboundTrafficPolicyMode,proto3,enum=navigator.types.v1alpha1.OutboundTrafficPolicyMode" json:"outbound_traffic_policy_mode,omitempty"`
 }
 
 func (x *ProxyConfig) Reset() {
@@ -512,6 +571,13 @@ func (x *ProxyConfig) GetRawClusters() string {
 	return ""
 }
 
+func (x *ProxyConfig) GetOutboundTrafficPolicyMode() OutboundTrafficPolicyMode {
+	if x != nil {
+		return x.OutboundTrafficPolicyMode
+	}
+	return OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_UNSPECIFIED
+}
+
 // BootstrapSummary contains essential bootstrap configuration information
 type BootstrapSummary struct {
 	state         protoimpl.MessageState
@@ -998,6 +1064,14 @@ type ListenerSummary struct {
 	RawConfig      string              `protobuf:"bytes,6,opt,name=raw_config,json=rawConfig,proto3" json:"raw_config,omitempty"`
 	Rules          []*ListenerRule     `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
 	FilterChains   *FilterChainSummary `protobuf:"bytes,8,opt,name=filter_chains,json=filterChains,proto3" json:"filter_chains,omitempty"`
+	// route_configs are the names of the route configurations the listener's HTTP connection managers
+	// load over RDS, e.g. "http.80" or "https.443.https.my-gateway.istio-system" on gateways.
+	RouteConfigs []string `protobuf:"bytes,9,rep,name=route_configs,json=routeConfigs,proto3" json:"route_configs,omitempty"`
+	// sources are the Gateway servers or Sidecar listeners that generated the listener. Empty when the
+	// listener was generated from the mesh defaults.
+	Sources []*ListenerSource `protobuf:"bytes,10,rep,name=sources,proto3" json:"sources,omitempty"`
+	// port_protocols are the application protocols the listener selects for each port it serves
+	PortProtocols []*PortProtocol `protobuf:"bytes,11,rep,name=port_protocols,json=portProtocols,proto3" json:"port_protocols,omitempty"`
 }
 
 func (x *ListenerSummary) Reset() {
@@ -1088,6 +1162,182 @@ func (x *ListenerSummary) GetFilterChains() *FilterChainSummary {
 	return nil
 }
 
+func (x *ListenerSummary) GetRouteConfigs() []string {
+	if x != nil {
+		return x.RouteConfigs
+	}
+	return nil
+}
+
+func (x *ListenerSummary) GetSources() []*ListenerSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ListenerSummary) GetPortProtocols() []*PortProtocol {
+	if x != nil {
+		return x.PortProtocols
+	}
+	return nil
+}
+
+// PortProtocol is the application protocol selection of a listener for one destination port
+type PortProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port is the destination port: the port matched by the listener's filter chains, or the listener's
+	// own port for filter chains that do not match on a destination port
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// protocols are the application protocols served on the port: HTTP/1.1, HTTP/2, TCP or TLS. TLS is
+	// reported for TLS passed through without termination.
+	Protocols []string `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	// detection is how the protocol is selected
+	Detection ProtocolDetection `protobuf:"varint,3,opt,name=detection,proto3,enum=navigator.types.v1alpha1.ProtocolDetection" json:"detection,omitempty"`
+}
+
+func (x *PortProtocol) Reset() {
+	*x = PortProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortProtocol) ProtoMessage() {}
+
+func (x *PortProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortProtocol.ProtoReflect.Descriptor instead.
+func (*PortProtocol) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PortProtocol) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PortProtocol) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *PortProtocol) GetDetection() ProtocolDetection {
+	if x != nil {
+		return x.Detection
+	}
+	return ProtocolDetection_UNKNOWN_PROTOCOL_DETECTION
+}
+
+// ListenerSource identifies the part of an Istio resource that generated a listener
+type ListenerSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is the kind of the resource: Gateway or Sidecar
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the name of the resource
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// namespace is the namespace of the resource
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// section identifies the part of the resource: the name of a Gateway server, or "servers[<index>]" when
+	// it is unnamed, or a Sidecar's "ingress[<index>]" or "egress[<index>]" listener.
+	Section string `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	// hosts are the hosts of the Gateway server or Sidecar egress listener
+	Hosts []string `protobuf:"bytes,5,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *ListenerSource) Reset() {
+	*x = ListenerSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerSource) ProtoMessage() {}
+
+func (x *ListenerSource) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerSource.ProtoReflect.Descriptor instead.
+func (*ListenerSource) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListenerSource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListenerSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListenerSource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListenerSource) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ListenerSource) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
 // ClusterSummary contains essential cluster configuration information
 type ClusterSummary struct {
 	state         protoimpl.MessageState
@@ -1104,12 +1354,25 @@ type ClusterSummary struct {
 	Subset              string           `protobuf:"bytes,8,opt,name=subset,proto3" json:"subset,omitempty"`
 	ServiceFqdn         string           `protobuf:"bytes,9,opt,name=service_fqdn,json=serviceFqdn,proto3" json:"service_fqdn,omitempty"`
 	RawConfig           string           `protobuf:"bytes,10,opt,name=raw_config,json=rawConfig,proto3" json:"raw_config,omitempty"`
+	// metadata contains the cluster's string-valued filter metadata keyed by "<filter>.<key>",
+	// e.g. "istio.config" which names the DestinationRule that configured the cluster.
+	Metadata map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tls describes the TLS the cluster originates to upstream hosts. Unset when connections are plaintext.
+	Tls *UpstreamTlsInfo `protobuf:"bytes,12,opt,name=tls,proto3" json:"tls,omitempty"`
+	// tls_mode is the Istio TLS mode of an outbound cluster: ISTIO_MUTUAL, MUTUAL, SIMPLE or DISABLE,
+	// or AUTO when Istio selects mutual TLS per endpoint. Empty for other clusters.
+	TlsMode string `protobuf:"bytes,13,opt,name=tls_mode,json=tlsMode,proto3" json:"tls_mode,omitempty"`
+	// destination_rule_name is the name of the DestinationRule responsible for the cluster's subset and
+	// TLS settings. Empty when no DestinationRule applies to the cluster.
+	DestinationRuleName string `protobuf:"bytes,14,opt,name=destination_rule_name,json=destinationRuleName,proto3" json:"destination_rule_name,omitempty"`
+	// destination_rule_namespace is the namespace of the DestinationRule responsible for the cluster
+	DestinationRuleNamespace string `protobuf:"bytes,15,opt,name=destination_rule_namespace,json=destinationRuleNamespace,proto3" json:"destination_rule_namespace,omitempty"`
 }
 
 func (x *ClusterSummary) Reset() {
 	*x = ClusterSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[8]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1122,7 +1385,7 @@ func (x *ClusterSummary) String() string {
 func (*ClusterSummary) ProtoMessage() {}
 
 func (x *ClusterSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[8]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1398,7 @@ func (x *ClusterSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSummary.ProtoReflect.Descriptor instead.
 func (*ClusterSummary) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{8}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ClusterSummary) GetName() string {
@@ -1208,6 +1471,120 @@ func (x *ClusterSummary) GetRawConfig() string {
 	return ""
 }
 
+func (x *ClusterSummary) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ClusterSummary) GetTls() *UpstreamTlsInfo {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *ClusterSummary) GetTlsMode() string {
+	if x != nil {
+		return x.TlsMode
+	}
+	return ""
+}
+
+func (x *ClusterSummary) GetDestinationRuleName() string {
+	if x != nil {
+		return x.DestinationRuleName
+	}
+	return ""
+}
+
+func (x *ClusterSummary) GetDestinationRuleNamespace() string {
+	if x != nil {
+		return x.DestinationRuleNamespace
+	}
+	return ""
+}
+
+// UpstreamTlsInfo contains the TLS settings a cluster connects to upstream hosts with
+type UpstreamTlsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sni is the server name indication sent to upstream hosts
+	Sni string `protobuf:"bytes,1,opt,name=sni,proto3" json:"sni,omitempty"`
+	// certificates are the SDS secrets of the client certificates presented to upstream hosts,
+	// e.g. "default" for Istio's workload certificate
+	Certificates []string `protobuf:"bytes,2,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	// validation is the SDS secret validating upstream certificates, e.g. "ROOTCA" for Istio's root
+	// certificate. Empty when upstream certificates are not validated with an SDS secret.
+	Validation string `protobuf:"bytes,3,opt,name=validation,proto3" json:"validation,omitempty"`
+	// per_endpoint is true when TLS is only used for the endpoints matched by the cluster's transport
+	// socket matches, as with Istio's auto mutual TLS
+	PerEndpoint bool `protobuf:"varint,4,opt,name=per_endpoint,json=perEndpoint,proto3" json:"per_endpoint,omitempty"`
+}
+
+func (x *UpstreamTlsInfo) Reset() {
+	*x = UpstreamTlsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamTlsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamTlsInfo) ProtoMessage() {}
+
+func (x *UpstreamTlsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamTlsInfo.ProtoReflect.Descriptor instead.
+func (*UpstreamTlsInfo) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpstreamTlsInfo) GetSni() string {
+	if x != nil {
+		return x.Sni
+	}
+	return ""
+}
+
+func (x *UpstreamTlsInfo) GetCertificates() []string {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *UpstreamTlsInfo) GetValidation() string {
+	if x != nil {
+		return x.Validation
+	}
+	return ""
+}
+
+func (x *UpstreamTlsInfo) GetPerEndpoint() bool {
+	if x != nil {
+		return x.PerEndpoint
+	}
+	return false
+}
+
 // EndpointSummary contains endpoint configuration information
 type EndpointSummary struct {
 	state         protoimpl.MessageState
@@ -1226,7 +1603,7 @@ type EndpointSummary struct {
 func (x *EndpointSummary) Reset() {
 	*x = EndpointSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[9]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1239,7 +1616,7 @@ func (x *EndpointSummary) String() string {
 func (*EndpointSummary) ProtoMessage() {}
 
 func (x *EndpointSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[9]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1629,7 @@ func (x *EndpointSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndpointSummary.ProtoReflect.Descriptor instead.
 func (*EndpointSummary) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{9}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EndpointSummary) GetClusterName() string {
@@ -1324,7 +1701,7 @@ type EndpointInfo struct {
 func (x *EndpointInfo) Reset() {
 	*x = EndpointInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[10]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1337,7 +1714,7 @@ func (x *EndpointInfo) String() string {
 func (*EndpointInfo) ProtoMessage() {}
 
 func (x *EndpointInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[10]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,7 +1727,7 @@ func (x *EndpointInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndpointInfo.ProtoReflect.Descriptor instead.
 func (*EndpointInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{10}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EndpointInfo) GetAddress() string {
@@ -1433,7 +1810,7 @@ type RouteConfigSummary struct {
 func (x *RouteConfigSummary) Reset() {
 	*x = RouteConfigSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[11]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1446,7 +1823,7 @@ func (x *RouteConfigSummary) String() string {
 func (*RouteConfigSummary) ProtoMessage() {}
 
 func (x *RouteConfigSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[11]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1836,7 @@ func (x *RouteConfigSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteConfigSummary.ProtoReflect.Descriptor instead.
 func (*RouteConfigSummary) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{11}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RouteConfigSummary) GetName() string {
@@ -1518,7 +1895,7 @@ type VirtualHostInfo struct {
 func (x *VirtualHostInfo) Reset() {
 	*x = VirtualHostInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[12]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1531,7 +1908,7 @@ func (x *VirtualHostInfo) String() string {
 func (*VirtualHostInfo) ProtoMessage() {}
 
 func (x *VirtualHostInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[12]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,7 +1921,7 @@ func (x *VirtualHostInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VirtualHostInfo.ProtoReflect.Descriptor instead.
 func (*VirtualHostInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{12}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VirtualHostInfo) GetName() string {
@@ -1577,12 +1954,20 @@ type RouteInfo struct {
 	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Match  *RouteMatchInfo  `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
 	Action *RouteActionInfo `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// metadata contains the route's string-valued filter metadata keyed by "<filter>.<key>",
+	// e.g. "istio.config" which names the resource that generated the route.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// virtual_service_name is the name of the VirtualService that generated the route, read from its
+	// istio.config metadata. Empty when the route was not generated from a VirtualService.
+	VirtualServiceName string `protobuf:"bytes,5,opt,name=virtual_service_name,json=virtualServiceName,proto3" json:"virtual_service_name,omitempty"`
+	// virtual_service_namespace is the namespace of the VirtualService that generated the route
+	VirtualServiceNamespace string `protobuf:"bytes,6,opt,name=virtual_service_namespace,json=virtualServiceNamespace,proto3" json:"virtual_service_namespace,omitempty"`
 }
 
 func (x *RouteInfo) Reset() {
 	*x = RouteInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[13]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1595,7 +1980,7 @@ func (x *RouteInfo) String() string {
 func (*RouteInfo) ProtoMessage() {}
 
 func (x *RouteInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[13]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1993,7 @@ func (x *RouteInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteInfo.ProtoReflect.Descriptor instead.
 func (*RouteInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{13}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RouteInfo) GetName() string {
@@ -1632,6 +2017,27 @@ func (x *RouteInfo) GetAction() *RouteActionInfo {
 	return nil
 }
 
+func (x *RouteInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetVirtualServiceName() string {
+	if x != nil {
+		return x.VirtualServiceName
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetVirtualServiceNamespace() string {
+	if x != nil {
+		return x.VirtualServiceNamespace
+	}
+	return ""
+}
+
 // RouteMatchInfo contains route matching information
 type RouteMatchInfo struct {
 	state         protoimpl.MessageState
@@ -1641,12 +2047,14 @@ type RouteMatchInfo struct {
 	PathSpecifier string `protobuf:"bytes,1,opt,name=path_specifier,json=pathSpecifier,proto3" json:"path_specifier,omitempty"`
 	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	CaseSensitive bool   `protobuf:"varint,3,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	// header_matches contains the headers (including pseudo-headers such as :method) the request must match
+	HeaderMatches []*HeaderMatchInfo `protobuf:"bytes,4,rep,name=header_matches,json=headerMatches,proto3" json:"header_matches,omitempty"`
 }
 
 func (x *RouteMatchInfo) Reset() {
 	*x = RouteMatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[14]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1659,7 +2067,7 @@ func (x *RouteMatchInfo) String() string {
 func (*RouteMatchInfo) ProtoMessage() {}
 
 func (x *RouteMatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[14]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,7 +2080,7 @@ func (x *RouteMatchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteMatchInfo.ProtoReflect.Descriptor instead.
 func (*RouteMatchInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{14}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RouteMatchInfo) GetPathSpecifier() string {
@@ -1696,6 +2104,13 @@ func (x *RouteMatchInfo) GetCaseSensitive() bool {
 	return false
 }
 
+func (x *RouteMatchInfo) GetHeaderMatches() []*HeaderMatchInfo {
+	if x != nil {
+		return x.HeaderMatches
+	}
+	return nil
+}
+
 // RouteActionInfo contains route action information
 type RouteActionInfo struct {
 	state         protoimpl.MessageState
@@ -1711,7 +2126,7 @@ type RouteActionInfo struct {
 func (x *RouteActionInfo) Reset() {
 	*x = RouteActionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[15]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1724,7 +2139,7 @@ func (x *RouteActionInfo) String() string {
 func (*RouteActionInfo) ProtoMessage() {}
 
 func (x *RouteActionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[15]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1737,7 +2152,7 @@ func (x *RouteActionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteActionInfo.ProtoReflect.Descriptor instead.
 func (*RouteActionInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{15}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RouteActionInfo) GetActionType() string {
@@ -1782,7 +2197,7 @@ type WeightedClusterInfo struct {
 func (x *WeightedClusterInfo) Reset() {
 	*x = WeightedClusterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[16]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +2210,7 @@ func (x *WeightedClusterInfo) String() string {
 func (*WeightedClusterInfo) ProtoMessage() {}
 
 func (x *WeightedClusterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[16]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2223,7 @@ func (x *WeightedClusterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeightedClusterInfo.ProtoReflect.Descriptor instead.
 func (*WeightedClusterInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{16}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WeightedClusterInfo) GetName() string {
@@ -1849,7 +2264,7 @@ type ListenerMatch struct {
 func (x *ListenerMatch) Reset() {
 	*x = ListenerMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[17]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +2277,7 @@ func (x *ListenerMatch) String() string {
 func (*ListenerMatch) ProtoMessage() {}
 
 func (x *ListenerMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[17]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +2290,7 @@ func (x *ListenerMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerMatch.ProtoReflect.Descriptor instead.
 func (*ListenerMatch) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{17}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{20}
 }
 
 func (m *ListenerMatch) GetMatchType() isListenerMatch_MatchType {
@@ -1945,7 +2360,7 @@ type HttpRouteMatch struct {
 func (x *HttpRouteMatch) Reset() {
 	*x = HttpRouteMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[18]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1958,7 +2373,7 @@ func (x *HttpRouteMatch) String() string {
 func (*HttpRouteMatch) ProtoMessage() {}
 
 func (x *HttpRouteMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[18]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +2386,7 @@ func (x *HttpRouteMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpRouteMatch.ProtoReflect.Descriptor instead.
 func (*HttpRouteMatch) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{18}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *HttpRouteMatch) GetPathMatch() *PathMatchInfo {
@@ -2012,7 +2427,7 @@ type FilterChainMatch struct {
 func (x *FilterChainMatch) Reset() {
 	*x = FilterChainMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[19]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2025,7 +2440,7 @@ func (x *FilterChainMatch) String() string {
 func (*FilterChainMatch) ProtoMessage() {}
 
 func (x *FilterChainMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[19]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,7 +2453,7 @@ func (x *FilterChainMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterChainMatch.ProtoReflect.Descriptor instead.
 func (*FilterChainMatch) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{19}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FilterChainMatch) GetServerNames() []string {
@@ -2075,7 +2490,7 @@ type TcpProxyMatch struct {
 func (x *TcpProxyMatch) Reset() {
 	*x = TcpProxyMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[20]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2088,7 +2503,7 @@ func (x *TcpProxyMatch) String() string {
 func (*TcpProxyMatch) ProtoMessage() {}
 
 func (x *TcpProxyMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[20]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2516,7 @@ func (x *TcpProxyMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcpProxyMatch.ProtoReflect.Descriptor instead.
 func (*TcpProxyMatch) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{20}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TcpProxyMatch) GetClusterName() string {
@@ -2128,7 +2543,7 @@ type PathMatchInfo struct {
 func (x *PathMatchInfo) Reset() {
 	*x = PathMatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[21]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2141,7 +2556,7 @@ func (x *PathMatchInfo) String() string {
 func (*PathMatchInfo) ProtoMessage() {}
 
 func (x *PathMatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[21]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2569,7 @@ func (x *PathMatchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathMatchInfo.ProtoReflect.Descriptor instead.
 func (*PathMatchInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{21}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PathMatchInfo) GetMatchType() string {
@@ -2197,7 +2612,7 @@ type HeaderMatchInfo struct {
 func (x *HeaderMatchInfo) Reset() {
 	*x = HeaderMatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[22]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2210,7 +2625,7 @@ func (x *HeaderMatchInfo) String() string {
 func (*HeaderMatchInfo) ProtoMessage() {}
 
 func (x *HeaderMatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[22]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +2638,7 @@ func (x *HeaderMatchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeaderMatchInfo.ProtoReflect.Descriptor instead.
 func (*HeaderMatchInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{22}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *HeaderMatchInfo) GetName() string {
@@ -2277,7 +2692,7 @@ type ListenerDestination struct {
 func (x *ListenerDestination) Reset() {
 	*x = ListenerDestination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[23]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2290,7 +2705,7 @@ func (x *ListenerDestination) String() string {
 func (*ListenerDestination) ProtoMessage() {}
 
 func (x *ListenerDestination) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[23]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2303,7 +2718,7 @@ func (x *ListenerDestination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerDestination.ProtoReflect.Descriptor instead.
 func (*ListenerDestination) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{23}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListenerDestination) GetDestinationType() string {
@@ -2363,7 +2778,7 @@ type ListenerRule struct {
 func (x *ListenerRule) Reset() {
 	*x = ListenerRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[24]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2376,7 +2791,7 @@ func (x *ListenerRule) String() string {
 func (*ListenerRule) ProtoMessage() {}
 
 func (x *ListenerRule) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[24]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2804,7 @@ func (x *ListenerRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerRule.ProtoReflect.Descriptor instead.
 func (*ListenerRule) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{24}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListenerRule) GetMatch() *ListenerMatch {
@@ -2425,7 +2840,7 @@ type FilterChainSummary struct {
 func (x *FilterChainSummary) Reset() {
 	*x = FilterChainSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[25]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2438,7 +2853,7 @@ func (x *FilterChainSummary) String() string {
 func (*FilterChainSummary) ProtoMessage() {}
 
 func (x *FilterChainSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[25]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,7 +2866,7 @@ func (x *FilterChainSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterChainSummary.ProtoReflect.Descriptor instead.
 func (*FilterChainSummary) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{25}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *FilterChainSummary) GetTotalChains() uint32 {
@@ -2499,7 +2914,7 @@ type FilterInfo struct {
 func (x *FilterInfo) Reset() {
 	*x = FilterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[26]
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2512,7 +2927,7 @@ func (x *FilterInfo) String() string {
 func (*FilterInfo) ProtoMessage() {}
 
 func (x *FilterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[26]
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +2940,7 @@ func (x *FilterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterInfo.ProtoReflect.Descriptor instead.
 func (*FilterInfo) Descriptor() ([]byte, []int) {
-	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{26}
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *FilterInfo) GetName() string {
@@ -2549,297 +2964,559 @@ func (x *FilterInfo) GetConfigSummary() string {
 	return ""
 }
 
+// RouteSimulationMatch describes the route a proxy would select for a simulated HTTP request
+// within a single route configuration
+type RouteSimulationMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// route_config is the name of the route configuration that was evaluated (e.g. "9080")
+	RouteConfig string `protobuf:"bytes,1,opt,name=route_config,json=routeConfig,proto3" json:"route_config,omitempty"`
+	// virtual_host is the name of the virtual host whose domains matched the request host
+	VirtualHost string `protobuf:"bytes,2,opt,name=virtual_host,json=virtualHost,proto3" json:"virtual_host,omitempty"`
+	// route is the first route in the virtual host that matches the request
+	Route *RouteInfo `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// destinations are the upstream clusters the route forwards to, one per weighted cluster
+	Destinations []*RouteSimulationDestination `protobuf:"bytes,4,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// virtual_service is the VirtualService that generated the route, as namespace/name.
+	// Empty when the route was not generated from a VirtualService.
+	VirtualService string `protobuf:"bytes,5,opt,name=virtual_service,json=virtualService,proto3" json:"virtual_service,omitempty"`
+}
+
+func (x *RouteSimulationMatch) Reset() {
+	*x = RouteSimulationMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteSimulationMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSimulationMatch) ProtoMessage() {}
+
+func (x *RouteSimulationMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSimulationMatch.ProtoReflect.Descriptor instead.
+func (*RouteSimulationMatch) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RouteSimulationMatch) GetRouteConfig() string {
+	if x != nil {
+		return x.RouteConfig
+	}
+	return ""
+}
+
+func (x *RouteSimulationMatch) GetVirtualHost() string {
+	if x != nil {
+		return x.VirtualHost
+	}
+	return ""
+}
+
+func (x *RouteSimulationMatch) GetRoute() *RouteInfo {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteSimulationMatch) GetDestinations() []*RouteSimulationDestination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *RouteSimulationMatch) GetVirtualService() string {
+	if x != nil {
+		return x.VirtualService
+	}
+	return ""
+}
+
+// RouteSimulationDestination is an upstream cluster selected by a simulated route
+type RouteSimulationDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster is the Envoy cluster name
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// weight is the cluster's share of traffic; zero when the route has a single destination
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// service_fqdn is the destination service parsed from the cluster name
+	ServiceFqdn string `protobuf:"bytes,3,opt,name=service_fqdn,json=serviceFqdn,proto3" json:"service_fqdn,omitempty"`
+	// port is the destination service port parsed from the cluster name
+	Port uint32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// subset is the DestinationRule subset parsed from the cluster name
+	Subset string `protobuf:"bytes,5,opt,name=subset,proto3" json:"subset,omitempty"`
+}
+
+func (x *RouteSimulationDestination) Reset() {
+	*x = RouteSimulationDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteSimulationDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSimulationDestination) ProtoMessage() {}
+
+func (x *RouteSimulationDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1alpha1_proxy_types_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSimulationDestination.ProtoReflect.Descriptor instead.
+func (*RouteSimulationDestination) Descriptor() ([]byte, []int) {
+	return file_types_v1alpha1_proxy_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RouteSimulationDestination) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *RouteSimulationDestination) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RouteSimulationDestination) GetServiceFqdn() string {
+	if x != nil {
+		return x.ServiceFqdn
+	}
+	return ""
+}
+
+func (x *RouteSimulationDestination) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RouteSimulationDestination) GetSubset() string {
+	if x != nil {
+		return x.Subset
+	}
+	return ""
+}
+
 var File_types_v1alpha1_proxy_types_proto protoreflect.FileDescriptor
 
 var file_types_v1alpha1_proxy_types_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x18, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xda, 0x03, 0x0a,
-	0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x48,
-	0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x62,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x47, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61,
-	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x73, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x44, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61,
-	0x77, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x10, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x39,
-	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x16, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x55,
-	0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x24, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd0, 0x04, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x75, 0x6d, 0x70, 0x12, 0x48, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x22, 0xcd, 0x02, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x4f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e,
-	0x65, 0x22, 0x89, 0x04, 0x0a, 0x11, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x64, 0x73, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61,
-	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x6c, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x47,
+	0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x09, 0x6c, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a,
-	0x0a, 0x63, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63,
-	0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x64, 0x73, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x65, 0x64, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x61, 0x77, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x74, 0x0a, 0x1c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x19, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x89, 0x03, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x65, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x16,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x55, 0x0a, 0x0f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x22, 0xcd, 0x02, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x42, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x3a, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x89, 0x04,
+	0x0a, 0x11, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49,
-	0x0a, 0x0a, 0x73, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49,
+	0x0a, 0x0a, 0x6c, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
-	0x73, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x99, 0x01,
-	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x12, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69,
-	0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x75,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a,
-	0x11, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x48, 0x0a, 0x21, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x70,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe9, 0x02, 0x0a,
-	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x75, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
-	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x77, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61,
-	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
+	0x6c, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x64, 0x73,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x64, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x65, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x49, 0x0a, 0x0a, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x73, 0x64,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x64, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36,
+	0x0a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x69, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x12, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42,
+	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x48,
+	0x0a, 0x21, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x70, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa1, 0x04, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3a,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6e,
 	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xf1, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x61,
-	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xdd, 0x02, 0x0a,
-	0x0f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x71, 0x64, 0x6e, 0x22, 0xce, 0x03, 0x0a,
-	0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x50, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x48, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73,
+	0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x44, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x51, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
 	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4d, 0x0a,
+	0x0e, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x0c, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12,
+	0x49, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0xcc, 0x05, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x61,
+	0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x48, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x77, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x03, 0x74,
+	0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6c, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6c, 0x73, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x6c, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6e, 0x69, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0xdd, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0c,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x71, 0x64, 0x6e, 0x22,
+	0xce, 0x03, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb1, 0x02, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x0f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x74, 0x68, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x02,
-	0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x76, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x77,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x61, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x7c, 0x0a, 0x0f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22,
-	0xa2, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x3e, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x73,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x70, 0x61, 0x74, 0x68, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x52, 0x6f, 0x75,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x52, 0x6f, 0x75,
 	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
@@ -2967,53 +3644,86 @@ var file_types_v1alpha1_proxy_types_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x2a, 0x46, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16,
-	0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a,
-	0x06, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x2a, 0xef, 0x01, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
-	0x5f, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49,
-	0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x42,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
-	0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
-	0x58, 0x59, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11,
-	0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x43, 0x48, 0x45, 0x43,
-	0x4b, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x58, 0x44, 0x53,
-	0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x48,
-	0x4f, 0x4f, 0x4b, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x44,
-	0x45, 0x42, 0x55, 0x47, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41,
-	0x59, 0x5f, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x2a, 0x3d, 0x0a, 0x09, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x42, 0x41, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56,
-	0x49, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x0b, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
-	0x45, 0x44, 0x53, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4c, 0x55,
-	0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x5f, 0x44, 0x4e, 0x53, 0x10,
-	0x03, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47,
-	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x44, 0x4e, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4c,
-	0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x44,
-	0x53, 0x54, 0x10, 0x05, 0x2a, 0x3e, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x42,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x02, 0x2a, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41,
-	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
-	0x53, 0x10, 0x02, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x9d,
+	0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x71,
+	0x64, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2a, 0x46,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x2a, 0xef, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x49, 0x4e,
+	0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x52, 0x54, 0x55,
+	0x41, 0x4c, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x42,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f,
+	0x58, 0x59, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x06,
+	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x58, 0x44, 0x53, 0x10, 0x07, 0x12,
+	0x11, 0x0a, 0x0d, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x44, 0x45, 0x42, 0x55,
+	0x47, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x49,
+	0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x2a, 0x3d, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x44, 0x53,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x43, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x5f, 0x44, 0x4e, 0x53, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x44, 0x4e, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x53, 0x54, 0x10,
+	0x05, 0x2a, 0x3e, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x02, 0x2a, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f,
+	0x43, 0x4b, 0x45, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02,
+	0x2a, 0x4e, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43, 0x49,
+	0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4e, 0x49, 0x46, 0x46, 0x45, 0x44, 0x10, 0x02,
+	0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x69, 0x61, 0x6d, 0x61, 0x77, 0x68, 0x69, 0x74, 0x65, 0x2f, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -3028,95 +3738,114 @@ func file_types_v1alpha1_proxy_types_proto_rawDescGZIP() []byte {
 	return file_types_v1alpha1_proxy_types_proto_rawDescData
 }
 
-var file_types_v1alpha1_proxy_types_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_types_v1alpha1_proxy_types_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_types_v1alpha1_proxy_types_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_types_v1alpha1_proxy_types_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_types_v1alpha1_proxy_types_proto_goTypes = []any{
-	(ProxyMode)(0),              // 0: navigator.types.v1alpha1.ProxyMode
-	(ListenerType)(0),           // 1: navigator.types.v1alpha1.ListenerType
-	(RouteType)(0),              // 2: navigator.types.v1alpha1.RouteType
-	(ClusterType)(0),            // 3: navigator.types.v1alpha1.ClusterType
-	(ClusterDirection)(0),       // 4: navigator.types.v1alpha1.ClusterDirection
-	(AddressType)(0),            // 5: navigator.types.v1alpha1.AddressType
-	(*ProxyConfig)(nil),         // 6: navigator.types.v1alpha1.ProxyConfig
-	(*BootstrapSummary)(nil),    // 7: navigator.types.v1alpha1.BootstrapSummary
-	(*NodeSummary)(nil),         // 8: navigator.types.v1alpha1.NodeSummary
-	(*LocalityInfo)(nil),        // 9: navigator.types.v1alpha1.LocalityInfo
-	(*DynamicConfigInfo)(nil),   // 10: navigator.types.v1alpha1.DynamicConfigInfo
-	(*ConfigSourceInfo)(nil),    // 11: navigator.types.v1alpha1.ConfigSourceInfo
-	(*ClusterManagerInfo)(nil),  // 12: navigator.types.v1alpha1.ClusterManagerInfo
-	(*ListenerSummary)(nil),     // 13: navigator.types.v1alpha1.ListenerSummary
-	(*ClusterSummary)(nil),      // 14: navigator.types.v1alpha1.ClusterSummary
-	(*EndpointSummary)(nil),     // 15: navigator.types.v1alpha1.EndpointSummary
-	(*EndpointInfo)(nil),        // 16: navigator.types.v1alpha1.EndpointInfo
-	(*RouteConfigSummary)(nil),  // 17: navigator.types.v1alpha1.RouteConfigSummary
-	(*VirtualHostInfo)(nil),     // 18: navigator.types.v1alpha1.VirtualHostInfo
-	(*RouteInfo)(nil),           // 19: navigator.types.v1alpha1.RouteInfo
-	(*RouteMatchInfo)(nil),      // 20: navigator.types.v1alpha1.RouteMatchInfo
-	(*RouteActionInfo)(nil),     // 21: navigator.types.v1alpha1.RouteActionInfo
-	(*WeightedClusterInfo)(nil), // 22: navigator.types.v1alpha1.WeightedClusterInfo
-	(*ListenerMatch)(nil),       // 23: navigator.types.v1alpha1.ListenerMatch
-	(*HttpRouteMatch)(nil),      // 24: navigator.types.v1alpha1.HttpRouteMatch
-	(*FilterChainMatch)(nil),    // 25: navigator.types.v1alpha1.FilterChainMatch
-	(*TcpProxyMatch)(nil),       // 26: navigator.types.v1alpha1.TcpProxyMatch
-	(*PathMatchInfo)(nil),       // 27: navigator.types.v1alpha1.PathMatchInfo
-	(*HeaderMatchInfo)(nil),     // 28: navigator.types.v1alpha1.HeaderMatchInfo
-	(*ListenerDestination)(nil), // 29: navigator.types.v1alpha1.ListenerDestination
-	(*ListenerRule)(nil),        // 30: navigator.types.v1alpha1.ListenerRule
-	(*FilterChainSummary)(nil),  // 31: navigator.types.v1alpha1.FilterChainSummary
-	(*FilterInfo)(nil),          // 32: navigator.types.v1alpha1.FilterInfo
-	nil,                         // 33: navigator.types.v1alpha1.NodeSummary.MetadataEntry
-	nil,                         // 34: navigator.types.v1alpha1.EndpointInfo.MetadataEntry
-	nil,                         // 35: navigator.types.v1alpha1.WeightedClusterInfo.MetadataMatchEntry
+	(ProxyMode)(0),                     // 0: navigator.types.v1alpha1.ProxyMode
+	(ListenerType)(0),                  // 1: navigator.types.v1alpha1.ListenerType
+	(RouteType)(0),                     // 2: navigator.types.v1alpha1.RouteType
+	(ClusterType)(0),                   // 3: navigator.types.v1alpha1.ClusterType
+	(ClusterDirection)(0),              // 4: navigator.types.v1alpha1.ClusterDirection
+	(AddressType)(0),                   // 5: navigator.types.v1alpha1.AddressType
+	(ProtocolDetection)(0),             // 6: navigator.types.v1alpha1.ProtocolDetection
+	(*ProxyConfig)(nil),                // 7: navigator.types.v1alpha1.ProxyConfig
+	(*BootstrapSummary)(nil),           // 8: navigator.types.v1alpha1.BootstrapSummary
+	(*NodeSummary)(nil),                // 9: navigator.types.v1alpha1.NodeSummary
+	(*LocalityInfo)(nil),               // 10: navigator.types.v1alpha1.LocalityInfo
+	(*DynamicConfigInfo)(nil),          // 11: navigator.types.v1alpha1.DynamicConfigInfo
+	(*ConfigSourceInfo)(nil),           // 12: navigator.types.v1alpha1.ConfigSourceInfo
+	(*ClusterManagerInfo)(nil),         // 13: navigator.types.v1alpha1.ClusterManagerInfo
+	(*ListenerSummary)(nil),            // 14: navigator.types.v1alpha1.ListenerSummary
+	(*PortProtocol)(nil),               // 15: navigator.types.v1alpha1.PortProtocol
+	(*ListenerSource)(nil),             // 16: navigator.types.v1alpha1.ListenerSource
+	(*ClusterSummary)(nil),             // 17: navigator.types.v1alpha1.ClusterSummary
+	(*UpstreamTlsInfo)(nil),            // 18: navigator.types.v1alpha1.UpstreamTlsInfo
+	(*EndpointSummary)(nil),            // 19: navigator.types.v1alpha1.EndpointSummary
+	(*EndpointInfo)(nil),               // 20: navigator.types.v1alpha1.EndpointInfo
+	(*RouteConfigSummary)(nil),         // 21: navigator.types.v1alpha1.RouteConfigSummary
+	(*VirtualHostInfo)(nil),            // 22: navigator.types.v1alpha1.VirtualHostInfo
+	(*RouteInfo)(nil),                  // 23: navigator.types.v1alpha1.RouteInfo
+	(*RouteMatchInfo)(nil),             // 24: navigator.types.v1alpha1.RouteMatchInfo
+	(*RouteActionInfo)(nil),            // 25: navigator.types.v1alpha1.RouteActionInfo
+	(*WeightedClusterInfo)(nil),        // 26: navigator.types.v1alpha1.WeightedClusterInfo
+	(*ListenerMatch)(nil),              // 27: navigator.types.v1alpha1.ListenerMatch
+	(*HttpRouteMatch)(nil),             // 28: navigator.types.v1alpha1.HttpRouteMatch
+	(*FilterChainMatch)(nil),           // 29: navigator.types.v1alpha1.FilterChainMatch
+	(*TcpProxyMatch)(nil),              // 30: navigator.types.v1alpha1.TcpProxyMatch
+	(*PathMatchInfo)(nil),              // 31: navigator.types.v1alpha1.PathMatchInfo
+	(*HeaderMatchInfo)(nil),            // 32: navigator.types.v1alpha1.HeaderMatchInfo
+	(*ListenerDestination)(nil),        // 33: navigator.types.v1alpha1.ListenerDestination
+	(*ListenerRule)(nil),               // 34: navigator.types.v1alpha1.ListenerRule
+	(*FilterChainSummary)(nil),         // 35: navigator.types.v1alpha1.FilterChainSummary
+	(*FilterInfo)(nil),                 // 36: navigator.types.v1alpha1.FilterInfo
+	(*RouteSimulationMatch)(nil),       // 37: navigator.types.v1alpha1.RouteSimulationMatch
+	(*RouteSimulationDestination)(nil), // 38: navigator.types.v1alpha1.RouteSimulationDestination
+	nil,                                // 39: navigator.types.v1alpha1.NodeSummary.MetadataEntry
+	nil,                                // 40: navigator.types.v1alpha1.ClusterSummary.MetadataEntry
+	nil,                                // 41: navigator.types.v1alpha1.EndpointInfo.MetadataEntry
+	nil,                                // 42: navigator.types.v1alpha1.RouteInfo.MetadataEntry
+	nil,                                // 43: navigator.types.v1alpha1.WeightedClusterInfo.MetadataMatchEntry
+	(OutboundTrafficPolicyMode)(0),     // 44: navigator.types.v1alpha1.OutboundTrafficPolicyMode
 }
 var file_types_v1alpha1_proxy_types_proto_depIdxs = []int32{
-	7,  // 0: navigator.types.v1alpha1.ProxyConfig.bootstrap:type_name -> navigator.types.v1alpha1.BootstrapSummary
-	13, // 1: navigator.types.v1alpha1.ProxyConfig.listeners:type_name -> navigator.types.v1alpha1.ListenerSummary
-	14, // 2: navigator.types.v1alpha1.ProxyConfig.clusters:type_name -> navigator.types.v1alpha1.ClusterSummary
-	15, // 3: navigator.types.v1alpha1.ProxyConfig.endpoints:type_name -> navigator.types.v1alpha1.EndpointSummary
-	17, // 4: navigator.types.v1alpha1.ProxyConfig.routes:type_name -> navigator.types.v1alpha1.RouteConfigSummary
-	8,  // 5: navigator.types.v1alpha1.BootstrapSummary.node:type_name -> navigator.types.v1alpha1.NodeSummary
-	10, // 6: navigator.types.v1alpha1.BootstrapSummary.dynamic_resources_config:type_name -> navigator.types.v1alpha1.DynamicConfigInfo
-	12, // 7: navigator.types.v1alpha1.BootstrapSummary.cluster_manager:type_name -> navigator.types.v1alpha1.ClusterManagerInfo
-	33, // 8: navigator.types.v1alpha1.NodeSummary.metadata:type_name -> navigator.types.v1alpha1.NodeSummary.MetadataEntry
-	9,  // 9: navigator.types.v1alpha1.NodeSummary.locality:type_name -> navigator.types.v1alpha1.LocalityInfo
-	0,  // 10: navigator.types.v1alpha1.NodeSummary.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
-	11, // 11: navigator.types.v1alpha1.DynamicConfigInfo.ads_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	11, // 12: navigator.types.v1alpha1.DynamicConfigInfo.lds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	11, // 13: navigator.types.v1alpha1.DynamicConfigInfo.cds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	11, // 14: navigator.types.v1alpha1.DynamicConfigInfo.eds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	11, // 15: navigator.types.v1alpha1.DynamicConfigInfo.rds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	11, // 16: navigator.types.v1alpha1.DynamicConfigInfo.sds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
-	1,  // 17: navigator.types.v1alpha1.ListenerSummary.type:type_name -> navigator.types.v1alpha1.ListenerType
-	30, // 18: navigator.types.v1alpha1.ListenerSummary.rules:type_name -> navigator.types.v1alpha1.ListenerRule
-	31, // 19: navigator.types.v1alpha1.ListenerSummary.filter_chains:type_name -> navigator.types.v1alpha1.FilterChainSummary
-	4,  // 20: navigator.types.v1alpha1.ClusterSummary.direction:type_name -> navigator.types.v1alpha1.ClusterDirection
-	16, // 21: navigator.types.v1alpha1.EndpointSummary.endpoints:type_name -> navigator.types.v1alpha1.EndpointInfo
-	3,  // 22: navigator.types.v1alpha1.EndpointSummary.cluster_type:type_name -> navigator.types.v1alpha1.ClusterType
-	4,  // 23: navigator.types.v1alpha1.EndpointSummary.direction:type_name -> navigator.types.v1alpha1.ClusterDirection
-	34, // 24: navigator.types.v1alpha1.EndpointInfo.metadata:type_name -> navigator.types.v1alpha1.EndpointInfo.MetadataEntry
-	5,  // 25: navigator.types.v1alpha1.EndpointInfo.address_type:type_name -> navigator.types.v1alpha1.AddressType
-	9,  // 26: navigator.types.v1alpha1.EndpointInfo.locality:type_name -> navigator.types.v1alpha1.LocalityInfo
-	18, // 27: navigator.types.v1alpha1.RouteConfigSummary.virtual_hosts:type_name -> navigator.types.v1alpha1.VirtualHostInfo
-	2,  // 28: navigator.types.v1alpha1.RouteConfigSummary.type:type_name -> navigator.types.v1alpha1.RouteType
-	19, // 29: navigator.types.v1alpha1.VirtualHostInfo.routes:type_name -> navigator.types.v1alpha1.RouteInfo
-	20, // 30: navigator.types.v1alpha1.RouteInfo.match:type_name -> navigator.types.v1alpha1.RouteMatchInfo
-	21, // 31: navigator.types.v1alpha1.RouteInfo.action:type_name -> navigator.types.v1alpha1.RouteActionInfo
-	22, // 32: navigator.types.v1alpha1.RouteActionInfo.weighted_clusters:type_name -> navigator.types.v1alpha1.WeightedClusterInfo
-	35, // 33: navigator.types.v1alpha1.WeightedClusterInfo.metadata_match:type_name -> navigator.types.v1alpha1.WeightedClusterInfo.MetadataMatchEntry
-	24, // 34: navigator.types.v1alpha1.ListenerMatch.http_route:type_name -> navigator.types.v1alpha1.HttpRouteMatch
-	25, // 35: navigator.types.v1alpha1.ListenerMatch.filter_chain:type_name -> navigator.types.v1alpha1.FilterChainMatch
-	26, // 36: navigator.types.v1alpha1.ListenerMatch.tcp_proxy:type_name -> navigator.types.v1alpha1.TcpProxyMatch
-	27, // 37: navigator.types.v1alpha1.HttpRouteMatch.path_match:type_name -> navigator.types.v1alpha1.PathMatchInfo
-	28, // 38: navigator.types.v1alpha1.HttpRouteMatch.header_matches:type_name -> navigator.types.v1alpha1.HeaderMatchInfo
-	23, // 39: navigator.types.v1alpha1.ListenerRule.match:type_name -> navigator.types.v1alpha1.ListenerMatch
-	29, // 40: navigator.types.v1alpha1.ListenerRule.destination:type_name -> navigator.types.v1alpha1.ListenerDestination
-	32, // 41: navigator.types.v1alpha1.FilterChainSummary.http_filters:type_name -> navigator.types.v1alpha1.FilterInfo
-	32, // 42: navigator.types.v1alpha1.FilterChainSummary.network_filters:type_name -> navigator.types.v1alpha1.FilterInfo
-	43, // [43:43] is the sub-list for method output_type
-	43, // [43:43] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	8,  // 0: navigator.types.v1alpha1.ProxyConfig.bootstrap:type_name -> navigator.types.v1alpha1.BootstrapSummary
+	14, // 1: navigator.types.v1alpha1.ProxyConfig.listeners:type_name -> navigator.types.v1alpha1.ListenerSummary
+	17, // 2: navigator.types.v1alpha1.ProxyConfig.clusters:type_name -> navigator.types.v1alpha1.ClusterSummary
+	19, // 3: navigator.types.v1alpha1.ProxyConfig.endpoints:type_name -> navigator.types.v1alpha1.EndpointSummary
+	21, // 4: navigator.types.v1alpha1.ProxyConfig.routes:type_name -> navigator.types.v1alpha1.RouteConfigSummary
+	44, // 5: navigator.types.v1alpha1.ProxyConfig.outbound_traffic_policy_mode:type_name -> navigator.types.v1alpha1.OutboundTrafficPolicyMode
+	9,  // 6: navigator.types.v1alpha1.BootstrapSummary.node:type_name -> navigator.types.v1alpha1.NodeSummary
+	11, // 7: navigator.types.v1alpha1.BootstrapSummary.dynamic_resources_config:type_name -> navigator.types.v1alpha1.DynamicConfigInfo
+	13, // 8: navigator.types.v1alpha1.BootstrapSummary.cluster_manager:type_name -> navigator.types.v1alpha1.ClusterManagerInfo
+	39, // 9: navigator.types.v1alpha1.NodeSummary.metadata:type_name -> navigator.types.v1alpha1.NodeSummary.MetadataEntry
+	10, // 10: navigator.types.v1alpha1.NodeSummary.locality:type_name -> navigator.types.v1alpha1.LocalityInfo
+	0,  // 11: navigator.types.v1alpha1.NodeSummary.proxy_mode:type_name -> navigator.types.v1alpha1.ProxyMode
+	12, // 12: navigator.types.v1alpha1.DynamicConfigInfo.ads_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	12, // 13: navigator.types.v1alpha1.DynamicConfigInfo.lds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	12, // 14: navigator.types.v1alpha1.DynamicConfigInfo.cds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	12, // 15: navigator.types.v1alpha1.DynamicConfigInfo.eds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	12, // 16: navigator.types.v1alpha1.DynamicConfigInfo.rds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	12, // 17: navigator.types.v1alpha1.DynamicConfigInfo.sds_config:type_name -> navigator.types.v1alpha1.ConfigSourceInfo
+	1,  // 18: navigator.types.v1alpha1.ListenerSummary.type:type_name -> navigator.types.v1alpha1.ListenerType
+	34, // 19: navigator.types.v1alpha1.ListenerSummary.rules:type_name -> navigator.types.v1alpha1.ListenerRule
+	35, // 20: navigator.types.v1alpha1.ListenerSummary.filter_chains:type_name -> navigator.types.v1alpha1.FilterChainSummary
+	16, // 21: navigator.types.v1alpha1.ListenerSummary.sources:type_name -> navigator.types.v1alpha1.ListenerSource
+	15, // 22: navigator.types.v1alpha1.ListenerSummary.port_protocols:type_name -> navigator.types.v1alpha1.PortProtocol
+	6,  // 23: navigator.types.v1alpha1.PortProtocol.detection:type_name -> navigator.types.v1alpha1.ProtocolDetection
+	4,  // 24: navigator.types.v1alpha1.ClusterSummary.direction:type_name -> navigator.types.v1alpha1.ClusterDirection
+	40, // 25: navigator.types.v1alpha1.ClusterSummary.metadata:type_name -> navigator.types.v1alpha1.ClusterSummary.MetadataEntry
+	18, // 26: navigator.types.v1alpha1.ClusterSummary.tls:type_name -> navigator.types.v1alpha1.UpstreamTlsInfo
+	20, // 27: navigator.types.v1alpha1.EndpointSummary.endpoints:type_name -> navigator.types.v1alpha1.EndpointInfo
+	3,  // 28: navigator.types.v1alpha1.EndpointSummary.cluster_type:type_name -> navigator.types.v1alpha1.ClusterType
+	4,  // 29: navigator.types.v1alpha1.EndpointSummary.direction:type_name -> navigator.types.v1alpha1.ClusterDirection
+	41, // 30: navigator.types.v1alpha1.EndpointInfo.metadata:type_name -> navigator.types.v1alpha1.EndpointInfo.MetadataEntry
+	5,  // 31: navigator.types.v1alpha1.EndpointInfo.address_type:type_name -> navigator.types.v1alpha1.AddressType
+	10, // 32: navigator.types.v1alpha1.EndpointInfo.locality:type_name -> navigator.types.v1alpha1.LocalityInfo
+	22, // 33: navigator.types.v1alpha1.RouteConfigSummary.virtual_hosts:type_name -> navigator.types.v1alpha1.VirtualHostInfo
+	2,  // 34: navigator.types.v1alpha1.RouteConfigSummary.type:type_name -> navigator.types.v1alpha1.RouteType
+	23, // 35: navigator.types.v1alpha1.VirtualHostInfo.routes:type_name -> navigator.types.v1alpha1.RouteInfo
+	24, // 36: navigator.types.v1alpha1.RouteInfo.match:type_name -> navigator.types.v1alpha1.RouteMatchInfo
+	25, // 37: navigator.types.v1alpha1.RouteInfo.action:type_name -> navigator.types.v1alpha1.RouteActionInfo
+	42, // 38: navigator.types.v1alpha1.RouteInfo.metadata:type_name -> navigator.types.v1alpha1.RouteInfo.MetadataEntry
+	32, // 39: navigator.types.v1alpha1.RouteMatchInfo.header_matches:type_name -> navigator.types.v1alpha1.HeaderMatchInfo
+	26, // 40: navigator.types.v1alpha1.RouteActionInfo.weighted_clusters:type_name -> navigator.types.v1alpha1.WeightedClusterInfo
+	43, // 41: navigator.types.v1alpha1.WeightedClusterInfo.metadata_match:type_name -> navigator.types.v1alpha1.WeightedClusterInfo.MetadataMatchEntry
+	28, // 42: navigator.types.v1alpha1.ListenerMatch.http_route:type_name -> navigator.types.v1alpha1.HttpRouteMatch
+	29, // 43: navigator.types.v1alpha1.ListenerMatch.filter_chain:type_name -> navigator.types.v1alpha1.FilterChainMatch
+	30, // 44: navigator.types.v1alpha1.ListenerMatch.tcp_proxy:type_name -> navigator.types.v1alpha1.TcpProxyMatch
+	31, // 45: navigator.types.v1alpha1.HttpRouteMatch.path_match:type_name -> navigator.types.v1alpha1.PathMatchInfo
+	32, // 46: navigator.types.v1alpha1.HttpRouteMatch.header_matches:type_name -> navigator.types.v1alpha1.HeaderMatchInfo
+	27, // 47: navigator.types.v1alpha1.ListenerRule.match:type_name -> navigator.types.v1alpha1.ListenerMatch
+	33, // 48: navigator.types.v1alpha1.ListenerRule.destination:type_name -> navigator.types.v1alpha1.ListenerDestination
+	36, // 49: navigator.types.v1alpha1.FilterChainSummary.http_filters:type_name -> navigator.types.v1alpha1.FilterInfo
+	36, // 50: navigator.types.v1alpha1.FilterChainSummary.network_filters:type_name -> navigator.types.v1alpha1.FilterInfo
+	23, // 51: navigator.types.v1alpha1.RouteSimulationMatch.route:type_name -> navigator.types.v1alpha1.RouteInfo
+	38, // 52: navigator.types.v1alpha1.RouteSimulationMatch.destinations:type_name -> navigator.types.v1alpha1.RouteSimulationDestination
+	53, // [53:53] is the sub-list for method output_type
+	53, // [53:53] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_types_v1alpha1_proxy_types_proto_init() }
@@ -3124,6 +3853,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 	if File_types_v1alpha1_proxy_types_proto != nil {
 		return
 	}
+	file_types_v1alpha1_istio_resources_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_types_v1alpha1_proxy_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*ProxyConfig); i {
@@ -3222,7 +3952,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ClusterSummary); i {
+			switch v := v.(*PortProtocol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,7 +3964,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*EndpointSummary); i {
+			switch v := v.(*ListenerSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,7 +3976,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*EndpointInfo); i {
+			switch v := v.(*ClusterSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,7 +3988,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*RouteConfigSummary); i {
+			switch v := v.(*UpstreamTlsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,7 +4000,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*VirtualHostInfo); i {
+			switch v := v.(*EndpointSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,7 +4012,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*RouteInfo); i {
+			switch v := v.(*EndpointInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,7 +4024,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*RouteMatchInfo); i {
+			switch v := v.(*RouteConfigSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,7 +4036,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*RouteActionInfo); i {
+			switch v := v.(*VirtualHostInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,7 +4048,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*WeightedClusterInfo); i {
+			switch v := v.(*RouteInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,7 +4060,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListenerMatch); i {
+			switch v := v.(*RouteMatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,7 +4072,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*HttpRouteMatch); i {
+			switch v := v.(*RouteActionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,7 +4084,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterChainMatch); i {
+			switch v := v.(*WeightedClusterInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,7 +4096,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*TcpProxyMatch); i {
+			switch v := v.(*ListenerMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,7 +4108,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*PathMatchInfo); i {
+			switch v := v.(*HttpRouteMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,7 +4120,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*HeaderMatchInfo); i {
+			switch v := v.(*FilterChainMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,7 +4132,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*ListenerDestination); i {
+			switch v := v.(*TcpProxyMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,7 +4144,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*ListenerRule); i {
+			switch v := v.(*PathMatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,7 +4156,7 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterChainSummary); i {
+			switch v := v.(*HeaderMatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,6 +4168,42 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 			}
 		}
 		file_types_v1alpha1_proxy_types_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ListenerDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_proxy_types_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListenerRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_proxy_types_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterChainSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_proxy_types_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*FilterInfo); i {
 			case 0:
 				return &v.state
@@ -3449,8 +4215,32 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 				return nil
 			}
 		}
+		file_types_v1alpha1_proxy_types_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteSimulationMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1alpha1_proxy_types_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteSimulationDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_types_v1alpha1_proxy_types_proto_msgTypes[17].OneofWrappers = []any{
+	file_types_v1alpha1_proxy_types_proto_msgTypes[20].OneofWrappers = []any{
 		(*ListenerMatch_HttpRoute)(nil),
 		(*ListenerMatch_FilterChain)(nil),
 		(*ListenerMatch_TcpProxy)(nil),
@@ -3460,8 +4250,8 @@ func file_types_v1alpha1_proxy_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v1alpha1_proxy_types_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   30,
+			NumEnums:      7,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,79 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admin defines which Envoy admin endpoints may be accessed through Navigator.
+// Only read-only endpoints are allowed; requests are always issued as GET.
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// allowedPaths are the Envoy admin endpoints that can be tunneled to a proxy
+var allowedPaths = map[string]bool{
+	"certs":            true,
+	"clusters":         true,
+	"config_dump":      true,
+	"listeners":        true,
+	"logging":          true,
+	"memory":           true,
+	"ready":            true,
+	"runtime":          true,
+	"server_info":      true,
+	"stats":            true,
+	"stats/prometheus": true,
+}
+
+// queryFreePaths are the allowlisted endpoints that only report state without a query string. With one, they
+// change it, such as "logging?level=debug" setting the proxy's log level.
+var queryFreePaths = map[string]bool{
+	"logging": true,
+}
+
+// AllowedPaths returns the allowlisted admin endpoints in sorted order
+func AllowedPaths() []string {
+	paths := make([]string, 0, len(allowedPaths))
+	for path := range allowedPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// BuildRequestPath validates an admin path and query string and combines them into
+// the form expected by pilot-agent (e.g. "stats?filter=http"). A leading slash is ignored.
+func BuildRequestPath(path, query string) (string, error) {
+	path = strings.TrimPrefix(path, "/")
+	if !allowedPaths[path] {
+		return "", fmt.Errorf("envoy admin path %q is not allowed (allowed: %s)", path, strings.Join(AllowedPaths(), ", "))
+	}
+
+	query = strings.TrimPrefix(query, "?")
+	if query == "" {
+		return path, nil
+	}
+	if queryFreePaths[path] {
+		return "", fmt.Errorf("envoy admin path %q does not accept a query string", path)
+	}
+	for _, r := range query {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", fmt.Errorf("envoy admin query must not contain whitespace or control characters")
+		}
+	}
+
+	return path + "?" + query, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRequestPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		query    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "plain path", path: "config_dump", expected: "config_dump"},
+		{name: "leading slash", path: "/stats", expected: "stats"},
+		{name: "nested path", path: "stats/prometheus", expected: "stats/prometheus"},
+		{name: "with query", path: "stats", query: "filter=^http&format=json", expected: "stats?filter=^http&format=json"},
+		{name: "query with leading question mark", path: "config_dump", query: "?resource=dynamic_listeners", expected: "config_dump?resource=dynamic_listeners"},
+		{name: "mutating endpoint", path: "quitquitquit", wantErr: true},
+		{name: "logging levels", path: "logging", expected: "logging"},
+		{name: "changing log level", path: "logging", query: "level=debug", wantErr: true},
+		{name: "changing log level with leading question mark", path: "/logging", query: "?paths=http:debug", wantErr: true},
+		{name: "path traversal", path: "stats/../quitquitquit", wantErr: true},
+		{name: "empty path", path: "", wantErr: true},
+		{name: "whitespace in query", path: "stats", query: "filter=a b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := BuildRequestPath(tt.path, tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
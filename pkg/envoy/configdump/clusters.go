@@ -19,6 +19,8 @@ import (
 
 	admin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
@@ -89,6 +91,9 @@ func (p *Parser) summarizeCluster(cluster *clusterv3.Cluster, parsed *ParsedConf
 		summary.ConnectTimeout = cluster.ConnectTimeout.String()
 	}
 
+	summary.Metadata = flattenMetadata(cluster.Metadata)
+	summary.Tls = summarizeUpstreamTLS(cluster)
+
 	// Load assignment details are processed separately in endpoints.go
 	// This keeps cluster and endpoint concerns properly separated
 
@@ -105,6 +110,51 @@ func (p *Parser) summarizeCluster(cluster *clusterv3.Cluster, parsed *ParsedConf
 	return summary
 }
 
+// summarizeUpstreamTLS returns the TLS settings of the cluster's transport socket. Without one, the TLS
+// settings of the first transport socket match using TLS are returned, as TLS is then selected per endpoint.
+func summarizeUpstreamTLS(cluster *clusterv3.Cluster) *v1alpha1.UpstreamTlsInfo {
+	if tls := upstreamTLSContext(cluster.GetTransportSocket()); tls != nil {
+		return summarizeUpstreamTLSContext(tls)
+	}
+	for _, match := range cluster.GetTransportSocketMatches() {
+		if tls := upstreamTLSContext(match.GetTransportSocket()); tls != nil {
+			summary := summarizeUpstreamTLSContext(tls)
+			summary.PerEndpoint = true
+			return summary
+		}
+	}
+	return nil
+}
+
+// upstreamTLSContext returns the TLS context of a TLS transport socket, or nil for other transport sockets
+func upstreamTLSContext(socket *corev3.TransportSocket) *tlsv3.UpstreamTlsContext {
+	config := socket.GetTypedConfig()
+	if config == nil {
+		return nil
+	}
+	tls := &tlsv3.UpstreamTlsContext{}
+	if err := config.UnmarshalTo(tls); err != nil {
+		return nil
+	}
+	return tls
+}
+
+// summarizeUpstreamTLSContext summarizes the server name and SDS secrets of an upstream TLS context
+func summarizeUpstreamTLSContext(tls *tlsv3.UpstreamTlsContext) *v1alpha1.UpstreamTlsInfo {
+	common := tls.GetCommonTlsContext()
+	summary := &v1alpha1.UpstreamTlsInfo{Sni: tls.GetSni()}
+	for _, secret := range common.GetTlsCertificateSdsSecretConfigs() {
+		summary.Certificates = append(summary.Certificates, secret.GetName())
+	}
+	switch validation := common.GetValidationContextType().(type) {
+	case *tlsv3.CommonTlsContext_ValidationContextSdsSecretConfig:
+		summary.Validation = validation.ValidationContextSdsSecretConfig.GetName()
+	case *tlsv3.CommonTlsContext_CombinedValidationContext:
+		summary.Validation = validation.CombinedValidationContext.GetValidationContextSdsSecretConfig().GetName()
+	}
+	return summary
+}
+
 // parseClusterName provides basic cluster name parsing for generic Envoy deployments
 // This function only extracts basic information without service mesh assumptions
 func (p *Parser) parseClusterName(clusterName string, summary *v1alpha1.ClusterSummary) {
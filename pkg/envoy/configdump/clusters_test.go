@@ -18,7 +18,12 @@ import (
 	"testing"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
@@ -128,3 +133,71 @@ func TestParser_summarizeCluster(t *testing.T) {
 	assert.Equal(t, "test-cluster", summary.ServiceFqdn)    // Generic behavior: cluster name as FQDN
 	assert.Contains(t, summary.RawConfig, `"test-cluster"`) // Should contain raw JSON
 }
+
+// tlsTransportSocket returns a TLS transport socket presenting the certificate secrets and validating with
+// the validation secret
+func tlsTransportSocket(t *testing.T, sni string, certificates []string, validation string) *corev3.TransportSocket {
+	common := &tlsv3.CommonTlsContext{}
+	for _, certificate := range certificates {
+		common.TlsCertificateSdsSecretConfigs = append(common.TlsCertificateSdsSecretConfigs, &tlsv3.SdsSecretConfig{Name: certificate})
+	}
+	if validation != "" {
+		common.ValidationContextType = &tlsv3.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &tlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+				ValidationContextSdsSecretConfig: &tlsv3.SdsSecretConfig{Name: validation},
+			},
+		}
+	}
+	config, err := anypb.New(&tlsv3.UpstreamTlsContext{Sni: sni, CommonTlsContext: common})
+	require.NoError(t, err)
+	return &corev3.TransportSocket{
+		Name:       "envoy.transport_sockets.tls",
+		ConfigType: &corev3.TransportSocket_TypedConfig{TypedConfig: config},
+	}
+}
+
+func TestParser_summarizeClusterTLSAndMetadata(t *testing.T) {
+	parser := NewParser()
+	istioMetadata, err := structpb.NewStruct(map[string]interface{}{
+		"config":   "/apis/networking.istio.io/v1alpha3/namespaces/demo/destination-rule/backend",
+		"services": []interface{}{map[string]interface{}{"host": "backend.demo.svc.cluster.local"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("transport socket", func(t *testing.T) {
+		cluster := &clusterv3.Cluster{
+			Name:            "outbound|8080|v1|backend.demo.svc.cluster.local",
+			Metadata:        &corev3.Metadata{FilterMetadata: map[string]*structpb.Struct{"istio": istioMetadata}},
+			TransportSocket: tlsTransportSocket(t, "outbound_.8080_.v1_.backend.demo.svc.cluster.local", []string{"default"}, "ROOTCA"),
+		}
+
+		summary := parser.summarizeCluster(cluster, &ParsedConfig{})
+		assert.Equal(t, map[string]string{"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/demo/destination-rule/backend"}, summary.Metadata)
+		require.NotNil(t, summary.Tls)
+		assert.Equal(t, "outbound_.8080_.v1_.backend.demo.svc.cluster.local", summary.Tls.Sni)
+		assert.Equal(t, []string{"default"}, summary.Tls.Certificates)
+		assert.Equal(t, "ROOTCA", summary.Tls.Validation)
+		assert.False(t, summary.Tls.PerEndpoint)
+	})
+
+	t.Run("transport socket matches", func(t *testing.T) {
+		cluster := &clusterv3.Cluster{
+			Name: "outbound|8080||backend.demo.svc.cluster.local",
+			TransportSocketMatches: []*clusterv3.Cluster_TransportSocketMatch{
+				{Name: "tlsMode-istio", TransportSocket: tlsTransportSocket(t, "", []string{"default"}, "ROOTCA")},
+				{Name: "tlsMode-disabled", TransportSocket: &corev3.TransportSocket{Name: "envoy.transport_sockets.raw_buffer"}},
+			},
+		}
+
+		summary := parser.summarizeCluster(cluster, &ParsedConfig{})
+		assert.Empty(t, summary.Metadata)
+		require.NotNil(t, summary.Tls)
+		assert.True(t, summary.Tls.PerEndpoint)
+		assert.Equal(t, "ROOTCA", summary.Tls.Validation)
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		summary := parser.summarizeCluster(&clusterv3.Cluster{Name: "BlackHoleCluster"}, &ParsedConfig{})
+		assert.Nil(t, summary.Tls)
+	})
+}
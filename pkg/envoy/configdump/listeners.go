@@ -16,12 +16,14 @@ package configdump
 
 import (
 	"fmt"
+	"slices"
 
 	admin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
@@ -96,10 +98,143 @@ func (p *Parser) summarizeListener(listener *listenerv3.Listener, parsed *Parsed
 	rules, filterChains := p.parseListenerFilters(listener)
 	summary.Rules = rules
 	summary.FilterChains = filterChains
+	summary.RouteConfigs = listenerRouteConfigs(listener)
+	summary.PortProtocols = listenerPortProtocols(listener, summary.Port)
 
 	return summary
 }
 
+// listenerRouteConfigs returns the names of the route configurations a listener loads over RDS, in order
+func listenerRouteConfigs(listener *listenerv3.Listener) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, filterChain := range listener.FilterChains {
+		for _, filter := range filterChain.Filters {
+			if filter.Name != "envoy.filters.network.http_connection_manager" || filter.GetTypedConfig() == nil {
+				continue
+			}
+			var hcmConfig hcm.HttpConnectionManager
+			if err := filter.GetTypedConfig().UnmarshalTo(&hcmConfig); err != nil {
+				continue
+			}
+			if name := hcmConfig.GetRds().GetRouteConfigName(); name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// listenerPortProtocols returns the application protocols a listener selects for each destination port its
+// filter chains serve, in order of first appearance. Filter chains without a destination port match serve the
+// listener's own port. Istio only generates both HTTP and TCP filter chains for a port when it sniffs the protocol,
+// so a port served by both is reported as sniffed.
+func listenerPortProtocols(listener *listenerv3.Listener, listenerPort uint32) []*v1alpha1.PortProtocol {
+	var ports []uint32
+	protocols := make(map[uint32][]string)
+	for _, filterChain := range listener.FilterChains {
+		chainProtocols := filterChainProtocols(filterChain)
+		if len(chainProtocols) == 0 {
+			continue
+		}
+		port := listenerPort
+		if destinationPort := filterChain.GetFilterChainMatch().GetDestinationPort(); destinationPort != nil {
+			port = destinationPort.GetValue()
+		}
+		if _, exists := protocols[port]; !exists {
+			ports = append(ports, port)
+		}
+		for _, protocol := range chainProtocols {
+			if !slices.Contains(protocols[port], protocol) {
+				protocols[port] = append(protocols[port], protocol)
+			}
+		}
+	}
+
+	var portProtocols []*v1alpha1.PortProtocol
+	for _, port := range ports {
+		portProtocols = append(portProtocols, &v1alpha1.PortProtocol{
+			Port:      port,
+			Protocols: protocols[port],
+			Detection: protocolDetection(protocols[port]),
+		})
+	}
+	return portProtocols
+}
+
+// filterChainProtocols returns the application protocols a filter chain serves: HTTP/1.1 and/or HTTP/2 for
+// HTTP connection managers, and TCP or TLS for TCP proxies. TLS is reported for filter chains matching TLS
+// connections they do not terminate.
+func filterChainProtocols(filterChain *listenerv3.FilterChain) []string {
+	for _, filter := range filterChain.Filters {
+		switch filter.Name {
+		case HTTPConnectionManager:
+			return httpProtocols(filter, filterChain.GetFilterChainMatch().GetApplicationProtocols())
+		case TCPProxy:
+			if filterChain.GetFilterChainMatch().GetTransportProtocol() == "tls" && filterChain.TransportSocket == nil {
+				return []string{ProtocolTLS}
+			}
+			return []string{ProtocolTCP}
+		}
+	}
+	return nil
+}
+
+// httpProtocols returns the HTTP versions an HTTP connection manager serves, from the ALPN values its filter
+// chain matches, falling back to its codec type when the filter chain does not match on ALPN
+func httpProtocols(filter *listenerv3.Filter, applicationProtocols []string) []string {
+	var http1, http2 bool
+	for _, applicationProtocol := range applicationProtocols {
+		switch applicationProtocol {
+		case "http/1.0", "http/1.1", "istio-http/1.0", "istio-http/1.1":
+			http1 = true
+		case "h2", "h2c", "istio-h2":
+			http2 = true
+		}
+	}
+	if !http1 && !http2 {
+		var hcmConfig hcm.HttpConnectionManager
+		if typedConfig := filter.GetTypedConfig(); typedConfig != nil {
+			// A config that fails to unmarshal is treated as the default AUTO codec
+			_ = typedConfig.UnmarshalTo(&hcmConfig)
+		}
+		switch hcmConfig.CodecType {
+		case hcm.HttpConnectionManager_HTTP1:
+			http1 = true
+		case hcm.HttpConnectionManager_HTTP2:
+			http2 = true
+		default:
+			http1, http2 = true, true
+		}
+	}
+
+	var protocols []string
+	if http1 {
+		protocols = append(protocols, ProtocolHTTP1)
+	}
+	if http2 {
+		protocols = append(protocols, ProtocolHTTP2)
+	}
+	return protocols
+}
+
+// protocolDetection reports a port as sniffed when it is served by both HTTP and non-HTTP filter chains
+func protocolDetection(protocols []string) v1alpha1.ProtocolDetection {
+	var http, other bool
+	for _, protocol := range protocols {
+		if protocol == ProtocolHTTP1 || protocol == ProtocolHTTP2 {
+			http = true
+		} else {
+			other = true
+		}
+	}
+	if http && other {
+		return v1alpha1.ProtocolDetection_SNIFFED
+	}
+	return v1alpha1.ProtocolDetection_EXPLICIT
+}
+
 // parseListenerFilters extracts matched rules and filter chain info from listener
 func (p *Parser) parseListenerFilters(listener *listenerv3.Listener) ([]*v1alpha1.ListenerRule, *v1alpha1.FilterChainSummary) {
 	if listener == nil || len(listener.FilterChains) == 0 {
@@ -347,6 +482,25 @@ func (p *Parser) parseHeaderMatch(headerMatch *route.HeaderMatcher) *v1alpha1.He
 	case *route.HeaderMatcher_PresentMatch:
 		headerMatchInfo.MatchType = "present"
 		headerMatchInfo.Value = ""
+	case *route.HeaderMatcher_StringMatch:
+		stringMatch := headerMatch.GetStringMatch()
+		switch stringMatch.GetMatchPattern().(type) {
+		case *matcher.StringMatcher_Exact:
+			headerMatchInfo.MatchType = "exact"
+			headerMatchInfo.Value = stringMatch.GetExact()
+		case *matcher.StringMatcher_Prefix:
+			headerMatchInfo.MatchType = "prefix"
+			headerMatchInfo.Value = stringMatch.GetPrefix()
+		case *matcher.StringMatcher_Suffix:
+			headerMatchInfo.MatchType = "suffix"
+			headerMatchInfo.Value = stringMatch.GetSuffix()
+		case *matcher.StringMatcher_SafeRegex:
+			headerMatchInfo.MatchType = "regex"
+			headerMatchInfo.Value = stringMatch.GetSafeRegex().GetRegex()
+		case *matcher.StringMatcher_Contains:
+			headerMatchInfo.MatchType = "contains"
+			headerMatchInfo.Value = stringMatch.GetContains()
+		}
 	}
 
 	return headerMatchInfo
@@ -279,6 +279,20 @@ func TestParser_parseHeaderMatch(t *testing.T) {
 			expectedType:  "present",
 			expectedValue: "",
 		},
+		{
+			name: "string matcher header match",
+			headerMatcher: &route.HeaderMatcher{
+				Name: ":method",
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{
+						MatchPattern: &matcherv3.StringMatcher_Exact{Exact: "GET"},
+					},
+				},
+			},
+			expectedName:  ":method",
+			expectedType:  "exact",
+			expectedValue: "GET",
+		},
 		{
 			name: "inverted header match",
 			headerMatcher: &route.HeaderMatcher{
@@ -589,3 +603,87 @@ func TestParser_parseListenerFilters_EmptyListener(t *testing.T) {
 		assert.Equal(t, &v1alpha1.FilterChainSummary{}, filterChains)
 	})
 }
+
+func TestListenerRouteConfigs(t *testing.T) {
+	rdsFilter := func(routeConfigName string) *listenerv3.Filter {
+		hcmAny, err := anypb.New(&hcm.HttpConnectionManager{
+			RouteSpecifier: &hcm.HttpConnectionManager_Rds{
+				Rds: &hcm.Rds{RouteConfigName: routeConfigName},
+			},
+		})
+		require.NoError(t, err)
+		return &listenerv3.Filter{
+			Name:       "envoy.filters.network.http_connection_manager",
+			ConfigType: &listenerv3.Filter_TypedConfig{TypedConfig: hcmAny},
+		}
+	}
+
+	listener := &listenerv3.Listener{
+		Name: "0.0.0.0_8443",
+		FilterChains: []*listenerv3.FilterChain{
+			{Filters: []*listenerv3.Filter{rdsFilter("https.443.https.bookinfo-gateway.bookinfo")}},
+			{Filters: []*listenerv3.Filter{rdsFilter("https.443.https-admin.admin-gateway.admin")}},
+			{Filters: []*listenerv3.Filter{rdsFilter("https.443.https.bookinfo-gateway.bookinfo")}},
+			{Filters: []*listenerv3.Filter{{Name: "envoy.filters.network.tcp_proxy"}}},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"https.443.https.bookinfo-gateway.bookinfo",
+		"https.443.https-admin.admin-gateway.admin",
+	}, listenerRouteConfigs(listener))
+	assert.Nil(t, listenerRouteConfigs(&listenerv3.Listener{Name: "empty-listener"}))
+}
+
+func TestListenerPortProtocols(t *testing.T) {
+	hcmFilter := func(codecType hcm.HttpConnectionManager_CodecType) *listenerv3.Filter {
+		hcmAny, err := anypb.New(&hcm.HttpConnectionManager{CodecType: codecType})
+		require.NoError(t, err)
+		return &listenerv3.Filter{
+			Name:       HTTPConnectionManager,
+			ConfigType: &listenerv3.Filter_TypedConfig{TypedConfig: hcmAny},
+		}
+	}
+	tcpFilter := &listenerv3.Filter{Name: TCPProxy}
+
+	t.Run("sniffed outbound port", func(t *testing.T) {
+		listener := &listenerv3.Listener{
+			Name: "0.0.0.0_8080",
+			FilterChains: []*listenerv3.FilterChain{
+				{
+					FilterChainMatch: &listenerv3.FilterChainMatch{ApplicationProtocols: []string{"http/1.1", "h2c"}},
+					Filters:          []*listenerv3.Filter{hcmFilter(hcm.HttpConnectionManager_AUTO)},
+				},
+				{Filters: []*listenerv3.Filter{tcpFilter}},
+			},
+		}
+
+		assert.Equal(t, []*v1alpha1.PortProtocol{
+			{Port: 8080, Protocols: []string{ProtocolHTTP1, ProtocolHTTP2, ProtocolTCP}, Detection: v1alpha1.ProtocolDetection_SNIFFED},
+		}, listenerPortProtocols(listener, 8080))
+	})
+
+	t.Run("explicit ports on the virtual inbound listener", func(t *testing.T) {
+		destinationPort := func(port uint32, transportProtocol string) *listenerv3.FilterChainMatch {
+			return &listenerv3.FilterChainMatch{DestinationPort: wrapperspb.UInt32(port), TransportProtocol: transportProtocol}
+		}
+		listener := &listenerv3.Listener{
+			Name: "virtualInbound",
+			FilterChains: []*listenerv3.FilterChain{
+				{FilterChainMatch: destinationPort(9080, "raw_buffer"), Filters: []*listenerv3.Filter{hcmFilter(hcm.HttpConnectionManager_HTTP2)}},
+				{FilterChainMatch: destinationPort(3306, "raw_buffer"), Filters: []*listenerv3.Filter{tcpFilter}},
+				{FilterChainMatch: destinationPort(8443, "tls"), Filters: []*listenerv3.Filter{tcpFilter}},
+				{FilterChainMatch: destinationPort(9080, "raw_buffer"), Filters: []*listenerv3.Filter{hcmFilter(hcm.HttpConnectionManager_HTTP2)}},
+				{Filters: []*listenerv3.Filter{{Name: "envoy.filters.network.rbac"}}},
+			},
+		}
+
+		assert.Equal(t, []*v1alpha1.PortProtocol{
+			{Port: 9080, Protocols: []string{ProtocolHTTP2}, Detection: v1alpha1.ProtocolDetection_EXPLICIT},
+			{Port: 3306, Protocols: []string{ProtocolTCP}, Detection: v1alpha1.ProtocolDetection_EXPLICIT},
+			{Port: 8443, Protocols: []string{ProtocolTLS}, Detection: v1alpha1.ProtocolDetection_EXPLICIT},
+		}, listenerPortProtocols(listener, 15006))
+	})
+
+	assert.Nil(t, listenerPortProtocols(&listenerv3.Listener{Name: "empty-listener"}, 0))
+}
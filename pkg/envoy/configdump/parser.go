@@ -42,6 +42,15 @@ const (
 	TCPProxy = "envoy.filters.network.tcp_proxy"
 )
 
+// Application protocols reported for the ports served by a listener
+const (
+	ProtocolHTTP1 = "HTTP/1.1"
+	ProtocolHTTP2 = "HTTP/2"
+	ProtocolTCP   = "TCP"
+	// ProtocolTLS is TLS passed through without termination
+	ProtocolTLS = "TLS"
+)
+
 // BlackHoleCluster to catch traffic from routes with unresolved clusters
 const BlackHoleCluster = "BlackHoleCluster"
 
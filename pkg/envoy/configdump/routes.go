@@ -18,8 +18,10 @@ import (
 	"fmt"
 
 	admin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
@@ -154,7 +156,8 @@ func (p *Parser) summarizeRouteConfig(routeConfig *routev3.RouteConfiguration, p
 			// Extract match information (basic)
 			if match := route.Match; match != nil {
 				routeInfo.Match = &v1alpha1.RouteMatchInfo{
-					CaseSensitive: match.CaseSensitive.GetValue(),
+					// Envoy matches paths case sensitively unless told otherwise
+					CaseSensitive: match.CaseSensitive == nil || match.CaseSensitive.GetValue(),
 				}
 
 				// Extract path specifier
@@ -169,8 +172,16 @@ func (p *Parser) summarizeRouteConfig(routeConfig *routev3.RouteConfiguration, p
 					routeInfo.Match.PathSpecifier = "safe_regex"
 					routeInfo.Match.Path = ps.SafeRegex.Regex
 				}
+
+				for _, header := range match.Headers {
+					if headerMatch := p.parseHeaderMatch(header); headerMatch != nil {
+						routeInfo.Match.HeaderMatches = append(routeInfo.Match.HeaderMatches, headerMatch)
+					}
+				}
 			}
 
+			routeInfo.Metadata = flattenMetadata(route.Metadata)
+
 			// Extract action information (basic)
 			switch action := route.Action.(type) {
 			case *routev3.Route_Route:
@@ -209,3 +220,19 @@ func (p *Parser) summarizeRouteConfig(routeConfig *routev3.RouteConfiguration, p
 
 	return summary
 }
+
+// flattenMetadata flattens string-valued filter metadata into "<filter>.<key>" entries
+func flattenMetadata(metadata *corev3.Metadata) map[string]string {
+	var flattened map[string]string
+	for filter, fields := range metadata.GetFilterMetadata() {
+		for key, value := range fields.GetFields() {
+			if str, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+				if flattened == nil {
+					flattened = make(map[string]string)
+				}
+				flattened[filter+"."+key] = str.StringValue
+			}
+		}
+	}
+	return flattened
+}
@@ -17,7 +17,11 @@ package configdump
 import (
 	"testing"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
@@ -96,3 +100,50 @@ func TestDetermineRouteType(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_summarizeRouteConfig(t *testing.T) {
+	parser := NewParser()
+
+	routeConfig := &routev3.RouteConfiguration{
+		Name: "9080",
+		VirtualHosts: []*routev3.VirtualHost{{
+			Name:    "reviews.default.svc.cluster.local:9080",
+			Domains: []string{"reviews.default.svc.cluster.local", "reviews"},
+			Routes: []*routev3.Route{{
+				Name: "v2",
+				Match: &routev3.RouteMatch{
+					PathSpecifier: &routev3.RouteMatch_Prefix{Prefix: "/"},
+					Headers: []*routev3.HeaderMatcher{{
+						Name:                 "end-user",
+						HeaderMatchSpecifier: &routev3.HeaderMatcher_ExactMatch{ExactMatch: "jason"},
+					}},
+				},
+				Metadata: &corev3.Metadata{
+					FilterMetadata: map[string]*structpb.Struct{
+						"istio": {Fields: map[string]*structpb.Value{
+							"config": structpb.NewStringValue("/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews"),
+							"weight": structpb.NewNumberValue(100),
+						}},
+					},
+				},
+				Action: &routev3.Route_Route{Route: &routev3.RouteAction{
+					ClusterSpecifier: &routev3.RouteAction_Cluster{Cluster: "outbound|9080|v2|reviews.default.svc.cluster.local"},
+				}},
+			}},
+		}},
+	}
+
+	summary := parser.summarizeRouteConfig(routeConfig, nil)
+	require.Len(t, summary.VirtualHosts, 1)
+	require.Len(t, summary.VirtualHosts[0].Routes, 1)
+
+	route := summary.VirtualHosts[0].Routes[0]
+	assert.True(t, route.Match.CaseSensitive)
+	require.Len(t, route.Match.HeaderMatches, 1)
+	assert.Equal(t, "end-user", route.Match.HeaderMatches[0].Name)
+	assert.Equal(t, "exact", route.Match.HeaderMatches[0].MatchType)
+	assert.Equal(t, "jason", route.Match.HeaderMatches[0].Value)
+	assert.Equal(t, map[string]string{
+		"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews",
+	}, route.Metadata)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analysis checks the Istio configuration collected from a cluster for problems such as
+// resources that refer to missing resources or that apply to nothing.
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// cluster is the state a check runs against
+type cluster struct {
+	id            string
+	state         *backendv1alpha1.ClusterState
+	graph         *references.Graph
+	rootNamespace string
+	inbound       []*typesv1alpha1.ServicePairMetrics
+}
+
+// check inspects a cluster and reports the problems it finds
+type check func(c *cluster) []*typesv1alpha1.AnalysisFinding
+
+// checks are run in order against every analyzed cluster
+var checks = []check{
+	checkGatewayWorkloads,
+	checkVirtualServiceGateways,
+	checkDestinationRuleSubsets,
+	checkDestinationRuleConflicts,
+	checkVirtualServiceHosts,
+	checkPolicyWorkloads,
+	checkPeerAuthenticationConflicts,
+	checkNamespacePermissiveDefault,
+	checkStrictPlaintextTraffic,
+	checkDeprecatedAPIVersions,
+}
+
+// Analyze runs every check against a cluster's state and returns the findings ordered by resource and code.
+// inbound are the inbound connection metrics of the cluster's services, which metrics-based checks compare
+// the configuration against; those checks report nothing without them.
+func Analyze(clusterID string, state *backendv1alpha1.ClusterState, inbound []*typesv1alpha1.ServicePairMetrics) []*typesv1alpha1.AnalysisFinding {
+	if state == nil {
+		return nil
+	}
+
+	c := newCluster(clusterID, state, inbound)
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, check := range checks {
+		findings = append(findings, check(c)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i].Resource, findings[j].Resource
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return findings[i].Code < findings[j].Code
+	})
+
+	return findings
+}
+
+// newCluster prepares a cluster's state for analysis
+func newCluster(clusterID string, state *backendv1alpha1.ClusterState, inbound []*typesv1alpha1.ServicePairMetrics) *cluster {
+	c := &cluster{
+		id:            clusterID,
+		state:         state,
+		graph:         references.Build(clusterID, state),
+		rootNamespace: references.DefaultRootNamespace,
+		inbound:       inbound,
+	}
+	if config := state.IstioControlPlaneConfig; config != nil && config.RootNamespace != "" {
+		c.rootNamespace = config.RootNamespace
+	}
+	return c
+}
+
+// ref returns a reference to a resource in the analyzed cluster
+func (c *cluster) ref(kind, namespace, name string) *typesv1alpha1.ResourceRef {
+	return &typesv1alpha1.ResourceRef{
+		ClusterId: c.id,
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+// selectsWorkloads reports whether a resource's selector applies to at least one workload
+func (c *cluster) selectsWorkloads(kind, namespace, name string) bool {
+	for _, reference := range c.graph.Uses(kind, namespace, name) {
+		if reference.Type == typesv1alpha1.ReferenceType_REFERENCE_TYPE_WORKLOAD_SELECTOR {
+			return true
+		}
+	}
+	return false
+}
+
+// objectMeta is the part of a resource's metadata that checks read from its raw config
+type objectMeta struct {
+	CreationTimestamp time.Time `json:"creationTimestamp"`
+}
+
+// clientNamespaces returns the namespaces running workloads, in order
+func (c *cluster) clientNamespaces() []string {
+	namespaceSet := make(map[string]bool)
+	for _, service := range c.state.Services {
+		namespaceSet[service.Namespace] = true
+	}
+	namespaces := make([]string, 0, len(namespaceSet))
+	for namespace := range namespaceSet {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// CodeDeprecatedAPIVersion is reported for resources last written with an API version that has a stable replacement
+const CodeDeprecatedAPIVersion = "DeprecatedAPIVersion"
+
+// stableAPIVersions maps the pre-v1 API versions of Istio kinds that are served at v1 to their replacement.
+// EnvoyFilter and WasmPlugin have no stable version and are not checked.
+var stableAPIVersions = map[string]string{
+	"networking.istio.io/v1alpha3": "networking.istio.io/v1",
+	"networking.istio.io/v1beta1":  "networking.istio.io/v1",
+	"security.istio.io/v1beta1":    "security.istio.io/v1",
+}
+
+// versionedResource is a resource that records the API version it was written with
+type versionedResource interface {
+	GetName() string
+	GetNamespace() string
+	GetApiVersion() string
+}
+
+// checkDeprecatedAPIVersions reports resources whose clients still write them with an API version that has a
+// stable replacement, which should be migrated before upgrading to an Istio release that stops serving it
+func checkDeprecatedAPIVersions(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	var findings []*typesv1alpha1.AnalysisFinding
+	findings = append(findings, deprecatedAPIVersions(c, references.KindAuthorizationPolicy, c.state.AuthorizationPolicies)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindDestinationRule, c.state.DestinationRules)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindGateway, c.state.Gateways)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindPeerAuthentication, c.state.PeerAuthentications)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindRequestAuthentication, c.state.RequestAuthentications)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindServiceEntry, c.state.ServiceEntries)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindSidecar, c.state.Sidecars)...)
+	findings = append(findings, deprecatedAPIVersions(c, references.KindVirtualService, c.state.VirtualServices)...)
+	return findings
+}
+
+// deprecatedAPIVersions reports the resources of a kind written with a deprecated API version
+func deprecatedAPIVersions[T versionedResource](c *cluster, kind string, resources []T) []*typesv1alpha1.AnalysisFinding {
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, resource := range resources {
+		stable, deprecated := stableAPIVersions[resource.GetApiVersion()]
+		if !deprecated {
+			continue
+		}
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodeDeprecatedAPIVersion,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_INFO,
+			Resource: c.ref(kind, resource.GetNamespace(), resource.GetName()),
+			Message:  fmt.Sprintf("last written with deprecated apiVersion %s; migrate to %s", resource.GetApiVersion(), stable),
+		})
+	}
+	return findings
+}
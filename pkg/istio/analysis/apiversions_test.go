@@ -0,0 +1,54 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+func TestCheckDeprecatedAPIVersions(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "default", ApiVersion: "networking.istio.io/v1alpha3"},
+			{Name: "ratings", Namespace: "default", ApiVersion: "networking.istio.io/v1"},
+			{Name: "details", Namespace: "default"},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+			{Name: "allow", Namespace: "default", ApiVersion: "security.istio.io/v1beta1"},
+		},
+		EnvoyFilters: []*typesv1alpha1.EnvoyFilter{
+			{Name: "lua", Namespace: "default", ApiVersion: "networking.istio.io/v1alpha3"},
+		},
+	}
+
+	findings := checkDeprecatedAPIVersions(newTestCluster(state))
+	require.Len(t, findings, 2)
+
+	assert.Equal(t, CodeDeprecatedAPIVersion, findings[0].Code)
+	assert.Equal(t, typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_INFO, findings[0].Severity)
+	assert.Equal(t, references.KindAuthorizationPolicy, findings[0].Resource.Kind)
+	assert.Equal(t, "last written with deprecated apiVersion security.istio.io/v1beta1; migrate to security.istio.io/v1", findings[0].Message)
+
+	assert.Equal(t, references.KindVirtualService, findings[1].Resource.Kind)
+	assert.Equal(t, "reviews", findings[1].Resource.Name)
+	assert.Equal(t, "last written with deprecated apiVersion networking.istio.io/v1alpha3; migrate to networking.istio.io/v1", findings[1].Message)
+}
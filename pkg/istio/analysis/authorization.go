@@ -0,0 +1,169 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"sort"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/authz"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// AuthorizationPolicyDryRun is the effect a drafted AuthorizationPolicy would have on observed traffic
+type AuthorizationPolicyDryRun struct {
+	// Workloads are the pods the drafted policy applies to
+	Workloads []*typesv1alpha1.ResourceRef
+	// FlowsEvaluated is the number of observed flows to the services of those pods
+	FlowsEvaluated int
+	// DeniedFlows are the flows allowed by the current policies that the drafted policy would deny
+	DeniedFlows []*typesv1alpha1.DeniedFlow
+}
+
+// AuthorizationPolicyServices returns the services with at least one workload a drafted policy applies to.
+// Their inbound metrics are what DryRunAuthorizationPolicy evaluates the policy against.
+func AuthorizationPolicyServices(state *backendv1alpha1.ClusterState, draft *typesv1alpha1.AuthorizationPolicy) []*backendv1alpha1.Service {
+	if state == nil {
+		return nil
+	}
+	c := newCluster("", state, nil)
+
+	var services []*backendv1alpha1.Service
+	for _, service := range state.Services {
+		if len(c.draftedWorkloads(service, draft)) > 0 {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// DryRunAuthorizationPolicy evaluates the observed inbound flows of the workloads a drafted policy applies to,
+// both with the cluster's current AuthorizationPolicies and with the drafted policy added, and reports the
+// flows whose verdict would get worse. The drafted policy replaces any existing policy with the same name
+// and namespace.
+func DryRunAuthorizationPolicy(clusterID string, state *backendv1alpha1.ClusterState, draft *typesv1alpha1.AuthorizationPolicy, inbound []*typesv1alpha1.ServicePairMetrics) *AuthorizationPolicyDryRun {
+	result := &AuthorizationPolicyDryRun{}
+	if state == nil {
+		return result
+	}
+	c := newCluster(clusterID, state, inbound)
+
+	current := state.AuthorizationPolicies
+	drafted := []*typesv1alpha1.AuthorizationPolicy{draft}
+	for _, policy := range current {
+		if policy.Name != draft.Name || policy.Namespace != draft.Namespace {
+			drafted = append(drafted, policy)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, service := range state.Services {
+		workloads := c.draftedWorkloads(service, draft)
+		if len(workloads) == 0 {
+			continue
+		}
+		for _, instance := range workloads {
+			key := service.Namespace + "/" + instance.PodName
+			if !seen[key] {
+				seen[key] = true
+				result.Workloads = append(result.Workloads, c.ref(references.KindPod, service.Namespace, instance.PodName))
+			}
+		}
+
+		for _, pair := range inbound {
+			if pair.DestinationService != service.Name || pair.DestinationNamespace != service.Namespace {
+				continue
+			}
+			result.FlowsEvaluated++
+
+			req := authz.Request{SourceNamespace: pair.SourceNamespace, MTLS: flowMTLS(pair)}
+			var flow *typesv1alpha1.DeniedFlow
+			for _, instance := range workloads {
+				before := authz.Evaluate(filters.FilterAuthorizationPoliciesForWorkload(current, instance, service.Namespace, c.rootNamespace), req)
+				after := authz.Evaluate(filters.FilterAuthorizationPoliciesForWorkload(drafted, instance, service.Namespace, c.rootNamespace), req)
+				// Verdicts are ordered from allowed to denied
+				if after.Verdict <= before.Verdict {
+					continue
+				}
+				if flow == nil {
+					flow = &typesv1alpha1.DeniedFlow{
+						SourceCluster:        pair.SourceCluster,
+						SourceNamespace:      pair.SourceNamespace,
+						SourceService:        pair.SourceService,
+						DestinationNamespace: pair.DestinationNamespace,
+						DestinationService:   pair.DestinationService,
+						RequestRate:          pair.RequestRate,
+					}
+				}
+				if after.Verdict > flow.Verdict {
+					flow.Verdict = after.Verdict
+					flow.Reason = after.Reason
+				}
+				flow.Workloads = append(flow.Workloads, c.ref(references.KindPod, service.Namespace, instance.PodName))
+			}
+			if flow != nil {
+				result.DeniedFlows = append(result.DeniedFlows, flow)
+			}
+		}
+	}
+
+	sort.SliceStable(result.Workloads, func(i, j int) bool {
+		a, b := result.Workloads[i], result.Workloads[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	sort.SliceStable(result.DeniedFlows, func(i, j int) bool {
+		a, b := result.DeniedFlows[i], result.DeniedFlows[j]
+		if a.SourceNamespace != b.SourceNamespace {
+			return a.SourceNamespace < b.SourceNamespace
+		}
+		if a.SourceService != b.SourceService {
+			return a.SourceService < b.SourceService
+		}
+		if a.DestinationNamespace != b.DestinationNamespace {
+			return a.DestinationNamespace < b.DestinationNamespace
+		}
+		return a.DestinationService < b.DestinationService
+	})
+
+	return result
+}
+
+// draftedWorkloads returns the instances of a service that a drafted policy applies to
+func (c *cluster) draftedWorkloads(service *backendv1alpha1.Service, draft *typesv1alpha1.AuthorizationPolicy) []*backendv1alpha1.ServiceInstance {
+	var workloads []*backendv1alpha1.ServiceInstance
+	for _, instance := range service.Instances {
+		if len(filters.FilterAuthorizationPoliciesForWorkload([]*typesv1alpha1.AuthorizationPolicy{draft}, instance, service.Namespace, c.rootNamespace)) > 0 {
+			workloads = append(workloads, instance)
+		}
+	}
+	return workloads
+}
+
+// flowMTLS returns whether the requests of a flow were sent with mTLS, from the share of them received in plaintext
+func flowMTLS(pair *typesv1alpha1.ServicePairMetrics) authz.MTLSMode {
+	switch {
+	case pair.PlaintextRequestRate <= 0:
+		return authz.MTLSEnabled
+	case pair.PlaintextRequestRate >= pair.RequestRate:
+		return authz.MTLSDisabled
+	default:
+		return authz.MTLSUnknown
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/authz"
+)
+
+func authorizationClusterState() *backendv1alpha1.ClusterState {
+	return &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews"}}},
+			},
+			{
+				Name:      "ratings",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{{PodName: "ratings-v1", Labels: map[string]string{"app": "ratings"}}},
+			},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+			{
+				Name:      "deny-legacy",
+				Namespace: "default",
+				RawConfig: `{"spec":{"action":"DENY","rules":[{"from":[{"source":{"namespaces":["legacy"]}}]}]}}`,
+			},
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Selector:  &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+				RawConfig: `{"spec":{"rules":[{"from":[{"source":{"namespaces":["frontend","monitoring"]}}]}]}}`,
+			},
+		},
+	}
+}
+
+func draftedReviewsPolicy(t *testing.T) *typesv1alpha1.AuthorizationPolicy {
+	policy, err := authz.Parse(`
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: reviews
+  rules:
+  - from:
+    - source:
+        namespaces: ["frontend"]
+`)
+	require.NoError(t, err)
+	return policy
+}
+
+func TestAuthorizationPolicyServices(t *testing.T) {
+	services := AuthorizationPolicyServices(authorizationClusterState(), draftedReviewsPolicy(t))
+	require.Len(t, services, 1)
+	assert.Equal(t, "reviews", services[0].Name)
+}
+
+func TestDryRunAuthorizationPolicy(t *testing.T) {
+	inbound := []*typesv1alpha1.ServicePairMetrics{
+		{SourceNamespace: "monitoring", SourceService: "prober", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 0.2},
+		{SourceNamespace: "frontend", SourceService: "productpage", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 5},
+		{SourceNamespace: "legacy", SourceService: "batch", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 1},
+		{SourceNamespace: "monitoring", SourceService: "prober", DestinationNamespace: "default", DestinationService: "ratings", RequestRate: 0.2},
+	}
+
+	result := DryRunAuthorizationPolicy("cluster-1", authorizationClusterState(), draftedReviewsPolicy(t), inbound)
+
+	require.Len(t, result.Workloads, 1)
+	assert.Equal(t, "reviews-v1", result.Workloads[0].Name)
+	assert.Equal(t, 3, result.FlowsEvaluated)
+
+	// The drafted policy replaces the existing policy of the same name, and traffic denied today is not reported
+	require.Len(t, result.DeniedFlows, 1)
+	flow := result.DeniedFlows[0]
+	assert.Equal(t, "monitoring", flow.SourceNamespace)
+	assert.Equal(t, "prober", flow.SourceService)
+	assert.Equal(t, "reviews", flow.DestinationService)
+	assert.Equal(t, 0.2, flow.RequestRate)
+	assert.Equal(t, typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED, flow.Verdict)
+	assert.Equal(t, "no ALLOW policy matches", flow.Reason)
+	require.Len(t, flow.Workloads, 1)
+	assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: "Pod", Namespace: "default", Name: "reviews-v1"}, flow.Workloads[0])
+}
+
+func TestDryRunAuthorizationPolicy_NoWorkloads(t *testing.T) {
+	draft := draftedReviewsPolicy(t)
+	draft.Selector.MatchLabels = map[string]string{"app": "details"}
+
+	result := DryRunAuthorizationPolicy("cluster-1", authorizationClusterState(), draft, nil)
+	assert.Empty(t, result.Workloads)
+	assert.Zero(t, result.FlowsEvaluated)
+	assert.Empty(t, result.DeniedFlows)
+}
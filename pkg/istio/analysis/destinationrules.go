@@ -0,0 +1,180 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// CodeDestinationRuleConflict is reported for a DestinationRule whose TLS mode is overridden by another rule for the same host
+const CodeDestinationRuleConflict = "DestinationRuleConflict"
+
+// unsetTLSMode describes a DestinationRule that leaves the TLS mode to auto mTLS
+const unsetTLSMode = "unset"
+
+// destinationRuleConfig is the part of a DestinationRule's raw config that conflict detection reads
+type destinationRuleConfig struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		TrafficPolicy struct {
+			TLS struct {
+				Mode string `json:"mode"`
+			} `json:"tls"`
+		} `json:"trafficPolicy"`
+	} `json:"spec"`
+}
+
+// destinationRule is a DestinationRule along with the fields conflict detection compares
+type destinationRule struct {
+	rule    *typesv1alpha1.DestinationRule
+	created time.Time
+	tlsMode string
+}
+
+// destinationRuleConflict is a rule overridden by another for the clients in some namespaces
+type destinationRuleConflict struct {
+	overridden *destinationRule
+	winner     *destinationRule
+	namespaces []string
+}
+
+// checkDestinationRuleConflicts reports DestinationRules for a service host whose TLS mode differs from the
+// rule Istio applies. For clients in a namespace Istio uses the rules visible to it in the client's namespace,
+// then the service's namespace, then the root namespace; rules at the same level are merged with the oldest
+// rule's traffic policy taking effect. Rules with a workload selector are not checked.
+func checkDestinationRuleConflicts(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	rules := make(map[*typesv1alpha1.DestinationRule]*destinationRule)
+	for _, dr := range c.state.DestinationRules {
+		if len(dr.WorkloadSelector.GetMatchLabels()) > 0 {
+			continue
+		}
+		var config destinationRuleConfig
+		if err := rawconfig.Decode(dr, &config); err != nil {
+			continue
+		}
+		tlsMode := config.Spec.TrafficPolicy.TLS.Mode
+		if tlsMode == "" {
+			tlsMode = unsetTLSMode
+		}
+		rules[dr] = &destinationRule{rule: dr, created: config.Metadata.CreationTimestamp, tlsMode: tlsMode}
+	}
+
+	namespaces := c.clientNamespaces()
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, service := range c.state.Services {
+		var candidates []*destinationRule
+		for _, dr := range filters.FilterDestinationRulesForHost(c.state.DestinationRules, service.Name, service.Namespace) {
+			if rule, ok := rules[dr]; ok {
+				candidates = append(candidates, rule)
+			}
+		}
+		if len(candidates) < 2 {
+			continue
+		}
+
+		var conflicts []*destinationRuleConflict
+		for _, namespace := range namespaces {
+			visible := c.destinationRulePrecedence(candidates, namespace, service.Namespace)
+			if len(visible) < 2 {
+				continue
+			}
+			for _, overridden := range visible[1:] {
+				if overridden.tlsMode == visible[0].tlsMode {
+					continue
+				}
+				conflict := findConflict(conflicts, overridden, visible[0])
+				if conflict == nil {
+					conflict = &destinationRuleConflict{overridden: overridden, winner: visible[0]}
+					conflicts = append(conflicts, conflict)
+				}
+				conflict.namespaces = append(conflict.namespaces, namespace)
+			}
+		}
+
+		host := qualifyHost(service.Name, service.Namespace)
+		for _, conflict := range conflicts {
+			overridden, winner := conflict.overridden.rule, conflict.winner.rule
+			findings = append(findings, &typesv1alpha1.AnalysisFinding{
+				Code:     CodeDestinationRuleConflict,
+				Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+				Resource: c.ref(references.KindDestinationRule, overridden.Namespace, overridden.Name),
+				Message: fmt.Sprintf("TLS mode %s for host %s is overridden by DestinationRule %s/%s (TLS mode %s) for clients in namespaces %s",
+					conflict.overridden.tlsMode, host, winner.Namespace, winner.Name, conflict.winner.tlsMode, strings.Join(conflict.namespaces, ", ")),
+				Related: []*typesv1alpha1.ResourceRef{
+					c.ref(references.KindDestinationRule, winner.Namespace, winner.Name),
+					c.ref(references.KindService, service.Namespace, service.Name),
+				},
+			})
+		}
+	}
+	return findings
+}
+
+// destinationRulePrecedence returns the rules visible to clients in a namespace, most preferred first
+func (c *cluster) destinationRulePrecedence(rules []*destinationRule, clientNamespace, serviceNamespace string) []*destinationRule {
+	level := func(rule *destinationRule) int {
+		switch rule.rule.Namespace {
+		case clientNamespace:
+			return 0
+		case serviceNamespace:
+			return 1
+		case c.rootNamespace:
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	var visible []*destinationRule
+	for _, rule := range rules {
+		if filters.IsVisibleToNamespace(rule.rule, clientNamespace) {
+			visible = append(visible, rule)
+		}
+	}
+
+	sort.SliceStable(visible, func(i, j int) bool {
+		a, b := visible[i], visible[j]
+		if level(a) != level(b) {
+			return level(a) < level(b)
+		}
+		if !a.created.Equal(b.created) {
+			return a.created.Before(b.created)
+		}
+		if a.rule.Name != b.rule.Name {
+			return a.rule.Name < b.rule.Name
+		}
+		return a.rule.Namespace < b.rule.Namespace
+	})
+	return visible
+}
+
+// findConflict returns the recorded conflict between two rules, if any
+func findConflict(conflicts []*destinationRuleConflict, overridden, winner *destinationRule) *destinationRuleConflict {
+	for _, conflict := range conflicts {
+		if conflict.overridden == overridden && conflict.winner == winner {
+			return conflict
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+func destinationRuleRawConfig(created, tlsMode string) string {
+	return `{"metadata":{"creationTimestamp":"` + created + `"},"spec":{"trafficPolicy":{"tls":{"mode":"` + tlsMode + `"}}}}`
+}
+
+func TestCheckDestinationRuleConflicts(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{Name: "reviews", Namespace: "default"},
+			{Name: "productpage", Namespace: "frontend"},
+		},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{Name: "mesh-default", Namespace: "istio-system", Host: "*.local", RawConfig: destinationRuleRawConfig("2024-01-01T00:00:00Z", "ISTIO_MUTUAL")},
+			{Name: "reviews", Namespace: "default", Host: "reviews", RawConfig: destinationRuleRawConfig("2024-02-01T00:00:00Z", "ISTIO_MUTUAL")},
+			{Name: "reviews-disable", Namespace: "default", Host: "reviews.default.svc.cluster.local", RawConfig: destinationRuleRawConfig("2024-03-01T00:00:00Z", "DISABLE")},
+			{Name: "reviews-frontend", Namespace: "frontend", Host: "reviews.default.svc.cluster.local", ExportTo: []string{"."}, RawConfig: destinationRuleRawConfig("2024-04-01T00:00:00Z", "SIMPLE")},
+			{
+				Name:             "reviews-canary",
+				Namespace:        "default",
+				Host:             "reviews",
+				WorkloadSelector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "canary"}},
+				RawConfig:        destinationRuleRawConfig("2024-01-01T00:00:00Z", "DISABLE"),
+			},
+		},
+	}
+	c := newTestCluster(state)
+
+	findings := checkDestinationRuleConflicts(c)
+
+	type conflict struct{ overridden, winner, message string }
+	var conflicts []conflict
+	for _, finding := range findings {
+		assert.Equal(t, CodeDestinationRuleConflict, finding.Code)
+		require.Len(t, finding.Related, 2)
+		conflicts = append(conflicts, conflict{
+			overridden: finding.Resource.Namespace + "/" + finding.Resource.Name,
+			winner:     finding.Related[0].Namespace + "/" + finding.Related[0].Name,
+			message:    finding.Message,
+		})
+	}
+
+	require.Len(t, conflicts, 4)
+	assert.Equal(t, "default/reviews-disable", conflicts[0].overridden)
+	assert.Equal(t, "default/reviews", conflicts[0].winner)
+	assert.Equal(t, "TLS mode DISABLE for host reviews.default.svc.cluster.local is overridden by DestinationRule default/reviews (TLS mode ISTIO_MUTUAL) for clients in namespaces default", conflicts[0].message)
+	assert.Equal(t, "default/reviews", conflicts[1].overridden)
+	assert.Equal(t, "frontend/reviews-frontend", conflicts[1].winner)
+	assert.Equal(t, "default/reviews-disable", conflicts[2].overridden)
+	assert.Equal(t, "frontend/reviews-frontend", conflicts[2].winner)
+	assert.Equal(t, "istio-system/mesh-default", conflicts[3].overridden)
+	assert.Equal(t, "frontend/reviews-frontend", conflicts[3].winner)
+	assert.Contains(t, conflicts[3].message, "for clients in namespaces frontend")
+}
+
+func TestCheckDestinationRuleConflicts_SameTLSMode(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{{Name: "reviews", Namespace: "default"}},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{Name: "reviews", Namespace: "default", Host: "reviews", RawConfig: destinationRuleRawConfig("2024-01-01T00:00:00Z", "ISTIO_MUTUAL")},
+			{Name: "reviews-subsets", Namespace: "default", Host: "reviews", RawConfig: destinationRuleRawConfig("2024-02-01T00:00:00Z", "ISTIO_MUTUAL")},
+		},
+	}
+
+	assert.Empty(t, checkDestinationRuleConflicts(newTestCluster(state)))
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Codes of the orphaned and dangling resource checks
+const (
+	CodeGatewayNoWorkloads               = "GatewayNoWorkloads"
+	CodeVirtualServiceUnknownGateway     = "VirtualServiceUnknownGateway"
+	CodeDestinationRuleSubsetNoEndpoints = "DestinationRuleSubsetNoEndpoints"
+	CodePolicyNoWorkloads                = "PolicyNoWorkloads"
+)
+
+// checkGatewayWorkloads reports Gateways whose selector matches no gateway workloads
+func checkGatewayWorkloads(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, gateway := range c.state.Gateways {
+		if c.selectsWorkloads(references.KindGateway, gateway.Namespace, gateway.Name) {
+			continue
+		}
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodeGatewayNoWorkloads,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+			Resource: c.ref(references.KindGateway, gateway.Namespace, gateway.Name),
+			Message:  fmt.Sprintf("selector %q matches no gateway workloads", labels.Set(gateway.Selector).String()),
+		})
+	}
+	return findings
+}
+
+// checkVirtualServiceGateways reports VirtualServices bound to gateways that do not exist
+func checkVirtualServiceGateways(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, vs := range c.state.VirtualServices {
+		for _, gateway := range vs.Gateways {
+			if gateway == references.MeshGateway {
+				continue
+			}
+			namespace, name := vs.Namespace, gateway
+			if ns, n, found := strings.Cut(gateway, "/"); found {
+				namespace, name = ns, n
+			}
+			if _, exists := c.graph.Resource(references.KindGateway, namespace, name); exists {
+				continue
+			}
+			findings = append(findings, &typesv1alpha1.AnalysisFinding{
+				Code:     CodeVirtualServiceUnknownGateway,
+				Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_ERROR,
+				Resource: c.ref(references.KindVirtualService, vs.Namespace, vs.Name),
+				Message:  fmt.Sprintf("gateway %s/%s does not exist", namespace, name),
+			})
+		}
+	}
+	return findings
+}
+
+// checkDestinationRuleSubsets reports DestinationRule subsets whose labels match no endpoints of the
+// services the rule applies to. Rules for hosts outside the cluster's services are not checked.
+func checkDestinationRuleSubsets(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	instances := make(map[string][]map[string]string)
+	for _, service := range c.state.Services {
+		key := service.Namespace + "/" + service.Name
+		for _, instance := range service.Instances {
+			instances[key] = append(instances[key], instance.Labels)
+		}
+	}
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, dr := range c.state.DestinationRules {
+		var services []*typesv1alpha1.ResourceRef
+		for _, reference := range c.graph.Uses(references.KindDestinationRule, dr.Namespace, dr.Name) {
+			if reference.Type == typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST {
+				services = append(services, reference.To)
+			}
+		}
+		if len(services) == 0 {
+			continue
+		}
+
+		for _, subset := range dr.Subsets {
+			selector := labels.SelectorFromSet(subset.Labels)
+			matched := false
+			for _, service := range services {
+				for _, instanceLabels := range instances[service.Namespace+"/"+service.Name] {
+					if selector.Matches(labels.Set(instanceLabels)) {
+						matched = true
+						break
+					}
+				}
+				if matched {
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+			findings = append(findings, &typesv1alpha1.AnalysisFinding{
+				Code:     CodeDestinationRuleSubsetNoEndpoints,
+				Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+				Resource: c.ref(references.KindDestinationRule, dr.Namespace, dr.Name),
+				Message:  fmt.Sprintf("subset %q labels %q match no endpoints of host %s", subset.Name, labels.Set(subset.Labels).String(), dr.Host),
+				Related:  services,
+			})
+		}
+	}
+	return findings
+}
+
+// checkPolicyWorkloads reports policies with a workload selector that matches no workloads.
+// Policies without a selector apply to their whole namespace and are not checked.
+func checkPolicyWorkloads(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	var findings []*typesv1alpha1.AnalysisFinding
+	report := func(kind, namespace, name string, selector *typesv1alpha1.WorkloadSelector) {
+		if len(selector.GetMatchLabels()) == 0 || c.selectsWorkloads(kind, namespace, name) {
+			return
+		}
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodePolicyNoWorkloads,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+			Resource: c.ref(kind, namespace, name),
+			Message:  fmt.Sprintf("selector %q matches no workloads", labels.Set(selector.GetMatchLabels()).String()),
+		})
+	}
+
+	for _, policy := range c.state.AuthorizationPolicies {
+		report(references.KindAuthorizationPolicy, policy.Namespace, policy.Name, policy.Selector)
+	}
+	for _, policy := range c.state.PeerAuthentications {
+		report(references.KindPeerAuthentication, policy.Namespace, policy.Name, policy.Selector)
+	}
+	for _, policy := range c.state.RequestAuthentications {
+		report(references.KindRequestAuthentication, policy.Namespace, policy.Name, policy.Selector)
+	}
+	for _, sidecar := range c.state.Sidecars {
+		report(references.KindSidecar, sidecar.Namespace, sidecar.Name, sidecar.WorkloadSelector)
+	}
+	for _, filter := range c.state.EnvoyFilters {
+		report(references.KindEnvoyFilter, filter.Namespace, filter.Name, filter.WorkloadSelector)
+	}
+	for _, plugin := range c.state.WasmPlugins {
+		report(references.KindWasmPlugin, plugin.Namespace, plugin.Name, plugin.Selector)
+	}
+	return findings
+}
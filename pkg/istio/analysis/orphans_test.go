@@ -0,0 +1,157 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+func testClusterState() *backendv1alpha1.ClusterState {
+	return &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{
+					{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews", "version": "v1"}, ProxyMode: typesv1alpha1.ProxyMode_SIDECAR},
+				},
+			},
+			{
+				Name:      "istio-ingressgateway",
+				Namespace: "istio-system",
+				Instances: []*backendv1alpha1.ServiceInstance{
+					{PodName: "ingress", Labels: map[string]string{"istio": "ingressgateway"}, ProxyMode: typesv1alpha1.ProxyMode_ROUTER},
+				},
+			},
+		},
+		Gateways: []*typesv1alpha1.Gateway{
+			{Name: "public", Namespace: "istio-system", Selector: map[string]string{"istio": "ingressgateway"}},
+			{Name: "internal", Namespace: "istio-system", Selector: map[string]string{"istio": "internalgateway"}},
+		},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "default", Hosts: []string{"reviews"}, Gateways: []string{"mesh", "istio-system/public", "missing"}},
+		},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Host:      "reviews",
+				Subsets: []*typesv1alpha1.DestinationRuleSubset{
+					{Name: "v1", Labels: map[string]string{"version": "v1"}},
+					{Name: "v2", Labels: map[string]string{"version": "v2"}},
+				},
+			},
+			{
+				Name:      "external",
+				Namespace: "default",
+				Host:      "api.example.com",
+				Subsets:   []*typesv1alpha1.DestinationRuleSubset{{Name: "v1", Labels: map[string]string{"version": "v1"}}},
+			},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+			{Name: "reviews-v1", Namespace: "default", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"version": "v1"}}},
+			{Name: "reviews-v2", Namespace: "default", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"version": "v2"}}},
+			{Name: "namespace-wide", Namespace: "other"},
+		},
+		Sidecars: []*typesv1alpha1.Sidecar{
+			{Name: "ratings", Namespace: "default", WorkloadSelector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}}},
+		},
+	}
+}
+
+func TestCheckGatewayWorkloads(t *testing.T) {
+	c := newTestCluster(testClusterState())
+
+	findings := checkGatewayWorkloads(c)
+	require.Len(t, findings, 1)
+	assert.Equal(t, CodeGatewayNoWorkloads, findings[0].Code)
+	assert.Equal(t, typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING, findings[0].Severity)
+	assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: references.KindGateway, Namespace: "istio-system", Name: "internal"}, findings[0].Resource)
+	assert.Contains(t, findings[0].Message, "istio=internalgateway")
+}
+
+func TestCheckVirtualServiceGateways(t *testing.T) {
+	c := newTestCluster(testClusterState())
+
+	findings := checkVirtualServiceGateways(c)
+	require.Len(t, findings, 1)
+	assert.Equal(t, CodeVirtualServiceUnknownGateway, findings[0].Code)
+	assert.Equal(t, typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_ERROR, findings[0].Severity)
+	assert.Equal(t, "reviews", findings[0].Resource.Name)
+	assert.Equal(t, "gateway default/missing does not exist", findings[0].Message)
+}
+
+func TestCheckDestinationRuleSubsets(t *testing.T) {
+	c := newTestCluster(testClusterState())
+
+	findings := checkDestinationRuleSubsets(c)
+	require.Len(t, findings, 1)
+	assert.Equal(t, CodeDestinationRuleSubsetNoEndpoints, findings[0].Code)
+	assert.Equal(t, "reviews", findings[0].Resource.Name)
+	assert.Contains(t, findings[0].Message, `subset "v2"`)
+	require.Len(t, findings[0].Related, 1)
+	assert.Equal(t, references.KindService, findings[0].Related[0].Kind)
+	assert.Equal(t, "reviews", findings[0].Related[0].Name)
+}
+
+func TestCheckPolicyWorkloads(t *testing.T) {
+	c := newTestCluster(testClusterState())
+
+	findings := checkPolicyWorkloads(c)
+	require.Len(t, findings, 2)
+	assert.Equal(t, references.KindAuthorizationPolicy, findings[0].Resource.Kind)
+	assert.Equal(t, "reviews-v2", findings[0].Resource.Name)
+	assert.Equal(t, references.KindSidecar, findings[1].Resource.Kind)
+	assert.Equal(t, "ratings", findings[1].Resource.Name)
+	for _, finding := range findings {
+		assert.Equal(t, CodePolicyNoWorkloads, finding.Code)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Run("findings are ordered by resource", func(t *testing.T) {
+		findings := Analyze("cluster-1", testClusterState(), nil)
+		require.Len(t, findings, 7)
+
+		var kinds []string
+		for _, finding := range findings {
+			kinds = append(kinds, finding.Resource.Kind)
+		}
+		assert.Equal(t, []string{
+			references.KindAuthorizationPolicy,
+			references.KindDestinationRule,
+			references.KindGateway,
+			KindNamespace,
+			KindNamespace,
+			references.KindSidecar,
+			references.KindVirtualService,
+		}, kinds)
+	})
+
+	t.Run("nil state", func(t *testing.T) {
+		assert.Empty(t, Analyze("cluster-1", nil, nil))
+	})
+}
+
+func newTestCluster(state *backendv1alpha1.ClusterState) *cluster {
+	return newCluster("cluster-1", state, nil)
+}
@@ -0,0 +1,324 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// Codes of the PeerAuthentication checks
+const (
+	CodePeerAuthenticationConflict = "PeerAuthenticationConflict"
+	CodeNamespacePermissiveDefault = "NamespacePermissiveDefault"
+	CodeStrictPlaintextTraffic     = "StrictPlaintextTraffic"
+)
+
+// KindNamespace is the kind of findings about a namespace as a whole
+const KindNamespace = "Namespace"
+
+// mTLS modes of a PeerAuthentication
+const (
+	mtlsModeUnset      = "UNSET"
+	mtlsModePermissive = "PERMISSIVE"
+	mtlsModeStrict     = "STRICT"
+)
+
+// peerAuthenticationConfig is the part of a PeerAuthentication's raw config that mTLS checks read
+type peerAuthenticationConfig struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Mtls struct {
+			Mode string `json:"mode"`
+		} `json:"mtls"`
+	} `json:"spec"`
+}
+
+// peerAuthentication is a PeerAuthentication along with its creation time and mTLS mode
+type peerAuthentication struct {
+	policy  *typesv1alpha1.PeerAuthentication
+	created time.Time
+	mode    string
+}
+
+// peerAuthenticationConflict is a policy overridden by another at the same level for some workloads
+type peerAuthenticationConflict struct {
+	overridden *peerAuthentication
+	winner     *peerAuthentication
+	workloads  []*typesv1alpha1.ResourceRef
+}
+
+// peerAuthentications returns the cluster's PeerAuthentications whose raw config could be read
+func (c *cluster) peerAuthentications() []*peerAuthentication {
+	var policies []*peerAuthentication
+	for _, policy := range c.state.PeerAuthentications {
+		var config peerAuthenticationConfig
+		if err := rawconfig.Decode(policy, &config); err != nil {
+			continue
+		}
+		mode := config.Spec.Mtls.Mode
+		if mode == "" {
+			mode = mtlsModeUnset
+		}
+		policies = append(policies, &peerAuthentication{policy: policy, created: config.Metadata.CreationTimestamp, mode: mode})
+	}
+	return policies
+}
+
+// peerAuthenticationLevels returns the policies applying to a workload by precedence: workload-specific
+// policies, then namespace-wide policies, then mesh-wide policies in the root namespace. Each level is
+// ordered oldest first, which is the policy Istio applies when a level has several.
+func (c *cluster) peerAuthenticationLevels(policies []*peerAuthentication, instance *backendv1alpha1.ServiceInstance, namespace string) [3][]*peerAuthentication {
+	byPolicy := make(map[*typesv1alpha1.PeerAuthentication]*peerAuthentication, len(policies))
+	all := make([]*typesv1alpha1.PeerAuthentication, 0, len(policies))
+	for _, policy := range policies {
+		byPolicy[policy.policy] = policy
+		all = append(all, policy.policy)
+	}
+
+	var levels [3][]*peerAuthentication
+	for _, match := range filters.FilterPeerAuthenticationsForWorkload(all, instance, namespace, c.rootNamespace) {
+		level := 2
+		switch {
+		case len(match.Selector.GetMatchLabels()) > 0:
+			level = 0
+		case match.Namespace == namespace:
+			level = 1
+		}
+		levels[level] = append(levels[level], byPolicy[match])
+	}
+	for _, level := range levels {
+		sort.SliceStable(level, func(i, j int) bool {
+			return olderPeerAuthentication(level[i], level[j])
+		})
+	}
+	return levels
+}
+
+// effectiveMTLSMode returns the mTLS mode Istio applies to a workload and the policy that sets it.
+// Policies with an unset mode inherit from the next level; with no policy the mesh default is PERMISSIVE.
+func (c *cluster) effectiveMTLSMode(policies []*peerAuthentication, instance *backendv1alpha1.ServiceInstance, namespace string) (string, *peerAuthentication) {
+	for _, level := range c.peerAuthenticationLevels(policies, instance, namespace) {
+		if len(level) > 0 && level[0].mode != mtlsModeUnset {
+			return level[0].mode, level[0]
+		}
+	}
+	return mtlsModePermissive, nil
+}
+
+// checkPeerAuthenticationConflicts reports PeerAuthentications overridden for a workload by an older policy
+// at the same level with a different mTLS mode
+func checkPeerAuthenticationConflicts(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	policies := c.peerAuthentications()
+
+	var conflicts []*peerAuthenticationConflict
+	c.forEachWorkload(func(namespace string, instance *backendv1alpha1.ServiceInstance) {
+		for _, level := range c.peerAuthenticationLevels(policies, instance, namespace) {
+			if len(level) < 2 {
+				continue
+			}
+			for _, overridden := range level[1:] {
+				if overridden.mode == level[0].mode {
+					continue
+				}
+				var conflict *peerAuthenticationConflict
+				for _, existing := range conflicts {
+					if existing.overridden == overridden && existing.winner == level[0] {
+						conflict = existing
+						break
+					}
+				}
+				if conflict == nil {
+					conflict = &peerAuthenticationConflict{overridden: overridden, winner: level[0]}
+					conflicts = append(conflicts, conflict)
+				}
+				conflict.workloads = append(conflict.workloads, c.ref(references.KindPod, namespace, instance.PodName))
+			}
+		}
+	})
+
+	findings := make([]*typesv1alpha1.AnalysisFinding, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		overridden, winner := conflict.overridden.policy, conflict.winner.policy
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodePeerAuthenticationConflict,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+			Resource: c.ref(references.KindPeerAuthentication, overridden.Namespace, overridden.Name),
+			Message: fmt.Sprintf("mTLS mode %s is overridden by the older PeerAuthentication %s/%s (mode %s) for %d workloads",
+				conflict.overridden.mode, winner.Namespace, winner.Name, conflict.winner.mode, len(conflict.workloads)),
+			Related: append([]*typesv1alpha1.ResourceRef{c.ref(references.KindPeerAuthentication, winner.Namespace, winner.Name)}, conflict.workloads...),
+		})
+	}
+	return findings
+}
+
+// checkNamespacePermissiveDefault reports namespaces running workloads that neither a namespace-wide nor a
+// mesh-wide PeerAuthentication sets an mTLS mode for, leaving them on the PERMISSIVE mesh default
+func checkNamespacePermissiveDefault(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	var namespaceWide []*peerAuthentication
+	for _, policy := range c.peerAuthentications() {
+		if len(policy.policy.Selector.GetMatchLabels()) == 0 {
+			namespaceWide = append(namespaceWide, policy)
+		}
+	}
+	sort.SliceStable(namespaceWide, func(i, j int) bool {
+		return olderPeerAuthentication(namespaceWide[i], namespaceWide[j])
+	})
+
+	// The oldest policy in a namespace is the one Istio applies
+	modeOf := func(namespace string) string {
+		for _, policy := range namespaceWide {
+			if policy.policy.Namespace == namespace {
+				return policy.mode
+			}
+		}
+		return mtlsModeUnset
+	}
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, namespace := range c.clientNamespaces() {
+		if modeOf(namespace) != mtlsModeUnset || modeOf(c.rootNamespace) != mtlsModeUnset {
+			continue
+		}
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodeNamespacePermissiveDefault,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_INFO,
+			Resource: c.ref(KindNamespace, "", namespace),
+			Message:  "no namespace-wide or mesh-wide PeerAuthentication sets an mTLS mode, so workloads fall back to the PERMISSIVE mesh default and accept plaintext traffic",
+		})
+	}
+	return findings
+}
+
+// checkStrictPlaintextTraffic reports STRICT PeerAuthentications on services whose inbound metrics still
+// show plaintext requests
+func checkStrictPlaintextTraffic(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	type plaintext struct {
+		rate    float64
+		sources []string
+	}
+	received := make(map[string]*plaintext)
+	for _, pair := range c.inbound {
+		if pair.PlaintextRequestRate <= 0 {
+			continue
+		}
+		key := pair.DestinationNamespace + "/" + pair.DestinationService
+		if received[key] == nil {
+			received[key] = &plaintext{}
+		}
+		received[key].rate += pair.PlaintextRequestRate
+		received[key].sources = append(received[key].sources, pair.SourceNamespace+"/"+pair.SourceService)
+	}
+	if len(received) == 0 {
+		return nil
+	}
+
+	policies := c.peerAuthentications()
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, service := range c.state.Services {
+		traffic, ok := received[service.Namespace+"/"+service.Name]
+		if !ok {
+			continue
+		}
+		sort.Strings(traffic.sources)
+
+		var strict []*peerAuthentication
+		for _, instance := range service.Instances {
+			mode, policy := c.effectiveMTLSMode(policies, instance, service.Namespace)
+			if mode != mtlsModeStrict || containsPeerAuthentication(strict, policy) {
+				continue
+			}
+			strict = append(strict, policy)
+		}
+
+		for _, policy := range strict {
+			findings = append(findings, &typesv1alpha1.AnalysisFinding{
+				Code:     CodeStrictPlaintextTraffic,
+				Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+				Resource: c.ref(references.KindPeerAuthentication, policy.policy.Namespace, policy.policy.Name),
+				Message: fmt.Sprintf("STRICT mTLS applies to service %s/%s, but it received %.2f plaintext requests/s from %s",
+					service.Namespace, service.Name, traffic.rate, strings.Join(traffic.sources, ", ")),
+				Related: []*typesv1alpha1.ResourceRef{c.ref(references.KindService, service.Namespace, service.Name)},
+			})
+		}
+	}
+	return findings
+}
+
+// StrictServices returns the services with at least one workload whose effective mTLS mode is STRICT.
+// Their inbound metrics are what Analyze compares against to find plaintext traffic.
+func StrictServices(state *backendv1alpha1.ClusterState) []*backendv1alpha1.Service {
+	if state == nil {
+		return nil
+	}
+	c := newCluster("", state, nil)
+	policies := c.peerAuthentications()
+
+	var services []*backendv1alpha1.Service
+	for _, service := range state.Services {
+		for _, instance := range service.Instances {
+			if mode, _ := c.effectiveMTLSMode(policies, instance, service.Namespace); mode == mtlsModeStrict {
+				services = append(services, service)
+				break
+			}
+		}
+	}
+	return services
+}
+
+// forEachWorkload calls fn once for each pod backing the cluster's services
+func (c *cluster) forEachWorkload(fn func(namespace string, instance *backendv1alpha1.ServiceInstance)) {
+	seen := make(map[string]bool)
+	for _, service := range c.state.Services {
+		for _, instance := range service.Instances {
+			key := service.Namespace + "/" + instance.PodName
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			fn(service.Namespace, instance)
+		}
+	}
+}
+
+// olderPeerAuthentication orders PeerAuthentications by creation time, then name and namespace
+func olderPeerAuthentication(a, b *peerAuthentication) bool {
+	if !a.created.Equal(b.created) {
+		return a.created.Before(b.created)
+	}
+	if a.policy.Name != b.policy.Name {
+		return a.policy.Name < b.policy.Name
+	}
+	return a.policy.Namespace < b.policy.Namespace
+}
+
+// containsPeerAuthentication reports whether a policy is in a list
+func containsPeerAuthentication(policies []*peerAuthentication, policy *peerAuthentication) bool {
+	for _, existing := range policies {
+		if existing == policy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+func peerAuthenticationRawConfig(created, mode string) string {
+	if mode == "" {
+		return `{"metadata":{"creationTimestamp":"` + created + `"},"spec":{}}`
+	}
+	return `{"metadata":{"creationTimestamp":"` + created + `"},"spec":{"mtls":{"mode":"` + mode + `"}}}`
+}
+
+func peerAuthenticationClusterState() *backendv1alpha1.ClusterState {
+	return &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{
+					{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews", "version": "v1"}},
+					{PodName: "reviews-v2", Labels: map[string]string{"app": "reviews", "version": "v2"}},
+				},
+			},
+			{
+				Name:      "productpage",
+				Namespace: "frontend",
+				Instances: []*backendv1alpha1.ServiceInstance{{PodName: "productpage", Labels: map[string]string{"app": "productpage"}}},
+			},
+			{
+				Name:      "ratings",
+				Namespace: "legacy",
+				Instances: []*backendv1alpha1.ServiceInstance{{PodName: "ratings", Labels: map[string]string{"app": "ratings"}}},
+			},
+		},
+		PeerAuthentications: []*typesv1alpha1.PeerAuthentication{
+			{Name: "default", Namespace: "istio-system", RawConfig: peerAuthenticationRawConfig("2023-01-01T00:00:00Z", "")},
+			{Name: "default-strict", Namespace: "default", RawConfig: peerAuthenticationRawConfig("2024-01-01T00:00:00Z", "STRICT")},
+			{Name: "default-permissive", Namespace: "default", RawConfig: peerAuthenticationRawConfig("2024-02-01T00:00:00Z", "PERMISSIVE")},
+			{
+				Name:      "reviews-v2",
+				Namespace: "default",
+				Selector:  &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"version": "v2"}},
+				RawConfig: peerAuthenticationRawConfig("2024-03-01T00:00:00Z", "DISABLE"),
+			},
+			{Name: "frontend", Namespace: "frontend", RawConfig: peerAuthenticationRawConfig("2024-01-01T00:00:00Z", "PERMISSIVE")},
+		},
+	}
+}
+
+func TestCheckPeerAuthenticationConflicts(t *testing.T) {
+	findings := checkPeerAuthenticationConflicts(newTestCluster(peerAuthenticationClusterState()))
+	require.Len(t, findings, 1)
+
+	assert.Equal(t, CodePeerAuthenticationConflict, findings[0].Code)
+	assert.Equal(t, "default-permissive", findings[0].Resource.Name)
+	assert.Equal(t, "mTLS mode PERMISSIVE is overridden by the older PeerAuthentication default/default-strict (mode STRICT) for 2 workloads", findings[0].Message)
+	require.Len(t, findings[0].Related, 3)
+	assert.Equal(t, "default-strict", findings[0].Related[0].Name)
+	assert.Equal(t, references.KindPod, findings[0].Related[1].Kind)
+	assert.Equal(t, "reviews-v1", findings[0].Related[1].Name)
+	assert.Equal(t, "reviews-v2", findings[0].Related[2].Name)
+}
+
+func TestCheckNamespacePermissiveDefault(t *testing.T) {
+	t.Run("namespaces without a policy", func(t *testing.T) {
+		findings := checkNamespacePermissiveDefault(newTestCluster(peerAuthenticationClusterState()))
+		require.Len(t, findings, 1)
+		assert.Equal(t, CodeNamespacePermissiveDefault, findings[0].Code)
+		assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: KindNamespace, Name: "legacy"}, findings[0].Resource)
+	})
+
+	t.Run("mesh-wide policy", func(t *testing.T) {
+		state := peerAuthenticationClusterState()
+		state.PeerAuthentications[0].RawConfig = peerAuthenticationRawConfig("2023-01-01T00:00:00Z", "STRICT")
+		assert.Empty(t, checkNamespacePermissiveDefault(newTestCluster(state)))
+	})
+}
+
+func TestStrictServices(t *testing.T) {
+	services := StrictServices(peerAuthenticationClusterState())
+	require.Len(t, services, 1)
+	assert.Equal(t, "reviews", services[0].Name)
+
+	assert.Empty(t, StrictServices(nil))
+}
+
+func TestCheckStrictPlaintextTraffic(t *testing.T) {
+	inbound := []*typesv1alpha1.ServicePairMetrics{
+		{SourceNamespace: "frontend", SourceService: "productpage", DestinationNamespace: "default", DestinationService: "reviews", RequestRate: 2, PlaintextRequestRate: 0.5},
+		{SourceNamespace: "legacy", SourceService: "ratings", DestinationNamespace: "frontend", DestinationService: "productpage", RequestRate: 1, PlaintextRequestRate: 1},
+	}
+
+	t.Run("strict service receiving plaintext", func(t *testing.T) {
+		findings := checkStrictPlaintextTraffic(newCluster("cluster-1", peerAuthenticationClusterState(), inbound))
+		require.Len(t, findings, 1)
+		assert.Equal(t, CodeStrictPlaintextTraffic, findings[0].Code)
+		assert.Equal(t, "default-strict", findings[0].Resource.Name)
+		assert.Equal(t, "STRICT mTLS applies to service default/reviews, but it received 0.50 plaintext requests/s from frontend/productpage", findings[0].Message)
+		assert.Equal(t, references.KindService, findings[0].Related[0].Kind)
+	})
+
+	t.Run("without metrics", func(t *testing.T) {
+		assert.Empty(t, checkStrictPlaintextTraffic(newTestCluster(peerAuthenticationClusterState())))
+	})
+}
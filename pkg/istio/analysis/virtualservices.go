@@ -0,0 +1,220 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+// Codes of the VirtualService host checks
+const (
+	CodeVirtualServiceHostConflict = "VirtualServiceHostConflict"
+	CodeVirtualServiceHostMerged   = "VirtualServiceHostMerged"
+)
+
+// virtualServiceConfig is the part of a VirtualService's raw config that host checks read
+type virtualServiceConfig struct {
+	Metadata objectMeta `json:"metadata"`
+}
+
+// virtualService is a VirtualService along with its creation time, which orders duplicates
+type virtualService struct {
+	vs      *typesv1alpha1.VirtualService
+	created time.Time
+}
+
+// hostBinding is a host claimed on a gateway, "mesh" for sidecars
+type hostBinding struct {
+	gateway string
+	host    string
+}
+
+// virtualServiceConflict is a VirtualService ignored in favour of another for the sidecars in some namespaces
+type virtualServiceConflict struct {
+	ignored    *virtualService
+	winner     *virtualService
+	namespaces []string
+}
+
+// checkVirtualServiceHosts reports VirtualServices claiming the same host on the same gateway.
+// Sidecars only use the first VirtualService for a host: those in the client's own namespace come first,
+// then the oldest. Gateways merge the VirtualServices for a host, evaluating the oldest one's routes first.
+func checkVirtualServiceHosts(c *cluster) []*typesv1alpha1.AnalysisFinding {
+	bindings := make(map[hostBinding][]*virtualService)
+	for _, vs := range c.state.VirtualServices {
+		entry := &virtualService{vs: vs}
+		var config virtualServiceConfig
+		if err := rawconfig.Decode(vs, &config); err == nil {
+			entry.created = config.Metadata.CreationTimestamp
+		}
+
+		gateways := vs.Gateways
+		if len(gateways) == 0 {
+			gateways = []string{references.MeshGateway}
+		}
+		for _, gateway := range gateways {
+			if gateway != references.MeshGateway && !strings.Contains(gateway, "/") {
+				gateway = vs.Namespace + "/" + gateway
+			}
+			for _, host := range vs.Hosts {
+				binding := hostBinding{gateway: gateway, host: qualifyHost(host, vs.Namespace)}
+				claims := bindings[binding]
+				if len(claims) > 0 && claims[len(claims)-1] == entry {
+					continue
+				}
+				bindings[binding] = append(claims, entry)
+			}
+		}
+	}
+
+	keys := make([]hostBinding, 0, len(bindings))
+	for binding, claims := range bindings {
+		if len(claims) > 1 {
+			keys = append(keys, binding)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].gateway != keys[j].gateway {
+			return keys[i].gateway < keys[j].gateway
+		}
+		return keys[i].host < keys[j].host
+	})
+
+	var findings []*typesv1alpha1.AnalysisFinding
+	for _, binding := range keys {
+		if binding.gateway == references.MeshGateway {
+			findings = append(findings, c.meshHostConflicts(binding.host, bindings[binding])...)
+		} else {
+			findings = append(findings, c.gatewayHostMerges(binding, bindings[binding])...)
+		}
+	}
+	return findings
+}
+
+// meshHostConflicts reports the VirtualServices sidecars ignore for a host because another one claims it first
+func (c *cluster) meshHostConflicts(host string, claims []*virtualService) []*typesv1alpha1.AnalysisFinding {
+	var conflicts []*virtualServiceConflict
+	for _, namespace := range c.clientNamespaces() {
+		visible := visibleVirtualServices(claims, namespace)
+		if len(visible) < 2 {
+			continue
+		}
+		sort.SliceStable(visible, func(i, j int) bool {
+			a, b := visible[i], visible[j]
+			if local := a.vs.Namespace == namespace; local != (b.vs.Namespace == namespace) {
+				return local
+			}
+			return olderVirtualService(a, b)
+		})
+
+		for _, ignored := range visible[1:] {
+			var conflict *virtualServiceConflict
+			for _, existing := range conflicts {
+				if existing.ignored == ignored && existing.winner == visible[0] {
+					conflict = existing
+					break
+				}
+			}
+			if conflict == nil {
+				conflict = &virtualServiceConflict{ignored: ignored, winner: visible[0]}
+				conflicts = append(conflicts, conflict)
+			}
+			conflict.namespaces = append(conflict.namespaces, namespace)
+		}
+	}
+
+	findings := make([]*typesv1alpha1.AnalysisFinding, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		ignored, winner := conflict.ignored.vs, conflict.winner.vs
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodeVirtualServiceHostConflict,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_WARNING,
+			Resource: c.ref(references.KindVirtualService, ignored.Namespace, ignored.Name),
+			Message: fmt.Sprintf("host %s is already claimed by VirtualService %s/%s for sidecars in namespaces %s, so this VirtualService is ignored for it",
+				host, winner.Namespace, winner.Name, strings.Join(conflict.namespaces, ", ")),
+			Related: []*typesv1alpha1.ResourceRef{c.ref(references.KindVirtualService, winner.Namespace, winner.Name)},
+		})
+	}
+	return findings
+}
+
+// gatewayHostMerges reports the VirtualServices a gateway merges for a host after the one it evaluates first
+func (c *cluster) gatewayHostMerges(binding hostBinding, claims []*virtualService) []*typesv1alpha1.AnalysisFinding {
+	gatewayNamespace, gatewayName, _ := strings.Cut(binding.gateway, "/")
+	visible := visibleVirtualServices(claims, gatewayNamespace)
+	if len(visible) < 2 {
+		return nil
+	}
+	sort.SliceStable(visible, func(i, j int) bool {
+		return olderVirtualService(visible[i], visible[j])
+	})
+
+	first := visible[0].vs
+	related := []*typesv1alpha1.ResourceRef{c.ref(references.KindVirtualService, first.Namespace, first.Name)}
+	if gateway, exists := c.graph.Resource(references.KindGateway, gatewayNamespace, gatewayName); exists {
+		related = append(related, gateway)
+	}
+
+	findings := make([]*typesv1alpha1.AnalysisFinding, 0, len(visible)-1)
+	for _, merged := range visible[1:] {
+		findings = append(findings, &typesv1alpha1.AnalysisFinding{
+			Code:     CodeVirtualServiceHostMerged,
+			Severity: typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_INFO,
+			Resource: c.ref(references.KindVirtualService, merged.vs.Namespace, merged.vs.Name),
+			Message: fmt.Sprintf("routes for host %s on gateway %s are merged after those of VirtualService %s/%s, which are evaluated first",
+				binding.host, binding.gateway, first.Namespace, first.Name),
+			Related: related,
+		})
+	}
+	return findings
+}
+
+// visibleVirtualServices returns the VirtualServices exported to a namespace
+func visibleVirtualServices(claims []*virtualService, namespace string) []*virtualService {
+	var visible []*virtualService
+	for _, claim := range claims {
+		if filters.IsVisibleToNamespace(claim.vs, namespace) {
+			visible = append(visible, claim)
+		}
+	}
+	return visible
+}
+
+// olderVirtualService orders VirtualServices by creation time, then name and namespace
+func olderVirtualService(a, b *virtualService) bool {
+	if !a.created.Equal(b.created) {
+		return a.created.Before(b.created)
+	}
+	if a.vs.Name != b.vs.Name {
+		return a.vs.Name < b.vs.Name
+	}
+	return a.vs.Namespace < b.vs.Namespace
+}
+
+// qualifyHost expands a short host name to the FQDN of the service in the declaring resource's namespace
+func qualifyHost(host, namespace string) string {
+	if host == "*" || strings.Contains(host, ".") {
+		return host
+	}
+	return host + "." + namespace + ".svc.cluster.local"
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/references"
+)
+
+func virtualServiceRawConfig(created string) string {
+	return `{"metadata":{"creationTimestamp":"` + created + `"}}`
+}
+
+func TestCheckVirtualServiceHosts(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{Name: "reviews", Namespace: "default"},
+			{Name: "productpage", Namespace: "frontend"},
+		},
+		Gateways: []*typesv1alpha1.Gateway{{Name: "public", Namespace: "istio-system"}},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "default", Hosts: []string{"reviews"}, RawConfig: virtualServiceRawConfig("2024-01-01T00:00:00Z")},
+			{Name: "reviews-override", Namespace: "frontend", Hosts: []string{"reviews.default.svc.cluster.local"}, Gateways: []string{"mesh"}, RawConfig: virtualServiceRawConfig("2024-02-01T00:00:00Z")},
+			{Name: "bookinfo-a", Namespace: "default", Hosts: []string{"bookinfo.example.com"}, Gateways: []string{"istio-system/public"}, RawConfig: virtualServiceRawConfig("2024-03-01T00:00:00Z")},
+			{Name: "bookinfo-b", Namespace: "default", Hosts: []string{"bookinfo.example.com"}, Gateways: []string{"istio-system/public"}, RawConfig: virtualServiceRawConfig("2024-01-01T00:00:00Z")},
+			{Name: "bookinfo-private", Namespace: "frontend", Hosts: []string{"bookinfo.example.com"}, Gateways: []string{"istio-system/public"}, ExportTo: []string{"."}},
+		},
+	}
+
+	findings := checkVirtualServiceHosts(newTestCluster(state))
+	require.Len(t, findings, 3)
+
+	t.Run("gateway merges in creation order", func(t *testing.T) {
+		assert.Equal(t, CodeVirtualServiceHostMerged, findings[0].Code)
+		assert.Equal(t, typesv1alpha1.AnalysisSeverity_ANALYSIS_SEVERITY_INFO, findings[0].Severity)
+		assert.Equal(t, "bookinfo-a", findings[0].Resource.Name)
+		require.Len(t, findings[0].Related, 2)
+		assert.Equal(t, "bookinfo-b", findings[0].Related[0].Name)
+		assert.Equal(t, references.KindGateway, findings[0].Related[1].Kind)
+		assert.Equal(t, "routes for host bookinfo.example.com on gateway istio-system/public are merged after those of VirtualService default/bookinfo-b, which are evaluated first", findings[0].Message)
+	})
+
+	t.Run("sidecars prefer their own namespace", func(t *testing.T) {
+		assert.Equal(t, CodeVirtualServiceHostConflict, findings[1].Code)
+		assert.Equal(t, "frontend/reviews-override", findings[1].Resource.Namespace+"/"+findings[1].Resource.Name)
+		assert.Equal(t, "default/reviews", findings[1].Related[0].Namespace+"/"+findings[1].Related[0].Name)
+		assert.Contains(t, findings[1].Message, "for sidecars in namespaces default,")
+
+		assert.Equal(t, CodeVirtualServiceHostConflict, findings[2].Code)
+		assert.Equal(t, "default/reviews", findings[2].Resource.Namespace+"/"+findings[2].Resource.Name)
+		assert.Equal(t, "frontend/reviews-override", findings[2].Related[0].Namespace+"/"+findings[2].Related[0].Name)
+		assert.Contains(t, findings[2].Message, "for sidecars in namespaces frontend,")
+	})
+}
+
+func TestQualifyHost(t *testing.T) {
+	assert.Equal(t, "reviews.default.svc.cluster.local", qualifyHost("reviews", "default"))
+	assert.Equal(t, "reviews.other.svc.cluster.local", qualifyHost("reviews.other.svc.cluster.local", "default"))
+	assert.Equal(t, "*", qualifyHost("*", "default"))
+}
@@ -0,0 +1,432 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authz evaluates Istio AuthorizationPolicies against traffic observed by mesh metrics.
+//
+// Metrics only record the source and destination of a flow, so request attributes such as paths,
+// methods, headers or JWT claims are unknown. Evaluation is three-valued: rules that depend on
+// unknown attributes neither match nor fail to match, and a verdict that depends on them is
+// INDETERMINATE rather than ALLOWED or DENIED.
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
+	"gopkg.in/yaml.v3"
+)
+
+// Policy actions
+const (
+	ActionAllow  = "ALLOW"
+	ActionDeny   = "DENY"
+	ActionAudit  = "AUDIT"
+	ActionCustom = "CUSTOM"
+)
+
+// dryRunAnnotation marks a policy that Istio evaluates without enforcing
+const dryRunAnnotation = "istio.io/dry-run"
+
+// Request is what is known about the requests of an observed traffic flow
+type Request struct {
+	// SourceNamespace is the namespace of the source workload
+	SourceNamespace string
+	// MTLS is whether the requests carry the peer identity that principals and namespaces are read from
+	MTLS MTLSMode
+}
+
+// MTLSMode is whether the requests of a flow were sent with mutual TLS
+type MTLSMode int
+
+const (
+	// MTLSUnknown is for flows whose mTLS is not known, or that mix mTLS and plaintext requests
+	MTLSUnknown MTLSMode = iota
+	// MTLSEnabled is for flows whose requests are all sent with mTLS
+	MTLSEnabled
+	// MTLSDisabled is for flows whose requests are all plaintext, so they have no peer identity
+	MTLSDisabled
+)
+
+// Decision is the verdict for a request and the reason for it
+type Decision struct {
+	Verdict typesv1alpha1.AuthorizationVerdict
+	Reason  string
+}
+
+// policyConfig is the part of an AuthorizationPolicy's raw config that evaluation reads
+type policyConfig struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		Selector *struct {
+			MatchLabels map[string]string `json:"matchLabels"`
+		} `json:"selector"`
+		TargetRefs []struct {
+			Group     string `json:"group"`
+			Kind      string `json:"kind"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"targetRefs"`
+		TargetRef *struct {
+			Group     string `json:"group"`
+			Kind      string `json:"kind"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"targetRef"`
+		Action string `json:"action"`
+		Rules  []rule `json:"rules"`
+	} `json:"spec"`
+}
+
+type rule struct {
+	From []struct {
+		Source source `json:"source"`
+	} `json:"from"`
+	To []struct {
+		Operation operation `json:"operation"`
+	} `json:"to"`
+	When []condition `json:"when"`
+}
+
+type source struct {
+	Principals           []string `json:"principals"`
+	NotPrincipals        []string `json:"notPrincipals"`
+	RequestPrincipals    []string `json:"requestPrincipals"`
+	NotRequestPrincipals []string `json:"notRequestPrincipals"`
+	Namespaces           []string `json:"namespaces"`
+	NotNamespaces        []string `json:"notNamespaces"`
+	IPBlocks             []string `json:"ipBlocks"`
+	NotIPBlocks          []string `json:"notIpBlocks"`
+	RemoteIPBlocks       []string `json:"remoteIpBlocks"`
+	NotRemoteIPBlocks    []string `json:"notRemoteIpBlocks"`
+}
+
+type operation struct {
+	Hosts      []string `json:"hosts"`
+	NotHosts   []string `json:"notHosts"`
+	Ports      []string `json:"ports"`
+	NotPorts   []string `json:"notPorts"`
+	Methods    []string `json:"methods"`
+	NotMethods []string `json:"notMethods"`
+	Paths      []string `json:"paths"`
+	NotPaths   []string `json:"notPaths"`
+}
+
+type condition struct {
+	Key       string   `json:"key"`
+	Values    []string `json:"values"`
+	NotValues []string `json:"notValues"`
+}
+
+// match is the three-valued result of matching a request. The values are ordered so that
+// conjunction is the minimum and disjunction the maximum.
+type match int
+
+const (
+	noMatch match = iota
+	unknownMatch
+	fullMatch
+)
+
+func (m match) not() match {
+	return fullMatch - m
+}
+
+// Parse reads a drafted AuthorizationPolicy manifest in YAML or JSON
+func Parse(manifest string) (*typesv1alpha1.AuthorizationPolicy, error) {
+	var resource map[string]interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	var config policyConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if config.Kind != "" && config.Kind != "AuthorizationPolicy" {
+		return nil, fmt.Errorf("expected an AuthorizationPolicy, got %s", config.Kind)
+	}
+	if config.Metadata.Name == "" || config.Metadata.Namespace == "" {
+		return nil, fmt.Errorf("policy must set metadata.name and metadata.namespace")
+	}
+	switch config.Spec.Action {
+	case "", ActionAllow, ActionDeny, ActionAudit, ActionCustom:
+	default:
+		return nil, fmt.Errorf("unknown action %s", config.Spec.Action)
+	}
+
+	policy := &typesv1alpha1.AuthorizationPolicy{
+		Name:      config.Metadata.Name,
+		Namespace: config.Metadata.Namespace,
+		RawConfig: string(raw),
+	}
+	if config.Spec.Selector != nil {
+		policy.Selector = &typesv1alpha1.WorkloadSelector{MatchLabels: config.Spec.Selector.MatchLabels}
+	}
+	for _, ref := range config.Spec.TargetRefs {
+		policy.TargetRefs = append(policy.TargetRefs, &typesv1alpha1.PolicyTargetReference{
+			Group:     ref.Group,
+			Kind:      ref.Kind,
+			Name:      ref.Name,
+			Namespace: ref.Namespace,
+		})
+	}
+	if ref := config.Spec.TargetRef; ref != nil {
+		policy.TargetRefs = append(policy.TargetRefs, &typesv1alpha1.PolicyTargetReference{
+			Group:     ref.Group,
+			Kind:      ref.Kind,
+			Name:      ref.Name,
+			Namespace: ref.Namespace,
+		})
+	}
+	return policy, nil
+}
+
+// Evaluate decides whether requests are allowed by the AuthorizationPolicies applying to their
+// destination workload, following Istio's order: CUSTOM and DENY policies first, then ALLOW policies.
+// AUDIT policies and policies in dry-run mode are not enforced and are ignored.
+func Evaluate(policies []*typesv1alpha1.AuthorizationPolicy, req Request) Decision {
+	denied := noMatch
+	var denyingPolicy string
+	allowed := noMatch
+	hasAllow := false
+
+	for _, policy := range policies {
+		var config policyConfig
+		raw, err := rawconfig.Get(policy)
+		if err == nil {
+			err = json.Unmarshal([]byte(raw), &config)
+		}
+		if err != nil {
+			return Decision{
+				Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+				Reason:  fmt.Sprintf("AuthorizationPolicy %s/%s could not be read", policy.Namespace, policy.Name),
+			}
+		}
+		if config.Metadata.Annotations[dryRunAnnotation] == "true" {
+			continue
+		}
+
+		m := noMatch
+		for _, r := range config.Spec.Rules {
+			m = max(m, r.matches(req))
+		}
+
+		switch config.Spec.Action {
+		case ActionDeny, ActionCustom:
+			// The external authorizer behind a CUSTOM policy may deny any request the policy matches
+			if config.Spec.Action == ActionCustom {
+				m = min(m, unknownMatch)
+			}
+			if m > denied {
+				denied = m
+				denyingPolicy = fmt.Sprintf("%s policy %s/%s", config.action(), policy.Namespace, policy.Name)
+			}
+		case ActionAudit:
+		default:
+			hasAllow = true
+			allowed = max(allowed, m)
+		}
+	}
+	if !hasAllow {
+		allowed = fullMatch
+	}
+
+	switch {
+	case denied == fullMatch:
+		return Decision{
+			Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			Reason:  fmt.Sprintf("denied by %s", denyingPolicy),
+		}
+	case allowed == noMatch:
+		return Decision{
+			Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			Reason:  "no ALLOW policy matches",
+		}
+	case denied == unknownMatch:
+		return Decision{
+			Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			Reason:  fmt.Sprintf("may be denied by %s", denyingPolicy),
+		}
+	case allowed == unknownMatch:
+		return Decision{
+			Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			Reason:  "ALLOW policies match only some requests",
+		}
+	default:
+		return Decision{Verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED}
+	}
+}
+
+// action returns the policy's action, which defaults to ALLOW
+func (c *policyConfig) action() string {
+	if c.Spec.Action == "" {
+		return ActionAllow
+	}
+	return c.Spec.Action
+}
+
+// matches requires every one of a rule's from, to and when clauses to match. A rule with no
+// clauses matches every request.
+func (r *rule) matches(req Request) match {
+	from := fullMatch
+	if len(r.From) > 0 {
+		from = noMatch
+		for _, f := range r.From {
+			from = max(from, f.Source.matches(req))
+		}
+	}
+
+	to := fullMatch
+	if len(r.To) > 0 {
+		to = noMatch
+		for _, t := range r.To {
+			to = max(to, t.Operation.matches())
+		}
+	}
+
+	when := fullMatch
+	for _, c := range r.When {
+		when = min(when, c.matches(req))
+	}
+
+	return min(from, to, when)
+}
+
+// matches requires every field set on a source to match
+func (s *source) matches(req Request) match {
+	namespace := func(value string) match { return peerMatch(stringMatch(value, req.SourceNamespace), req.MTLS) }
+	principal := func(value string) match { return peerMatch(principalMatch(value, req.SourceNamespace), req.MTLS) }
+
+	return min(
+		field(s.Principals, s.NotPrincipals, principal),
+		field(s.Namespaces, s.NotNamespaces, namespace),
+		unknownField(s.RequestPrincipals, s.NotRequestPrincipals),
+		unknownField(s.IPBlocks, s.NotIPBlocks),
+		unknownField(s.RemoteIPBlocks, s.NotRemoteIPBlocks),
+	)
+}
+
+// matches requires every field set on an operation to match. Metrics record none of them.
+func (o *operation) matches() match {
+	return min(
+		unknownField(o.Hosts, o.NotHosts),
+		unknownField(o.Ports, o.NotPorts),
+		unknownField(o.Methods, o.NotMethods),
+		unknownField(o.Paths, o.NotPaths),
+	)
+}
+
+// matches evaluates a condition on the source identity; other attributes are unknown
+func (c *condition) matches(req Request) match {
+	switch c.Key {
+	case "source.namespace":
+		return field(c.Values, c.NotValues, func(value string) match {
+			return peerMatch(stringMatch(value, req.SourceNamespace), req.MTLS)
+		})
+	case "source.principal":
+		return field(c.Values, c.NotValues, func(value string) match {
+			return peerMatch(principalMatch(value, req.SourceNamespace), req.MTLS)
+		})
+	default:
+		return unknownField(c.Values, c.NotValues)
+	}
+}
+
+// field matches if any of values matches, when set, and none of notValues does
+func field(values, notValues []string, matchValue func(string) match) match {
+	result := fullMatch
+	if len(values) > 0 {
+		result = noMatch
+		for _, value := range values {
+			result = max(result, matchValue(value))
+		}
+	}
+	for _, value := range notValues {
+		result = min(result, matchValue(value).not())
+	}
+	return result
+}
+
+// unknownField is a field on an attribute metrics do not record
+func unknownField(values, notValues []string) match {
+	if len(values) == 0 && len(notValues) == 0 {
+		return fullMatch
+	}
+	return unknownMatch
+}
+
+// stringMatch implements Istio's string matching: exact, prefix ("abc*"), suffix ("*abc") or any ("*")
+func stringMatch(pattern, value string) match {
+	var matched bool
+	switch {
+	case pattern == "*":
+		matched = true
+	case strings.HasPrefix(pattern, "*"):
+		matched = strings.HasSuffix(value, pattern[1:])
+	case strings.HasSuffix(pattern, "*"):
+		matched = strings.HasPrefix(value, pattern[:len(pattern)-1])
+	default:
+		matched = pattern == value
+	}
+	if matched {
+		return fullMatch
+	}
+	return noMatch
+}
+
+// peerMatch qualifies a match on the peer identity, which Istio only has for mTLS requests: plaintext requests
+// match no principal or namespace, not even "*"
+func peerMatch(m match, mtls MTLSMode) match {
+	switch mtls {
+	case MTLSEnabled:
+		return m
+	case MTLSDisabled:
+		return noMatch
+	default:
+		return min(m, unknownMatch)
+	}
+}
+
+// principalMatch matches a principal pattern such as "cluster.local/ns/default/sa/reviews" against a
+// source. Only the namespace of a principal is known, so patterns for other namespaces do not match
+// and patterns for the source's namespace may or may not.
+func principalMatch(pattern, namespace string) match {
+	if pattern == "*" {
+		return fullMatch
+	}
+	_, rest, found := strings.Cut(pattern, "/ns/")
+	if !found {
+		return unknownMatch
+	}
+	patternNamespace, _, found := strings.Cut(rest, "/sa/")
+	if !found || strings.Contains(patternNamespace, "*") {
+		return unknownMatch
+	}
+	if patternNamespace != namespace {
+		return noMatch
+	}
+	return unknownMatch
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"testing"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func policy(name, spec string) *typesv1alpha1.AuthorizationPolicy {
+	return &typesv1alpha1.AuthorizationPolicy{
+		Name:      name,
+		Namespace: "default",
+		RawConfig: `{"metadata":{"name":"` + name + `","namespace":"default"},"spec":` + spec + `}`,
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		policy, err := Parse(`
+apiVersion: security.istio.io/v1
+kind: AuthorizationPolicy
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: reviews
+  action: ALLOW
+  rules:
+  - from:
+    - source:
+        namespaces: ["frontend"]
+`)
+		require.NoError(t, err)
+		assert.Equal(t, "reviews", policy.Name)
+		assert.Equal(t, "default", policy.Namespace)
+		assert.Equal(t, map[string]string{"app": "reviews"}, policy.Selector.MatchLabels)
+		assert.Contains(t, policy.RawConfig, `"namespaces":["frontend"]`)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		policy, err := Parse(`{"kind":"AuthorizationPolicy","metadata":{"name":"deny-all","namespace":"default"},"spec":{}}`)
+		require.NoError(t, err)
+		assert.Equal(t, "deny-all", policy.Name)
+		assert.Nil(t, policy.Selector)
+	})
+
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{name: "invalid yaml", manifest: "metadata: ["},
+		{name: "other kind", manifest: `{"kind":"PeerAuthentication","metadata":{"name":"a","namespace":"default"}}`},
+		{name: "missing namespace", manifest: `{"kind":"AuthorizationPolicy","metadata":{"name":"a"}}`},
+		{name: "unknown action", manifest: `{"metadata":{"name":"a","namespace":"default"},"spec":{"action":"BLOCK"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.manifest)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	frontend := Request{SourceNamespace: "frontend", MTLS: MTLSEnabled}
+
+	tests := []struct {
+		name     string
+		policies []*typesv1alpha1.AuthorizationPolicy
+		verdict  typesv1alpha1.AuthorizationVerdict
+		reason   string
+	}{
+		{
+			name:    "no policies",
+			verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED,
+		},
+		{
+			name:     "allow nothing",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow-nothing", `{}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			reason:   "no ALLOW policy matches",
+		},
+		{
+			name:     "allow source namespace",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"namespaces":["front*"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED,
+		},
+		{
+			name:     "allow other namespace",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"namespaces":["backend"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			reason:   "no ALLOW policy matches",
+		},
+		{
+			name:     "allow principal in other namespace",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"principals":["cluster.local/ns/backend/sa/api"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			reason:   "no ALLOW policy matches",
+		},
+		{
+			name:     "allow principal in source namespace",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"principals":["cluster.local/ns/frontend/sa/web"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			reason:   "ALLOW policies match only some requests",
+		},
+		{
+			name:     "allow some paths",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"to":[{"operation":{"paths":["/api/*"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			reason:   "ALLOW policies match only some requests",
+		},
+		{
+			name:     "deny source namespace",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("deny", `{"action":"DENY","rules":[{"when":[{"key":"source.namespace","values":["frontend"]}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			reason:   "denied by DENY policy default/deny",
+		},
+		{
+			name:     "deny other namespaces",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("deny", `{"action":"DENY","rules":[{"from":[{"source":{"notNamespaces":["frontend"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED,
+		},
+		{
+			name:     "deny some methods",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("deny", `{"action":"DENY","rules":[{"to":[{"operation":{"methods":["DELETE"]}}]}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			reason:   "may be denied by DENY policy default/deny",
+		},
+		{
+			name: "deny takes precedence over allow",
+			policies: []*typesv1alpha1.AuthorizationPolicy{
+				policy("allow", `{"rules":[{}]}`),
+				policy("deny", `{"action":"DENY","rules":[{}]}`),
+			},
+			verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED,
+			reason:  "denied by DENY policy default/deny",
+		},
+		{
+			name:     "custom",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("ext-authz", `{"action":"CUSTOM","rules":[{}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			reason:   "may be denied by CUSTOM policy default/ext-authz",
+		},
+		{
+			name:     "audit",
+			policies: []*typesv1alpha1.AuthorizationPolicy{policy("audit", `{"action":"AUDIT","rules":[{}]}`)},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED,
+		},
+		{
+			name: "dry-run",
+			policies: []*typesv1alpha1.AuthorizationPolicy{{
+				Name:      "deny",
+				Namespace: "default",
+				RawConfig: `{"metadata":{"annotations":{"istio.io/dry-run":"true"}},"spec":{"action":"DENY","rules":[{}]}}`,
+			}},
+			verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED,
+		},
+		{
+			name:     "unreadable policy",
+			policies: []*typesv1alpha1.AuthorizationPolicy{{Name: "broken", Namespace: "default", RawConfig: "{"}},
+			verdict:  typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE,
+			reason:   "AuthorizationPolicy default/broken could not be read",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := Evaluate(tt.policies, frontend)
+			assert.Equal(t, tt.verdict, decision.Verdict)
+			assert.Equal(t, tt.reason, decision.Reason)
+		})
+	}
+}
+
+func TestEvaluate_MTLS(t *testing.T) {
+	anyPrincipal := []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"principals":["*"]}}]}]}`)}
+	sourceNamespace := []*typesv1alpha1.AuthorizationPolicy{policy("allow", `{"rules":[{"from":[{"source":{"namespaces":["frontend"]}}]}]}`)}
+	notPrincipal := []*typesv1alpha1.AuthorizationPolicy{policy("deny", `{"action":"DENY","rules":[{"from":[{"source":{"notPrincipals":["*"]}}]}]}`)}
+
+	tests := []struct {
+		name     string
+		policies []*typesv1alpha1.AuthorizationPolicy
+		mtls     MTLSMode
+		verdict  typesv1alpha1.AuthorizationVerdict
+	}{
+		{name: "any principal with mtls", policies: anyPrincipal, mtls: MTLSEnabled, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED},
+		{name: "any principal in plaintext", policies: anyPrincipal, mtls: MTLSDisabled, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED},
+		{name: "any principal with unknown mtls", policies: anyPrincipal, mtls: MTLSUnknown, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_INDETERMINATE},
+		{name: "namespace in plaintext", policies: sourceNamespace, mtls: MTLSDisabled, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED},
+		{name: "deny unauthenticated with mtls", policies: notPrincipal, mtls: MTLSEnabled, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_ALLOWED},
+		{name: "deny unauthenticated in plaintext", policies: notPrincipal, mtls: MTLSDisabled, verdict: typesv1alpha1.AuthorizationVerdict_AUTHORIZATION_VERDICT_DENIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := Evaluate(tt.policies, Request{SourceNamespace: "frontend", MTLS: tt.mtls})
+			assert.Equal(t, tt.verdict, decision.Verdict)
+		})
+	}
+}
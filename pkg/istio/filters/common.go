@@ -31,13 +31,13 @@ type ExporterResource interface {
 	GetExportTo() []string
 }
 
-// isVisibleToNamespace determines if an Istio resource is visible to a specific namespace
+// IsVisibleToNamespace determines if an Istio resource is visible to a specific namespace
 // based on its exportTo field following Istio's visibility rules:
 // - Empty exportTo defaults to ["*"] (visible to all namespaces)
 // - "*" means visible to all namespaces
 // - "." means visible only to the same namespace as the resource
 // - Specific namespace names mean visible only to those namespaces
-func isVisibleToNamespace(resource ExporterResource, workloadNamespace string) bool {
+func IsVisibleToNamespace(resource ExporterResource, workloadNamespace string) bool {
 	if resource == nil {
 		return false
 	}
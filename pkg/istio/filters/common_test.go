@@ -79,7 +79,7 @@ func TestIsVisibleToNamespace(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := isVisibleToNamespace(tt.resource, tt.workloadNamespace)
+			result := IsVisibleToNamespace(tt.resource, tt.workloadNamespace)
 			assert.Equal(t, tt.expectedVisible, result)
 		})
 	}
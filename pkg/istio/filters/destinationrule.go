@@ -66,7 +66,7 @@ func destinationRuleMatchesWorkload(dr *typesv1alpha1.DestinationRule, instance
 	}
 
 	// Stage 1: Check namespace visibility
-	if !isVisibleToNamespace(destinationRuleExporter(dr), workloadNamespace) {
+	if !IsVisibleToNamespace(destinationRuleExporter(dr), workloadNamespace) {
 		return false
 	}
 
@@ -0,0 +1,127 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"strings"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// clusterDomainSuffix is the DNS suffix of Kubernetes service hostnames
+const clusterDomainSuffix = ".svc.cluster.local"
+
+// hostMatchesService determines if an Istio host refers to a Kubernetes service following Istio's host rules:
+// - Short names (no dots) are resolved relative to the namespace of the resource declaring them
+// - Wildcard hosts ("*" or "*.suffix") match any service FQDN ending with the suffix
+// - Anything else must equal the service FQDN
+func hostMatchesService(host, resourceNamespace, serviceName, serviceNamespace string) bool {
+	serviceFQDN := serviceName + "." + serviceNamespace + clusterDomainSuffix
+
+	if host == "*" {
+		return true
+	}
+	if strings.HasPrefix(host, "*.") {
+		return strings.HasSuffix(serviceFQDN, host[1:])
+	}
+	if !strings.Contains(host, ".") {
+		return host == serviceName && resourceNamespace == serviceNamespace
+	}
+
+	return host == serviceFQDN
+}
+
+// hostMatchesExternalName determines if an Istio host refers to the external hostname of an ExternalName service.
+// Hostnames are compared case-insensitively without a trailing dot, and wildcard hosts match any hostname ending
+// with their suffix.
+func hostMatchesExternalName(host, externalName string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	externalName = strings.TrimSuffix(strings.ToLower(externalName), ".")
+	if externalName == "" {
+		return false
+	}
+
+	if host == "*" {
+		return true
+	}
+	if strings.HasPrefix(host, "*.") {
+		return strings.HasSuffix(externalName, host[1:])
+	}
+
+	return host == externalName
+}
+
+// FilterVirtualServicesForHost returns the virtual services that route traffic addressed to a specific service.
+func FilterVirtualServicesForHost(virtualServices []*typesv1alpha1.VirtualService, serviceName, serviceNamespace string) []*typesv1alpha1.VirtualService {
+	var matchingVirtualServices []*typesv1alpha1.VirtualService
+
+	for _, vs := range virtualServices {
+		for _, host := range vs.Hosts {
+			if hostMatchesService(host, vs.Namespace, serviceName, serviceNamespace) {
+				matchingVirtualServices = append(matchingVirtualServices, vs)
+				break
+			}
+		}
+	}
+
+	return matchingVirtualServices
+}
+
+// FilterDestinationRulesForHost returns the destination rules that configure traffic addressed to a specific service.
+func FilterDestinationRulesForHost(destinationRules []*typesv1alpha1.DestinationRule, serviceName, serviceNamespace string) []*typesv1alpha1.DestinationRule {
+	var matchingDestinationRules []*typesv1alpha1.DestinationRule
+
+	for _, dr := range destinationRules {
+		if hostMatchesService(dr.Host, dr.Namespace, serviceName, serviceNamespace) {
+			matchingDestinationRules = append(matchingDestinationRules, dr)
+		}
+	}
+
+	return matchingDestinationRules
+}
+
+// FilterVirtualServicesForExternalName returns the virtual services that route traffic addressed to the external
+// hostname of an ExternalName service.
+func FilterVirtualServicesForExternalName(virtualServices []*typesv1alpha1.VirtualService, externalName string) []*typesv1alpha1.VirtualService {
+	var matchingVirtualServices []*typesv1alpha1.VirtualService
+
+	for _, vs := range virtualServices {
+		for _, host := range vs.Hosts {
+			if hostMatchesExternalName(host, externalName) {
+				matchingVirtualServices = append(matchingVirtualServices, vs)
+				break
+			}
+		}
+	}
+
+	return matchingVirtualServices
+}
+
+// FilterServiceEntriesForExternalName returns the service entries that declare the external hostname of an
+// ExternalName service.
+func FilterServiceEntriesForExternalName(serviceEntries []*typesv1alpha1.ServiceEntry, externalName string) []*typesv1alpha1.ServiceEntry {
+	var matchingServiceEntries []*typesv1alpha1.ServiceEntry
+
+	for _, se := range serviceEntries {
+		for _, host := range se.Hosts {
+			if hostMatchesExternalName(host, externalName) {
+				matchingServiceEntries = append(matchingServiceEntries, se)
+				break
+			}
+		}
+	}
+
+	return matchingServiceEntries
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"testing"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostMatchesService(t *testing.T) {
+	tests := []struct {
+		name              string
+		host              string
+		resourceNamespace string
+		expectedMatch     bool
+	}{
+		{name: "fqdn", host: "reviews.default.svc.cluster.local", resourceNamespace: "other", expectedMatch: true},
+		{name: "short name in same namespace", host: "reviews", resourceNamespace: "default", expectedMatch: true},
+		{name: "short name in other namespace", host: "reviews", resourceNamespace: "other", expectedMatch: false},
+		{name: "namespace wildcard", host: "*.default.svc.cluster.local", resourceNamespace: "other", expectedMatch: true},
+		{name: "other namespace wildcard", host: "*.other.svc.cluster.local", resourceNamespace: "other", expectedMatch: false},
+		{name: "global wildcard", host: "*", resourceNamespace: "other", expectedMatch: true},
+		{name: "different service", host: "ratings.default.svc.cluster.local", resourceNamespace: "default", expectedMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMatch, hostMatchesService(tt.host, tt.resourceNamespace, "reviews", "default"))
+		})
+	}
+}
+
+func TestHostMatchesExternalName(t *testing.T) {
+	tests := []struct {
+		name          string
+		host          string
+		externalName  string
+		expectedMatch bool
+	}{
+		{name: "exact", host: "api.example.com", externalName: "api.example.com", expectedMatch: true},
+		{name: "case and trailing dot", host: "API.example.com.", externalName: "api.example.com", expectedMatch: true},
+		{name: "wildcard", host: "*.example.com", externalName: "api.example.com", expectedMatch: true},
+		{name: "other domain wildcard", host: "*.example.org", externalName: "api.example.com", expectedMatch: false},
+		{name: "global wildcard", host: "*", externalName: "api.example.com", expectedMatch: true},
+		{name: "different host", host: "db.example.com", externalName: "api.example.com", expectedMatch: false},
+		{name: "empty external name", host: "*", externalName: "", expectedMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMatch, hostMatchesExternalName(tt.host, tt.externalName))
+		})
+	}
+}
+
+func TestFilterServiceEntriesForExternalName(t *testing.T) {
+	serviceEntries := []*typesv1alpha1.ServiceEntry{
+		{Name: "api", Namespace: "default", Hosts: []string{"api.example.com"}},
+		{Name: "db", Namespace: "default", Hosts: []string{"db.example.com"}},
+		{Name: "example", Namespace: "egress", Hosts: []string{"www.example.org", "*.example.com"}},
+	}
+
+	result := FilterServiceEntriesForExternalName(serviceEntries, "api.example.com")
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "api", result[0].Name)
+	assert.Equal(t, "example", result[1].Name)
+
+	virtualServices := []*typesv1alpha1.VirtualService{
+		{Name: "api", Namespace: "default", Hosts: []string{"api.example.com"}},
+		{Name: "reviews", Namespace: "default", Hosts: []string{"reviews"}},
+	}
+	vsResult := FilterVirtualServicesForExternalName(virtualServices, "api.example.com")
+	assert.Equal(t, 1, len(vsResult))
+	assert.Equal(t, "api", vsResult[0].Name)
+}
+
+func TestFilterVirtualServicesForHost(t *testing.T) {
+	virtualServices := []*typesv1alpha1.VirtualService{
+		{Name: "reviews", Namespace: "default", Hosts: []string{"reviews"}},
+		{Name: "ratings", Namespace: "default", Hosts: []string{"ratings"}},
+		{Name: "multi", Namespace: "frontend", Hosts: []string{"productpage", "reviews.default.svc.cluster.local"}},
+	}
+
+	result := FilterVirtualServicesForHost(virtualServices, "reviews", "default")
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "reviews", result[0].Name)
+	assert.Equal(t, "multi", result[1].Name)
+}
+
+func TestFilterDestinationRulesForHost(t *testing.T) {
+	destinationRules := []*typesv1alpha1.DestinationRule{
+		{Name: "reviews", Namespace: "default", Host: "reviews"},
+		{Name: "reviews-elsewhere", Namespace: "other", Host: "reviews"},
+		{Name: "mesh-wide", Namespace: "istio-system", Host: "*.local"},
+	}
+
+	result := FilterDestinationRulesForHost(destinationRules, "reviews", "default")
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "reviews", result[0].Name)
+	assert.Equal(t, "mesh-wide", result[1].Name)
+}
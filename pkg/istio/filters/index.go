@@ -0,0 +1,236 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"sort"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// SelectorIndex is an inverted index from workload labels to the Istio resources of a cluster whose
+// selectors may match them. Looking up the resources that apply to a workload only visits the few
+// candidates the index returns instead of every resource in the cluster.
+//
+// A selector only matches workloads carrying all of its labels, so each resource with selector labels is
+// indexed under one of them. Resources that select without labels, such as namespace-wide policies or
+// policies using targetRefs, are candidates for every workload in their namespace. Candidates are a
+// superset of the matches, so callers still apply the ...ForWorkload filters to them.
+type SelectorIndex struct {
+	state                  *backendv1alpha1.ClusterState
+	gateways               selectorPostings
+	sidecars               selectorPostings
+	envoyFilters           selectorPostings
+	requestAuthentications selectorPostings
+	peerAuthentications    selectorPostings
+	authorizationPolicies  selectorPostings
+	wasmPlugins            selectorPostings
+}
+
+// selectorKey is a selector label of resources in a namespace
+type selectorKey struct {
+	namespace string
+	key       string
+	value     string
+}
+
+// selectorPostings lists the positions of resources in their cluster state list
+type selectorPostings struct {
+	byLabel     map[selectorKey][]int
+	byNamespace map[string][]int // resources without selector labels
+	byName      map[string]int   // namespace/name -> position
+}
+
+// namedResource is an Istio resource identified by its namespace and name
+type namedResource interface {
+	GetNamespace() string
+	GetName() string
+}
+
+// NewSelectorIndex indexes the selectors of the resources in a cluster state
+func NewSelectorIndex(state *backendv1alpha1.ClusterState) *SelectorIndex {
+	if state == nil {
+		state = &backendv1alpha1.ClusterState{}
+	}
+
+	// Gateways can select workloads in other namespaces, so they are indexed across namespaces
+	return &SelectorIndex{
+		state: state,
+		gateways: newSelectorPostings(state.Gateways, func(gateway *typesv1alpha1.Gateway) (string, map[string]string) {
+			return "", gateway.Selector
+		}),
+		sidecars: newSelectorPostings(state.Sidecars, func(sidecar *typesv1alpha1.Sidecar) (string, map[string]string) {
+			return sidecar.Namespace, sidecar.GetWorkloadSelector().GetMatchLabels()
+		}),
+		envoyFilters: newSelectorPostings(state.EnvoyFilters, func(filter *typesv1alpha1.EnvoyFilter) (string, map[string]string) {
+			return filter.Namespace, selectorLabels(filter.GetWorkloadSelector(), filter.TargetRefs)
+		}),
+		requestAuthentications: newSelectorPostings(state.RequestAuthentications, func(policy *typesv1alpha1.RequestAuthentication) (string, map[string]string) {
+			return policy.Namespace, selectorLabels(policy.Selector, policy.TargetRefs)
+		}),
+		peerAuthentications: newSelectorPostings(state.PeerAuthentications, func(policy *typesv1alpha1.PeerAuthentication) (string, map[string]string) {
+			return policy.Namespace, policy.GetSelector().GetMatchLabels()
+		}),
+		authorizationPolicies: newSelectorPostings(state.AuthorizationPolicies, func(policy *typesv1alpha1.AuthorizationPolicy) (string, map[string]string) {
+			return policy.Namespace, selectorLabels(policy.Selector, policy.TargetRefs)
+		}),
+		wasmPlugins: newSelectorPostings(state.WasmPlugins, func(plugin *typesv1alpha1.WasmPlugin) (string, map[string]string) {
+			return plugin.Namespace, selectorLabels(plugin.Selector, plugin.TargetRefs)
+		}),
+	}
+}
+
+// ClusterState returns the cluster state the index was built from
+func (x *SelectorIndex) ClusterState() *backendv1alpha1.ClusterState {
+	return x.state
+}
+
+// Candidates returns the resources that may select a workload, in their original order: its gateways,
+// sidecars, EnvoyFilters, RequestAuthentications, PeerAuthentications, AuthorizationPolicies and
+// WasmPlugins. Other resource kinds are not selected by workload labels and are left empty.
+func (x *SelectorIndex) Candidates(instance *backendv1alpha1.ServiceInstance, workloadNamespace, rootNamespace string) *backendv1alpha1.ClusterState {
+	// Use default root namespace if not provided
+	if rootNamespace == "" {
+		rootNamespace = "istio-system"
+	}
+
+	// Namespaced resources only apply to workloads in their own namespace, or in every namespace from the root
+	namespaces := []string{workloadNamespace}
+	if rootNamespace != workloadNamespace {
+		namespaces = append(namespaces, rootNamespace)
+	}
+
+	labels := instance.GetLabels()
+	return &backendv1alpha1.ClusterState{
+		Gateways:               pick(x.state.Gateways, x.gateways.candidates(labels, "")),
+		Sidecars:               pick(x.state.Sidecars, x.sidecars.candidates(labels, namespaces...)),
+		EnvoyFilters:           pick(x.state.EnvoyFilters, x.envoyFilters.candidates(labels, namespaces...)),
+		RequestAuthentications: pick(x.state.RequestAuthentications, x.requestAuthentications.candidates(labels, namespaces...)),
+		PeerAuthentications:    pick(x.state.PeerAuthentications, x.peerAuthentications.candidates(labels, namespaces...)),
+		AuthorizationPolicies:  pick(x.state.AuthorizationPolicies, x.authorizationPolicies.candidates(labels, namespaces...)),
+		WasmPlugins:            pick(x.state.WasmPlugins, x.wasmPlugins.candidates(labels, namespaces...)),
+	}
+}
+
+// MatchWorkload matches the namespace-scoped policies that apply to a workload, returning references to them
+func (x *SelectorIndex) MatchWorkload(instance *backendv1alpha1.ServiceInstance, workloadNamespace, rootNamespace string) *backendv1alpha1.WorkloadPolicies {
+	candidates := x.Candidates(instance, workloadNamespace, rootNamespace)
+	return &backendv1alpha1.WorkloadPolicies{
+		Sidecars:               references(FilterSidecarsForWorkload(candidates.Sidecars, instance, workloadNamespace)),
+		EnvoyFilters:           references(FilterEnvoyFiltersForWorkload(candidates.EnvoyFilters, instance, workloadNamespace, rootNamespace)),
+		RequestAuthentications: references(FilterRequestAuthenticationsForWorkload(candidates.RequestAuthentications, instance, workloadNamespace, rootNamespace)),
+		PeerAuthentications:    references(FilterPeerAuthenticationsForWorkload(candidates.PeerAuthentications, instance, workloadNamespace, rootNamespace)),
+		AuthorizationPolicies:  references(FilterAuthorizationPoliciesForWorkload(candidates.AuthorizationPolicies, instance, workloadNamespace, rootNamespace)),
+		WasmPlugins:            references(FilterWasmPluginsForWorkload(candidates.WasmPlugins, instance, workloadNamespace, rootNamespace)),
+	}
+}
+
+// Resolve returns the policies a workload's policy references point to, in their original order.
+// References to resources that are not in the cluster state, e.g. from namespaces the edge does not
+// collect, are skipped.
+func (x *SelectorIndex) Resolve(policies *backendv1alpha1.WorkloadPolicies) *backendv1alpha1.ClusterState {
+	return &backendv1alpha1.ClusterState{
+		Sidecars:               pick(x.state.Sidecars, x.sidecars.resolve(policies.GetSidecars())),
+		EnvoyFilters:           pick(x.state.EnvoyFilters, x.envoyFilters.resolve(policies.GetEnvoyFilters())),
+		RequestAuthentications: pick(x.state.RequestAuthentications, x.requestAuthentications.resolve(policies.GetRequestAuthentications())),
+		PeerAuthentications:    pick(x.state.PeerAuthentications, x.peerAuthentications.resolve(policies.GetPeerAuthentications())),
+		AuthorizationPolicies:  pick(x.state.AuthorizationPolicies, x.authorizationPolicies.resolve(policies.GetAuthorizationPolicies())),
+		WasmPlugins:            pick(x.state.WasmPlugins, x.wasmPlugins.resolve(policies.GetWasmPlugins())),
+	}
+}
+
+// selectorLabels returns the labels of a selector, or none if the resource selects with targetRefs
+func selectorLabels(selector *typesv1alpha1.WorkloadSelector, targetRefs []*typesv1alpha1.PolicyTargetReference) map[string]string {
+	if len(targetRefs) > 0 {
+		return nil
+	}
+	return selector.GetMatchLabels()
+}
+
+func newSelectorPostings[T namedResource](resources []T, selector func(T) (string, map[string]string)) selectorPostings {
+	postings := selectorPostings{
+		byLabel:     make(map[selectorKey][]int),
+		byNamespace: make(map[string][]int),
+		byName:      make(map[string]int, len(resources)),
+	}
+
+	for i, resource := range resources {
+		postings.byName[resource.GetNamespace()+"/"+resource.GetName()] = i
+
+		namespace, labels := selector(resource)
+		if len(labels) == 0 {
+			postings.byNamespace[namespace] = append(postings.byNamespace[namespace], i)
+			continue
+		}
+
+		// Any label will do, the smallest key keeps the index deterministic
+		first := ""
+		for key := range labels {
+			if first == "" || key < first {
+				first = key
+			}
+		}
+		k := selectorKey{namespace: namespace, key: first, value: labels[first]}
+		postings.byLabel[k] = append(postings.byLabel[k], i)
+	}
+
+	return postings
+}
+
+// candidates returns the positions of the resources in the namespaces that may select workload labels, in order
+func (p selectorPostings) candidates(labels map[string]string, namespaces ...string) []int {
+	var positions []int
+	for _, namespace := range namespaces {
+		positions = append(positions, p.byNamespace[namespace]...)
+		for key, value := range labels {
+			positions = append(positions, p.byLabel[selectorKey{namespace: namespace, key: key, value: value}]...)
+		}
+	}
+	sort.Ints(positions)
+	return positions
+}
+
+// resolve returns the positions of the referenced resources that exist, in order
+func (p selectorPostings) resolve(references []string) []int {
+	var positions []int
+	for _, reference := range references {
+		if position, exists := p.byName[reference]; exists {
+			positions = append(positions, position)
+		}
+	}
+	sort.Ints(positions)
+	return positions
+}
+
+// references returns the namespace/name references to resources
+func references[T namedResource](resources []T) []string {
+	result := make([]string, len(resources))
+	for i, resource := range resources {
+		result[i] = resource.GetNamespace() + "/" + resource.GetName()
+	}
+	return result
+}
+
+func pick[T any](resources []T, positions []int) []T {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]T, len(positions))
+	for i, position := range positions {
+		result[i] = resources[position]
+	}
+	return result
+}
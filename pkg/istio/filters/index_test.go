@@ -0,0 +1,106 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"testing"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorIndex_Candidates(t *testing.T) {
+	selector := func(labels map[string]string) *typesv1alpha1.WorkloadSelector {
+		return &typesv1alpha1.WorkloadSelector{MatchLabels: labels}
+	}
+	state := &backendv1alpha1.ClusterState{
+		Gateways: []*typesv1alpha1.Gateway{
+			{Name: "ingress", Namespace: "istio-system", Selector: map[string]string{"istio": "ingressgateway"}},
+			{Name: "egress", Namespace: "istio-system", Selector: map[string]string{"istio": "egressgateway"}},
+		},
+		Sidecars: []*typesv1alpha1.Sidecar{
+			{Name: "default", Namespace: "bookinfo"},
+			{Name: "reviews", Namespace: "bookinfo", WorkloadSelector: selector(map[string]string{"app": "reviews"})},
+			{Name: "ratings", Namespace: "bookinfo", WorkloadSelector: selector(map[string]string{"app": "ratings"})},
+			{Name: "other", Namespace: "other"},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+			{Name: "mesh", Namespace: "istio-system"},
+			{Name: "reviews-v2", Namespace: "bookinfo", Selector: selector(map[string]string{"app": "reviews", "version": "v2"})},
+			{Name: "reviews-v1", Namespace: "bookinfo", Selector: selector(map[string]string{"app": "reviews", "version": "v1"})},
+			{Name: "by-service", Namespace: "bookinfo", TargetRefs: []*typesv1alpha1.PolicyTargetReference{{Kind: "Service", Name: "reviews"}}},
+			{Name: "reviews", Namespace: "other", Selector: selector(map[string]string{"app": "reviews"})},
+		},
+		PeerAuthentications: []*typesv1alpha1.PeerAuthentication{
+			{Name: "strict", Namespace: "bookinfo"},
+		},
+	}
+	index := NewSelectorIndex(state)
+	instance := &backendv1alpha1.ServiceInstance{Labels: map[string]string{"app": "reviews", "version": "v2"}}
+
+	candidates := index.Candidates(instance, "bookinfo", "")
+	assert.Same(t, state, index.ClusterState())
+	assert.Empty(t, candidates.Gateways)
+	assert.Equal(t, []string{"default", "reviews"}, names(candidates.Sidecars))
+	assert.Equal(t, []string{"mesh", "reviews-v2", "reviews-v1", "by-service"}, names(candidates.AuthorizationPolicies))
+	assert.Equal(t, []string{"strict"}, names(candidates.PeerAuthentications))
+	assert.Empty(t, candidates.EnvoyFilters)
+
+	// Filtering the candidates gives the same result as filtering every resource
+	assert.Equal(t,
+		FilterAuthorizationPoliciesForWorkload(state.AuthorizationPolicies, instance, "bookinfo", ""),
+		FilterAuthorizationPoliciesForWorkload(candidates.AuthorizationPolicies, instance, "bookinfo", ""))
+	assert.Equal(t,
+		FilterSidecarsForWorkload(state.Sidecars, instance, "bookinfo"),
+		FilterSidecarsForWorkload(candidates.Sidecars, instance, "bookinfo"))
+
+	// Gateways select workloads across namespaces
+	gateway := &backendv1alpha1.ServiceInstance{Labels: map[string]string{"istio": "ingressgateway"}}
+	assert.Equal(t, []string{"ingress"}, names(index.Candidates(gateway, "ingress", "").Gateways))
+}
+
+func TestSelectorIndex_MatchWorkloadAndResolve(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		PeerAuthentications: []*typesv1alpha1.PeerAuthentication{
+			{Name: "strict", Namespace: "istio-system"},
+			{Name: "ratings", Namespace: "bookinfo", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}}},
+			{Name: "reviews", Namespace: "bookinfo", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}}},
+		},
+		WasmPlugins: []*typesv1alpha1.WasmPlugin{
+			{Name: "other", Namespace: "other"},
+		},
+	}
+	index := NewSelectorIndex(state)
+	instance := &backendv1alpha1.ServiceInstance{Labels: map[string]string{"app": "reviews"}}
+
+	policies := index.MatchWorkload(instance, "bookinfo", "istio-system")
+	assert.Equal(t, []string{"istio-system/strict", "bookinfo/reviews"}, policies.PeerAuthentications)
+	assert.Empty(t, policies.WasmPlugins)
+
+	// Resolving references yields the resources in their original order, skipping unknown ones
+	policies.PeerAuthentications = append(policies.PeerAuthentications, "bookinfo/deleted")
+	resolved := index.Resolve(policies)
+	assert.Equal(t, []*typesv1alpha1.PeerAuthentication{state.PeerAuthentications[0], state.PeerAuthentications[2]}, resolved.PeerAuthentications)
+	assert.Empty(t, resolved.WasmPlugins)
+}
+
+func names[T interface{ GetName() string }](resources []T) []string {
+	result := []string{}
+	for _, resource := range resources {
+		result = append(result, resource.GetName())
+	}
+	return result
+}
@@ -28,7 +28,7 @@ func serviceEntryMatchesWorkload(se *typesv1alpha1.ServiceEntry, instance *backe
 	}
 
 	// Check namespace visibility based on exportTo field
-	return isVisibleToNamespace(serviceEntryExporter(se), workloadNamespace)
+	return IsVisibleToNamespace(serviceEntryExporter(se), workloadNamespace)
 }
 
 // FilterServiceEntriesForWorkload returns all service entries that apply to a specific workload instance.
@@ -90,7 +90,7 @@ func virtualServiceMatchesWorkload(vs *typesv1alpha1.VirtualService, instance *b
 	}
 
 	// Stage 1: Check namespace visibility
-	if !isVisibleToNamespace(virtualServiceExporter(vs), workloadNamespace) {
+	if !IsVisibleToNamespace(virtualServiceExporter(vs), workloadNamespace) {
 		return false
 	}
 
@@ -143,7 +143,7 @@ func FilterVirtualServicesForMatchingGateways(virtualServices []*typesv1alpha1.V
 
 	for _, vs := range virtualServices {
 		// Check namespace visibility first
-		if !isVisibleToNamespace(virtualServiceExporter(vs), workloadNamespace) {
+		if !IsVisibleToNamespace(virtualServiceExporter(vs), workloadNamespace) {
 			continue
 		}
 
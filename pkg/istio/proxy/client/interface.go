@@ -32,6 +32,7 @@ type AdminClient interface {
 	GetConfigDump(ctx context.Context, namespace, podName string) (string, error)
 	GetServerInfo(ctx context.Context, namespace, podName string) (string, error)
 	GetClusters(ctx context.Context, namespace, podName string) (string, error)
+	GetAdminPath(ctx context.Context, namespace, podName, path string) (string, error)
 	GetProxyVersion(ctx context.Context, namespace, podName string) (string, error)
 	IsIstioProxyReady(ctx context.Context, namespace, podName string) (bool, error)
 }
@@ -81,6 +82,12 @@ func (a *kubectlExecAdapter) GetClusters(ctx context.Context, namespace, podName
 	return a.exec.ExecInContainer(ctx, namespace, podName, "istio-proxy", command)
 }
 
+// GetAdminPath implementation for backward compatibility
+func (a *kubectlExecAdapter) GetAdminPath(ctx context.Context, namespace, podName, path string) (string, error) {
+	command := []string{"pilot-agent", "request", "GET", path}
+	return a.exec.ExecInContainer(ctx, namespace, podName, "istio-proxy", command)
+}
+
 // GetProxyVersion implementation for backward compatibility
 func (a *kubectlExecAdapter) GetProxyVersion(ctx context.Context, namespace, podName string) (string, error) {
 	// Simple implementation - just return "unknown" for adapter
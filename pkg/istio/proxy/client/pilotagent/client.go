@@ -107,6 +107,24 @@ func (c *Client) GetClusters(ctx context.Context, namespace, podName string) (st
 	return strings.TrimSpace(output), nil
 }
 
+// GetAdminPath retrieves an arbitrary Envoy admin endpoint from istio-proxy container.
+// Callers are responsible for restricting path to safe, read-only endpoints.
+// Equivalent to: kubectl exec POD -c istio-proxy -- pilot-agent request GET <path>
+func (c *Client) GetAdminPath(ctx context.Context, namespace, podName, path string) (string, error) {
+	// Validate the pod has istio-proxy container
+	if err := c.validateIstioProxy(ctx, namespace, podName); err != nil {
+		return "", err
+	}
+
+	command := []string{"pilot-agent", "request", "GET", path}
+	output, err := c.execInContainer(ctx, namespace, podName, IstioProxyContainer, command)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute pilot-agent %s: %w", path, err)
+	}
+
+	return output, nil
+}
+
 // GetProxyVersion extracts the Envoy version from istio-proxy container
 func (c *Client) GetProxyVersion(ctx context.Context, namespace, podName string) (string, error) {
 	serverInfo, err := c.GetServerInfo(ctx, namespace, podName)
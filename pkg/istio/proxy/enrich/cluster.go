@@ -15,7 +15,27 @@
 package enrich
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+)
+
+// Istio TLS modes reported for outbound clusters, named after the DestinationRule ClientTLSSettings modes
+const (
+	TLSModeDisable     = "DISABLE"
+	TLSModeSimple      = "SIMPLE"
+	TLSModeMutual      = "MUTUAL"
+	TLSModeIstioMutual = "ISTIO_MUTUAL"
+	// TLSModeAuto is reported when Istio picks plaintext or mTLS per endpoint (auto mTLS)
+	TLSModeAuto = "AUTO"
+)
+
+// istioDefaultCertificate and istioRootCertificate are the SDS secrets of the workload's mesh identity
+const (
+	istioDefaultCertificate = "default"
+	istioRootCertificate    = "ROOTCA"
 )
 
 // enrichClusterNameComponents parses Istio cluster name components and populates them
@@ -34,3 +54,124 @@ func enrichClusterNameComponents() func(*v1alpha1.ClusterSummary) error {
 		return nil
 	}
 }
+
+// enrichClusterDestinationRule attributes a cluster to the DestinationRule named by Istio's cluster metadata
+// and infers the Istio TLS mode of outbound clusters from their upstream TLS settings
+func enrichClusterDestinationRule() func(*v1alpha1.ClusterSummary) error {
+	return func(cluster *v1alpha1.ClusterSummary) error {
+		if cluster == nil {
+			return nil
+		}
+
+		cluster.DestinationRuleNamespace, cluster.DestinationRuleName = DestinationRule(cluster.Metadata)
+		if cluster.Direction == v1alpha1.ClusterDirection_OUTBOUND {
+			cluster.TlsMode = inferTLSMode(cluster.Tls)
+		}
+		return nil
+	}
+}
+
+// DestinationRule returns the namespace and name of the DestinationRule named by Istio's cluster metadata,
+// e.g. "/apis/networking.istio.io/v1alpha3/namespaces/default/destination-rule/reviews". Both are empty
+// when no DestinationRule configured the cluster.
+func DestinationRule(metadata map[string]string) (namespace, name string) {
+	return istioConfigResource(metadata, "destination-rule")
+}
+
+// inferTLSMode maps upstream TLS settings to the Istio TLS mode that produces them
+func inferTLSMode(tls *v1alpha1.UpstreamTlsInfo) string {
+	switch {
+	case tls == nil:
+		return TLSModeDisable
+	case tls.PerEndpoint:
+		return TLSModeAuto
+	case slices.Equal(tls.Certificates, []string{istioDefaultCertificate}) && tls.Validation == istioRootCertificate:
+		return TLSModeIstioMutual
+	case len(tls.Certificates) > 0:
+		return TLSModeMutual
+	default:
+		return TLSModeSimple
+	}
+}
+
+// ClusterDestinationRules attributes outbound clusters that Istio's metadata leaves unattributed to the
+// DestinationRule that applies to them, chosen from the destination rules of the proxy's cluster the way
+// Istio merges them: rules in the proxy's namespace take precedence over rules in the service's namespace,
+// which take precedence over the rest, and an exact host takes precedence over a wildcard. Subset clusters
+// are only attributed to a rule defining their subset. Rules with a workload selector are skipped as the
+// proxy's labels are not known.
+func ClusterDestinationRules(clusters []*v1alpha1.ClusterSummary, destinationRules []*v1alpha1.DestinationRule, proxyNamespace string) {
+	for _, cluster := range clusters {
+		if cluster.Direction != v1alpha1.ClusterDirection_OUTBOUND || cluster.ServiceFqdn == "" || cluster.DestinationRuleName != "" {
+			continue
+		}
+
+		var best *v1alpha1.DestinationRule
+		bestRank := -1
+		for _, dr := range destinationRules {
+			if dr.WorkloadSelector != nil || !filters.IsVisibleToNamespace(dr, proxyNamespace) {
+				continue
+			}
+			if cluster.Subset != "" && !definesSubset(dr, cluster.Subset) {
+				continue
+			}
+			rank := destinationRuleRank(dr, cluster.ServiceFqdn, proxyNamespace)
+			if rank > bestRank {
+				best, bestRank = dr, rank
+			}
+		}
+		if best != nil {
+			cluster.DestinationRuleNamespace, cluster.DestinationRuleName = best.Namespace, best.Name
+		}
+	}
+}
+
+// destinationRuleRank ranks how specifically a destination rule applies to a service for a proxy, or
+// returns -1 when its host does not match the service. The namespace precedence dominates the host match.
+func destinationRuleRank(dr *v1alpha1.DestinationRule, serviceFqdn, proxyNamespace string) int {
+	hostRank := hostMatchRank(dr.Host, dr.Namespace, serviceFqdn)
+	if hostRank < 0 {
+		return -1
+	}
+
+	_, serviceNamespace := parseFQDN(serviceFqdn)
+	namespaceRank := 0
+	switch dr.Namespace {
+	case proxyNamespace:
+		namespaceRank = 2
+	case serviceNamespace:
+		namespaceRank = 1
+	}
+	return namespaceRank*(len(serviceFqdn)+2) + hostRank
+}
+
+// hostMatchRank returns how specifically an Istio host matches a service FQDN: the length of the matched
+// wildcard suffix, one more than any wildcard for an exact match, or -1 when the host does not match.
+// Short names are resolved relative to the namespace of the resource declaring them.
+func hostMatchRank(host, resourceNamespace, serviceFqdn string) int {
+	switch {
+	case host == "*":
+		return 0
+	case strings.HasPrefix(host, "*."):
+		if strings.HasSuffix(serviceFqdn, host[1:]) {
+			return len(host) - 1
+		}
+		return -1
+	case !strings.Contains(host, "."):
+		host = host + "." + resourceNamespace + ".svc.cluster.local"
+	}
+	if host == serviceFqdn {
+		return len(serviceFqdn) + 1
+	}
+	return -1
+}
+
+// definesSubset reports whether a destination rule defines the named subset
+func definesSubset(dr *v1alpha1.DestinationRule, subset string) bool {
+	for _, s := range dr.Subsets {
+		if s.Name == subset {
+			return true
+		}
+	}
+	return false
+}
@@ -331,3 +331,153 @@ func TestParseClusterNameComponents(t *testing.T) {
 		})
 	}
 }
+
+func TestEnrichClusterDestinationRule(t *testing.T) {
+	enrichFunc := enrichClusterDestinationRule()
+
+	tests := []struct {
+		name              string
+		cluster           *v1alpha1.ClusterSummary
+		expectedNamespace string
+		expectedName      string
+		expectedTLSMode   string
+	}{
+		{
+			name: "subset cluster generated from a destination rule",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction: v1alpha1.ClusterDirection_OUTBOUND,
+				Metadata:  map[string]string{IstioConfigMetadataKey: "/apis/networking.istio.io/v1/namespaces/demo/destination-rule/backend"},
+				Tls:       &v1alpha1.UpstreamTlsInfo{Certificates: []string{"default"}, Validation: "ROOTCA"},
+			},
+			expectedNamespace: "demo",
+			expectedName:      "backend",
+			expectedTLSMode:   TLSModeIstioMutual,
+		},
+		{
+			name:            "plaintext cluster",
+			cluster:         &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND},
+			expectedTLSMode: TLSModeDisable,
+		},
+		{
+			name: "auto mTLS cluster",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction: v1alpha1.ClusterDirection_OUTBOUND,
+				Tls:       &v1alpha1.UpstreamTlsInfo{Certificates: []string{"default"}, Validation: "ROOTCA", PerEndpoint: true},
+			},
+			expectedTLSMode: TLSModeAuto,
+		},
+		{
+			name: "TLS origination with client certificate",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction: v1alpha1.ClusterDirection_OUTBOUND,
+				Tls:       &v1alpha1.UpstreamTlsInfo{Sni: "api.example.com", Certificates: []string{"file-cert:/etc/certs/client.pem"}},
+			},
+			expectedTLSMode: TLSModeMutual,
+		},
+		{
+			name: "TLS origination",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction: v1alpha1.ClusterDirection_OUTBOUND,
+				Tls:       &v1alpha1.UpstreamTlsInfo{Sni: "api.example.com"},
+			},
+			expectedTLSMode: TLSModeSimple,
+		},
+		{
+			name: "inbound cluster has no TLS mode",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction: v1alpha1.ClusterDirection_INBOUND,
+				Metadata:  map[string]string{IstioConfigMetadataKey: "/apis/networking.istio.io/v1/namespaces/demo/virtual-service/backend"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, enrichFunc(tt.cluster))
+			assert.Equal(t, tt.expectedNamespace, tt.cluster.DestinationRuleNamespace)
+			assert.Equal(t, tt.expectedName, tt.cluster.DestinationRuleName)
+			assert.Equal(t, tt.expectedTLSMode, tt.cluster.TlsMode)
+		})
+	}
+}
+
+func TestClusterDestinationRules(t *testing.T) {
+	destinationRules := []*v1alpha1.DestinationRule{
+		{Name: "mesh-default", Namespace: "istio-system", Host: "*.svc.cluster.local"},
+		{Name: "backend", Namespace: "demo", Host: "backend", Subsets: []*v1alpha1.DestinationRuleSubset{{Name: "v1"}}},
+		{Name: "backend-override", Namespace: "frontend", Host: "backend.demo.svc.cluster.local"},
+		{Name: "backend-private", Namespace: "demo", Host: "backend", ExportTo: []string{"."}, Subsets: []*v1alpha1.DestinationRuleSubset{{Name: "v2"}}},
+		{Name: "backend-selected", Namespace: "frontend", Host: "backend.demo.svc.cluster.local", WorkloadSelector: &v1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "web"}}},
+		{Name: "external", Namespace: "demo", Host: "api.example.com"},
+	}
+
+	tests := []struct {
+		name           string
+		cluster        *v1alpha1.ClusterSummary
+		proxyNamespace string
+		expected       string
+	}{
+		{
+			name:           "rule in the service namespace",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, ServiceFqdn: "backend.demo.svc.cluster.local"},
+			proxyNamespace: "other",
+			expected:       "demo/backend",
+		},
+		{
+			name:           "rule in the proxy namespace takes precedence",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, ServiceFqdn: "backend.demo.svc.cluster.local"},
+			proxyNamespace: "frontend",
+			expected:       "frontend/backend-override",
+		},
+		{
+			name:           "subset cluster is attributed to the rule defining the subset",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, Subset: "v1", ServiceFqdn: "backend.demo.svc.cluster.local"},
+			proxyNamespace: "frontend",
+			expected:       "demo/backend",
+		},
+		{
+			name:           "subset of a rule not exported to the proxy namespace",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, Subset: "v2", ServiceFqdn: "backend.demo.svc.cluster.local"},
+			proxyNamespace: "frontend",
+		},
+		{
+			name:           "wildcard rule",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, ServiceFqdn: "reviews.demo.svc.cluster.local"},
+			proxyNamespace: "frontend",
+			expected:       "istio-system/mesh-default",
+		},
+		{
+			name:           "external service",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_OUTBOUND, ServiceFqdn: "api.example.com"},
+			proxyNamespace: "frontend",
+			expected:       "demo/external",
+		},
+		{
+			name: "attribution from metadata is kept",
+			cluster: &v1alpha1.ClusterSummary{
+				Direction:                v1alpha1.ClusterDirection_OUTBOUND,
+				ServiceFqdn:              "backend.demo.svc.cluster.local",
+				DestinationRuleNamespace: "demo",
+				DestinationRuleName:      "backend",
+			},
+			proxyNamespace: "frontend",
+			expected:       "demo/backend",
+		},
+		{
+			name:           "inbound cluster",
+			cluster:        &v1alpha1.ClusterSummary{Direction: v1alpha1.ClusterDirection_INBOUND},
+			proxyNamespace: "demo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ClusterDestinationRules([]*v1alpha1.ClusterSummary{tt.cluster}, destinationRules, tt.proxyNamespace)
+			actual := ""
+			if tt.cluster.DestinationRuleName != "" {
+				actual = tt.cluster.DestinationRuleNamespace + "/" + tt.cluster.DestinationRuleName
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -49,6 +49,9 @@ func ProxyConfigSummary(summary *configdump.ParsedSummary) error {
 		if err := enrichClusterNameComponents()(cluster); err != nil {
 			return err
 		}
+		if err := enrichClusterDestinationRule()(cluster); err != nil {
+			return err
+		}
 	}
 
 	// Enrich routes
@@ -56,6 +59,9 @@ func ProxyConfigSummary(summary *configdump.ParsedSummary) error {
 		if err := enrichRouteType()(route); err != nil {
 			return err
 		}
+		if err := enrichRouteVirtualServices()(route); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -37,10 +37,20 @@ func TestProxyConfigSummary(t *testing.T) {
 				},
 			},
 			Clusters: []*v1alpha1.ClusterSummary{
-				{Name: "outbound|8080||backend.demo.svc.cluster.local"},
+				{
+					Name:     "outbound|8080||backend.demo.svc.cluster.local",
+					Metadata: map[string]string{"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/demo/destination-rule/backend"},
+				},
 			},
 			Routes: []*v1alpha1.RouteConfigSummary{
-				{Name: "8080"},
+				{
+					Name: "8080",
+					VirtualHosts: []*v1alpha1.VirtualHostInfo{
+						{Routes: []*v1alpha1.RouteInfo{
+							{Metadata: map[string]string{"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/demo/virtual-service/backend"}},
+						}},
+					},
+				},
 			},
 		}
 
@@ -56,9 +66,12 @@ func TestProxyConfigSummary(t *testing.T) {
 		// Check cluster enrichment
 		assert.Equal(t, v1alpha1.ClusterDirection_OUTBOUND, summary.Clusters[0].Direction)
 		assert.Equal(t, uint32(8080), summary.Clusters[0].Port)
+		assert.Equal(t, "backend", summary.Clusters[0].DestinationRuleName)
+		assert.Equal(t, TLSModeDisable, summary.Clusters[0].TlsMode)
 
 		// Check route enrichment
 		assert.Equal(t, v1alpha1.RouteType_PORT_BASED, summary.Routes[0].Type)
+		assert.Equal(t, "backend", summary.Routes[0].VirtualHosts[0].Routes[0].VirtualServiceName)
 	})
 }
 
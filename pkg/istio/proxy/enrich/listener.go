@@ -15,9 +15,12 @@
 package enrich
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/rawconfig"
 )
 
 // enrichListenerType classifies listener type based on Istio-specific patterns
@@ -221,3 +224,245 @@ func enrichTcpProxyMatch(tcpProxy *v1alpha1.TcpProxyMatch, listener *v1alpha1.Li
 		}
 	}
 }
+
+// OutboundTrafficPolicyMode returns how a proxy handles traffic to destinations outside the service registry,
+// from the destination of the catch-all filter chain of its virtual outbound listener: PassthroughCluster
+// under ALLOW_ANY and BlackHoleCluster under REGISTRY_ONLY. The filter chains matching on a destination, such
+// as the one blackholing traffic addressed to the outbound capture port itself, are ignored. The mode is
+// unspecified when the proxy has no virtual outbound listener.
+func OutboundTrafficPolicyMode(listeners []*v1alpha1.ListenerSummary) v1alpha1.OutboundTrafficPolicyMode {
+	for _, listener := range listeners {
+		if listener.Type != v1alpha1.ListenerType_VIRTUAL_OUTBOUND {
+			continue
+		}
+		for _, rule := range listener.Rules {
+			if rule.Match != nil {
+				continue
+			}
+			switch rule.GetDestination().GetClusterName() {
+			case "PassthroughCluster":
+				return v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY
+			case "BlackHoleCluster":
+				return v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY
+			}
+		}
+	}
+	return v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_UNSPECIFIED
+}
+
+// portSpec is the port of a Gateway server or Sidecar listener
+type portSpec struct {
+	Number   uint32 `json:"number"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+}
+
+// gatewaySpec is the part of a Gateway's raw config listener attribution reads
+type gatewaySpec struct {
+	Spec struct {
+		Servers []struct {
+			Name  string   `json:"name"`
+			Port  portSpec `json:"port"`
+			Hosts []string `json:"hosts"`
+			TLS   *struct {
+				Mode string `json:"mode"`
+			} `json:"tls"`
+		} `json:"servers"`
+	} `json:"spec"`
+}
+
+// sidecarSpec is the part of a Sidecar's raw config listener attribution reads
+type sidecarSpec struct {
+	Spec struct {
+		Ingress []struct {
+			Port portSpec `json:"port"`
+			Bind string   `json:"bind"`
+		} `json:"ingress"`
+		Egress []struct {
+			Port  *portSpec `json:"port"`
+			Bind  string    `json:"bind"`
+			Hosts []string  `json:"hosts"`
+		} `json:"egress"`
+	} `json:"spec"`
+}
+
+// ListenerSources attributes a proxy's listeners to the Gateway servers and Sidecar listeners that generated
+// them. gateways and sidecars are the resources selecting the proxy; of the sidecars, the one Istio applies
+// is used: a sidecar with a workload selector takes precedence over the namespace default.
+//
+// Gateway listeners are attributed to HTTP servers by their "http.<port>" route configuration, to terminated
+// HTTPS servers by their "https.<port>.<port name>.<gateway>.<namespace>" route configuration, to other TLS
+// servers by SNI when the listener matches on it, and to the remaining servers by port. Sidecar listeners are
+// attributed to ingress listeners by the inbound cluster of their port and to egress listeners by port; the
+// egress listener without a port is attributed the outbound listeners no other egress listener claims.
+// Resources whose raw config cannot be read are skipped.
+func ListenerSources(listeners []*v1alpha1.ListenerSummary, gateways []*v1alpha1.Gateway, sidecars []*v1alpha1.Sidecar) {
+	for _, gateway := range gateways {
+		var spec gatewaySpec
+		if rawconfig.Decode(gateway, &spec) != nil {
+			continue
+		}
+		for i, server := range spec.Spec.Servers {
+			section := server.Name
+			if section == "" {
+				section = fmt.Sprintf("servers[%d]", i)
+			}
+			tlsMode := ""
+			if server.TLS != nil {
+				tlsMode = strings.ToUpper(server.TLS.Mode)
+			}
+			for _, listener := range listeners {
+				if listener.Type != v1alpha1.ListenerType_GATEWAY_INBOUND {
+					continue
+				}
+				if gatewayServerGenerated(listener, gateway, server.Port, server.Hosts, tlsMode) {
+					listener.Sources = append(listener.Sources, &v1alpha1.ListenerSource{
+						Kind:      "Gateway",
+						Name:      gateway.Name,
+						Namespace: gateway.Namespace,
+						Section:   section,
+						Hosts:     server.Hosts,
+					})
+				}
+			}
+		}
+	}
+
+	sidecar := effectiveSidecar(sidecars)
+	if sidecar == nil {
+		return
+	}
+	var spec sidecarSpec
+	if rawconfig.Decode(sidecar, &spec) != nil {
+		return
+	}
+	source := func(section string, hosts []string) *v1alpha1.ListenerSource {
+		return &v1alpha1.ListenerSource{
+			Kind:      "Sidecar",
+			Name:      sidecar.Name,
+			Namespace: sidecar.Namespace,
+			Section:   section,
+			Hosts:     hosts,
+		}
+	}
+
+	for i, ingress := range spec.Spec.Ingress {
+		for _, listener := range listeners {
+			if routesToInboundPort(listener, ingress.Port.Number) || (ingress.Bind != "" && listener.Address == ingress.Bind && listener.Port == ingress.Port.Number) {
+				listener.Sources = append(listener.Sources, source(fmt.Sprintf("ingress[%d]", i), nil))
+			}
+		}
+	}
+
+	claimed := make(map[*v1alpha1.ListenerSummary]bool)
+	for i, egress := range spec.Spec.Egress {
+		if egress.Port == nil || egress.Port.Number == 0 {
+			continue
+		}
+		for _, listener := range listeners {
+			if isOutboundListener(listener) && listener.Port == egress.Port.Number && (egress.Bind == "" || listener.Address == egress.Bind) {
+				listener.Sources = append(listener.Sources, source(fmt.Sprintf("egress[%d]", i), egress.Hosts))
+				claimed[listener] = true
+			}
+		}
+	}
+	for i, egress := range spec.Spec.Egress {
+		if egress.Port != nil && egress.Port.Number != 0 {
+			continue
+		}
+		for _, listener := range listeners {
+			if isOutboundListener(listener) && !claimed[listener] {
+				listener.Sources = append(listener.Sources, source(fmt.Sprintf("egress[%d]", i), egress.Hosts))
+			}
+		}
+	}
+}
+
+// gatewayServerGenerated reports whether a gateway server generated a gateway listener
+func gatewayServerGenerated(listener *v1alpha1.ListenerSummary, gateway *v1alpha1.Gateway, port portSpec, hosts []string, tlsMode string) bool {
+	number := strconv.FormatUint(uint64(port.Number), 10)
+	switch protocol := strings.ToUpper(port.Protocol); {
+	case protocol == "HTTP" || protocol == "HTTP2" || protocol == "GRPC":
+		return hasRouteConfig(listener, "http."+number)
+	case protocol == "HTTPS" && tlsMode != "PASSTHROUGH":
+		return hasRouteConfig(listener, strings.Join([]string{"https", number, port.Name, gateway.Name, gateway.Namespace}, "."))
+	case (protocol == "HTTPS" || protocol == "TLS") && hasServerNames(listener):
+		return matchesServerNames(listener, hosts)
+	default:
+		return listener.Port == port.Number
+	}
+}
+
+// hasRouteConfig reports whether a listener loads the named route configuration
+func hasRouteConfig(listener *v1alpha1.ListenerSummary, name string) bool {
+	for _, routeConfig := range listener.RouteConfigs {
+		if routeConfig == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasServerNames reports whether any of a listener's filter chains match on SNI
+func hasServerNames(listener *v1alpha1.ListenerSummary) bool {
+	for _, rule := range listener.Rules {
+		if len(rule.GetMatch().GetFilterChain().GetServerNames()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesServerNames reports whether a listener's filter chains match the SNI of any of a gateway server's
+// hosts, which may be prefixed with a namespace ("namespace/host") and use a leading wildcard
+func matchesServerNames(listener *v1alpha1.ListenerSummary, hosts []string) bool {
+	for _, rule := range listener.Rules {
+		for _, serverName := range rule.GetMatch().GetFilterChain().GetServerNames() {
+			for _, host := range hosts {
+				if _, after, found := strings.Cut(host, "/"); found {
+					host = after
+				}
+				if host == "*" || host == serverName || (strings.HasPrefix(host, "*.") && strings.HasSuffix(serverName, host[1:])) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// routesToInboundPort reports whether a virtual inbound listener routes to the inbound cluster of a port
+func routesToInboundPort(listener *v1alpha1.ListenerSummary, port uint32) bool {
+	if listener.Type != v1alpha1.ListenerType_VIRTUAL_INBOUND {
+		return false
+	}
+	for _, rule := range listener.Rules {
+		clusterName := rule.GetDestination().GetClusterName()
+		if !strings.HasPrefix(clusterName, "inbound|") {
+			continue
+		}
+		if _, clusterPort, _, _ := ParseClusterNameComponents(clusterName); clusterPort == port {
+			return true
+		}
+	}
+	return false
+}
+
+// isOutboundListener reports whether a listener serves outbound traffic to specific ports
+func isOutboundListener(listener *v1alpha1.ListenerSummary) bool {
+	return listener.Type == v1alpha1.ListenerType_PORT_OUTBOUND || listener.Type == v1alpha1.ListenerType_SERVICE_OUTBOUND
+}
+
+// effectiveSidecar returns the sidecar Istio applies out of those selecting a proxy
+func effectiveSidecar(sidecars []*v1alpha1.Sidecar) *v1alpha1.Sidecar {
+	var namespaceDefault *v1alpha1.Sidecar
+	for _, sidecar := range sidecars {
+		if len(sidecar.GetWorkloadSelector().GetMatchLabels()) > 0 {
+			return sidecar
+		}
+		if namespaceDefault == nil {
+			namespaceDefault = sidecar
+		}
+	}
+	return namespaceDefault
+}
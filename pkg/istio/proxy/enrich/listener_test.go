@@ -994,3 +994,153 @@ func TestEnrichMatchWithIstioInfo(t *testing.T) {
 		enrichMatchWithIstioInfo(match, listener) // Should not panic
 	})
 }
+
+// listenerSections returns the sources of a listener as "<kind>/<namespace>/<name>/<section>"
+func listenerSections(listener *v1alpha1.ListenerSummary) []string {
+	var sections []string
+	for _, source := range listener.Sources {
+		sections = append(sections, source.Kind+"/"+source.Namespace+"/"+source.Name+"/"+source.Section)
+	}
+	return sections
+}
+
+func TestListenerSourcesGateway(t *testing.T) {
+	gateways := []*v1alpha1.Gateway{
+		{
+			Name:      "bookinfo-gateway",
+			Namespace: "bookinfo",
+			RawConfig: `{"spec": {"servers": [
+				{"port": {"number": 80, "name": "http", "protocol": "HTTP"}, "hosts": ["*"]},
+				{"name": "bookinfo-https", "port": {"number": 443, "name": "https", "protocol": "HTTPS"}, "hosts": ["bookinfo.example.com"], "tls": {"mode": "SIMPLE"}}
+			]}}`,
+		},
+		{
+			Name:      "passthrough-gateway",
+			Namespace: "payments",
+			RawConfig: `{"spec": {"servers": [
+				{"port": {"number": 9443, "name": "tls", "protocol": "TLS"}, "hosts": ["payments/*.payments.example.com"], "tls": {"mode": "PASSTHROUGH"}}
+			]}}`,
+		},
+		{Name: "invalid", Namespace: "bookinfo", RawConfig: "{"},
+	}
+	listeners := []*v1alpha1.ListenerSummary{
+		{Name: "0.0.0.0_8080", Port: 8080, Type: v1alpha1.ListenerType_GATEWAY_INBOUND, RouteConfigs: []string{"http.80"}},
+		{Name: "0.0.0.0_8443", Port: 8443, Type: v1alpha1.ListenerType_GATEWAY_INBOUND, RouteConfigs: []string{"https.443.https.bookinfo-gateway.bookinfo"}},
+		{
+			Name: "0.0.0.0_15443",
+			Port: 15443,
+			Type: v1alpha1.ListenerType_GATEWAY_INBOUND,
+			Rules: []*v1alpha1.ListenerRule{{Match: &v1alpha1.ListenerMatch{MatchType: &v1alpha1.ListenerMatch_FilterChain{
+				FilterChain: &v1alpha1.FilterChainMatch{ServerNames: []string{"api.payments.example.com"}},
+			}}}},
+		},
+		{Name: "0.0.0.0_15021", Port: 15021, Type: v1alpha1.ListenerType_PROXY_HEALTHCHECK},
+	}
+
+	ListenerSources(listeners, gateways, nil)
+
+	assert.Equal(t, []string{"Gateway/bookinfo/bookinfo-gateway/servers[0]"}, listenerSections(listeners[0]))
+	assert.Equal(t, []string{"Gateway/bookinfo/bookinfo-gateway/bookinfo-https"}, listenerSections(listeners[1]))
+	assert.Equal(t, []string{"Gateway/payments/passthrough-gateway/servers[0]"}, listenerSections(listeners[2]))
+	assert.Equal(t, []string{"payments/*.payments.example.com"}, listeners[2].Sources[0].Hosts)
+	assert.Empty(t, listeners[3].Sources)
+}
+
+func TestListenerSourcesSidecar(t *testing.T) {
+	sidecars := []*v1alpha1.Sidecar{
+		{
+			Name:      "default",
+			Namespace: "bookinfo",
+			RawConfig: `{"spec": {"egress": [{"hosts": ["./*"]}]}}`,
+		},
+		{
+			Name:             "reviews",
+			Namespace:        "bookinfo",
+			WorkloadSelector: &v1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+			RawConfig: `{"spec": {
+				"ingress": [{"port": {"number": 9080, "name": "http", "protocol": "HTTP"}, "defaultEndpoint": "127.0.0.1:9080"}],
+				"egress": [
+					{"port": {"number": 3306, "name": "mysql", "protocol": "TCP"}, "hosts": ["db/*"]},
+					{"hosts": ["./*", "istio-system/*"]}
+				]
+			}}`,
+		},
+	}
+	listeners := []*v1alpha1.ListenerSummary{
+		{
+			Name: "virtualInbound",
+			Type: v1alpha1.ListenerType_VIRTUAL_INBOUND,
+			Rules: []*v1alpha1.ListenerRule{
+				{Destination: &v1alpha1.ListenerDestination{ClusterName: "inbound|9080||"}},
+			},
+		},
+		{Name: "0.0.0.0_3306", Address: "0.0.0.0", Port: 3306, Type: v1alpha1.ListenerType_PORT_OUTBOUND},
+		{Name: "0.0.0.0_9080", Address: "0.0.0.0", Port: 9080, Type: v1alpha1.ListenerType_PORT_OUTBOUND},
+		{Name: "virtualOutbound", Port: 15001, Type: v1alpha1.ListenerType_VIRTUAL_OUTBOUND},
+	}
+
+	ListenerSources(listeners, nil, sidecars)
+
+	assert.Equal(t, []string{"Sidecar/bookinfo/reviews/ingress[0]"}, listenerSections(listeners[0]))
+	assert.Equal(t, []string{"Sidecar/bookinfo/reviews/egress[0]"}, listenerSections(listeners[1]))
+	assert.Equal(t, []string{"db/*"}, listeners[1].Sources[0].Hosts)
+	assert.Equal(t, []string{"Sidecar/bookinfo/reviews/egress[1]"}, listenerSections(listeners[2]))
+	assert.Empty(t, listeners[3].Sources)
+}
+
+func TestEffectiveSidecar(t *testing.T) {
+	namespaceDefault := &v1alpha1.Sidecar{Name: "default"}
+	selecting := &v1alpha1.Sidecar{Name: "reviews", WorkloadSelector: &v1alpha1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}}}
+
+	assert.Nil(t, effectiveSidecar(nil))
+	assert.Equal(t, namespaceDefault, effectiveSidecar([]*v1alpha1.Sidecar{namespaceDefault}))
+	require.Equal(t, selecting, effectiveSidecar([]*v1alpha1.Sidecar{namespaceDefault, selecting}))
+}
+
+func TestOutboundTrafficPolicyMode(t *testing.T) {
+	virtualOutbound := func(catchAllCluster string) *v1alpha1.ListenerSummary {
+		return &v1alpha1.ListenerSummary{
+			Name: "virtualOutbound",
+			Type: v1alpha1.ListenerType_VIRTUAL_OUTBOUND,
+			Rules: []*v1alpha1.ListenerRule{
+				{
+					// Traffic addressed to the outbound capture port itself is always blackholed.
+					Match: &v1alpha1.ListenerMatch{
+						MatchType: &v1alpha1.ListenerMatch_FilterChain{FilterChain: &v1alpha1.FilterChainMatch{}},
+					},
+					Destination: &v1alpha1.ListenerDestination{ClusterName: "BlackHoleCluster"},
+				},
+				{Destination: &v1alpha1.ListenerDestination{ClusterName: catchAllCluster}},
+			},
+		}
+	}
+	outbound := &v1alpha1.ListenerSummary{Name: "0.0.0.0_8080", Type: v1alpha1.ListenerType_PORT_OUTBOUND}
+
+	tests := []struct {
+		name      string
+		listeners []*v1alpha1.ListenerSummary
+		want      v1alpha1.OutboundTrafficPolicyMode
+	}{
+		{
+			name:      "passthrough catch-all",
+			listeners: []*v1alpha1.ListenerSummary{outbound, virtualOutbound("PassthroughCluster")},
+			want:      v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY,
+		},
+		{
+			name:      "blackhole catch-all",
+			listeners: []*v1alpha1.ListenerSummary{outbound, virtualOutbound("BlackHoleCluster")},
+			want:      v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY,
+		},
+		{
+			name:      "no virtual outbound listener",
+			listeners: []*v1alpha1.ListenerSummary{outbound},
+			want:      v1alpha1.OutboundTrafficPolicyMode_OUTBOUND_TRAFFIC_POLICY_MODE_UNSPECIFIED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, OutboundTrafficPolicyMode(tt.listeners))
+		})
+	}
+}
@@ -21,6 +21,10 @@ import (
 	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
 )
 
+// IstioConfigMetadataKey is the flattened metadata key Istio uses to name the resource that generated
+// a route or cluster
+const IstioConfigMetadataKey = "istio.config"
+
 // Common Istio static cluster names
 var istioStaticClusters = []string{
 	"prometheus_stats",
@@ -217,3 +221,17 @@ func ExtractNamespace(serviceFqdn string) string {
 	_, namespace := parseFQDN(serviceFqdn)
 	return namespace
 }
+
+// istioConfigResource returns the namespace and name of the resource of the given kind named by Istio's
+// config metadata, e.g. "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews".
+// Both are empty when the metadata names no resource of that kind.
+func istioConfigResource(metadata map[string]string, kind string) (namespace, name string) {
+	parts := strings.Split(strings.Trim(metadata[IstioConfigMetadataKey], "/"), "/")
+	if len(parts) != 7 || parts[0] != "apis" || parts[1] != "networking.istio.io" || parts[3] != "namespaces" {
+		return "", ""
+	}
+	if parts[5] != kind || parts[4] == "" || parts[6] == "" {
+		return "", ""
+	}
+	return parts[4], parts[6]
+}
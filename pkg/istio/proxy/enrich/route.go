@@ -32,6 +32,29 @@ func enrichRouteType() func(*v1alpha1.RouteConfigSummary) error {
 	}
 }
 
+// enrichRouteVirtualServices attributes each route to the VirtualService that generated it
+func enrichRouteVirtualServices() func(*v1alpha1.RouteConfigSummary) error {
+	return func(route *v1alpha1.RouteConfigSummary) error {
+		if route == nil {
+			return nil
+		}
+
+		for _, virtualHost := range route.VirtualHosts {
+			for _, routeInfo := range virtualHost.GetRoutes() {
+				routeInfo.VirtualServiceNamespace, routeInfo.VirtualServiceName = VirtualService(routeInfo.Metadata)
+			}
+		}
+		return nil
+	}
+}
+
+// VirtualService returns the namespace and name of the VirtualService named by Istio's route metadata,
+// e.g. "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews". Both are empty
+// when the route was not generated from a VirtualService.
+func VirtualService(metadata map[string]string) (namespace, name string) {
+	return istioConfigResource(metadata, "virtual-service")
+}
+
 // inferIstioRouteType applies Istio-specific route type detection
 func inferIstioRouteType(routeName string, currentType v1alpha1.RouteType) v1alpha1.RouteType {
 	// If already classified as static, keep it
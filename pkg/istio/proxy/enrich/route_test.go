@@ -720,3 +720,79 @@ func TestInferIstioRouteType(t *testing.T) {
 		})
 	}
 }
+
+func TestEnrichRouteVirtualServices(t *testing.T) {
+	route := &v1alpha1.RouteConfigSummary{
+		Name: "9080",
+		VirtualHosts: []*v1alpha1.VirtualHostInfo{
+			{
+				Name: "reviews.default.svc.cluster.local:9080",
+				Routes: []*v1alpha1.RouteInfo{
+					{
+						Name:     "reviews-v2",
+						Metadata: map[string]string{"istio.config": "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews"},
+					},
+					{Name: "default"},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, enrichRouteVirtualServices()(route))
+	routes := route.VirtualHosts[0].Routes
+	assert.Equal(t, "default", routes[0].VirtualServiceNamespace)
+	assert.Equal(t, "reviews", routes[0].VirtualServiceName)
+	assert.Empty(t, routes[1].VirtualServiceNamespace)
+	assert.Empty(t, routes[1].VirtualServiceName)
+
+	assert.NoError(t, enrichRouteVirtualServices()(nil))
+}
+
+func TestVirtualService(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        string
+		wantNamespace string
+		wantName      string
+	}{
+		{
+			name:          "virtual service",
+			config:        "/apis/networking.istio.io/v1alpha3/namespaces/bookinfo/virtual-service/reviews",
+			wantNamespace: "bookinfo",
+			wantName:      "reviews",
+		},
+		{
+			name:          "virtual service of another API version",
+			config:        "/apis/networking.istio.io/v1/namespaces/bookinfo/virtual-service/reviews",
+			wantNamespace: "bookinfo",
+			wantName:      "reviews",
+		},
+		{
+			name:   "other kind",
+			config: "/apis/networking.istio.io/v1alpha3/namespaces/istio-system/envoy-filter/stats",
+		},
+		{
+			name:   "other API group",
+			config: "/apis/gateway.networking.k8s.io/v1/namespaces/default/http-route/reviews",
+		},
+		{
+			name:   "malformed",
+			config: "/apis/networking.istio.io/v1alpha3/namespaces//virtual-service/reviews",
+		},
+		{
+			name: "no metadata",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var metadata map[string]string
+			if test.config != "" {
+				metadata = map[string]string{IstioConfigMetadataKey: test.config}
+			}
+			namespace, name := VirtualService(metadata)
+			assert.Equal(t, test.wantNamespace, namespace)
+			assert.Equal(t, test.wantName, name)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routesim simulates Envoy HTTP route selection against a summarized proxy configuration.
+//
+// Given a request's host, path, method and headers it walks the proxy's route configurations the
+// way Envoy does: pick the virtual host whose domains match the host, then the first route whose
+// path and header matchers match the request. Matched routes are annotated with their destination
+// clusters and the Istio VirtualService that generated them.
+package routesim
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/proxy/enrich"
+)
+
+// Request is the HTTP request to simulate
+type Request struct {
+	// Host is the Host (:authority) header, optionally including a port
+	Host string
+	// Port restricts evaluation to route configurations serving this port; zero uses the port in Host, if any
+	Port uint32
+	// Path is the request path, optionally including a query string
+	Path string
+	// Method is the HTTP method
+	Method string
+	// Headers are additional request headers
+	Headers map[string]string
+}
+
+// Simulate returns the route each applicable route configuration would select for the request.
+// Route configurations with no virtual host or route matching the request are omitted.
+func Simulate(config *v1alpha1.ProxyConfig, req Request) []*v1alpha1.RouteSimulationMatch {
+	if config == nil {
+		return nil
+	}
+
+	port := req.Port
+	if port == 0 {
+		port = hostPort(req.Host)
+	}
+	headers := requestHeaders(req)
+
+	var matches []*v1alpha1.RouteSimulationMatch
+	for _, routeConfig := range config.Routes {
+		if !servesPort(routeConfig, port) {
+			continue
+		}
+
+		virtualHost := matchVirtualHost(routeConfig.VirtualHosts, headers[":authority"])
+		if virtualHost == nil {
+			continue
+		}
+
+		for _, route := range virtualHost.Routes {
+			if !routeMatches(route.Match, headers) {
+				continue
+			}
+			matches = append(matches, &v1alpha1.RouteSimulationMatch{
+				RouteConfig:    routeConfig.Name,
+				VirtualHost:    virtualHost.Name,
+				Route:          route,
+				Destinations:   destinations(route.Action),
+				VirtualService: virtualService(route.Metadata),
+			})
+			break
+		}
+	}
+
+	return matches
+}
+
+// requestHeaders builds the lowercased header map Envoy matches against, including pseudo-headers
+func requestHeaders(req Request) map[string]string {
+	headers := make(map[string]string, len(req.Headers)+3)
+	for name, value := range req.Headers {
+		headers[strings.ToLower(name)] = value
+	}
+
+	headers[":authority"] = req.Host
+	headers[":path"] = req.Path
+	if headers[":path"] == "" {
+		headers[":path"] = "/"
+	}
+	headers[":method"] = strings.ToUpper(req.Method)
+	if headers[":method"] == "" {
+		headers[":method"] = "GET"
+	}
+
+	return headers
+}
+
+// hostPort returns the port in a host:port authority, or zero if there is none
+func hostPort(host string) uint32 {
+	_, portStr, err := net.SplitHostPort(host)
+	if err != nil {
+		return 0
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return 0
+	}
+	return uint32(port)
+}
+
+// servesPort reports whether a route configuration should be evaluated for the given port.
+// Without a port, every non-static route configuration is evaluated.
+func servesPort(routeConfig *v1alpha1.RouteConfigSummary, port uint32) bool {
+	if port == 0 {
+		return routeConfig.Type != v1alpha1.RouteType_STATIC
+	}
+
+	portStr := strconv.FormatUint(uint64(port), 10)
+	name := routeConfig.Name
+	return name == portStr || name == "http."+portStr || strings.HasSuffix(name, ":"+portStr)
+}
+
+// matchVirtualHost picks the virtual host for a request authority. Istio strips the port from the
+// host before matching, so the bare host is tried first; the full authority is only used when it
+// matches a domain more specifically than the bare host does, as with port-qualified domains.
+func matchVirtualHost(virtualHosts []*v1alpha1.VirtualHostInfo, authority string) *v1alpha1.VirtualHostInfo {
+	host, _, err := net.SplitHostPort(authority)
+	if err != nil {
+		return selectVirtualHost(virtualHosts, authority)
+	}
+
+	virtualHost := selectVirtualHost(virtualHosts, host)
+	if virtualHost == nil || isCatchAll(virtualHost) {
+		if withPort := selectVirtualHost(virtualHosts, authority); withPort != nil {
+			return withPort
+		}
+	}
+	return virtualHost
+}
+
+// isCatchAll reports whether a virtual host matches any domain
+func isCatchAll(virtualHost *v1alpha1.VirtualHostInfo) bool {
+	for _, domain := range virtualHost.Domains {
+		if domain == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// selectVirtualHost picks the virtual host for a host using Envoy's domain precedence:
+// exact match, then the longest suffix wildcard, then the longest prefix wildcard, then "*"
+func selectVirtualHost(virtualHosts []*v1alpha1.VirtualHostInfo, host string) *v1alpha1.VirtualHostInfo {
+	host = strings.ToLower(host)
+
+	var suffixMatch, prefixMatch, catchAll *v1alpha1.VirtualHostInfo
+	suffixLen, prefixLen := 0, 0
+	for _, virtualHost := range virtualHosts {
+		for _, domain := range virtualHost.Domains {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == "*":
+				if catchAll == nil {
+					catchAll = virtualHost
+				}
+			case domain == host:
+				return virtualHost
+			case strings.HasPrefix(domain, "*"):
+				suffix := domain[1:]
+				if len(host) > len(suffix) && strings.HasSuffix(host, suffix) && len(suffix) > suffixLen {
+					suffixMatch, suffixLen = virtualHost, len(suffix)
+				}
+			case strings.HasSuffix(domain, "*"):
+				prefix := domain[:len(domain)-1]
+				if len(host) > len(prefix) && strings.HasPrefix(host, prefix) && len(prefix) > prefixLen {
+					prefixMatch, prefixLen = virtualHost, len(prefix)
+				}
+			}
+		}
+	}
+
+	switch {
+	case suffixMatch != nil:
+		return suffixMatch
+	case prefixMatch != nil:
+		return prefixMatch
+	default:
+		return catchAll
+	}
+}
+
+// routeMatches reports whether a route's path and header matchers all match the request
+func routeMatches(match *v1alpha1.RouteMatchInfo, headers map[string]string) bool {
+	if match == nil {
+		return false
+	}
+
+	// Envoy matches the path without its query string
+	path := headers[":path"]
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	switch match.PathSpecifier {
+	case "prefix":
+		if match.CaseSensitive {
+			if !strings.HasPrefix(path, match.Path) {
+				return false
+			}
+		} else if !strings.HasPrefix(strings.ToLower(path), strings.ToLower(match.Path)) {
+			return false
+		}
+	case "path":
+		if match.CaseSensitive {
+			if path != match.Path {
+				return false
+			}
+		} else if !strings.EqualFold(path, match.Path) {
+			return false
+		}
+	case "safe_regex":
+		if !fullMatch(match.Path, path) {
+			return false
+		}
+	default:
+		// Path specifiers the summary does not capture cannot be evaluated
+		return false
+	}
+
+	for _, headerMatch := range match.HeaderMatches {
+		if !headerMatches(headerMatch, headers) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// headerMatches evaluates a single header matcher. A missing header never matches,
+// so an inverted matcher on a missing header does.
+func headerMatches(headerMatch *v1alpha1.HeaderMatchInfo, headers map[string]string) bool {
+	value, present := headers[strings.ToLower(headerMatch.Name)]
+
+	matched := false
+	if present {
+		switch headerMatch.MatchType {
+		case "exact":
+			matched = value == headerMatch.Value
+		case "prefix":
+			matched = strings.HasPrefix(value, headerMatch.Value)
+		case "suffix":
+			matched = strings.HasSuffix(value, headerMatch.Value)
+		case "contains":
+			matched = strings.Contains(value, headerMatch.Value)
+		case "regex":
+			matched = fullMatch(headerMatch.Value, value)
+		case "present":
+			matched = true
+		}
+	}
+
+	return matched != headerMatch.InvertMatch
+}
+
+// fullMatch reports whether the RE2 pattern matches the whole value, as Envoy's safe_regex does
+func fullMatch(pattern, value string) bool {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(value)
+}
+
+// destinations lists the upstream clusters of a route action, parsing Istio cluster names
+func destinations(action *v1alpha1.RouteActionInfo) []*v1alpha1.RouteSimulationDestination {
+	if action == nil {
+		return nil
+	}
+
+	var result []*v1alpha1.RouteSimulationDestination
+	if action.Cluster != "" {
+		result = append(result, destination(action.Cluster, 0))
+	}
+	for _, weighted := range action.WeightedClusters {
+		result = append(result, destination(weighted.Name, weighted.Weight))
+	}
+
+	// Highest weight first so the dominant destination leads
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Weight > result[j].Weight
+	})
+
+	return result
+}
+
+// destination describes a single upstream cluster
+func destination(cluster string, weight uint32) *v1alpha1.RouteSimulationDestination {
+	dest := &v1alpha1.RouteSimulationDestination{
+		Cluster: cluster,
+		Weight:  weight,
+	}
+	if strings.Contains(cluster, "|") {
+		_, dest.Port, dest.Subset, dest.ServiceFqdn = enrich.ParseClusterNameComponents(cluster)
+	}
+	return dest
+}
+
+// virtualService returns namespace/name of the VirtualService that generated a route, if any
+func virtualService(metadata map[string]string) string {
+	namespace, name := enrich.VirtualService(metadata)
+	if name == "" {
+		return ""
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,244 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routesim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+const reviewsVirtualService = "/apis/networking.istio.io/v1alpha3/namespaces/default/virtual-service/reviews"
+
+func testProxyConfig() *v1alpha1.ProxyConfig {
+	return &v1alpha1.ProxyConfig{
+		Routes: []*v1alpha1.RouteConfigSummary{
+			{
+				Name: "9080",
+				Type: v1alpha1.RouteType_PORT_BASED,
+				VirtualHosts: []*v1alpha1.VirtualHostInfo{
+					{
+						Name:    "reviews.default.svc.cluster.local:9080",
+						Domains: []string{"reviews.default.svc.cluster.local", "reviews"},
+						Routes: []*v1alpha1.RouteInfo{
+							{
+								Name: "jason",
+								Match: &v1alpha1.RouteMatchInfo{
+									PathSpecifier: "prefix",
+									Path:          "/",
+									CaseSensitive: true,
+									HeaderMatches: []*v1alpha1.HeaderMatchInfo{
+										{Name: "end-user", MatchType: "exact", Value: "jason"},
+									},
+								},
+								Action: &v1alpha1.RouteActionInfo{
+									ActionType: "route",
+									Cluster:    "outbound|9080|v2|reviews.default.svc.cluster.local",
+								},
+								Metadata: map[string]string{"istio.config": reviewsVirtualService},
+							},
+							{
+								Name: "split",
+								Match: &v1alpha1.RouteMatchInfo{
+									PathSpecifier: "prefix",
+									Path:          "/",
+									CaseSensitive: true,
+								},
+								Action: &v1alpha1.RouteActionInfo{
+									ActionType: "route",
+									WeightedClusters: []*v1alpha1.WeightedClusterInfo{
+										{Name: "outbound|9080|v1|reviews.default.svc.cluster.local", Weight: 20},
+										{Name: "outbound|9080|v3|reviews.default.svc.cluster.local", Weight: 80},
+									},
+								},
+								Metadata: map[string]string{"istio.config": reviewsVirtualService},
+							},
+						},
+					},
+					{
+						Name:    "allow_any",
+						Domains: []string{"*"},
+						Routes: []*v1alpha1.RouteInfo{
+							{
+								Name:   "allow_any",
+								Match:  &v1alpha1.RouteMatchInfo{PathSpecifier: "prefix", Path: "/", CaseSensitive: true},
+								Action: &v1alpha1.RouteActionInfo{ActionType: "route", Cluster: "PassthroughCluster"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "8080",
+				Type: v1alpha1.RouteType_PORT_BASED,
+				VirtualHosts: []*v1alpha1.VirtualHostInfo{
+					{
+						Name:    "api.example.com:8080",
+						Domains: []string{"*.example.com"},
+						Routes: []*v1alpha1.RouteInfo{
+							{
+								Name: "admin",
+								Match: &v1alpha1.RouteMatchInfo{
+									PathSpecifier: "safe_regex",
+									Path:          "/admin/[a-z]+",
+									HeaderMatches: []*v1alpha1.HeaderMatchInfo{
+										{Name: ":method", MatchType: "exact", Value: "POST"},
+									},
+								},
+								Action: &v1alpha1.RouteActionInfo{ActionType: "direct_response"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "admin",
+				Type: v1alpha1.RouteType_STATIC,
+				VirtualHosts: []*v1alpha1.VirtualHostInfo{
+					{
+						Name:    "backend",
+						Domains: []string{"*"},
+						Routes: []*v1alpha1.RouteInfo{
+							{
+								Name:   "stats",
+								Match:  &v1alpha1.RouteMatchInfo{PathSpecifier: "prefix", Path: "/", CaseSensitive: true},
+								Action: &v1alpha1.RouteActionInfo{ActionType: "route", Cluster: "prometheus_stats"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	config := testProxyConfig()
+
+	t.Run("header match selects first route", func(t *testing.T) {
+		matches := Simulate(config, Request{
+			Host:    "reviews:9080",
+			Path:    "/reviews/1",
+			Headers: map[string]string{"End-User": "jason"},
+		})
+
+		require.Len(t, matches, 1)
+		assert.Equal(t, "9080", matches[0].RouteConfig)
+		assert.Equal(t, "reviews.default.svc.cluster.local:9080", matches[0].VirtualHost)
+		assert.Equal(t, "jason", matches[0].Route.Name)
+		assert.Equal(t, "default/reviews", matches[0].VirtualService)
+		require.Len(t, matches[0].Destinations, 1)
+		assert.Equal(t, "reviews.default.svc.cluster.local", matches[0].Destinations[0].ServiceFqdn)
+		assert.Equal(t, uint32(9080), matches[0].Destinations[0].Port)
+		assert.Equal(t, "v2", matches[0].Destinations[0].Subset)
+	})
+
+	t.Run("weighted destinations are ordered by weight", func(t *testing.T) {
+		matches := Simulate(config, Request{Host: "reviews", Port: 9080, Path: "/"})
+
+		require.Len(t, matches, 1)
+		assert.Equal(t, "split", matches[0].Route.Name)
+		require.Len(t, matches[0].Destinations, 2)
+		assert.Equal(t, "v3", matches[0].Destinations[0].Subset)
+		assert.Equal(t, uint32(80), matches[0].Destinations[0].Weight)
+		assert.Equal(t, "v1", matches[0].Destinations[1].Subset)
+	})
+
+	t.Run("unknown host falls back to catch-all virtual host", func(t *testing.T) {
+		matches := Simulate(config, Request{Host: "httpbin.org:9080", Path: "/get"})
+
+		require.Len(t, matches, 1)
+		assert.Equal(t, "allow_any", matches[0].VirtualHost)
+		assert.Empty(t, matches[0].VirtualService)
+		require.Len(t, matches[0].Destinations, 1)
+		assert.Equal(t, "PassthroughCluster", matches[0].Destinations[0].Cluster)
+		assert.Zero(t, matches[0].Destinations[0].Port)
+	})
+
+	t.Run("wildcard domain with regex path and method", func(t *testing.T) {
+		matches := Simulate(config, Request{Host: "api.example.com:8080", Path: "/admin/users?limit=1", Method: "post"})
+		require.Len(t, matches, 1)
+		assert.Equal(t, "admin", matches[0].Route.Name)
+		assert.Empty(t, matches[0].Destinations)
+
+		assert.Empty(t, Simulate(config, Request{Host: "api.example.com:8080", Path: "/admin/users"}))
+		assert.Empty(t, Simulate(config, Request{Host: "example.com:8080", Path: "/admin/users", Method: "POST"}))
+	})
+
+	t.Run("without a port every non-static route configuration is evaluated", func(t *testing.T) {
+		matches := Simulate(config, Request{Host: "reviews", Path: "/"})
+
+		require.Len(t, matches, 1)
+		assert.Equal(t, "9080", matches[0].RouteConfig)
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		assert.Nil(t, Simulate(nil, Request{Host: "reviews"}))
+	})
+}
+
+func TestSelectVirtualHost(t *testing.T) {
+	exact := &v1alpha1.VirtualHostInfo{Name: "exact", Domains: []string{"foo.example.com"}}
+	suffix := &v1alpha1.VirtualHostInfo{Name: "suffix", Domains: []string{"*.example.com"}}
+	longerSuffix := &v1alpha1.VirtualHostInfo{Name: "longer-suffix", Domains: []string{"*.bar.example.com"}}
+	prefix := &v1alpha1.VirtualHostInfo{Name: "prefix", Domains: []string{"foo.*"}}
+	catchAll := &v1alpha1.VirtualHostInfo{Name: "catch-all", Domains: []string{"*"}}
+	virtualHosts := []*v1alpha1.VirtualHostInfo{catchAll, prefix, suffix, longerSuffix, exact}
+
+	tests := []struct {
+		host     string
+		expected *v1alpha1.VirtualHostInfo
+	}{
+		{host: "FOO.example.com", expected: exact},
+		{host: "baz.bar.example.com", expected: longerSuffix},
+		{host: "baz.example.com", expected: suffix},
+		{host: "foo.local", expected: prefix},
+		{host: "other", expected: catchAll},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.expected, selectVirtualHost(virtualHosts, tt.host))
+		})
+	}
+}
+
+func TestHeaderMatches(t *testing.T) {
+	headers := map[string]string{"x-version": "v2-beta"}
+
+	tests := []struct {
+		name     string
+		match    *v1alpha1.HeaderMatchInfo
+		expected bool
+	}{
+		{name: "exact", match: &v1alpha1.HeaderMatchInfo{Name: "X-Version", MatchType: "exact", Value: "v2-beta"}, expected: true},
+		{name: "prefix", match: &v1alpha1.HeaderMatchInfo{Name: "x-version", MatchType: "prefix", Value: "v2"}, expected: true},
+		{name: "suffix", match: &v1alpha1.HeaderMatchInfo{Name: "x-version", MatchType: "suffix", Value: "alpha"}, expected: false},
+		{name: "contains", match: &v1alpha1.HeaderMatchInfo{Name: "x-version", MatchType: "contains", Value: "-"}, expected: true},
+		{name: "regex must match whole value", match: &v1alpha1.HeaderMatchInfo{Name: "x-version", MatchType: "regex", Value: "v[0-9]"}, expected: false},
+		{name: "present", match: &v1alpha1.HeaderMatchInfo{Name: "x-version", MatchType: "present"}, expected: true},
+		{name: "missing header", match: &v1alpha1.HeaderMatchInfo{Name: "x-user", MatchType: "present"}, expected: false},
+		{name: "inverted missing header", match: &v1alpha1.HeaderMatchInfo{Name: "x-user", MatchType: "exact", Value: "a", InvertMatch: true}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, headerMatches(tt.match, headers))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rawconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// lastAppliedConfigAnnotation is the annotation kubectl uses to record client-side applied configuration
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// serverPopulatedMetadataFields are metadata fields set by the API server that must not be re-applied
+var serverPopulatedMetadataFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// Clean parses a raw resource and removes status and server-populated metadata, leaving
+// only the fields a user would write in a manifest.
+func Clean(rawConfig string) (map[string]interface{}, error) {
+	var resource map[string]interface{}
+	if err := json.Unmarshal([]byte(rawConfig), &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse raw config: %w", err)
+	}
+
+	delete(resource, "status")
+
+	metadata, ok := resource["metadata"].(map[string]interface{})
+	if !ok {
+		return resource, nil
+	}
+	for _, field := range serverPopulatedMetadataFields {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+
+	return resource, nil
+}
+
+// YAML renders a raw resource as a cleaned YAML manifest that can be applied with kubectl.
+func YAML(rawConfig string) (string, error) {
+	resource, err := Clean(rawConfig)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(resource); err != nil {
+		return "", fmt.Errorf("failed to encode yaml: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode yaml: %w", err)
+	}
+	return buf.String(), nil
+}
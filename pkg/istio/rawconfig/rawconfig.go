@@ -0,0 +1,165 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rawconfig compresses, restores and cleans the raw_config JSON carried by Istio resources.
+//
+// Istio resource messages carry their full resource JSON in raw_config, which dominates
+// cluster state size. Edges may move it into raw_config_zstd before sending; the manager
+// keeps it compressed in memory and restores raw_config when serving frontend responses.
+// Clean and YAML strip server-populated fields so the resource can be read or re-applied.
+package rawconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	rawConfigField        = "raw_config"
+	rawConfigZstdField    = "raw_config_zstd"
+	maxDecompressedLength = 64 * 1024 * 1024
+)
+
+var (
+	encoderOnce sync.Once
+	encoder     *zstd.Encoder
+	decoderOnce sync.Once
+	decoder     *zstd.Decoder
+)
+
+func getEncoder() *zstd.Encoder {
+	encoderOnce.Do(func() {
+		// NewWriter only fails on invalid options
+		encoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	return encoder
+}
+
+func getDecoder() *zstd.Decoder {
+	decoderOnce.Do(func() {
+		// NewReader only fails on invalid options
+		decoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxDecompressedLength))
+	})
+	return decoder
+}
+
+// rawConfigFields returns the raw_config and raw_config_zstd fields of a message, if it has both
+func rawConfigFields(m protoreflect.Message) (raw, compressed protoreflect.FieldDescriptor, ok bool) {
+	fields := m.Descriptor().Fields()
+	raw = fields.ByName(rawConfigField)
+	compressed = fields.ByName(rawConfigZstdField)
+	if raw == nil || compressed == nil || raw.Kind() != protoreflect.StringKind || compressed.Kind() != protoreflect.BytesKind {
+		return nil, nil, false
+	}
+	return raw, compressed, true
+}
+
+// Compress moves raw_config into raw_config_zstd for every resource in the repeated
+// fields of msg. Resources are modified in place.
+func Compress(msg proto.Message) {
+	forEachResource(msg.ProtoReflect(), func(resource protoreflect.Message, raw, compressed protoreflect.FieldDescriptor) protoreflect.Message {
+		rawConfig := resource.Get(raw).String()
+		if rawConfig == "" {
+			return resource
+		}
+		resource.Set(compressed, protoreflect.ValueOfBytes(getEncoder().EncodeAll([]byte(rawConfig), nil)))
+		resource.Clear(raw)
+		return resource
+	})
+}
+
+// Decompress restores raw_config for every resource in the repeated fields of msg that
+// carries raw_config_zstd. Compressed resources are cloned before being restored and the
+// repeated fields of msg are replaced, so resources shared with other messages are not modified.
+func Decompress(msg proto.Message) error {
+	var firstErr error
+	forEachResource(msg.ProtoReflect(), func(resource protoreflect.Message, raw, compressed protoreflect.FieldDescriptor) protoreflect.Message {
+		data := resource.Get(compressed).Bytes()
+		if len(data) == 0 {
+			return resource
+		}
+		decoded, err := getDecoder().DecodeAll(data, nil)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to decompress %s: %w", resource.Descriptor().Name(), err)
+			}
+			return resource
+		}
+		restored := proto.Clone(resource.Interface()).ProtoReflect()
+		restored.Set(raw, protoreflect.ValueOfString(string(decoded)))
+		restored.Clear(compressed)
+		return restored
+	})
+	return firstErr
+}
+
+// Get returns the raw_config of a single resource, decompressing raw_config_zstd if it is set.
+// The resource itself is not modified.
+func Get(resource proto.Message) (string, error) {
+	m := resource.ProtoReflect()
+	raw, compressed, ok := rawConfigFields(m)
+	if !ok {
+		return "", fmt.Errorf("%s has no raw config", m.Descriptor().Name())
+	}
+
+	data := m.Get(compressed).Bytes()
+	if len(data) == 0 {
+		return m.Get(raw).String(), nil
+	}
+	decoded, err := getDecoder().DecodeAll(data, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress %s: %w", m.Descriptor().Name(), err)
+	}
+	return string(decoded), nil
+}
+
+// Decode unmarshals the raw_config JSON of a single resource into v, decompressing raw_config_zstd if it is set
+func Decode(resource proto.Message, v interface{}) error {
+	raw, err := Get(resource)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(raw), v)
+}
+
+// forEachResource calls fn for each element of msg's repeated message fields that has
+// raw_config fields, replacing each list with one containing the returned elements
+func forEachResource(m protoreflect.Message, fn func(resource protoreflect.Message, raw, compressed protoreflect.FieldDescriptor) protoreflect.Message) {
+	var listFields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsList() && fd.Kind() == protoreflect.MessageKind {
+			listFields = append(listFields, fd)
+		}
+		return true
+	})
+
+	for _, fd := range listFields {
+		list := m.Get(fd).List()
+		raw, compressed, ok := rawConfigFields(list.Get(0).Message())
+		if !ok {
+			continue
+		}
+
+		replaced := m.NewField(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			replaced.Append(protoreflect.ValueOfMessage(fn(list.Get(i).Message(), raw, compressed)))
+		}
+		m.Set(fd, protoreflect.ValueOfList(replaced))
+	}
+}
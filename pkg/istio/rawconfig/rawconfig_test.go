@@ -0,0 +1,164 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rawconfig
+
+import (
+	"strings"
+	"testing"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	frontendv1alpha1 "github.com/liamawhite/navigator/pkg/api/frontend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sampleRawConfig = `{"apiVersion":"networking.istio.io/v1beta1","kind":"VirtualService","spec":{"hosts":["` + strings.Repeat("reviews.default.svc.cluster.local,", 50) + `"]}}`
+
+func TestCompressDecompress_RoundTrip(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services:        []*backendv1alpha1.Service{{Name: "reviews"}},
+		VirtualServices: []*typesv1alpha1.VirtualService{{Name: "reviews", RawConfig: sampleRawConfig}},
+		Gateways:        []*typesv1alpha1.Gateway{{Name: "gw", RawConfig: sampleRawConfig}, {Name: "empty"}},
+	}
+
+	Compress(state)
+
+	vs := state.VirtualServices[0]
+	assert.Empty(t, vs.RawConfig)
+	assert.NotEmpty(t, vs.RawConfigZstd)
+	assert.Less(t, len(vs.RawConfigZstd), len(sampleRawConfig), "Expected compressed config to be smaller")
+	assert.Empty(t, state.Gateways[1].RawConfigZstd, "Expected empty raw config to stay empty")
+	assert.Equal(t, "reviews", state.Services[0].Name, "Expected resources without raw config to be untouched")
+
+	response := &frontendv1alpha1.GetIstioResourcesResponse{
+		VirtualServices: state.VirtualServices,
+		Gateways:        state.Gateways,
+	}
+	require.NoError(t, Decompress(response))
+
+	assert.Equal(t, sampleRawConfig, response.VirtualServices[0].RawConfig)
+	assert.Empty(t, response.VirtualServices[0].RawConfigZstd)
+	assert.Equal(t, sampleRawConfig, response.Gateways[0].RawConfig)
+	assert.Equal(t, "empty", response.Gateways[1].Name)
+
+	// The shared cluster state keeps its compressed form
+	assert.Empty(t, state.VirtualServices[0].RawConfig)
+	assert.NotEmpty(t, state.VirtualServices[0].RawConfigZstd)
+}
+
+func TestDecompress_Uncompressed(t *testing.T) {
+	response := &frontendv1alpha1.GetIstioResourcesResponse{
+		Sidecars: []*typesv1alpha1.Sidecar{{Name: "default", RawConfig: sampleRawConfig}},
+	}
+
+	require.NoError(t, Decompress(response))
+	assert.Equal(t, sampleRawConfig, response.Sidecars[0].RawConfig)
+}
+
+func TestDecompress_Corrupt(t *testing.T) {
+	response := &frontendv1alpha1.GetIstioResourcesResponse{
+		Sidecars: []*typesv1alpha1.Sidecar{{Name: "default", RawConfigZstd: []byte("not zstd")}},
+	}
+
+	assert.Error(t, Decompress(response))
+}
+
+func TestGet(t *testing.T) {
+	compressed := &typesv1alpha1.DestinationRule{Name: "reviews", RawConfig: sampleRawConfig}
+	Compress(&backendv1alpha1.ClusterState{DestinationRules: []*typesv1alpha1.DestinationRule{compressed}})
+	require.NotEmpty(t, compressed.RawConfigZstd)
+
+	rawConfig, err := Get(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, sampleRawConfig, rawConfig)
+	assert.Empty(t, compressed.RawConfig, "Expected resource to stay compressed")
+
+	rawConfig, err = Get(&typesv1alpha1.Sidecar{Name: "default", RawConfig: sampleRawConfig})
+	require.NoError(t, err)
+	assert.Equal(t, sampleRawConfig, rawConfig)
+
+	_, err = Get(&typesv1alpha1.Sidecar{Name: "default", RawConfigZstd: []byte("not zstd")})
+	assert.Error(t, err)
+
+	_, err = Get(&backendv1alpha1.Service{Name: "reviews"})
+	assert.Error(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	compressed := &typesv1alpha1.VirtualService{Name: "reviews", RawConfig: sampleRawConfig}
+	Compress(&backendv1alpha1.ClusterState{VirtualServices: []*typesv1alpha1.VirtualService{compressed}})
+	require.NotEmpty(t, compressed.RawConfigZstd)
+
+	var resource struct {
+		Kind string `json:"kind"`
+	}
+	require.NoError(t, Decode(compressed, &resource))
+	assert.Equal(t, "VirtualService", resource.Kind)
+
+	assert.Error(t, Decode(&typesv1alpha1.Sidecar{Name: "default", RawConfig: "not json"}, &resource))
+	assert.Error(t, Decode(&backendv1alpha1.Service{Name: "reviews"}, &resource))
+}
+
+func TestClean(t *testing.T) {
+	raw := `{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind": "VirtualService",
+		"metadata": {
+			"name": "reviews",
+			"namespace": "default",
+			"resourceVersion": "12345",
+			"uid": "abc",
+			"generation": 2,
+			"creationTimestamp": "2025-01-01T00:00:00Z",
+			"managedFields": [{"manager": "kubectl"}],
+			"labels": {"app": "reviews"},
+			"annotations": {"kubectl.kubernetes.io/last-applied-configuration": "{}"}
+		},
+		"spec": {"hosts": ["reviews"]},
+		"status": {"observedGeneration": 2}
+	}`
+
+	resource, err := Clean(raw)
+	require.NoError(t, err)
+
+	assert.NotContains(t, resource, "status")
+	metadata := resource["metadata"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"name":      "reviews",
+		"namespace": "default",
+		"labels":    map[string]interface{}{"app": "reviews"},
+	}, metadata)
+	assert.Equal(t, map[string]interface{}{"hosts": []interface{}{"reviews"}}, resource["spec"])
+
+	_, err = Clean("not json")
+	assert.Error(t, err)
+}
+
+func TestYAML(t *testing.T) {
+	raw := `{"apiVersion":"networking.istio.io/v1beta1","kind":"VirtualService","metadata":{"name":"reviews","namespace":"default","managedFields":[{}]},"spec":{"hosts":["reviews"]},"status":{}}`
+
+	out, err := YAML(raw)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  hosts:
+    - reviews
+`, out)
+}
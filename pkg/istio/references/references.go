@@ -0,0 +1,295 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package references builds the graph of references between the Istio resources, services and
+// workloads of a single cluster.
+//
+// Edges point from the referring resource to the referenced one:
+//   - VirtualService -> Gateway for each gateway the VirtualService binds
+//   - VirtualService/DestinationRule -> Service for each service host they apply to
+//   - VirtualService/ServiceEntry -> Service for each ExternalName service whose external hostname they declare
+//   - Service -> Pod for each instance of the service
+//   - Gateway/policy -> Pod for each workload the resource's selector applies to
+package references
+
+import (
+	"sort"
+	"strings"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/liamawhite/navigator/pkg/istio/filters"
+)
+
+// Kinds of the resources in the graph
+const (
+	KindAuthorizationPolicy   = "AuthorizationPolicy"
+	KindDestinationRule       = "DestinationRule"
+	KindEnvoyFilter           = "EnvoyFilter"
+	KindGateway               = "Gateway"
+	KindPeerAuthentication    = "PeerAuthentication"
+	KindRequestAuthentication = "RequestAuthentication"
+	KindServiceEntry          = "ServiceEntry"
+	KindSidecar               = "Sidecar"
+	KindVirtualService        = "VirtualService"
+	KindWasmPlugin            = "WasmPlugin"
+	KindService               = "Service"
+	KindPod                   = "Pod"
+)
+
+// MeshGateway is the reserved gateway name for sidecars in the mesh
+const MeshGateway = "mesh"
+
+// DefaultRootNamespace is the Istio root namespace used when the control plane does not report one
+const DefaultRootNamespace = "istio-system"
+
+// key identifies a resource within a cluster
+type key struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// workload is a pod backing one or more services
+type workload struct {
+	namespace string
+	instance  *backendv1alpha1.ServiceInstance
+}
+
+// Graph is the reference graph of a single cluster
+type Graph struct {
+	clusterID string
+	resources map[key]*typesv1alpha1.ResourceRef
+	uses      map[key][]*typesv1alpha1.ResourceReference
+	usedBy    map[key][]*typesv1alpha1.ResourceReference
+}
+
+// Build constructs the reference graph of a cluster from its state
+func Build(clusterID string, state *backendv1alpha1.ClusterState) *Graph {
+	g := &Graph{
+		clusterID: clusterID,
+		resources: make(map[key]*typesv1alpha1.ResourceRef),
+		uses:      make(map[key][]*typesv1alpha1.ResourceReference),
+		usedBy:    make(map[key][]*typesv1alpha1.ResourceReference),
+	}
+	if state == nil {
+		return g
+	}
+
+	scopeToNamespace := false
+	rootNamespace := DefaultRootNamespace
+	if config := state.IstioControlPlaneConfig; config != nil {
+		scopeToNamespace = config.PilotScopeGatewayToNamespace
+		if config.RootNamespace != "" {
+			rootNamespace = config.RootNamespace
+		}
+	}
+
+	addResources(g, KindAuthorizationPolicy, state.AuthorizationPolicies)
+	addResources(g, KindDestinationRule, state.DestinationRules)
+	addResources(g, KindEnvoyFilter, state.EnvoyFilters)
+	addResources(g, KindGateway, state.Gateways)
+	addResources(g, KindPeerAuthentication, state.PeerAuthentications)
+	addResources(g, KindRequestAuthentication, state.RequestAuthentications)
+	addResources(g, KindServiceEntry, state.ServiceEntries)
+	addResources(g, KindSidecar, state.Sidecars)
+	addResources(g, KindVirtualService, state.VirtualServices)
+	addResources(g, KindWasmPlugin, state.WasmPlugins)
+	addResources(g, KindService, state.Services)
+
+	// Services route to their instances; a pod backing several services is a single workload
+	var workloads []workload
+	for _, service := range state.Services {
+		for _, instance := range service.Instances {
+			pod := key{KindPod, service.Namespace, instance.PodName}
+			if _, exists := g.resources[pod]; !exists {
+				g.add(pod)
+				workloads = append(workloads, workload{namespace: service.Namespace, instance: instance})
+			}
+			g.link(key{KindService, service.Namespace, service.Name}, pod, typesv1alpha1.ReferenceType_REFERENCE_TYPE_ENDPOINT)
+		}
+	}
+
+	for _, vs := range state.VirtualServices {
+		from := key{KindVirtualService, vs.Namespace, vs.Name}
+		for _, gateway := range vs.Gateways {
+			if gateway == MeshGateway {
+				continue
+			}
+			to := key{KindGateway, vs.Namespace, gateway}
+			if namespace, name, found := strings.Cut(gateway, "/"); found {
+				to = key{KindGateway, namespace, name}
+			}
+			g.link(from, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_GATEWAY)
+		}
+	}
+
+	for _, service := range state.Services {
+		to := key{KindService, service.Namespace, service.Name}
+		for _, vs := range filters.FilterVirtualServicesForHost(state.VirtualServices, service.Name, service.Namespace) {
+			g.link(key{KindVirtualService, vs.Namespace, vs.Name}, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST)
+		}
+		for _, dr := range filters.FilterDestinationRulesForHost(state.DestinationRules, service.Name, service.Namespace) {
+			g.link(key{KindDestinationRule, dr.Namespace, dr.Name}, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST)
+		}
+
+		// ExternalName services are a DNS alias, so traffic to them is configured by the resources for their target
+		if service.ExternalName == "" {
+			continue
+		}
+		for _, vs := range filters.FilterVirtualServicesForExternalName(state.VirtualServices, service.ExternalName) {
+			g.link(key{KindVirtualService, vs.Namespace, vs.Name}, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST)
+		}
+		for _, se := range filters.FilterServiceEntriesForExternalName(state.ServiceEntries, service.ExternalName) {
+			g.link(key{KindServiceEntry, se.Namespace, se.Name}, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST)
+		}
+	}
+
+	// Only the resources the selector index returns for a workload can select it
+	index := filters.NewSelectorIndex(state)
+	for _, w := range workloads {
+		to := key{KindPod, w.namespace, w.instance.PodName}
+		candidates := index.Candidates(w.instance, w.namespace, rootNamespace)
+		selects := func(kind string, resources []NamedResource) {
+			for _, resource := range resources {
+				g.link(key{kind, resource.GetNamespace(), resource.GetName()}, to, typesv1alpha1.ReferenceType_REFERENCE_TYPE_WORKLOAD_SELECTOR)
+			}
+		}
+
+		// Gateway resources only configure gateway proxies
+		if w.instance.ProxyMode == typesv1alpha1.ProxyMode_ROUTER {
+			selects(KindGateway, asNamed(filters.FilterGatewaysForWorkload(candidates.Gateways, w.instance, w.namespace, scopeToNamespace)))
+		}
+		selects(KindSidecar, asNamed(filters.FilterSidecarsForWorkload(candidates.Sidecars, w.instance, w.namespace)))
+		selects(KindEnvoyFilter, asNamed(filters.FilterEnvoyFiltersForWorkload(candidates.EnvoyFilters, w.instance, w.namespace, rootNamespace)))
+		selects(KindRequestAuthentication, asNamed(filters.FilterRequestAuthenticationsForWorkload(candidates.RequestAuthentications, w.instance, w.namespace, rootNamespace)))
+		selects(KindPeerAuthentication, asNamed(filters.FilterPeerAuthenticationsForWorkload(candidates.PeerAuthentications, w.instance, w.namespace, rootNamespace)))
+		selects(KindAuthorizationPolicy, asNamed(filters.FilterAuthorizationPoliciesForWorkload(candidates.AuthorizationPolicies, w.instance, w.namespace, rootNamespace)))
+		selects(KindWasmPlugin, asNamed(filters.FilterWasmPluginsForWorkload(candidates.WasmPlugins, w.instance, w.namespace, rootNamespace)))
+	}
+
+	return g
+}
+
+// Resource returns the reference to a resource in the graph, if it exists
+func (g *Graph) Resource(kind, namespace, name string) (*typesv1alpha1.ResourceRef, bool) {
+	ref, ok := g.resources[key{kind, namespace, name}]
+	return ref, ok
+}
+
+// Uses returns the references from a resource to other resources, ordered by type and target
+func (g *Graph) Uses(kind, namespace, name string) []*typesv1alpha1.ResourceReference {
+	return g.uses[key{kind, namespace, name}]
+}
+
+// UsedBy returns the references from other resources to a resource, ordered by type and source
+func (g *Graph) UsedBy(kind, namespace, name string) []*typesv1alpha1.ResourceReference {
+	return g.usedBy[key{kind, namespace, name}]
+}
+
+// NamedResource is an Istio resource identified by its namespace and name, implemented by every resource
+// message added to the graph
+type NamedResource interface {
+	GetName() string
+	GetNamespace() string
+}
+
+// AppendUnique appends the resources in src that are not already in dst, identified by namespace and name
+func AppendUnique[T NamedResource](dst, src []T) []T {
+	type name struct{ namespace, name string }
+	seen := make(map[name]bool, len(dst)+len(src))
+	for _, existing := range dst {
+		seen[name{existing.GetNamespace(), existing.GetName()}] = true
+	}
+	for _, candidate := range src {
+		key := name{candidate.GetNamespace(), candidate.GetName()}
+		if !seen[key] {
+			seen[key] = true
+			dst = append(dst, candidate)
+		}
+	}
+	return dst
+}
+
+// asNamed converts a slice of resources to named resources
+func asNamed[T NamedResource](resources []T) []NamedResource {
+	named := make([]NamedResource, len(resources))
+	for i, resource := range resources {
+		named[i] = resource
+	}
+	return named
+}
+
+// addResources adds resources of a kind to the graph
+func addResources[T NamedResource](g *Graph, kind string, resources []T) {
+	for _, resource := range resources {
+		g.add(key{kind, resource.GetNamespace(), resource.GetName()})
+	}
+}
+
+// add adds a resource to the graph
+func (g *Graph) add(k key) {
+	g.resources[k] = &typesv1alpha1.ResourceRef{
+		ClusterId: g.clusterID,
+		Kind:      k.kind,
+		Namespace: k.namespace,
+		Name:      k.name,
+	}
+}
+
+// link adds a reference between two resources. References to resources missing from the
+// cluster are dropped, as are duplicates.
+func (g *Graph) link(from, to key, referenceType typesv1alpha1.ReferenceType) {
+	fromRef, ok := g.resources[from]
+	if !ok {
+		return
+	}
+	toRef, ok := g.resources[to]
+	if !ok {
+		return
+	}
+
+	for _, existing := range g.uses[from] {
+		if existing.To == toRef && existing.Type == referenceType {
+			return
+		}
+	}
+
+	reference := &typesv1alpha1.ResourceReference{From: fromRef, To: toRef, Type: referenceType}
+	g.uses[from] = insertSorted(g.uses[from], reference, func(r *typesv1alpha1.ResourceReference) *typesv1alpha1.ResourceRef { return r.To })
+	g.usedBy[to] = insertSorted(g.usedBy[to], reference, func(r *typesv1alpha1.ResourceReference) *typesv1alpha1.ResourceRef { return r.From })
+}
+
+// insertSorted inserts a reference, keeping references ordered by type and then by the other resource
+func insertSorted(references []*typesv1alpha1.ResourceReference, reference *typesv1alpha1.ResourceReference, other func(*typesv1alpha1.ResourceReference) *typesv1alpha1.ResourceRef) []*typesv1alpha1.ResourceReference {
+	less := func(a, b *typesv1alpha1.ResourceReference) bool {
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		ra, rb := other(a), other(b)
+		if ra.Kind != rb.Kind {
+			return ra.Kind < rb.Kind
+		}
+		if ra.Namespace != rb.Namespace {
+			return ra.Namespace < rb.Namespace
+		}
+		return ra.Name < rb.Name
+	}
+
+	i := sort.Search(len(references), func(i int) bool { return less(reference, references[i]) })
+	references = append(references, nil)
+	copy(references[i+1:], references[i:])
+	references[i] = reference
+	return references
+}
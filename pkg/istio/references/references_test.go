@@ -0,0 +1,161 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package references
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+func testClusterState() *backendv1alpha1.ClusterState {
+	return &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{
+				Name:      "reviews",
+				Namespace: "default",
+				Instances: []*backendv1alpha1.ServiceInstance{
+					{PodName: "reviews-v1", Labels: map[string]string{"app": "reviews", "version": "v1"}, ProxyMode: typesv1alpha1.ProxyMode_SIDECAR},
+					{PodName: "reviews-v2", Labels: map[string]string{"app": "reviews", "version": "v2"}, ProxyMode: typesv1alpha1.ProxyMode_SIDECAR},
+				},
+			},
+			{
+				Name:      "istio-ingressgateway",
+				Namespace: "istio-system",
+				Instances: []*backendv1alpha1.ServiceInstance{
+					{PodName: "ingress", Labels: map[string]string{"istio": "ingressgateway"}, ProxyMode: typesv1alpha1.ProxyMode_ROUTER},
+				},
+			},
+		},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "reviews", Namespace: "default", Hosts: []string{"reviews"}, Gateways: []string{"mesh", "istio-system/public", "missing"}},
+		},
+		DestinationRules: []*typesv1alpha1.DestinationRule{
+			{Name: "reviews", Namespace: "default", Host: "reviews.default.svc.cluster.local"},
+		},
+		Gateways: []*typesv1alpha1.Gateway{
+			{Name: "public", Namespace: "istio-system", Selector: map[string]string{"istio": "ingressgateway"}},
+		},
+		AuthorizationPolicies: []*typesv1alpha1.AuthorizationPolicy{
+			{Name: "reviews-v2", Namespace: "default", Selector: &typesv1alpha1.WorkloadSelector{MatchLabels: map[string]string{"version": "v2"}}},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	graph := Build("cluster-1", testClusterState())
+
+	t.Run("virtual service uses gateway and service", func(t *testing.T) {
+		uses := graph.Uses(KindVirtualService, "default", "reviews")
+		require.Len(t, uses, 2)
+		assert.Equal(t, typesv1alpha1.ReferenceType_REFERENCE_TYPE_GATEWAY, uses[0].Type)
+		assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: KindGateway, Namespace: "istio-system", Name: "public"}, uses[0].To)
+		assert.Equal(t, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST, uses[1].Type)
+		assert.Equal(t, KindService, uses[1].To.Kind)
+		assert.Equal(t, "reviews", uses[1].To.Name)
+	})
+
+	t.Run("service is used by virtual service and destination rule", func(t *testing.T) {
+		usedBy := graph.UsedBy(KindService, "default", "reviews")
+		require.Len(t, usedBy, 2)
+		assert.Equal(t, KindDestinationRule, usedBy[0].From.Kind)
+		assert.Equal(t, KindVirtualService, usedBy[1].From.Kind)
+
+		uses := graph.Uses(KindService, "default", "reviews")
+		require.Len(t, uses, 2)
+		assert.Equal(t, typesv1alpha1.ReferenceType_REFERENCE_TYPE_ENDPOINT, uses[0].Type)
+		assert.Equal(t, "reviews-v1", uses[0].To.Name)
+		assert.Equal(t, "reviews-v2", uses[1].To.Name)
+	})
+
+	t.Run("gateway selects gateway workloads only", func(t *testing.T) {
+		uses := graph.Uses(KindGateway, "istio-system", "public")
+		require.Len(t, uses, 1)
+		assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: KindPod, Namespace: "istio-system", Name: "ingress"}, uses[0].To)
+
+		usedBy := graph.UsedBy(KindGateway, "istio-system", "public")
+		require.Len(t, usedBy, 1)
+		assert.Equal(t, KindVirtualService, usedBy[0].From.Kind)
+	})
+
+	t.Run("policy selects matching workloads", func(t *testing.T) {
+		uses := graph.Uses(KindAuthorizationPolicy, "default", "reviews-v2")
+		require.Len(t, uses, 1)
+		assert.Equal(t, "reviews-v2", uses[0].To.Name)
+
+		usedBy := graph.UsedBy(KindPod, "default", "reviews-v2")
+		require.Len(t, usedBy, 2)
+		assert.Equal(t, KindAuthorizationPolicy, usedBy[0].From.Kind)
+		assert.Equal(t, KindService, usedBy[1].From.Kind)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		_, ok := graph.Resource(KindDestinationRule, "default", "reviews")
+		assert.True(t, ok)
+		_, ok = graph.Resource(KindGateway, "default", "missing")
+		assert.False(t, ok)
+		assert.Empty(t, graph.Uses(KindGateway, "default", "missing"))
+	})
+}
+
+func TestBuild_ExternalNameService(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{
+			{Name: "payments", Namespace: "default", ServiceType: typesv1alpha1.ServiceType_EXTERNAL_NAME, ExternalName: "api.payments.example.com"},
+		},
+		ServiceEntries: []*typesv1alpha1.ServiceEntry{
+			{Name: "payments", Namespace: "egress", Hosts: []string{"api.payments.example.com"}},
+			{Name: "other", Namespace: "egress", Hosts: []string{"api.other.example.com"}},
+		},
+		VirtualServices: []*typesv1alpha1.VirtualService{
+			{Name: "payments-timeouts", Namespace: "egress", Hosts: []string{"api.payments.example.com"}},
+		},
+	}
+	graph := Build("cluster-1", state)
+
+	usedBy := graph.UsedBy(KindService, "default", "payments")
+	require.Len(t, usedBy, 2)
+	assert.Equal(t, &typesv1alpha1.ResourceRef{ClusterId: "cluster-1", Kind: KindServiceEntry, Namespace: "egress", Name: "payments"}, usedBy[0].From)
+	assert.Equal(t, typesv1alpha1.ReferenceType_REFERENCE_TYPE_HOST, usedBy[0].Type)
+	assert.Equal(t, KindVirtualService, usedBy[1].From.Kind)
+	assert.Empty(t, graph.Uses(KindServiceEntry, "egress", "other"))
+}
+
+func TestBuild_NilState(t *testing.T) {
+	graph := Build("cluster-1", nil)
+	_, ok := graph.Resource(KindService, "default", "reviews")
+	assert.False(t, ok)
+}
+
+func TestAppendUnique(t *testing.T) {
+	dst := []*typesv1alpha1.Sidecar{{Name: "default", Namespace: "bookinfo"}}
+	src := []*typesv1alpha1.Sidecar{
+		{Name: "default", Namespace: "bookinfo"},
+		{Name: "default", Namespace: "istio-system"},
+		{Name: "default", Namespace: "istio-system"},
+		{Name: "egress", Namespace: "bookinfo"},
+	}
+
+	// Resources already in dst, or earlier in src, are skipped and order is kept
+	merged := AppendUnique(dst, src)
+	require.Len(t, merged, 3)
+	assert.Same(t, dst[0], merged[0])
+	assert.Equal(t, "istio-system", merged[1].Namespace)
+	assert.Equal(t, "egress", merged[2].Name)
+}
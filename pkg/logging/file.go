@@ -0,0 +1,180 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// DefaultFileMaxSize is the size in megabytes a log file grows to before it is rotated by default
+const DefaultFileMaxSize = 100
+
+// FileConfig configures a log file and how it is rotated
+type FileConfig struct {
+	Path             string
+	MaxSize          int           // Megabytes before the file is rotated, defaults to DefaultFileMaxSize
+	MaxBackups       int           // Rotated files to keep, 0 keeps them all
+	MaxAge           int           // Days to keep rotated files, 0 keeps them regardless of age
+	RotationInterval time.Duration // How often the file is rotated regardless of its size, 0 to rotate by size only
+	Compress         bool          // Whether rotated files are gzipped
+}
+
+// FileSink is a log file that is rotated once it reaches its maximum size, and at every rotation
+// interval. Rotated files are renamed with the time of their rotation, e.g. manager-2025-01-02T15-04-05.000.log.
+type FileSink struct {
+	file *lumberjack.Logger
+
+	mu      sync.Mutex
+	written bool // Whether anything was written since the last rotation
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewFileSink opens a rotating log file, creating its directory if needed
+func NewFileSink(config FileConfig) *FileSink {
+	maxSize := config.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultFileMaxSize
+	}
+	sink := &FileSink{
+		file: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    maxSize,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAge,
+			LocalTime:  true,
+			Compress:   config.Compress,
+		},
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	if config.RotationInterval > 0 {
+		go sink.rotateEvery(config.RotationInterval)
+	} else {
+		close(sink.done)
+	}
+	return sink
+}
+
+// Write appends a log record to the file, rotating it first if it would exceed its maximum size
+func (s *FileSink) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.written = true
+	return s.file.Write(p)
+}
+
+// Close stops the rotation of the file and closes it
+func (s *FileSink) Close() error {
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	<-s.done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// rotateEvery rotates the file at every interval until the sink is closed. Files nothing was written to
+// are not rotated, so idle components do not leave empty files behind.
+func (s *FileSink) rotateEvery(interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			if s.written {
+				if err := s.file.Rotate(); err != nil {
+					For(ComponentCLI).Warn("failed to rotate log file", "path", s.file.Filename, "error", err)
+				}
+				s.written = false
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// WithWriter returns a logger writing its records to w, in the given format, as well as to the handler of
+// logger. Records are written to w at the level shared by all loggers created with NewLogger. Attributes
+// added to logger before are not written to w, so add them to the returned logger.
+func WithWriter(logger *slog.Logger, w io.Writer, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		handler = slog.NewTextHandler(w, opts)
+	}
+	return slog.New(teeHandler{logger.Handler(), handler})
+}
+
+// teeHandler passes records to each of its handlers that is enabled for them
+type teeHandler []slog.Handler
+
+func (t teeHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	for _, handler := range t {
+		if handler.Enabled(ctx, l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t teeHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, handler := range t {
+		if handler.Enabled(ctx, record.Level) {
+			errs = append(errs, handler.Handle(ctx, record.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(teeHandler, len(t))
+	for i, handler := range t {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (t teeHandler) WithGroup(name string) slog.Handler {
+	handlers := make(teeHandler, len(t))
+	for i, handler := range t {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return handlers
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logFiles returns the names of the files in a log directory
+func logFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return names
+}
+
+func TestFileSink_RotationInterval(t *testing.T) {
+	dir := t.TempDir()
+	interval := 20 * time.Millisecond
+	sink := NewFileSink(FileConfig{Path: filepath.Join(dir, "manager.log"), RotationInterval: interval})
+	defer func() { _ = sink.Close() }()
+
+	_, err := sink.Write([]byte("first\n"))
+	require.NoError(t, err)
+
+	// The written file is rotated at the next interval
+	require.Eventually(t, func() bool { return len(logFiles(t, dir)) == 2 }, 5*time.Second, interval/2)
+
+	current, err := os.ReadFile(filepath.Join(dir, "manager.log"))
+	require.NoError(t, err)
+	assert.Empty(t, current, "the rotated records are moved to a backup")
+
+	// Nothing was written since, so later intervals leave the idle file alone
+	time.Sleep(5 * interval)
+	assert.Len(t, logFiles(t, dir), 2)
+}
+
+func TestFileSink_IdleFileNotRotated(t *testing.T) {
+	dir := t.TempDir()
+	interval := 10 * time.Millisecond
+	sink := NewFileSink(FileConfig{Path: filepath.Join(dir, "manager.log"), RotationInterval: interval})
+
+	time.Sleep(5 * interval)
+	require.NoError(t, sink.Close())
+	assert.Empty(t, logFiles(t, dir), "no empty files are left behind")
+}
+
+func TestFileSink_Close(t *testing.T) {
+	for name, interval := range map[string]time.Duration{"size rotation": 0, "interval rotation": time.Hour} {
+		t.Run(name, func(t *testing.T) {
+			sink := NewFileSink(FileConfig{Path: filepath.Join(t.TempDir(), "manager.log"), RotationInterval: interval})
+			_, err := sink.Write([]byte("record\n"))
+			require.NoError(t, err)
+
+			require.NoError(t, sink.Close())
+			assert.NoError(t, sink.Close(), "closing again does nothing")
+		})
+	}
+}
+
+func TestTeeHandler(t *testing.T) {
+	var info, warn bytes.Buffer
+	logger := slog.New(teeHandler{
+		slog.NewTextHandler(&info, &slog.HandlerOptions{Level: slog.LevelInfo}),
+		slog.NewTextHandler(&warn, &slog.HandlerOptions{Level: slog.LevelWarn}),
+	}).With("component", "manager")
+
+	assert.False(t, logger.Enabled(context.Background(), slog.LevelDebug), "no handler is enabled for debug")
+
+	logger.Info("connected")
+	logger.WithGroup("edge").Warn("disconnected", "cluster", "east")
+
+	assert.Contains(t, info.String(), "msg=connected component=manager")
+	assert.Contains(t, info.String(), "msg=disconnected component=manager edge.cluster=east")
+	assert.NotContains(t, warn.String(), "msg=connected", "each handler keeps its own level")
+	assert.Contains(t, warn.String(), "msg=disconnected component=manager edge.cluster=east")
+}
+
+func TestWithWriter(t *testing.T) {
+	var stdout, file bytes.Buffer
+	logger := WithWriter(slog.New(slog.NewTextHandler(&stdout, nil)), &file, "json")
+
+	logger.Info("started", "port", 8080)
+
+	assert.Contains(t, stdout.String(), "msg=started port=8080")
+	assert.Contains(t, file.String(), `"msg":"started","port":8080`)
+}
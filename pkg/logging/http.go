@@ -18,6 +18,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel/propagation"
 )
 
 // HTTPMiddleware returns an HTTP middleware that logs requests and responses
@@ -26,7 +28,11 @@ func HTTPMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
-			requestID := GenerateRequestID()
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = GenerateRequestID()
+				r.Header.Set(RequestIDHeader, requestID)
+			}
 
 			// Create request-scoped logger
 			requestLogger := For(ComponentHTTP).With(
@@ -36,9 +42,10 @@ func HTTPMiddleware() func(http.Handler) http.Handler {
 				"user_agent", r.UserAgent(),
 				"remote_addr", r.RemoteAddr,
 			)
+			requestLogger = requestLogger.With(traceAttrs(propagation.HeaderCarrier(r.Header))...)
 
 			// Add request ID to response headers for client debugging
-			w.Header().Set("X-Request-ID", requestID)
+			w.Header().Set(RequestIDHeader, requestID)
 
 			// Create a response writer wrapper to capture status code
 			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -46,7 +53,7 @@ func HTTPMiddleware() func(http.Handler) http.Handler {
 			requestLogger.Debug("http request started")
 
 			// Process the request
-			next.ServeHTTP(wrapped, r)
+			next.ServeHTTP(wrapped, r.WithContext(WithRequestID(r.Context(), requestID)))
 
 			// Calculate duration
 			duration := time.Since(start)
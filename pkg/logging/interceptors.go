@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
@@ -27,7 +28,10 @@ import (
 func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		start := time.Now()
-		requestID := GenerateRequestID()
+		requestID := RequestIDFromIncomingContext(ctx)
+		if requestID == "" {
+			requestID = GenerateRequestID()
+		}
 
 		// Create request-scoped logger
 		requestLogger := For(ComponentGRPC).With(
@@ -35,6 +39,11 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			"method", info.FullMethod,
 		)
 
+		// Add the trace the request was made in, if any
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			requestLogger = requestLogger.With(traceAttrs(metadataCarrier(md))...)
+		}
+
 		// Add client information if available
 		if p, ok := peer.FromContext(ctx); ok {
 			requestLogger = requestLogger.With("client_addr", p.Addr.String())
@@ -74,7 +83,10 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 func StreamServerInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		start := time.Now()
-		requestID := GenerateRequestID()
+		requestID := RequestIDFromIncomingContext(stream.Context())
+		if requestID == "" {
+			requestID = GenerateRequestID()
+		}
 
 		// Create request-scoped logger
 		requestLogger := For(ComponentGRPC).With(
@@ -82,6 +94,11 @@ func StreamServerInterceptor() grpc.StreamServerInterceptor {
 			"method", info.FullMethod,
 		)
 
+		// Add the trace the request was made in, if any
+		if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+			requestLogger = requestLogger.With(traceAttrs(metadataCarrier(md))...)
+		}
+
 		// Add client information if available
 		if p, ok := peer.FromContext(stream.Context()); ok {
 			requestLogger = requestLogger.With("client_addr", p.Addr.String())
@@ -0,0 +1,84 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// LevelPath is the admin path used to inspect and change the log level
+const LevelPath = "/admin/log-level"
+
+// levelResponse is the JSON body returned by the log level handler
+type levelResponse struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler returns an HTTP handler that reports the current log level on GET
+// and changes it on PUT or POST, e.g. PUT /admin/log-level?level=debug
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			requested := r.URL.Query().Get("level")
+			if requested == "" && r.Body != nil {
+				var body levelResponse
+				if err := json.NewDecoder(r.Body).Decode(&body); err == nil {
+					requested = body.Level
+				}
+			}
+
+			newLevel, err := parseLevelStrict(requested)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			previous := GetLevel()
+			SetLevel(newLevel)
+			For(ComponentHTTP).Info("log level changed",
+				"previous_level", levelName(previous),
+				"level", levelName(newLevel),
+				"remote_addr", r.RemoteAddr)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelResponse{Level: levelName(GetLevel())})
+	})
+}
+
+// parseLevelStrict parses a log level, rejecting unknown values instead of defaulting
+func parseLevelStrict(s string) (slog.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug", "info", "warn", "warning", "error":
+		return ParseLevel(s), nil
+	default:
+		return 0, fmt.Errorf("invalid log level %q, must be one of: debug, info, warn, error", s)
+	}
+}
+
+// levelName returns the lowercase name of a log level
+func levelName(l slog.Level) string {
+	return strings.ToLower(l.String())
+}
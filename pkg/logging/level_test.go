@@ -0,0 +1,131 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevelStrict(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    slog.Level
+		wantErr bool
+	}{
+		{input: "debug", want: slog.LevelDebug},
+		{input: "INFO", want: slog.LevelInfo},
+		{input: "warn", want: slog.LevelWarn},
+		{input: "warning", want: slog.LevelWarn},
+		{input: "error", want: slog.LevelError},
+		{input: "", wantErr: true},
+		{input: "verbose", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			level, err := parseLevelStrict(tt.input)
+			if tt.wantErr {
+				assert.EqualError(t, err, `invalid log level "`+tt.input+`", must be one of: debug, info, warn, error`)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, level)
+		})
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	previous := GetLevel()
+	t.Cleanup(func() { SetLevel(previous) })
+	SetLevel(slog.LevelInfo)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+		wantLevel  slog.Level
+	}{
+		{
+			name:       "get reports the current level",
+			method:     http.MethodGet,
+			target:     LevelPath,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"info"}`,
+			wantLevel:  slog.LevelInfo,
+		},
+		{
+			name:       "put with a query parameter",
+			method:     http.MethodPut,
+			target:     LevelPath + "?level=debug",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"debug"}`,
+			wantLevel:  slog.LevelDebug,
+		},
+		{
+			name:       "post with a JSON body",
+			method:     http.MethodPost,
+			target:     LevelPath,
+			body:       `{"level":"warn"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"level":"warn"}`,
+			wantLevel:  slog.LevelWarn,
+		},
+		{
+			name:       "unknown level is rejected",
+			method:     http.MethodPut,
+			target:     LevelPath + "?level=verbose",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `invalid log level "verbose", must be one of: debug, info, warn, error`,
+			wantLevel:  slog.LevelWarn,
+		},
+		{
+			name:       "missing level is rejected",
+			method:     http.MethodPut,
+			target:     LevelPath,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `invalid log level "", must be one of: debug, info, warn, error`,
+			wantLevel:  slog.LevelWarn,
+		},
+		{
+			name:       "other methods are not allowed",
+			method:     http.MethodDelete,
+			target:     LevelPath,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "method not allowed",
+			wantLevel:  slog.LevelWarn,
+		},
+	}
+
+	handler := LevelHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, tt.wantBody, strings.TrimSpace(recorder.Body.String()))
+			assert.Equal(t, tt.wantLevel, GetLevel())
+		})
+	}
+}
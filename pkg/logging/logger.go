@@ -37,6 +37,9 @@ type Config struct {
 	Format string // "json" or "text"
 }
 
+// level is shared by all loggers created with NewLogger so it can be adjusted at runtime
+var level = new(slog.LevelVar)
+
 // DefaultConfig returns a default logging configuration
 func DefaultConfig() *Config {
 	return &Config{
@@ -49,8 +52,9 @@ func DefaultConfig() *Config {
 func NewLogger(config *Config) *slog.Logger {
 	var handler slog.Handler
 
+	level.Set(config.Level)
 	opts := &slog.HandlerOptions{
-		Level: config.Level,
+		Level: level,
 	}
 
 	switch strings.ToLower(config.Format) {
@@ -73,6 +77,16 @@ func ForComponent(component Component, requestID string) *slog.Logger {
 	return slog.Default().With("component", string(component), "request_id", requestID)
 }
 
+// SetLevel changes the log level of all loggers created with NewLogger
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+// GetLevel returns the current log level
+func GetLevel() slog.Level {
+	return level.Level()
+}
+
 // ParseLevel parses a string log level into slog.Level
 func ParseLevel(level string) slog.Level {
 	switch strings.ToLower(level) {
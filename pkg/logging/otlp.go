@@ -0,0 +1,205 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// otlpScope is the instrumentation scope of exported log records
+const otlpScope = "github.com/liamawhite/navigator/pkg/logging"
+
+// OTLPConfig configures the export of log records to an OpenTelemetry collector. Headers, such as
+// credentials, are read from the standard OTEL_EXPORTER_OTLP_HEADERS environment variable.
+type OTLPConfig struct {
+	Endpoint       string // OTLP/HTTP logs endpoint URL, e.g. http://otel-collector:4318/v1/logs
+	ServiceName    string // service.name of the exported records, e.g. navigator-manager
+	ServiceVersion string // service.version of the exported records
+}
+
+// OTLPExporter batches log records and exports them to an OpenTelemetry collector over OTLP/HTTP
+type OTLPExporter struct {
+	provider *sdklog.LoggerProvider
+	logger   otellog.Logger
+}
+
+// NewOTLPExporter creates an exporter of log records to the collector at the configured endpoint
+func NewOTLPExporter(ctx context.Context, config OTLPConfig) (*OTLPExporter, error) {
+	exporter, err := otlploghttp.New(ctx, otlploghttp.WithEndpointURL(config.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP log exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", config.ServiceName),
+		attribute.String("service.version", config.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP log resource: %w", err)
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(res),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+	)
+	return &OTLPExporter{provider: provider, logger: provider.Logger(otlpScope)}, nil
+}
+
+// Shutdown exports the buffered log records and stops the exporter
+func (e *OTLPExporter) Shutdown(ctx context.Context) error {
+	return e.provider.Shutdown(ctx)
+}
+
+// WithOTLP returns a logger exporting its records with an OTLP exporter as well as writing them to the
+// handler of logger. Records are exported at the level shared by all loggers created with NewLogger.
+// Their trace context is taken from the context they are logged with, or from the trace_id and span_id
+// attributes of request-scoped loggers.
+func WithOTLP(logger *slog.Logger, exporter *OTLPExporter) *slog.Logger {
+	return slog.New(teeHandler{logger.Handler(), &otlpHandler{logger: exporter.logger}})
+}
+
+// otlpHandler converts slog records to OpenTelemetry log records. Groups are flattened into dotted
+// attribute keys.
+type otlpHandler struct {
+	logger  otellog.Logger
+	attrs   []otellog.KeyValue
+	prefix  string // Keys of the open groups, each followed by a dot
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func (h *otlpHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= level.Level()
+}
+
+func (h *otlpHandler) Handle(ctx context.Context, record slog.Record) error {
+	var otelRecord otellog.Record
+	otelRecord.SetTimestamp(record.Time)
+	otelRecord.SetObservedTimestamp(time.Now())
+	otelRecord.SetSeverity(otellog.Severity(record.Level + 9)) // slog levels are 9 below OpenTelemetry severities
+	otelRecord.SetSeverityText(record.Level.String())
+	otelRecord.SetBody(otellog.StringValue(record.Message))
+	otelRecord.AddAttributes(h.attrs...)
+
+	traceID, spanID := h.traceID, h.spanID
+	record.Attrs(func(attr slog.Attr) bool {
+		if h.prefix == "" {
+			traceID, spanID = traceAttr(attr, traceID, spanID)
+		}
+		otelRecord.AddAttributes(convertAttrs(h.prefix, []slog.Attr{attr})...)
+		return true
+	})
+
+	// Records logged without a span are correlated with the trace of the request they belong to
+	if !trace.SpanContextFromContext(ctx).IsValid() && traceID.IsValid() && spanID.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+			Remote:  true,
+		}))
+	}
+	h.logger.Emit(ctx, otelRecord)
+	return nil
+}
+
+func (h *otlpHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handler := *h
+	handler.attrs = append(append([]otellog.KeyValue(nil), h.attrs...), convertAttrs(h.prefix, attrs)...)
+	if h.prefix == "" {
+		for _, attr := range attrs {
+			handler.traceID, handler.spanID = traceAttr(attr, handler.traceID, handler.spanID)
+		}
+	}
+	return &handler
+}
+
+func (h *otlpHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	handler := *h
+	handler.prefix = h.prefix + name + "."
+	return &handler
+}
+
+// traceAttr returns the trace and span IDs an attribute sets, or the given IDs if it sets neither
+func traceAttr(attr slog.Attr, traceID trace.TraceID, spanID trace.SpanID) (trace.TraceID, trace.SpanID) {
+	switch attr.Key {
+	case TraceIDKey:
+		if id, err := trace.TraceIDFromHex(attr.Value.String()); err == nil {
+			traceID = id
+		}
+	case SpanIDKey:
+		if id, err := trace.SpanIDFromHex(attr.Value.String()); err == nil {
+			spanID = id
+		}
+	}
+	return traceID, spanID
+}
+
+// convertAttrs converts slog attributes to OpenTelemetry attributes, prefixing their keys
+func convertAttrs(prefix string, attrs []slog.Attr) []otellog.KeyValue {
+	converted := make([]otellog.KeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		value := attr.Value.Resolve()
+		if attr.Equal(slog.Attr{}) {
+			continue
+		}
+		// Attributes of inline groups are added to the enclosing group
+		if value.Kind() == slog.KindGroup && attr.Key == "" {
+			converted = append(converted, convertAttrs(prefix, value.Group())...)
+			continue
+		}
+		converted = append(converted, otellog.KeyValue{Key: prefix + attr.Key, Value: convertValue(value)})
+	}
+	return converted
+}
+
+// convertValue converts a resolved slog value to an OpenTelemetry value
+func convertValue(value slog.Value) otellog.Value {
+	switch value.Kind() {
+	case slog.KindString:
+		return otellog.StringValue(value.String())
+	case slog.KindInt64:
+		return otellog.Int64Value(value.Int64())
+	case slog.KindUint64:
+		if value.Uint64() > math.MaxInt64 {
+			return otellog.StringValue(value.String())
+		}
+		return otellog.Int64Value(int64(value.Uint64()))
+	case slog.KindFloat64:
+		return otellog.Float64Value(value.Float64())
+	case slog.KindBool:
+		return otellog.BoolValue(value.Bool())
+	case slog.KindTime:
+		return otellog.StringValue(value.Time().Format(time.RFC3339Nano))
+	case slog.KindGroup:
+		return otellog.MapValue(convertAttrs("", value.Group())...)
+	default:
+		// Durations, errors and other values are exported as they are printed by the text handler
+		return otellog.StringValue(value.String())
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingExporter keeps the log records exported to it
+type recordingExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *recordingExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, record := range records {
+		e.records = append(e.records, record.Clone())
+	}
+	return nil
+}
+
+func (e *recordingExporter) Shutdown(context.Context) error   { return nil }
+func (e *recordingExporter) ForceFlush(context.Context) error { return nil }
+
+// otlpTestLogger returns a logger exporting its records to a recording exporter, and discarding its output
+func otlpTestLogger(t *testing.T) (*slog.Logger, *recordingExporter) {
+	t.Helper()
+	previous := GetLevel()
+	t.Cleanup(func() { SetLevel(previous) })
+	SetLevel(slog.LevelDebug)
+
+	exporter := &recordingExporter{}
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exporter)))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	logger := WithOTLP(slog.New(slog.NewTextHandler(io.Discard, nil)), &OTLPExporter{provider: provider, logger: provider.Logger(otlpScope)})
+	return logger, exporter
+}
+
+// attributes returns the attributes of an exported record by key
+func attributes(record sdklog.Record) map[string]otellog.Value {
+	attrs := make(map[string]otellog.Value)
+	record.WalkAttributes(func(kv otellog.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	return attrs
+}
+
+func TestOTLPHandler_Severity(t *testing.T) {
+	logger, exporter := otlpTestLogger(t)
+
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Warn("warn")
+	logger.Error("error")
+
+	require.Len(t, exporter.records, 4)
+	for i, want := range []struct {
+		severity otellog.Severity
+		text     string
+	}{
+		{otellog.SeverityDebug, "DEBUG"},
+		{otellog.SeverityInfo, "INFO"},
+		{otellog.SeverityWarn, "WARN"},
+		{otellog.SeverityError, "ERROR"},
+	} {
+		assert.Equal(t, want.severity, exporter.records[i].Severity())
+		assert.Equal(t, want.text, exporter.records[i].SeverityText())
+	}
+	assert.Equal(t, "info", exporter.records[1].Body().AsString())
+}
+
+func TestOTLPHandler_Attributes(t *testing.T) {
+	logger, exporter := otlpTestLogger(t)
+
+	logger.With("component", "manager").WithGroup("edge").With("cluster", "east").Info("synced",
+		"resources", 42,
+		slog.Group("state", "bytes", uint64(1024)),
+		slog.Group("", "inline", true),
+		"overflow", uint64(math.MaxUint64),
+	)
+
+	require.Len(t, exporter.records, 1)
+	attrs := attributes(exporter.records[0])
+	assert.Equal(t, "manager", attrs["component"].AsString())
+	assert.Equal(t, "east", attrs["edge.cluster"].AsString(), "groups are flattened into dotted keys")
+	assert.Equal(t, int64(42), attrs["edge.resources"].AsInt64())
+	assert.Equal(t, otellog.KindMap, attrs["edge.state"].Kind())
+	assert.Equal(t, []otellog.KeyValue{otellog.Int64("bytes", 1024)}, attrs["edge.state"].AsMap())
+	assert.True(t, attrs["edge.inline"].AsBool(), "inline groups are added to the enclosing group")
+	assert.Equal(t, "18446744073709551615", attrs["edge.overflow"].AsString(), "uint64 values beyond int64 are kept as strings")
+}
+
+func TestOTLPHandler_TraceContext(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+
+	t.Run("from request-scoped attributes", func(t *testing.T) {
+		logger, exporter := otlpTestLogger(t)
+
+		logger.With(TraceIDKey, traceID.String(), SpanIDKey, spanID.String()).Info("request")
+		logger.Info("record", TraceIDKey, traceID.String(), SpanIDKey, spanID.String())
+		logger.WithGroup("edge").Info("grouped", TraceIDKey, traceID.String(), SpanIDKey, spanID.String())
+
+		require.Len(t, exporter.records, 3)
+		assert.Equal(t, traceID, exporter.records[0].TraceID())
+		assert.Equal(t, spanID, exporter.records[0].SpanID())
+		assert.Equal(t, traceID, exporter.records[1].TraceID(), "record attributes set the trace context too")
+		assert.False(t, exporter.records[2].TraceID().IsValid(), "grouped attributes are not the request's trace context")
+	})
+
+	t.Run("context span takes precedence", func(t *testing.T) {
+		logger, exporter := otlpTestLogger(t)
+		spanTraceID, _ := trace.TraceIDFromHex("0af7651916cd43dd8448eb211c80319c")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: spanTraceID,
+			SpanID:  spanID,
+		}))
+
+		logger.With(TraceIDKey, traceID.String(), SpanIDKey, spanID.String()).InfoContext(ctx, "request")
+
+		require.Len(t, exporter.records, 1)
+		assert.Equal(t, spanTraceID, exporter.records[0].TraceID())
+	})
+
+	t.Run("invalid ids are ignored", func(t *testing.T) {
+		logger, exporter := otlpTestLogger(t)
+
+		logger.Info("request", TraceIDKey, "not-a-trace", SpanIDKey, spanID.String())
+
+		require.Len(t, exporter.records, 1)
+		assert.False(t, exporter.records[0].TraceID().IsValid())
+	})
+}
+
+func TestTraceAttrs(t *testing.T) {
+	header := http.Header{}
+	header.Set(TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.Equal(t, []any{TraceIDKey, "4bf92f3577b34da6a3ce929d0e0e4736", SpanIDKey, "00f067aa0ba902b7"},
+		traceAttrs(propagation.HeaderCarrier(header)))
+
+	assert.Nil(t, traceAttrs(propagation.HeaderCarrier(http.Header{})))
+
+	md := metadataCarrier{}
+	md.Set(TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.Equal(t, []string{TraceParentHeader}, md.Keys())
+	assert.Len(t, traceAttrs(md), 4)
+}
@@ -19,6 +19,8 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"log/slog"
+
+	"google.golang.org/grpc/metadata"
 )
 
 type contextKey string
@@ -28,6 +30,17 @@ const (
 	loggerKey    contextKey = "logger"
 )
 
+const (
+	// RequestIDHeader is the HTTP header used to carry a correlation ID
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDMetadataKey is the gRPC metadata key used to carry a correlation ID
+	RequestIDMetadataKey = "x-request-id"
+)
+
+// maxRequestIDLength bounds the length of a caller-supplied request ID, which is logged with every line of
+// the request
+const maxRequestIDLength = 128
+
 // GenerateRequestID generates a random request ID
 func GenerateRequestID() string {
 	bytes := make([]byte, 8)
@@ -48,6 +61,33 @@ func RequestIDFromContext(ctx context.Context) string {
 	return ""
 }
 
+// RequestIDFromIncomingContext extracts a caller-supplied request ID from gRPC metadata, or returns "" if
+// there is none or it is not a valid request ID
+func RequestIDFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(RequestIDMetadataKey); len(values) > 0 && validRequestID(values[0]) {
+		return values[0]
+	}
+	return ""
+}
+
+// validRequestID reports whether a caller-supplied request ID is at most maxRequestIDLength printable ASCII
+// characters, so callers cannot bloat or forge log lines with it
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x20 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
 // WithLogger adds a logger to the context
 func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
 	return context.WithValue(ctx, loggerKey, logger)
@@ -0,0 +1,132 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDFromIncomingContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "request ID present",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "abc123")),
+			want: "abc123",
+		},
+		{
+			name: "first of several request IDs",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "abc123", RequestIDMetadataKey, "def456")),
+			want: "abc123",
+		},
+		{
+			name: "request ID absent",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")),
+			want: "",
+		},
+		{
+			name: "empty request ID",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "")),
+			want: "",
+		},
+		{
+			name: "longest request ID",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, strings.Repeat("a", maxRequestIDLength))),
+			want: strings.Repeat("a", maxRequestIDLength),
+		},
+		{
+			name: "request ID too long",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, strings.Repeat("a", maxRequestIDLength+1))),
+			want: "",
+		},
+		{
+			name: "request ID with control characters",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "abc\nlevel=ERROR")),
+			want: "",
+		},
+		{
+			name: "request ID with non-ASCII characters",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "abc\u00e9")),
+			want: "",
+		},
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RequestIDFromIncomingContext(tt.ctx))
+		})
+	}
+}
+
+func TestUnaryServerInterceptor_RequestID(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	requestID := func(ctx context.Context) string {
+		var handled string
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			handled = RequestIDFromContext(ctx)
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		return handled
+	}
+
+	// A caller-supplied request ID is reused so logs correlate across services
+	assert.Equal(t, "abc123", requestID(metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "abc123"))))
+
+	// Otherwise, or when it is empty or invalid, one is generated
+	assert.Len(t, requestID(context.Background()), 16)
+	assert.Len(t, requestID(metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, ""))), 16)
+	assert.Len(t, requestID(metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, strings.Repeat("a", 1024)))), 16)
+}
+
+func TestHTTPMiddleware_RequestID(t *testing.T) {
+	handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, w.Header().Get(RequestIDHeader), RequestIDFromContext(r.Context()))
+	}))
+	requestID := func(header string) string {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/services", nil)
+		if header != "" {
+			req.Header.Set(RequestIDHeader, header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Header().Get(RequestIDHeader)
+	}
+
+	// A caller-supplied request ID is reused and echoed back
+	assert.Equal(t, "abc123", requestID("abc123"))
+
+	// Otherwise, or when it is too long or not printable ASCII, one is generated
+	assert.Len(t, requestID(""), 16)
+	assert.Len(t, requestID(strings.Repeat("a", maxRequestIDLength+1)), 16)
+	assert.Len(t, requestID("abc\u00e9"), 16)
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// TraceIDKey and SpanIDKey are the attributes of request-scoped loggers holding the W3C trace context
+	// the request was made in, so its log records can be correlated with the trace
+	TraceIDKey = "trace_id"
+	SpanIDKey  = "span_id"
+
+	// TraceParentHeader is the W3C Trace Context header carrying the trace a request was made in
+	TraceParentHeader = "traceparent"
+)
+
+// traceAttrs returns the trace_id and span_id attributes of the trace context a request carries, or none
+// if it carries no valid trace context
+func traceAttrs(carrier propagation.TextMapCarrier) []any {
+	spanContext := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []any{TraceIDKey, spanContext.TraceID().String(), SpanIDKey, spanContext.SpanID().String()}
+}
+
+// metadataCarrier reads the trace context of a gRPC request from its metadata
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	if values := metadata.MD(m).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
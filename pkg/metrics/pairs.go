@@ -0,0 +1,45 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sort"
+
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// SortInstancePairMetrics orders pod metrics by source cluster, workload and pod, then by destination cluster,
+// workload and pod
+func SortInstancePairMetrics(instances []*typesv1alpha1.InstancePairMetrics) {
+	sort.Slice(instances, func(i, j int) bool {
+		a, b := instances[i], instances[j]
+		if a.SourceCluster != b.SourceCluster {
+			return a.SourceCluster < b.SourceCluster
+		}
+		if a.SourceWorkload != b.SourceWorkload {
+			return a.SourceWorkload < b.SourceWorkload
+		}
+		if a.SourcePod != b.SourcePod {
+			return a.SourcePod < b.SourcePod
+		}
+		if a.DestinationCluster != b.DestinationCluster {
+			return a.DestinationCluster < b.DestinationCluster
+		}
+		if a.DestinationWorkload != b.DestinationWorkload {
+			return a.DestinationWorkload < b.DestinationWorkload
+		}
+		return a.DestinationPod < b.DestinationPod
+	})
+}
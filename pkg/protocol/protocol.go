@@ -0,0 +1,227 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protocol describes the parts of the backend protocol a build of the edge or manager supports, so
+// edges and managers of different versions can negotiate the features they both support.
+package protocol
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+)
+
+// APIVersion is the version of the backend API
+const APIVersion = "v1alpha1"
+
+// CompressionZstd is zstd compression of Istio resource raw config
+const CompressionZstd = "zstd"
+
+// ResourceTypeResourceChanges is the cluster state field edges that track the versions of Istio resources
+// report their changes in
+const ResourceTypeResourceChanges = "resource_changes"
+
+// Capability names reported in capability gaps
+const (
+	CapabilityProtocol             = "protocol"
+	CapabilityAPIVersion           = "api_version"
+	CapabilityResourceType         = "resource_type"
+	CapabilityRequest              = "request"
+	CapabilityDeltaSync            = "delta_sync"
+	CapabilityCompression          = "compression"
+	CapabilityChunkedClusterState  = "chunked_cluster_state"
+	CapabilityStreamedClusterState = "streamed_cluster_state"
+	CapabilityCapabilitiesUpdate   = "capabilities_update"
+)
+
+// Requests of the manager to edges, named after their ConnectResponse field without the "_request" suffix
+const (
+	RequestProxyConfig           = "proxy_config"
+	RequestServiceConnections    = "service_connections"
+	RequestResync                = "resync"
+	RequestPodLogs               = "pod_logs"
+	RequestEnvoyAdmin            = "envoy_admin"
+	RequestPairInstanceMetrics   = "pair_instance_metrics"
+	RequestMeshMetricsTimeSeries = "mesh_metrics_time_series"
+	RequestTraces                = "traces"
+	RequestAccessLogs            = "access_logs"
+)
+
+// LegacyRequests are the requests every edge that predates capability negotiation handles
+var LegacyRequests = []string{
+	RequestProxyConfig,
+	RequestServiceConnections,
+	RequestResync,
+	RequestPodLogs,
+	RequestEnvoyAdmin,
+	RequestPairInstanceMetrics,
+	RequestMeshMetricsTimeSeries,
+	RequestTraces,
+	RequestAccessLogs,
+}
+
+// requestSuffix ends the ConnectResponse fields that are requests to the edge
+const requestSuffix = "_request"
+
+// ResourceTypes returns the cluster state fields this build knows, in field order. Fields added to the
+// cluster state after an edge was built are missing from the resource types it reports.
+func ResourceTypes() []string {
+	fields := (&v1alpha1.ClusterState{}).ProtoReflect().Descriptor().Fields()
+	resourceTypes := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		name := string(fields.Get(i).Name())
+		if name == "sync_metadata" {
+			continue
+		}
+		resourceTypes = append(resourceTypes, name)
+	}
+	return resourceTypes
+}
+
+// SupportsResourceType returns whether an edge with the capabilities sends a cluster state field
+func SupportsResourceType(edge *v1alpha1.ProtocolCapabilities, resourceType string) bool {
+	return slices.Contains(edge.GetResourceTypes(), resourceType)
+}
+
+// Requests returns the requests of the manager to edges this build knows, in field order
+func Requests() []string {
+	fields := (&v1alpha1.ConnectResponse{}).ProtoReflect().Descriptor().Fields()
+	requests := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		if name, isRequest := strings.CutSuffix(string(fields.Get(i).Name()), requestSuffix); isRequest {
+			requests = append(requests, name)
+		}
+	}
+	return requests
+}
+
+// RequestName returns the name of the request a message from the manager carries, or an empty name for
+// messages that are not requests, such as the connection acknowledgment
+func RequestName(message *v1alpha1.ConnectResponse) string {
+	reflected := message.ProtoReflect()
+	field := reflected.WhichOneof(reflected.Descriptor().Oneofs().ByName("message"))
+	if field == nil {
+		return ""
+	}
+	name, isRequest := strings.CutSuffix(string(field.Name()), requestSuffix)
+	if !isRequest {
+		return ""
+	}
+	return name
+}
+
+// SupportsRequest returns whether an edge with the capabilities handles a request. Edges that predate
+// capability negotiation handle the legacy requests.
+func SupportsRequest(edge *v1alpha1.ProtocolCapabilities, request string) bool {
+	if request == "" {
+		return true
+	}
+	if edge == nil {
+		return slices.Contains(LegacyRequests, request)
+	}
+	return slices.Contains(edge.Requests, request)
+}
+
+// Capabilities returns the protocol capabilities of this build
+func Capabilities() *v1alpha1.ProtocolCapabilities {
+	return &v1alpha1.ProtocolCapabilities{
+		ApiVersion:           APIVersion,
+		ResourceTypes:        ResourceTypes(),
+		Requests:             Requests(),
+		Compression:          []string{CompressionZstd},
+		ChunkedClusterState:  true,
+		StreamedClusterState: true,
+		CapabilitiesUpdate:   true,
+	}
+}
+
+// SupportsCompression returns whether the capabilities include a compression algorithm
+func SupportsCompression(capabilities *v1alpha1.ProtocolCapabilities, algorithm string) bool {
+	return slices.Contains(capabilities.GetCompression(), algorithm)
+}
+
+// Gaps returns the features the manager supports that an edge does not. An edge that predates capability
+// negotiation reports no capabilities, which is a gap of its own.
+func Gaps(manager, edge *v1alpha1.ProtocolCapabilities) []*typesv1alpha1.CapabilityGap {
+	if edge == nil {
+		return []*typesv1alpha1.CapabilityGap{{
+			Capability: CapabilityProtocol,
+			Message:    "the edge predates capability negotiation; upgrade it to report which features it supports",
+		}}
+	}
+
+	var gaps []*typesv1alpha1.CapabilityGap
+	if edge.ApiVersion != manager.ApiVersion {
+		gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+			Capability: CapabilityAPIVersion,
+			Value:      edge.ApiVersion,
+			Message:    fmt.Sprintf("the edge speaks backend API %s while the manager speaks %s", edge.ApiVersion, manager.ApiVersion),
+		})
+	}
+	for _, resourceType := range manager.ResourceTypes {
+		if !slices.Contains(edge.ResourceTypes, resourceType) {
+			gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+				Capability: CapabilityResourceType,
+				Value:      resourceType,
+				Message:    fmt.Sprintf("the edge does not collect %s, so the cluster has none", resourceType),
+			})
+		}
+	}
+	for _, request := range manager.Requests {
+		if !SupportsRequest(edge, request) {
+			gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+				Capability: CapabilityRequest,
+				Value:      request,
+				Message:    fmt.Sprintf("the edge does not handle %s requests, so they fail as unsupported", request),
+			})
+		}
+	}
+	if manager.DeltaSync && !edge.DeltaSync {
+		gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+			Capability: CapabilityDeltaSync,
+			Message:    "the edge sends its full cluster state on every sync",
+		})
+	}
+	for _, algorithm := range manager.Compression {
+		if !SupportsCompression(edge, algorithm) {
+			gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+				Capability: CapabilityCompression,
+				Value:      algorithm,
+				Message:    fmt.Sprintf("the edge sends Istio resource raw config without %s compression", algorithm),
+			})
+		}
+	}
+	if manager.ChunkedClusterState && !edge.ChunkedClusterState {
+		gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+			Capability: CapabilityChunkedClusterState,
+			Message:    "the edge cannot split a cluster state larger than the maximum message size",
+		})
+	}
+	if manager.StreamedClusterState && !edge.StreamedClusterState {
+		gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+			Capability: CapabilityStreamedClusterState,
+			Message:    "the edge collects its whole cluster state before sending it",
+		})
+	}
+	if manager.CapabilitiesUpdate && !edge.CapabilitiesUpdate {
+		gaps = append(gaps, &typesv1alpha1.CapabilityGap{
+			Capability: CapabilityCapabilitiesUpdate,
+			Message:    "the edge only reports resource types that become available when it reconnects",
+		})
+	}
+	return gaps
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTypes(t *testing.T) {
+	resourceTypes := ResourceTypes()
+	assert.Equal(t, "services", resourceTypes[0])
+	assert.Contains(t, resourceTypes, "sidecar_injection")
+	assert.NotContains(t, resourceTypes, "sync_metadata")
+}
+
+func TestRequests(t *testing.T) {
+	assert.Subset(t, Requests(), LegacyRequests)
+	assert.NotContains(t, Requests(), "connection_ack")
+
+	assert.Equal(t, RequestPodLogs, RequestName(&v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_PodLogsRequest{PodLogsRequest: &v1alpha1.PodLogsRequest{}},
+	}))
+	assert.Empty(t, RequestName(&v1alpha1.ConnectResponse{
+		Message: &v1alpha1.ConnectResponse_ConnectionAck{ConnectionAck: &v1alpha1.ConnectionAck{}},
+	}))
+	assert.Empty(t, RequestName(&v1alpha1.ConnectResponse{}))
+}
+
+func TestSupportsRequest(t *testing.T) {
+	// Edges predating negotiation handle the legacy requests
+	assert.True(t, SupportsRequest(nil, RequestEnvoyAdmin))
+	assert.False(t, SupportsRequest(nil, "workload_certificates"))
+
+	edge := &v1alpha1.ProtocolCapabilities{Requests: []string{RequestProxyConfig}}
+	assert.True(t, SupportsRequest(edge, RequestProxyConfig))
+	assert.False(t, SupportsRequest(edge, RequestEnvoyAdmin))
+
+	// Messages that are not requests are always supported
+	assert.True(t, SupportsRequest(edge, ""))
+}
+
+func TestGaps(t *testing.T) {
+	manager := Capabilities()
+
+	// An edge of the same build has no gaps
+	assert.Empty(t, Gaps(manager, Capabilities()))
+
+	// An edge predating negotiation is one gap
+	gaps := Gaps(manager, nil)
+	if assert.Len(t, gaps, 1) {
+		assert.Equal(t, CapabilityProtocol, gaps[0].Capability)
+	}
+
+	// An older edge misses resource types and features added since
+	edge := &v1alpha1.ProtocolCapabilities{
+		ApiVersion:          APIVersion,
+		ResourceTypes:       []string{"services", "destination_rules"},
+		Requests:            []string{RequestProxyConfig},
+		ChunkedClusterState: true,
+	}
+	var capabilities, values []string
+	for _, gap := range Gaps(manager, edge) {
+		capabilities = append(capabilities, gap.Capability)
+		values = append(values, gap.Value)
+		assert.NotEmpty(t, gap.Message)
+	}
+	assert.Contains(t, values, "sidecar_injection")
+	assert.Contains(t, values, RequestEnvoyAdmin)
+	assert.NotContains(t, values, RequestProxyConfig)
+	assert.NotContains(t, values, "destination_rules")
+	assert.Contains(t, capabilities, CapabilityCompression)
+	assert.Contains(t, capabilities, CapabilityStreamedClusterState)
+	assert.Contains(t, capabilities, CapabilityCapabilitiesUpdate)
+	assert.NotContains(t, capabilities, CapabilityChunkedClusterState)
+	assert.NotContains(t, capabilities, CapabilityDeltaSync)
+
+	// A manager with delta sync reports edges without it
+	manager.DeltaSync = true
+	gaps = Gaps(manager, Capabilities())
+	if assert.Len(t, gaps, 1) {
+		assert.Equal(t, CapabilityDeltaSync, gaps[0].Capability)
+	}
+}
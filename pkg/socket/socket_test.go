@@ -0,0 +1,80 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package socket
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "navigator.sock")
+
+	listener, err := Listen(path)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(Mode), info.Mode().Perm())
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.URL.Path)
+	})}
+	go func() { _ = server.Serve(listener) }()
+
+	client := &http.Client{Transport: Transport(path)}
+	resp, err := client.Get("http://navigator/api/v1alpha1/services")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, "/api/v1alpha1/services", string(body))
+
+	// The socket is in use
+	_, err = Listen(path)
+	assert.ErrorContains(t, err, "another process is listening")
+
+	require.NoError(t, server.Close())
+	assert.NoFileExists(t, path)
+}
+
+func TestListen_staleSocket(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "navigator.sock")
+
+	// A socket left behind by a process that exited without closing it
+	listener, err := Listen(path)
+	require.NoError(t, err)
+	listener.(interface{ SetUnlinkOnClose(bool) }).SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+	require.FileExists(t, path)
+
+	listener, err = Listen(path)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	// Other files are never replaced
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = Listen(file)
+	assert.ErrorContains(t, err, "is not a socket")
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry exposes Navigator's own operational metrics in Prometheus format.
+package telemetry
+
+import (
+	"net/http"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsPath is the HTTP path Navigator metrics are served on
+const MetricsPath = "/metrics"
+
+var (
+	// Manager-side metrics, recorded as cluster state is received
+	clusterStateBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "navigator",
+		Subsystem: "manager",
+		Name:      "cluster_state_bytes",
+		Help:      "Serialized size of the most recent cluster state received from each cluster, in bytes.",
+	}, []string{"cluster_id"})
+
+	clusterStateResources = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "navigator",
+		Subsystem: "manager",
+		Name:      "cluster_state_resources",
+		Help:      "Number of resources of each type in the most recent cluster state received from each cluster.",
+	}, []string{"cluster_id", "resource_type"})
+
+	clusterEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "navigator",
+		Subsystem: "manager",
+		Name:      "cluster_evictions_total",
+		Help:      "Number of clusters evicted from aggregation, by reason.",
+	}, []string{"reason"})
+
+	// Edge-side metrics, recorded as cluster state is collected and pushed
+	clusterStatePushBytes = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "navigator",
+		Subsystem: "edge",
+		Name:      "cluster_state_push_bytes",
+		Help:      "Distribution of serialized cluster state push sizes, in bytes.",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 10), // 1KiB to 256MiB
+	}, []string{"cluster_id"})
+
+	conversionErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "navigator",
+		Subsystem: "edge",
+		Name:      "conversion_errors_total",
+		Help:      "Number of resources dropped from cluster state because they failed to convert.",
+	}, []string{"resource_type"})
+)
+
+// Handler returns an HTTP handler serving all registered metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// CountResources returns the number of resources of each type in a cluster state
+func CountResources(state *backendv1alpha1.ClusterState) map[string]int {
+	return map[string]int{
+		"services":                len(state.GetServices()),
+		"destination_rules":       len(state.GetDestinationRules()),
+		"envoy_filters":           len(state.GetEnvoyFilters()),
+		"gateways":                len(state.GetGateways()),
+		"sidecars":                len(state.GetSidecars()),
+		"virtual_services":        len(state.GetVirtualServices()),
+		"request_authentications": len(state.GetRequestAuthentications()),
+		"peer_authentications":    len(state.GetPeerAuthentications()),
+		"authorization_policies":  len(state.GetAuthorizationPolicies()),
+		"wasm_plugins":            len(state.GetWasmPlugins()),
+		"service_entries":         len(state.GetServiceEntries()),
+		"service_exports":         len(state.GetServiceExports()),
+		"service_imports":         len(state.GetServiceImports()),
+	}
+}
+
+// RecordClusterState records the serialized size and resource counts of cluster state received by the manager
+func RecordClusterState(clusterID string, sizeBytes int, resourceCounts map[string]int) {
+	clusterStateBytes.WithLabelValues(clusterID).Set(float64(sizeBytes))
+	for resourceType, count := range resourceCounts {
+		clusterStateResources.WithLabelValues(clusterID, resourceType).Set(float64(count))
+	}
+}
+
+// ForgetCluster removes the manager's per-cluster gauges for a cluster that is no longer connected
+func ForgetCluster(clusterID string) {
+	clusterStateBytes.DeleteLabelValues(clusterID)
+	clusterStateResources.DeletePartialMatch(prometheus.Labels{"cluster_id": clusterID})
+}
+
+// RecordClusterEviction records a cluster evicted from aggregation
+func RecordClusterEviction(reason string) {
+	clusterEvictions.WithLabelValues(reason).Inc()
+}
+
+// RecordClusterStatePush records the serialized size of a cluster state pushed by the edge
+func RecordClusterStatePush(clusterID string, sizeBytes int) {
+	clusterStatePushBytes.WithLabelValues(clusterID).Observe(float64(sizeBytes))
+}
+
+// RecordConversionError records a resource that was dropped because it failed to convert
+func RecordConversionError(resourceType string) {
+	conversionErrors.WithLabelValues(resourceType).Inc()
+}
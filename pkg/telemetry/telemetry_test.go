@@ -0,0 +1,68 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	backendv1alpha1 "github.com/liamawhite/navigator/pkg/api/backend/v1alpha1"
+	typesv1alpha1 "github.com/liamawhite/navigator/pkg/api/types/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountResources(t *testing.T) {
+	state := &backendv1alpha1.ClusterState{
+		Services: []*backendv1alpha1.Service{{Name: "a"}, {Name: "b"}},
+		Gateways: []*typesv1alpha1.Gateway{{Name: "gw"}},
+	}
+
+	counts := CountResources(state)
+	assert.Equal(t, 2, counts["services"])
+	assert.Equal(t, 1, counts["gateways"])
+	assert.Equal(t, 0, counts["virtual_services"])
+
+	assert.Equal(t, 0, CountResources(nil)["services"])
+}
+
+func TestRecordClusterState(t *testing.T) {
+	RecordClusterState("test-cluster", 2048, map[string]int{"services": 3})
+
+	assert.Equal(t, float64(2048), testutil.ToFloat64(clusterStateBytes.WithLabelValues("test-cluster")))
+	assert.Equal(t, float64(3), testutil.ToFloat64(clusterStateResources.WithLabelValues("test-cluster", "services")))
+
+	ForgetCluster("test-cluster")
+	assert.Equal(t, 0, testutil.CollectAndCount(clusterStateBytes))
+	assert.Equal(t, 0, testutil.CollectAndCount(clusterStateResources))
+}
+
+func TestRecordConversionError(t *testing.T) {
+	before := testutil.ToFloat64(conversionErrors.WithLabelValues("sidecars"))
+	RecordConversionError("sidecars")
+	assert.Equal(t, before+1, testutil.ToFloat64(conversionErrors.WithLabelValues("sidecars")))
+}
+
+func TestHandler(t *testing.T) {
+	RecordClusterStatePush("push-cluster", 4096)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, MetricsPath, nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), "navigator_edge_cluster_state_push_bytes"))
+}
@@ -25,7 +25,10 @@ import {
 } from './ui/dropdown-menu';
 import { serviceApi } from '../utils/api';
 import type { v1alpha1ClusterSyncInfo } from '../types/generated/openapi-cluster_registry';
-import { v1alpha1SyncStatus } from '../types/generated/openapi-cluster_registry';
+import {
+    v1alpha1OutboundTrafficPolicyMode,
+    v1alpha1SyncStatus,
+} from '../types/generated/openapi-cluster_registry';
 import { ChevronDown, Server, Circle, AlertTriangle } from 'lucide-react';
 import {
     Tooltip,
@@ -94,6 +97,19 @@ const getTimeAgo = (timestamp?: string): string => {
     }
 };
 
+const getOutboundTrafficText = (
+    mode?: v1alpha1OutboundTrafficPolicyMode
+): string => {
+    switch (mode) {
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY:
+            return 'Allow any';
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY:
+            return 'Registry only';
+        default:
+            return 'Unknown';
+    }
+};
+
 const hasMixedMetricsCapability = (
     clusters: v1alpha1ClusterSyncInfo[]
 ): boolean => {
@@ -247,6 +263,12 @@ export const ClusterSyncStatus: React.FC = () => {
                                             ? 'Enabled'
                                             : 'Disabled'}
                                     </div>
+                                    <div>
+                                        Outbound traffic:{' '}
+                                        {getOutboundTrafficText(
+                                            cluster.outboundTrafficPolicyMode
+                                        )}
+                                    </div>
                                     <div>
                                         Last sync:{' '}
                                         {getTimeAgo(cluster.lastUpdate)}
@@ -0,0 +1,118 @@
+// Copyright 2025 Navigator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+import { useQuery } from '@tanstack/react-query';
+import { History } from 'lucide-react';
+import { Card, CardContent, CardHeader, CardTitle } from './ui/card';
+import { Badge } from './ui/badge';
+import { serviceApi } from '../utils/api';
+import { v1alpha1ChangeType } from '../types/generated/openapi-cluster_registry';
+
+// recentChangesLimit is how many of the most recent changes are shown
+const recentChangesLimit = 10;
+
+const getChangeTypeText = (type?: v1alpha1ChangeType): string => {
+    switch (type) {
+        case v1alpha1ChangeType.CHANGE_TYPE_CREATED:
+            return 'Created';
+        case v1alpha1ChangeType.CHANGE_TYPE_UPDATED:
+            return 'Updated';
+        case v1alpha1ChangeType.CHANGE_TYPE_DELETED:
+            return 'Deleted';
+        default:
+            return 'Changed';
+    }
+};
+
+const getChangeTypeColor = (type?: v1alpha1ChangeType): string => {
+    switch (type) {
+        case v1alpha1ChangeType.CHANGE_TYPE_CREATED:
+            return 'border-green-500 text-green-700 dark:text-green-400';
+        case v1alpha1ChangeType.CHANGE_TYPE_UPDATED:
+            return 'border-blue-500 text-blue-700 dark:text-blue-400';
+        case v1alpha1ChangeType.CHANGE_TYPE_DELETED:
+            return 'border-red-500 text-red-700 dark:text-red-400';
+        default:
+            return '';
+    }
+};
+
+// RecentChanges lists the most recent configuration and topology changes
+// observed across the connected clusters, answering "what changed?"
+export const RecentChanges: React.FC = () => {
+    const { data: changes } = useQuery({
+        queryKey: ['changes'],
+        queryFn: () => serviceApi.listChanges(undefined, recentChangesLimit),
+        refetchInterval: 30000,
+    });
+
+    if (!changes || changes.length === 0) {
+        return null;
+    }
+
+    return (
+        <Card className="mb-6">
+            <CardHeader>
+                <CardTitle className="flex items-center gap-2 text-lg">
+                    <History className="w-5 h-5 text-muted-foreground" />
+                    What changed
+                </CardTitle>
+            </CardHeader>
+            <CardContent>
+                <ul className="space-y-2">
+                    {changes.map((change, index) => (
+                        <li
+                            key={`${change.clusterId}-${change.kind}-${change.namespace}-${change.name}-${change.observedAt}-${index}`}
+                            className="flex items-center gap-2 text-sm"
+                        >
+                            <Badge
+                                variant="outline"
+                                className={`text-xs w-20 justify-center ${getChangeTypeColor(change.type)}`}
+                            >
+                                {getChangeTypeText(change.type)}
+                            </Badge>
+                            <span className="text-muted-foreground">
+                                {change.kind}
+                            </span>
+                            <span className="font-medium text-foreground">
+                                {change.namespace
+                                    ? `${change.namespace}/${change.name}`
+                                    : change.name}
+                            </span>
+                            {change.description && (
+                                <span className="text-muted-foreground">
+                                    {change.description}
+                                </span>
+                            )}
+                            {change.actor && (
+                                <span className="text-xs text-muted-foreground">
+                                    by {change.actor}
+                                </span>
+                            )}
+                            <Badge variant="secondary" className="text-xs">
+                                {change.clusterId}
+                            </Badge>
+                            <span className="ml-auto text-xs text-muted-foreground">
+                                {change.observedAt
+                                    ? new Date(
+                                          change.observedAt
+                                      ).toLocaleString()
+                                    : ''}
+                            </span>
+                        </li>
+                    ))}
+                </ul>
+            </CardContent>
+        </Card>
+    );
+};
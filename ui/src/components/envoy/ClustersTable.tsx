@@ -87,6 +87,24 @@ const getClusterTypeVariant = (
     }
 };
 
+const formatDestinationRule = (cluster: v1alpha1ClusterSummary): string =>
+    `${cluster.destinationRuleNamespace}/${cluster.destinationRuleName}`;
+
+const getTlsModeVariant = (
+    tlsMode?: string
+): 'default' | 'secondary' | 'destructive' | 'outline' => {
+    switch (tlsMode) {
+        case 'ISTIO_MUTUAL':
+        case 'AUTO':
+            return 'default'; // Blue - mesh mTLS
+        case 'SIMPLE':
+        case 'MUTUAL':
+            return 'secondary'; // Gray - TLS origination
+        default:
+            return 'outline';
+    }
+};
+
 // Helper function to group clusters by type
 const groupClustersByType = (clusters: v1alpha1ClusterSummary[]) => {
     const groups = {
@@ -233,6 +251,26 @@ const ClusterGroup: React.FC<{
                                     {getSortIcon('subset')}
                                 </div>
                             </TableHead>
+                            <TableHead
+                                className="cursor-pointer select-none hover:bg-muted/50 w-40"
+                                onClick={() =>
+                                    handleSort('destinationRuleName')
+                                }
+                            >
+                                <div className="flex items-center">
+                                    DestinationRule
+                                    {getSortIcon('destinationRuleName')}
+                                </div>
+                            </TableHead>
+                            <TableHead
+                                className="cursor-pointer select-none hover:bg-muted/50 w-28"
+                                onClick={() => handleSort('tlsMode')}
+                            >
+                                <div className="flex items-center">
+                                    TLS
+                                    {getSortIcon('tlsMode')}
+                                </div>
+                            </TableHead>
                             <TableHead
                                 className="cursor-pointer select-none hover:bg-muted/50 w-24"
                                 onClick={() => handleSort('type')}
@@ -280,6 +318,32 @@ const ClusterGroup: React.FC<{
                                         {cluster.subset || '-'}
                                     </span>
                                 </TableCell>
+                                <TableCell className="w-40">
+                                    {cluster.destinationRuleName ? (
+                                        <Badge
+                                            variant="outline"
+                                            className="font-mono text-xs"
+                                        >
+                                            {formatDestinationRule(cluster)}
+                                        </Badge>
+                                    ) : (
+                                        <span className="text-sm">-</span>
+                                    )}
+                                </TableCell>
+                                <TableCell className="w-28">
+                                    {cluster.tlsMode ? (
+                                        <Badge
+                                            variant={getTlsModeVariant(
+                                                cluster.tlsMode
+                                            )}
+                                            title={cluster.tls?.sni}
+                                        >
+                                            {cluster.tlsMode.toLowerCase()}
+                                        </Badge>
+                                    ) : (
+                                        <span className="text-sm">-</span>
+                                    )}
+                                </TableCell>
                                 <TableCell className="w-24">
                                     <Badge
                                         variant={getClusterTypeVariant(
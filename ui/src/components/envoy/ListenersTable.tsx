@@ -33,8 +33,15 @@ import {
 } from '@/components/ui/table';
 import { Badge } from '@/components/ui/badge';
 import { ConfigActions } from '@/components/envoy/ConfigActions';
-import type { v1alpha1ListenerSummary } from '@/types/generated/openapi-service_registry';
-import { v1alpha1ProxyMode } from '@/types/generated/openapi-service_registry';
+import type {
+    v1alpha1ListenerSource,
+    v1alpha1ListenerSummary,
+    v1alpha1PortProtocol,
+} from '@/types/generated/openapi-service_registry';
+import {
+    v1alpha1ProtocolDetection,
+    v1alpha1ProxyMode,
+} from '@/types/generated/openapi-service_registry';
 
 interface ListenersTableProps {
     listeners: v1alpha1ListenerSummary[];
@@ -47,6 +54,18 @@ type SortConfig = {
     direction: 'asc' | 'desc';
 } | null;
 
+// Helper function to label the Gateway server or Sidecar listener that generated a listener
+const formatListenerSource = (source: v1alpha1ListenerSource): string =>
+    `${source.kind} ${source.namespace}/${source.name} ${source.section}`;
+
+// Helper function to label the protocols a listener selects for a port, flagging sniffed ports that fall back to TCP
+const formatPortProtocol = (portProtocol: v1alpha1PortProtocol): string => {
+    const protocols = `${portProtocol.port} ${portProtocol.protocols?.join(', ')}`;
+    return portProtocol.detection === v1alpha1ProtocolDetection.SNIFFED
+        ? `${protocols} (sniffed)`
+        : protocols;
+};
+
 // Helper functions for listener type formatting and styling
 const formatListenerType = (type?: string | number): string => {
     if (!type) return 'unknown';
@@ -288,6 +307,8 @@ const ListenerGroup: React.FC<{
                                     {getSortIcon('type')}
                                 </div>
                             </TableHead>
+                            <TableHead className="w-48">Protocol</TableHead>
+                            <TableHead>Source</TableHead>
                             <TableHead className="w-20"></TableHead>
                         </TableRow>
                     </TableHeader>
@@ -315,6 +336,56 @@ const ListenerGroup: React.FC<{
                                         {formatListenerType(listener.type)}
                                     </Badge>
                                 </TableCell>
+                                <TableCell>
+                                    {listener.portProtocols?.length ? (
+                                        <div className="flex flex-wrap gap-1">
+                                            {listener.portProtocols.map(
+                                                (portProtocol, protocolIndex) => (
+                                                    <Badge
+                                                        key={protocolIndex}
+                                                        variant={
+                                                            portProtocol.detection ===
+                                                            v1alpha1ProtocolDetection.SNIFFED
+                                                                ? 'secondary'
+                                                                : 'outline'
+                                                        }
+                                                        className="font-mono text-xs"
+                                                    >
+                                                        {formatPortProtocol(
+                                                            portProtocol
+                                                        )}
+                                                    </Badge>
+                                                )
+                                            )}
+                                        </div>
+                                    ) : (
+                                        <span className="text-sm">-</span>
+                                    )}
+                                </TableCell>
+                                <TableCell>
+                                    {listener.sources?.length ? (
+                                        <div className="flex flex-wrap gap-1">
+                                            {listener.sources.map(
+                                                (source, sourceIndex) => (
+                                                    <Badge
+                                                        key={sourceIndex}
+                                                        variant="outline"
+                                                        className="font-mono text-xs"
+                                                        title={source.hosts?.join(
+                                                            ', '
+                                                        )}
+                                                    >
+                                                        {formatListenerSource(
+                                                            source
+                                                        )}
+                                                    </Badge>
+                                                )
+                                            )}
+                                        </div>
+                                    ) : (
+                                        <span className="text-sm">-</span>
+                                    )}
+                                </TableCell>
                                 <TableCell>
                                     <ConfigActions
                                         name={listener.name || 'Unknown'}
@@ -86,6 +86,21 @@ const getRouteTypeVariant = (
     }
 };
 
+// Helper function to list the VirtualServices (namespace/name) that generated a route configuration's routes
+const getVirtualServices = (route: v1alpha1RouteConfigSummary): string[] => {
+    const virtualServices = new Set<string>();
+    route.virtualHosts?.forEach((virtualHost) => {
+        virtualHost.routes?.forEach((routeInfo) => {
+            if (routeInfo.virtualServiceName) {
+                virtualServices.add(
+                    `${routeInfo.virtualServiceNamespace}/${routeInfo.virtualServiceName}`
+                );
+            }
+        });
+    });
+    return [...virtualServices].sort();
+};
+
 // Helper function to group routes by type
 const groupRoutesByType = (routes: v1alpha1RouteConfigSummary[]) => {
     const groups = {
@@ -169,6 +184,10 @@ const RouteGroup: React.FC<{
             aVal = a.virtualHosts?.length || 0;
             bVal = b.virtualHosts?.length || 0;
         }
+        if (sortConfig.key === 'virtualServices') {
+            aVal = getVirtualServices(a).join(',');
+            bVal = getVirtualServices(b).join(',');
+        }
 
         // Convert to string for comparison if needed
         if (typeof aVal === 'string') aVal = aVal.toLowerCase();
@@ -217,7 +236,7 @@ const RouteGroup: React.FC<{
                         <TableRow>
                             <TableHead
                                 className="cursor-pointer select-none hover:bg-muted/50"
-                                style={{ width: '60%' }}
+                                style={{ width: '40%' }}
                                 onClick={() => handleSort('name')}
                             >
                                 <div className="flex items-center">
@@ -227,7 +246,7 @@ const RouteGroup: React.FC<{
                             </TableHead>
                             <TableHead
                                 className="cursor-pointer select-none hover:bg-muted/50"
-                                style={{ width: '15%' }}
+                                style={{ width: '12%' }}
                                 onClick={() => handleSort('type')}
                             >
                                 <div className="flex items-center">
@@ -237,7 +256,7 @@ const RouteGroup: React.FC<{
                             </TableHead>
                             <TableHead
                                 className="cursor-pointer select-none hover:bg-muted/50"
-                                style={{ width: '15%' }}
+                                style={{ width: '13%' }}
                                 onClick={() => handleSort('virtualHosts')}
                             >
                                 <div className="flex items-center">
@@ -245,6 +264,16 @@ const RouteGroup: React.FC<{
                                     {getSortIcon('virtualHosts')}
                                 </div>
                             </TableHead>
+                            <TableHead
+                                className="cursor-pointer select-none hover:bg-muted/50"
+                                style={{ width: '25%' }}
+                                onClick={() => handleSort('virtualServices')}
+                            >
+                                <div className="flex items-center">
+                                    VirtualServices
+                                    {getSortIcon('virtualServices')}
+                                </div>
+                            </TableHead>
                             <TableHead style={{ width: '10%' }}></TableHead>
                         </TableRow>
                     </TableHeader>
@@ -270,6 +299,22 @@ const RouteGroup: React.FC<{
                                         {route.virtualHosts?.length || 0}
                                     </span>
                                 </TableCell>
+                                <TableCell>
+                                    <div className="flex flex-wrap gap-1">
+                                        {getVirtualServices(route).map(
+                                            (virtualService) => (
+                                                <Badge
+                                                    key={virtualService}
+                                                    variant="outline"
+                                                    className="font-mono text-xs"
+                                                    title="VirtualService that generated routes of this configuration"
+                                                >
+                                                    {virtualService}
+                                                </Badge>
+                                            )
+                                        )}
+                                    </div>
+                                </TableCell>
                                 <TableCell>
                                     <ConfigActions
                                         name={route.name || 'Unknown Route'}
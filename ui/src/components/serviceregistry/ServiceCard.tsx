@@ -89,6 +89,23 @@ export const ServiceCard: React.FC<ServiceCardProps> = ({
                             </Badge>
                         </div>
                     )}
+
+                {service.externalNames &&
+                    Object.keys(service.externalNames).length > 0 && (
+                        <div className="flex items-center gap-2">
+                            <Globe className="w-4 h-4 text-green-500" />
+                            <span className="text-sm text-slate-600 font-mono">
+                                {[
+                                    ...new Set(
+                                        Object.values(service.externalNames)
+                                    ),
+                                ].join(', ')}
+                            </span>
+                            <Badge variant="secondary" className="text-xs">
+                                ExternalName
+                            </Badge>
+                        </div>
+                    )}
             </CardContent>
         </Card>
     );
@@ -16,15 +16,15 @@ import React from 'react';
 import { render, screen, fireEvent, waitFor } from '@testing-library/react';
 import { ServiceConnectionsCard } from './ServiceConnectionsCard';
 import { useServiceConnections } from '../../hooks/useServiceConnections';
-import { useClusters } from '../../hooks/useClusters';
+import { useCapabilities } from '../../hooks/useCapabilities';
 import { useMetricsContext } from '../../contexts/MetricsContext';
 
 jest.mock('../../hooks/useServiceConnections', () => ({
     useServiceConnections: jest.fn(),
 }));
 
-jest.mock('../../hooks/useClusters', () => ({
-    useClusters: jest.fn(),
+jest.mock('../../hooks/useCapabilities', () => ({
+    useCapabilities: jest.fn(),
 }));
 
 jest.mock('../../contexts/MetricsContext', () => ({
@@ -51,8 +51,8 @@ jest.mock('./ServiceConnectionsTable', () => ({
 
 const mockedUseServiceConnections =
     useServiceConnections as jest.MockedFunction<typeof useServiceConnections>;
-const mockedUseClusters = useClusters as jest.MockedFunction<
-    typeof useClusters
+const mockedUseCapabilities = useCapabilities as jest.MockedFunction<
+    typeof useCapabilities
 >;
 const mockedUseMetricsContext = useMetricsContext as jest.MockedFunction<
     typeof useMetricsContext
@@ -75,8 +75,8 @@ describe('ServiceConnectionsCard', () => {
     beforeEach(() => {
         jest.clearAllMocks();
         mockedUseMetricsContext.mockReturnValue(mockMetricsContext);
-        mockedUseClusters.mockReturnValue({
-            data: [{ clusterId: 'cluster-1', metricsEnabled: true }],
+        mockedUseCapabilities.mockReturnValue({
+            data: { metrics: true },
             isLoading: false,
         } as any); // eslint-disable-line @typescript-eslint/no-explicit-any
     });
@@ -183,8 +183,8 @@ describe('ServiceConnectionsCard', () => {
     });
 
     it('should show collapsed state when no metrics enabled', () => {
-        mockedUseClusters.mockReturnValue({
-            data: [{ clusterId: 'cluster-1', metricsEnabled: false }],
+        mockedUseCapabilities.mockReturnValue({
+            data: { metrics: false },
             isLoading: false,
         } as any); // eslint-disable-line @typescript-eslint/no-explicit-any
 
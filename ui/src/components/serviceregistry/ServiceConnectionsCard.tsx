@@ -16,7 +16,7 @@ import React, { useRef } from 'react';
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
 import { Network, AlertCircle, Clock, RefreshCw } from 'lucide-react';
 import { useServiceConnections } from '../../hooks/useServiceConnections';
-import { useClusters } from '../../hooks/useClusters';
+import { useCapabilities } from '../../hooks/useCapabilities';
 import { useMetricsContext, TIME_RANGES } from '../../contexts/MetricsContext';
 import { ServiceConnectionsTable } from './ServiceConnectionsTable';
 import { Button } from '@/components/ui/button';
@@ -76,7 +76,8 @@ export const ServiceConnectionsCard: React.FC<ServiceConnectionsCardProps> = ({
         error,
     } = useServiceConnections(serviceName, namespace);
 
-    const { data: clusters, isLoading: clustersLoading } = useClusters();
+    const { data: capabilities, isLoading: capabilitiesLoading } =
+        useCapabilities();
 
     // Track if initial refresh has been triggered to prevent memory leaks
     const hasTriggeredInitialRefresh = useRef(false);
@@ -118,9 +119,7 @@ export const ServiceConnectionsCard: React.FC<ServiceConnectionsCardProps> = ({
         updateLastUpdated,
     ]);
 
-    const hasAnyMetrics =
-        clusters?.some((cluster) => cluster.metricsEnabled) ?? false;
-    const showCollapsed = !clustersLoading && !hasAnyMetrics;
+    const showCollapsed = !capabilitiesLoading && !capabilities?.metrics;
 
     return (
         <Card className={`mb-6 ${showCollapsed ? 'opacity-50' : ''}`}>
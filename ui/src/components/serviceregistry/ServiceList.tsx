@@ -24,9 +24,11 @@ import {
     Hexagon,
     Globe,
     ArrowRightToLine,
+    Layers,
 } from 'lucide-react';
 import { Card, CardContent } from '@/components/ui/card';
 import { Badge } from '@/components/ui/badge';
+import { Button } from '@/components/ui/button';
 import {
     Table,
     TableBody,
@@ -58,6 +60,7 @@ export const ServiceList: React.FC<ServiceListProps> = ({
     const { data: clusters } = useClusters();
     const [sortField, setSortField] = useState<SortField>('namespace');
     const [sortDirection, setSortDirection] = useState<SortDirection>('asc');
+    const [groupByCanonical, setGroupByCanonical] = useState(false);
 
     const handleSort = (field: SortField) => {
         if (sortField === field) {
@@ -122,6 +125,35 @@ export const ServiceList: React.FC<ServiceListProps> = ({
         };
     }, [services, sortField, sortDirection]);
 
+    // Group services by the canonical services their instances report
+    // telemetry under; a service backing several appears in each group
+    const canonicalGroups = useMemo(() => {
+        const groups = new Map<
+            string,
+            {
+                name: string;
+                namespace: string;
+                services: NonNullable<typeof services>;
+            }
+        >();
+        [...gateways, ...regularServices].forEach((service) => {
+            (service.canonicalServices || []).forEach((name) => {
+                const key = `${service.namespace}:${name}`;
+                if (!groups.has(key)) {
+                    groups.set(key, {
+                        name,
+                        namespace: service.namespace || '',
+                        services: [],
+                    });
+                }
+                groups.get(key)!.services.push(service);
+            });
+        });
+        return [...groups.entries()]
+            .sort(([a], [b]) => a.localeCompare(b))
+            .map(([key, group]) => ({ key, ...group }));
+    }, [gateways, regularServices]);
+
     const ServiceTable = ({
         serviceList,
         title,
@@ -286,6 +318,74 @@ export const ServiceList: React.FC<ServiceListProps> = ({
         );
     };
 
+    const CanonicalServiceTable = () => (
+        <div className="mb-4">
+            <div className="flex items-center justify-between mb-2">
+                <div className="flex items-center gap-2">
+                    <Layers className="w-5 h-5 text-purple-600 dark:text-purple-400" />
+                    <h3 className="text-lg font-semibold text-foreground">
+                        Canonical Services
+                    </h3>
+                </div>
+                <span className="text-sm text-muted-foreground bg-muted px-3 py-1 rounded-full">
+                    {canonicalGroups.length} canonical service
+                    {canonicalGroups.length !== 1 ? 's' : ''}
+                </span>
+            </div>
+
+            <div className="bg-background border rounded-lg shadow-sm overflow-hidden">
+                <Table>
+                    <TableHeader>
+                        <TableRow>
+                            <TableHead className="w-64">
+                                Canonical Service
+                            </TableHead>
+                            <TableHead className="w-32">Namespace</TableHead>
+                            <TableHead>Services</TableHead>
+                        </TableRow>
+                    </TableHeader>
+                    <TableBody>
+                        {canonicalGroups.map((group) => (
+                            <TableRow key={group.key}>
+                                <TableCell className="w-64">
+                                    <span className="font-medium text-foreground truncate">
+                                        {group.name}
+                                    </span>
+                                </TableCell>
+                                <TableCell className="w-32">
+                                    <Badge
+                                        variant="secondary"
+                                        className="text-xs text-foreground bg-muted"
+                                    >
+                                        {group.namespace}
+                                    </Badge>
+                                </TableCell>
+                                <TableCell>
+                                    <div className="flex flex-wrap gap-1">
+                                        {group.services.map((service) => (
+                                            <Badge
+                                                key={service.id}
+                                                variant="outline"
+                                                className="text-xs cursor-pointer hover:bg-muted/50"
+                                                onClick={() =>
+                                                    onServiceSelect?.(
+                                                        service.id || ''
+                                                    )
+                                                }
+                                            >
+                                                {service.name}
+                                            </Badge>
+                                        ))}
+                                    </div>
+                                </TableCell>
+                            </TableRow>
+                        ))}
+                    </TableBody>
+                </Table>
+            </div>
+        </div>
+    );
+
     if (isLoading) {
         return (
             <Card className="border-0 shadow-md">
@@ -367,6 +467,18 @@ export const ServiceList: React.FC<ServiceListProps> = ({
                     Discovered Services
                 </h2>
                 <div className="flex items-center gap-2">
+                    {canonicalGroups.length > 0 && (
+                        <Button
+                            variant={groupByCanonical ? 'secondary' : 'outline'}
+                            size="sm"
+                            onClick={() =>
+                                setGroupByCanonical(!groupByCanonical)
+                            }
+                        >
+                            <Layers className="w-4 h-4" />
+                            Group by canonical service
+                        </Button>
+                    )}
                     {gateways.length > 0 && (
                         <span className="text-sm text-muted-foreground bg-orange-100 dark:bg-orange-950 text-orange-700 dark:text-orange-300 px-3 py-1 rounded-full">
                             {gateways.length} gateway
@@ -379,7 +491,11 @@ export const ServiceList: React.FC<ServiceListProps> = ({
                 </div>
             </div>
 
-            {gateways.length > 0 && (
+            {groupByCanonical && canonicalGroups.length > 0 && (
+                <CanonicalServiceTable />
+            )}
+
+            {!groupByCanonical && gateways.length > 0 && (
                 <ServiceTable
                     serviceList={gateways}
                     title="Gateways"
@@ -389,7 +505,7 @@ export const ServiceList: React.FC<ServiceListProps> = ({
                 />
             )}
 
-            {regularServices.length > 0 && (
+            {!groupByCanonical && regularServices.length > 0 && (
                 <ServiceTable
                     serviceList={regularServices}
                     title="Services"
@@ -14,6 +14,7 @@
 
 import { ServiceList } from '../components/serviceregistry';
 import { Navbar } from '../components/Navbar';
+import { RecentChanges } from '../components/RecentChanges';
 import { useNavigate } from 'react-router-dom';
 
 export const HomePage: React.FC = () => {
@@ -27,6 +28,7 @@ export const HomePage: React.FC = () => {
         <div className="min-h-screen bg-background">
             <Navbar />
             <div className="container mx-auto px-4 py-8">
+                <RecentChanges />
                 <ServiceList onServiceSelect={handleServiceSelect} />
             </div>
         </div>
@@ -17,7 +17,16 @@ import { useService } from '../hooks/useServices';
 import { Navbar } from '../components/Navbar';
 import { ServiceConnectionsCard } from '../components/serviceregistry/ServiceConnectionsCard';
 import { MetricsProvider } from '../contexts/MetricsContext';
-import { Server, Database, MapPin, Hexagon, Home, Network } from 'lucide-react';
+import {
+    Server,
+    Database,
+    MapPin,
+    Hexagon,
+    Home,
+    Network,
+    LocateFixed,
+    Layers,
+} from 'lucide-react';
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
 import { Badge } from '@/components/ui/badge';
 import {
@@ -41,6 +50,7 @@ import {
     BreadcrumbPage,
     BreadcrumbSeparator,
 } from '@/components/ui/breadcrumb';
+import { v1alpha1PodLifecyclePhase } from '@/types/generated/openapi-service_registry/index';
 
 export const ServiceDetailPage: React.FC = () => {
     const { id } = useParams<{ id: string }>();
@@ -141,9 +151,17 @@ export const ServiceDetailPage: React.FC = () => {
     const proxiedInstances = service.instances.filter((i) => i.envoyPresent);
     const serviceMeshEnabled = proxiedInstances.length > 0;
 
+    // ExternalName services have no instances, so their clusters come from their external names
+    const externalNames = Object.entries(service.externalNames || {});
     const uniqueClusters = [
-        ...new Set(service.instances.map((i) => i.clusterName)),
+        ...new Set([
+            ...service.instances.map((i) => i.clusterName),
+            ...externalNames.map(([cluster]) => cluster),
+        ]),
     ];
+    const zoneSpread = Object.entries(service.zoneSpread || {}).sort(
+        ([a], [b]) => a.localeCompare(b)
+    );
 
     return (
         <div className="min-h-screen bg-background">
@@ -205,6 +223,11 @@ export const ServiceDetailPage: React.FC = () => {
                                                                     .externalIps?.[
                                                                     cluster
                                                                 ];
+                                                            const externalName =
+                                                                service
+                                                                    .externalNames?.[
+                                                                    cluster
+                                                                ];
                                                             const displayIP =
                                                                 externalIP ||
                                                                 clusterIP;
@@ -218,15 +241,42 @@ export const ServiceDetailPage: React.FC = () => {
                                                                     className={`text-xs ${externalIP ? 'border-green-500 text-green-700' : ''}`}
                                                                 >
                                                                     {cluster}
-                                                                    {displayIP
-                                                                        ? `:${displayIP}`
-                                                                        : ''}
+                                                                    {externalName
+                                                                        ? ` → ${externalName}`
+                                                                        : displayIP
+                                                                          ? `:${displayIP}`
+                                                                          : ''}
                                                                 </Badge>
                                                             );
                                                         }
                                                     )}
                                                 </div>
                                             </div>
+                                            {service.canonicalServices &&
+                                                service.canonicalServices
+                                                    .length > 0 && (
+                                                    <div
+                                                        className="flex items-center gap-1"
+                                                        title="Canonical services telemetry is reported under"
+                                                    >
+                                                        <Layers className="w-3 h-3 text-muted-foreground" />
+                                                        <div className="flex gap-1">
+                                                            {service.canonicalServices.map(
+                                                                (name) => (
+                                                                    <Badge
+                                                                        key={
+                                                                            name
+                                                                        }
+                                                                        variant="outline"
+                                                                        className="text-xs"
+                                                                    >
+                                                                        {name}
+                                                                    </Badge>
+                                                                )
+                                                            )}
+                                                        </div>
+                                                    </div>
+                                                )}
                                         </div>
                                     </div>
                                 </div>
@@ -243,6 +293,25 @@ export const ServiceDetailPage: React.FC = () => {
                                         </span>
                                     </div>
 
+                                    {zoneSpread.length > 0 && (
+                                        <div className="flex items-center gap-2">
+                                            <LocateFixed className="w-4 h-4 text-muted-foreground" />
+                                            <div className="flex gap-1">
+                                                {zoneSpread.map(
+                                                    ([zone, count]) => (
+                                                        <Badge
+                                                            key={zone}
+                                                            variant="outline"
+                                                            className="text-xs"
+                                                        >
+                                                            {zone}: {count}
+                                                        </Badge>
+                                                    )
+                                                )}
+                                            </div>
+                                        </div>
+                                    )}
+
                                     <div className="flex items-center gap-2">
                                         <Hexagon
                                             className={`w-4 h-4 ${serviceMeshEnabled ? 'text-purple-600' : 'text-muted-foreground'}`}
@@ -283,6 +352,7 @@ export const ServiceDetailPage: React.FC = () => {
                                         <TableHead>Pod</TableHead>
                                         <TableHead>Namespace</TableHead>
                                         <TableHead>Cluster</TableHead>
+                                        <TableHead>Zone</TableHead>
                                     </TableRow>
                                 </TableHeader>
                                 <TableBody>
@@ -322,10 +392,21 @@ export const ServiceDetailPage: React.FC = () => {
                                                     </span>
                                                 </TableCell>
                                                 <TableCell>
-                                                    <span className="font-mono text-sm">
-                                                        {instance.podName ||
-                                                            'N/A'}
-                                                    </span>
+                                                    <div className="flex items-center gap-2">
+                                                        <span className="font-mono text-sm">
+                                                            {instance.podName ||
+                                                                'N/A'}
+                                                        </span>
+                                                        {instance.lifecyclePhase ===
+                                                            v1alpha1PodLifecyclePhase.POD_LIFECYCLE_PHASE_TERMINATING && (
+                                                            <Badge
+                                                                variant="outline"
+                                                                className="text-orange-600 border-orange-300"
+                                                            >
+                                                                Terminating
+                                                            </Badge>
+                                                        )}
+                                                    </div>
                                                 </TableCell>
                                                 <TableCell>
                                                     <Badge variant="secondary">
@@ -337,6 +418,11 @@ export const ServiceDetailPage: React.FC = () => {
                                                         {instance.clusterName}
                                                     </Badge>
                                                 </TableCell>
+                                                <TableCell>
+                                                    <span className="text-sm">
+                                                        {instance.zone || '-'}
+                                                    </span>
+                                                </TableCell>
                                             </TableRow>
                                         )
                                     )}
@@ -348,10 +434,28 @@ export const ServiceDetailPage: React.FC = () => {
                                 <h3 className="text-lg font-semibold text-foreground mb-2">
                                     No instances available
                                 </h3>
-                                <p className="text-muted-foreground">
-                                    This service currently has no running
-                                    instances.
-                                </p>
+                                {externalNames.length > 0 ? (
+                                    <p className="text-muted-foreground">
+                                        This is an ExternalName service
+                                        resolving to{' '}
+                                        <span className="font-mono">
+                                            {externalNames
+                                                .map(([, name]) => name)
+                                                .filter(
+                                                    (name, i, names) =>
+                                                        names.indexOf(name) ===
+                                                        i
+                                                )
+                                                .join(', ')}
+                                        </span>
+                                        , so it has no instances of its own.
+                                    </p>
+                                ) : (
+                                    <p className="text-muted-foreground">
+                                        This service currently has no running
+                                        instances.
+                                    </p>
+                                )}
                             </div>
                         )}
                     </CardContent>
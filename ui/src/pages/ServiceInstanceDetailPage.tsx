@@ -32,6 +32,8 @@ import {
     AlertCircle,
     Sailboat,
     Container,
+    LocateFixed,
+    Layers,
 } from 'lucide-react';
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
 import { Badge } from '@/components/ui/badge';
@@ -68,7 +70,11 @@ import {
     ConfigActions,
 } from '../components/envoy';
 import { IstioResourcesView } from '../components/istio';
-import { v1alpha1ProxyMode } from '@/types/generated/openapi-service_registry/index';
+import {
+    v1alpha1OutboundTrafficPolicyMode,
+    v1alpha1PodLifecyclePhase,
+    v1alpha1ProxyMode,
+} from '@/types/generated/openapi-service_registry/index';
 
 const formatProxyMode = (proxyMode: v1alpha1ProxyMode | undefined): string => {
     switch (proxyMode) {
@@ -100,6 +106,48 @@ const getProxyModeVariant = (
     }
 };
 
+const formatOutboundTrafficPolicyMode = (
+    mode: v1alpha1OutboundTrafficPolicyMode | undefined
+): string => {
+    switch (mode) {
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY:
+            return 'Allow Any';
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY:
+            return 'Registry Only';
+        default:
+            return 'Unknown';
+    }
+};
+
+const getOutboundTrafficPolicyModeVariant = (
+    mode: v1alpha1OutboundTrafficPolicyMode | undefined
+): 'default' | 'secondary' | 'destructive' | 'outline' => {
+    switch (mode) {
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY:
+            return 'outline';
+        case v1alpha1OutboundTrafficPolicyMode.OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY:
+            return 'default';
+        default:
+            return 'secondary';
+    }
+};
+
+const getPodStatusColor = (
+    phase: v1alpha1PodLifecyclePhase | undefined,
+    podStatus: string | undefined
+): string => {
+    switch (phase) {
+        case v1alpha1PodLifecyclePhase.POD_LIFECYCLE_PHASE_TERMINATING:
+            return 'text-orange-500';
+        case v1alpha1PodLifecyclePhase.POD_LIFECYCLE_PHASE_FAILED:
+            return 'text-red-500';
+        default:
+            return podStatus === 'Running'
+                ? 'text-green-500'
+                : 'text-yellow-500';
+    }
+};
+
 export const ServiceInstanceDetailPage: React.FC = () => {
     const { serviceId, instanceId } = useParams<{
         serviceId: string;
@@ -208,6 +256,10 @@ export const ServiceInstanceDetailPage: React.FC = () => {
         }
     };
 
+    const isTerminating =
+        instance.lifecyclePhase ===
+        v1alpha1PodLifecyclePhase.POD_LIFECYCLE_PHASE_TERMINATING;
+
     return (
         <div className="min-h-screen bg-background">
             <Navbar />
@@ -278,6 +330,39 @@ export const ServiceInstanceDetailPage: React.FC = () => {
                                             {instance.nodeName}
                                         </Badge>
                                     </div>
+                                    {instance.locality?.zone && (
+                                        <div className="flex items-center gap-2">
+                                            <LocateFixed className="w-4 h-4 text-muted-foreground" />
+                                            <span className="text-muted-foreground">
+                                                Zone:
+                                            </span>
+                                            <Badge
+                                                variant="outline"
+                                                title={
+                                                    instance.zoneHints?.length
+                                                        ? `Hinted for ${instance.zoneHints.join(', ')}`
+                                                        : undefined
+                                                }
+                                            >
+                                                {instance.locality.region
+                                                    ? `${instance.locality.region}/${instance.locality.zone}`
+                                                    : instance.locality.zone}
+                                            </Badge>
+                                        </div>
+                                    )}
+                                    {instance.canonicalService && (
+                                        <div className="flex items-center gap-2">
+                                            <Layers className="w-4 h-4 text-muted-foreground" />
+                                            <span className="text-muted-foreground">
+                                                Canonical Service:
+                                            </span>
+                                            <Badge variant="outline">
+                                                {instance.canonicalRevision
+                                                    ? `${instance.canonicalService} (${instance.canonicalRevision})`
+                                                    : instance.canonicalService}
+                                            </Badge>
+                                        </div>
+                                    )}
                                 </div>
                             </div>
                             <Tooltip>
@@ -327,16 +412,19 @@ export const ServiceInstanceDetailPage: React.FC = () => {
                         <CardContent>
                             <div className="text-2xl font-bold flex items-center gap-2">
                                 <Circle
-                                    className={`w-3 h-3 fill-current ${
-                                        instance.podStatus === 'Running'
-                                            ? 'text-green-500'
-                                            : 'text-yellow-500'
-                                    }`}
+                                    className={`w-3 h-3 fill-current ${getPodStatusColor(
+                                        instance.lifecyclePhase,
+                                        instance.podStatus
+                                    )}`}
                                 />
-                                {instance.podStatus}
+                                {isTerminating
+                                    ? 'Terminating'
+                                    : instance.podStatus}
                             </div>
                             <p className="text-xs text-muted-foreground">
-                                Current pod status
+                                {isTerminating && instance.deletionTimestamp
+                                    ? `Deleted ${formatDate(instance.deletionTimestamp)}`
+                                    : 'Current pod status'}
                             </p>
                         </CardContent>
                     </Card>
@@ -576,7 +664,7 @@ export const ServiceInstanceDetailPage: React.FC = () => {
                                     </div>
                                 ) : proxyConfig ? (
                                     <div className="space-y-4">
-                                        <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+                                        <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
                                             <div>
                                                 <label className="text-sm font-medium text-muted-foreground">
                                                     Proxy Mode
@@ -610,6 +698,26 @@ export const ServiceInstanceDetailPage: React.FC = () => {
                                                     }
                                                 </div>
                                             </div>
+                                            <div>
+                                                <label className="text-sm font-medium text-muted-foreground">
+                                                    Outbound Traffic Policy
+                                                </label>
+                                                <div className="text-sm">
+                                                    <Badge
+                                                        variant={getOutboundTrafficPolicyModeVariant(
+                                                            proxyConfig
+                                                                .proxyConfig
+                                                                .outboundTrafficPolicyMode
+                                                        )}
+                                                    >
+                                                        {formatOutboundTrafficPolicyMode(
+                                                            proxyConfig
+                                                                .proxyConfig
+                                                                .outboundTrafficPolicyMode
+                                                        )}
+                                                    </Badge>
+                                                </div>
+                                            </div>
                                         </div>
 
                                         <Tabs
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+export { ApiError } from './core/ApiError';
+export { CancelablePromise, CancelError } from './core/CancelablePromise';
+export { OpenAPI } from './core/OpenAPI';
+export type { OpenAPIConfig } from './core/OpenAPI';
+
+export type { protobufAny } from './models/protobufAny';
+export type { rpcStatus } from './models/rpcStatus';
+export type { v1alpha1AccessLogEntry } from './models/v1alpha1AccessLogEntry';
+export type { v1alpha1ListAccessLogsResponse } from './models/v1alpha1ListAccessLogsResponse';
+
+export { AccessLogsServiceService } from './services/AccessLogsServiceService';
@@ -0,0 +1,54 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * AccessLogEntry is a single Envoy access log line of a service's proxy, as stored by a logs backend.
+ */
+export type v1alpha1AccessLogEntry = {
+    /**
+     * timestamp is when the log line was recorded.
+     */
+    timestamp?: string;
+    /**
+     * cluster is the cluster whose logs backend the entry was found in.
+     */
+    cluster?: string;
+    /**
+     * namespace is the namespace of the pod that logged the request.
+     */
+    namespace?: string;
+    /**
+     * pod_name is the name of the pod that logged the request.
+     */
+    podName?: string;
+    /**
+     * method is the HTTP method of the request.
+     */
+    method?: string;
+    /**
+     * path is the path of the request.
+     */
+    path?: string;
+    /**
+     * response_code is the HTTP response code, 0 if the request received no response.
+     */
+    responseCode?: number;
+    /**
+     * response_flags are Envoy's response flags, e.g. "UH" or "UF", or "-" if there are none.
+     */
+    responseFlags?: string;
+    /**
+     * duration is the total duration of the request.
+     */
+    duration?: string;
+    /**
+     * upstream_cluster is the Envoy cluster the request was sent to, e.g. "outbound|9080||reviews.default.svc.cluster.local".
+     */
+    upstreamCluster?: string;
+    /**
+     * line is the raw log line.
+     */
+    line?: string;
+};
+
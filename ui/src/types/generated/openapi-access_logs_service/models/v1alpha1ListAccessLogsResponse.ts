@@ -0,0 +1,23 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AccessLogEntry } from './v1alpha1AccessLogEntry';
+/**
+ * ListAccessLogsResponse contains the access logs of a service.
+ */
+export type v1alpha1ListAccessLogsResponse = {
+    /**
+     * entries are the access log entries found across all clusters, most recent first.
+     */
+    entries?: Array<v1alpha1AccessLogEntry>;
+    /**
+     * clusters_queried lists the clusters whose logs backends were searched.
+     */
+    clustersQueried?: Array<string>;
+    /**
+     * warnings describes clusters whose access logs could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
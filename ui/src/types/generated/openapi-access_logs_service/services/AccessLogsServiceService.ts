@@ -0,0 +1,55 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { rpcStatus } from '../models/rpcStatus';
+import type { v1alpha1ListAccessLogsResponse } from '../models/v1alpha1ListAccessLogsResponse';
+import type { CancelablePromise } from '../core/CancelablePromise';
+import { OpenAPI } from '../core/OpenAPI';
+import { request as __request } from '../core/request';
+export class AccessLogsServiceService {
+    /**
+     * ListAccessLogs returns the recent access logs of a service, or of one of its instances, across all clusters
+     * with a logs backend.
+     * @param serviceName service_name is the name of the service whose access logs to list (required).
+     * @param namespace namespace is the namespace of the service (required).
+     * @param instanceId instance_id limits the list to the access logs of a single service instance.
+     * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param startTime start_time is the start of the time window. Must be in the past.
+     * @param endTime end_time is the end of the time window. Must be in the past and after start_time.
+     * @param responseCodes response_codes limits the list to requests with these response codes. Each is a code such as "404"
+     * or a class such as "5xx".
+     * @param pathPrefix path_prefix limits the list to requests whose path starts with this prefix.
+     * @param limit limit is the most entries to return. Defaults to 100, at most 1000.
+     * @returns v1alpha1ListAccessLogsResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static accessLogsServiceListAccessLogs(
+        serviceName: string,
+        namespace?: string,
+        instanceId?: string,
+        startTime?: string,
+        endTime?: string,
+        responseCodes?: Array<string>,
+        pathPrefix?: string,
+        limit?: number,
+    ): CancelablePromise<v1alpha1ListAccessLogsResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/access-logs/service/{serviceName}',
+            path: {
+                'serviceName': serviceName,
+            },
+            query: {
+                'namespace': namespace,
+                'instanceId': instanceId,
+                'startTime': startTime,
+                'endTime': endTime,
+                'responseCodes': responseCodes,
+                'pathPrefix': pathPrefix,
+                'limit': limit,
+            },
+        });
+    }
+}
@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+export { ApiError } from './core/ApiError';
+export { CancelablePromise, CancelError } from './core/CancelablePromise';
+export { OpenAPI } from './core/OpenAPI';
+export type { OpenAPIConfig } from './core/OpenAPI';
+
+export type { AnalysisServiceDryRunAuthorizationPolicyBody } from './models/AnalysisServiceDryRunAuthorizationPolicyBody';
+export type { protobufAny } from './models/protobufAny';
+export type { rpcStatus } from './models/rpcStatus';
+export type { v1alpha1AnalysisFinding } from './models/v1alpha1AnalysisFinding';
+export { v1alpha1AnalysisSeverity } from './models/v1alpha1AnalysisSeverity';
+export type { v1alpha1AnalyzeClustersResponse } from './models/v1alpha1AnalyzeClustersResponse';
+export { v1alpha1AuthorizationVerdict } from './models/v1alpha1AuthorizationVerdict';
+export type { v1alpha1ClusterAnalysis } from './models/v1alpha1ClusterAnalysis';
+export type { v1alpha1DeniedFlow } from './models/v1alpha1DeniedFlow';
+export type { v1alpha1DryRunAuthorizationPolicyResponse } from './models/v1alpha1DryRunAuthorizationPolicyResponse';
+export type { v1alpha1ResourceRef } from './models/v1alpha1ResourceRef';
+
+export { AnalysisServiceService } from './services/AnalysisServiceService';
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * DryRunAuthorizationPolicyRequest specifies a drafted AuthorizationPolicy and the cluster to evaluate it in.
+ */
+export type AnalysisServiceDryRunAuthorizationPolicyBody = {
+    /**
+     * policy is the drafted AuthorizationPolicy manifest in YAML or JSON. It replaces any existing
+     * AuthorizationPolicy with the same name and namespace.
+     */
+    policy?: string;
+};
+
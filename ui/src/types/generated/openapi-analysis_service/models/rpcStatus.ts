@@ -0,0 +1,11 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { protobufAny } from './protobufAny';
+export type rpcStatus = {
+    code?: number;
+    message?: string;
+    details?: Array<protobufAny>;
+};
+
@@ -0,0 +1,32 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AnalysisSeverity } from './v1alpha1AnalysisSeverity';
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+/**
+ * AnalysisFinding is a problem found while analyzing a cluster's configuration.
+ */
+export type v1alpha1AnalysisFinding = {
+    /**
+     * code identifies the check that produced the finding, e.g. "GatewayNoWorkloads".
+     */
+    code?: string;
+    /**
+     * severity indicates how serious the finding is.
+     */
+    severity?: v1alpha1AnalysisSeverity;
+    /**
+     * resource is the resource the finding is about.
+     */
+    resource?: v1alpha1ResourceRef;
+    /**
+     * message describes the finding.
+     */
+    message?: string;
+    /**
+     * related are other resources involved in the finding.
+     */
+    related?: Array<v1alpha1ResourceRef>;
+};
+
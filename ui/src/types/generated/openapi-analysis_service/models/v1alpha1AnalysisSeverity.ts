@@ -0,0 +1,17 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * AnalysisSeverity indicates how serious an analysis finding is.
+ *
+ * - ANALYSIS_SEVERITY_INFO: The configuration is valid but may not behave as intended
+ * - ANALYSIS_SEVERITY_WARNING: The configuration has no effect
+ * - ANALYSIS_SEVERITY_ERROR: The configuration refers to something that does not exist
+ */
+export enum v1alpha1AnalysisSeverity {
+    ANALYSIS_SEVERITY_UNSPECIFIED = 'ANALYSIS_SEVERITY_UNSPECIFIED',
+    ANALYSIS_SEVERITY_INFO = 'ANALYSIS_SEVERITY_INFO',
+    ANALYSIS_SEVERITY_WARNING = 'ANALYSIS_SEVERITY_WARNING',
+    ANALYSIS_SEVERITY_ERROR = 'ANALYSIS_SEVERITY_ERROR',
+}
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ClusterAnalysis } from './v1alpha1ClusterAnalysis';
+/**
+ * AnalyzeClustersResponse contains the findings for each analyzed cluster.
+ */
+export type v1alpha1AnalyzeClustersResponse = {
+    /**
+     * clusters contains the analysis of each cluster, ordered by cluster ID.
+     */
+    clusters?: Array<v1alpha1ClusterAnalysis>;
+};
+
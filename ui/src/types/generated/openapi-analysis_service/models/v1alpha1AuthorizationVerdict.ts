@@ -0,0 +1,17 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * AuthorizationVerdict is the outcome of evaluating AuthorizationPolicies against a traffic flow.
+ *
+ * - AUTHORIZATION_VERDICT_ALLOWED: Every request in the flow is allowed
+ * - AUTHORIZATION_VERDICT_INDETERMINATE: Some requests may be denied, depending on attributes metrics do not record
+ * - AUTHORIZATION_VERDICT_DENIED: Every request in the flow is denied
+ */
+export enum v1alpha1AuthorizationVerdict {
+    AUTHORIZATION_VERDICT_UNSPECIFIED = 'AUTHORIZATION_VERDICT_UNSPECIFIED',
+    AUTHORIZATION_VERDICT_ALLOWED = 'AUTHORIZATION_VERDICT_ALLOWED',
+    AUTHORIZATION_VERDICT_INDETERMINATE = 'AUTHORIZATION_VERDICT_INDETERMINATE',
+    AUTHORIZATION_VERDICT_DENIED = 'AUTHORIZATION_VERDICT_DENIED',
+}
@@ -0,0 +1,23 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AnalysisFinding } from './v1alpha1AnalysisFinding';
+/**
+ * ClusterAnalysis contains the findings for a single cluster.
+ */
+export type v1alpha1ClusterAnalysis = {
+    /**
+     * cluster_id is the analyzed cluster.
+     */
+    clusterId?: string;
+    /**
+     * findings are the problems found in the cluster's configuration.
+     */
+    findings?: Array<v1alpha1AnalysisFinding>;
+    /**
+     * warnings describe services whose traffic could not be retrieved in time and was not checked.
+     */
+    warnings?: Array<string>;
+};
+
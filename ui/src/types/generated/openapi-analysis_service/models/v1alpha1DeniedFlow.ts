@@ -0,0 +1,49 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AuthorizationVerdict } from './v1alpha1AuthorizationVerdict';
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+/**
+ * DeniedFlow is an observed traffic flow that a drafted AuthorizationPolicy would deny.
+ */
+export type v1alpha1DeniedFlow = {
+    /**
+     * source_cluster is the cluster name of the source service.
+     */
+    sourceCluster?: string;
+    /**
+     * source_namespace is the namespace of the source service.
+     */
+    sourceNamespace?: string;
+    /**
+     * source_service is the service name of the source service.
+     */
+    sourceService?: string;
+    /**
+     * destination_namespace is the namespace of the destination service.
+     */
+    destinationNamespace?: string;
+    /**
+     * destination_service is the service name of the destination service.
+     */
+    destinationService?: string;
+    /**
+     * request_rate is the observed request rate of the flow in requests per second.
+     */
+    requestRate?: number;
+    /**
+     * verdict is DENIED if every request would be denied, or INDETERMINATE if requests would be denied
+     * depending on attributes such as paths, methods or principals.
+     */
+    verdict?: v1alpha1AuthorizationVerdict;
+    /**
+     * reason explains which policy decides the verdict.
+     */
+    reason?: string;
+    /**
+     * workloads are the destination pods that would deny the flow.
+     */
+    workloads?: Array<v1alpha1ResourceRef>;
+};
+
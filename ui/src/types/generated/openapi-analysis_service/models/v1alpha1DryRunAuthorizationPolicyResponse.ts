@@ -0,0 +1,32 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1DeniedFlow } from './v1alpha1DeniedFlow';
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+/**
+ * DryRunAuthorizationPolicyResponse contains the observed traffic flows the drafted policy would deny.
+ */
+export type v1alpha1DryRunAuthorizationPolicyResponse = {
+    /**
+     * policy is the drafted AuthorizationPolicy.
+     */
+    policy?: v1alpha1ResourceRef;
+    /**
+     * workloads are the pods the drafted policy applies to.
+     */
+    workloads?: Array<v1alpha1ResourceRef>;
+    /**
+     * flows_evaluated is the number of observed flows to those workloads' services.
+     */
+    flowsEvaluated?: number;
+    /**
+     * denied_flows are the flows that are allowed today but would be denied, ordered by source and destination.
+     */
+    deniedFlows?: Array<v1alpha1DeniedFlow>;
+    /**
+     * warnings describe services whose traffic could not be retrieved and was not evaluated.
+     */
+    warnings?: Array<string>;
+};
+
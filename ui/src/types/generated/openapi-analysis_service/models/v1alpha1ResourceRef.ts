@@ -0,0 +1,27 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ResourceRef identifies a resource in a cluster's resource reference graph.
+ */
+export type v1alpha1ResourceRef = {
+    /**
+     * cluster_id is the cluster the resource was collected from.
+     */
+    clusterId?: string;
+    /**
+     * kind is the Kubernetes kind of the resource: an Istio kind such as "VirtualService" or "Gateway",
+     * "Service", "Pod" for workloads, or "Namespace". Namespaces have an empty namespace.
+     */
+    kind?: string;
+    /**
+     * namespace is the namespace of the resource.
+     */
+    namespace?: string;
+    /**
+     * name is the name of the resource.
+     */
+    name?: string;
+};
+
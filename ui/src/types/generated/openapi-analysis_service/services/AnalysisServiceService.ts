@@ -0,0 +1,52 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { AnalysisServiceDryRunAuthorizationPolicyBody } from '../models/AnalysisServiceDryRunAuthorizationPolicyBody';
+import type { rpcStatus } from '../models/rpcStatus';
+import type { v1alpha1AnalyzeClustersResponse } from '../models/v1alpha1AnalyzeClustersResponse';
+import type { v1alpha1DryRunAuthorizationPolicyResponse } from '../models/v1alpha1DryRunAuthorizationPolicyResponse';
+import type { CancelablePromise } from '../core/CancelablePromise';
+import { OpenAPI } from '../core/OpenAPI';
+import { request as __request } from '../core/request';
+export class AnalysisServiceService {
+    /**
+     * AnalyzeClusters analyzes the configuration collected from connected clusters and reports findings per cluster.
+     * @param clusterId cluster_id limits the analysis to a single cluster. All connected clusters are analyzed if omitted.
+     * @returns v1alpha1AnalyzeClustersResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static analysisServiceAnalyzeClusters(
+        clusterId?: string,
+    ): CancelablePromise<v1alpha1AnalyzeClustersResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/analysis',
+            query: {
+                'clusterId': clusterId,
+            },
+        });
+    }
+    /**
+     * DryRunAuthorizationPolicy reports which traffic flows observed by mesh metrics a drafted AuthorizationPolicy would deny.
+     * @param clusterId cluster_id is the cluster the policy would be applied to.
+     * @param body
+     * @returns v1alpha1DryRunAuthorizationPolicyResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static analysisServiceDryRunAuthorizationPolicy(
+        clusterId: string,
+        body: AnalysisServiceDryRunAuthorizationPolicyBody,
+    ): CancelablePromise<v1alpha1DryRunAuthorizationPolicyResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'POST',
+            url: '/api/v1alpha1/analysis/{clusterId}/authorization-policy-dry-run',
+            path: {
+                'clusterId': clusterId,
+            },
+            body: body,
+        });
+    }
+}
@@ -9,8 +9,29 @@ export type { OpenAPIConfig } from './core/OpenAPI';
 
 export type { protobufAny } from './models/protobufAny';
 export type { rpcStatus } from './models/rpcStatus';
+export { v1alpha1AuthMode } from './models/v1alpha1AuthMode';
+export type { v1alpha1CapabilityGap } from './models/v1alpha1CapabilityGap';
+export type { v1alpha1Change } from './models/v1alpha1Change';
+export { v1alpha1ChangeType } from './models/v1alpha1ChangeType';
 export type { v1alpha1ClusterSyncInfo } from './models/v1alpha1ClusterSyncInfo';
+export type { v1alpha1ClusterSyncMetadata } from './models/v1alpha1ClusterSyncMetadata';
+export type { v1alpha1Event } from './models/v1alpha1Event';
+export { v1alpha1EventType } from './models/v1alpha1EventType';
+export type { v1alpha1GetCapabilitiesResponse } from './models/v1alpha1GetCapabilitiesResponse';
+export type { v1alpha1GetSyncStatusResponse } from './models/v1alpha1GetSyncStatusResponse';
+export type { v1alpha1InjectionTemplate } from './models/v1alpha1InjectionTemplate';
+export type { v1alpha1InjectionWebhook } from './models/v1alpha1InjectionWebhook';
+export type { v1alpha1IstioCNINode } from './models/v1alpha1IstioCNINode';
+export type { v1alpha1IstioCNIStatus } from './models/v1alpha1IstioCNIStatus';
+export type { v1alpha1ListChangesResponse } from './models/v1alpha1ListChangesResponse';
 export type { v1alpha1ListClustersResponse } from './models/v1alpha1ListClustersResponse';
+export type { v1alpha1ListEventsResponse } from './models/v1alpha1ListEventsResponse';
+export type { v1alpha1NamespaceInjection } from './models/v1alpha1NamespaceInjection';
+export { v1alpha1OutboundTrafficPolicyMode } from './models/v1alpha1OutboundTrafficPolicyMode';
+export type { v1alpha1ProxyResources } from './models/v1alpha1ProxyResources';
+export type { v1alpha1ResyncClusterResponse } from './models/v1alpha1ResyncClusterResponse';
+export type { v1alpha1SidecarInjectionStatus } from './models/v1alpha1SidecarInjectionStatus';
+export type { v1alpha1SidecarInjectorConfig } from './models/v1alpha1SidecarInjectorConfig';
 export { v1alpha1SyncStatus } from './models/v1alpha1SyncStatus';
 
 export { ClusterRegistryServiceService } from './services/ClusterRegistryServiceService';
@@ -0,0 +1,16 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * AuthMode is how the manager scopes requests to their callers.
+ *
+ * - AUTH_MODE_NONE: Requests are not authenticated and may access every cluster.
+ * - AUTH_MODE_TENANT: Requests are authenticated by a proxy in front of the manager and scoped to the clusters of the
+ * caller's tenants.
+ */
+export enum v1alpha1AuthMode {
+    AUTH_MODE_UNSPECIFIED = 'AUTH_MODE_UNSPECIFIED',
+    AUTH_MODE_NONE = 'AUTH_MODE_NONE',
+    AUTH_MODE_TENANT = 'AUTH_MODE_TENANT',
+}
@@ -0,0 +1,24 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * CapabilityGap is a feature the manager supports that an edge of a cluster does not, typically because the
+ * edge runs an older version.
+ */
+export type v1alpha1CapabilityGap = {
+    /**
+     * capability is the missing feature: "protocol", "api_version", "resource_type", "request", "delta_sync",
+     * "compression", "chunked_cluster_state", "streamed_cluster_state" or "capabilities_update".
+     */
+    capability?: string;
+    /**
+     * value identifies what of the capability is missing, e.g. the resource type or compression algorithm.
+     */
+    value?: string;
+    /**
+     * message explains the consequence of the gap.
+     */
+    message?: string;
+};
+
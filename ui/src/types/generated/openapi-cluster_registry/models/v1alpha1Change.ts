@@ -0,0 +1,67 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ChangeType } from './v1alpha1ChangeType';
+/**
+ * Change is a change to a cluster's configuration or topology, observed by comparing consecutive syncs of
+ * the cluster's state, or reported by edges that track the versions of Istio resources.
+ */
+export type v1alpha1Change = {
+    /**
+     * cluster_id is the cluster the change was observed in.
+     */
+    clusterId?: string;
+    /**
+     * observed_at is when the edge collected the change, or when the manager received the sync that
+     * contained it for changes the manager observed.
+     */
+    observedAt?: string;
+    /**
+     * type is whether the resource was created, updated or deleted.
+     */
+    type?: v1alpha1ChangeType;
+    /**
+     * kind is the kind of the changed resource: an Istio resource kind (e.g., "VirtualService"), "Service",
+     * or "ControlPlane" for the cluster's Istio control plane.
+     */
+    kind?: string;
+    /**
+     * namespace is the namespace of the changed resource, empty for the control plane.
+     */
+    namespace?: string;
+    /**
+     * name is the name of the changed resource.
+     */
+    name?: string;
+    /**
+     * description summarizes the change for control plane changes (e.g., "version 1.25.2 -> 1.26.0").
+     */
+    description?: string;
+    /**
+     * actor is the field manager that made the change (e.g., "kubectl-client-side-apply"), when the edge
+     * tracks Istio resource versions. Empty if unknown, or if the resource was deleted.
+     */
+    actor?: string;
+    /**
+     * resource_version is the resourceVersion of the Istio resource after the change, or before it if it
+     * was deleted. Empty unless the edge tracks Istio resource versions.
+     */
+    resourceVersion?: string;
+    /**
+     * generation is the metadata.generation of the Istio resource after the change, or before it if it was
+     * deleted. Zero unless the edge tracks Istio resource versions.
+     */
+    generation?: string;
+    /**
+     * old_hash is the SHA-256 of the Istio resource's raw config before the change, empty if it was created
+     * or the edge does not track Istio resource versions.
+     */
+    oldHash?: string;
+    /**
+     * new_hash is the SHA-256 of the Istio resource's raw config after the change, empty if it was deleted
+     * or the edge does not track Istio resource versions.
+     */
+    newHash?: string;
+};
+
@@ -0,0 +1,13 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ChangeType is how a resource changed.
+ */
+export enum v1alpha1ChangeType {
+    CHANGE_TYPE_UNSPECIFIED = 'CHANGE_TYPE_UNSPECIFIED',
+    CHANGE_TYPE_CREATED = 'CHANGE_TYPE_CREATED',
+    CHANGE_TYPE_UPDATED = 'CHANGE_TYPE_UPDATED',
+    CHANGE_TYPE_DELETED = 'CHANGE_TYPE_DELETED',
+}
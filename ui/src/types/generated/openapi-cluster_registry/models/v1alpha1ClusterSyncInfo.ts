@@ -2,6 +2,12 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1CapabilityGap } from './v1alpha1CapabilityGap';
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
+import type { v1alpha1IstioCNIStatus } from './v1alpha1IstioCNIStatus';
+import type { v1alpha1OutboundTrafficPolicyMode } from './v1alpha1OutboundTrafficPolicyMode';
+import type { v1alpha1SidecarInjectionStatus } from './v1alpha1SidecarInjectionStatus';
+import type { v1alpha1SidecarInjectorConfig } from './v1alpha1SidecarInjectorConfig';
 import type { v1alpha1SyncStatus } from './v1alpha1SyncStatus';
 /**
  * ClusterSyncInfo contains synchronization status and metadata for a connected cluster.
@@ -31,5 +37,34 @@ export type v1alpha1ClusterSyncInfo = {
      * metrics_enabled indicates whether this cluster's edge supports metrics collection.
      */
     metricsEnabled?: boolean;
+    /**
+     * sync_metadata describes the most recent state sync from this cluster.
+     */
+    syncMetadata?: v1alpha1ClusterSyncMetadata;
+    /**
+     * istio_cni describes the cluster's Istio CNI node agent and whether its pods still rely on istio-init.
+     * Unset until the cluster's edge reports it.
+     */
+    istioCni?: v1alpha1IstioCNIStatus;
+    /**
+     * sidecar_injector is the sidecar injection configuration of the cluster's active control plane, so
+     * differences in injection defaults between clusters are visible. Unset until the cluster's edge reports it.
+     */
+    sidecarInjector?: v1alpha1SidecarInjectorConfig;
+    /**
+     * sidecar_injection lists the cluster's sidecar injection webhooks and explains which revision injects
+     * each namespace. Unset until the cluster's edge reports it.
+     */
+    sidecarInjection?: v1alpha1SidecarInjectionStatus;
+    /**
+     * capability_gaps lists the features the manager supports that the cluster's edges do not, such as
+     * resource types an older edge does not collect. Empty when the edges support everything the manager does.
+     */
+    capabilityGaps?: Array<v1alpha1CapabilityGap>;
+    /**
+     * outbound_traffic_policy_mode is the mesh config outboundTrafficPolicy mode of the cluster's active control
+     * plane. Unspecified until the cluster's edge reports it.
+     */
+    outboundTrafficPolicyMode?: v1alpha1OutboundTrafficPolicyMode;
 };
 
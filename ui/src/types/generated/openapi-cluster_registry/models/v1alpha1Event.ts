@@ -0,0 +1,37 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1Change } from './v1alpha1Change';
+import type { v1alpha1EventType } from './v1alpha1EventType';
+/**
+ * Event is an entry of the manager's event log.
+ */
+export type v1alpha1Event = {
+    /**
+     * time is when the manager recorded the event.
+     */
+    time?: string;
+    /**
+     * type is what happened.
+     */
+    type?: v1alpha1EventType;
+    /**
+     * cluster_id is the cluster the event belongs to.
+     */
+    clusterId?: string;
+    /**
+     * connection_id identifies the edge connection of connect, disconnect and sync events, which includes
+     * the namespace shard when the cluster is split between several edges.
+     */
+    connectionId?: string;
+    /**
+     * message describes the event (e.g., "synced 42 services").
+     */
+    message?: string;
+    /**
+     * change is the change of change events.
+     */
+    change?: v1alpha1Change;
+};
+
@@ -0,0 +1,23 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * EventType is what happened in an event.
+ *
+ * - EVENT_TYPE_CONNECTED: An edge connected to the manager.
+ * - EVENT_TYPE_DISCONNECTED: An edge disconnected from the manager.
+ * - EVENT_TYPE_SYNCED: An edge synced its cluster state.
+ * - EVENT_TYPE_RESYNC_REQUESTED: A resync of the cluster was requested from its edges.
+ * - EVENT_TYPE_CHANGED: A change to the cluster's configuration or topology was observed.
+ * - EVENT_TYPE_EVICTED: The cluster was evicted from aggregation.
+ */
+export enum v1alpha1EventType {
+    EVENT_TYPE_UNSPECIFIED = 'EVENT_TYPE_UNSPECIFIED',
+    EVENT_TYPE_CONNECTED = 'EVENT_TYPE_CONNECTED',
+    EVENT_TYPE_DISCONNECTED = 'EVENT_TYPE_DISCONNECTED',
+    EVENT_TYPE_SYNCED = 'EVENT_TYPE_SYNCED',
+    EVENT_TYPE_RESYNC_REQUESTED = 'EVENT_TYPE_RESYNC_REQUESTED',
+    EVENT_TYPE_CHANGED = 'EVENT_TYPE_CHANGED',
+    EVENT_TYPE_EVICTED = 'EVENT_TYPE_EVICTED',
+}
@@ -0,0 +1,41 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AuthMode } from './v1alpha1AuthMode';
+/**
+ * GetCapabilitiesResponse reports which features are available across the connected clusters the caller may
+ * access. A feature is available if at least one connected cluster supports it.
+ */
+export type v1alpha1GetCapabilitiesResponse = {
+    /**
+     * metrics indicates whether a connected cluster's edge has a metrics provider configured.
+     */
+    metrics?: boolean;
+    /**
+     * traces indicates whether a connected cluster's edge has a tracing backend configured.
+     */
+    traces?: boolean;
+    /**
+     * access_logs indicates whether a connected cluster's edge has a logs backend configured for access logs.
+     */
+    accessLogs?: boolean;
+    /**
+     * pod_logs indicates whether a connected cluster's edge handles pod log requests.
+     */
+    podLogs?: boolean;
+    /**
+     * ambient indicates whether Istio ambient mode (ztunnel and waypoints) is supported. Navigator only
+     * models sidecar proxies, so this is always false for now.
+     */
+    ambient?: boolean;
+    /**
+     * auth_mode is how the manager scopes requests to the caller.
+     */
+    authMode?: v1alpha1AuthMode;
+    /**
+     * tenants are the names of the caller's tenants, empty unless auth_mode is AUTH_MODE_TENANT.
+     */
+    tenants?: Array<string>;
+};
+
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ClusterSyncInfo } from './v1alpha1ClusterSyncInfo';
+/**
+ * GetSyncStatusResponse contains sync information for a single cluster.
+ */
+export type v1alpha1GetSyncStatusResponse = {
+    /**
+     * cluster contains the cluster's sync status and metadata.
+     */
+    cluster?: v1alpha1ClusterSyncInfo;
+};
+
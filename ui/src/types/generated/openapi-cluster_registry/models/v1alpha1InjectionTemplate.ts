@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * InjectionTemplate is a sidecar injection template.
+ */
+export type v1alpha1InjectionTemplate = {
+    /**
+     * name is the name pods select the template by (e.g., "sidecar").
+     */
+    name?: string;
+    /**
+     * custom indicates whether the template is not one Istio ships, such as sidecar or gateway.
+     */
+    custom?: boolean;
+    /**
+     * checksum is the SHA-256 of the template, so templates can be compared between clusters.
+     */
+    checksum?: string;
+};
+
@@ -0,0 +1,45 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * InjectionWebhook is one sidecar injection webhook of a MutatingWebhookConfiguration.
+ */
+export type v1alpha1InjectionWebhook = {
+    /**
+     * configuration is the name of the MutatingWebhookConfiguration.
+     */
+    configuration?: string;
+    /**
+     * name is the name of the webhook (e.g., "rev.namespace.sidecar-injector.istio.io").
+     */
+    name?: string;
+    /**
+     * revision is the control plane revision the webhook calls, from the configuration's istio.io/rev label.
+     */
+    revision?: string;
+    /**
+     * tag is the revision tag the configuration implements, from its istio.io/tag label. Empty for the
+     * webhooks installed with a revision.
+     */
+    tag?: string;
+    /**
+     * namespace_selector is the webhook's namespace selector in label selector syntax. Empty selects every
+     * namespace.
+     */
+    namespaceSelector?: string;
+    /**
+     * object_selector is the webhook's object selector in label selector syntax. Empty selects every pod.
+     */
+    objectSelector?: string;
+    /**
+     * service is the istiod service the webhook calls as "name.namespace", or its URL.
+     */
+    service?: string;
+    /**
+     * opt_in indicates whether the object selector requires pod labels, so the webhook only injects pods
+     * that opt in rather than every pod of the namespaces it selects.
+     */
+    optIn?: boolean;
+};
+
@@ -0,0 +1,30 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * IstioCNINode is the CNI agent running on one node.
+ */
+export type v1alpha1IstioCNINode = {
+    /**
+     * node_name is the name of the node.
+     */
+    nodeName?: string;
+    /**
+     * pod_name is the name of the CNI agent pod on the node.
+     */
+    podName?: string;
+    /**
+     * ready indicates whether the CNI agent pod is ready.
+     */
+    ready?: boolean;
+    /**
+     * restart_count is the number of times the CNI agent container has restarted.
+     */
+    restartCount?: number;
+    /**
+     * version is the image tag the CNI agent pod runs, which differs from the DaemonSet's during a rollout.
+     */
+    version?: string;
+};
+
@@ -0,0 +1,58 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1IstioCNINode } from './v1alpha1IstioCNINode';
+/**
+ * IstioCNIStatus describes the Istio CNI node agent of a cluster and how its sidecar pods set up traffic
+ * redirection. A CNI agent that is not ready on a node leaves new pods on that node without redirection.
+ */
+export type v1alpha1IstioCNIStatus = {
+    /**
+     * installed indicates whether an istio-cni DaemonSet was found in the cluster.
+     */
+    installed?: boolean;
+    /**
+     * namespace is the namespace of the istio-cni DaemonSet.
+     */
+    namespace?: string;
+    /**
+     * name is the name of the istio-cni DaemonSet.
+     */
+    name?: string;
+    /**
+     * version is the image tag of the CNI agent (e.g., "1.26.2").
+     */
+    version?: string;
+    /**
+     * desired_nodes is the number of nodes that should run the CNI agent.
+     */
+    desiredNodes?: number;
+    /**
+     * ready_nodes is the number of nodes whose CNI agent is ready.
+     */
+    readyNodes?: number;
+    /**
+     * updated_nodes is the number of nodes running the current DaemonSet template. It is lower than
+     * desired_nodes while a rollout is in progress.
+     */
+    updatedNodes?: number;
+    /**
+     * nodes is the readiness of the CNI agent on each node it runs on, sorted by node name.
+     */
+    nodes?: Array<v1alpha1IstioCNINode>;
+    /**
+     * istio_init_pods is the number of pods that set up traffic redirection with the istio-init init container.
+     */
+    istioInitPods?: number;
+    /**
+     * cni_pods is the number of pods whose traffic redirection is set up by the CNI agent, which run the
+     * istio-validation init container instead of istio-init.
+     */
+    cniPods?: number;
+    /**
+     * relies_on_istio_init indicates whether any pods still set up traffic redirection with istio-init.
+     */
+    reliesOnIstioInit?: boolean;
+};
+
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1Change } from './v1alpha1Change';
+/**
+ * ListChangesResponse contains the matching changes, newest first.
+ */
+export type v1alpha1ListChangesResponse = {
+    /**
+     * changes are the changes observed in the clusters, newest first.
+     */
+    changes?: Array<v1alpha1Change>;
+};
+
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1Event } from './v1alpha1Event';
+/**
+ * ListEventsResponse contains the matching events, newest first.
+ */
+export type v1alpha1ListEventsResponse = {
+    /**
+     * events are the events of the clusters, newest first.
+     */
+    events?: Array<v1alpha1Event>;
+};
+
@@ -0,0 +1,40 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * NamespaceInjection explains which control plane revision injects the pods of a namespace.
+ */
+export type v1alpha1NamespaceInjection = {
+    /**
+     * namespace is the name of the namespace.
+     */
+    namespace?: string;
+    /**
+     * labels are the namespace's injection labels (istio-injection and istio.io/rev).
+     */
+    labels?: Record<string, string>;
+    /**
+     * revision is the revision injecting every pod of the namespace. Empty when no webhook injects the
+     * namespace's pods without them opting in.
+     */
+    revision?: string;
+    /**
+     * webhooks are the webhooks whose namespace selector matches the namespace, as "configuration/name".
+     */
+    webhooks?: Array<string>;
+    /**
+     * opt_in_revisions are the revisions that only inject the namespace's pods labelled to opt in.
+     */
+    optInRevisions?: Array<string>;
+    /**
+     * conflict indicates whether webhooks of several revisions inject every pod of the namespace, so pods
+     * are injected more than once.
+     */
+    conflict?: boolean;
+    /**
+     * explanation describes in a sentence why the namespace is injected by its revision, or not at all.
+     */
+    explanation?: string;
+};
+
@@ -0,0 +1,27 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ProxyResources are the resource requests and limits of a proxy container, as Kubernetes quantities.
+ * Empty fields are not set.
+ */
+export type v1alpha1ProxyResources = {
+    /**
+     * cpu_request is the requested CPU (e.g., "100m").
+     */
+    cpuRequest?: string;
+    /**
+     * memory_request is the requested memory (e.g., "128Mi").
+     */
+    memoryRequest?: string;
+    /**
+     * cpu_limit is the CPU limit (e.g., "2000m").
+     */
+    cpuLimit?: string;
+    /**
+     * memory_limit is the memory limit (e.g., "1024Mi").
+     */
+    memoryLimit?: string;
+};
+
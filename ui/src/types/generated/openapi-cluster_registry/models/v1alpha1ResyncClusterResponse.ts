@@ -0,0 +1,8 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ResyncClusterResponse is returned once the resync request has been sent to the edge.
+ */
+export type v1alpha1ResyncClusterResponse = Record<string, any>;
@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1InjectionWebhook } from './v1alpha1InjectionWebhook';
+import type { v1alpha1NamespaceInjection } from './v1alpha1NamespaceInjection';
+/**
+ * SidecarInjectionStatus describes the sidecar injection webhooks of a cluster and which control plane
+ * revision injects the pods of each namespace.
+ */
+export type v1alpha1SidecarInjectionStatus = {
+    /**
+     * webhooks are the sidecar injection webhooks of the cluster's MutatingWebhookConfigurations, sorted by
+     * configuration and webhook name.
+     */
+    webhooks?: Array<v1alpha1InjectionWebhook>;
+    /**
+     * namespaces is the injection of each collected namespace, sorted by namespace.
+     */
+    namespaces?: Array<v1alpha1NamespaceInjection>;
+};
+
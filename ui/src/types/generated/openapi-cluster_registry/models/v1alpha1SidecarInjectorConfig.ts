@@ -0,0 +1,42 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1InjectionTemplate } from './v1alpha1InjectionTemplate';
+import type { v1alpha1ProxyResources } from './v1alpha1ProxyResources';
+/**
+ * SidecarInjectorConfig is the sidecar injection configuration of a control plane revision, read from the
+ * config and values of its istio-sidecar-injector ConfigMap.
+ */
+export type v1alpha1SidecarInjectorConfig = {
+    /**
+     * config_map is the name of the ConfigMap in the control plane namespace (e.g., "istio-sidecar-injector-canary").
+     */
+    configMap?: string;
+    /**
+     * policy is the default injection policy, "enabled" or "disabled".
+     */
+    policy?: string;
+    /**
+     * default_templates are the templates injected into pods that do not select templates with the
+     * inject.istio.io/templates annotation.
+     */
+    defaultTemplates?: Array<string>;
+    /**
+     * templates are the injection templates of the ConfigMap, sorted by name.
+     */
+    templates?: Array<v1alpha1InjectionTemplate>;
+    /**
+     * proxy_image is the proxy image the templates inject (e.g., "docker.io/istio/proxyv2:1.26.2").
+     */
+    proxyImage?: string;
+    /**
+     * version is the version of the injected proxy, the image tag from the values (e.g., "1.26.2").
+     */
+    version?: string;
+    /**
+     * proxy_resources are the default resource requests and limits of the injected proxy container.
+     */
+    proxyResources?: v1alpha1ProxyResources;
+};
+
@@ -3,11 +3,58 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { rpcStatus } from '../models/rpcStatus';
+import type { v1alpha1GetCapabilitiesResponse } from '../models/v1alpha1GetCapabilitiesResponse';
+import type { v1alpha1GetSyncStatusResponse } from '../models/v1alpha1GetSyncStatusResponse';
+import type { v1alpha1ListChangesResponse } from '../models/v1alpha1ListChangesResponse';
 import type { v1alpha1ListClustersResponse } from '../models/v1alpha1ListClustersResponse';
+import type { v1alpha1ListEventsResponse } from '../models/v1alpha1ListEventsResponse';
+import type { v1alpha1ResyncClusterResponse } from '../models/v1alpha1ResyncClusterResponse';
 import type { CancelablePromise } from '../core/CancelablePromise';
 import { OpenAPI } from '../core/OpenAPI';
 import { request as __request } from '../core/request';
 export class ClusterRegistryServiceService {
+    /**
+     * GetCapabilities reports the features available to the caller across its connected clusters, so clients
+     * can hide the views of features that are not configured rather than showing their errors.
+     * @returns v1alpha1GetCapabilitiesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static clusterRegistryServiceGetCapabilities(): CancelablePromise<v1alpha1GetCapabilitiesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/capabilities',
+        });
+    }
+    /**
+     * ListChanges returns the recent configuration and topology changes observed in the connected clusters,
+     * newest first, so the first question of an incident ("what changed?") can be answered.
+     * @param clusterId cluster_id limits the list to the changes of a single cluster.
+     * @param since since limits the list to changes observed after this time.
+     * @param limit limit is the most changes to return. Defaults to 100, at most 1000.
+     * @param clusterSelector cluster_selector filters changes to only those of clusters whose labels match it, using Kubernetes
+     * label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+     * @returns v1alpha1ListChangesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static clusterRegistryServiceListChanges(
+        clusterId?: string,
+        since?: string,
+        limit?: number,
+        clusterSelector?: string,
+    ): CancelablePromise<v1alpha1ListChangesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/changes',
+            query: {
+                'clusterId': clusterId,
+                'since': since,
+                'limit': limit,
+                'clusterSelector': clusterSelector,
+            },
+        });
+    }
     /**
      * ListClusters returns sync state information for all connected clusters.
      * @returns v1alpha1ListClustersResponse A successful response.
@@ -20,4 +67,73 @@ export class ClusterRegistryServiceService {
             url: '/api/v1alpha1/clusters',
         });
     }
+    /**
+     * GetSyncStatus returns sync state information for a single connected cluster.
+     * @param clusterId cluster_id is the cluster to retrieve sync information for.
+     * @returns v1alpha1GetSyncStatusResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static clusterRegistryServiceGetSyncStatus(
+        clusterId: string,
+    ): CancelablePromise<v1alpha1GetSyncStatusResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/clusters/{clusterId}/sync-status',
+            path: {
+                'clusterId': clusterId,
+            },
+        });
+    }
+    /**
+     * ResyncCluster asks the edge managing a cluster to sync its state immediately
+     * rather than waiting for the next sync interval.
+     * @param clusterId cluster_id is the cluster whose state should be resynced.
+     * @returns v1alpha1ResyncClusterResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static clusterRegistryServiceResyncCluster(
+        clusterId: string,
+    ): CancelablePromise<v1alpha1ResyncClusterResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'POST',
+            url: '/api/v1alpha1/clusters/{clusterId}/resync',
+            path: {
+                'clusterId': clusterId,
+            },
+        });
+    }
+    /**
+     * ListEvents returns the manager's event log, newest first: syncs, edge connects and disconnects, resync
+     * requests, changes and evictions of the connected clusters.
+     * @param clusterId cluster_id limits the list to the events of a single cluster.
+     * @param startTime start_time limits the list to events at or after this time.
+     * @param endTime end_time limits the list to events at or before this time.
+     * @param limit limit is the most events to return. Defaults to 100, at most 1000.
+     * @param clusterSelector cluster_selector filters events to only those of clusters whose labels match it, using Kubernetes
+     * label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+     * @returns v1alpha1ListEventsResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static clusterRegistryServiceListEvents(
+        clusterId?: string,
+        startTime?: string,
+        endTime?: string,
+        limit?: number,
+        clusterSelector?: string,
+    ): CancelablePromise<v1alpha1ListEventsResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/events',
+            query: {
+                'clusterId': clusterId,
+                'startTime': startTime,
+                'endTime': endTime,
+                'limit': limit,
+                'clusterSelector': clusterSelector,
+            },
+        });
+    }
 }
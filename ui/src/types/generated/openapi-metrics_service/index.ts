@@ -10,10 +10,36 @@ export type { OpenAPIConfig } from './core/OpenAPI';
 export type { protobufAny } from './models/protobufAny';
 export type { rpcStatus } from './models/rpcStatus';
 export type { v1alpha1AggregatedServicePairMetrics } from './models/v1alpha1AggregatedServicePairMetrics';
+export type { v1alpha1AuthorizationPolicy } from './models/v1alpha1AuthorizationPolicy';
 export type { v1alpha1ClusterPairInfo } from './models/v1alpha1ClusterPairInfo';
+export type { v1alpha1CompareServiceResponse } from './models/v1alpha1CompareServiceResponse';
+export type { v1alpha1DestinationRule } from './models/v1alpha1DestinationRule';
+export type { v1alpha1DestinationRuleSubset } from './models/v1alpha1DestinationRuleSubset';
+export type { v1alpha1ExplainPathResponse } from './models/v1alpha1ExplainPathResponse';
+export type { v1alpha1Gateway } from './models/v1alpha1Gateway';
+export type { v1alpha1GetMeshMetricsTimeSeriesRequest } from './models/v1alpha1GetMeshMetricsTimeSeriesRequest';
+export type { v1alpha1GetMeshMetricsTimeSeriesResponse } from './models/v1alpha1GetMeshMetricsTimeSeriesResponse';
+export type { v1alpha1GetPairInstanceMetricsResponse } from './models/v1alpha1GetPairInstanceMetricsResponse';
 export type { v1alpha1GetServiceConnectionsResponse } from './models/v1alpha1GetServiceConnectionsResponse';
 export type { v1alpha1HistogramBucket } from './models/v1alpha1HistogramBucket';
+export type { v1alpha1InstancePairMetrics } from './models/v1alpha1InstancePairMetrics';
 export type { v1alpha1LatencyDistribution } from './models/v1alpha1LatencyDistribution';
+export type { v1alpha1LatencyExemplar } from './models/v1alpha1LatencyExemplar';
+export type { v1alpha1MetricsBucket } from './models/v1alpha1MetricsBucket';
+export { v1alpha1MetricsPerspective } from './models/v1alpha1MetricsPerspective';
+export type { v1alpha1PairTimeSeries } from './models/v1alpha1PairTimeSeries';
+export type { v1alpha1PathResources } from './models/v1alpha1PathResources';
+export type { v1alpha1PeerAuthentication } from './models/v1alpha1PeerAuthentication';
+export type { v1alpha1PerspectiveMetrics } from './models/v1alpha1PerspectiveMetrics';
+export type { v1alpha1PolicyTargetReference } from './models/v1alpha1PolicyTargetReference';
+export type { v1alpha1ResourceRef } from './models/v1alpha1ResourceRef';
+export type { v1alpha1ServiceClusterComparison } from './models/v1alpha1ServiceClusterComparison';
+export type { v1alpha1ServiceInboundMetrics } from './models/v1alpha1ServiceInboundMetrics';
+export type { v1alpha1ServicePair } from './models/v1alpha1ServicePair';
 export type { v1alpha1ServicePairMetrics } from './models/v1alpha1ServicePairMetrics';
+export type { v1alpha1ServiceVersion } from './models/v1alpha1ServiceVersion';
+export type { v1alpha1Sidecar } from './models/v1alpha1Sidecar';
+export type { v1alpha1VirtualService } from './models/v1alpha1VirtualService';
+export type { v1alpha1WorkloadSelector } from './models/v1alpha1WorkloadSelector';
 
 export { MetricsServiceService } from './services/MetricsServiceService';
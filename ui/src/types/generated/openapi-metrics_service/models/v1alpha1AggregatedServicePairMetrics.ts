@@ -3,6 +3,8 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { v1alpha1ClusterPairInfo } from './v1alpha1ClusterPairInfo';
+import type { v1alpha1LatencyExemplar } from './v1alpha1LatencyExemplar';
+import type { v1alpha1PerspectiveMetrics } from './v1alpha1PerspectiveMetrics';
 import type { v1alpha1ServicePairMetrics } from './v1alpha1ServicePairMetrics';
 /**
  * AggregatedServicePairMetrics represents properly aggregated metrics across clusters.
@@ -44,5 +46,25 @@ export type v1alpha1AggregatedServicePairMetrics = {
      * detailed_breakdown contains per-cluster breakdown for drill-down analysis.
      */
     detailedBreakdown?: Array<v1alpha1ServicePairMetrics>;
+    /**
+     * source_reported contains the metrics as reported by the source proxies.
+     * Only set when both perspectives were requested.
+     */
+    sourceReported?: v1alpha1PerspectiveMetrics;
+    /**
+     * destination_reported contains the metrics as reported by the destination proxies.
+     * Only set when both perspectives were requested.
+     */
+    destinationReported?: v1alpha1PerspectiveMetrics;
+    /**
+     * discrepancies describes where the source and destination perspectives disagree, such as errors seen by the
+     * source that the destination never reported. Only set when both perspectives were requested.
+     */
+    discrepancies?: Array<string>;
+    /**
+     * exemplars are sample traces of the slowest requests across all clusters, slowest first.
+     * Only set when Prometheus stores exemplars.
+     */
+    exemplars?: Array<v1alpha1LatencyExemplar>;
 };
 
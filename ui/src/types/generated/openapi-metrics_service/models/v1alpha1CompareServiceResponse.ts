@@ -0,0 +1,28 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ServiceClusterComparison } from './v1alpha1ServiceClusterComparison';
+/**
+ * CompareServiceResponse contains the service as seen in each of the two clusters.
+ */
+export type v1alpha1CompareServiceResponse = {
+    /**
+     * cluster_a is the service in the first cluster.
+     */
+    clusterA?: v1alpha1ServiceClusterComparison;
+    /**
+     * cluster_b is the service in the second cluster.
+     */
+    clusterB?: v1alpha1ServiceClusterComparison;
+    /**
+     * differences describes how the service differs between the clusters, e.g. instance counts, versions or
+     * Istio resources present in only one of them. Empty when the clusters match.
+     */
+    differences?: Array<string>;
+    /**
+     * warnings describes parts of the comparison that could not be completed, such as metrics that could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
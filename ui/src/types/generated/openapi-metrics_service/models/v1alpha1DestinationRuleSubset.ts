@@ -0,0 +1,18 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * DestinationRuleSubset represents a named subset for destination rule traffic routing.
+ */
+export type v1alpha1DestinationRuleSubset = {
+    /**
+     * name is the name of the subset.
+     */
+    name?: string;
+    /**
+     * labels are the key-value pairs that define the subset.
+     */
+    labels?: Record<string, string>;
+};
+
@@ -0,0 +1,35 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AggregatedServicePairMetrics } from './v1alpha1AggregatedServicePairMetrics';
+import type { v1alpha1PathResources } from './v1alpha1PathResources';
+/**
+ * ExplainPathResponse contains the resources and metrics that explain a traffic path.
+ */
+export type v1alpha1ExplainPathResponse = {
+    /**
+     * source contains, per cluster, the resources applied by the source service's proxies to outbound traffic
+     * for the destination: sidecar scope, gateways, VirtualServices and DestinationRules (including subsets).
+     */
+    source?: Array<v1alpha1PathResources>;
+    /**
+     * destination contains, per cluster, the resources applied by the destination service's proxies to inbound traffic:
+     * PeerAuthentications and AuthorizationPolicies.
+     */
+    destination?: Array<v1alpha1PathResources>;
+    /**
+     * metrics contains current request metrics from the source to the destination.
+     * Unset when no traffic between the pair was observed.
+     */
+    metrics?: v1alpha1AggregatedServicePairMetrics;
+    /**
+     * clusters_queried lists the clusters that were queried for metrics.
+     */
+    clustersQueried?: Array<string>;
+    /**
+     * warnings describes parts of the path that could not be explained, such as clusters whose resources could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
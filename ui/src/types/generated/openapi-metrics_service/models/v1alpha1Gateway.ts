@@ -0,0 +1,36 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Gateway represents an Istio Gateway resource.
+ */
+export type v1alpha1Gateway = {
+    /**
+     * name is the name of the gateway.
+     */
+    name?: string;
+    /**
+     * namespace is the namespace of the gateway.
+     */
+    namespace?: string;
+    /**
+     * raw_config is the complete gateway resource as a JSON string.
+     */
+    rawConfig?: string;
+    /**
+     * selector is the workload selector for the gateway.
+     */
+    selector?: Record<string, string>;
+    /**
+     * raw_config_zstd is raw_config compressed with zstd. When set, raw_config is empty.
+     * It is only used between edge and manager; frontend responses always carry raw_config.
+     */
+    rawConfigZstd?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with (e.g. "networking.istio.io/v1alpha3"),
+     * as recorded by the API server. It is empty if unknown.
+     */
+    apiVersion?: string;
+};
+
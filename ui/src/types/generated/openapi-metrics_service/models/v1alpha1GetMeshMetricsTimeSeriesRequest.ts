@@ -0,0 +1,32 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ServicePair } from './v1alpha1ServicePair';
+/**
+ * GetMeshMetricsTimeSeriesRequest specifies the service pairs and time window to return time series for.
+ */
+export type v1alpha1GetMeshMetricsTimeSeriesRequest = {
+    /**
+     * pairs are the service pairs to return time series for, at most 50.
+     */
+    pairs?: Array<v1alpha1ServicePair>;
+    /**
+     * start_time is the start of the time window. Must be in the past.
+     */
+    startTime?: string;
+    /**
+     * end_time is the end of the time window. Must be in the past and after start_time.
+     */
+    endTime?: string;
+    /**
+     * buckets is the number of equal intervals the time window is divided into. Defaults to 30, at most 120.
+     */
+    buckets?: number;
+    /**
+     * cluster_selector aggregates only the metrics of clusters whose labels match it, using Kubernetes
+     * label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+     */
+    clusterSelector?: string;
+};
+
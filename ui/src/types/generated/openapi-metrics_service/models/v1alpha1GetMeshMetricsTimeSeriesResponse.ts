@@ -0,0 +1,24 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1PairTimeSeries } from './v1alpha1PairTimeSeries';
+/**
+ * GetMeshMetricsTimeSeriesResponse contains the metrics of service pairs over time.
+ */
+export type v1alpha1GetMeshMetricsTimeSeriesResponse = {
+    /**
+     * series contains a time series for each requested service pair, in the order requested. The metrics of each
+     * interval are aggregated across clusters.
+     */
+    series?: Array<v1alpha1PairTimeSeries>;
+    /**
+     * clusters_queried lists the clusters that were queried for these metrics.
+     */
+    clustersQueried?: Array<string>;
+    /**
+     * warnings describes clusters whose metrics could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
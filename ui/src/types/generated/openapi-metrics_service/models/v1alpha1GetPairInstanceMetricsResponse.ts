@@ -0,0 +1,29 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1InstancePairMetrics } from './v1alpha1InstancePairMetrics';
+/**
+ * GetPairInstanceMetricsResponse contains the metrics between two services broken down by pod.
+ */
+export type v1alpha1GetPairInstanceMetricsResponse = {
+    /**
+     * source_pods contains the requests sent to the destination by each source pod, as reported by the source proxies,
+     * ordered by cluster, workload and pod.
+     */
+    sourcePods?: Array<v1alpha1InstancePairMetrics>;
+    /**
+     * destination_pods contains the requests received from the source by each destination pod, as reported by the
+     * destination proxies, ordered by cluster, workload and pod.
+     */
+    destinationPods?: Array<v1alpha1InstancePairMetrics>;
+    /**
+     * clusters_queried lists the clusters that were queried for these metrics.
+     */
+    clustersQueried?: Array<string>;
+    /**
+     * warnings describes clusters whose metrics could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
@@ -0,0 +1,53 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1LatencyDistribution } from './v1alpha1LatencyDistribution';
+/**
+ * InstancePairMetrics represents metrics between a source and destination service broken down by workload and pod.
+ * Istio metrics only identify the pod of the proxy that reported them, so source_pod is set for metrics reported by
+ * the source proxies and destination_pod for metrics reported by the destination proxies.
+ */
+export type v1alpha1InstancePairMetrics = {
+    /**
+     * source_cluster is the cluster name of the source workload.
+     */
+    sourceCluster?: string;
+    /**
+     * source_workload is the name of the source workload.
+     */
+    sourceWorkload?: string;
+    /**
+     * source_pod is the name of the source pod, for metrics reported by the source.
+     */
+    sourcePod?: string;
+    /**
+     * destination_cluster is the cluster name of the destination workload.
+     */
+    destinationCluster?: string;
+    /**
+     * destination_workload is the name of the destination workload.
+     */
+    destinationWorkload?: string;
+    /**
+     * destination_pod is the name of the destination pod, for metrics reported by the destination.
+     */
+    destinationPod?: string;
+    /**
+     * error_rate is the error rate in requests per second.
+     */
+    errorRate?: number;
+    /**
+     * request_rate is the request rate in requests per second.
+     */
+    requestRate?: number;
+    /**
+     * latency_p99 is the 99th percentile latency.
+     */
+    latencyP99?: string;
+    /**
+     * latency_distribution contains the raw histogram distribution for latency.
+     */
+    latencyDistribution?: v1alpha1LatencyDistribution;
+};
+
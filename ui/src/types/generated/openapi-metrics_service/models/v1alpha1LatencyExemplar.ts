@@ -0,0 +1,30 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * LatencyExemplar is a sample request recorded on a latency histogram with the trace it belongs to.
+ */
+export type v1alpha1LatencyExemplar = {
+    /**
+     * trace_id is the ID of the trace of the request.
+     */
+    traceId?: string;
+    /**
+     * span_id is the ID of the span of the request, if recorded.
+     */
+    spanId?: string;
+    /**
+     * latency is the duration of the request.
+     */
+    latency?: string;
+    /**
+     * timestamp is when the request was recorded.
+     */
+    timestamp?: string;
+    /**
+     * cluster is the cluster whose metrics recorded the request.
+     */
+    cluster?: string;
+};
+
@@ -0,0 +1,35 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1LatencyDistribution } from './v1alpha1LatencyDistribution';
+/**
+ * MetricsBucket contains the metrics of a service pair over one interval of a time series.
+ */
+export type v1alpha1MetricsBucket = {
+    /**
+     * start_time is the start of the interval.
+     */
+    startTime?: string;
+    /**
+     * end_time is the end of the interval.
+     */
+    endTime?: string;
+    /**
+     * request_rate is the average request rate over the interval, in requests per second.
+     */
+    requestRate?: number;
+    /**
+     * error_rate is the average error rate over the interval, in requests per second.
+     */
+    errorRate?: number;
+    /**
+     * latency_p99 is the 99th percentile latency over the interval.
+     */
+    latencyP99?: string;
+    /**
+     * latency_distribution contains the raw histogram distribution for latency, used to merge intervals across clusters.
+     */
+    latencyDistribution?: v1alpha1LatencyDistribution;
+};
+
@@ -0,0 +1,16 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * MetricsPerspective selects which side of a connection reports its metrics.
+ *
+ * - METRICS_PERSPECTIVE_UNSPECIFIED: METRICS_PERSPECTIVE_UNSPECIFIED reports inbound connections as seen by the service's proxies (reporter=destination)
+ * and outbound connections as seen by the service's proxies as clients (reporter=source).
+ * - METRICS_PERSPECTIVE_BOTH: METRICS_PERSPECTIVE_BOTH additionally reports each connection as seen by the proxies on its other side, and
+ * describes where the two perspectives disagree.
+ */
+export enum v1alpha1MetricsPerspective {
+    METRICS_PERSPECTIVE_UNSPECIFIED = 'METRICS_PERSPECTIVE_UNSPECIFIED',
+    METRICS_PERSPECTIVE_BOTH = 'METRICS_PERSPECTIVE_BOTH',
+}
@@ -0,0 +1,20 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1MetricsBucket } from './v1alpha1MetricsBucket';
+import type { v1alpha1ServicePair } from './v1alpha1ServicePair';
+/**
+ * PairTimeSeries contains the metrics of a service pair over consecutive intervals.
+ */
+export type v1alpha1PairTimeSeries = {
+    /**
+     * pair is the service pair these metrics are for.
+     */
+    pair?: v1alpha1ServicePair;
+    /**
+     * buckets contains the metrics of each interval, oldest first. Intervals without traffic have zero rates.
+     */
+    buckets?: Array<v1alpha1MetricsBucket>;
+};
+
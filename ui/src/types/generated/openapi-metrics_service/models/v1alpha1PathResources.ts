@@ -0,0 +1,44 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1AuthorizationPolicy } from './v1alpha1AuthorizationPolicy';
+import type { v1alpha1DestinationRule } from './v1alpha1DestinationRule';
+import type { v1alpha1Gateway } from './v1alpha1Gateway';
+import type { v1alpha1PeerAuthentication } from './v1alpha1PeerAuthentication';
+import type { v1alpha1Sidecar } from './v1alpha1Sidecar';
+import type { v1alpha1VirtualService } from './v1alpha1VirtualService';
+/**
+ * PathResources contains the Istio resources affecting one side of a traffic path in a single cluster.
+ */
+export type v1alpha1PathResources = {
+    /**
+     * cluster_id is the cluster the resources were collected from.
+     */
+    clusterId?: string;
+    /**
+     * sidecars are Sidecar resources scoping the proxies' configuration.
+     */
+    sidecars?: Array<v1alpha1Sidecar>;
+    /**
+     * gateways are Gateway resources served by the workloads, when they are gateways.
+     */
+    gateways?: Array<v1alpha1Gateway>;
+    /**
+     * virtual_services are VirtualService resources routing traffic for the destination host.
+     */
+    virtualServices?: Array<v1alpha1VirtualService>;
+    /**
+     * destination_rules are DestinationRule resources, including subsets, for the destination host.
+     */
+    destinationRules?: Array<v1alpha1DestinationRule>;
+    /**
+     * peer_authentications are PeerAuthentication resources applying mTLS settings to the workloads.
+     */
+    peerAuthentications?: Array<v1alpha1PeerAuthentication>;
+    /**
+     * authorization_policies are AuthorizationPolicy resources applying to the workloads.
+     */
+    authorizationPolicies?: Array<v1alpha1AuthorizationPolicy>;
+};
+
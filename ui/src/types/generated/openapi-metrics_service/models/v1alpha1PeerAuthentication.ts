@@ -0,0 +1,37 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1WorkloadSelector } from './v1alpha1WorkloadSelector';
+/**
+ * PeerAuthentication represents an Istio PeerAuthentication resource.
+ */
+export type v1alpha1PeerAuthentication = {
+    /**
+     * name is the name of the peer authentication.
+     */
+    name?: string;
+    /**
+     * namespace is the namespace of the peer authentication.
+     */
+    namespace?: string;
+    /**
+     * raw_config is the complete peer authentication resource as a JSON string.
+     */
+    rawConfig?: string;
+    /**
+     * selector is the criteria used to select the specific set of pods/VMs.
+     */
+    selector?: v1alpha1WorkloadSelector;
+    /**
+     * raw_config_zstd is raw_config compressed with zstd. When set, raw_config is empty.
+     * It is only used between edge and manager; frontend responses always carry raw_config.
+     */
+    rawConfigZstd?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with (e.g. "networking.istio.io/v1alpha3"),
+     * as recorded by the API server. It is empty if unknown.
+     */
+    apiVersion?: string;
+};
+
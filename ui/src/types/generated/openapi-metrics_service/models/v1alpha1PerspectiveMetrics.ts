@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * PerspectiveMetrics contains the metrics of a connection as reported by the proxies on one side of it.
+ */
+export type v1alpha1PerspectiveMetrics = {
+    /**
+     * request_rate is the request rate in requests per second.
+     */
+    requestRate?: number;
+    /**
+     * error_rate is the error rate in requests per second.
+     */
+    errorRate?: number;
+    /**
+     * latency_p99 is the 99th percentile latency.
+     */
+    latencyP99?: string;
+};
+
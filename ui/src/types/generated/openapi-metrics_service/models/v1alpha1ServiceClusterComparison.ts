@@ -0,0 +1,40 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+import type { v1alpha1ServiceInboundMetrics } from './v1alpha1ServiceInboundMetrics';
+import type { v1alpha1ServiceVersion } from './v1alpha1ServiceVersion';
+/**
+ * ServiceClusterComparison describes a service in a single cluster.
+ */
+export type v1alpha1ServiceClusterComparison = {
+    /**
+     * cluster_id is the cluster the service was observed in.
+     */
+    clusterId?: string;
+    /**
+     * instance_count is the number of instances of the service in the cluster.
+     */
+    instanceCount?: number;
+    /**
+     * healthy_instance_count is the number of instances whose pod is running with all containers ready.
+     */
+    healthyInstanceCount?: number;
+    /**
+     * versions counts the instances of each version, from the pods' "version" or "app.kubernetes.io/version" label,
+     * ordered by version. Instances without either label are counted under an empty version.
+     */
+    versions?: Array<v1alpha1ServiceVersion>;
+    /**
+     * istio_resources are the VirtualServices and DestinationRules for the service's host and the Sidecars,
+     * PeerAuthentications and AuthorizationPolicies applying to its instances, ordered by kind, namespace and name.
+     */
+    istioResources?: Array<v1alpha1ResourceRef>;
+    /**
+     * metrics are the current inbound request metrics of the service, as reported by the cluster's metrics provider.
+     * Unset when no traffic to the service was observed.
+     */
+    metrics?: v1alpha1ServiceInboundMetrics;
+};
+
@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ServiceInboundMetrics summarizes the requests received by a service from all of its callers.
+ */
+export type v1alpha1ServiceInboundMetrics = {
+    /**
+     * request_rate is the request rate in requests per second.
+     */
+    requestRate?: number;
+    /**
+     * error_rate is the error rate in requests per second.
+     */
+    errorRate?: number;
+    /**
+     * latency_p99 is the 99th percentile latency.
+     */
+    latencyP99?: string;
+};
+
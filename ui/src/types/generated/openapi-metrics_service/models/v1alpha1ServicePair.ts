@@ -0,0 +1,26 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ServicePair identifies the connection from a source service to a destination service.
+ */
+export type v1alpha1ServicePair = {
+    /**
+     * source_service is the name of the calling service.
+     */
+    sourceService?: string;
+    /**
+     * source_namespace is the Kubernetes namespace of the calling service.
+     */
+    sourceNamespace?: string;
+    /**
+     * destination_service is the name of the called service.
+     */
+    destinationService?: string;
+    /**
+     * destination_namespace is the Kubernetes namespace of the called service.
+     */
+    destinationNamespace?: string;
+};
+
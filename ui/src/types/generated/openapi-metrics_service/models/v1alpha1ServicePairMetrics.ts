@@ -3,6 +3,7 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { v1alpha1LatencyDistribution } from './v1alpha1LatencyDistribution';
+import type { v1alpha1LatencyExemplar } from './v1alpha1LatencyExemplar';
 /**
  * ServicePairMetrics represents metrics between a source and destination service.
  */
@@ -48,5 +49,19 @@ export type v1alpha1ServicePairMetrics = {
      * This enables aggregation and percentile calculation at different levels.
      */
     latencyDistribution?: v1alpha1LatencyDistribution;
+    /**
+     * plaintext_request_rate is the rate of requests received without mTLS, in requests per second.
+     * It is only reported for inbound connections.
+     */
+    plaintextRequestRate?: number;
+    /**
+     * reporter is the side of the connection whose proxies reported these metrics: "source" or "destination".
+     */
+    reporter?: string;
+    /**
+     * exemplars are sample traces of the slowest requests recorded on the latency histogram, slowest first.
+     * Only set when Prometheus stores exemplars.
+     */
+    exemplars?: Array<v1alpha1LatencyExemplar>;
 };
 
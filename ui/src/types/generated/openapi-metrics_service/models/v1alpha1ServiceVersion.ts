@@ -0,0 +1,18 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ServiceVersion is the number of instances of a service running one version.
+ */
+export type v1alpha1ServiceVersion = {
+    /**
+     * version is the version of the instances.
+     */
+    version?: string;
+    /**
+     * instance_count is the number of instances running the version.
+     */
+    instanceCount?: number;
+};
+
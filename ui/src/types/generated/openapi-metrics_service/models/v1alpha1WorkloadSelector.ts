@@ -0,0 +1,14 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * WorkloadSelector represents the workload selector criteria used across Istio resources.
+ */
+export type v1alpha1WorkloadSelector = {
+    /**
+     * match_labels are the labels used to select pods/VMs.
+     */
+    matchLabels?: Record<string, string>;
+};
+
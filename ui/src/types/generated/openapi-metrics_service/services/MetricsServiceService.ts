@@ -3,11 +3,100 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { rpcStatus } from '../models/rpcStatus';
+import type { v1alpha1CompareServiceResponse } from '../models/v1alpha1CompareServiceResponse';
+import type { v1alpha1ExplainPathResponse } from '../models/v1alpha1ExplainPathResponse';
+import type { v1alpha1GetMeshMetricsTimeSeriesRequest } from '../models/v1alpha1GetMeshMetricsTimeSeriesRequest';
+import type { v1alpha1GetMeshMetricsTimeSeriesResponse } from '../models/v1alpha1GetMeshMetricsTimeSeriesResponse';
+import type { v1alpha1GetPairInstanceMetricsResponse } from '../models/v1alpha1GetPairInstanceMetricsResponse';
 import type { v1alpha1GetServiceConnectionsResponse } from '../models/v1alpha1GetServiceConnectionsResponse';
 import type { CancelablePromise } from '../core/CancelablePromise';
 import { OpenAPI } from '../core/OpenAPI';
 import { request as __request } from '../core/request';
 export class MetricsServiceService {
+    /**
+     * CompareService compares the same logical service in two clusters side by side: its instance counts and versions,
+     * the Istio resources applying to it and its current inbound metrics. It is meant as a failover sanity check.
+     * @param serviceName service_name is the name of the service to compare.
+     * @param namespace namespace is the Kubernetes namespace of the service.
+     * @param clusterA cluster_a is the first cluster to compare.
+     * @param clusterB cluster_b is the second cluster to compare.
+     * @returns v1alpha1CompareServiceResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static metricsServiceCompareService(
+        serviceName?: string,
+        namespace?: string,
+        clusterA?: string,
+        clusterB?: string,
+    ): CancelablePromise<v1alpha1CompareServiceResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/metrics/compare',
+            query: {
+                'serviceName': serviceName,
+                'namespace': namespace,
+                'clusterA': clusterA,
+                'clusterB': clusterB,
+            },
+        });
+    }
+    /**
+     * ExplainPath explains the traffic path from a source service to a destination service for guided debugging.
+     * It returns the Istio resources each side's proxies apply to the path together with current metrics for the pair.
+     * @param sourceService source_service is the name of the calling service.
+     * @param sourceNamespace source_namespace is the Kubernetes namespace of the calling service.
+     * @param destinationService destination_service is the name of the called service.
+     * @param destinationNamespace destination_namespace is the Kubernetes namespace of the called service.
+     * @returns v1alpha1ExplainPathResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static metricsServiceExplainPath(
+        sourceService?: string,
+        sourceNamespace?: string,
+        destinationService?: string,
+        destinationNamespace?: string,
+    ): CancelablePromise<v1alpha1ExplainPathResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/metrics/path',
+            query: {
+                'sourceService': sourceService,
+                'sourceNamespace': sourceNamespace,
+                'destinationService': destinationService,
+                'destinationNamespace': destinationNamespace,
+            },
+        });
+    }
+    /**
+     * GetPairInstanceMetrics breaks the current metrics from a source service to a destination service down by the
+     * workload and pod on each side, so that hot or failing replicas can be told apart from the service average.
+     * @param sourceService source_service is the name of the calling service.
+     * @param sourceNamespace source_namespace is the Kubernetes namespace of the calling service.
+     * @param destinationService destination_service is the name of the called service.
+     * @param destinationNamespace destination_namespace is the Kubernetes namespace of the called service.
+     * @returns v1alpha1GetPairInstanceMetricsResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static metricsServiceGetPairInstanceMetrics(
+        sourceService?: string,
+        sourceNamespace?: string,
+        destinationService?: string,
+        destinationNamespace?: string,
+    ): CancelablePromise<v1alpha1GetPairInstanceMetricsResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/metrics/path/instances',
+            query: {
+                'sourceService': sourceService,
+                'sourceNamespace': sourceNamespace,
+                'destinationService': destinationService,
+                'destinationNamespace': destinationNamespace,
+            },
+        });
+    }
     /**
      * GetServiceConnections returns inbound and outbound connections for a specific service.
      * @param serviceName service_name is the name of the service to get connections for.
@@ -16,6 +105,13 @@ export class MetricsServiceService {
      * Must be in the past (before current time).
      * @param endTime end_time specifies the end time for the metrics query (required).
      * Must be in the past (before current time) and after start_time.
+     * @param perspective perspective selects which side of each connection reports its metrics. With METRICS_PERSPECTIVE_BOTH, each
+     * connection also carries the metrics reported by its source and destination proxies and their discrepancies.
+     *
+     * - METRICS_PERSPECTIVE_UNSPECIFIED: METRICS_PERSPECTIVE_UNSPECIFIED reports inbound connections as seen by the service's proxies (reporter=destination)
+     * and outbound connections as seen by the service's proxies as clients (reporter=source).
+     * - METRICS_PERSPECTIVE_BOTH: METRICS_PERSPECTIVE_BOTH additionally reports each connection as seen by the proxies on its other side, and
+     * describes where the two perspectives disagree.
      * @returns v1alpha1GetServiceConnectionsResponse A successful response.
      * @returns rpcStatus An unexpected error response.
      * @throws ApiError
@@ -25,6 +121,7 @@ export class MetricsServiceService {
         namespace?: string,
         startTime?: string,
         endTime?: string,
+        perspective: 'METRICS_PERSPECTIVE_UNSPECIFIED' | 'METRICS_PERSPECTIVE_BOTH' = 'METRICS_PERSPECTIVE_UNSPECIFIED',
     ): CancelablePromise<v1alpha1GetServiceConnectionsResponse | rpcStatus> {
         return __request(OpenAPI, {
             method: 'GET',
@@ -36,7 +133,25 @@ export class MetricsServiceService {
                 'namespace': namespace,
                 'startTime': startTime,
                 'endTime': endTime,
+                'perspective': perspective,
             },
         });
     }
+    /**
+     * GetMeshMetricsTimeSeries returns the request rate, error rate and P99 latency of selected service pairs over
+     * consecutive intervals of a time window, aggregated across clusters, so that trends can be shown with one request.
+     * @param body GetMeshMetricsTimeSeriesRequest specifies the service pairs and time window to return time series for.
+     * @returns v1alpha1GetMeshMetricsTimeSeriesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static metricsServiceGetMeshMetricsTimeSeries(
+        body: v1alpha1GetMeshMetricsTimeSeriesRequest,
+    ): CancelablePromise<v1alpha1GetMeshMetricsTimeSeriesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'POST',
+            url: '/api/v1alpha1/metrics/timeseries',
+            body: body,
+        });
+    }
 }
@@ -7,17 +7,23 @@ export { CancelablePromise, CancelError } from './core/CancelablePromise';
 export { OpenAPI } from './core/OpenAPI';
 export type { OpenAPIConfig } from './core/OpenAPI';
 
+export type { apiHttpBody } from './models/apiHttpBody';
 export type { protobufAny } from './models/protobufAny';
 export type { rpcStatus } from './models/rpcStatus';
+export type { ServiceRegistryServiceSimulateRouteBody } from './models/ServiceRegistryServiceSimulateRouteBody';
 export { v1alpha1AddressType } from './models/v1alpha1AddressType';
 export type { v1alpha1AuthorizationPolicy } from './models/v1alpha1AuthorizationPolicy';
 export type { v1alpha1BootstrapSummary } from './models/v1alpha1BootstrapSummary';
+export type { v1alpha1CanonicalService } from './models/v1alpha1CanonicalService';
 export { v1alpha1ClusterDirection } from './models/v1alpha1ClusterDirection';
 export type { v1alpha1ClusterManagerInfo } from './models/v1alpha1ClusterManagerInfo';
+export type { v1alpha1ClusterResourceInventory } from './models/v1alpha1ClusterResourceInventory';
 export type { v1alpha1ClusterSummary } from './models/v1alpha1ClusterSummary';
+export type { v1alpha1ClusterSyncMetadata } from './models/v1alpha1ClusterSyncMetadata';
 export { v1alpha1ClusterType } from './models/v1alpha1ClusterType';
 export type { v1alpha1ConfigSourceInfo } from './models/v1alpha1ConfigSourceInfo';
 export type { v1alpha1Container } from './models/v1alpha1Container';
+export type { v1alpha1ContainerLogs } from './models/v1alpha1ContainerLogs';
 export type { v1alpha1DestinationRule } from './models/v1alpha1DestinationRule';
 export type { v1alpha1DestinationRuleSubset } from './models/v1alpha1DestinationRuleSubset';
 export type { v1alpha1DynamicConfigInfo } from './models/v1alpha1DynamicConfigInfo';
@@ -28,37 +34,66 @@ export type { v1alpha1FilterChainMatch } from './models/v1alpha1FilterChainMatch
 export type { v1alpha1FilterChainSummary } from './models/v1alpha1FilterChainSummary';
 export type { v1alpha1FilterInfo } from './models/v1alpha1FilterInfo';
 export type { v1alpha1Gateway } from './models/v1alpha1Gateway';
+export type { v1alpha1GetEnvoyAdminResponse } from './models/v1alpha1GetEnvoyAdminResponse';
+export type { v1alpha1GetInstanceLogsResponse } from './models/v1alpha1GetInstanceLogsResponse';
 export type { v1alpha1GetIstioResourcesResponse } from './models/v1alpha1GetIstioResourcesResponse';
 export type { v1alpha1GetProxyConfigResponse } from './models/v1alpha1GetProxyConfigResponse';
+export type { v1alpha1GetResourceInventoryResponse } from './models/v1alpha1GetResourceInventoryResponse';
+export type { v1alpha1GetResourceReferencesResponse } from './models/v1alpha1GetResourceReferencesResponse';
 export type { v1alpha1GetServiceInstanceResponse } from './models/v1alpha1GetServiceInstanceResponse';
+export type { v1alpha1GetServiceProxyConfigsResponse } from './models/v1alpha1GetServiceProxyConfigsResponse';
 export type { v1alpha1GetServiceResponse } from './models/v1alpha1GetServiceResponse';
 export type { v1alpha1HeaderMatchInfo } from './models/v1alpha1HeaderMatchInfo';
 export type { v1alpha1HttpRouteMatch } from './models/v1alpha1HttpRouteMatch';
+export { v1alpha1InstanceHealth } from './models/v1alpha1InstanceHealth';
+export type { v1alpha1InstanceProxyConfig } from './models/v1alpha1InstanceProxyConfig';
+export type { v1alpha1IstioResource } from './models/v1alpha1IstioResource';
+export { v1alpha1IstioResourceKind } from './models/v1alpha1IstioResourceKind';
 export type { v1alpha1ListenerDestination } from './models/v1alpha1ListenerDestination';
 export type { v1alpha1ListenerMatch } from './models/v1alpha1ListenerMatch';
 export type { v1alpha1ListenerRule } from './models/v1alpha1ListenerRule';
+export type { v1alpha1ListenerSource } from './models/v1alpha1ListenerSource';
 export type { v1alpha1ListenerSummary } from './models/v1alpha1ListenerSummary';
 export { v1alpha1ListenerType } from './models/v1alpha1ListenerType';
+export type { v1alpha1ListCanonicalServicesResponse } from './models/v1alpha1ListCanonicalServicesResponse';
+export type { v1alpha1ListIstioResourcesResponse } from './models/v1alpha1ListIstioResourcesResponse';
+export type { v1alpha1ListServiceInstancesResponse } from './models/v1alpha1ListServiceInstancesResponse';
 export type { v1alpha1ListServicesResponse } from './models/v1alpha1ListServicesResponse';
 export type { v1alpha1LocalityInfo } from './models/v1alpha1LocalityInfo';
+export type { v1alpha1NamespaceResourceInventory } from './models/v1alpha1NamespaceResourceInventory';
 export type { v1alpha1NodeSummary } from './models/v1alpha1NodeSummary';
+export { v1alpha1OutboundTrafficPolicyMode } from './models/v1alpha1OutboundTrafficPolicyMode';
 export type { v1alpha1PathMatchInfo } from './models/v1alpha1PathMatchInfo';
 export type { v1alpha1PeerAuthentication } from './models/v1alpha1PeerAuthentication';
+export { v1alpha1PodLifecyclePhase } from './models/v1alpha1PodLifecyclePhase';
 export type { v1alpha1PolicyTargetReference } from './models/v1alpha1PolicyTargetReference';
+export type { v1alpha1PortProtocol } from './models/v1alpha1PortProtocol';
+export { v1alpha1ProtocolDetection } from './models/v1alpha1ProtocolDetection';
 export type { v1alpha1ProxyConfig } from './models/v1alpha1ProxyConfig';
+export type { v1alpha1ProxyConfigFreshness } from './models/v1alpha1ProxyConfigFreshness';
 export { v1alpha1ProxyMode } from './models/v1alpha1ProxyMode';
+export { v1alpha1RawConfigFormat } from './models/v1alpha1RawConfigFormat';
+export { v1alpha1ReferenceType } from './models/v1alpha1ReferenceType';
 export type { v1alpha1RequestAuthentication } from './models/v1alpha1RequestAuthentication';
+export type { v1alpha1ResourceKindCount } from './models/v1alpha1ResourceKindCount';
+export type { v1alpha1ResourceRef } from './models/v1alpha1ResourceRef';
+export type { v1alpha1ResourceReference } from './models/v1alpha1ResourceReference';
 export type { v1alpha1RouteActionInfo } from './models/v1alpha1RouteActionInfo';
 export type { v1alpha1RouteConfigSummary } from './models/v1alpha1RouteConfigSummary';
 export type { v1alpha1RouteInfo } from './models/v1alpha1RouteInfo';
 export type { v1alpha1RouteMatchInfo } from './models/v1alpha1RouteMatchInfo';
+export type { v1alpha1RouteSimulationDestination } from './models/v1alpha1RouteSimulationDestination';
+export type { v1alpha1RouteSimulationMatch } from './models/v1alpha1RouteSimulationMatch';
 export { v1alpha1RouteType } from './models/v1alpha1RouteType';
 export type { v1alpha1Service } from './models/v1alpha1Service';
 export type { v1alpha1ServiceEntry } from './models/v1alpha1ServiceEntry';
 export type { v1alpha1ServiceInstance } from './models/v1alpha1ServiceInstance';
 export type { v1alpha1ServiceInstanceDetail } from './models/v1alpha1ServiceInstanceDetail';
 export type { v1alpha1Sidecar } from './models/v1alpha1Sidecar';
+export type { v1alpha1SimulateRouteResponse } from './models/v1alpha1SimulateRouteResponse';
 export type { v1alpha1TcpProxyMatch } from './models/v1alpha1TcpProxyMatch';
+export type { v1alpha1Toleration } from './models/v1alpha1Toleration';
+export type { v1alpha1UpstreamTlsInfo } from './models/v1alpha1UpstreamTlsInfo';
 export type { v1alpha1VirtualHostInfo } from './models/v1alpha1VirtualHostInfo';
 export type { v1alpha1VirtualService } from './models/v1alpha1VirtualService';
 export type { v1alpha1WasmPlugin } from './models/v1alpha1WasmPlugin';
@@ -0,0 +1,35 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * SimulateRouteRequest describes the HTTP request to evaluate against a service instance's proxy routes.
+ */
+export type ServiceRegistryServiceSimulateRouteBody = {
+    /**
+     * host is the request's Host (:authority) header, optionally including a port (e.g., "reviews:9080").
+     */
+    host?: string;
+    /**
+     * path is the request path, optionally including a query string. Defaults to "/".
+     */
+    path?: string;
+    /**
+     * method is the HTTP method. Defaults to GET.
+     */
+    method?: string;
+    /**
+     * headers are additional request headers to match against route header matchers.
+     */
+    headers?: Record<string, string>;
+    /**
+     * port restricts evaluation to the route configurations serving this port.
+     * If not specified, the port in host is used; without either, every route configuration is evaluated.
+     */
+    port?: number;
+    /**
+     * force_refresh bypasses any cached configuration and takes a new snapshot from the proxy.
+     */
+    forceRefresh?: boolean;
+};
+
@@ -0,0 +1,26 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { protobufAny } from './protobufAny';
+/**
+ * Message that represents an arbitrary HTTP body. It should only be used for
+ * payload formats that can't be represented as JSON, such as raw binary or
+ * an HTML page.
+ */
+export type apiHttpBody = {
+    /**
+     * The HTTP Content-Type header value specifying the content type of the body.
+     */
+    contentType?: string;
+    /**
+     * The HTTP request/response body as raw binary.
+     */
+    data?: string;
+    /**
+     * Application specific response metadata. Must be set in the first response
+     * for streaming APIs.
+     */
+    extensions?: Array<protobufAny>;
+};
+
@@ -0,0 +1,40 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * CanonicalService groups the instances Istio's telemetry reports under one canonical service, together with
+ * the Kubernetes services they back. A canonical service may span several services, and a service may back
+ * several canonical services.
+ */
+export type v1alpha1CanonicalService = {
+    /**
+     * id is a unique identifier for the canonical service in format namespace:canonical-name.
+     */
+    id?: string;
+    /**
+     * name is the canonical service name.
+     */
+    name?: string;
+    /**
+     * namespace is the Kubernetes namespace of the canonical service.
+     */
+    namespace?: string;
+    /**
+     * services are the IDs of the Kubernetes services backed by instances of the canonical service, sorted.
+     */
+    services?: Array<string>;
+    /**
+     * revisions are the canonical revisions of the canonical service's instances, sorted.
+     */
+    revisions?: Array<string>;
+    /**
+     * clusters are the clusters running instances of the canonical service, sorted.
+     */
+    clusters?: Array<string>;
+    /**
+     * instance_count is the number of distinct instances of the canonical service across all clusters.
+     */
+    instanceCount?: number;
+};
+
@@ -0,0 +1,28 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1NamespaceResourceInventory } from './v1alpha1NamespaceResourceInventory';
+import type { v1alpha1ResourceKindCount } from './v1alpha1ResourceKindCount';
+/**
+ * ClusterResourceInventory contains the number of Istio resources of each kind in a cluster.
+ */
+export type v1alpha1ClusterResourceInventory = {
+    /**
+     * cluster_id is the cluster the resources were collected from.
+     */
+    clusterId?: string;
+    /**
+     * namespaces are the counts of each namespace holding resources, ordered by namespace.
+     */
+    namespaces?: Array<v1alpha1NamespaceResourceInventory>;
+    /**
+     * counts are the number of resources of each kind in the cluster, ordered by kind.
+     */
+    counts?: Array<v1alpha1ResourceKindCount>;
+    /**
+     * total_count is the number of resources in the cluster.
+     */
+    totalCount?: number;
+};
+
@@ -3,6 +3,7 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { v1alpha1ClusterDirection } from './v1alpha1ClusterDirection';
+import type { v1alpha1UpstreamTlsInfo } from './v1alpha1UpstreamTlsInfo';
 export type v1alpha1ClusterSummary = {
     name?: string;
     type?: string;
@@ -14,5 +15,25 @@ export type v1alpha1ClusterSummary = {
     subset?: string;
     serviceFqdn?: string;
     rawConfig?: string;
+    /**
+     * metadata contains the cluster's string-valued filter metadata keyed by "<filter>.<key>",
+     * e.g. "istio.config" which names the DestinationRule that configured the cluster.
+     */
+    metadata?: Record<string, string>;
+    /**
+     * tls describes the TLS the cluster originates to upstream hosts. Unset when connections are plaintext.
+     */
+    tls?: v1alpha1UpstreamTlsInfo;
+    /**
+     * tls_mode is the Istio TLS mode of an outbound cluster: ISTIO_MUTUAL, MUTUAL, SIMPLE or DISABLE,
+     * or AUTO when Istio selects mutual TLS per endpoint. Empty for other clusters.
+     */
+    tlsMode?: string;
+    /**
+     * destination_rule_name is the name of the DestinationRule responsible for the cluster's subset and
+     * TLS settings. Empty when no DestinationRule applies to the cluster.
+     */
+    destinationRuleName?: string;
+    destinationRuleNamespace?: string;
 };
 
@@ -0,0 +1,49 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ClusterSyncMetadata describes the most recent state sync received from a cluster's edge.
+ * It accompanies cluster-scoped API responses so consumers can tell how fresh the data is.
+ */
+export type v1alpha1ClusterSyncMetadata = {
+    /**
+     * cluster_id identifies the cluster this metadata describes.
+     */
+    clusterId?: string;
+    /**
+     * last_sync_time is when the edge collected the most recent cluster state (RFC3339 format).
+     */
+    lastSyncTime?: string;
+    /**
+     * sync_duration_ms is how long the edge took to collect the most recent cluster state, in milliseconds.
+     */
+    syncDurationMs?: string;
+    /**
+     * resource_counts is the number of resources of each type in the most recent cluster state, keyed by resource type.
+     */
+    resourceCounts?: Record<string, number>;
+    /**
+     * edge_version is the version of the edge process syncing this cluster.
+     */
+    edgeVersion?: string;
+    /**
+     * stale indicates the cluster's edges disconnected and its last state is still served until the
+     * manager's retention expires.
+     */
+    stale?: boolean;
+    /**
+     * disconnected_at is when the last edge of a stale cluster disconnected (RFC3339 format).
+     */
+    disconnectedAt?: string;
+    /**
+     * evicted indicates the cluster's edge is connected but its state exceeded the manager's maximum
+     * staleness, so its resources are left out until the edge syncs again.
+     */
+    evicted?: boolean;
+    /**
+     * labels are the metadata labels configured for the cluster on its edge (e.g., region, env, tier).
+     */
+    labels?: Record<string, string>;
+};
+
@@ -26,5 +26,10 @@ export type v1alpha1Container = {
      * restart_count is the number of times the container has been restarted.
      */
     restartCount?: number;
+    /**
+     * image_digest is the digest of the image the container runs (e.g., "sha256:..."), from its status.
+     * Empty until the image has been pulled.
+     */
+    imageDigest?: string;
 };
 
@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ContainerLogs contains log output retrieved from a single container.
+ */
+export type v1alpha1ContainerLogs = {
+    /**
+     * container is the name of the container the logs were retrieved from.
+     */
+    container?: string;
+    /**
+     * logs is the raw log output, oldest line first.
+     */
+    logs?: string;
+    /**
+     * truncated indicates the output was cut short because it exceeded the size limit.
+     */
+    truncated?: boolean;
+};
+
@@ -36,5 +36,15 @@ export type v1alpha1DestinationRule = {
      * workload_selector is the criteria used to select the specific set of pods/VMs.
      */
     workloadSelector?: v1alpha1WorkloadSelector;
+    /**
+     * raw_config_zstd is raw_config compressed with zstd. When set, raw_config is empty.
+     * It is only used between edge and manager; frontend responses always carry raw_config.
+     */
+    rawConfigZstd?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with (e.g. "networking.istio.io/v1alpha3"),
+     * as recorded by the API server. It is empty if unknown.
+     */
+    apiVersion?: string;
 };
 
@@ -0,0 +1,18 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * GetEnvoyAdminResponse contains the raw output of the Envoy admin endpoint.
+ */
+export type v1alpha1GetEnvoyAdminResponse = {
+    /**
+     * path is the admin endpoint that was queried, including any query string.
+     */
+    path?: string;
+    /**
+     * output is the raw response body returned by the admin endpoint.
+     */
+    output?: string;
+};
+
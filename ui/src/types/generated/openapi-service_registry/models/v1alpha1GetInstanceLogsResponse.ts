@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ContainerLogs } from './v1alpha1ContainerLogs';
+/**
+ * GetInstanceLogsResponse contains the container logs for the requested service instance.
+ */
+export type v1alpha1GetInstanceLogsResponse = {
+    /**
+     * logs contains the retrieved container logs.
+     */
+    logs?: v1alpha1ContainerLogs;
+};
+
@@ -3,6 +3,7 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { v1alpha1AuthorizationPolicy } from './v1alpha1AuthorizationPolicy';
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
 import type { v1alpha1DestinationRule } from './v1alpha1DestinationRule';
 import type { v1alpha1EnvoyFilter } from './v1alpha1EnvoyFilter';
 import type { v1alpha1Gateway } from './v1alpha1Gateway';
@@ -56,5 +57,9 @@ export type v1alpha1GetIstioResourcesResponse = {
      * service_entries are ServiceEntry resources affecting this instance.
      */
     serviceEntries?: Array<v1alpha1ServiceEntry>;
+    /**
+     * sync_metadata describes the most recent state sync from the instance's cluster.
+     */
+    syncMetadata?: v1alpha1ClusterSyncMetadata;
 };
 
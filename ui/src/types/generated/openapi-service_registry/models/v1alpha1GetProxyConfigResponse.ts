@@ -2,7 +2,9 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
 import type { v1alpha1ProxyConfig } from './v1alpha1ProxyConfig';
+import type { v1alpha1ProxyConfigFreshness } from './v1alpha1ProxyConfigFreshness';
 /**
  * GetProxyConfigResponse contains the proxy configuration for the requested pod.
  */
@@ -11,5 +13,13 @@ export type v1alpha1GetProxyConfigResponse = {
      * proxy_config contains the complete Envoy proxy configuration.
      */
     proxyConfig?: v1alpha1ProxyConfig;
+    /**
+     * sync_metadata describes the most recent state sync from the instance's cluster.
+     */
+    syncMetadata?: v1alpha1ClusterSyncMetadata;
+    /**
+     * freshness describes when the proxy configuration was captured.
+     */
+    freshness?: v1alpha1ProxyConfigFreshness;
 };
 
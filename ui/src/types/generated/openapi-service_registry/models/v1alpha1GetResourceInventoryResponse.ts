@@ -0,0 +1,29 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ClusterResourceInventory } from './v1alpha1ClusterResourceInventory';
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
+import type { v1alpha1ResourceKindCount } from './v1alpha1ResourceKindCount';
+/**
+ * GetResourceInventoryResponse contains the number of Istio resources of each kind.
+ */
+export type v1alpha1GetResourceInventoryResponse = {
+    /**
+     * clusters are the counts of each cluster, ordered by cluster ID.
+     */
+    clusters?: Array<v1alpha1ClusterResourceInventory>;
+    /**
+     * counts are the number of resources of each kind across all clusters, ordered by kind.
+     */
+    counts?: Array<v1alpha1ResourceKindCount>;
+    /**
+     * total_count is the number of resources across all clusters and kinds.
+     */
+    totalCount?: number;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
+};
+
@@ -0,0 +1,24 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+import type { v1alpha1ResourceReference } from './v1alpha1ResourceReference';
+/**
+ * GetResourceReferencesResponse contains the references to and from a resource.
+ */
+export type v1alpha1GetResourceReferencesResponse = {
+    /**
+     * resource is the resource the references were requested for.
+     */
+    resource?: v1alpha1ResourceRef;
+    /**
+     * uses are the references from the resource to other resources.
+     */
+    uses?: Array<v1alpha1ResourceReference>;
+    /**
+     * used_by are the references from other resources to the resource.
+     */
+    usedBy?: Array<v1alpha1ResourceReference>;
+};
+
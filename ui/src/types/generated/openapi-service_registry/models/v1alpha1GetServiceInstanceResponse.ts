@@ -2,6 +2,7 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
 import type { v1alpha1ServiceInstanceDetail } from './v1alpha1ServiceInstanceDetail';
 /**
  * GetServiceInstanceResponse contains the requested service instance details.
@@ -11,5 +12,9 @@ export type v1alpha1GetServiceInstanceResponse = {
      * instance contains the detailed service instance information.
      */
     instance?: v1alpha1ServiceInstanceDetail;
+    /**
+     * sync_metadata describes the most recent state sync from the instance's cluster.
+     */
+    syncMetadata?: v1alpha1ClusterSyncMetadata;
 };
 
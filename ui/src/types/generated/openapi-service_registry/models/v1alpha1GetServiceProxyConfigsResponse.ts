@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1InstanceProxyConfig } from './v1alpha1InstanceProxyConfig';
+/**
+ * GetServiceProxyConfigsResponse contains the proxy configuration of each instance of the requested service.
+ */
+export type v1alpha1GetServiceProxyConfigsResponse = {
+    /**
+     * instances contains one result per service instance, ordered by instance ID.
+     */
+    instances?: Array<v1alpha1InstanceProxyConfig>;
+};
+
@@ -2,6 +2,7 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
 import type { v1alpha1Service } from './v1alpha1Service';
 /**
  * GetServiceResponse contains the requested service details.
@@ -11,5 +12,9 @@ export type v1alpha1GetServiceResponse = {
      * service contains the detailed service information.
      */
     service?: v1alpha1Service;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
 };
 
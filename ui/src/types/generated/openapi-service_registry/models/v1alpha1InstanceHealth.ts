@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * InstanceHealth is the health of a service instance.
+ *
+ * - INSTANCE_HEALTH_HEALTHY: The pod is running and all of its containers are ready
+ * - INSTANCE_HEALTH_UNHEALTHY: The pod is not running or any of its containers is not ready
+ */
+export enum v1alpha1InstanceHealth {
+    INSTANCE_HEALTH_UNSPECIFIED = 'INSTANCE_HEALTH_UNSPECIFIED',
+    INSTANCE_HEALTH_HEALTHY = 'INSTANCE_HEALTH_HEALTHY',
+    INSTANCE_HEALTH_UNHEALTHY = 'INSTANCE_HEALTH_UNHEALTHY',
+}
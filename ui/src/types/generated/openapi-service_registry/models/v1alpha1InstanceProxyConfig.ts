@@ -0,0 +1,29 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ProxyConfig } from './v1alpha1ProxyConfig';
+import type { v1alpha1ProxyConfigFreshness } from './v1alpha1ProxyConfigFreshness';
+/**
+ * InstanceProxyConfig is the proxy configuration, or the reason it could not be retrieved, for one service instance.
+ */
+export type v1alpha1InstanceProxyConfig = {
+    instanceId?: string;
+    /**
+     * cluster_name is the name of the cluster the instance runs in.
+     */
+    clusterName?: string;
+    /**
+     * proxy_config contains the complete Envoy proxy configuration.
+     */
+    proxyConfig?: v1alpha1ProxyConfig;
+    /**
+     * error_message describes why the configuration could not be retrieved.
+     */
+    errorMessage?: string;
+    /**
+     * freshness describes when the proxy configuration was captured. Unset when retrieval failed.
+     */
+    freshness?: v1alpha1ProxyConfigFreshness;
+};
+
@@ -0,0 +1,35 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1IstioResourceKind } from './v1alpha1IstioResourceKind';
+/**
+ * IstioResource is a single Istio configuration resource collected from a cluster.
+ */
+export type v1alpha1IstioResource = {
+    /**
+     * cluster_id is the cluster the resource was collected from.
+     */
+    clusterId?: string;
+    /**
+     * kind is the kind of Istio resource.
+     */
+    kind?: v1alpha1IstioResourceKind;
+    /**
+     * name is the name of the resource.
+     */
+    name?: string;
+    /**
+     * namespace is the namespace of the resource.
+     */
+    namespace?: string;
+    /**
+     * raw_config is the complete resource as JSON.
+     */
+    rawConfig?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with. It is empty if unknown.
+     */
+    apiVersion?: string;
+};
+
@@ -0,0 +1,20 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * IstioResourceKind identifies a kind of Istio configuration resource collected by Navigator.
+ */
+export enum v1alpha1IstioResourceKind {
+    ISTIO_RESOURCE_KIND_UNSPECIFIED = 'ISTIO_RESOURCE_KIND_UNSPECIFIED',
+    ISTIO_RESOURCE_KIND_AUTHORIZATION_POLICY = 'ISTIO_RESOURCE_KIND_AUTHORIZATION_POLICY',
+    ISTIO_RESOURCE_KIND_DESTINATION_RULE = 'ISTIO_RESOURCE_KIND_DESTINATION_RULE',
+    ISTIO_RESOURCE_KIND_ENVOY_FILTER = 'ISTIO_RESOURCE_KIND_ENVOY_FILTER',
+    ISTIO_RESOURCE_KIND_GATEWAY = 'ISTIO_RESOURCE_KIND_GATEWAY',
+    ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION = 'ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION',
+    ISTIO_RESOURCE_KIND_REQUEST_AUTHENTICATION = 'ISTIO_RESOURCE_KIND_REQUEST_AUTHENTICATION',
+    ISTIO_RESOURCE_KIND_SERVICE_ENTRY = 'ISTIO_RESOURCE_KIND_SERVICE_ENTRY',
+    ISTIO_RESOURCE_KIND_SIDECAR = 'ISTIO_RESOURCE_KIND_SIDECAR',
+    ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE = 'ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE',
+    ISTIO_RESOURCE_KIND_WASM_PLUGIN = 'ISTIO_RESOURCE_KIND_WASM_PLUGIN',
+}
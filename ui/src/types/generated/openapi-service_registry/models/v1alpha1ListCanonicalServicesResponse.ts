@@ -0,0 +1,20 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1CanonicalService } from './v1alpha1CanonicalService';
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
+/**
+ * ListCanonicalServicesResponse contains the canonical services in the requested namespace(s).
+ */
+export type v1alpha1ListCanonicalServicesResponse = {
+    /**
+     * canonical_services are the canonical services found, sorted by namespace and name.
+     */
+    canonicalServices?: Array<v1alpha1CanonicalService>;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
+};
+
@@ -0,0 +1,28 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
+import type { v1alpha1IstioResource } from './v1alpha1IstioResource';
+/**
+ * ListIstioResourcesResponse contains a page of Istio resources.
+ */
+export type v1alpha1ListIstioResourcesResponse = {
+    /**
+     * resources is the page of matching resources, ordered by cluster, namespace, kind and name.
+     */
+    resources?: Array<v1alpha1IstioResource>;
+    /**
+     * next_page_token retrieves the next page of results. Empty when there are no more results.
+     */
+    nextPageToken?: string;
+    /**
+     * total_count is the total number of resources matching the filters across all pages.
+     */
+    totalCount?: number;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
+};
+
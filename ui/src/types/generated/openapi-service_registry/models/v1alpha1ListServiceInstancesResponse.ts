@@ -0,0 +1,29 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
+import type { v1alpha1ServiceInstanceDetail } from './v1alpha1ServiceInstanceDetail';
+/**
+ * ListServiceInstancesResponse contains a page of service instances.
+ */
+export type v1alpha1ListServiceInstancesResponse = {
+    /**
+     * instances is the page of matching instances, ordered by cluster, namespace, pod name and service.
+     * An instance backing several services is listed once for each of them.
+     */
+    instances?: Array<v1alpha1ServiceInstanceDetail>;
+    /**
+     * next_page_token retrieves the next page of results. Empty when there are no more results.
+     */
+    nextPageToken?: string;
+    /**
+     * total_count is the total number of instances matching the filters across all pages.
+     */
+    totalCount?: number;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
+};
+
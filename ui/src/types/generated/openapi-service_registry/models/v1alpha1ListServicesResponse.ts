@@ -2,6 +2,7 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1ClusterSyncMetadata } from './v1alpha1ClusterSyncMetadata';
 import type { v1alpha1Service } from './v1alpha1Service';
 /**
  * ListServicesResponse contains the list of services in the requested namespace(s).
@@ -11,5 +12,9 @@ export type v1alpha1ListServicesResponse = {
      * services is the list of services found in the namespace(s).
      */
     services?: Array<v1alpha1Service>;
+    /**
+     * sync_metadata describes the most recent state sync from each cluster contributing to this response.
+     */
+    syncMetadata?: Array<v1alpha1ClusterSyncMetadata>;
 };
 
@@ -0,0 +1,16 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+export type v1alpha1ListenerSource = {
+    kind?: string;
+    name?: string;
+    namespace?: string;
+    /**
+     * section identifies the part of the resource: the name of a Gateway server, or "servers[<index>]" when
+     * it is unnamed, or a Sidecar's "ingress[<index>]" or "egress[<index>]" listener.
+     */
+    section?: string;
+    hosts?: Array<string>;
+};
+
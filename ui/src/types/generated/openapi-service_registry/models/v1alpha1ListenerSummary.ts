@@ -4,7 +4,9 @@
 /* eslint-disable */
 import type { v1alpha1FilterChainSummary } from './v1alpha1FilterChainSummary';
 import type { v1alpha1ListenerRule } from './v1alpha1ListenerRule';
+import type { v1alpha1ListenerSource } from './v1alpha1ListenerSource';
 import type { v1alpha1ListenerType } from './v1alpha1ListenerType';
+import type { v1alpha1PortProtocol } from './v1alpha1PortProtocol';
 export type v1alpha1ListenerSummary = {
     name?: string;
     address?: string;
@@ -14,5 +16,16 @@ export type v1alpha1ListenerSummary = {
     rawConfig?: string;
     rules?: Array<v1alpha1ListenerRule>;
     filterChains?: v1alpha1FilterChainSummary;
+    /**
+     * route_configs are the names of the route configurations the listener's HTTP connection managers
+     * load over RDS, e.g. "http.80" or "https.443.https.my-gateway.istio-system" on gateways.
+     */
+    routeConfigs?: Array<string>;
+    /**
+     * sources are the Gateway servers or Sidecar listeners that generated the listener. Empty when the
+     * listener was generated from the mesh defaults.
+     */
+    sources?: Array<v1alpha1ListenerSource>;
+    portProtocols?: Array<v1alpha1PortProtocol>;
 };
 
@@ -0,0 +1,23 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ResourceKindCount } from './v1alpha1ResourceKindCount';
+/**
+ * NamespaceResourceInventory contains the number of Istio resources of each kind in a namespace.
+ */
+export type v1alpha1NamespaceResourceInventory = {
+    /**
+     * namespace is the namespace of the resources.
+     */
+    namespace?: string;
+    /**
+     * counts are the number of resources of each kind in the namespace, ordered by kind.
+     */
+    counts?: Array<v1alpha1ResourceKindCount>;
+    /**
+     * total_count is the number of resources in the namespace.
+     */
+    totalCount?: number;
+};
+
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * OutboundTrafficPolicyMode is how proxies handle traffic to destinations outside the service registry.
+ *
+ * - OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY: Traffic to unknown destinations is passed through (PassthroughCluster), Istio's default.
+ * - OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY: Traffic to unknown destinations is dropped (BlackHoleCluster).
+ */
+export enum v1alpha1OutboundTrafficPolicyMode {
+    OUTBOUND_TRAFFIC_POLICY_MODE_UNSPECIFIED = 'OUTBOUND_TRAFFIC_POLICY_MODE_UNSPECIFIED',
+    OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY = 'OUTBOUND_TRAFFIC_POLICY_MODE_ALLOW_ANY',
+    OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY = 'OUTBOUND_TRAFFIC_POLICY_MODE_REGISTRY_ONLY',
+}
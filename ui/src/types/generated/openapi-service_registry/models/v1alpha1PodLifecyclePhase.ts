@@ -0,0 +1,24 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * PodLifecyclePhase is where a pod is in its lifecycle: its Kubernetes phase, or terminating once it has
+ * been deleted.
+ *
+ * - POD_LIFECYCLE_PHASE_UNSPECIFIED: POD_LIFECYCLE_PHASE_UNSPECIFIED indicates the phase is unknown.
+ * - POD_LIFECYCLE_PHASE_PENDING: POD_LIFECYCLE_PHASE_PENDING indicates the pod is scheduled or starting but not all of its containers run.
+ * - POD_LIFECYCLE_PHASE_RUNNING: POD_LIFECYCLE_PHASE_RUNNING indicates the pod's containers have started.
+ * - POD_LIFECYCLE_PHASE_TERMINATING: POD_LIFECYCLE_PHASE_TERMINATING indicates the pod has been deleted and is shutting down, e.g. when it
+ * is replaced during a rolling update.
+ * - POD_LIFECYCLE_PHASE_SUCCEEDED: POD_LIFECYCLE_PHASE_SUCCEEDED indicates all of the pod's containers exited successfully.
+ * - POD_LIFECYCLE_PHASE_FAILED: POD_LIFECYCLE_PHASE_FAILED indicates the pod's containers exited and at least one failed.
+ */
+export enum v1alpha1PodLifecyclePhase {
+    POD_LIFECYCLE_PHASE_UNSPECIFIED = 'POD_LIFECYCLE_PHASE_UNSPECIFIED',
+    POD_LIFECYCLE_PHASE_PENDING = 'POD_LIFECYCLE_PHASE_PENDING',
+    POD_LIFECYCLE_PHASE_RUNNING = 'POD_LIFECYCLE_PHASE_RUNNING',
+    POD_LIFECYCLE_PHASE_TERMINATING = 'POD_LIFECYCLE_PHASE_TERMINATING',
+    POD_LIFECYCLE_PHASE_SUCCEEDED = 'POD_LIFECYCLE_PHASE_SUCCEEDED',
+    POD_LIFECYCLE_PHASE_FAILED = 'POD_LIFECYCLE_PHASE_FAILED',
+}
@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ProtocolDetection } from './v1alpha1ProtocolDetection';
+export type v1alpha1PortProtocol = {
+    port?: number;
+    /**
+     * protocols are the application protocols served on the port: HTTP/1.1, HTTP/2, TCP or TLS. TLS is
+     * reported for TLS passed through without termination.
+     */
+    protocols?: Array<string>;
+    detection?: v1alpha1ProtocolDetection;
+};
+
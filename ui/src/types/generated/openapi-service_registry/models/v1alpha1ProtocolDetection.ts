@@ -0,0 +1,16 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * - UNKNOWN_PROTOCOL_DETECTION: UNKNOWN_PROTOCOL_DETECTION indicates the listener serves no recognizable filter chains for the port
+ * - EXPLICIT: EXPLICIT indicates the protocol was declared by the service port's name or appProtocol, so every
+ * filter chain for the port serves the same protocol
+ * - SNIFFED: SNIFFED indicates the protocol is sniffed from the first bytes of each connection, with HTTP and TCP
+ * filter chains for the port. Connections that do not look like HTTP fall back to TCP.
+ */
+export enum v1alpha1ProtocolDetection {
+    UNKNOWN_PROTOCOL_DETECTION = 'UNKNOWN_PROTOCOL_DETECTION',
+    EXPLICIT = 'EXPLICIT',
+    SNIFFED = 'SNIFFED',
+}
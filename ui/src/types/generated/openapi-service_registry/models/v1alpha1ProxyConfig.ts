@@ -6,6 +6,7 @@ import type { v1alpha1BootstrapSummary } from './v1alpha1BootstrapSummary';
 import type { v1alpha1ClusterSummary } from './v1alpha1ClusterSummary';
 import type { v1alpha1EndpointSummary } from './v1alpha1EndpointSummary';
 import type { v1alpha1ListenerSummary } from './v1alpha1ListenerSummary';
+import type { v1alpha1OutboundTrafficPolicyMode } from './v1alpha1OutboundTrafficPolicyMode';
 import type { v1alpha1RouteConfigSummary } from './v1alpha1RouteConfigSummary';
 /**
  * ProxyConfig represents the configuration of a proxy sidecar (e.g., Envoy).
@@ -43,5 +44,11 @@ export type v1alpha1ProxyConfig = {
      * raw_clusters is the original raw clusters output from /clusters?format=json endpoint.
      */
     rawClusters?: string;
+    /**
+     * outbound_traffic_policy_mode is how the proxy handles traffic to destinations outside the service
+     * registry, read from the catch-all filter chain of its virtual outbound listener. Unspecified when
+     * the proxy has no virtual outbound listener, such as gateways.
+     */
+    outboundTrafficPolicyMode?: v1alpha1OutboundTrafficPolicyMode;
 };
 
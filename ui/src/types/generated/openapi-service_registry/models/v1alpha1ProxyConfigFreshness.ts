@@ -0,0 +1,22 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ProxyConfigFreshness describes when a proxy configuration snapshot was taken from the proxy.
+ */
+export type v1alpha1ProxyConfigFreshness = {
+    /**
+     * fetched_at is when the configuration was retrieved from the proxy (RFC3339 format).
+     */
+    fetchedAt?: string;
+    /**
+     * age_seconds is how long before this response the configuration was retrieved.
+     */
+    ageSeconds?: string;
+    /**
+     * cached indicates the configuration was served from a previous snapshot rather than fetched for this request.
+     */
+    cached?: boolean;
+};
+
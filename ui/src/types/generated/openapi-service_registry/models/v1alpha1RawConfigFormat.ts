@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * RawConfigFormat is the format of a resource's raw_config.
+ *
+ * - RAW_CONFIG_FORMAT_JSON: The JSON collected from the cluster, unchanged
+ * - RAW_CONFIG_FORMAT_YAML: YAML with status and server-populated metadata (managedFields, resourceVersion, ...) stripped
+ */
+export enum v1alpha1RawConfigFormat {
+    RAW_CONFIG_FORMAT_UNSPECIFIED = 'RAW_CONFIG_FORMAT_UNSPECIFIED',
+    RAW_CONFIG_FORMAT_JSON = 'RAW_CONFIG_FORMAT_JSON',
+    RAW_CONFIG_FORMAT_YAML = 'RAW_CONFIG_FORMAT_YAML',
+}
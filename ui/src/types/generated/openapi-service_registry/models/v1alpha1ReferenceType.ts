@@ -0,0 +1,19 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ReferenceType describes how one resource refers to another.
+ *
+ * - REFERENCE_TYPE_GATEWAY: A VirtualService binds to a Gateway through its gateways field
+ * - REFERENCE_TYPE_HOST: A VirtualService, DestinationRule or ServiceEntry applies to a Service host
+ * - REFERENCE_TYPE_WORKLOAD_SELECTOR: A Gateway or policy selects a workload
+ * - REFERENCE_TYPE_ENDPOINT: A Service routes to a workload
+ */
+export enum v1alpha1ReferenceType {
+    REFERENCE_TYPE_UNSPECIFIED = 'REFERENCE_TYPE_UNSPECIFIED',
+    REFERENCE_TYPE_GATEWAY = 'REFERENCE_TYPE_GATEWAY',
+    REFERENCE_TYPE_HOST = 'REFERENCE_TYPE_HOST',
+    REFERENCE_TYPE_WORKLOAD_SELECTOR = 'REFERENCE_TYPE_WORKLOAD_SELECTOR',
+    REFERENCE_TYPE_ENDPOINT = 'REFERENCE_TYPE_ENDPOINT',
+}
@@ -0,0 +1,19 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1IstioResourceKind } from './v1alpha1IstioResourceKind';
+/**
+ * ResourceKindCount is the number of Istio resources of a kind. Kinds without resources are omitted.
+ */
+export type v1alpha1ResourceKindCount = {
+    /**
+     * kind is the kind of Istio resource.
+     */
+    kind?: v1alpha1IstioResourceKind;
+    /**
+     * count is the number of resources of the kind.
+     */
+    count?: number;
+};
+
@@ -0,0 +1,24 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1ReferenceType } from './v1alpha1ReferenceType';
+import type { v1alpha1ResourceRef } from './v1alpha1ResourceRef';
+/**
+ * ResourceReference is a directed edge in the resource reference graph: from uses to.
+ */
+export type v1alpha1ResourceReference = {
+    /**
+     * from is the referring resource.
+     */
+    from?: v1alpha1ResourceRef;
+    /**
+     * to is the referenced resource.
+     */
+    to?: v1alpha1ResourceRef;
+    /**
+     * type describes how from refers to to.
+     */
+    type?: v1alpha1ReferenceType;
+};
+
@@ -8,5 +8,16 @@ export type v1alpha1RouteInfo = {
     name?: string;
     match?: v1alpha1RouteMatchInfo;
     action?: v1alpha1RouteActionInfo;
+    /**
+     * metadata contains the route's string-valued filter metadata keyed by "<filter>.<key>",
+     * e.g. "istio.config" which names the resource that generated the route.
+     */
+    metadata?: Record<string, string>;
+    /**
+     * virtual_service_name is the name of the VirtualService that generated the route, read from its
+     * istio.config metadata. Empty when the route was not generated from a VirtualService.
+     */
+    virtualServiceName?: string;
+    virtualServiceNamespace?: string;
 };
 
@@ -2,9 +2,11 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1HeaderMatchInfo } from './v1alpha1HeaderMatchInfo';
 export type v1alpha1RouteMatchInfo = {
     pathSpecifier?: string;
     path?: string;
     caseSensitive?: boolean;
+    headerMatches?: Array<v1alpha1HeaderMatchInfo>;
 };
 
@@ -0,0 +1,12 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+export type v1alpha1RouteSimulationDestination = {
+    cluster?: string;
+    weight?: number;
+    serviceFqdn?: string;
+    port?: number;
+    subset?: string;
+};
+
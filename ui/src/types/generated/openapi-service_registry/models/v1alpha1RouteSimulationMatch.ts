@@ -0,0 +1,18 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1RouteInfo } from './v1alpha1RouteInfo';
+import type { v1alpha1RouteSimulationDestination } from './v1alpha1RouteSimulationDestination';
+export type v1alpha1RouteSimulationMatch = {
+    routeConfig?: string;
+    virtualHost?: string;
+    route?: v1alpha1RouteInfo;
+    destinations?: Array<v1alpha1RouteSimulationDestination>;
+    /**
+     * virtual_service is the VirtualService that generated the route, as namespace/name.
+     * Empty when the route was not generated from a VirtualService.
+     */
+    virtualService?: string;
+};
+
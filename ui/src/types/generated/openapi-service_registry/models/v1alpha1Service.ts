@@ -38,5 +38,30 @@ export type v1alpha1Service = {
      * Services with instances that have ProxyMode_ROUTER are gateway services.
      */
     proxyMode?: v1alpha1ProxyMode;
+    /**
+     * exported_clusters are the clusters exporting this service to their cluster set with a Multi-Cluster
+     * Services API ServiceExport.
+     */
+    exportedClusters?: Array<string>;
+    /**
+     * imported_clusters are the clusters importing this service from their cluster set with a Multi-Cluster
+     * Services API ServiceImport.
+     */
+    importedClusters?: Array<string>;
+    /**
+     * zone_spread maps zones to the number of instances of this service running in them, across all clusters.
+     * Instances in an unknown zone are not counted.
+     */
+    zoneSpread?: Record<string, number>;
+    /**
+     * external_names maps cluster names to the hostname the service resolves to in clusters where it is an
+     * ExternalName service. ExternalName services have no instances.
+     */
+    externalNames?: Record<string, string>;
+    /**
+     * canonical_services are the Istio canonical services of this service's instances, sorted by name. Metrics
+     * and traces of the service are reported under these names rather than the service name.
+     */
+    canonicalServices?: Array<string>;
 };
 
@@ -22,5 +22,19 @@ export type v1alpha1ServiceEntry = {
      * export_to controls the visibility of this service entry to other namespaces.
      */
     exportTo?: Array<string>;
+    /**
+     * raw_config_zstd is raw_config compressed with zstd. When set, raw_config is empty.
+     * It is only used between edge and manager; frontend responses always carry raw_config.
+     */
+    rawConfigZstd?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with (e.g. "networking.istio.io/v1alpha3"),
+     * as recorded by the API server. It is empty if unknown.
+     */
+    apiVersion?: string;
+    /**
+     * hosts are the hosts the service entry adds to the mesh's service registry.
+     */
+    hosts?: Array<string>;
 };
 
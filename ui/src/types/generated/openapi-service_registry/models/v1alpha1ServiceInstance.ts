@@ -2,6 +2,7 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { v1alpha1PodLifecyclePhase } from './v1alpha1PodLifecyclePhase';
 /**
  * ServiceInstance represents a single backend instance serving a service.
  */
@@ -27,5 +28,21 @@ export type v1alpha1ServiceInstance = {
      * envoy_present indicates whether this instance has an Envoy proxy sidecar.
      */
     envoyPresent?: boolean;
+    /**
+     * zone is the zone of the node hosting this instance. Empty when unknown.
+     */
+    zone?: string;
+    /**
+     * lifecycle_phase is where the instance's pod is in its lifecycle, terminating once it has been deleted.
+     */
+    lifecyclePhase?: v1alpha1PodLifecyclePhase;
+    /**
+     * canonical_service is the Istio canonical service of the instance's pod, which Istio's telemetry is keyed by.
+     */
+    canonicalService?: string;
+    /**
+     * canonical_revision is the revision of the instance's canonical service.
+     */
+    canonicalRevision?: string;
 };
 
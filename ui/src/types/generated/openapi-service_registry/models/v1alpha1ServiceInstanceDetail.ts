@@ -3,6 +3,9 @@
 /* tslint:disable */
 /* eslint-disable */
 import type { v1alpha1Container } from './v1alpha1Container';
+import type { v1alpha1LocalityInfo } from './v1alpha1LocalityInfo';
+import type { v1alpha1PodLifecyclePhase } from './v1alpha1PodLifecyclePhase';
+import type { v1alpha1Toleration } from './v1alpha1Toleration';
 /**
  * ServiceInstanceDetail represents detailed information about a specific service instance.
  */
@@ -60,5 +63,50 @@ export type v1alpha1ServiceInstanceDetail = {
      * is_envoy_present indicates whether this instance has an Envoy proxy sidecar.
      */
     isEnvoyPresent?: boolean;
+    /**
+     * init_containers is the list of init containers of this pod, such as istio-init or istio-validation.
+     */
+    initContainers?: Array<v1alpha1Container>;
+    /**
+     * service_account is the name of the Kubernetes service account the pod runs as.
+     */
+    serviceAccount?: string;
+    /**
+     * istio_annotations are the pod's annotations that change how Istio injects and configures its proxy:
+     * those prefixed with sidecar.istio.io/, traffic.sidecar.istio.io/ or proxy.istio.io/.
+     */
+    istioAnnotations?: Record<string, string>;
+    /**
+     * tolerations are the pod's tolerations.
+     */
+    tolerations?: Array<v1alpha1Toleration>;
+    /**
+     * locality is the region and zone of the node hosting this instance.
+     */
+    locality?: v1alpha1LocalityInfo;
+    /**
+     * zone_hints are the zones whose clients the EndpointSlice hints should be routed to this instance by
+     * topology aware routing.
+     */
+    zoneHints?: Array<string>;
+    /**
+     * lifecycle_phase is where the pod is in its lifecycle, terminating once it has been deleted.
+     */
+    lifecyclePhase?: v1alpha1PodLifecyclePhase;
+    /**
+     * deletion_timestamp is when the pod was deleted, in RFC 3339 format. Empty unless it is terminating.
+     */
+    deletionTimestamp?: string;
+    /**
+     * canonical_service is the Istio canonical service of the pod, which Istio's telemetry is keyed by. It is
+     * taken from the service.istio.io/canonical-name, app.kubernetes.io/name or app label, falling back to the
+     * name of the pod's workload.
+     */
+    canonicalService?: string;
+    /**
+     * canonical_revision is the revision of the pod's canonical service, taken from the
+     * service.istio.io/canonical-revision, app.kubernetes.io/version or version label. Defaults to "latest".
+     */
+    canonicalRevision?: string;
 };
 
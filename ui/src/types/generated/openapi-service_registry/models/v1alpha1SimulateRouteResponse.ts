@@ -0,0 +1,25 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1IstioResource } from './v1alpha1IstioResource';
+import type { v1alpha1ProxyConfigFreshness } from './v1alpha1ProxyConfigFreshness';
+import type { v1alpha1RouteSimulationMatch } from './v1alpha1RouteSimulationMatch';
+/**
+ * SimulateRouteResponse reports how the instance's proxy would route the simulated request.
+ */
+export type v1alpha1SimulateRouteResponse = {
+    /**
+     * matches contains the selected route for each evaluated route configuration that handles the request.
+     */
+    matches?: Array<v1alpha1RouteSimulationMatch>;
+    /**
+     * virtual_services contains the VirtualServices referenced by the matches, as collected from the instance's cluster.
+     */
+    virtualServices?: Array<v1alpha1IstioResource>;
+    /**
+     * freshness describes when the proxy configuration used for the simulation was captured.
+     */
+    freshness?: v1alpha1ProxyConfigFreshness;
+};
+
@@ -0,0 +1,31 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Toleration allows a pod to be scheduled onto nodes with a matching taint.
+ */
+export type v1alpha1Toleration = {
+    /**
+     * key is the taint key the toleration applies to. Empty matches all keys.
+     */
+    key?: string;
+    /**
+     * operator is the relationship between the key and the value: "Exists" or "Equal".
+     */
+    operator?: string;
+    /**
+     * value is the taint value the toleration matches when the operator is "Equal".
+     */
+    value?: string;
+    /**
+     * effect is the taint effect to match: "NoSchedule", "PreferNoSchedule" or "NoExecute". Empty matches all effects.
+     */
+    effect?: string;
+    /**
+     * toleration_seconds is how long a NoExecute taint is tolerated before the pod is evicted.
+     * Unset tolerates the taint forever.
+     */
+    tolerationSeconds?: string;
+};
+
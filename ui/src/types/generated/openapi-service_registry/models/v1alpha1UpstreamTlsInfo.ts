@@ -0,0 +1,15 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+export type v1alpha1UpstreamTlsInfo = {
+    sni?: string;
+    certificates?: Array<string>;
+    /**
+     * validation is the SDS secret validating upstream certificates, e.g. "ROOTCA" for Istio's root
+     * certificate. Empty when upstream certificates are not validated with an SDS secret.
+     */
+    validation?: string;
+    perEndpoint?: boolean;
+};
+
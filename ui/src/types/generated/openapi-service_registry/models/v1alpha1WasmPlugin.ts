@@ -28,5 +28,15 @@ export type v1alpha1WasmPlugin = {
      * target_refs is the list of resources that this wasm plugin applies to.
      */
     targetRefs?: Array<v1alpha1PolicyTargetReference>;
+    /**
+     * raw_config_zstd is raw_config compressed with zstd. When set, raw_config is empty.
+     * It is only used between edge and manager; frontend responses always carry raw_config.
+     */
+    rawConfigZstd?: string;
+    /**
+     * api_version is the apiVersion the resource was last written with (e.g. "networking.istio.io/v1alpha3"),
+     * as recorded by the API server. It is empty if unknown.
+     */
+    apiVersion?: string;
 };
 
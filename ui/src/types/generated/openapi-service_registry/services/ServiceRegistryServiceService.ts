@@ -2,16 +2,223 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { apiHttpBody } from '../models/apiHttpBody';
 import type { rpcStatus } from '../models/rpcStatus';
+import type { ServiceRegistryServiceSimulateRouteBody } from '../models/ServiceRegistryServiceSimulateRouteBody';
+import type { v1alpha1GetEnvoyAdminResponse } from '../models/v1alpha1GetEnvoyAdminResponse';
+import type { v1alpha1GetInstanceLogsResponse } from '../models/v1alpha1GetInstanceLogsResponse';
 import type { v1alpha1GetIstioResourcesResponse } from '../models/v1alpha1GetIstioResourcesResponse';
 import type { v1alpha1GetProxyConfigResponse } from '../models/v1alpha1GetProxyConfigResponse';
+import type { v1alpha1GetResourceInventoryResponse } from '../models/v1alpha1GetResourceInventoryResponse';
+import type { v1alpha1GetResourceReferencesResponse } from '../models/v1alpha1GetResourceReferencesResponse';
 import type { v1alpha1GetServiceInstanceResponse } from '../models/v1alpha1GetServiceInstanceResponse';
+import type { v1alpha1GetServiceProxyConfigsResponse } from '../models/v1alpha1GetServiceProxyConfigsResponse';
 import type { v1alpha1GetServiceResponse } from '../models/v1alpha1GetServiceResponse';
+import type { v1alpha1ListCanonicalServicesResponse } from '../models/v1alpha1ListCanonicalServicesResponse';
+import type { v1alpha1ListIstioResourcesResponse } from '../models/v1alpha1ListIstioResourcesResponse';
+import type { v1alpha1ListServiceInstancesResponse } from '../models/v1alpha1ListServiceInstancesResponse';
 import type { v1alpha1ListServicesResponse } from '../models/v1alpha1ListServicesResponse';
+import type { v1alpha1SimulateRouteResponse } from '../models/v1alpha1SimulateRouteResponse';
 import type { CancelablePromise } from '../core/CancelablePromise';
 import { OpenAPI } from '../core/OpenAPI';
 import { request as __request } from '../core/request';
 export class ServiceRegistryServiceService {
+    /**
+     * ListCanonicalServices groups service instances by their Istio canonical service, which Istio's telemetry
+     * is keyed by, listing the Kubernetes services each canonical service backs.
+     * @param namespace namespace is the Kubernetes namespace to list canonical services from.
+     * If not specified, canonical services from all namespaces are returned.
+     * @param clusterId cluster_id filters canonical services to only those from the specified cluster.
+     * If not specified, canonical services from all connected clusters are returned.
+     * @param clusterSelector cluster_selector filters canonical services to only those from clusters whose labels match it, using
+     * Kubernetes label selector syntax (e.g., "env=prod,region in (eu-west-1,eu-west-2)").
+     * @returns v1alpha1ListCanonicalServicesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceListCanonicalServices(
+        namespace?: string,
+        clusterId?: string,
+        clusterSelector?: string,
+    ): CancelablePromise<v1alpha1ListCanonicalServicesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/canonical-services',
+            query: {
+                'namespace': namespace,
+                'clusterId': clusterId,
+                'clusterSelector': clusterSelector,
+            },
+        });
+    }
+    /**
+     * ListIstioResources lists the Istio resources collected from connected clusters, independent of any workload.
+     * Results can be filtered by cluster, namespace and kind, and are paginated.
+     * @param namespace namespace filters resources to only those in the specified namespace.
+     * If not specified, resources from all namespaces are returned.
+     * @param clusterId cluster_id filters resources to only those from the specified cluster.
+     * If not specified, resources from all connected clusters are returned.
+     * @param kinds kinds filters resources to only those of the specified kinds.
+     * If not specified, resources of all kinds are returned.
+     * @param pageSize page_size is the maximum number of resources to return. Defaults to 100; values above 500 are capped.
+     * @param pageToken page_token is the next_page_token from a previous response with the same filters, used to retrieve the
+     * following page. Tokens are opaque.
+     * @param rawConfigFormat raw_config_format selects how raw_config is returned. Defaults to the JSON collected from the cluster.
+     *
+     * - RAW_CONFIG_FORMAT_JSON: The JSON collected from the cluster, unchanged
+     * - RAW_CONFIG_FORMAT_YAML: YAML with status and server-populated metadata (managedFields, resourceVersion, ...) stripped
+     * @returns v1alpha1ListIstioResourcesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceListIstioResources(
+        namespace?: string,
+        clusterId?: string,
+        kinds?: Array<'ISTIO_RESOURCE_KIND_UNSPECIFIED' | 'ISTIO_RESOURCE_KIND_AUTHORIZATION_POLICY' | 'ISTIO_RESOURCE_KIND_DESTINATION_RULE' | 'ISTIO_RESOURCE_KIND_ENVOY_FILTER' | 'ISTIO_RESOURCE_KIND_GATEWAY' | 'ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION' | 'ISTIO_RESOURCE_KIND_REQUEST_AUTHENTICATION' | 'ISTIO_RESOURCE_KIND_SERVICE_ENTRY' | 'ISTIO_RESOURCE_KIND_SIDECAR' | 'ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE' | 'ISTIO_RESOURCE_KIND_WASM_PLUGIN'>,
+        pageSize?: number,
+        pageToken?: string,
+        rawConfigFormat: 'RAW_CONFIG_FORMAT_UNSPECIFIED' | 'RAW_CONFIG_FORMAT_JSON' | 'RAW_CONFIG_FORMAT_YAML' = 'RAW_CONFIG_FORMAT_UNSPECIFIED',
+    ): CancelablePromise<v1alpha1ListIstioResourcesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/istio-resources',
+            query: {
+                'namespace': namespace,
+                'clusterId': clusterId,
+                'kinds': kinds,
+                'pageSize': pageSize,
+                'pageToken': pageToken,
+                'rawConfigFormat': rawConfigFormat,
+            },
+        });
+    }
+    /**
+     * DownloadIstioResources downloads the Istio resources matching the filters as a multi-document YAML file
+     * of cleaned, apply-able manifests. The response is served as a file attachment. Downloads larger than 64 MiB
+     * are rejected and must be narrowed by cluster, namespace or kind.
+     * @param namespace namespace filters resources to only those in the specified namespace.
+     * If not specified, resources from all namespaces are downloaded.
+     * @param clusterId cluster_id filters resources to only those from the specified cluster.
+     * If not specified, resources from all connected clusters are downloaded.
+     * @param kinds kinds filters resources to only those of the specified kinds.
+     * If not specified, resources of all kinds are downloaded.
+     * @returns apiHttpBody A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceDownloadIstioResources(
+        namespace?: string,
+        clusterId?: string,
+        kinds?: Array<'ISTIO_RESOURCE_KIND_UNSPECIFIED' | 'ISTIO_RESOURCE_KIND_AUTHORIZATION_POLICY' | 'ISTIO_RESOURCE_KIND_DESTINATION_RULE' | 'ISTIO_RESOURCE_KIND_ENVOY_FILTER' | 'ISTIO_RESOURCE_KIND_GATEWAY' | 'ISTIO_RESOURCE_KIND_PEER_AUTHENTICATION' | 'ISTIO_RESOURCE_KIND_REQUEST_AUTHENTICATION' | 'ISTIO_RESOURCE_KIND_SERVICE_ENTRY' | 'ISTIO_RESOURCE_KIND_SIDECAR' | 'ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE' | 'ISTIO_RESOURCE_KIND_WASM_PLUGIN'>,
+    ): CancelablePromise<apiHttpBody | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/istio-resources/download',
+            query: {
+                'namespace': namespace,
+                'clusterId': clusterId,
+                'kinds': kinds,
+            },
+        });
+    }
+    /**
+     * GetResourceInventory counts the Istio resources of each kind collected from connected clusters, per namespace
+     * per cluster and in total, without returning the resources themselves.
+     * @param clusterId cluster_id counts only the resources from the specified cluster.
+     * If not specified, resources from all connected clusters are counted.
+     * @returns v1alpha1GetResourceInventoryResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetResourceInventory(
+        clusterId?: string,
+    ): CancelablePromise<v1alpha1GetResourceInventoryResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/istio-resources/inventory',
+            query: {
+                'clusterId': clusterId,
+            },
+        });
+    }
+    /**
+     * GetResourceReferences returns the references to and from a resource in its cluster's reference graph,
+     * linking VirtualServices to the Gateways they bind, VirtualServices and DestinationRules to the Services
+     * they target, Services to their workloads, and Gateways and policies to the workloads they select.
+     * @param clusterId cluster_id is the cluster the resource was collected from.
+     * @param kind kind is the Kubernetes kind of the resource, e.g. "VirtualService", "Gateway", "DestinationRule",
+     * "Service" or "Pod".
+     * @param namespace namespace is the namespace of the resource.
+     * @param name name is the name of the resource.
+     * @returns v1alpha1GetResourceReferencesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetResourceReferences(
+        clusterId?: string,
+        kind?: string,
+        namespace?: string,
+        name?: string,
+    ): CancelablePromise<v1alpha1GetResourceReferencesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/resource-references',
+            query: {
+                'clusterId': clusterId,
+                'kind': kind,
+                'namespace': namespace,
+                'name': name,
+            },
+        });
+    }
+    /**
+     * ListServiceInstances lists service instances across all services and connected clusters.
+     * Results can be filtered by cluster, namespace, node, sidecar presence, labels and health, and are paginated.
+     * @param clusterId cluster_id filters instances to only those in the specified cluster.
+     * If not specified, instances from all connected clusters are returned.
+     * @param namespace namespace filters instances to only those in the specified namespace.
+     * If not specified, instances from all namespaces are returned.
+     * @param nodeName node_name filters instances to only those scheduled on the specified node.
+     * @param envoyPresent envoy_present filters instances by whether they have an Envoy proxy sidecar.
+     * If not specified, instances are returned regardless of their sidecar.
+     * @param labelSelector label_selector filters instances by their pod labels, using Kubernetes label selector syntax
+     * (e.g., "app=reviews,version in (v1,v2)").
+     * @param health health filters instances by their health. Defaults to returning all instances.
+     *
+     * - INSTANCE_HEALTH_HEALTHY: The pod is running and all of its containers are ready
+     * - INSTANCE_HEALTH_UNHEALTHY: The pod is not running or any of its containers is not ready
+     * @param pageSize page_size is the maximum number of instances to return. Defaults to 100; values above 500 are capped.
+     * @param pageToken page_token is the next_page_token from a previous response with the same filters, used to retrieve the
+     * following page. Tokens are opaque.
+     * @returns v1alpha1ListServiceInstancesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceListServiceInstances(
+        clusterId?: string,
+        namespace?: string,
+        nodeName?: string,
+        envoyPresent?: boolean,
+        labelSelector?: string,
+        health: 'INSTANCE_HEALTH_UNSPECIFIED' | 'INSTANCE_HEALTH_HEALTHY' | 'INSTANCE_HEALTH_UNHEALTHY' = 'INSTANCE_HEALTH_UNSPECIFIED',
+        pageSize?: number,
+        pageToken?: string,
+    ): CancelablePromise<v1alpha1ListServiceInstancesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/service-instances',
+            query: {
+                'clusterId': clusterId,
+                'namespace': namespace,
+                'nodeName': nodeName,
+                'envoyPresent': envoyPresent,
+                'labelSelector': labelSelector,
+                'health': health,
+                'pageSize': pageSize,
+                'pageToken': pageToken,
+            },
+        });
+    }
     /**
      * ListServices returns all services in the specified namespace, or all namespaces if not specified.
      * Services are aggregated across all connected clusters.
@@ -79,6 +286,72 @@ export class ServiceRegistryServiceService {
             },
         });
     }
+    /**
+     * GetProxyConfigDump downloads the full, unsummarized Envoy config_dump JSON for a specific service instance,
+     * suitable for attaching to upstream bug reports. The response is served as a file attachment.
+     * @param serviceId service_id is the unique identifier of the service.
+     * Format: namespace:service-name (e.g., "default:nginx-service")
+     * @param instanceId instance_id is the unique identifier of the service instance.
+     * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param gzip gzip compresses the download with gzip.
+     * @param includeEds include_eds includes endpoint (EDS) configuration in the dump.
+     * @returns apiHttpBody A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetProxyConfigDump(
+        serviceId: string,
+        instanceId: string,
+        gzip?: boolean,
+        includeEds?: boolean,
+    ): CancelablePromise<apiHttpBody | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/services/{serviceId}/instances/{instanceId}/config-dump',
+            path: {
+                'serviceId': serviceId,
+                'instanceId': instanceId,
+            },
+            query: {
+                'gzip': gzip,
+                'includeEds': includeEds,
+            },
+        });
+    }
+    /**
+     * GetEnvoyAdmin queries an allowlisted, read-only Envoy admin endpoint (e.g. stats, config_dump)
+     * on a specific service instance's proxy and returns the raw output.
+     * @param serviceId service_id is the unique identifier of the service.
+     * Format: namespace:service-name (e.g., "default:nginx-service")
+     * @param instanceId instance_id is the unique identifier of the service instance.
+     * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param path path is the Envoy admin endpoint to query. Only read-only endpoints are allowed:
+     * certs, clusters, config_dump, listeners, logging, memory, ready, runtime, server_info, stats, stats/prometheus.
+     * @param query query is the query string passed to the admin endpoint, without the leading "?"
+     * (e.g., "filter=^cluster&format=json"). logging does not accept one, since it would change the log levels.
+     * @returns v1alpha1GetEnvoyAdminResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetEnvoyAdmin(
+        serviceId: string,
+        instanceId: string,
+        path: string,
+        query?: string,
+    ): CancelablePromise<v1alpha1GetEnvoyAdminResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/services/{serviceId}/instances/{instanceId}/envoy-admin/{path}',
+            path: {
+                'serviceId': serviceId,
+                'instanceId': instanceId,
+                'path': path,
+            },
+            query: {
+                'query': query,
+            },
+        });
+    }
     /**
      * GetIstioResources retrieves the Istio configuration resources for a specific service instance.
      * @param serviceId service_id is the unique identifier of the service.
@@ -102,12 +375,51 @@ export class ServiceRegistryServiceService {
             },
         });
     }
+    /**
+     * GetInstanceLogs retrieves container logs for a specific service instance through its cluster's edge.
+     * @param serviceId service_id is the unique identifier of the service.
+     * Format: namespace:service-name (e.g., "default:nginx-service")
+     * @param instanceId instance_id is the unique identifier of the service instance.
+     * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param container container is the container to retrieve logs from (e.g., "istio-proxy").
+     * If not specified, the pod's default container is used.
+     * @param tailLines tail_lines limits the number of lines returned from the end of the log.
+     * @param sinceSeconds since_seconds limits the logs to those written within this many seconds.
+     * @param previous previous returns logs from the previous terminated instance of the container.
+     * @returns v1alpha1GetInstanceLogsResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetInstanceLogs(
+        serviceId: string,
+        instanceId: string,
+        container?: string,
+        tailLines?: string,
+        sinceSeconds?: string,
+        previous?: boolean,
+    ): CancelablePromise<v1alpha1GetInstanceLogsResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/services/{serviceId}/instances/{instanceId}/logs',
+            path: {
+                'serviceId': serviceId,
+                'instanceId': instanceId,
+            },
+            query: {
+                'container': container,
+                'tailLines': tailLines,
+                'sinceSeconds': sinceSeconds,
+                'previous': previous,
+            },
+        });
+    }
     /**
      * GetProxyConfig retrieves the Envoy proxy configuration for a specific service instance.
      * @param serviceId service_id is the unique identifier of the service.
      * Format: namespace:service-name (e.g., "default:nginx-service")
      * @param instanceId instance_id is the unique identifier of the service instance.
      * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param forceRefresh force_refresh bypasses any cached configuration and takes a new snapshot from the proxy.
      * @returns v1alpha1GetProxyConfigResponse A successful response.
      * @returns rpcStatus An unexpected error response.
      * @throws ApiError
@@ -115,6 +427,7 @@ export class ServiceRegistryServiceService {
     public static serviceRegistryServiceGetProxyConfig(
         serviceId: string,
         instanceId: string,
+        forceRefresh?: boolean,
     ): CancelablePromise<v1alpha1GetProxyConfigResponse | rpcStatus> {
         return __request(OpenAPI, {
             method: 'GET',
@@ -123,6 +436,61 @@ export class ServiceRegistryServiceService {
                 'serviceId': serviceId,
                 'instanceId': instanceId,
             },
+            query: {
+                'forceRefresh': forceRefresh,
+            },
+        });
+    }
+    /**
+     * SimulateRoute evaluates an HTTP request against a service instance's proxy routes and reports
+     * the route that would handle it, its destination clusters and the VirtualService that generated it.
+     * @param serviceId service_id is the unique identifier of the service.
+     * Format: namespace:service-name (e.g., "default:nginx-service")
+     * @param instanceId instance_id is the unique identifier of the service instance whose proxy routes the request.
+     * Format: cluster_id:namespace:pod_name (e.g., "cluster1:default:nginx-pod-123")
+     * @param body
+     * @returns v1alpha1SimulateRouteResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceSimulateRoute(
+        serviceId: string,
+        instanceId: string,
+        body: ServiceRegistryServiceSimulateRouteBody,
+    ): CancelablePromise<v1alpha1SimulateRouteResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'POST',
+            url: '/api/v1alpha1/services/{serviceId}/instances/{instanceId}/simulate-route',
+            path: {
+                'serviceId': serviceId,
+                'instanceId': instanceId,
+            },
+            body: body,
+        });
+    }
+    /**
+     * GetServiceProxyConfigs retrieves the Envoy proxy configuration for every instance of a service in parallel,
+     * so configuration consistency across replicas can be checked in one call. Failures are reported per instance.
+     * @param serviceId service_id is the unique identifier of the service.
+     * Format: namespace:service-name (e.g., "default:nginx-service")
+     * @param forceRefresh force_refresh bypasses any cached configuration and takes new snapshots from every proxy.
+     * @returns v1alpha1GetServiceProxyConfigsResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static serviceRegistryServiceGetServiceProxyConfigs(
+        serviceId: string,
+        forceRefresh?: boolean,
+    ): CancelablePromise<v1alpha1GetServiceProxyConfigsResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/services/{serviceId}/proxy-configs',
+            path: {
+                'serviceId': serviceId,
+            },
+            query: {
+                'forceRefresh': forceRefresh,
+            },
         });
     }
 }
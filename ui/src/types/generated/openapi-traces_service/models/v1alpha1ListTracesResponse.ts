@@ -0,0 +1,23 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { v1alpha1TraceSummary } from './v1alpha1TraceSummary';
+/**
+ * ListTracesResponse contains the traces of a service.
+ */
+export type v1alpha1ListTracesResponse = {
+    /**
+     * traces are the traces found across all clusters, most recent first.
+     */
+    traces?: Array<v1alpha1TraceSummary>;
+    /**
+     * clusters_queried lists the clusters whose tracing backends were searched.
+     */
+    clustersQueried?: Array<string>;
+    /**
+     * warnings describes clusters whose traces could not be retrieved.
+     */
+    warnings?: Array<string>;
+};
+
@@ -0,0 +1,46 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * TraceSummary summarizes a trace found in a tracing backend.
+ */
+export type v1alpha1TraceSummary = {
+    /**
+     * trace_id is the ID of the trace in the tracing backend.
+     */
+    traceId?: string;
+    /**
+     * root_service is the service of the trace's root span.
+     */
+    rootService?: string;
+    /**
+     * root_operation is the operation of the trace's root span.
+     */
+    rootOperation?: string;
+    /**
+     * start_time is when the trace started.
+     */
+    startTime?: string;
+    /**
+     * duration is the time from the start of the trace's first span to the end of its last span.
+     */
+    duration?: string;
+    /**
+     * span_count is the number of spans in the trace, if known.
+     */
+    spanCount?: number;
+    /**
+     * error indicates whether any span of the trace recorded an error.
+     */
+    error?: boolean;
+    /**
+     * services are the services with spans in the trace, ordered by name.
+     */
+    services?: Array<string>;
+    /**
+     * cluster is the cluster whose tracing backend the trace was found in.
+     */
+    cluster?: string;
+};
+
@@ -0,0 +1,46 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+import type { rpcStatus } from '../models/rpcStatus';
+import type { v1alpha1ListTracesResponse } from '../models/v1alpha1ListTracesResponse';
+import type { CancelablePromise } from '../core/CancelablePromise';
+import { OpenAPI } from '../core/OpenAPI';
+import { request as __request } from '../core/request';
+export class TracesServiceService {
+    /**
+     * ListTraces returns the recent traces of a service across all clusters with a tracing backend.
+     * @param serviceName service_name is the name of the service whose traces to list (required).
+     * @param namespace namespace is the namespace of the service (required).
+     * @param startTime start_time is the start of the time window. Must be in the past.
+     * @param endTime end_time is the end of the time window. Must be in the past and after start_time.
+     * @param minDuration min_duration limits the list to traces at least this long, e.g. to find slow requests.
+     * @param limit limit is the most traces to return. Defaults to 20, at most 100.
+     * @returns v1alpha1ListTracesResponse A successful response.
+     * @returns rpcStatus An unexpected error response.
+     * @throws ApiError
+     */
+    public static tracesServiceListTraces(
+        serviceName: string,
+        namespace?: string,
+        startTime?: string,
+        endTime?: string,
+        minDuration?: string,
+        limit?: number,
+    ): CancelablePromise<v1alpha1ListTracesResponse | rpcStatus> {
+        return __request(OpenAPI, {
+            method: 'GET',
+            url: '/api/v1alpha1/traces/service/{serviceName}',
+            path: {
+                'serviceName': serviceName,
+            },
+            query: {
+                'namespace': namespace,
+                'startTime': startTime,
+                'endTime': endTime,
+                'minDuration': minDuration,
+                'limit': limit,
+            },
+        });
+    }
+}
@@ -32,6 +32,7 @@ mockedAxios.create = jest.fn(() => mockAxiosInstance);
 
 // Import the API module after setting up mocks
 import { serviceApi } from './api';
+import { v1alpha1IstioResourceKind } from '../types/generated/openapi-service_registry';
 
 describe('API utilities', () => {
     beforeEach(() => {
@@ -162,6 +163,21 @@ describe('API utilities', () => {
             expect(result).toEqual(mockResponse.data);
         });
 
+        it('should request a fresh snapshot when forced', async () => {
+            mockAxiosInstance.get.mockResolvedValue({ data: {} });
+
+            await serviceApi.getProxyConfig(
+                'test-service-id',
+                'test-instance-id',
+                true
+            );
+
+            expect(mockAxiosInstance.get).toHaveBeenCalledWith(
+                '/api/v1alpha1/services/test-service-id/instances/test-instance-id/proxy-config',
+                { params: { forceRefresh: true } }
+            );
+        });
+
         it('should handle fetch proxy config error', async () => {
             const mockError = new Error('Config not available');
             mockAxiosInstance.get.mockRejectedValue(mockError);
@@ -199,6 +215,159 @@ describe('API utilities', () => {
         });
     });
 
+    describe('analyzeClusters', () => {
+        it('should analyze all clusters', async () => {
+            const mockResponse = {
+                data: {
+                    clusters: [
+                        {
+                            clusterId: 'cluster-1',
+                            findings: [
+                                {
+                                    code: 'GatewayNoWorkloads',
+                                    severity: 'ANALYSIS_SEVERITY_WARNING',
+                                },
+                            ],
+                        },
+                    ],
+                },
+            };
+            mockAxiosInstance.get.mockResolvedValue(mockResponse);
+
+            const result = await serviceApi.analyzeClusters();
+
+            expect(mockAxiosInstance.get).toHaveBeenCalledWith(
+                '/api/v1alpha1/analysis',
+                { params: undefined }
+            );
+            expect(result).toEqual(mockResponse.data.clusters);
+        });
+
+        it('should analyze a single cluster', async () => {
+            mockAxiosInstance.get.mockResolvedValue({ data: {} });
+
+            const result = await serviceApi.analyzeClusters('cluster-1');
+
+            expect(mockAxiosInstance.get).toHaveBeenCalledWith(
+                '/api/v1alpha1/analysis',
+                { params: { clusterId: 'cluster-1' } }
+            );
+            expect(result).toEqual([]);
+        });
+    });
+
+    describe('listTraces', () => {
+        it('should list the slow traces of a service', async () => {
+            const mockResponse = {
+                data: {
+                    traces: [
+                        {
+                            traceId: 'abc123',
+                            rootService: 'productpage.bookinfo',
+                            duration: '1.500s',
+                            cluster: 'cluster-1',
+                        },
+                    ],
+                    clustersQueried: ['cluster-1'],
+                },
+            };
+            mockAxiosInstance.get.mockResolvedValue(mockResponse);
+
+            const result = await serviceApi.listTraces(
+                'productpage',
+                'bookinfo',
+                new Date('2025-01-01T00:00:00Z'),
+                new Date('2025-01-01T01:00:00Z'),
+                { minDuration: '1s', limit: 10 }
+            );
+
+            expect(mockAxiosInstance.get).toHaveBeenCalledWith(
+                '/api/v1alpha1/traces/service/productpage',
+                {
+                    params: {
+                        namespace: 'bookinfo',
+                        startTime: '2025-01-01T00:00:00.000Z',
+                        endTime: '2025-01-01T01:00:00.000Z',
+                        minDuration: '1s',
+                        limit: 10,
+                    },
+                }
+            );
+            expect(result).toEqual(mockResponse.data);
+        });
+    });
+
+    describe('listAccessLogs', () => {
+        it('should list the failed requests of an instance', async () => {
+            const mockResponse = {
+                data: {
+                    entries: [
+                        {
+                            podName: 'reviews-v1-abc',
+                            method: 'GET',
+                            path: '/reviews/1',
+                            responseCode: 503,
+                        },
+                    ],
+                    clustersQueried: ['cluster-1'],
+                },
+            };
+            mockAxiosInstance.get.mockResolvedValue(mockResponse);
+
+            const result = await serviceApi.listAccessLogs(
+                'reviews',
+                'bookinfo',
+                new Date('2025-01-01T00:00:00Z'),
+                new Date('2025-01-01T01:00:00Z'),
+                {
+                    instanceId: 'cluster-1:bookinfo:reviews-v1-abc',
+                    responseCodes: ['404', '5xx'],
+                    pathPrefix: '/reviews',
+                }
+            );
+
+            const [url, config] = mockAxiosInstance.get.mock.calls[0];
+            expect(url).toBe('/api/v1alpha1/access-logs/service/reviews');
+            expect(config.params.toString()).toBe(
+                'namespace=bookinfo&startTime=2025-01-01T00%3A00%3A00.000Z&endTime=2025-01-01T01%3A00%3A00.000Z&instanceId=cluster-1%3Abookinfo%3Areviews-v1-abc&responseCodes=404&responseCodes=5xx&pathPrefix=%2Freviews'
+            );
+            expect(result).toEqual(mockResponse.data);
+        });
+    });
+
+    describe('dryRunAuthorizationPolicy', () => {
+        it('should post the drafted policy', async () => {
+            const mockResponse = {
+                data: {
+                    flowsEvaluated: 2,
+                    deniedFlows: [
+                        {
+                            sourceNamespace: 'monitoring',
+                            sourceService: 'prober',
+                            destinationNamespace: 'default',
+                            destinationService: 'reviews',
+                            verdict: 'AUTHORIZATION_VERDICT_DENIED',
+                        },
+                    ],
+                },
+            };
+            mockAxiosInstance.post.mockResolvedValue(mockResponse);
+
+            const policy =
+                'kind: AuthorizationPolicy\nmetadata:\n  name: reviews\n  namespace: default\n';
+            const result = await serviceApi.dryRunAuthorizationPolicy(
+                'cluster-1',
+                policy
+            );
+
+            expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+                '/api/v1alpha1/analysis/cluster-1/authorization-policy-dry-run',
+                { policy }
+            );
+            expect(result).toEqual(mockResponse.data);
+        });
+    });
+
     describe('getIstioResources', () => {
         it('should fetch Istio resources successfully', async () => {
             const mockResponse = {
@@ -233,4 +402,98 @@ describe('API utilities', () => {
             ).rejects.toThrow('Istio resources unavailable');
         });
     });
+
+    describe('getIstioResourcesDownloadUrl', () => {
+        it('should build the download URL without filters', () => {
+            expect(serviceApi.getIstioResourcesDownloadUrl()).toBe(
+                '/api/v1alpha1/istio-resources/download'
+            );
+        });
+
+        it('should include filters as query parameters', () => {
+            expect(
+                serviceApi.getIstioResourcesDownloadUrl({
+                    namespace: 'default',
+                    clusterId: 'cluster-1',
+                    kinds: [
+                        v1alpha1IstioResourceKind.ISTIO_RESOURCE_KIND_GATEWAY,
+                        v1alpha1IstioResourceKind.ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE,
+                    ],
+                })
+            ).toBe(
+                '/api/v1alpha1/istio-resources/download?namespace=default&clusterId=cluster-1&kinds=ISTIO_RESOURCE_KIND_GATEWAY&kinds=ISTIO_RESOURCE_KIND_VIRTUAL_SERVICE'
+            );
+        });
+    });
+
+    describe('getResourceReferences', () => {
+        it('should fetch references for a resource', async () => {
+            const resource = {
+                clusterId: 'cluster-1',
+                kind: 'Gateway',
+                namespace: 'istio-system',
+                name: 'public',
+            };
+            const mockResponse = {
+                data: {
+                    resource,
+                    uses: [],
+                    usedBy: [
+                        {
+                            from: {
+                                clusterId: 'cluster-1',
+                                kind: 'VirtualService',
+                                namespace: 'default',
+                                name: 'reviews',
+                            },
+                            to: resource,
+                            type: 'REFERENCE_TYPE_GATEWAY',
+                        },
+                    ],
+                },
+            };
+            mockAxiosInstance.get.mockResolvedValue(mockResponse);
+
+            const result = await serviceApi.getResourceReferences(resource);
+
+            expect(mockAxiosInstance.get).toHaveBeenCalledWith(
+                '/api/v1alpha1/resource-references',
+                { params: resource }
+            );
+            expect(result).toEqual(mockResponse.data);
+        });
+    });
+
+    describe('simulateRoute', () => {
+        it('should post the simulated request', async () => {
+            const mockResponse = {
+                data: {
+                    matches: [
+                        {
+                            routeConfig: '9080',
+                            virtualService: 'default/reviews',
+                        },
+                    ],
+                },
+            };
+            mockAxiosInstance.post.mockResolvedValue(mockResponse);
+
+            const request = {
+                host: 'reviews:9080',
+                path: '/reviews/1',
+                headers: { 'end-user': 'jason' },
+            };
+            const result = await serviceApi.simulateRoute(
+                'test-service-id',
+                'test-instance-id',
+                request
+            );
+
+            expect(mockAxiosInstance.post).toHaveBeenCalledWith(
+                '/api/v1alpha1/services/test-service-id/instances/test-instance-id/simulate-route',
+                request
+            );
+            expect(result).toEqual(mockResponse.data);
+        });
+    });
 });
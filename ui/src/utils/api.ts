@@ -19,11 +19,35 @@ import type {
     v1alpha1GetProxyConfigResponse,
     v1alpha1ServiceInstanceDetail,
     v1alpha1GetIstioResourcesResponse,
+    v1alpha1ContainerLogs,
+    v1alpha1GetInstanceLogsResponse,
+    v1alpha1GetEnvoyAdminResponse,
+    v1alpha1InstanceProxyConfig,
+    v1alpha1GetServiceProxyConfigsResponse,
+    v1alpha1IstioResourceKind,
+    v1alpha1ListIstioResourcesResponse,
+    v1alpha1RawConfigFormat,
+    v1alpha1GetResourceReferencesResponse,
+    ServiceRegistryServiceSimulateRouteBody,
+    v1alpha1SimulateRouteResponse,
+    v1alpha1CanonicalService,
+    v1alpha1ListCanonicalServicesResponse,
 } from '../types/generated/openapi-service_registry';
 import type {
     v1alpha1ListClustersResponse,
     v1alpha1ClusterSyncInfo,
+    v1alpha1GetSyncStatusResponse,
+    v1alpha1Change,
+    v1alpha1ListChangesResponse,
+    v1alpha1GetCapabilitiesResponse,
 } from '../types/generated/openapi-cluster_registry';
+import type {
+    v1alpha1AnalyzeClustersResponse,
+    v1alpha1DryRunAuthorizationPolicyResponse,
+    v1alpha1ClusterAnalysis,
+} from '../types/generated/openapi-analysis_service';
+import type { v1alpha1ListTracesResponse } from '../types/generated/openapi-traces_service';
+import type { v1alpha1ListAccessLogsResponse } from '../types/generated/openapi-access_logs_service';
 
 const API_BASE_URL =
     (typeof window !== 'undefined' && (window as any).VITE_API_URL) || ''; // eslint-disable-line @typescript-eslint/no-explicit-any
@@ -43,6 +67,13 @@ export const serviceApi = {
         return response.data.services || [];
     },
 
+    listCanonicalServices: async (): Promise<v1alpha1CanonicalService[]> => {
+        const response = await api.get<v1alpha1ListCanonicalServicesResponse>(
+            '/api/v1alpha1/canonical-services'
+        );
+        return response.data.canonicalServices || [];
+    },
+
     getService: async (id: string): Promise<v1alpha1Service> => {
         const response = await api.get<{ service: v1alpha1Service }>(
             `/api/v1alpha1/services/${id}`
@@ -62,14 +93,31 @@ export const serviceApi = {
 
     getProxyConfig: async (
         serviceId: string,
-        instanceId: string
+        instanceId: string,
+        forceRefresh = false
     ): Promise<v1alpha1GetProxyConfigResponse> => {
-        const response = await api.get<v1alpha1GetProxyConfigResponse>(
-            `/api/v1alpha1/services/${serviceId}/instances/${instanceId}/proxy-config`
-        );
+        const url = `/api/v1alpha1/services/${serviceId}/instances/${instanceId}/proxy-config`;
+        const response = forceRefresh
+            ? await api.get<v1alpha1GetProxyConfigResponse>(url, {
+                  params: { forceRefresh: true },
+              })
+            : await api.get<v1alpha1GetProxyConfigResponse>(url);
         return response.data;
     },
 
+    getServiceProxyConfigs: async (
+        serviceId: string,
+        forceRefresh = false
+    ): Promise<v1alpha1InstanceProxyConfig[]> => {
+        const url = `/api/v1alpha1/services/${serviceId}/proxy-configs`;
+        const response = forceRefresh
+            ? await api.get<v1alpha1GetServiceProxyConfigsResponse>(url, {
+                  params: { forceRefresh: true },
+              })
+            : await api.get<v1alpha1GetServiceProxyConfigsResponse>(url);
+        return response.data.instances || [];
+    },
+
     listClusters: async (): Promise<v1alpha1ClusterSyncInfo[]> => {
         const response = await api.get<v1alpha1ListClustersResponse>(
             '/api/v1alpha1/clusters'
@@ -77,6 +125,112 @@ export const serviceApi = {
         return response.data.clusters || [];
     },
 
+    listChanges: async (
+        clusterId?: string,
+        limit?: number
+    ): Promise<v1alpha1Change[]> => {
+        const response = await api.get<v1alpha1ListChangesResponse>(
+            '/api/v1alpha1/changes',
+            { params: { clusterId, limit } }
+        );
+        return response.data.changes || [];
+    },
+
+    getCapabilities: async (): Promise<v1alpha1GetCapabilitiesResponse> => {
+        const response = await api.get<v1alpha1GetCapabilitiesResponse>(
+            '/api/v1alpha1/capabilities'
+        );
+        return response.data;
+    },
+
+    dryRunAuthorizationPolicy: async (
+        clusterId: string,
+        policy: string
+    ): Promise<v1alpha1DryRunAuthorizationPolicyResponse> => {
+        const response =
+            await api.post<v1alpha1DryRunAuthorizationPolicyResponse>(
+                `/api/v1alpha1/analysis/${clusterId}/authorization-policy-dry-run`,
+                { policy }
+            );
+        return response.data;
+    },
+
+    getClusterSyncStatus: async (
+        clusterId: string
+    ): Promise<v1alpha1ClusterSyncInfo | undefined> => {
+        const response = await api.get<v1alpha1GetSyncStatusResponse>(
+            `/api/v1alpha1/clusters/${clusterId}/sync-status`
+        );
+        return response.data.cluster;
+    },
+
+    resyncCluster: async (clusterId: string): Promise<void> => {
+        await api.post(
+            `/api/v1alpha1/clusters/${clusterId}/resync`
+        );
+    },
+
+    analyzeClusters: async (
+        clusterId?: string
+    ): Promise<v1alpha1ClusterAnalysis[]> => {
+        const response = await api.get<v1alpha1AnalyzeClustersResponse>(
+            '/api/v1alpha1/analysis',
+            { params: clusterId ? { clusterId } : undefined }
+        );
+        return response.data.clusters || [];
+    },
+
+    listTraces: async (
+        serviceName: string,
+        namespace: string,
+        startTime: Date,
+        endTime: Date,
+        options: { minDuration?: string; limit?: number } = {}
+    ): Promise<v1alpha1ListTracesResponse> => {
+        const response = await api.get<v1alpha1ListTracesResponse>(
+            `/api/v1alpha1/traces/service/${serviceName}`,
+            {
+                params: {
+                    namespace,
+                    startTime: startTime.toISOString(),
+                    endTime: endTime.toISOString(),
+                    ...options,
+                },
+            }
+        );
+        return response.data;
+    },
+
+    // Repeated query parameters are sent as responseCodes=404&responseCodes=5xx, which is what the gateway expects
+    listAccessLogs: async (
+        serviceName: string,
+        namespace: string,
+        startTime: Date,
+        endTime: Date,
+        options: {
+            instanceId?: string;
+            responseCodes?: string[];
+            pathPrefix?: string;
+            limit?: number;
+        } = {}
+    ): Promise<v1alpha1ListAccessLogsResponse> => {
+        const params = new URLSearchParams();
+        params.set('namespace', namespace);
+        params.set('startTime', startTime.toISOString());
+        params.set('endTime', endTime.toISOString());
+        if (options.instanceId) params.set('instanceId', options.instanceId);
+        options.responseCodes?.forEach((code) =>
+            params.append('responseCodes', code)
+        );
+        if (options.pathPrefix) params.set('pathPrefix', options.pathPrefix);
+        if (options.limit) params.set('limit', String(options.limit));
+        const response = await api.get<v1alpha1ListAccessLogsResponse>(
+            `/api/v1alpha1/access-logs/service/${serviceName}`,
+            { params }
+        );
+        return response.data;
+    },
+
     getIstioResources: async (
         serviceId: string,
         instanceId: string
@@ -86,6 +240,114 @@ export const serviceApi = {
         );
         return response.data;
     },
+
+    getInstanceLogs: async (
+        serviceId: string,
+        instanceId: string,
+        options: {
+            container?: string;
+            tailLines?: number;
+            sinceSeconds?: number;
+            previous?: boolean;
+        } = {}
+    ): Promise<v1alpha1ContainerLogs | undefined> => {
+        const response = await api.get<v1alpha1GetInstanceLogsResponse>(
+            `/api/v1alpha1/services/${serviceId}/instances/${instanceId}/logs`,
+            { params: options }
+        );
+        return response.data.logs;
+    },
+
+    getProxyConfigDumpUrl: (
+        serviceId: string,
+        instanceId: string,
+        options: { gzip?: boolean; includeEds?: boolean } = {}
+    ): string => {
+        const params = new URLSearchParams();
+        if (options.gzip) params.set('gzip', 'true');
+        if (options.includeEds) params.set('includeEds', 'true');
+        const query = params.toString();
+        return `${API_BASE_URL}/api/v1alpha1/services/${serviceId}/instances/${instanceId}/config-dump${query ? `?${query}` : ''}`;
+    },
+
+    // Repeated query parameters are sent as kinds=A&kinds=B, which is what the gateway expects
+    listIstioResources: async (
+        options: {
+            namespace?: string;
+            clusterId?: string;
+            kinds?: v1alpha1IstioResourceKind[];
+            pageSize?: number;
+            pageToken?: string;
+            rawConfigFormat?: v1alpha1RawConfigFormat;
+        } = {}
+    ): Promise<v1alpha1ListIstioResourcesResponse> => {
+        const params = new URLSearchParams();
+        if (options.namespace) params.set('namespace', options.namespace);
+        if (options.clusterId) params.set('clusterId', options.clusterId);
+        options.kinds?.forEach((kind) => params.append('kinds', kind));
+        if (options.pageSize) params.set('pageSize', String(options.pageSize));
+        if (options.pageToken) params.set('pageToken', options.pageToken);
+        if (options.rawConfigFormat)
+            params.set('rawConfigFormat', options.rawConfigFormat);
+        const response = await api.get<v1alpha1ListIstioResourcesResponse>(
+            '/api/v1alpha1/istio-resources',
+            { params }
+        );
+        return response.data;
+    },
+
+    getIstioResourcesDownloadUrl: (
+        options: {
+            namespace?: string;
+            clusterId?: string;
+            kinds?: v1alpha1IstioResourceKind[];
+        } = {}
+    ): string => {
+        const params = new URLSearchParams();
+        if (options.namespace) params.set('namespace', options.namespace);
+        if (options.clusterId) params.set('clusterId', options.clusterId);
+        options.kinds?.forEach((kind) => params.append('kinds', kind));
+        const query = params.toString();
+        return `${API_BASE_URL}/api/v1alpha1/istio-resources/download${query ? `?${query}` : ''}`;
+    },
+
+    getResourceReferences: async (resource: {
+        clusterId: string;
+        kind: string;
+        namespace: string;
+        name: string;
+    }): Promise<v1alpha1GetResourceReferencesResponse> => {
+        const response = await api.get<v1alpha1GetResourceReferencesResponse>(
+            '/api/v1alpha1/resource-references',
+            { params: resource }
+        );
+        return response.data;
+    },
+
+    getEnvoyAdmin: async (
+        serviceId: string,
+        instanceId: string,
+        path: string,
+        query?: string
+    ): Promise<v1alpha1GetEnvoyAdminResponse> => {
+        const response = await api.get<v1alpha1GetEnvoyAdminResponse>(
+            `/api/v1alpha1/services/${serviceId}/instances/${instanceId}/envoy-admin/${path}`,
+            { params: query ? { query } : undefined }
+        );
+        return response.data;
+    },
+
+    simulateRoute: async (
+        serviceId: string,
+        instanceId: string,
+        request: ServiceRegistryServiceSimulateRouteBody
+    ): Promise<v1alpha1SimulateRouteResponse> => {
+        const response = await api.post<v1alpha1SimulateRouteResponse>(
+            `/api/v1alpha1/services/${serviceId}/instances/${instanceId}/simulate-route`,
+            request
+        );
+        return response.data;
+    },
 };
 
 export default api;